@@ -414,6 +414,11 @@ func (i *Installer) Install(ctx context.Context, mode Mode) (err error) {
 			if ok, err = metaState.SetTag(ctx, metaconsts.Upgrade, bootInstallResult.PreviousLabel); !ok || err != nil {
 				return fmt.Errorf("failed to set upgrade tag: %q", bootInstallResult.PreviousLabel)
 			}
+
+			// the post-upgrade health checks start over for the new upgrade
+			if _, err = metaState.DeleteTag(ctx, metaconsts.UpgradeHealthCheckStarted); err != nil {
+				return fmt.Errorf("failed to delete upgrade health check tag: %w", err)
+			}
 		}
 
 		for _, v := range i.options.MetaValues.values {
@@ -0,0 +1,157 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package common
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"google.golang.org/grpc/codes"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+// Exit codes of talosctl.
+//
+// The exit codes are the same for all commands, so that talosctl can be used in the scripts:
+//
+//	0 - the command succeeded on all nodes
+//	1 - the command failed
+//	2 - the command succeeded on some nodes, but failed on others (or the result is degraded)
+//	3 - the nodes (or the endpoints) are unreachable
+//	4 - authentication or authorization failed
+const (
+	// ExitCodeFailed is returned if the command failed.
+	ExitCodeFailed = 1
+	// ExitCodePartial is returned if the command completed, but failed on some of the nodes.
+	ExitCodePartial = 2
+	// ExitCodeDegraded is returned if the command completed, but the result is partial (e.g. some checks failed).
+	ExitCodeDegraded = ExitCodePartial
+	// ExitCodeUnreachable is returned if the nodes or the endpoints can't be reached.
+	ExitCodeUnreachable = 3
+	// ExitCodeAuth is returned if the client failed to authenticate or is not authorized to run the command.
+	ExitCodeAuth = 4
+)
+
+// ExitError is an error which sets the exit code of talosctl.
+type ExitError struct {
+	Err  error
+	Code int
+}
+
+// NewExitError wraps the error with the exit code.
+func NewExitError(code int, err error) error {
+	return &ExitError{Err: err, Code: code}
+}
+
+// Error implements error interface.
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap implements errors.Unwrap interface.
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode returns the exit code for the error returned by the command.
+//
+// If the error doesn't carry the exit code explicitly, it is derived from the gRPC status codes of the error,
+// so the API errors should be wrapped with `%w`.
+// Multiple errors (e.g. one per node) result in a specific exit code only if all of them agree on it.
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+
+	var exitErr *ExitError
+
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+
+	var partialErr *helpers.PartialError
+
+	if errors.As(err, &partialErr) {
+		return ExitCodePartial
+	}
+
+	var multiErr *multierror.Error
+
+	if errors.As(err, &multiErr) && len(multiErr.Errors) > 0 {
+		return exitCodeAll(multiErr.Errors)
+	}
+
+	var joinedErr interface{ Unwrap() []error }
+
+	if errors.As(err, &joinedErr) && len(joinedErr.Unwrap()) > 0 {
+		return exitCodeAll(joinedErr.Unwrap())
+	}
+
+	if st := client.Status(err); st != nil {
+		return exitCodeFromStatus(st.Code(), st.Message())
+	}
+
+	return ExitCodeFailed
+}
+
+// exitCodeAll returns the exit code the errors agree on, or ExitCodeFailed.
+func exitCodeAll(errs []error) int {
+	code := ExitCode(errs[0])
+
+	for _, err := range errs[1:] {
+		if ExitCode(err) != code {
+			return ExitCodeFailed
+		}
+	}
+
+	return code
+}
+
+func exitCodeFromStatus(code codes.Code, message string) int {
+	switch code { //nolint:exhaustive
+	case codes.Unauthenticated, codes.PermissionDenied:
+		return ExitCodeAuth
+	case codes.Unavailable:
+		// TLS errors are reported by gRPC as a connection failure, the status carries only the message
+		if strings.Contains(message, "authentication handshake failed") {
+			return ExitCodeAuth
+		}
+
+		return ExitCodeUnreachable
+	case codes.DeadlineExceeded:
+		return ExitCodeUnreachable
+	default:
+		return ExitCodeFailed
+	}
+}
+
+var nodeErrors error
+
+// WarnNodeErrors prints the errors of the nodes which failed to respond as a warning and records them.
+//
+// The command continues with the responses of the other nodes, and talosctl exits with ExitCodePartial,
+// unless the command fails.
+func WarnNodeErrors(err error) {
+	if err == nil {
+		return
+	}
+
+	cli.Warning("%s", err)
+
+	nodeErrors = errors.Join(nodeErrors, err)
+}
+
+// PartialFailure returns the error with ExitCodePartial if any node errors were recorded by WarnNodeErrors.
+func PartialFailure() error {
+	if nodeErrors == nil {
+		return nil
+	}
+
+	return NewExitError(ExitCodePartial, nodeErrors)
+}
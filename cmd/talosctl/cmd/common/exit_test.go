@@ -0,0 +1,126 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package common_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+func TestExitCode(t *testing.T) {
+	t.Parallel()
+
+	unavailable := status.Error(codes.Unavailable, "connection error: desc = \"transport: Error while dialing: dial tcp 172.20.0.2:50000: connect: connection refused\"")
+	denied := status.Error(codes.PermissionDenied, "not authorized")
+
+	for _, test := range []struct {
+		name string
+		err  error
+
+		expected int
+	}{
+		{
+			name:     "success",
+			expected: 0,
+		},
+		{
+			name:     "generic",
+			err:      errors.New("failed"),
+			expected: common.ExitCodeFailed,
+		},
+		{
+			name:     "explicit",
+			err:      fmt.Errorf("health check failed: %w", common.NewExitError(common.ExitCodeDegraded, errors.New("failed"))),
+			expected: common.ExitCodePartial,
+		},
+		{
+			name:     "partial",
+			err:      fmt.Errorf("error listing files: %w", &helpers.PartialError{Err: unavailable}),
+			expected: common.ExitCodePartial,
+		},
+		{
+			name:     "unavailable",
+			err:      fmt.Errorf("error getting version: %w", unavailable),
+			expected: common.ExitCodeUnreachable,
+		},
+		{
+			name:     "deadline",
+			err:      status.Error(codes.DeadlineExceeded, "context deadline exceeded"),
+			expected: common.ExitCodeUnreachable,
+		},
+		{
+			name:     "unauthenticated",
+			err:      status.Error(codes.Unauthenticated, "invalid token"),
+			expected: common.ExitCodeAuth,
+		},
+		{
+			name:     "tls",
+			err:      status.Error(codes.Unavailable, "connection error: desc = \"transport: authentication handshake failed: tls: failed to verify certificate\""),
+			expected: common.ExitCodeAuth,
+		},
+		{
+			name:     "not found",
+			err:      status.Error(codes.NotFound, "service not found"),
+			expected: common.ExitCodeFailed,
+		},
+		{
+			name:     "wrapped",
+			err:      fmt.Errorf("error getting version: %w", denied),
+			expected: common.ExitCodeAuth,
+		},
+		{
+			name:     "formatted",
+			err:      fmt.Errorf("error getting version: %s", denied),
+			expected: common.ExitCodeFailed,
+		},
+		{
+			name: "all nodes unreachable",
+			err: multierror.Append(nil,
+				&client.NodeError{Node: "172.20.0.2", Err: unavailable},
+				&client.NodeError{Node: "172.20.0.3", Err: unavailable},
+			),
+			expected: common.ExitCodeUnreachable,
+		},
+		{
+			name: "all nodes failed",
+			err: multierror.Append(nil,
+				&client.NodeError{Node: "172.20.0.2", Err: unavailable},
+				&client.NodeError{Node: "172.20.0.3", Err: denied},
+			),
+			expected: common.ExitCodeFailed,
+		},
+		{
+			name:     "wrapped nodes unreachable",
+			err:      fmt.Errorf("error getting version: %w", multierror.Append(nil, unavailable, unavailable)),
+			expected: common.ExitCodeUnreachable,
+		},
+		{
+			name:     "wrapped nodes failed",
+			err:      fmt.Errorf("error getting version: %w", multierror.Append(nil, unavailable, denied)),
+			expected: common.ExitCodeFailed,
+		},
+		{
+			name:     "joined nodes unreachable",
+			err:      errors.Join(&client.NodeError{Node: "172.20.0.2", Err: unavailable}, &client.NodeError{Node: "172.20.0.3", Err: unavailable}),
+			expected: common.ExitCodeUnreachable,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, common.ExitCode(test.err))
+		})
+	}
+}
@@ -22,6 +22,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/blang/semver/v4"
 	"github.com/dustin/go-humanize"
 	"github.com/google/uuid"
 	"github.com/hashicorp/go-getter/v2"
@@ -34,14 +35,18 @@ import (
 	"github.com/siderolabs/go-procfs/procfs"
 	sideronet "github.com/siderolabs/net"
 	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
 	"k8s.io/client-go/tools/clientcmd"
 
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/internal/badnode"
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt/cluster/internal/firewallpatch"
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/mgmt/helpers"
 	"github.com/siderolabs/talos/pkg/cli"
 	"github.com/siderolabs/talos/pkg/cluster/check"
 	"github.com/siderolabs/talos/pkg/images"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+	"github.com/siderolabs/talos/pkg/machinery/compatibility"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/bundle"
 	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
@@ -188,6 +193,7 @@ var (
 	withFirewall              string
 	withUUIDHostnames         bool
 	withSiderolinkAgent       agentFlag
+	withBadNode               []string
 )
 
 // createCmd represents the cluster up command.
@@ -196,7 +202,9 @@ var createCmd = &cobra.Command{
 	Short: "Creates a local docker-based or QEMU-based kubernetes cluster",
 	Args:  cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return cli.WithContext(context.Background(), create)
+		return cli.WithContext(context.Background(), func(ctx context.Context) error {
+			return create(ctx, cmd.Flags())
+		})
 	},
 }
 
@@ -291,8 +299,72 @@ func downloadBootAssets(ctx context.Context) error {
 	return nil
 }
 
+// pinTalosVersion sets the images and boot assets of the Talos release requested with --talos-version,
+// unless they were explicitly set.
+//
+// Partial versions (e.g. v1.9) only select the machine configuration version.
+func pinTalosVersion(flags *pflag.FlagSet) {
+	if !flags.Changed(talosVersionFlag) {
+		return
+	}
+
+	if _, err := semver.Parse(strings.TrimPrefix(talosVersion, "v")); err != nil {
+		return
+	}
+
+	talosVersion = "v" + strings.TrimPrefix(talosVersion, "v")
+
+	if !flags.Changed("image") {
+		nodeImage = images.DefaultTalosImageRepository + ":" + talosVersion
+	}
+
+	if !flags.Changed(nodeInstallImageFlag) {
+		nodeInstallImage = images.DefaultInstallerImageRepository + ":" + talosVersion
+	}
+
+	releaseAssetURL := func(name string) string {
+		return fmt.Sprintf("https://github.com/%s/talos/releases/download/%s/%s",
+			images.Username, talosVersion, strings.ReplaceAll(name, constants.ArchVariable, targetArch))
+	}
+
+	if nodeISOPath != "" || nodeDiskImagePath != "" || nodeIPXEBootScript != "" {
+		return
+	}
+
+	if !flags.Changed("vmlinuz-path") {
+		nodeVmlinuzPath = releaseAssetURL(constants.KernelAssetWithArch)
+	}
+
+	if !flags.Changed("initrd-path") {
+		nodeInitramfsPath = releaseAssetURL(constants.InitramfsAssetWithArch)
+	}
+}
+
+// checkKubernetesVersion warns if the Kubernetes version is not supported by the Talos version.
+func checkKubernetesVersion() {
+	if talosVersion == "latest" {
+		return
+	}
+
+	talosVers, err := compatibility.ParseTalosVersion(&machineapi.VersionInfo{Tag: talosVersion})
+	if err != nil {
+		return
+	}
+
+	k8sVers, err := compatibility.ParseKubernetesVersion(kubernetesVersion)
+	if err != nil {
+		return
+	}
+
+	if err = k8sVers.SupportedWith(talosVers); err != nil {
+		fmt.Fprintf(os.Stderr, "warning: Kubernetes version compatibility check failed: %s\n", err)
+	}
+}
+
 //nolint:gocyclo,cyclop
-func create(ctx context.Context) error {
+func create(ctx context.Context, flags *pflag.FlagSet) error {
+	pinTalosVersion(flags)
+
 	if err := downloadBootAssets(ctx); err != nil {
 		return err
 	}
@@ -415,6 +487,23 @@ func create(ctx context.Context) error {
 		}
 	}
 
+	badNodeFaults, err := badnode.Parse(withBadNode)
+	if err != nil {
+		return err
+	}
+
+	if len(badNodeFaults) > 0 && workers < 1 {
+		return errors.New("--with-bad-node requires at least one worker")
+	}
+
+	if provisionerName == docker {
+		for _, fault := range badNodeFaults {
+			if fault.QEMUOnly() {
+				return fmt.Errorf("bad node fault %q is not supported with docker provisioner", fault)
+			}
+		}
+	}
+
 	provisioner, err := providers.Factory(ctx, provisionerName)
 	if err != nil {
 		return err
@@ -546,6 +635,10 @@ func create(ctx context.Context) error {
 			}
 		}
 
+		if flags.Changed(talosVersionFlag) {
+			checkKubernetesVersion()
+		}
+
 		var versionContract *config.VersionContract
 
 		if talosVersion != "latest" {
@@ -911,20 +1004,29 @@ func create(ctx context.Context) error {
 			return err
 		}
 
-		request.Nodes = append(request.Nodes,
-			provision.NodeRequest{
-				Name:                nodeName(clusterName, "worker", i, nodeUUID),
-				Type:                machine.TypeWorker,
-				IPs:                 nodeIPs,
-				Memory:              workerMemory,
-				NanoCPUs:            workerNanoCPUs,
-				Disks:               disks,
-				Config:              cfg,
-				SkipInjectingConfig: skipInjectingConfig,
-				BadRTC:              badRTC,
-				ExtraKernelArgs:     extraKernelArgs,
-				UUID:                pointer.To(nodeUUID),
-			})
+		nodeReq := provision.NodeRequest{
+			Name:                nodeName(clusterName, "worker", i, nodeUUID),
+			Type:                machine.TypeWorker,
+			IPs:                 nodeIPs,
+			Memory:              workerMemory,
+			NanoCPUs:            workerNanoCPUs,
+			Disks:               disks,
+			SkipInjectingConfig: skipInjectingConfig,
+			BadRTC:              badRTC,
+			ExtraKernelArgs:     extraKernelArgs,
+			UUID:                pointer.To(nodeUUID),
+		}
+
+		// the last worker is the bad node
+		if len(badNodeFaults) > 0 && i == workers {
+			cfg, err = injectFaults(&nodeReq, cfg, badNodeFaults)
+			if err != nil {
+				return err
+			}
+		}
+
+		nodeReq.Config = cfg
+		request.Nodes = append(request.Nodes, nodeReq)
 	}
 
 	request.SiderolinkRequest = slb.SiderolinkRequest()
@@ -973,6 +1075,26 @@ func nodeName(clusterName, role string, index int, uuid uuid.UUID) string {
 	return fmt.Sprintf("%s-%s-%d", clusterName, role, index)
 }
 
+func injectFaults(nodeReq *provision.NodeRequest, cfg config.Provider, faults []badnode.Fault) (config.Provider, error) {
+	patch, err := badnode.Patch(faults)
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := configpatcher.Apply(configpatcher.WithConfig(cfg), []configpatcher.Patch{patch})
+	if err != nil {
+		return nil, fmt.Errorf("error injecting faults into bad node config: %w", err)
+	}
+
+	if slices.Contains(faults, badnode.ClockSkew) {
+		nodeReq.ClockOffset = badnode.ClockOffset
+	}
+
+	fmt.Fprintf(os.Stderr, "injecting faults into node %s: %v\n", nodeReq.Name, faults)
+
+	return out.Config()
+}
+
 func postCreate(ctx context.Context, clusterAccess *access.Adapter) error {
 	if !withInitNode {
 		if err := clusterAccess.Bootstrap(ctx, os.Stdout); err != nil {
@@ -984,6 +1106,12 @@ func postCreate(ctx context.Context, clusterAccess *access.Adapter) error {
 		return nil
 	}
 
+	if len(withBadNode) > 0 {
+		fmt.Fprintln(os.Stderr, "skipping cluster readiness checks, as the bad node is expected to be unhealthy")
+
+		return nil
+	}
+
 	// Run cluster readiness checks
 	checkCtx, checkCtxCancel := context.WithTimeout(ctx, clusterWaitTimeout)
 	defer checkCtxCancel()
@@ -1225,7 +1353,7 @@ func init() {
 	createCmd.Flags().BoolVar(&encryptStatePartition, encryptStatePartitionFlag, false, "enable state partition encryption")
 	createCmd.Flags().BoolVar(&encryptEphemeralPartition, encryptEphemeralPartitionFlag, false, "enable ephemeral partition encryption")
 	createCmd.Flags().StringArrayVar(&diskEncryptionKeyTypes, diskEncryptionKeyTypesFlag, []string{"uuid"}, "encryption key types to use for disk encryption (uuid, kms)")
-	createCmd.Flags().StringVar(&talosVersion, talosVersionFlag, "", "the desired Talos version to generate config for (if not set, defaults to image version)")
+	createCmd.Flags().StringVar(&talosVersion, talosVersionFlag, "", "the desired Talos version to generate config for, release versions (e.g. v1.8.1) also select the images and boot assets of the release (if not set, defaults to image version)")
 	createCmd.Flags().BoolVar(&useVIP, useVIPFlag, false, "use a virtual IP for the controlplane endpoint instead of the loadbalancer")
 	createCmd.Flags().BoolVar(&enableClusterDiscovery, withClusterDiscoveryFlag, true, "enable cluster discovery")
 	createCmd.Flags().BoolVar(&enableKubeSpan, enableKubeSpanFlag, false, "enable KubeSpan system")
@@ -1250,6 +1378,8 @@ func init() {
 	createCmd.Flags().IntVar(&bandwidth, "with-network-bandwidth", 0, "specify bandwidth restriction (in kbps) on the bridge interface when creating a qemu cluster")
 	createCmd.Flags().StringVar(&withFirewall, firewallFlag, "", "inject firewall rules into the cluster, value is default policy - accept/block (QEMU only)")
 	createCmd.Flags().BoolVar(&withUUIDHostnames, "with-uuid-hostnames", false, "use machine UUIDs as default hostnames (QEMU only)")
+	createCmd.Flags().StringSliceVar(&withBadNode, "with-bad-node", nil,
+		"inject failure modes into the last worker node to rehearse diagnosis (clock-skew, partitioned, wrong-token, full-disk; clock-skew and full-disk are QEMU only)")
 	createCmd.Flags().Var(&withSiderolinkAgent, "with-siderolink", "enables the use of siderolink agent as configuration apply mechanism. `true` or `wireguard` enables the agent, `tunnel` enables the agent with grpc tunneling") //nolint:lll
 
 	createCmd.MarkFlagsMutuallyExclusive(inputDirFlag, nodeInstallImageFlag)
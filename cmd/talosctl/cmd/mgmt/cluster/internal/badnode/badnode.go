@@ -0,0 +1,151 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package badnode provides config patches to inject failure modes into a node of a local cluster.
+package badnode
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/siderolabs/go-pointer"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configpatcher"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/block"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+// Fault is a failure mode injected into the node.
+type Fault string
+
+// Supported faults.
+const (
+	// ClockSkew starts the node with the clock behind the rest of the cluster and time sync disabled.
+	ClockSkew Fault = "clock-skew"
+	// Partitioned blocks all incoming connections to the node.
+	Partitioned Fault = "partitioned"
+	// WrongToken configures the node with a machine token not matching the cluster.
+	WrongToken Fault = "wrong-token"
+	// FullDisk shrinks the EPHEMERAL volume of the node, so that it fills up on image pulls.
+	FullDisk Fault = "full-disk"
+)
+
+// Faults lists all supported faults.
+var Faults = []Fault{ClockSkew, Partitioned, WrongToken, FullDisk}
+
+// ClockOffset is the offset of the node clock relative to the host clock for the ClockSkew fault.
+//
+// The clock is moved to the past, so that the certificates issued by the cluster are not valid yet.
+const ClockOffset = -24 * time.Hour
+
+// ephemeralSize is the size of the EPHEMERAL volume for the FullDisk fault.
+//
+// It is slightly above the minimum size of the XFS filesystem.
+const ephemeralSize = "400MiB"
+
+// Parse parses the list of fault names.
+func Parse(names []string) ([]Fault, error) {
+	faults := make([]Fault, 0, len(names))
+
+	for _, name := range names {
+		fault := Fault(strings.TrimSpace(name))
+
+		if !slices.Contains(Faults, fault) {
+			return nil, fmt.Errorf("unknown bad node fault %q, supported faults: %s", name, strings.Join(faultNames(), ", "))
+		}
+
+		if !slices.Contains(faults, fault) {
+			faults = append(faults, fault)
+		}
+	}
+
+	return faults, nil
+}
+
+func faultNames() []string {
+	names := make([]string, 0, len(Faults))
+
+	for _, fault := range Faults {
+		names = append(names, string(fault))
+	}
+
+	return names
+}
+
+// QEMUOnly returns true if the fault can be injected only by the QEMU provisioner.
+//
+// Docker nodes share the clock and the filesystem of the host.
+func (f Fault) QEMUOnly() bool {
+	return f == ClockSkew || f == FullDisk
+}
+
+// Patch generates the config patch which injects the faults into the node config.
+func Patch(faults []Fault) (configpatcher.Patch, error) {
+	machineConfig := &v1alpha1.MachineConfig{}
+	documents := []config.Document{
+		&v1alpha1.Config{
+			MachineConfig: machineConfig,
+		},
+	}
+
+	for _, fault := range faults {
+		switch fault {
+		case ClockSkew:
+			machineConfig.MachineTime = &v1alpha1.TimeConfig{
+				TimeDisabled: pointer.To(true),
+			}
+		case Partitioned:
+			def := network.NewDefaultActionConfigV1Alpha1()
+			def.Ingress = nethelpers.DefaultActionBlock
+
+			documents = append(documents, def)
+		case WrongToken:
+			token, err := randomToken()
+			if err != nil {
+				return nil, err
+			}
+
+			machineConfig.MachineToken = token
+		case FullDisk:
+			ephemeral := block.NewVolumeConfigV1Alpha1()
+			ephemeral.MetaName = constants.EphemeralPartitionLabel
+			ephemeral.ProvisioningSpec = block.ProvisioningSpec{
+				ProvisioningGrow:    pointer.To(false),
+				ProvisioningMinSize: block.MustByteSize(ephemeralSize),
+				ProvisioningMaxSize: block.MustByteSize(ephemeralSize),
+			}
+
+			documents = append(documents, ephemeral)
+		}
+	}
+
+	provider, err := container.New(documents...)
+	if err != nil {
+		return nil, err
+	}
+
+	return configpatcher.NewStrategicMergePatch(provider), nil
+}
+
+// randomToken generates a token in the format of the machine token (abcdef.0123456789abcdef).
+func randomToken() (string, error) {
+	buf := make([]byte, 11)
+
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	encoded := hex.EncodeToString(buf)
+
+	return encoded[:6] + "." + encoded[6:], nil
+}
@@ -0,0 +1,143 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package debug
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/talos"
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var attachCmdFlags struct {
+	listen string
+}
+
+// attachCmd represents the `debug attach` command.
+var attachCmd = &cobra.Command{
+	Use:   "attach <pid>",
+	Short: "Attach the delve debugger to a Talos service",
+	Long: `Attach the delve debugger to a process on the node, and tunnel the debugger API to a local port.
+
+The API is only available in the debug builds of Talos (built with WITH_DEBUG=1).
+Use PID 1 to debug machined, and 'talosctl processes' to find the PIDs of other services.
+
+Connect to the debugger with 'dlv connect', the debugger detaches from the process when the client disconnects
+(unless the process is killed with 'quit' in the client).
+As the debugger stops the process, services which are in the path of the API (apid) can't be debugged this way.`,
+	Example: `  talosctl debug attach 1 --listen 127.0.0.1:2345
+  dlv connect 127.0.0.1:2345`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pid, err := strconv.ParseInt(args[0], 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid process ID %q: %w", args[0], err)
+		}
+
+		return talos.WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "debug attach"); err != nil {
+				return err
+			}
+
+			var lc net.ListenConfig
+
+			listener, err := lc.Listen(ctx, "tcp", attachCmdFlags.listen)
+			if err != nil {
+				return err
+			}
+
+			defer listener.Close() //nolint:errcheck
+
+			go func() {
+				<-ctx.Done()
+
+				listener.Close() //nolint:errcheck
+			}()
+
+			fmt.Fprintf(os.Stderr, "debugger tunnel is listening on %s, connect with 'dlv connect %s'\n", listener.Addr(), listener.Addr())
+
+			for {
+				conn, err := listener.Accept()
+				if err != nil {
+					if ctx.Err() != nil {
+						return nil
+					}
+
+					return err
+				}
+
+				// the process can be debugged by a single debugger at a time, so serve the connections one by one
+				if err = tunnelDebugger(ctx, c, int32(pid), conn); err != nil {
+					fmt.Fprintf(os.Stderr, "debugger tunnel failed: %s\n", err)
+				} else {
+					fmt.Fprintln(os.Stderr, "debugger detached")
+				}
+			}
+		})
+	},
+}
+
+func tunnelDebugger(ctx context.Context, c *client.Client, pid int32, conn net.Conn) error {
+	defer conn.Close() //nolint:errcheck
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	stream, err := c.DebugAttach(ctx, pid)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		buf := make([]byte, 32*1024)
+
+		for {
+			n, err := conn.Read(buf)
+
+			if n > 0 {
+				if stream.Send(&machine.DebugAttachRequest{Data: buf[:n]}) != nil {
+					return
+				}
+			}
+
+			if err != nil {
+				stream.CloseSend() //nolint:errcheck
+
+				return
+			}
+		}
+	}()
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return err
+		}
+
+		if _, err = conn.Write(msg.GetBytes()); err != nil {
+			return err
+		}
+	}
+}
+
+func init() {
+	attachCmd.Flags().StringVar(&attachCmdFlags.listen, "listen", "127.0.0.1:2345", "local address to listen on for the debugger client")
+
+	Cmd.AddCommand(attachCmd)
+}
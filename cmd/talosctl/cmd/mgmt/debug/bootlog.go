@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package debug
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/talos"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var bootlogCmdFlags struct {
+	boots int
+}
+
+// bootlogCmd represents the `debug bootlog` command.
+var bootlogCmd = &cobra.Command{
+	Use:   "bootlog",
+	Short: "Retrieve early boot logs of the last boots",
+	Long: `Retrieve early boot logs (kernel and machined logs) of the last boots.
+
+The early boot log is saved to the STATE partition once it is mounted, and updated if the boot fails,
+so it can be used to diagnose boot failures which happened before the services were started.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return talos.WithClient(func(ctx context.Context, c *client.Client) error {
+			resp, err := c.BootLogs(ctx, bootlogCmdFlags.boots)
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error getting boot logs: %w", err)
+				}
+
+				common.WarnNodeErrors(err)
+			}
+
+			for _, msg := range resp.GetMessages() {
+				for _, boot := range msg.GetBoots() {
+					current := ""
+
+					if boot.GetCurrent() {
+						current = " (current)"
+					}
+
+					fmt.Printf("==> %s: boot #%d%s, boot ID %s, saved at %s <==\n",
+						msg.GetMetadata().GetHostname(),
+						boot.GetSequence(),
+						current,
+						boot.GetBootId(),
+						boot.GetTimestamp().AsTime().Format(time.RFC3339),
+					)
+
+					if _, writeErr := os.Stdout.Write(boot.GetLog()); writeErr != nil {
+						return writeErr
+					}
+
+					fmt.Println()
+				}
+			}
+
+			return err
+		})
+	},
+}
+
+func init() {
+	bootlogCmd.Flags().IntVar(&bootlogCmdFlags.boots, "boots", 1, "number of the last boots to retrieve logs for")
+
+	Cmd.AddCommand(bootlogCmd)
+}
@@ -0,0 +1,163 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package debug
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/talos"
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// coresCmd represents the `debug cores` command.
+var coresCmd = &cobra.Command{
+	Use:   "cores",
+	Short: "Manage core dumps of the crashed system services",
+	Long: `Manage core dumps of the crashed system services.
+
+The core dumps of Talos and Kubernetes runtime services are compressed with zstd and stored on the STATE partition,
+only the latest core dumps are kept.`,
+}
+
+// coresListCmd represents the `debug cores list` command.
+var coresListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List core dumps",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return talos.WithClient(func(ctx context.Context, c *client.Client) error {
+			// list the STATE partition, as the core dumps directory is created on the first crash
+			stream, err := c.LS(ctx, &machine.ListRequest{
+				Root:           path.Dir(constants.CoreDumpsPath),
+				Recurse:        true,
+				RecursionDepth: 2,
+				Types:          []machine.ListRequest_Type{machine.ListRequest_REGULAR},
+			})
+			if err != nil {
+				return fmt.Errorf("error listing core dumps: %w", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			defer w.Flush() //nolint:errcheck
+
+			fmt.Fprintln(w, "NODE\tNAME\tSIZE\tCREATED")
+
+			return helpers.ReadGRPCStream(stream, func(info *machine.FileInfo, node string, _ bool) error {
+				if path.Dir(info.Name) != constants.CoreDumpsPath {
+					return nil
+				}
+
+				if info.Error != "" {
+					return helpers.NonFatalError(fmt.Errorf("%s: error reading file %s: %s", node, info.Name, info.Error))
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n",
+					node,
+					path.Base(info.Name),
+					humanize.Bytes(uint64(info.Size)),
+					time.Unix(info.Modified, 0).Format(time.RFC3339),
+				)
+
+				return nil
+			})
+		})
+	},
+}
+
+var coresFetchCmdFlags struct {
+	output string
+}
+
+// coresFetchCmd represents the `debug cores fetch` command.
+var coresFetchCmd = &cobra.Command{
+	Use:   "fetch <name>",
+	Short: "Download a core dump",
+	Long: `Download a core dump from the node.
+
+The core dump is saved compressed, use 'zstd -d' to decompress it.`,
+	Example: `  talosctl debug cores fetch 1700000000-udevd-1234.core.zst`,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		name := args[0]
+
+		if path.Base(name) != name {
+			return fmt.Errorf("invalid core dump name %q", name)
+		}
+
+		output := coresFetchCmdFlags.output
+		if output == "" {
+			output = name
+		}
+
+		return talos.WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "debug cores fetch"); err != nil {
+				return err
+			}
+
+			r, err := c.Read(ctx, path.Join(constants.CoreDumpsPath, name))
+			if err != nil {
+				return fmt.Errorf("error reading core dump: %w", err)
+			}
+
+			defer r.Close() //nolint:errcheck
+
+			partPath := output + ".part"
+
+			defer os.RemoveAll(partPath) //nolint:errcheck
+
+			dest, err := os.OpenFile(partPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+			if err != nil {
+				return fmt.Errorf("error creating temporary file: %w", err)
+			}
+
+			defer dest.Close() //nolint:errcheck
+
+			size, err := io.Copy(dest, r)
+			if err != nil {
+				return fmt.Errorf("error reading core dump: %w", err)
+			}
+
+			if err = r.Close(); err != nil {
+				return fmt.Errorf("error reading core dump: %w", err)
+			}
+
+			if size == 0 {
+				return errors.New("empty core dump received")
+			}
+
+			if err = dest.Close(); err != nil {
+				return err
+			}
+
+			if err = os.Rename(partPath, output); err != nil {
+				return fmt.Errorf("error renaming to final location: %w", err)
+			}
+
+			fmt.Fprintf(os.Stderr, "core dump saved to %q (%s)\n", output, humanize.Bytes(uint64(size)))
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	coresFetchCmd.Flags().StringVarP(&coresFetchCmdFlags.output, "output", "o", "", "output file (defaults to the core dump name)")
+
+	coresCmd.AddCommand(coresListCmd, coresFetchCmd)
+	Cmd.AddCommand(coresCmd)
+}
@@ -0,0 +1,110 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package debug
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/talos"
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var pprofCmdFlags struct {
+	profileType string
+	output      string
+	duration    time.Duration
+}
+
+// pprofCmd represents the `debug pprof` command.
+var pprofCmd = &cobra.Command{
+	Use:   "pprof <service>",
+	Short: "Collect a pprof profile of a Talos service",
+	Long: `Collect a pprof profile of a Talos service (machined, apid or trustd).
+
+CPU and mutex profiles are collected over the duration, heap and goroutine profiles are snapshots.
+The profile is saved in the gzipped protobuf format, and can be analyzed with 'go tool pprof'.`,
+	Example:   `  talosctl debug pprof machined --type heap -o machined-heap.pb.gz`,
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: []string{"machined", "apid", "trustd"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		profileType, ok := machine.ProfileRequest_Type_value[strings.ToUpper(pprofCmdFlags.profileType)]
+		if !ok {
+			return fmt.Errorf("unsupported profile type %q", pprofCmdFlags.profileType)
+		}
+
+		output := pprofCmdFlags.output
+		if output == "" {
+			output = fmt.Sprintf("%s-%s.pb.gz", args[0], strings.ToLower(pprofCmdFlags.profileType))
+		}
+
+		return talos.WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "debug pprof"); err != nil {
+				return err
+			}
+
+			r, err := c.Profile(ctx, &machine.ProfileRequest{
+				Service:  args[0],
+				Type:     machine.ProfileRequest_Type(profileType),
+				Duration: durationpb.New(pprofCmdFlags.duration),
+			})
+			if err != nil {
+				return fmt.Errorf("error collecting profile: %w", err)
+			}
+
+			defer r.Close() //nolint:errcheck
+
+			partPath := output + ".part"
+
+			defer os.RemoveAll(partPath) //nolint:errcheck
+
+			dest, err := os.OpenFile(partPath, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+			if err != nil {
+				return fmt.Errorf("error creating temporary file: %w", err)
+			}
+
+			defer dest.Close() //nolint:errcheck
+
+			size, err := io.Copy(dest, r)
+			if err != nil {
+				return fmt.Errorf("error reading profile: %w", err)
+			}
+
+			if size == 0 {
+				return errors.New("empty profile received")
+			}
+
+			if err = dest.Close(); err != nil {
+				return err
+			}
+
+			if err = os.Rename(partPath, output); err != nil {
+				return fmt.Errorf("error renaming to final location: %w", err)
+			}
+
+			fmt.Fprintf(os.Stderr, "%s profile of %s saved to %q (%d bytes)\n", strings.ToLower(pprofCmdFlags.profileType), args[0], output, size)
+
+			return nil
+		})
+	},
+}
+
+func init() {
+	pprofCmd.Flags().StringVar(&pprofCmdFlags.profileType, "type", "cpu", "profile type: cpu, heap, goroutine or mutex")
+	pprofCmd.Flags().StringVarP(&pprofCmdFlags.output, "output", "o", "", "output file (defaults to <service>-<type>.pb.gz)")
+	pprofCmd.Flags().DurationVar(&pprofCmdFlags.duration, "duration", 30*time.Second, "duration of the cpu and mutex profiles")
+
+	Cmd.AddCommand(pprofCmd)
+}
@@ -0,0 +1,55 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package debug
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/talos"
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+// stacksCmd represents the `debug stacks` command.
+var stacksCmd = &cobra.Command{
+	Use:   "stacks <service>",
+	Short: "Dump the goroutine stacks of a Talos service",
+	Long: `Dump the goroutine stacks of a Talos service (machined, apid or trustd).
+
+The stacks are printed in the same format as the Go runtime prints them on SIGQUIT, but the service keeps running,
+so the dump can be attached to a bug report about a stuck service.`,
+	Example:   `  talosctl debug stacks machined > machined-stacks.txt`,
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: []string{"machined", "apid", "trustd"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return talos.WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "debug stacks"); err != nil {
+				return err
+			}
+
+			r, err := c.StackDump(ctx, args[0])
+			if err != nil {
+				return fmt.Errorf("error dumping stacks: %w", err)
+			}
+
+			defer r.Close() //nolint:errcheck
+
+			if _, err = io.Copy(os.Stdout, r); err != nil {
+				return fmt.Errorf("error reading stacks: %w", err)
+			}
+
+			return r.Close()
+		})
+	},
+}
+
+func init() {
+	Cmd.AddCommand(stacksCmd)
+}
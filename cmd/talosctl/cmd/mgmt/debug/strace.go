@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package debug
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/talos"
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var straceCmdFlags struct {
+	syscalls    []string
+	duration    time.Duration
+	minDuration time.Duration
+}
+
+// straceCmd represents the `debug strace` command.
+var straceCmd = &cobra.Command{
+	Use:   "strace <pid>",
+	Short: "Trace the syscalls of a process",
+	Long: `Attach to the process on the node with ptrace and stream the syscalls it makes.
+
+The tracer detaches from the process when the tracing duration expires or the command is interrupted.`,
+	Example: `  talosctl debug strace 1234 --syscalls openat,read,write --min-duration 100ms`,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		pid, err := strconv.ParseInt(args[0], 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid process ID %q: %w", args[0], err)
+		}
+
+		req := &machine.StraceRequest{
+			Pid:      int32(pid),
+			Syscalls: straceCmdFlags.syscalls,
+			Duration: durationpb.New(straceCmdFlags.duration),
+		}
+
+		if straceCmdFlags.minDuration != 0 {
+			req.MinDuration = durationpb.New(straceCmdFlags.minDuration)
+		}
+
+		return talos.WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "debug strace"); err != nil {
+				return err
+			}
+
+			stream, err := c.Strace(ctx, req)
+			if err != nil {
+				return fmt.Errorf("error starting strace: %w", err)
+			}
+
+			return helpers.ReadGRPCStream(stream, func(event *machine.StraceEvent, _ string, _ bool) error {
+				result := strconv.FormatInt(event.GetReturn(), 10)
+
+				if event.GetErrno() != "" {
+					result = "-1 " + event.GetErrno()
+				}
+
+				fmt.Printf("[%d] %s %s(%s) = %s <%.6f>\n",
+					event.GetTid(),
+					event.GetTimestamp().AsTime().Local().Format("15:04:05.000000"),
+					event.GetSyscall(),
+					strings.Join(event.GetArgs(), ", "),
+					result,
+					event.GetDuration().AsDuration().Seconds(),
+				)
+
+				return nil
+			})
+		})
+	},
+}
+
+func init() {
+	straceCmd.Flags().StringSliceVar(&straceCmdFlags.syscalls, "syscalls", nil, "syscalls to trace (defaults to all syscalls)")
+	straceCmd.Flags().DurationVar(&straceCmdFlags.duration, "duration", 30*time.Second, "tracing duration (at most 10m)")
+	straceCmd.Flags().DurationVar(&straceCmdFlags.minDuration, "min-duration", 0, "skip the syscalls which completed faster")
+
+	Cmd.AddCommand(straceCmd)
+}
@@ -0,0 +1,103 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package debug
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/talos"
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var traceCmdFlags struct {
+	duration   time.Duration
+	minLatency time.Duration
+}
+
+// traceCmd represents the `debug trace` command.
+var traceCmd = &cobra.Command{
+	Use:   "trace <tool>",
+	Short: "Trace the node with an eBPF-based tool",
+	Long: `Trace the node with an eBPF-based tool and stream the traced events.
+
+Available tools:
+
+  syscall-latency    system calls slower than --min-latency (10ms by default)
+  tcp-retransmit     TCP retransmits
+  block-io-latency   block I/O requests slower than --min-latency (1ms by default)
+  exec               new processes being executed
+
+Events are dropped if the node can't keep up, the number of dropped events is reported.`,
+	Example:   `  talosctl debug trace block-io-latency --min-latency 20ms --duration 1m`,
+	Args:      cobra.ExactArgs(1),
+	ValidArgs: []string{"syscall-latency", "tcp-retransmit", "block-io-latency", "exec"},
+	RunE: func(cmd *cobra.Command, args []string) error {
+		tool, ok := machine.TraceRequest_Tool_value[strings.ToUpper(strings.ReplaceAll(args[0], "-", "_"))]
+		if !ok {
+			return fmt.Errorf("unsupported tracing tool %q", args[0])
+		}
+
+		req := &machine.TraceRequest{
+			Tool:     machine.TraceRequest_Tool(tool),
+			Duration: durationpb.New(traceCmdFlags.duration),
+		}
+
+		if traceCmdFlags.minLatency != 0 {
+			req.MinLatency = durationpb.New(traceCmdFlags.minLatency)
+		}
+
+		return talos.WithClient(func(ctx context.Context, c *client.Client) error {
+			stream, err := c.Trace(ctx, req)
+			if err != nil {
+				return fmt.Errorf("error starting trace: %w", err)
+			}
+
+			fmt.Printf("%-16s %-12s %8s %-16s %10s %s\n", "NODE", "TIME", "PID", "COMM", "LATENCY", "DETAILS")
+
+			lost := map[string]uint64{}
+
+			return helpers.ReadGRPCStream(stream, func(event *machine.TraceEvent, node string, _ bool) error {
+				latency := "-"
+
+				if event.GetLatency() != nil {
+					latency = event.GetLatency().AsDuration().Round(time.Microsecond).String()
+				}
+
+				fmt.Printf("%-16s %-12s %8d %-16s %10s %s\n",
+					node,
+					event.GetTimestamp().AsTime().Local().Format("15:04:05.000"),
+					event.GetPid(),
+					event.GetComm(),
+					latency,
+					event.GetDetails(),
+				)
+
+				if event.GetLost() > lost[node] {
+					fmt.Fprintf(os.Stderr, "%s: %d events lost\n", node, event.GetLost()-lost[node])
+
+					lost[node] = event.GetLost()
+				}
+
+				return nil
+			})
+		})
+	},
+}
+
+func init() {
+	traceCmd.Flags().DurationVar(&traceCmdFlags.duration, "duration", 30*time.Second, "tracing duration (at most 10m)")
+	traceCmd.Flags().DurationVar(&traceCmdFlags.minLatency, "min-latency", 0, "latency threshold for the latency tools (defaults to the tool default)")
+
+	Cmd.AddCommand(traceCmd)
+}
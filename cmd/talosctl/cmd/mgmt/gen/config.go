@@ -5,6 +5,7 @@
 package gen
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net/url"
@@ -65,6 +66,7 @@ var genConfigCmdFlags struct {
 	outputDir string
 
 	output                  string
+	outputFormat            string
 	outputTypes             []string
 	configPatch             []string
 	configPatchControlPlane []string
@@ -76,6 +78,7 @@ var genConfigCmdFlags struct {
 	withClusterDiscovery    bool
 	withKubeSpan            bool
 	withSecrets             string
+	workerJoinToken         string
 }
 
 // NewConfigCmd builds the config generation subcommand with the given name.
@@ -225,6 +228,10 @@ func writeConfig(args []string) error {
 		genOptions = append(genOptions, generate.WithSecretsBundle(secretsBundle))
 	}
 
+	if genConfigCmdFlags.workerJoinToken != "" {
+		genOptions = append(genOptions, generate.WithWorkerMachineToken(genConfigCmdFlags.workerJoinToken))
+	}
+
 	genOptions = append(genOptions,
 		generate.WithInstallDisk(genConfigCmdFlags.installDisk),
 		generate.WithInstallImage(genConfigCmdFlags.installImage),
@@ -255,7 +262,37 @@ func writeConfig(args []string) error {
 		return err
 	}
 
-	return writeConfigBundle(configBundle, paths, commentsFlags)
+	files, err := writeConfigBundle(configBundle, paths, commentsFlags)
+	if err != nil {
+		return err
+	}
+
+	if genConfigCmdFlags.outputFormat == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(&genConfigReport{
+			ClusterName:       args[0],
+			Endpoint:          args[1],
+			KubernetesVersion: genConfigCmdFlags.kubernetesVersion,
+			Files:             files,
+		})
+	}
+
+	return nil
+}
+
+// genConfigReport is the machine-readable result of the config generation.
+type genConfigReport struct {
+	ClusterName       string                `json:"clusterName"`
+	Endpoint          string                `json:"endpoint"`
+	KubernetesVersion string                `json:"kubernetesVersion"`
+	Files             []generatedConfigFile `json:"files"`
+}
+
+type generatedConfigFile struct {
+	Type string `json:"type"`
+	Path string `json:"path"`
 }
 
 func validateFlags() error {
@@ -271,6 +308,16 @@ func validateFlags() error {
 		return errors.New("can't use both output-dir and output")
 	}
 
+	switch genConfigCmdFlags.outputFormat {
+	case "", "text":
+	case "json":
+		if genConfigCmdFlags.output == stdoutOutput {
+			return errors.New("can't use json output format with stdout")
+		}
+	default:
+		return fmt.Errorf("unknown output format: %q", genConfigCmdFlags.outputFormat)
+	}
+
 	if genConfigCmdFlags.outputDir != "" {
 		genConfigCmdFlags.output = genConfigCmdFlags.outputDir
 	}
@@ -288,43 +335,55 @@ func validateFlags() error {
 	return err
 }
 
-func writeConfigBundle(configBundle *bundle.Bundle, outputPaths configOutputPaths, commentsFlags encoder.CommentsFlags) error {
+func writeConfigBundle(configBundle *bundle.Bundle, outputPaths configOutputPaths, commentsFlags encoder.CommentsFlags) ([]generatedConfigFile, error) {
 	outputTypesSet := xslices.ToSet(genConfigCmdFlags.outputTypes)
 
+	files := make([]generatedConfigFile, 0, len(outputTypesSet))
+
+	write := func(outputType string, data []byte, destination string) error {
+		if err := writeToDestination(data, destination, 0o644); err != nil {
+			return err
+		}
+
+		files = append(files, generatedConfigFile{Type: outputType, Path: destination})
+
+		return nil
+	}
+
 	if _, ok := outputTypesSet[controlPlaneOutputType]; ok {
 		data, err := configBundle.Serialize(commentsFlags, machine.TypeControlPlane)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		if err = writeToDestination(data, outputPaths.controlPlane, 0o644); err != nil {
-			return err
+		if err = write(controlPlaneOutputType, data, outputPaths.controlPlane); err != nil {
+			return nil, err
 		}
 	}
 
 	if _, ok := outputTypesSet[workerOutputType]; ok {
 		data, err := configBundle.Serialize(commentsFlags, machine.TypeWorker)
 		if err != nil {
-			return err
+			return nil, err
 		}
 
-		if err = writeToDestination(data, outputPaths.worker, 0o644); err != nil {
-			return err
+		if err = write(workerOutputType, data, outputPaths.worker); err != nil {
+			return nil, err
 		}
 	}
 
 	if _, ok := outputTypesSet[talosconfigOutputType]; ok {
 		data, err := yaml.Marshal(configBundle.TalosConfig())
 		if err != nil {
-			return fmt.Errorf("failed to marshal config: %+v", err)
+			return nil, fmt.Errorf("failed to marshal config: %+v", err)
 		}
 
-		if err = writeToDestination(data, outputPaths.talosconfig, 0o644); err != nil {
-			return err
+		if err = write(talosconfigOutputType, data, outputPaths.talosconfig); err != nil {
+			return nil, err
 		}
 	}
 
-	return nil
+	return files, nil
 }
 
 func writeToDestination(data []byte, destination string, permissions os.FileMode) error {
@@ -445,10 +504,13 @@ func init() {
 	genConfigCmd.Flags().BoolVarP(&genConfigCmdFlags.withClusterDiscovery, "with-cluster-discovery", "", true, "enable cluster discovery feature")
 	genConfigCmd.Flags().BoolVarP(&genConfigCmdFlags.withKubeSpan, "with-kubespan", "", false, "enable KubeSpan feature")
 	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.withSecrets, "with-secrets", "", "use a secrets file generated using 'gen secrets'")
+	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.workerJoinToken, "worker-join-token", "", "use a join token created with 'talosctl token create' as the machine token in the worker machine config")
 
 	genConfigCmd.Flags().StringSliceVarP(&genConfigCmdFlags.outputTypes, "output-types", "t", allOutputTypes, fmt.Sprintf("types of outputs to be generated. valid types are: %q", allOutputTypes))
 	genConfigCmd.Flags().StringVarP(&genConfigCmdFlags.output, "output", "o", "",
 		`destination to output generated files. when multiple output types are specified, it must be a directory. for a single output type, it must either be a file path, or "-" for stdout`)
+	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.outputFormat, "output-format", "text",
+		`format of the command result (text|json). json prints the generated files to stdout, it can't be used with "--output -"`)
 	genConfigCmd.Flags().StringVar(&genConfigCmdFlags.outputDir, "output-dir", "", "destination to output generated files") // kept for backwards compatibility
 	genConfigCmd.Flags().MarkHidden("output-dir")                                                                           //nolint:errcheck
 
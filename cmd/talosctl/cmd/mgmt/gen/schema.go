@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package gen
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/schemas"
+)
+
+var genSchemaCmdFlags struct {
+	format string
+	output string
+}
+
+// genSchemaCmd represents the `gen schema` command.
+var genSchemaCmd = &cobra.Command{
+	Use:   "schema",
+	Short: "Generates the JSON schema or OpenAPI document of the machine configuration",
+	Long: `The schema covers all machine configuration documents supported by this version of talosctl.
+It can be used by the editors to validate and autocomplete the machine configuration, or to validate it in CI pipelines.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		var (
+			data []byte
+			err  error
+		)
+
+		switch genSchemaCmdFlags.format {
+		case "json-schema":
+			data = schemas.JSONSchema()
+		case "openapi":
+			data, err = schemas.OpenAPI()
+			if err != nil {
+				return fmt.Errorf("failed to generate OpenAPI document: %w", err)
+			}
+		default:
+			return fmt.Errorf("unknown schema format: %q", genSchemaCmdFlags.format)
+		}
+
+		if genSchemaCmdFlags.output == stdoutOutput {
+			_, err = os.Stdout.Write(data)
+
+			return err
+		}
+
+		if err = validateFileExists(genSchemaCmdFlags.output); err != nil {
+			return err
+		}
+
+		return os.WriteFile(genSchemaCmdFlags.output, data, 0o644)
+	},
+}
+
+func init() {
+	genSchemaCmd.Flags().StringVar(&genSchemaCmdFlags.format, "format", "json-schema", "schema format (json-schema|openapi)")
+	genSchemaCmd.Flags().StringVarP(&genSchemaCmdFlags.output, "output", "o", stdoutOutput, `path of the output file, or "-" for stdout`)
+
+	Cmd.AddCommand(genSchemaCmd)
+}
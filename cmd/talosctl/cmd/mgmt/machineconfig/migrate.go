@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package machineconfig
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/migrate"
+)
+
+var migrateCmdFlags struct {
+	output string
+}
+
+// migrateCmd represents the `machineconfig migrate` command.
+var migrateCmd = &cobra.Command{
+	Use:   "migrate <machineconfig-file>",
+	Short: "Migrate a machine config from the deprecated fields",
+	Long: `Machine config is migrated the same way Talos migrates it on boot.
+The list of changes is printed to stderr.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		cfg, err := configloader.NewFromFile(args[0])
+		if err != nil {
+			return err
+		}
+
+		migrated, changes, err := migrate.Config(cfg)
+		if err != nil {
+			return err
+		}
+
+		for _, change := range changes {
+			fmt.Fprintf(os.Stderr, "migrated %s\n", change)
+		}
+
+		migratedData, err := migrated.Bytes()
+		if err != nil {
+			return err
+		}
+
+		if migrateCmdFlags.output == "" { // write to stdout
+			fmt.Printf("%s\n", migratedData)
+
+			return nil
+		}
+
+		parentDir := filepath.Dir(migrateCmdFlags.output)
+
+		// Create dir path, ignoring "already exists" messages
+		if err := os.MkdirAll(parentDir, os.ModePerm); err != nil && !os.IsExist(err) {
+			return fmt.Errorf("failed to create output dir: %w", err)
+		}
+
+		return os.WriteFile(migrateCmdFlags.output, migratedData, 0o644)
+	},
+}
+
+func init() {
+	migrateCmd.Flags().StringVarP(&migrateCmdFlags.output, "output", "o", "", "output destination. if not specified, output will be printed to stdout")
+
+	Cmd.AddCommand(migrateCmd)
+}
@@ -0,0 +1,60 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mgmt
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/talos"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+// validateImageCmd runs the upgrade pre-flight checks against the installer image on the nodes.
+var validateImageCmd = &cobra.Command{
+	Use:   "image <image>",
+	Short: "Validate the installer image for upgrade",
+	Long: `Validate the installer image for upgrade on the nodes.
+
+The check is performed by each node: the installer image should be available with the node registry configuration,
+should be built for the node architecture, and should have a valid signature if the image verification is configured.
+The same check is run by the nodes before the upgrade starts, so this command can be used as a pre-flight check in CI pipelines.`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return talos.WithClient(func(ctx context.Context, c *client.Client) error {
+			resp, err := c.ImageValidate(ctx, args[0])
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error validating image: %w", err)
+				}
+
+				common.WarnNodeErrors(err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tIMAGE\tPLATFORM\tSIGNATURE VERIFIED")
+
+			for _, msg := range resp.GetMessages() {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%v\n", msg.GetMetadata().GetHostname(), msg.GetReference(), msg.GetPlatform(), msg.GetSignatureVerified())
+			}
+
+			if flushErr := w.Flush(); flushErr != nil {
+				return flushErr
+			}
+
+			// fail the command if any of the nodes failed the check
+			return err
+		})
+	},
+}
+
+func init() {
+	validateCmd.AddCommand(validateImageCmd)
+}
@@ -11,21 +11,33 @@ import (
 	"path/filepath"
 	"slices"
 	"strings"
+	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
 
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/mgmt"
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/talos"
 	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
 // rootCmd represents the base command when called without any subcommands.
 var rootCmd = &cobra.Command{
-	Use:               "talosctl",
-	Short:             "A CLI for out-of-band management of Kubernetes nodes created by Talos",
-	Long:              ``,
+	Use:   "talosctl",
+	Short: "A CLI for out-of-band management of Kubernetes nodes created by Talos",
+	Long: `A CLI for out-of-band management of Kubernetes nodes created by Talos
+
+Exit codes:
+  0  the command succeeded
+  1  the command failed
+  2  the command succeeded on some nodes, but failed on the others
+  3  the nodes or the endpoints are unreachable
+  4  authentication or authorization failed
+
+With --quiet, the output of the command is suppressed, so the exit code can be used in the shell conditionals.`,
 	SilenceErrors:     true,
 	SilenceUsage:      true,
 	DisableAutoGenTag: true,
@@ -44,12 +56,26 @@ func Execute() error {
 			filepath.Join(constants.ServiceAccountMountPath, constants.TalosconfigFilename),
 		),
 	)
-	rootCmd.PersistentFlags().StringVar(&talos.GlobalArgs.CmdContext, "context", "", "Context to be used in command")
+	rootCmd.PersistentFlags().StringVar(&talos.GlobalArgs.CmdContext, "context", "", "Context to be used in command (\"all\" runs the version, health and get commands against every context)")
 	rootCmd.PersistentFlags().StringSliceVarP(&talos.GlobalArgs.Nodes, "nodes", "n", []string{}, "target the specified nodes")
-	rootCmd.PersistentFlags().StringSliceVarP(&talos.GlobalArgs.Endpoints, "endpoints", "e", []string{}, "override default endpoints in Talos configuration")
+	rootCmd.PersistentFlags().StringSliceVarP(&talos.GlobalArgs.Endpoints, "endpoints", "e", []string{}, "override default endpoints in Talos configuration (mock://<dir> serves canned responses from the fixtures directory)")
 	cli.Should(rootCmd.RegisterFlagCompletionFunc("context", talos.CompleteConfigContext))
 	cli.Should(rootCmd.RegisterFlagCompletionFunc("nodes", talos.CompleteNodes))
 	rootCmd.PersistentFlags().StringVar(&talos.GlobalArgs.Cluster, "cluster", "", "Cluster to connect to if a proxy endpoint is used.")
+	rootCmd.PersistentFlags().IntVar(&talos.GlobalArgs.Concurrency, "concurrency", 32,
+		"maximum number of the nodes to query in parallel, the streaming requests query all nodes at once")
+	rootCmd.PersistentFlags().DurationVar(&talos.GlobalArgs.NodeTimeout, "node-timeout", 0,
+		"timeout of the query to each node, except for the streaming requests (zero means no timeout)")
+	rootCmd.PersistentFlags().DurationVar(&talos.GlobalArgs.RequestTimeout, "request-timeout", 30*time.Second,
+		"timeout of each read-only API request, for the streaming requests it applies to receiving the first response, except for the follow and watch requests (zero means no timeout)")
+	rootCmd.PersistentFlags().IntVar(&talos.GlobalArgs.Retries, "retries", 3,
+		"number of retries with exponential backoff of the read-only API requests which failed as the node is unavailable or timed out")
+	rootCmd.PersistentFlags().StringSliceVar(&talos.GlobalArgs.Compression, "compression", client.DefaultCompressors,
+		"compressors to negotiate with the server in the order of preference, falling back to no compression (\"none\" disables the compression)")
+	rootCmd.PersistentFlags().StringVar(&talos.GlobalArgs.MaxMessageSize, "max-message-size", humanize.IBytes(constants.GRPCMaxMessageSize),
+		"maximum size of the API response message")
+	rootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false,
+		"suppress the output of the command, the result is reported with the exit code (errors are still printed)")
 
 	cmd, err := rootCmd.ExecuteContextC(context.Background())
 	if err != nil && !common.SuppressErrors {
@@ -64,10 +90,31 @@ func Execute() error {
 		}
 	}
 
+	if err == nil {
+		// some nodes failed, but the command completed with the responses from the other nodes
+		err = common.PartialFailure()
+	}
+
 	return err
 }
 
+var quiet bool
+
+// suppressOutput redirects the standard output of the command to /dev/null if --quiet is set.
+func suppressOutput() {
+	if !quiet {
+		return
+	}
+
+	devNull, err := os.OpenFile(os.DevNull, os.O_WRONLY, 0)
+	cli.Should(err)
+
+	os.Stdout = devNull
+}
+
 func init() {
+	cobra.OnInitialize(suppressOutput)
+
 	for _, cmd := range slices.Concat(talos.Commands, mgmt.Commands) {
 		rootCmd.AddCommand(cmd)
 	}
@@ -147,7 +147,7 @@ var applyConfigCmd = &cobra.Command{
 				TryModeTimeout: durationpb.New(applyConfigCmdFlags.configTryTimeout),
 			})
 			if err != nil {
-				return fmt.Errorf("error applying new configuration: %s", err)
+				return fmt.Errorf("error applying new configuration: %w", err)
 			}
 
 			helpers.PrintApplyResults(resp)
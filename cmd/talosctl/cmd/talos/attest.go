@@ -0,0 +1,115 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// attestCmd represents the attest command.
+var attestCmd = &cobra.Command{
+	Use:   "attest",
+	Short: "Inspect TPM measured boot attestation state",
+	Long:  ``,
+}
+
+// attestStatusCmd represents the attest status command.
+var attestStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show Secure Boot state and current TPM PCR measurements",
+	Long: `Show Secure Boot state and current TPM PCR measurements.
+
+PCR values are the ones included into the TPM attestation presented to trustd,
+they can be used to build the TPMAttestationConfig policy.
+The attestation key fingerprint should be enrolled in the TPMAttestationConfig for trustd to trust the node quotes.
+
+The measurement log lists the events extended to each PCR: the firmware event log and the Talos boot phases.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			nodes := client.NodesFromContext(ctx)
+
+			type attestation struct {
+				securityState *runtime.SecurityState
+				pcrs          safe.List[*runtime.PCRStatus]
+			}
+
+			results := client.FanOut(ctx, nodes, GlobalArgs.FanOutOptions(), func(nodeCtx context.Context, _ string) (attestation, error) {
+				securityState, err := safe.StateGetByID[*runtime.SecurityState](nodeCtx, c.COSI, runtime.SecurityStateID)
+				if err != nil {
+					return attestation{}, fmt.Errorf("error getting security state: %w", err)
+				}
+
+				pcrs, err := safe.StateListAll[*runtime.PCRStatus](nodeCtx, c.COSI)
+				if err != nil {
+					return attestation{}, fmt.Errorf("error listing PCR status: %w", err)
+				}
+
+				return attestation{securityState, pcrs}, nil
+			})
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tSECUREBOOT\tATTESTATION KEY\tPCR\tVALUE")
+
+			for _, result := range results {
+				if result.Err != nil {
+					return fmt.Errorf("error on node %q: %w", result.Node, result.Err)
+				}
+
+				node, securityState, pcrs := result.Node, result.Value.securityState, result.Value.pcrs
+
+				attestationKey := securityState.TypedSpec().AttestationKeyFingerprint
+				if attestationKey == "" {
+					attestationKey = "-"
+				}
+
+				if pcrs.Len() == 0 {
+					fmt.Fprintf(w, "%s\t%v\t%s\t%s\t%s\n", node, securityState.TypedSpec().SecureBoot, attestationKey, "-", "no TPM available")
+
+					continue
+				}
+
+				for it := pcrs.Iterator(); it.Next(); {
+					fmt.Fprintf(w, "%s\t%v\t%s\t%s\t%s\n",
+						node, securityState.TypedSpec().SecureBoot, attestationKey, it.Value().Metadata().ID(), it.Value().TypedSpec().Value,
+					)
+				}
+			}
+
+			if err := w.Flush(); err != nil {
+				return err
+			}
+
+			fmt.Fprint(os.Stdout, "\nMEASUREMENT LOG:\n\n")
+
+			w = tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tPCR\tEVENT\tDIGEST\tDESCRIPTION")
+
+			for _, result := range results {
+				for it := result.Value.pcrs.Iterator(); it.Next(); {
+					for _, event := range it.Value().TypedSpec().Events {
+						fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", result.Node, it.Value().Metadata().ID(), event.Type, event.Digest, event.Description)
+					}
+				}
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+func init() {
+	attestCmd.AddCommand(attestStatusCmd)
+	addCommand(attestCmd)
+}
@@ -0,0 +1,222 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var benchmarkCmd = &cobra.Command{
+	Use:   "benchmark",
+	Short: "Run micro-benchmarks of the node hardware",
+	Long: `Run micro-benchmarks of the node hardware.
+
+Only one benchmark runs on a node at a time, as they would skew each other's results.`,
+	Args: cobra.NoArgs,
+}
+
+var benchmarkDiskCmdFlags struct {
+	size                string
+	sequentialBlockSize string
+	randomBlockSize     string
+	duration            time.Duration
+}
+
+var benchmarkDiskCmd = &cobra.Command{
+	Use:   "disk",
+	Short: "Measure the disk throughput and latency",
+	Long: `Measure the disk throughput and latency.
+
+The node creates a scratch file in the EPHEMERAL partition, and runs the sequential and random write and read tests on it,
+bypassing the page cache where the filesystem allows it.
+The scratch file is removed once the benchmark finishes.`,
+	Example: `talosctl -n 172.20.0.2 benchmark disk --size 4GiB --duration 30s`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		size, err := humanize.ParseBytes(benchmarkDiskCmdFlags.size)
+		if err != nil {
+			return fmt.Errorf("error parsing size: %w", err)
+		}
+
+		sequentialBlockSize, err := humanize.ParseBytes(benchmarkDiskCmdFlags.sequentialBlockSize)
+		if err != nil {
+			return fmt.Errorf("error parsing sequential block size: %w", err)
+		}
+
+		randomBlockSize, err := humanize.ParseBytes(benchmarkDiskCmdFlags.randomBlockSize)
+		if err != nil {
+			return fmt.Errorf("error parsing random block size: %w", err)
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			resp, err := c.DiskBenchmark(ctx, &machine.DiskBenchmarkRequest{
+				Size:                size,
+				SequentialBlockSize: uint32(sequentialBlockSize),
+				RandomBlockSize:     uint32(randomBlockSize),
+				Duration:            durationpb.New(benchmarkDiskCmdFlags.duration),
+			})
+
+			return printBenchmarkResults(resp, err)
+		})
+	},
+}
+
+var benchmarkMemoryCmdFlags struct {
+	size     string
+	threads  uint32
+	duration time.Duration
+}
+
+var benchmarkMemoryCmd = &cobra.Command{
+	Use:   "memory",
+	Short: "Measure the memory bandwidth",
+	Long: `Measure the memory bandwidth.
+
+The node runs the read, write and copy tests over a buffer per thread, the copy throughput counts both bytes read and written.
+The buffer should be much larger than the CPU caches to measure the memory and not the cache bandwidth.`,
+	Example: `talosctl -n 172.20.0.2 benchmark memory --threads 4`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		size, err := humanize.ParseBytes(benchmarkMemoryCmdFlags.size)
+		if err != nil {
+			return fmt.Errorf("error parsing size: %w", err)
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			resp, err := c.MemoryBenchmark(ctx, &machine.MemoryBenchmarkRequest{
+				Size:     size,
+				Threads:  benchmarkMemoryCmdFlags.threads,
+				Duration: durationpb.New(benchmarkMemoryCmdFlags.duration),
+			})
+
+			return printBenchmarkResults(resp, err)
+		})
+	},
+}
+
+var benchmarkNetworkCmdFlags struct {
+	server   string
+	port     uint32
+	streams  uint32
+	duration time.Duration
+}
+
+var benchmarkNetworkCmd = &cobra.Command{
+	Use:   "network",
+	Short: "Measure the TCP throughput between the nodes",
+	Long: `Measure the TCP throughput between the nodes.
+
+The server node (--server) listens on the TCP port (--port), and the nodes (--nodes) send the data to it
+over one or more parallel connections for the duration of the test.
+The address of the server node should be reachable from the other nodes, and the port should be allowed
+by the ingress firewall rules of the server node, if any.`,
+	Example: `talosctl -n 172.20.0.2 benchmark network --server 172.20.0.3 --streams 4`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if benchmarkNetworkCmdFlags.server == "" {
+			return fmt.Errorf("--server is required")
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			type serverResult struct {
+				resp *machine.BenchmarkResponse
+				err  error
+			}
+
+			serverCh := make(chan serverResult, 1)
+
+			go func() {
+				resp, err := c.NetworkBenchmark(client.WithNode(ctx, benchmarkNetworkCmdFlags.server), &machine.NetworkBenchmarkRequest{
+					Port:     benchmarkNetworkCmdFlags.port,
+					Duration: durationpb.New(benchmarkNetworkCmdFlags.duration),
+				})
+
+				serverCh <- serverResult{resp, err}
+			}()
+
+			// the client side retries connecting until the server side is listening
+			resp, err := c.NetworkBenchmark(ctx, &machine.NetworkBenchmarkRequest{
+				Server:   benchmarkNetworkCmdFlags.server,
+				Port:     benchmarkNetworkCmdFlags.port,
+				Streams:  benchmarkNetworkCmdFlags.streams,
+				Duration: durationpb.New(benchmarkNetworkCmdFlags.duration),
+			})
+
+			if err = printBenchmarkResults(resp, err); err != nil {
+				return err
+			}
+
+			server := <-serverCh
+
+			return printBenchmarkResults(server.resp, server.err)
+		})
+	},
+}
+
+func printBenchmarkResults(resp *machine.BenchmarkResponse, err error) error {
+	if err != nil {
+		if resp == nil {
+			return fmt.Errorf("error running benchmark: %w", err)
+		}
+
+		common.WarnNodeErrors(err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NODE\tTEST\tTHROUGHPUT\tOPS/S\tAVG LATENCY\tP99 LATENCY")
+
+	for _, msg := range resp.GetMessages() {
+		for _, result := range msg.GetResults() {
+			avgLatency, p99Latency := "-", "-"
+
+			if result.GetLatencyAvg() != nil {
+				avgLatency = result.GetLatencyAvg().AsDuration().String()
+				p99Latency = result.GetLatencyP99().AsDuration().String()
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%s/s\t%.0f\t%s\t%s\n",
+				metadataNode(msg.GetMetadata()),
+				result.GetName(),
+				humanize.IBytes(uint64(result.GetBytesPerSecond())),
+				result.GetOperationsPerSecond(),
+				avgLatency,
+				p99Latency,
+			)
+		}
+	}
+
+	return w.Flush()
+}
+
+func init() {
+	benchmarkDiskCmd.Flags().StringVar(&benchmarkDiskCmdFlags.size, "size", "1GiB", "size of the scratch file")
+	benchmarkDiskCmd.Flags().StringVar(&benchmarkDiskCmdFlags.sequentialBlockSize, "sequential-block-size", "1MiB", "block size of the sequential tests")
+	benchmarkDiskCmd.Flags().StringVar(&benchmarkDiskCmdFlags.randomBlockSize, "random-block-size", "4KiB", "block size of the random tests")
+	benchmarkDiskCmd.Flags().DurationVar(&benchmarkDiskCmdFlags.duration, "duration", 10*time.Second, "duration of each test")
+
+	benchmarkMemoryCmd.Flags().StringVar(&benchmarkMemoryCmdFlags.size, "size", "64MiB", "size of the buffer of each thread")
+	benchmarkMemoryCmd.Flags().Uint32Var(&benchmarkMemoryCmdFlags.threads, "threads", 1, "number of the concurrent threads")
+	benchmarkMemoryCmd.Flags().DurationVar(&benchmarkMemoryCmdFlags.duration, "duration", 3*time.Second, "duration of each test")
+
+	benchmarkNetworkCmd.Flags().StringVar(&benchmarkNetworkCmdFlags.server, "server", "", "node to run the server side of the test on")
+	benchmarkNetworkCmd.Flags().Uint32Var(&benchmarkNetworkCmdFlags.port, "port", 5201, "TCP port of the server side of the test")
+	benchmarkNetworkCmd.Flags().Uint32Var(&benchmarkNetworkCmdFlags.streams, "streams", 1, "number of the parallel TCP connections")
+	benchmarkNetworkCmd.Flags().DurationVar(&benchmarkNetworkCmdFlags.duration, "duration", 10*time.Second, "duration of the test")
+
+	benchmarkCmd.AddCommand(benchmarkDiskCmd, benchmarkMemoryCmd, benchmarkNetworkCmd)
+	addCommand(benchmarkCmd)
+}
@@ -0,0 +1,151 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+// bmcCmd represents the bmc command.
+var bmcCmd = &cobra.Command{
+	Use:   "bmc",
+	Short: "Read the hardware health information from the node's BMC",
+	Long: `Read the hardware health information from the node's BMC (Baseboard Management Controller) via IPMI.
+
+IPMI device requires 'ipmi_si' and 'ipmi_devintf' kernel modules to be loaded on the node.`,
+}
+
+// bmcSensorsCmd represents the bmc sensors command.
+var bmcSensorsCmd = &cobra.Command{
+	Use:   "sensors",
+	Short: "Show the chassis power state and the sensor readings",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			resp, err := c.BMCSensors(ctx)
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error reading BMC sensors: %w", err)
+				}
+
+				common.WarnNodeErrors(err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tSENSOR\tTYPE\tVALUE\tSTATUS")
+
+			for _, msg := range resp.GetMessages() {
+				node := metadataNode(msg.GetMetadata())
+
+				power, powerStatus := "off", "ok"
+
+				if msg.GetPowerOn() {
+					power = "on"
+				}
+
+				if msg.GetPowerFault() {
+					powerStatus = "fault"
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", node, "Chassis Power", "Chassis", power, powerStatus)
+
+				for _, sensor := range msg.GetSensors() {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", node, sensor.GetName(), sensor.GetType(), formatBMCSensorValue(sensor), sensor.GetStatus())
+				}
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+func formatBMCSensorValue(sensor *machine.BMCSensor) string {
+	switch {
+	case sensor.GetHasValue():
+		return strings.TrimSpace(fmt.Sprintf("%.2f %s", sensor.GetValue(), sensor.GetUnit()))
+	case sensor.GetStatus() == "n/a":
+		return "-"
+	default:
+		return fmt.Sprintf("0x%04x", sensor.GetState())
+	}
+}
+
+var bmcSELCmdFlags struct {
+	tail int
+}
+
+// bmcSELCmd represents the bmc sel command.
+var bmcSELCmd = &cobra.Command{
+	Use:   "sel",
+	Short: "Show the BMC System Event Log",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			resp, err := c.BMCEventLog(ctx, bmcSELCmdFlags.tail)
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error reading BMC event log: %w", err)
+				}
+
+				common.WarnNodeErrors(err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tID\tTIME\tSENSOR\tEVENT")
+
+			for _, msg := range resp.GetMessages() {
+				node := metadataNode(msg.GetMetadata())
+
+				for _, entry := range msg.GetEntries() {
+					timestamp := "-"
+
+					if entry.GetTimestamp() != nil {
+						timestamp = entry.GetTimestamp().AsTime().Local().Format(time.RFC3339)
+					}
+
+					sensor := "-"
+
+					if entry.GetSensorType() != "" {
+						sensor = fmt.Sprintf("%s #0x%02x", entry.GetSensorType(), entry.GetSensorNumber())
+					}
+
+					event := entry.GetEvent()
+
+					if entry.GetDeasserted() {
+						event += " (deasserted)"
+					}
+
+					fmt.Fprintf(w, "%s\t0x%04x\t%s\t%s\t%s\n", node, entry.GetId(), timestamp, sensor, event)
+				}
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+func init() {
+	bmcSELCmd.Flags().IntVar(&bmcSELCmdFlags.tail, "tail", 0, "show only the specified number of latest entries (default is to show all entries)")
+
+	bmcCmd.AddCommand(
+		bmcSensorsCmd,
+		bmcSELCmd,
+	)
+
+	addCommand(bmcCmd)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/spf13/cobra"
 	snapshot "go.etcd.io/etcd/etcdutl/v3/snapshot"
 
+	"github.com/siderolabs/talos/pkg/cli"
 	"github.com/siderolabs/talos/pkg/logging"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
@@ -21,6 +22,7 @@ import (
 var bootstrapCmdFlags struct {
 	recoverFrom          string
 	recoverSkipHashCheck bool
+	skipPrecheck         bool
 }
 
 // bootstrapCmd represents the bootstrap command.
@@ -34,7 +36,10 @@ Other control plane nodes will join etcd cluster once Kubernetes is boostrapped
 
 This command should not be used when "init" type node are used.
 
-Talos etcd cluster can be recovered from a known snapshot with '--recover-from=' flag.`,
+Talos etcd cluster can be recovered from a known snapshot with '--recover-from=' flag.
+
+Before bootstrapping, the etcd disk latency is checked (see 'talosctl etcd precheck'), and a warning is printed
+if the disk is too slow for etcd.`,
 	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(func(ctx context.Context, c *client.Client) error {
@@ -42,6 +47,10 @@ Talos etcd cluster can be recovered from a known snapshot with '--recover-from='
 				return errors.New("command \"bootstrap\" is not supported with multiple nodes")
 			}
 
+			if !bootstrapCmdFlags.skipPrecheck {
+				etcdPrecheckBeforeBootstrap(ctx, c)
+			}
+
 			if bootstrapCmdFlags.recoverFrom != "" {
 				manager := snapshot.NewV3(logging.Wrap(os.Stderr))
 
@@ -78,8 +87,28 @@ Talos etcd cluster can be recovered from a known snapshot with '--recover-from='
 	},
 }
 
+func etcdPrecheckBeforeBootstrap(ctx context.Context, c *client.Client) {
+	fmt.Fprintln(os.Stderr, "checking etcd disk latency")
+
+	resp, err := c.EtcdPrecheck(ctx, &machineapi.EtcdPrecheckRequest{})
+	if err != nil {
+		cli.Warning("skipping etcd precheck: %s", err)
+
+		return
+	}
+
+	if len(etcdPrecheckFailedNodes(resp)) == 0 {
+		return
+	}
+
+	printEtcdPrecheck(resp) //nolint:errcheck
+
+	cli.Warning("the disk is too slow for etcd, which might lead to frequent leader elections and an unstable control plane")
+}
+
 func init() {
 	bootstrapCmd.Flags().StringVar(&bootstrapCmdFlags.recoverFrom, "recover-from", "", "recover etcd cluster from the snapshot")
+	bootstrapCmd.Flags().BoolVar(&bootstrapCmdFlags.skipPrecheck, "skip-precheck", false, "skip checking the etcd disk latency before bootstrapping")
 	bootstrapCmd.Flags().BoolVar(&bootstrapCmdFlags.recoverSkipHashCheck, "recover-skip-hash-check", false, "skip integrity check when recovering etcd (use when recovering from data directory copy)")
 	addCommand(bootstrapCmd)
 }
@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var certificatesCmdFlags struct {
+	json           bool
+	expiringWithin time.Duration
+}
+
+// certificatesCmd represents the certificates command.
+var certificatesCmd = &cobra.Command{
+	Use:     "certificates",
+	Aliases: []string{"certs"},
+	Short:   "List the certificates held by the node",
+	Long: `List the certificates held by the node (Talos API, trustd, etcd, Kubernetes control plane components, kubelet)
+with their issuer, SANs and expiration.
+
+Private keys are never returned.
+Use --expiring-within to show only the certificates which expire soon.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			resp, err := c.Certificates(ctx)
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error listing certificates: %w", err)
+				}
+
+				common.WarnNodeErrors(err)
+			}
+
+			now := time.Now()
+
+			for _, msg := range resp.GetMessages() {
+				if certificatesCmdFlags.expiringWithin > 0 {
+					msg.Certificates = slices.DeleteFunc(msg.Certificates, func(cert *machine.Certificate) bool {
+						return cert.GetNotAfter().AsTime().Sub(now) > certificatesCmdFlags.expiringWithin
+					})
+				}
+			}
+
+			if certificatesCmdFlags.json {
+				for _, msg := range resp.GetMessages() {
+					b, err := protojson.Marshal(msg)
+					if err != nil {
+						return err
+					}
+
+					fmt.Printf("%s\n", b)
+				}
+
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tNAME\tSUBJECT\tISSUER\tNOT AFTER\tEXPIRES IN\tSANS")
+
+			for _, msg := range resp.GetMessages() {
+				node := metadataNode(msg.GetMetadata())
+
+				for _, cert := range msg.GetCertificates() {
+					notAfter := cert.GetNotAfter().AsTime()
+
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+						node,
+						cert.GetName(),
+						orDash(cert.GetSubject()),
+						orDash(cert.GetIssuer()),
+						notAfter.Format(time.RFC3339),
+						formatExpiresIn(notAfter.Sub(now)),
+						orDash(strings.Join(append(slices.Clone(cert.GetDnsNames()), cert.GetIpAddresses()...), ",")),
+					)
+				}
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+// formatExpiresIn formats the time left until the certificate expiration in days.
+func formatExpiresIn(d time.Duration) string {
+	if d < 0 {
+		return "expired"
+	}
+
+	return fmt.Sprintf("%dd", int(d.Hours()/24))
+}
+
+func init() {
+	certificatesCmd.Flags().BoolVar(&certificatesCmdFlags.json, "json", false, "output the certificates as JSON")
+	certificatesCmd.Flags().DurationVar(&certificatesCmdFlags.expiringWithin, "expiring-within", 0, "show only the certificates expiring within the specified duration")
+	addCommand(certificatesCmd)
+}
@@ -173,14 +173,18 @@ func buildCgroupResolveMap(ctx context.Context, c *client.Client) map[string]str
 func buildProcessResolveMap(ctx context.Context, c *client.Client) map[string]string {
 	processResolveMap := map[string]string{}
 
-	processesResp, err := c.Processes(ctx)
+	processes, err := listProcesses(ctx, c)
 	if err != nil {
 		cli.Warning("error getting processes: %s", err)
 
 		return processResolveMap
 	}
 
-	for _, proc := range processesResp.Messages[0].Processes {
+	if len(processes) == 0 {
+		return processResolveMap
+	}
+
+	for _, proc := range processes[0].Processes {
 		name := proc.Executable
 
 		if name == "" {
@@ -0,0 +1,143 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var chaosCmd = &cobra.Command{
+	Use:   "chaos",
+	Short: "Induce controlled failures on the nodes for game days",
+	Long: `Induce controlled failures on the nodes for game days.
+
+The chaos APIs should be enabled in the machine configuration (.machine.features.chaosAPI),
+and the talosconfig should have the os:chaos role, which is not included into any other role:
+
+    talosctl config new --roles os:chaos chaos-talosconfig
+
+The network and disk failures are reverted automatically after the duration (at most one hour), or on reboot.`,
+	Args: cobra.NoArgs,
+}
+
+var chaosKillServiceCmd = &cobra.Command{
+	Use:   "kill-service <id>",
+	Short: "Kill all processes of a service",
+	Long: `Kill all processes of a service with SIGKILL.
+
+The service is restarted by Talos as after a crash.`,
+	Example: `talosctl -n 172.20.0.5 chaos kill-service kubelet`,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			resp, err := c.ChaosServiceKill(ctx, args[0])
+
+			return printChaosResults(resp, err)
+		})
+	},
+}
+
+var chaosDropNetworkCmdFlags struct {
+	cidrs    []string
+	duration time.Duration
+}
+
+var chaosDropNetworkCmd = &cobra.Command{
+	Use:   "drop-network",
+	Short: "Drop the network traffic to and from the subnets",
+	Long: `Drop the network traffic to and from the subnets for the duration.
+
+The traffic is dropped by the nftables rules, which are removed once the duration elapses.
+Dropping the traffic to the talosctl host (or to the node proxying the request) cuts the API access
+to the node until the traffic is restored.`,
+	Example: `talosctl -n 172.20.0.5 chaos drop-network --cidr 172.20.0.2/32 --duration 1m`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(chaosDropNetworkCmdFlags.cidrs) == 0 {
+			return fmt.Errorf("--cidr is required")
+		}
+
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			resp, err := c.ChaosNetworkDrop(ctx, &machine.ChaosNetworkDropRequest{
+				Cidrs:    chaosDropNetworkCmdFlags.cidrs,
+				Duration: durationpb.New(chaosDropNetworkCmdFlags.duration),
+			})
+
+			return printChaosResults(resp, err)
+		})
+	},
+}
+
+var chaosFillEphemeralCmdFlags struct {
+	percent  uint32
+	duration time.Duration
+}
+
+var chaosFillEphemeralCmd = &cobra.Command{
+	Use:   "fill-ephemeral",
+	Short: "Fill the EPHEMERAL partition",
+	Long: `Fill the EPHEMERAL partition up to the given percentage of its size for the duration.
+
+The space is allocated by an unlinked file, so it is released once the duration elapses or the node reboots.`,
+	Example: `talosctl -n 172.20.0.5 chaos fill-ephemeral --percent 95 --duration 10m`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			resp, err := c.ChaosEphemeralFill(ctx, &machine.ChaosEphemeralFillRequest{
+				Percent:  chaosFillEphemeralCmdFlags.percent,
+				Duration: durationpb.New(chaosFillEphemeralCmdFlags.duration),
+			})
+
+			return printChaosResults(resp, err)
+		})
+	},
+}
+
+func printChaosResults(resp *machine.ChaosResponse, err error) error {
+	if err != nil {
+		if resp == nil {
+			return fmt.Errorf("error inducing failure: %w", err)
+		}
+
+		common.WarnNodeErrors(err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NODE\tRESULT\tUNTIL")
+
+	for _, msg := range resp.GetMessages() {
+		until := "-"
+
+		if msg.GetUntil() != nil {
+			until = msg.GetUntil().AsTime().Local().Format(time.RFC3339)
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\n", metadataNode(msg.GetMetadata()), msg.GetResp(), until)
+	}
+
+	return w.Flush()
+}
+
+func init() {
+	chaosDropNetworkCmd.Flags().StringSliceVar(&chaosDropNetworkCmdFlags.cidrs, "cidr", nil, "subnets to drop the traffic to and from")
+	chaosDropNetworkCmd.Flags().DurationVar(&chaosDropNetworkCmdFlags.duration, "duration", time.Minute, "duration of the network drop")
+
+	chaosFillEphemeralCmd.Flags().Uint32Var(&chaosFillEphemeralCmdFlags.percent, "percent", 95, "target usage of the EPHEMERAL partition in percent")
+	chaosFillEphemeralCmd.Flags().DurationVar(&chaosFillEphemeralCmdFlags.duration, "duration", 5*time.Minute, "duration of the fill")
+
+	chaosCmd.AddCommand(chaosKillServiceCmd, chaosDropNetworkCmd, chaosFillEphemeralCmd)
+	addCommand(chaosCmd)
+}
@@ -71,26 +71,7 @@ var configEndpointCmd = &cobra.Command{
 	Long:    ``,
 	Args:    cobra.MinimumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		c, err := openConfigAndContext("")
-		if err != nil {
-			return err
-		}
-
-		for i := range args {
-			args[i] = strings.TrimSpace(args[i])
-		}
-
-		ctxData, err := getContextData(c)
-		if err != nil {
-			return err
-		}
-
-		ctxData.Endpoints = args
-		if err := c.Save(GlobalArgs.Talosconfig); err != nil {
-			return fmt.Errorf("error writing config: %w", err)
-		}
-
-		return nil
+		return contextEndpoints.update(args, contextEndpoints.set)
 	},
 }
 
@@ -101,22 +82,68 @@ var configNodeCmd = &cobra.Command{
 	Short:   "Set the node(s) for the current context",
 	Long:    ``,
 	Args:    cobra.ArbitraryArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return contextNodes.update(args, contextNodes.set)
+	},
+}
+
+// configProxyCmdFlags represents the `config proxy` command flags.
+var configProxyCmdFlags struct {
+	ssh            string
+	identityFile   string
+	knownHostsFile string
+	socket         string
+	clear          bool
+}
+
+// configProxyCmd represents the `config proxy` command.
+var configProxyCmd = &cobra.Command{
+	Use:   "proxy",
+	Short: "Set the proxy used to reach the endpoints of the current context",
+	Long: `Set the proxy used to reach the endpoints of the current context.
+
+With --ssh, the connections to the endpoints are tunneled through the SSH jump host (bastion).
+The SSH agent and the default keys are used unless --ssh-identity is set, and the host key
+of the jump host is verified with the known hosts file.
+
+With --socket, the connections to the endpoints are made to the local Unix socket, which should
+forward them to the endpoints (e.g. 'ssh -L /tmp/talos.sock:<endpoint>:50000 bastion').`,
+	Example: `talosctl config proxy --ssh user@bastion.example.com
+talosctl config proxy --socket /tmp/talos.sock
+talosctl config proxy --clear`,
+	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		c, err := openConfigAndContext("")
 		if err != nil {
 			return err
 		}
 
-		for i := range args {
-			args[i] = strings.TrimSpace(args[i])
-		}
-
 		ctxData, err := getContextData(c)
 		if err != nil {
 			return err
 		}
 
-		ctxData.Nodes = args
+		switch {
+		case configProxyCmdFlags.clear:
+			ctxData.Proxy = nil
+		case configProxyCmdFlags.ssh != "":
+			proxy := &clientconfig.SSHProxy{
+				Host:           configProxyCmdFlags.ssh,
+				IdentityFile:   configProxyCmdFlags.identityFile,
+				KnownHostsFile: configProxyCmdFlags.knownHostsFile,
+			}
+
+			if user, host, ok := strings.Cut(configProxyCmdFlags.ssh, "@"); ok {
+				proxy.User, proxy.Host = user, host
+			}
+
+			ctxData.Proxy = &clientconfig.Proxy{SSH: proxy}
+		case configProxyCmdFlags.socket != "":
+			ctxData.Proxy = &clientconfig.Proxy{Socket: configProxyCmdFlags.socket}
+		default:
+			return errors.New("one of --ssh, --socket or --clear should be set")
+		}
+
 		if err := c.Save(GlobalArgs.Talosconfig); err != nil {
 			return fmt.Errorf("error writing config: %w", err)
 		}
@@ -143,7 +170,7 @@ var configContextCmd = &cobra.Command{
 		c.Context = context
 
 		if err := c.Save(GlobalArgs.Talosconfig); err != nil {
-			return fmt.Errorf("error writing config: %s", err)
+			return fmt.Errorf("error writing config: %w", err)
 		}
 
 		return nil
@@ -382,7 +409,7 @@ var configMergeCmd = &cobra.Command{
 		}
 
 		if err := c.Save(GlobalArgs.Talosconfig); err != nil {
-			return fmt.Errorf("error writing config: %s", err)
+			return fmt.Errorf("error writing config: %w", err)
 		}
 
 		return nil
@@ -391,15 +418,22 @@ var configMergeCmd = &cobra.Command{
 
 // configNewCmdFlags represents the `config new` command flags.
 var configNewCmdFlags struct {
-	roles  []string
-	crtTTL time.Duration
+	roles        []string
+	crtTTL       time.Duration
+	allowedNodes []string
 }
 
 // configNewCmd represents the `config new` command.
 var configNewCmd = &cobra.Command{
 	Use:   "new [<path>]",
 	Short: "Generate a new client configuration file",
-	Args:  cobra.RangeArgs(0, 1),
+	Long: `Generate a new client configuration file.
+
+With --allowed-nodes, the client certificate is limited to the listed nodes: requests to other nodes are rejected.
+For example, to generate a temporary read-only talosconfig for a support engineer:
+
+    talosctl config new --roles os:support --crt-ttl 24h --allowed-nodes 10.5.0.2,10.5.0.3 support-talosconfig`,
+	Args: cobra.RangeArgs(0, 1),
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if len(args) == 0 {
 			args = []string{"talosconfig"}
@@ -424,6 +458,7 @@ var configNewCmd = &cobra.Command{
 			resp, err := c.GenerateClientConfiguration(ctx, &machineapi.GenerateClientConfigurationRequest{
 				Roles:  roles.Strings(),
 				CrtTtl: durationpb.New(configNewCmdFlags.crtTTL),
+				Nodes:  configNewCmdFlags.allowedNodes,
 			})
 			if err != nil {
 				return err
@@ -454,8 +489,12 @@ var configInfoCmdTemplate = template.Must(template.New("configInfoCmdTemplate").
 Current context:     {{ .Context }}
 Nodes:               {{ if .Nodes }}{{ join .Nodes ", " }}{{ else }}not defined{{ end }}
 Endpoints:           {{ if .Endpoints }}{{ join .Endpoints ", " }}{{ else }}not defined{{ end }}
+{{- if .Proxy }}
+Proxy:               {{ .Proxy }}{{ end }}
 {{- if .Roles }}
 Roles:               {{ join .Roles ", " }}{{ end }}
+{{- if .AllowedNodes }}
+Limited to nodes:    {{ join .AllowedNodes ", " }}{{ end }}
 {{- if .CertTTL }}
 Certificate expires: {{ .CertTTL }} ({{ .CertNotAfter }}){{ end }}
 `)))
@@ -464,9 +503,13 @@ type talosconfigInfo struct {
 	Context      string   `json:"context" yaml:"context"`
 	Nodes        []string `json:"nodes" yaml:"nodes"`
 	Endpoints    []string `json:"endpoints" yaml:"endpoints"`
+	Proxy        string   `json:"proxy,omitempty" yaml:"proxy,omitempty"`
 	Roles        []string `json:"roles" yaml:"roles"`
+	AllowedNodes []string `json:"allowedNodes,omitempty" yaml:"allowedNodes,omitempty"`
 	CertTTL      string   `json:"certTTL" yaml:"certTTL"`
 	CertNotAfter string   `json:"certNotAfter" yaml:"certNotAfter"`
+
+	Fingerprints []endpointFingerprint `json:"fingerprints,omitempty" yaml:"fingerprints,omitempty"`
 }
 
 // configInfo returns talosct config info.
@@ -479,6 +522,7 @@ func configInfo(config *clientconfig.Config, now time.Time) (talosconfigInfo, er
 	var (
 		certTTL, certNotAfter string
 		roles                 role.Set
+		allowedNodes          []string
 	)
 
 	if cfgContext.Crt != "" {
@@ -502,6 +546,7 @@ func configInfo(config *clientconfig.Config, now time.Time) (talosconfigInfo, er
 		}
 
 		roles, _ = role.Parse(crt.Subject.Organization)
+		allowedNodes = role.NodeScope(crt.Subject.OrganizationalUnit)
 
 		certTTL = humanize.RelTime(crt.NotAfter, now, "ago", "from now")
 		certNotAfter = crt.NotAfter.UTC().Format("2006-01-02")
@@ -511,12 +556,30 @@ func configInfo(config *clientconfig.Config, now time.Time) (talosconfigInfo, er
 		Context:      config.Context,
 		Nodes:        cfgContext.Nodes,
 		Endpoints:    cfgContext.Endpoints,
+		Proxy:        proxyInfo(cfgContext.Proxy),
 		Roles:        roles.Strings(),
+		AllowedNodes: allowedNodes,
 		CertTTL:      certTTL,
 		CertNotAfter: certNotAfter,
 	}, nil
 }
 
+// proxyInfo formats the proxy configuration for `config info`.
+func proxyInfo(proxy *clientconfig.Proxy) string {
+	switch {
+	case proxy == nil:
+		return ""
+	case proxy.SSH != nil:
+		if proxy.SSH.User != "" {
+			return "ssh://" + proxy.SSH.User + "@" + proxy.SSH.Host
+		}
+
+		return "ssh://" + proxy.SSH.Host
+	default:
+		return "unix://" + proxy.Socket
+	}
+}
+
 // configInfoCommand implements `config info` command logic.
 func configInfoCommand(config *clientconfig.Config, now time.Time) (string, error) {
 	info, err := configInfo(config, now)
@@ -531,20 +594,69 @@ func configInfoCommand(config *clientconfig.Config, now time.Time) (string, erro
 }
 
 var configInfoCmdFlags struct {
-	output string
+	output  string
+	verify  bool
+	repin   bool
+	enforce bool
 }
 
 // configInfoCmd represents the `config info` command.
 var configInfoCmd = &cobra.Command{
 	Use:   "info",
 	Short: "Show information about the current context",
-	Args:  cobra.NoArgs,
+	Long: `Show information about the current context.
+
+With --verify, the server certificate of each endpoint is fetched and its fingerprint is compared
+with the one pinned in the talosconfig. The fingerprint is pinned on first use. A changed fingerprint is
+re-pinned if the new certificate is signed by the context CA, otherwise it is reported as a mismatch
+(use --repin to trust the new certificate anyway).
+
+With --enforce-fingerprints, the pinned fingerprints are enforced on every connection to the endpoints,
+so the commands fail if the server certificate doesn't match the pin (use --enforce-fingerprints=false to disable it).
+Talos re-issues the API server certificate on each boot, so with the enforcement enabled
+the endpoints should be re-pinned with --verify after a reboot.`,
+	Args: cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		c, err := openConfigAndContext("")
 		if err != nil {
 			return err
 		}
 
+		var fingerprints []endpointFingerprint
+
+		if cmd.Flags().Changed("enforce-fingerprints") {
+			cfgContext, err := getContextData(c)
+			if err != nil {
+				return err
+			}
+
+			cfgContext.EnforceEndpointFingerprints = configInfoCmdFlags.enforce
+
+			if err = c.Save(GlobalArgs.Talosconfig); err != nil {
+				return err
+			}
+		}
+
+		if configInfoCmdFlags.verify || configInfoCmdFlags.repin {
+			cfgContext, err := getContextData(c)
+			if err != nil {
+				return err
+			}
+
+			var changed bool
+
+			fingerprints, changed, err = verifyEndpointFingerprints(cmd.Context(), cfgContext, configInfoCmdFlags.repin)
+			if err != nil {
+				return err
+			}
+
+			if changed {
+				if err = c.Save(GlobalArgs.Talosconfig); err != nil {
+					return err
+				}
+			}
+		}
+
 		switch configInfoCmdFlags.output {
 		case "text":
 			res, err := configInfoCommand(c, time.Now())
@@ -554,27 +666,43 @@ var configInfoCmd = &cobra.Command{
 
 			fmt.Print(res)
 
-			return nil
+			if fingerprints != nil {
+				fmt.Println()
+
+				if err = printEndpointFingerprints(os.Stdout, fingerprints); err != nil {
+					return err
+				}
+			}
 		case "json":
 			info, err := configInfo(c, time.Now())
 			if err != nil {
 				return err
 			}
 
+			info.Fingerprints = fingerprints
+
 			enc := json.NewEncoder(os.Stdout)
 			enc.SetIndent("", "  ")
 
-			return enc.Encode(&info)
+			if err = enc.Encode(&info); err != nil {
+				return err
+			}
 		case "yaml":
 			info, err := configInfo(c, time.Now())
 			if err != nil {
 				return err
 			}
 
-			return yaml.NewEncoder(os.Stdout).Encode(&info)
+			info.Fingerprints = fingerprints
+
+			if err = yaml.NewEncoder(os.Stdout).Encode(&info); err != nil {
+				return err
+			}
 		default:
 			return fmt.Errorf("unknown output format: %q", configInfoCmdFlags.output)
 		}
+
+		return endpointFingerprintsError(fingerprints)
 	},
 }
 
@@ -596,6 +724,7 @@ func init() {
 	configCmd.AddCommand(
 		configEndpointCmd,
 		configNodeCmd,
+		configProxyCmd,
 		configContextCmd,
 		configAddCmd,
 		configRemoveCmd,
@@ -609,6 +738,13 @@ func init() {
 	configAddCmd.Flags().StringVar(&configAddCmdFlags.crt, "crt", "", "the path to the certificate")
 	configAddCmd.Flags().StringVar(&configAddCmdFlags.key, "key", "", "the path to the key")
 
+	configProxyCmd.Flags().StringVar(&configProxyCmdFlags.ssh, "ssh", "", "the SSH jump host to tunnel the connections through ([user@]host[:port])")
+	configProxyCmd.Flags().StringVar(&configProxyCmdFlags.identityFile, "ssh-identity", "", "the path to the SSH private key")
+	configProxyCmd.Flags().StringVar(&configProxyCmdFlags.knownHostsFile, "ssh-known-hosts", "", "the path to the SSH known hosts file (defaults to ~/.ssh/known_hosts)")
+	configProxyCmd.Flags().StringVar(&configProxyCmdFlags.socket, "socket", "", "the path to the local Unix socket which forwards the connections to the endpoints")
+	configProxyCmd.Flags().BoolVar(&configProxyCmdFlags.clear, "clear", false, "remove the proxy, connect to the endpoints directly")
+	configProxyCmd.MarkFlagsMutuallyExclusive("ssh", "socket", "clear")
+
 	configRemoveCmd.Flags().BoolVarP(
 		&configRemoveCmdFlags.noconfirm, "noconfirm", "y", false,
 		"do not ask for confirmation",
@@ -619,8 +755,12 @@ func init() {
 
 	configNewCmd.Flags().StringSliceVar(&configNewCmdFlags.roles, "roles", role.MakeSet(role.Admin).Strings(), "roles")
 	configNewCmd.Flags().DurationVar(&configNewCmdFlags.crtTTL, "crt-ttl", constants.TalosAPIDefaultCertificateValidityDuration, "certificate TTL")
+	configNewCmd.Flags().StringSliceVar(&configNewCmdFlags.allowedNodes, "allowed-nodes", nil, "limit the client certificate to the nodes (addresses or hostnames)")
 
 	configInfoCmd.Flags().StringVarP(&configInfoCmdFlags.output, "output", "o", "text", "output format (json|yaml|text). Default text.")
+	configInfoCmd.Flags().BoolVar(&configInfoCmdFlags.verify, "verify", false, "verify the server certificate fingerprints of the endpoints, pinning them on first use")
+	configInfoCmd.Flags().BoolVar(&configInfoCmdFlags.repin, "repin", false, "trust and pin the changed server certificate fingerprints (implies --verify)")
+	configInfoCmd.Flags().BoolVar(&configInfoCmdFlags.enforce, "enforce-fingerprints", false, "enforce the pinned fingerprints on every connection to the endpoints of the context")
 
 	addCommand(configCmd)
 }
@@ -0,0 +1,229 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+)
+
+// contextList is a list of the current context (endpoints or nodes) which can be mutated from the CLI.
+type contextList struct {
+	// name is the name of a list item, used in the error messages.
+	name string
+	// required is true if the list can't be empty.
+	required bool
+
+	list     func(*clientconfig.Context) *[]string
+	validate func(string) error
+}
+
+var (
+	contextEndpoints = contextList{
+		name:     "endpoint",
+		required: true,
+		list:     func(c *clientconfig.Context) *[]string { return &c.Endpoints },
+		validate: validateContextEndpoint,
+	}
+
+	contextNodes = contextList{
+		name:     "node",
+		list:     func(c *clientconfig.Context) *[]string { return &c.Nodes },
+		validate: validateContextHost,
+	}
+)
+
+// update validates the arguments, updates the list of the current context and saves the talosconfig.
+func (l contextList) update(args []string, op func(current, values []string) ([]string, error)) error {
+	values := make([]string, 0, len(args))
+
+	for _, arg := range args {
+		arg = strings.TrimSpace(arg)
+
+		if err := l.validate(arg); err != nil {
+			return fmt.Errorf("invalid %s %q: %w", l.name, arg, err)
+		}
+
+		values = append(values, arg)
+	}
+
+	c, err := openConfigAndContext("")
+	if err != nil {
+		return err
+	}
+
+	ctxData, err := getContextData(c)
+	if err != nil {
+		return err
+	}
+
+	list := l.list(ctxData)
+
+	updated, err := op(*list, values)
+	if err != nil {
+		return err
+	}
+
+	if l.required && len(updated) == 0 {
+		return fmt.Errorf("at least one %s is required", l.name)
+	}
+
+	*list = updated
+
+	if err = c.Save(GlobalArgs.Talosconfig); err != nil {
+		return fmt.Errorf("error writing config: %w", err)
+	}
+
+	return nil
+}
+
+// set replaces the list with the values.
+func (l contextList) set(_, values []string) ([]string, error) {
+	return uniqueValues(values), nil
+}
+
+// add appends the values which are not in the list yet.
+func (l contextList) add(current, values []string) ([]string, error) {
+	return uniqueValues(append(slices.Clone(current), values...)), nil
+}
+
+// remove removes the values from the list, all of them should be in the list.
+func (l contextList) remove(current, values []string) ([]string, error) {
+	for _, value := range values {
+		if !slices.Contains(current, value) {
+			return nil, fmt.Errorf("%s %q is not defined in the context", l.name, value)
+		}
+	}
+
+	return slices.DeleteFunc(slices.Clone(current), func(value string) bool {
+		return slices.Contains(values, value)
+	}), nil
+}
+
+func uniqueValues(values []string) []string {
+	result := make([]string, 0, len(values))
+
+	for _, value := range values {
+		if !slices.Contains(result, value) {
+			result = append(result, value)
+		}
+	}
+
+	return result
+}
+
+// hostnameRe matches a DNS hostname (RFC 1123).
+var hostnameRe = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(\.[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*\.?$`)
+
+// validateContextHost checks that the value is an IP address or a hostname.
+func validateContextHost(host string) error {
+	if host == "" {
+		return errors.New("empty value")
+	}
+
+	if net.ParseIP(host) != nil {
+		return nil
+	}
+
+	if len(host) > 253 || !hostnameRe.MatchString(host) {
+		return errors.New("not an IP address or a hostname")
+	}
+
+	return nil
+}
+
+// validateContextEndpoint checks that the value is a host, optionally with a port, or an HTTPS URL.
+func validateContextEndpoint(endpoint string) error {
+	if strings.Contains(endpoint, "://") {
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return err
+		}
+
+		if u.Scheme != "https" {
+			return fmt.Errorf("unsupported scheme %q", u.Scheme)
+		}
+
+		return validateContextHostPort(u.Hostname(), u.Port())
+	}
+
+	host, port, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		// no port, the default API port is used
+		return validateContextHost(endpoint)
+	}
+
+	if port == "" {
+		return errors.New("empty port")
+	}
+
+	return validateContextHostPort(host, port)
+}
+
+func validateContextHostPort(host, port string) error {
+	if port != "" {
+		if p, err := strconv.ParseUint(port, 10, 16); err != nil || p == 0 {
+			return fmt.Errorf("invalid port %q", port)
+		}
+	}
+
+	return validateContextHost(host)
+}
+
+// newContextListCmds creates the `set`, `add` and `remove` subcommands of the `config endpoint` and `config node` commands.
+func newContextListCmds(l contextList, plural string) []*cobra.Command {
+	use := "<" + l.name + ">..."
+
+	setArgs := cobra.ArbitraryArgs
+	if l.required {
+		setArgs = cobra.MinimumNArgs(1)
+	}
+
+	return []*cobra.Command{
+		{
+			Use:   "set " + use,
+			Short: fmt.Sprintf("Replace the %s of the current context", plural),
+			Args:  setArgs,
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return l.update(args, l.set)
+			},
+		},
+		{
+			Use:   "add " + use,
+			Short: fmt.Sprintf("Add %s to the current context", plural),
+			Long: fmt.Sprintf(`Add %s to the current context.
+
+The %s already defined in the context are skipped.`, plural, plural),
+			Args: cobra.MinimumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return l.update(args, l.add)
+			},
+		},
+		{
+			Use:     "remove " + use,
+			Aliases: []string{"rm"},
+			Short:   fmt.Sprintf("Remove %s from the current context", plural),
+			Args:    cobra.MinimumNArgs(1),
+			RunE: func(cmd *cobra.Command, args []string) error {
+				return l.update(args, l.remove)
+			},
+		},
+	}
+}
+
+func init() {
+	configEndpointCmd.AddCommand(newContextListCmds(contextEndpoints, "endpoints")...)
+	configNodeCmd.AddCommand(newContextListCmds(contextNodes, "nodes")...)
+}
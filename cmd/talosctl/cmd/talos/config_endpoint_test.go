@@ -0,0 +1,68 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos //nolint:testpackage // to test unexported function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateContextEndpoint(t *testing.T) {
+	t.Parallel()
+
+	for _, endpoint := range []string{
+		"10.5.0.2",
+		"10.5.0.2:50000",
+		"fd00::1",
+		"[fd00::1]:50000",
+		"cp.example.com",
+		"cp.example.com:443",
+		"localhost",
+		"https://cp.example.com",
+		"https://[fd00::1]:8443",
+	} {
+		assert.NoError(t, validateContextEndpoint(endpoint), endpoint)
+	}
+
+	for _, endpoint := range []string{
+		"",
+		"10.5.0.2:",
+		"10.5.0.2:0",
+		"10.5.0.2:70000",
+		"http://10.5.0.2",
+		"10.5.0.2/24",
+		"cp_1.example.com",
+		"-cp.example.com",
+	} {
+		assert.Error(t, validateContextEndpoint(endpoint), endpoint)
+	}
+
+	assert.Error(t, validateContextHost("10.5.0.2:50000"))
+}
+
+func TestContextListOps(t *testing.T) {
+	t.Parallel()
+
+	current := []string{"10.5.0.2", "10.5.0.3"}
+
+	updated, err := contextNodes.set(current, []string{"10.5.0.4", "10.5.0.4"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.5.0.4"}, updated)
+
+	updated, err = contextNodes.add(current, []string{"10.5.0.3", "10.5.0.4"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.5.0.2", "10.5.0.3", "10.5.0.4"}, updated)
+
+	updated, err = contextNodes.remove(current, []string{"10.5.0.2"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"10.5.0.3"}, updated)
+
+	_, err = contextEndpoints.remove(current, []string{"10.5.0.4"})
+	assert.EqualError(t, err, `endpoint "10.5.0.4" is not defined in the context`)
+
+	assert.Equal(t, []string{"10.5.0.2", "10.5.0.3"}, current)
+}
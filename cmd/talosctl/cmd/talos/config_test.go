@@ -5,10 +5,15 @@
 package talos //nolint:testpackage // to test unexported function
 
 import (
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"net"
 	"strings"
 	"testing"
 	"time"
 
+	talosx509 "github.com/siderolabs/crypto/x509"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -102,3 +107,82 @@ Certificate expires: 10 years from now (2031-07-03)
 		})
 	}
 }
+
+func TestVerifyEndpointFingerprints(t *testing.T) {
+	t.Parallel()
+
+	ca, err := talosx509.NewSelfSignedCertificateAuthority(talosx509.Organization("talos"))
+	require.NoError(t, err)
+
+	otherCA, err := talosx509.NewSelfSignedCertificateAuthority(talosx509.Organization("other"))
+	require.NoError(t, err)
+
+	serverCert, err := talosx509.NewKeyPair(ca, talosx509.IPAddresses([]net.IP{net.ParseIP("127.0.0.1")}))
+	require.NoError(t, err)
+
+	listener, err := tls.Listen("tcp", "127.0.0.1:0", &tls.Config{Certificates: []tls.Certificate{*serverCert.Certificate}})
+	require.NoError(t, err)
+
+	t.Cleanup(func() { listener.Close() }) //nolint:errcheck
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+
+			conn.(*tls.Conn).Handshake() //nolint:errcheck,forcetypeassert
+			conn.Close()                 //nolint:errcheck
+		}
+	}()
+
+	endpoint := listener.Addr().String()
+
+	cfgContext := &clientconfig.Context{
+		Endpoints: []string{endpoint},
+		CA:        base64.StdEncoding.EncodeToString(ca.CrtPEM),
+	}
+
+	ctx := context.Background()
+
+	// first use: the fingerprint is pinned
+	results, changed, err := verifyEndpointFingerprints(ctx, cfgContext, false)
+	require.NoError(t, err)
+	require.Len(t, results, 1)
+	assert.True(t, changed)
+	assert.Equal(t, fingerprintPinned, results[0].Status)
+	assert.True(t, results[0].CAVerified)
+	assert.Equal(t, talosx509.SPKIFingerprint(serverCert.Leaf).String(), cfgContext.EndpointFingerprints[endpoint])
+
+	results, changed, err = verifyEndpointFingerprints(ctx, cfgContext, false)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, fingerprintMatch, results[0].Status)
+	assert.NoError(t, endpointFingerprintsError(results))
+
+	// the certificate was re-issued by the same CA: re-pinned
+	cfgContext.EndpointFingerprints[endpoint] = "old"
+
+	results, changed, err = verifyEndpointFingerprints(ctx, cfgContext, false)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, fingerprintRepinned, results[0].Status)
+
+	// the certificate is not signed by the context CA: mismatch
+	cfgContext.CA = base64.StdEncoding.EncodeToString(otherCA.CrtPEM)
+	cfgContext.EndpointFingerprints[endpoint] = "old"
+
+	results, changed, err = verifyEndpointFingerprints(ctx, cfgContext, false)
+	require.NoError(t, err)
+	assert.False(t, changed)
+	assert.Equal(t, fingerprintMismatch, results[0].Status)
+	assert.False(t, results[0].CAVerified)
+	assert.Error(t, endpointFingerprintsError(results))
+	assert.Equal(t, "old", cfgContext.EndpointFingerprints[endpoint])
+
+	results, changed, err = verifyEndpointFingerprints(ctx, cfgContext, true)
+	require.NoError(t, err)
+	assert.True(t, changed)
+	assert.Equal(t, fingerprintRepinned, results[0].Status)
+}
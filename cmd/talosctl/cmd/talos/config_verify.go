@@ -0,0 +1,240 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	talosx509 "github.com/siderolabs/crypto/x509"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+	"github.com/siderolabs/talos/pkg/machinery/client/resolver"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// endpointVerifyTimeout is the timeout of the TLS handshake with a single endpoint.
+const endpointVerifyTimeout = 10 * time.Second
+
+// Endpoint fingerprint verification statuses.
+const (
+	fingerprintPinned      = "pinned"
+	fingerprintMatch       = "match"
+	fingerprintRepinned    = "repinned"
+	fingerprintMismatch    = "MISMATCH"
+	fingerprintUnreachable = "unreachable"
+)
+
+// endpointFingerprint is the result of verifying the server certificate of a single endpoint.
+type endpointFingerprint struct {
+	Endpoint    string `json:"endpoint" yaml:"endpoint"`
+	Fingerprint string `json:"fingerprint,omitempty" yaml:"fingerprint,omitempty"`
+	CAVerified  bool   `json:"caVerified" yaml:"caVerified"`
+	Status      string `json:"status" yaml:"status"`
+	Error       string `json:"error,omitempty" yaml:"error,omitempty"`
+}
+
+// verifyEndpointFingerprints connects to each endpoint of the context and checks the server certificate
+// against the pinned fingerprint, pinning it on first use.
+//
+// It returns true if the pinned fingerprints were changed and the talosconfig should be saved.
+func verifyEndpointFingerprints(ctx context.Context, cfgContext *clientconfig.Context, repin bool) ([]endpointFingerprint, bool, error) {
+	if len(cfgContext.Endpoints) == 0 {
+		return nil, false, errors.New("no endpoints are defined in the context")
+	}
+
+	roots, err := contextCertPool(cfgContext)
+	if err != nil {
+		return nil, false, err
+	}
+
+	clientCert, err := client.CertificateFromConfigContext(cfgContext)
+	if err != nil {
+		return nil, false, err
+	}
+
+	results := make([]endpointFingerprint, 0, len(cfgContext.Endpoints))
+	changed := false
+
+	for _, endpoint := range cfgContext.Endpoints {
+		chain, err := fetchServerCertificates(ctx, endpoint, clientCert)
+		if err != nil {
+			results = append(results, endpointFingerprint{
+				Endpoint: endpoint,
+				Status:   fingerprintUnreachable,
+				Error:    err.Error(),
+			})
+
+			continue
+		}
+
+		result := checkEndpointFingerprint(cfgContext, endpoint, chain, roots, repin)
+
+		if result.Status == fingerprintPinned || result.Status == fingerprintRepinned {
+			changed = true
+		}
+
+		results = append(results, result)
+	}
+
+	return results, changed, nil
+}
+
+// checkEndpointFingerprint compares the server certificate with the fingerprint pinned for the endpoint.
+//
+// The fingerprint is pinned if there is no pin yet.
+// As the server certificate is re-issued by Talos on each boot, a changed fingerprint is re-pinned
+// if the new certificate is signed by the context CA (or if repin is set), otherwise it is reported as a mismatch.
+func checkEndpointFingerprint(cfgContext *clientconfig.Context, endpoint string, chain []*x509.Certificate, roots *x509.CertPool, repin bool) endpointFingerprint {
+	fingerprint := talosx509.SPKIFingerprint(chain[0]).String()
+
+	result := endpointFingerprint{
+		Endpoint:    endpoint,
+		Fingerprint: fingerprint,
+	}
+
+	if roots != nil {
+		intermediates := x509.NewCertPool()
+
+		for _, cert := range chain[1:] {
+			intermediates.AddCert(cert)
+		}
+
+		_, err := chain[0].Verify(x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		})
+		if err != nil {
+			result.Error = fmt.Sprintf("certificate is not signed by the context CA: %s", err)
+		} else {
+			result.CAVerified = true
+		}
+	}
+
+	pinned, ok := cfgContext.EndpointFingerprints[endpoint]
+
+	switch {
+	case !ok:
+		result.Status = fingerprintPinned
+	case pinned == fingerprint:
+		result.Status = fingerprintMatch
+
+		return result
+	case result.CAVerified || repin:
+		result.Status = fingerprintRepinned
+	default:
+		result.Status = fingerprintMismatch
+		result.Error = fmt.Sprintf("fingerprint changed from %s and the certificate can't be verified with the context CA", pinned)
+
+		return result
+	}
+
+	if cfgContext.EndpointFingerprints == nil {
+		cfgContext.EndpointFingerprints = map[string]string{}
+	}
+
+	cfgContext.EndpointFingerprints[endpoint] = fingerprint
+
+	return result
+}
+
+// contextCertPool returns the pool with the context CA, or nil if the context has no CA.
+func contextCertPool(cfgContext *clientconfig.Context) (*x509.CertPool, error) {
+	if cfgContext.CA == "" {
+		return nil, nil //nolint:nilnil
+	}
+
+	caBytes, err := base64.StdEncoding.DecodeString(cfgContext.CA)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding CA: %w", err)
+	}
+
+	roots := x509.NewCertPool()
+
+	if !roots.AppendCertsFromPEM(caBytes) {
+		return nil, errors.New("failed to parse the context CA")
+	}
+
+	return roots, nil
+}
+
+// fetchServerCertificates performs the TLS handshake with the endpoint and returns the server certificate chain.
+//
+// The chain is not verified during the handshake, it is verified by the caller.
+func fetchServerCertificates(ctx context.Context, endpoint string, clientCert *tls.Certificate) ([]*x509.Certificate, error) {
+	ctx, cancel := context.WithTimeout(ctx, endpointVerifyTimeout)
+	defer cancel()
+
+	var chain []*x509.Certificate
+
+	tlsConfig := &tls.Config{
+		InsecureSkipVerify: true, //nolint:gosec // the certificate is checked against the pinned fingerprint and the CA by the caller
+		VerifyConnection: func(state tls.ConnectionState) error {
+			if len(state.PeerCertificates) == 0 {
+				return errors.New("no server certificate")
+			}
+
+			chain = state.PeerCertificates
+
+			return nil
+		},
+	}
+
+	if clientCert != nil {
+		tlsConfig.Certificates = []tls.Certificate{*clientCert}
+	}
+
+	dialer := tls.Dialer{Config: tlsConfig}
+
+	conn, err := dialer.DialContext(ctx, "tcp", resolver.EnsureEndpointsHavePorts([]string{endpoint}, constants.ApidPort)[0])
+	if err != nil {
+		return nil, err
+	}
+
+	if err = conn.Close(); err != nil {
+		return nil, err
+	}
+
+	return chain, nil
+}
+
+// printEndpointFingerprints prints the endpoint fingerprint verification results as a table.
+func printEndpointFingerprints(out io.Writer, results []endpointFingerprint) error {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "ENDPOINT\tFINGERPRINT\tCA VERIFIED\tSTATUS\tERROR")
+
+	for _, result := range results {
+		fmt.Fprintf(w, "%s\t%s\t%t\t%s\t%s\n", result.Endpoint, result.Fingerprint, result.CAVerified, result.Status, result.Error)
+	}
+
+	return w.Flush()
+}
+
+// endpointFingerprintsError returns an error if any of the endpoints failed the verification.
+func endpointFingerprintsError(results []endpointFingerprint) error {
+	var mismatched []string
+
+	for _, result := range results {
+		if result.Status == fingerprintMismatch {
+			mismatched = append(mismatched, result.Endpoint)
+		}
+	}
+
+	if len(mismatched) > 0 {
+		return fmt.Errorf("server certificate fingerprint mismatch for endpoints %v, use --repin to trust the new certificates", mismatched)
+	}
+
+	return nil
+}
@@ -7,10 +7,13 @@ package talos
 import (
 	"context"
 	"fmt"
+	"os"
+	"text/tabwriter"
 
 	"github.com/spf13/cobra"
 
 	"github.com/siderolabs/talos/pkg/cluster"
+	"github.com/siderolabs/talos/pkg/cluster/cis"
 	"github.com/siderolabs/talos/pkg/cluster/hydrophone"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 )
@@ -60,7 +63,73 @@ var conformanceKubernetesCmd = &cobra.Command{
 	},
 }
 
+var conformanceCISCmdFlags struct {
+	failedOnly bool
+}
+
+var conformanceCISCmd = &cobra.Command{
+	Use:   "cis",
+	Short: "Run CIS Kubernetes Benchmark self-assessment",
+	Long: `Run CIS Kubernetes Benchmark self-assessment.
+
+The configuration of the kubelet, control plane components and etcd is gathered over the Talos API,
+and evaluated against the automated controls of the CIS Kubernetes Benchmark v1.8.
+Remediation hints are printed for the failed controls.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			nodes := client.NodesFromContext(ctx)
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tCONTROL\tRESULT\tDESCRIPTION\tREMEDIATION")
+
+			var passed, failed int
+
+			gathered := client.FanOut(ctx, nodes, GlobalArgs.FanOutOptions(), func(nodeCtx context.Context, _ string) (*cis.NodeConfig, error) {
+				return cis.Gather(nodeCtx, c)
+			})
+
+			for _, nodeConfig := range gathered {
+				if nodeConfig.Err != nil {
+					return fmt.Errorf("error gathering configuration on node %q: %w", nodeConfig.Node, nodeConfig.Err)
+				}
+
+				node := nodeConfig.Node
+
+				for _, result := range cis.Evaluate(nodeConfig.Value) {
+					status, remediation := "PASS", ""
+
+					if result.Pass {
+						passed++
+					} else {
+						failed++
+
+						status, remediation = "FAIL", result.Remediation
+					}
+
+					if result.Pass && conformanceCISCmdFlags.failedOnly {
+						continue
+					}
+
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", node, result.ID, status, result.Title, remediation)
+				}
+			}
+
+			if err := w.Flush(); err != nil {
+				return err
+			}
+
+			fmt.Printf("\n%d checks passed, %d checks failed\n", passed, failed)
+
+			return nil
+		})
+	},
+}
+
 func init() {
+	conformanceCISCmd.Flags().BoolVar(&conformanceCISCmdFlags.failedOnly, "failed-only", false, "show only failed controls")
+	conformanceCmd.AddCommand(conformanceCISCmd)
+
 	conformanceKubernetesCmd.Flags().StringVar(&conformanceKubernetesCmdFlags.mode, "mode", "fast", "conformance test mode: [fast, certified]")
 	conformanceCmd.AddCommand(conformanceKubernetesCmd)
 	addCommand(conformanceCmd)
@@ -7,22 +7,31 @@ package talos
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"sort"
 	"strings"
 	"text/tabwriter"
 
+	"github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 
-	"github.com/siderolabs/talos/pkg/cli"
-	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/internal/pkg/cgroups"
+	commonapi "github.com/siderolabs/talos/pkg/machinery/api/common"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
+var containersCmdFlags struct {
+	watch     bool
+	resources bool
+}
+
 // containersCmd represents the processes command.
 var containersCmd = &cobra.Command{
 	Use:     "containers",
@@ -34,36 +43,131 @@ var containersCmd = &cobra.Command{
 		return WithClient(func(ctx context.Context, c *client.Client) error {
 			var (
 				namespace string
-				driver    common.ContainerDriver
+				driver    commonapi.ContainerDriver
 			)
 
 			if kubernetesFlag {
 				namespace = constants.K8sContainerdNamespace
-				driver = common.ContainerDriver_CRI
+				driver = commonapi.ContainerDriver_CRI
 			} else {
 				namespace = constants.SystemContainerdNamespace
-				driver = common.ContainerDriver_CONTAINERD
+				driver = commonapi.ContainerDriver_CONTAINERD
 			}
 
-			var remotePeer peer.Peer
-
-			resp, err := c.Containers(ctx, namespace, driver, grpc.Peer(&remotePeer))
-			if err != nil {
-				if resp == nil {
-					return fmt.Errorf("error getting container list: %s", err)
+			if containersCmdFlags.resources {
+				if err := helpers.FailIfMultiNodes(ctx, "containers --resources"); err != nil {
+					return err
 				}
+			}
 
-				cli.Warning("%s", err)
+			if containersCmdFlags.watch {
+				return watchPoll(ctx, func(ctx context.Context, w io.Writer) error {
+					return containerList(ctx, c, w, namespace, driver)
+				})
 			}
 
-			return containerRender(&remotePeer, resp)
+			return containerList(ctx, c, os.Stdout, namespace, driver)
 		})
 	},
 }
 
-func containerRender(remotePeer *peer.Peer, resp *machineapi.ContainersResponse) error {
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
-	fmt.Fprintln(w, "NODE\tNAMESPACE\tID\tIMAGE\tPID\tSTATUS")
+func containerList(ctx context.Context, c *client.Client, out io.Writer, namespace string, driver commonapi.ContainerDriver) error {
+	var remotePeer peer.Peer
+
+	resp, err := c.Containers(ctx, namespace, driver, grpc.Peer(&remotePeer))
+	if err != nil {
+		if resp == nil {
+			return fmt.Errorf("error getting container list: %w", err)
+		}
+
+		common.WarnNodeErrors(err)
+	}
+
+	var cgroupNodes map[string]*cgroups.Node
+
+	if containersCmdFlags.resources {
+		cgroupNodes, err = containerCgroups(ctx, c)
+		if err != nil {
+			return err
+		}
+	}
+
+	return containerRender(out, &remotePeer, resp, cgroupNodes)
+}
+
+// containerCgroups returns the cgroups of the node indexed by the cgroup name.
+func containerCgroups(ctx context.Context, c *client.Client) (map[string]*cgroups.Node, error) {
+	r, err := c.Copy(ctx, constants.CgroupMountPath)
+	if err != nil {
+		return nil, fmt.Errorf("error copying cgroups: %w", err)
+	}
+
+	defer r.Close() //nolint:errcheck
+
+	tree, err := cgroups.TreeFromTarGz(r)
+	if err != nil {
+		return nil, fmt.Errorf("error reading cgroups: %w", err)
+	}
+
+	cgroupNodes := map[string]*cgroups.Node{}
+
+	var index func(node *cgroups.Node)
+
+	index = func(node *cgroups.Node) {
+		for name, child := range node.Children {
+			cgroupNodes[name] = child
+
+			index(child)
+		}
+	}
+
+	index(tree.Root)
+
+	return cgroupNodes, nil
+}
+
+// containerResources returns the CPU, memory and disk IO usage columns for the container.
+//
+// Pod sandboxes show the usage of the whole pod cgroup, containers show the usage of the container cgroup.
+func containerResources(ctr *machineapi.ContainerInfo, cgroupNodes map[string]*cgroups.Node) string {
+	var node *cgroups.Node
+
+	switch {
+	case ctr.Id == ctr.PodId && ctr.Uid != "":
+		node = cgroupNodes["pod"+ctr.Uid]
+	case ctr.InternalId != "":
+		node = cgroupNodes[ctr.InternalId]
+	default:
+		node = cgroupNodes[ctr.Id]
+	}
+
+	if node == nil {
+		return "-\t-\t-\t-"
+	}
+
+	var readBytes, writtenBytes int64
+
+	for _, stat := range node.IOStat {
+		readBytes += stat["rbytes"].Val
+		writtenBytes += stat["wbytes"].Val
+	}
+
+	return fmt.Sprintf("%s\t%s\t%s\t%s",
+		node.CPUStat["usage_usec"].UsecToDuration(),
+		node.MemoryCurrent.HumanizeIBytes(),
+		humanize.IBytes(uint64(readBytes)),
+		humanize.IBytes(uint64(writtenBytes)),
+	)
+}
+
+func containerRender(out io.Writer, remotePeer *peer.Peer, resp *machineapi.ContainersResponse, cgroupNodes map[string]*cgroups.Node) error {
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+
+	if cgroupNodes != nil {
+		fmt.Fprintln(w, "NODE\tNAMESPACE\tID\tIMAGE\tPID\tSTATUS\tCPU\tMEMORY\tIO READ\tIO WRITE")
+	} else {
+		fmt.Fprintln(w, "NODE\tNAMESPACE\tID\tIMAGE\tPID\tSTATUS")
+	}
 
 	defaultNode := client.AddrFromPeer(remotePeer)
 
@@ -86,7 +190,11 @@ func containerRender(remotePeer *peer.Peer, resp *machineapi.ContainersResponse)
 				node = msg.Metadata.Hostname
 			}
 
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n", node, p.Namespace, display, p.Image, p.Pid, p.Status)
+			if cgroupNodes != nil {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\t%s\n", node, p.Namespace, display, p.Image, p.Pid, p.Status, containerResources(p, cgroupNodes))
+			} else {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%s\n", node, p.Namespace, display, p.Image, p.Pid, p.Status)
+			}
 		}
 	}
 
@@ -96,6 +204,9 @@ func containerRender(remotePeer *peer.Peer, resp *machineapi.ContainersResponse)
 func init() {
 	containersCmd.Flags().BoolVarP(&kubernetesFlag, "kubernetes", "k", false, "use the k8s.io containerd namespace")
 
+	containersCmd.Flags().BoolVar(&containersCmdFlags.resources, "resources", false, "show CPU, memory and disk IO usage of the containers (pods with --kubernetes) from the node cgroups")
+	containersCmd.Flags().BoolVarP(&containersCmdFlags.watch, "watch", "w", false, "watch the container list, re-rendering it on changes")
+
 	containersCmd.Flags().BoolP("use-cri", "c", false, "use the CRI driver")
 	containersCmd.Flags().MarkHidden("use-cri") //nolint:errcheck
 
@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos //nolint:testpackage // to test unexported function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/talos/internal/pkg/cgroups"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+func TestContainerResources(t *testing.T) {
+	t.Parallel()
+
+	value := func(v int64) cgroups.Value { return cgroups.Value{Val: v, IsSet: true} }
+
+	cgroupNodes := map[string]*cgroups.Node{
+		"pod5f3b": {
+			CPUStat:       cgroups.FlatMap{"usage_usec": value(2_500_000)},
+			MemoryCurrent: value(64 * 1024 * 1024),
+			IOStat: cgroups.NestedKeyed{
+				"8:0":  cgroups.FlatMap{"rbytes": value(1024), "wbytes": value(4096)},
+				"8:16": cgroups.FlatMap{"rbytes": value(1024), "wbytes": value(0)},
+			},
+		},
+		"a1b2c3": {
+			CPUStat:       cgroups.FlatMap{"usage_usec": value(1_000_000)},
+			MemoryCurrent: value(32 * 1024 * 1024),
+		},
+		"apid": {
+			CPUStat:       cgroups.FlatMap{"usage_usec": value(500)},
+			MemoryCurrent: value(1024),
+		},
+	}
+
+	for _, test := range []struct {
+		name      string
+		container *machineapi.ContainerInfo
+		expected  string
+	}{
+		{
+			name:      "pod sandbox",
+			container: &machineapi.ContainerInfo{Id: "kube-system/coredns", PodId: "kube-system/coredns", Uid: "5f3b", InternalId: "d4e5f6"},
+			expected:  "2.5s\t64 MiB\t2.0 KiB\t4.0 KiB",
+		},
+		{
+			name:      "pod container",
+			container: &machineapi.ContainerInfo{Id: "kube-system/coredns:coredns", PodId: "kube-system/coredns", Uid: "5f3b", InternalId: "a1b2c3"},
+			expected:  "1s\t32 MiB\t0 B\t0 B",
+		},
+		{
+			name:      "system container",
+			container: &machineapi.ContainerInfo{Id: "apid"},
+			expected:  "500µs\t1.0 KiB\t0 B\t0 B",
+		},
+		{
+			name:      "unknown container",
+			container: &machineapi.ContainerInfo{Id: "unknown"},
+			expected:  "-\t-\t-\t-",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, containerResources(test.container, cgroupNodes))
+		})
+	}
+}
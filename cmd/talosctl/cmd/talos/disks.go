@@ -14,7 +14,7 @@ import (
 	humanize "github.com/dustin/go-humanize"
 	"github.com/spf13/cobra"
 
-	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 )
 
@@ -43,7 +43,7 @@ func printDisks(ctx context.Context, c *client.Client) error {
 			return fmt.Errorf("error getting disks: %w", err)
 		}
 
-		cli.Warning("%s", err)
+		common.WarnNodeErrors(err)
 	}
 
 	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
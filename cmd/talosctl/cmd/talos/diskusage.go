@@ -5,10 +5,12 @@
 package talos
 
 import (
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"text/tabwriter"
 
@@ -23,6 +25,7 @@ import (
 var (
 	all       bool
 	threshold int64
+	sortSize  bool
 )
 
 // duCmd represents the du command.
@@ -63,59 +66,74 @@ var duCmd = &cobra.Command{
 				Paths:          paths,
 			})
 			if err != nil {
-				return fmt.Errorf("error fetching disk usage: %s", err)
+				return fmt.Errorf("error fetching disk usage: %w", err)
 			}
 
-			addedHeader := false
-
-			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
-
-			stringifySize := func(s int64) string {
-				if humanizeFlag {
-					return humanize.Bytes(uint64(s))
-				}
-
-				return strconv.FormatInt(s, 10)
+			type entry struct {
+				node string
+				info *machineapi.DiskUsageInfo
 			}
 
-			defer w.Flush() //nolint:errcheck
+			var (
+				entries       []entry
+				multipleNodes bool
+			)
 
-			return helpers.ReadGRPCStream(stream, func(info *machineapi.DiskUsageInfo, node string, multipleNodes bool) error {
+			err = helpers.ReadGRPCStream(stream, func(info *machineapi.DiskUsageInfo, node string, multiple bool) error {
 				if info.Error != "" {
 					return helpers.NonFatalError(errors.New(info.Error))
 				}
 
-				pattern := "%s\t%s\n"
+				if info.Metadata != nil && info.Metadata.Hostname != "" {
+					multiple = true
+					node = info.Metadata.Hostname
+				}
 
-				size := stringifySize(info.Size)
+				multipleNodes = multipleNodes || multiple
 
-				args := []any{
-					size, info.RelativeName,
-				}
+				entries = append(entries, entry{node: node, info: info})
 
-				if info.Metadata != nil && info.Metadata.Hostname != "" {
-					multipleNodes = true
-					node = info.Metadata.Hostname
+				return nil
+			})
+
+			if sortSize {
+				// largest entries first, stable to keep the walk order for the entries of the same size
+				slices.SortStableFunc(entries, func(a, b entry) int {
+					return cmp.Compare(b.info.Size, a.info.Size)
+				})
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+			stringifySize := func(s int64) string {
+				if humanizeFlag {
+					return humanize.Bytes(uint64(s))
 				}
 
-				if !addedHeader {
-					if multipleNodes {
-						fmt.Fprintln(w, "NODE\tSIZE\tNAME")
-					} else {
-						fmt.Fprintln(w, "SIZE\tNAME")
-					}
-					addedHeader = true
+				return strconv.FormatInt(s, 10)
+			}
+
+			if len(entries) > 0 {
+				if multipleNodes {
+					fmt.Fprintln(w, "NODE\tSIZE\tNAME")
+				} else {
+					fmt.Fprintln(w, "SIZE\tNAME")
 				}
+			}
 
+			for _, e := range entries {
 				if multipleNodes {
-					pattern = "%s\t%s\t%s\n"
-					args = append([]any{node}, args...)
+					fmt.Fprintf(w, "%s\t%s\t%s\n", e.node, stringifySize(e.info.Size), e.info.RelativeName)
+				} else {
+					fmt.Fprintf(w, "%s\t%s\n", stringifySize(e.info.Size), e.info.RelativeName)
 				}
+			}
 
-				fmt.Fprintf(w, pattern, args...)
+			if flushErr := w.Flush(); flushErr != nil {
+				return flushErr
+			}
 
-				return nil
-			})
+			return err
 		})
 	},
 }
@@ -124,6 +142,7 @@ func init() {
 	duCmd.Flags().BoolVarP(&humanizeFlag, "humanize", "H", false, "humanize size and time in the output")
 	duCmd.Flags().BoolVarP(&all, "all", "a", false, "write counts for all files, not just directories")
 	duCmd.Flags().Int64VarP(&threshold, "threshold", "t", 0, "threshold exclude entries smaller than SIZE if positive, or entries greater than SIZE if negative")
+	duCmd.Flags().BoolVarP(&sortSize, "sort", "s", false, "sort entries by size, largest first")
 	duCmd.Flags().Int32VarP(&recursionDepth, "depth", "d", 0, "maximum recursion depth")
 	addCommand(duCmd)
 }
@@ -15,7 +15,12 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/client"
 )
 
-var dmesgTail bool
+var dmesgCmdFlags struct {
+	tail       bool
+	severity   string
+	facilities []string
+	rateLimit  uint32
+}
 
 // dmesgCmd represents the dmesg command.
 var dmesgCmd = &cobra.Command{
@@ -25,7 +30,11 @@ var dmesgCmd = &cobra.Command{
 	Args:  cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(func(ctx context.Context, c *client.Client) error {
-			stream, err := c.Dmesg(ctx, follow, dmesgTail)
+			stream, err := c.Dmesg(ctx, follow, dmesgCmdFlags.tail,
+				client.WithDmesgSeverity(dmesgCmdFlags.severity),
+				client.WithDmesgFacilities(dmesgCmdFlags.facilities...),
+				client.WithDmesgRateLimit(dmesgCmdFlags.rateLimit),
+			)
 			if err != nil {
 				return fmt.Errorf("error getting dmesg: %w", err)
 			}
@@ -44,5 +53,8 @@ var dmesgCmd = &cobra.Command{
 func init() {
 	addCommand(dmesgCmd)
 	dmesgCmd.Flags().BoolVarP(&follow, "follow", "f", false, "specify if the kernel log should be streamed")
-	dmesgCmd.Flags().BoolVarP(&dmesgTail, "tail", "", false, "specify if only new messages should be sent (makes sense only when combined with --follow)")
+	dmesgCmd.Flags().BoolVarP(&dmesgCmdFlags.tail, "tail", "", false, "specify if only new messages should be sent (makes sense only when combined with --follow)")
+	dmesgCmd.Flags().StringVar(&dmesgCmdFlags.severity, "severity", "", "show only messages with the specified or higher severity (emerg, alert, crit, err, warning, notice, info, debug)")
+	dmesgCmd.Flags().StringSliceVar(&dmesgCmdFlags.facilities, "facility", nil, "show only messages of the specified facilities (e.g. kern, daemon)")
+	dmesgCmd.Flags().Uint32Var(&dmesgCmdFlags.rateLimit, "rate-limit", 0, "maximum number of messages per second sent by the node (0 for unlimited)")
 }
@@ -192,7 +192,7 @@ or 'notepad' for Windows.`,
 
 			for _, node := range GlobalArgs.Nodes {
 				nodeCtx := client.WithNodes(ctx, node)
-				if err := helpers.ForEachResource(nodeCtx, c, nil, editFn(c), editCmdFlags.namespace, args...); err != nil {
+				if err := helpers.ForEachResource(nodeCtx, c, nil, editFn(c), client.FanOutOptions{}, editCmdFlags.namespace, args...); err != nil {
 					return err
 				}
 			}
@@ -7,21 +7,25 @@ package talos
 import (
 	"context"
 	"crypto/sha256"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"strings"
 	"text/tabwriter"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/siderolabs/gen/xslices"
 	"github.com/spf13/cobra"
 	snapshot "go.etcd.io/etcd/etcdutl/v3/snapshot"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/durationpb"
 
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
-	"github.com/siderolabs/talos/pkg/cli"
 	"github.com/siderolabs/talos/pkg/logging"
-	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	commonapi "github.com/siderolabs/talos/pkg/machinery/api/common"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	etcdresource "github.com/siderolabs/talos/pkg/machinery/resources/etcd"
@@ -42,7 +46,7 @@ var etcdAlarmCmd = &cobra.Command{
 }
 
 type alarmMessage interface {
-	GetMetadata() *common.Metadata
+	GetMetadata() *commonapi.Metadata
 	GetMemberAlarms() []*machine.EtcdMemberAlarm
 }
 
@@ -94,7 +98,7 @@ var etcdAlarmListCmd = &cobra.Command{
 				if response == nil {
 					return fmt.Errorf("error getting alarms: %w", err)
 				}
-				cli.Warning("%s", err)
+				common.WarnNodeErrors(err)
 			}
 
 			return displayAlarms(xslices.Map(response.Messages, func(v *machine.EtcdAlarm) alarmMessage {
@@ -116,7 +120,7 @@ var etcdAlarmDisarmCmd = &cobra.Command{
 				if response == nil {
 					return fmt.Errorf("error disarming alarms: %w", err)
 				}
-				cli.Warning("%s", err)
+				common.WarnNodeErrors(err)
 			}
 
 			return displayAlarms(xslices.Map(response.Messages, func(v *machine.EtcdAlarmDisarm) alarmMessage {
@@ -194,6 +198,56 @@ var etcdForfeitLeadershipCmd = &cobra.Command{
 	},
 }
 
+var etcdRotateCertsCmd = &cobra.Command{
+	Use:   "rotate-certs",
+	Short: "Rotate etcd peer and server certificates",
+	Long: `Issues new etcd peer and server certificates and restarts etcd on each node, one node at a time.
+
+Each node is checked to have a healthy etcd cluster before the restart, so that the quorum is maintained.
+If several nodes are specified, the rotation is performed sequentially and stops on the first failure.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			nodes := GlobalArgs.Nodes
+			if len(nodes) == 0 {
+				// rotate certificates on the endpoint node
+				return etcdRotateCerts(ctx, c, "")
+			}
+
+			for _, node := range nodes {
+				if err := etcdRotateCerts(client.WithNode(ctx, node), c, node); err != nil {
+					return fmt.Errorf("error rotating etcd certificates on %s: %w", node, err)
+				}
+			}
+
+			return nil
+		})
+	},
+}
+
+func etcdRotateCerts(ctx context.Context, c *client.Client, node string) error {
+	stream, err := c.EtcdRotateCertificates(ctx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		msg, err := stream.Recv()
+		if err != nil {
+			if errors.Is(err, io.EOF) || client.StatusCode(err) == codes.Canceled {
+				return nil
+			}
+
+			return err
+		}
+
+		if node != "" {
+			fmt.Printf("%s: %s\n", node, msg.Message)
+		} else {
+			fmt.Println(msg.Message)
+		}
+	}
+}
+
 var etcdMemberListCmd = &cobra.Command{
 	Use:   "members",
 	Short: "Get the list of etcd cluster members",
@@ -207,7 +261,7 @@ var etcdMemberListCmd = &cobra.Command{
 				if response == nil {
 					return fmt.Errorf("error getting members: %w", err)
 				}
-				cli.Warning("%s", err)
+				common.WarnNodeErrors(err)
 			}
 
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
@@ -264,7 +318,7 @@ var etcdStatusCmd = &cobra.Command{
 				if response == nil {
 					return fmt.Errorf("error getting status: %w", err)
 				}
-				cli.Warning("%s", err)
+				common.WarnNodeErrors(err)
 			}
 
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
@@ -385,6 +439,84 @@ var etcdSnapshotCmd = &cobra.Command{
 	},
 }
 
+var etcdPrecheckCmdFlags struct {
+	duration time.Duration
+}
+
+var etcdPrecheckCmd = &cobra.Command{
+	Use:   "precheck",
+	Short: "Check whether the disk is fast enough for etcd",
+	Long: `Measures the latency of the disk writes etcd depends on (WAL fsync and backend commit) in the EPHEMERAL partition,
+and compares the 99th percentile against the thresholds recommended by the etcd documentation.
+
+Slow disks are the most common cause of etcd leader elections and unstable control planes.
+The check should be run before bootstrapping etcd, as the running etcd competes with it for the disk.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			resp, err := c.EtcdPrecheck(ctx, &machine.EtcdPrecheckRequest{
+				Duration: durationpb.New(etcdPrecheckCmdFlags.duration),
+			})
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error running etcd precheck: %w", err)
+				}
+
+				common.WarnNodeErrors(err)
+			}
+
+			if err = printEtcdPrecheck(resp); err != nil {
+				return err
+			}
+
+			if failed := etcdPrecheckFailedNodes(resp); len(failed) > 0 {
+				return fmt.Errorf("etcd precheck failed on %s: the disk is too slow for etcd", strings.Join(failed, ", "))
+			}
+
+			return nil
+		})
+	},
+}
+
+func printEtcdPrecheck(resp *machine.EtcdPrecheckResponse) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NODE\tTEST\tOPERATIONS\tAVG LATENCY\tP99 LATENCY\tTHRESHOLD\tRESULT")
+
+	for _, msg := range resp.GetMessages() {
+		for _, result := range msg.GetResults() {
+			verdict := "PASS"
+
+			if !result.GetPassed() {
+				verdict = "FAIL"
+			}
+
+			fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%s\t%s\n",
+				metadataNode(msg.GetMetadata()),
+				result.GetName(),
+				result.GetOperations(),
+				result.GetLatencyAvg().AsDuration(),
+				result.GetLatencyP99().AsDuration(),
+				result.GetThreshold().AsDuration(),
+				verdict,
+			)
+		}
+	}
+
+	return w.Flush()
+}
+
+func etcdPrecheckFailedNodes(resp *machine.EtcdPrecheckResponse) []string {
+	var failed []string
+
+	for _, msg := range resp.GetMessages() {
+		if !msg.GetPassed() {
+			failed = append(failed, metadataNode(msg.GetMetadata()))
+		}
+	}
+
+	return failed
+}
+
 func init() {
 	etcdAlarmCmd.AddCommand(
 		etcdAlarmListCmd,
@@ -398,9 +530,13 @@ func init() {
 		etcdLeaveCmd,
 		etcdMemberListCmd,
 		etcdMemberRemoveCmd,
+		etcdPrecheckCmd,
+		etcdRotateCertsCmd,
 		etcdSnapshotCmd,
 		etcdStatusCmd,
 	)
 
+	etcdPrecheckCmd.Flags().DurationVar(&etcdPrecheckCmdFlags.duration, "duration", 3*time.Second, "duration of each test")
+
 	addCommand(etcdCmd)
 }
@@ -96,17 +96,38 @@ var eventsCmd = &cobra.Command{
 				case *machine.AddressEvent:
 					args = []any{msg.GetHostname(), fmt.Sprintf("ADDRESSES: %s", strings.Join(msg.GetAddresses(), ","))}
 				case *machine.MachineStatusEvent:
-					args = []any{
-						msg.GetStage().String(),
-						fmt.Sprintf("ready: %v, unmet conditions: %v",
-							msg.GetStatus().Ready,
-							xslices.Map(msg.GetStatus().GetUnmetConditions(),
-								func(c *machine.MachineStatusEvent_MachineStatus_UnmetCondition) string {
-									return c.Name
-								},
-							),
+					details := fmt.Sprintf("ready: %v, unmet conditions: %v",
+						msg.GetStatus().Ready,
+						xslices.Map(msg.GetStatus().GetUnmetConditions(),
+							func(c *machine.MachineStatusEvent_MachineStatus_UnmetCondition) string {
+								return c.Name
+							},
 						),
+					)
+
+					if msg.GetNodeMaintenance() {
+						details += fmt.Sprintf(", node maintenance: %q", msg.GetNodeMaintenanceReason())
 					}
+
+					args = []any{msg.GetStage().String(), details}
+				case *machine.SyscallAuditEvent:
+					args = []any{msg.GetComm(), fmt.Sprintf("pid: %d, exe: %s, arch: %s, syscall: %d", msg.GetPid(), msg.GetExe(), msg.GetArch(), msg.GetSyscall())}
+				case *machine.OOMKillEvent:
+					args = []any{msg.GetProcess(), fmt.Sprintf("pid: %d, cgroup: %s, constraint: %s", msg.GetPid(), msg.GetCgroup(), msg.GetConstraint())}
+				case *machine.BootFallbackEvent:
+					args = []any{msg.GetBootedLabel(), fmt.Sprintf("fell back from %s after %d boot attempts", msg.GetFailedLabel(), msg.GetBootAttempts())}
+				case *machine.WatchdogResetEvent:
+					args = []any{msg.GetDevice(), fmt.Sprintf("last reset reasons: %s", strings.Join(msg.GetReasons(), ", "))}
+				case *machine.PowerActionEvent:
+					args = []any{strings.ToLower(msg.GetAction().String()), fmt.Sprintf("%s: %s", strings.ToLower(msg.GetState().String()), powerActionEventDetails(msg))}
+				case *machine.CSRRejectedEvent:
+					args = []any{msg.GetPeer(), fmt.Sprintf("%s (dns names: %s, addresses: %s)", msg.GetReason(), strings.Join(msg.GetDnsNames(), ", "), strings.Join(msg.GetIpAddresses(), ", "))}
+				case *machine.EtcdCertificateRotationEvent:
+					args = []any{strings.ToLower(msg.GetStage().String()), msg.GetMessage()}
+				case *machine.KernelErrorEvent:
+					args = []any{msg.GetKind(), fmt.Sprintf("%s: %s", msg.GetPriority(), msg.GetMessage())}
+				case *machine.ConfigApplyEvent:
+					args = []any{strings.ToLower(msg.GetMode().String()), msg.GetModeDetails()}
 				}
 
 				args = append([]any{event.Node, event.ID, event.TypeURL, event.ActorID}, args...)
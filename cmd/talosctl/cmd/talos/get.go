@@ -7,6 +7,7 @@ package talos
 import (
 	"context"
 	"fmt"
+	"os"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
@@ -17,6 +18,7 @@ import (
 	"google.golang.org/grpc/metadata"
 
 	"github.com/siderolabs/talos/cmd/talosctl/cmd/talos/output"
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/global"
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
 	"github.com/siderolabs/talos/pkg/cli"
 	"github.com/siderolabs/talos/pkg/machinery/client"
@@ -29,6 +31,7 @@ var getCmdFlags struct {
 	namespace string
 	output    string
 	watch     bool
+	cached    bool
 }
 
 // getCmd represents the get (resources) command.
@@ -52,26 +55,53 @@ To get a list of all available resource definitions, issue 'talosctl get rd'`,
 	},
 	Args: cobra.RangeArgs(1, 2),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if getCmdFlags.cached {
+			if getCmdFlags.insecure || getCmdFlags.watch || len(args) > 1 || GlobalArgs.AllContextsRequested() {
+				return fmt.Errorf("--cached flag is not supported with --insecure, --watch flags, resource ID or --context %s", global.AllContexts)
+			}
+
+			return getCachedMembers(args[0])
+		}
+
+		if GlobalArgs.AllContextsRequested() {
+			if getCmdFlags.insecure || getCmdFlags.watch || getCmdFlags.output != "table" {
+				return fmt.Errorf("--insecure, --watch flags and output formats other than table are not supported with --context %s", global.AllContexts)
+			}
+
+			// the resources of all contexts are printed in a single table with the CONTEXT column
+			out := output.NewTable(os.Stdout)
+
+			defer out.Flush() //nolint:errcheck
+
+			return withAllContexts(func(contextName string) error {
+				out.SetContext(contextName)
+
+				return WithClient(getResources(args, out))
+			})
+		}
+
+		out, err := output.NewWriter(getCmdFlags.output)
+		if err != nil {
+			return err
+		}
+
+		defer out.Flush() //nolint:errcheck
+
 		if getCmdFlags.insecure {
-			return WithClientMaintenance(nil, getResources(args))
+			return WithClientMaintenance(nil, getResources(args, out))
 		}
 
-		return WithClient(getResources(args))
+		return WithClient(getResources(args, out))
 	},
 }
 
 //nolint:gocyclo,cyclop
-func getResources(args []string) func(ctx context.Context, c *client.Client) error {
+func getResources(args []string, out output.Writer) func(ctx context.Context, c *client.Client) error {
 	return func(ctx context.Context, c *client.Client) error {
 		if err := helpers.ClientVersionCheck(ctx, c); err != nil {
 			return err
 		}
 
-		out, err := output.NewWriter(getCmdFlags.output)
-		if err != nil {
-			return err
-		}
-
 		resourceType := args[0]
 
 		var resourceID string
@@ -80,8 +110,6 @@ func getResources(args []string) func(ctx context.Context, c *client.Client) err
 			resourceID = args[1]
 		}
 
-		defer out.Flush() //nolint:errcheck
-
 		if getCmdFlags.watch { // get -w <type> OR get -w <type> <id>
 			md, _ := metadata.FromOutgoingContext(ctx)
 			nodes := md.Get("nodes")
@@ -182,11 +210,18 @@ func getResources(args []string) func(ctx context.Context, c *client.Client) err
 			}
 		}
 
-		var multiErr *multierror.Error
+		var (
+			multiErr *multierror.Error
+			members  *membersCollector
+		)
 
 		// get <type>
 		// get <type> <id>
 		callbackResource := func(parentCtx context.Context, hostname string, r resource.Resource, callError error) error {
+			if members != nil {
+				members.add(hostname, r, callError)
+			}
+
 			if callError != nil {
 				multiErr = multierror.Append(multiErr, callError)
 
@@ -197,14 +232,23 @@ func getResources(args []string) func(ctx context.Context, c *client.Client) err
 		}
 
 		callbackRD := func(definition *meta.ResourceDefinition) error {
+			// cache the full list of members, so that it can be shown with --cached when the nodes are unreachable
+			if definition.TypedSpec().Type == cluster.MemberType && resourceID == "" && !getCmdFlags.insecure {
+				members = newMembersCollector()
+			}
+
 			return out.WriteHeader(definition, false)
 		}
 
-		helperErr := helpers.ForEachResource(ctx, c, callbackRD, callbackResource, getCmdFlags.namespace, args...)
+		helperErr := helpers.ForEachResource(ctx, c, callbackRD, callbackResource, GlobalArgs.FanOutOptions(), getCmdFlags.namespace, args...)
 		if helperErr != nil {
 			return helperErr
 		}
 
+		if members != nil {
+			members.save()
+		}
+
 		return multiErr.ErrorOrNil()
 	}
 }
@@ -318,6 +362,7 @@ func init() {
 	getCmd.Flags().StringVar(&getCmdFlags.namespace, "namespace", "", "resource namespace (default is to use default namespace per resource)")
 	getCmd.Flags().StringVarP(&getCmdFlags.output, "output", "o", "table", "output mode (json, table, yaml, jsonpath)")
 	getCmd.Flags().BoolVarP(&getCmdFlags.watch, "watch", "w", false, "watch resource changes")
+	getCmd.Flags().BoolVar(&getCmdFlags.cached, "cached", false, "print the last known cluster members from the local cache without connecting to the nodes (only for members)")
 	getCmd.Flags().BoolVarP(&getCmdFlags.insecure, "insecure", "i", false, "get resources using the insecure (encrypted with no auth) maintenance service")
 	cli.Should(getCmd.RegisterFlagCompletionFunc("output", output.CompleteOutputArg))
 	addCommand(getCmd)
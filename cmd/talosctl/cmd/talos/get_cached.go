@@ -0,0 +1,141 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	yaml "gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/talos/output"
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/cache"
+	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+)
+
+// membersCollector collects the cluster members listed with 'talosctl get members' to update the local cache.
+type membersCollector struct {
+	members map[string][]cache.Member
+	failed  map[string]struct{}
+}
+
+func newMembersCollector() *membersCollector {
+	return &membersCollector{
+		members: map[string][]cache.Member{},
+		failed:  map[string]struct{}{},
+	}
+}
+
+func (collector *membersCollector) add(node string, r resource.Resource, callError error) {
+	if callError != nil {
+		collector.failed[node] = struct{}{}
+
+		return
+	}
+
+	// the resources are not unmarshaled from protobuf, so convert the spec via YAML
+	spec, err := yaml.Marshal(r.Spec())
+	if err != nil {
+		collector.failed[node] = struct{}{}
+
+		return
+	}
+
+	var member cache.Member
+
+	if err = yaml.Unmarshal(spec, &member.Spec); err != nil {
+		collector.failed[node] = struct{}{}
+
+		return
+	}
+
+	member.ID = r.Metadata().ID()
+
+	collector.members[node] = append(collector.members[node], member)
+}
+
+func (collector *membersCollector) save() {
+	nodeCache, _, err := GlobalArgs.OpenCache()
+	if err != nil {
+		return
+	}
+
+	now := time.Now()
+
+	for node, members := range collector.members {
+		if _, failed := collector.failed[node]; failed {
+			continue
+		}
+
+		nodeCache.Node(node).Members = &cache.Members{
+			Updated: now,
+			Items:   members,
+		}
+	}
+
+	if err = nodeCache.Save(); err != nil {
+		cli.Warning("failed to update the cache: %s", err)
+	}
+}
+
+// getCachedMembers prints the last known cluster members from the local cache without connecting to the nodes.
+func getCachedMembers(resourceType string) error {
+	rd, err := meta.NewResourceDefinition(cluster.MemberExtension{}.ResourceDefinition())
+	if err != nil {
+		return err
+	}
+
+	if !slices.ContainsFunc(append([]string{rd.TypedSpec().Type}, rd.TypedSpec().AllAliases...), func(alias string) bool {
+		return strings.EqualFold(alias, resourceType)
+	}) {
+		return fmt.Errorf("--cached flag is only supported for the %s resource", rd.TypedSpec().Type)
+	}
+
+	nodeCache, nodes, err := GlobalArgs.OpenCache()
+	if err != nil {
+		return err
+	}
+
+	if len(nodes) == 0 {
+		return errors.New("nodes are not set for the command: please use `--nodes` flag or configuration file to set the nodes to run the command against")
+	}
+
+	out, err := output.NewWriter(getCmdFlags.output)
+	if err != nil {
+		return err
+	}
+
+	if err = out.WriteHeader(rd, false); err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		cached := nodeCache.Nodes[node]
+		if cached == nil || cached.Members == nil {
+			cli.Warning("%s: no cached members, run 'talosctl get members' while the node is reachable", node)
+
+			continue
+		}
+
+		cli.Warning("%s: %s", node, cache.Stale(cached.Members.Updated))
+
+		for _, item := range cached.Members.Items {
+			member := cluster.NewMember(cluster.NamespaceName, item.ID)
+			*member.TypedSpec() = item.Spec
+
+			if err = out.WriteResource(node, member, 0); err != nil {
+				return err
+			}
+		}
+	}
+
+	return out.Flush()
+}
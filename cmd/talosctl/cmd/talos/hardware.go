@@ -0,0 +1,156 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var hardwareCmdFlags struct {
+	json bool
+}
+
+// hardwareCmd represents the hardware command.
+var hardwareCmd = &cobra.Command{
+	Use:   "hardware",
+	Short: "Show the hardware inventory of the node",
+	Long: `Show the hardware inventory of the node: system, BIOS and baseboard information (DMI/SMBIOS),
+processors, memory modules, PCI devices, network interfaces and disks.
+
+Use --json to get the inventory as structured data.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			resp, err := c.HardwareInventory(ctx)
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error getting hardware inventory: %w", err)
+				}
+
+				common.WarnNodeErrors(err)
+			}
+
+			if hardwareCmdFlags.json {
+				for _, msg := range resp.GetMessages() {
+					b, err := protojson.Marshal(msg)
+					if err != nil {
+						return err
+					}
+
+					fmt.Printf("%s\n", b)
+				}
+
+				return nil
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tCATEGORY\tID\tDESCRIPTION\tDETAILS")
+
+			for _, msg := range resp.GetMessages() {
+				printHardwareInventory(w, metadataNode(msg.GetMetadata()), msg)
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+//nolint:gocyclo
+func printHardwareInventory(w io.Writer, node string, inventory *machine.HardwareInventory) {
+	row := func(category, id, description string, details ...string) {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", node, category, orDash(id), orDash(description), orDash(joinDetails(details...)))
+	}
+
+	if system := inventory.GetSystem(); system != nil {
+		row("system", system.GetUuid(), joinNonEmpty(system.GetManufacturer(), system.GetProductName()), "serial", system.GetSerialNumber(), "sku", system.GetSkuNumber())
+	}
+
+	if bios := inventory.GetBios(); bios != nil {
+		row("bios", "", bios.GetVendor(), "version", bios.GetVersion(), "date", bios.GetReleaseDate())
+	}
+
+	if baseboard := inventory.GetBaseboard(); baseboard != nil {
+		row("baseboard", "", joinNonEmpty(baseboard.GetManufacturer(), baseboard.GetProductName()), "serial", baseboard.GetSerialNumber(), "asset tag", baseboard.GetAssetTag())
+	}
+
+	for _, processor := range inventory.GetProcessors() {
+		row("processor", processor.GetSocket(), joinNonEmpty(processor.GetManufacturer(), processor.GetProductName()),
+			"cores", fmt.Sprint(processor.GetCoreCount()), "threads", fmt.Sprint(processor.GetThreadCount()), "max speed (MHz)", fmt.Sprint(processor.GetMaxSpeedMhz()))
+	}
+
+	for _, memoryModule := range inventory.GetMemoryModules() {
+		row("memory", memoryModule.GetDeviceLocator(), joinNonEmpty(memoryModule.GetManufacturer(), memoryModule.GetProductName()),
+			"size (MiB)", fmt.Sprint(memoryModule.GetSizeMib()), "speed", fmt.Sprint(memoryModule.GetSpeed()), "serial", memoryModule.GetSerialNumber())
+	}
+
+	for _, pciDevice := range inventory.GetPciDevices() {
+		row("pci", pciDevice.GetAddress(), joinNonEmpty(pciDevice.GetVendor(), pciDevice.GetProduct()),
+			"class", joinNonEmpty(pciDevice.GetClass(), pciDevice.GetSubclass()), "id", pciDevice.GetVendorId()+":"+pciDevice.GetProductId())
+	}
+
+	for _, nic := range inventory.GetNetworkInterfaces() {
+		row("nic", nic.GetName(), joinNonEmpty(nic.GetVendor(), nic.GetProduct()),
+			"mac", nic.GetPermanentAddr(), "driver", joinNonEmpty(nic.GetDriver(), nic.GetDriverVersion()), "firmware", nic.GetFirmwareVersion())
+	}
+
+	for _, disk := range inventory.GetDisks() {
+		row("disk", disk.GetDevPath(), disk.GetModel(),
+			"size", humanize.Bytes(disk.GetSize()), "serial", disk.GetSerial(), "transport", disk.GetTransport())
+	}
+}
+
+// joinDetails formats key-value pairs, skipping empty values.
+func joinDetails(kv ...string) string {
+	var details []string
+
+	for i := 0; i+1 < len(kv); i += 2 {
+		if kv[i+1] == "" || kv[i+1] == "0" {
+			continue
+		}
+
+		details = append(details, kv[i]+": "+kv[i+1])
+	}
+
+	return strings.Join(details, ", ")
+}
+
+// joinNonEmpty joins non-empty parts with a space.
+func joinNonEmpty(parts ...string) string {
+	var nonEmpty []string
+
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+
+	return strings.Join(nonEmpty, " ")
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+
+	return s
+}
+
+func init() {
+	hardwareCmd.Flags().BoolVar(&hardwareCmdFlags.json, "json", false, "output the inventory as JSON")
+	addCommand(hardwareCmd)
+}
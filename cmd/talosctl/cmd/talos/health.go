@@ -9,12 +9,14 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"text/tabwriter"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc/codes"
 
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/global"
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
 	"github.com/siderolabs/talos/pkg/cluster"
 	"github.com/siderolabs/talos/pkg/cluster/check"
@@ -83,6 +85,7 @@ var healthCmdFlags struct {
 	clusterState       clusterNodes
 	clusterWaitTimeout time.Duration
 	forceEndpoint      string
+	output             string
 	runOnServer        bool
 	runE2E             bool
 }
@@ -94,13 +97,38 @@ var healthCmd = &cobra.Command{
 	Long:  ``,
 	Args:  cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		switch healthCmdFlags.output {
+		case "text", "json":
+		default:
+			return fmt.Errorf("unknown output format: %q", healthCmdFlags.output)
+		}
+
 		err := healthCmdFlags.clusterState.InitNodeInfos()
 		if err != nil {
 			return err
 		}
 
-		if err := runHealth(); err != nil {
-			return err
+		if GlobalArgs.AllContextsRequested() {
+			return runHealthAllContexts()
+		}
+
+		tracker := &healthTracker{}
+
+		if healthCmdFlags.output == "text" {
+			tracker.reporter = check.StderrReporter()
+		}
+
+		err = runHealth(tracker)
+		report := tracker.report(err)
+
+		if healthCmdFlags.output == "json" {
+			if encodeErr := report.write(); encodeErr != nil {
+				return encodeErr
+			}
+		}
+
+		if err != nil {
+			return report.exitError(err)
 		}
 
 		if healthCmdFlags.runE2E {
@@ -111,15 +139,71 @@ var healthCmd = &cobra.Command{
 	},
 }
 
-func runHealth() error {
+func runHealth(tracker *healthTracker) error {
 	if healthCmdFlags.runOnServer {
-		return WithClient(healthOnServer)
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			return healthOnServer(ctx, c, tracker)
+		})
+	}
+
+	return WithClientNoNodes(func(ctx context.Context, c *client.Client) error {
+		return healthOnClient(ctx, c, tracker)
+	})
+}
+
+// runHealthAllContexts runs the health check for each context in the talosconfig and prints the summary.
+func runHealthAllContexts() error {
+	if len(healthCmdFlags.clusterState.Nodes()) > 0 || healthCmdFlags.runE2E {
+		return fmt.Errorf("--init-node, --control-plane-nodes, --worker-nodes and --run-e2e flags are not supported with --context %s", global.AllContexts)
+	}
+
+	var reports []healthReport
+
+	err := withAllContexts(func(contextName string) error {
+		tracker := &healthTracker{}
+
+		if healthCmdFlags.output == "text" {
+			fmt.Fprintf(os.Stderr, "context %q:\n", contextName)
+
+			tracker.reporter = check.StderrReporter()
+		}
+
+		err := runHealth(tracker)
+
+		report := tracker.report(err)
+		report.Context = contextName
+
+		reports = append(reports, report)
+
+		return report.exitError(err)
+	})
+
+	if healthCmdFlags.output == "json" {
+		for _, report := range reports {
+			if encodeErr := report.write(); encodeErr != nil {
+				return encodeErr
+			}
+		}
+
+		return err
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "CONTEXT\tSTATUS\tERROR")
+
+	for _, report := range reports {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", report.Context, report.Status, report.Error)
+	}
+
+	if flushErr := w.Flush(); flushErr != nil {
+		return flushErr
 	}
 
-	return WithClientNoNodes(healthOnClient)
+	return err
 }
 
-func healthOnClient(ctx context.Context, c *client.Client) error {
+func healthOnClient(ctx context.Context, c *client.Client, tracker *healthTracker) error {
 	clientProvider := &cluster.ConfigClientProvider{
 		DefaultClient: c,
 	}
@@ -147,10 +231,10 @@ func healthOnClient(ctx context.Context, c *client.Client) error {
 	checkCtx, checkCtxCancel := context.WithTimeout(ctx, healthCmdFlags.clusterWaitTimeout)
 	defer checkCtxCancel()
 
-	return check.Wait(checkCtx, &state, append(check.DefaultClusterChecks(), check.ExtraClusterChecks()...), check.StderrReporter())
+	return check.Wait(checkCtx, &state, append(check.DefaultClusterChecks(), check.ExtraClusterChecks()...), tracker)
 }
 
-func healthOnServer(ctx context.Context, c *client.Client) error {
+func healthOnServer(ctx context.Context, c *client.Client, tracker *healthTracker) error {
 	if err := helpers.FailIfMultiNodes(ctx, "health"); err != nil {
 		return err
 	}
@@ -187,7 +271,11 @@ func healthOnServer(ctx context.Context, c *client.Client) error {
 			return fmt.Errorf("healthcheck error: %s", msg.GetMetadata().GetError())
 		}
 
-		fmt.Fprintln(os.Stderr, msg.GetMessage())
+		tracker.record(msg.GetMessage())
+
+		if healthCmdFlags.output == "text" {
+			fmt.Fprintln(os.Stderr, msg.GetMessage())
+		}
 	}
 }
 
@@ -223,6 +311,9 @@ func init() {
 	healthCmd.Flags().StringVar(&healthCmdFlags.forceEndpoint, "k8s-endpoint", "", "use endpoint instead of kubeconfig default")
 	healthCmd.Flags().BoolVar(&healthCmdFlags.runOnServer, "server", true, "run server-side check")
 	healthCmd.Flags().BoolVar(&healthCmdFlags.runE2E, "run-e2e", false, "run Kubernetes e2e test")
+	healthCmd.Flags().StringVarP(&healthCmdFlags.output, "output", "o", "text",
+		"output format (text|json). json prints the result of each check to stdout. "+
+			"exit code is 0 if the cluster is healthy, 2 if some checks failed, 1 if the health check couldn't run")
 }
 
 func buildClusterInfo(clusterState clusterNodes) (cluster.Info, error) {
@@ -0,0 +1,142 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
+	"github.com/siderolabs/talos/pkg/cluster/check"
+	"github.com/siderolabs/talos/pkg/conditions"
+)
+
+// Health report statuses.
+const (
+	healthStatusHealthy  = "healthy"
+	healthStatusDegraded = "degraded"
+	healthStatusFailed   = "failed"
+
+	healthCheckPassed  = "passed"
+	healthCheckSkipped = "skipped"
+	healthCheckFailed  = "failed"
+)
+
+// healthReport is the machine-readable result of the health check.
+type healthReport struct {
+	// Context is set if the health check runs for each context in the talosconfig (--context all).
+	Context string              `json:"context,omitempty"`
+	Status  string              `json:"status"`
+	Error   string              `json:"error,omitempty"`
+	Checks  []healthCheckResult `json:"checks"`
+}
+
+type healthCheckResult struct {
+	Name    string `json:"name"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// healthTracker records the last state of each check from the progress lines ("waiting for <check>: <state>").
+type healthTracker struct {
+	// reporter, if set, presents the progress
+	reporter check.Reporter
+	checks   []healthCheckResult
+}
+
+// Update implements check.Reporter interface.
+func (t *healthTracker) Update(condition conditions.Condition) {
+	if t.reporter != nil {
+		t.reporter.Update(condition)
+	}
+
+	t.record(fmt.Sprintf("waiting for %s", condition))
+}
+
+func (t *healthTracker) record(line string) {
+	line, ok := strings.CutPrefix(strings.TrimSpace(line), "waiting for ")
+	if !ok {
+		return
+	}
+
+	name, state, ok := strings.Cut(line, ": ")
+	if !ok {
+		return
+	}
+
+	result := healthCheckResult{Name: name}
+
+	switch state {
+	case "...":
+		// still running, reported as failed if the health check doesn't finish
+		result.Status = healthCheckFailed
+	case conditions.OK:
+		result.Status = healthCheckPassed
+	case conditions.ErrSkipAssertion.Error():
+		result.Status = healthCheckSkipped
+	default:
+		result.Status = healthCheckFailed
+		result.Message = state
+	}
+
+	// checks run one after another, so the update either replaces the last check, or starts the next one
+	if len(t.checks) > 0 && t.checks[len(t.checks)-1].Name == name {
+		t.checks[len(t.checks)-1] = result
+
+		return
+	}
+
+	t.checks = append(t.checks, result)
+}
+
+// report builds the health report from the error returned by the health check.
+//
+// The cluster is degraded if the checks were started, but didn't pass; it is failed if the checks couldn't run at all.
+func (t *healthTracker) report(err error) healthReport {
+	report := healthReport{
+		Status: healthStatusHealthy,
+		Checks: t.checks,
+	}
+
+	if report.Checks == nil {
+		report.Checks = []healthCheckResult{}
+	}
+
+	if err == nil {
+		return report
+	}
+
+	report.Error = err.Error()
+
+	if len(t.checks) > 0 {
+		report.Status = healthStatusDegraded
+	} else {
+		report.Status = healthStatusFailed
+	}
+
+	return report
+}
+
+func (r healthReport) write() error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(&r)
+}
+
+// exitError wraps the health check error with the exit code matching the report status.
+func (r healthReport) exitError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if r.Status == healthStatusDegraded {
+		return common.NewExitError(common.ExitCodeDegraded, err)
+	}
+
+	return common.NewExitError(common.ExitCodeFailed, err)
+}
@@ -0,0 +1,93 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos //nolint:testpackage // to test unexported function
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
+)
+
+func TestHealthReport(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name  string
+		lines []string
+		err   error
+
+		expectedReport   healthReport
+		expectedExitCode int
+	}{
+		{
+			name: "healthy",
+			lines: []string{
+				`discovered nodes: ["172.20.0.2"]`,
+				"waiting for etcd to be healthy: ...",
+				"waiting for etcd to be healthy: OK",
+				"waiting for all nodes memory sizes: ...",
+				"waiting for all nodes memory sizes: SKIP",
+			},
+			expectedReport: healthReport{
+				Status: healthStatusHealthy,
+				Checks: []healthCheckResult{
+					{Name: "etcd to be healthy", Status: healthCheckPassed},
+					{Name: "all nodes memory sizes", Status: healthCheckSkipped},
+				},
+			},
+		},
+		{
+			name: "degraded",
+			lines: []string{
+				"waiting for etcd to be healthy: OK",
+				"waiting for kubelet to be healthy: ...",
+				"waiting for kubelet to be healthy: service \"kubelet\" not in expected state \"Running\": current state [Preparing]",
+			},
+			err: context.DeadlineExceeded,
+			expectedReport: healthReport{
+				Status: healthStatusDegraded,
+				Error:  "context deadline exceeded",
+				Checks: []healthCheckResult{
+					{Name: "etcd to be healthy", Status: healthCheckPassed},
+					{
+						Name:    "kubelet to be healthy",
+						Status:  healthCheckFailed,
+						Message: "service \"kubelet\" not in expected state \"Running\": current state [Preparing]",
+					},
+				},
+			},
+			expectedExitCode: common.ExitCodeDegraded,
+		},
+		{
+			name: "failed",
+			err:  errors.New("connection refused"),
+			expectedReport: healthReport{
+				Status: healthStatusFailed,
+				Error:  "connection refused",
+				Checks: []healthCheckResult{},
+			},
+			expectedExitCode: common.ExitCodeFailed,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var tracker healthTracker
+
+			for _, line := range test.lines {
+				tracker.record(line)
+			}
+
+			report := tracker.report(test.err)
+
+			assert.Equal(t, test.expectedReport, report)
+			assert.Equal(t, test.expectedExitCode, common.ExitCode(report.exitError(test.err)))
+		})
+	}
+}
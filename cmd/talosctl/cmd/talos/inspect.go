@@ -11,8 +11,8 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
-	"github.com/siderolabs/talos/pkg/cli"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/formatters"
 )
@@ -49,10 +49,10 @@ to render the graph:
 			resp, err := c.Inspect.ControllerRuntimeDependencies(ctx)
 			if err != nil {
 				if resp == nil {
-					return fmt.Errorf("error getting controller runtime dependencies: %s", err)
+					return fmt.Errorf("error getting controller runtime dependencies: %w", err)
 				}
 
-				cli.Warning("%s", err)
+				common.WarnNodeErrors(err)
 			}
 
 			return formatters.RenderGraph(ctx, c, resp, os.Stdout, inspectDependenciesCmdFlags.withResources)
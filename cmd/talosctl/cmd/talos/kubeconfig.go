@@ -55,7 +55,7 @@ Otherwise kubeconfig will be written to PWD or [local-path] if specified.`,
 				} else {
 					localPath, err = os.Getwd()
 					if err != nil {
-						return fmt.Errorf("error getting current working directory: %s", err)
+						return fmt.Errorf("error getting current working directory: %w", err)
 					}
 				}
 			} else {
@@ -88,7 +88,7 @@ var lsCmd = &cobra.Command{
 				ReportXattrs:   long,
 			})
 			if err != nil {
-				return fmt.Errorf("error fetching logs: %s", err)
+				return fmt.Errorf("error fetching logs: %w", err)
 			}
 
 			if !long {
@@ -7,35 +7,56 @@ package talos
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
+	"path"
+	"slices"
 	"sync"
 
+	"github.com/fatih/color"
 	"github.com/siderolabs/gen/xslices"
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
 
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
 	"github.com/siderolabs/talos/pkg/cli"
-	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	commonapi "github.com/siderolabs/talos/pkg/machinery/api/common"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
 var (
-	follow    bool
-	tailLines int32
+	follow      bool
+	tailLines   int32
+	allServices bool
 )
 
 var logsCmd = &cobra.Command{
 	Use:   "logs <service name>",
 	Short: "Retrieve logs for a service",
-	Long:  ``,
-	Args:  cobra.ExactArgs(1),
+	Long: `Retrieve logs for a service.
+
+With --all-services, the logs of all services are streamed simultaneously, each line prefixed with the service name.
+The arguments are used as glob patterns to select the services in that case.`,
+	Example: `talosctl logs -f --all-services
+talosctl logs -f --all-services 'kube*' etcd`,
+	Args: func(cmd *cobra.Command, args []string) error {
+		if allServices {
+			return nil
+		}
+
+		return cobra.ExactArgs(1)(cmd, args)
+	},
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+		if allServices {
+			return getLogsContainers(), cobra.ShellCompDirectiveNoFileComp
+		}
+
 		if len(args) != 0 {
 			return nil, cobra.ShellCompDirectiveError | cobra.ShellCompDirectiveNoFileComp
 		}
@@ -47,23 +68,33 @@ var logsCmd = &cobra.Command{
 		return mergeSuggestions(getServiceFromNode(), getContainersFromNode(kubernetesFlag), getLogsContainers()), cobra.ShellCompDirectiveNoFileComp
 	},
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if allServices {
+			if kubernetesFlag {
+				return errors.New("--all-services can't be used with --kubernetes")
+			}
+
+			return WithClient(func(ctx context.Context, c *client.Client) error {
+				return logsAllServices(ctx, c, args)
+			})
+		}
+
 		return WithClient(func(ctx context.Context, c *client.Client) error {
 			var (
 				namespace string
-				driver    common.ContainerDriver
+				driver    commonapi.ContainerDriver
 			)
 
 			if kubernetesFlag {
 				namespace = constants.K8sContainerdNamespace
-				driver = common.ContainerDriver_CRI
+				driver = commonapi.ContainerDriver_CRI
 			} else {
 				namespace = constants.SystemContainerdNamespace
-				driver = common.ContainerDriver_CONTAINERD
+				driver = commonapi.ContainerDriver_CONTAINERD
 			}
 
 			stream, err := c.Logs(ctx, namespace, driver, args[0], follow, tailLines)
 			if err != nil {
-				return fmt.Errorf("error fetching logs: %s", err)
+				return fmt.Errorf("error fetching logs: %w", err)
 			}
 
 			defaultNode := client.RemotePeer(stream.Context())
@@ -96,7 +127,7 @@ var logsCmd = &cobra.Command{
 			}
 
 			if err = <-errCh; err != nil {
-				return fmt.Errorf("error getting logs: %v", err)
+				return fmt.Errorf("error getting logs: %w", err)
 			}
 
 			if gotErrors {
@@ -108,18 +139,172 @@ var logsCmd = &cobra.Command{
 	},
 }
 
+// serviceColors is the palette to tell apart the services in the output of `logs --all-services`.
+var serviceColors = []*color.Color{
+	color.New(color.FgCyan),
+	color.New(color.FgGreen),
+	color.New(color.FgYellow),
+	color.New(color.FgBlue),
+	color.New(color.FgMagenta),
+	color.New(color.FgHiCyan),
+	color.New(color.FgHiGreen),
+	color.New(color.FgHiYellow),
+	color.New(color.FgHiBlue),
+	color.New(color.FgHiMagenta),
+}
+
+// serviceLine is a line of logs of a service.
+type serviceLine struct {
+	service string
+	data    *commonapi.Data
+	err     error
+}
+
+// logsAllServices streams the logs of all services matching the patterns (all services if no patterns are given).
+//
+//nolint:gocyclo
+func logsAllServices(ctx context.Context, c *client.Client, patterns []string) error {
+	resp, err := c.LogsContainers(ctx)
+	if err != nil {
+		if resp == nil {
+			return fmt.Errorf("error listing services: %w", err)
+		}
+
+		common.WarnNodeErrors(err)
+	}
+
+	services, err := matchServices(xslices.FlatMap(resp.GetMessages(), func(lc *machine.LogsContainer) []string { return lc.Ids }), patterns)
+	if err != nil {
+		return err
+	}
+
+	if len(services) == 0 {
+		return errors.New("no services matching the patterns")
+	}
+
+	width := len(slices.MaxFunc(services, func(a, b string) int { return len(a) - len(b) }))
+	linesCh := make(chan serviceLine)
+
+	var (
+		wg          sync.WaitGroup
+		defaultNode string
+		gotErrors   bool
+	)
+
+	for _, service := range services {
+		stream, err := c.Logs(ctx, constants.SystemContainerdNamespace, commonapi.ContainerDriver_CONTAINERD, service, follow, tailLines)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "ERROR: error fetching logs of %q: %s\n", service, err)
+
+			gotErrors = true
+
+			continue
+		}
+
+		if defaultNode == "" {
+			defaultNode = client.RemotePeer(stream.Context())
+		}
+
+		respCh, errCh := newLineSlicer(stream)
+
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for data := range respCh {
+				linesCh <- serviceLine{service: service, data: data}
+			}
+
+			if err := <-errCh; err != nil {
+				linesCh <- serviceLine{service: service, err: err}
+			}
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(linesCh)
+	}()
+
+	for line := range linesCh {
+		if line.err != nil {
+			fmt.Fprintf(os.Stderr, "ERROR: error getting logs of %q: %v\n", line.service, line.err)
+
+			gotErrors = true
+
+			continue
+		}
+
+		if line.data.Metadata != nil && line.data.Metadata.Error != "" {
+			fmt.Fprintf(os.Stderr, "ERROR: %s: %s\n", line.service, line.data.Metadata.Error)
+
+			gotErrors = true
+
+			continue
+		}
+
+		node := defaultNode
+		if line.data.Metadata != nil && line.data.Metadata.Hostname != "" {
+			node = line.data.Metadata.Hostname
+		}
+
+		prefix := serviceColors[slices.Index(services, line.service)%len(serviceColors)].Sprintf("%-*s", width, line.service)
+
+		if _, err = fmt.Printf("%s: %s | %s\n", node, prefix, line.data.Bytes); err != nil {
+			return err
+		}
+	}
+
+	if gotErrors {
+		os.Exit(1)
+	}
+
+	return nil
+}
+
+// matchServices returns the sorted unique services matching any of the glob patterns, or all services if there are no patterns.
+func matchServices(services, patterns []string) ([]string, error) {
+	var matched []string
+
+	for _, service := range services {
+		ok := len(patterns) == 0
+
+		for _, pattern := range patterns {
+			match, err := path.Match(pattern, service)
+			if err != nil {
+				return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+			}
+
+			if match {
+				ok = true
+
+				break
+			}
+		}
+
+		if ok {
+			matched = append(matched, service)
+		}
+	}
+
+	slices.Sort(matched)
+
+	return slices.Compact(matched), nil
+}
+
 // lineSlicer splits random chunks of bytes coming from nodes into a stream
 // of lines aggregated per node.
 type lineSlicer struct {
-	respCh chan *common.Data
+	respCh chan *commonapi.Data
 	errCh  chan error
 	pipes  map[string]*io.PipeWriter
 	wg     sync.WaitGroup
 }
 
-func newLineSlicer(stream machine.MachineService_LogsClient) (chan *common.Data, chan error) {
+func newLineSlicer(stream machine.MachineService_LogsClient) (chan *commonapi.Data, chan error) {
 	slicer := &lineSlicer{
-		respCh: make(chan *common.Data),
+		respCh: make(chan *commonapi.Data),
 		errCh:  make(chan error, 1),
 		pipes:  map[string]*io.PipeWriter{},
 	}
@@ -138,8 +323,8 @@ func (slicer *lineSlicer) chopper(r io.Reader, hostname string) {
 		line := scanner.Bytes()
 		line = xslices.CopyN(line, len(line))
 
-		slicer.respCh <- &common.Data{
-			Metadata: &common.Metadata{
+		slicer.respCh <- &commonapi.Data{
+			Metadata: &commonapi.Metadata{
 				Hostname: hostname,
 			},
 			Bytes: line,
@@ -232,6 +417,7 @@ func init() {
 	logsCmd.Flags().BoolVarP(&kubernetesFlag, "kubernetes", "k", false, "use the k8s.io containerd namespace")
 	logsCmd.Flags().BoolVarP(&follow, "follow", "f", false, "specify if the logs should be streamed")
 	logsCmd.Flags().Int32VarP(&tailLines, "tail", "", -1, "lines of log file to display (default is to show from the beginning)")
+	logsCmd.Flags().BoolVar(&allServices, "all-services", false, "retrieve logs for all services (or the services matching the glob patterns passed as arguments)")
 
 	logsCmd.Flags().BoolP("use-cri", "c", false, "use the CRI driver")
 	logsCmd.Flags().MarkHidden("use-cri") //nolint:errcheck
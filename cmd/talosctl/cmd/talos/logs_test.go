@@ -0,0 +1,33 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos //nolint:testpackage // to test unexported function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchServices(t *testing.T) {
+	t.Parallel()
+
+	services := []string{"kubelet", "etcd", "apid", "kubelet", "machined", "etcd"}
+
+	matched, err := matchServices(services, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"apid", "etcd", "kubelet", "machined"}, matched)
+
+	matched, err = matchServices(services, []string{"kube*", "etcd"})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"etcd", "kubelet"}, matched)
+
+	matched, err = matchServices(services, []string{"cri"})
+	require.NoError(t, err)
+	assert.Empty(t, matched)
+
+	_, err = matchServices(services, []string{"["})
+	assert.Error(t, err)
+}
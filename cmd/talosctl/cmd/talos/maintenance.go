@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
+)
+
+var maintenanceCmdFlags struct {
+	reason string
+}
+
+var maintenanceCmd = &cobra.Command{
+	Use:   "maintenance",
+	Short: "Put the node into maintenance or take it out of maintenance",
+	Long: `Put the node into maintenance or take it out of maintenance.
+
+While the node is in maintenance:
+  - the Kubernetes node is cordoned,
+  - the extension services are stopped,
+  - the node doesn't reboot or shut down on its own: scheduled reboots and shutdowns are refused (or canceled when they are due),
+    the post-upgrade rollback is held off, and the configuration changes which require a reboot are staged,
+  - the node is marked in the cluster discovery members.
+
+Explicit reboots, shutdowns and upgrades via the API are still allowed.
+
+The maintenance flag is stored in the META partition, so it persists across reboots until the node exits the maintenance.
+The flag and the reason are reported in the MachineStatus resource and events.`,
+	Args: cobra.NoArgs,
+}
+
+var maintenanceEnterCmd = &cobra.Command{
+	Use:     "enter",
+	Short:   "Put the node into maintenance",
+	Long:    ``,
+	Example: `  talosctl -n 172.20.0.5 maintenance enter --reason "replacing disks"`,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			return c.MetaWrite(ctx, meta.NodeMaintenance, []byte(maintenanceCmdFlags.reason))
+		})
+	},
+}
+
+var maintenanceExitCmd = &cobra.Command{
+	Use:   "exit",
+	Short: "Take the node out of maintenance",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			err := c.MetaDelete(ctx, meta.NodeMaintenance)
+			if status.Code(err) == codes.NotFound {
+				// the node is not in maintenance
+				return nil
+			}
+
+			return err
+		})
+	},
+}
+
+func init() {
+	maintenanceEnterCmd.Flags().StringVar(&maintenanceCmdFlags.reason, "reason", "", "the reason for the maintenance, reported in the machine status")
+
+	maintenanceCmd.AddCommand(maintenanceEnterCmd, maintenanceExitCmd)
+	addCommand(maintenanceCmd)
+}
@@ -14,8 +14,8 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
-	"github.com/siderolabs/talos/pkg/cli"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 )
@@ -36,10 +36,10 @@ var memoryCmd = &cobra.Command{
 			resp, err := c.Memory(ctx, grpc.Peer(&remotePeer))
 			if err != nil {
 				if resp == nil {
-					return fmt.Errorf("error getting memory stats: %s", err)
+					return fmt.Errorf("error getting memory stats: %w", err)
 				}
 
-				cli.Warning("%s", err)
+				common.WarnNodeErrors(err)
 			}
 
 			if verbose {
@@ -7,17 +7,22 @@ package talos
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 
-	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/formatters"
 )
 
+var mountsCmdFlags struct {
+	watch bool
+}
+
 // mountsCmd represents the mounts command.
 var mountsCmd = &cobra.Command{
 	Use:     "mounts",
@@ -27,22 +32,34 @@ var mountsCmd = &cobra.Command{
 	Args:    cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(func(ctx context.Context, c *client.Client) error {
-			var remotePeer peer.Peer
-
-			resp, err := c.Mounts(ctx, grpc.Peer(&remotePeer))
-			if err != nil {
-				if resp == nil {
-					return fmt.Errorf("error getting mount information: %s", err)
-				}
-
-				cli.Warning("%s", err)
+			if mountsCmdFlags.watch {
+				return watchPoll(ctx, func(ctx context.Context, w io.Writer) error {
+					return mountList(ctx, c, w)
+				})
 			}
 
-			return formatters.RenderMounts(resp, os.Stdout, &remotePeer)
+			return mountList(ctx, c, os.Stdout)
 		})
 	},
 }
 
+func mountList(ctx context.Context, c *client.Client, out io.Writer) error {
+	var remotePeer peer.Peer
+
+	resp, err := c.Mounts(ctx, grpc.Peer(&remotePeer))
+	if err != nil {
+		if resp == nil {
+			return fmt.Errorf("error getting mount information: %w", err)
+		}
+
+		common.WarnNodeErrors(err)
+	}
+
+	return formatters.RenderMounts(resp, out, &remotePeer)
+}
+
 func init() {
+	mountsCmd.Flags().BoolVarP(&mountsCmdFlags.watch, "watch", "w", false, "watch the mount list, re-rendering it on changes")
+
 	addCommand(mountsCmd)
 }
@@ -0,0 +1,63 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+// neighborsCmd represents the neighbors command.
+var neighborsCmd = &cobra.Command{
+	Use:     "neighbors",
+	Aliases: []string{"arp", "neigh"},
+	Short:   "Show the kernel neighbor table (ARP and NDP entries)",
+	Long: `Show the kernel neighbor table (ARP and NDP entries).
+
+The table shows which hardware address each IP address resolves to on the node,
+which helps debugging duplicate IP addresses and Virtual IP failover.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			resp, err := c.Neighbors(ctx)
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error listing neighbors: %w", err)
+				}
+
+				common.WarnNodeErrors(err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tLINK\tIP\tHWADDR\tSTATE\tROUTER")
+
+			for _, msg := range resp.GetMessages() {
+				node := metadataNode(msg.GetMetadata())
+
+				for _, neighbor := range msg.GetNeighbors() {
+					hwAddr := neighbor.GetHardwareAddr()
+					if hwAddr == "" {
+						hwAddr = "-"
+					}
+
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%t\n", node, neighbor.GetLink(), neighbor.GetIp(), hwAddr, neighbor.GetState(), neighbor.GetRouter())
+				}
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+func init() {
+	addCommand(neighborsCmd)
+}
@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var netcheckCmd = &cobra.Command{
+	Use:   "netcheck",
+	Short: "Run network diagnostics from the node",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+}
+
+var netcheckMTUCmdFlags struct {
+	timeout time.Duration
+}
+
+var netcheckMTUCmd = &cobra.Command{
+	Use:   "mtu <destination>",
+	Short: "Discover the path MTU from the node to the destination",
+	Long: `Discover the path MTU from the node to the destination.
+
+The node sends ICMP echo requests with the Don't Fragment bit set, and binary-searches
+the largest packet size which reaches the destination.
+A path MTU lower than the route MTU means that the packets of the route MTU size are dropped along the path,
+which is a common issue with encapsulation (VXLAN, WireGuard, IPsec) in the underlay network.`,
+	Example: `talosctl -n 172.20.0.2 netcheck mtu 172.20.0.3`,
+	Args:    cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			resp, err := c.PathMTU(ctx, &machine.PathMTURequest{
+				Destination: args[0],
+				Timeout:     durationpb.New(netcheckMTUCmdFlags.timeout),
+			})
+			if err != nil {
+				if resp == nil {
+					return fmt.Errorf("error discovering path MTU: %w", err)
+				}
+
+				common.WarnNodeErrors(err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tDESTINATION\tROUTE MTU\tPATH MTU\tPROBES")
+
+			for _, msg := range resp.GetMessages() {
+				fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\n", metadataNode(msg.GetMetadata()), msg.GetDestination(), msg.GetRouteMtu(), msg.GetPathMtu(), msg.GetProbes())
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+func init() {
+	netcheckMTUCmd.Flags().DurationVar(&netcheckMTUCmdFlags.timeout, "timeout", time.Second, "timeout of each probe")
+
+	netcheckCmd.AddCommand(netcheckMTUCmd)
+	addCommand(netcheckCmd)
+}
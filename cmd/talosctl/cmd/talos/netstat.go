@@ -14,9 +14,11 @@ import (
 	"text/tabwriter"
 
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
 	"github.com/siderolabs/talos/pkg/cli"
-	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	commonapi "github.com/siderolabs/talos/pkg/machinery/api/common"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -36,6 +38,7 @@ var netstatCmdFlags struct {
 	raw       bool
 	ipv4      bool
 	ipv6      bool
+	json      bool
 }
 
 type netstat struct {
@@ -53,7 +56,9 @@ var netstatCmd = &cobra.Command{
 You can pass an optional argument to view a specific pod's connections.
 To do this, format the argument as "namespace/pod".
 Note that only pods with a pod network namespace are allowed.
-If you don't pass an argument, the command will show host connections.`,
+If you don't pass an argument, the command will show host connections.
+
+Use --json to get the sockets as structured data.`,
 	Args: cobra.MaximumNArgs(1),
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		if len(args) > 0 {
@@ -130,7 +135,20 @@ If you don't pass an argument, the command will show host connections.`,
 					return err
 				}
 
-				cli.Warning("%s", err)
+				common.WarnNodeErrors(err)
+			}
+
+			if netstatCmdFlags.json {
+				for _, msg := range response.GetMessages() {
+					b, err := protojson.Marshal(msg)
+					if err != nil {
+						return err
+					}
+
+					fmt.Printf("%s\n", b)
+				}
+
+				return nil
 			}
 
 			err = n.printNetstat(response)
@@ -207,7 +225,7 @@ func netstatFlagsToRequest() *machine.NetstatRequest {
 }
 
 func (n *netstat) getPodNetNsFromNode(ctx context.Context) (err error) {
-	resp, err := n.client.Containers(ctx, constants.K8sContainerdNamespace, common.ContainerDriver_CRI)
+	resp, err := n.client.Containers(ctx, constants.K8sContainerdNamespace, commonapi.ContainerDriver_CRI)
 	if err != nil {
 		cli.Warning("error getting containers: %v", err)
 
@@ -407,6 +425,7 @@ func init() {
 	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.raw, "raw", "w", false, "display only RAW sockets")
 	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.ipv4, "ipv4", "4", false, "display only ipv4 sockets")
 	netstatCmd.Flags().BoolVarP(&netstatCmdFlags.ipv6, "ipv6", "6", false, "display only ipv6 sockets")
+	netstatCmd.Flags().BoolVar(&netstatCmdFlags.json, "json", false, "output the sockets as JSON")
 
 	addCommand(netstatCmd)
 }
@@ -25,6 +25,10 @@ type Table struct {
 	withEvents     bool
 	displayType    string
 	dynamicColumns []dynamicColumn
+
+	withContext   bool
+	context       string
+	headerWritten bool
 }
 
 type dynamicColumn func(value any) (string, error)
@@ -37,8 +41,21 @@ func NewTable(writer io.Writer) *Table {
 	return output
 }
 
+// SetContext adds the CONTEXT column to the table, the following resources are written with the context name.
+//
+// The resources of all contexts are written into the same table, so the header is written only once.
+func (table *Table) SetContext(name string) {
+	table.withContext = true
+	table.context = name
+}
+
 // WriteHeader implements output.Writer interface.
 func (table *Table) WriteHeader(definition *meta.ResourceDefinition, withEvents bool) error {
+	if table.withContext && table.headerWritten {
+		return nil
+	}
+
+	table.headerWritten = true
 	table.withEvents = withEvents
 	fields := []string{"NAMESPACE", "TYPE", "ID", "VERSION"}
 
@@ -73,6 +90,10 @@ func (table *Table) WriteHeader(definition *meta.ResourceDefinition, withEvents
 
 	fields = slices.Insert(fields, 0, "NODE")
 
+	if table.withContext {
+		fields = slices.Insert(fields, 0, "CONTEXT")
+	}
+
 	_, err := fmt.Fprintln(&table.w, strings.Join(fields, "\t"))
 
 	return err
@@ -123,6 +144,10 @@ func (table *Table) WriteResource(node string, r resource.Resource, event state.
 
 	values = slices.Insert(values, 0, node)
 
+	if table.withContext {
+		values = slices.Insert(values, 0, table.context)
+	}
+
 	_, err = fmt.Fprintln(&table.w, strings.Join(values, "\t"))
 
 	return err
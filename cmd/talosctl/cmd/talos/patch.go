@@ -114,7 +114,7 @@ var patchCmd = &cobra.Command{
 
 			for _, node := range GlobalArgs.Nodes {
 				nodeCtx := client.WithNodes(ctx, node)
-				if err := helpers.ForEachResource(nodeCtx, c, nil, patchFn(c, patches), patchCmdFlags.namespace, args...); err != nil {
+				if err := helpers.ForEachResource(nodeCtx, c, nil, patchFn(c, patches), client.FanOutOptions{}, patchCmdFlags.namespace, args...); err != nil {
 					return err
 				}
 			}
@@ -0,0 +1,111 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+// powerActionCmdFlags are the flags shared by the reboot and shutdown commands.
+type powerActionCmdFlags struct {
+	force   bool
+	at      string
+	message string
+	cancel  bool
+}
+
+func (f *powerActionCmdFlags) addPowerActionFlags(cmd *cobra.Command, forceUsage string) {
+	cmd.Flags().BoolVar(&f.force, "force", false, forceUsage)
+	cmd.Flags().StringVar(&f.at, "at", "", "schedule the operation at the specified time: duration (\"10m\"), time of the day (\"HH:MM\") or RFC3339 timestamp")
+	cmd.Flags().StringVar(&f.message, "message", "", "message to broadcast to the event stream with the operation")
+	cmd.Flags().BoolVar(&f.cancel, "cancel", false, "cancel the scheduled reboot or shutdown")
+}
+
+// scheduledAt returns the time the operation is scheduled at, or zero time if the operation should run immediately.
+func (f *powerActionCmdFlags) scheduledAt() (time.Time, error) {
+	if f.at == "" {
+		return time.Time{}, nil
+	}
+
+	return helpers.ParseScheduleTime(f.at, time.Now())
+}
+
+func powerActionCancel() error {
+	return WithClient(func(ctx context.Context, c *client.Client) error {
+		resp, err := c.PowerActionCancel(ctx)
+		if err != nil {
+			return fmt.Errorf("error canceling scheduled operation: %w", err)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+		fmt.Fprintln(w, "NODE\tCANCELED\tSCHEDULED AT")
+
+		for _, msg := range resp.GetMessages() {
+			fmt.Fprintf(w, "%s\t%s\t%s\n", metadataNode(msg.GetMetadata()), strings.ToLower(msg.GetAction().String()), formatScheduledAt(msg.GetScheduledAt()))
+		}
+
+		return w.Flush()
+	})
+}
+
+type scheduledPowerActionMessage interface {
+	GetMetadata() *common.Metadata
+	GetScheduledAt() *timestamppb.Timestamp
+}
+
+func printScheduledPowerAction[T scheduledPowerActionMessage](action string, messages []T) error {
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+	fmt.Fprintln(w, "NODE\tSCHEDULED\tAT")
+
+	for _, msg := range messages {
+		fmt.Fprintf(w, "%s\t%s\t%s\n", metadataNode(msg.GetMetadata()), action, formatScheduledAt(msg.GetScheduledAt()))
+	}
+
+	return w.Flush()
+}
+
+// metadataNode returns the node hostname from the response metadata.
+func metadataNode(md *common.Metadata) string {
+	if md.GetHostname() == "" {
+		return "-"
+	}
+
+	return md.GetHostname()
+}
+
+func formatScheduledAt(at *timestamppb.Timestamp) string {
+	if at == nil {
+		return "now"
+	}
+
+	return at.AsTime().Local().Format(time.RFC3339)
+}
+
+func powerActionEventDetails(event *machine.PowerActionEvent) string {
+	details := []string{formatScheduledAt(event.GetAt())}
+
+	if event.GetForce() {
+		details = append(details, "forced")
+	}
+
+	if event.GetMessage() != "" {
+		details = append(details, fmt.Sprintf("%q", event.GetMessage()))
+	}
+
+	return strings.Join(details, ", ")
+}
@@ -6,7 +6,9 @@ package talos
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"path/filepath"
 	"sort"
 	"strings"
@@ -19,10 +21,12 @@ import (
 	"github.com/spf13/cobra"
 	"golang.org/x/term"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
 
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
 	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 )
@@ -178,23 +182,122 @@ var cpu = func(p1, p2 *machineapi.ProcessInfo) bool {
 	return p1.CpuTime > p2.CpuTime
 }
 
+// listProcesses collects the process lists of the nodes streamed in batches.
+//
+// Nodes which don't support the ProcessesStream API are queried with the Processes API.
+//
 //nolint:gocyclo
-func processesOutput(ctx context.Context, c *client.Client) (output string, err error) {
+func listProcesses(ctx context.Context, c *client.Client) ([]*machineapi.Process, error) {
+	rcv, err := c.ProcessesStream(ctx, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		processes     []*machineapi.Process
+		nodeIndex     = map[string]int{}
+		unimplemented []string
+		errs          error
+	)
+
+	defaultNode := client.RemotePeer(rcv.Context())
+
+	for {
+		msg, err := rcv.Recv()
+		if err != nil {
+			if err == io.EOF || client.StatusCode(err) == codes.Canceled {
+				break
+			}
+
+			if client.StatusCode(err) == codes.Unimplemented && len(processes) == 0 && len(unimplemented) == 0 {
+				// the node (or the apid proxying the request) doesn't support the streaming API at all
+				return listProcessesUnary(ctx, c)
+			}
+
+			return processes, helpers.AppendErrors(errs, fmt.Errorf("error streaming results: %w", err))
+		}
+
+		node := defaultNode
+
+		if md := msg.GetMetadata(); md != nil {
+			if md.Hostname != "" {
+				node = md.Hostname
+			}
+
+			if md.Error != "" {
+				if codes.Code(md.GetStatus().GetCode()) == codes.Unimplemented {
+					// the node is running an older version, retry it with the unary API
+					unimplemented = append(unimplemented, node)
+				} else {
+					errs = helpers.AppendErrors(errs, errors.New(md.Error))
+				}
+
+				continue
+			}
+		}
+
+		idx, ok := nodeIndex[node]
+		if !ok {
+			idx = len(processes)
+			nodeIndex[node] = idx
+
+			processes = append(processes, &machineapi.Process{
+				Metadata: &common.Metadata{Hostname: node},
+			})
+		}
+
+		processes[idx].Processes = append(processes[idx].Processes, msg.Processes...)
+		processes[idx].Vanished += msg.Vanished
+	}
+
+	if len(unimplemented) > 0 {
+		unaryProcesses, err := listProcessesUnary(client.WithNodes(ctx, unimplemented...), c)
+		if err != nil {
+			errs = helpers.AppendErrors(errs, err)
+		}
+
+		processes = append(processes, unaryProcesses...)
+	}
+
+	return processes, errs
+}
+
+// listProcessesUnary collects the process lists of the nodes with the Processes API.
+func listProcessesUnary(ctx context.Context, c *client.Client) ([]*machineapi.Process, error) {
 	var remotePeer peer.Peer
 
 	resp, err := c.Processes(ctx, grpc.Peer(&remotePeer))
 	if err != nil {
-		return output, err
+		return nil, err
+	}
+
+	for _, msg := range resp.Messages {
+		if msg.Metadata == nil {
+			msg.Metadata = &common.Metadata{Hostname: client.AddrFromPeer(&remotePeer)}
+		}
 	}
 
-	defaultNode := client.AddrFromPeer(&remotePeer)
+	return resp.Messages, helpers.CheckErrors(resp.Messages...)
+}
+
+//nolint:gocyclo
+func processesOutput(ctx context.Context, c *client.Client) (output string, err error) {
+	processes, err := listProcesses(ctx, c)
+	if err != nil && processes == nil {
+		return output, err
+	}
 
-	var s []string
+	var s, notes []string
 
 	s = append(s, "NODE | PID | STATE | THREADS | CPU-TIME | VIRTMEM | RESMEM | LABEL | COMMAND")
 
-	for _, msg := range resp.Messages {
+	for _, msg := range processes {
 		procs := msg.Processes
+		node := msg.Metadata.Hostname
+
+		if msg.Vanished > 0 {
+			notes = append(notes, fmt.Sprintf("%s: %d processes exited while the list was collected", node, msg.Vanished))
+		}
 
 		switch sortMethod {
 		case "cpu":
@@ -224,12 +327,6 @@ func processesOutput(ctx context.Context, c *client.Client) (output string, err
 				return r
 			}, args)
 
-			node := defaultNode
-
-			if msg.Metadata != nil {
-				node = msg.Metadata.Hostname
-			}
-
 			s = append(s,
 				fmt.Sprintf("%12s | %6d | %1s | %4d | %8.2f | %7s | %7s | %64s | %s",
 					node, p.Pid, p.State, p.Threads, p.CpuTime, humanize.Bytes(p.VirtualMemory), humanize.Bytes(p.ResidentMemory), p.Label, args))
@@ -237,9 +334,10 @@ func processesOutput(ctx context.Context, c *client.Client) (output string, err
 	}
 
 	res := columnize.SimpleFormat(s)
-	if err != nil {
-		return res, err
+
+	if len(notes) > 0 {
+		res += "\n\n" + strings.Join(notes, "\n")
 	}
 
-	return res, helpers.CheckErrors(resp.Messages...)
+	return res, err
 }
@@ -18,6 +18,7 @@ import (
 
 var rebootCmdFlags struct {
 	trackableActionCmdFlags
+	powerActionCmdFlags
 	mode string
 }
 
@@ -28,11 +29,23 @@ var rebootCmd = &cobra.Command{
 	Long:  ``,
 	Args:  cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if rebootCmdFlags.cancel {
+			return powerActionCancel()
+		}
+
 		if rebootCmdFlags.debug {
 			rebootCmdFlags.wait = true
 		}
 
-		var opts []client.RebootMode
+		at, err := rebootCmdFlags.scheduledAt()
+		if err != nil {
+			return err
+		}
+
+		opts := []client.RebootMode{
+			client.WithRebootForce(rebootCmdFlags.force),
+			client.WithRebootMessage(rebootCmdFlags.message),
+		}
 
 		switch rebootCmdFlags.mode {
 		// skips kexec and reboots with power cycle
@@ -43,6 +56,19 @@ var rebootCmd = &cobra.Command{
 			return fmt.Errorf("invalid reboot mode: %q", rebootCmdFlags.mode)
 		}
 
+		if !at.IsZero() {
+			opts = append(opts, client.WithRebootAt(at))
+
+			return WithClient(func(ctx context.Context, c *client.Client) error {
+				resp, err := c.RebootWithResponse(ctx, opts...)
+				if err != nil {
+					return fmt.Errorf("error scheduling reboot: %w", err)
+				}
+
+				return printScheduledPowerAction("reboot", resp.GetMessages())
+			})
+		}
+
 		if !rebootCmdFlags.wait {
 			return WithClient(func(ctx context.Context, c *client.Client) error {
 				if err := helpers.ClientVersionCheck(ctx, c); err != nil {
@@ -50,7 +76,7 @@ var rebootCmd = &cobra.Command{
 				}
 
 				if err := c.Reboot(ctx, opts...); err != nil {
-					return fmt.Errorf("error executing reboot: %s", err)
+					return fmt.Errorf("error executing reboot: %w", err)
 				}
 
 				return nil
@@ -85,6 +111,7 @@ func rebootGetActorID(opts ...client.RebootMode) func(ctx context.Context, c *cl
 
 func init() {
 	rebootCmd.Flags().StringVarP(&rebootCmdFlags.mode, "mode", "m", "default", "select the reboot mode: \"default\", \"powercycle\" (skips kexec)")
+	rebootCmdFlags.addPowerActionFlags(rebootCmd, "if true, reboot the node without gracefully stopping the pods")
 	rebootCmdFlags.addTrackActionFlags(rebootCmd)
 	addCommand(rebootCmd)
 }
@@ -102,7 +102,7 @@ var resetCmd = &cobra.Command{
 				}
 
 				if err := c.ResetGeneric(ctx, resetRequest); err != nil {
-					return fmt.Errorf("error executing reset: %s", err)
+					return fmt.Errorf("error executing reset: %w", err)
 				}
 
 				return nil
@@ -44,7 +44,7 @@ var restartCmd = &cobra.Command{
 			}
 
 			if err := c.Restart(ctx, namespace, driver, args[0]); err != nil {
-				return fmt.Errorf("error restarting process: %s", err)
+				return fmt.Errorf("error restarting process: %w", err)
 			}
 
 			return nil
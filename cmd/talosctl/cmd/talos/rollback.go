@@ -21,7 +21,7 @@ var rollbackCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return WithClient(func(ctx context.Context, c *client.Client) error {
 			if err := c.Rollback(ctx); err != nil {
-				return fmt.Errorf("error executing rollback: %s", err)
+				return fmt.Errorf("error executing rollback: %w", err)
 			}
 
 			return nil
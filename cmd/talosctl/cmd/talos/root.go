@@ -17,9 +17,10 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
 
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/global"
 	_ "github.com/siderolabs/talos/pkg/grpc/codec" // register codec
-	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	commonapi "github.com/siderolabs/talos/pkg/machinery/api/common"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -50,6 +51,39 @@ func WithClientMaintenance(enforceFingerprints []string, action func(context.Con
 	return GlobalArgs.WithClientMaintenance(enforceFingerprints, action)
 }
 
+// withAllContexts runs the action for each context in the talosconfig with GlobalArgs set to the context.
+//
+// The errors of some of the contexts are printed as warnings (talosctl exits with common.ExitCodePartial),
+// the command fails only if it failed for all contexts.
+func withAllContexts(action func(contextName string) error) error {
+	savedArgs := GlobalArgs
+
+	defer func() {
+		GlobalArgs = savedArgs
+	}()
+
+	var succeeded bool
+
+	err := savedArgs.ForEachContext(func(contextName string, args *global.Args) error {
+		GlobalArgs = *args
+
+		if err := action(contextName); err != nil {
+			return err
+		}
+
+		succeeded = true
+
+		return nil
+	})
+	if err != nil && succeeded {
+		common.WarnNodeErrors(err)
+
+		return nil
+	}
+
+	return err
+}
+
 // Commands is a list of commands published by the package.
 var Commands []*cobra.Command
 
@@ -106,7 +140,7 @@ func getPathFromNode(path, filter string) map[string]struct{} {
 						return nil
 					}
 
-					return fmt.Errorf("error streaming results: %s", err)
+					return fmt.Errorf("error streaming results: %w", err)
 				}
 
 				if resp.Metadata != nil && resp.Metadata.Error != "" {
@@ -183,15 +217,15 @@ func getContainersFromNode(kubernetes bool) []string {
 		func(ctx context.Context, c *client.Client) error {
 			var (
 				namespace string
-				driver    common.ContainerDriver
+				driver    commonapi.ContainerDriver
 			)
 
 			if kubernetes {
 				namespace = constants.K8sContainerdNamespace
-				driver = common.ContainerDriver_CRI
+				driver = commonapi.ContainerDriver_CRI
 			} else {
 				namespace = constants.SystemContainerdNamespace
-				driver = common.ContainerDriver_CONTAINERD
+				driver = commonapi.ContainerDriver_CONTAINERD
 			}
 
 			resp, err := c.Containers(ctx, namespace, driver)
@@ -0,0 +1,90 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+var sbomCmdFlags struct {
+	spdx bool
+}
+
+// sbomCmd represents the sbom command.
+var sbomCmd = &cobra.Command{
+	Use:   "sbom",
+	Short: "List the software packages running on the node",
+	Long: `List the software packages running on the node.
+
+The list is built from the SPDX SBOM documents shipped in the Talos rootfs and system extensions.
+The Talos rootfs SBOM is partial: it lists the Go modules of the Go binaries in the rootfs, while the other components
+(e.g. the C libraries and tools) are only listed as the version of siderolabs/pkgs and of the Linux kernel they come with.
+With --spdx flag, the Talos rootfs SPDX document is printed as is, so that it can be fed into vulnerability scanners.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			nodes := client.NodesFromContext(ctx)
+
+			if sbomCmdFlags.spdx {
+				if len(nodes) > 1 {
+					return fmt.Errorf("--spdx flag is only supported with a single node")
+				}
+
+				return printSPDX(ctx, c)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tNAME\tVERSION\tLICENSE\tEXTENSION")
+
+			results := client.FanOut(ctx, nodes, GlobalArgs.FanOutOptions(), func(nodeCtx context.Context, _ string) (safe.List[*runtime.SBOMItem], error) {
+				return safe.StateListAll[*runtime.SBOMItem](nodeCtx, c.COSI)
+			})
+
+			for _, result := range results {
+				if result.Err != nil {
+					return fmt.Errorf("error listing SBOM items on node %q: %w", result.Node, result.Err)
+				}
+
+				for it := result.Value.Iterator(); it.Next(); {
+					spec := it.Value().TypedSpec()
+
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%v\n", result.Node, spec.Name, spec.Version, spec.License, spec.Extension)
+				}
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+func printSPDX(ctx context.Context, c *client.Client) error {
+	r, err := c.Read(ctx, filepath.Join(constants.SPDXPath, "talos.spdx.json"))
+	if err != nil {
+		return fmt.Errorf("error reading SPDX document: %w", err)
+	}
+
+	defer r.Close() //nolint:errcheck
+
+	_, err = io.Copy(os.Stdout, r)
+
+	return err
+}
+
+func init() {
+	sbomCmd.Flags().BoolVar(&sbomCmdFlags.spdx, "spdx", false, "print the SPDX SBOM document of the Talos rootfs")
+	addCommand(sbomCmd)
+}
@@ -0,0 +1,230 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// securityCmd represents the security command.
+var securityCmd = &cobra.Command{
+	Use:   "security",
+	Short: "Inspect node security features",
+	Long:  ``,
+}
+
+// securityLSMCmd represents the security lsm command.
+var securityLSMCmd = &cobra.Command{
+	Use:   "lsm",
+	Short: "Inspect Linux Security Modules state",
+	Long:  ``,
+}
+
+// securityLSMStatusCmd represents the security lsm status command.
+var securityLSMStatusCmd = &cobra.Command{
+	Use:   "status",
+	Short: "Show active Linux Security Modules and the baseline AppArmor profile mode",
+	Long: `Show active Linux Security Modules and the baseline AppArmor profile mode.
+
+The baseline AppArmor profile (talos-system) is applied to apid, trustd, etcd, kubelet and the extension services,
+it denies the access to the kernel memory interfaces, the sysrq trigger and writes to the securityfs,
+and it is configured with the LSMConfig document.
+Other Talos services (including machined, which is the init process) are not confined by AppArmor.
+If the profile is not loaded, the mode is shown as unconfined.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			nodes := client.NodesFromContext(ctx)
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tMODULES\tPROFILE\tMODE")
+
+			results := client.FanOut(ctx, nodes, GlobalArgs.FanOutOptions(), func(nodeCtx context.Context, _ string) (*runtime.LSMStatus, error) {
+				return safe.StateGetByID[*runtime.LSMStatus](nodeCtx, c.COSI, runtime.LSMStatusID)
+			})
+
+			for _, result := range results {
+				if result.Err != nil {
+					return fmt.Errorf("error getting LSM status on node %q: %w", result.Node, result.Err)
+				}
+
+				status := result.Value
+				profile, mode := status.TypedSpec().AppArmorProfile, status.TypedSpec().AppArmorMode
+
+				if profile == "" {
+					profile, mode = "-", "unconfined"
+				}
+
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", result.Node, strings.Join(status.TypedSpec().Modules, ","), profile, mode)
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+// securityTrustCmd represents the security trust command.
+var securityTrustCmd = &cobra.Command{
+	Use:   "trust",
+	Short: "Inspect the system trust store",
+	Long:  ``,
+}
+
+var securityTrustListCmdFlags struct {
+	all bool
+}
+
+// securityTrustListCmd represents the security trust list command.
+var securityTrustListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List CA certificates in the system trust store",
+	Long: `List CA certificates in the system trust store.
+
+The system trust store is used by Talos services, containerd and kubelet.
+Custom CA certificates are configured with the TrustedRootsConfig document,
+by default only the custom certificates are listed.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			nodes := client.NodesFromContext(ctx)
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "NODE\tSOURCE\tSUBJECT\tNOT AFTER")
+
+			results := client.FanOut(ctx, nodes, GlobalArgs.FanOutOptions(), func(nodeCtx context.Context, _ string) ([]trustedRoot, error) {
+				return readTrustedRoots(nodeCtx, c)
+			})
+
+			for _, result := range results {
+				if result.Err != nil {
+					return fmt.Errorf("error reading trusted roots on node %q: %w", result.Node, result.Err)
+				}
+
+				node := result.Node
+
+				for _, root := range result.Value {
+					if root.source == trustedRootsSystemSource && !securityTrustListCmdFlags.all {
+						continue
+					}
+
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", node, root.source, root.cert.Subject, root.cert.NotAfter.Format(time.RFC3339))
+				}
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+// trustedRootsSystemSource is the source of the CA certificates bundled with Talos.
+const trustedRootsSystemSource = "system"
+
+type trustedRoot struct {
+	source string
+	cert   *x509.Certificate
+}
+
+func readTrustedRoots(ctx context.Context, c *client.Client) ([]trustedRoot, error) {
+	r, err := c.Read(ctx, constants.DefaultTrustedCAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	defer r.Close() //nolint:errcheck
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = r.Close(); err != nil {
+		return nil, err
+	}
+
+	return parseTrustedRoots(data)
+}
+
+// parseTrustedRoots parses the trust store bundle, attributing each certificate to its source.
+//
+// Each certificate (or a group of certificates) in the bundle is preceded by a header underlined with '='.
+// Headers of the TrustedRootsConfig documents are the document name followed by a colon,
+// all other certificates are bundled with Talos.
+func parseTrustedRoots(data []byte) ([]trustedRoot, error) {
+	var (
+		roots      []trustedRoot
+		prevLine   string
+		pemLines   []string
+		source     = trustedRootsSystemSource
+		scanner    = bufio.NewScanner(bytes.NewReader(data))
+		inPEMBlock bool
+	)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "-----BEGIN CERTIFICATE-----":
+			inPEMBlock = true
+			pemLines = []string{line}
+		case inPEMBlock:
+			pemLines = append(pemLines, line)
+
+			if line != "-----END CERTIFICATE-----" {
+				break
+			}
+
+			inPEMBlock = false
+
+			block, _ := pem.Decode([]byte(strings.Join(pemLines, "\n")))
+			if block == nil {
+				return nil, fmt.Errorf("failed to decode certificate of %q", source)
+			}
+
+			cert, err := x509.ParseCertificate(block.Bytes)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse certificate of %q: %w", source, err)
+			}
+
+			roots = append(roots, trustedRoot{source: source, cert: cert})
+		case line != "" && prevLine != "" && strings.Trim(line, "=") == "":
+			if name, ok := strings.CutSuffix(prevLine, ":"); ok {
+				source = name
+			} else {
+				source = trustedRootsSystemSource
+			}
+		}
+
+		prevLine = line
+	}
+
+	return roots, scanner.Err()
+}
+
+func init() {
+	securityTrustListCmd.Flags().BoolVar(&securityTrustListCmdFlags.all, "all", false, "include CA certificates bundled with Talos")
+	securityTrustCmd.AddCommand(securityTrustListCmd)
+	securityCmd.AddCommand(securityTrustCmd)
+
+	securityLSMCmd.AddCommand(securityLSMStatusCmd)
+	securityCmd.AddCommand(securityLSMCmd)
+	addCommand(securityCmd)
+}
@@ -0,0 +1,53 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos //nolint:testpackage // to test unexported function
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+)
+
+func TestParseTrustedRoots(t *testing.T) {
+	t.Parallel()
+
+	systemCA, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("system-ca"))
+	require.NoError(t, err)
+
+	customCA1, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("custom-ca-1"))
+	require.NoError(t, err)
+
+	customCA2, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("custom-ca-2"))
+	require.NoError(t, err)
+
+	cfg := security.NewTrustedRootsConfigV1Alpha1()
+	cfg.MetaName = "custom"
+	cfg.Certificates = string(customCA1.CrtPEM) + string(customCA2.CrtPEM)
+
+	bundle := "##\n## Bundle of CA Root Certificates\n##\n\n\nSystem CA\n=========\n" + string(systemCA.CrtPEM) +
+		strings.Join(cfg.ExtraTrustedRootCertificates(), "\n\n")
+
+	roots, err := parseTrustedRoots([]byte(bundle))
+	require.NoError(t, err)
+
+	require.Len(t, roots, 3)
+
+	for i, expected := range []struct {
+		source string
+		org    string
+	}{
+		{source: "system", org: "system-ca"},
+		{source: "custom", org: "custom-ca-1"},
+		{source: "custom", org: "custom-ca-2"},
+	} {
+		assert.Equal(t, expected.source, roots[i].source)
+		assert.Equal(t, []string{expected.org}, roots[i].cert.Subject.Organization)
+	}
+}
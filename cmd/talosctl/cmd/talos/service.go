@@ -7,6 +7,7 @@ package talos
 import (
 	"context"
 	"fmt"
+	"io"
 	"os"
 	"text/tabwriter"
 
@@ -14,11 +15,19 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 
-	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/formatters"
 )
 
+var serviceCmdFlags struct {
+	output      string
+	watch       bool
+	graph       bool
+	graphFormat string
+}
+
 // serviceCmd represents the service command.
 var serviceCmd = &cobra.Command{
 	Use:     "service [<id> [start|stop|restart|status]]",
@@ -26,7 +35,12 @@ var serviceCmd = &cobra.Command{
 	Short:   "Retrieve the state of a service (or all services), control service state",
 	Long: `Service control command. If run without arguments, lists all the services and their state.
 If service ID is specified, default action 'status' is executed which shows status of a single list service.
-With actions 'start', 'stop', 'restart', service state is updated respectively.`,
+With actions 'start', 'stop', 'restart', service state is updated respectively.
+
+With --graph, the dependencies of the services are shown along with the conditions the pending services are waiting for,
+either as a tree or in the DOT format (e.g. to render it with 'dot -Tsvg').`,
+	Example: `talosctl services --graph
+talosctl services --graph --graph-format dot | dot -Tsvg > services.svg`,
 	Args: cobra.MaximumNArgs(2),
 	ValidArgsFunction: func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
 		switch len(args) {
@@ -54,7 +68,15 @@ With actions 'start', 'stop', 'restart', service state is updated respectively.`
 			switch action {
 			case "status":
 				if serviceID == "" {
-					return serviceList(ctx, c)
+					if serviceCmdFlags.graph {
+						return serviceGraph(ctx, c, os.Stdout, serviceCmdFlags.graphFormat)
+					}
+
+					if serviceCmdFlags.watch {
+						return serviceWatch(ctx, c, serviceCmdFlags.output)
+					}
+
+					return serviceList(ctx, c, os.Stdout, serviceCmdFlags.output)
 				}
 
 				return serviceInfo(ctx, c, serviceID)
@@ -71,7 +93,17 @@ With actions 'start', 'stop', 'restart', service state is updated respectively.`
 	},
 }
 
-func serviceList(ctx context.Context, c *client.Client) error {
+func serviceList(ctx context.Context, c *client.Client, out io.Writer, output string) error {
+	var wide bool
+
+	switch output {
+	case "table":
+	case "wide":
+		wide = true
+	default:
+		return fmt.Errorf("unsupported output mode: %q", output)
+	}
+
 	var remotePeer peer.Peer
 
 	resp, err := c.ServiceList(ctx, grpc.Peer(&remotePeer))
@@ -80,11 +112,15 @@ func serviceList(ctx context.Context, c *client.Client) error {
 			return fmt.Errorf("error listing services: %w", err)
 		}
 
-		cli.Warning("%s", err)
+		common.WarnNodeErrors(err)
 	}
 
-	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
-	fmt.Fprintln(w, "NODE\tSERVICE\tSTATE\tHEALTH\tLAST CHANGE\tLAST EVENT")
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+	if wide {
+		fmt.Fprintln(w, "NODE\tSERVICE\tSTATE\tHEALTH\tMEMORY\tCPU\tPIDS\tLAST CHANGE\tLAST EVENT")
+	} else {
+		fmt.Fprintln(w, "NODE\tSERVICE\tSTATE\tHEALTH\tLAST CHANGE\tLAST EVENT")
+	}
 
 	defaultNode := client.AddrFromPeer(&remotePeer)
 
@@ -98,13 +134,49 @@ func serviceList(ctx context.Context, c *client.Client) error {
 				node = msg.Metadata.Hostname
 			}
 
-			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s ago\t%s\n", node, svc.Id, svc.State, svc.HealthStatus(), svc.LastUpdated(), svc.LastEvent())
+			if wide {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s ago\t%s\n",
+					node, svc.Id, svc.State, svc.HealthStatus(), svc.MemoryUsage(), svc.CPUUsage(), svc.PidsUsage(), svc.LastUpdated(), svc.LastEvent())
+			} else {
+				fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s ago\t%s\n", node, svc.Id, svc.State, svc.HealthStatus(), svc.LastUpdated(), svc.LastEvent())
+			}
 		}
 	}
 
 	return w.Flush()
 }
 
+// serviceWatch re-renders the service list on each service state change.
+func serviceWatch(ctx context.Context, c *client.Client, output string) error {
+	eventCh := make(chan client.EventResult)
+
+	if err := c.EventsWatchV2(ctx, eventCh); err != nil {
+		return err
+	}
+
+	renderer := newWatchRenderer()
+
+	for {
+		if err := renderer.Render(func(w io.Writer) error { return serviceList(ctx, c, w, output) }); err != nil {
+			return err
+		}
+
+		// wait for the next service state change
+		for changed := false; !changed; {
+			select {
+			case <-ctx.Done():
+				return nil
+			case result := <-eventCh:
+				if result.Error != nil {
+					return fmt.Errorf("error watching service events: %w", result.Error)
+				}
+
+				_, changed = result.Event.Payload.(*machineapi.ServiceStateEvent)
+			}
+		}
+	}
+}
+
 func serviceInfo(ctx context.Context, c *client.Client, id string) error {
 	var remotePeer peer.Peer
 
@@ -114,7 +186,7 @@ func serviceInfo(ctx context.Context, c *client.Client, id string) error {
 			return fmt.Errorf("error listing services: %w", err)
 		}
 
-		cli.Warning("%s", err)
+		common.WarnNodeErrors(err)
 	}
 
 	defaultNode := client.AddrFromPeer(&remotePeer)
@@ -135,7 +207,7 @@ func serviceStart(ctx context.Context, c *client.Client, id string) error {
 			return fmt.Errorf("error starting service: %w", err)
 		}
 
-		cli.Warning("%s", err)
+		common.WarnNodeErrors(err)
 	}
 
 	defaultNode := client.AddrFromPeer(&remotePeer)
@@ -165,7 +237,7 @@ func serviceStop(ctx context.Context, c *client.Client, id string) error {
 			return fmt.Errorf("error starting service: %w", err)
 		}
 
-		cli.Warning("%s", err)
+		common.WarnNodeErrors(err)
 	}
 
 	defaultNode := client.AddrFromPeer(&remotePeer)
@@ -195,7 +267,7 @@ func serviceRestart(ctx context.Context, c *client.Client, id string) error {
 			return fmt.Errorf("error starting service: %w", err)
 		}
 
-		cli.Warning("%s", err)
+		common.WarnNodeErrors(err)
 	}
 
 	defaultNode := client.AddrFromPeer(&remotePeer)
@@ -217,5 +289,10 @@ func serviceRestart(ctx context.Context, c *client.Client, id string) error {
 }
 
 func init() {
+	serviceCmd.Flags().StringVarP(&serviceCmdFlags.output, "output", "o", "table", "output mode for the service list (table, wide), wide adds the resource usage and limits")
+	serviceCmd.Flags().BoolVarP(&serviceCmdFlags.watch, "watch", "w", false, "watch the service list, re-rendering it on each service state change")
+	serviceCmd.Flags().BoolVar(&serviceCmdFlags.graph, "graph", false, "show the dependency graph of the services")
+	serviceCmd.Flags().StringVar(&serviceCmdFlags.graphFormat, "graph-format", "tree", "format of the dependency graph (tree, dot)")
+	serviceCmd.MarkFlagsMutuallyExclusive("graph", "watch")
 	addCommand(serviceCmd)
 }
@@ -0,0 +1,183 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+func serviceGraph(ctx context.Context, c *client.Client, out io.Writer, format string) error {
+	var render func(io.Writer, string, []*machineapi.ServiceGraphNode)
+
+	switch format {
+	case "tree":
+		render = renderServiceTree
+	case "dot":
+		render = renderServiceDOT
+	default:
+		return fmt.Errorf("unsupported graph format: %q", format)
+	}
+
+	var remotePeer peer.Peer
+
+	resp, err := c.ServiceGraph(ctx, grpc.Peer(&remotePeer))
+	if err != nil {
+		if resp == nil {
+			return fmt.Errorf("error getting service graph: %w", err)
+		}
+
+		common.WarnNodeErrors(err)
+	}
+
+	defaultNode := client.AddrFromPeer(&remotePeer)
+
+	if format == "dot" {
+		fmt.Fprintln(out, "digraph services {")
+		fmt.Fprintln(out, "  rankdir=LR;")
+		fmt.Fprintln(out, "  node [shape=box, style=filled];")
+	}
+
+	for _, msg := range resp.Messages {
+		node := defaultNode
+
+		if msg.Metadata != nil {
+			node = msg.Metadata.Hostname
+		}
+
+		render(out, node, msg.Services)
+	}
+
+	if format == "dot" {
+		fmt.Fprintln(out, "}")
+	}
+
+	return nil
+}
+
+// renderServiceTree renders the services as trees of dependencies.
+//
+// The services no other service depends on are the roots, each service is followed by the services it depends on.
+// The subtree of a service is rendered only once.
+func renderServiceTree(out io.Writer, node string, services []*machineapi.ServiceGraphNode) {
+	byID := make(map[string]*machineapi.ServiceGraphNode, len(services))
+	required := map[string]struct{}{}
+
+	for _, svc := range services {
+		byID[svc.Id] = svc
+
+		for _, dep := range svc.DependsOn {
+			required[dep] = struct{}{}
+		}
+	}
+
+	fmt.Fprintf(out, "%s\n", node)
+
+	rendered := map[string]struct{}{}
+
+	var renderService func(id, prefix string, last bool)
+
+	renderService = func(id, prefix string, last bool) {
+		branch, childPrefix := "├── ", prefix+"│   "
+		if last {
+			branch, childPrefix = "└── ", prefix+"    "
+		}
+
+		svc, ok := byID[id]
+		if !ok {
+			fmt.Fprintf(out, "%s%s%s [not registered]\n", prefix, branch, id)
+
+			return
+		}
+
+		if _, ok = rendered[id]; ok {
+			if len(svc.DependsOn) > 0 {
+				fmt.Fprintf(out, "%s%s%s [%s] (see above)\n", prefix, branch, id, svc.State)
+			} else {
+				fmt.Fprintf(out, "%s%s%s [%s]\n", prefix, branch, id, svc.State)
+			}
+
+			return
+		}
+
+		rendered[id] = struct{}{}
+
+		line := fmt.Sprintf("%s [%s]", id, svc.State)
+
+		if svc.WaitingFor != "" {
+			line += ": waiting for " + svc.WaitingFor
+		}
+
+		fmt.Fprintf(out, "%s%s%s\n", prefix, branch, line)
+
+		for i, dep := range svc.DependsOn {
+			renderService(dep, childPrefix, i == len(svc.DependsOn)-1)
+		}
+	}
+
+	var roots []string
+
+	for _, svc := range services {
+		if _, ok := required[svc.Id]; !ok {
+			roots = append(roots, svc.Id)
+		}
+	}
+
+	slices.Sort(roots)
+
+	for i, id := range roots {
+		renderService(id, "", i == len(roots)-1)
+	}
+}
+
+// renderServiceDOT renders the services as a DOT subgraph, with the edges pointing to the dependencies.
+func renderServiceDOT(out io.Writer, node string, services []*machineapi.ServiceGraphNode) {
+	fmt.Fprintf(out, "  subgraph %q {\n", "cluster_"+node)
+	fmt.Fprintf(out, "    label=%q;\n", node)
+
+	for _, svc := range services {
+		label := svc.Id + "\n" + svc.State
+
+		switch {
+		case svc.WaitingFor != "":
+			label += "\nwaiting for " + svc.WaitingFor
+		case svc.Condition != "":
+			label += "\nrequires " + svc.Condition
+		}
+
+		fmt.Fprintf(out, "    %q [label=%q, fillcolor=%q];\n", node+"/"+svc.Id, label, serviceStateColor(svc.State))
+	}
+
+	for _, svc := range services {
+		for _, dep := range svc.DependsOn {
+			fmt.Fprintf(out, "    %q -> %q;\n", node+"/"+svc.Id, node+"/"+dep)
+		}
+	}
+
+	fmt.Fprintln(out, "  }")
+}
+
+func serviceStateColor(state string) string {
+	switch strings.ToLower(state) {
+	case "running", "finished":
+		return "palegreen"
+	case "waiting", "starting", "preparing":
+		return "khaki"
+	case "failed":
+		return "salmon"
+	default:
+		return "lightgrey"
+	}
+}
@@ -0,0 +1,56 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos //nolint:testpackage // to test unexported function
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+var testServiceGraph = []*machineapi.ServiceGraphNode{
+	{Id: "apid", State: "Running", DependsOn: []string{"containerd"}, Condition: "api certificates"},
+	{Id: "containerd", State: "Running"},
+	{Id: "cri", State: "Running"},
+	{Id: "kubelet", State: "Waiting", DependsOn: []string{"cri", "ext-tailscale"}, Condition: "time is in sync", WaitingFor: "service \"ext-tailscale\" to be \"up\", time is in sync"},
+	{Id: "trustd", State: "Running", DependsOn: []string{"containerd"}},
+}
+
+func TestRenderServiceTree(t *testing.T) {
+	t.Parallel()
+
+	var out strings.Builder
+
+	renderServiceTree(&out, "10.5.0.2", testServiceGraph)
+
+	assert.Equal(t, `10.5.0.2
+├── apid [Running]
+│   └── containerd [Running]
+├── kubelet [Waiting]: waiting for service "ext-tailscale" to be "up", time is in sync
+│   ├── cri [Running]
+│   └── ext-tailscale [not registered]
+└── trustd [Running]
+    └── containerd [Running]
+`, out.String())
+}
+
+func TestRenderServiceDOT(t *testing.T) {
+	t.Parallel()
+
+	var out strings.Builder
+
+	renderServiceDOT(&out, "10.5.0.2", testServiceGraph[:2])
+
+	assert.Equal(t, `  subgraph "cluster_10.5.0.2" {
+    label="10.5.0.2";
+    "10.5.0.2/apid" [label="apid\nRunning\nrequires api certificates", fillcolor="palegreen"];
+    "10.5.0.2/containerd" [label="containerd\nRunning", fillcolor="palegreen"];
+    "10.5.0.2/apid" -> "10.5.0.2/containerd";
+  }
+`, out.String())
+}
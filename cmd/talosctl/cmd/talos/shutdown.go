@@ -18,7 +18,7 @@ import (
 
 var shutdownCmdFlags struct {
 	trackableActionCmdFlags
-	force bool
+	powerActionCmdFlags
 }
 
 // shutdownCmd represents the shutdown command.
@@ -28,12 +28,35 @@ var shutdownCmd = &cobra.Command{
 	Long:  ``,
 	Args:  cobra.NoArgs,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if shutdownCmdFlags.cancel {
+			return powerActionCancel()
+		}
+
 		if shutdownCmdFlags.debug {
 			shutdownCmdFlags.wait = true
 		}
 
+		at, err := shutdownCmdFlags.scheduledAt()
+		if err != nil {
+			return err
+		}
+
 		opts := []client.ShutdownOption{
 			client.WithShutdownForce(shutdownCmdFlags.force),
+			client.WithShutdownMessage(shutdownCmdFlags.message),
+		}
+
+		if !at.IsZero() {
+			opts = append(opts, client.WithShutdownAt(at))
+
+			return WithClient(func(ctx context.Context, c *client.Client) error {
+				resp, err := c.ShutdownWithResponse(ctx, opts...)
+				if err != nil {
+					return fmt.Errorf("error scheduling shutdown: %w", err)
+				}
+
+				return printScheduledPowerAction("shutdown", resp.GetMessages())
+			})
 		}
 
 		if !shutdownCmdFlags.wait {
@@ -43,7 +66,7 @@ var shutdownCmd = &cobra.Command{
 				}
 
 				if err := c.Shutdown(ctx, opts...); err != nil {
-					return fmt.Errorf("error executing shutdown: %s", err)
+					return fmt.Errorf("error executing shutdown: %w", err)
 				}
 
 				return nil
@@ -53,28 +76,30 @@ var shutdownCmd = &cobra.Command{
 		return action.NewTracker(
 			&GlobalArgs,
 			action.StopAllServicesEventFn,
-			shutdownGetActorID,
+			shutdownGetActorID(opts...),
 			action.WithDebug(shutdownCmdFlags.debug),
 			action.WithTimeout(shutdownCmdFlags.timeout),
 		).Run()
 	},
 }
 
-func shutdownGetActorID(ctx context.Context, c *client.Client) (string, error) {
-	resp, err := c.ShutdownWithResponse(ctx, client.WithShutdownForce(shutdownCmdFlags.force))
-	if err != nil {
-		return "", err
-	}
+func shutdownGetActorID(opts ...client.ShutdownOption) func(ctx context.Context, c *client.Client) (string, error) {
+	return func(ctx context.Context, c *client.Client) (string, error) {
+		resp, err := c.ShutdownWithResponse(ctx, opts...)
+		if err != nil {
+			return "", err
+		}
 
-	if len(resp.GetMessages()) == 0 {
-		return "", errors.New("no messages returned from action run")
-	}
+		if len(resp.GetMessages()) == 0 {
+			return "", errors.New("no messages returned from action run")
+		}
 
-	return resp.GetMessages()[0].GetActorId(), nil
+		return resp.GetMessages()[0].GetActorId(), nil
+	}
 }
 
 func init() {
-	shutdownCmd.Flags().BoolVar(&shutdownCmdFlags.force, "force", false, "if true, force a node to shutdown without a cordon/drain")
+	shutdownCmdFlags.addPowerActionFlags(shutdownCmd, "if true, force a node to shutdown without a cordon/drain")
 	shutdownCmdFlags.addTrackActionFlags(shutdownCmd)
 	addCommand(shutdownCmd)
 }
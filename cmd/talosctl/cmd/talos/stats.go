@@ -16,8 +16,8 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 
-	"github.com/siderolabs/talos/pkg/cli"
-	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
+	commonapi "github.com/siderolabs/talos/pkg/machinery/api/common"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -33,15 +33,15 @@ var statsCmd = &cobra.Command{
 		return WithClient(func(ctx context.Context, c *client.Client) error {
 			var (
 				namespace string
-				driver    common.ContainerDriver
+				driver    commonapi.ContainerDriver
 			)
 
 			if kubernetesFlag {
 				namespace = constants.K8sContainerdNamespace
-				driver = common.ContainerDriver_CRI
+				driver = commonapi.ContainerDriver_CRI
 			} else {
 				namespace = constants.SystemContainerdNamespace
-				driver = common.ContainerDriver_CONTAINERD
+				driver = commonapi.ContainerDriver_CONTAINERD
 			}
 
 			var remotePeer peer.Peer
@@ -49,10 +49,10 @@ var statsCmd = &cobra.Command{
 			resp, err := c.Stats(ctx, namespace, driver, grpc.Peer(&remotePeer))
 			if err != nil {
 				if resp == nil {
-					return fmt.Errorf("error getting stats: %s", err)
+					return fmt.Errorf("error getting stats: %w", err)
 				}
 
-				cli.Warning("%s", err)
+				common.WarnNodeErrors(err)
 			}
 
 			return statsRender(&remotePeer, resp)
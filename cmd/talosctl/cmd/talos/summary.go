@@ -0,0 +1,301 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/dustin/go-humanize"
+	"github.com/spf13/cobra"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+	etcdresource "github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// summaryCmd represents the summary command.
+var summaryCmd = &cobra.Command{
+	Use:   "summary",
+	Short: "Print a one-screen summary of the cluster",
+	Long: `Print a one-screen summary of the cluster of the current context:
+the endpoints, the Kubernetes version, the cluster members with their Talos and kubelet versions, health and staged upgrades,
+and the status of the etcd members.
+
+The cluster members are listed with the cluster discovery, so it should be enabled.`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClientNoNodes(summary)
+	},
+}
+
+// nodeSummary is the state of a cluster member.
+type nodeSummary struct {
+	TalosVersion   string
+	KubeletVersion string
+	// UnhealthyServices is the list of services which report failed health checks.
+	UnhealthyServices []string
+	// StagedUpgrade is the installer image of the upgrade staged to run on the next boot.
+	StagedUpgrade string
+}
+
+func (s *nodeSummary) health() string {
+	if len(s.UnhealthyServices) == 0 {
+		return "healthy"
+	}
+
+	return fmt.Sprintf("unhealthy (%s)", strings.Join(s.UnhealthyServices, ", "))
+}
+
+//nolint:gocyclo,cyclop
+func summary(ctx context.Context, c *client.Client) error {
+	items, err := safe.StateListAll[*cluster.Member](ctx, c.COSI)
+	if err != nil {
+		return fmt.Errorf("error listing cluster members: %w", err)
+	}
+
+	if items.Len() == 0 {
+		return errors.New("no cluster members found, the cluster discovery should be enabled")
+	}
+
+	var (
+		members           []*cluster.Member
+		nodes             []string
+		controlPlaneNodes []string
+	)
+
+	for it := items.Iterator(); it.Next(); {
+		member := it.Value()
+
+		if len(member.TypedSpec().Addresses) == 0 {
+			continue
+		}
+
+		node := member.TypedSpec().Addresses[0].String()
+
+		members = append(members, member)
+		nodes = append(nodes, node)
+
+		if member.TypedSpec().MachineType.IsControlPlane() {
+			controlPlaneNodes = append(controlPlaneNodes, node)
+		}
+	}
+
+	results := client.FanOut(ctx, nodes, GlobalArgs.FanOutOptions(), func(nodeCtx context.Context, _ string) (*nodeSummary, error) {
+		return getNodeSummary(nodeCtx, c)
+	})
+
+	kubernetesVersion := "-"
+
+	for _, node := range controlPlaneNodes {
+		apiServerConfig, err := safe.StateGetByID[*k8s.APIServerConfig](client.WithNode(ctx, node), c.COSI, k8s.APIServerConfigID)
+		if err != nil {
+			continue
+		}
+
+		kubernetesVersion = imageTag(apiServerConfig.TypedSpec().Image)
+
+		break
+	}
+
+	var (
+		nodeErrors    error
+		stagedUpgrade int
+	)
+
+	for _, result := range results {
+		if result.Err != nil {
+			nodeErrors = errors.Join(nodeErrors, &client.NodeError{Node: result.Node, Err: result.Err})
+
+			continue
+		}
+
+		if result.Value.StagedUpgrade != "" {
+			stagedUpgrade++
+		}
+	}
+
+	pendingUpgrades := "none"
+
+	if stagedUpgrade > 0 {
+		pendingUpgrades = fmt.Sprintf("%d node(s) with the staged upgrade", stagedUpgrade)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintf(w, "CONTEXT\t%s\n", summaryContextName())
+	fmt.Fprintf(w, "ENDPOINTS\t%s\n", strings.Join(c.GetEndpoints(), ", "))
+	fmt.Fprintf(w, "KUBERNETES VERSION\t%s\n", kubernetesVersion)
+	fmt.Fprintf(w, "PENDING UPGRADES\t%s\n", pendingUpgrades)
+
+	if err = w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprint(os.Stdout, "\nMEMBERS:\n\n")
+
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "NODE\tHOSTNAME\tMACHINE TYPE\tTALOS\tKUBELET\tHEALTH\tSTAGED UPGRADE")
+
+	for i, result := range results {
+		spec := members[i].TypedSpec()
+
+		if result.Err != nil {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", result.Node, spec.Hostname, spec.MachineType, "-", "-", "unreachable", "-")
+
+			continue
+		}
+
+		staged := result.Value.StagedUpgrade
+		if staged == "" {
+			staged = "-"
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			result.Node, spec.Hostname, spec.MachineType,
+			result.Value.TalosVersion, result.Value.KubeletVersion, result.Value.health(), staged,
+		)
+	}
+
+	if err = w.Flush(); err != nil {
+		return err
+	}
+
+	if len(controlPlaneNodes) > 0 {
+		fmt.Fprint(os.Stdout, "\nETCD:\n\n")
+
+		if err = printEtcdSummary(client.WithNodes(ctx, controlPlaneNodes...), c, os.Stdout); err != nil {
+			nodeErrors = errors.Join(nodeErrors, err)
+		}
+	}
+
+	if nodeErrors != nil {
+		common.WarnNodeErrors(nodeErrors)
+	}
+
+	return nil
+}
+
+func getNodeSummary(ctx context.Context, c *client.Client) (*nodeSummary, error) {
+	version, err := c.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	services, err := c.ServiceList(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &nodeSummary{
+		KubeletVersion: "-",
+	}
+
+	for _, msg := range version.Messages {
+		result.TalosVersion = msg.GetVersion().GetTag()
+	}
+
+	for _, msg := range services.Messages {
+		for _, svc := range msg.Services {
+			if svc.GetHealth() != nil && !svc.GetHealth().GetUnknown() && !svc.GetHealth().GetHealthy() {
+				result.UnhealthyServices = append(result.UnhealthyServices, svc.GetId())
+			}
+		}
+	}
+
+	kubeletSpec, err := safe.StateGetByID[*k8s.KubeletSpec](ctx, c.COSI, k8s.KubeletID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	if kubeletSpec != nil {
+		result.KubeletVersion = imageTag(kubeletSpec.TypedSpec().Image)
+	}
+
+	stagedUpgrade, err := safe.StateGetByID[*runtime.MetaKey](ctx, c.COSI, runtime.MetaKeyTagToID(meta.StagedUpgradeImageRef))
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	if stagedUpgrade != nil {
+		result.StagedUpgrade = stagedUpgrade.TypedSpec().Value
+	}
+
+	return result, nil
+}
+
+// printEtcdSummary prints the status of the etcd members running on the control plane nodes.
+func printEtcdSummary(ctx context.Context, c *client.Client, out io.Writer) error {
+	response, err := c.EtcdStatus(ctx)
+	if err != nil && response == nil {
+		return fmt.Errorf("error getting etcd status: %w", err)
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(w, "NODE\tMEMBER\tDB SIZE\tLEADER\tLEARNER\tERRORS")
+
+	for _, message := range response.Messages {
+		status := message.GetMemberStatus()
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%v\t%s\n",
+			message.GetMetadata().GetHostname(),
+			etcdresource.FormatMemberID(status.GetMemberId()),
+			humanize.Bytes(uint64(status.GetDbSize())),
+			etcdresource.FormatMemberID(status.GetLeader()),
+			status.GetIsLearner(),
+			strings.Join(status.GetErrors(), ", "),
+		)
+	}
+
+	if flushErr := w.Flush(); flushErr != nil {
+		return flushErr
+	}
+
+	return err
+}
+
+// summaryContextName returns the name of the talosconfig context the summary is printed for.
+func summaryContextName() string {
+	if GlobalArgs.CmdContext != "" {
+		return GlobalArgs.CmdContext
+	}
+
+	cfg, err := clientconfig.Open(GlobalArgs.Talosconfig)
+	if err != nil {
+		return "-"
+	}
+
+	return cfg.Context
+}
+
+// imageTag returns the tag of the container image reference, which is the version of the component.
+func imageTag(image string) string {
+	image, _, _ = strings.Cut(image, "@")
+
+	idx := strings.LastIndex(image, ":")
+	if idx == -1 || strings.Contains(image[idx:], "/") {
+		return "-"
+	}
+
+	return image[idx+1:]
+}
+
+func init() {
+	addCommand(summaryCmd)
+}
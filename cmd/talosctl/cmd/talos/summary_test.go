@@ -0,0 +1,43 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos //nolint:testpackage // to test unexported function
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageTag(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		image    string
+		expected string
+	}{
+		{
+			image:    "registry.k8s.io/kube-apiserver:v1.31.1",
+			expected: "v1.31.1",
+		},
+		{
+			image:    "ghcr.io/siderolabs/kubelet:v1.31.1@sha256:2a8b3ef6e8c3e0b3c6a1e5d6d7f1f7b6f0a5c2e8f1a2b3c4d5e6f7a8b9c0d1e2",
+			expected: "v1.31.1",
+		},
+		{
+			image:    "localhost:5000/kube-apiserver",
+			expected: "-",
+		},
+		{
+			image:    "",
+			expected: "-",
+		},
+	} {
+		t.Run(test.image, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, imageTag(test.image))
+		})
+	}
+}
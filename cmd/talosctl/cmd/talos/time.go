@@ -16,7 +16,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 
-	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
 	timeapi "github.com/siderolabs/talos/pkg/machinery/api/time"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 )
@@ -50,7 +50,7 @@ var timeCmd = &cobra.Command{
 					return fmt.Errorf("error fetching time: %w", err)
 				}
 
-				cli.Warning("%s", err)
+				common.WarnNodeErrors(err)
 			}
 
 			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
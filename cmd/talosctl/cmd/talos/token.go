@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+var tokenCreateCmdFlags struct {
+	ttl         time.Duration
+	description string
+}
+
+var tokenCmd = &cobra.Command{
+	Use:   "token",
+	Short: "Manage short-lived join tokens",
+	Long: `Join tokens can be used as the machine token (.machine.token) in the worker machine configuration.
+Unlike the machine token, join tokens expire and can be revoked, so a leaked worker machine configuration can't be used to join the cluster afterwards.
+
+Join tokens are stored in etcd, so the commands should be run against a single control plane node.`,
+	Args: cobra.NoArgs,
+}
+
+var tokenCreateCmd = &cobra.Command{
+	Use:   "create",
+	Short: "Create a join token",
+	Long:  ``,
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "token create"); err != nil {
+				return err
+			}
+
+			resp, err := c.JoinTokenCreate(ctx, &machine.JoinTokenCreateRequest{
+				Ttl:         durationpb.New(tokenCreateCmdFlags.ttl),
+				Description: tokenCreateCmdFlags.description,
+			})
+			if err != nil {
+				return fmt.Errorf("error creating join token: %w", err)
+			}
+
+			for _, msg := range resp.GetMessages() {
+				fmt.Fprintf(os.Stderr, "join token %q expires at %s\n", msg.GetToken().GetId(), msg.GetToken().GetExpires().AsTime().Local().Format(time.RFC3339))
+				fmt.Println(msg.GetSecretToken())
+			}
+
+			return nil
+		})
+	},
+}
+
+var tokenListCmd = &cobra.Command{
+	Use:     "list",
+	Aliases: []string{"ls"},
+	Short:   "List the join tokens which are not expired",
+	Long:    ``,
+	Args:    cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "token list"); err != nil {
+				return err
+			}
+
+			resp, err := c.JoinTokenList(ctx)
+			if err != nil {
+				return fmt.Errorf("error listing join tokens: %w", err)
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
+			fmt.Fprintln(w, "ID\tEXPIRES\tDESCRIPTION")
+
+			for _, msg := range resp.GetMessages() {
+				for _, token := range msg.GetTokens() {
+					fmt.Fprintf(w, "%s\t%s\t%s\n", token.GetId(), token.GetExpires().AsTime().Local().Format(time.RFC3339), token.GetDescription())
+				}
+			}
+
+			return w.Flush()
+		})
+	},
+}
+
+var tokenRevokeCmd = &cobra.Command{
+	Use:   "revoke <id>",
+	Short: "Revoke a join token",
+	Long:  ``,
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return WithClient(func(ctx context.Context, c *client.Client) error {
+			if err := helpers.FailIfMultiNodes(ctx, "token revoke"); err != nil {
+				return err
+			}
+
+			return c.JoinTokenRevoke(ctx, args[0])
+		})
+	},
+}
+
+func init() {
+	tokenCreateCmd.Flags().DurationVar(&tokenCreateCmdFlags.ttl, "ttl", time.Hour, "time-to-live of the token")
+	tokenCreateCmd.Flags().StringVar(&tokenCreateCmdFlags.description, "description", "", "human-readable description of the token")
+
+	tokenCmd.AddCommand(tokenCreateCmd, tokenListCmd, tokenRevokeCmd)
+	addCommand(tokenCmd)
+}
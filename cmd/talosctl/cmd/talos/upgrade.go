@@ -20,9 +20,9 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/action"
 	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
-	"github.com/siderolabs/talos/pkg/cli"
 	"github.com/siderolabs/talos/pkg/images"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
@@ -33,6 +33,7 @@ var upgradeCmdFlags struct {
 	trackableActionCmdFlags
 	upgradeImage string
 	rebootMode   string
+	output       string
 	preserve     bool
 	stage        bool
 	force        bool
@@ -54,6 +55,12 @@ var upgradeCmd = &cobra.Command{
 			return errors.New("cannot use --wait and --insecure together")
 		}
 
+		switch upgradeCmdFlags.output {
+		case "text", "json":
+		default:
+			return fmt.Errorf("unknown output format: %q", upgradeCmdFlags.output)
+		}
+
 		rebootModeStr := strings.ToUpper(upgradeCmdFlags.rebootMode)
 
 		rebootMode, rebootModeOk := machine.UpgradeRequest_RebootMode_value[rebootModeStr]
@@ -73,7 +80,7 @@ var upgradeCmd = &cobra.Command{
 			return runUpgradeNoWait(opts)
 		}
 
-		return action.NewTracker(
+		tracker := action.NewTracker(
 			&GlobalArgs,
 			action.MachineReadyEventFn,
 			func(ctx context.Context, c *client.Client) (string, error) {
@@ -82,7 +89,19 @@ var upgradeCmd = &cobra.Command{
 			action.WithPostCheck(action.BootIDChangedPostCheckFn),
 			action.WithDebug(upgradeCmdFlags.debug),
 			action.WithTimeout(upgradeCmdFlags.timeout),
-		).Run()
+		)
+
+		err := tracker.Run()
+
+		report := newUpgradeReport(GlobalArgs.NodeList(), tracker.NodeStatuses(), err)
+
+		if upgradeCmdFlags.output == "json" {
+			if encodeErr := report.write(); encodeErr != nil {
+				return encodeErr
+			}
+		}
+
+		return report.exitError(err)
 	},
 }
 
@@ -100,17 +119,39 @@ func runUpgradeNoWait(opts []client.UpgradeOption) error {
 		resp, err := c.UpgradeWithOptions(ctx, opts...)
 		if err != nil {
 			if resp == nil {
-				return fmt.Errorf("error performing upgrade: %s", err)
+				return fmt.Errorf("error performing upgrade: %w", err)
+			}
+
+			common.WarnNodeErrors(err)
+		}
+
+		defaultNode := client.AddrFromPeer(&remotePeer)
+
+		if upgradeCmdFlags.output == "json" {
+			report := upgradeReport{
+				Status: upgradeStatusSucceeded,
+			}
+
+			for _, msg := range resp.Messages {
+				node := defaultNode
+
+				if msg.Metadata != nil {
+					node = msg.Metadata.Hostname
+				}
+
+				report.Nodes = append(report.Nodes, upgradeNodeResult{
+					Node:    node,
+					Status:  upgradeNodeAcknowledged,
+					Message: msg.Ack,
+				})
 			}
 
-			cli.Warning("%s", err)
+			return report.write()
 		}
 
 		w := tabwriter.NewWriter(os.Stdout, 0, 0, 3, ' ', 0)
 		fmt.Fprintln(w, "NODE\tACK\tSTARTED")
 
-		defaultNode := client.AddrFromPeer(&remotePeer)
-
 		for _, msg := range resp.Messages {
 			node := defaultNode
 
@@ -163,6 +204,9 @@ func init() {
 	upgradeCmd.Flags().BoolVarP(&upgradeCmdFlags.stage, "stage", "s", false, "stage the upgrade to perform it after a reboot")
 	upgradeCmd.Flags().BoolVarP(&upgradeCmdFlags.force, "force", "f", false, "force the upgrade (skip checks on etcd health and members, might lead to data loss)")
 	upgradeCmd.Flags().BoolVar(&upgradeCmdFlags.insecure, "insecure", false, "upgrade using the insecure (encrypted with no auth) maintenance service")
+	upgradeCmd.Flags().StringVarP(&upgradeCmdFlags.output, "output", "o", "text",
+		"output format (text|json). json prints the result for each node to stdout. "+
+			"with --wait, exit code is 0 if all nodes were upgraded, 2 if some nodes failed, 1 if all nodes failed")
 	upgradeCmdFlags.addTrackActionFlags(upgradeCmd)
 
 	if err := upgradeCmd.Flags().MarkHidden("preserve"); err != nil {
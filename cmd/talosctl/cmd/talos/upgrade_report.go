@@ -0,0 +1,118 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"encoding/json"
+	"os"
+	"slices"
+
+	"github.com/siderolabs/gen/maps"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
+	"github.com/siderolabs/talos/pkg/reporter"
+)
+
+// Upgrade report statuses.
+const (
+	upgradeStatusSucceeded = "succeeded"
+	upgradeStatusDegraded  = "degraded"
+	upgradeStatusFailed    = "failed"
+
+	upgradeNodeAcknowledged = "acknowledged"
+	upgradeNodeSucceeded    = "succeeded"
+	upgradeNodeFailed       = "failed"
+)
+
+// upgradeReport is the machine-readable result of the upgrade.
+type upgradeReport struct {
+	Status string              `json:"status"`
+	Error  string              `json:"error,omitempty"`
+	Nodes  []upgradeNodeResult `json:"nodes"`
+}
+
+type upgradeNodeResult struct {
+	Node    string `json:"node"`
+	Status  string `json:"status"`
+	Message string `json:"message,omitempty"`
+}
+
+// newUpgradeReport builds the report from the last status of each node tracked during the upgrade.
+//
+// The nodes which didn't report the success are considered failed.
+func newUpgradeReport(nodes []string, statuses map[string]reporter.Update, err error) upgradeReport {
+	nodes = slices.Clone(nodes)
+
+	for _, node := range maps.Keys(statuses) {
+		if !slices.Contains(nodes, node) {
+			nodes = append(nodes, node)
+		}
+	}
+
+	slices.Sort(nodes)
+
+	report := upgradeReport{
+		Nodes: make([]upgradeNodeResult, 0, len(nodes)),
+	}
+
+	succeeded := 0
+
+	for _, node := range nodes {
+		result := upgradeNodeResult{
+			Node:   node,
+			Status: upgradeNodeFailed,
+		}
+
+		if status, ok := statuses[node]; ok {
+			result.Message = status.Message
+
+			if status.Status == reporter.StatusSucceeded {
+				result.Status = upgradeNodeSucceeded
+				succeeded++
+			}
+		}
+
+		report.Nodes = append(report.Nodes, result)
+	}
+
+	switch {
+	case err == nil:
+		report.Status = upgradeStatusSucceeded
+	case succeeded > 0:
+		report.Status = upgradeStatusDegraded
+	default:
+		report.Status = upgradeStatusFailed
+	}
+
+	if err != nil {
+		report.Error = err.Error()
+	}
+
+	return report
+}
+
+func (r upgradeReport) write() error {
+	if r.Nodes == nil {
+		r.Nodes = []upgradeNodeResult{}
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(&r)
+}
+
+// exitError wraps the upgrade error with the exit code matching the report status.
+func (r upgradeReport) exitError(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	if r.Status == upgradeStatusDegraded {
+		return common.NewExitError(common.ExitCodeDegraded, err)
+	}
+
+	return common.NewExitError(common.ExitCodeFailed, err)
+}
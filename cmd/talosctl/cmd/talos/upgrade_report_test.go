@@ -0,0 +1,48 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos //nolint:testpackage // to test unexported function
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
+	"github.com/siderolabs/talos/pkg/reporter"
+)
+
+func TestUpgradeReport(t *testing.T) {
+	t.Parallel()
+
+	statuses := map[string]reporter.Update{
+		"172.20.0.2": {Message: "post check passed", Status: reporter.StatusSucceeded},
+		"172.20.0.3": {Message: "unavailable, retrying...", Status: reporter.StatusError},
+	}
+
+	report := newUpgradeReport([]string{"172.20.0.3", "172.20.0.2", "172.20.0.4"}, statuses, context.DeadlineExceeded)
+
+	assert.Equal(t, upgradeReport{
+		Status: upgradeStatusDegraded,
+		Error:  "context deadline exceeded",
+		Nodes: []upgradeNodeResult{
+			{Node: "172.20.0.2", Status: upgradeNodeSucceeded, Message: "post check passed"},
+			{Node: "172.20.0.3", Status: upgradeNodeFailed, Message: "unavailable, retrying..."},
+			{Node: "172.20.0.4", Status: upgradeNodeFailed},
+		},
+	}, report)
+	assert.Equal(t, common.ExitCodeDegraded, common.ExitCode(report.exitError(context.DeadlineExceeded)))
+
+	report = newUpgradeReport([]string{"172.20.0.2"}, nil, errors.New("connection refused"))
+
+	assert.Equal(t, upgradeStatusFailed, report.Status)
+	assert.Equal(t, common.ExitCodeFailed, common.ExitCode(report.exitError(errors.New("connection refused"))))
+
+	report = newUpgradeReport([]string{"172.20.0.2"}, statuses, nil)
+
+	assert.Equal(t, upgradeStatusSucceeded, report.Status)
+	assert.Equal(t, 0, common.ExitCode(report.exitError(nil)))
+}
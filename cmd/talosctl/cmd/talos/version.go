@@ -6,15 +6,21 @@ package talos
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/cache"
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/global"
 	"github.com/siderolabs/talos/pkg/cli"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/version"
 )
@@ -25,6 +31,7 @@ var versionCmdFlags struct {
 	shortVersion bool
 	json         bool
 	insecure     bool
+	cached       bool
 }
 
 // versionCmd represents the `talosctl version` command.
@@ -50,28 +57,65 @@ var versionCmd = &cobra.Command{
 			fmt.Println("Server:")
 		}
 
+		if versionCmdFlags.cached {
+			if versionCmdFlags.json || versionCmdFlags.insecure {
+				return errors.New("--cached flag is not supported with --json or --insecure flags")
+			}
+
+			if GlobalArgs.AllContextsRequested() {
+				return withAllContexts(printCachedVersions)
+			}
+
+			return printCachedVersions("")
+		}
+
+		if GlobalArgs.AllContextsRequested() {
+			if versionCmdFlags.json || versionCmdFlags.insecure {
+				return fmt.Errorf("--json and --insecure flags are not supported with --context %s", global.AllContexts)
+			}
+
+			return withAllContexts(func(contextName string) error {
+				return WithClient(cmdVersion(contextName))
+			})
+		}
+
 		if versionCmdFlags.insecure {
-			return WithClientMaintenance(nil, cmdVersion)
+			return WithClientMaintenance(nil, cmdVersion(""))
 		}
 
-		return WithClient(cmdVersion)
+		return WithClient(cmdVersion(""))
 	},
 }
 
-func cmdVersion(ctx context.Context, c *client.Client) error {
+// cmdVersion prints the server versions, contextName is printed for each node if it is set (with --context all).
+func cmdVersion(contextName string) func(ctx context.Context, c *client.Client) error {
+	return func(ctx context.Context, c *client.Client) error {
+		return printVersions(ctx, c, contextName)
+	}
+}
+
+func printVersions(ctx context.Context, c *client.Client, contextName string) error {
 	var remotePeer peer.Peer
 
 	resp, err := c.Version(ctx, grpc.Peer(&remotePeer))
 	if err != nil {
 		if resp == nil {
-			return fmt.Errorf("error getting version: %s", err)
+			return fmt.Errorf("error getting version: %w", err)
 		}
 
-		cli.Warning("%s", err)
+		common.WarnNodeErrors(err)
 	}
 
 	defaultNode := client.AddrFromPeer(&remotePeer)
 
+	var versions map[string]*cache.Version
+
+	if !versionCmdFlags.insecure {
+		versions = map[string]*cache.Version{}
+
+		defer updateCachedVersions(versions)
+	}
+
 	for _, msg := range resp.Messages {
 		node := defaultNode
 
@@ -79,9 +123,30 @@ func cmdVersion(ctx context.Context, c *client.Client) error {
 			node = msg.Metadata.Hostname
 		}
 
+		if versions != nil && msg.Version != nil {
+			versions[node] = &cache.Version{
+				Updated:   time.Now(),
+				Hostname:  msg.Hostname,
+				Tag:       msg.Version.Tag,
+				SHA:       msg.Version.Sha,
+				Built:     msg.Version.Built,
+				GoVersion: msg.Version.GoVersion,
+				OS:        msg.Version.Os,
+				Arch:      msg.Version.Arch,
+			}
+		}
+
 		if !versionCmdFlags.json {
+			if contextName != "" {
+				fmt.Printf("\t%s:     %s\n", "CONTEXT", contextName)
+			}
+
 			fmt.Printf("\t%s:        %s\n", "NODE", node)
 
+			if msg.Hostname != "" {
+				fmt.Printf("\tHostname:    %s\n", msg.Hostname)
+			}
+
 			version.PrintLongVersionFromExisting(msg.Version)
 
 			var enabledFeatures []string
@@ -105,10 +170,72 @@ func cmdVersion(ctx context.Context, c *client.Client) error {
 	return nil
 }
 
+// updateCachedVersions stores the node versions in the local cache, so that they can be shown with --cached.
+func updateCachedVersions(versions map[string]*cache.Version) {
+	if len(versions) == 0 {
+		return
+	}
+
+	nodeCache, _, err := GlobalArgs.OpenCache()
+	if err != nil {
+		return
+	}
+
+	for node, v := range versions {
+		nodeCache.Node(node).Version = v
+	}
+
+	if err = nodeCache.Save(); err != nil {
+		cli.Warning("failed to update the cache: %s", err)
+	}
+}
+
+func printCachedVersions(contextName string) error {
+	nodeCache, nodes, err := GlobalArgs.OpenCache()
+	if err != nil {
+		return err
+	}
+
+	for _, node := range nodes {
+		if contextName != "" {
+			fmt.Printf("\t%s:     %s\n", "CONTEXT", contextName)
+		}
+
+		fmt.Printf("\t%s:        %s\n", "NODE", node)
+
+		cached := nodeCache.Nodes[node]
+		if cached == nil || cached.Version == nil {
+			fmt.Printf("\tno cached version, run 'talosctl version' while the node is reachable\n")
+
+			continue
+		}
+
+		v := cached.Version
+
+		fmt.Printf("\t%s\n", cache.Stale(v.Updated))
+
+		if v.Hostname != "" {
+			fmt.Printf("\tHostname:    %s\n", v.Hostname)
+		}
+
+		version.PrintLongVersionFromExisting(&machine.VersionInfo{
+			Tag:       v.Tag,
+			Sha:       v.SHA,
+			Built:     v.Built,
+			GoVersion: v.GoVersion,
+			Os:        v.OS,
+			Arch:      v.Arch,
+		})
+	}
+
+	return nil
+}
+
 func init() {
 	versionCmd.Flags().BoolVar(&versionCmdFlags.shortVersion, "short", false, "Print the short version")
 	versionCmd.Flags().BoolVar(&versionCmdFlags.clientOnly, "client", false, "Print client version only")
 	versionCmd.Flags().BoolVarP(&versionCmdFlags.insecure, "insecure", "i", false, "use Talos maintenance mode API")
+	versionCmd.Flags().BoolVar(&versionCmdFlags.cached, "cached", false, "print the last known server versions from the local cache without connecting to the nodes")
 
 	// TODO remove when https://github.com/siderolabs/talos/issues/907 is implemented
 	versionCmd.Flags().BoolVar(&versionCmdFlags.json, "json", false, "")
@@ -0,0 +1,107 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/mattn/go-isatty"
+	"golang.org/x/term"
+)
+
+// watchPollInterval is the refresh interval for the watched outputs which can't be tracked via the events.
+const watchPollInterval = time.Second
+
+// watchRenderer renders the output of the watched command.
+//
+// If the output is a terminal, the previous output is replaced in place, otherwise
+// each new output is appended separated by an empty line.
+// The output is rendered only if it changed since the last render.
+type watchRenderer struct {
+	w          *os.File
+	lastOutput string
+	inPlace    bool
+}
+
+func newWatchRenderer() *watchRenderer {
+	return &watchRenderer{
+		w:       os.Stdout,
+		inPlace: isatty.IsTerminal(os.Stdout.Fd()),
+	}
+}
+
+// Render renders the output produced by the render function.
+func (r *watchRenderer) Render(render func(w io.Writer) error) error {
+	var buf bytes.Buffer
+
+	if err := render(&buf); err != nil {
+		return err
+	}
+
+	output := buf.String()
+
+	if output == r.lastOutput {
+		return nil
+	}
+
+	switch {
+	case r.lastOutput == "":
+	case r.inPlace:
+		if lines := r.terminalLines(r.lastOutput); lines > 0 {
+			fmt.Fprintf(r.w, "\033[%dA\033[J", lines) // cursor up, clear to the end of the screen
+		}
+	default:
+		fmt.Fprintln(r.w)
+	}
+
+	r.lastOutput = output
+
+	_, err := io.WriteString(r.w, output)
+
+	return err
+}
+
+// terminalLines returns the number of terminal lines occupied by the output, taking line wrapping into account.
+func (r *watchRenderer) terminalLines(output string) int {
+	width, _, _ := term.GetSize(int(r.w.Fd())) //nolint:errcheck
+	if width <= 0 {
+		width = 80
+	}
+
+	lines := 0
+
+	for _, line := range strings.Split(strings.TrimSuffix(output, "\n"), "\n") {
+		lines += max(1, (utf8.RuneCountInString(line)+width-1)/width)
+	}
+
+	return lines
+}
+
+// watchPoll re-renders the output every watchPollInterval until the context is canceled.
+func watchPoll(ctx context.Context, render func(ctx context.Context, w io.Writer) error) error {
+	renderer := newWatchRenderer()
+
+	ticker := time.NewTicker(watchPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := renderer.Render(func(w io.Writer) error { return render(ctx, w) }); err != nil {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
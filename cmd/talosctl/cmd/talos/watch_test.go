@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package talos //nolint:testpackage // to test unexported function
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatchRenderer(t *testing.T) {
+	t.Parallel()
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "output"))
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+	renderer := &watchRenderer{w: f}
+
+	render := func(output string) {
+		require.NoError(t, renderer.Render(func(w io.Writer) error {
+			_, err := io.WriteString(w, output)
+
+			return err
+		}))
+	}
+
+	render("NODE   SERVICE   STATE\n10.5.0.2   etcd   Preparing\n")
+	render("NODE   SERVICE   STATE\n10.5.0.2   etcd   Preparing\n")
+	render("NODE   SERVICE   STATE\n10.5.0.2   etcd   Running\n")
+
+	contents, err := os.ReadFile(f.Name())
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		"NODE   SERVICE   STATE\n10.5.0.2   etcd   Preparing\n\nNODE   SERVICE   STATE\n10.5.0.2   etcd   Running\n",
+		string(contents),
+	)
+}
+
+func TestWatchRendererTerminalLines(t *testing.T) {
+	t.Parallel()
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "output"))
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+	renderer := &watchRenderer{w: f}
+
+	// file is not a terminal, so the default width of 80 is used
+	assert.Equal(t, 2, renderer.terminalLines("a\nb\n"))
+	assert.Equal(t, 3, renderer.terminalLines("a\n"+string(make([]byte, 100))+"\n"))
+}
@@ -9,10 +9,11 @@ import (
 	"os"
 
 	"github.com/siderolabs/talos/cmd/talosctl/cmd"
+	"github.com/siderolabs/talos/cmd/talosctl/cmd/common"
 )
 
 func main() {
 	if err := cmd.Execute(); err != nil {
-		os.Exit(1)
+		os.Exit(common.ExitCode(err))
 	}
 }
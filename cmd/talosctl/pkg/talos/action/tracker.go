@@ -264,6 +264,13 @@ func (a *Tracker) Run() error {
 	return err
 }
 
+// NodeStatuses returns the last reported status of each node.
+//
+// It should be called after Run returns.
+func (a *Tracker) NodeStatuses() map[string]reporter.Update {
+	return a.nodeToLatestStatusUpdate
+}
+
 // runReporter starts the (colored) stderr reporter.
 func (a *Tracker) runReporter(ctx context.Context) error {
 	var (
@@ -290,6 +297,10 @@ func (a *Tracker) runReporter(ctx context.Context) error {
 
 		case update = <-a.reportCh:
 			if !a.isTerminal {
+				if update.node != "" {
+					a.nodeToLatestStatusUpdate[update.node] = update.update
+				}
+
 				fmt.Fprintf(os.Stderr, "%q: %v\n", update.node, update.update.Message)
 
 				continue
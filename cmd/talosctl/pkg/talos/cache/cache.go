@@ -0,0 +1,129 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package cache implements the local cache of the last known node versions and cluster members.
+//
+// The cache allows talosctl to show the data when the nodes are unreachable, clearly marked as stale.
+package cache
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	yaml "gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+)
+
+// Directory is the name of the cache directory, it is created next to the talosconfig.
+const Directory = "cache"
+
+// Cache of the node data for a single talosconfig context.
+type Cache struct {
+	path string
+
+	Nodes map[string]*Node `yaml:"nodes"`
+}
+
+// Node is the cached data of a single node.
+type Node struct {
+	Version *Version `yaml:"version,omitempty"`
+	Members *Members `yaml:"members,omitempty"`
+}
+
+// Version is the cached Talos version of the node.
+type Version struct {
+	Updated   time.Time `yaml:"updated"`
+	Hostname  string    `yaml:"hostname,omitempty"`
+	Tag       string    `yaml:"tag"`
+	SHA       string    `yaml:"sha"`
+	Built     string    `yaml:"built,omitempty"`
+	GoVersion string    `yaml:"goVersion,omitempty"`
+	OS        string    `yaml:"os,omitempty"`
+	Arch      string    `yaml:"arch,omitempty"`
+}
+
+// Members are the cached cluster members as seen by the node.
+type Members struct {
+	Updated time.Time `yaml:"updated"`
+	Items   []Member  `yaml:"items"`
+}
+
+// Member is a single cached cluster member.
+type Member struct {
+	ID   string             `yaml:"id"`
+	Spec cluster.MemberSpec `yaml:"spec"`
+}
+
+// Path returns the path of the cache file for the talosconfig path and context.
+func Path(talosconfigPath, contextName string) string {
+	return filepath.Join(filepath.Dir(talosconfigPath), Directory, contextName+".yaml")
+}
+
+// Load the cache from the file, a missing file results in an empty cache.
+func Load(path string) (*Cache, error) {
+	c := &Cache{
+		path:  path,
+		Nodes: map[string]*Node{},
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return c, nil
+		}
+
+		return nil, err
+	}
+
+	if err = yaml.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("error parsing cache %q: %w", path, err)
+	}
+
+	if c.Nodes == nil {
+		c.Nodes = map[string]*Node{}
+	}
+
+	return c, nil
+}
+
+// Node returns the cached data of the node, creating it if needed.
+func (c *Cache) Node(node string) *Node {
+	n, ok := c.Nodes[node]
+	if !ok {
+		n = &Node{}
+		c.Nodes[node] = n
+	}
+
+	return n
+}
+
+// Save the cache to the file atomically.
+func (c *Cache) Save() error {
+	data, err := yaml.Marshal(c)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(c.path), 0o700); err != nil {
+		return err
+	}
+
+	tmp := c.path + ".tmp"
+
+	if err = os.WriteFile(tmp, data, 0o600); err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, c.path)
+}
+
+// Stale formats the warning for the data cached at the given time.
+func Stale(updated time.Time) string {
+	return fmt.Sprintf("STALE: cached at %s (%s ago)", updated.Format(time.RFC3339), time.Since(updated).Truncate(time.Second))
+}
@@ -0,0 +1,68 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cache_test
+
+import (
+	"net/netip"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/cache"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+)
+
+func TestCache(t *testing.T) {
+	t.Parallel()
+
+	path := cache.Path(filepath.Join(t.TempDir(), "config"), "prod")
+
+	c, err := cache.Load(path)
+	require.NoError(t, err)
+	assert.Empty(t, c.Nodes)
+
+	updated := time.Now().Truncate(time.Second)
+
+	c.Node("10.5.0.2").Version = &cache.Version{
+		Updated: updated,
+		Tag:     "v1.9.0",
+		SHA:     "abcdef",
+	}
+
+	c.Node("10.5.0.2").Members = &cache.Members{
+		Updated: updated,
+		Items: []cache.Member{
+			{
+				ID: "cp-1",
+				Spec: cluster.MemberSpec{
+					NodeID:          "abc",
+					Addresses:       []netip.Addr{netip.MustParseAddr("10.5.0.2")},
+					Hostname:        "cp-1",
+					MachineType:     machine.TypeControlPlane,
+					OperatingSystem: "Talos (v1.9.0)",
+				},
+			},
+		},
+	}
+
+	require.NoError(t, c.Save())
+
+	c, err = cache.Load(path)
+	require.NoError(t, err)
+
+	require.Contains(t, c.Nodes, "10.5.0.2")
+	assert.Equal(t, "v1.9.0", c.Nodes["10.5.0.2"].Version.Tag)
+	assert.True(t, updated.Equal(c.Nodes["10.5.0.2"].Version.Updated))
+	require.Len(t, c.Nodes["10.5.0.2"].Members.Items, 1)
+	assert.Equal(t, "cp-1", c.Nodes["10.5.0.2"].Members.Items[0].ID)
+	assert.Equal(t, machine.TypeControlPlane, c.Nodes["10.5.0.2"].Members.Items[0].Spec.MachineType)
+	assert.Equal(t, []netip.Addr{netip.MustParseAddr("10.5.0.2")}, c.Nodes["10.5.0.2"].Members.Items[0].Spec.Addresses)
+
+	assert.Contains(t, cache.Stale(updated), "STALE: cached at ")
+}
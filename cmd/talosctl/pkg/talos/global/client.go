@@ -10,13 +10,23 @@ import (
 	"crypto/tls"
 	"errors"
 	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/dustin/go-humanize"
+	"github.com/hashicorp/go-multierror"
 	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/gen/maps"
 	"google.golang.org/grpc"
 
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/cache"
 	"github.com/siderolabs/talos/pkg/cli"
 	"github.com/siderolabs/talos/pkg/machinery/client"
 	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+	"github.com/siderolabs/talos/pkg/machinery/client/fake"
 )
 
 // Args is a context for the Talos command line client.
@@ -26,6 +36,17 @@ type Args struct {
 	Cluster     string
 	Nodes       []string
 	Endpoints   []string
+
+	// Concurrency and NodeTimeout apply to the commands which query each node separately, and to the unary requests to multiple nodes.
+	Concurrency int
+	NodeTimeout time.Duration
+
+	RequestTimeout time.Duration
+	Retries        int
+
+	// Compression is the list of compressors to negotiate, "none" disables the compression.
+	Compression    []string
+	MaxMessageSize string
 }
 
 // NodeList returns the list of nodes to run the command against.
@@ -33,21 +54,135 @@ func (c *Args) NodeList() []string {
 	return c.Nodes
 }
 
+// FanOutOptions returns the options to query each node separately.
+func (c *Args) FanOutOptions() client.FanOutOptions {
+	return client.FanOutOptions{
+		Concurrency: c.Concurrency,
+		Timeout:     c.NodeTimeout,
+	}
+}
+
+// OpenCache loads the local cache of the node data for the talosconfig context.
+//
+// It also returns the nodes to run the command against, resolved without connecting to the cluster.
+// The cache is stored next to the talosconfig, so it is not available if the talosconfig is read-only.
+func (c *Args) OpenCache() (*cache.Cache, []string, error) {
+	cfg, err := clientconfig.Open(c.Talosconfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to open config file %q: %w", c.Talosconfig, err)
+	}
+
+	if !cfg.Path().WriteAllowed {
+		return nil, nil, fmt.Errorf("cache is not available for the read-only config file %q", cfg.Path().Path)
+	}
+
+	contextName := cfg.Context
+
+	if c.CmdContext != "" {
+		contextName = c.CmdContext
+	}
+
+	configContext, ok := cfg.Contexts[contextName]
+	if !ok {
+		return nil, nil, ErrConfigContext
+	}
+
+	nodes := c.Nodes
+
+	if len(nodes) == 0 {
+		nodes = configContext.Nodes
+	}
+
+	nodeCache, err := cache.Load(cache.Path(cfg.Path().Path, contextName))
+
+	return nodeCache, nodes, err
+}
+
+// contextProxy returns the proxy configured for the talosconfig context, if any.
+//
+// The maintenance mode client doesn't use the talosconfig context otherwise, but the nodes might still be reachable only via the proxy.
+func (c *Args) contextProxy() (*clientconfig.Proxy, error) {
+	cfg, err := clientconfig.Open(c.Talosconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open config file %q: %w", c.Talosconfig, err)
+	}
+
+	contextName := cfg.Context
+
+	if c.CmdContext != "" {
+		contextName = c.CmdContext
+	}
+
+	configContext, ok := cfg.Contexts[contextName]
+	if !ok {
+		return nil, nil //nolint:nilnil
+	}
+
+	return configContext.Proxy, nil
+}
+
+// transportOptions returns the client options controlling the requests timeouts, retries, fan-out, compression and message size.
+func (c *Args) transportOptions() ([]client.OptionFunc, error) {
+	opts := []client.OptionFunc{
+		client.WithRequestTimeout(c.RequestTimeout),
+		client.WithRetries(c.Retries),
+		client.WithFanOut(c.FanOutOptions()),
+	}
+
+	if c.Compression != nil {
+		compressors := c.Compression
+
+		if slices.Equal(compressors, []string{"none"}) {
+			compressors = nil
+		}
+
+		opts = append(opts, client.WithCompression(compressors...))
+	}
+
+	if c.MaxMessageSize != "" {
+		size, err := humanize.ParseBytes(c.MaxMessageSize)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing max message size: %w", err)
+		}
+
+		if size == 0 || size > math.MaxInt32 {
+			return nil, fmt.Errorf("max message size should be between 1 byte and %s", humanize.IBytes(math.MaxInt32))
+		}
+
+		opts = append(opts, client.WithMaxRecvMsgSize(int(size)))
+	}
+
+	return opts, nil
+}
+
 // WithClientNoNodes wraps common code to initialize Talos client and provide cancellable context.
 //
 // WithClientNoNodes doesn't set any node information on the request context.
 func (c *Args) WithClientNoNodes(action func(context.Context, *client.Client) error, dialOptions ...grpc.DialOption) error {
 	return cli.WithContext(
 		context.Background(), func(ctx context.Context) error {
+			if c.AllContextsRequested() {
+				return fmt.Errorf("the command doesn't support --context %s", AllContexts)
+			}
+
+			transportOpts, err := c.transportOptions()
+			if err != nil {
+				return err
+			}
+
+			if fixturesDir, ok := c.mockEndpoint(); ok {
+				return withMockClient(ctx, fixturesDir, transportOpts, action)
+			}
+
 			cfg, err := clientconfig.Open(c.Talosconfig)
 			if err != nil {
 				return fmt.Errorf("failed to open config file %q: %w", c.Talosconfig, err)
 			}
 
-			opts := []client.OptionFunc{
+			opts := append([]client.OptionFunc{
 				client.WithConfig(cfg),
 				client.WithGRPCDialOptions(dialOptions...),
-			}
+			}, transportOpts...)
 
 			if c.CmdContext != "" {
 				opts = append(opts, client.WithContextName(c.CmdContext))
@@ -74,6 +209,97 @@ func (c *Args) WithClientNoNodes(action func(context.Context, *client.Client) er
 	)
 }
 
+// mockEndpointPrefix is the prefix of the endpoint which serves canned responses from the fixtures directory.
+const mockEndpointPrefix = "mock://"
+
+// mockEndpoint returns the fixtures directory if the mock endpoint is set.
+func (c *Args) mockEndpoint() (string, bool) {
+	if len(c.Endpoints) != 1 {
+		return "", false
+	}
+
+	return strings.CutPrefix(c.Endpoints[0], mockEndpointPrefix)
+}
+
+// withMockClient runs the action with the client connected to the fake API server,
+// which serves canned responses from the fixtures directory (see package fake).
+func withMockClient(ctx context.Context, fixturesDir string, transportOpts []client.OptionFunc, action func(context.Context, *client.Client) error) error {
+	if _, err := os.Stat(fixturesDir); err != nil {
+		return fmt.Errorf("error opening fixtures directory: %w", err)
+	}
+
+	srv := fake.NewServer(os.DirFS(fixturesDir))
+	defer srv.Stop()
+
+	c, err := srv.Client(ctx, transportOpts...)
+	if err != nil {
+		return fmt.Errorf("error constructing client: %w", err)
+	}
+	//nolint:errcheck
+	defer c.Close()
+
+	return action(ctx, c)
+}
+
+// AllContexts is the value of the --context flag which runs the command against every context in the talosconfig.
+const AllContexts = "all"
+
+// AllContextsRequested returns true if the command should run against every context in the talosconfig.
+func (c *Args) AllContextsRequested() bool {
+	return c.CmdContext == AllContexts
+}
+
+// ForEachContext runs the action for each context in the talosconfig (in the order of the context names).
+//
+// The action gets a copy of the arguments with the context set, the nodes and the endpoints come from the context.
+// The action runs for all contexts even if some of them fail, the errors are returned together annotated with the context name.
+func (c *Args) ForEachContext(action func(contextName string, args *Args) error) error {
+	if len(c.Nodes) > 0 || len(c.Endpoints) > 0 || c.Cluster != "" {
+		return fmt.Errorf("--nodes, --endpoints and --cluster flags can't be used with --context %s", AllContexts)
+	}
+
+	cfg, err := clientconfig.Open(c.Talosconfig)
+	if err != nil {
+		return fmt.Errorf("failed to open config file %q: %w", c.Talosconfig, err)
+	}
+
+	contextNames := maps.Keys(cfg.Contexts)
+	slices.Sort(contextNames)
+
+	if len(contextNames) == 0 {
+		return ErrConfigContext
+	}
+
+	var multiErr *multierror.Error
+
+	for _, contextName := range contextNames {
+		contextArgs := *c
+		contextArgs.CmdContext = contextName
+
+		if err = action(contextName, &contextArgs); err != nil {
+			multiErr = multierror.Append(multiErr, &ContextError{Context: contextName, Err: err})
+		}
+	}
+
+	return multiErr.ErrorOrNil()
+}
+
+// ContextError is the error of the command for one of the contexts.
+type ContextError struct {
+	Context string
+	Err     error
+}
+
+// Error implements error interface.
+func (e *ContextError) Error() string {
+	return fmt.Sprintf("context %q: %s", e.Context, e.Err)
+}
+
+// Unwrap implements errors.Unwrap interface.
+func (e *ContextError) Unwrap() error {
+	return e.Err
+}
+
 // ErrConfigContext is returned when config context cannot be resolved.
 var ErrConfigContext = errors.New("failed to resolve config context")
 
@@ -125,7 +351,23 @@ func (c *Args) WithClientMaintenance(enforceFingerprints []string, action func(c
 				tlsConfig.VerifyConnection = x509.MatchSPKIFingerprints(fingerprints...)
 			}
 
-			c, err := client.New(ctx, client.WithTLSConfig(tlsConfig), client.WithEndpoints(c.Nodes...))
+			transportOpts, err := c.transportOptions()
+			if err != nil {
+				return err
+			}
+
+			proxy, err := c.contextProxy()
+			if err != nil {
+				return err
+			}
+
+			c, err := client.New(ctx,
+				append([]client.OptionFunc{
+					client.WithTLSConfig(tlsConfig),
+					client.WithEndpoints(c.Nodes...),
+					client.WithProxy(proxy),
+				}, transportOpts...)...,
+			)
 			if err != nil {
 				return err
 			}
@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package global_test
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/global"
+	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+)
+
+func TestForEachContext(t *testing.T) {
+	t.Parallel()
+
+	talosconfig := filepath.Join(t.TempDir(), "talosconfig")
+
+	cfg := &clientconfig.Config{
+		Context: "staging",
+		Contexts: map[string]*clientconfig.Context{
+			"staging":    {Endpoints: []string{"10.5.0.2"}},
+			"production": {Endpoints: []string{"10.6.0.2"}},
+			"edge":       {Endpoints: []string{"10.7.0.2"}},
+		},
+	}
+
+	require.NoError(t, cfg.Save(talosconfig))
+
+	args := &global.Args{
+		Talosconfig: talosconfig,
+		CmdContext:  global.AllContexts,
+	}
+
+	assert.True(t, args.AllContextsRequested())
+
+	var visited []string
+
+	err := args.ForEachContext(func(contextName string, contextArgs *global.Args) error {
+		assert.Equal(t, contextName, contextArgs.CmdContext)
+		assert.False(t, contextArgs.AllContextsRequested())
+
+		visited = append(visited, contextName)
+
+		if contextName == "production" {
+			return errors.New("unreachable")
+		}
+
+		return nil
+	})
+
+	assert.Equal(t, []string{"edge", "production", "staging"}, visited)
+	assert.EqualError(t, err, "1 error occurred:\n\t* context \"production\": unreachable\n\n")
+
+	var contextErr *global.ContextError
+
+	require.ErrorAs(t, err, &contextErr)
+	assert.Equal(t, "production", contextErr.Context)
+
+	// the arguments of the command are not modified
+	assert.Equal(t, global.AllContexts, args.CmdContext)
+
+	args.Nodes = []string{"10.5.0.3"}
+
+	assert.Error(t, args.ForEachContext(func(string, *global.Args) error {
+		t.Fatal("unexpected call")
+
+		return nil
+	}))
+}
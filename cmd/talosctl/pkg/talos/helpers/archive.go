@@ -74,7 +74,7 @@ func ExtractTarGz(localPath string, r io.ReadCloser) error {
 				break
 			}
 
-			return fmt.Errorf("error reading tar header: %s", err)
+			return fmt.Errorf("error reading tar header: %w", err)
 		}
 
 		hdrPath := safepath.CleanPath(hdr.Name)
@@ -31,3 +31,18 @@ func AppendErrors(err error, errs ...error) error {
 
 	return res
 }
+
+// PartialError is returned when the command failed on some of the nodes, while the other nodes responded.
+type PartialError struct {
+	Err error
+}
+
+// Error implements error interface.
+func (e *PartialError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap implements errors.Unwrap interface.
+func (e *PartialError) Unwrap() error {
+	return e.Err
+}
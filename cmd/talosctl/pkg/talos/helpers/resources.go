@@ -11,18 +11,20 @@ import (
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/state"
-	"google.golang.org/grpc/metadata"
 
 	"github.com/siderolabs/talos/pkg/machinery/client"
 )
 
 // ForEachResource gets resources from the controller runtime and runs a callback for each resource.
 //
+// The resources are fetched from the nodes in parallel (see client.FanOut), and the callbacks are called in the order of the nodes.
+//
 //nolint:gocyclo
 func ForEachResource(ctx context.Context,
 	c *client.Client,
 	callbackRD func(rd *meta.ResourceDefinition) error,
 	callback func(ctx context.Context, hostname string, r resource.Resource, callError error) error,
+	fanOut client.FanOutOptions,
 	namespace string,
 	args ...string,
 ) error {
@@ -38,12 +40,7 @@ func ForEachResource(ctx context.Context,
 		resourceID = args[1]
 	}
 
-	md, _ := metadata.FromOutgoingContext(ctx)
-	nodes := md.Get("nodes")
-
-	if len(nodes) == 0 {
-		nodes = []string{""}
-	}
+	nodes := client.NodesFromContext(ctx)
 
 	// fetch the RD from the first node (it doesn't matter which one to use, so we'll use the first one)
 	rd, err := c.ResolveResourceKind(client.WithNode(ctx, nodes[0]), &namespace, resourceType)
@@ -59,43 +56,41 @@ func ForEachResource(ctx context.Context,
 
 	resourceType = rd.TypedSpec().Type
 
-	for _, node := range nodes {
-		var nodeCtx context.Context
-
-		if node == "" {
-			nodeCtx = ctx
-		} else {
-			nodeCtx = client.WithNode(ctx, node)
-		}
-
+	results := client.FanOut(ctx, nodes, fanOut, func(nodeCtx context.Context, _ string) ([]resource.Resource, error) {
 		if resourceID != "" {
 			r, callErr := c.COSI.Get(
 				nodeCtx,
-				resource.NewMetadata(namespace, rd.TypedSpec().Type, resourceID, resource.VersionUndefined),
+				resource.NewMetadata(namespace, resourceType, resourceID, resource.VersionUndefined),
 				state.WithGetUnmarshalOptions(state.WithSkipProtobufUnmarshal()),
 			)
 
-			if err = callback(ctx, node, r, callErr); err != nil {
+			return []resource.Resource{r}, callErr
+		}
+
+		items, callErr := c.COSI.List(
+			nodeCtx,
+			resource.NewMetadata(namespace, resourceType, "", resource.VersionUndefined),
+			state.WithListUnmarshalOptions(state.WithSkipProtobufUnmarshal()),
+		)
+		if callErr != nil {
+			return nil, callErr
+		}
+
+		return items.Items, nil
+	})
+
+	for _, result := range results {
+		if result.Err != nil {
+			if err = callback(ctx, result.Node, nil, result.Err); err != nil {
 				return err
 			}
-		} else {
-			items, callErr := c.COSI.List(
-				nodeCtx,
-				resource.NewMetadata(namespace, resourceType, "", resource.VersionUndefined),
-				state.WithListUnmarshalOptions(state.WithSkipProtobufUnmarshal()),
-			)
-			if callErr != nil {
-				if err = callback(ctx, node, nil, callErr); err != nil {
-					return err
-				}
 
-				continue
-			}
+			continue
+		}
 
-			for _, r := range items.Items {
-				if err = callback(ctx, node, r, nil); err != nil {
-					return err
-				}
+		for _, r := range result.Value {
+			if err = callback(ctx, result.Node, r, nil); err != nil {
+				return err
 			}
 		}
 	}
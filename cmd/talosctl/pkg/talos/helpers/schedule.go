@@ -0,0 +1,43 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package helpers
+
+import (
+	"fmt"
+	"time"
+)
+
+// ParseScheduleTime parses the time to schedule an action at.
+//
+// Supported formats are:
+//   - duration relative to now, e.g. "10m" or "1h30m";
+//   - time of the day "HH:MM" in the local timezone, the next occurrence of the time is used;
+//   - RFC3339 timestamp, e.g. "2024-01-02T15:04:05Z".
+func ParseScheduleTime(s string, now time.Time) (time.Time, error) {
+	if d, err := time.ParseDuration(s); err == nil {
+		if d < 0 {
+			return time.Time{}, fmt.Errorf("negative duration %q", s)
+		}
+
+		return now.Add(d), nil
+	}
+
+	if t, err := time.ParseInLocation("15:04", s, now.Location()); err == nil {
+		at := time.Date(now.Year(), now.Month(), now.Day(), t.Hour(), t.Minute(), 0, 0, now.Location())
+
+		if !at.After(now) {
+			at = at.AddDate(0, 0, 1)
+		}
+
+		return at, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid time %q: expected duration, \"HH:MM\" or RFC3339 timestamp", s)
+	}
+
+	return t, nil
+}
@@ -0,0 +1,60 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package helpers_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/cmd/talosctl/pkg/talos/helpers"
+)
+
+func TestParseScheduleTime(t *testing.T) {
+	now := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+
+	for _, test := range []struct {
+		name     string
+		input    string
+		expected time.Time
+	}{
+		{
+			name:     "duration",
+			input:    "10m",
+			expected: now.Add(10 * time.Minute),
+		},
+		{
+			name:     "time today",
+			input:    "23:30",
+			expected: time.Date(2024, 1, 2, 23, 30, 0, 0, time.UTC),
+		},
+		{
+			name:     "time tomorrow",
+			input:    "02:00",
+			expected: time.Date(2024, 1, 3, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:     "rfc3339",
+			input:    "2024-02-01T10:00:00Z",
+			expected: time.Date(2024, 2, 1, 10, 0, 0, 0, time.UTC),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			at, err := helpers.ParseScheduleTime(test.input, now)
+			require.NoError(t, err)
+
+			assert.True(t, test.expected.Equal(at), "expected %s, got %s", test.expected, at)
+		})
+	}
+
+	for _, input := range []string{"-5m", "25:00", "tomorrow"} {
+		t.Run(input, func(t *testing.T) {
+			_, err := helpers.ParseScheduleTime(input, now)
+			assert.Error(t, err)
+		})
+	}
+}
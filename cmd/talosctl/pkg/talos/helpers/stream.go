@@ -30,8 +30,13 @@ type Message interface {
 }
 
 // ReadGRPCStream consumes all messages from the gRPC stream, handles errors, calls the passed handler for each message.
+//
+// If some of the messages were handled successfully, the errors are reported as PartialError.
 func ReadGRPCStream[S Stream[T], T Message](stream S, handler func(T, string, bool) error) error {
-	var streamErrs error
+	var (
+		streamErrs error
+		handled    bool
+	)
 
 	defaultNode := client.RemotePeer(stream.Context())
 
@@ -41,10 +46,14 @@ func ReadGRPCStream[S Stream[T], T Message](stream S, handler func(T, string, bo
 		info, err := stream.Recv()
 		if err != nil {
 			if err == io.EOF || client.StatusCode(err) == codes.Canceled {
+				if streamErrs != nil && handled {
+					return &PartialError{Err: streamErrs}
+				}
+
 				return streamErrs
 			}
 
-			return fmt.Errorf("error streaming results: %s", err)
+			return fmt.Errorf("error streaming results: %w", err)
 		}
 
 		node := defaultNode
@@ -72,6 +81,8 @@ func ReadGRPCStream[S Stream[T], T Message](stream S, handler func(T, string, bo
 
 			return err
 		}
+
+		handled = true
 	}
 }
 
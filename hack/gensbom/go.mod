@@ -0,0 +1,3 @@
+module gensbom
+
+go 1.23.1
@@ -0,0 +1,317 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package main generates the SPDX SBOM for the Talos rootfs.
+//
+// Go module versions are extracted from the build info embedded into the Go binaries found in the rootfs,
+// additional packages are passed with the -package flag.
+//
+// The SBOM is partial: the components without the embedded build info (e.g. the C libraries and tools
+// built in siderolabs/pkgs) are only described with the version of the package which they come from.
+package main
+
+import (
+	"debug/buildinfo"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type document struct {
+	SPDXVersion       string         `json:"spdxVersion"`
+	DataLicense       string         `json:"dataLicense"`
+	SPDXID            string         `json:"SPDXID"`
+	Name              string         `json:"name"`
+	DocumentNamespace string         `json:"documentNamespace"`
+	CreationInfo      creationInfo   `json:"creationInfo"`
+	Packages          []pkg          `json:"packages"`
+	Relationships     []relationship `json:"relationships"`
+}
+
+type creationInfo struct {
+	Created  string   `json:"created"`
+	Creators []string `json:"creators"`
+}
+
+type pkg struct {
+	Name             string        `json:"name"`
+	SPDXID           string        `json:"SPDXID"`
+	VersionInfo      string        `json:"versionInfo"`
+	DownloadLocation string        `json:"downloadLocation"`
+	LicenseConcluded string        `json:"licenseConcluded"`
+	LicenseDeclared  string        `json:"licenseDeclared"`
+	FilesAnalyzed    bool          `json:"filesAnalyzed"`
+	ExternalRefs     []externalRef `json:"externalRefs,omitempty"`
+}
+
+type externalRef struct {
+	ReferenceCategory string `json:"referenceCategory"`
+	ReferenceType     string `json:"referenceType"`
+	ReferenceLocator  string `json:"referenceLocator"`
+}
+
+type relationship struct {
+	SPDXElementID      string `json:"spdxElementId"`
+	RelationshipType   string `json:"relationshipType"`
+	RelatedSPDXElement string `json:"relatedSpdxElement"`
+}
+
+type packageList []string
+
+func (l *packageList) String() string {
+	return strings.Join(*l, ",")
+}
+
+func (l *packageList) Set(value string) error {
+	if !strings.Contains(value, "@") {
+		return fmt.Errorf("package should be in the name@version format: %q", value)
+	}
+
+	*l = append(*l, value)
+
+	return nil
+}
+
+func main() {
+	var (
+		name     string
+		version  string
+		license  string
+		output   string
+		packages packageList
+	)
+
+	flag.StringVar(&name, "name", "talos", "name of the described package")
+	flag.StringVar(&version, "version", "", "version of the described package")
+	flag.StringVar(&license, "license", "MPL-2.0", "license of the described package")
+	flag.StringVar(&output, "o", "", "output file (default is stdout)")
+	flag.Var(&packages, "package", "additional package in the name@version format (can be repeated)")
+	flag.Parse()
+
+	doc, err := generate(name, version, license, packages, flag.Args())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	out := os.Stdout
+
+	if output != "" {
+		if err = os.MkdirAll(filepath.Dir(output), 0o755); err != nil {
+			log.Fatal(err)
+		}
+
+		if out, err = os.Create(output); err != nil {
+			log.Fatal(err)
+		}
+
+		defer out.Close() //nolint:errcheck
+	}
+
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+
+	if err = enc.Encode(doc); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func generate(name, version, license string, packages packageList, paths []string) (*document, error) {
+	created := time.Now()
+
+	// keep the build reproducible
+	if epoch := os.Getenv("SOURCE_DATE_EPOCH"); epoch != "" {
+		sec, err := strconv.ParseInt(epoch, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid SOURCE_DATE_EPOCH: %w", err)
+		}
+
+		created = time.Unix(sec, 0)
+	}
+
+	mainID := "SPDXRef-Package-" + sanitize(name)
+
+	doc := &document{
+		SPDXVersion:       "SPDX-2.3",
+		DataLicense:       "CC0-1.0",
+		SPDXID:            "SPDXRef-DOCUMENT",
+		Name:              name,
+		DocumentNamespace: fmt.Sprintf("https://siderolabs.com/spdx/%s-%s", name, version),
+		CreationInfo: creationInfo{
+			Created:  created.UTC().Format(time.RFC3339),
+			Creators: []string{"Organization: Sidero Labs, Inc.", "Tool: gensbom"},
+		},
+		Packages: []pkg{
+			{
+				Name:             name,
+				SPDXID:           mainID,
+				VersionInfo:      version,
+				DownloadLocation: "NOASSERTION",
+				LicenseConcluded: "NOASSERTION",
+				LicenseDeclared:  license,
+			},
+		},
+		Relationships: []relationship{
+			{
+				SPDXElementID:      "SPDXRef-DOCUMENT",
+				RelationshipType:   "DESCRIBES",
+				RelatedSPDXElement: mainID,
+			},
+		},
+	}
+
+	seen := map[string]struct{}{}
+
+	addPackage := func(name, version, purl string) {
+		key := name + "@" + version
+
+		if _, ok := seen[key]; ok {
+			return
+		}
+
+		seen[key] = struct{}{}
+
+		p := pkg{
+			Name:             name,
+			SPDXID:           "SPDXRef-Package-" + sanitize(key),
+			VersionInfo:      version,
+			DownloadLocation: "NOASSERTION",
+			LicenseConcluded: "NOASSERTION",
+			LicenseDeclared:  "NOASSERTION",
+		}
+
+		if purl != "" {
+			p.ExternalRefs = []externalRef{
+				{
+					ReferenceCategory: "PACKAGE-MANAGER",
+					ReferenceType:     "purl",
+					ReferenceLocator:  purl,
+				},
+			}
+		}
+
+		doc.Packages = append(doc.Packages, p)
+		doc.Relationships = append(doc.Relationships, relationship{
+			SPDXElementID:      mainID,
+			RelationshipType:   "CONTAINS",
+			RelatedSPDXElement: p.SPDXID,
+		})
+	}
+
+	for _, p := range packages {
+		pkgName, pkgVersion, _ := strings.Cut(p, "@")
+
+		addPackage(pkgName, pkgVersion, "")
+	}
+
+	infos, err := readBuildInfo(paths)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, info := range infos {
+		addPackage("go", strings.TrimPrefix(info.GoVersion, "go"), "pkg:golang/stdlib@"+info.GoVersion)
+
+		// the main module of the binary is versioned only if it was built from the module (e.g. containerd or runc)
+		if info.Main.Version != "" && info.Main.Version != "(devel)" {
+			addPackage(info.Main.Path, info.Main.Version, "pkg:golang/"+info.Main.Path+"@"+info.Main.Version)
+		}
+
+		for _, dep := range info.Deps {
+			if dep.Replace != nil {
+				dep = dep.Replace
+			}
+
+			addPackage(dep.Path, dep.Version, "pkg:golang/"+dep.Path+"@"+dep.Version)
+		}
+	}
+
+	// keep the output stable, the described package goes first
+	slices.SortFunc(doc.Packages[1:], func(a, b pkg) int {
+		return strings.Compare(a.SPDXID, b.SPDXID)
+	})
+
+	slices.SortFunc(doc.Relationships[1:], func(a, b relationship) int {
+		return strings.Compare(a.RelatedSPDXElement, b.RelatedSPDXElement)
+	})
+
+	return doc, nil
+}
+
+// readBuildInfo reads the build info of the Go binaries.
+//
+// The paths might be the binaries or the directories, which are searched for the Go binaries recursively.
+func readBuildInfo(paths []string) ([]*debug.BuildInfo, error) {
+	var infos []*debug.BuildInfo
+
+	for _, root := range paths {
+		st, err := os.Stat(root)
+		if err != nil {
+			return nil, err
+		}
+
+		if !st.IsDir() {
+			info, err := buildinfo.ReadFile(root)
+			if err != nil {
+				return nil, fmt.Errorf("error reading build info of %q: %w", root, err)
+			}
+
+			infos = append(infos, info)
+
+			continue
+		}
+
+		if err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !d.Type().IsRegular() {
+				return nil
+			}
+
+			fi, err := d.Info()
+			if err != nil {
+				return err
+			}
+
+			if fi.Mode().Perm()&0o111 == 0 {
+				return nil
+			}
+
+			info, err := buildinfo.ReadFile(path)
+			if err != nil {
+				// not a Go binary
+				return nil //nolint:nilerr
+			}
+
+			infos = append(infos, info)
+
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return infos, nil
+}
+
+// sanitize converts the string to the valid SPDX identifier.
+func sanitize(s string) string {
+	return strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9') || r == '.' || r == '-' {
+			return r
+		}
+
+		return '-'
+	}, s)
+}
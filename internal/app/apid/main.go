@@ -12,9 +12,12 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"math"
+	"net"
 	"os/signal"
 	"regexp"
 	"slices"
+	"strings"
 	"syscall"
 	"time"
 
@@ -31,8 +34,11 @@ import (
 	apidbackend "github.com/siderolabs/talos/internal/app/apid/pkg/backend"
 	"github.com/siderolabs/talos/internal/app/apid/pkg/director"
 	"github.com/siderolabs/talos/internal/app/apid/pkg/provider"
+	"github.com/siderolabs/talos/internal/pkg/profiling"
+	"github.com/siderolabs/talos/internal/pkg/vsock"
 	"github.com/siderolabs/talos/pkg/grpc/factory"
 	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	"github.com/siderolabs/talos/pkg/grpc/middleware/ratelimit"
 	"github.com/siderolabs/talos/pkg/grpc/proxy/backend"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/startup"
@@ -50,6 +56,12 @@ func runDebugServer(ctx context.Context) {
 	}
 }
 
+func runProfilingServer(ctx context.Context) {
+	if err := profiling.Serve(ctx, constants.ApidProfilingSocketPath); err != nil {
+		log.Printf("failed to serve profiles: %s", err)
+	}
+}
+
 // Main is the entrypoint of apid.
 func Main() {
 	if err := apidMain(); err != nil {
@@ -66,10 +78,16 @@ func apidMain() error {
 
 	rbacEnabled := flag.Bool("enable-rbac", false, "enable RBAC for Talos API")
 	extKeyUsageCheckEnabled := flag.Bool("enable-ext-key-usage-check", false, "enable check for client certificate ext key usage")
+	rateLimitMethods := flag.String("rate-limit-methods", "", "comma-separated list of API methods to rate limit for each client")
+	rateLimitRequestsPerMinute := flag.Int("rate-limit-requests-per-minute", 60, "number of requests per minute each client can make to each rate limited method")
+	rateLimitBurst := flag.Int("rate-limit-burst", 10, "number of requests each client can make in a burst above the rate limit")
+	rateLimitMaxConcurrentRequests := flag.Int("rate-limit-max-concurrent-requests", 5, "number of concurrent requests to the rate limited methods for each client")
+	vsockPort := flag.Int("vsock-port", 0, "serve the API on the vsock port in addition to the network (zero disables the vsock listener)")
 
 	flag.Parse()
 
 	go runDebugServer(ctx)
+	go runProfilingServer(ctx)
 
 	startup.LimitMaxProcs(constants.ApidMaxProcs)
 
@@ -120,9 +138,15 @@ func apidMain() error {
 
 	router := director.NewRouter(remoteFactory, localBackend, localAddressProvider)
 
+	if debug.Enabled {
+		// the debugger stops the debugged process, so the debugger tunnel is served by debugd
+		router.RegisterLocalBackend("/machine.MachineService/DebugAttach", backend.NewLocal("debugd", constants.DebugdSocketPath))
+	}
+
 	// all existing streaming methods
 	for _, methodName := range []string{
 		"/machine.MachineService/Copy",
+		"/machine.MachineService/DebugAttach",
 		"/machine.MachineService/DiskUsage",
 		"/machine.MachineService/Dmesg",
 		"/machine.MachineService/EtcdSnapshot",
@@ -132,7 +156,11 @@ func apidMain() error {
 		"/machine.MachineService/List",
 		"/machine.MachineService/Logs",
 		"/machine.MachineService/PacketCapture",
+		"/machine.MachineService/Profile",
 		"/machine.MachineService/Read",
+		"/machine.MachineService/StackDump",
+		"/machine.MachineService/Strace",
+		"/machine.MachineService/Trace",
 		"/os.OSService/Dmesg",
 		"/cluster.ClusterService/HealthCheck",
 	} {
@@ -157,6 +185,22 @@ func apidMain() error {
 		return fmt.Errorf("error creating listner: %w", err)
 	}
 
+	// the vsock listener is a fallback access path, so apid keeps serving the API over the network
+	// if the VM sockets are not available (e.g. not a virtual machine)
+	var vsockListener net.Listener
+
+	if *vsockPort != 0 {
+		// the highest port is reserved (VMADDR_PORT_ANY)
+		if *vsockPort < 0 || int64(*vsockPort) >= math.MaxUint32 {
+			return fmt.Errorf("invalid vsock port: %d", *vsockPort)
+		}
+
+		vsockListener, err = vsock.Listen(uint32(*vsockPort))
+		if err != nil {
+			log.Printf("failed to listen on vsock port %d: %s", *vsockPort, err)
+		}
+	}
+
 	networkServer := func() *grpc.Server {
 		mode := authz.Disabled
 		if *rbacEnabled {
@@ -171,8 +215,7 @@ func apidMain() error {
 			injector.Logger = log.New(log.Writer(), "apid/authz/injector/http ", log.Flags()).Printf
 		}
 
-		return factory.NewServer(
-			router,
+		options := []factory.Option{
 			factory.WithDefaultLog(),
 			factory.ServerOptions(
 				grpc.Creds(
@@ -189,7 +232,25 @@ func apidMain() error {
 			),
 			factory.WithUnaryInterceptor(injector.UnaryInterceptor()),
 			factory.WithStreamInterceptor(injector.StreamInterceptor()),
-		)
+		}
+
+		// requests proxied from other apid instances carry the identity of the original client,
+		// so each node enforces the limits for the original client
+		if *rateLimitMethods != "" {
+			limiter := ratelimit.NewLimiter(
+				strings.Split(*rateLimitMethods, ","),
+				*rateLimitRequestsPerMinute,
+				*rateLimitBurst,
+				*rateLimitMaxConcurrentRequests,
+			)
+
+			options = append(options,
+				factory.WithUnaryInterceptor(limiter.UnaryInterceptor()),
+				factory.WithStreamInterceptor(limiter.StreamInterceptor()),
+			)
+		}
+
+		return factory.NewServer(router, options...)
 	}()
 
 	socketServer := func() *grpc.Server {
@@ -229,6 +290,13 @@ func apidMain() error {
 		return socketServer.Serve(socketListener)
 	})
 
+	if vsockListener != nil {
+		// the same server as for the network listener: TLS, RBAC and rate limits apply to the vsock clients
+		errGroup.Go(func() error {
+			return networkServer.Serve(vsockListener)
+		})
+	}
+
 	errGroup.Go(func() error {
 		return tlsConfig.Watch(ctx, onPKIUpdate)
 	})
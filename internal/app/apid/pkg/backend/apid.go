@@ -22,6 +22,7 @@ import (
 	"google.golang.org/protobuf/encoding/protowire"
 
 	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	"github.com/siderolabs/talos/pkg/grpc/middleware/ratelimit"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/proto"
@@ -71,6 +72,7 @@ func (a *APID) GetConnection(ctx context.Context, fullMethodName string) (contex
 	md = md.Copy()
 
 	authz.SetMetadata(md, authz.GetRoles(ctx))
+	ratelimit.SetMetadata(md, ratelimit.Identity(ctx))
 
 	if authority := md[":authority"]; len(authority) > 0 {
 		md.Set("proxyfrom", authority...)
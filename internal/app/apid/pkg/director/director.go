@@ -21,6 +21,7 @@ import (
 // Router wraps grpc-proxy StreamDirector.
 type Router struct {
 	localBackend         proxy.Backend
+	localMethodBackends  map[string]proxy.Backend
 	remoteBackendFactory RemoteBackendFactory
 	localAddressProvider LocalAddressProvider
 	streamedMatchers     []*regexp.Regexp
@@ -50,11 +51,11 @@ func (r *Router) Register(srv *grpc.Server) {
 func (r *Router) Director(ctx context.Context, fullMethodName string) (proxy.Mode, []proxy.Backend, error) {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
-		return proxy.One2One, []proxy.Backend{r.localBackend}, nil
+		return r.localDirector(ctx, fullMethodName)
 	}
 
 	if _, exists := md["proxyfrom"]; exists {
-		return proxy.One2One, []proxy.Backend{r.localBackend}, nil
+		return r.localDirector(ctx, fullMethodName)
 	}
 
 	nodes, okNodes := md["nodes"]
@@ -64,6 +65,10 @@ func (r *Router) Director(ctx context.Context, fullMethodName string) (proxy.Mod
 		return proxy.One2One, nil, status.Error(codes.InvalidArgument, "node metadata must be single-valued")
 	}
 
+	if err := r.checkNodeScope(ctx, append(slices.Clone(node), nodes...)); err != nil {
+		return proxy.One2One, nil, err
+	}
+
 	// special handling for cases when a single node is requested, but forwarding is disabled
 	//
 	// if there's a single destination, and that destination is local node, skip forwarding and send a request to the same node
@@ -89,8 +94,26 @@ func (r *Router) Director(ctx context.Context, fullMethodName string) (proxy.Mod
 		return r.singleDirector(node[0])
 	default:
 		// send directly to local node, skips another layer of proxying
-		return proxy.One2One, []proxy.Backend{r.localBackend}, nil
+		return proxy.One2One, []proxy.Backend{r.local(fullMethodName)}, nil
+	}
+}
+
+// localDirector sends request to the local node.
+func (r *Router) localDirector(ctx context.Context, fullMethodName string) (proxy.Mode, []proxy.Backend, error) {
+	if err := r.checkNodeScope(ctx, nil); err != nil {
+		return proxy.One2One, nil, err
+	}
+
+	return proxy.One2One, []proxy.Backend{r.local(fullMethodName)}, nil
+}
+
+// local returns the backend serving the method on the local node.
+func (r *Router) local(fullMethodName string) proxy.Backend {
+	if backend, ok := r.localMethodBackends[fullMethodName]; ok {
+		return backend
 	}
+
+	return r.localBackend
 }
 
 // singleDirector sends request to a single instance in one-2-one mode.
@@ -139,3 +162,12 @@ func (r *Router) StreamedDetector(fullMethodName string) bool {
 func (r *Router) RegisterStreamedRegex(regex string) {
 	r.streamedMatchers = append(r.streamedMatchers, regexp.MustCompile(regex))
 }
+
+// RegisterLocalBackend registers the backend which serves the method on the local node instead of the default local backend.
+func (r *Router) RegisterLocalBackend(fullMethodName string, backend proxy.Backend) {
+	if r.localMethodBackends == nil {
+		r.localMethodBackends = map[string]proxy.Backend{}
+	}
+
+	r.localMethodBackends[fullMethodName] = backend
+}
@@ -6,16 +6,22 @@ package director_test
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
 	"regexp"
 	"testing"
 
 	"github.com/siderolabs/grpc-proxy/proxy"
 	"github.com/stretchr/testify/suite"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 
 	"github.com/siderolabs/talos/internal/app/apid/pkg/director"
+	"github.com/siderolabs/talos/pkg/machinery/role"
 )
 
 type DirectorSuite struct {
@@ -102,6 +108,45 @@ func (suite *DirectorSuite) TestDirectorLocal() {
 	suite.Assert().NoError(err)
 }
 
+func (suite *DirectorSuite) TestDirectorLocalMethodBackend() {
+	ctx := context.Background()
+
+	methodBackend := &mockBackend{target: "debugd"}
+
+	suite.router.RegisterLocalBackend("/service.Service/debugMethod", methodBackend)
+
+	md := metadata.New(nil)
+	mode, backends, err := suite.router.Director(metadata.NewIncomingContext(ctx, md), "/service.Service/debugMethod")
+	suite.Assert().Equal(proxy.One2One, mode)
+	suite.Assert().Len(backends, 1)
+	suite.Assert().Equal(methodBackend, backends[0])
+	suite.Assert().NoError(err)
+
+	md = metadata.New(nil)
+	md.Set("proxyfrom", "127.0.0.2")
+	mode, backends, err = suite.router.Director(metadata.NewIncomingContext(ctx, md), "/service.Service/debugMethod")
+	suite.Assert().Equal(proxy.One2One, mode)
+	suite.Assert().Len(backends, 1)
+	suite.Assert().Equal(methodBackend, backends[0])
+	suite.Assert().NoError(err)
+
+	// other methods are still sent to the default local backend
+	mode, backends, err = suite.router.Director(metadata.NewIncomingContext(ctx, md), "/service.Service/method")
+	suite.Assert().Equal(proxy.One2One, mode)
+	suite.Assert().Len(backends, 1)
+	suite.Assert().Equal(suite.localBackend, backends[0])
+	suite.Assert().NoError(err)
+
+	// forwarding is not affected
+	md = metadata.New(nil)
+	md.Set("node", "127.0.0.1")
+	mode, backends, err = suite.router.Director(metadata.NewIncomingContext(ctx, md), "/service.Service/debugMethod")
+	suite.Assert().Equal(proxy.One2One, mode)
+	suite.Assert().Len(backends, 1)
+	suite.Assert().Equal("127.0.0.1", backends[0].(*mockBackend).target)
+	suite.Assert().NoError(err)
+}
+
 func (suite *DirectorSuite) TestDirectorNoRemoteBackend() {
 	// override the router to have no remote backends
 	router := director.NewRouter(
@@ -155,6 +200,80 @@ func (suite *DirectorSuite) TestDirectorNoRemoteBackend() {
 	suite.Assert().NoError(err)
 }
 
+func (suite *DirectorSuite) TestDirectorNodeScope() {
+	peerContext := func(organizations, organizationalUnits []string) context.Context {
+		return peer.NewContext(context.Background(), &peer.Peer{
+			AuthInfo: credentials.TLSInfo{
+				State: tls.ConnectionState{
+					PeerCertificates: []*x509.Certificate{
+						{
+							Subject: pkix.Name{
+								Organization:       organizations,
+								OrganizationalUnit: organizationalUnits,
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+
+	scoped := peerContext([]string{string(role.Support)}, role.NodeScopeUnits([]string{"10.5.0.2", "localhost"}))
+
+	// nodes in the scope, allowed
+	md := metadata.New(nil)
+	md.Set("nodes", "10.5.0.2", "localhost")
+	mode, backends, err := suite.router.Director(metadata.NewIncomingContext(scoped, md), "/service.Service/method")
+	suite.Require().NoError(err)
+	suite.Assert().Equal(proxy.One2Many, mode)
+	suite.Assert().Len(backends, 2)
+
+	md = metadata.New(nil)
+	md.Set("node", "::ffff:10.5.0.2")
+	_, _, err = suite.router.Director(metadata.NewIncomingContext(scoped, md), "/service.Service/method")
+	suite.Assert().NoError(err)
+
+	// local node is in the scope, allowed
+	_, _, err = suite.router.Director(metadata.NewIncomingContext(scoped, metadata.New(nil)), "/service.Service/method")
+	suite.Assert().NoError(err)
+
+	// node out of the scope, denied
+	md = metadata.New(nil)
+	md.Set("nodes", "10.5.0.2", "10.5.0.3")
+	_, _, err = suite.router.Director(metadata.NewIncomingContext(scoped, md), "/service.Service/method")
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(err))
+
+	md = metadata.New(nil)
+	md.Set("node", "10.5.0.3")
+	_, _, err = suite.router.Director(metadata.NewIncomingContext(scoped, md), "/service.Service/method")
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(err))
+
+	// local node out of the scope, denied
+	otherScoped := peerContext([]string{string(role.Support)}, role.NodeScopeUnits([]string{"10.5.0.3"}))
+
+	_, _, err = suite.router.Director(metadata.NewIncomingContext(otherScoped, metadata.New(nil)), "/service.Service/method")
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(err))
+
+	md = metadata.New(nil)
+	md.Set("proxyfrom", "10.5.0.3")
+	_, _, err = suite.router.Director(metadata.NewIncomingContext(otherScoped, md), "/service.Service/method")
+	suite.Assert().Equal(codes.PermissionDenied, status.Code(err))
+
+	// requests proxied by other apid instances are not limited
+	proxied := peerContext([]string{string(role.Impersonator)}, nil)
+
+	md = metadata.New(nil)
+	md.Set("proxyfrom", "10.5.0.3")
+	_, _, err = suite.router.Director(metadata.NewIncomingContext(proxied, md), "/service.Service/method")
+	suite.Assert().NoError(err)
+
+	// certificates without the scope are not limited
+	md = metadata.New(nil)
+	md.Set("node", "10.5.0.3")
+	_, _, err = suite.router.Director(metadata.NewIncomingContext(peerContext([]string{string(role.Admin)}, nil), md), "/service.Service/method")
+	suite.Assert().NoError(err)
+}
+
 func TestDirectorSuite(t *testing.T) {
 	suite.Run(t, new(DirectorSuite))
 }
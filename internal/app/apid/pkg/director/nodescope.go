@@ -0,0 +1,82 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package director
+
+import (
+	"context"
+	"net/netip"
+	"slices"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+// nodeScope returns the nodes the client certificate of the peer is limited to.
+//
+// Nil is returned if the client is not limited to the specific nodes, or the client is not a TLS peer (e.g. local socket).
+// Clients with the impersonator role (including other apid instances proxying the requests) are never limited,
+// as the scope is enforced by the first apid instance the client connects to.
+func nodeScope(ctx context.Context) []string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return nil
+	}
+
+	cert := tlsInfo.State.PeerCertificates[0]
+
+	roles, _ := role.Parse(cert.Subject.Organization)
+	if roles.Includes(role.Impersonator) {
+		return nil
+	}
+
+	return role.NodeScope(cert.Subject.OrganizationalUnit)
+}
+
+// checkNodeScope returns an error if any of the targets is outside the node scope of the client.
+//
+// Empty targets stand for the local node.
+func (r *Router) checkNodeScope(ctx context.Context, targets []string) error {
+	scope := nodeScope(ctx)
+	if scope == nil {
+		return nil
+	}
+
+	if len(targets) == 0 {
+		if r.localAddressProvider != nil && slices.ContainsFunc(scope, r.localAddressProvider.IsLocalTarget) {
+			return nil
+		}
+
+		return status.Error(codes.PermissionDenied, "client certificate is not allowed to access this node")
+	}
+
+	for _, target := range targets {
+		if !slices.ContainsFunc(scope, func(node string) bool { return sameNode(node, target) }) {
+			return status.Errorf(codes.PermissionDenied, "client certificate is not allowed to access node %q", target)
+		}
+	}
+
+	return nil
+}
+
+// sameNode compares the nodes as IP addresses if possible, and as strings otherwise.
+func sameNode(a, b string) bool {
+	if a == b {
+		return true
+	}
+
+	addrA, errA := netip.ParseAddr(a)
+	addrB, errB := netip.ParseAddr(b)
+
+	return errA == nil && errB == nil && addrA.Unmap() == addrB.Unmap()
+}
@@ -0,0 +1,134 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package coredump implements the kernel core dump handler.
+//
+// The kernel pipes the core dump of the crashed process to the handler via core_pattern,
+// and the handler stores the core dumps of Talos system services on the STATE partition.
+package coredump
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/siderolabs/go-kmsg"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/pkg/coredump"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// Main is the entrypoint into /sbin/coredump.
+func Main(args []string) {
+	if err := kmsg.SetupLogger(nil, "[talos] [coredump]", nil); err != nil {
+		log.Printf("failed to setup logger: %s", err)
+	}
+
+	if err := run(args[1:]); err != nil {
+		log.Printf("failed to save core dump: %s", err)
+
+		os.Exit(1)
+	}
+}
+
+func run(args []string) error {
+	// see constants.CorePattern
+	if len(args) < 3 {
+		return fmt.Errorf("unexpected arguments %q", args)
+	}
+
+	pid, err := strconv.Atoi(args[0])
+	if err != nil {
+		return fmt.Errorf("invalid PID: %w", err)
+	}
+
+	timestamp, err := strconv.ParseInt(args[1], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp: %w", err)
+	}
+
+	dump := coredump.Dump{
+		Timestamp: time.Unix(timestamp, 0),
+		PID:       pid,
+		Name:      strings.Join(args[2:], " "),
+	}
+
+	if !isSystemService(pid) {
+		return nil
+	}
+
+	mounted, err := isMounted(constants.StateMountPoint)
+	if err != nil {
+		return err
+	}
+
+	if !mounted {
+		log.Printf("dropping core dump of %s (%d): STATE is not mounted", dump.Name, dump.PID)
+
+		return nil
+	}
+
+	err = coredump.Save(constants.CoreDumpsPath, dump, os.Stdin, constants.CoreDumpsMaxCount, constants.CoreDumpMaxSize)
+
+	switch {
+	case errors.Is(err, coredump.ErrTruncated):
+		log.Printf("saved truncated core dump of %s (%d) as %s", dump.Name, dump.PID, dump.FileName())
+	case err != nil:
+		return err
+	default:
+		log.Printf("saved core dump of %s (%d) as %s", dump.Name, dump.PID, dump.FileName())
+	}
+
+	return nil
+}
+
+// isSystemService checks whether the process belongs to Talos or Kubernetes runtime cgroups
+// (and not to the workloads).
+func isSystemService(pid int) bool {
+	contents, err := os.ReadFile(filepath.Join("/proc", strconv.Itoa(pid), "cgroup"))
+	if err != nil {
+		return false
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(string(contents)), "\n") {
+		// hierarchy-ID:controller-list:cgroup-path
+		parts := strings.SplitN(line, ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+
+		for _, cgroup := range []string{constants.CgroupInit, constants.CgroupSystem, constants.CgroupPodRuntimeRoot} {
+			if parts[2] == cgroup || strings.HasPrefix(parts[2], cgroup+"/") {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// isMounted checks whether the path is a mount point, so that the core dumps don't end up in memory.
+func isMounted(path string) (bool, error) {
+	var st, parentSt unix.Stat_t
+
+	if err := unix.Stat(path, &st); err != nil {
+		if errors.Is(err, unix.ENOENT) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	if err := unix.Stat(filepath.Dir(path), &parentSt); err != nil {
+		return false, err
+	}
+
+	return st.Dev != parentSt.Dev, nil
+}
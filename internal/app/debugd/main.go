@@ -0,0 +1,96 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package debugd implements the debugger tunnel service of the debug builds.
+//
+// The delve debugger stops the debugged process, so the tunnel to the debugger can't be served
+// by machined itself: debugd runs as a separate process, and apid routes the DebugAttach API to it.
+package debugd
+
+import (
+	"context"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/siderolabs/talos/pkg/grpc/factory"
+	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+// Main is the entrypoint into /sbin/debugd.
+func Main() {
+	log.SetFlags(log.Lshortfile | log.Ldate | log.Lmicroseconds | log.Ltime)
+
+	if err := run(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run() error {
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGTERM, syscall.SIGINT)
+	defer cancel()
+
+	injector := &authz.Injector{
+		Mode:   authz.MetadataOnly,
+		Logger: log.New(log.Writer(), "debugd/authz/injector ", log.Flags()).Printf,
+	}
+
+	authorizer := &authz.Authorizer{
+		Rules: map[string]role.Set{
+			"/machine.MachineService/DebugAttach": role.MakeSet(role.Admin),
+		},
+		FallbackRoles: role.MakeSet(role.Admin),
+		Logger:        log.New(log.Writer(), "debugd/authz/authorizer ", log.Flags()).Printf,
+	}
+
+	server := factory.NewServer(
+		&Server{},
+		factory.WithDefaultLog(),
+		factory.ServerOptions(
+			grpc.MaxRecvMsgSize(constants.GRPCMaxMessageSize),
+		),
+		factory.WithStreamInterceptor(injector.StreamInterceptor()),
+		factory.WithStreamInterceptor(authorizer.StreamInterceptor()),
+	)
+
+	if err := os.MkdirAll(filepath.Dir(constants.DebugdSocketPath), 0o770); err != nil {
+		return err
+	}
+
+	// set the final leaf to be world-executable to make apid connect to the socket
+	if err := os.Chmod(filepath.Dir(constants.DebugdSocketPath), 0o771); err != nil {
+		return err
+	}
+
+	listener, err := factory.NewListener(factory.Network("unix"), factory.SocketPath(constants.DebugdSocketPath))
+	if err != nil {
+		return err
+	}
+
+	// chown the socket path to make it accessible to the apid
+	if err = os.Chown(constants.DebugdSocketPath, constants.ApidUserID, constants.ApidUserID); err != nil {
+		return err
+	}
+
+	go func() {
+		//nolint:errcheck
+		server.Serve(listener)
+	}()
+
+	<-ctx.Done()
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer shutdownCancel()
+
+	factory.ServerGracefulStop(server, shutdownCtx)
+
+	return nil
+}
@@ -0,0 +1,225 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package debugd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+const (
+	// dlvPath is the path to the delve binary included into the debug builds.
+	dlvPath = "/sbin/dlv"
+
+	// listeningPrefix is printed by the headless delve once the API server is ready.
+	listeningPrefix = "API server listening at:"
+
+	attachTimeout = time.Minute
+	detachTimeout = 10 * time.Second
+)
+
+// Server implements the DebugAttach API.
+type Server struct {
+	machine.UnimplementedMachineServiceServer
+}
+
+// Register implements the factory.Registrator interface.
+func (s *Server) Register(obj *grpc.Server) {
+	machine.RegisterMachineServiceServer(obj, s)
+}
+
+// DebugAttach implements the machine.MachineServiceServer interface.
+//
+// The debugger is started in the headless mode, and the stream is tunneled to the debugger API server.
+// Once the client disconnects, the debugger detaches from the process.
+func (s *Server) DebugAttach(srv machine.MachineService_DebugAttachServer) error {
+	req, err := srv.Recv()
+	if err != nil {
+		return err
+	}
+
+	pid := int(req.GetPid())
+
+	if pid <= 0 {
+		return status.Error(codes.InvalidArgument, "process ID should be positive")
+	}
+
+	if pid == os.Getpid() {
+		return status.Error(codes.InvalidArgument, "debugd can't debug itself")
+	}
+
+	if _, err = os.Stat(filepath.Join("/proc", strconv.Itoa(pid))); err != nil {
+		return status.Errorf(codes.NotFound, "process %d not found", pid)
+	}
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	dlv, err := startDebugger(ctx, pid)
+	if err != nil {
+		return err
+	}
+
+	defer dlv.stop()
+
+	var d net.Dialer
+
+	conn, err := d.DialContext(ctx, "tcp", dlv.addr)
+	if err != nil {
+		return fmt.Errorf("error connecting to the debugger: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	log.Printf("debugger attached to process %d", pid)
+
+	go func() {
+		defer conn.(*net.TCPConn).CloseWrite() //nolint:errcheck
+
+		for {
+			msg, recvErr := srv.Recv()
+			if recvErr != nil {
+				return
+			}
+
+			if _, recvErr = conn.Write(msg.GetData()); recvErr != nil {
+				return
+			}
+		}
+	}()
+
+	buf := make([]byte, 32*1024)
+
+	for {
+		n, readErr := conn.Read(buf)
+
+		if n > 0 {
+			if err = srv.Send(&common.Data{Bytes: buf[:n]}); err != nil {
+				return err
+			}
+		}
+
+		if readErr != nil {
+			if errors.Is(readErr, io.EOF) {
+				break
+			}
+
+			return readErr
+		}
+	}
+
+	log.Printf("debugger detached from process %d", pid)
+
+	return nil
+}
+
+type debugger struct {
+	cmd  *exec.Cmd
+	addr string
+}
+
+// startDebugger attaches delve to the process and waits for the debugger API server to start.
+func startDebugger(ctx context.Context, pid int) (*debugger, error) {
+	cmd := exec.Command(dlvPath, "attach", strconv.Itoa(pid), "--headless", "--api-version=2", "--listen=127.0.0.1:0")
+	cmd.Stderr = os.Stderr
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+
+	if err = cmd.Start(); err != nil {
+		return nil, fmt.Errorf("error starting the debugger: %w", err)
+	}
+
+	dlv := &debugger{cmd: cmd}
+
+	addrCh := make(chan string, 1)
+
+	go func() {
+		defer close(addrCh)
+
+		scanner := bufio.NewScanner(stdout)
+		found := false
+
+		for scanner.Scan() {
+			line := scanner.Text()
+
+			log.Printf("dlv: %s", line)
+
+			if addr, ok := strings.CutPrefix(line, listeningPrefix); ok && !found {
+				found = true
+
+				addrCh <- strings.TrimSpace(addr)
+			}
+		}
+	}()
+
+	timer := time.NewTimer(attachTimeout)
+	defer timer.Stop()
+
+	select {
+	case addr, ok := <-addrCh:
+		if !ok {
+			if err = cmd.Wait(); err != nil {
+				return nil, fmt.Errorf("debugger failed: %w", err)
+			}
+
+			return nil, errors.New("debugger exited unexpectedly")
+		}
+
+		dlv.addr = addr
+
+		return dlv, nil
+	case <-ctx.Done():
+		dlv.kill()
+
+		return nil, ctx.Err()
+	case <-timer.C:
+		dlv.kill()
+
+		return nil, status.Error(codes.DeadlineExceeded, "timed out waiting for the debugger to attach")
+	}
+}
+
+// stop waits for the debugger to detach from the process, and kills it on timeout.
+func (d *debugger) stop() {
+	done := make(chan error, 1)
+
+	go func() {
+		done <- d.cmd.Wait()
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(detachTimeout):
+		d.cmd.Process.Kill() //nolint:errcheck
+
+		<-done
+	}
+}
+
+func (d *debugger) kill() {
+	d.cmd.Process.Kill() //nolint:errcheck
+	d.cmd.Wait()         //nolint:errcheck
+}
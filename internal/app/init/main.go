@@ -167,12 +167,12 @@ func mountRootFS() error {
 	overlays := make([]string, 0, len(layers))
 
 	for _, layer := range layers {
-		dev, err := losetup.Attach(layer.image, 0, true)
+		devPath, err := attachLayer(layer.image)
 		if err != nil {
 			return err
 		}
 
-		p := mount.NewMountPoint(dev.Path(), "/"+layer.name, "squashfs", unix.MS_RDONLY|unix.MS_I_VERSION, "", mount.WithPrefix(constants.ExtensionLayers), mount.WithFlags(mount.ReadOnly|mount.Shared))
+		p := mount.NewMountPoint(devPath, "/"+layer.name, "squashfs", unix.MS_RDONLY|unix.MS_I_VERSION, "", mount.WithPrefix(constants.ExtensionLayers), mount.WithFlags(mount.ReadOnly|mount.Shared))
 
 		overlays = append(overlays, p.Target())
 		squashfs.Set(layer.name, p)
@@ -196,6 +196,20 @@ func mountRootFS() error {
 	return unix.Mount(constants.ExtensionsConfigFile, filepath.Join(constants.NewRoot, constants.ExtensionsRuntimeConfigFile), "", unix.MS_BIND|unix.MS_RDONLY, "")
 }
 
+func attachLayer(image string) (string, error) {
+	// rootfs might be protected with dm-verity
+	if image == "/"+constants.RootfsAsset {
+		return mount.AttachRootfs()
+	}
+
+	dev, err := losetup.Attach(image, 0, true)
+	if err != nil {
+		return "", err
+	}
+
+	return dev.Path(), nil
+}
+
 func bindMountFirmware() error {
 	if _, err := os.Stat(constants.FirmwarePath); err != nil {
 		if os.IsNotExist(err) {
@@ -0,0 +1,69 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"os"
+
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1"
+	"github.com/siderolabs/talos/internal/pkg/bootlog"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+var (
+	bootLogLogging runtime.LoggingManager
+	bootLogState   state.State
+)
+
+func bootLogSetRuntime(l runtime.LoggingManager, s state.State) {
+	bootLogLogging = l
+	bootLogState = s
+}
+
+// updateBootLog updates the boot log of the current boot with the logs up to the failure.
+//
+// If the boot log was not saved to the STATE partition on this boot, it is saved to the EFI partition.
+func updateBootLog(ctx context.Context) {
+	if bootLogLogging == nil {
+		return
+	}
+
+	if err := updateBootLogInternal(ctx, bootLogLogging, bootLogState); err != nil {
+		log.Printf("failed to update boot log: %s", err)
+	}
+}
+
+func updateBootLogInternal(ctx context.Context, logging runtime.LoggingManager, resourceState state.State) error {
+	bootID, err := bootlog.CurrentBootID()
+	if err != nil {
+		return err
+	}
+
+	machinedLog, err := logging.ServiceLog("machined").Reader()
+	if err != nil {
+		return err
+	}
+
+	defer machinedLog.Close() //nolint:errcheck
+
+	contents, err := bootlog.Collect(ctx, machinedLog)
+	if err != nil {
+		return err
+	}
+
+	err = bootlog.Update(constants.BootLogPath, bootID, contents)
+	if errors.Is(err, os.ErrNotExist) {
+		// boot log was not saved on this boot (e.g. STATE is not mounted)
+		return v1alpha1runtime.SaveFallbackBootLog(ctx, resourceState, bootID, contents)
+	}
+
+	return err
+}
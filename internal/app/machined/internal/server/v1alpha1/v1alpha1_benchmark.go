@@ -0,0 +1,181 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/siderolabs/gen/xslices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/internal/pkg/benchmark"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+const (
+	defaultDiskBenchmarkSize                = 1024 * 1024 * 1024
+	defaultDiskBenchmarkSequentialBlockSize = 1024 * 1024
+	defaultDiskBenchmarkRandomBlockSize     = 4096
+	defaultDiskBenchmarkDuration            = 10 * time.Second
+
+	defaultMemoryBenchmarkSize     = 64 * 1024 * 1024
+	maxMemoryBenchmarkSize         = 1024 * 1024 * 1024
+	defaultMemoryBenchmarkDuration = 3 * time.Second
+
+	defaultNetworkBenchmarkDuration = 10 * time.Second
+	networkBenchmarkAcceptTimeout   = 30 * time.Second
+
+	maxBenchmarkDuration = 5 * time.Minute
+)
+
+// DiskBenchmark implements the machine.MachineServer interface.
+func (s *Server) DiskBenchmark(ctx context.Context, in *machine.DiskBenchmarkRequest) (*machine.BenchmarkResponse, error) {
+	opts := benchmark.DiskOptions{
+		Directory:           constants.EphemeralMountPoint,
+		Size:                valueOrDefault(in.GetSize(), defaultDiskBenchmarkSize),
+		SequentialBlockSize: valueOrDefault(in.GetSequentialBlockSize(), defaultDiskBenchmarkSequentialBlockSize),
+		RandomBlockSize:     valueOrDefault(in.GetRandomBlockSize(), defaultDiskBenchmarkRandomBlockSize),
+		Duration:            benchmarkDuration(in.GetDuration(), defaultDiskBenchmarkDuration),
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if opts.Duration > maxBenchmarkDuration {
+		return nil, status.Errorf(codes.InvalidArgument, "duration should be at most %s", maxBenchmarkDuration)
+	}
+
+	return runBenchmark(func() ([]benchmark.Result, error) {
+		return benchmark.Disk(ctx, opts)
+	})
+}
+
+// MemoryBenchmark implements the machine.MachineServer interface.
+func (s *Server) MemoryBenchmark(ctx context.Context, in *machine.MemoryBenchmarkRequest) (*machine.BenchmarkResponse, error) {
+	opts := benchmark.MemoryOptions{
+		Size:     valueOrDefault(in.GetSize(), defaultMemoryBenchmarkSize),
+		Threads:  valueOrDefault(in.GetThreads(), 1),
+		Duration: benchmarkDuration(in.GetDuration(), defaultMemoryBenchmarkDuration),
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if opts.Size*uint64(opts.Threads) > maxMemoryBenchmarkSize {
+		return nil, status.Errorf(codes.InvalidArgument, "total buffer size should be at most %d bytes", maxMemoryBenchmarkSize)
+	}
+
+	if opts.Duration > maxBenchmarkDuration {
+		return nil, status.Errorf(codes.InvalidArgument, "duration should be at most %s", maxBenchmarkDuration)
+	}
+
+	return runBenchmark(func() ([]benchmark.Result, error) {
+		return benchmark.Memory(ctx, opts)
+	})
+}
+
+// NetworkBenchmark implements the machine.MachineServer interface.
+func (s *Server) NetworkBenchmark(ctx context.Context, in *machine.NetworkBenchmarkRequest) (*machine.BenchmarkResponse, error) {
+	port := valueOrDefault(in.GetPort(), benchmark.DefaultNetworkPort)
+	if port > 65535 {
+		return nil, status.Error(codes.InvalidArgument, "invalid port")
+	}
+
+	duration := benchmarkDuration(in.GetDuration(), defaultNetworkBenchmarkDuration)
+
+	if duration <= 0 || duration > maxBenchmarkDuration {
+		return nil, status.Errorf(codes.InvalidArgument, "duration should be positive and at most %s", maxBenchmarkDuration)
+	}
+
+	if in.GetServer() == "" {
+		return runBenchmark(func() ([]benchmark.Result, error) {
+			result, err := benchmark.NetworkServer(ctx, benchmark.NetworkServerOptions{
+				ListenAddress: net.JoinHostPort("", strconv.FormatUint(uint64(port), 10)),
+				AcceptTimeout: networkBenchmarkAcceptTimeout,
+				Timeout:       2 * duration,
+			})
+
+			return []benchmark.Result{result}, err
+		})
+	}
+
+	opts := benchmark.NetworkClientOptions{
+		Address:  net.JoinHostPort(in.GetServer(), strconv.FormatUint(uint64(port), 10)),
+		Streams:  valueOrDefault(in.GetStreams(), 1),
+		Duration: duration,
+	}
+
+	if err := opts.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return runBenchmark(func() ([]benchmark.Result, error) {
+		result, err := benchmark.NetworkClient(ctx, opts)
+
+		return []benchmark.Result{result}, err
+	})
+}
+
+func runBenchmark(f func() ([]benchmark.Result, error)) (*machine.BenchmarkResponse, error) {
+	unlock, ok := benchmark.TryLock()
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "another benchmark is already running")
+	}
+
+	defer unlock()
+
+	results, err := f()
+	if err != nil {
+		return nil, err
+	}
+
+	return &machine.BenchmarkResponse{
+		Messages: []*machine.Benchmark{
+			{
+				Results: xslices.Map(results, func(r benchmark.Result) *machine.BenchmarkResult {
+					result := &machine.BenchmarkResult{
+						Name:                r.Name,
+						Bytes:               r.Bytes,
+						Operations:          r.Operations,
+						Elapsed:             durationpb.New(r.Elapsed),
+						BytesPerSecond:      r.BytesPerSecond(),
+						OperationsPerSecond: r.OperationsPerSecond(),
+					}
+
+					if r.LatencyAvg > 0 {
+						result.LatencyAvg = durationpb.New(r.LatencyAvg)
+						result.LatencyP99 = durationpb.New(r.LatencyP99)
+					}
+
+					return result
+				}),
+			},
+		},
+	}, nil
+}
+
+func valueOrDefault[T uint32 | uint64](v, def T) T {
+	if v == 0 {
+		return def
+	}
+
+	return v
+}
+
+func benchmarkDuration(d *durationpb.Duration, def time.Duration) time.Duration {
+	if d == nil {
+		return def
+	}
+
+	return d.AsDuration()
+}
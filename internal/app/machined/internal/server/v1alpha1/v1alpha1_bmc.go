@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/gen/xslices"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/talos/internal/pkg/ipmi"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+func openBMC() (*ipmi.Device, error) {
+	dev, err := ipmi.Open()
+	if err != nil {
+		if errors.Is(err, ipmi.ErrNotAvailable) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+
+		return nil, err
+	}
+
+	return dev, nil
+}
+
+// BMCSensors implements the machine.MachineServer interface.
+func (s *Server) BMCSensors(ctx context.Context, in *machine.BMCSensorsRequest) (*machine.BMCSensorsResponse, error) {
+	dev, err := openBMC()
+	if err != nil {
+		return nil, err
+	}
+
+	defer dev.Close() //nolint:errcheck
+
+	chassis, err := ipmi.GetChassisStatus(ctx, dev)
+	if err != nil {
+		return nil, fmt.Errorf("error reading chassis status: %w", err)
+	}
+
+	sensors, err := ipmi.ReadSensors(ctx, dev)
+	if err != nil {
+		return nil, fmt.Errorf("error reading sensors: %w", err)
+	}
+
+	return &machine.BMCSensorsResponse{
+		Messages: []*machine.BMCSensors{
+			{
+				PowerOn:    chassis.PowerOn,
+				PowerFault: chassis.PowerFault,
+				Sensors: xslices.Map(sensors, func(sensor ipmi.Sensor) *machine.BMCSensor {
+					return &machine.BMCSensor{
+						Number:   uint32(sensor.Number),
+						Name:     sensor.Name,
+						Type:     sensor.Type,
+						Value:    sensor.Value,
+						HasValue: sensor.HasValue,
+						Unit:     sensor.Unit,
+						State:    uint32(sensor.State),
+						Status:   sensor.Status,
+					}
+				}),
+			},
+		},
+	}, nil
+}
+
+// BMCEventLog implements the machine.MachineServer interface.
+func (s *Server) BMCEventLog(ctx context.Context, in *machine.BMCEventLogRequest) (*machine.BMCEventLogResponse, error) {
+	dev, err := openBMC()
+	if err != nil {
+		return nil, err
+	}
+
+	defer dev.Close() //nolint:errcheck
+
+	entries, total, err := ipmi.ReadSEL(ctx, dev, int(in.GetTail()))
+	if err != nil {
+		return nil, fmt.Errorf("error reading system event log: %w", err)
+	}
+
+	return &machine.BMCEventLogResponse{
+		Messages: []*machine.BMCEventLog{
+			{
+				TotalEntries: int32(total),
+				Entries: xslices.Map(entries, func(entry ipmi.SELEntry) *machine.BMCEventLogEntry {
+					var timestamp *timestamppb.Timestamp
+
+					if !entry.Timestamp.IsZero() {
+						timestamp = timestamppb.New(entry.Timestamp)
+					}
+
+					return &machine.BMCEventLogEntry{
+						Id:           uint32(entry.ID),
+						RecordType:   uint32(entry.RecordType),
+						Timestamp:    timestamp,
+						SensorType:   entry.SensorType,
+						SensorNumber: uint32(entry.SensorNumber),
+						Event:        entry.Event,
+						Deasserted:   entry.Deasserted,
+					}
+				}),
+			},
+		},
+	}, nil
+}
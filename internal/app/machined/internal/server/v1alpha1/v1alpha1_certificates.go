@@ -0,0 +1,50 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/siderolabs/gen/xslices"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/talos/internal/pkg/certinventory"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+// Certificates implements the machine.MachineServer interface.
+func (s *Server) Certificates(ctx context.Context, in *emptypb.Empty) (*machine.CertificatesResponse, error) {
+	inventory := &certinventory.Inventory{
+		State: s.Controller.Runtime().State().V1Alpha2().Resources(),
+	}
+
+	certs, err := inventory.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error listing certificates: %w", err)
+	}
+
+	resp := &machine.Certificates{}
+
+	for _, cert := range certs {
+		resp.Certificates = append(resp.Certificates, &machine.Certificate{
+			Name:         cert.Name,
+			Subject:      cert.Subject.String(),
+			Issuer:       cert.Issuer.String(),
+			SerialNumber: cert.SerialNumber.String(),
+			DnsNames:     cert.DNSNames,
+			IpAddresses:  xslices.Map(cert.IPAddresses, net.IP.String),
+			NotBefore:    timestamppb.New(cert.NotBefore),
+			NotAfter:     timestamppb.New(cert.NotAfter),
+			IsCa:         cert.IsCA,
+		})
+	}
+
+	return &machine.CertificatesResponse{
+		Messages: []*machine.Certificates{resp},
+	}, nil
+}
@@ -0,0 +1,314 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/netip"
+	"os"
+	"time"
+
+	"github.com/containerd/cgroups/v3"
+	"github.com/containerd/cgroups/v3/cgroup2"
+	"github.com/dustin/go-humanize"
+	"github.com/siderolabs/go-pointer"
+	"golang.org/x/sys/unix"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
+	"github.com/siderolabs/talos/internal/pkg/cgroup"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+const (
+	maxChaosDuration = time.Hour
+
+	// chaosFillChunkSize is the size of a single allocation when filling the EPHEMERAL partition.
+	chaosFillChunkSize = 64 * 1024 * 1024
+)
+
+// ChaosServiceKill implements the machine.MachineServer interface.
+func (s *Server) ChaosServiceKill(ctx context.Context, in *machine.ChaosServiceKillRequest) (*machine.ChaosResponse, error) {
+	if err := s.checkChaosEnabled(); err != nil {
+		return nil, err
+	}
+
+	if cgroups.Mode() != cgroups.Unified {
+		return nil, status.Error(codes.FailedPrecondition, "killing services requires cgroups v2")
+	}
+
+	svc, running, err := system.Services(s.Controller.Runtime()).IsRunning(in.GetId())
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	if !running {
+		return nil, status.Errorf(codes.FailedPrecondition, "service %q is not running", in.GetId())
+	}
+
+	cgroupService, ok := svc.(system.CgroupService)
+	if !ok {
+		return nil, status.Errorf(codes.FailedPrecondition, "service %q doesn't run in a dedicated cgroup", in.GetId())
+	}
+
+	cg, err := cgroup2.Load(cgroup.Path(cgroupService.Cgroup(s.Controller.Runtime())))
+	if err != nil {
+		return nil, fmt.Errorf("error loading cgroup of service %q: %w", in.GetId(), err)
+	}
+
+	log.Printf("chaos: killing service %q", in.GetId())
+
+	if err = cg.Kill(); err != nil {
+		return nil, fmt.Errorf("error killing service %q: %w", in.GetId(), err)
+	}
+
+	return chaosResponse(fmt.Sprintf("service %q killed", in.GetId()), time.Time{}), nil
+}
+
+// ChaosNetworkDrop implements the machine.MachineServer interface.
+func (s *Server) ChaosNetworkDrop(ctx context.Context, in *machine.ChaosNetworkDropRequest) (*machine.ChaosResponse, error) {
+	if err := s.checkChaosEnabled(); err != nil {
+		return nil, err
+	}
+
+	duration, err := chaosDuration(in.GetDuration().AsDuration())
+	if err != nil {
+		return nil, err
+	}
+
+	if len(in.GetCidrs()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one subnet is required")
+	}
+
+	subnets := make([]netip.Prefix, 0, len(in.GetCidrs()))
+
+	for _, cidr := range in.GetCidrs() {
+		subnet, err := netip.ParsePrefix(cidr)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid subnet %q: %s", cidr, err)
+		}
+
+		subnets = append(subnets, subnet.Masked())
+	}
+
+	id, err := chaosID()
+	if err != nil {
+		return nil, err
+	}
+
+	// the traffic is dropped in both directions: incoming from the subnets and outgoing to the subnets
+	chains := []*network.NfTablesChain{
+		chaosDropChain(id+"-input", nethelpers.ChainHookInput, network.NfTablesRule{
+			MatchSourceAddress: &network.NfTablesAddressMatch{IncludeSubnets: subnets},
+			Verdict:            pointer.To(nethelpers.VerdictDrop),
+		}),
+		chaosDropChain(id+"-output", nethelpers.ChainHookOutput, network.NfTablesRule{
+			MatchDestinationAddress: &network.NfTablesAddressMatch{IncludeSubnets: subnets},
+			Verdict:                 pointer.To(nethelpers.VerdictDrop),
+		}),
+	}
+
+	resources := s.Controller.Runtime().State().V1Alpha2().Resources()
+
+	for _, chain := range chains {
+		if err = resources.Create(ctx, chain); err != nil {
+			return nil, fmt.Errorf("error creating nftables chain: %w", err)
+		}
+	}
+
+	log.Printf("chaos: dropping network traffic to %v for %s", subnets, duration)
+
+	s.revertChaosAfter(duration, func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+
+		for _, chain := range chains {
+			if err := resources.Destroy(ctx, chain.Metadata()); err != nil {
+				log.Printf("chaos: error removing nftables chain %q: %s", chain.Metadata().ID(), err)
+			}
+		}
+
+		log.Printf("chaos: network traffic to %v restored", subnets)
+	})
+
+	return chaosResponse(fmt.Sprintf("network traffic to %v dropped", subnets), time.Now().Add(duration)), nil
+}
+
+// ChaosEphemeralFill implements the machine.MachineServer interface.
+func (s *Server) ChaosEphemeralFill(ctx context.Context, in *machine.ChaosEphemeralFillRequest) (*machine.ChaosResponse, error) {
+	if err := s.checkChaosEnabled(); err != nil {
+		return nil, err
+	}
+
+	duration, err := chaosDuration(in.GetDuration().AsDuration())
+	if err != nil {
+		return nil, err
+	}
+
+	if in.GetPercent() == 0 || in.GetPercent() > 100 {
+		return nil, status.Error(codes.InvalidArgument, "percent should be between 1 and 100")
+	}
+
+	total, used, err := filesystemUsage(constants.EphemeralMountPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	target := total * uint64(in.GetPercent()) / 100
+
+	if used >= target {
+		return chaosResponse(fmt.Sprintf("EPHEMERAL is already %d%% full", used*100/total), time.Time{}), nil
+	}
+
+	// the file is never linked into the filesystem, so the space is released when the file is closed,
+	// even if machined is terminated abruptly
+	fd, err := unix.Open(constants.EphemeralMountPoint, unix.O_TMPFILE|unix.O_RDWR|unix.O_CLOEXEC, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("error creating the fill file: %w", err)
+	}
+
+	f := os.NewFile(uintptr(fd), "chaos-fill")
+
+	allocated, err := fallocateUpTo(f, target-used)
+	if err != nil {
+		f.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("error filling EPHEMERAL: %w", err)
+	}
+
+	log.Printf("chaos: filled EPHEMERAL with %s for %s", humanize.IBytes(allocated), duration)
+
+	s.revertChaosAfter(duration, func() {
+		if err := f.Close(); err != nil {
+			log.Printf("chaos: error releasing the fill file: %s", err)
+		}
+
+		log.Printf("chaos: EPHEMERAL fill of %s released", humanize.IBytes(allocated))
+	})
+
+	return chaosResponse(
+		fmt.Sprintf("EPHEMERAL filled with %s up to %d%%", humanize.IBytes(allocated), (used+allocated)*100/total),
+		time.Now().Add(duration),
+	), nil
+}
+
+func (s *Server) checkChaosEnabled() error {
+	cfg := s.Controller.Runtime().Config()
+
+	if cfg == nil || cfg.Machine() == nil || !cfg.Machine().Features().ChaosAPIEnabled() {
+		return status.Error(codes.FailedPrecondition, "chaos APIs are disabled in the machine configuration (.machine.features.chaosAPI)")
+	}
+
+	return nil
+}
+
+// revertChaosAfter reverts the induced failure after the duration, or when the server is shutting down.
+func (s *Server) revertChaosAfter(duration time.Duration, revert func()) {
+	go func() {
+		timer := time.NewTimer(duration)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+		case <-s.ShutdownCtx.Done():
+		}
+
+		revert()
+	}()
+}
+
+func chaosDuration(duration time.Duration) (time.Duration, error) {
+	if duration <= 0 || duration > maxChaosDuration {
+		return 0, status.Errorf(codes.InvalidArgument, "duration should be positive and at most %s", maxChaosDuration)
+	}
+
+	return duration, nil
+}
+
+func chaosResponse(resp string, until time.Time) *machine.ChaosResponse {
+	msg := &machine.Chaos{
+		Resp: resp,
+	}
+
+	if !until.IsZero() {
+		msg.Until = timestamppb.New(until)
+	}
+
+	return &machine.ChaosResponse{
+		Messages: []*machine.Chaos{msg},
+	}
+}
+
+func chaosID() (string, error) {
+	buf := make([]byte, 4)
+
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return "chaos-drop-" + hex.EncodeToString(buf), nil
+}
+
+func chaosDropChain(id string, hook nethelpers.NfTablesChainHook, rule network.NfTablesRule) *network.NfTablesChain {
+	chain := network.NewNfTablesChain(network.NamespaceName, id)
+
+	spec := chain.TypedSpec()
+	spec.Type = nethelpers.ChainTypeFilter
+	spec.Hook = hook
+	spec.Priority = nethelpers.ChainPriorityMangle
+	spec.Policy = nethelpers.VerdictAccept
+	spec.Rules = []network.NfTablesRule{rule}
+
+	return chain
+}
+
+// filesystemUsage returns the total and used space of the filesystem available to the unprivileged users.
+func filesystemUsage(path string) (total, used uint64, err error) {
+	var st unix.Statfs_t
+
+	if err = unix.Statfs(path, &st); err != nil {
+		return 0, 0, fmt.Errorf("error getting usage of %q: %w", path, err)
+	}
+
+	if st.Blocks == 0 {
+		return 0, 0, fmt.Errorf("filesystem at %q has zero size", path)
+	}
+
+	total = st.Blocks * uint64(st.Bsize)
+	used = total - st.Bavail*uint64(st.Bsize)
+
+	return total, used, nil
+}
+
+// fallocateUpTo allocates up to size bytes in chunks, stopping early when the filesystem is out of space.
+func fallocateUpTo(f *os.File, size uint64) (uint64, error) {
+	var allocated uint64
+
+	for allocated < size {
+		chunk := min(size-allocated, chaosFillChunkSize)
+
+		if err := unix.Fallocate(int(f.Fd()), 0, int64(allocated), int64(chunk)); err != nil {
+			if errors.Is(err, unix.ENOSPC) {
+				break
+			}
+
+			return 0, err
+		}
+
+		allocated += chunk
+	}
+
+	return allocated, nil
+}
@@ -0,0 +1,181 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	clientv3 "go.etcd.io/etcd/client/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
+	"github.com/siderolabs/talos/internal/pkg/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	etcdresource "github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// etcdCertsRotationTimeout is the timeout for each step of the etcd certificate rotation.
+const etcdCertsRotationTimeout = 5 * time.Minute
+
+// EtcdRotateCertificates implements the machine.MachineServer interface.
+//
+//nolint:gocyclo
+func (s *Server) EtcdRotateCertificates(_ *emptypb.Empty, srv machine.MachineService_EtcdRotateCertificatesServer) error {
+	if err := s.checkControlplane("etcd rotate certificates"); err != nil {
+		return err
+	}
+
+	ctx := srv.Context()
+	resources := s.Controller.Runtime().State().V1Alpha2().Resources()
+
+	progress := func(stage machine.EtcdCertificateRotationEvent_Stage, format string, args ...any) error {
+		message := fmt.Sprintf(format, args...)
+
+		s.Controller.Runtime().Events().Publish(ctx, &machine.EtcdCertificateRotationEvent{
+			Stage:   stage,
+			Message: message,
+		})
+
+		return srv.Send(&machine.EtcdRotateCertificatesProgress{
+			Stage:   stage,
+			Message: message,
+		})
+	}
+
+	if err := progress(machine.EtcdCertificateRotationEvent_CHECKING_HEALTH, "checking etcd cluster health"); err != nil {
+		return err
+	}
+
+	client, err := etcd.NewClientFromControlPlaneIPs(ctx, resources)
+	if err != nil {
+		return fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	if err = client.ValidateForMemberRestart(ctx, "certificate rotation"); err != nil {
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	members, err := client.MemberList(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to list etcd members: %w", err)
+	}
+
+	if len(members.Members) == 1 {
+		if err = progress(machine.EtcdCertificateRotationEvent_CHECKING_HEALTH, "etcd has a single member, it will be unavailable while restarting"); err != nil {
+			return err
+		}
+	} else {
+		memberID, err := etcd.GetLocalMemberID(ctx, resources)
+		if err != nil {
+			return err
+		}
+
+		// move the leadership away to avoid the leader election when the member restarts
+		leader, err := client.ForfeitLeadership(clientv3.WithRequireLeader(ctx), etcdresource.FormatMemberID(memberID))
+		if err != nil {
+			return fmt.Errorf("failed to forfeit leadership: %w", err)
+		}
+
+		if leader != "" {
+			if err = progress(machine.EtcdCertificateRotationEvent_CHECKING_HEALTH, "moved etcd leadership to %s", leader); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err = progress(machine.EtcdCertificateRotationEvent_ISSUING_CERTIFICATES, "issuing new etcd certificates"); err != nil {
+		return err
+	}
+
+	if err = s.refreshEtcdCerts(ctx); err != nil {
+		return err
+	}
+
+	if err = progress(machine.EtcdCertificateRotationEvent_RESTARTING, "restarting etcd"); err != nil {
+		return err
+	}
+
+	services := system.Services(s.Controller.Runtime())
+
+	if err = services.Stop(ctx, "etcd"); err != nil {
+		return fmt.Errorf("failed to stop etcd: %w", err)
+	}
+
+	if err = services.Start("etcd"); err != nil {
+		return fmt.Errorf("failed to start etcd: %w", err)
+	}
+
+	if err = progress(machine.EtcdCertificateRotationEvent_WAITING_HEALTHY, "waiting for etcd to become healthy"); err != nil {
+		return err
+	}
+
+	waitCtx, waitCancel := context.WithTimeout(ctx, etcdCertsRotationTimeout)
+	defer waitCancel()
+
+	if err = system.WaitForService(system.StateEventUp, "etcd").Wait(waitCtx); err != nil {
+		return fmt.Errorf("etcd didn't become healthy: %w", err)
+	}
+
+	if err = client.ValidateQuorum(waitCtx); err != nil {
+		return fmt.Errorf("etcd quorum check failed: %w", err)
+	}
+
+	return progress(machine.EtcdCertificateRotationEvent_DONE, "etcd certificates rotated")
+}
+
+// refreshEtcdCerts requests new etcd certificates and waits for them to be written to disk.
+func (s *Server) refreshEtcdCerts(ctx context.Context) error {
+	resources := s.Controller.Runtime().State().V1Alpha2().Resources()
+
+	pkiStatus, err := safe.StateGetByID[*etcdresource.PKIStatus](ctx, resources, etcdresource.PKIID)
+	if err != nil {
+		return fmt.Errorf("failed to get etcd PKI status: %w", err)
+	}
+
+	oldVersion := pkiStatus.TypedSpec().Version
+
+	refreshRequest := secrets.NewEtcdCertsRefreshRequest()
+
+	if err = resources.Create(ctx, refreshRequest); err != nil {
+		if !state.IsConflictError(err) {
+			return fmt.Errorf("failed to request etcd certificates: %w", err)
+		}
+
+		if _, err = safe.StateUpdateWithConflicts(ctx, resources, refreshRequest.Metadata(), func(r *secrets.EtcdCertsRefreshRequest) error {
+			r.TypedSpec().Request++
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("failed to request etcd certificates: %w", err)
+		}
+	}
+
+	waitCtx, waitCancel := context.WithTimeout(ctx, etcdCertsRotationTimeout)
+	defer waitCancel()
+
+	if _, err = resources.WatchFor(waitCtx, pkiStatus.Metadata(), state.WithCondition(func(r resource.Resource) (bool, error) {
+		status, ok := r.(*etcdresource.PKIStatus)
+		if !ok {
+			return false, nil
+		}
+
+		return status.TypedSpec().Ready && status.TypedSpec().Version != oldVersion, nil
+	})); err != nil {
+		return fmt.Errorf("failed waiting for new etcd certificates: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/internal/pkg/benchmark"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+const defaultEtcdPrecheckDuration = 3 * time.Second
+
+// etcdPrecheckThresholds are the maximums of the 99th percentile latency recommended by the etcd documentation.
+var etcdPrecheckThresholds = map[string]time.Duration{
+	benchmark.EtcdWALFsync:      10 * time.Millisecond,
+	benchmark.EtcdBackendCommit: 25 * time.Millisecond,
+}
+
+// EtcdPrecheck implements the machine.MachineServer interface.
+func (s *Server) EtcdPrecheck(ctx context.Context, in *machine.EtcdPrecheckRequest) (*machine.EtcdPrecheckResponse, error) {
+	if err := s.checkControlplane("etcd precheck"); err != nil {
+		return nil, err
+	}
+
+	duration := benchmarkDuration(in.GetDuration(), defaultEtcdPrecheckDuration)
+
+	if duration <= 0 || duration > maxBenchmarkDuration {
+		return nil, status.Errorf(codes.InvalidArgument, "duration should be positive and at most %s", maxBenchmarkDuration)
+	}
+
+	unlock, ok := benchmark.TryLock()
+	if !ok {
+		return nil, status.Error(codes.FailedPrecondition, "another benchmark is already running")
+	}
+
+	defer unlock()
+
+	// etcd data directory is in the EPHEMERAL partition, and it might not exist before the bootstrap
+	results, err := benchmark.EtcdDisk(ctx, constants.EphemeralMountPoint, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	precheck := &machine.EtcdPrecheck{
+		Passed: true,
+	}
+
+	for _, result := range results {
+		threshold := etcdPrecheckThresholds[result.Name]
+		passed := result.LatencyP99 <= threshold
+
+		precheck.Results = append(precheck.Results, &machine.EtcdPrecheckResult{
+			Name:       result.Name,
+			Operations: result.Operations,
+			LatencyAvg: durationpb.New(result.LatencyAvg),
+			LatencyP99: durationpb.New(result.LatencyP99),
+			Threshold:  durationpb.New(threshold),
+			Passed:     passed,
+		})
+
+		precheck.Passed = precheck.Passed && passed
+	}
+
+	return &machine.EtcdPrecheckResponse{
+		Messages: []*machine.EtcdPrecheck{precheck},
+	}, nil
+}
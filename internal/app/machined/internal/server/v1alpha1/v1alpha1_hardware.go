@@ -0,0 +1,184 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/talos/internal/pkg/smbios"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/block"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// HardwareInventory implements the machine.MachineServer interface.
+//
+//nolint:gocyclo
+func (s *Server) HardwareInventory(ctx context.Context, in *machine.HardwareInventoryRequest) (*machine.HardwareInventoryResponse, error) {
+	st := s.Controller.Runtime().State().V1Alpha2().Resources()
+
+	inventory := &machine.HardwareInventory{}
+
+	systemInformation, err := safe.StateGetByID[*hardware.SystemInformation](ctx, st, hardware.SystemInformationID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting system information: %w", err)
+	}
+
+	if systemInformation != nil {
+		spec := systemInformation.TypedSpec()
+
+		inventory.System = &machine.HardwareSystem{
+			Manufacturer: spec.Manufacturer,
+			ProductName:  spec.ProductName,
+			Version:      spec.Version,
+			SerialNumber: spec.SerialNumber,
+			Uuid:         spec.UUID,
+			SkuNumber:    spec.SKUNumber,
+		}
+	}
+
+	// BIOS and baseboard information is not available as resources, so read it from SMBIOS directly
+	if info, err := smbios.GetSMBIOSInfo(); err == nil {
+		inventory.Bios = &machine.HardwareBIOS{
+			Vendor:      info.BIOSInformation.Vendor,
+			Version:     info.BIOSInformation.Version,
+			ReleaseDate: info.BIOSInformation.ReleaseDate,
+		}
+
+		inventory.Baseboard = &machine.HardwareBaseboard{
+			Manufacturer: info.BaseboardInformation.Manufacturer,
+			ProductName:  info.BaseboardInformation.Product,
+			Version:      info.BaseboardInformation.Version,
+			SerialNumber: info.BaseboardInformation.SerialNumber,
+			AssetTag:     info.BaseboardInformation.AssetTag,
+		}
+	} else {
+		log.Printf("error reading SMBIOS information: %s", err)
+	}
+
+	processors, err := safe.StateListAll[*hardware.Processor](ctx, st)
+	if err != nil {
+		return nil, fmt.Errorf("error listing processors: %w", err)
+	}
+
+	for iter := processors.Iterator(); iter.Next(); {
+		processor := iter.Value()
+		spec := processor.TypedSpec()
+
+		inventory.Processors = append(inventory.Processors, &machine.HardwareProcessor{
+			Socket:       spec.Socket,
+			Manufacturer: spec.Manufacturer,
+			ProductName:  spec.ProductName,
+			MaxSpeedMhz:  spec.MaxSpeed,
+			CoreCount:    spec.CoreCount,
+			ThreadCount:  spec.ThreadCount,
+			SerialNumber: spec.SerialNumber,
+			PartNumber:   spec.PartNumber,
+		})
+	}
+
+	memoryModules, err := safe.StateListAll[*hardware.MemoryModule](ctx, st)
+	if err != nil {
+		return nil, fmt.Errorf("error listing memory modules: %w", err)
+	}
+
+	for iter := memoryModules.Iterator(); iter.Next(); {
+		memoryModule := iter.Value()
+		spec := memoryModule.TypedSpec()
+
+		inventory.MemoryModules = append(inventory.MemoryModules, &machine.HardwareMemoryModule{
+			DeviceLocator: spec.DeviceLocator,
+			BankLocator:   spec.BankLocator,
+			SizeMib:       spec.Size,
+			Speed:         spec.Speed,
+			Manufacturer:  spec.Manufacturer,
+			ProductName:   spec.ProductName,
+			SerialNumber:  spec.SerialNumber,
+			AssetTag:      spec.AssetTag,
+		})
+	}
+
+	pciDevices, err := safe.StateListAll[*hardware.PCIDevice](ctx, st)
+	if err != nil {
+		return nil, fmt.Errorf("error listing PCI devices: %w", err)
+	}
+
+	for iter := pciDevices.Iterator(); iter.Next(); {
+		pciDevice := iter.Value()
+		spec := pciDevice.TypedSpec()
+
+		inventory.PciDevices = append(inventory.PciDevices, &machine.HardwarePCIDevice{
+			Address:    pciDevice.Metadata().ID(),
+			Class:      spec.Class,
+			Subclass:   spec.Subclass,
+			Vendor:     spec.Vendor,
+			Product:    spec.Product,
+			ClassId:    spec.ClassID,
+			SubclassId: spec.SubclassID,
+			VendorId:   spec.VendorID,
+			ProductId:  spec.ProductID,
+		})
+	}
+
+	links, err := safe.StateListAll[*network.LinkStatus](ctx, st)
+	if err != nil {
+		return nil, fmt.Errorf("error listing links: %w", err)
+	}
+
+	for iter := links.Iterator(); iter.Next(); {
+		link := iter.Value()
+		spec := link.TypedSpec()
+
+		if !spec.Physical() {
+			continue
+		}
+
+		inventory.NetworkInterfaces = append(inventory.NetworkInterfaces, &machine.HardwareNetworkInterface{
+			Name:            link.Metadata().ID(),
+			HardwareAddr:    spec.HardwareAddr.String(),
+			PermanentAddr:   spec.PermanentAddr.String(),
+			BusPath:         spec.BusPath,
+			Driver:          spec.Driver,
+			DriverVersion:   spec.DriverVersion,
+			FirmwareVersion: spec.FirmwareVersion,
+			Vendor:          spec.Vendor,
+			Product:         spec.Product,
+			LinkState:       spec.LinkState,
+			SpeedMbit:       int64(spec.SpeedMegabits),
+		})
+	}
+
+	disks, err := safe.StateListAll[*block.Disk](ctx, st)
+	if err != nil {
+		return nil, fmt.Errorf("error listing disks: %w", err)
+	}
+
+	for iter := disks.Iterator(); iter.Next(); {
+		disk := iter.Value()
+		spec := disk.TypedSpec()
+
+		inventory.Disks = append(inventory.Disks, &machine.HardwareDisk{
+			DevPath:    spec.DevPath,
+			Size:       spec.Size,
+			Model:      spec.Model,
+			Serial:     spec.Serial,
+			Wwid:       spec.WWID,
+			Transport:  spec.Transport,
+			Rotational: spec.Rotational,
+			Cdrom:      spec.CDROM,
+			BusPath:    spec.BusPath,
+		})
+	}
+
+	return &machine.HardwareInventoryResponse{
+		Messages: []*machine.HardwareInventory{inventory},
+	}, nil
+}
@@ -6,6 +6,7 @@ package runtime
 
 import (
 	"context"
+	"errors"
 
 	containerdapi "github.com/containerd/containerd/v2/client"
 	"github.com/containerd/containerd/v2/pkg/namespaces"
@@ -16,6 +17,7 @@ import (
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/siderolabs/talos/internal/pkg/containers/image"
+	"github.com/siderolabs/talos/internal/pkg/install"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -106,3 +108,36 @@ func (s *Server) ImagePull(ctx context.Context, req *machine.ImagePullRequest) (
 		},
 	}, nil
 }
+
+// ImageValidate implements the machine.MachineServer interface.
+func (s *Server) ImageValidate(ctx context.Context, req *machine.ImageValidateRequest) (*machine.ImageValidateResponse, error) {
+	if req.GetReference() == "" {
+		return nil, status.Error(codes.InvalidArgument, "image reference is required")
+	}
+
+	preflight, err := install.PreflightInstallerImage(ctx, s.Controller.Runtime().Config().Machine().Registries(), s.Controller.Runtime().Config().ImageVerification(), req.GetReference())
+	if err != nil {
+		return nil, preflightStatus(req.GetReference(), err)
+	}
+
+	return &machine.ImageValidateResponse{
+		Messages: []*machine.ImageValidate{
+			{
+				Reference:         preflight.Image,
+				Platform:          platforms.Format(preflight.Platform),
+				SignatureVerified: preflight.SignatureVerified,
+			},
+		},
+	}, nil
+}
+
+// preflightStatus converts the installer image pre-flight check error to the API status.
+//
+// The signature verification failures are reported as PermissionDenied, the other failures as FailedPrecondition.
+func preflightStatus(ref string, err error) error {
+	if errors.Is(err, install.ErrSignatureVerification) {
+		return status.Errorf(codes.PermissionDenied, "installer image %q pre-flight check failed: %s", ref, err)
+	}
+
+	return status.Errorf(codes.FailedPrecondition, "installer image %q pre-flight check failed: %s", ref, err)
+}
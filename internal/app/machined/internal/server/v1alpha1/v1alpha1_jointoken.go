@@ -0,0 +1,120 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/talos/internal/pkg/etcd"
+	"github.com/siderolabs/talos/internal/pkg/jointoken"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+// JoinTokenCreate implements the machine.MachineServer interface.
+func (s *Server) JoinTokenCreate(ctx context.Context, in *machine.JoinTokenCreateRequest) (*machine.JoinTokenCreateResponse, error) {
+	if err := s.checkControlplane("join token create"); err != nil {
+		return nil, err
+	}
+
+	if in.GetTtl() == nil {
+		return nil, status.Error(codes.InvalidArgument, "join token TTL is required")
+	}
+
+	client, err := etcd.NewLocalClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	secretToken, token, err := jointoken.Create(ctx, client.Client, in.GetTtl().AsDuration(), in.GetDescription())
+	if err != nil {
+		return nil, err
+	}
+
+	return &machine.JoinTokenCreateResponse{
+		Messages: []*machine.JoinTokenCreate{
+			{
+				Token:       joinTokenToProto(token),
+				SecretToken: secretToken,
+			},
+		},
+	}, nil
+}
+
+// JoinTokenList implements the machine.MachineServer interface.
+func (s *Server) JoinTokenList(ctx context.Context, in *emptypb.Empty) (*machine.JoinTokenListResponse, error) {
+	if err := s.checkControlplane("join token list"); err != nil {
+		return nil, err
+	}
+
+	client, err := etcd.NewLocalClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	tokens, err := jointoken.List(ctx, client.Client)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &machine.JoinTokenList{}
+
+	for _, token := range tokens {
+		resp.Tokens = append(resp.Tokens, joinTokenToProto(token))
+	}
+
+	return &machine.JoinTokenListResponse{
+		Messages: []*machine.JoinTokenList{resp},
+	}, nil
+}
+
+// JoinTokenRevoke implements the machine.MachineServer interface.
+func (s *Server) JoinTokenRevoke(ctx context.Context, in *machine.JoinTokenRevokeRequest) (*machine.JoinTokenRevokeResponse, error) {
+	if err := s.checkControlplane("join token revoke"); err != nil {
+		return nil, err
+	}
+
+	client, err := etcd.NewLocalClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create etcd client: %w", err)
+	}
+
+	//nolint:errcheck
+	defer client.Close()
+
+	if err = jointoken.Revoke(ctx, client.Client, in.GetId()); err != nil {
+		if errors.Is(err, jointoken.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+
+		return nil, err
+	}
+
+	return &machine.JoinTokenRevokeResponse{
+		Messages: []*machine.JoinTokenRevoke{
+			{},
+		},
+	}, nil
+}
+
+func joinTokenToProto(token jointoken.Token) *machine.JoinToken {
+	return &machine.JoinToken{
+		Id:          token.ID,
+		Expires:     timestamppb.New(token.Expires),
+		Description: token.Description,
+	}
+}
@@ -14,8 +14,10 @@ import (
 	"github.com/prometheus/procfs"
 	"github.com/siderolabs/gen/maps"
 	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/go-pointer"
 	"google.golang.org/protobuf/types/known/emptypb"
 
+	"github.com/siderolabs/talos/internal/pkg/hwmon"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 )
 
@@ -320,3 +322,33 @@ func (s *Server) DiskStats(ctx context.Context, in *emptypb.Empty) (*machine.Dis
 
 	return reply, nil
 }
+
+// SensorStats implements the machine.MachineServer interface.
+func (s *Server) SensorStats(ctx context.Context, in *emptypb.Empty) (*machine.SensorStatsResponse, error) {
+	sensors, err := hwmon.Read(hwmon.SysfsPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &machine.SensorStatsResponse{
+		Messages: []*machine.SensorStats{
+			{
+				Sensors: xslices.Map(sensors, func(sensor hwmon.Sensor) *machine.SensorStat {
+					return &machine.SensorStat{
+						Device:      sensor.Device,
+						Chip:        sensor.Chip,
+						Id:          sensor.ID,
+						Label:       sensor.Label,
+						Type:        string(sensor.Type),
+						Value:       sensor.Value,
+						Unit:        sensor.Type.Unit(),
+						Max:         pointer.SafeDeref(sensor.Max),
+						Critical:    pointer.SafeDeref(sensor.Critical),
+						LowCritical: pointer.SafeDeref(sensor.LowCritical),
+						Alarm:       sensor.IsCritical(),
+					}
+				}),
+			},
+		},
+	}, nil
+}
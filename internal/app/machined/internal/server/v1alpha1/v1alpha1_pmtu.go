@@ -0,0 +1,56 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"net/netip"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/pkg/pmtu"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+const (
+	defaultPathMTUProbeTimeout = time.Second
+	maxPathMTUProbeTimeout     = 10 * time.Second
+)
+
+// PathMTU implements the machine.MachineServer interface.
+func (s *Server) PathMTU(ctx context.Context, in *machine.PathMTURequest) (*machine.PathMTUResponse, error) {
+	dst, err := netip.ParseAddr(in.GetDestination())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid destination address: %s", err)
+	}
+
+	timeout := defaultPathMTUProbeTimeout
+
+	if in.GetTimeout() != nil {
+		timeout = in.GetTimeout().AsDuration()
+	}
+
+	if timeout <= 0 || timeout > maxPathMTUProbeTimeout {
+		return nil, status.Errorf(codes.InvalidArgument, "probe timeout should be positive and at most %s", maxPathMTUProbeTimeout)
+	}
+
+	result, err := pmtu.Discover(ctx, dst, timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &machine.PathMTUResponse{
+		Messages: []*machine.PathMTU{
+			{
+				Destination: dst.String(),
+				RouteMtu:    uint32(result.RouteMTU),
+				PathMtu:     uint32(result.PathMTU),
+				Probes:      uint32(result.Probes),
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,335 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// powerActionScheduler keeps track of the scheduled reboot or shutdown.
+//
+// Only a single power action can be scheduled, a new power action replaces the scheduled one.
+// The scheduled power action is persisted in META, so that it survives reboots.
+type powerActionScheduler struct {
+	mu        sync.Mutex
+	scheduled *scheduledPowerAction
+}
+
+type scheduledPowerAction struct {
+	request *powerActionRequest
+	timer   *time.Timer
+}
+
+// powerActionRequest is the reboot or shutdown request, as persisted in META.
+type powerActionRequest struct {
+	Action     machine.PowerActionEvent_Action `json:"action"`
+	At         time.Time                       `json:"at,omitempty"`
+	Message    string                          `json:"message,omitempty"`
+	Force      bool                            `json:"force,omitempty"`
+	RebootMode machine.RebootRequest_Mode      `json:"rebootMode,omitempty"`
+	ActorID    string                          `json:"actorID,omitempty"`
+}
+
+func newPowerActionRequest(action machine.PowerActionEvent_Action, at *timestamppb.Timestamp, message string, force bool, actorID string) *powerActionRequest {
+	req := &powerActionRequest{
+		Action:  action,
+		Message: message,
+		Force:   force,
+		ActorID: actorID,
+	}
+
+	if at != nil {
+		req.At = at.AsTime()
+	}
+
+	return req
+}
+
+func (req *powerActionRequest) event() *machine.PowerActionEvent {
+	event := &machine.PowerActionEvent{
+		Action:  req.Action,
+		Message: req.Message,
+		Force:   req.Force,
+	}
+
+	if !req.At.IsZero() {
+		event.At = timestamppb.New(req.At)
+	}
+
+	return event
+}
+
+// canceledEvent returns the event with the reason the power action was canceled as the message.
+func (req *powerActionRequest) canceledEvent(reason string) *machine.PowerActionEvent {
+	event := req.event()
+	event.Message = reason
+
+	return event
+}
+
+// runPowerAction runs the power action immediately, or schedules it if the requested time is in the future.
+//
+// The power action is published to the event stream with the broadcast message.
+// The time the power action is scheduled at is returned (nil if the power action was started immediately).
+func (s *Server) runPowerAction(ctx context.Context, req *powerActionRequest) (*timestamppb.Timestamp, error) {
+	s.powerActions.mu.Lock()
+	defer s.powerActions.mu.Unlock()
+
+	s.cancelPowerActionLocked(ctx, "replaced by a new "+strings.ToLower(req.Action.String()))
+
+	if !req.At.After(time.Now()) {
+		s.publishPowerAction(req.event(), machine.PowerActionEvent_STARTED)
+
+		go s.startPowerAction(req)
+
+		return nil, nil
+	}
+
+	if err := s.persistPowerAction(ctx, req); err != nil {
+		return nil, fmt.Errorf("failed to persist the scheduled %s: %w", strings.ToLower(req.Action.String()), err)
+	}
+
+	s.schedulePowerActionLocked(req)
+
+	return timestamppb.New(req.At), nil
+}
+
+// schedulePowerActionLocked starts the timer for the scheduled power action.
+func (s *Server) schedulePowerActionLocked(req *powerActionRequest) {
+	action := strings.ToLower(req.Action.String())
+
+	log.Printf("%s scheduled at %s", action, req.At.Format(time.RFC3339))
+
+	s.publishPowerAction(req.event(), machine.PowerActionEvent_SCHEDULED)
+
+	scheduled := &scheduledPowerAction{
+		request: req,
+	}
+
+	scheduled.timer = time.AfterFunc(time.Until(req.At), func() {
+		s.powerActions.mu.Lock()
+
+		if s.powerActions.scheduled != scheduled {
+			// canceled or replaced in the meantime
+			s.powerActions.mu.Unlock()
+
+			return
+		}
+
+		s.powerActions.scheduled = nil
+
+		if err := s.persistPowerAction(context.Background(), nil); err != nil {
+			log.Printf("failed to remove the scheduled %s from META: %s", action, err)
+		}
+
+		s.powerActions.mu.Unlock()
+
+		if s.inNodeMaintenance() {
+			// the node entered maintenance after the power action was scheduled
+			log.Printf("scheduled %s canceled, as the node is in maintenance", action)
+
+			s.publishPowerAction(req.canceledEvent("node is in maintenance"), machine.PowerActionEvent_CANCELED)
+
+			return
+		}
+
+		s.publishPowerAction(req.event(), machine.PowerActionEvent_STARTED)
+
+		s.startPowerAction(req)
+	})
+
+	s.powerActions.scheduled = scheduled
+}
+
+// RestorePowerAction restores the power action scheduled before the reboot from META.
+//
+// The power action which was scheduled at the time the machine was down is dropped.
+func (s *Server) RestorePowerAction(ctx context.Context) error {
+	if !s.Controller.Runtime().State().Platform().Mode().Supports(runtime.MetaKV) {
+		return nil
+	}
+
+	resources := s.Controller.Runtime().State().V1Alpha2().Resources()
+
+	if _, err := resources.WatchFor(
+		ctx,
+		resource.NewMetadata(runtimeres.NamespaceName, runtimeres.MetaLoadedType, runtimeres.MetaLoadedID, resource.VersionUndefined),
+		state.WithEventTypes(state.Created, state.Updated),
+	); err != nil {
+		return err
+	}
+
+	persisted, ok := s.Controller.Runtime().State().Machine().Meta().ReadTag(meta.PowerActionSchedule)
+	if !ok {
+		return nil
+	}
+
+	var req powerActionRequest
+
+	if err := json.Unmarshal([]byte(persisted), &req); err != nil {
+		return fmt.Errorf("failed to decode the scheduled power action: %w", err)
+	}
+
+	s.powerActions.mu.Lock()
+	defer s.powerActions.mu.Unlock()
+
+	if s.powerActions.scheduled != nil {
+		// a new power action was scheduled in the meantime
+		return nil
+	}
+
+	if !req.At.After(time.Now()) {
+		log.Printf("scheduled %s dropped, as it was due at %s", strings.ToLower(req.Action.String()), req.At.Format(time.RFC3339))
+
+		s.publishPowerAction(req.canceledEvent("machine was down at the scheduled time"), machine.PowerActionEvent_CANCELED)
+
+		return s.persistPowerAction(ctx, nil)
+	}
+
+	s.schedulePowerActionLocked(&req)
+
+	return nil
+}
+
+// startPowerAction runs the power action sequence.
+func (s *Server) startPowerAction(req *powerActionRequest) {
+	ctx := context.WithValue(context.Background(), runtime.ActorIDCtxKey{}, req.ActorID)
+
+	switch req.Action { //nolint:exhaustive
+	case machine.PowerActionEvent_REBOOT:
+		if err := s.Controller.Run(ctx, runtime.SequenceReboot, &machine.RebootRequest{
+			Mode:    req.RebootMode,
+			Force:   req.Force,
+			Message: req.Message,
+		}); err != nil {
+			if !runtime.IsRebootError(err) {
+				log.Println("reboot failed:", err)
+			}
+		}
+	case machine.PowerActionEvent_SHUTDOWN:
+		if err := s.Controller.Run(ctx, runtime.SequenceShutdown, &machine.ShutdownRequest{
+			Force:   req.Force,
+			Message: req.Message,
+		}, runtime.WithTakeover()); err != nil {
+			if !runtime.IsRebootError(err) {
+				log.Println("shutdown failed:", err)
+			}
+		}
+	}
+}
+
+// persistPowerAction stores the scheduled power action in META, or removes it if req is nil.
+func (s *Server) persistPowerAction(ctx context.Context, req *powerActionRequest) error {
+	if !s.Controller.Runtime().State().Platform().Mode().Supports(runtime.MetaKV) {
+		return nil
+	}
+
+	metaState := s.Controller.Runtime().State().Machine().Meta()
+
+	if req == nil {
+		ok, err := metaState.DeleteTag(ctx, meta.PowerActionSchedule)
+		if err != nil || !ok {
+			return err
+		}
+	} else {
+		persisted, err := json.Marshal(req)
+		if err != nil {
+			return err
+		}
+
+		ok, err := metaState.SetTag(ctx, meta.PowerActionSchedule, string(persisted))
+		if err != nil {
+			return err
+		}
+
+		if !ok {
+			return errors.New("failed to set META tag")
+		}
+	}
+
+	if err := metaState.Flush(); err != nil && !os.IsNotExist(err) {
+		// ignore not exist error, as it's possible that the meta partition is not created yet
+		return err
+	}
+
+	return nil
+}
+
+// isScheduled returns true if the power action is requested to run in the future.
+func isScheduled(at *timestamppb.Timestamp) bool {
+	return at != nil && at.AsTime().After(time.Now())
+}
+
+// cancelPowerActionLocked cancels the scheduled power action (if any).
+//
+// The reason is logged and published as the message of the canceled event.
+func (s *Server) cancelPowerActionLocked(ctx context.Context, reason string) *machine.PowerActionEvent {
+	scheduled := s.powerActions.scheduled
+	if scheduled == nil {
+		return nil
+	}
+
+	scheduled.timer.Stop()
+	s.powerActions.scheduled = nil
+
+	if err := s.persistPowerAction(ctx, nil); err != nil {
+		log.Printf("failed to remove the scheduled %s from META: %s", strings.ToLower(scheduled.request.Action.String()), err)
+	}
+
+	log.Printf("scheduled %s canceled: %s", strings.ToLower(scheduled.request.Action.String()), reason)
+
+	s.publishPowerAction(scheduled.request.canceledEvent(reason), machine.PowerActionEvent_CANCELED)
+
+	return scheduled.request.event()
+}
+
+func (s *Server) publishPowerAction(event *machine.PowerActionEvent, eventState machine.PowerActionEvent_State) {
+	s.Controller.Runtime().Events().Publish(context.Background(), &machine.PowerActionEvent{
+		Action:  event.GetAction(),
+		State:   eventState,
+		At:      event.GetAt(),
+		Message: event.GetMessage(),
+		Force:   event.GetForce(),
+	})
+}
+
+// PowerActionCancel implements the machine.MachineServer interface.
+func (s *Server) PowerActionCancel(ctx context.Context, in *machine.PowerActionCancelRequest) (*machine.PowerActionCancelResponse, error) {
+	s.powerActions.mu.Lock()
+	defer s.powerActions.mu.Unlock()
+
+	canceled := s.cancelPowerActionLocked(ctx, "canceled via API")
+	if canceled == nil {
+		return nil, status.Error(codes.FailedPrecondition, "no reboot or shutdown is scheduled")
+	}
+
+	return &machine.PowerActionCancelResponse{
+		Messages: []*machine.PowerActionCancel{
+			{
+				Action:      canceled.GetAction(),
+				ScheduledAt: canceled.GetAt(),
+			},
+		},
+	}, nil
+}
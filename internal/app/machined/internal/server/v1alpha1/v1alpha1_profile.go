@@ -0,0 +1,90 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/pkg/profiling"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// profileChunkSize is the size of the profile chunks streamed back.
+const profileChunkSize = 64 * 1024
+
+// Profile implements the machine.MachineServer interface.
+func (s *Server) Profile(in *machine.ProfileRequest, srv machine.MachineService_ProfileServer) error {
+	return collectProfile(srv, in.GetService(), profiling.Type(strings.ToLower(in.GetType().String())), in.GetDuration().AsDuration())
+}
+
+// StackDump implements the machine.MachineServer interface.
+func (s *Server) StackDump(in *machine.StackDumpRequest, srv machine.MachineService_StackDumpServer) error {
+	return collectProfile(srv, in.GetService(), profiling.Stacks, 0)
+}
+
+// collectProfile collects the profile of the Talos service and streams it back in chunks.
+//
+// The stream of any RPC returning common.Data chunks can be used.
+func collectProfile(srv machine.MachineService_ProfileServer, service string, typ profiling.Type, duration time.Duration) error {
+	var collect func(ctx context.Context, w io.Writer) error
+
+	switch service {
+	case "machined":
+		collect = func(ctx context.Context, w io.Writer) error {
+			return profiling.Collect(ctx, w, typ, duration)
+		}
+	case "apid", "trustd":
+		socketPath := constants.ApidProfilingSocketPath
+		if service == "trustd" {
+			socketPath = constants.TrustdProfilingSocketPath
+		}
+
+		collect = func(ctx context.Context, w io.Writer) error {
+			if err := profiling.Fetch(ctx, socketPath, w, typ, duration); err != nil {
+				if errors.Is(err, profiling.ErrUnsupportedType) {
+					return err
+				}
+
+				return status.Errorf(codes.Unavailable, "error fetching profile of %q: %s", service, err)
+			}
+
+			return nil
+		}
+	default:
+		return status.Errorf(codes.InvalidArgument, "profiling is not supported for service %q: should be one of machined, apid, trustd", service)
+	}
+
+	var buf bytes.Buffer
+
+	if err := collect(srv.Context(), &buf); err != nil {
+		if errors.Is(err, profiling.ErrUnsupportedType) {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		return fmt.Errorf("error collecting profile: %w", err)
+	}
+
+	for data := buf.Bytes(); len(data) > 0; {
+		chunk := data[:min(len(data), profileChunkSize)]
+		data = data[len(chunk):]
+
+		if err := srv.Send(&common.Data{Bytes: chunk}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -30,6 +30,7 @@ import (
 	"github.com/google/uuid"
 	"github.com/gopacket/gopacket/afpacket"
 	multierror "github.com/hashicorp/go-multierror"
+	"github.com/jsimonetti/rtnetlink/v2"
 	"github.com/nberlee/go-netstat/netstat"
 	"github.com/pkg/xattr"
 	"github.com/prometheus/procfs"
@@ -47,6 +48,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader"
@@ -54,12 +56,14 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
 	"github.com/siderolabs/talos/internal/app/resources"
 	storaged "github.com/siderolabs/talos/internal/app/storaged"
+	"github.com/siderolabs/talos/internal/pkg/bootlog"
 	"github.com/siderolabs/talos/internal/pkg/configuration"
 	"github.com/siderolabs/talos/internal/pkg/containers"
 	taloscontainerd "github.com/siderolabs/talos/internal/pkg/containers/containerd"
 	"github.com/siderolabs/talos/internal/pkg/containers/cri"
 	"github.com/siderolabs/talos/internal/pkg/etcd"
 	"github.com/siderolabs/talos/internal/pkg/install"
+	"github.com/siderolabs/talos/internal/pkg/kmsgfilter"
 	"github.com/siderolabs/talos/internal/pkg/miniprocfs"
 	"github.com/siderolabs/talos/internal/pkg/partition"
 	"github.com/siderolabs/talos/internal/pkg/pcap"
@@ -113,6 +117,8 @@ type Server struct {
 	ShutdownCtx context.Context //nolint:containedctx
 
 	server *grpc.Server
+
+	powerActions powerActionScheduler
 }
 
 func (s *Server) checkSupported(feature runtime.ModeCapability) error {
@@ -125,6 +131,30 @@ func (s *Server) checkSupported(feature runtime.ModeCapability) error {
 	return nil
 }
 
+// inNodeMaintenance returns true if the node is in maintenance.
+//
+// While the node is in maintenance, the scheduled and automatic disruptive actions are held off,
+// but the explicit operator actions are still allowed.
+func (s *Server) inNodeMaintenance() bool {
+	_, inMaintenance := s.Controller.Runtime().State().Machine().Meta().ReadTag(meta.NodeMaintenance)
+
+	return inMaintenance
+}
+
+// checkNodeMaintenance refuses to schedule the disruptive actions while the node is in maintenance.
+func (s *Server) checkNodeMaintenance(apiName string) error {
+	reason, inMaintenance := s.Controller.Runtime().State().Machine().Meta().ReadTag(meta.NodeMaintenance)
+	if !inMaintenance {
+		return nil
+	}
+
+	if reason != "" {
+		reason = fmt.Sprintf(" (%s)", reason)
+	}
+
+	return status.Errorf(codes.FailedPrecondition, "%s is not allowed while the node is in maintenance%s, exit the maintenance first", apiName, reason)
+}
+
 func (s *Server) checkControlplane(apiName string) error {
 	switch s.Controller.Runtime().Config().Machine().Type() { //nolint:exhaustive
 	case machinetype.TypeControlPlane:
@@ -207,8 +237,15 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 	// --mode=auto detect actual update mode
 	case machine.ApplyConfigurationRequest_AUTO:
 		if err = s.Controller.Runtime().CanApplyImmediate(cfgProvider); err != nil {
-			in.Mode = machine.ApplyConfigurationRequest_REBOOT
-			modeDetails = "Applied configuration with a reboot"
+			if s.inNodeMaintenance() {
+				// don't reboot the node on its own while it is in maintenance
+				in.Mode = machine.ApplyConfigurationRequest_STAGED
+				modeDetails = "Staged configuration to be applied after the next reboot, as the node is in maintenance"
+			} else {
+				in.Mode = machine.ApplyConfigurationRequest_REBOOT
+				modeDetails = "Applied configuration with a reboot"
+			}
+
 			modeErr = ": " + err.Error()
 		} else {
 			in.Mode = machine.ApplyConfigurationRequest_NO_REBOOT
@@ -285,6 +322,11 @@ func (s *Server) ApplyConfiguration(ctx context.Context, in *machine.ApplyConfig
 		return nil, fmt.Errorf("incorrect mode '%s' specified for the apply config call", in.Mode.String())
 	}
 
+	s.Controller.Runtime().Events().Publish(ctx, &machine.ConfigApplyEvent{
+		Mode:        in.Mode,
+		ModeDetails: modeDetails,
+	})
+
 	return &machine.ApplyConfigurationResponse{
 		Messages: []*machine.ApplyConfiguration{
 			{
@@ -328,20 +370,25 @@ func (s *Server) Reboot(ctx context.Context, in *machine.RebootRequest) (reply *
 		return nil, err
 	}
 
-	rebootCtx := context.WithValue(context.Background(), runtime.ActorIDCtxKey{}, actorID)
-
-	go func() {
-		if err := s.Controller.Run(rebootCtx, runtime.SequenceReboot, in); err != nil {
-			if !runtime.IsRebootError(err) {
-				log.Println("reboot failed:", err)
-			}
+	if isScheduled(in.GetAt()) {
+		if err := s.checkNodeMaintenance("scheduled reboot"); err != nil {
+			return nil, err
 		}
-	}()
+	}
+
+	req := newPowerActionRequest(machine.PowerActionEvent_REBOOT, in.GetAt(), in.GetMessage(), in.GetForce(), actorID)
+	req.RebootMode = in.GetMode()
+
+	scheduledAt, err := s.runPowerAction(ctx, req)
+	if err != nil {
+		return nil, err
+	}
 
 	reply = &machine.RebootResponse{
 		Messages: []*machine.Reboot{
 			{
-				ActorId: actorID,
+				ActorId:     actorID,
+				ScheduledAt: scheduledAt,
 			},
 		},
 	}
@@ -438,20 +485,22 @@ func (s *Server) Shutdown(ctx context.Context, in *machine.ShutdownRequest) (rep
 		return nil, err
 	}
 
-	shutdownCtx := context.WithValue(context.Background(), runtime.ActorIDCtxKey{}, actorID)
-
-	go func() {
-		if err := s.Controller.Run(shutdownCtx, runtime.SequenceShutdown, in, runtime.WithTakeover()); err != nil {
-			if !runtime.IsRebootError(err) {
-				log.Println("shutdown failed:", err)
-			}
+	if isScheduled(in.GetAt()) {
+		if err = s.checkNodeMaintenance("scheduled shutdown"); err != nil {
+			return nil, err
 		}
-	}()
+	}
+
+	scheduledAt, err := s.runPowerAction(ctx, newPowerActionRequest(machine.PowerActionEvent_SHUTDOWN, in.GetAt(), in.GetMessage(), in.GetForce(), actorID))
+	if err != nil {
+		return nil, err
+	}
 
 	reply = &machine.ShutdownResponse{
 		Messages: []*machine.Shutdown{
 			{
-				ActorId: actorID,
+				ActorId:     actorID,
+				ScheduledAt: scheduledAt,
 			},
 		},
 	}
@@ -473,6 +522,16 @@ func (s *Server) Upgrade(ctx context.Context, in *machine.UpgradeRequest) (*mach
 
 	log.Printf("upgrade request received: staged %v, force %v, reboot mode %v", in.GetStage(), in.GetForce(), in.GetRebootMode().String())
 
+	log.Printf("running pre-flight checks for %q", in.GetImage())
+
+	preflight, err := install.PreflightInstallerImage(ctx, s.Controller.Runtime().Config().Machine().Registries(), s.Controller.Runtime().Config().ImageVerification(), in.GetImage())
+	if err != nil {
+		return nil, preflightStatus(in.GetImage(), err)
+	}
+
+	// use the validated digest from now on, so that the registry can't swap the image
+	in.Image = preflight.Image
+
 	log.Printf("validating %q", in.GetImage())
 
 	if err := install.PullAndValidateInstallerImage(ctx, s.Controller.Runtime().Config().Machine().Registries(), in.GetImage()); err != nil {
@@ -681,6 +740,20 @@ func (s *Server) ServiceList(ctx context.Context, in *emptypb.Empty) (result *ma
 	return result, nil
 }
 
+// ServiceGraph implements the machine.MachineServer interface and returns the dependencies
+// and the conditions of the services.
+func (s *Server) ServiceGraph(ctx context.Context, in *emptypb.Empty) (*machine.ServiceGraphResponse, error) {
+	services := system.Services(s.Controller.Runtime()).List()
+
+	return &machine.ServiceGraphResponse{
+		Messages: []*machine.ServiceGraph{
+			{
+				Services: xslices.Map(services, (*system.ServiceRunner).AsGraphProto),
+			},
+		},
+	}, nil
+}
+
 // ServiceStart implements the machine.MachineServer interface and starts a
 // service running on Talos.
 func (s *Server) ServiceStart(ctx context.Context, in *machine.ServiceStartRequest) (reply *machine.ServiceStartResponse, err error) {
@@ -757,17 +830,21 @@ func (s *Server) Copy(req *machine.CopyRequest, obj machine.MachineService_CopyS
 		errCh <- archiver.TarGz(ctx, path, pw)
 	}()
 
-	chunker := stream.NewChunker(ctx, pr)
-	chunkCh := chunker.Read()
+	// the archive is produced as fast as the client consumes it
+	sendErr := stream.Send(ctx, pr, stream.DefaultSendSize, func(data []byte) error {
+		return obj.Send(&common.Data{Bytes: data})
+	})
 
-	for data := range chunkCh {
-		err := obj.SendMsg(&common.Data{Bytes: data})
-		if err != nil {
-			ctxCancel()
-		}
-	}
+	// unblock the archiver if the client went away
+	pr.CloseWithError(sendErr) //nolint:errcheck
+	ctxCancel()
 
 	archiveErr := <-errCh
+
+	if sendErr != nil {
+		return sendErr
+	}
+
 	if archiveErr != nil {
 		return obj.SendMsg(&common.Data{
 			Metadata: &common.Metadata{
@@ -1142,12 +1219,24 @@ func (s *Server) Version(ctx context.Context, in *emptypb.Empty) (reply *machine
 		}
 	}
 
+	var hostname string
+
+	hostnameStatus, err := safe.StateGetByID[*network.HostnameStatus](ctx, s.Controller.Runtime().State().V1Alpha2().Resources(), network.HostnameID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, err
+	}
+
+	if hostnameStatus != nil {
+		hostname = hostnameStatus.TypedSpec().FQDN()
+	}
+
 	return &machine.VersionResponse{
 		Messages: []*machine.Version{
 			{
 				Version:  version.NewVersion(),
 				Platform: platform,
 				Features: features,
+				Hostname: hostname,
 			},
 		},
 	}, nil
@@ -1315,20 +1404,10 @@ func (s *Server) Read(in *machine.ReadRequest, srv machine.MachineService_ReadSe
 
 		defer f.Close() //nolint:errcheck
 
-		ctx, cancel := context.WithCancel(srv.Context())
-		defer cancel()
-
-		chunker := stream.NewChunker(ctx, f)
-		chunkCh := chunker.Read()
-
-		for data := range chunkCh {
-			err := srv.SendMsg(&common.Data{Bytes: data})
-			if err != nil {
-				cancel()
-			}
-		}
-
-		return nil
+		// the file is read as fast as the client consumes it, so the memory usage is bounded by the chunk size
+		return stream.Send(srv.Context(), f, stream.DefaultSendSize, func(data []byte) error {
+			return srv.Send(&common.Data{Bytes: data})
+		})
 	default:
 		return errors.New("path must be a regular file")
 	}
@@ -1556,6 +1635,13 @@ func (s *Server) Dmesg(req *machine.DmesgRequest, srv machine.MachineService_Dme
 		options = append(options, kmsg.FromTail())
 	}
 
+	filter, err := kmsgfilter.NewFilter(req.Severity, req.Facilities)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limiter := kmsgfilter.NewRateLimiter(req.RateLimit)
+
 	reader, err := kmsg.NewReader(options...)
 	if err != nil {
 		return fmt.Errorf("error opening /dev/kmsg reader: %w", err)
@@ -1587,6 +1673,24 @@ func (s *Server) Dmesg(req *machine.DmesgRequest, srv machine.MachineService_Dme
 				})
 			} else {
 				msg := packet.Message
+
+				if !filter.Match(msg) {
+					continue
+				}
+
+				allowed, suppressed := limiter.Allow()
+				if !allowed {
+					continue
+				}
+
+				if suppressed > 0 {
+					if err = srv.Send(&common.Data{
+						Bytes: []byte(fmt.Sprintf("%d kernel messages suppressed by the rate limit\n", suppressed)),
+					}); err != nil {
+						return err
+					}
+				}
+
 				err = srv.Send(&common.Data{
 					Bytes: []byte(fmt.Sprintf("%s: %7s: [%s]: %s", msg.Facility, msg.Priority, msg.Timestamp.Format(time.RFC3339Nano), msg.Message)),
 				})
@@ -1599,6 +1703,40 @@ func (s *Server) Dmesg(req *machine.DmesgRequest, srv machine.MachineService_Dme
 	}
 }
 
+// BootLogs implements the machine.MachineServer interface.
+func (s *Server) BootLogs(ctx context.Context, req *machine.BootLogsRequest) (*machine.BootLogsResponse, error) {
+	boots := int(req.GetBoots())
+	if boots <= 0 {
+		boots = 1
+	}
+
+	currentBootID, err := bootlog.CurrentBootID()
+	if err != nil {
+		return nil, fmt.Errorf("error reading boot ID: %w", err)
+	}
+
+	logs, err := bootlog.Read(constants.BootLogPath, boots)
+	if err != nil {
+		return nil, fmt.Errorf("error reading boot logs: %w", err)
+	}
+
+	return &machine.BootLogsResponse{
+		Messages: []*machine.BootLogs{
+			{
+				Boots: xslices.Map(logs, func(boot bootlog.Boot) *machine.BootLog {
+					return &machine.BootLog{
+						Sequence:  int32(boot.Sequence),
+						BootId:    boot.BootID,
+						Timestamp: timestamppb.New(boot.Timestamp),
+						Current:   boot.BootID == currentBootID,
+						Log:       boot.Log,
+					}
+				}),
+			},
+		},
+	}, nil
+}
+
 // Processes implements the machine.MachineServer interface.
 func (s *Server) Processes(ctx context.Context, in *emptypb.Empty) (reply *machine.ProcessesResponse, err error) {
 	var processes []*machine.ProcessInfo
@@ -1608,7 +1746,13 @@ func (s *Server) Processes(ctx context.Context, in *emptypb.Empty) (reply *machi
 		return nil, err
 	}
 
+	defer procs.Close() //nolint:errcheck
+
 	for {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+
 		info, err := procs.Next()
 		if err != nil {
 			return nil, err
@@ -1625,6 +1769,7 @@ func (s *Server) Processes(ctx context.Context, in *emptypb.Empty) (reply *machi
 		Messages: []*machine.Process{
 			{
 				Processes: processes,
+				Vanished:  uint32(procs.Vanished()),
 			},
 		},
 	}
@@ -1632,6 +1777,69 @@ func (s *Server) Processes(ctx context.Context, in *emptypb.Empty) (reply *machi
 	return reply, nil
 }
 
+// defaultProcessesBatchSize is the default number of processes sent in a single ProcessesStream message.
+const defaultProcessesBatchSize = 256
+
+// ProcessesStream implements the machine.MachineServer interface.
+func (s *Server) ProcessesStream(in *machine.ProcessesStreamRequest, srv machine.MachineService_ProcessesStreamServer) error {
+	batchSize := int(in.BatchSize)
+	if batchSize == 0 {
+		batchSize = defaultProcessesBatchSize
+	}
+
+	procs, err := miniprocfs.NewProcesses()
+	if err != nil {
+		return err
+	}
+
+	defer procs.Close() //nolint:errcheck
+
+	var (
+		batch    []*machine.ProcessInfo
+		vanished int
+	)
+
+	send := func() error {
+		msg := &machine.Process{
+			Processes: batch,
+			Vanished:  uint32(procs.Vanished() - vanished),
+		}
+
+		batch, vanished = nil, procs.Vanished()
+
+		return srv.Send(msg)
+	}
+
+	for {
+		if err = srv.Context().Err(); err != nil {
+			return err
+		}
+
+		info, err := procs.Next()
+		if err != nil {
+			return err
+		}
+
+		if info == nil {
+			break
+		}
+
+		batch = append(batch, info)
+
+		if len(batch) == batchSize {
+			if err = send(); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(batch) > 0 || procs.Vanished() > vanished {
+		return send()
+	}
+
+	return nil
+}
+
 // Memory implements the machine.MachineServer interface.
 func (s *Server) Memory(ctx context.Context, in *emptypb.Empty) (reply *machine.MemoryResponse, err error) {
 	proc, err := procfs.NewDefaultFS()
@@ -1867,19 +2075,11 @@ func (s *Server) EtcdSnapshot(in *machine.EtcdSnapshotRequest, srv machine.Machi
 		return fmt.Errorf("failed reading etcd snapshot: %w", err)
 	}
 
-	chunker := stream.NewChunker(ctx, rd)
-	chunkCh := chunker.Read()
-
-	for data := range chunkCh {
-		err := srv.SendMsg(&common.Data{Bytes: data})
-		if err != nil {
-			cancel()
-
-			return err
-		}
-	}
+	defer rd.Close() //nolint:errcheck
 
-	return nil
+	return stream.Send(ctx, rd, stream.DefaultSendSize, func(data []byte) error {
+		return srv.Send(&common.Data{Bytes: data})
+	})
 }
 
 // EtcdRecover implements the machine.MachineServer interface.
@@ -2115,9 +2315,18 @@ func (s *Server) GenerateClientConfiguration(ctx context.Context, in *machine.Ge
 
 	roles, _ := role.Parse(in.Roles)
 
+	if slices.Contains(in.Nodes, "") {
+		return nil, status.Error(codes.InvalidArgument, "nodes should not be empty")
+	}
+
+	// the impersonator role allows to override the roles and bypass the node scope
+	if len(in.Nodes) > 0 && roles.Includes(role.Impersonator) {
+		return nil, status.Error(codes.InvalidArgument, "client certificate limited to nodes can't have the impersonator role")
+	}
+
 	secretsBundle := secrets.NewBundleFromConfig(secrets.NewFixedClock(time.Now()), s.Controller.Runtime().Config())
 
-	cert, err := secretsBundle.GenerateTalosAPIClientCertificateWithTTL(roles, crtTTL)
+	cert, err := secretsBundle.GenerateScopedTalosAPIClientCertificate(roles, crtTTL, in.Nodes)
 	if err != nil {
 		return nil, err
 	}
@@ -2129,6 +2338,7 @@ func (s *Server) GenerateClientConfiguration(ctx context.Context, in *machine.Ge
 	}
 
 	talosconfig := clientconfig.NewConfig(contextName, nil, secretsBundle.Certs.OS.Crt, cert)
+	talosconfig.Contexts[contextName].Nodes = in.Nodes
 
 	b, err := talosconfig.Bytes()
 	if err != nil {
@@ -2409,3 +2619,63 @@ func (s *Server) Netstat(ctx context.Context, req *machine.NetstatRequest) (*mac
 
 	return reply, err
 }
+
+// Neighbors implements the machine.MachineServer interface.
+func (s *Server) Neighbors(ctx context.Context, in *emptypb.Empty) (*machine.NeighborsResponse, error) {
+	conn, err := rtnetlink.Dial(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error dialing rtnetlink: %w", err)
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	neighs, err := conn.Neigh.List()
+	if err != nil {
+		return nil, fmt.Errorf("error listing neighbors: %w", err)
+	}
+
+	links, err := conn.Link.List()
+	if err != nil {
+		return nil, fmt.Errorf("error listing links: %w", err)
+	}
+
+	linkNames := make(map[uint32]string, len(links))
+
+	for _, link := range links {
+		if link.Attributes != nil {
+			linkNames[link.Index] = link.Attributes.Name
+		}
+	}
+
+	neighbors := make([]*machine.Neighbor, 0, len(neighs))
+
+	for _, neigh := range neighs {
+		if neigh.Attributes == nil || neigh.Attributes.Address == nil {
+			continue
+		}
+
+		neighbors = append(neighbors, &machine.Neighbor{
+			Ip:           neigh.Attributes.Address.String(),
+			HardwareAddr: neigh.Attributes.LLAddress.String(),
+			State:        machine.Neighbor_State(neigh.State),
+			Link:         linkNames[neigh.Index],
+			Router:       neigh.Flags&unix.NTF_ROUTER != 0,
+		})
+	}
+
+	slices.SortFunc(neighbors, func(a, b *machine.Neighbor) int {
+		if c := strings.Compare(a.Link, b.Link); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.Ip, b.Ip)
+	})
+
+	return &machine.NeighborsResponse{
+		Messages: []*machine.Neighbors{
+			{
+				Neighbors: neighbors,
+			},
+		},
+	}, nil
+}
@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/version"
+)
+
+// StandaloneServer implements the read-only inspection subset of the machine API
+// which doesn't depend on the Talos runtime, so that it can be served on any Linux host.
+//
+// It is used by machined in the standalone mode to develop and test talosctl features
+// without booting Talos.
+type StandaloneServer struct {
+	machine.UnimplementedMachineServiceServer
+
+	// server is used only for the methods which don't access the controller
+	server Server
+}
+
+// Register implements the factory.Registrator interface.
+func (s *StandaloneServer) Register(obj *grpc.Server) {
+	machine.RegisterMachineServiceServer(obj, s)
+}
+
+// Version implements the machine.MachineServer interface.
+func (s *StandaloneServer) Version(ctx context.Context, in *emptypb.Empty) (*machine.VersionResponse, error) {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+
+	return &machine.VersionResponse{
+		Messages: []*machine.Version{
+			{
+				Version:  version.NewVersion(),
+				Hostname: hostname,
+			},
+		},
+	}, nil
+}
+
+// Processes implements the machine.MachineServer interface.
+func (s *StandaloneServer) Processes(ctx context.Context, in *emptypb.Empty) (*machine.ProcessesResponse, error) {
+	return s.server.Processes(ctx, in)
+}
+
+// ProcessesStream implements the machine.MachineServer interface.
+func (s *StandaloneServer) ProcessesStream(in *machine.ProcessesStreamRequest, srv machine.MachineService_ProcessesStreamServer) error {
+	return s.server.ProcessesStream(in, srv)
+}
+
+// Mounts implements the machine.MachineServer interface.
+func (s *StandaloneServer) Mounts(ctx context.Context, in *emptypb.Empty) (*machine.MountsResponse, error) {
+	return s.server.Mounts(ctx, in)
+}
+
+// Memory implements the machine.MachineServer interface.
+func (s *StandaloneServer) Memory(ctx context.Context, in *emptypb.Empty) (*machine.MemoryResponse, error) {
+	return s.server.Memory(ctx, in)
+}
+
+// LoadAvg implements the machine.MachineServer interface.
+func (s *StandaloneServer) LoadAvg(ctx context.Context, in *emptypb.Empty) (*machine.LoadAvgResponse, error) {
+	return s.server.LoadAvg(ctx, in)
+}
+
+// SystemStat implements the machine.MachineServer interface.
+func (s *StandaloneServer) SystemStat(ctx context.Context, in *emptypb.Empty) (*machine.SystemStatResponse, error) {
+	return s.server.SystemStat(ctx, in)
+}
+
+// CPUInfo implements the machine.MachineServer interface.
+func (s *StandaloneServer) CPUInfo(ctx context.Context, in *emptypb.Empty) (*machine.CPUInfoResponse, error) {
+	return s.server.CPUInfo(ctx, in)
+}
+
+// NetworkDeviceStats implements the machine.MachineServer interface.
+func (s *StandaloneServer) NetworkDeviceStats(ctx context.Context, in *emptypb.Empty) (*machine.NetworkDeviceStatsResponse, error) {
+	return s.server.NetworkDeviceStats(ctx, in)
+}
+
+// DiskStats implements the machine.MachineServer interface.
+func (s *StandaloneServer) DiskStats(ctx context.Context, in *emptypb.Empty) (*machine.DiskStatsResponse, error) {
+	return s.server.DiskStats(ctx, in)
+}
@@ -0,0 +1,137 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os/exec"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/siderolabs/go-cmd/pkg/cmd/proc/reaper"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/talos/internal/pkg/ptrace"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+// Strace implements the machine.MachineServer interface.
+//
+// The tracing is done by the /sbin/strace helper process, as machined reaps all its children
+// and would steal the ptrace events.
+//
+//nolint:gocyclo
+func (s *Server) Strace(in *machine.StraceRequest, srv machine.MachineService_StraceServer) error {
+	if in.GetPid() <= 1 {
+		return status.Error(codes.InvalidArgument, "process ID should be greater than 1")
+	}
+
+	if _, err := ptrace.ParseSyscalls(in.GetSyscalls()); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	duration := defaultTraceDuration
+
+	if in.GetDuration() != nil {
+		duration = in.GetDuration().AsDuration()
+	}
+
+	if duration <= 0 || duration > maxTraceDuration {
+		return status.Errorf(codes.InvalidArgument, "tracing duration should be positive and at most %s", maxTraceDuration)
+	}
+
+	ctx, cancel := context.WithTimeout(srv.Context(), duration)
+	defer cancel()
+
+	args := []string{"-pid", strconv.Itoa(int(in.GetPid()))}
+
+	if len(in.GetSyscalls()) > 0 {
+		args = append(args, "-syscalls", strings.Join(in.GetSyscalls(), ","))
+	}
+
+	if in.GetMinDuration() != nil {
+		args = append(args, "-min-duration", in.GetMinDuration().AsDuration().String())
+	}
+
+	var stderr bytes.Buffer
+
+	cmd := exec.Command("/sbin/strace", args...)
+	cmd.Stderr = &stderr
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	notifyCh := make(chan reaper.ProcessInfo, 8)
+
+	usingReaper := reaper.Notify(notifyCh)
+	if usingReaper {
+		defer reaper.Stop(notifyCh)
+	}
+
+	if err = cmd.Start(); err != nil {
+		return fmt.Errorf("error starting tracer: %w", err)
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+			// the tracer detaches from the process on SIGTERM
+			cmd.Process.Signal(syscall.SIGTERM) //nolint:errcheck
+		case <-done:
+		}
+	}()
+
+	decoder := json.NewDecoder(stdout)
+
+	var sendErr error
+
+	for {
+		var event ptrace.Event
+
+		if err = decoder.Decode(&event); err != nil {
+			break
+		}
+
+		if sendErr = srv.Send(&machine.StraceEvent{
+			Timestamp: timestamppb.New(event.Timestamp),
+			Tid:       int32(event.TID),
+			Syscall:   event.Syscall,
+			Args:      event.Args,
+			Return:    event.Return,
+			Errno:     event.Errno,
+			Duration:  durationpb.New(event.Duration),
+		}); sendErr != nil {
+			cancel()
+
+			break
+		}
+	}
+
+	// drain the output, so that the tracer doesn't block on write
+	io.Copy(io.Discard, stdout) //nolint:errcheck
+
+	if err = reaper.WaitWrapper(usingReaper, notifyCh, cmd); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("error tracing: %s", msg)
+		}
+
+		return fmt.Errorf("error tracing: %w", err)
+	}
+
+	return sendErr
+}
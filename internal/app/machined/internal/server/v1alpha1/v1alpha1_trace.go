@@ -0,0 +1,74 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/talos/internal/pkg/tracing"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+const (
+	defaultTraceDuration = 30 * time.Second
+	maxTraceDuration     = 10 * time.Minute
+)
+
+var traceTools = map[machine.TraceRequest_Tool]tracing.Tool{
+	machine.TraceRequest_SYSCALL_LATENCY:  tracing.SyscallLatency,
+	machine.TraceRequest_TCP_RETRANSMIT:   tracing.TCPRetransmit,
+	machine.TraceRequest_BLOCK_IO_LATENCY: tracing.BlockIOLatency,
+	machine.TraceRequest_EXEC:             tracing.Exec,
+}
+
+// Trace implements the machine.MachineServer interface.
+func (s *Server) Trace(in *machine.TraceRequest, srv machine.MachineService_TraceServer) error {
+	tool, ok := traceTools[in.GetTool()]
+	if !ok {
+		return status.Errorf(codes.InvalidArgument, "unsupported tracing tool %s", in.GetTool())
+	}
+
+	duration := defaultTraceDuration
+
+	if in.GetDuration() != nil {
+		duration = in.GetDuration().AsDuration()
+	}
+
+	if duration <= 0 || duration > maxTraceDuration {
+		return status.Errorf(codes.InvalidArgument, "tracing duration should be positive and at most %s", maxTraceDuration)
+	}
+
+	ctx, cancel := context.WithTimeout(srv.Context(), duration)
+	defer cancel()
+
+	err := tracing.Run(ctx, tool, tracing.Options{MinLatency: in.GetMinLatency().AsDuration()}, func(event tracing.Event) error {
+		msg := &machine.TraceEvent{
+			Timestamp: timestamppb.New(event.Timestamp),
+			Pid:       event.PID,
+			Tid:       event.TID,
+			Comm:      event.Comm,
+			Details:   event.Details,
+			Lost:      event.Lost,
+		}
+
+		if event.Latency != 0 {
+			msg.Latency = durationpb.New(event.Latency)
+		}
+
+		return srv.Send(msg)
+	})
+	if err != nil {
+		return fmt.Errorf("error tracing: %w", err)
+	}
+
+	return nil
+}
@@ -26,7 +26,9 @@ import (
 	"golang.org/x/sys/unix"
 
 	"github.com/siderolabs/talos/internal/app/apid"
+	"github.com/siderolabs/talos/internal/app/coredump"
 	"github.com/siderolabs/talos/internal/app/dashboard"
+	"github.com/siderolabs/talos/internal/app/debugd"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/emergency"
 	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1"
@@ -34,6 +36,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system/services"
 	"github.com/siderolabs/talos/internal/app/maintenance"
 	"github.com/siderolabs/talos/internal/app/poweroff"
+	"github.com/siderolabs/talos/internal/app/strace"
 	"github.com/siderolabs/talos/internal/app/trustd"
 	"github.com/siderolabs/talos/internal/app/wrapperd"
 	"github.com/siderolabs/talos/internal/pkg/mount"
@@ -110,6 +113,7 @@ func handle(ctx context.Context, err error) {
 
 	if err != nil {
 		log.Print(err)
+		updateBootLog(ctx)
 		revertBootloader(ctx)
 
 		if p := procfs.ProcCmdline().Get(constants.KernelParamPanic).First(); p != nil {
@@ -181,6 +185,7 @@ func run() error {
 	}
 
 	revertSetState(c.Runtime().State().V1Alpha2().Resources())
+	bootLogSetRuntime(c.Runtime().Logging(), c.Runtime().State().V1Alpha2().Resources())
 
 	var controllerWaitGroup sync.WaitGroup
 	defer controllerWaitGroup.Wait() // wait for controller-runtime to finish before rebooting
@@ -258,6 +263,13 @@ func run() error {
 			&services.APID{},
 		)
 
+		if debug.Enabled {
+			// Start the debugger tunnel for the DebugAttach API.
+			system.Services(c.Runtime()).LoadAndStart(
+				&services.Debugd{},
+			)
+		}
+
 		// Boot the machine.
 		if err = c.Run(ctx, runtime.SequenceBoot, nil); err != nil && !errors.Is(err, context.Canceled) {
 			return err
@@ -321,10 +333,31 @@ func main() {
 	case "dashboard":
 		dashboard.Main()
 
+		return
+	case "strace":
+		strace.Main()
+
+		return
+	case "coredump":
+		coredump.Main(os.Args)
+
+		return
+	case "debugd":
+		debugd.Main()
+
 		return
 	default:
 	}
 
+	// not running as PID 1 (nor in a container), so that's a developer running machined on a generic Linux host
+	if os.Getpid() != 1 && len(os.Args) > 1 {
+		if err := standaloneMain(os.Args[1:]); err != nil {
+			log.Fatal(err)
+		}
+
+		return
+	}
+
 	// Setup panic handler.
 	defer recovery(ctx)
 
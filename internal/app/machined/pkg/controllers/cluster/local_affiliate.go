@@ -20,11 +20,13 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
 	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/kubespan"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/version"
 )
 
@@ -97,6 +99,12 @@ func (ctrl *LocalAffiliateController) Inputs() []controller.Input {
 			ID:        optional.Some(k8s.APIServerConfigID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.MetaKeyType,
+			ID:        optional.Some(runtime.MetaKeyTagToID(meta.NodeMaintenance)),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -212,6 +220,12 @@ func (ctrl *LocalAffiliateController) Run(ctx context.Context, r controller.Runt
 			return fmt.Errorf("error getting API server config: %w", err)
 		}
 
+		// optional resources (node maintenance)
+		nodeMaintenance, err := safe.ReaderGetByID[*runtime.MetaKey](ctx, r, runtime.MetaKeyTagToID(meta.NodeMaintenance))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting node maintenance flag: %w", err)
+		}
+
 		localID := identity.TypedSpec().NodeID
 
 		touchedIDs := map[resource.ID]struct{}{}
@@ -225,6 +239,7 @@ func (ctrl *LocalAffiliateController) Run(ctx context.Context, r controller.Runt
 				spec.Nodename = nodename.TypedSpec().Nodename
 				spec.MachineType = machineType.MachineType()
 				spec.OperatingSystem = fmt.Sprintf("%s (%s)", version.Name, version.Tag)
+				spec.NodeMaintenance = nodeMaintenance != nil
 
 				if machineType.MachineType().IsControlPlane() && apiServerConfig != nil {
 					spec.ControlPlane = &cluster.ControlPlane{
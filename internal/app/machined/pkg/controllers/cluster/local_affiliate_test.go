@@ -19,11 +19,13 @@ import (
 	clusterctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/cluster"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
 	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/kubespan"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/version"
 )
 
@@ -132,6 +134,16 @@ func (suite *LocalAffiliateSuite) TestGeneration() {
 
 	ctest.AssertResource(suite, nodeIdentity.TypedSpec().NodeID, func(r *cluster.Affiliate, asrt *assert.Assertions) {
 		asrt.Empty(r.TypedSpec().KubeSpan.AdditionalAddresses)
+		asrt.False(r.TypedSpec().NodeMaintenance)
+	})
+
+	// enter node maintenance
+	nodeMaintenance := runtime.NewMetaKey(runtime.NamespaceName, runtime.MetaKeyTagToID(meta.NodeMaintenance))
+	nodeMaintenance.TypedSpec().Value = "replacing disks"
+	suite.Require().NoError(suite.state.Create(suite.ctx, nodeMaintenance))
+
+	ctest.AssertResource(suite, nodeIdentity.TypedSpec().NodeID, func(r *cluster.Affiliate, asrt *assert.Assertions) {
+		asrt.True(r.TypedSpec().NodeMaintenance)
 	})
 
 	// disable discovery, local affiliate should be removed
@@ -81,6 +81,7 @@ func (ctrl *MemberController) Run(ctx context.Context, r controller.Runtime, _ *
 				spec.OperatingSystem = affiliateSpec.OperatingSystem
 				spec.NodeID = affiliateSpec.NodeID
 				spec.ControlPlane = affiliateSpec.ControlPlane
+				spec.NodeMaintenance = affiliateSpec.NodeMaintenance
 
 				return nil
 			}); err != nil {
@@ -30,6 +30,7 @@ import (
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/migrate"
 	"github.com/siderolabs/talos/pkg/machinery/config/validation"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	configresource "github.com/siderolabs/talos/pkg/machinery/resources/config"
@@ -236,6 +237,20 @@ func (validationModeDiskConfig) String() string {
 	return "diskConfig"
 }
 
+// migrateConfig upgrades the deprecated parts of the machine config, and logs the changes.
+func migrateConfig(logger *zap.Logger, cfg config.Provider) (config.Provider, bool, error) {
+	migrated, changes, err := migrate.Config(cfg)
+	if err != nil {
+		return nil, false, err
+	}
+
+	for _, change := range changes {
+		logger.Info("machine config migrated", zap.String("change", change))
+	}
+
+	return migrated, len(changes) > 0, nil
+}
+
 // loadFromDisk is a helper function for stateDisk.
 func (ctrl *AcquireController) loadFromDisk(logger *zap.Logger) (config.Provider, error) {
 	logger.Debug("loading config from STATE", zap.String("path", ctrl.ConfigPath))
@@ -255,6 +270,23 @@ func (ctrl *AcquireController) loadFromDisk(logger *zap.Logger) (config.Provider
 		return nil, fmt.Errorf("failed to load config from STATE: %w", err)
 	}
 
+	cfg, migrated, err := migrateConfig(logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate config from STATE: %w", err)
+	}
+
+	if migrated {
+		// persist the migrated config, so that the migration is done once
+		cfgBytes, err := cfg.Bytes()
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal migrated config: %w", err)
+		}
+
+		if err = os.WriteFile(ctrl.ConfigPath, cfgBytes, 0o600); err != nil {
+			return nil, fmt.Errorf("failed to save migrated config to STATE: %w", err)
+		}
+	}
+
 	// if the STATE partition is present & contains machine config, Talos is already installed
 	warnings, err := cfg.Validate(validationModeDiskConfig{})
 	if err != nil {
@@ -340,6 +372,11 @@ func (ctrl *AcquireController) loadFromPlatform(ctx context.Context, logger *zap
 		return nil, fmt.Errorf("failed to load config via platform %s: %w", platformName, err)
 	}
 
+	cfg, _, err = migrateConfig(logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate config acquired via platform %s: %w", platformName, err)
+	}
+
 	warnings, err := cfg.Validate(ctrl.ValidationMode)
 	if err != nil {
 		return nil, fmt.Errorf("failed to validate config acquired via platform %s: %w", platformName, err)
@@ -430,6 +467,11 @@ func (ctrl *AcquireController) loadFromCmdline(logger *zap.Logger) (config.Provi
 		return nil, fmt.Errorf("failed to load config via cmdline %s: %w", constants.KernelParamConfigInline, err)
 	}
 
+	cfg, _, err = migrateConfig(logger, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to migrate config acquired via cmdline %s: %w", constants.KernelParamConfigInline, err)
+	}
+
 	warnings, err := cfg.Validate(ctrl.ValidationMode)
 	if err != nil {
 		return nil, fmt.Errorf("failed to validate config acquired via cmdline %s: %w", constants.KernelParamConfigInline, err)
@@ -0,0 +1,171 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package files
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/userfiles"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// UserFilesController re-applies the machine config files with the create operation once the machine is running.
+//
+// The files are written on boot by the sequencer, the controller keeps them up to date
+// when the machine config is applied without a reboot or the node metadata used in the templates changes.
+type UserFilesController struct {
+	// RootPath is prepended to the file paths, used in tests.
+	RootPath string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *UserFilesController) Name() string {
+	return "files.UserFilesController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *UserFilesController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.MachineStatusType,
+			ID:        optional.Some(runtime.MachineStatusID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.HostnameStatusType,
+			ID:        optional.Some(network.HostnameID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.NodeAddressType,
+			ID:        optional.Some(network.NodeAddressDefaultID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.NodeAddressType,
+			ID:        optional.Some(network.NodeAddressRoutedID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *UserFilesController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *UserFilesController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		machineStatus, err := safe.ReaderGetByID[*runtime.MachineStatus](ctx, r, runtime.MachineStatusID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine status: %w", err)
+		}
+
+		// before the machine is running, the files are written by the boot sequence
+		if machineStatus == nil || machineStatus.TypedSpec().Stage != runtime.MachineStageRunning {
+			continue
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		if cfg.Config().Machine() == nil {
+			continue
+		}
+
+		files, err := cfg.Config().Machine().Files()
+		if err != nil {
+			return fmt.Errorf("error getting machine files: %w", err)
+		}
+
+		hostnameStatus, err := safe.ReaderGetByID[*network.HostnameStatus](ctx, r, network.HostnameID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting hostname status: %w", err)
+		}
+
+		defaultAddress, err := safe.ReaderGetByID[*network.NodeAddress](ctx, r, network.NodeAddressDefaultID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting default node address: %w", err)
+		}
+
+		routedAddresses, err := safe.ReaderGetByID[*network.NodeAddress](ctx, r, network.NodeAddressRoutedID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting routed node addresses: %w", err)
+		}
+
+		templateData := userfiles.NewTemplateData(cfg.Config().Machine().Type(), hostnameStatus, defaultAddress, routedAddresses)
+
+		var errs error
+
+		for _, f := range files {
+			// other operations are not idempotent, so they are applied only on boot
+			if f.Op() != "create" || !userfiles.CanCreate(f.Path()) {
+				continue
+			}
+
+			path := filepath.Join(ctrl.RootPath, f.Path())
+
+			content, err := userfiles.Content(ctx, path, f, templateData)
+			if err != nil {
+				errs = errors.Join(errs, fmt.Errorf("error getting contents of file %q: %w", f.Path(), err))
+
+				continue
+			}
+
+			changed, err := userfiles.Write(path, f, content)
+			if err != nil {
+				errs = errors.Join(errs, fmt.Errorf("error writing file %q: %w", f.Path(), err))
+
+				continue
+			}
+
+			if changed {
+				logger.Info("updated machine file", zap.String("path", f.Path()))
+			}
+		}
+
+		if errs != nil {
+			return errs
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
@@ -0,0 +1,131 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package files_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	filesctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/files"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type UserFilesSuite struct {
+	ctest.DefaultSuite
+
+	rootPath string
+}
+
+func TestUserFilesSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &UserFilesSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.rootPath = suite.T().TempDir()
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&filesctrl.UserFilesController{
+				RootPath: s.rootPath,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *UserFilesSuite) assertFile(path, expected string) {
+	suite.Assert().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			contents, err := os.ReadFile(filepath.Join(suite.rootPath, path))
+			if err != nil {
+				return retry.ExpectedError(err)
+			}
+
+			if string(contents) != expected {
+				return retry.ExpectedErrorf("file %q: expected %q, actual %q", path, expected, string(contents))
+			}
+
+			return nil
+		},
+	))
+}
+
+func (suite *UserFilesSuite) TestReconcile() {
+	ctr, err := container.New(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineType: "worker",
+			MachineFiles: []*v1alpha1.MachineFile{
+				{
+					FileContent:     "node: {{ .Hostname }}",
+					FilePath:        "/var/lib/agent/config.yaml",
+					FilePermissions: 0o644,
+					FileOp:          "create",
+					FileUID:         os.Getuid(),
+					FileGID:         os.Getgid(),
+					FileTemplate:    true,
+				},
+				{
+					FileContent:     "{}",
+					FilePath:        "/etc/cni/net.d/10-custom.conflist",
+					FilePermissions: 0o644,
+					FileOp:          "create",
+					FileUID:         os.Getuid(),
+					FileGID:         os.Getgid(),
+				},
+				{
+					FileContent:     "skipped",
+					FilePath:        "/var/lib/agent/extra",
+					FilePermissions: 0o644,
+					FileOp:          "append",
+				},
+			},
+		},
+	})
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(ctr)))
+
+	hostnameStatus := network.NewHostnameStatus(network.NamespaceName, network.HostnameID)
+	hostnameStatus.TypedSpec().Hostname = "node-1"
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), hostnameStatus))
+
+	// files are not touched until the machine is running
+	machineStatus := runtime.NewMachineStatus()
+	machineStatus.TypedSpec().Stage = runtime.MachineStageBooting
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineStatus))
+
+	time.Sleep(100 * time.Millisecond)
+
+	_, err = os.Stat(filepath.Join(suite.rootPath, "/var/lib/agent/config.yaml"))
+	suite.Require().ErrorIs(err, os.ErrNotExist)
+
+	machineStatus.TypedSpec().Stage = runtime.MachineStageRunning
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), machineStatus))
+
+	suite.assertFile("/var/lib/agent/config.yaml", "node: node-1")
+	suite.assertFile("/etc/cni/net.d/10-custom.conflist", "{}")
+
+	_, err = os.Stat(filepath.Join(suite.rootPath, "/var/lib/agent/extra"))
+	suite.Require().ErrorIs(err, os.ErrNotExist)
+
+	// templates are re-rendered when the node metadata changes
+	hostnameStatus.TypedSpec().Hostname = "node-2"
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), hostnameStatus))
+
+	suite.assertFile("/var/lib/agent/config.yaml", "node: node-2")
+}
@@ -124,18 +124,19 @@ func NewControlPlaneAPIServerController() *ControlPlaneAPIServerController {
 				}
 
 				*res.TypedSpec() = k8s.APIServerConfigSpec{
-					Image:                    cfgProvider.Cluster().APIServer().Image(),
-					CloudProvider:            cloudProvider,
-					ControlPlaneEndpoint:     cfgProvider.Cluster().Endpoint().String(),
-					EtcdServers:              []string{fmt.Sprintf("https://%s", nethelpers.JoinHostPort("localhost", constants.EtcdClientPort))},
-					LocalPort:                cfgProvider.Cluster().LocalAPIServerPort(),
-					ServiceCIDRs:             cfgProvider.Cluster().Network().ServiceCIDRs(),
-					ExtraArgs:                cfgProvider.Cluster().APIServer().ExtraArgs(),
-					ExtraVolumes:             convertVolumes(cfgProvider.Cluster().APIServer().ExtraVolumes()),
-					EnvironmentVariables:     cfgProvider.Cluster().APIServer().Env(),
-					PodSecurityPolicyEnabled: !cfgProvider.Cluster().APIServer().DisablePodSecurityPolicy(),
-					AdvertisedAddress:        advertisedAddress,
-					Resources:                convertResources(cfgProvider.Cluster().APIServer().Resources()),
+					Image:                     cfgProvider.Cluster().APIServer().Image(),
+					CloudProvider:             cloudProvider,
+					ControlPlaneEndpoint:      cfgProvider.Cluster().Endpoint().String(),
+					EtcdServers:               []string{fmt.Sprintf("https://%s", nethelpers.JoinHostPort("localhost", constants.EtcdClientPort))},
+					LocalPort:                 cfgProvider.Cluster().LocalAPIServerPort(),
+					ServiceCIDRs:              cfgProvider.Cluster().Network().ServiceCIDRs(),
+					ExtraArgs:                 cfgProvider.Cluster().APIServer().ExtraArgs(),
+					ExtraVolumes:              convertVolumes(cfgProvider.Cluster().APIServer().ExtraVolumes()),
+					EnvironmentVariables:      cfgProvider.Cluster().APIServer().Env(),
+					PodSecurityPolicyEnabled:  !cfgProvider.Cluster().APIServer().DisablePodSecurityPolicy(),
+					AdvertisedAddress:         advertisedAddress,
+					Resources:                 convertResources(cfgProvider.Cluster().APIServer().Resources()),
+					VerifyKubeletCertificates: cfgProvider.Cluster().KubeletServingCertificateRotation(),
 				}
 
 				return nil
@@ -279,6 +280,7 @@ func NewControlPlaneBootstrapManifestsController() *ControlPlaneBootstrapManifes
 					FlannelExtraArgs:       cfgProvider.Cluster().Network().CNI().Flannel().ExtraArgs(),
 					FlannelKubeServiceHost: flannelKubeServiceHost,
 					FlannelKubeServicePort: flannelKubeServicePort,
+					FlannelMTU:             cfgProvider.Cluster().Network().CNI().Flannel().MTU(),
 
 					PodSecurityPolicyEnabled: !cfgProvider.Cluster().APIServer().DisablePodSecurityPolicy(),
 
@@ -431,6 +431,13 @@ func (ctrl *ControlPlaneStaticPodController) manageAPIServer(ctx context.Context
 		"tls-private-key-file":             argsbuilder.MergeDenied,
 	}
 
+	if cfg.VerifyKubeletCertificates {
+		// kubelet serving certificates are signed by the Kubernetes CA
+		builder.Set("kubelet-certificate-authority", filepath.Join(constants.KubernetesAPIServerSecretsDir, "ca.crt"))
+
+		mergePolicies["kubelet-certificate-authority"] = argsbuilder.MergeDenied
+	}
+
 	if err := builder.Merge(cfg.ExtraArgs, argsbuilder.WithMergePolicies(mergePolicies)); err != nil {
 		return "", err
 	}
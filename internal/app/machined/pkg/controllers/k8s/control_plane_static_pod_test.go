@@ -574,6 +574,49 @@ func (suite *ControlPlaneStaticPodSuite) TestReconcileAdvertisedAddressArg() {
 	suite.Require().NoError(suite.state.Destroy(suite.ctx, configAPIServer.Metadata()))
 }
 
+func (suite *ControlPlaneStaticPodSuite) TestReconcileVerifyKubeletCertificates() {
+	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
+	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, configStatus))
+	suite.Require().NoError(suite.state.Create(suite.ctx, secretStatus))
+
+	configAPIServer := k8s.NewAPIServerConfig()
+
+	*configAPIServer.TypedSpec() = k8s.APIServerConfigSpec{
+		VerifyKubeletCertificates: true,
+	}
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, configAPIServer))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertControlPlaneStaticPods(
+					[]string{
+						"kube-apiserver",
+					},
+				)
+			},
+		),
+	)
+
+	r, err := suite.state.Get(
+		suite.ctx,
+		resource.NewMetadata(k8s.NamespaceName, k8s.StaticPodType, "kube-apiserver", resource.VersionUndefined),
+	)
+	suite.Require().NoError(err)
+
+	apiServerPod, err := k8sadapter.StaticPod(r.(*k8s.StaticPod)).Pod()
+	suite.Require().NoError(err)
+
+	suite.Require().NotEmpty(apiServerPod.Spec.Containers)
+
+	suite.Assert().Contains(apiServerPod.Spec.Containers[0].Command, "--kubelet-certificate-authority=/system/secrets/kubernetes/kube-apiserver/ca.crt")
+
+	suite.Require().NoError(suite.state.Destroy(suite.ctx, configAPIServer.Metadata()))
+}
+
 func (suite *ControlPlaneStaticPodSuite) TestControlPlaneStaticPodsExceptScheduler() {
 	configStatus := k8s.NewConfigStatus(k8s.ControlPlaneNamespaceName, k8s.ConfigStatusStaticPodID)
 	secretStatus := k8s.NewSecretsStatus(k8s.ControlPlaneNamespaceName, k8s.StaticPodSecretsStaticPodID)
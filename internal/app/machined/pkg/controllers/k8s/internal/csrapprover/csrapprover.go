@@ -0,0 +1,142 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package csrapprover validates kubelet serving certificate signing requests.
+package csrapprover
+
+import (
+	stdx509 "crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strings"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s/internal/nodename"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+)
+
+const (
+	nodeUserPrefix = "system:node:"
+	nodesGroup     = "system:nodes"
+)
+
+// IsPending returns true if the CSR is neither approved, denied nor failed.
+func IsPending(csr *certificatesv1.CertificateSigningRequest) bool {
+	for _, condition := range csr.Status.Conditions {
+		switch condition.Type { //nolint:exhaustive
+		case certificatesv1.CertificateApproved, certificatesv1.CertificateDenied, certificatesv1.CertificateFailed:
+			return false
+		}
+	}
+
+	return true
+}
+
+// Validate checks that the kubelet serving CSR is submitted by the node it is requested for,
+// and that it contains only the node name and the node addresses.
+//
+// The node is looked up in the cluster members known from the discovery service.
+//
+//nolint:gocyclo,cyclop
+func Validate(csr *certificatesv1.CertificateSigningRequest, members []*cluster.MemberSpec) error {
+	if csr.Spec.SignerName != certificatesv1.KubeletServingSignerName {
+		return fmt.Errorf("unexpected signer name %q", csr.Spec.SignerName)
+	}
+
+	nodeName, ok := strings.CutPrefix(csr.Spec.Username, nodeUserPrefix)
+	if !ok || nodeName == "" {
+		return fmt.Errorf("CSR is not submitted by a node: %q", csr.Spec.Username)
+	}
+
+	if !slices.Contains(csr.Spec.Groups, nodesGroup) {
+		return fmt.Errorf("CSR submitter is not in the %q group", nodesGroup)
+	}
+
+	for _, usage := range csr.Spec.Usages {
+		switch usage { //nolint:exhaustive
+		case certificatesv1.UsageDigitalSignature, certificatesv1.UsageKeyEncipherment, certificatesv1.UsageServerAuth:
+		default:
+			return fmt.Errorf("unexpected key usage %q", usage)
+		}
+	}
+
+	if !slices.Contains(csr.Spec.Usages, certificatesv1.UsageServerAuth) {
+		return errors.New("server auth key usage is missing")
+	}
+
+	block, _ := pem.Decode(csr.Spec.Request)
+	if block == nil || block.Type != "CERTIFICATE REQUEST" {
+		return errors.New("failed to decode the certificate request")
+	}
+
+	request, err := stdx509.ParseCertificateRequest(block.Bytes)
+	if err != nil {
+		return fmt.Errorf("failed to parse the certificate request: %w", err)
+	}
+
+	if request.Subject.CommonName != csr.Spec.Username {
+		return fmt.Errorf("common name %q doesn't match the CSR submitter %q", request.Subject.CommonName, csr.Spec.Username)
+	}
+
+	if !slices.Equal(request.Subject.Organization, []string{nodesGroup}) {
+		return fmt.Errorf("organization %q is not %q", request.Subject.Organization, nodesGroup)
+	}
+
+	if len(request.EmailAddresses) > 0 || len(request.URIs) > 0 {
+		return errors.New("email and URI SANs are not allowed")
+	}
+
+	if len(request.DNSNames) == 0 && len(request.IPAddresses) == 0 {
+		return errors.New("CSR has no DNS or IP SANs")
+	}
+
+	member := findMember(nodeName, members)
+	if member == nil {
+		return fmt.Errorf("node %q is not a known cluster member", nodeName)
+	}
+
+	allowedDNSNames := []string{nodeName, member.Hostname}
+
+	if shortHostname, _, ok := strings.Cut(member.Hostname, "."); ok {
+		allowedDNSNames = append(allowedDNSNames, shortHostname)
+	}
+
+	for _, dnsName := range request.DNSNames {
+		if !slices.ContainsFunc(allowedDNSNames, func(name string) bool { return strings.EqualFold(name, dnsName) }) {
+			return fmt.Errorf("DNS name %q doesn't belong to node %q", dnsName, nodeName)
+		}
+	}
+
+	for _, ip := range request.IPAddresses {
+		addr, ok := netip.AddrFromSlice(ip)
+		if !ok || !slices.Contains(member.Addresses, addr.Unmap()) {
+			return fmt.Errorf("IP address %q doesn't belong to node %q", ip, nodeName)
+		}
+	}
+
+	return nil
+}
+
+// findMember returns the member which has the node name derived from its hostname.
+func findMember(nodeName string, members []*cluster.MemberSpec) *cluster.MemberSpec {
+	for _, member := range members {
+		candidates := []string{member.Hostname}
+
+		if shortHostname, _, ok := strings.Cut(member.Hostname, "."); ok {
+			candidates = append(candidates, shortHostname)
+		}
+
+		for _, candidate := range candidates {
+			if name, err := nodename.FromHostname(candidate); err == nil && name == nodeName {
+				return member
+			}
+		}
+	}
+
+	return nil
+}
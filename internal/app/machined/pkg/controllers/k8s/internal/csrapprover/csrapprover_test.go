@@ -0,0 +1,184 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package csrapprover_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	stdx509 "crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	certificatesv1 "k8s.io/api/certificates/v1"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s/internal/csrapprover"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+)
+
+func buildCSR(t *testing.T, username string, template *stdx509.CertificateRequest) *certificatesv1.CertificateSigningRequest {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := stdx509.CreateCertificateRequest(rand.Reader, template, key)
+	require.NoError(t, err)
+
+	return &certificatesv1.CertificateSigningRequest{
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Request:    pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: der}),
+			SignerName: certificatesv1.KubeletServingSignerName,
+			Username:   username,
+			Groups:     []string{"system:nodes", "system:authenticated"},
+			Usages: []certificatesv1.KeyUsage{
+				certificatesv1.UsageDigitalSignature,
+				certificatesv1.UsageKeyEncipherment,
+				certificatesv1.UsageServerAuth,
+			},
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	members := []*cluster.MemberSpec{
+		{
+			Hostname:  "worker-1.example.com",
+			Addresses: []netip.Addr{netip.MustParseAddr("10.5.0.3"), netip.MustParseAddr("fd00::3")},
+		},
+		{
+			Hostname:  "controlplane-1",
+			Addresses: []netip.Addr{netip.MustParseAddr("10.5.0.2")},
+		},
+	}
+
+	subject := func(nodeName string) pkix.Name {
+		return pkix.Name{CommonName: "system:node:" + nodeName, Organization: []string{"system:nodes"}}
+	}
+
+	for _, test := range []struct {
+		name string
+
+		username string
+		template *stdx509.CertificateRequest
+		modify   func(*certificatesv1.CertificateSigningRequest)
+
+		expectedError string
+	}{
+		{
+			name:     "valid short name",
+			username: "system:node:worker-1",
+			template: &stdx509.CertificateRequest{
+				Subject:     subject("worker-1"),
+				DNSNames:    []string{"worker-1"},
+				IPAddresses: []net.IP{net.ParseIP("10.5.0.3"), net.ParseIP("fd00::3")},
+			},
+		},
+		{
+			name:     "valid FQDN",
+			username: "system:node:worker-1.example.com",
+			template: &stdx509.CertificateRequest{
+				Subject:     subject("worker-1.example.com"),
+				DNSNames:    []string{"worker-1.example.com"},
+				IPAddresses: []net.IP{net.ParseIP("10.5.0.3")},
+			},
+		},
+		{
+			name:     "not a node",
+			username: "admin",
+			template: &stdx509.CertificateRequest{
+				Subject:  subject("worker-1"),
+				DNSNames: []string{"worker-1"},
+			},
+			expectedError: "CSR is not submitted by a node: \"admin\"",
+		},
+		{
+			name:     "common name mismatch",
+			username: "system:node:worker-1",
+			template: &stdx509.CertificateRequest{
+				Subject:  subject("controlplane-1"),
+				DNSNames: []string{"worker-1"},
+			},
+			expectedError: "common name \"system:node:controlplane-1\" doesn't match the CSR submitter \"system:node:worker-1\"",
+		},
+		{
+			name:     "client auth usage",
+			username: "system:node:worker-1",
+			template: &stdx509.CertificateRequest{
+				Subject:  subject("worker-1"),
+				DNSNames: []string{"worker-1"},
+			},
+			modify: func(csr *certificatesv1.CertificateSigningRequest) {
+				csr.Spec.Usages = append(csr.Spec.Usages, certificatesv1.UsageClientAuth)
+			},
+			expectedError: "unexpected key usage \"client auth\"",
+		},
+		{
+			name:     "unknown node",
+			username: "system:node:worker-2",
+			template: &stdx509.CertificateRequest{
+				Subject:  subject("worker-2"),
+				DNSNames: []string{"worker-2"},
+			},
+			expectedError: "node \"worker-2\" is not a known cluster member",
+		},
+		{
+			name:     "foreign DNS name",
+			username: "system:node:worker-1",
+			template: &stdx509.CertificateRequest{
+				Subject:  subject("worker-1"),
+				DNSNames: []string{"worker-1", "kubernetes.default"},
+			},
+			expectedError: "DNS name \"kubernetes.default\" doesn't belong to node \"worker-1\"",
+		},
+		{
+			name:     "foreign IP address",
+			username: "system:node:worker-1",
+			template: &stdx509.CertificateRequest{
+				Subject:     subject("worker-1"),
+				IPAddresses: []net.IP{net.ParseIP("10.5.0.2")},
+			},
+			expectedError: "IP address \"10.5.0.2\" doesn't belong to node \"worker-1\"",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			csr := buildCSR(t, test.username, test.template)
+
+			if test.modify != nil {
+				test.modify(csr)
+			}
+
+			err := csrapprover.Validate(csr, members)
+
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestIsPending(t *testing.T) {
+	t.Parallel()
+
+	csr := &certificatesv1.CertificateSigningRequest{}
+
+	require.True(t, csrapprover.IsPending(csr))
+
+	csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+		Type: certificatesv1.CertificateApproved,
+	})
+
+	require.False(t, csrapprover.IsPending(csr))
+}
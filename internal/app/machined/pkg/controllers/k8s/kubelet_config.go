@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net/netip"
+	"strconv"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/controller/generic/transform"
@@ -78,6 +79,20 @@ func NewKubeletConfigController() *KubeletConfigController {
 				kubeletConfig.DisableManifestsDirectory = cfgProvider.Machine().Kubelet().DisableManifestsDirectory()
 				kubeletConfig.EnableFSQuotaMonitoring = cfgProvider.Machine().Features().DiskQuotaSupportEnabled()
 				kubeletConfig.CredentialProviderConfig = cfgProvider.Machine().Kubelet().CredentialProviderConfig()
+				kubeletConfig.SystemReserved = nil
+				kubeletConfig.ServerTLSBootstrap = cfgProvider.Cluster().KubeletServingCertificateRotation()
+
+				if systemResources := cfgProvider.SystemResources(); systemResources != nil {
+					kubeletConfig.SystemReserved = map[string]string{}
+
+					if milliCPU := systemResources.ReservedMilliCPU(); milliCPU > 0 {
+						kubeletConfig.SystemReserved["cpu"] = strconv.FormatInt(milliCPU, 10) + "m"
+					}
+
+					if memory := systemResources.ReservedMemory(); memory > 0 {
+						kubeletConfig.SystemReserved["memory"] = strconv.FormatUint(memory, 10)
+					}
+				}
 
 				return nil
 			},
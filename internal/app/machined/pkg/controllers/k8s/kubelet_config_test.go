@@ -25,6 +25,7 @@ import (
 
 	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
@@ -249,6 +250,74 @@ func (suite *KubeletConfigSuite) TestReconcileDefaults() {
 	)
 }
 
+func (suite *KubeletConfigSuite) TestReconcileSystemResources() {
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	suite.createStaticPodServerStatus()
+
+	systemResources := runtimecfg.NewSystemResourcesV1Alpha1()
+	systemResources.SystemReservedCPU = "1.5"
+	systemResources.SystemReservedMemory = "1GiB"
+
+	ctr, err := container.New(
+		&v1alpha1.Config{
+			ConfigVersion: "v1alpha1",
+			MachineConfig: &v1alpha1.MachineConfig{
+				MachineKubelet: &v1alpha1.KubeletConfig{
+					KubeletImage: "kubelet",
+				},
+			},
+			ClusterConfig: &v1alpha1.ClusterConfig{
+				ControlPlane: &v1alpha1.ControlPlaneConfig{
+					Endpoint: &v1alpha1.Endpoint{
+						URL: u,
+					},
+				},
+				ClusterNetwork: &v1alpha1.ClusterNetworkConfig{
+					ServiceSubnet: []string{constants.DefaultIPv4ServiceNet},
+				},
+			},
+		},
+		systemResources,
+	)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, config.NewMachineConfig(ctr)))
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				kubeletConfig, err := suite.state.Get(
+					suite.ctx,
+					resource.NewMetadata(
+						k8s.NamespaceName,
+						k8s.KubeletConfigType,
+						k8s.KubeletID,
+						resource.VersionUndefined,
+					),
+				)
+				if err != nil {
+					if state.IsNotFoundError(err) {
+						return retry.ExpectedError(err)
+					}
+
+					return err
+				}
+
+				spec := kubeletConfig.(*k8s.KubeletConfig).TypedSpec()
+
+				suite.Assert().Equal(map[string]string{
+					"cpu":    "1500m",
+					"memory": "1073741824",
+				}, spec.SystemReserved)
+
+				return nil
+			},
+		),
+	)
+}
+
 func (suite *KubeletConfigSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
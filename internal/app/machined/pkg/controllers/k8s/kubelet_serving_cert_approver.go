@@ -0,0 +1,215 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s/internal/csrapprover"
+	"github.com/siderolabs/talos/pkg/kubernetes"
+	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// KubeletServingCertApproverController approves kubelet serving certificate signing requests.
+//
+// The controller runs on control plane nodes when the kubelet serving certificate rotation is enabled.
+// Requests are approved only if they contain the node name and the node addresses known from the cluster discovery,
+// other requests are left pending.
+type KubeletServingCertApproverController struct {
+	// PollInterval defaults to 30 seconds.
+	PollInterval time.Duration
+
+	rejected map[string]struct{}
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *KubeletServingCertApproverController) Name() string {
+	return "k8s.KubeletServingCertApproverController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *KubeletServingCertApproverController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.KubernetesType,
+			ID:        optional.Some(secrets.KubernetesID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: cluster.NamespaceName,
+			Type:      cluster.MemberType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *KubeletServingCertApproverController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *KubeletServingCertApproverController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	pollInterval := ctrl.PollInterval
+	if pollInterval == 0 {
+		pollInterval = 30 * time.Second
+	}
+
+	ticker := time.NewTicker(pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		if cfg.Config().Machine() == nil || cfg.Config().Cluster() == nil {
+			continue
+		}
+
+		if !cfg.Config().Machine().Type().IsControlPlane() || !cfg.Config().Cluster().KubeletServingCertificateRotation() {
+			continue
+		}
+
+		k8sSecrets, err := safe.ReaderGetByID[*secrets.Kubernetes](ctx, r, secrets.KubernetesID)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting kubernetes secrets: %w", err)
+		}
+
+		members, err := safe.ReaderListAll[*cluster.Member](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing cluster members: %w", err)
+		}
+
+		memberSpecs := safe.ToSlice(members, func(m *cluster.Member) *cluster.MemberSpec { return m.TypedSpec() })
+
+		// Kubernetes API might not be available yet (or the CSR might be approved by another control plane node), so don't fail the controller
+		if err = ctrl.approvePending(ctx, logger, k8sSecrets.TypedSpec().LocalhostAdminKubeconfig, memberSpecs); err != nil {
+			logger.Debug("error approving kubelet serving CSRs", zap.Error(err))
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *KubeletServingCertApproverController) approvePending(ctx context.Context, logger *zap.Logger, kubeconfig string, members []*cluster.MemberSpec) error {
+	restConfig, err := clientcmd.BuildConfigFromKubeconfigGetter("", func() (*clientcmdapi.Config, error) {
+		return clientcmd.Load([]byte(kubeconfig))
+	})
+	if err != nil {
+		return fmt.Errorf("error loading kubeconfig: %w", err)
+	}
+
+	client, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("error building Kubernetes client: %w", err)
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	csrs, err := client.CertificatesV1().CertificateSigningRequests().List(ctx, metav1.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector("spec.signerName", certificatesv1.KubeletServingSignerName).String(),
+	})
+	if err != nil {
+		return fmt.Errorf("error listing CSRs: %w", err)
+	}
+
+	if ctrl.rejected == nil {
+		ctrl.rejected = map[string]struct{}{}
+	}
+
+	seen := map[string]struct{}{}
+
+	for i := range csrs.Items {
+		csr := &csrs.Items[i]
+
+		if !csrapprover.IsPending(csr) {
+			continue
+		}
+
+		seen[csr.Name] = struct{}{}
+
+		if err = csrapprover.Validate(csr, members); err != nil {
+			// log each rejected CSR only once
+			if _, logged := ctrl.rejected[csr.Name]; !logged {
+				logger.Warn("kubelet serving CSR is not approved", zap.String("csr", csr.Name), zap.String("username", csr.Spec.Username), zap.Error(err))
+
+				ctrl.rejected[csr.Name] = struct{}{}
+			}
+
+			continue
+		}
+
+		csr.Status.Conditions = append(csr.Status.Conditions, certificatesv1.CertificateSigningRequestCondition{
+			Type:           certificatesv1.CertificateApproved,
+			Status:         corev1.ConditionTrue,
+			Reason:         "TalosApproved",
+			Message:        "kubelet serving certificate request matches the cluster member",
+			LastUpdateTime: metav1.Now(),
+		})
+
+		if _, err = client.CertificatesV1().CertificateSigningRequests().UpdateApproval(ctx, csr.Name, csr, metav1.UpdateOptions{}); err != nil {
+			if apierrors.IsConflict(err) || apierrors.IsNotFound(err) {
+				// CSR was updated by another control plane node
+				continue
+			}
+
+			return fmt.Errorf("error approving CSR %q: %w", csr.Name, err)
+		}
+
+		logger.Info("approved kubelet serving CSR", zap.String("csr", csr.Name), zap.String("username", csr.Spec.Username))
+	}
+
+	// forget about the CSRs which are no longer pending
+	for name := range ctrl.rejected {
+		if _, ok := seen[name]; !ok {
+			delete(ctrl.rejected, name)
+		}
+	}
+
+	return nil
+}
@@ -7,6 +7,7 @@ package k8s
 import (
 	"context"
 	"fmt"
+	"maps"
 	"net/netip"
 	"strings"
 	"time"
@@ -307,6 +308,10 @@ func NewKubeletConfiguration(cfgSpec *k8s.KubeletConfigSpec, kubeletVersion comp
 		config.SeccompDefault = pointer.To(true)
 	}
 
+	if cfgSpec.ServerTLSBootstrap {
+		config.ServerTLSBootstrap = true
+	}
+
 	if cfgSpec.EnableFSQuotaMonitoring {
 		if _, overridden := config.FeatureGates["LocalStorageCapacityIsolationFSQuotaMonitoring"]; !overridden {
 			if config.FeatureGates == nil {
@@ -359,6 +364,9 @@ func NewKubeletConfiguration(cfgSpec *k8s.KubeletConfigSpec, kubeletVersion comp
 		} else {
 			config.SystemReserved["memory"] = constants.KubeletSystemReservedMemoryWorker
 		}
+
+		// align with the resources reserved for the system services in the machine config
+		maps.Copy(config.SystemReserved, cfgSpec.SystemReserved)
 	}
 
 	if config.Logging.Format == "" {
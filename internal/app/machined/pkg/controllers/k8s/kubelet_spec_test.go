@@ -414,6 +414,19 @@ func TestNewKubeletConfigurationMerge(t *testing.T) {
 			},
 			machineType: machine.TypeWorker,
 		},
+		{
+			name: "server TLS bootstrap",
+			cfgSpec: &k8s.KubeletConfigSpec{
+				ClusterDNS:         []string{"10.0.0.5"},
+				ClusterDomain:      "cluster.local",
+				ServerTLSBootstrap: true,
+			},
+			kubeletVersion: compatibility.VersionFromImageRef("ghcr.io/siderolabs/kubelet:v1.29.0"),
+			expectedOverrides: func(kc *kubeletconfig.KubeletConfiguration) {
+				kc.ServerTLSBootstrap = true
+			},
+			machineType: machine.TypeWorker,
+		},
 		{
 			name: "controlplane",
 			cfgSpec: &k8s.KubeletConfigSpec{
@@ -426,6 +439,46 @@ func TestNewKubeletConfigurationMerge(t *testing.T) {
 			},
 			machineType: machine.TypeControlPlane,
 		},
+		{
+			name: "system reserved",
+			cfgSpec: &k8s.KubeletConfigSpec{
+				ClusterDNS:    []string{"10.0.0.5"},
+				ClusterDomain: "cluster.local",
+				SystemReserved: map[string]string{
+					"cpu":    "1500m",
+					"memory": "1073741824",
+				},
+			},
+			kubeletVersion: compatibility.VersionFromImageRef("ghcr.io/siderolabs/kubelet:v1.29.0"),
+			expectedOverrides: func(kc *kubeletconfig.KubeletConfiguration) {
+				kc.SystemReserved["cpu"] = "1500m"
+				kc.SystemReserved["memory"] = "1073741824"
+			},
+			machineType: machine.TypeWorker,
+		},
+		{
+			name: "system reserved overridden",
+			cfgSpec: &k8s.KubeletConfigSpec{
+				ClusterDNS:    []string{"10.0.0.5"},
+				ClusterDomain: "cluster.local",
+				ExtraConfig: map[string]any{
+					"systemReserved": map[string]any{
+						"memory": "2Gi",
+					},
+				},
+				SystemReserved: map[string]string{
+					"cpu":    "1500m",
+					"memory": "1073741824",
+				},
+			},
+			kubeletVersion: compatibility.VersionFromImageRef("ghcr.io/siderolabs/kubelet:v1.29.0"),
+			expectedOverrides: func(kc *kubeletconfig.KubeletConfiguration) {
+				kc.SystemReserved = map[string]string{
+					"memory": "2Gi",
+				}
+			},
+			machineType: machine.TypeWorker,
+		},
 		{
 			name: "disable graceful shutdown",
 			cfgSpec: &k8s.KubeletConfigSpec{
@@ -7,6 +7,7 @@ package k8s_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"slices"
 	"sync"
@@ -314,6 +315,59 @@ func (suite *ManifestSuite) TestReconcileIPv6() {
 	suite.Assert().Contains(v, fmt.Sprintf(`"IPv6Network": "%s"`, constants.DefaultIPv6PodNet))
 }
 
+func (suite *ManifestSuite) TestReconcileFlannelMTU() {
+	rootSecrets := secrets.NewKubernetesRoot(secrets.KubernetesRootID)
+	manifestConfig := k8s.NewBootstrapManifestsConfig()
+	spec := defaultManifestSpec
+	spec.FlannelMTU = 1400
+	*manifestConfig.TypedSpec() = spec
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, rootSecrets))
+	suite.Require().NoError(suite.state.Create(suite.ctx, manifestConfig))
+
+	var manifest *k8s.Manifest
+
+	suite.Assert().NoError(
+		retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				r, err := suite.state.Get(
+					suite.ctx,
+					resource.NewMetadata(
+						k8s.ControlPlaneNamespaceName,
+						k8s.ManifestType,
+						"05-flannel",
+						resource.VersionUndefined,
+					),
+				)
+				if err != nil {
+					return retry.ExpectedError(err)
+				}
+
+				manifest = r.(*k8s.Manifest) //nolint:errcheck,forcetypeassert
+
+				return nil
+			},
+		),
+	)
+
+	configmap := k8sadapter.Manifest(manifest).Objects()[3]
+	suite.Assert().Equal("ConfigMap", configmap.GetKind())
+
+	v, _, _ := unstructured.NestedString(configmap.Object, "data", "cni-conf.json") //nolint:errcheck
+
+	var cniConf struct {
+		Plugins []struct {
+			Type     string         `json:"type"`
+			Delegate map[string]any `json:"delegate"`
+		} `json:"plugins"`
+	}
+
+	suite.Require().NoError(json.Unmarshal([]byte(v), &cniConf))
+	suite.Assert().Equal("flannel", cniConf.Plugins[0].Type)
+	suite.Assert().EqualValues(1400, cniConf.Plugins[0].Delegate["mtu"])
+	suite.Assert().Equal(true, cniConf.Plugins[0].Delegate["isDefaultGateway"])
+}
+
 func (suite *ManifestSuite) TestReconcileDisablePSP() {
 	rootSecrets := secrets.NewKubernetesRoot(secrets.KubernetesRootID)
 	manifestConfig := k8s.NewBootstrapManifestsConfig()
@@ -42,6 +42,12 @@ func (ctrl *NodeAnnotationSpecController) Inputs() []controller.Input {
 			Type:      runtime.ExtensionStatusType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.PlatformMetadataType,
+			ID:        optional.Some(runtime.PlatformMetadataID),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -90,6 +96,15 @@ func (ctrl *NodeAnnotationSpecController) Run(ctx context.Context, r controller.
 			return fmt.Errorf("error converting extensions to node annotations: %w", err)
 		}
 
+		if err = talosToNodeKV(
+			ctx, r, cfg, nodeAnnotations,
+			func(annotationValue string) bool {
+				return labels.ValidateLabelValue(annotationValue) != nil
+			},
+		); err != nil {
+			return fmt.Errorf("error converting Talos metadata to node annotations: %w", err)
+		}
+
 		for key, value := range nodeAnnotations {
 			if err = safe.WriterModify(ctx, r, k8s.NewNodeAnnotationSpec(key), func(k *k8s.NodeAnnotationSpec) error {
 				k.TypedSpec().Key = key
@@ -11,6 +11,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
@@ -18,6 +19,7 @@ import (
 	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/extensions"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
@@ -120,3 +122,30 @@ func (suite *NodeAnnotationsSuite) TestExtensionAnnotations() {
 			asrt.Equal("e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855", labelSpec.TypedSpec().Value)
 		})
 }
+
+func (suite *NodeAnnotationsSuite) TestTalosMetadataAnnotations() {
+	cfg := config.NewMachineConfig(container.NewV1Alpha1(&v1alpha1.Config{
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineType: "controlplane",
+			MachineInstall: &v1alpha1.InstallConfig{
+				InstallImage: "ghcr.io/siderolabs/installer:v1.9.0",
+			},
+			MachineFeatures: &v1alpha1.FeaturesConfig{
+				NodeTalosMetadata: pointer.To(true),
+			},
+		},
+	}))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{constants.K8sTalosInstallImageKey},
+		func(annotationSpec *k8s.NodeAnnotationSpec, asrt *assert.Assertions) {
+			asrt.Equal("ghcr.io/siderolabs/installer:v1.9.0", annotationSpec.TypedSpec().Value)
+		})
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{constants.K8sTalosConfigHashKey},
+		func(annotationSpec *k8s.NodeAnnotationSpec, asrt *assert.Assertions) {
+			asrt.Len(annotationSpec.TypedSpec().Value, 64)
+		})
+
+	// valid label values, published as labels
+	rtestutils.AssertNoResource[*k8s.NodeAnnotationSpec](suite.Ctx(), suite.T(), suite.State(), constants.K8sTalosVersionKey)
+}
@@ -74,7 +74,8 @@ func (ctrl *NodeCordonedSpecController) Run(ctx context.Context, r controller.Ru
 		case runtime.MachineStageShuttingDown, runtime.MachineStageUpgrading, runtime.MachineStageResetting:
 			shouldCordon = true
 		case runtime.MachineStageBooting, runtime.MachineStageRunning:
-			shouldCordon = false
+			// keep the node cordoned while it is in maintenance
+			shouldCordon = status.TypedSpec().NodeMaintenance
 		default:
 			// don't change cordoned status
 			continue
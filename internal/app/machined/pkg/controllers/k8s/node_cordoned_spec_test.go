@@ -74,3 +74,31 @@ func (suite *NodeCordonedSuite) TestResetting() {
 	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{k8s.NodeCordonedID},
 		func(*k8s.NodeCordonedSpec, *assert.Assertions) {})
 }
+
+func (suite *NodeCordonedSuite) TestNodeMaintenance() {
+	suite.updateMachineStage(runtime.MachineStageRunning)
+
+	rtestutils.AssertNoResource[*k8s.NodeCordonedSpec](suite.Ctx(), suite.T(), suite.State(), k8s.NodeCordonedID)
+
+	suite.setNodeMaintenance(true)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{k8s.NodeCordonedID},
+		func(*k8s.NodeCordonedSpec, *assert.Assertions) {})
+
+	suite.updateMachineStage(runtime.MachineStageBooting)
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{k8s.NodeCordonedID},
+		func(*k8s.NodeCordonedSpec, *assert.Assertions) {})
+
+	suite.setNodeMaintenance(false)
+
+	rtestutils.AssertNoResource[*k8s.NodeCordonedSpec](suite.Ctx(), suite.T(), suite.State(), k8s.NodeCordonedID)
+}
+
+func (suite *NodeCordonedSuite) setNodeMaintenance(maintenance bool) {
+	status, err := safe.StateGetByID[*runtime.MachineStatus](suite.Ctx(), suite.State(), runtime.MachineStatusID)
+	suite.Require().NoError(err)
+
+	status.TypedSpec().NodeMaintenance = maintenance
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), status))
+}
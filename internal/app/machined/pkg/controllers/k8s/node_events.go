@@ -0,0 +1,304 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/rs/xid"
+	"github.com/siderolabs/gen/channel"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	machinedruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/conditions"
+	"github.com/siderolabs/talos/pkg/kubernetes"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// nodeEventSourceComponent is the source component of the Kubernetes Events published by Talos.
+const nodeEventSourceComponent = "talos"
+
+// nodeEvent is a Kubernetes Event built from the machine event.
+type nodeEvent struct {
+	Type    string
+	Reason  string
+	Message string
+}
+
+// NodeEventsController publishes machine events as Kubernetes Events attached to the Node object.
+//
+// The controller is active if the node events feature is enabled in the machine configuration.
+type NodeEventsController struct {
+	V1Alpha1Events machinedruntime.Watcher
+
+	eventID xid.ID
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *NodeEventsController) Name() string {
+	return "k8s.NodeEventsController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NodeEventsController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.NodenameType,
+			ID:        optional.Some(k8s.NodenameID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineTypeType,
+			ID:        optional.Some(config.MachineTypeID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.KubernetesRootType,
+			ID:        optional.Some(secrets.KubernetesRootID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NodeEventsController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *NodeEventsController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var watchCh, consumeWatchCh chan machinedruntime.EventInfo
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+			cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+			if err != nil && !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting machine config: %w", err)
+			}
+
+			if cfg == nil || cfg.Config().Machine() == nil || !cfg.Config().Machine().Features().NodeEventsEnabled() {
+				// stop consuming events, they will be picked up once the feature is enabled again
+				consumeWatchCh = nil
+
+				continue
+			}
+
+			if watchCh == nil {
+				watchCh = make(chan machinedruntime.EventInfo)
+
+				var opts []machinedruntime.WatchOptionFunc
+
+				if ctrl.eventID.IsNil() {
+					opts = append(opts, machinedruntime.WithTailEvents(-1))
+				} else {
+					opts = append(opts, machinedruntime.WithTailID(ctrl.eventID))
+				}
+
+				// Watch returns immediately, setting up a goroutine which will copy events to `watchCh`
+				if err = ctrl.V1Alpha1Events.Watch(func(eventCh <-chan machinedruntime.EventInfo) {
+					for {
+						select {
+						case <-ctx.Done():
+							return
+						case event := <-eventCh:
+							if !channel.SendWithContext(ctx, watchCh, event) {
+								return
+							}
+						}
+					}
+				}, opts...); err != nil {
+					return fmt.Errorf("error watching events: %w", err)
+				}
+			}
+
+			consumeWatchCh = watchCh
+		case event := <-consumeWatchCh:
+			if k8sEvent, ok := buildNodeEvent(event.Payload); ok {
+				// the event is retried on the controller restart
+				if err := ctrl.publish(ctx, r, logger, k8sEvent, event.ID.Time()); err != nil {
+					return fmt.Errorf("error publishing node event: %w", err)
+				}
+			}
+
+			ctrl.eventID = event.ID
+
+			r.ResetRestartBackoff()
+		}
+	}
+}
+
+func (ctrl *NodeEventsController) publish(ctx context.Context, r controller.Runtime, logger *zap.Logger, event nodeEvent, timestamp time.Time) error {
+	nodename, err := safe.ReaderGetByID[*k8s.Nodename](ctx, r, k8s.NodenameID)
+	if err != nil {
+		return fmt.Errorf("error getting nodename: %w", err)
+	}
+
+	if nodename.TypedSpec().SkipNodeRegistration {
+		return nil
+	}
+
+	k8sClient, err := ctrl.getK8sClient(ctx, r, logger)
+	if err != nil {
+		return fmt.Errorf("error building kubernetes client: %w", err)
+	}
+
+	if k8sClient == nil {
+		return fmt.Errorf("kubernetes client is not ready yet")
+	}
+
+	defer k8sClient.Close() //nolint:errcheck
+
+	name := nodename.TypedSpec().Nodename
+
+	_, err = k8sClient.CoreV1().Events(metav1.NamespaceDefault).Create(ctx, &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: name + ".",
+			Namespace:    metav1.NamespaceDefault,
+		},
+		InvolvedObject: corev1.ObjectReference{
+			Kind: "Node",
+			Name: name,
+			// kubelet and kubectl use the node name as the UID of the Node events
+			UID: types.UID(name),
+		},
+		Reason:  event.Reason,
+		Message: event.Message,
+		Type:    event.Type,
+		Source: corev1.EventSource{
+			Component: nodeEventSourceComponent,
+			Host:      name,
+		},
+		FirstTimestamp: metav1.NewTime(timestamp),
+		LastTimestamp:  metav1.NewTime(timestamp),
+		Count:          1,
+	}, metav1.CreateOptions{})
+
+	return err
+}
+
+func (ctrl *NodeEventsController) getK8sClient(ctx context.Context, r controller.Runtime, logger *zap.Logger) (*kubernetes.Client, error) {
+	machineType, err := safe.ReaderGetByID[*config.MachineType](ctx, r, config.MachineTypeID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting machine type: %w", err)
+	}
+
+	if machineType.MachineType().IsControlPlane() {
+		return kubernetes.NewTemporaryClientControlPlane(ctx, r)
+	}
+
+	logger.Debug("waiting for kubelet client config", zap.String("file", constants.KubeletKubeconfig))
+
+	if err := conditions.WaitForKubeconfigReady(constants.KubeletKubeconfig).Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	return kubernetes.NewClientFromKubeletKubeconfig()
+}
+
+// buildNodeEvent builds a Kubernetes Event for the machine event.
+//
+// Only the events which are interesting for the cluster operators are published.
+//
+//nolint:gocyclo,cyclop
+func buildNodeEvent(payload proto.Message) (nodeEvent, bool) {
+	switch msg := payload.(type) {
+	case *machine.SequenceEvent:
+		if msg.GetSequence() != "upgrade" && msg.GetSequence() != "stageUpgrade" {
+			return nodeEvent{}, false
+		}
+
+		switch {
+		case msg.GetError() != nil:
+			if msg.GetError().GetCode() == common.Code_LOCKED {
+				return nodeEvent{}, false
+			}
+
+			return nodeEvent{corev1.EventTypeWarning, "UpgradeFailed", msg.GetError().GetMessage()}, true
+		case msg.GetAction() == machine.SequenceEvent_START:
+			return nodeEvent{corev1.EventTypeNormal, "UpgradeStarted", "Talos upgrade started"}, true
+		case msg.GetAction() == machine.SequenceEvent_STOP:
+			return nodeEvent{corev1.EventTypeNormal, "UpgradeFinished", "Talos upgrade sequence finished"}, true
+		}
+	case *machine.ConfigApplyEvent:
+		return nodeEvent{corev1.EventTypeNormal, "ConfigApplied", msg.GetModeDetails()}, true
+	case *machine.ConfigLoadErrorEvent:
+		return nodeEvent{corev1.EventTypeWarning, "ConfigLoadFailed", msg.GetError()}, true
+	case *machine.ConfigValidationErrorEvent:
+		return nodeEvent{corev1.EventTypeWarning, "ConfigValidationFailed", msg.GetError()}, true
+	case *machine.PowerActionEvent:
+		action := "Reboot"
+		if msg.GetAction() == machine.PowerActionEvent_SHUTDOWN {
+			action = "Shutdown"
+		}
+
+		reason := action + map[machine.PowerActionEvent_State]string{
+			machine.PowerActionEvent_SCHEDULED: "Scheduled",
+			machine.PowerActionEvent_STARTED:   "Started",
+			machine.PowerActionEvent_CANCELED:  "Canceled",
+		}[msg.GetState()]
+
+		message := fmt.Sprintf("%s %s", strings.ToLower(action), strings.ToLower(msg.GetState().String()))
+		if msg.GetMessage() != "" {
+			message += ": " + msg.GetMessage()
+		}
+
+		return nodeEvent{corev1.EventTypeNormal, reason, message}, true
+	case *machine.OOMKillEvent:
+		return nodeEvent{
+			corev1.EventTypeWarning,
+			"OOMKill",
+			fmt.Sprintf("process %s (pid %d) killed by the OOM killer, cgroup %s, constraint %s", msg.GetProcess(), msg.GetPid(), msg.GetCgroup(), msg.GetConstraint()),
+		}, true
+	case *machine.KernelErrorEvent:
+		return nodeEvent{corev1.EventTypeWarning, "HardwareError", fmt.Sprintf("%s: %s", msg.GetKind(), msg.GetMessage())}, true
+	case *machine.BootFallbackEvent:
+		return nodeEvent{
+			corev1.EventTypeWarning,
+			"BootFallback",
+			fmt.Sprintf("booted %s after %s failed %d boot attempts", msg.GetBootedLabel(), msg.GetFailedLabel(), msg.GetBootAttempts()),
+		}, true
+	case *machine.WatchdogResetEvent:
+		return nodeEvent{corev1.EventTypeWarning, "WatchdogReset", fmt.Sprintf("last reset reasons: %s", strings.Join(msg.GetReasons(), ", "))}, true
+	case *machine.EtcdCertificateRotationEvent:
+		if msg.GetStage() == machine.EtcdCertificateRotationEvent_DONE {
+			return nodeEvent{corev1.EventTypeNormal, "EtcdCertificatesRotated", msg.GetMessage()}, true
+		}
+	}
+
+	return nodeEvent{}, false
+}
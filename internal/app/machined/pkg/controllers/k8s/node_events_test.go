@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package k8s_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+
+	k8sctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+func TestBuildNodeEvent(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name    string
+		payload proto.Message
+
+		expectedType    string
+		expectedReason  string
+		expectedMessage string
+	}{
+		{
+			name:    "upgrade started",
+			payload: &machine.SequenceEvent{Sequence: "upgrade", Action: machine.SequenceEvent_START},
+
+			expectedType:    corev1.EventTypeNormal,
+			expectedReason:  "UpgradeStarted",
+			expectedMessage: "Talos upgrade started",
+		},
+		{
+			name: "upgrade failed",
+			payload: &machine.SequenceEvent{
+				Sequence: "upgrade",
+				Action:   machine.SequenceEvent_NOOP,
+				Error:    &common.Error{Code: common.Code_FATAL, Message: "sequence failed: disk is busy"},
+			},
+
+			expectedType:    corev1.EventTypeWarning,
+			expectedReason:  "UpgradeFailed",
+			expectedMessage: "sequence failed: disk is busy",
+		},
+		{
+			name: "upgrade locked",
+			payload: &machine.SequenceEvent{
+				Sequence: "upgrade",
+				Action:   machine.SequenceEvent_NOOP,
+				Error:    &common.Error{Code: common.Code_LOCKED},
+			},
+		},
+		{
+			name:    "boot sequence",
+			payload: &machine.SequenceEvent{Sequence: "boot", Action: machine.SequenceEvent_START},
+		},
+		{
+			name:    "config applied",
+			payload: &machine.ConfigApplyEvent{Mode: machine.ApplyConfigurationRequest_NO_REBOOT, ModeDetails: "Applied configuration without a reboot"},
+
+			expectedType:    corev1.EventTypeNormal,
+			expectedReason:  "ConfigApplied",
+			expectedMessage: "Applied configuration without a reboot",
+		},
+		{
+			name:    "shutdown scheduled",
+			payload: &machine.PowerActionEvent{Action: machine.PowerActionEvent_SHUTDOWN, State: machine.PowerActionEvent_SCHEDULED, Message: "maintenance"},
+
+			expectedType:    corev1.EventTypeNormal,
+			expectedReason:  "ShutdownScheduled",
+			expectedMessage: "shutdown scheduled: maintenance",
+		},
+		{
+			name:    "OOM kill",
+			payload: &machine.OOMKillEvent{Pid: 42, Process: "app", Cgroup: "/kubepods/pod1/abcd", Constraint: "CONSTRAINT_MEMCG"},
+
+			expectedType:    corev1.EventTypeWarning,
+			expectedReason:  "OOMKill",
+			expectedMessage: "process app (pid 42) killed by the OOM killer, cgroup /kubepods/pod1/abcd, constraint CONSTRAINT_MEMCG",
+		},
+		{
+			name:    "disk error",
+			payload: &machine.KernelErrorEvent{Kind: "io", Message: "I/O error, dev sda, sector 2048"},
+
+			expectedType:    corev1.EventTypeWarning,
+			expectedReason:  "HardwareError",
+			expectedMessage: "io: I/O error, dev sda, sector 2048",
+		},
+		{
+			name:    "service state",
+			payload: &machine.ServiceStateEvent{Service: "kubelet", Action: machine.ServiceStateEvent_RUNNING},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			event, ok := k8sctrl.BuildNodeEvent(test.payload)
+
+			if test.expectedReason == "" {
+				assert.False(t, ok)
+
+				return
+			}
+
+			assert.True(t, ok)
+			assert.Equal(t, test.expectedType, event.Type)
+			assert.Equal(t, test.expectedReason, event.Reason)
+			assert.Equal(t, test.expectedMessage, event.Message)
+		})
+	}
+}
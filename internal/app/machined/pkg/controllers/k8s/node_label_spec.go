@@ -6,6 +6,7 @@ package k8s
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 
 	"github.com/cosi-project/runtime/pkg/controller"
@@ -19,6 +20,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/version"
 )
 
 // NodeLabelSpecController manages k8s.NodeLabelsConfig based on configuration.
@@ -43,6 +45,12 @@ func (ctrl *NodeLabelSpecController) Inputs() []controller.Input {
 			Type:      runtime.ExtensionStatusType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.PlatformMetadataType,
+			ID:        optional.Some(runtime.PlatformMetadataID),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -95,6 +103,15 @@ func (ctrl *NodeLabelSpecController) Run(ctx context.Context, r controller.Runti
 			return fmt.Errorf("error converting extensions to node labels: %w", err)
 		}
 
+		if err = talosToNodeKV(
+			ctx, r, cfg, nodeLabels,
+			func(labelValue string) bool {
+				return labels.ValidateLabelValue(labelValue) == nil
+			},
+		); err != nil {
+			return fmt.Errorf("error converting Talos metadata to node labels: %w", err)
+		}
+
 		for key, value := range nodeLabels {
 			if err = safe.WriterModify(ctx, r, k8s.NewNodeLabelSpec(key), func(k *k8s.NodeLabelSpec) error {
 				k.TypedSpec().Key = key
@@ -135,3 +152,41 @@ func extensionsToNodeKV(ctx context.Context, r controller.Reader, spec map[strin
 
 	return nil
 }
+
+// talosToNodeKV adds Talos metadata to the node labels/annotations if the feature is enabled.
+//
+// Only the values accepted by the valueFilter are added, so each key ends up either as a label or as an annotation.
+func talosToNodeKV(ctx context.Context, r controller.Reader, cfg *config.MachineConfig, spec map[string]string, valueFilter func(string) bool) error {
+	if cfg == nil || cfg.Config().Machine() == nil || !cfg.Config().Machine().Features().NodeTalosMetadataEnabled() {
+		return nil
+	}
+
+	kv := map[string]string{
+		constants.K8sTalosVersionKey:      version.Tag,
+		constants.K8sTalosInstallImageKey: cfg.Config().Machine().Install().Image(),
+	}
+
+	platformMetadata, err := safe.ReaderGetByID[*runtime.PlatformMetadata](ctx, r, runtime.PlatformMetadataID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting platform metadata: %w", err)
+	}
+
+	if platformMetadata != nil {
+		kv[constants.K8sTalosPlatformKey] = platformMetadata.TypedSpec().Platform
+	}
+
+	configBytes, err := cfg.Provider().Bytes()
+	if err != nil {
+		return fmt.Errorf("error encoding machine config: %w", err)
+	}
+
+	kv[constants.K8sTalosConfigHashKey] = fmt.Sprintf("%x", sha256.Sum256(configBytes))
+
+	for key, value := range kv {
+		if value != "" && valueFilter(value) {
+			spec[key] = value
+		}
+	}
+
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/go-pointer"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/suite"
 
@@ -24,6 +25,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/version"
 )
 
 type NodeLabelsSuite struct {
@@ -170,3 +172,41 @@ func (suite *NodeLabelsSuite) TestExtensionLabels() {
 
 	rtestutils.AssertNoResource[*k8s.NodeLabelSpec](suite.Ctx(), suite.T(), suite.State(), "extensions.talos.dev/schematic")
 }
+
+func (suite *NodeLabelsSuite) TestTalosMetadataLabels() {
+	platformMetadata := runtime.NewPlatformMetadataSpec(runtime.NamespaceName, runtime.PlatformMetadataID)
+	platformMetadata.TypedSpec().Platform = "metal"
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), platformMetadata))
+
+	cfg := config.NewMachineConfig(container.NewV1Alpha1(&v1alpha1.Config{
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineType: machine.TypeWorker.String(),
+			MachineInstall: &v1alpha1.InstallConfig{
+				InstallImage: "ghcr.io/siderolabs/installer:v1.9.0",
+			},
+			MachineFeatures: &v1alpha1.FeaturesConfig{
+				NodeTalosMetadata: pointer.To(true),
+			},
+		},
+	}))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{constants.K8sTalosVersionKey},
+		func(labelSpec *k8s.NodeLabelSpec, asrt *assert.Assertions) {
+			asrt.Equal(version.Tag, labelSpec.TypedSpec().Value)
+		})
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []string{constants.K8sTalosPlatformKey},
+		func(labelSpec *k8s.NodeLabelSpec, asrt *assert.Assertions) {
+			asrt.Equal("metal", labelSpec.TypedSpec().Value)
+		})
+
+	// not valid label values, published as annotations
+	rtestutils.AssertNoResource[*k8s.NodeLabelSpec](suite.Ctx(), suite.T(), suite.State(), constants.K8sTalosInstallImageKey)
+	rtestutils.AssertNoResource[*k8s.NodeLabelSpec](suite.Ctx(), suite.T(), suite.State(), constants.K8sTalosConfigHashKey)
+
+	cfg.Container().RawV1Alpha1().MachineConfig.MachineFeatures.NodeTalosMetadata = pointer.To(false)
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertNoResource[*k8s.NodeLabelSpec](suite.Ctx(), suite.T(), suite.State(), constants.K8sTalosVersionKey)
+	rtestutils.AssertNoResource[*k8s.NodeLabelSpec](suite.Ctx(), suite.T(), suite.State(), constants.K8sTalosPlatformKey)
+}
@@ -0,0 +1,244 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/bgp"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// BGPController runs the BGP sessions from the BGPConfig document, and outputs BGPPeerStatuses.
+type BGPController struct {
+	sessions map[netip.Addr]*bgp.Session
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *BGPController) Name() string {
+	return "network.BGPController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *BGPController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.DeviceConfigSpecType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.AddressStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *BGPController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.BGPPeerStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *BGPController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	notifyCh := make(chan struct{}, 1)
+
+	ctrl.sessions = make(map[netip.Addr]*bgp.Session)
+
+	defer func() {
+		for _, session := range ctrl.sessions {
+			session.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+			if err := ctrl.reconcileSessions(ctx, r, logger, notifyCh); err != nil {
+				return err
+			}
+		case <-notifyCh:
+		}
+
+		if err := ctrl.reconcileOutputs(ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *BGPController) reconcileSessions(ctx context.Context, r controller.Runtime, logger *zap.Logger, notifyCh chan<- struct{}) error {
+	cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting config: %w", err)
+	}
+
+	var bgpConfig talosconfig.BGPConfig
+
+	if cfg != nil {
+		bgpConfig = cfg.Config().BGP()
+	}
+
+	shouldRun := map[netip.Addr]bgp.Config{}
+
+	var prefixes []netip.Prefix
+
+	if bgpConfig != nil {
+		for _, peer := range bgpConfig.Peers() {
+			shouldRun[peer.Address] = bgp.Config{
+				LocalASN:    bgpConfig.ASN(),
+				RouterID:    bgpConfig.RouterID().ValueOrZero(),
+				HoldTime:    bgpConfig.HoldTime(),
+				PeerAddress: peer.Address,
+				PeerPort:    peer.Port,
+				PeerASN:     peer.ASN,
+				BFD:         peer.BFD,
+			}
+		}
+
+		prefixes = bgpConfig.AnnouncePrefixes()
+
+		if bgpConfig.AnnounceVIPs() {
+			vips, err := ctrl.heldVIPs(ctx, r)
+			if err != nil {
+				return err
+			}
+
+			prefixes = append(prefixes, vips...)
+		}
+	}
+
+	// stop sessions which shouldn't run, or if the config was changed
+	for peer, session := range ctrl.sessions {
+		if sessionConfig, exists := shouldRun[peer]; exists && sessionConfig == session.Config() {
+			continue
+		}
+
+		logger.Debug("stopping BGP session", zap.Stringer("peer", peer))
+
+		session.Stop()
+		delete(ctrl.sessions, peer)
+	}
+
+	for peer, sessionConfig := range shouldRun {
+		session, exists := ctrl.sessions[peer]
+		if !exists {
+			logger.Debug("starting BGP session", zap.Stringer("peer", peer))
+
+			session = bgp.NewSession(sessionConfig)
+			session.Start(ctx, notifyCh, logger)
+
+			ctrl.sessions[peer] = session
+		}
+
+		session.Announce(prefixes)
+	}
+
+	return nil
+}
+
+// heldVIPs returns the host routes for the shared IPs currently assigned to the machine.
+func (ctrl *BGPController) heldVIPs(ctx context.Context, r controller.Runtime) ([]netip.Prefix, error) {
+	deviceConfigs, err := safe.ReaderListAll[*network.DeviceConfigSpec](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("error listing device configs: %w", err)
+	}
+
+	vips := map[netip.Addr]struct{}{}
+
+	addVIP := func(vipConfig talosconfig.VIPConfig) {
+		if vipConfig == nil {
+			return
+		}
+
+		if vip, err := netip.ParseAddr(vipConfig.IP()); err == nil {
+			vips[vip] = struct{}{}
+		}
+	}
+
+	for iter := deviceConfigs.Iterator(); iter.Next(); {
+		device := iter.Value().TypedSpec().Device
+
+		addVIP(device.VIPConfig())
+
+		for _, vlan := range device.Vlans() {
+			addVIP(vlan.VIPConfig())
+		}
+	}
+
+	if len(vips) == 0 {
+		return nil, nil
+	}
+
+	addresses, err := safe.ReaderListAll[*network.AddressStatus](ctx, r)
+	if err != nil {
+		return nil, fmt.Errorf("error listing addresses: %w", err)
+	}
+
+	var prefixes []netip.Prefix
+
+	for iter := addresses.Iterator(); iter.Next(); {
+		addr := iter.Value().TypedSpec().Address.Addr()
+
+		if _, isVIP := vips[addr]; isVIP {
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+		}
+	}
+
+	return prefixes, nil
+}
+
+func (ctrl *BGPController) reconcileOutputs(ctx context.Context, r controller.Runtime) error {
+	r.StartTrackingOutputs()
+
+	for peer, session := range ctrl.sessions {
+		sessionConfig := session.Config()
+		status := session.Status()
+
+		if err := safe.WriterModify(ctx, r, network.NewBGPPeerStatus(network.NamespaceName, peer.String()), func(res *network.BGPPeerStatus) error {
+			spec := res.TypedSpec()
+
+			spec.Address = peer
+			spec.ASN = sessionConfig.PeerASN
+			spec.LocalASN = sessionConfig.LocalASN
+			spec.State = status.State
+			spec.LocalAddress = status.LocalAddress
+			spec.Announced = status.Announced
+			spec.BFDState = status.BFDState
+			spec.LastError = status.LastError
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating BGP peer status: %w", err)
+		}
+	}
+
+	return safe.CleanupOutputs[*network.BGPPeerStatus](ctx, r)
+}
@@ -0,0 +1,159 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type BGPSuite struct {
+	ctest.DefaultSuite
+}
+
+// fakeBGPPeer accepts a single session, answers the OPEN message and discards everything else.
+func fakeBGPPeer(listener net.Listener, asn uint16) {
+	conn, err := listener.Accept()
+	if err != nil {
+		return
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	send := func(typ byte, body []byte) error {
+		msg := bytes.Repeat([]byte{0xff}, 16)
+		msg = binary.BigEndian.AppendUint16(msg, uint16(19+len(body)))
+		msg = append(msg, typ)
+
+		_, err := conn.Write(append(msg, body...))
+
+		return err
+	}
+
+	// OPEN: version, AS, hold time, router ID, no optional parameters
+	open := []byte{4}
+	open = binary.BigEndian.AppendUint16(open, asn)
+	open = binary.BigEndian.AppendUint16(open, 30)
+	open = append(open, 10, 0, 0, 1, 0)
+
+	if send(1, open) != nil || send(4, nil) != nil {
+		return
+	}
+
+	for {
+		header := make([]byte, 19)
+
+		if _, err := io.ReadFull(conn, header); err != nil {
+			return
+		}
+
+		if _, err := io.CopyN(io.Discard, conn, int64(binary.BigEndian.Uint16(header[16:]))-19); err != nil {
+			return
+		}
+
+		if header[18] == 4 {
+			if send(4, nil) != nil {
+				return
+			}
+		}
+	}
+}
+
+func (suite *BGPSuite) TestAnnounce() {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	suite.Require().NoError(err)
+
+	defer listener.Close() //nolint:errcheck
+
+	go fakeBGPPeer(listener, 64500)
+
+	bgpCfg := networkcfg.NewBGPConfigV1Alpha1()
+	bgpCfg.BGPASN = 64512
+	bgpCfg.BGPPeers = []networkcfg.BGPPeer{
+		{
+			PeerAddress: netip.MustParseAddr("127.0.0.1"),
+			PeerPort:    uint16(listener.Addr().(*net.TCPAddr).Port),
+			PeerASN:     64500,
+		},
+	}
+	bgpCfg.BGPAnnounce.AnnounceVIPs = pointer.To(true)
+	bgpCfg.BGPAnnounce.AnnouncePrefixes = []netip.Prefix{netip.MustParsePrefix("192.168.100.0/24")}
+
+	cfg, err := container.New(bgpCfg)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+
+	deviceConfig := network.NewDeviceConfig("eth0/000", &v1alpha1.Device{
+		DeviceInterface: "eth0",
+		DeviceVIPConfig: &v1alpha1.DeviceVIPConfig{
+			SharedIP: "10.5.0.1",
+		},
+	})
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), deviceConfig))
+
+	ctest.AssertResource(suite, "127.0.0.1", func(status *network.BGPPeerStatus, asrt *assert.Assertions) {
+		asrt.Equal("Established", status.TypedSpec().State)
+		asrt.EqualValues(64500, status.TypedSpec().ASN)
+		asrt.EqualValues(64512, status.TypedSpec().LocalASN)
+		asrt.Equal(netip.MustParseAddr("127.0.0.1"), status.TypedSpec().LocalAddress)
+		asrt.Equal([]netip.Prefix{netip.MustParsePrefix("192.168.100.0/24")}, status.TypedSpec().Announced)
+	})
+
+	// the machine acquires the shared IP
+	addressStatus := network.NewAddressStatus(network.NamespaceName, "eth0/10.5.0.1/32")
+	addressStatus.TypedSpec().Address = netip.MustParsePrefix("10.5.0.1/32")
+	addressStatus.TypedSpec().LinkName = "eth0"
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), addressStatus))
+
+	ctest.AssertResource(suite, "127.0.0.1", func(status *network.BGPPeerStatus, asrt *assert.Assertions) {
+		asrt.Equal([]netip.Prefix{
+			netip.MustParsePrefix("10.5.0.1/32"),
+			netip.MustParsePrefix("192.168.100.0/24"),
+		}, status.TypedSpec().Announced)
+	})
+
+	// and loses it
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), addressStatus.Metadata()))
+
+	ctest.AssertResource(suite, "127.0.0.1", func(status *network.BGPPeerStatus, asrt *assert.Assertions) {
+		asrt.Equal([]netip.Prefix{netip.MustParsePrefix("192.168.100.0/24")}, status.TypedSpec().Announced)
+	})
+
+	// removing the config stops the session
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), config.NewMachineConfig(cfg).Metadata()))
+
+	ctest.AssertNoResource[*network.BGPPeerStatus](suite, "127.0.0.1")
+}
+
+func TestBGPSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &BGPSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.BGPController{}))
+			},
+		},
+	})
+}
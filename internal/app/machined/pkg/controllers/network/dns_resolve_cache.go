@@ -35,6 +35,9 @@ type DNSResolveCacheController struct {
 	State  state.State
 	Logger *zap.Logger
 
+	// Metrics of the DNS server, optional.
+	Metrics *dns.Metrics
+
 	mx          sync.Mutex
 	handler     *dns.Handler
 	nodeHandler *dns.NodeHandler
@@ -167,6 +170,10 @@ func (ctrl *DNSResolveCacheController) Run(ctx context.Context, r controller.Run
 			ctrl.Logger.Info("updated dns server nameservers", zap.Strings("addrs", addrs))
 		}
 
+		if ctrl.handler.SetStubDomains(StubDomainProxies(upstreams)) {
+			ctrl.Logger.Info("updated dns server stub domains")
+		}
+
 		if err = safe.CleanupOutputs[*network.DNSResolveCache](ctx, r); err != nil {
 			return fmt.Errorf("error cleaning up dns status: %w", err)
 		}
@@ -174,14 +181,45 @@ func (ctrl *DNSResolveCacheController) Run(ctx context.Context, r controller.Run
 }
 
 // SortedProxies returns sorted list of proxies and their addresses.
+//
+// The upstreams of the stub domains are skipped.
 func SortedProxies(upstreams safe.List[*network.DNSUpstream]) ([]*proxy.Proxy, []string) {
 	upstreams.SortFunc(func(a, b *network.DNSUpstream) int {
 		return cmp.Compare(a.TypedSpec().Value.Idx, b.TypedSpec().Value.Idx)
 	})
 
-	//nolint:forcetypeassert
-	return safe.ToSlice(upstreams, func(d *network.DNSUpstream) *proxy.Proxy { return d.TypedSpec().Value.Prx.(*proxy.Proxy) }),
-		safe.ToSlice(upstreams, func(d *network.DNSUpstream) string { return d.TypedSpec().Value.Prx.Addr() })
+	var (
+		prxs  []*proxy.Proxy
+		addrs []string
+	)
+
+	for it := upstreams.Iterator(); it.Next(); {
+		if it.Value().TypedSpec().Value.Domain != "" {
+			continue
+		}
+
+		prxs = append(prxs, it.Value().TypedSpec().Value.Prx.(*proxy.Proxy)) //nolint:forcetypeassert
+		addrs = append(addrs, it.Value().TypedSpec().Value.Prx.Addr())
+	}
+
+	return prxs, addrs
+}
+
+// StubDomainProxies returns sorted lists of proxies of the stub domains.
+func StubDomainProxies(upstreams safe.List[*network.DNSUpstream]) map[string][]*proxy.Proxy {
+	upstreams.SortFunc(func(a, b *network.DNSUpstream) int {
+		return cmp.Compare(a.TypedSpec().Value.Idx, b.TypedSpec().Value.Idx)
+	})
+
+	stubs := map[string][]*proxy.Proxy{}
+
+	for it := upstreams.Iterator(); it.Next(); {
+		if domain := it.Value().TypedSpec().Value.Domain; domain != "" {
+			stubs[domain] = append(stubs[domain], it.Value().TypedSpec().Value.Prx.(*proxy.Proxy)) //nolint:forcetypeassert
+		}
+	}
+
+	return stubs
 }
 
 func (ctrl *DNSResolveCacheController) writeDNSStatus(ctx context.Context, r controller.Runtime, config runnerConfig) error {
@@ -203,9 +241,9 @@ func (ctrl *DNSResolveCacheController) init(ctx context.Context) {
 	}
 
 	ctrl.originalCtx = ctx
-	ctrl.handler = dns.NewHandler(ctrl.Logger)
+	ctrl.handler = dns.NewHandler(ctrl.Logger, ctrl.Metrics)
 	ctrl.nodeHandler = dns.NewNodeHandler(ctrl.handler, &stateMapper{state: ctrl.State}, ctrl.Logger)
-	ctrl.rootHandler = dns.NewCache(ctrl.nodeHandler, ctrl.Logger)
+	ctrl.rootHandler = dns.NewCache(ctrl.nodeHandler, ctrl.Logger, ctrl.Metrics)
 	ctrl.runners = map[runnerConfig]pair.Pair[func(), <-chan struct{}]{}
 	ctrl.reconcile = make(chan struct{}, 1)
 
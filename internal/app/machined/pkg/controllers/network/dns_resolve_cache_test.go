@@ -12,6 +12,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/coredns/coredns/plugin/pkg/proxy"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/rtestutils"
 	"github.com/cosi-project/runtime/pkg/safe"
@@ -326,6 +327,57 @@ func (suite *DNSUpstreams) TestOrder() {
 	}
 }
 
+func (suite *DNSUpstreams) TestConfiguredUpstreamsAndStubDomains() {
+	port := must.Value(getDynamicPort())(suite.T())
+
+	resolverSpec := network.NewResolverStatus(network.NamespaceName, network.ResolverID)
+	resolverSpec.TypedSpec().DNSServers = []netip.Addr{netip.MustParseAddr("192.168.0.1")}
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), resolverSpec))
+
+	cfg := network.NewHostDNSConfig(network.HostDNSConfigID)
+	cfg.TypedSpec().Enabled = true
+	cfg.TypedSpec().ListenAddresses = makeAddrs(port)
+	cfg.TypedSpec().Upstreams = []netip.Addr{netip.MustParseAddr("1.1.1.1"), netip.MustParseAddr("8.8.8.8")}
+	cfg.TypedSpec().StubDomains = []network.HostDNSStubDomain{
+		{
+			Domain:      "corp.example.com",
+			Nameservers: []netip.Addr{netip.MustParseAddr("10.0.0.53"), netip.MustParseAddr("10.0.1.53")},
+		},
+	}
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(),
+		[]resource.ID{"1.1.1.1", "8.8.8.8", "corp.example.com/10.0.0.53", "corp.example.com/10.0.1.53"},
+		func(r *network.DNSUpstream, assert *assert.Assertions) {},
+	)
+	rtestutils.AssertLength[*network.DNSUpstream](suite.Ctx(), suite.T(), suite.State(), 4)
+
+	upstreams, err := safe.ReaderListAll[*network.DNSUpstream](suite.Ctx(), suite.State())
+	suite.Require().NoError(err)
+
+	_, upstreamAddrs := netctrl.SortedProxies(upstreams)
+	suite.Assert().Equal([]string{"1.1.1.1:53", "8.8.8.8:53"}, upstreamAddrs)
+
+	stubs := netctrl.StubDomainProxies(upstreams)
+	suite.Require().Len(stubs, 1)
+	suite.Assert().Equal(
+		[]string{"10.0.0.53:53", "10.0.1.53:53"},
+		xslices.Map(stubs["corp.example.com"], func(p *proxy.Proxy) string { return p.Addr() }),
+	)
+
+	// without configured upstreams, the nameservers of the machine are used
+	cfg.TypedSpec().Upstreams = nil
+	cfg.TypedSpec().StubDomains = nil
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(),
+		[]resource.ID{"192.168.0.1"},
+		func(r *network.DNSUpstream, assert *assert.Assertions) {},
+	)
+	rtestutils.AssertLength[*network.DNSUpstream](suite.Ctx(), suite.T(), suite.State(), 1)
+}
+
 func TestDNSUpstreams(t *testing.T) {
 	suite.Run(t, &DNSUpstreams{
 		DefaultSuite: ctest.DefaultSuite{
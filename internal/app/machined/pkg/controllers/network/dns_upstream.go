@@ -7,6 +7,7 @@ package network
 import (
 	"context"
 	"net"
+	"net/netip"
 	"time"
 
 	"github.com/coredns/coredns/plugin/pkg/proxy"
@@ -94,54 +95,87 @@ func (ctrl *DNSUpstreamController) run(ctx context.Context, r controller.Runtime
 		return nil
 	}
 
-	rs, err := safe.ReaderGetByID[*network.ResolverStatus](ctx, r, network.ResolverID)
-	if err != nil {
-		if state.IsNotFoundError(err) {
-			return nil
+	servers := cfg.TypedSpec().Upstreams
+
+	// use the nameservers of the machine if the upstreams are not configured explicitly
+	if len(servers) == 0 {
+		rs, err := safe.ReaderGetByID[*network.ResolverStatus](ctx, r, network.ResolverID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return err
 		}
 
-		return err
+		if rs != nil {
+			servers = rs.TypedSpec().DNSServers
+		}
 	}
 
-	for i, s := range rs.TypedSpec().DNSServers {
-		remoteAddr := s.String()
+	for i, s := range servers {
+		if err = ctrl.writeUpstream(ctx, r, touchedIDs, "", s, i, l); err != nil {
+			return err
+		}
+	}
 
-		if err = safe.WriterModify[*network.DNSUpstream](
-			ctx,
-			r,
-			network.NewDNSUpstream(remoteAddr),
-			func(u *network.DNSUpstream) error {
-				touchedIDs[u.Metadata().ID()] = struct{}{}
+	for _, stub := range cfg.TypedSpec().StubDomains {
+		for i, s := range stub.Nameservers {
+			if err = ctrl.writeUpstream(ctx, r, touchedIDs, stub.Domain, s, i, l); err != nil {
+				return err
+			}
+		}
+	}
 
-				if u.TypedSpec().Value.Prx != nil {
-					// Found upstream, update index
-					if u.TypedSpec().Value.Idx != i {
-						old := u.TypedSpec().Value.Idx
-						u.TypedSpec().Value.Idx = i
+	return nil
+}
+
+func (ctrl *DNSUpstreamController) writeUpstream(
+	ctx context.Context,
+	r controller.Runtime,
+	touchedIDs map[resource.ID]struct{},
+	domain string,
+	addr netip.Addr,
+	idx int,
+	l *zap.Logger,
+) error {
+	remoteAddr := addr.String()
+
+	// the upstreams of the stub domains are identified by the domain and the address,
+	// as the same nameserver might be used for several domains
+	id := remoteAddr
+	if domain != "" {
+		id = domain + "/" + remoteAddr
+	}
+
+	return safe.WriterModify[*network.DNSUpstream](
+		ctx,
+		r,
+		network.NewDNSUpstream(id),
+		func(u *network.DNSUpstream) error {
+			touchedIDs[u.Metadata().ID()] = struct{}{}
 
-						l.Info("updated dns upstream idx", zap.String("addr", remoteAddr), zap.Int("was", old), zap.Int("now", i))
-					}
+			if u.TypedSpec().Value.Prx != nil {
+				// Found upstream, update index
+				if u.TypedSpec().Value.Idx != idx {
+					old := u.TypedSpec().Value.Idx
+					u.TypedSpec().Value.Idx = idx
 
-					return nil
+					l.Info("updated dns upstream idx", zap.String("addr", remoteAddr), zap.String("domain", domain), zap.Int("was", old), zap.Int("now", idx))
 				}
 
-				prx := proxy.NewProxy(remoteAddr, net.JoinHostPort(remoteAddr, "53"), "dns")
+				return nil
+			}
 
-				prx.Start(500 * time.Millisecond)
+			prx := proxy.NewProxy(remoteAddr, net.JoinHostPort(remoteAddr, "53"), "dns")
 
-				u.TypedSpec().Value.Prx = prx
-				u.TypedSpec().Value.Idx = i
+			prx.Start(500 * time.Millisecond)
 
-				l.Info("created dns upstream", zap.String("addr", remoteAddr), zap.Int("idx", i))
+			u.TypedSpec().Value.Prx = prx
+			u.TypedSpec().Value.Idx = idx
+			u.TypedSpec().Value.Domain = domain
 
-				return nil
-			},
-		); err != nil {
-			return err
-		}
-	}
+			l.Info("created dns upstream", zap.String("addr", remoteAddr), zap.String("domain", domain), zap.Int("idx", idx))
 
-	return nil
+			return nil
+		},
+	)
 }
 
 func (ctrl *DNSUpstreamController) cleanupUpstream(ctx context.Context, r controller.Runtime, touchedIDs map[resource.ID]struct{}, l *zap.Logger) {
@@ -0,0 +1,277 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/ethtool"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// Ethtool reads and changes the Ethernet settings of the links.
+type Ethtool interface {
+	Rings(link string) (ethtool.Rings, error)
+	SetRings(link string, rings ethtool.Rings) error
+	Channels(link string) (ethtool.Channels, error)
+	SetChannels(link string, channels ethtool.Channels) error
+	Offload(link string, offload ethtool.Offload) (bool, error)
+	SetOffload(link string, offload ethtool.Offload, enabled bool) error
+}
+
+// EthernetConfigController applies the Ethernet settings from the EthernetConfig documents to the links,
+// and reports the current settings as EthernetStatus resources.
+type EthernetConfigController struct {
+	// Ethtool defaults to issuing the ethtool ioctls.
+	Ethtool Ethtool
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *EthernetConfigController) Name() string {
+	return "network.EthernetConfigController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *EthernetConfigController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *EthernetConfigController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.EthernetStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *EthernetConfigController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.Ethtool == nil {
+		handle, err := ethtool.Open()
+		if err != nil {
+			return fmt.Errorf("error opening ethtool handle: %w", err)
+		}
+
+		defer handle.Close() //nolint:errcheck
+
+		ctrl.Ethtool = handle
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		if err := ctrl.reconcile(ctx, r, logger); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *EthernetConfigController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting config: %w", err)
+	}
+
+	configs := map[string]talosconfig.EthernetConfig{}
+
+	if cfg != nil {
+		for _, ethernetConfig := range cfg.Config().EthernetConfigs() {
+			configs[ethernetConfig.Link()] = ethernetConfig
+		}
+	}
+
+	links, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing links: %w", err)
+	}
+
+	r.StartTrackingOutputs()
+
+	for iter := links.Iterator(); iter.Next(); {
+		linkName := iter.Value().Metadata().ID()
+
+		ethernetConfig, configured := configs[linkName]
+
+		if !configured && !iter.Value().TypedSpec().Physical() {
+			continue
+		}
+
+		linkLogger := logger.With(zap.String("link", linkName))
+
+		if configured {
+			ctrl.apply(linkLogger, linkName, ethernetConfig)
+		}
+
+		if err = safe.WriterModify(ctx, r, network.NewEthernetStatus(network.NamespaceName, linkName), func(res *network.EthernetStatus) error {
+			*res.TypedSpec() = ctrl.status(linkName)
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating ethernet status: %w", err)
+		}
+	}
+
+	return safe.CleanupOutputs[*network.EthernetStatus](ctx, r)
+}
+
+// apply changes the settings of the link which differ from the config.
+//
+// Failures are logged and don't stop the controller, as the link driver might not support some settings.
+func (ctrl *EthernetConfigController) apply(logger *zap.Logger, linkName string, ethernetConfig talosconfig.EthernetConfig) {
+	ringsConfig := ethernetConfig.Rings()
+
+	if ringsConfig.RX.IsPresent() || ringsConfig.TX.IsPresent() {
+		if err := ctrl.applyRings(linkName, ringsConfig); err != nil {
+			logger.Warn("failed to apply ring buffer sizes", zap.Error(err))
+		}
+	}
+
+	channelsConfig := ethernetConfig.Channels()
+
+	if channelsConfig.RX.IsPresent() || channelsConfig.TX.IsPresent() || channelsConfig.Other.IsPresent() || channelsConfig.Combined.IsPresent() {
+		if err := ctrl.applyChannels(linkName, channelsConfig); err != nil {
+			logger.Warn("failed to apply channels", zap.Error(err))
+		}
+	}
+
+	offloadsConfig := ethernetConfig.Offloads()
+
+	for _, offload := range []struct {
+		offload ethtool.Offload
+		desired optional.Optional[bool]
+	}{
+		{ethtool.OffloadGRO, offloadsConfig.GRO},
+		{ethtool.OffloadGSO, offloadsConfig.GSO},
+		{ethtool.OffloadTSO, offloadsConfig.TSO},
+	} {
+		enabled, ok := offload.desired.Get()
+		if !ok {
+			continue
+		}
+
+		if err := ctrl.applyOffload(linkName, offload.offload, enabled); err != nil {
+			logger.Warn("failed to apply offload", zap.Stringer("offload", offload.offload), zap.Error(err))
+		}
+	}
+}
+
+func (ctrl *EthernetConfigController) applyRings(linkName string, ringsConfig talosconfig.EthernetRingsConfig) error {
+	current, err := ctrl.Ethtool.Rings(linkName)
+	if err != nil {
+		return fmt.Errorf("error getting ring buffer sizes: %w", err)
+	}
+
+	desired := current
+	desired.RX = ringsConfig.RX.ValueOr(current.RX)
+	desired.TX = ringsConfig.TX.ValueOr(current.TX)
+
+	if desired == current {
+		return nil
+	}
+
+	return ctrl.Ethtool.SetRings(linkName, desired)
+}
+
+func (ctrl *EthernetConfigController) applyChannels(linkName string, channelsConfig talosconfig.EthernetChannelsConfig) error {
+	current, err := ctrl.Ethtool.Channels(linkName)
+	if err != nil {
+		return fmt.Errorf("error getting channels: %w", err)
+	}
+
+	desired := current
+	desired.RX = channelsConfig.RX.ValueOr(current.RX)
+	desired.TX = channelsConfig.TX.ValueOr(current.TX)
+	desired.Other = channelsConfig.Other.ValueOr(current.Other)
+	desired.Combined = channelsConfig.Combined.ValueOr(current.Combined)
+
+	if desired == current {
+		return nil
+	}
+
+	return ctrl.Ethtool.SetChannels(linkName, desired)
+}
+
+func (ctrl *EthernetConfigController) applyOffload(linkName string, offload ethtool.Offload, enabled bool) error {
+	current, err := ctrl.Ethtool.Offload(linkName, offload)
+	if err != nil {
+		return fmt.Errorf("error getting offload: %w", err)
+	}
+
+	if current == enabled {
+		return nil
+	}
+
+	return ctrl.Ethtool.SetOffload(linkName, offload, enabled)
+}
+
+// status reads the current settings of the link, the settings not supported by the driver are left nil.
+func (ctrl *EthernetConfigController) status(linkName string) network.EthernetStatusSpec {
+	var spec network.EthernetStatusSpec
+
+	if rings, err := ctrl.Ethtool.Rings(linkName); err == nil {
+		spec.Rings = &network.EthernetRingsStatus{
+			RXMax: rings.RXMax,
+			TXMax: rings.TXMax,
+			RX:    rings.RX,
+			TX:    rings.TX,
+		}
+	}
+
+	if channels, err := ctrl.Ethtool.Channels(linkName); err == nil {
+		spec.Channels = &network.EthernetChannelsStatus{
+			RXMax:       channels.RXMax,
+			TXMax:       channels.TXMax,
+			OtherMax:    channels.OtherMax,
+			CombinedMax: channels.CombinedMax,
+			RX:          channels.RX,
+			TX:          channels.TX,
+			Other:       channels.Other,
+			Combined:    channels.Combined,
+		}
+	}
+
+	gro, groErr := ctrl.Ethtool.Offload(linkName, ethtool.OffloadGRO)
+	gso, gsoErr := ctrl.Ethtool.Offload(linkName, ethtool.OffloadGSO)
+	tso, tsoErr := ctrl.Ethtool.Offload(linkName, ethtool.OffloadTSO)
+
+	if groErr == nil && gsoErr == nil && tsoErr == nil {
+		spec.Offloads = &network.EthernetOffloadsStatus{
+			GRO: gro,
+			GSO: gso,
+			TSO: tso,
+		}
+	}
+
+	return spec
+}
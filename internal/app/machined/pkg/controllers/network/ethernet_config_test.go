@@ -0,0 +1,214 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/ethtool"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type mockEthtool struct {
+	mu sync.Mutex
+
+	rings    map[string]ethtool.Rings
+	channels map[string]ethtool.Channels
+	offloads map[string]map[ethtool.Offload]bool
+
+	setCalls int
+}
+
+func (m *mockEthtool) Rings(link string) (ethtool.Rings, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	rings, ok := m.rings[link]
+	if !ok {
+		return ethtool.Rings{}, unix.EOPNOTSUPP
+	}
+
+	return rings, nil
+}
+
+func (m *mockEthtool) SetRings(link string, rings ethtool.Rings) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.setCalls++
+	m.rings[link] = rings
+
+	return nil
+}
+
+func (m *mockEthtool) Channels(link string) (ethtool.Channels, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	channels, ok := m.channels[link]
+	if !ok {
+		return ethtool.Channels{}, unix.EOPNOTSUPP
+	}
+
+	return channels, nil
+}
+
+func (m *mockEthtool) SetChannels(link string, channels ethtool.Channels) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.setCalls++
+	m.channels[link] = channels
+
+	return nil
+}
+
+func (m *mockEthtool) Offload(link string, offload ethtool.Offload) (bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	offloads, ok := m.offloads[link]
+	if !ok {
+		return false, unix.EOPNOTSUPP
+	}
+
+	return offloads[offload], nil
+}
+
+func (m *mockEthtool) SetOffload(link string, offload ethtool.Offload, enabled bool) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.setCalls++
+	m.offloads[link][offload] = enabled
+
+	return nil
+}
+
+type EthernetConfigSuite struct {
+	ctest.DefaultSuite
+
+	ethtool *mockEthtool
+}
+
+func (suite *EthernetConfigSuite) TestReconcile() {
+	eth0 := network.NewLinkStatus(network.NamespaceName, "eth0")
+	eth0.TypedSpec().Type = nethelpers.LinkEther
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), eth0))
+
+	// not a physical link, and no config, so no status
+	bond0 := network.NewLinkStatus(network.NamespaceName, "bond0")
+	bond0.TypedSpec().Type = nethelpers.LinkEther
+	bond0.TypedSpec().Kind = "bond"
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), bond0))
+
+	ctest.AssertResource(suite, "eth0", func(status *network.EthernetStatus, asrt *assert.Assertions) {
+		spec := status.TypedSpec()
+
+		if asrt.NotNil(spec.Rings) {
+			asrt.EqualValues(4096, spec.Rings.RXMax)
+			asrt.EqualValues(256, spec.Rings.RX)
+			asrt.EqualValues(256, spec.Rings.TX)
+		}
+
+		if asrt.NotNil(spec.Channels) {
+			asrt.EqualValues(1, spec.Channels.Combined)
+		}
+
+		if asrt.NotNil(spec.Offloads) {
+			asrt.True(spec.Offloads.GRO)
+			asrt.True(spec.Offloads.TSO)
+		}
+	})
+
+	ctest.AssertNoResource[*network.EthernetStatus](suite, "bond0")
+
+	ethernetCfg := networkcfg.NewEthernetConfigV1Alpha1("eth0")
+	ethernetCfg.RingsConfig = &networkcfg.EthernetRingsConfig{
+		RingsRX: pointer.To[uint32](4096),
+	}
+	ethernetCfg.ChannelsConfig = &networkcfg.EthernetChannelsConfig{
+		ChannelsCombined: pointer.To[uint32](4),
+	}
+	ethernetCfg.OffloadsConfig = &networkcfg.EthernetOffloadsConfig{
+		OffloadGRO: pointer.To(true),
+		OffloadTSO: pointer.To(false),
+	}
+
+	cfg, err := container.New(ethernetCfg)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+
+	ctest.AssertResource(suite, "eth0", func(status *network.EthernetStatus, asrt *assert.Assertions) {
+		spec := status.TypedSpec()
+
+		if asrt.NotNil(spec.Rings) {
+			asrt.EqualValues(4096, spec.Rings.RX)
+			asrt.EqualValues(256, spec.Rings.TX)
+		}
+
+		if asrt.NotNil(spec.Channels) {
+			asrt.EqualValues(4, spec.Channels.Combined)
+		}
+
+		if asrt.NotNil(spec.Offloads) {
+			asrt.True(spec.Offloads.GRO)
+			asrt.False(spec.Offloads.TSO)
+		}
+	})
+
+	suite.ethtool.mu.Lock()
+	// rings, channels and TSO were changed, GRO was already enabled
+	suite.Assert().Equal(3, suite.ethtool.setCalls)
+	suite.ethtool.mu.Unlock()
+
+	// removing the link removes the status
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), eth0.Metadata()))
+
+	ctest.AssertNoResource[*network.EthernetStatus](suite, "eth0")
+}
+
+func TestEthernetConfigSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &EthernetConfigSuite{
+		ethtool: &mockEthtool{
+			rings: map[string]ethtool.Rings{
+				"eth0": {RXMax: 4096, TXMax: 4096, RX: 256, TX: 256},
+			},
+			channels: map[string]ethtool.Channels{
+				"eth0": {CombinedMax: 8, Combined: 1},
+			},
+			offloads: map[string]map[ethtool.Offload]bool{
+				"eth0": {ethtool.OffloadGRO: true, ethtool.OffloadGSO: true, ethtool.OffloadTSO: true},
+			},
+		},
+	}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.EthernetConfigController{
+				Ethtool: s.ethtool,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
@@ -91,6 +91,8 @@ func (ctrl *HostDNSConfigController) Run(ctx context.Context, r controller.Runti
 			}
 
 			res.TypedSpec().ServiceHostDNSAddress = netip.Addr{}
+			res.TypedSpec().Upstreams = nil
+			res.TypedSpec().StubDomains = nil
 
 			if cfgProvider == nil {
 				res.TypedSpec().Enabled = false
@@ -100,6 +102,14 @@ func (ctrl *HostDNSConfigController) Run(ctx context.Context, r controller.Runti
 
 			res.TypedSpec().Enabled = cfgProvider.Machine().Features().HostDNS().Enabled()
 			res.TypedSpec().ResolveMemberNames = cfgProvider.Machine().Features().HostDNS().ResolveMemberNames()
+			res.TypedSpec().Upstreams = parseHostDNSServers(logger, cfgProvider.Machine().Features().HostDNS().Upstreams())
+
+			for _, stub := range cfgProvider.Machine().Features().HostDNS().StubDomains() {
+				res.TypedSpec().StubDomains = append(res.TypedSpec().StubDomains, network.HostDNSStubDomain{
+					Domain:      stub.Domain(),
+					Nameservers: parseHostDNSServers(logger, stub.Nameservers()),
+				})
+			}
 
 			if cfgProvider.Machine().Features().HostDNS().ForwardKubeDNSToHost() {
 				newServiceAddr = netip.MustParseAddr(constants.HostDNSAddress)
@@ -141,6 +151,23 @@ func (ctrl *HostDNSConfigController) Run(ctx context.Context, r controller.Runti
 	}
 }
 
+func parseHostDNSServers(logger *zap.Logger, servers []string) []netip.Addr {
+	var result []netip.Addr
+
+	for _, server := range servers {
+		addr, err := netip.ParseAddr(server)
+		if err != nil {
+			logger.Warn("failed to parse host DNS server", zap.String("server", server), zap.Error(err))
+
+			continue
+		}
+
+		result = append(result, addr)
+	}
+
+	return result
+}
+
 func (ctrl *HostDNSConfigController) cleanupAddressSpecs(ctx context.Context, r controller.Runtime, checkResource func(id resource.ID) bool, logger *zap.Logger) error {
 	list, err := safe.ReaderList[*network.AddressSpec](ctx, r, network.NewAddressSpec(network.ConfigNamespaceName, "").Metadata())
 	if err != nil {
@@ -7,6 +7,7 @@ package network
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"strings"
 
@@ -20,8 +21,10 @@ import (
 	"go.uber.org/zap"
 
 	talosconfig "github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
@@ -56,6 +59,18 @@ func (ctrl *HostnameConfigController) Inputs() []controller.Input {
 			ID:        optional.Some(cluster.LocalIdentity),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.HardwareAddrType,
+			ID:        optional.Some(network.FirstHardwareAddr),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: hardware.NamespaceName,
+			Type:      hardware.SystemInformationType,
+			ID:        optional.Some(hardware.SystemInformationID),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -117,6 +132,13 @@ func (ctrl *HostnameConfigController) Run(ctx context.Context, r controller.Runt
 		if cfgProvider != nil {
 			configHostname := ctrl.parseMachineConfiguration(logger, cfgProvider)
 
+			if configHostname.Hostname == "" && cfgProvider.Machine().Network().HostnameTemplate() != "" {
+				configHostname, err = ctrl.renderHostnameTemplate(ctx, r, logger, cfgProvider, defaultAddr)
+				if err != nil {
+					return err
+				}
+			}
+
 			if configHostname.Hostname != "" {
 				specs = append(specs, configHostname)
 			}
@@ -201,6 +223,76 @@ func (ctrl *HostnameConfigController) apply(ctx context.Context, r controller.Ru
 	return ids, nil
 }
 
+// renderHostnameTemplate renders the hostname template over the node facts.
+//
+// The hostname is not set until all the facts used in the template are available.
+func (ctrl *HostnameConfigController) renderHostnameTemplate(
+	ctx context.Context,
+	r controller.Reader,
+	logger *zap.Logger,
+	cfgProvider talosconfig.Config,
+	defaultAddr *network.NodeAddress,
+) (network.HostnameSpecSpec, error) {
+	tmpl, err := nethelpers.ParseHostnameTemplate(cfgProvider.Machine().Network().HostnameTemplate())
+	if err != nil {
+		logger.Warn("ignoring invalid hostname template", zap.Error(err))
+
+		return network.HostnameSpecSpec{}, nil
+	}
+
+	facts := map[string]string{
+		"MachineType": cfgProvider.Machine().Type().String(),
+	}
+
+	if defaultAddr != nil && len(defaultAddr.TypedSpec().Addresses) == 1 {
+		facts["IP"] = strings.NewReplacer(".", "-", ":", "-").Replace(defaultAddr.TypedSpec().Addresses[0].Addr().String())
+	}
+
+	hwAddr, err := safe.ReaderGetByID[*network.HardwareAddr](ctx, r, network.FirstHardwareAddr)
+	if err != nil && !state.IsNotFoundError(err) {
+		return network.HostnameSpecSpec{}, fmt.Errorf("error getting hardware address: %w", err)
+	}
+
+	if hwAddr != nil {
+		facts["MAC"] = hex.EncodeToString(hwAddr.TypedSpec().HardwareAddr)
+	}
+
+	systemInfo, err := safe.ReaderGetByID[*hardware.SystemInformation](ctx, r, hardware.SystemInformationID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return network.HostnameSpecSpec{}, fmt.Errorf("error getting system information: %w", err)
+	}
+
+	if systemInfo != nil {
+		if systemInfo.TypedSpec().UUID != "" {
+			facts["UUID"] = strings.ToLower(systemInfo.TypedSpec().UUID)
+		}
+
+		if systemInfo.TypedSpec().SerialNumber != "" {
+			facts["Serial"] = systemInfo.TypedSpec().SerialNumber
+		}
+	}
+
+	var buf strings.Builder
+
+	if err = tmpl.Execute(&buf, facts); err != nil {
+		logger.Debug("hostname template facts are not available yet", zap.Error(err))
+
+		return network.HostnameSpecSpec{}, nil
+	}
+
+	var spec network.HostnameSpecSpec
+
+	if err = spec.ParseFQDN(buf.String()); err != nil {
+		logger.Warn("ignoring invalid hostname rendered from the template", zap.String("hostname", buf.String()), zap.Error(err))
+
+		return network.HostnameSpecSpec{}, nil
+	}
+
+	spec.ConfigLayer = network.ConfigMachineConfiguration
+
+	return spec, nil
+}
+
 func (ctrl *HostnameConfigController) getStableDefault(nodeID string) *network.HostnameSpecSpec {
 	hashBytes := sha256.Sum256([]byte(nodeID))
 	b36 := strings.ToLower(base36.EncodeBytes(hashBytes[:8]))
@@ -30,8 +30,10 @@ import (
 	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
 	"github.com/siderolabs/talos/pkg/machinery/resources/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 )
 
@@ -245,6 +247,66 @@ func (suite *HostnameConfigSuite) TestMachineConfiguration() {
 	)
 }
 
+func (suite *HostnameConfigSuite) TestMachineConfigurationTemplate() {
+	suite.Require().NoError(suite.runtime.RegisterController(&netctrl.HostnameConfigController{}))
+
+	suite.startRuntime()
+
+	u, err := url.Parse("https://foo:6443")
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(
+		container.NewV1Alpha1(
+			&v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkHostnameTemplate: "{{ .MachineType }}-{{ .MAC }}.{{ .UUID | lower }}.example.com",
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							URL: u,
+						},
+					},
+				},
+			},
+		),
+	)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, cfg))
+
+	hwAddr := network.NewHardwareAddr(network.NamespaceName, network.FirstHardwareAddr)
+	hwAddr.TypedSpec().Name = "eth0"
+	hwAddr.TypedSpec().HardwareAddr = nethelpers.HardwareAddr{0x0a, 0x1b, 0x2c, 0x3d, 0x4e, 0x5f}
+	suite.Require().NoError(suite.state.Create(suite.ctx, hwAddr))
+
+	// the hostname is not set until all the facts are available
+	suite.Assert().NoError(
+		retry.Constant(time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			func() error {
+				return suite.assertNoHostname("configuration/hostname")
+			},
+		),
+	)
+
+	systemInfo := hardware.NewSystemInformation(hardware.SystemInformationID)
+	systemInfo.TypedSpec().UUID = "4C4C4544-0039-4B10"
+	suite.Require().NoError(suite.state.Create(suite.ctx, systemInfo))
+
+	suite.assertHostnames(
+		[]string{
+			"configuration/hostname",
+		}, func(r *network.HostnameSpec, asrt *assert.Assertions) {
+			asrt.Equal("worker-0a1b2c3d4e5f", r.TypedSpec().Hostname)
+			asrt.Equal("4c4c4544-0039-4b10.example.com", r.TypedSpec().Domainname)
+			asrt.Equal(network.ConfigMachineConfiguration, r.TypedSpec().ConfigLayer)
+		},
+	)
+}
+
 func (suite *HostnameConfigSuite) TearDownTest() {
 	suite.T().Log("tear down")
 
@@ -0,0 +1,559 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package bgp
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net"
+	"net/netip"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+)
+
+// BFD session states, see RFC 5880.
+const (
+	bfdStateAdminDown = 0
+	bfdStateDown      = 1
+	bfdStateInit      = 2
+	bfdStateUp        = 3
+)
+
+// BFD diagnostic codes.
+const (
+	bfdDiagNone             = 0
+	bfdDiagDetectionExpired = 1
+	bfdDiagNeighborDown     = 3
+)
+
+const (
+	bfdVersion   = 1
+	bfdPacketLen = 24
+
+	bfdFlagPoll  = 0x20
+	bfdFlagFinal = 0x10
+
+	// single-hop BFD control port, see RFC 5881.
+	bfdPort    = 3784
+	bfdMinPort = 49152
+	bfdTTL     = 255
+
+	bfdDetectMult = 3
+	// transmit interval while the session is not up, RFC 5880 requires at least one second.
+	bfdSlowTxInterval = time.Second
+	bfdTxInterval     = 300 * time.Millisecond
+	bfdRxInterval     = 300 * time.Millisecond
+)
+
+// BFD states as reported in the status.
+const (
+	BFDStateDown = "Down"
+	BFDStateInit = "Init"
+	BFDStateUp   = "Up"
+)
+
+// bfdPacket is the BFD control packet (without authentication).
+type bfdPacket struct {
+	Diag          byte
+	State         byte
+	Flags         byte
+	DetectMult    byte
+	MyDiscr       uint32
+	YourDiscr     uint32
+	DesiredMinTx  time.Duration
+	RequiredMinRx time.Duration
+}
+
+func (p *bfdPacket) marshal() []byte {
+	b := []byte{bfdVersion<<5 | p.Diag, p.State<<6 | p.Flags, p.DetectMult, bfdPacketLen}
+	b = binary.BigEndian.AppendUint32(b, p.MyDiscr)
+	b = binary.BigEndian.AppendUint32(b, p.YourDiscr)
+	b = binary.BigEndian.AppendUint32(b, uint32(p.DesiredMinTx.Microseconds()))
+	b = binary.BigEndian.AppendUint32(b, uint32(p.RequiredMinRx.Microseconds()))
+
+	// required min echo RX interval, echo mode is not supported
+	return binary.BigEndian.AppendUint32(b, 0)
+}
+
+func parseBFDPacket(b []byte) (bfdPacket, error) {
+	var p bfdPacket
+
+	if len(b) < bfdPacketLen || int(b[3]) < bfdPacketLen || int(b[3]) > len(b) {
+		return p, errors.New("truncated BFD packet")
+	}
+
+	if b[0]>>5 != bfdVersion {
+		return p, fmt.Errorf("unsupported BFD version %d", b[0]>>5)
+	}
+
+	p.Diag = b[0] & 0x1f
+	p.State = b[1] >> 6
+	p.Flags = b[1] & 0x3f
+	p.DetectMult = b[2]
+	p.MyDiscr = binary.BigEndian.Uint32(b[4:])
+	p.YourDiscr = binary.BigEndian.Uint32(b[8:])
+	p.DesiredMinTx = time.Duration(binary.BigEndian.Uint32(b[12:])) * time.Microsecond
+	p.RequiredMinRx = time.Duration(binary.BigEndian.Uint32(b[16:])) * time.Microsecond
+
+	if p.DetectMult == 0 || p.MyDiscr == 0 {
+		return p, errors.New("invalid BFD packet")
+	}
+
+	return p, nil
+}
+
+// bfdSession is a single-hop asynchronous BFD session with a peer.
+type bfdSession struct {
+	peer netip.Addr
+	conn *net.UDPConn
+
+	// changeCh is notified on every state change.
+	changeCh chan struct{}
+	// sendCh triggers sending a packet out of the schedule.
+	sendCh chan struct{}
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu sync.Mutex
+
+	state       byte
+	diag        byte
+	localDiscr  uint32
+	desiredTx   time.Duration
+	pollActive  bool
+	finalNeeded bool
+
+	remoteDiscr      uint32
+	remoteState      byte
+	remoteDesiredTx  time.Duration
+	remoteMinRx      time.Duration
+	remoteDetectMult byte
+	lastRx           time.Time
+}
+
+func newBFDSession(peer netip.Addr) *bfdSession {
+	return &bfdSession{
+		peer:       peer,
+		changeCh:   make(chan struct{}, 1),
+		sendCh:     make(chan struct{}, 1),
+		state:      bfdStateDown,
+		localDiscr: rand.Uint32() | 1,
+		desiredTx:  bfdSlowTxInterval,
+		// until the first packet is received, assume 1 second
+		remoteMinRx: time.Second,
+	}
+}
+
+// startBFD starts a BFD session with a peer.
+func startBFD(ctx context.Context, peer netip.Addr, logger *zap.Logger) (*bfdSession, error) {
+	s := newBFDSession(peer)
+
+	conn, err := dialBFD(peer)
+	if err != nil {
+		return nil, err
+	}
+
+	s.conn = conn
+
+	if err = bfdReceivers.register(s, logger); err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, err
+	}
+
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	s.wg.Add(1)
+
+	go func() {
+		defer s.wg.Done()
+
+		s.run(ctx, logger)
+	}()
+
+	return s, nil
+}
+
+// dialBFD opens the socket to send the control packets from a port in the range required by RFC 5881.
+func dialBFD(peer netip.Addr) (*net.UDPConn, error) {
+	var lastErr error
+
+	for range 10 {
+		port := bfdMinPort + rand.IntN(65536-bfdMinPort)
+
+		conn, err := net.DialUDP("udp", &net.UDPAddr{Port: port}, net.UDPAddrFromAddrPort(netip.AddrPortFrom(peer, bfdPort)))
+		if err != nil {
+			lastErr = err
+
+			continue
+		}
+
+		if peer.Is4() {
+			err = ipv4.NewConn(conn).SetTTL(bfdTTL)
+		} else {
+			err = ipv6.NewConn(conn).SetHopLimit(bfdTTL)
+		}
+
+		if err != nil {
+			conn.Close() //nolint:errcheck
+
+			return nil, fmt.Errorf("error setting BFD TTL: %w", err)
+		}
+
+		return conn, nil
+	}
+
+	return nil, fmt.Errorf("error opening BFD socket: %w", lastErr)
+}
+
+// Stop the session.
+func (s *bfdSession) Stop() {
+	bfdReceivers.unregister(s)
+
+	s.cancel()
+	s.conn.Close() //nolint:errcheck
+
+	s.wg.Wait()
+}
+
+// State returns the current state of the session.
+func (s *bfdSession) State() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return bfdStateString(s.state)
+}
+
+func bfdStateString(state byte) string {
+	switch state {
+	case bfdStateUp:
+		return BFDStateUp
+	case bfdStateInit:
+		return BFDStateInit
+	default:
+		return BFDStateDown
+	}
+}
+
+func (s *bfdSession) run(ctx context.Context, logger *zap.Logger) {
+	logger = logger.With(zap.Stringer("peer", s.peer))
+
+	txTimer := time.NewTimer(0)
+	defer txTimer.Stop()
+
+	detectTicker := time.NewTicker(bfdRxInterval / 4)
+	defer detectTicker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-txTimer.C:
+			txTimer.Reset(s.txInterval())
+		case <-s.sendCh:
+		case now := <-detectTicker.C:
+			s.checkDetectionTime(now)
+
+			continue
+		}
+
+		if _, err := s.conn.Write(s.packet()); err != nil {
+			logger.Debug("error sending BFD packet", zap.Error(err))
+		}
+	}
+}
+
+// txInterval returns the interval to the next packet with the jitter applied, see RFC 5880 6.8.7.
+func (s *bfdSession) txInterval() time.Duration {
+	s.mu.Lock()
+	interval := max(s.desiredTx, s.remoteMinRx)
+	s.mu.Unlock()
+
+	return interval * time.Duration(75+rand.IntN(26)) / 100
+}
+
+func (s *bfdSession) packet() []byte {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	p := bfdPacket{
+		Diag:          s.diag,
+		State:         s.state,
+		DetectMult:    bfdDetectMult,
+		MyDiscr:       s.localDiscr,
+		YourDiscr:     s.remoteDiscr,
+		DesiredMinTx:  s.desiredTx,
+		RequiredMinRx: bfdRxInterval,
+	}
+
+	switch {
+	case s.finalNeeded:
+		p.Flags = bfdFlagFinal
+		s.finalNeeded = false
+	case s.pollActive:
+		p.Flags = bfdFlagPoll
+	}
+
+	return p.marshal()
+}
+
+// receive processes a control packet from the peer, see RFC 5880 6.8.6.
+func (s *bfdSession) receive(p bfdPacket, now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if p.YourDiscr == 0 && s.state != bfdStateDown && s.state != bfdStateAdminDown {
+		return
+	}
+
+	if p.YourDiscr != 0 && p.YourDiscr != s.localDiscr {
+		return
+	}
+
+	s.remoteDiscr = p.MyDiscr
+	s.remoteState = p.State
+	s.remoteDesiredTx = p.DesiredMinTx
+	s.remoteMinRx = p.RequiredMinRx
+	s.remoteDetectMult = p.DetectMult
+	s.lastRx = now
+
+	if p.Flags&bfdFlagFinal != 0 {
+		s.pollActive = false
+	}
+
+	if p.Flags&bfdFlagPoll != 0 {
+		s.finalNeeded = true
+
+		trigger(s.sendCh)
+	}
+
+	switch {
+	case p.State == bfdStateAdminDown:
+		if s.state != bfdStateDown {
+			s.setState(bfdStateDown, bfdDiagNeighborDown)
+		}
+	case s.state == bfdStateDown:
+		switch p.State {
+		case bfdStateDown:
+			s.setState(bfdStateInit, bfdDiagNone)
+		case bfdStateInit:
+			s.setState(bfdStateUp, bfdDiagNone)
+		}
+	case s.state == bfdStateInit:
+		if p.State == bfdStateInit || p.State == bfdStateUp {
+			s.setState(bfdStateUp, bfdDiagNone)
+		}
+	case s.state == bfdStateUp:
+		if p.State == bfdStateDown {
+			s.setState(bfdStateDown, bfdDiagNeighborDown)
+		}
+	}
+}
+
+// checkDetectionTime brings the session down if no packets were received within the detection time.
+func (s *bfdSession) checkDetectionTime(now time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.remoteDetectMult == 0 {
+		return
+	}
+
+	detectionTime := time.Duration(s.remoteDetectMult) * max(bfdRxInterval, s.remoteDesiredTx)
+
+	if now.Sub(s.lastRx) <= detectionTime {
+		return
+	}
+
+	if s.state == bfdStateInit || s.state == bfdStateUp {
+		s.setState(bfdStateDown, bfdDiagDetectionExpired)
+	}
+
+	// the peer is gone, start over
+	s.remoteDiscr = 0
+	s.remoteDetectMult = 0
+	s.remoteMinRx = time.Second
+}
+
+// setState changes the state, s.mu should be held.
+func (s *bfdSession) setState(state, diag byte) {
+	s.state = state
+	s.diag = diag
+
+	if state == bfdStateUp {
+		// switch to the fast transmit interval with a poll sequence
+		s.desiredTx = bfdTxInterval
+		s.pollActive = true
+	} else {
+		s.desiredTx = bfdSlowTxInterval
+		s.pollActive = false
+	}
+
+	trigger(s.changeCh)
+	trigger(s.sendCh)
+}
+
+// bfdReceivers dispatches the received control packets to the sessions.
+//
+// All sessions share the BFD control port, so the packets are received on a single socket per address family.
+var bfdReceivers = &bfdDemux{
+	sessions: map[netip.Addr]*bfdSession{},
+	conns:    map[bool]*net.UDPConn{},
+}
+
+type bfdDemux struct {
+	mu       sync.Mutex
+	sessions map[netip.Addr]*bfdSession
+	// conns are keyed by the IPv6 flag.
+	conns map[bool]*net.UDPConn
+}
+
+func (d *bfdDemux) register(s *bfdSession, logger *zap.Logger) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if _, exists := d.sessions[s.peer]; exists {
+		return fmt.Errorf("BFD session with %s already exists", s.peer)
+	}
+
+	is6 := s.peer.Is6()
+
+	if d.conns[is6] == nil {
+		conn, err := d.listen(is6, logger)
+		if err != nil {
+			return err
+		}
+
+		d.conns[is6] = conn
+	}
+
+	d.sessions[s.peer] = s
+
+	return nil
+}
+
+func (d *bfdDemux) unregister(s *bfdSession) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.sessions[s.peer] != s {
+		return
+	}
+
+	delete(d.sessions, s.peer)
+
+	is6 := s.peer.Is6()
+
+	for peer := range d.sessions {
+		if peer.Is6() == is6 {
+			return
+		}
+	}
+
+	d.conns[is6].Close() //nolint:errcheck
+	delete(d.conns, is6)
+}
+
+func (d *bfdDemux) lookup(peer netip.Addr) *bfdSession {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	return d.sessions[peer]
+}
+
+func (d *bfdDemux) listen(is6 bool, logger *zap.Logger) (*net.UDPConn, error) {
+	network, address := "udp4", "0.0.0.0"
+	if is6 {
+		network, address = "udp6", "::"
+	}
+
+	conn, err := net.ListenUDP(network, net.UDPAddrFromAddrPort(netip.AddrPortFrom(netip.MustParseAddr(address), bfdPort)))
+	if err != nil {
+		return nil, fmt.Errorf("error listening for BFD packets: %w", err)
+	}
+
+	// only the packets with the maximum TTL are accepted (GTSM), so the TTL is required
+	var readTTL func([]byte) (int, int, net.Addr, error)
+
+	if is6 {
+		pc := ipv6.NewPacketConn(conn)
+		err = pc.SetControlMessage(ipv6.FlagHopLimit, true)
+
+		readTTL = func(b []byte) (int, int, net.Addr, error) {
+			n, cm, src, err := pc.ReadFrom(b)
+			if cm == nil {
+				return n, 0, src, err
+			}
+
+			return n, cm.HopLimit, src, err
+		}
+	} else {
+		pc := ipv4.NewPacketConn(conn)
+		err = pc.SetControlMessage(ipv4.FlagTTL, true)
+
+		readTTL = func(b []byte) (int, int, net.Addr, error) {
+			n, cm, src, err := pc.ReadFrom(b)
+			if cm == nil {
+				return n, 0, src, err
+			}
+
+			return n, cm.TTL, src, err
+		}
+	}
+
+	if err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("error enabling TTL reception: %w", err)
+	}
+
+	go func() {
+		buf := make([]byte, 512)
+
+		for {
+			n, ttl, src, err := readTTL(buf)
+			if err != nil {
+				if !errors.Is(err, net.ErrClosed) {
+					logger.Warn("error receiving BFD packet", zap.Error(err))
+				}
+
+				return
+			}
+
+			if ttl != bfdTTL {
+				continue
+			}
+
+			udpAddr, ok := src.(*net.UDPAddr)
+			if !ok {
+				continue
+			}
+
+			s := d.lookup(udpAddr.AddrPort().Addr().Unmap())
+			if s == nil {
+				continue
+			}
+
+			p, err := parseBFDPacket(buf[:n])
+			if err != nil {
+				logger.Debug("ignoring invalid BFD packet", zap.Stringer("peer", src), zap.Error(err))
+
+				continue
+			}
+
+			s.receive(p, time.Now())
+		}
+	}()
+
+	return conn, nil
+}
@@ -0,0 +1,111 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package bgp_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/bgp"
+)
+
+func TestBFDPacket(t *testing.T) {
+	t.Parallel()
+
+	p := bgp.BFDPacket{
+		Diag:          1,
+		State:         3,
+		Flags:         0x20,
+		DetectMult:    3,
+		MyDiscr:       0x01020304,
+		YourDiscr:     0x05060708,
+		DesiredMinTx:  300 * time.Millisecond,
+		RequiredMinRx: time.Second,
+	}
+
+	b := p.Marshal()
+
+	assert.Equal(t, []byte{
+		0x21, 0xe0, 3, 24,
+		1, 2, 3, 4,
+		5, 6, 7, 8,
+		0x00, 0x04, 0x93, 0xe0,
+		0x00, 0x0f, 0x42, 0x40,
+		0, 0, 0, 0,
+	}, b)
+
+	parsed, err := bgp.ParseBFDPacket(b)
+	require.NoError(t, err)
+
+	assert.Equal(t, p, parsed)
+
+	_, err = bgp.ParseBFDPacket(b[:20])
+	assert.EqualError(t, err, "truncated BFD packet")
+}
+
+//nolint:gocyclo
+func TestBFDSession(t *testing.T) {
+	t.Parallel()
+
+	s := bgp.NewBFDSession(netip.MustParseAddr("10.0.0.1"))
+	now := time.Now()
+
+	parse := func() bgp.BFDPacket {
+		p, err := bgp.ParseBFDPacket(s.Packet())
+		require.NoError(t, err)
+
+		return p
+	}
+
+	p := parse()
+	assert.EqualValues(t, 1, p.State) // Down
+	assert.EqualValues(t, 0, p.YourDiscr)
+	assert.Equal(t, time.Second, p.DesiredMinTx)
+
+	// the peer is down as well
+	s.Receive(bgp.BFDPacket{State: 1, DetectMult: 3, MyDiscr: 42, DesiredMinTx: time.Second, RequiredMinRx: time.Second}, now)
+	assert.Equal(t, bgp.BFDStateInit, s.State())
+
+	p = parse()
+	assert.EqualValues(t, 2, p.State)
+	assert.EqualValues(t, 42, p.YourDiscr)
+
+	// packets for another session are ignored
+	s.Receive(bgp.BFDPacket{State: 3, DetectMult: 3, MyDiscr: 42, YourDiscr: s.LocalDiscr() + 1}, now)
+	assert.Equal(t, bgp.BFDStateInit, s.State())
+
+	s.Receive(bgp.BFDPacket{State: 3, DetectMult: 3, MyDiscr: 42, YourDiscr: s.LocalDiscr(), DesiredMinTx: time.Second, RequiredMinRx: time.Second}, now)
+	assert.Equal(t, bgp.BFDStateUp, s.State())
+
+	// the faster interval is announced with a poll sequence
+	p = parse()
+	assert.EqualValues(t, 3, p.State)
+	assert.Equal(t, 300*time.Millisecond, p.DesiredMinTx)
+	assert.EqualValues(t, 0x20, p.Flags)
+
+	s.Receive(bgp.BFDPacket{State: 3, Flags: 0x10, DetectMult: 3, MyDiscr: 42, YourDiscr: s.LocalDiscr(), DesiredMinTx: 300 * time.Millisecond, RequiredMinRx: 300 * time.Millisecond}, now)
+	assert.EqualValues(t, 0, parse().Flags)
+
+	// poll from the peer is answered with the final flag
+	s.Receive(bgp.BFDPacket{State: 3, Flags: 0x20, DetectMult: 3, MyDiscr: 42, YourDiscr: s.LocalDiscr(), DesiredMinTx: 300 * time.Millisecond, RequiredMinRx: 300 * time.Millisecond}, now)
+	assert.EqualValues(t, 0x10, parse().Flags)
+	assert.EqualValues(t, 0, parse().Flags)
+
+	// detection time is 3 * 300ms
+	s.CheckDetectionTime(now.Add(800 * time.Millisecond))
+	assert.Equal(t, bgp.BFDStateUp, s.State())
+
+	s.CheckDetectionTime(now.Add(time.Second))
+	assert.Equal(t, bgp.BFDStateDown, s.State())
+
+	p = parse()
+	assert.EqualValues(t, 1, p.Diag) // control detection time expired
+	assert.EqualValues(t, 0, p.YourDiscr)
+	assert.Equal(t, time.Second, p.DesiredMinTx)
+}
@@ -0,0 +1,55 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package bgp
+
+import (
+	"net/netip"
+	"time"
+)
+
+// EncodeMessage is exported for testing.
+var EncodeMessage = encodeMessage
+
+// ReadMessage is exported for testing.
+var ReadMessage = readMessage
+
+// BFDPacket is exported for testing.
+type BFDPacket = bfdPacket
+
+// ParseBFDPacket is exported for testing.
+var ParseBFDPacket = parseBFDPacket
+
+// Marshal is exported for testing.
+func (p *bfdPacket) Marshal() []byte {
+	return p.marshal()
+}
+
+// BFDSession is exported for testing.
+type BFDSession = bfdSession
+
+// NewBFDSession is exported for testing.
+func NewBFDSession(peer netip.Addr) *BFDSession {
+	return newBFDSession(peer)
+}
+
+// LocalDiscr is exported for testing.
+func (s *bfdSession) LocalDiscr() uint32 {
+	return s.localDiscr
+}
+
+// Receive is exported for testing.
+func (s *bfdSession) Receive(p BFDPacket, now time.Time) {
+	s.receive(p, now)
+}
+
+// CheckDetectionTime is exported for testing.
+func (s *bfdSession) CheckDetectionTime(now time.Time) {
+	s.checkDetectionTime(now)
+}
+
+// Packet is exported for testing.
+func (s *bfdSession) Packet() []byte {
+	return s.packet()
+}
@@ -0,0 +1,372 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package bgp
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+)
+
+// Message types, see RFC 4271.
+const (
+	msgOpen         = 1
+	msgUpdate       = 2
+	msgNotification = 3
+	msgKeepalive    = 4
+)
+
+const (
+	headerLen     = 19
+	maxMessageLen = 4096
+
+	bgpVersion = 4
+
+	// asTrans is the 2-octet AS number placeholder for the 4-octet AS numbers, see RFC 6793.
+	asTrans = 23456
+)
+
+// OPEN optional parameters and capabilities.
+const (
+	paramCapabilities = 2
+
+	capMultiprotocol = 1
+	capFourOctetAS   = 65
+)
+
+// Address families.
+const (
+	afiIPv4 = 1
+	afiIPv6 = 2
+
+	safiUnicast = 1
+)
+
+// Path attributes.
+const (
+	attrFlagOptional       = 0x80
+	attrFlagTransitive     = 0x40
+	attrFlagExtendedLength = 0x10
+
+	attrOrigin        = 1
+	attrASPath        = 2
+	attrNextHop       = 3
+	attrLocalPref     = 5
+	attrMPReachNLRI   = 14
+	attrMPUnreachNLRI = 15
+
+	originIGP        = 0
+	asPathSequence   = 2
+	defaultLocalPref = 100
+)
+
+// NOTIFICATION error codes and subcodes.
+const (
+	errMessageHeader    = 1
+	errOpenMessage      = 2
+	errUpdateMessage    = 3
+	errHoldTimerExpired = 4
+	errFSM              = 5
+	errCease            = 6
+
+	errOpenUnsupportedVersion = 1
+	errOpenBadPeerAS          = 2
+	errOpenUnacceptableHold   = 6
+
+	errCeaseAdminShutdown = 2
+	errCeaseAdminReset    = 4
+)
+
+// marker is the all-ones header marker.
+var marker = bytes.Repeat([]byte{0xff}, 16)
+
+// encodeMessage adds the header to the message body.
+func encodeMessage(typ byte, body []byte) []byte {
+	b := make([]byte, headerLen, headerLen+len(body))
+
+	copy(b, marker)
+	binary.BigEndian.PutUint16(b[16:], uint16(headerLen+len(body)))
+	b[18] = typ
+
+	return append(b, body...)
+}
+
+// readMessage reads a single message, and returns its type and body.
+func readMessage(r io.Reader) (byte, []byte, error) {
+	header := make([]byte, headerLen)
+
+	if _, err := io.ReadFull(r, header); err != nil {
+		return 0, nil, err
+	}
+
+	if !bytes.Equal(header[:16], marker) {
+		return 0, nil, &Notification{Code: errMessageHeader, Subcode: 1}
+	}
+
+	length := int(binary.BigEndian.Uint16(header[16:]))
+	if length < headerLen || length > maxMessageLen {
+		return 0, nil, &Notification{Code: errMessageHeader, Subcode: 2}
+	}
+
+	body := make([]byte, length-headerLen)
+
+	if _, err := io.ReadFull(r, body); err != nil {
+		return 0, nil, err
+	}
+
+	return header[18], body, nil
+}
+
+// Open is the BGP OPEN message.
+type Open struct {
+	ASN         uint32
+	HoldTime    uint16
+	RouterID    netip.Addr
+	FourOctetAS bool
+	// AFIs advertised with the multiprotocol capability (unicast SAFI).
+	AFIs []uint16
+}
+
+// Marshal encodes the message body.
+func (o *Open) Marshal() []byte {
+	var caps []byte
+
+	for _, afi := range o.AFIs {
+		caps = append(caps, capMultiprotocol, 4, byte(afi>>8), byte(afi), 0, safiUnicast)
+	}
+
+	if o.FourOctetAS {
+		caps = append(caps, capFourOctetAS, 4)
+		caps = binary.BigEndian.AppendUint32(caps, o.ASN)
+	}
+
+	asn := uint16(asTrans)
+	if o.ASN <= 0xffff {
+		asn = uint16(o.ASN)
+	}
+
+	b := []byte{bgpVersion}
+	b = binary.BigEndian.AppendUint16(b, asn)
+	b = binary.BigEndian.AppendUint16(b, o.HoldTime)
+	b = append(b, o.RouterID.AsSlice()...)
+
+	if len(caps) == 0 {
+		return append(b, 0)
+	}
+
+	b = append(b, byte(len(caps)+2), paramCapabilities, byte(len(caps)))
+
+	return append(b, caps...)
+}
+
+// ParseOpen decodes the OPEN message body.
+//
+//nolint:gocyclo
+func ParseOpen(b []byte) (Open, error) {
+	var o Open
+
+	if len(b) < 10 {
+		return o, &Notification{Code: errMessageHeader, Subcode: 2}
+	}
+
+	if b[0] != bgpVersion {
+		return o, &Notification{Code: errOpenMessage, Subcode: errOpenUnsupportedVersion, Data: []byte{0, bgpVersion}}
+	}
+
+	o.ASN = uint32(binary.BigEndian.Uint16(b[1:]))
+	o.HoldTime = binary.BigEndian.Uint16(b[3:])
+	o.RouterID = netip.AddrFrom4([4]byte(b[5:9]))
+
+	params := b[10:]
+	if len(params) != int(b[9]) {
+		return o, &Notification{Code: errOpenMessage}
+	}
+
+	for len(params) > 0 {
+		if len(params) < 2 || len(params) < 2+int(params[1]) {
+			return o, &Notification{Code: errOpenMessage}
+		}
+
+		typ, value := params[0], params[2:2+int(params[1])]
+		params = params[2+int(params[1]):]
+
+		if typ != paramCapabilities {
+			continue
+		}
+
+		for len(value) > 0 {
+			if len(value) < 2 || len(value) < 2+int(value[1]) {
+				return o, &Notification{Code: errOpenMessage}
+			}
+
+			code, capValue := value[0], value[2:2+int(value[1])]
+			value = value[2+int(value[1]):]
+
+			switch {
+			case code == capMultiprotocol && len(capValue) == 4 && capValue[3] == safiUnicast:
+				o.AFIs = append(o.AFIs, binary.BigEndian.Uint16(capValue))
+			case code == capFourOctetAS && len(capValue) == 4:
+				o.FourOctetAS = true
+				o.ASN = binary.BigEndian.Uint32(capValue)
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Update is the BGP UPDATE message for a single address family.
+type Update struct {
+	Announced []netip.Prefix
+	Withdrawn []netip.Prefix
+
+	// NextHop is required if any prefixes are announced, it defines the address family.
+	NextHop netip.Addr
+	// ASPath is empty for the internal peers.
+	ASPath      []uint32
+	FourOctetAS bool
+	// LocalPref is sent to the internal peers.
+	LocalPref uint32
+}
+
+// Marshal encodes the message body.
+//
+// IPv4 prefixes are encoded in the message fields, IPv6 prefixes in the multiprotocol attributes (RFC 4760).
+func (u *Update) Marshal() []byte {
+	var (
+		withdrawn, attrs, nlri   []byte
+		v4Withdrawn, v6Withdrawn []netip.Prefix
+	)
+
+	for _, prefix := range u.Withdrawn {
+		if prefix.Addr().Is4() {
+			v4Withdrawn = append(v4Withdrawn, prefix)
+		} else {
+			v6Withdrawn = append(v6Withdrawn, prefix)
+		}
+	}
+
+	withdrawn = appendPrefixes(withdrawn, v4Withdrawn)
+
+	if len(v6Withdrawn) > 0 {
+		value := []byte{0, afiIPv6, safiUnicast}
+		value = appendPrefixes(value, v6Withdrawn)
+
+		attrs = appendAttr(attrs, attrFlagOptional, attrMPUnreachNLRI, value)
+	}
+
+	if len(u.Announced) > 0 {
+		attrs = appendAttr(attrs, attrFlagTransitive, attrOrigin, []byte{originIGP})
+
+		var asPath []byte
+
+		if len(u.ASPath) > 0 {
+			asPath = []byte{asPathSequence, byte(len(u.ASPath))}
+
+			for _, asn := range u.ASPath {
+				if u.FourOctetAS {
+					asPath = binary.BigEndian.AppendUint32(asPath, asn)
+				} else {
+					asPath = binary.BigEndian.AppendUint16(asPath, uint16(asn))
+				}
+			}
+		}
+
+		attrs = appendAttr(attrs, attrFlagTransitive, attrASPath, asPath)
+
+		if u.NextHop.Is4() {
+			attrs = appendAttr(attrs, attrFlagTransitive, attrNextHop, u.NextHop.AsSlice())
+			nlri = appendPrefixes(nlri, u.Announced)
+		} else {
+			value := []byte{0, afiIPv6, safiUnicast, 16}
+			value = append(value, u.NextHop.AsSlice()...)
+			value = append(value, 0)
+			value = appendPrefixes(value, u.Announced)
+
+			attrs = appendAttr(attrs, attrFlagOptional, attrMPReachNLRI, value)
+		}
+
+		if u.LocalPref != 0 {
+			attrs = appendAttr(attrs, attrFlagTransitive, attrLocalPref, binary.BigEndian.AppendUint32(nil, u.LocalPref))
+		}
+	}
+
+	b := binary.BigEndian.AppendUint16(nil, uint16(len(withdrawn)))
+	b = append(b, withdrawn...)
+	b = binary.BigEndian.AppendUint16(b, uint16(len(attrs)))
+	b = append(b, attrs...)
+
+	return append(b, nlri...)
+}
+
+func appendAttr(b []byte, flags, typ byte, value []byte) []byte {
+	if len(value) > 0xff {
+		b = append(b, flags|attrFlagExtendedLength, typ)
+		b = binary.BigEndian.AppendUint16(b, uint16(len(value)))
+	} else {
+		b = append(b, flags, typ, byte(len(value)))
+	}
+
+	return append(b, value...)
+}
+
+// appendPrefixes encodes the prefixes as the prefix length followed by the significant octets.
+func appendPrefixes(b []byte, prefixes []netip.Prefix) []byte {
+	for _, prefix := range prefixes {
+		bits := prefix.Bits()
+
+		b = append(b, byte(bits))
+		b = append(b, prefix.Masked().Addr().AsSlice()[:(bits+7)/8]...)
+	}
+
+	return b
+}
+
+// Notification is the BGP NOTIFICATION message, it is used as an error which closes the session.
+type Notification struct {
+	Code    byte
+	Subcode byte
+	Data    []byte
+}
+
+// Marshal encodes the message body.
+func (n *Notification) Marshal() []byte {
+	return append([]byte{n.Code, n.Subcode}, n.Data...)
+}
+
+// ParseNotification decodes the NOTIFICATION message body.
+func ParseNotification(b []byte) (*Notification, error) {
+	if len(b) < 2 {
+		return nil, errors.New("truncated notification message")
+	}
+
+	return &Notification{Code: b[0], Subcode: b[1], Data: b[2:]}, nil
+}
+
+func (n *Notification) Error() string {
+	var reason string
+
+	switch n.Code {
+	case errMessageHeader:
+		reason = "message header error"
+	case errOpenMessage:
+		reason = "OPEN message error"
+	case errUpdateMessage:
+		reason = "UPDATE message error"
+	case errHoldTimerExpired:
+		reason = "hold timer expired"
+	case errFSM:
+		reason = "finite state machine error"
+	case errCease:
+		reason = "cease"
+	default:
+		reason = "unknown error"
+	}
+
+	return fmt.Sprintf("%s (code %d, subcode %d)", reason, n.Code, n.Subcode)
+}
@@ -0,0 +1,195 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package bgp_test
+
+import (
+	"bytes"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/bgp"
+)
+
+func TestOpen(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		open bgp.Open
+
+		expected []byte
+	}{
+		{
+			name: "2-octet AS",
+			open: bgp.Open{
+				ASN:         64512,
+				HoldTime:    90,
+				RouterID:    netip.MustParseAddr("10.0.0.10"),
+				FourOctetAS: true,
+				AFIs:        []uint16{1},
+			},
+			expected: []byte{
+				4, 0xfc, 0x00, 0, 90, 10, 0, 0, 10,
+				14, 2, 12,
+				1, 4, 0, 1, 0, 1,
+				65, 4, 0, 0, 0xfc, 0x00,
+			},
+		},
+		{
+			name: "4-octet AS",
+			open: bgp.Open{
+				ASN:         4200000000,
+				HoldTime:    30,
+				RouterID:    netip.MustParseAddr("10.0.0.10"),
+				FourOctetAS: true,
+				AFIs:        []uint16{2},
+			},
+			expected: []byte{
+				4, 0x5b, 0xa0, 0, 30, 10, 0, 0, 10,
+				14, 2, 12,
+				1, 4, 0, 2, 0, 1,
+				65, 4, 0xfa, 0x56, 0xea, 0x00,
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := test.open.Marshal()
+			assert.Equal(t, test.expected, b)
+
+			parsed, err := bgp.ParseOpen(b)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.open, parsed)
+		})
+	}
+}
+
+func TestParseOpenErrors(t *testing.T) {
+	t.Parallel()
+
+	_, err := bgp.ParseOpen([]byte{3, 0xfc, 0x00, 0, 90, 10, 0, 0, 10, 0})
+	assert.EqualError(t, err, "OPEN message error (code 2, subcode 1)")
+
+	_, err = bgp.ParseOpen([]byte{4, 0xfc, 0x00, 0, 90, 10, 0, 0, 10, 4, 2, 2})
+	assert.EqualError(t, err, "OPEN message error (code 2, subcode 0)")
+}
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name   string
+		update bgp.Update
+
+		expected []byte
+	}{
+		{
+			name: "IPv4 eBGP",
+			update: bgp.Update{
+				Announced: []netip.Prefix{
+					netip.MustParsePrefix("192.168.100.0/24"),
+					netip.MustParsePrefix("10.5.0.1/32"),
+				},
+				NextHop:     netip.MustParseAddr("10.0.0.10"),
+				ASPath:      []uint32{64512},
+				FourOctetAS: true,
+			},
+			expected: []byte{
+				0, 0, // withdrawn routes
+				0, 20, // path attributes
+				0x40, 1, 1, 0, // ORIGIN IGP
+				0x40, 2, 6, 2, 1, 0, 0, 0xfc, 0x00, // AS_PATH
+				0x40, 3, 4, 10, 0, 0, 10, // NEXT_HOP
+				24, 192, 168, 100,
+				32, 10, 5, 0, 1,
+			},
+		},
+		{
+			name: "IPv4 iBGP withdraw",
+			update: bgp.Update{
+				Withdrawn: []netip.Prefix{
+					netip.MustParsePrefix("192.168.100.0/24"),
+				},
+			},
+			expected: []byte{
+				0, 4, 24, 192, 168, 100,
+				0, 0,
+			},
+		},
+		{
+			name: "IPv4 iBGP 2-octet AS",
+			update: bgp.Update{
+				Announced: []netip.Prefix{
+					netip.MustParsePrefix("10.0.0.0/8"),
+				},
+				NextHop:   netip.MustParseAddr("10.0.0.10"),
+				LocalPref: 100,
+			},
+			expected: []byte{
+				0, 0,
+				0, 21,
+				0x40, 1, 1, 0,
+				0x40, 2, 0,
+				0x40, 3, 4, 10, 0, 0, 10,
+				0x40, 5, 4, 0, 0, 0, 100,
+				8, 10,
+			},
+		},
+		{
+			name: "IPv6",
+			update: bgp.Update{
+				Announced: []netip.Prefix{
+					netip.MustParsePrefix("2001:db8:1::/48"),
+				},
+				Withdrawn: []netip.Prefix{
+					netip.MustParsePrefix("2001:db8:2::1/128"),
+				},
+				NextHop: netip.MustParseAddr("2001:db8::10"),
+				ASPath:  []uint32{64512},
+			},
+			expected: append(append([]byte{
+				0, 0,
+				0, 65,
+				0x80, 15, 20, 0, 2, 1, 128, // MP_UNREACH_NLRI
+			}, netip.MustParseAddr("2001:db8:2::1").AsSlice()...),
+				append(append([]byte{
+					0x40, 1, 1, 0,
+					0x40, 2, 4, 2, 1, 0xfc, 0x00,
+					0x80, 14, 28, 0, 2, 1, 16, // MP_REACH_NLRI
+				}, netip.MustParseAddr("2001:db8::10").AsSlice()...),
+					0, 48, 0x20, 0x01, 0x0d, 0xb8, 0x00, 0x01)...),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, test.update.Marshal())
+		})
+	}
+}
+
+func TestMessage(t *testing.T) {
+	t.Parallel()
+
+	notification := bgp.Notification{Code: 6, Subcode: 2}
+
+	typ, body, err := bgp.ReadMessage(bytes.NewReader(bgp.EncodeMessage(3, notification.Marshal())))
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 3, typ)
+
+	parsed, err := bgp.ParseNotification(body)
+	require.NoError(t, err)
+
+	assert.EqualError(t, parsed, "cease (code 6, subcode 2)")
+
+	_, _, err = bgp.ReadMessage(bytes.NewReader(make([]byte, 19)))
+	assert.EqualError(t, err, "message header error (code 1, subcode 1)")
+}
@@ -0,0 +1,577 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package bgp implements a minimal BGP speaker which announces routes to the peers.
+//
+// The speaker establishes the sessions itself, and ignores the routes received from the peers.
+package bgp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Session states, see RFC 4271.
+const (
+	StateIdle        = "Idle"
+	StateConnect     = "Connect"
+	StateOpenSent    = "OpenSent"
+	StateOpenConfirm = "OpenConfirm"
+	StateEstablished = "Established"
+)
+
+const (
+	connectTimeout = 30 * time.Second
+	// hold time used until the OPEN message of the peer is received, see RFC 4271 8.2.2.
+	largeHoldTime = 4 * time.Minute
+
+	connectRetryMin = 5 * time.Second
+	connectRetryMax = 2 * time.Minute
+
+	// keeps the UPDATE messages below the maximum message size.
+	maxPrefixesPerUpdate = 200
+)
+
+// Config of the session.
+type Config struct {
+	LocalASN uint32
+	// RouterID defaults to the local IPv4 address of the session.
+	RouterID netip.Addr
+	HoldTime time.Duration
+
+	PeerAddress netip.Addr
+	PeerPort    uint16
+	PeerASN     uint32
+
+	// BFD resets the session when the BFD session with the peer goes down.
+	BFD bool
+}
+
+// Status of the session.
+type Status struct {
+	State        string
+	LocalAddress netip.Addr
+	Announced    []netip.Prefix
+	BFDState     string
+	LastError    string
+}
+
+// Session is a BGP session with a peer.
+type Session struct {
+	config Config
+
+	notifyCh chan<- struct{}
+	updateCh chan struct{}
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+
+	mu       sync.Mutex
+	status   Status
+	prefixes []netip.Prefix
+}
+
+// NewSession creates a new session, the session is started with Start.
+func NewSession(config Config) *Session {
+	return &Session{
+		config:   config,
+		updateCh: make(chan struct{}, 1),
+		status: Status{
+			State: StateIdle,
+		},
+	}
+}
+
+// Config returns the config of the session.
+func (s *Session) Config() Config {
+	return s.config
+}
+
+// Start the session, notifyCh is signaled on every status change.
+func (s *Session) Start(ctx context.Context, notifyCh chan<- struct{}, logger *zap.Logger) {
+	s.notifyCh = notifyCh
+
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	s.wg.Add(1)
+
+	go func() {
+		defer s.wg.Done()
+
+		s.run(ctx, logger)
+	}()
+}
+
+// Stop the session, the peer is notified if the session is established.
+func (s *Session) Stop() {
+	s.cancel()
+
+	s.wg.Wait()
+}
+
+// Announce sets the prefixes to announce, the prefixes of the other address family are ignored.
+func (s *Session) Announce(prefixes []netip.Prefix) {
+	prefixes = slices.DeleteFunc(slices.Clone(prefixes), func(prefix netip.Prefix) bool {
+		return prefix.Addr().Is4() != s.config.PeerAddress.Is4()
+	})
+
+	slices.SortFunc(prefixes, comparePrefixes)
+	prefixes = slices.Compact(prefixes)
+
+	s.mu.Lock()
+	changed := !slices.Equal(s.prefixes, prefixes)
+	s.prefixes = prefixes
+	s.mu.Unlock()
+
+	if changed {
+		trigger(s.updateCh)
+	}
+}
+
+// Status returns the current status of the session.
+func (s *Session) Status() Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	status := s.status
+	status.Announced = slices.Clone(status.Announced)
+
+	return status
+}
+
+func (s *Session) updateStatus(f func(*Status)) {
+	s.mu.Lock()
+	f(&s.status)
+	s.mu.Unlock()
+
+	trigger(s.notifyCh)
+}
+
+func (s *Session) run(ctx context.Context, logger *zap.Logger) {
+	logger = logger.With(zap.Stringer("peer", s.config.PeerAddress))
+
+	var bfd *bfdSession
+
+	if s.config.BFD {
+		var err error
+
+		bfd, err = startBFD(ctx, s.config.PeerAddress, logger)
+		if err != nil {
+			logger.Warn("failed to start BFD session", zap.Error(err))
+		} else {
+			defer bfd.Stop()
+
+			s.updateStatus(func(status *Status) { status.BFDState = bfd.State() })
+		}
+	}
+
+	retry := connectRetryMin
+
+	for {
+		established, err := s.connect(ctx, logger, bfd)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if established {
+			retry = connectRetryMin
+		}
+
+		logger.Warn("BGP session closed", zap.Error(err), zap.Duration("retry", retry))
+
+		s.updateStatus(func(status *Status) {
+			status.State = StateIdle
+			status.LocalAddress = netip.Addr{}
+			status.Announced = nil
+			status.LastError = err.Error()
+		})
+
+		if !s.wait(ctx, retry, bfd) {
+			return
+		}
+
+		retry = min(2*retry, connectRetryMax)
+	}
+}
+
+// wait for the connect retry interval, while keeping the BFD state up to date.
+func (s *Session) wait(ctx context.Context, interval time.Duration, bfd *bfdSession) bool {
+	timer := time.NewTimer(interval)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-timer.C:
+			return true
+		case <-bfdChanges(bfd):
+			s.updateStatus(func(status *Status) { status.BFDState = bfd.State() })
+		}
+	}
+}
+
+func bfdChanges(bfd *bfdSession) <-chan struct{} {
+	if bfd == nil {
+		return nil
+	}
+
+	return bfd.changeCh
+}
+
+// connect runs a single BGP session until it fails.
+//
+// It returns true if the session was established.
+//
+//nolint:gocyclo,cyclop
+func (s *Session) connect(ctx context.Context, logger *zap.Logger, bfd *bfdSession) (bool, error) {
+	s.updateStatus(func(status *Status) { status.State = StateConnect })
+
+	dialer := net.Dialer{Timeout: connectTimeout}
+
+	conn, err := dialer.DialContext(ctx, "tcp", netip.AddrPortFrom(s.config.PeerAddress, s.config.PeerPort).String())
+	if err != nil {
+		return false, err
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	localAddr := conn.LocalAddr().(*net.TCPAddr).AddrPort().Addr().Unmap() //nolint:forcetypeassert
+
+	routerID := s.config.RouterID
+	if !routerID.IsValid() {
+		if !localAddr.Is4() {
+			return false, errors.New("router ID should be set for the IPv6 sessions")
+		}
+
+		routerID = localAddr
+	}
+
+	afi := uint16(afiIPv4)
+	if s.config.PeerAddress.Is6() {
+		afi = afiIPv6
+	}
+
+	localOpen := Open{
+		ASN:         s.config.LocalASN,
+		HoldTime:    uint16(s.config.HoldTime / time.Second),
+		RouterID:    routerID,
+		FourOctetAS: true,
+		AFIs:        []uint16{afi},
+	}
+
+	if err = writeMessage(conn, msgOpen, localOpen.Marshal()); err != nil {
+		return false, err
+	}
+
+	s.updateStatus(func(status *Status) {
+		status.State = StateOpenSent
+		status.LocalAddress = localAddr
+	})
+
+	peerOpen, err := s.receiveOpen(conn, afi)
+	if err != nil {
+		return false, closeWithError(conn, err)
+	}
+
+	holdTime := min(s.config.HoldTime, time.Duration(peerOpen.HoldTime)*time.Second)
+
+	if err = writeMessage(conn, msgKeepalive, nil); err != nil {
+		return false, err
+	}
+
+	s.updateStatus(func(status *Status) { status.State = StateOpenConfirm })
+
+	if err = conn.SetReadDeadline(deadline(holdTime)); err != nil {
+		return false, err
+	}
+
+	typ, body, err := readMessage(conn)
+	if err != nil {
+		return false, closeWithError(conn, err)
+	}
+
+	switch typ {
+	case msgKeepalive:
+	case msgNotification:
+		return false, peerNotification(body)
+	default:
+		return false, closeWithError(conn, &Notification{Code: errFSM})
+	}
+
+	logger.Info("BGP session established", zap.Stringer("local", localAddr), zap.Duration("hold_time", holdTime))
+
+	s.updateStatus(func(status *Status) {
+		status.State = StateEstablished
+		status.LastError = ""
+	})
+
+	readErrCh := make(chan error, 1)
+
+	go func() {
+		readErrCh <- receiveLoop(conn, holdTime)
+	}()
+
+	var keepaliveCh <-chan time.Time
+
+	if holdTime > 0 {
+		ticker := time.NewTicker(holdTime / 3)
+		defer ticker.Stop()
+
+		keepaliveCh = ticker.C
+	}
+
+	announcer := announcer{
+		session:     s,
+		conn:        conn,
+		nextHop:     localAddr,
+		fourOctetAS: peerOpen.FourOctetAS,
+		internal:    s.config.LocalASN == s.config.PeerASN,
+		announced:   map[netip.Prefix]struct{}{},
+	}
+
+	if err = announcer.update(); err != nil {
+		return true, err
+	}
+
+	var bfdUp bool
+
+	if bfd != nil {
+		bfdUp = bfd.State() == BFDStateUp
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return true, closeWithError(conn, &Notification{Code: errCease, Subcode: errCeaseAdminShutdown})
+		case err = <-readErrCh:
+			return true, closeWithError(conn, err)
+		case <-keepaliveCh:
+			if err = writeMessage(conn, msgKeepalive, nil); err != nil {
+				return true, err
+			}
+		case <-s.updateCh:
+			if err = announcer.update(); err != nil {
+				return true, err
+			}
+		case <-bfdChanges(bfd):
+			bfdState := bfd.State()
+
+			s.updateStatus(func(status *Status) { status.BFDState = bfdState })
+
+			if bfdUp && bfdState != BFDStateUp {
+				closeWithError(conn, &Notification{Code: errCease, Subcode: errCeaseAdminReset}) //nolint:errcheck
+
+				return true, errors.New("BFD session went down")
+			}
+
+			bfdUp = bfdState == BFDStateUp
+		}
+	}
+}
+
+// receiveOpen receives and validates the OPEN message of the peer.
+func (s *Session) receiveOpen(conn net.Conn, afi uint16) (Open, error) {
+	if err := conn.SetReadDeadline(time.Now().Add(largeHoldTime)); err != nil {
+		return Open{}, err
+	}
+
+	typ, body, err := readMessage(conn)
+	if err != nil {
+		return Open{}, err
+	}
+
+	switch typ {
+	case msgOpen:
+	case msgNotification:
+		return Open{}, peerNotification(body)
+	default:
+		return Open{}, &Notification{Code: errFSM}
+	}
+
+	peerOpen, err := ParseOpen(body)
+	if err != nil {
+		return Open{}, err
+	}
+
+	if peerOpen.ASN != s.config.PeerASN {
+		return Open{}, fmt.Errorf("unexpected peer AS %d: %w", peerOpen.ASN, &Notification{Code: errOpenMessage, Subcode: errOpenBadPeerAS})
+	}
+
+	if peerOpen.HoldTime == 1 || peerOpen.HoldTime == 2 {
+		return Open{}, &Notification{Code: errOpenMessage, Subcode: errOpenUnacceptableHold}
+	}
+
+	if !peerOpen.FourOctetAS && s.config.LocalASN > 0xffff {
+		return Open{}, fmt.Errorf("peer doesn't support 4-octet AS numbers: %w", &Notification{Code: errCease})
+	}
+
+	// without the multiprotocol capability, only IPv4 unicast is supported
+	if (len(peerOpen.AFIs) == 0 && afi != afiIPv4) || (len(peerOpen.AFIs) > 0 && !slices.Contains(peerOpen.AFIs, afi)) {
+		return Open{}, fmt.Errorf("peer doesn't support the address family: %w", &Notification{Code: errCease})
+	}
+
+	return peerOpen, nil
+}
+
+// receiveLoop reads the messages of the established session until an error.
+func receiveLoop(conn net.Conn, holdTime time.Duration) error {
+	for {
+		if err := conn.SetReadDeadline(deadline(holdTime)); err != nil {
+			return err
+		}
+
+		typ, body, err := readMessage(conn)
+		if err != nil {
+			if errors.Is(err, os.ErrDeadlineExceeded) {
+				return &Notification{Code: errHoldTimerExpired}
+			}
+
+			return err
+		}
+
+		switch typ {
+		case msgKeepalive, msgUpdate:
+			// received routes are ignored
+		case msgNotification:
+			return peerNotification(body)
+		default:
+			return &Notification{Code: errFSM}
+		}
+	}
+}
+
+// announcer sends the UPDATE messages for the changes in the announced prefixes.
+type announcer struct {
+	session     *Session
+	conn        net.Conn
+	nextHop     netip.Addr
+	fourOctetAS bool
+	internal    bool
+	announced   map[netip.Prefix]struct{}
+}
+
+func (a *announcer) update() error {
+	a.session.mu.Lock()
+	desired := slices.Clone(a.session.prefixes)
+	a.session.mu.Unlock()
+
+	var announce, withdraw []netip.Prefix
+
+	for _, prefix := range desired {
+		if _, ok := a.announced[prefix]; !ok {
+			announce = append(announce, prefix)
+		}
+	}
+
+	for prefix := range a.announced {
+		if !slices.Contains(desired, prefix) {
+			withdraw = append(withdraw, prefix)
+		}
+	}
+
+	slices.SortFunc(withdraw, comparePrefixes)
+
+	for chunk := range slices.Chunk(withdraw, maxPrefixesPerUpdate) {
+		update := Update{Withdrawn: chunk}
+
+		if err := writeMessage(a.conn, msgUpdate, update.Marshal()); err != nil {
+			return err
+		}
+
+		for _, prefix := range chunk {
+			delete(a.announced, prefix)
+		}
+	}
+
+	for chunk := range slices.Chunk(announce, maxPrefixesPerUpdate) {
+		update := Update{
+			Announced:   chunk,
+			NextHop:     a.nextHop,
+			FourOctetAS: a.fourOctetAS,
+		}
+
+		if a.internal {
+			update.LocalPref = defaultLocalPref
+		} else {
+			update.ASPath = []uint32{a.session.config.LocalASN}
+		}
+
+		if err := writeMessage(a.conn, msgUpdate, update.Marshal()); err != nil {
+			return err
+		}
+
+		for _, prefix := range chunk {
+			a.announced[prefix] = struct{}{}
+		}
+	}
+
+	a.session.updateStatus(func(status *Status) { status.Announced = desired })
+
+	return nil
+}
+
+func writeMessage(conn net.Conn, typ byte, body []byte) error {
+	if err := conn.SetWriteDeadline(time.Now().Add(connectTimeout)); err != nil {
+		return err
+	}
+
+	_, err := conn.Write(encodeMessage(typ, body))
+
+	return err
+}
+
+// closeWithError sends the NOTIFICATION message to the peer if the error is a protocol error.
+func closeWithError(conn net.Conn, err error) error {
+	var notification *Notification
+
+	if errors.As(err, &notification) {
+		writeMessage(conn, msgNotification, notification.Marshal()) //nolint:errcheck
+	}
+
+	return err
+}
+
+func peerNotification(body []byte) error {
+	notification, err := ParseNotification(body)
+	if err != nil {
+		return err
+	}
+
+	// not wrapped, as the notification shouldn't be sent back to the peer
+	return fmt.Errorf("peer sent notification: %s", notification)
+}
+
+// deadline returns the read deadline for the hold time, zero hold time disables the hold timer.
+func deadline(holdTime time.Duration) time.Time {
+	if holdTime == 0 {
+		return time.Time{}
+	}
+
+	return time.Now().Add(holdTime)
+}
+
+func comparePrefixes(a, b netip.Prefix) int {
+	if c := a.Addr().Compare(b.Addr()); c != 0 {
+		return c
+	}
+
+	return a.Bits() - b.Bits()
+}
+
+func trigger(ch chan<- struct{}) {
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+}
@@ -0,0 +1,185 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package bgp_test
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/bgp"
+)
+
+//nolint:gocyclo
+func TestSession(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { listener.Close() }) //nolint:errcheck
+
+	session := bgp.NewSession(bgp.Config{
+		LocalASN:    64512,
+		HoldTime:    90 * time.Second,
+		PeerAddress: netip.MustParseAddr("127.0.0.1"),
+		PeerPort:    uint16(listener.Addr().(*net.TCPAddr).Port),
+		PeerASN:     64500,
+	})
+
+	session.Announce([]netip.Prefix{
+		netip.MustParsePrefix("192.168.100.0/24"),
+		netip.MustParsePrefix("2001:db8::/32"), // ignored, different address family
+	})
+
+	notifyCh := make(chan struct{}, 1)
+
+	session.Start(ctx, notifyCh, zaptest.NewLogger(t))
+
+	conn, err := listener.Accept()
+	require.NoError(t, err)
+
+	t.Cleanup(func() { conn.Close() }) //nolint:errcheck
+
+	require.NoError(t, conn.SetDeadline(time.Now().Add(10*time.Second)))
+
+	expectMessage := func(expectedType byte) []byte {
+		typ, body, err := bgp.ReadMessage(conn)
+		require.NoError(t, err)
+		require.Equal(t, expectedType, typ)
+
+		return body
+	}
+
+	send := func(typ byte, body []byte) {
+		_, err := conn.Write(bgp.EncodeMessage(typ, body))
+		require.NoError(t, err)
+	}
+
+	open, err := bgp.ParseOpen(expectMessage(1))
+	require.NoError(t, err)
+
+	assert.Equal(t, bgp.Open{
+		ASN:         64512,
+		HoldTime:    90,
+		RouterID:    netip.MustParseAddr("127.0.0.1"),
+		FourOctetAS: true,
+		AFIs:        []uint16{1},
+	}, open)
+
+	peerOpen := bgp.Open{
+		ASN:         64500,
+		HoldTime:    30,
+		RouterID:    netip.MustParseAddr("10.0.0.1"),
+		FourOctetAS: true,
+		AFIs:        []uint16{1, 2},
+	}
+
+	send(1, peerOpen.Marshal())
+	expectMessage(4)
+	send(4, nil)
+
+	update := bgp.Update{
+		Announced:   []netip.Prefix{netip.MustParsePrefix("192.168.100.0/24")},
+		NextHop:     netip.MustParseAddr("127.0.0.1"),
+		ASPath:      []uint32{64512},
+		FourOctetAS: true,
+	}
+
+	assert.Equal(t, update.Marshal(), expectMessage(2))
+
+	require.Eventually(t, func() bool {
+		status := session.Status()
+
+		return status.State == bgp.StateEstablished && len(status.Announced) == 1
+	}, 5*time.Second, 10*time.Millisecond)
+
+	session.Announce([]netip.Prefix{netip.MustParsePrefix("10.5.0.1/32")})
+
+	withdraw := bgp.Update{
+		Withdrawn: []netip.Prefix{netip.MustParsePrefix("192.168.100.0/24")},
+	}
+
+	assert.Equal(t, withdraw.Marshal(), expectMessage(2))
+
+	update.Announced = []netip.Prefix{netip.MustParsePrefix("10.5.0.1/32")}
+
+	assert.Equal(t, update.Marshal(), expectMessage(2))
+
+	// stopping the session sends cease
+	session.Stop()
+
+	notification, err := bgp.ParseNotification(expectMessage(3))
+	require.NoError(t, err)
+
+	assert.EqualError(t, notification, "cease (code 6, subcode 2)")
+}
+
+func TestSessionBadPeerAS(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { listener.Close() }) //nolint:errcheck
+
+	session := bgp.NewSession(bgp.Config{
+		LocalASN:    64512,
+		HoldTime:    90 * time.Second,
+		PeerAddress: netip.MustParseAddr("127.0.0.1"),
+		PeerPort:    uint16(listener.Addr().(*net.TCPAddr).Port),
+		PeerASN:     64500,
+	})
+
+	session.Start(ctx, make(chan struct{}, 1), zaptest.NewLogger(t))
+	t.Cleanup(session.Stop)
+
+	conn, err := listener.Accept()
+	require.NoError(t, err)
+
+	t.Cleanup(func() { conn.Close() }) //nolint:errcheck
+
+	_, _, err = bgp.ReadMessage(conn)
+	require.NoError(t, err)
+
+	peerOpen := bgp.Open{
+		ASN:         64501,
+		HoldTime:    90,
+		RouterID:    netip.MustParseAddr("10.0.0.1"),
+		FourOctetAS: true,
+	}
+
+	_, err = conn.Write(bgp.EncodeMessage(1, peerOpen.Marshal()))
+	require.NoError(t, err)
+
+	typ, body, err := bgp.ReadMessage(conn)
+	require.NoError(t, err)
+	require.EqualValues(t, 3, typ)
+
+	notification, err := bgp.ParseNotification(body)
+	require.NoError(t, err)
+
+	assert.EqualError(t, notification, "OPEN message error (code 2, subcode 2)")
+
+	require.Eventually(t, func() bool {
+		status := session.Status()
+
+		return status.State == bgp.StateIdle && status.LastError != ""
+	}, 5*time.Second, 10*time.Millisecond)
+
+	assert.Equal(t, "unexpected peer AS 64501: OPEN message error (code 2, subcode 2)", session.Status().LastError)
+}
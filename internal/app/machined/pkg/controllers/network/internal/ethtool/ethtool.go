@@ -0,0 +1,207 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package ethtool implements the subset of the ethtool ioctl interface to tune the Ethernet links.
+package ethtool
+
+import (
+	"fmt"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// Rings describes the ring buffer sizes of a link (struct ethtool_ringparam).
+type Rings struct {
+	RXMax      uint32
+	RXMiniMax  uint32
+	RXJumboMax uint32
+	TXMax      uint32
+	RX         uint32
+	RXMini     uint32
+	RXJumbo    uint32
+	TX         uint32
+}
+
+// Channels describes the queue (channel) counts of a link (struct ethtool_channels).
+type Channels struct {
+	RXMax       uint32
+	TXMax       uint32
+	OtherMax    uint32
+	CombinedMax uint32
+	RX          uint32
+	TX          uint32
+	Other       uint32
+	Combined    uint32
+}
+
+// Offload is a legacy offload toggle.
+type Offload int
+
+// Offload toggles.
+const (
+	OffloadGRO Offload = iota
+	OffloadGSO
+	OffloadTSO
+)
+
+// String implements fmt.Stringer.
+func (offload Offload) String() string {
+	switch offload {
+	case OffloadGRO:
+		return "gro"
+	case OffloadGSO:
+		return "gso"
+	case OffloadTSO:
+		return "tso"
+	default:
+		return fmt.Sprintf("offload(%d)", int(offload))
+	}
+}
+
+func (offload Offload) commands() (get, set uint32) {
+	switch offload {
+	case OffloadGRO:
+		return unix.ETHTOOL_GGRO, unix.ETHTOOL_SGRO
+	case OffloadGSO:
+		return unix.ETHTOOL_GGSO, unix.ETHTOOL_SGSO
+	case OffloadTSO:
+		return unix.ETHTOOL_GTSO, unix.ETHTOOL_STSO
+	default:
+		panic("unknown offload")
+	}
+}
+
+// Handle is a socket to issue the ethtool ioctls.
+type Handle struct {
+	fd int
+}
+
+// Open the handle.
+func Open() (*Handle, error) {
+	fd, err := unix.Socket(unix.AF_INET, unix.SOCK_DGRAM|unix.SOCK_CLOEXEC, 0)
+	if err != nil {
+		return nil, fmt.Errorf("error opening socket: %w", err)
+	}
+
+	return &Handle{fd: fd}, nil
+}
+
+// Close the handle.
+func (h *Handle) Close() error {
+	return unix.Close(h.fd)
+}
+
+// Rings returns the ring buffer sizes of the link.
+func (h *Handle) Rings(link string) (Rings, error) {
+	param := struct {
+		cmd uint32
+		Rings
+	}{
+		cmd: unix.ETHTOOL_GRINGPARAM,
+	}
+
+	err := h.ioctl(link, unsafe.Pointer(&param))
+
+	return param.Rings, err
+}
+
+// SetRings sets the ring buffer sizes of the link.
+func (h *Handle) SetRings(link string, rings Rings) error {
+	param := struct {
+		cmd uint32
+		Rings
+	}{
+		cmd:   unix.ETHTOOL_SRINGPARAM,
+		Rings: rings,
+	}
+
+	return h.ioctl(link, unsafe.Pointer(&param))
+}
+
+// Channels returns the queue counts of the link.
+func (h *Handle) Channels(link string) (Channels, error) {
+	param := struct {
+		cmd uint32
+		Channels
+	}{
+		cmd: unix.ETHTOOL_GCHANNELS,
+	}
+
+	err := h.ioctl(link, unsafe.Pointer(&param))
+
+	return param.Channels, err
+}
+
+// SetChannels sets the queue counts of the link.
+func (h *Handle) SetChannels(link string, channels Channels) error {
+	param := struct {
+		cmd uint32
+		Channels
+	}{
+		cmd:      unix.ETHTOOL_SCHANNELS,
+		Channels: channels,
+	}
+
+	return h.ioctl(link, unsafe.Pointer(&param))
+}
+
+// Offload returns whether the offload is enabled on the link.
+func (h *Handle) Offload(link string, offload Offload) (bool, error) {
+	get, _ := offload.commands()
+
+	value := struct {
+		cmd  uint32
+		data uint32
+	}{
+		cmd: get,
+	}
+
+	err := h.ioctl(link, unsafe.Pointer(&value))
+
+	return value.data != 0, err
+}
+
+// SetOffload enables or disables the offload on the link.
+func (h *Handle) SetOffload(link string, offload Offload, enabled bool) error {
+	_, set := offload.commands()
+
+	value := struct {
+		cmd  uint32
+		data uint32
+	}{
+		cmd: set,
+	}
+
+	if enabled {
+		value.data = 1
+	}
+
+	return h.ioctl(link, unsafe.Pointer(&value))
+}
+
+// ifreqData is the struct ifreq with the pointer to the ethtool command.
+type ifreqData struct {
+	name [unix.IFNAMSIZ]byte
+	data unsafe.Pointer
+	_    [24 - unsafe.Sizeof(uintptr(0))]byte
+}
+
+func (h *Handle) ioctl(link string, data unsafe.Pointer) error {
+	if len(link) >= unix.IFNAMSIZ {
+		return fmt.Errorf("link name %q is too long", link)
+	}
+
+	ifr := ifreqData{
+		data: data,
+	}
+
+	copy(ifr.name[:], link)
+
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, uintptr(h.fd), unix.SIOCETHTOOL, uintptr(unsafe.Pointer(&ifr))); errno != 0 {
+		return errno
+	}
+
+	return nil
+}
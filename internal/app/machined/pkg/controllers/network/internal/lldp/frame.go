@@ -0,0 +1,147 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package lldp
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// TLV types, see IEEE 802.1AB.
+const (
+	tlvEnd               = 0
+	tlvChassisID         = 1
+	tlvPortID            = 2
+	tlvTTL               = 3
+	tlvPortDescription   = 4
+	tlvSystemName        = 5
+	tlvSystemDescription = 6
+	tlvManagementAddress = 8
+)
+
+// Chassis ID and port ID subtypes which are not printable strings.
+const (
+	chassisIDSubtypeMACAddress     = 4
+	chassisIDSubtypeNetworkAddress = 5
+	portIDSubtypeMACAddress        = 3
+	portIDSubtypeNetworkAddress    = 4
+)
+
+// Address families of the network addresses (IANA address family numbers).
+const (
+	addressFamilyIPv4 = 1
+	addressFamilyIPv6 = 2
+)
+
+// ParseFrame parses LLDP data unit (Ethernet frame payload) into the neighbor spec.
+//
+//nolint:gocyclo
+func ParseFrame(b []byte) (network.LLDPNeighborSpec, error) {
+	var (
+		spec                           network.LLDPNeighborSpec
+		seenChassis, seenPort, seenTTL bool
+	)
+
+	for len(b) > 0 {
+		if len(b) < 2 {
+			return spec, errors.New("truncated TLV header")
+		}
+
+		header := binary.BigEndian.Uint16(b)
+		typ, length := header>>9, int(header&0x1ff)
+
+		b = b[2:]
+
+		if len(b) < length {
+			return spec, fmt.Errorf("truncated TLV %d: expected %d bytes, got %d", typ, length, len(b))
+		}
+
+		value := b[:length]
+		b = b[length:]
+
+		switch typ {
+		case tlvEnd:
+			b = nil
+		case tlvChassisID:
+			if length < 2 {
+				return spec, errors.New("invalid chassis ID TLV")
+			}
+
+			spec.ChassisID = formatID(value[0], value[1:], chassisIDSubtypeMACAddress, chassisIDSubtypeNetworkAddress)
+			seenChassis = true
+		case tlvPortID:
+			if length < 2 {
+				return spec, errors.New("invalid port ID TLV")
+			}
+
+			spec.PortID = formatID(value[0], value[1:], portIDSubtypeMACAddress, portIDSubtypeNetworkAddress)
+			seenPort = true
+		case tlvTTL:
+			if length < 2 {
+				return spec, errors.New("invalid TTL TLV")
+			}
+
+			spec.TTL = time.Duration(binary.BigEndian.Uint16(value)) * time.Second
+			seenTTL = true
+		case tlvPortDescription:
+			spec.PortDescription = string(value)
+		case tlvSystemName:
+			spec.SystemName = string(value)
+		case tlvSystemDescription:
+			spec.SystemDescription = string(value)
+		case tlvManagementAddress:
+			// address string length (including the subtype), address subtype, address, ...
+			if length < 2 || int(value[0]) < 1 || int(value[0]) >= length {
+				return spec, errors.New("invalid management address TLV")
+			}
+
+			if addr, ok := parseNetworkAddress(value[1 : 1+int(value[0])]); ok {
+				spec.ManagementAddresses = append(spec.ManagementAddresses, addr)
+			}
+		}
+	}
+
+	if !seenChassis || !seenPort || !seenTTL {
+		return spec, errors.New("mandatory TLVs are missing")
+	}
+
+	return spec, nil
+}
+
+// formatID formats chassis or port ID depending on the subtype.
+func formatID(subtype byte, id []byte, macSubtype, networkAddressSubtype byte) string {
+	switch subtype {
+	case macSubtype:
+		return net.HardwareAddr(id).String()
+	case networkAddressSubtype:
+		if addr, ok := parseNetworkAddress(id); ok {
+			return addr.String()
+		}
+
+		return fmt.Sprintf("%x", id)
+	default:
+		return string(id)
+	}
+}
+
+// parseNetworkAddress parses network address prefixed with the address family.
+func parseNetworkAddress(b []byte) (netip.Addr, bool) {
+	if len(b) < 1 {
+		return netip.Addr{}, false
+	}
+
+	switch b[0] {
+	case addressFamilyIPv4, addressFamilyIPv6:
+		return netip.AddrFromSlice(b[1:])
+	default:
+		return netip.Addr{}, false
+	}
+}
@@ -0,0 +1,108 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package lldp_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/lldp"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+func tlv(typ uint16, value ...byte) []byte {
+	header := typ<<9 | uint16(len(value))
+
+	return append([]byte{byte(header >> 8), byte(header)}, value...)
+}
+
+func frame(tlvs ...[]byte) []byte {
+	var b []byte
+
+	for _, t := range tlvs {
+		b = append(b, t...)
+	}
+
+	return b
+}
+
+func TestParseFrame(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name  string
+		frame []byte
+
+		expected      network.LLDPNeighborSpec
+		expectedError string
+	}{
+		{
+			name: "full",
+			frame: frame(
+				tlv(1, append([]byte{4}, 0x00, 0x1c, 0x73, 0x01, 0x02, 0x03)...),
+				tlv(2, append([]byte{5}, "Ethernet12"...)...),
+				tlv(3, 0x00, 0x78),
+				tlv(4, []byte("server-42 uplink")...),
+				tlv(5, []byte("tor-a1")...),
+				tlv(6, []byte("Arista Networks EOS")...),
+				tlv(8, 5, 1, 10, 0, 0, 1, 2, 0, 0, 0, 1, 0),
+				tlv(127, 0x00, 0x80, 0xc2, 0x01, 0x00, 0x0a),
+				tlv(0),
+			),
+			expected: network.LLDPNeighborSpec{
+				ChassisID:           "00:1c:73:01:02:03",
+				PortID:              "Ethernet12",
+				PortDescription:     "server-42 uplink",
+				SystemName:          "tor-a1",
+				SystemDescription:   "Arista Networks EOS",
+				ManagementAddresses: []netip.Addr{netip.MustParseAddr("10.0.0.1")},
+				TTL:                 120 * time.Second,
+			},
+		},
+		{
+			name: "minimal",
+			frame: frame(
+				tlv(1, append([]byte{5, 2}, netip.MustParseAddr("2001:db8::1").AsSlice()...)...),
+				tlv(2, append([]byte{3}, 0x52, 0x54, 0x00, 0x0a, 0x0b, 0x0c)...),
+				tlv(3, 0x00, 0x00),
+			),
+			expected: network.LLDPNeighborSpec{
+				ChassisID: "2001:db8::1",
+				PortID:    "52:54:00:0a:0b:0c",
+			},
+		},
+		{
+			name: "missing TTL",
+			frame: frame(
+				tlv(1, append([]byte{7}, "chassis"...)...),
+				tlv(2, append([]byte{7}, "port"...)...),
+			),
+			expectedError: "mandatory TLVs are missing",
+		},
+		{
+			name:          "truncated",
+			frame:         tlv(5, []byte("tor-a1")...)[:5],
+			expectedError: "truncated TLV 5: expected 6 bytes, got 3",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			spec, err := lldp.ParseFrame(test.frame)
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, spec)
+		})
+	}
+}
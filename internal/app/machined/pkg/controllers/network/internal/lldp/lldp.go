@@ -0,0 +1,134 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package lldp contains implementation of the LLDP neighbor listener.
+package lldp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+
+	"github.com/mdlayher/packet"
+	"github.com/siderolabs/gen/channel"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// nearestBridgeAddr is the destination MAC address of the LLDP frames.
+var nearestBridgeAddr = net.HardwareAddr{0x01, 0x80, 0xc2, 0x00, 0x00, 0x0e}
+
+// Listener receives LLDP frames on a link.
+type Listener struct {
+	LinkName  string
+	LinkIndex uint32
+
+	conn   *packet.Conn
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// Notification of a received neighbor information.
+type Notification struct {
+	LinkName string
+	Neighbor network.LLDPNeighborSpec
+}
+
+// Start a listener with a given context.
+func (listener *Listener) Start(ctx context.Context, notifyCh chan<- Notification, logger *zap.Logger) error {
+	conn, err := packet.Listen(&net.Interface{Index: int(listener.LinkIndex), Name: listener.LinkName}, packet.Datagram, unix.ETH_P_LLDP, nil)
+	if err != nil {
+		return fmt.Errorf("error listening on link %q: %w", listener.LinkName, err)
+	}
+
+	if err = joinNearestBridgeGroup(conn, listener.LinkIndex); err != nil {
+		conn.Close() //nolint:errcheck
+
+		return fmt.Errorf("error joining LLDP multicast group on link %q: %w", listener.LinkName, err)
+	}
+
+	listener.conn = conn
+
+	listener.wg.Add(1)
+
+	ctx, listener.cancel = context.WithCancel(ctx)
+
+	go func() {
+		defer listener.wg.Done()
+
+		listener.run(ctx, notifyCh, logger)
+	}()
+
+	return nil
+}
+
+// Stop a listener.
+func (listener *Listener) Stop() {
+	listener.cancel()
+	listener.conn.Close() //nolint:errcheck
+
+	listener.wg.Wait()
+}
+
+func (listener *Listener) run(ctx context.Context, notifyCh chan<- Notification, logger *zap.Logger) {
+	logger = logger.With(zap.String("link", listener.LinkName))
+
+	buf := make([]byte, 1500)
+
+	for {
+		n, _, err := listener.conn.ReadFrom(buf)
+		if err != nil {
+			if ctx.Err() == nil {
+				logger.Warn("error receiving LLDP frame", zap.Error(err))
+			}
+
+			return
+		}
+
+		neighbor, err := ParseFrame(buf[:n])
+		if err != nil {
+			logger.Debug("ignoring invalid LLDP frame", zap.Error(err))
+
+			continue
+		}
+
+		neighbor.LinkName = listener.LinkName
+
+		if !channel.SendWithContext(ctx, notifyCh, Notification{
+			LinkName: listener.LinkName,
+			Neighbor: neighbor,
+		}) {
+			return
+		}
+	}
+}
+
+// joinNearestBridgeGroup makes the link accept frames sent to the LLDP multicast address.
+func joinNearestBridgeGroup(conn *packet.Conn, linkIndex uint32) error {
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		return err
+	}
+
+	mreq := unix.PacketMreq{
+		Ifindex: int32(linkIndex),
+		Type:    unix.PACKET_MR_MULTICAST,
+		Alen:    uint16(len(nearestBridgeAddr)),
+	}
+
+	copy(mreq.Address[:], nearestBridgeAddr)
+
+	var sockErr error
+
+	if err = rawConn.Control(func(fd uintptr) {
+		sockErr = unix.SetsockoptPacketMreq(int(fd), unix.SOL_PACKET, unix.PACKET_ADD_MEMBERSHIP, &mreq)
+	}); err != nil {
+		return err
+	}
+
+	return sockErr
+}
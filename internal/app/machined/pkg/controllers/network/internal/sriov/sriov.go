@@ -0,0 +1,166 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package sriov implements managing the SR-IOV virtual functions of the physical links via sysfs and netlink.
+package sriov
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/vishvananda/netlink"
+)
+
+// VirtualFunction describes a virtual function of a physical link.
+type VirtualFunction struct {
+	Index int
+
+	// PCIAddress of the virtual function device.
+	PCIAddress string
+	// LinkName is the name of the virtual function link, empty if the device is not bound to a network driver.
+	LinkName string
+
+	HardwareAddr net.HardwareAddr
+	VLAN         int
+	SpoofCheck   bool
+	Trust        bool
+}
+
+// Host manages the SR-IOV virtual functions of the host links.
+type Host struct {
+	// SysfsRoot defaults to /sys.
+	SysfsRoot string
+}
+
+func (h Host) devicePath(link string, elem ...string) string {
+	root := h.SysfsRoot
+	if root == "" {
+		root = "/sys"
+	}
+
+	return filepath.Join(append([]string{root, "class", "net", link, "device"}, elem...)...)
+}
+
+func (h Host) readInt(path string) (int, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(strings.TrimSpace(string(contents)))
+}
+
+// TotalVFs returns the maximum number of virtual functions supported by the link.
+//
+// If the link doesn't support SR-IOV, the error matches os.ErrNotExist.
+func (h Host) TotalVFs(link string) (int, error) {
+	return h.readInt(h.devicePath(link, "sriov_totalvfs"))
+}
+
+// NumVFs returns the number of enabled virtual functions.
+func (h Host) NumVFs(link string) (int, error) {
+	return h.readInt(h.devicePath(link, "sriov_numvfs"))
+}
+
+// SetNumVFs changes the number of enabled virtual functions.
+//
+// The kernel doesn't allow to change the number of virtual functions once enabled,
+// so the virtual functions are disabled first.
+func (h Host) SetNumVFs(link string, numVFs int) error {
+	current, err := h.NumVFs(link)
+	if err != nil {
+		return err
+	}
+
+	path := h.devicePath(link, "sriov_numvfs")
+
+	if current != 0 && numVFs != 0 {
+		if err = os.WriteFile(path, []byte("0"), 0o644); err != nil {
+			return fmt.Errorf("error disabling virtual functions: %w", err)
+		}
+	}
+
+	return os.WriteFile(path, []byte(strconv.Itoa(numVFs)), 0o644)
+}
+
+// VirtualFunctions returns the enabled virtual functions of the link.
+func (h Host) VirtualFunctions(link string) ([]VirtualFunction, error) {
+	l, err := netlink.LinkByName(link)
+	if err != nil {
+		return nil, fmt.Errorf("error getting link: %w", err)
+	}
+
+	vfs := make([]VirtualFunction, 0, len(l.Attrs().Vfs))
+
+	for _, info := range l.Attrs().Vfs {
+		vf := VirtualFunction{
+			Index:        info.ID,
+			HardwareAddr: info.Mac,
+			VLAN:         info.Vlan,
+			SpoofCheck:   info.Spoofchk,
+			Trust:        info.Trust != 0,
+		}
+
+		virtfn := h.devicePath(link, "virtfn"+strconv.Itoa(info.ID))
+
+		if target, err := os.Readlink(virtfn); err == nil {
+			vf.PCIAddress = filepath.Base(target)
+		}
+
+		if links, err := os.ReadDir(filepath.Join(virtfn, "net")); err == nil && len(links) > 0 {
+			vf.LinkName = links[0].Name()
+		}
+
+		vfs = append(vfs, vf)
+	}
+
+	return vfs, nil
+}
+
+// SetHardwareAddr sets the hardware address of the virtual function.
+func (h Host) SetHardwareAddr(link string, vf int, addr net.HardwareAddr) error {
+	return h.withLink(link, func(l netlink.Link) error {
+		return netlink.LinkSetVfHardwareAddr(l, vf, addr)
+	})
+}
+
+// SetVLAN sets the VLAN of the virtual function, zero disables VLAN tagging.
+func (h Host) SetVLAN(link string, vf, vlan int) error {
+	return h.withLink(link, func(l netlink.Link) error {
+		return netlink.LinkSetVfVlan(l, vf, vlan)
+	})
+}
+
+// SetSpoofCheck enables or disables the source address spoof checking of the virtual function.
+func (h Host) SetSpoofCheck(link string, vf int, enabled bool) error {
+	return h.withLink(link, func(l netlink.Link) error {
+		return netlink.LinkSetVfSpoofchk(l, vf, enabled)
+	})
+}
+
+// SetTrust enables or disables the trusted mode of the virtual function.
+func (h Host) SetTrust(link string, vf int, enabled bool) error {
+	return h.withLink(link, func(l netlink.Link) error {
+		return netlink.LinkSetVfTrust(l, vf, enabled)
+	})
+}
+
+func (h Host) withLink(link string, f func(netlink.Link) error) error {
+	l, err := netlink.LinkByName(link)
+	if err != nil {
+		return fmt.Errorf("error getting link: %w", err)
+	}
+
+	return f(l)
+}
+
+// IsNotSupported returns true if the error indicates that the link doesn't support SR-IOV.
+func IsNotSupported(err error) bool {
+	return errors.Is(err, os.ErrNotExist)
+}
@@ -0,0 +1,43 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package sriov_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/sriov"
+)
+
+func TestNumVFs(t *testing.T) {
+	t.Parallel()
+
+	root := t.TempDir()
+	device := filepath.Join(root, "class", "net", "enp1s0f0", "device")
+
+	require.NoError(t, os.MkdirAll(device, 0o755))
+	require.NoError(t, os.WriteFile(filepath.Join(device, "sriov_totalvfs"), []byte("64\n"), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(device, "sriov_numvfs"), []byte("0\n"), 0o644))
+
+	host := sriov.Host{SysfsRoot: root}
+
+	totalVFs, err := host.TotalVFs("enp1s0f0")
+	require.NoError(t, err)
+	assert.Equal(t, 64, totalVFs)
+
+	_, err = host.TotalVFs("eth0")
+	assert.True(t, sriov.IsNotSupported(err))
+
+	require.NoError(t, host.SetNumVFs("enp1s0f0", 4))
+	require.NoError(t, host.SetNumVFs("enp1s0f0", 8))
+
+	numVFs, err := host.NumVFs("enp1s0f0")
+	require.NoError(t, err)
+	assert.Equal(t, 8, numVFs)
+}
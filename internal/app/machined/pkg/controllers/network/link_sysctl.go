@@ -0,0 +1,157 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/kernel"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// LinkSysctlController renders the LinkSysctlConfig documents to the kernel params of the existing links.
+type LinkSysctlController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *LinkSysctlController) Name() string {
+	return "network.LinkSysctlController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *LinkSysctlController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *LinkSysctlController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.KernelParamSpecType,
+			Kind: controller.OutputShared,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *LinkSysctlController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		if err := ctrl.reconcile(ctx, r, logger); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *LinkSysctlController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting config: %w", err)
+	}
+
+	r.StartTrackingOutputs()
+
+	if cfg != nil {
+		var machineSysctls map[string]string
+
+		if cfg.Config().Machine() != nil {
+			machineSysctls = cfg.Config().Machine().Sysctls()
+		}
+
+		for _, linkSysctlConfig := range cfg.Config().LinkSysctlConfigs() {
+			// the link might not exist yet, the params are created once it appears
+			if _, err = safe.ReaderGetByID[*network.LinkStatus](ctx, r, linkSysctlConfig.Link()); err != nil {
+				if state.IsNotFoundError(err) {
+					continue
+				}
+
+				return fmt.Errorf("error getting link status: %w", err)
+			}
+
+			for key, value := range linkSysctlParams(linkSysctlConfig) {
+				// .machine.sysctls take precedence, and the param can't be owned by two controllers
+				if _, overridden := machineSysctls[key]; overridden {
+					logger.Warn("kernel param is overridden by machine sysctls", zap.String("key", key))
+
+					continue
+				}
+
+				if err = safe.WriterModify(ctx, r, runtime.NewKernelParamSpec(runtime.NamespaceName, kernel.Sysctl+"."+key), func(res *runtime.KernelParamSpec) error {
+					res.TypedSpec().Value = value
+					// the link might disappear before the param is applied
+					res.TypedSpec().IgnoreErrors = true
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error updating kernel param: %w", err)
+				}
+			}
+		}
+	}
+
+	return safe.CleanupOutputs[*runtime.KernelParamSpec](ctx, r)
+}
+
+// linkSysctlParams renders the config to sysctl keys and values.
+func linkSysctlParams(linkSysctlConfig talosconfig.LinkSysctlConfig) map[string]string {
+	// dots in the link name (VLANs) are written as slashes in the sysctl key
+	linkName := strings.ReplaceAll(linkSysctlConfig.Link(), ".", "/")
+
+	params := map[string]string{}
+
+	setBool := func(key string, value optional.Optional[bool]) {
+		if enabled, ok := value.Get(); ok {
+			if enabled {
+				params[key] = "1"
+			} else {
+				params[key] = "0"
+			}
+		}
+	}
+
+	setInt := func(key string, value optional.Optional[int]) {
+		if v, ok := value.Get(); ok {
+			params[key] = strconv.Itoa(v)
+		}
+	}
+
+	setBool("net.ipv4.conf."+linkName+".forwarding", linkSysctlConfig.IPv4Forwarding())
+	setBool("net.ipv6.conf."+linkName+".forwarding", linkSysctlConfig.IPv6Forwarding())
+	setInt("net.ipv4.conf."+linkName+".rp_filter", linkSysctlConfig.RPFilter())
+	setBool("net.ipv4.conf."+linkName+".proxy_arp", linkSysctlConfig.ProxyARP())
+	setInt("net.ipv4.conf."+linkName+".arp_ignore", linkSysctlConfig.ARPIgnore())
+
+	return params
+}
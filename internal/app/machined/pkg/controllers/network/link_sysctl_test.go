@@ -0,0 +1,99 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type LinkSysctlSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *LinkSysctlSuite) TestReconcile() {
+	eth1Cfg := networkcfg.NewLinkSysctlConfigV1Alpha1("eth1")
+	eth1Cfg.LinkIPv4Forwarding = pointer.To(true)
+	eth1Cfg.LinkIPv6Forwarding = pointer.To(false)
+	eth1Cfg.LinkRPFilter = networkcfg.RPFilterLoose
+	eth1Cfg.LinkProxyARP = pointer.To(true)
+
+	vlanCfg := networkcfg.NewLinkSysctlConfigV1Alpha1("eth1.100")
+	vlanCfg.LinkARPIgnore = pointer.To(1)
+	vlanCfg.LinkIPv4Forwarding = pointer.To(true)
+
+	cfg, err := container.New(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineSysctls: map[string]string{
+				"net.ipv4.conf.eth1/100.forwarding": "0",
+			},
+		},
+	}, eth1Cfg, vlanCfg)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+
+	// no links yet
+	ctest.AssertNoResource[*runtime.KernelParamSpec](suite, "proc.sys.net.ipv4.conf.eth1.forwarding")
+
+	for _, linkName := range []string{"eth1", "eth1.100"} {
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), network.NewLinkStatus(network.NamespaceName, linkName)))
+	}
+
+	ctest.AssertResources(suite, []string{
+		"proc.sys.net.ipv4.conf.eth1.forwarding",
+		"proc.sys.net.ipv6.conf.eth1.forwarding",
+		"proc.sys.net.ipv4.conf.eth1.rp_filter",
+		"proc.sys.net.ipv4.conf.eth1.proxy_arp",
+		"proc.sys.net.ipv4.conf.eth1/100.arp_ignore",
+	}, func(param *runtime.KernelParamSpec, asrt *assert.Assertions) {
+		asrt.True(param.TypedSpec().IgnoreErrors)
+
+		switch param.Metadata().ID() {
+		case "proc.sys.net.ipv6.conf.eth1.forwarding":
+			asrt.Equal("0", param.TypedSpec().Value)
+		case "proc.sys.net.ipv4.conf.eth1.rp_filter":
+			asrt.Equal("2", param.TypedSpec().Value)
+		default:
+			asrt.Equal("1", param.TypedSpec().Value)
+		}
+	})
+
+	// overridden by .machine.sysctls
+	ctest.AssertNoResource[*runtime.KernelParamSpec](suite, "proc.sys.net.ipv4.conf.eth1/100.forwarding")
+
+	// the params are removed with the link
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), network.NewLinkStatus(network.NamespaceName, "eth1.100").Metadata()))
+
+	ctest.AssertNoResource[*runtime.KernelParamSpec](suite, "proc.sys.net.ipv4.conf.eth1/100.arp_ignore")
+	ctest.AssertResource(suite, "proc.sys.net.ipv4.conf.eth1.forwarding", func(*runtime.KernelParamSpec, *assert.Assertions) {})
+}
+
+func TestLinkSysctlSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &LinkSysctlSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.LinkSysctlController{}))
+			},
+		},
+	})
+}
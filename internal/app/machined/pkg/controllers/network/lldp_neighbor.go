@@ -0,0 +1,226 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/lldp"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// lldpExpireInterval is the interval to check for the expired LLDP neighbors.
+const lldpExpireInterval = 5 * time.Second
+
+// LLDPNeighborController listens for LLDP advertisements on the links and outputs LLDPNeighbors.
+type LLDPNeighborController struct {
+	listeners map[string]*lldp.Listener
+	neighbors map[string]lldpNeighbor
+}
+
+type lldpNeighbor struct {
+	spec    network.LLDPNeighborSpec
+	expires time.Time
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *LLDPNeighborController) Name() string {
+	return "network.LLDPNeighborController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *LLDPNeighborController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *LLDPNeighborController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: network.LLDPNeighborType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *LLDPNeighborController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	notifyCh := make(chan lldp.Notification)
+
+	ctrl.listeners = make(map[string]*lldp.Listener)
+	ctrl.neighbors = make(map[string]lldpNeighbor)
+
+	defer func() {
+		for _, listener := range ctrl.listeners {
+			listener.Stop()
+		}
+	}()
+
+	ticker := time.NewTicker(lldpExpireInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+			if err := ctrl.reconcileListeners(ctx, r, logger, notifyCh); err != nil {
+				return err
+			}
+		case ev := <-notifyCh:
+			ctrl.handleNotification(ev)
+		case <-ticker.C:
+		}
+
+		if err := ctrl.reconcileOutputs(ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+//nolint:gocyclo
+func (ctrl *LLDPNeighborController) reconcileListeners(ctx context.Context, r controller.Runtime, logger *zap.Logger, notifyCh chan<- lldp.Notification) error {
+	cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting config: %w", err)
+	}
+
+	// figure out which links should be listened on
+	shouldListen := map[string]uint32{}
+
+	if cfg != nil && cfg.Config().LLDP() != nil {
+		interfaces := cfg.Config().LLDP().Interfaces()
+
+		links, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing links: %w", err)
+		}
+
+		for iter := links.Iterator(); iter.Next(); {
+			link := iter.Value()
+
+			if len(interfaces) == 0 && !link.TypedSpec().Physical() {
+				continue
+			}
+
+			if len(interfaces) > 0 && !slices.Contains(interfaces, link.Metadata().ID()) {
+				continue
+			}
+
+			shouldListen[link.Metadata().ID()] = link.TypedSpec().Index
+		}
+	}
+
+	// stop listeners which shouldn't run, or if the link was re-created
+	for linkName, listener := range ctrl.listeners {
+		if index, exists := shouldListen[linkName]; exists && index == listener.LinkIndex {
+			continue
+		}
+
+		logger.Debug("stopping LLDP listener", zap.String("link", linkName))
+
+		listener.Stop()
+		delete(ctrl.listeners, linkName)
+
+		for id, neighbor := range ctrl.neighbors {
+			if neighbor.spec.LinkName == linkName {
+				delete(ctrl.neighbors, id)
+			}
+		}
+	}
+
+	// start listeners which aren't running
+	for linkName, index := range shouldListen {
+		if _, exists := ctrl.listeners[linkName]; exists {
+			continue
+		}
+
+		listener := &lldp.Listener{
+			LinkName:  linkName,
+			LinkIndex: index,
+		}
+
+		if err = listener.Start(ctx, notifyCh, logger); err != nil {
+			logger.Warn("failed to start LLDP listener", zap.String("link", linkName), zap.Error(err))
+
+			continue
+		}
+
+		logger.Debug("started LLDP listener", zap.String("link", linkName))
+
+		ctrl.listeners[linkName] = listener
+	}
+
+	return nil
+}
+
+func (ctrl *LLDPNeighborController) handleNotification(ev lldp.Notification) {
+	if _, exists := ctrl.listeners[ev.LinkName]; !exists {
+		// listener was already stopped, late notification, ignore it
+		return
+	}
+
+	id := ev.LinkName + "/" + ev.Neighbor.ChassisID + "/" + ev.Neighbor.PortID
+
+	// zero TTL means the neighbor is shutting down
+	if ev.Neighbor.TTL == 0 {
+		delete(ctrl.neighbors, id)
+
+		return
+	}
+
+	ctrl.neighbors[id] = lldpNeighbor{
+		spec:    ev.Neighbor,
+		expires: time.Now().Add(ev.Neighbor.TTL),
+	}
+}
+
+func (ctrl *LLDPNeighborController) reconcileOutputs(ctx context.Context, r controller.Runtime) error {
+	r.StartTrackingOutputs()
+
+	now := time.Now()
+
+	for id, neighbor := range ctrl.neighbors {
+		if now.After(neighbor.expires) {
+			delete(ctrl.neighbors, id)
+
+			continue
+		}
+
+		if err := safe.WriterModify(ctx, r, network.NewLLDPNeighbor(network.NamespaceName, id), func(res *network.LLDPNeighbor) error {
+			*res.TypedSpec() = neighbor.spec
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating LLDP neighbor: %w", err)
+		}
+	}
+
+	return safe.CleanupOutputs[*network.LLDPNeighbor](ctx, r)
+}
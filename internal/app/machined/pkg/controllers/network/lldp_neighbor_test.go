@@ -0,0 +1,113 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"context"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/mdlayher/packet"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type LLDPNeighborSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *LLDPNeighborSuite) TestLoopback() {
+	if os.Geteuid() != 0 {
+		suite.T().Skip("requires root")
+	}
+
+	lo, err := net.InterfaceByName("lo")
+	suite.Require().NoError(err)
+
+	linkStatus := network.NewLinkStatus(network.NamespaceName, lo.Name)
+	linkStatus.TypedSpec().Index = uint32(lo.Index)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), linkStatus))
+
+	lldpCfg := networkcfg.NewLLDPConfigV1Alpha1()
+	lldpCfg.LLDPInterfaces = []string{lo.Name}
+
+	cfg, err := container.New(lldpCfg)
+	suite.Require().NoError(err)
+
+	machineConfig := config.NewMachineConfig(cfg)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineConfig))
+
+	conn, err := packet.Listen(lo, packet.Datagram, unix.ETH_P_LLDP, nil)
+	suite.Require().NoError(err)
+
+	defer conn.Close() //nolint:errcheck
+
+	lldpdu := []byte{
+		0x02, 0x07, 0x04, 0x00, 0x1c, 0x73, 0x01, 0x02, 0x03, // chassis ID: MAC address
+		0x04, 0x0b, 0x05, 'E', 't', 'h', 'e', 'r', 'n', 'e', 't', '1', '2', // port ID: interface name
+		0x06, 0x02, 0x00, 0x78, // TTL: 120 seconds
+		0x0a, 0x06, 't', 'o', 'r', '-', 'a', '1', // system name
+		0x00, 0x00, // end
+	}
+
+	id := lo.Name + "/00:1c:73:01:02:03/Ethernet12"
+
+	// the listener is started asynchronously, so keep sending the frames until the neighbor shows up
+	sendCtx, sendCancel := context.WithCancel(suite.Ctx())
+	defer sendCancel()
+
+	go func() {
+		ticker := time.NewTicker(100 * time.Millisecond)
+		defer ticker.Stop()
+
+		for {
+			conn.WriteTo(lldpdu, &packet.Addr{HardwareAddr: net.HardwareAddr{0x01, 0x80, 0xc2, 0x00, 0x00, 0x0e}}) //nolint:errcheck
+
+			select {
+			case <-sendCtx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	ctest.AssertResource(suite, id, func(neighbor *network.LLDPNeighbor, asrt *assert.Assertions) {
+		spec := neighbor.TypedSpec()
+
+		asrt.Equal(lo.Name, spec.LinkName)
+		asrt.Equal("00:1c:73:01:02:03", spec.ChassisID)
+		asrt.Equal("Ethernet12", spec.PortID)
+		asrt.Equal("tor-a1", spec.SystemName)
+		asrt.Equal(120*time.Second, spec.TTL)
+	})
+
+	// removing the LLDP config stops the listener and removes the neighbors
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), machineConfig.Metadata()))
+
+	ctest.AssertNoResource[*network.LLDPNeighbor](suite, id)
+}
+
+func TestLLDPNeighborSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &LLDPNeighborSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.LLDPNeighborController{}))
+			},
+		},
+	})
+}
@@ -151,7 +151,7 @@ func findMatchingRoutes(existingRoutes []rtnetlink.RouteMessage, expected *netwo
 			continue
 		}
 
-		if nethelpers.RoutingTable(route.Table) != expected.Table {
+		if routeTable(&route) != expected.Table {
 			continue
 		}
 
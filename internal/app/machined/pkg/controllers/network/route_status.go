@@ -104,7 +104,9 @@ func (ctrl *RouteStatusController) Run(ctx context.Context, r controller.Runtime
 			gatewayAddr, _ := netip.AddrFromSlice(route.Attributes.Gateway)
 			outLinkName := linkLookup[route.Attributes.OutIface]
 
-			id := network.RouteID(nethelpers.RoutingTable(route.Table), nethelpers.Family(route.Family), dstPrefix, gatewayAddr, route.Attributes.Priority, outLinkName)
+			table := routeTable(&route)
+
+			id := network.RouteID(table, nethelpers.Family(route.Family), dstPrefix, gatewayAddr, route.Attributes.Priority, outLinkName)
 
 			if err = r.Modify(ctx, network.NewRouteStatus(network.NamespaceName, id), func(r resource.Resource) error {
 				status := r.(*network.RouteStatus).TypedSpec()
@@ -116,7 +118,7 @@ func (ctrl *RouteStatusController) Run(ctx context.Context, r controller.Runtime
 				status.OutLinkIndex = route.Attributes.OutIface
 				status.OutLinkName = outLinkName
 				status.Priority = route.Attributes.Priority
-				status.Table = nethelpers.RoutingTable(route.Table)
+				status.Table = table
 				status.Scope = nethelpers.Scope(route.Scope)
 				status.Type = nethelpers.RouteType(route.Type)
 				status.Protocol = nethelpers.RouteProtocol(route.Protocol)
@@ -145,3 +147,14 @@ func (ctrl *RouteStatusController) Run(ctx context.Context, r controller.Runtime
 		r.ResetRestartBackoff()
 	}
 }
+
+// routeTable returns the routing table ID of the route.
+//
+// Routing table IDs which don't fit into the message header are only reported in the RTA_TABLE attribute.
+func routeTable(route *rtnetlink.RouteMessage) nethelpers.RoutingTable {
+	if route.Attributes.Table != 0 {
+		return nethelpers.RoutingTable(route.Attributes.Table)
+	}
+
+	return nethelpers.RoutingTable(route.Table)
+}
@@ -0,0 +1,245 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net"
+	"strconv"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/sriov"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// SRIOVHost manages the SR-IOV virtual functions of the links.
+type SRIOVHost interface {
+	TotalVFs(link string) (int, error)
+	NumVFs(link string) (int, error)
+	SetNumVFs(link string, numVFs int) error
+	VirtualFunctions(link string) ([]sriov.VirtualFunction, error)
+	SetHardwareAddr(link string, vf int, addr net.HardwareAddr) error
+	SetVLAN(link string, vf, vlan int) error
+	SetSpoofCheck(link string, vf int, enabled bool) error
+	SetTrust(link string, vf int, enabled bool) error
+}
+
+// SRIOVController enables the SR-IOV virtual functions from the SRIOVConfig documents,
+// and reports the virtual functions of the links as VirtualFunction resources.
+type SRIOVController struct {
+	// Host defaults to managing the virtual functions via sysfs and netlink.
+	Host SRIOVHost
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *SRIOVController) Name() string {
+	return "network.SRIOVController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *SRIOVController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.LinkStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *SRIOVController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: hardware.VirtualFunctionType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *SRIOVController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.Host == nil {
+		ctrl.Host = sriov.Host{}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		if err := ctrl.reconcile(ctx, r, logger); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+//nolint:gocyclo
+func (ctrl *SRIOVController) reconcile(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return fmt.Errorf("error getting config: %w", err)
+	}
+
+	configs := map[string]talosconfig.SRIOVConfig{}
+
+	if cfg != nil {
+		for _, sriovConfig := range cfg.Config().SRIOVConfigs() {
+			configs[sriovConfig.Link()] = sriovConfig
+		}
+	}
+
+	links, err := safe.ReaderListAll[*network.LinkStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing links: %w", err)
+	}
+
+	r.StartTrackingOutputs()
+
+	for iter := links.Iterator(); iter.Next(); {
+		linkName := iter.Value().Metadata().ID()
+
+		sriovConfig, configured := configs[linkName]
+
+		if !configured && !iter.Value().TypedSpec().Physical() {
+			continue
+		}
+
+		linkLogger := logger.With(zap.String("link", linkName))
+
+		totalVFs, err := ctrl.Host.TotalVFs(linkName)
+		if err != nil {
+			if configured {
+				linkLogger.Warn("link doesn't support SR-IOV", zap.Error(err))
+			}
+
+			continue
+		}
+
+		if configured {
+			if err = ctrl.apply(linkLogger, linkName, totalVFs, sriovConfig); err != nil {
+				linkLogger.Warn("failed to configure SR-IOV", zap.Error(err))
+			}
+		}
+
+		vfs, err := ctrl.Host.VirtualFunctions(linkName)
+		if err != nil {
+			linkLogger.Warn("failed to list virtual functions", zap.Error(err))
+
+			continue
+		}
+
+		for _, vf := range vfs {
+			if err = safe.WriterModify(ctx, r, hardware.NewVirtualFunction(linkName+"/"+strconv.Itoa(vf.Index)), func(res *hardware.VirtualFunction) error {
+				spec := res.TypedSpec()
+
+				spec.PhysicalFunction = linkName
+				spec.Index = uint32(vf.Index)
+				spec.PCIAddress = vf.PCIAddress
+				spec.LinkName = vf.LinkName
+				spec.HardwareAddr = vf.HardwareAddr.String()
+				spec.VLAN = uint32(vf.VLAN)
+				spec.SpoofCheck = vf.SpoofCheck
+				spec.Trust = vf.Trust
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating virtual function: %w", err)
+			}
+		}
+	}
+
+	return safe.CleanupOutputs[*hardware.VirtualFunction](ctx, r)
+}
+
+// apply enables the virtual functions and changes the settings which differ from the config.
+//
+//nolint:gocyclo
+func (ctrl *SRIOVController) apply(logger *zap.Logger, linkName string, totalVFs int, sriovConfig talosconfig.SRIOVConfig) error {
+	if sriovConfig.NumVFs() > totalVFs {
+		return fmt.Errorf("link supports at most %d virtual functions, %d requested", totalVFs, sriovConfig.NumVFs())
+	}
+
+	numVFs, err := ctrl.Host.NumVFs(linkName)
+	if err != nil {
+		return fmt.Errorf("error getting number of virtual functions: %w", err)
+	}
+
+	if numVFs != sriovConfig.NumVFs() {
+		logger.Info("changing number of virtual functions", zap.Int("old", numVFs), zap.Int("new", sriovConfig.NumVFs()))
+
+		if err = ctrl.Host.SetNumVFs(linkName, sriovConfig.NumVFs()); err != nil {
+			return fmt.Errorf("error setting number of virtual functions: %w", err)
+		}
+	}
+
+	vfs, err := ctrl.Host.VirtualFunctions(linkName)
+	if err != nil {
+		return fmt.Errorf("error listing virtual functions: %w", err)
+	}
+
+	current := make(map[int]sriov.VirtualFunction, len(vfs))
+
+	for _, vf := range vfs {
+		current[vf.Index] = vf
+	}
+
+	for _, vfConfig := range sriovConfig.VirtualFunctions() {
+		vf, exists := current[vfConfig.Index]
+		if !exists {
+			logger.Warn("virtual function not found", zap.Int("vf", vfConfig.Index))
+
+			continue
+		}
+
+		vfLogger := logger.With(zap.Int("vf", vfConfig.Index))
+
+		if hwAddr, ok := vfConfig.HardwareAddr.Get(); ok && !bytes.Equal(hwAddr, vf.HardwareAddr) {
+			if err = ctrl.Host.SetHardwareAddr(linkName, vf.Index, hwAddr); err != nil {
+				vfLogger.Warn("failed to set hardware address", zap.Error(err))
+			}
+		}
+
+		if vlan, ok := vfConfig.VLAN.Get(); ok && vlan != vf.VLAN {
+			if err = ctrl.Host.SetVLAN(linkName, vf.Index, vlan); err != nil {
+				vfLogger.Warn("failed to set VLAN", zap.Error(err))
+			}
+		}
+
+		if spoofCheck, ok := vfConfig.SpoofCheck.Get(); ok && spoofCheck != vf.SpoofCheck {
+			if err = ctrl.Host.SetSpoofCheck(linkName, vf.Index, spoofCheck); err != nil {
+				vfLogger.Warn("failed to set spoof checking", zap.Error(err))
+			}
+		}
+
+		if trust, ok := vfConfig.Trust.Get(); ok && trust != vf.Trust {
+			if err = ctrl.Host.SetTrust(linkName, vf.Index, trust); err != nil {
+				vfLogger.Warn("failed to set trusted mode", zap.Error(err))
+			}
+		}
+	}
+
+	return nil
+}
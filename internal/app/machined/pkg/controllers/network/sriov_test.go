@@ -0,0 +1,186 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	"net"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	netctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/network/internal/sriov"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	networkcfg "github.com/siderolabs/talos/pkg/machinery/config/types/network"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+type mockSRIOVHost struct {
+	mu sync.Mutex
+
+	totalVFs map[string]int
+	vfs      map[string][]sriov.VirtualFunction
+}
+
+func (m *mockSRIOVHost) TotalVFs(link string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	totalVFs, ok := m.totalVFs[link]
+	if !ok {
+		return 0, os.ErrNotExist
+	}
+
+	return totalVFs, nil
+}
+
+func (m *mockSRIOVHost) NumVFs(link string) (int, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.vfs[link]), nil
+}
+
+func (m *mockSRIOVHost) SetNumVFs(link string, numVFs int) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	vfs := make([]sriov.VirtualFunction, 0, numVFs)
+
+	for i := range numVFs {
+		vfs = append(vfs, sriov.VirtualFunction{
+			Index:        i,
+			PCIAddress:   "0000:01:10." + string(rune('0'+i)),
+			HardwareAddr: net.HardwareAddr{0, 0, 0, 0, 0, 0},
+			SpoofCheck:   true,
+		})
+	}
+
+	m.vfs[link] = vfs
+
+	return nil
+}
+
+func (m *mockSRIOVHost) VirtualFunctions(link string) ([]sriov.VirtualFunction, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return append([]sriov.VirtualFunction(nil), m.vfs[link]...), nil
+}
+
+func (m *mockSRIOVHost) update(link string, vf int, f func(*sriov.VirtualFunction)) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	f(&m.vfs[link][vf])
+
+	return nil
+}
+
+func (m *mockSRIOVHost) SetHardwareAddr(link string, vf int, addr net.HardwareAddr) error {
+	return m.update(link, vf, func(v *sriov.VirtualFunction) { v.HardwareAddr = addr })
+}
+
+func (m *mockSRIOVHost) SetVLAN(link string, vf, vlan int) error {
+	return m.update(link, vf, func(v *sriov.VirtualFunction) { v.VLAN = vlan })
+}
+
+func (m *mockSRIOVHost) SetSpoofCheck(link string, vf int, enabled bool) error {
+	return m.update(link, vf, func(v *sriov.VirtualFunction) { v.SpoofCheck = enabled })
+}
+
+func (m *mockSRIOVHost) SetTrust(link string, vf int, enabled bool) error {
+	return m.update(link, vf, func(v *sriov.VirtualFunction) { v.Trust = enabled })
+}
+
+type SRIOVSuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *SRIOVSuite) TestReconcile() {
+	for _, linkName := range []string{"enp1s0f0", "eth0"} {
+		link := network.NewLinkStatus(network.NamespaceName, linkName)
+		link.TypedSpec().Type = nethelpers.LinkEther
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), link))
+	}
+
+	sriovCfg := networkcfg.NewSRIOVConfigV1Alpha1("enp1s0f0")
+	sriovCfg.SRIOVNumVFs = 2
+	sriovCfg.SRIOVVirtualFunctions = []networkcfg.SRIOVVirtualFunctionConfig{
+		{
+			VFIndex:        1,
+			VFHardwareAddr: "02:00:00:00:00:01",
+			VFVLAN:         pointer.To(100),
+			VFSpoofCheck:   pointer.To(false),
+			VFTrust:        pointer.To(true),
+		},
+	}
+
+	cfg, err := container.New(sriovCfg)
+	suite.Require().NoError(err)
+
+	machineConfig := config.NewMachineConfig(cfg)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineConfig))
+
+	ctest.AssertResource(suite, "enp1s0f0/0", func(vf *hardware.VirtualFunction, asrt *assert.Assertions) {
+		spec := vf.TypedSpec()
+
+		asrt.Equal("enp1s0f0", spec.PhysicalFunction)
+		asrt.EqualValues(0, spec.Index)
+		asrt.Equal("0000:01:10.0", spec.PCIAddress)
+		asrt.Equal("00:00:00:00:00:00", spec.HardwareAddr)
+		asrt.True(spec.SpoofCheck)
+		asrt.False(spec.Trust)
+	})
+
+	ctest.AssertResource(suite, "enp1s0f0/1", func(vf *hardware.VirtualFunction, asrt *assert.Assertions) {
+		spec := vf.TypedSpec()
+
+		asrt.EqualValues(1, spec.Index)
+		asrt.Equal("02:00:00:00:00:01", spec.HardwareAddr)
+		asrt.EqualValues(100, spec.VLAN)
+		asrt.False(spec.SpoofCheck)
+		asrt.True(spec.Trust)
+	})
+
+	// disabling the virtual functions removes them
+	sriovCfg = networkcfg.NewSRIOVConfigV1Alpha1("enp1s0f0")
+
+	cfg, err = container.New(sriovCfg)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), machineConfig.Metadata()))
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+
+	ctest.AssertNoResource[*hardware.VirtualFunction](suite, "enp1s0f0/0")
+	ctest.AssertNoResource[*hardware.VirtualFunction](suite, "enp1s0f0/1")
+}
+
+func TestSRIOVSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &SRIOVSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&netctrl.SRIOVController{
+					Host: &mockSRIOVHost{
+						totalVFs: map[string]int{"enp1s0f0": 8},
+						vfs:      map[string][]sriov.VirtualFunction{},
+					},
+				}))
+			},
+		},
+	})
+}
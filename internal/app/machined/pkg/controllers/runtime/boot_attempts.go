@@ -0,0 +1,104 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/options"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// BootAttemptsController resets the bootloader boot attempts counter once machine reaches ready & running.
+//
+// If the bootloader fell back to the previous boot entry after exhausting the boot attempts, an event is published.
+type BootAttemptsController struct {
+	V1Alpha1Events machineruntime.Publisher
+	V1Alpha1Mode   machineruntime.Mode
+
+	ResetBootAttempts func(context.Context) (*options.BootFallback, error)
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *BootAttemptsController) Name() string {
+	return "runtime.BootAttemptsController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *BootAttemptsController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.MachineStatusType,
+			ID:        optional.Some(runtime.MachineStatusID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *BootAttemptsController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *BootAttemptsController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// there is no bootloader in container mode
+	if ctrl.V1Alpha1Mode == machineruntime.ModeContainer {
+		return nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		machineStatus, err := safe.ReaderGetByID[*runtime.MachineStatus](ctx, r, runtime.MachineStatusID)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting machine status: %w", err)
+		}
+
+		if !(machineStatus.TypedSpec().Stage == runtime.MachineStageRunning && machineStatus.TypedSpec().Status.Ready) {
+			continue
+		}
+
+		fallback, err := ctrl.ResetBootAttempts(ctx)
+		if err != nil {
+			return fmt.Errorf("error resetting boot attempts: %w", err)
+		}
+
+		if fallback != nil {
+			logger.Warn("bootloader fell back to the previous boot entry",
+				zap.String("failed_label", fallback.FailedLabel),
+				zap.String("booted_label", fallback.BootedLabel),
+				zap.Int("boot_attempts", fallback.BootAttempts),
+			)
+
+			ctrl.V1Alpha1Events.Publish(ctx, &machine.BootFallbackEvent{
+				FailedLabel:  fallback.FailedLabel,
+				BootedLabel:  fallback.BootedLabel,
+				BootAttempts: int32(fallback.BootAttempts),
+			})
+		}
+
+		// terminating the controller here, as boot attempts are reset only once on boot
+		return nil
+	}
+}
@@ -0,0 +1,123 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/options"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type BootAttemptsControllerSuite struct {
+	ctest.DefaultSuite
+
+	events *eventPublisherMock
+
+	mu     sync.Mutex
+	resets int
+}
+
+type eventPublisherMock struct {
+	mu     sync.Mutex
+	events []proto.Message
+}
+
+func (e *eventPublisherMock) Publish(_ context.Context, ev proto.Message) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	e.events = append(e.events, ev)
+}
+
+func (e *eventPublisherMock) getEvents() []proto.Message {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	return slices.Clone(e.events)
+}
+
+func TestBootAttemptsControllerSuite(t *testing.T) {
+	s := &BootAttemptsControllerSuite{
+		events: &eventPublisherMock{},
+	}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			suite.Require().NoError(suite.Runtime().RegisterController(&runtime.BootAttemptsController{
+				V1Alpha1Events: s.events,
+				V1Alpha1Mode:   machineruntime.ModeMetal,
+				ResetBootAttempts: func(context.Context) (*options.BootFallback, error) {
+					s.mu.Lock()
+					defer s.mu.Unlock()
+
+					s.resets++
+
+					return &options.BootFallback{
+						FailedLabel:  "B",
+						BootedLabel:  "A",
+						BootAttempts: 3,
+					}, nil
+				},
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *BootAttemptsControllerSuite) getResets() int {
+	suite.mu.Lock()
+	defer suite.mu.Unlock()
+
+	return suite.resets
+}
+
+func (suite *BootAttemptsControllerSuite) TestReset() {
+	machineStatus := runtimeres.NewMachineStatus()
+	machineStatus.TypedSpec().Stage = runtimeres.MachineStageBooting
+	machineStatus.TypedSpec().Status.Ready = false
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineStatus))
+
+	time.Sleep(time.Second)
+
+	// boot attempts should not be reset until the machine is ready
+	suite.Require().Zero(suite.getResets())
+	suite.Require().Empty(suite.events.getEvents())
+
+	machineStatus.TypedSpec().Status.Ready = true
+	machineStatus.TypedSpec().Stage = runtimeres.MachineStageRunning
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), machineStatus))
+
+	suite.AssertWithin(time.Second, 10*time.Millisecond, func() error {
+		if len(suite.events.getEvents()) == 0 {
+			return retry.ExpectedErrorf("no events published yet")
+		}
+
+		return nil
+	})
+
+	suite.Assert().Equal(1, suite.getResets())
+
+	events := suite.events.getEvents()
+	suite.Require().Len(events, 1)
+	suite.Assert().True(proto.Equal(&machine.BootFallbackEvent{
+		FailedLabel:  "B",
+		BootedLabel:  "A",
+		BootAttempts: 3,
+	}, events[0]))
+}
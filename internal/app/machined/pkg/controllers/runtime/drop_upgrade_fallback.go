@@ -16,6 +16,7 @@ import (
 
 	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/meta"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
 
@@ -25,6 +26,8 @@ type MetaProvider interface {
 }
 
 // DropUpgradeFallbackController removes upgrade fallback key once machine reaches ready & running.
+//
+// If the post-upgrade health checks are configured, the fallback key is removed by the UpgradeHealthCheckController instead.
 type DropUpgradeFallbackController struct {
 	MetaProvider MetaProvider
 }
@@ -43,6 +46,12 @@ func (ctrl *DropUpgradeFallbackController) Inputs() []controller.Input {
 			ID:        optional.Some(runtime.MachineStatusID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -75,6 +84,16 @@ func (ctrl *DropUpgradeFallbackController) Run(ctx context.Context, r controller
 			continue
 		}
 
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		if cfg != nil && cfg.Config().Runtime().UpgradeHealthCheck() != nil {
+			// fallback is handled by the post-upgrade health checks
+			return nil
+		}
+
 		ok, err := ctrl.MetaProvider.Meta().DeleteTag(ctx, meta.Upgrade)
 		if err != nil {
 			return err
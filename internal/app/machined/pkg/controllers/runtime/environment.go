@@ -0,0 +1,150 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/environment"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+)
+
+// environmentServices is the list of services restarted when the environment changes.
+//
+// CRI pulls the images on its own, so it has to be restarted to pick up the proxy settings,
+// the rest of the services pick up the environment on the next start.
+var environmentServices = []string{"cri", "kubelet"}
+
+// EnvironmentController applies the machine environment variables to machined and the services.
+//
+// The environment of machined is updated in place, so that the image pulls done by machined (e.g. installer image)
+// use the new proxy settings right away.
+type EnvironmentController struct {
+	V1Alpha1Services ServiceManager
+
+	applied []string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *EnvironmentController) Name() string {
+	return "runtime.EnvironmentController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *EnvironmentController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *EnvironmentController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *EnvironmentController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		env := environment.Get(cfg.Config())
+		slices.Sort(env)
+
+		if slices.Equal(env, ctrl.applied) {
+			continue
+		}
+
+		if err = ctrl.setEnv(env); err != nil {
+			return err
+		}
+
+		// services started before the first apply get the environment from the machine config directly
+		if ctrl.applied != nil {
+			if err = ctrl.restartServices(ctx, logger); err != nil {
+				return err
+			}
+		}
+
+		ctrl.applied = env
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *EnvironmentController) setEnv(env []string) error {
+	keys := map[string]struct{}{}
+
+	for _, value := range env {
+		key, val, _ := strings.Cut(value, "=")
+
+		if err := os.Setenv(key, val); err != nil {
+			return fmt.Errorf("failed to set environment variable %q: %w", key, err)
+		}
+
+		keys[key] = struct{}{}
+	}
+
+	for _, value := range ctrl.applied {
+		key, _, _ := strings.Cut(value, "=")
+
+		if _, ok := keys[key]; ok {
+			continue
+		}
+
+		if err := os.Unsetenv(key); err != nil {
+			return fmt.Errorf("failed to unset environment variable %q: %w", key, err)
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *EnvironmentController) restartServices(ctx context.Context, logger *zap.Logger) error {
+	for _, id := range environmentServices {
+		if _, running, err := ctrl.V1Alpha1Services.IsRunning(id); err != nil || !running {
+			continue
+		}
+
+		logger.Info("restarting service to apply the environment", zap.String("service", id))
+
+		if err := ctrl.V1Alpha1Services.Stop(ctx, id); err != nil {
+			return fmt.Errorf("error stopping service %q: %w", id, err)
+		}
+
+		if err := ctrl.V1Alpha1Services.Start(id); err != nil {
+			return fmt.Errorf("error starting service %q: %w", id, err)
+		}
+	}
+
+	return nil
+}
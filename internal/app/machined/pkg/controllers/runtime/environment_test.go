@@ -0,0 +1,132 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"fmt"
+	"os"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimecontrollers "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/services"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+)
+
+const testEnvKey = "TALOS_TEST_ENVIRONMENT_CONTROLLER"
+
+type EnvironmentSuite struct {
+	ctest.DefaultSuite
+
+	svcMock *serviceMock
+}
+
+func TestEnvironmentSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &EnvironmentSuite{
+		svcMock: &serviceMock{
+			services:     map[string]system.Service{},
+			running:      map[string]bool{},
+			timesStarted: map[string]int{},
+			timesStopped: map[string]int{},
+		},
+	}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			suite.Require().NoError(suite.Runtime().RegisterController(&runtimecontrollers.EnvironmentController{
+				V1Alpha1Services: s.svcMock,
+			}))
+		},
+	}
+
+	t.Cleanup(func() { os.Unsetenv(testEnvKey) }) //nolint:errcheck
+
+	suite.Run(t, s)
+}
+
+func (suite *EnvironmentSuite) assertEnv(expected string, expectedSet bool) {
+	suite.Assert().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			actual, set := os.LookupEnv(testEnvKey)
+
+			if actual != expected || set != expectedSet {
+				return retry.ExpectedError(fmt.Errorf("expected %q (set %v), actual %q (set %v)", expected, expectedSet, actual, set))
+			}
+
+			return nil
+		},
+	))
+}
+
+func (suite *EnvironmentSuite) assertTimesStartedStopped(expected map[string]serviceStartStopInfo) {
+	suite.Assert().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			actual := suite.svcMock.getTimesStartedStopped()
+
+			if !reflect.DeepEqual(actual, expected) {
+				return retry.ExpectedErrorf("services status expected %v, actual %v", expected, actual)
+			}
+
+			return nil
+		},
+	))
+}
+
+func (suite *EnvironmentSuite) machineConfig(env map[string]string) *config.MachineConfig {
+	ctr, err := container.New(&v1alpha1.Config{
+		ConfigVersion: "v1alpha1",
+		MachineConfig: &v1alpha1.MachineConfig{
+			MachineEnv: env,
+		},
+	})
+	suite.Require().NoError(err)
+
+	return config.NewMachineConfig(ctr)
+}
+
+func (suite *EnvironmentSuite) TestReconcile() {
+	suite.svcMock.Load(&services.CRI{})
+	suite.Require().NoError(suite.svcMock.Start("cri"))
+
+	cfg := suite.machineConfig(map[string]string{testEnvKey: "http://proxy:3128"})
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	suite.assertEnv("http://proxy:3128", true)
+
+	// services are not restarted on the initial apply
+	suite.assertTimesStartedStopped(map[string]serviceStartStopInfo{
+		"cri": {started: 1},
+	})
+
+	newCfg := suite.machineConfig(map[string]string{testEnvKey: "http://proxy2:3128"})
+	newCfg.Metadata().SetVersion(cfg.Metadata().Version())
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), newCfg))
+
+	suite.assertEnv("http://proxy2:3128", true)
+	suite.assertTimesStartedStopped(map[string]serviceStartStopInfo{
+		"cri": {started: 2, stopped: 1},
+	})
+
+	cfg = newCfg
+	newCfg = suite.machineConfig(nil)
+	newCfg.Metadata().SetVersion(cfg.Metadata().Version())
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), newCfg))
+
+	suite.assertEnv("", false)
+	suite.assertTimesStartedStopped(map[string]serviceStartStopInfo{
+		"cri": {started: 3, stopped: 2},
+	})
+}
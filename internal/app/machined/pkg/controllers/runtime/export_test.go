@@ -4,5 +4,31 @@
 
 package runtime
 
+import (
+	"time"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
 // BuildExpectedImageNames is exported for testing.
 var BuildExpectedImageNames = buildExpectedImageNames
+
+// ParseSeccompAudit is exported for testing.
+var ParseSeccompAudit = parseSeccompAudit
+
+// NewSeccompAuditThrottle is exported for testing.
+func NewSeccompAuditThrottle(interval time.Duration) func(*machine.SyscallAuditEvent, time.Time) (int, bool) {
+	return newSeccompAuditThrottle(interval).report
+}
+
+// ParseOOMKill is exported for testing.
+var ParseOOMKill = parseOOMKill
+
+// KernelErrorEvent is exported for testing.
+var KernelErrorEvent = kernelErrorEvent
+
+// WebhookNotification is exported for testing.
+var WebhookNotification = webhookNotification
+
+// CertificateExpiring is exported for testing.
+var CertificateExpiring = certificateExpiring
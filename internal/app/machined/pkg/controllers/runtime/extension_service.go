@@ -27,6 +27,7 @@ type ServiceManager interface {
 	Load(services ...system.Service) []string
 	Stop(ctx context.Context, serviceIDs ...string) (err error)
 	Start(serviceIDs ...string) error
+	Unload(ctx context.Context, serviceIDs ...string) error
 }
 
 // ExtensionServiceController creates extension services based on the extension service configuration found in the rootfs.
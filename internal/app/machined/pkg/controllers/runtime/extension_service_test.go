@@ -85,6 +85,26 @@ func (mock *serviceMock) Stop(ctx context.Context, serviceIDs ...string) error {
 	return nil
 }
 
+func (mock *serviceMock) Unload(ctx context.Context, serviceIDs ...string) error {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	for _, id := range serviceIDs {
+		if _, exists := mock.services[id]; !exists {
+			continue
+		}
+
+		if mock.running[id] {
+			mock.timesStopped[id]++
+		}
+
+		delete(mock.services, id)
+		delete(mock.running, id)
+	}
+
+	return nil
+}
+
 func (mock *serviceMock) getIDs() []string {
 	mock.mu.Lock()
 	defer mock.mu.Unlock()
@@ -46,5 +46,10 @@ func Checks() []CheckDescription {
 			Hysteresis: 30 * time.Second,
 			Check:      KubeletCSRNotApprovedCheck,
 		},
+		{
+			ID:         "hardware-sensor-critical",
+			Hysteresis: 30 * time.Second,
+			Check:      HardwareSensorCriticalCheck,
+		},
 	}
 }
@@ -0,0 +1,53 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package diagnostics
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/pkg/hwmon"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// HardwareSensorCriticalCheck checks for hardware monitoring sensors beyond the critical thresholds.
+func HardwareSensorCriticalCheck(ctx context.Context, r controller.Reader, logger *zap.Logger) (*runtime.DiagnosticSpec, error) {
+	sensors, err := hwmon.Read(hwmon.SysfsPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading hwmon sensors: %w", err)
+	}
+
+	var details []string
+
+	for _, sensor := range sensors {
+		if !sensor.IsCritical() {
+			continue
+		}
+
+		detail := fmt.Sprintf("%s/%s (%s): %.2f%s", sensor.Chip, sensor.Label, sensor.Type, sensor.Value, sensor.Type.Unit())
+
+		if sensor.Critical != nil {
+			detail += fmt.Sprintf(", critical %.2f%s", *sensor.Critical, sensor.Type.Unit())
+		}
+
+		if sensor.LowCritical != nil {
+			detail += fmt.Sprintf(", low critical %.2f%s", *sensor.LowCritical, sensor.Type.Unit())
+		}
+
+		details = append(details, detail)
+	}
+
+	if len(details) == 0 {
+		return nil, nil
+	}
+
+	return &runtime.DiagnosticSpec{
+		Message: "hardware sensors report critical values",
+		Details: details,
+	}, nil
+}
@@ -0,0 +1,126 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/siderolabs/go-kmsg"
+	"go.uber.org/zap"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/kmsgfilter"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+// kernelErrorEventRate is the maximum number of the kernel error events published per second.
+//
+// Failing hardware might flood the kernel log, so the events are rate limited.
+const kernelErrorEventRate = 5
+
+// KernelErrorController watches kernel log for the hardware errors and publishes them as events.
+type KernelErrorController struct {
+	V1Alpha1Events machineruntime.Publisher
+	V1Alpha1Mode   machineruntime.Mode
+
+	lastSequence int64
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *KernelErrorController) Name() string {
+	return "runtime.KernelErrorController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *KernelErrorController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *KernelErrorController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *KernelErrorController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// in container mode, kernel messages are not available
+	if ctrl.V1Alpha1Mode == machineruntime.ModeContainer {
+		return nil
+	}
+
+	// read the kernel log from the beginning to catch the errors logged during the boot,
+	// messages seen before the controller restart are skipped
+	reader, err := kmsg.NewReader(kmsg.Follow())
+	if err != nil {
+		return fmt.Errorf("error reading kernel messages: %w", err)
+	}
+
+	defer reader.Close() //nolint:errcheck
+
+	kmsgCh := reader.Scan(ctx)
+	limiter := kmsgfilter.NewRateLimiter(kernelErrorEventRate)
+
+	for {
+		var msg kmsg.Packet
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg = <-kmsgCh:
+		}
+
+		if msg.Err != nil {
+			return fmt.Errorf("error receiving kernel logs: %w", msg.Err)
+		}
+
+		if msg.Message.SequenceNumber <= ctrl.lastSequence {
+			continue
+		}
+
+		ctrl.lastSequence = msg.Message.SequenceNumber
+
+		event, ok := kernelErrorEvent(msg.Message)
+		if !ok {
+			continue
+		}
+
+		allowed, suppressed := limiter.Allow()
+		if !allowed {
+			continue
+		}
+
+		if suppressed > 0 {
+			logger.Warn("kernel error events suppressed by the rate limit", zap.Int("count", suppressed))
+		}
+
+		logger.Warn("kernel reported a hardware error",
+			zap.String("kind", event.Kind),
+			zap.String("message", event.Message),
+		)
+
+		ctrl.V1Alpha1Events.Publish(ctx, event)
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// kernelErrorEvent builds an event for the kernel message if it reports a hardware error.
+func kernelErrorEvent(msg kmsg.Message) (*machine.KernelErrorEvent, bool) {
+	kind, ok := kmsgfilter.ClassifyHardwareError(msg)
+	if !ok {
+		return nil, false
+	}
+
+	return &machine.KernelErrorEvent{
+		Kind:      string(kind),
+		Facility:  msg.Facility.String(),
+		Priority:  msg.Priority.String(),
+		Message:   msg.Message,
+		Timestamp: timestamppb.New(msg.Timestamp),
+	}, true
+}
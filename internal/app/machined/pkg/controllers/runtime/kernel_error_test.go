@@ -0,0 +1,50 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-kmsg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+func TestKernelErrorEvent(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Date(2024, 9, 1, 10, 0, 0, 0, time.UTC)
+
+	event, ok := runtime.KernelErrorEvent(kmsg.Message{
+		Timestamp: timestamp,
+		Facility:  kmsg.Kern,
+		Priority:  kmsg.Err,
+		Message:   "I/O error, dev nvme0n1, sector 1024 op 0x1:(WRITE) flags 0x0 phys_seg 1 prio class 2",
+	})
+	require.True(t, ok)
+
+	expected := &machine.KernelErrorEvent{
+		Kind:      "io",
+		Facility:  "kern",
+		Priority:  "err",
+		Message:   "I/O error, dev nvme0n1, sector 1024 op 0x1:(WRITE) flags 0x0 phys_seg 1 prio class 2",
+		Timestamp: timestamppb.New(timestamp),
+	}
+
+	assert.True(t, proto.Equal(expected, event), "expected %v, got %v", expected, event)
+
+	_, ok = runtime.KernelErrorEvent(kmsg.Message{
+		Facility: kmsg.Kern,
+		Priority: kmsg.Info,
+		Message:  "nvme nvme0: 8/0/0 default/read/poll queues",
+	})
+	assert.False(t, ok)
+}
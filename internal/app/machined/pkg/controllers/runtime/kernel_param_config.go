@@ -7,6 +7,7 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"strconv"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	"github.com/cosi-project/runtime/pkg/resource"
@@ -15,12 +16,15 @@ import (
 	"github.com/siderolabs/gen/optional"
 	"go.uber.org/zap"
 
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/kernel"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
 
 // KernelParamConfigController watches v1alpha1.Config, creates/updates/deletes kernel param specs.
+//
+// Huge pages reservations from the performance config are rendered to sysfs kernel params.
 type KernelParamConfigController struct{}
 
 // Name implements controller.Controller interface.
@@ -93,8 +97,37 @@ func (ctrl *KernelParamConfigController) Run(ctx context.Context, r controller.R
 			}
 		}
 
+		if cfg != nil && cfg.Config().Performance() != nil {
+			for _, hugePages := range cfg.Config().Performance().HugePages() {
+				for key, value := range hugePagesParams(hugePages) {
+					if err = setKernelParam(kernel.Sysfs, key, value); err != nil {
+						return err
+					}
+				}
+			}
+		}
+
 		if err = safe.CleanupOutputs[*runtime.KernelParamSpec](ctx, r); err != nil {
 			return err
 		}
 	}
 }
+
+// hugePagesParams renders huge pages reservation to sysfs params (relative to /sys).
+func hugePagesParams(hugePages talosconfig.HugePagesConfig) map[string]string {
+	pages := fmt.Sprintf("hugepages-%dkB", hugePages.SizeKB())
+
+	if len(hugePages.NodeCounts()) == 0 {
+		return map[string]string{
+			"kernel.mm.hugepages." + pages + ".nr_hugepages": strconv.Itoa(hugePages.Count()),
+		}
+	}
+
+	params := make(map[string]string, len(hugePages.NodeCounts()))
+
+	for node, count := range hugePages.NodeCounts() {
+		params[fmt.Sprintf("devices.system.node.node%d.hugepages.%s.nr_hugepages", node, pages)] = strconv.Itoa(count)
+	}
+
+	return params
+}
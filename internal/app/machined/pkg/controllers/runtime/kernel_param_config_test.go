@@ -14,6 +14,7 @@ import (
 
 	runtimecontrollers "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	runtimeresource "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
@@ -116,6 +117,53 @@ func (suite *KernelParamConfigSuite) TestReconcileConfig() {
 	))
 }
 
+func (suite *KernelParamConfigSuite) TestReconcileHugePages() {
+	suite.Require().NoError(suite.runtime.RegisterController(&runtimecontrollers.KernelParamConfigController{}))
+
+	suite.startRuntime()
+
+	performanceConfig := runtimecfg.NewPerformanceV1Alpha1()
+	performanceConfig.PerformanceHugePages = []runtimecfg.HugePagesConfig{
+		{
+			HugePagesSize:  "2M",
+			HugePagesCount: 512,
+		},
+		{
+			HugePagesSize: "1G",
+			HugePagesNodes: []runtimecfg.HugePagesNodeConfig{
+				{
+					NodeID:    0,
+					NodeCount: 4,
+				},
+				{
+					NodeID:    1,
+					NodeCount: 2,
+				},
+			},
+		},
+	}
+
+	ctr, err := container.New(performanceConfig)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.state.Create(suite.ctx, config.NewMachineConfig(ctr)))
+
+	for id, expected := range map[string]string{
+		"sys.kernel.mm.hugepages.hugepages-2048kB.nr_hugepages":                    "512",
+		"sys.devices.system.node.node0.hugepages.hugepages-1048576kB.nr_hugepages": "4",
+		"sys.devices.system.node.node1.hugepages.hugepages-1048576kB.nr_hugepages": "2",
+	} {
+		suite.Assert().NoError(retry.Constant(10*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+			suite.assertResource(
+				resource.NewMetadata(runtimeresource.NamespaceName, runtimeresource.KernelParamSpecType, id, resource.VersionUndefined),
+				func(res resource.Resource) bool {
+					return suite.Assert().Equal(expected, res.(*runtimeresource.KernelParamSpec).TypedSpec().Value)
+				},
+			),
+		))
+	}
+}
+
 func TestKernelParamConfigSuite(t *testing.T) {
 	suite.Run(t, new(KernelParamConfigSuite))
 }
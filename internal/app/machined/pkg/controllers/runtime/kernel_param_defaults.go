@@ -15,6 +15,7 @@ import (
 
 	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/pkg/kernel/kspp"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/kernel"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
@@ -90,6 +91,11 @@ func (ctrl *KernelParamDefaultsController) getKernelParams() []*kernel.Param {
 				Key:   "proc.sys.net.bridge.bridge-nf-call-ip6tables",
 				Value: "1",
 			},
+			// core dumps of the system services are stored by the /sbin/coredump handler.
+			{
+				Key:   "proc.sys.kernel.core_pattern",
+				Value: constants.CorePattern,
+			},
 		}...)
 	}
 
@@ -14,6 +14,7 @@ import (
 
 	runtimecontrollers "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/kernel"
 	runtimeresource "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
@@ -60,6 +61,10 @@ func getParams(mode runtime.Mode) []*kernel.Param {
 				Key:   "proc.sys.net.bridge.bridge-nf-call-ip6tables",
 				Value: "1",
 			},
+			{
+				Key:   "proc.sys.kernel.core_pattern",
+				Value: constants.CorePattern,
+			},
 		}...)
 	}
 
@@ -0,0 +1,165 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/lsm"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// LSMController loads the baseline AppArmor profile for the system services, and reports the LSM status.
+//
+// The LSM status is reported only once the machine configuration is available, as the services
+// wait for the LSM status before they are started (see runtime.LSMStatusCondition).
+type LSMController struct {
+	V1Alpha1Mode machineruntime.Mode
+
+	loadedProfile []byte
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *LSMController) Name() string {
+	return "runtime.LSMController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *LSMController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *LSMController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtimeres.LSMStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *LSMController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// in container mode, LSM policies are managed by the host
+	if ctrl.V1Alpha1Mode == machineruntime.ModeContainer {
+		// report the empty status, so that the services waiting for it are not blocked
+		if err := safe.WriterModify(ctx, r, runtimeres.NewLSMStatus(), func(*runtimeres.LSMStatus) error {
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating LSM status: %w", err)
+		}
+
+		return nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		modules, err := lsm.Modules()
+		if err != nil {
+			return fmt.Errorf("error reading active LSMs: %w", err)
+		}
+
+		var profile []byte
+
+		if cfg.Config().LSM() != nil {
+			if profile, err = lsm.AppArmorProfile(constants.AppArmorSystemProfile, cfg.Config().LSM().Enforcing()); err != nil {
+				return fmt.Errorf("error rendering AppArmor profile: %w", err)
+			}
+		}
+
+		var mode string
+
+		if slices.Contains(modules, lsm.ModuleAppArmor) {
+			if err = ctrl.syncAppArmorProfile(ctx, logger, profile); err != nil {
+				return err
+			}
+
+			profiles, err := lsm.AppArmorProfiles()
+			if err != nil {
+				return fmt.Errorf("error reading AppArmor profiles: %w", err)
+			}
+
+			mode = profiles[constants.AppArmorSystemProfile]
+		} else if profile != nil {
+			logger.Warn("AppArmor LSM is not active, the baseline AppArmor profile is not applied", zap.Strings("modules", modules))
+		}
+
+		if err = safe.WriterModify(ctx, r, runtimeres.NewLSMStatus(), func(status *runtimeres.LSMStatus) error {
+			status.TypedSpec().Modules = modules
+			status.TypedSpec().AppArmorMode = mode
+			status.TypedSpec().AppArmorProfile = ""
+
+			if mode != "" {
+				status.TypedSpec().AppArmorProfile = constants.AppArmorSystemProfile
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating LSM status: %w", err)
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// syncAppArmorProfile loads, replaces or removes the AppArmor profile to match the desired state.
+func (ctrl *LSMController) syncAppArmorProfile(ctx context.Context, logger *zap.Logger, profile []byte) error {
+	switch {
+	case bytes.Equal(profile, ctrl.loadedProfile):
+		return nil
+	case profile == nil:
+		if err := lsm.UnloadAppArmorProfile(ctx, ctrl.loadedProfile); err != nil {
+			return fmt.Errorf("error unloading AppArmor profile: %w", err)
+		}
+
+		logger.Info("unloaded AppArmor profile", zap.String("profile", constants.AppArmorSystemProfile))
+	default:
+		if err := lsm.LoadAppArmorProfile(ctx, profile); err != nil {
+			return fmt.Errorf("error loading AppArmor profile: %w", err)
+		}
+
+		logger.Info("loaded AppArmor profile", zap.String("profile", constants.AppArmorSystemProfile))
+	}
+
+	ctrl.loadedProfile = profile
+
+	return nil
+}
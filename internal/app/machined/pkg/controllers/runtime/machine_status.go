@@ -23,6 +23,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
@@ -41,7 +42,15 @@ type MachineStatusController struct {
 	notifyCh chan struct{}
 
 	mu           sync.Mutex
-	currentStage runtime.MachineStage
+	currentState machineState
+}
+
+// machineState is aggregated from the runtime events.
+type machineState struct {
+	stage     runtime.MachineStage
+	sequence  string
+	phase     string
+	lastError string
 }
 
 // Name implements controller.Controller interface.
@@ -91,6 +100,12 @@ func (ctrl *MachineStatusController) Inputs() []controller.Input {
 			Type:      k8s.NodeStatusType,
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.MetaKeyType,
+			ID:        optional.Some(runtime.MetaKeyTagToID(meta.NodeMaintenance)),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -136,10 +151,17 @@ func (ctrl *MachineStatusController) Run(ctx context.Context, r controller.Runti
 			machineType = machineTypeResource.MachineType()
 		}
 
+		nodeMaintenance, err := safe.ReaderGetByID[*runtime.MetaKey](ctx, r, runtime.MetaKeyTagToID(meta.NodeMaintenance))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting node maintenance flag: %w", err)
+		}
+
 		ctrl.mu.Lock()
-		currentStage := ctrl.currentStage
+		currentState := ctrl.currentState
 		ctrl.mu.Unlock()
 
+		currentStage := currentState.stage
+
 		ready := true
 
 		var unmetConditions []runtime.UnmetCondition
@@ -159,6 +181,15 @@ func (ctrl *MachineStatusController) Run(ctx context.Context, r controller.Runti
 			ms.TypedSpec().Stage = currentStage
 			ms.TypedSpec().Status.Ready = ready
 			ms.TypedSpec().Status.UnmetConditions = unmetConditions
+			ms.TypedSpec().Sequence = currentState.sequence
+			ms.TypedSpec().Phase = currentState.phase
+			ms.TypedSpec().LastError = currentState.lastError
+			ms.TypedSpec().NodeMaintenance = nodeMaintenance != nil
+			ms.TypedSpec().NodeMaintenanceReason = ""
+
+			if nodeMaintenance != nil {
+				ms.TypedSpec().NodeMaintenanceReason = nodeMaintenance.TypedSpec().Value
+			}
 
 			return nil
 		}); err != nil {
@@ -394,17 +425,15 @@ func (ctrl *MachineStatusController) watchEvents() {
 	// from the events, so what we're doing is watching the events forever as soon as the controller starts,
 	// and aggregating the state into the stage variable, notifying the controller whenever the state changes.
 	ctrl.V1Alpha1Events.Watch(func(eventCh <-chan v1alpha1runtime.EventInfo) { //nolint:errcheck
-		var (
-			oldStage        runtime.MachineStage
-			currentSequence string
-		)
+		var oldState machineState
 
 		for ev := range eventCh {
-			newStage := oldStage
+			newState := oldState
+			newStage := oldState.stage
 
 			switch event := ev.Event.Payload.(type) {
 			case *machineapi.SequenceEvent:
-				currentSequence = event.Sequence
+				newState.sequence = event.Sequence
 
 				switch event.Action {
 				case machineapi.SequenceEvent_START:
@@ -428,16 +457,33 @@ func (ctrl *MachineStatusController) watchEvents() {
 						// fatal errors lead to reboot
 						newStage = runtime.MachineStageRebooting
 					}
+
+					// the sequence was not run
+					newState.sequence = oldState.sequence
 				case machineapi.SequenceEvent_STOP:
 					if event.Sequence == v1alpha1runtime.SequenceBoot.String() && event.Error == nil {
 						newStage = runtime.MachineStageRunning
 					}
 
 					// sequence finished, it doesn't matter whether if it was successful or not
-					currentSequence = ""
+					newState.sequence = ""
+					newState.phase = ""
+				}
+
+				if event.Error != nil {
+					switch event.Error.Code { //nolint:exhaustive
+					case common.Code_LOCKED, common.Code_CANCELED:
+						// not a failure of the sequence itself
+					default:
+						newState.lastError = fmt.Sprintf("sequence %s failed: %s", event.Sequence, event.Error.Message)
+					}
 				}
 			case *machineapi.PhaseEvent:
 				if event.Action == machineapi.PhaseEvent_START {
+					newState.phase = event.Phase
+
+					currentSequence := newState.sequence
+
 					switch {
 					case currentSequence == v1alpha1runtime.SequenceInstall.String() && event.Phase == "install":
 						newStage = runtime.MachineStageInstalling
@@ -459,9 +505,11 @@ func (ctrl *MachineStatusController) watchEvents() {
 				}
 			}
 
-			if oldStage != newStage {
+			newState.stage = newStage
+
+			if oldState != newState {
 				ctrl.mu.Lock()
-				ctrl.currentStage = newStage
+				ctrl.currentState = newState
 				ctrl.mu.Unlock()
 
 				select {
@@ -470,7 +518,7 @@ func (ctrl *MachineStatusController) watchEvents() {
 				}
 			}
 
-			oldStage = newStage
+			oldState = newState
 		}
 	}, v1alpha1runtime.WithTailEvents(-1))
 }
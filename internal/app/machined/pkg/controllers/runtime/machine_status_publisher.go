@@ -77,6 +77,8 @@ func (ctrl *MachineStatusPublisherController) Run(ctx context.Context, r control
 						}
 					}),
 			},
+			NodeMaintenance:       machineStatus.TypedSpec().NodeMaintenance,
+			NodeMaintenanceReason: machineStatus.TypedSpec().NodeMaintenanceReason,
 		})
 
 		r.ResetRestartBackoff()
@@ -17,8 +17,10 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
 	runtimectrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
 	v1alpha1runtime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
@@ -70,6 +72,23 @@ func (suite *MachineStatusSuite) assertMachineStatus(stage runtime.MachineStage,
 		})
 }
 
+func (suite *MachineStatusSuite) assertSequence(sequence, phase, lastError string) {
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{runtime.MachineStatusID},
+		func(machineStatus *runtime.MachineStatus, asrt *assert.Assertions) {
+			asrt.Equal(sequence, machineStatus.TypedSpec().Sequence)
+			asrt.Equal(phase, machineStatus.TypedSpec().Phase)
+			asrt.Equal(lastError, machineStatus.TypedSpec().LastError)
+		})
+}
+
+func (suite *MachineStatusSuite) assertNodeMaintenance(maintenance bool, reason string) {
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{runtime.MachineStatusID},
+		func(machineStatus *runtime.MachineStatus, asrt *assert.Assertions) {
+			asrt.Equal(maintenance, machineStatus.TypedSpec().NodeMaintenance)
+			asrt.Equal(reason, machineStatus.TypedSpec().NodeMaintenanceReason)
+		})
+}
+
 func (suite *MachineStatusSuite) TestReconcile() {
 	suite.assertMachineStatus(runtime.MachineStageUnknown, true, nil)
 
@@ -102,6 +121,18 @@ func (suite *MachineStatusSuite) TestReconcile() {
 	}
 
 	suite.assertMachineStatus(runtime.MachineStageBooting, false, []string{"network", "services"})
+	suite.assertSequence(v1alpha1runtime.SequenceBoot.String(), "", "")
+
+	suite.eventCh <- v1alpha1runtime.EventInfo{
+		Event: v1alpha1runtime.Event{
+			Payload: &machineapi.PhaseEvent{
+				Phase:  "startEverything",
+				Action: machineapi.PhaseEvent_START,
+			},
+		},
+	}
+
+	suite.assertSequence(v1alpha1runtime.SequenceBoot.String(), "startEverything", "")
 
 	suite.eventCh <- v1alpha1runtime.EventInfo{
 		Event: v1alpha1runtime.Event{
@@ -145,6 +176,58 @@ func (suite *MachineStatusSuite) TestReconcile() {
 	suite.Require().NoError(suite.State().Update(suite.Ctx(), nodeStatus))
 
 	suite.assertMachineStatus(runtime.MachineStageRunning, true, nil)
+	suite.assertSequence("", "", "")
+	suite.assertNodeMaintenance(false, "")
+
+	nodeMaintenance := runtime.NewMetaKey(runtime.NamespaceName, runtime.MetaKeyTagToID(meta.NodeMaintenance))
+	nodeMaintenance.TypedSpec().Value = "replacing disks"
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), nodeMaintenance))
+
+	suite.assertNodeMaintenance(true, "replacing disks")
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), nodeMaintenance.Metadata()))
+
+	suite.assertNodeMaintenance(false, "")
+
+	suite.eventCh <- v1alpha1runtime.EventInfo{
+		Event: v1alpha1runtime.Event{
+			Payload: &machineapi.SequenceEvent{
+				Sequence: v1alpha1runtime.SequenceUpgrade.String(),
+				Action:   machineapi.SequenceEvent_START,
+			},
+		},
+	}
+
+	suite.assertSequence(v1alpha1runtime.SequenceUpgrade.String(), "", "")
+
+	// another sequence is rejected while the upgrade is running
+	suite.eventCh <- v1alpha1runtime.EventInfo{
+		Event: v1alpha1runtime.Event{
+			Payload: &machineapi.SequenceEvent{
+				Sequence: v1alpha1runtime.SequenceReboot.String(),
+				Action:   machineapi.SequenceEvent_NOOP,
+				Error: &common.Error{
+					Code:    common.Code_LOCKED,
+					Message: "locked",
+				},
+			},
+		},
+	}
+
+	suite.eventCh <- v1alpha1runtime.EventInfo{
+		Event: v1alpha1runtime.Event{
+			Payload: &machineapi.SequenceEvent{
+				Sequence: v1alpha1runtime.SequenceUpgrade.String(),
+				Action:   machineapi.SequenceEvent_STOP,
+				Error: &common.Error{
+					Code:    common.Code_FATAL,
+					Message: "failed to pull the installer image",
+				},
+			},
+		},
+	}
+
+	suite.assertSequence("", "", "sequence upgrade failed: failed to pull the installer image")
 
 	suite.eventCh <- v1alpha1runtime.EventInfo{
 		Event: v1alpha1runtime.Event{
@@ -0,0 +1,140 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+)
+
+const metricsServerShutdownTimeout = 5 * time.Second
+
+// MetricsServerController serves the Prometheus metrics endpoint if enabled in the machine configuration.
+type MetricsServerController struct {
+	// Collectors to expose via the metrics endpoint.
+	Collectors []prometheus.Collector
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *MetricsServerController) Name() string {
+	return "runtime.MetricsServerController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *MetricsServerController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *MetricsServerController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *MetricsServerController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	registry := prometheus.NewRegistry()
+
+	for _, collector := range ctrl.Collectors {
+		if err := registry.Register(collector); err != nil {
+			return fmt.Errorf("error registering collector: %w", err)
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(registry, promhttp.HandlerOpts{}))
+
+	var (
+		srv           *http.Server
+		listenAddress string
+	)
+
+	stopServer := func() {
+		if srv == nil {
+			return
+		}
+
+		shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), metricsServerShutdownTimeout)
+		defer shutdownCancel()
+
+		if err := srv.Shutdown(shutdownCtx); err != nil {
+			logger.Error("error shutting down metrics server", zap.Error(err))
+		}
+
+		logger.Info("metrics server stopped", zap.String("address", listenAddress))
+
+		srv, listenAddress = nil, ""
+	}
+
+	defer stopServer()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		var newListenAddress string
+
+		if cfg != nil && cfg.Config().Runtime().Metrics() != nil {
+			newListenAddress = cfg.Config().Runtime().Metrics().ListenAddress()
+		}
+
+		if newListenAddress == listenAddress {
+			continue
+		}
+
+		stopServer()
+
+		if newListenAddress == "" {
+			continue
+		}
+
+		listener, err := net.Listen("tcp", newListenAddress)
+		if err != nil {
+			return fmt.Errorf("error listening on %q: %w", newListenAddress, err)
+		}
+
+		srv = &http.Server{
+			Handler:           mux,
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+		listenAddress = newListenAddress
+
+		go func(srv *http.Server) {
+			if err := srv.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+				logger.Error("metrics server failed", zap.Error(err))
+			}
+		}(srv)
+
+		logger.Info("metrics server started", zap.String("address", listenAddress))
+	}
+}
@@ -0,0 +1,105 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+)
+
+type MetricsServerControllerSuite struct {
+	ctest.DefaultSuite
+}
+
+func TestMetricsServerControllerSuite(t *testing.T) {
+	suite.Run(t, &MetricsServerControllerSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				gauge := prometheus.NewGauge(prometheus.GaugeOpts{
+					Name: "talos_test_gauge",
+					Help: "Test gauge.",
+				})
+				gauge.Set(42)
+
+				suite.Require().NoError(suite.Runtime().RegisterController(&runtime.MetricsServerController{
+					Collectors: []prometheus.Collector{gauge},
+				}))
+			},
+		},
+	})
+}
+
+func (suite *MetricsServerControllerSuite) freeAddress() string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	suite.Require().NoError(err)
+
+	addr := l.Addr().String()
+
+	suite.Require().NoError(l.Close())
+
+	return addr
+}
+
+func (suite *MetricsServerControllerSuite) scrape(addr string) (string, error) {
+	resp, err := http.Get("http://" + addr + "/metrics") //nolint:noctx
+	if err != nil {
+		return "", retry.ExpectedError(err)
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	body, err := io.ReadAll(resp.Body)
+
+	return string(body), err
+}
+
+func (suite *MetricsServerControllerSuite) TestServe() {
+	addr := suite.freeAddress()
+
+	metricsCfg := runtimecfg.NewMetricsV1Alpha1()
+	metricsCfg.MetricsListenAddress = addr
+
+	cfg, err := container.New(metricsCfg)
+	suite.Require().NoError(err)
+
+	machineConfig := config.NewMachineConfig(cfg)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineConfig))
+
+	var body string
+
+	suite.Require().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		body, err = suite.scrape(addr)
+
+		return err
+	}))
+
+	suite.Assert().True(strings.Contains(body, "talos_test_gauge 42"), body)
+
+	// remove the metrics config, server should be stopped
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), machineConfig.Metadata()))
+
+	suite.Require().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		if _, err := suite.scrape(addr); err == nil {
+			return retry.ExpectedErrorf("metrics server is still running")
+		}
+
+		return nil
+	}))
+}
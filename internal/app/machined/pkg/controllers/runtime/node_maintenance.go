@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/meta"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// NodeMaintenanceController stops the extension services while the node is in maintenance.
+//
+// Extension services are not required to manage the node, so they are stopped for the duration of the maintenance,
+// and started back once the node leaves the maintenance.
+type NodeMaintenanceController struct {
+	V1Alpha1Services ServiceManager
+
+	stopped []string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *NodeMaintenanceController) Name() string {
+	return "runtime.NodeMaintenanceController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *NodeMaintenanceController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: runtime.NamespaceName,
+			Type:      runtime.MetaKeyType,
+			ID:        optional.Some(runtime.MetaKeyTagToID(meta.NodeMaintenance)),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *NodeMaintenanceController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *NodeMaintenanceController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		_, err := safe.ReaderGetByID[*runtime.MetaKey](ctx, r, runtime.MetaKeyTagToID(meta.NodeMaintenance))
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting node maintenance flag: %w", err)
+		}
+
+		if err == nil {
+			if err = ctrl.stopExtensionServices(ctx, r, logger); err != nil {
+				return err
+			}
+		} else if len(ctrl.stopped) > 0 {
+			logger.Info("node left maintenance, starting extension services", zap.Strings("services", ctrl.stopped))
+
+			if err = ctrl.V1Alpha1Services.Start(ctrl.stopped...); err != nil {
+				return fmt.Errorf("error starting extension services: %w", err)
+			}
+
+			ctrl.stopped = nil
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *NodeMaintenanceController) stopExtensionServices(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	services, err := safe.ReaderListAll[*v1alpha1.Service](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing services: %w", err)
+	}
+
+	for it := services.Iterator(); it.Next(); {
+		id := it.Value().Metadata().ID()
+
+		if !strings.HasPrefix(id, "ext-") || !it.Value().TypedSpec().Running || slices.Contains(ctrl.stopped, id) {
+			continue
+		}
+
+		logger.Info("node is in maintenance, stopping extension service", zap.String("service", id))
+
+		if err = ctrl.V1Alpha1Services.Stop(ctx, id); err != nil {
+			return fmt.Errorf("error stopping extension service %q: %w", id, err)
+		}
+
+		ctrl.stopped = append(ctrl.stopped, id)
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimecontrollers "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/services"
+	extservices "github.com/siderolabs/talos/pkg/machinery/extensions/services"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+type NodeMaintenanceSuite struct {
+	ctest.DefaultSuite
+
+	svcMock *serviceMock
+}
+
+func TestNodeMaintenanceSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &NodeMaintenanceSuite{
+		svcMock: &serviceMock{
+			services:     map[string]system.Service{},
+			running:      map[string]bool{},
+			timesStarted: map[string]int{},
+			timesStopped: map[string]int{},
+		},
+	}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			suite.Require().NoError(suite.Runtime().RegisterController(&runtimecontrollers.NodeMaintenanceController{
+				V1Alpha1Services: s.svcMock,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *NodeMaintenanceSuite) assertTimesStartedStopped(expected map[string]serviceStartStopInfo) {
+	suite.Assert().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			actual := suite.svcMock.getTimesStartedStopped()
+
+			if !reflect.DeepEqual(actual, expected) {
+				return retry.ExpectedErrorf("services status expected %v, actual %v", expected, actual)
+			}
+
+			return nil
+		},
+	))
+}
+
+func (suite *NodeMaintenanceSuite) TestReconcile() {
+	suite.svcMock.Load(
+		&services.APID{},
+		&services.Extension{Spec: extservices.Spec{Name: "hello-world"}},
+	)
+
+	for _, id := range []string{"apid", "ext-hello-world"} {
+		svc := v1alpha1.NewService(id)
+		svc.TypedSpec().Running = true
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), svc))
+	}
+
+	suite.assertTimesStartedStopped(map[string]serviceStartStopInfo{
+		"apid":            {},
+		"ext-hello-world": {},
+	})
+
+	nodeMaintenance := runtime.NewMetaKey(runtime.NamespaceName, runtime.MetaKeyTagToID(meta.NodeMaintenance))
+	nodeMaintenance.TypedSpec().Value = "replacing disks"
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), nodeMaintenance))
+
+	suite.assertTimesStartedStopped(map[string]serviceStartStopInfo{
+		"apid":            {},
+		"ext-hello-world": {stopped: 1},
+	})
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), nodeMaintenance.Metadata()))
+
+	suite.assertTimesStartedStopped(map[string]serviceStartStopInfo{
+		"apid":            {},
+		"ext-hello-world": {started: 1, stopped: 1},
+	})
+}
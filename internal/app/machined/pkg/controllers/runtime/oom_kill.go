@@ -0,0 +1,127 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/siderolabs/go-kmsg"
+	"go.uber.org/zap"
+
+	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+// oomKillPrefix is the prefix of the kernel OOM killer summary.
+const oomKillPrefix = "oom-kill:"
+
+// OOMKillController watches kernel OOM killer reports and publishes them as events.
+type OOMKillController struct {
+	V1Alpha1Events machineruntime.Publisher
+	V1Alpha1Mode   machineruntime.Mode
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *OOMKillController) Name() string {
+	return "runtime.OOMKillController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *OOMKillController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *OOMKillController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *OOMKillController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// in container mode, kernel messages are not available
+	if ctrl.V1Alpha1Mode == machineruntime.ModeContainer {
+		return nil
+	}
+
+	reader, err := kmsg.NewReader(kmsg.Follow(), kmsg.FromTail())
+	if err != nil {
+		return fmt.Errorf("error reading kernel messages: %w", err)
+	}
+
+	defer reader.Close() //nolint:errcheck
+
+	kmsgCh := reader.Scan(ctx)
+
+	for {
+		var msg kmsg.Packet
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg = <-kmsgCh:
+		}
+
+		if msg.Err != nil {
+			return fmt.Errorf("error receiving kernel logs: %w", msg.Err)
+		}
+
+		event, ok := parseOOMKill(msg.Message.Message)
+		if !ok {
+			continue
+		}
+
+		logger.Warn("process killed by the OOM killer",
+			zap.Int32("pid", event.Pid),
+			zap.String("process", event.Process),
+			zap.String("cgroup", event.Cgroup),
+			zap.String("constraint", event.Constraint),
+			zap.String("oom_cgroup", event.OomCgroup),
+		)
+
+		ctrl.V1Alpha1Events.Publish(ctx, event)
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// parseOOMKill parses the kernel OOM killer summary.
+//
+// Example message: 'oom-kill:constraint=CONSTRAINT_MEMCG,nodemask=(null),cpuset=/,mems_allowed=0,oom_memcg=/kubepods/burstable/pod1,task_memcg=/kubepods/burstable/pod1/abcd,task=stress,pid=1234,uid=0'.
+func parseOOMKill(message string) (*machine.OOMKillEvent, bool) {
+	fields, ok := strings.CutPrefix(message, oomKillPrefix)
+	if !ok {
+		return nil, false
+	}
+
+	event := &machine.OOMKillEvent{}
+
+	for _, field := range strings.Split(fields, ",") {
+		key, value, _ := strings.Cut(field, "=")
+
+		switch key {
+		case "constraint":
+			event.Constraint = value
+		case "oom_memcg":
+			event.OomCgroup = value
+		case "task_memcg":
+			event.Cgroup = value
+		case "task":
+			event.Process = value
+		case "pid":
+			pid, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				return nil, false
+			}
+
+			event.Pid = int32(pid)
+		}
+	}
+
+	return event, true
+}
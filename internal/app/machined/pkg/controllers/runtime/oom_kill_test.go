@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+func TestParseOOMKill(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name    string
+		message string
+
+		expected *machine.OOMKillEvent
+	}{
+		{
+			name:    "cgroup",
+			message: `oom-kill:constraint=CONSTRAINT_MEMCG,nodemask=(null),cpuset=cri-containerd-abcd.scope,mems_allowed=0,oom_memcg=/kubepods/burstable/pod1,task_memcg=/kubepods/burstable/pod1/abcd,task=stress,pid=1234,uid=0`,
+
+			expected: &machine.OOMKillEvent{
+				Pid:        1234,
+				Process:    "stress",
+				Cgroup:     "/kubepods/burstable/pod1/abcd",
+				Constraint: "CONSTRAINT_MEMCG",
+				OomCgroup:  "/kubepods/burstable/pod1",
+			},
+		},
+		{
+			name:    "system-wide",
+			message: `oom-kill:constraint=CONSTRAINT_NONE,nodemask=(null),cpuset=/,mems_allowed=0,global_oom,task_memcg=/system/extensions/ext-app,task=app,pid=42,uid=0`,
+
+			expected: &machine.OOMKillEvent{
+				Pid:        42,
+				Process:    "app",
+				Cgroup:     "/system/extensions/ext-app",
+				Constraint: "CONSTRAINT_NONE",
+			},
+		},
+		{
+			name:    "other message",
+			message: `Out of memory: Killed process 42 (app) total-vm:1024kB, anon-rss:512kB, file-rss:0kB, shmem-rss:0kB, UID:0 pgtables:40kB oom_score_adj:0`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			event, ok := runtime.ParseOOMKill(test.message)
+
+			if test.expected == nil {
+				assert.False(t, ok)
+
+				return
+			}
+
+			require.True(t, ok)
+			assert.True(t, proto.Equal(test.expected, event), "expected %v, got %v", test.expected, event)
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
+	"go.uber.org/zap"
+
+	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/secureboot/tpm2"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// PCRStatusController reports the current values and the measurement log of the TPM PCRs used for the attestation.
+type PCRStatusController struct {
+	V1Alpha1Mode machineruntime.Mode
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *PCRStatusController) Name() string {
+	return "runtime.PCRStatusController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *PCRStatusController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: runtimeres.NamespaceName,
+			Type:      runtimeres.MachineStatusType,
+			ID:        optional.Some(runtimeres.MachineStatusID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *PCRStatusController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtimeres.PCRStatusType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *PCRStatusController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// in container mode, there is no TPM
+	if ctrl.V1Alpha1Mode == machineruntime.ModeContainer {
+		return nil
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		// PCR values are extended on boot phase transitions, so re-read them on each machine status change
+		pcrValues, err := tpm2.ReadPCRs(tpm2.AttestationPCRs)
+		if err != nil {
+			return fmt.Errorf("error reading PCR values: %w", err)
+		}
+
+		measurementLog, err := tpm2.MeasurementLog(pcrValues)
+		if err != nil {
+			// the measurement log is informational, the PCR values are still reported
+			logger.Warn("error reading TPM measurement log", zap.Error(err))
+		}
+
+		r.StartTrackingOutputs()
+
+		for pcr, value := range pcrValues {
+			if err = safe.WriterModify(ctx, r, runtimeres.NewPCRStatus(strconv.Itoa(pcr)), func(status *runtimeres.PCRStatus) error {
+				status.TypedSpec().Value = hex.EncodeToString(value)
+				status.TypedSpec().Events = xslices.Map(measurementLog[pcr], func(event tpm2.Event) runtimeres.PCREvent {
+					return runtimeres.PCREvent{
+						Type:        event.Type,
+						Digest:      hex.EncodeToString(event.Digest),
+						Description: event.Description,
+					}
+				})
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating PCR status: %w", err)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*runtimeres.PCRStatus](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
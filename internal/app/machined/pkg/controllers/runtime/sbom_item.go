@@ -0,0 +1,147 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// SBOMItemController publishes the packages listed in the SPDX SBOM documents as resources.
+type SBOMItemController struct {
+	SPDXPath          string
+	ExtensionSPDXPath string
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *SBOMItemController) Name() string {
+	return "runtime.SBOMItemController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *SBOMItemController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *SBOMItemController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: runtime.SBOMItemType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// spdxDocument is a subset of the SPDX 2.3 JSON document.
+type spdxDocument struct {
+	Packages []struct {
+		Name            string `json:"name"`
+		VersionInfo     string `json:"versionInfo"`
+		LicenseDeclared string `json:"licenseDeclared"`
+		ExternalRefs    []struct {
+			ReferenceType    string `json:"referenceType"`
+			ReferenceLocator string `json:"referenceLocator"`
+		} `json:"externalRefs"`
+	} `json:"packages"`
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *SBOMItemController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.SPDXPath == "" {
+		ctrl.SPDXPath = constants.SPDXPath
+	}
+
+	if ctrl.ExtensionSPDXPath == "" {
+		ctrl.ExtensionSPDXPath = constants.ExtensionSPDXPath
+	}
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case <-r.EventCh():
+	}
+
+	// SBOM documents are part of the rootfs and extensions, so they don't change at runtime
+	r.StartTrackingOutputs()
+
+	for _, dir := range []struct {
+		path      string
+		extension bool
+	}{
+		{ctrl.SPDXPath, false},
+		{ctrl.ExtensionSPDXPath, true},
+	} {
+		documents, err := filepath.Glob(filepath.Join(dir.path, "*.spdx.json"))
+		if err != nil {
+			return err
+		}
+
+		for _, path := range documents {
+			if err = ctrl.publishDocument(ctx, r, path, dir.extension); err != nil {
+				logger.Warn("failed to process SBOM document", zap.String("path", path), zap.Error(err))
+			}
+		}
+	}
+
+	if err := safe.CleanupOutputs[*runtime.SBOMItem](ctx, r); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (ctrl *SBOMItemController) publishDocument(ctx context.Context, r controller.Runtime, path string, extension bool) error {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	var doc spdxDocument
+
+	if err = json.Unmarshal(contents, &doc); err != nil {
+		return fmt.Errorf("error parsing SPDX document: %w", err)
+	}
+
+	for _, pkg := range doc.Packages {
+		if pkg.Name == "" {
+			continue
+		}
+
+		if err = safe.WriterModify(ctx, r, runtime.NewSBOMItem(pkg.Name), func(item *runtime.SBOMItem) error {
+			item.TypedSpec().Name = pkg.Name
+			item.TypedSpec().Version = pkg.VersionInfo
+			item.TypedSpec().Extension = extension
+			item.TypedSpec().License = ""
+			item.TypedSpec().PURLs = nil
+
+			if pkg.LicenseDeclared != "NOASSERTION" {
+				item.TypedSpec().License = pkg.LicenseDeclared
+			}
+
+			for _, ref := range pkg.ExternalRefs {
+				if ref.ReferenceType == "purl" {
+					item.TypedSpec().PURLs = append(item.TypedSpec().PURLs, ref.ReferenceLocator)
+				}
+			}
+
+			return nil
+		}); err != nil {
+			return fmt.Errorf("error updating SBOM item: %w", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimectrls "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+type SBOMItemSuite struct {
+	ctest.DefaultSuite
+}
+
+func TestSBOMItemSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &SBOMItemSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&runtimectrls.SBOMItemController{
+					SPDXPath:          "testdata/spdx/rootfs",
+					ExtensionSPDXPath: "testdata/spdx/extensions",
+				}))
+			},
+		},
+	})
+}
+
+func (suite *SBOMItemSuite) TestReconcile() {
+	ctest.AssertResource(suite, "talos", func(item *runtime.SBOMItem, asrt *assert.Assertions) {
+		asrt.Equal("v1.9.0", item.TypedSpec().Version)
+		asrt.Equal("MPL-2.0", item.TypedSpec().License)
+		asrt.Empty(item.TypedSpec().PURLs)
+		asrt.False(item.TypedSpec().Extension)
+	})
+
+	ctest.AssertResource(suite, "github.com/google/go-cmp", func(item *runtime.SBOMItem, asrt *assert.Assertions) {
+		asrt.Equal("v0.6.0", item.TypedSpec().Version)
+		asrt.Empty(item.TypedSpec().License)
+		asrt.Equal([]string{"pkg:golang/github.com/google/go-cmp@v0.6.0"}, item.TypedSpec().PURLs)
+	})
+
+	ctest.AssertResource(suite, "gvisor", func(item *runtime.SBOMItem, asrt *assert.Assertions) {
+		asrt.Equal("20240826.0", item.TypedSpec().Version)
+		asrt.Equal("Apache-2.0", item.TypedSpec().License)
+		asrt.True(item.TypedSpec().Extension)
+	})
+}
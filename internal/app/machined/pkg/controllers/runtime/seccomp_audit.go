@@ -0,0 +1,229 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/siderolabs/go-kmsg"
+	"go.uber.org/zap"
+
+	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+// auditSeccompPrefix is the prefix of the kernel audit record for seccomp actions (AUDIT_SECCOMP).
+const auditSeccompPrefix = "audit: type=1326 "
+
+// seccompAuditInterval is the minimum interval between the reports of the same syscall audited for the same executable.
+//
+// In the audit mode, each syscall outside of the profile is logged by the kernel, so a service
+// repeatedly calling the syscall would flood the machined logs and the event stream.
+const seccompAuditInterval = time.Minute
+
+// auditArches maps audit architecture identifiers to the architecture names.
+var auditArches = map[string]string{
+	"c000003e": "x86_64",
+	"c00000b7": "aarch64",
+}
+
+// SeccompAuditController watches kernel seccomp audit records and publishes them as events.
+type SeccompAuditController struct {
+	V1Alpha1Events machineruntime.Publisher
+	V1Alpha1Mode   machineruntime.Mode
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *SeccompAuditController) Name() string {
+	return "runtime.SeccompAuditController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *SeccompAuditController) Inputs() []controller.Input {
+	return nil
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *SeccompAuditController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *SeccompAuditController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// in container mode, kernel messages are not available
+	if ctrl.V1Alpha1Mode == machineruntime.ModeContainer {
+		return nil
+	}
+
+	reader, err := kmsg.NewReader(kmsg.Follow(), kmsg.FromTail())
+	if err != nil {
+		return fmt.Errorf("error reading kernel messages: %w", err)
+	}
+
+	defer reader.Close() //nolint:errcheck
+
+	kmsgCh := reader.Scan(ctx)
+
+	throttle := newSeccompAuditThrottle(seccompAuditInterval)
+
+	for {
+		var msg kmsg.Packet
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case msg = <-kmsgCh:
+		}
+
+		if msg.Err != nil {
+			return fmt.Errorf("error receiving kernel logs: %w", msg.Err)
+		}
+
+		event, ok := parseSeccompAudit(msg.Message.Message)
+		if !ok {
+			continue
+		}
+
+		suppressed, report := throttle.report(event, time.Now())
+		if !report {
+			continue
+		}
+
+		logger.Warn("syscall audited by seccomp",
+			zap.Int32("pid", event.Pid),
+			zap.String("comm", event.Comm),
+			zap.String("exe", event.Exe),
+			zap.String("arch", event.Arch),
+			zap.Int32("syscall", event.Syscall),
+			zap.Int("suppressed", suppressed),
+		)
+
+		ctrl.V1Alpha1Events.Publish(ctx, event)
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// seccompAuditThrottle limits the reports of the same syscall audited for the same executable to one per interval.
+type seccompAuditThrottle struct {
+	interval time.Duration
+	reported map[seccompAuditKey]*seccompAuditReport
+}
+
+type seccompAuditKey struct {
+	exe     string
+	arch    string
+	syscall int32
+}
+
+type seccompAuditReport struct {
+	at         time.Time
+	suppressed int
+}
+
+func newSeccompAuditThrottle(interval time.Duration) *seccompAuditThrottle {
+	return &seccompAuditThrottle{
+		interval: interval,
+		reported: map[seccompAuditKey]*seccompAuditReport{},
+	}
+}
+
+// report returns true if the audit event should be reported, and the number of the events suppressed since the last report.
+func (throttle *seccompAuditThrottle) report(event *machine.SyscallAuditEvent, now time.Time) (int, bool) {
+	key := seccompAuditKey{
+		exe:     event.Exe,
+		arch:    event.Arch,
+		syscall: event.Syscall,
+	}
+
+	if last, ok := throttle.reported[key]; ok && now.Sub(last.at) < throttle.interval {
+		last.suppressed++
+
+		return 0, false
+	}
+
+	var suppressed int
+
+	if last, ok := throttle.reported[key]; ok {
+		suppressed = last.suppressed
+	}
+
+	// forget the expired reports, so that the map doesn't grow indefinitely
+	for k, report := range throttle.reported {
+		if now.Sub(report.at) >= throttle.interval {
+			delete(throttle.reported, k)
+		}
+	}
+
+	throttle.reported[key] = &seccompAuditReport{at: now}
+
+	return suppressed, true
+}
+
+// parseSeccompAudit parses the kernel seccomp audit record.
+//
+// Example record: 'audit: type=1326 audit(1700000000.123:45): auid=4294967295 uid=0 gid=0 ses=4294967295 pid=1234 comm="trustd" exe="/trustd" sig=0 arch=c000003e syscall=101 compat=0 ip=0x4a3b2c code=0x7ffc0000'.
+func parseSeccompAudit(message string) (*machine.SyscallAuditEvent, bool) {
+	if !strings.HasPrefix(message, auditSeccompPrefix) {
+		return nil, false
+	}
+
+	_, fields, ok := strings.Cut(message, "): ")
+	if !ok {
+		return nil, false
+	}
+
+	event := &machine.SyscallAuditEvent{}
+
+	for fields != "" {
+		var key, value string
+
+		key, fields, ok = strings.Cut(fields, "=")
+		if !ok {
+			break
+		}
+
+		if strings.HasPrefix(fields, `"`) {
+			value, fields, _ = strings.Cut(fields[1:], `"`)
+			fields = strings.TrimPrefix(fields, " ")
+		} else {
+			value, fields, _ = strings.Cut(fields, " ")
+		}
+
+		switch key {
+		case "pid":
+			pid, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				return nil, false
+			}
+
+			event.Pid = int32(pid)
+		case "comm":
+			event.Comm = value
+		case "exe":
+			event.Exe = value
+		case "arch":
+			event.Arch = value
+
+			if arch, known := auditArches[value]; known {
+				event.Arch = arch
+			}
+		case "syscall":
+			syscall, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				return nil, false
+			}
+
+			event.Syscall = int32(syscall)
+		}
+	}
+
+	return event, true
+}
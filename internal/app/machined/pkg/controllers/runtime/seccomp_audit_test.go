@@ -0,0 +1,102 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+func TestParseSeccompAudit(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name    string
+		message string
+
+		expected *machine.SyscallAuditEvent
+	}{
+		{
+			name:    "seccomp",
+			message: `audit: type=1326 audit(1700000000.123:45): auid=4294967295 uid=50 gid=50 ses=4294967295 subj=unconfined pid=1234 comm="trustd" exe="/trustd" sig=0 arch=c000003e syscall=101 compat=0 ip=0x4a3b2c code=0x7ffc0000`,
+
+			expected: &machine.SyscallAuditEvent{
+				Pid:     1234,
+				Comm:    "trustd",
+				Exe:     "/trustd",
+				Arch:    "x86_64",
+				Syscall: 101,
+			},
+		},
+		{
+			name:    "quoted with spaces",
+			message: `audit: type=1326 audit(1700000000.123:46): pid=42 comm="my app" exe="/usr/bin/my app" sig=0 arch=c00000b7 syscall=117 compat=0`,
+
+			expected: &machine.SyscallAuditEvent{
+				Pid:     42,
+				Comm:    "my app",
+				Exe:     "/usr/bin/my app",
+				Arch:    "aarch64",
+				Syscall: 117,
+			},
+		},
+		{
+			name:    "other audit record",
+			message: `audit: type=1400 audit(1700000000.123:47): apparmor="DENIED" operation="open" profile="talos-system"`,
+		},
+		{
+			name:    "not audit",
+			message: `random kernel message`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			event, ok := runtime.ParseSeccompAudit(test.message)
+
+			if test.expected == nil {
+				assert.False(t, ok)
+
+				return
+			}
+
+			require.True(t, ok)
+			assert.True(t, proto.Equal(test.expected, event), "expected %v, got %v", test.expected, event)
+		})
+	}
+}
+
+func TestSeccompAuditThrottle(t *testing.T) {
+	t.Parallel()
+
+	report := runtime.NewSeccompAuditThrottle(time.Minute)
+
+	now := time.Now()
+	event := &machine.SyscallAuditEvent{Pid: 1234, Exe: "/trustd", Arch: "x86_64", Syscall: 101}
+
+	suppressed, ok := report(event, now)
+	assert.True(t, ok)
+	assert.Zero(t, suppressed)
+
+	for range 3 {
+		_, ok = report(event, now.Add(time.Second))
+		assert.False(t, ok)
+	}
+
+	// other syscalls are reported independently
+	_, ok = report(&machine.SyscallAuditEvent{Pid: 1234, Exe: "/trustd", Arch: "x86_64", Syscall: 102}, now.Add(time.Second))
+	assert.True(t, ok)
+
+	suppressed, ok = report(event, now.Add(time.Minute))
+	assert.True(t, ok)
+	assert.Equal(t, 3, suppressed)
+}
@@ -23,6 +23,7 @@ import (
 	"go.uber.org/zap"
 
 	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/secureboot/tpm2"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
@@ -81,8 +82,9 @@ func (ctrl *SecurityStateController) Run(ctx context.Context, r controller.Runti
 		}
 
 		var (
-			secureBootState          bool
-			pcrSigningKeyFingerprint string
+			secureBootState           bool
+			pcrSigningKeyFingerprint  string
+			attestationKeyFingerprint string
 		)
 
 		// in container mode, never populate the fields
@@ -103,11 +105,17 @@ func (ctrl *SecurityStateController) Run(ctx context.Context, r controller.Runti
 
 				pcrSigningKeyFingerprint = x509CertFingerprint(cert)
 			}
+
+			attestationKeyFingerprint, err = tpm2.ReadAttestationKeyFingerprint()
+			if err != nil {
+				logger.Warn("failed to read TPM attestation key", zap.Error(err))
+			}
 		}
 
 		if err := safe.WriterModify(ctx, r, runtimeres.NewSecurityStateSpec(runtimeres.NamespaceName), func(state *runtimeres.SecurityState) error {
 			state.TypedSpec().SecureBoot = secureBootState
 			state.TypedSpec().PCRSigningKeyFingerprint = pcrSigningKeyFingerprint
+			state.TypedSpec().AttestationKeyFingerprint = attestationKeyFingerprint
 
 			return nil
 		}); err != nil {
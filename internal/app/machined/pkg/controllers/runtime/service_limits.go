@@ -0,0 +1,264 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/containerd/cgroups/v3"
+	"github.com/containerd/cgroups/v3/cgroup2"
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
+	"github.com/siderolabs/talos/internal/pkg/cgroup"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// ServiceLimitsController applies the resource limits of the services to the service cgroups.
+//
+// The limits are re-applied each time the service is started, as the cgroup of the service
+// might be re-created. Services which were never limited in the machine config keep the limits set on boot.
+type ServiceLimitsController struct {
+	V1Alpha1Mode     machineruntime.Mode
+	V1Alpha1Services ServiceManager
+
+	// Cgroups defaults to managing the cgroups via cgroupfs.
+	Cgroups CgroupManager
+
+	applied map[string]appliedServiceLimits
+}
+
+// serviceLimits is the set of limits of a service cgroup.
+type serviceLimits struct {
+	memoryMax int64
+	milliCPU  int64
+	pids      int64
+}
+
+type appliedServiceLimits struct {
+	// started is the creation time of the Service resource the limits were applied for.
+	started time.Time
+	limits  serviceLimits
+}
+
+// defaultServiceMemoryMax is the memory limit of the service cgroups set on boot.
+var defaultServiceMemoryMax = map[string]int64{
+	talosconfig.OOMProtectionServiceAPID:   constants.CgroupApidMaxMemory,
+	talosconfig.OOMProtectionServiceTrustd: constants.CgroupTrustdMaxMemory,
+	"dashboard":                            constants.CgroupDashboardMaxMemory,
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *ServiceLimitsController) Name() string {
+	return "runtime.ServiceLimitsController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *ServiceLimitsController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *ServiceLimitsController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *ServiceLimitsController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// in container mode cgroup resources are managed by the container runtime
+	if ctrl.V1Alpha1Mode == machineruntime.ModeContainer {
+		return nil
+	}
+
+	if ctrl.Cgroups == nil {
+		// the service cgroups are not configured with cgroups v1
+		if cgroups.Mode() != cgroups.Unified {
+			return nil
+		}
+
+		ctrl.Cgroups = cgroupfsManager{}
+	}
+
+	if ctrl.applied == nil {
+		ctrl.applied = map[string]appliedServiceLimits{}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		configuredLimits := map[string]talosconfig.ServiceLimitsConfig{}
+
+		var systemResources talosconfig.SystemResourcesConfig
+
+		if cfg != nil {
+			for _, limits := range cfg.Config().ServiceLimits() {
+				configuredLimits[limits.Service()] = limits
+			}
+
+			systemResources = cfg.Config().SystemResources()
+		}
+
+		services, err := safe.ReaderListAll[*v1alpha1.Service](ctx, r)
+		if err != nil {
+			return fmt.Errorf("error listing services: %w", err)
+		}
+
+		for it := services.Iterator(); it.Next(); {
+			svc := it.Value()
+			id := svc.Metadata().ID()
+			boot := bootServiceLimits(id)
+			desired := desiredServiceLimits(defaultServiceLimits(id, systemResources), configuredLimits[id])
+
+			applied, ok := ctrl.applied[id]
+			if !ok {
+				applied.limits = boot
+			}
+
+			// the limits should be re-applied if the service was restarted, unless the cgroup has the limits set on boot
+			if applied.limits == desired && (applied.started.Equal(svc.Metadata().Created()) || desired == boot) {
+				continue
+			}
+
+			service, _, err := ctrl.V1Alpha1Services.IsRunning(id)
+			if err != nil {
+				continue
+			}
+
+			cgroupService, ok := service.(system.CgroupService)
+			if !ok {
+				if _, configured := configuredLimits[id]; configured {
+					logger.Warn("service doesn't run in a dedicated cgroup, limits are not applied", zap.String("service", id))
+				}
+
+				// record the limits to avoid warning on every reconcile
+				ctrl.applied[id] = appliedServiceLimits{started: svc.Metadata().Created(), limits: desired}
+
+				continue
+			}
+
+			if err = ctrl.Cgroups.Update(cgroup.Path(cgroupService.Cgroup(nil)), desired.resources()); err != nil {
+				return fmt.Errorf("error updating limits of service %q: %w", id, err)
+			}
+
+			logger.Info("applied service limits", zap.String("service", id))
+
+			ctrl.applied[id] = appliedServiceLimits{started: svc.Metadata().Created(), limits: desired}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// bootServiceLimits returns the limits of the service cgroup set on boot.
+func bootServiceLimits(id string) serviceLimits {
+	limits := serviceLimits{
+		memoryMax: math.MaxInt64,
+	}
+
+	if memoryMax, ok := defaultServiceMemoryMax[id]; ok {
+		limits.memoryMax = memoryMax
+	}
+
+	return limits
+}
+
+// defaultServiceLimits returns the limits of the service cgroup if no limits are configured.
+//
+// The memory limit of the core services is raised to their memory protection.
+func defaultServiceLimits(id string, systemResources talosconfig.SystemResourcesConfig) serviceLimits {
+	limits := bootServiceLimits(id)
+
+	if limits.memoryMax == math.MaxInt64 || systemResources == nil {
+		return limits
+	}
+
+	for _, protection := range systemResources.OOMProtection() {
+		if memoryMin, ok := protection.MemoryMin().Get(); ok && protection.Service() == id {
+			limits.memoryMax = max(limits.memoryMax, int64(memoryMin))
+		}
+	}
+
+	return limits
+}
+
+// desiredServiceLimits overrides the default limits with the configured ones.
+func desiredServiceLimits(defaults serviceLimits, configured talosconfig.ServiceLimitsConfig) serviceLimits {
+	if configured == nil {
+		return defaults
+	}
+
+	limits := defaults
+
+	if memory, ok := configured.Memory().Get(); ok {
+		limits.memoryMax = int64(memory)
+	}
+
+	if milliCPU, ok := configured.MilliCPU().Get(); ok {
+		limits.milliCPU = milliCPU
+	}
+
+	if pids, ok := configured.Pids().Get(); ok {
+		limits.pids = pids
+	}
+
+	return limits
+}
+
+// resources converts the limits to the cgroup resources.
+//
+// Zero CPU and pids limits mean no limit.
+func (limits serviceLimits) resources() *cgroup2.Resources {
+	pids := limits.pids
+	if pids == 0 {
+		pids = -1
+	}
+
+	return &cgroup2.Resources{
+		Memory: &cgroup2.Memory{
+			Max: pointer.To(limits.memoryMax),
+		},
+		CPU: &cgroup2.CPU{
+			Max: cgroup.MilliCoresToCPUMax(cgroup.MilliCores(limits.milliCPU)),
+		},
+		Pids: &cgroup2.Pids{
+			Max: pids,
+		},
+	}
+}
@@ -0,0 +1,153 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/containerd/cgroups/v3/cgroup2"
+	"github.com/siderolabs/go-pointer"
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimecontrollers "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/services"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	extservices "github.com/siderolabs/talos/pkg/machinery/extensions/services"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	v1alpha1res "github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+type ServiceLimitsSuite struct {
+	ctest.DefaultSuite
+
+	cgroupMock *cgroupManagerMock
+}
+
+func TestServiceLimitsSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &ServiceLimitsSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.cgroupMock = &cgroupManagerMock{
+				updates:      map[string]cgroup2.Resources{},
+				oomScoreAdjs: map[string]int{},
+			}
+
+			svcMock := &serviceMock{
+				services: map[string]system.Service{
+					"apid":           &services.APID{},
+					"ext-nut-client": &services.Extension{Spec: extservices.Spec{Name: "nut-client"}},
+				},
+				running:      map[string]bool{},
+				timesStarted: map[string]int{},
+				timesStopped: map[string]int{},
+			}
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&runtimecontrollers.ServiceLimitsController{
+				V1Alpha1Services: svcMock,
+				Cgroups:          s.cgroupMock,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *ServiceLimitsSuite) assertResources(path string, expected cgroup2.Resources) {
+	suite.Assert().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			actual, ok := suite.cgroupMock.resources(path)
+			if !ok {
+				return retry.ExpectedErrorf("cgroup %q: no resources applied", path)
+			}
+
+			if !reflect.DeepEqual(actual, expected) {
+				return retry.ExpectedErrorf("cgroup %q: resources expected %+v, actual %+v", path, expected, actual)
+			}
+
+			return nil
+		},
+	))
+}
+
+func limitsResources(memoryMax int64, cpuMax cgroup2.CPUMax, pids int64) cgroup2.Resources {
+	return cgroup2.Resources{
+		Memory: &cgroup2.Memory{
+			Max: pointer.To(memoryMax),
+		},
+		CPU: &cgroup2.CPU{
+			Max: cpuMax,
+		},
+		Pids: &cgroup2.Pids{
+			Max: pids,
+		},
+	}
+}
+
+func (suite *ServiceLimitsSuite) TestReconcile() {
+	const extCgroup = constants.CgroupExtensions + "/nut-client"
+
+	for _, id := range []string{"apid", "ext-nut-client"} {
+		svc := v1alpha1res.NewService(id)
+		svc.TypedSpec().Running = true
+		suite.Require().NoError(suite.State().Create(suite.Ctx(), svc))
+	}
+
+	serviceLimits := runtimecfg.NewServiceLimitsV1Alpha1()
+	serviceLimits.MetaName = "ext-nut-client"
+	serviceLimits.LimitCPU = "500m"
+	serviceLimits.LimitMemory = "512MiB"
+	serviceLimits.LimitPids = 100
+
+	systemResources := runtimecfg.NewSystemResourcesV1Alpha1()
+	systemResources.SystemOOMProtection = []runtimecfg.OOMProtectionConfig{
+		{
+			OOMService:   "apid",
+			OOMMemoryMin: "64MiB",
+		},
+	}
+
+	ctr, err := container.New(&v1alpha1.Config{}, serviceLimits, systemResources)
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(ctr)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	suite.assertResources(extCgroup, limitsResources(512*1024*1024, "50000 100000", 100))
+
+	// the memory limit is raised to the memory protection
+	suite.assertResources(constants.CgroupApid, limitsResources(64*1024*1024, "max 100000", -1))
+
+	// the limits are re-applied when the service is restarted
+	suite.cgroupMock.mu.Lock()
+	delete(suite.cgroupMock.updates, extCgroup)
+	suite.cgroupMock.mu.Unlock()
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), v1alpha1res.NewService("ext-nut-client").Metadata()))
+
+	svc := v1alpha1res.NewService("ext-nut-client")
+	svc.TypedSpec().Running = true
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), svc))
+
+	suite.assertResources(extCgroup, limitsResources(512*1024*1024, "50000 100000", 100))
+
+	// removing the limits restores the defaults
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), cfg.Metadata()))
+
+	suite.assertResources(extCgroup, limitsResources(math.MaxInt64, "max 100000", -1))
+	suite.assertResources(constants.CgroupApid, limitsResources(constants.CgroupApidMaxMemory, "max 100000", -1))
+}
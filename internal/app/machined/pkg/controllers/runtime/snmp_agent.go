@@ -0,0 +1,180 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"slices"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
+	"go.uber.org/zap"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/pkg/snmp"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/version"
+)
+
+// SNMPAgentController runs the read-only SNMP agent if enabled in the machine configuration.
+type SNMPAgentController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *SNMPAgentController) Name() string {
+	return "runtime.SNMPAgentController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *SNMPAgentController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *SNMPAgentController) Outputs() []controller.Output {
+	return nil
+}
+
+type snmpAgentSettings struct {
+	listenAddress string
+	community     string
+	users         []snmp.User
+	location      string
+	contact       string
+}
+
+func (s snmpAgentSettings) Equal(other snmpAgentSettings) bool {
+	return s.listenAddress == other.listenAddress &&
+		s.community == other.community &&
+		slices.Equal(s.users, other.users) &&
+		s.location == other.location &&
+		s.contact == other.contact
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *SNMPAgentController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	var (
+		conn     net.PacketConn
+		settings snmpAgentSettings
+	)
+
+	stopAgent := func() {
+		if conn == nil {
+			return
+		}
+
+		if err := conn.Close(); err != nil {
+			logger.Error("error stopping SNMP agent", zap.Error(err))
+		}
+
+		logger.Info("SNMP agent stopped", zap.String("address", settings.listenAddress))
+
+		conn, settings = nil, snmpAgentSettings{}
+	}
+
+	defer stopAgent()
+
+	startTime := time.Now()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		var newSettings snmpAgentSettings
+
+		if cfg != nil && cfg.Config().Runtime().SNMP() != nil {
+			snmpConfig := cfg.Config().Runtime().SNMP()
+
+			newSettings = snmpAgentSettings{
+				listenAddress: snmpConfig.ListenAddress(),
+				community:     snmpConfig.Community(),
+				users: xslices.Map(snmpConfig.Users(), func(u talosconfig.SNMPUser) snmp.User {
+					return snmp.User{
+						Name:           u.Name(),
+						AuthProtocol:   u.AuthProtocol(),
+						AuthPassphrase: u.AuthPassphrase(),
+						PrivProtocol:   u.PrivProtocol(),
+						PrivPassphrase: u.PrivPassphrase(),
+					}
+				}),
+				location: snmpConfig.Location(),
+				contact:  snmpConfig.Contact(),
+			}
+		}
+
+		if newSettings.Equal(settings) {
+			continue
+		}
+
+		stopAgent()
+
+		if newSettings.listenAddress == "" {
+			continue
+		}
+
+		collector := &snmp.Collector{
+			Filesystems: []string{constants.EphemeralMountPoint, constants.StateMountPoint},
+			Description: systemDescription(),
+			Location:    newSettings.location,
+			Contact:     newSettings.contact,
+			StartTime:   startTime,
+		}
+
+		agent, err := snmp.NewAgent(newSettings.community, newSettings.users, collector.Collect, logger)
+		if err != nil {
+			return fmt.Errorf("error creating SNMP agent: %w", err)
+		}
+
+		conn, err = net.ListenPacket("udp", newSettings.listenAddress)
+		if err != nil {
+			return fmt.Errorf("error listening on %q: %w", newSettings.listenAddress, err)
+		}
+
+		settings = newSettings
+
+		go func(conn net.PacketConn) {
+			if err := agent.Serve(conn); err != nil {
+				logger.Error("SNMP agent failed", zap.Error(err))
+			}
+		}(conn)
+
+		logger.Info("SNMP agent started", zap.String("address", settings.listenAddress))
+	}
+}
+
+// systemDescription builds the sysDescr value.
+func systemDescription() string {
+	description := version.Name + " " + version.Tag
+
+	var uname unix.Utsname
+
+	if err := unix.Uname(&uname); err == nil {
+		description += " " + unix.ByteSliceToString(uname.Sysname[:]) + " " + unix.ByteSliceToString(uname.Release[:]) + " " + unix.ByteSliceToString(uname.Machine[:])
+	}
+
+	return description
+}
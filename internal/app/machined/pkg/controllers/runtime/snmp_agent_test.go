@@ -0,0 +1,109 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"net"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+)
+
+type SNMPAgentControllerSuite struct {
+	ctest.DefaultSuite
+}
+
+func TestSNMPAgentControllerSuite(t *testing.T) {
+	suite.Run(t, &SNMPAgentControllerSuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&runtime.SNMPAgentController{}))
+			},
+		},
+	})
+}
+
+func (suite *SNMPAgentControllerSuite) freePort() int {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	suite.Require().NoError(err)
+
+	port := conn.LocalAddr().(*net.UDPAddr).Port //nolint:forcetypeassert
+
+	suite.Require().NoError(conn.Close())
+
+	return port
+}
+
+func (suite *SNMPAgentControllerSuite) query(port int, community string) (string, error) {
+	client := &gosnmp.GoSNMP{
+		Target:    "127.0.0.1",
+		Port:      uint16(port),
+		Version:   gosnmp.Version2c,
+		Community: community,
+		Timeout:   200 * time.Millisecond,
+	}
+
+	if err := client.Connect(); err != nil {
+		return "", err
+	}
+
+	defer client.Conn.Close() //nolint:errcheck
+
+	result, err := client.Get([]string{".1.3.6.1.2.1.1.1.0", ".1.3.6.1.2.1.1.6.0"})
+	if err != nil {
+		return "", retry.ExpectedError(err)
+	}
+
+	return string(result.Variables[0].Value.([]byte)) + "|" + string(result.Variables[1].Value.([]byte)), nil //nolint:forcetypeassert
+}
+
+func (suite *SNMPAgentControllerSuite) TestServe() {
+	port := suite.freePort()
+
+	snmpCfg := runtimecfg.NewSNMPV1Alpha1()
+	snmpCfg.SNMPListenAddress = net.JoinHostPort("127.0.0.1", strconv.Itoa(port))
+	snmpCfg.SNMPCommunity = "public"
+	snmpCfg.SNMPLocation = "DC1"
+
+	cfg, err := container.New(snmpCfg)
+	suite.Require().NoError(err)
+
+	machineConfig := config.NewMachineConfig(cfg)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), machineConfig))
+
+	var value string
+
+	suite.Require().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		value, err = suite.query(port, "public")
+
+		return err
+	}))
+
+	suite.Assert().True(strings.HasPrefix(value, "Talos"), value)
+	suite.Assert().True(strings.HasSuffix(value, "|DC1"), value)
+
+	// remove the SNMP config, agent should be stopped
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), machineConfig.Metadata()))
+
+	suite.Require().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(func() error {
+		if _, err := suite.query(port, "public"); err == nil {
+			return retry.ExpectedErrorf("SNMP agent is still running")
+		}
+
+		return nil
+	}))
+}
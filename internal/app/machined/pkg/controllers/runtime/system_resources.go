@@ -0,0 +1,364 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"syscall"
+
+	"github.com/containerd/cgroups/v3"
+	"github.com/containerd/cgroups/v3/cgroup2"
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/go-pointer"
+	"go.uber.org/zap"
+
+	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/cgroup"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// CgroupManager applies resources to the cgroups.
+type CgroupManager interface {
+	// Update updates the cgroup resources.
+	Update(path string, resources *cgroup2.Resources) error
+	// SetOOMScoreAdj sets the OOM score adjustment of the processes in the cgroup.
+	SetOOMScoreAdj(path string, score int) error
+}
+
+// SystemResourcesController applies the resources reserved for Talos system services to the system cgroups.
+//
+// The system cgroups are created with the default reservations on boot, the controller
+// raises the reservations once the machine config is available, and restores the defaults
+// when the reservation is removed from the machine config.
+//
+// The controller also applies the OOM protection of the core services, re-applying the OOM score adjustment
+// when the services are restarted.
+type SystemResourcesController struct {
+	V1Alpha1Mode machineruntime.Mode
+
+	// Cgroups defaults to managing the cgroups via cgroupfs.
+	Cgroups CgroupManager
+
+	applied map[string]*cgroup2.Resources
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *SystemResourcesController) Name() string {
+	return "runtime.SystemResourcesController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *SystemResourcesController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *SystemResourcesController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *SystemResourcesController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	// in container mode cgroup resources are managed by the container runtime
+	if ctrl.V1Alpha1Mode == machineruntime.ModeContainer {
+		return nil
+	}
+
+	if ctrl.Cgroups == nil {
+		// the system cgroups are not configured with cgroups v1
+		if cgroups.Mode() != cgroups.Unified {
+			return nil
+		}
+
+		ctrl.Cgroups = cgroupfsManager{}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		var systemResources talosconfig.SystemResourcesConfig
+
+		if cfg != nil {
+			systemResources = cfg.Config().SystemResources()
+		}
+
+		desired := systemCgroupsResources(systemResources)
+
+		// as long as nothing is configured, the cgroups keep the resources set on boot
+		if ctrl.applied == nil && systemResources == nil {
+			ctrl.applied = desired
+		}
+
+		if !reflect.DeepEqual(desired, ctrl.applied) {
+			for path, resources := range desired {
+				if err = ctrl.Cgroups.Update(cgroup.Path(path), resources); err != nil {
+					return fmt.Errorf("error updating cgroup %q: %w", path, err)
+				}
+			}
+
+			logger.Info("applied system resources")
+
+			ctrl.applied = desired
+		}
+
+		for _, service := range coreServices {
+			if err = ctrl.Cgroups.SetOOMScoreAdj(cgroup.Path(service.cgroup), service.oomScoreAdj(systemResources)); err != nil {
+				return fmt.Errorf("error setting OOM score adjustment for %q: %w", service.name, err)
+			}
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+// systemCgroup describes the default reservation of a top-level system cgroup.
+type systemCgroup struct {
+	name string
+
+	// defaultMemory is the memory protection set on boot (if any).
+	defaultMemory int64
+	// memoryShare is the weight of the cgroup when splitting the reserved memory.
+	memoryShare int64
+	milliCPU    int64
+}
+
+// systemCgroups is the list of cgroups the system services run in (machined, system services and the pod runtime).
+var systemCgroups = []systemCgroup{
+	{
+		name:          constants.CgroupInit,
+		defaultMemory: constants.CgroupInitReservedMemory,
+		memoryShare:   constants.CgroupInitReservedMemory,
+		milliCPU:      constants.CgroupInitMillicores,
+	},
+	{
+		name:          constants.CgroupSystem,
+		defaultMemory: constants.CgroupSystemReservedMemory,
+		memoryShare:   constants.CgroupSystemReservedMemory,
+		milliCPU:      constants.CgroupSystemMillicores,
+	},
+	{
+		name:        constants.CgroupPodRuntimeRoot,
+		memoryShare: constants.CgroupPodRuntimeReservedMemory + constants.CgroupKubeletReservedMemory,
+		milliCPU:    constants.CgroupPodRuntimeRootMillicores,
+	},
+}
+
+// resources returns the cgroup resources for the reservation.
+//
+// The reservation is split between the system cgroups proportionally to the default reservations,
+// and it never goes below the defaults.
+func (group systemCgroup) resources(reservedMilliCPU int64, reservedMemory uint64) *cgroup2.Resources {
+	var totalMemoryShare, totalMilliCPU int64
+
+	for _, g := range systemCgroups {
+		totalMemoryShare += g.memoryShare
+		totalMilliCPU += g.milliCPU
+	}
+
+	memory := max(group.defaultMemory, int64(reservedMemory)*group.memoryShare/totalMemoryShare)
+	milliCPU := max(group.milliCPU, reservedMilliCPU*group.milliCPU/totalMilliCPU)
+
+	return &cgroup2.Resources{
+		Memory: &cgroup2.Memory{
+			Min: pointer.To(memory),
+			Low: pointer.To(memory * 2),
+		},
+		CPU: &cgroup2.CPU{
+			Weight: pointer.To(cgroup.MillicoresToCPUWeight(cgroup.MilliCores(milliCPU))),
+		},
+	}
+}
+
+// coreService describes the default OOM protection of a core service.
+type coreService struct {
+	name   string
+	cgroup string
+
+	defaultOOMScoreAdj int
+	// defaultMemory is the memory protection of the service cgroup set on boot (unused for the top-level cgroups).
+	//
+	// The memory limit of the service cgroup is raised to the protection by the ServiceLimitsController.
+	defaultMemory int64
+}
+
+// coreServices is the list of services supporting OOM protection overrides.
+var coreServices = []coreService{
+	{
+		name:               talosconfig.OOMProtectionServiceMachined,
+		cgroup:             constants.CgroupInit,
+		defaultOOMScoreAdj: constants.MachinedOOMScoreAdj,
+	},
+	{
+		name:               talosconfig.OOMProtectionServiceAPID,
+		cgroup:             constants.CgroupApid,
+		defaultOOMScoreAdj: -998,
+		defaultMemory:      constants.CgroupApidReservedMemory,
+	},
+	{
+		name:               talosconfig.OOMProtectionServiceTrustd,
+		cgroup:             constants.CgroupTrustd,
+		defaultOOMScoreAdj: -998,
+		defaultMemory:      constants.CgroupTrustdReservedMemory,
+	},
+	{
+		name:               talosconfig.OOMProtectionServiceContainerd,
+		cgroup:             constants.CgroupSystemRuntime,
+		defaultOOMScoreAdj: -999,
+		defaultMemory:      constants.CgroupSystemRuntimeReservedMemory,
+	},
+}
+
+func (service coreService) protection(systemResources talosconfig.SystemResourcesConfig) talosconfig.OOMProtectionConfig {
+	if systemResources == nil {
+		return nil
+	}
+
+	for _, protection := range systemResources.OOMProtection() {
+		if protection.Service() == service.name {
+			return protection
+		}
+	}
+
+	return nil
+}
+
+func (service coreService) oomScoreAdj(systemResources talosconfig.SystemResourcesConfig) int {
+	if protection := service.protection(systemResources); protection != nil {
+		return protection.OOMScoreAdj().ValueOr(service.defaultOOMScoreAdj)
+	}
+
+	return service.defaultOOMScoreAdj
+}
+
+// applyMemoryProtection applies the memory protection of the service to the cgroup resources.
+func (service coreService) applyMemoryProtection(resources map[string]*cgroup2.Resources, systemResources talosconfig.SystemResourcesConfig) {
+	var memoryMin optional.Optional[uint64]
+
+	if protection := service.protection(systemResources); protection != nil {
+		memoryMin = protection.MemoryMin()
+	}
+
+	if res, ok := resources[service.cgroup]; ok {
+		// top-level cgroup, the default memory protection comes from the reservation
+		if memory, configured := memoryMin.Get(); configured {
+			res.Memory.Min = pointer.To(int64(memory))
+			res.Memory.Low = pointer.To(int64(memory) * 2)
+		}
+
+		return
+	}
+
+	memory := int64(memoryMin.ValueOr(uint64(service.defaultMemory)))
+
+	resources[service.cgroup] = &cgroup2.Resources{
+		Memory: &cgroup2.Memory{
+			Min: pointer.To(memory),
+			Low: pointer.To(memory * 2),
+		},
+	}
+}
+
+// systemCgroupsResources returns the desired resources of the system cgroups.
+func systemCgroupsResources(systemResources talosconfig.SystemResourcesConfig) map[string]*cgroup2.Resources {
+	var (
+		reservedMilliCPU int64
+		reservedMemory   uint64
+	)
+
+	if systemResources != nil {
+		reservedMilliCPU = systemResources.ReservedMilliCPU()
+		reservedMemory = systemResources.ReservedMemory()
+	}
+
+	resources := make(map[string]*cgroup2.Resources, len(systemCgroups)+len(coreServices))
+
+	for _, group := range systemCgroups {
+		resources[group.name] = group.resources(reservedMilliCPU, reservedMemory)
+	}
+
+	for _, service := range coreServices {
+		service.applyMemoryProtection(resources, systemResources)
+	}
+
+	return resources
+}
+
+// cgroupfsManager manages the cgroups via cgroupfs.
+type cgroupfsManager struct{}
+
+func (cgroupfsManager) Update(path string, resources *cgroup2.Resources) error {
+	cg, err := cgroup2.Load(path)
+	if err != nil {
+		return err
+	}
+
+	// the controllers (e.g. pids) might be not enabled for the cgroup yet
+	if err = cg.ToggleControllers(resources.EnabledControllers(), cgroup2.Enable); err != nil {
+		return err
+	}
+
+	return cg.Update(resources)
+}
+
+func (cgroupfsManager) SetOOMScoreAdj(path string, score int) error {
+	cg, err := cgroup2.Load(path)
+	if err != nil {
+		return err
+	}
+
+	pids, err := cg.Procs(false)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+
+		return err
+	}
+
+	for _, pid := range pids {
+		err = os.WriteFile(filepath.Join("/proc", strconv.FormatUint(pid, 10), "oom_score_adj"), []byte(strconv.Itoa(score)), 0o644)
+		// the process might have exited
+		if err != nil && !errors.Is(err, os.ErrNotExist) && !errors.Is(err, syscall.ESRCH) {
+			return err
+		}
+	}
+
+	return nil
+}
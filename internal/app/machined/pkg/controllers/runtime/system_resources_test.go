@@ -0,0 +1,225 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/containerd/cgroups/v3/cgroup2"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/siderolabs/go-pointer"
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimecontrollers "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+)
+
+type cgroupManagerMock struct {
+	mu sync.Mutex
+
+	updates      map[string]cgroup2.Resources
+	oomScoreAdjs map[string]int
+}
+
+func (mock *cgroupManagerMock) Update(path string, resources *cgroup2.Resources) error {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	mock.updates[path] = *resources
+
+	return nil
+}
+
+func (mock *cgroupManagerMock) SetOOMScoreAdj(path string, score int) error {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	mock.oomScoreAdjs[path] = score
+
+	return nil
+}
+
+// memory returns the memory.min and memory.max applied to the cgroup, or -1 if not set.
+func (mock *cgroupManagerMock) memory(path string) (int64, int64) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	resources, ok := mock.updates[path]
+	if !ok {
+		return -1, -1
+	}
+
+	return pointer.SafeDeref(resources.Memory.Min), pointer.SafeDeref(resources.Memory.Max)
+}
+
+// resources returns the resources applied to the cgroup.
+func (mock *cgroupManagerMock) resources(path string) (cgroup2.Resources, bool) {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	resources, ok := mock.updates[path]
+
+	return resources, ok
+}
+
+func (mock *cgroupManagerMock) oomScoreAdj(path string) int {
+	mock.mu.Lock()
+	defer mock.mu.Unlock()
+
+	return mock.oomScoreAdjs[path]
+}
+
+type SystemResourcesSuite struct {
+	ctest.DefaultSuite
+
+	cgroupMock *cgroupManagerMock
+}
+
+func TestSystemResourcesSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &SystemResourcesSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			s.cgroupMock = &cgroupManagerMock{
+				updates:      map[string]cgroup2.Resources{},
+				oomScoreAdjs: map[string]int{},
+			}
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&runtimecontrollers.SystemResourcesController{
+				Cgroups: s.cgroupMock,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *SystemResourcesSuite) assertMemoryMin(expected map[string]int64) {
+	suite.Assert().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			for path, expectedMin := range expected {
+				if actual, _ := suite.cgroupMock.memory(path); actual != expectedMin {
+					return retry.ExpectedErrorf("cgroup %q: memory.min expected %d, actual %d", path, expectedMin, actual)
+				}
+			}
+
+			return nil
+		},
+	))
+}
+
+func (suite *SystemResourcesSuite) assertOOMScoreAdj(expected map[string]int) {
+	suite.Assert().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			for path, expectedScore := range expected {
+				if actual := suite.cgroupMock.oomScoreAdj(path); actual != expectedScore {
+					return retry.ExpectedErrorf("cgroup %q: oom_score_adj expected %d, actual %d", path, expectedScore, actual)
+				}
+			}
+
+			return nil
+		},
+	))
+}
+
+func (suite *SystemResourcesSuite) TestReconcile() {
+	const podRuntimeShare = constants.CgroupPodRuntimeReservedMemory + constants.CgroupKubeletReservedMemory
+
+	systemResources := runtimecfg.NewSystemResourcesV1Alpha1()
+	systemResources.SystemReservedMemory = "968MiB"
+
+	ctr, err := container.New(&v1alpha1.Config{}, systemResources)
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(ctr)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	// the reservation is twice the default reservations, so it is split proportionally to them
+	suite.assertMemoryMin(map[string]int64{
+		constants.CgroupInit:           2 * constants.CgroupInitReservedMemory,
+		constants.CgroupSystem:         2 * constants.CgroupSystemReservedMemory,
+		constants.CgroupPodRuntimeRoot: 2 * podRuntimeShare,
+	})
+
+	// reservation below the defaults doesn't lower them
+	systemResources = runtimecfg.NewSystemResourcesV1Alpha1()
+	systemResources.SystemReservedMemory = "100MiB"
+
+	ctr, err = container.New(&v1alpha1.Config{}, systemResources)
+	suite.Require().NoError(err)
+
+	newCfg := config.NewMachineConfig(ctr)
+	newCfg.Metadata().SetVersion(cfg.Metadata().Version())
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), newCfg))
+
+	suite.assertMemoryMin(map[string]int64{
+		constants.CgroupInit:           constants.CgroupInitReservedMemory,
+		constants.CgroupSystem:         constants.CgroupSystemReservedMemory,
+		constants.CgroupPodRuntimeRoot: 100 * 1024 * 1024 * podRuntimeShare / (constants.CgroupInitReservedMemory + constants.CgroupSystemReservedMemory + podRuntimeShare),
+	})
+
+	// removing the reservation restores the defaults
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), newCfg.Metadata()))
+
+	suite.assertMemoryMin(map[string]int64{
+		constants.CgroupInit:           constants.CgroupInitReservedMemory,
+		constants.CgroupSystem:         constants.CgroupSystemReservedMemory,
+		constants.CgroupPodRuntimeRoot: 0,
+	})
+}
+
+func (suite *SystemResourcesSuite) TestOOMProtection() {
+	// defaults are applied without any configuration
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(container.NewV1Alpha1(&v1alpha1.Config{}))))
+
+	suite.assertOOMScoreAdj(map[string]int{
+		constants.CgroupInit:          constants.MachinedOOMScoreAdj,
+		constants.CgroupApid:          -998,
+		constants.CgroupTrustd:        -998,
+		constants.CgroupSystemRuntime: -999,
+	})
+
+	systemResources := runtimecfg.NewSystemResourcesV1Alpha1()
+	systemResources.SystemOOMProtection = []runtimecfg.OOMProtectionConfig{
+		{
+			OOMService:       "machined",
+			OOMScoreAdjValue: pointer.To(-1000),
+			OOMMemoryMin:     "256MiB",
+		},
+		{
+			OOMService:   "apid",
+			OOMMemoryMin: "64MiB",
+		},
+	}
+
+	ctr, err := container.New(&v1alpha1.Config{}, systemResources)
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(ctr)
+	cfg.Metadata().SetVersion(resource.VersionUndefined.Next())
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), cfg))
+
+	suite.assertOOMScoreAdj(map[string]int{
+		constants.CgroupInit: -1000,
+		constants.CgroupApid: -998,
+	})
+
+	suite.assertMemoryMin(map[string]int64{
+		constants.CgroupInit:   256 * 1024 * 1024,
+		constants.CgroupApid:   64 * 1024 * 1024,
+		constants.CgroupTrustd: constants.CgroupTrustdReservedMemory,
+	})
+}
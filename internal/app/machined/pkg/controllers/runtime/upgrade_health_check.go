@@ -0,0 +1,287 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// UpgradeHealthCheckInterval is the interval between the post-upgrade health check runs.
+const UpgradeHealthCheckInterval = 5 * time.Second
+
+// UpgradeHealthCheckController runs post-upgrade health checks, and rolls back to the previous version if they fail.
+//
+// The health checks are run only on the first boot after the upgrade (while the upgrade fallback tag is present).
+// Once the health checks pass, the controller removes the fallback tag.
+// The health checks start time is persisted in META, so that the timeout is not reset if machined restarts
+// or the node reboots before the health checks pass.
+// The rollback is held off while the node is in maintenance.
+type UpgradeHealthCheckController struct {
+	MetaProvider MetaProvider
+	// Rollback reverts the bootloader to the previous version and reboots the machine.
+	Rollback func(context.Context) error
+	// HTTPClient is used for HTTP probes, defaults to a client with a short timeout.
+	HTTPClient *http.Client
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *UpgradeHealthCheckController) Name() string {
+	return "runtime.UpgradeHealthCheckController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *UpgradeHealthCheckController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: k8s.NamespaceName,
+			Type:      k8s.NodeStatusType,
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *UpgradeHealthCheckController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *UpgradeHealthCheckController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if _, upgraded := ctrl.MetaProvider.Meta().ReadTag(meta.Upgrade); !upgraded {
+		// not booted after an upgrade, nothing to check
+		return nil
+	}
+
+	if ctrl.HTTPClient == nil {
+		ctrl.HTTPClient = &http.Client{
+			Timeout: UpgradeHealthCheckInterval,
+		}
+	}
+
+	var started time.Time
+
+	ticker := time.NewTicker(UpgradeHealthCheckInterval)
+	defer ticker.Stop()
+
+	var (
+		lastFailure string
+		heldOff     bool
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		healthCheck := cfg.Config().Runtime().UpgradeHealthCheck()
+		if healthCheck == nil {
+			// no health checks configured, the fallback tag is removed by the DropUpgradeFallbackController
+			return nil
+		}
+
+		if started.IsZero() {
+			if started, err = ctrl.startedAt(ctx); err != nil {
+				return err
+			}
+		}
+
+		failure, err := ctrl.check(ctx, r, healthCheck)
+		if err != nil {
+			return err
+		}
+
+		if failure == "" {
+			logger.Info("post-upgrade health checks passed")
+
+			if _, err = ctrl.MetaProvider.Meta().DeleteTag(ctx, meta.Upgrade); err != nil {
+				return err
+			}
+
+			if err = ctrl.clearStartedAt(ctx); err != nil {
+				return err
+			}
+
+			return ctrl.MetaProvider.Meta().Flush()
+		}
+
+		if failure != lastFailure {
+			logger.Info("waiting for post-upgrade health checks", zap.String("failure", failure))
+
+			lastFailure = failure
+		}
+
+		if time.Since(started) < healthCheck.Timeout() {
+			continue
+		}
+
+		if _, inMaintenance := ctrl.MetaProvider.Meta().ReadTag(meta.NodeMaintenance); inMaintenance {
+			if !heldOff {
+				logger.Warn("post-upgrade health checks failed, rollback is held off while the node is in maintenance", zap.String("failure", failure))
+
+				heldOff = true
+			}
+
+			continue
+		}
+
+		logger.Error("post-upgrade health checks failed, rolling back", zap.String("failure", failure), zap.Duration("timeout", healthCheck.Timeout()))
+
+		// the previous version doesn't clear the start time, so clear it before rolling back
+		if err = ctrl.clearStartedAt(ctx); err != nil {
+			return err
+		}
+
+		if err = ctrl.MetaProvider.Meta().Flush(); err != nil {
+			return err
+		}
+
+		if err = ctrl.Rollback(ctx); err != nil {
+			return fmt.Errorf("error rolling back: %w", err)
+		}
+
+		return nil
+	}
+}
+
+// startedAt returns the time the health checks were started at, recording the current time on the first run.
+//
+// If the recorded time is in the future (e.g. the clock was adjusted), it is reset to the current time.
+func (ctrl *UpgradeHealthCheckController) startedAt(ctx context.Context) (time.Time, error) {
+	now := time.Now()
+
+	if val, ok := ctrl.MetaProvider.Meta().ReadTag(meta.UpgradeHealthCheckStarted); ok {
+		started, err := time.Parse(time.RFC3339, val)
+		if err == nil && !started.After(now) {
+			return started, nil
+		}
+	}
+
+	if _, err := ctrl.MetaProvider.Meta().SetTag(ctx, meta.UpgradeHealthCheckStarted, now.Format(time.RFC3339)); err != nil {
+		return time.Time{}, fmt.Errorf("error saving health checks start time: %w", err)
+	}
+
+	if err := ctrl.MetaProvider.Meta().Flush(); err != nil {
+		return time.Time{}, fmt.Errorf("error saving health checks start time: %w", err)
+	}
+
+	return now, nil
+}
+
+func (ctrl *UpgradeHealthCheckController) clearStartedAt(ctx context.Context) error {
+	_, err := ctrl.MetaProvider.Meta().DeleteTag(ctx, meta.UpgradeHealthCheckStarted)
+
+	return err
+}
+
+// check returns the description of the first failed health check, or empty string if all checks passed.
+//
+//nolint:gocyclo
+func (ctrl *UpgradeHealthCheckController) check(ctx context.Context, r controller.Reader, healthCheck talosconfig.UpgradeHealthCheckConfig) (string, error) {
+	for _, id := range healthCheck.Services() {
+		service, err := safe.ReaderGetByID[*v1alpha1.Service](ctx, r, id)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				return fmt.Sprintf("service %q is not running", id), nil
+			}
+
+			return "", fmt.Errorf("error getting service %q: %w", id, err)
+		}
+
+		if !service.TypedSpec().Running {
+			return fmt.Sprintf("service %q is not running", id), nil
+		}
+
+		if !service.TypedSpec().Healthy {
+			return fmt.Sprintf("service %q is not healthy", id), nil
+		}
+	}
+
+	if healthCheck.KubeletReady() {
+		nodeStatuses, err := safe.ReaderListAll[*k8s.NodeStatus](ctx, r)
+		if err != nil {
+			return "", fmt.Errorf("error listing node statuses: %w", err)
+		}
+
+		ready := false
+
+		for it := nodeStatuses.Iterator(); it.Next(); {
+			if it.Value().TypedSpec().NodeReady {
+				ready = true
+			}
+		}
+
+		if !ready {
+			return "kubernetes node is not ready", nil
+		}
+	}
+
+	for _, probe := range healthCheck.HTTPProbes() {
+		if failure := ctrl.probe(ctx, probe); failure != "" {
+			return failure, nil
+		}
+	}
+
+	return "", nil
+}
+
+func (ctrl *UpgradeHealthCheckController) probe(ctx context.Context, probe talosconfig.HTTPProbe) string {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, probe.URL().String(), nil)
+	if err != nil {
+		return fmt.Sprintf("http probe %q: %s", probe.URL(), err)
+	}
+
+	resp, err := ctrl.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Sprintf("http probe %q: %s", probe.URL(), err)
+	}
+
+	resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != probe.ExpectedStatus() {
+		return fmt.Sprintf("http probe %q: unexpected status %d", probe.URL(), resp.StatusCode)
+	}
+
+	return ""
+}
@@ -0,0 +1,208 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/siderolabs/gen/ensure"
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/internal/pkg/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	configmeta "github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	metaconsts "github.com/siderolabs/talos/pkg/machinery/meta"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+type UpgradeHealthCheckControllerSuite struct {
+	ctest.DefaultSuite
+
+	meta      *meta.Meta
+	rollbacks atomic.Int32
+}
+
+func TestUpgradeHealthCheckControllerSuite(t *testing.T) {
+	s := &UpgradeHealthCheckControllerSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			path := filepath.Join(suite.T().TempDir(), "meta")
+
+			f, err := os.Create(path)
+			suite.Require().NoError(err)
+			suite.Require().NoError(f.Truncate(1024 * 1024))
+			suite.Require().NoError(f.Close())
+
+			s.meta, err = meta.New(suite.Ctx(), state.WrapCore(namespaced.NewState(inmem.Build)), meta.WithFixedPath(path))
+			suite.Require().NoError(err)
+
+			// the machine has just been upgraded
+			_, err = s.meta.SetTag(suite.Ctx(), metaconsts.Upgrade, "A")
+			suite.Require().NoError(err)
+
+			s.rollbacks.Store(0)
+
+			suite.Require().NoError(suite.Runtime().RegisterController(&runtime.UpgradeHealthCheckController{
+				MetaProvider: metaProvider{meta: s.meta},
+				Rollback: func(context.Context) error {
+					s.rollbacks.Add(1)
+
+					return nil
+				},
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *UpgradeHealthCheckControllerSuite) createConfig(healthCheck *runtimecfg.UpgradeHealthCheckV1Alpha1) {
+	cfg, err := container.New(healthCheck)
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), config.NewMachineConfig(cfg)))
+}
+
+func (suite *UpgradeHealthCheckControllerSuite) TestHealthy() {
+	var probeStatus atomic.Int32
+
+	probeStatus.Store(http.StatusServiceUnavailable)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(int(probeStatus.Load()))
+	}))
+	suite.T().Cleanup(srv.Close)
+
+	healthCheck := runtimecfg.NewUpgradeHealthCheckV1Alpha1()
+	healthCheck.HealthCheckKubeletReady = true
+	healthCheck.HealthCheckServices = []string{"kubelet"}
+	healthCheck.HealthCheckHTTPProbes = []runtimecfg.HTTPProbe{
+		{
+			ProbeURL: configmeta.URL{URL: ensure.Value(url.Parse(srv.URL))},
+		},
+	}
+
+	suite.createConfig(healthCheck)
+
+	service := v1alpha1.NewService("kubelet")
+	service.TypedSpec().Running = true
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), service))
+
+	nodeStatus := k8s.NewNodeStatus(k8s.NamespaceName, "talos-default-worker-1")
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), nodeStatus))
+
+	time.Sleep(time.Second)
+
+	// health checks are not passing yet
+	_, ok := suite.meta.ReadTag(metaconsts.Upgrade)
+	suite.Require().True(ok)
+
+	service.TypedSpec().Healthy = true
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), service))
+
+	nodeStatus.TypedSpec().NodeReady = true
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), nodeStatus))
+
+	probeStatus.Store(http.StatusOK)
+
+	suite.AssertWithin(3*runtime.UpgradeHealthCheckInterval, 10*time.Millisecond, func() error {
+		if _, ok = suite.meta.ReadTag(metaconsts.Upgrade); ok {
+			return retry.ExpectedErrorf("tag is still present")
+		}
+
+		return nil
+	})
+
+	_, ok = suite.meta.ReadTag(metaconsts.UpgradeHealthCheckStarted)
+	suite.Assert().False(ok)
+
+	suite.Assert().EqualValues(0, suite.rollbacks.Load())
+}
+
+func (suite *UpgradeHealthCheckControllerSuite) TestRollback() {
+	healthCheck := runtimecfg.NewUpgradeHealthCheckV1Alpha1()
+	healthCheck.HealthCheckServices = []string{"etcd"}
+	healthCheck.HealthCheckTimeout = time.Second
+
+	suite.createConfig(healthCheck)
+
+	suite.AssertWithin(3*runtime.UpgradeHealthCheckInterval, 10*time.Millisecond, func() error {
+		if suite.rollbacks.Load() == 0 {
+			return retry.ExpectedErrorf("rollback wasn't triggered")
+		}
+
+		return nil
+	})
+
+	suite.Assert().EqualValues(1, suite.rollbacks.Load())
+}
+
+func (suite *UpgradeHealthCheckControllerSuite) TestRollbackNodeMaintenance() {
+	_, err := suite.meta.SetTag(suite.Ctx(), metaconsts.NodeMaintenance, "replacing disks")
+	suite.Require().NoError(err)
+
+	healthCheck := runtimecfg.NewUpgradeHealthCheckV1Alpha1()
+	healthCheck.HealthCheckServices = []string{"etcd"}
+	healthCheck.HealthCheckTimeout = time.Second
+
+	suite.createConfig(healthCheck)
+
+	time.Sleep(2 * runtime.UpgradeHealthCheckInterval)
+
+	// the rollback is held off while the node is in maintenance
+	suite.Assert().EqualValues(0, suite.rollbacks.Load())
+
+	_, err = suite.meta.DeleteTag(suite.Ctx(), metaconsts.NodeMaintenance)
+	suite.Require().NoError(err)
+
+	suite.AssertWithin(3*runtime.UpgradeHealthCheckInterval, 10*time.Millisecond, func() error {
+		if suite.rollbacks.Load() == 0 {
+			return retry.ExpectedErrorf("rollback wasn't triggered")
+		}
+
+		return nil
+	})
+}
+
+func (suite *UpgradeHealthCheckControllerSuite) TestRollbackPersistedStart() {
+	// the health checks were started before machined restart
+	_, err := suite.meta.SetTag(suite.Ctx(), metaconsts.UpgradeHealthCheckStarted, time.Now().Add(-time.Hour).Format(time.RFC3339))
+	suite.Require().NoError(err)
+
+	healthCheck := runtimecfg.NewUpgradeHealthCheckV1Alpha1()
+	healthCheck.HealthCheckServices = []string{"etcd"}
+	healthCheck.HealthCheckTimeout = 30 * time.Minute
+
+	suite.createConfig(healthCheck)
+
+	suite.AssertWithin(3*runtime.UpgradeHealthCheckInterval, 10*time.Millisecond, func() error {
+		if suite.rollbacks.Load() == 0 {
+			return retry.ExpectedErrorf("rollback wasn't triggered")
+		}
+
+		return nil
+	})
+
+	_, ok := suite.meta.ReadTag(metaconsts.UpgradeHealthCheckStarted)
+	suite.Assert().False(ok)
+}
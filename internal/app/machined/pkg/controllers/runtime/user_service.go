@@ -0,0 +1,116 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/services"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+)
+
+// UserServiceController runs the user services defined in the machine config.
+//
+// The service is restarted when its config document changes, and unloaded when the document is removed.
+type UserServiceController struct {
+	V1Alpha1Services ServiceManager
+
+	loaded map[string]talosconfig.UserServiceConfig
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *UserServiceController) Name() string {
+	return "runtime.UserServiceController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *UserServiceController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *UserServiceController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *UserServiceController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	if ctrl.loaded == nil {
+		ctrl.loaded = map[string]talosconfig.UserServiceConfig{}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		desired := map[string]talosconfig.UserServiceConfig{}
+
+		if cfg != nil {
+			for _, svcConfig := range cfg.Config().UserServices() {
+				desired[svcConfig.Name()] = svcConfig
+			}
+		}
+
+		for name, loaded := range ctrl.loaded {
+			if svcConfig, ok := desired[name]; ok && reflect.DeepEqual(svcConfig, loaded) {
+				continue
+			}
+
+			if err = ctrl.V1Alpha1Services.Unload(ctx, services.UserServiceIDPrefix+name); err != nil {
+				return fmt.Errorf("error unloading user service %q: %w", name, err)
+			}
+
+			logger.Info("unloaded user service", zap.String("service", name))
+
+			delete(ctrl.loaded, name)
+		}
+
+		for name, svcConfig := range desired {
+			if _, ok := ctrl.loaded[name]; ok {
+				continue
+			}
+
+			svc := &services.UserService{
+				Config: svcConfig,
+			}
+
+			ctrl.V1Alpha1Services.Load(svc)
+
+			if err = ctrl.V1Alpha1Services.Start(svc.ID(nil)); err != nil {
+				return fmt.Errorf("error starting user service %q: %w", name, err)
+			}
+
+			logger.Info("started user service", zap.String("service", name))
+
+			ctrl.loaded[name] = svcConfig
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
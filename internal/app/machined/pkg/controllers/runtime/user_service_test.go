@@ -0,0 +1,103 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-retry/retry"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	runtimecontrollers "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+)
+
+type UserServiceSuite struct {
+	ctest.DefaultSuite
+
+	svcMock *serviceMock
+}
+
+func TestUserServiceSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &UserServiceSuite{
+		svcMock: &serviceMock{
+			services:     map[string]system.Service{},
+			running:      map[string]bool{},
+			timesStarted: map[string]int{},
+			timesStopped: map[string]int{},
+		},
+	}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			suite.Require().NoError(suite.Runtime().RegisterController(&runtimecontrollers.UserServiceController{
+				V1Alpha1Services: s.svcMock,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+func (suite *UserServiceSuite) assertTimesStartedStopped(expected map[string]serviceStartStopInfo) {
+	suite.Assert().NoError(retry.Constant(5*time.Second, retry.WithUnits(100*time.Millisecond)).Retry(
+		func() error {
+			actual := suite.svcMock.getTimesStartedStopped()
+
+			if !reflect.DeepEqual(actual, expected) {
+				return retry.ExpectedErrorf("services status expected %v, actual %v", expected, actual)
+			}
+
+			return nil
+		},
+	))
+}
+
+func userService(name, image string) *runtimecfg.UserServiceV1Alpha1 {
+	svc := runtimecfg.NewUserServiceV1Alpha1()
+	svc.MetaName = name
+	svc.ServiceImage = image
+
+	return svc
+}
+
+func (suite *UserServiceSuite) TestReconcile() {
+	ctr, err := container.New(&v1alpha1.Config{}, userService("agent", "agent:v1"), userService("driver", "driver:v1"))
+	suite.Require().NoError(err)
+
+	cfg := config.NewMachineConfig(ctr)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), cfg))
+
+	suite.assertTimesStartedStopped(map[string]serviceStartStopInfo{
+		"user-agent":  {started: 1},
+		"user-driver": {started: 1},
+	})
+
+	// changing the service restarts it, removing the service unloads it
+	ctr, err = container.New(&v1alpha1.Config{}, userService("agent", "agent:v2"))
+	suite.Require().NoError(err)
+
+	newCfg := config.NewMachineConfig(ctr)
+	newCfg.Metadata().SetVersion(cfg.Metadata().Version())
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), newCfg))
+
+	suite.assertTimesStartedStopped(map[string]serviceStartStopInfo{
+		"user-agent": {started: 2, stopped: 1},
+	})
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), newCfg.Metadata()))
+
+	suite.assertTimesStartedStopped(map[string]serviceStartStopInfo{})
+}
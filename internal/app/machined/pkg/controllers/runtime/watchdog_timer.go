@@ -19,11 +19,17 @@ import (
 	"go.uber.org/zap"
 	"golang.org/x/sys/unix"
 
+	machineruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 )
 
 // WatchdogTimerController watches v1alpha1.Config, creates/updates/deletes kernel module specs.
-type WatchdogTimerController struct{}
+//
+// If the watchdog reports that the last reset was caused by it, an event is published.
+type WatchdogTimerController struct {
+	V1Alpha1Events machineruntime.Publisher
+}
 
 // Name implements controller.Controller interface.
 func (ctrl *WatchdogTimerController) Name() string {
@@ -73,7 +79,10 @@ func (ctrl *WatchdogTimerController) Run(ctx context.Context, r controller.Runti
 
 	defer tickerStop()
 
-	var wd *os.File
+	var (
+		wd               *os.File
+		lastResetReasons []string
+	)
 
 	wdClose := func() {
 		if wd == nil {
@@ -134,6 +143,25 @@ func (ctrl *WatchdogTimerController) Run(ctx context.Context, r controller.Runti
 				}
 
 				logger.Info("opened hardware watchdog", zap.String("path", cfg.TypedSpec().Device))
+
+				lastResetReasons = nil
+
+				bootStatus, statusErr := unix.IoctlGetInt(int(wd.Fd()), unix.WDIOC_GETBOOTSTATUS)
+				if statusErr != nil {
+					// not all watchdog drivers support boot status
+					logger.Debug("failed to get watchdog boot status", zap.String("path", wd.Name()), zap.Error(statusErr))
+				} else {
+					lastResetReasons = WatchdogResetReasons(bootStatus)
+				}
+
+				if len(lastResetReasons) > 0 {
+					logger.Warn("watchdog reported last reset reason", zap.String("path", wd.Name()), zap.Strings("reasons", lastResetReasons))
+
+					ctrl.V1Alpha1Events.Publish(ctx, &machine.WatchdogResetEvent{
+						Device:  wd.Name(),
+						Reasons: lastResetReasons,
+					})
+				}
 			}
 
 			timeout := int(cfg.TypedSpec().Timeout.Seconds())
@@ -160,6 +188,7 @@ func (ctrl *WatchdogTimerController) Run(ctx context.Context, r controller.Runti
 				status.TypedSpec().Device = cfg.TypedSpec().Device
 				status.TypedSpec().Timeout = cfg.TypedSpec().Timeout
 				status.TypedSpec().FeedInterval = feedInterval
+				status.TypedSpec().LastResetReasons = lastResetReasons
 
 				return nil
 			}); err != nil {
@@ -172,3 +201,30 @@ func (ctrl *WatchdogTimerController) Run(ctx context.Context, r controller.Runti
 		}
 	}
 }
+
+// watchdogResetFlags maps the watchdog boot status flags to the reset reasons.
+var watchdogResetFlags = []struct {
+	flag   int
+	reason string
+}{
+	{unix.WDIOF_CARDRESET, "watchdog reset"},
+	{unix.WDIOF_OVERHEAT, "overheat"},
+	{unix.WDIOF_FANFAULT, "fan fault"},
+	{unix.WDIOF_EXTERN1, "external relay 1"},
+	{unix.WDIOF_EXTERN2, "external relay 2"},
+	{unix.WDIOF_POWERUNDER, "power under voltage"},
+	{unix.WDIOF_POWEROVER, "power over voltage"},
+}
+
+// WatchdogResetReasons decodes the watchdog boot status flags (WDIOC_GETBOOTSTATUS) into the reset reasons.
+func WatchdogResetReasons(bootStatus int) []string {
+	var reasons []string
+
+	for _, f := range watchdogResetFlags {
+		if bootStatus&f.flag != 0 {
+			reasons = append(reasons, f.reason)
+		}
+	}
+
+	return reasons
+}
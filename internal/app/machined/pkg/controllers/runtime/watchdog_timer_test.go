@@ -0,0 +1,23 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+)
+
+func TestWatchdogResetReasons(t *testing.T) {
+	t.Parallel()
+
+	assert.Empty(t, runtime.WatchdogResetReasons(0))
+	assert.Equal(t, []string{"watchdog reset"}, runtime.WatchdogResetReasons(unix.WDIOF_CARDRESET))
+	assert.Equal(t, []string{"watchdog reset", "overheat"}, runtime.WatchdogResetReasons(unix.WDIOF_CARDRESET|unix.WDIOF_OVERHEAT))
+	assert.Equal(t, []string{"power under voltage"}, runtime.WatchdogResetReasons(unix.WDIOF_POWERUNDER|unix.WDIOF_KEEPALIVEPING))
+}
@@ -0,0 +1,287 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"context"
+	stdx509 "crypto/x509"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/rs/xid"
+	"github.com/siderolabs/gen/channel"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	machinedruntime "github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/pkg/certinventory"
+	"github.com/siderolabs/talos/internal/pkg/kmsgfilter"
+	"github.com/siderolabs/talos/internal/pkg/webhook"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	"github.com/siderolabs/talos/pkg/machinery/version"
+)
+
+// certificateExpiringThreshold is the time before the certificate expiration when the notification is sent.
+//
+// Certificates which are rotated automatically are reported only if they are in the last fifth of their lifetime,
+// i.e. if the rotation doesn't happen.
+const certificateExpiringThreshold = 30 * 24 * time.Hour
+
+// WebhookNotificationController sends webhook notifications about the critical machine events.
+type WebhookNotificationController struct {
+	V1Alpha1Events machinedruntime.Watcher
+	Certificates   *certinventory.Inventory
+
+	// CertificateCheckInterval defaults to 1 hour.
+	CertificateCheckInterval time.Duration
+	// Sender defaults to the sender with the default HTTP client and retry settings.
+	Sender *webhook.Sender
+
+	eventID       xid.ID
+	notifiedCerts map[string]time.Time
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *WebhookNotificationController) Name() string {
+	return "runtime.WebhookNotificationController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *WebhookNotificationController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.HostnameStatusType,
+			ID:        optional.Some(network.HostnameID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *WebhookNotificationController) Outputs() []controller.Output {
+	return nil
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo,cyclop
+func (ctrl *WebhookNotificationController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	if ctrl.Sender == nil {
+		ctrl.Sender = &webhook.Sender{}
+	}
+
+	if ctrl.notifiedCerts == nil {
+		ctrl.notifiedCerts = map[string]time.Time{}
+	}
+
+	checkInterval := ctrl.CertificateCheckInterval
+	if checkInterval == 0 {
+		checkInterval = time.Hour
+	}
+
+	ticker := time.NewTicker(checkInterval)
+	defer ticker.Stop()
+
+	var (
+		webhooks                []talosconfig.WebhookNotificationConfig
+		hostname                string
+		watchCh, consumeWatchCh chan machinedruntime.EventInfo
+	)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+			cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+			if err != nil && !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting machine config: %w", err)
+			}
+
+			hostnameStatus, err := safe.ReaderGetByID[*network.HostnameStatus](ctx, r, network.HostnameID)
+			if err != nil && !state.IsNotFoundError(err) {
+				return fmt.Errorf("error getting hostname status: %w", err)
+			}
+
+			if hostnameStatus != nil {
+				hostname = hostnameStatus.TypedSpec().FQDN()
+			}
+
+			webhooks = nil
+
+			if cfg != nil {
+				webhooks = cfg.Config().Runtime().WebhookNotifications()
+			}
+
+			if len(webhooks) == 0 {
+				// stop consuming events, they will be picked up once the webhooks are configured again
+				consumeWatchCh = nil
+
+				continue
+			}
+
+			if watchCh == nil {
+				watchCh = make(chan machinedruntime.EventInfo)
+
+				var opts []machinedruntime.WatchOptionFunc
+
+				if ctrl.eventID.IsNil() {
+					opts = append(opts, machinedruntime.WithTailEvents(-1))
+				} else {
+					opts = append(opts, machinedruntime.WithTailID(ctrl.eventID))
+				}
+
+				// Watch returns immediately, setting up a goroutine which will copy events to `watchCh`
+				if err = ctrl.V1Alpha1Events.Watch(func(eventCh <-chan machinedruntime.EventInfo) {
+					for {
+						select {
+						case <-ctx.Done():
+							return
+						case event := <-eventCh:
+							if !channel.SendWithContext(ctx, watchCh, event) {
+								return
+							}
+						}
+					}
+				}, opts...); err != nil {
+					return fmt.Errorf("error watching events: %w", err)
+				}
+			}
+
+			consumeWatchCh = watchCh
+
+			ctrl.checkCertificates(ctx, logger, webhooks, hostname)
+		case <-ticker.C:
+			if len(webhooks) > 0 {
+				ctrl.checkCertificates(ctx, logger, webhooks, hostname)
+			}
+		case event := <-consumeWatchCh:
+			if notification, ok := webhookNotification(event.Payload); ok {
+				notification.Timestamp = event.ID.Time()
+
+				ctrl.notify(ctx, logger, webhooks, hostname, notification)
+			}
+
+			ctrl.eventID = event.ID
+
+			r.ResetRestartBackoff()
+		}
+	}
+}
+
+func (ctrl *WebhookNotificationController) checkCertificates(ctx context.Context, logger *zap.Logger, webhooks []talosconfig.WebhookNotificationConfig, hostname string) {
+	if ctrl.Certificates == nil {
+		return
+	}
+
+	certs, err := ctrl.Certificates.List(ctx)
+	if err != nil {
+		logger.Warn("error listing certificates", zap.Error(err))
+
+		return
+	}
+
+	now := time.Now()
+
+	for _, cert := range certs {
+		if !certificateExpiring(cert.Certificate, now) {
+			continue
+		}
+
+		// notify once per certificate
+		if notAfter, notified := ctrl.notifiedCerts[cert.Name]; notified && notAfter.Equal(cert.NotAfter) {
+			continue
+		}
+
+		ctrl.notifiedCerts[cert.Name] = cert.NotAfter
+
+		ctrl.notify(ctx, logger, webhooks, hostname, webhook.Notification{
+			Event:     talosconfig.WebhookEventCertificateExpiring,
+			Message:   fmt.Sprintf("certificate %s (%s) expires at %s", cert.Name, cert.Subject, cert.NotAfter.Format(time.RFC3339)),
+			Timestamp: now,
+		})
+	}
+}
+
+func (ctrl *WebhookNotificationController) notify(ctx context.Context, logger *zap.Logger, webhooks []talosconfig.WebhookNotificationConfig, hostname string, notification webhook.Notification) {
+	notification.Hostname = hostname
+	notification.Version = version.Tag
+
+	for _, hook := range webhooks {
+		if !slices.Contains(hook.Events(), notification.Event) {
+			continue
+		}
+
+		if err := ctrl.Sender.Send(ctx, hook, notification); err != nil {
+			logger.Warn("failed to send webhook notification", zap.String("webhook", hook.Name()), zap.String("event", notification.Event), zap.Error(err))
+
+			continue
+		}
+
+		logger.Info("sent webhook notification", zap.String("webhook", hook.Name()), zap.String("event", notification.Event))
+	}
+}
+
+// certificateExpiring returns true if the certificate is about to expire and it is not rotated.
+func certificateExpiring(cert *stdx509.Certificate, now time.Time) bool {
+	remaining := cert.NotAfter.Sub(now)
+	lifetime := cert.NotAfter.Sub(cert.NotBefore)
+
+	return remaining < certificateExpiringThreshold && remaining < lifetime/5
+}
+
+// webhookNotification builds the notification for the critical machine events.
+func webhookNotification(payload proto.Message) (webhook.Notification, bool) {
+	switch msg := payload.(type) {
+	case *machine.SequenceEvent:
+		if msg.GetSequence() != "upgrade" && msg.GetSequence() != "stageUpgrade" {
+			return webhook.Notification{}, false
+		}
+
+		if msg.GetError() == nil || msg.GetError().GetCode() == common.Code_LOCKED {
+			return webhook.Notification{}, false
+		}
+
+		return webhook.Notification{
+			Event:   talosconfig.WebhookEventUpgradeFailed,
+			Message: msg.GetError().GetMessage(),
+		}, true
+	case *machine.BootFallbackEvent:
+		return webhook.Notification{
+			Event:   talosconfig.WebhookEventBootFallback,
+			Message: fmt.Sprintf("booted %s after %s failed %d boot attempts", msg.GetBootedLabel(), msg.GetFailedLabel(), msg.GetBootAttempts()),
+		}, true
+	case *machine.KernelErrorEvent:
+		if msg.GetKind() != string(kmsgfilter.HardwareErrorIO) {
+			return webhook.Notification{}, false
+		}
+
+		return webhook.Notification{
+			Event:   talosconfig.WebhookEventDiskFailure,
+			Message: msg.GetMessage(),
+		}, true
+	}
+
+	return webhook.Notification{}, false
+}
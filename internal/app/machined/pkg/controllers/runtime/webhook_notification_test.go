@@ -0,0 +1,138 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	stdx509 "crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+func TestWebhookNotification(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name    string
+		payload proto.Message
+
+		expectedEvent   string
+		expectedMessage string
+	}{
+		{
+			name: "upgrade failed",
+			payload: &machine.SequenceEvent{
+				Sequence: "upgrade",
+				Action:   machine.SequenceEvent_NOOP,
+				Error:    &common.Error{Code: common.Code_FATAL, Message: "sequence failed: disk is busy"},
+			},
+
+			expectedEvent:   config.WebhookEventUpgradeFailed,
+			expectedMessage: "sequence failed: disk is busy",
+		},
+		{
+			name:    "upgrade started",
+			payload: &machine.SequenceEvent{Sequence: "upgrade", Action: machine.SequenceEvent_START},
+		},
+		{
+			name: "reboot failed",
+			payload: &machine.SequenceEvent{
+				Sequence: "reboot",
+				Error:    &common.Error{Code: common.Code_FATAL, Message: "sequence failed"},
+			},
+		},
+		{
+			name:    "boot fallback",
+			payload: &machine.BootFallbackEvent{FailedLabel: "B", BootedLabel: "A", BootAttempts: 3},
+
+			expectedEvent:   config.WebhookEventBootFallback,
+			expectedMessage: "booted A after B failed 3 boot attempts",
+		},
+		{
+			name:    "disk failure",
+			payload: &machine.KernelErrorEvent{Kind: "io", Message: "I/O error, dev sda, sector 2048"},
+
+			expectedEvent:   config.WebhookEventDiskFailure,
+			expectedMessage: "I/O error, dev sda, sector 2048",
+		},
+		{
+			name:    "memory error",
+			payload: &machine.KernelErrorEvent{Kind: "edac", Message: "EDAC MC0: 1 CE memory read error"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			notification, ok := runtime.WebhookNotification(test.payload)
+
+			if test.expectedEvent == "" {
+				assert.False(t, ok)
+
+				return
+			}
+
+			assert.True(t, ok)
+			assert.Equal(t, test.expectedEvent, notification.Event)
+			assert.Equal(t, test.expectedMessage, notification.Message)
+		})
+	}
+}
+
+func TestCertificateExpiring(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	day := 24 * time.Hour
+
+	for _, test := range []struct {
+		name      string
+		notBefore time.Time
+		notAfter  time.Time
+
+		expected bool
+	}{
+		{
+			name:      "long-lived valid",
+			notBefore: now.Add(-300 * day),
+			notAfter:  now.Add(65 * day),
+		},
+		{
+			name:      "long-lived expiring",
+			notBefore: now.Add(-355 * day),
+			notAfter:  now.Add(10 * day),
+			expected:  true,
+		},
+		{
+			name:      "short-lived rotated",
+			notBefore: now.Add(-time.Hour),
+			notAfter:  now.Add(23 * time.Hour),
+		},
+		{
+			name:      "short-lived not rotated",
+			notBefore: now.Add(-22 * time.Hour),
+			notAfter:  now.Add(2 * time.Hour),
+			expected:  true,
+		},
+		{
+			name:      "expired",
+			notBefore: now.Add(-365 * day),
+			notAfter:  now.Add(-day),
+			expected:  true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, runtime.CertificateExpiring(&stdx509.Certificate{NotBefore: test.notBefore, NotAfter: test.notAfter}, now))
+		})
+	}
+}
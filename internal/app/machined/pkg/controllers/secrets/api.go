@@ -6,6 +6,7 @@ package secrets
 
 import (
 	"context"
+	"crypto/tls"
 	stdlibx509 "crypto/x509"
 	"errors"
 	"fmt"
@@ -18,7 +19,9 @@ import (
 	"github.com/siderolabs/gen/optional"
 	"go.uber.org/zap"
 
+	"github.com/siderolabs/talos/internal/pkg/secureboot/tpm2"
 	"github.com/siderolabs/talos/pkg/grpc/gen"
+	securityapi "github.com/siderolabs/talos/pkg/machinery/api/security"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
@@ -30,7 +33,10 @@ import (
 )
 
 // APIController manages secrets.API based on configuration to provide apid certificate.
-type APIController struct{}
+type APIController struct {
+	// StatePath is the path to cache the certificate issued by trustd.
+	StatePath string
+}
 
 // Name implements controller.Controller interface.
 func (ctrl *APIController) Name() string {
@@ -337,7 +343,17 @@ func (ctrl *APIController) generateControlPlane(ctx context.Context, r controlle
 func (ctrl *APIController) generateWorker(ctx context.Context, r controller.Runtime, logger *zap.Logger,
 	rootSpec *secrets.OSRootSpec, endpointsStr []string, certSANs *secrets.CertSANSpec,
 ) error {
-	remoteGen, err := gen.NewRemoteGenerator(rootSpec.Token, endpointsStr, rootSpec.AcceptedCAs)
+	var clientCerts []tls.Certificate
+
+	// the certificate issued previously authenticates the renewal, so the join token is only needed to join the cluster
+	nodeCert, err := ctrl.nodeCertificate()
+	if err != nil {
+		logger.Warn("failed to load the cached node certificate", zap.Error(err))
+	} else if nodeCert != nil {
+		clientCerts = append(clientCerts, *nodeCert)
+	}
+
+	remoteGen, err := gen.NewRemoteGenerator(rootSpec.Token, endpointsStr, rootSpec.AcceptedCAs, clientCerts...)
 	if err != nil {
 		return fmt.Errorf("failed creating trustd client: %w", err)
 	}
@@ -353,7 +369,17 @@ func (ctrl *APIController) generateWorker(ctx context.Context, r controller.Runt
 		return fmt.Errorf("failed to generate API server CSR: %w", err)
 	}
 
-	logger.Debug("sending CSR", zap.Strings("endpoints", endpointsStr))
+	var identityOpts []gen.IdentityOption
+
+	attestation, err := attestCSR(ctx, remoteGen, serverCSR)
+	if err != nil {
+		// trustd will reject the request if the attestation is required
+		logger.Warn("failed to generate TPM attestation", zap.Error(err))
+	} else if attestation != nil {
+		identityOpts = append(identityOpts, gen.WithAttestation(attestation))
+	}
+
+	logger.Debug("sending CSR", zap.Strings("endpoints", endpointsStr), zap.Bool("attested", attestation != nil))
 
 	var ca []byte
 
@@ -364,7 +390,7 @@ func (ctrl *APIController) generateWorker(ctx context.Context, r controller.Runt
 	defer cancel()
 
 	go func() {
-		ca, serverCert.Crt, err = remoteGen.IdentityContext(ctx, serverCSR)
+		ca, serverCert.Crt, err = remoteGen.IdentityContext(ctx, serverCSR, identityOpts...)
 		errCh <- err
 	}()
 
@@ -387,6 +413,11 @@ func (ctrl *APIController) generateWorker(ctx context.Context, r controller.Runt
 		return fmt.Errorf("failed to sign API server CSR: %w", err)
 	}
 
+	if err = ctrl.saveNodeCertificate(serverCert); err != nil {
+		// the token from the machine configuration is used for the next renewal
+		logger.Warn("failed to cache the node certificate", zap.Error(err))
+	}
+
 	if err := r.Modify(ctx, secrets.NewAPI(),
 		func(r resource.Resource) error {
 			apiSecrets := r.(*secrets.API).TypedSpec()
@@ -426,3 +457,36 @@ func (ctrl *APIController) teardownAll(ctx context.Context, r controller.Runtime
 
 	return nil
 }
+
+// attestCSR produces TPM attestation for the CSR, the quote is qualified with the nonce issued by trustd and the CSR.
+//
+// If the TPM is not available, attestCSR returns nil.
+func attestCSR(ctx context.Context, remoteGen *gen.RemoteGenerator, csr *x509.CertificateSigningRequest) (*securityapi.TPMAttestation, error) {
+	nonce, err := remoteGen.AttestationNonce(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attestation nonce: %w", err)
+	}
+
+	quote, err := tpm2.Quote(tpm2.AttestationQualifyingData(nonce, csr.X509CertificateRequestPEM), tpm2.AttestationPCRs)
+	if err != nil {
+		return nil, err
+	}
+
+	if quote == nil {
+		return nil, nil
+	}
+
+	attestation := &securityapi.TPMAttestation{
+		AttestationKey: quote.AttestationKeyPublic,
+		Quote:          quote.Quote,
+		Signature:      quote.Signature,
+		PcrValues:      make(map[uint32][]byte, len(quote.PCRValues)),
+		Nonce:          nonce,
+	}
+
+	for pcr, value := range quote.PCRValues {
+		attestation.PcrValues[uint32(pcr)] = value
+	}
+
+	return attestation, nil
+}
@@ -64,6 +64,13 @@ func (ctrl *EtcdController) Inputs() []controller.Input {
 			ID:        optional.Some(network.FilteredNodeAddressID(network.NodeAddressAccumulativeID, k8s.NodeAddressFilterNoK8s)),
 			Kind:      controller.InputWeak,
 		},
+		// certificates are issued on each reconcile, so any change to the refresh request issues new certificates
+		{
+			Namespace: secrets.NamespaceName,
+			Type:      secrets.EtcdCertsRefreshRequestType,
+			ID:        optional.Some(secrets.EtcdID),
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -172,4 +172,22 @@ func (suite *EtcdSuite) TestReconcile() {
 
 			assert.Equal("host", peerCert.Subject.CommonName)
 		}))
+
+	// refresh request should issue new certs
+	certs, err := ctest.Get[*secrets.Etcd](suite, secrets.NewEtcd().Metadata())
+	suite.Require().NoError(err)
+
+	oldPeerCert, err := certs.TypedSpec().EtcdPeer.GetCert()
+	suite.Require().NoError(err)
+
+	refreshRequest := secrets.NewEtcdCertsRefreshRequest()
+	refreshRequest.TypedSpec().Request = 1
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), refreshRequest))
+
+	ctest.AssertResource(suite, secrets.EtcdID, func(certs *secrets.Etcd, asrt *assert.Assertions) {
+		peerCert, err := certs.TypedSpec().EtcdPeer.GetCert()
+		asrt.NoError(err)
+
+		asrt.NotEqual(oldPeerCert.SerialNumber, peerCert.SerialNumber)
+	})
 }
@@ -0,0 +1,21 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"crypto/tls"
+
+	"github.com/siderolabs/crypto/x509"
+)
+
+// NodeCertificate is exported for testing.
+func (ctrl *APIController) NodeCertificate() (*tls.Certificate, error) {
+	return ctrl.nodeCertificate()
+}
+
+// SaveNodeCertificate is exported for testing.
+func (ctrl *APIController) SaveNodeCertificate(cert *x509.PEMEncodedCertificateAndKey) error {
+	return ctrl.saveNodeCertificate(cert)
+}
@@ -0,0 +1,63 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"crypto/tls"
+	"os"
+	"path/filepath"
+
+	"github.com/siderolabs/crypto/x509"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+func (ctrl *APIController) nodeCertificatePath() string {
+	statePath := ctrl.StatePath
+	if statePath == "" {
+		statePath = constants.StateMountPoint
+	}
+
+	return filepath.Join(statePath, constants.NodeCertificateFilename)
+}
+
+// nodeCertificate returns the certificate last issued by trustd to authenticate the certificate renewal with.
+//
+// The token from the machine configuration (e.g. a short-lived join token) is only needed to join the cluster,
+// so if there is no cached certificate, nodeCertificate returns nil.
+func (ctrl *APIController) nodeCertificate() (*tls.Certificate, error) {
+	data, err := os.ReadFile(ctrl.nodeCertificatePath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var cached x509.PEMEncodedCertificateAndKey
+
+	if err = yaml.Unmarshal(data, &cached); err != nil {
+		return nil, err
+	}
+
+	cert, err := tls.X509KeyPair(cached.Crt, cached.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &cert, nil
+}
+
+// saveNodeCertificate caches the certificate issued by trustd in the STATE, so that it survives reboots.
+func (ctrl *APIController) saveNodeCertificate(cert *x509.PEMEncodedCertificateAndKey) error {
+	data, err := yaml.Marshal(cert)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(ctrl.nodeCertificatePath(), data, 0o600)
+}
@@ -0,0 +1,42 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	secretsctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/secrets"
+	gensecrets "github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+)
+
+func TestAPIControllerNodeCertificate(t *testing.T) {
+	t.Parallel()
+
+	ctrl := &secretsctrl.APIController{StatePath: t.TempDir()}
+
+	// no cached certificate yet
+	cert, err := ctrl.NodeCertificate()
+	require.NoError(t, err)
+	assert.Nil(t, cert)
+
+	ca, err := gensecrets.NewTalosCA(time.Now())
+	require.NoError(t, err)
+
+	keyPair, err := x509.NewKeyPair(ca, x509.CommonName("talos-default-worker-1"))
+	require.NoError(t, err)
+
+	require.NoError(t, ctrl.SaveNodeCertificate(x509.NewCertificateAndKeyFromKeyPair(keyPair)))
+
+	cert, err = ctrl.NodeCertificate()
+	require.NoError(t, err)
+	require.NotNil(t, cert)
+
+	assert.Equal(t, keyPair.Certificate.Certificate, cert.Certificate)
+}
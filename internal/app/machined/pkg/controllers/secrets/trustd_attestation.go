@@ -0,0 +1,101 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/maps"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// TrustdAttestationPolicyController manages secrets.TrustdAttestationPolicy based on configuration.
+type TrustdAttestationPolicyController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *TrustdAttestationPolicyController) Name() string {
+	return "secrets.TrustdAttestationPolicyController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *TrustdAttestationPolicyController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *TrustdAttestationPolicyController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: secrets.TrustdAttestationPolicyType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *TrustdAttestationPolicyController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		if cfg != nil {
+			if attestationConfig := cfg.Config().TPMAttestation(); attestationConfig != nil {
+				if err = safe.WriterModify(ctx, r, secrets.NewTrustdAttestationPolicy(), func(res *secrets.TrustdAttestationPolicy) error {
+					pcrs := attestationConfig.PCRs()
+
+					indexes := maps.Keys(pcrs)
+					slices.Sort(indexes)
+
+					res.TypedSpec().Required = attestationConfig.Required()
+					res.TypedSpec().AttestationKeys = attestationConfig.AttestationKeys()
+					res.TypedSpec().PCRs = make([]secrets.PCRPolicy, 0, len(pcrs))
+
+					for _, index := range indexes {
+						res.TypedSpec().PCRs = append(res.TypedSpec().PCRs, secrets.PCRPolicy{
+							Index:  index,
+							Values: pcrs[index],
+						})
+					}
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error updating trustd attestation policy: %w", err)
+				}
+			}
+		}
+
+		if err = safe.CleanupOutputs[*secrets.TrustdAttestationPolicy](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
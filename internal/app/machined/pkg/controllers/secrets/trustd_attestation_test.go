@@ -0,0 +1,85 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	secretsctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+func TestTrustdAttestationPolicySuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &TrustdAttestationPolicySuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&secretsctrl.TrustdAttestationPolicyController{}))
+			},
+		},
+	})
+}
+
+type TrustdAttestationPolicySuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *TrustdAttestationPolicySuite) TestReconcile() {
+	ctest.AssertNoResource[*secrets.TrustdAttestationPolicy](suite, secrets.TrustdAttestationPolicyID)
+
+	attestationConfig := security.NewTPMAttestationConfigV1Alpha1()
+	attestationConfig.AttestationRequired = true
+	attestationConfig.AttestationKeys = []string{"dd"}
+	attestationConfig.AttestationPCRs = []security.PCRPolicy{
+		{
+			PCRIndex:  11,
+			PCRValues: []string{"aa"},
+		},
+		{
+			PCRIndex:  7,
+			PCRValues: []string{"bb", "cc"},
+		},
+	}
+
+	cfg, err := container.New(attestationConfig)
+	suite.Require().NoError(err)
+
+	mc := config.NewMachineConfig(cfg)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), mc))
+
+	ctest.AssertResource(suite, secrets.TrustdAttestationPolicyID, func(r *secrets.TrustdAttestationPolicy, asrt *assert.Assertions) {
+		asrt.True(r.TypedSpec().Required)
+		asrt.Equal([]string{"dd"}, r.TypedSpec().AttestationKeys)
+		asrt.Equal([]secrets.PCRPolicy{
+			{
+				Index:  7,
+				Values: []string{"bb", "cc"},
+			},
+			{
+				Index:  11,
+				Values: []string{"aa"},
+			},
+		}, r.TypedSpec().PCRs)
+	})
+
+	cfg, err = container.New()
+	suite.Require().NoError(err)
+
+	newMC := config.NewMachineConfig(cfg)
+	newMC.Metadata().SetVersion(mc.Metadata().Version())
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), newMC))
+
+	ctest.AssertNoResource[*secrets.TrustdAttestationPolicy](suite, secrets.TrustdAttestationPolicyID)
+}
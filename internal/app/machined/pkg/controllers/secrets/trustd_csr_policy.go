@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// TrustdCSRPolicyController manages secrets.TrustdCSRPolicy based on configuration.
+type TrustdCSRPolicyController struct{}
+
+// Name implements controller.Controller interface.
+func (ctrl *TrustdCSRPolicyController) Name() string {
+	return "secrets.TrustdCSRPolicyController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *TrustdCSRPolicyController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: config.NamespaceName,
+			Type:      config.MachineConfigType,
+			ID:        optional.Some(config.V1Alpha1ID),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *TrustdCSRPolicyController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: secrets.TrustdCSRPolicyType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+func (ctrl *TrustdCSRPolicyController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		}
+
+		cfg, err := safe.ReaderGetByID[*config.MachineConfig](ctx, r, config.V1Alpha1ID)
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting machine config: %w", err)
+		}
+
+		r.StartTrackingOutputs()
+
+		if cfg != nil {
+			if policyConfig := cfg.Config().TrustdCSRPolicy(); policyConfig != nil {
+				if err = safe.WriterModify(ctx, r, secrets.NewTrustdCSRPolicy(), func(res *secrets.TrustdCSRPolicy) error {
+					res.TypedSpec().AllowedIPs = slices.Clone(policyConfig.AllowedIPs())
+					res.TypedSpec().AllowedDNSNames = slices.Clone(policyConfig.AllowedDNSNames())
+
+					return nil
+				}); err != nil {
+					return fmt.Errorf("error updating trustd CSR policy: %w", err)
+				}
+			}
+		}
+
+		if err = safe.CleanupOutputs[*secrets.TrustdCSRPolicy](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
@@ -0,0 +1,66 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets_test
+
+import (
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	secretsctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+func TestTrustdCSRPolicySuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &TrustdCSRPolicySuite{
+		DefaultSuite: ctest.DefaultSuite{
+			Timeout: 10 * time.Second,
+			AfterSetup: func(suite *ctest.DefaultSuite) {
+				suite.Require().NoError(suite.Runtime().RegisterController(&secretsctrl.TrustdCSRPolicyController{}))
+			},
+		},
+	})
+}
+
+type TrustdCSRPolicySuite struct {
+	ctest.DefaultSuite
+}
+
+func (suite *TrustdCSRPolicySuite) TestReconcile() {
+	ctest.AssertNoResource[*secrets.TrustdCSRPolicy](suite, secrets.TrustdCSRPolicyID)
+
+	policyConfig := security.NewTrustdCSRPolicyConfigV1Alpha1()
+	policyConfig.CSRAllowedIPs = []netip.Prefix{netip.MustParsePrefix("10.5.0.1/24")}
+	policyConfig.CSRAllowedDNSNames = []string{"worker-*"}
+
+	cfg, err := container.New(policyConfig)
+	suite.Require().NoError(err)
+
+	mc := config.NewMachineConfig(cfg)
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), mc))
+
+	ctest.AssertResource(suite, secrets.TrustdCSRPolicyID, func(r *secrets.TrustdCSRPolicy, asrt *assert.Assertions) {
+		asrt.Equal([]netip.Prefix{netip.MustParsePrefix("10.5.0.0/24")}, r.TypedSpec().AllowedIPs)
+		asrt.Equal([]string{"worker-*"}, r.TypedSpec().AllowedDNSNames)
+	})
+
+	cfg, err = container.New()
+	suite.Require().NoError(err)
+
+	newMC := config.NewMachineConfig(cfg)
+	newMC.Metadata().SetVersion(mc.Metadata().Version())
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), newMC))
+
+	ctest.AssertNoResource[*secrets.TrustdCSRPolicy](suite, secrets.TrustdCSRPolicyID)
+}
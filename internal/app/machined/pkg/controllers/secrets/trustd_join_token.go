@@ -0,0 +1,162 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/optional"
+	"go.uber.org/zap"
+
+	pkgetcd "github.com/siderolabs/talos/internal/pkg/etcd"
+	"github.com/siderolabs/talos/internal/pkg/jointoken"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+// TrustdJoinTokenPollInterval is the interval to refresh the join tokens from etcd.
+const TrustdJoinTokenPollInterval = 10 * time.Second
+
+// TrustdJoinTokenController manages secrets.TrustdJoinToken resources based on the join tokens stored in etcd.
+type TrustdJoinTokenController struct {
+	ListTokensFunc func(ctx context.Context) ([]jointoken.Token, error)
+}
+
+// Name implements controller.Controller interface.
+func (ctrl *TrustdJoinTokenController) Name() string {
+	return "secrets.TrustdJoinTokenController"
+}
+
+// Inputs implements controller.Controller interface.
+func (ctrl *TrustdJoinTokenController) Inputs() []controller.Input {
+	return []controller.Input{
+		{
+			Namespace: v1alpha1.NamespaceName,
+			Type:      v1alpha1.ServiceType,
+			ID:        optional.Some("etcd"),
+			Kind:      controller.InputWeak,
+		},
+	}
+}
+
+// Outputs implements controller.Controller interface.
+func (ctrl *TrustdJoinTokenController) Outputs() []controller.Output {
+	return []controller.Output{
+		{
+			Type: secrets.TrustdJoinTokenType,
+			Kind: controller.OutputExclusive,
+		},
+	}
+}
+
+// Run implements controller.Controller interface.
+//
+//nolint:gocyclo
+func (ctrl *TrustdJoinTokenController) Run(ctx context.Context, r controller.Runtime, logger *zap.Logger) error {
+	ticker := time.NewTicker(TrustdJoinTokenPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-r.EventCh():
+		case <-ticker.C:
+		}
+
+		etcdService, err := safe.ReaderGetByID[*v1alpha1.Service](ctx, r, "etcd")
+		if err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error getting etcd service resource: %w", err)
+		}
+
+		now := time.Now()
+
+		if etcdService == nil || !etcdService.TypedSpec().Running || !etcdService.TypedSpec().Healthy {
+			// etcd is not available, keep the tokens which are not expired yet
+			if err = ctrl.cleanupExpired(ctx, r, now); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		tokens, err := ctrl.listTokens(ctx)
+		if err != nil {
+			// etcd might be temporarily unavailable, retry on the next tick
+			logger.Warn("error listing join tokens", zap.Error(err))
+
+			if err = ctrl.cleanupExpired(ctx, r, now); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		r.StartTrackingOutputs()
+
+		for _, token := range tokens {
+			if !token.Expires.After(now) {
+				continue
+			}
+
+			if err = safe.WriterModify(ctx, r, secrets.NewTrustdJoinToken(token.ID), func(res *secrets.TrustdJoinToken) error {
+				res.TypedSpec().SecretHash = token.SecretHash
+				res.TypedSpec().Expires = token.Expires
+				res.TypedSpec().Description = token.Description
+
+				return nil
+			}); err != nil {
+				return fmt.Errorf("error updating join token: %w", err)
+			}
+		}
+
+		if err = safe.CleanupOutputs[*secrets.TrustdJoinToken](ctx, r); err != nil {
+			return err
+		}
+
+		r.ResetRestartBackoff()
+	}
+}
+
+func (ctrl *TrustdJoinTokenController) cleanupExpired(ctx context.Context, r controller.Runtime, now time.Time) error {
+	tokens, err := safe.ReaderListAll[*secrets.TrustdJoinToken](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing join tokens: %w", err)
+	}
+
+	for iter := tokens.Iterator(); iter.Next(); {
+		token := iter.Value()
+
+		if token.TypedSpec().Expires.After(now) {
+			continue
+		}
+
+		if err = r.Destroy(ctx, token.Metadata()); err != nil && !state.IsNotFoundError(err) {
+			return fmt.Errorf("error destroying expired join token: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (ctrl *TrustdJoinTokenController) listTokens(ctx context.Context) ([]jointoken.Token, error) {
+	if ctrl.ListTokensFunc != nil {
+		return ctrl.ListTokensFunc(ctx)
+	}
+
+	client, err := pkgetcd.NewLocalClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	defer client.Close() //nolint:errcheck
+
+	return jointoken.List(ctx, client.Client)
+}
@@ -0,0 +1,103 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
+	secretsctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/secrets"
+	"github.com/siderolabs/talos/internal/pkg/jointoken"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
+)
+
+func TestTrustdJoinTokenSuite(t *testing.T) {
+	t.Parallel()
+
+	s := &TrustdJoinTokenSuite{}
+
+	s.DefaultSuite = ctest.DefaultSuite{
+		Timeout: 10 * time.Second,
+		AfterSetup: func(suite *ctest.DefaultSuite) {
+			suite.Require().NoError(suite.Runtime().RegisterController(&secretsctrl.TrustdJoinTokenController{
+				ListTokensFunc: s.listTokens,
+			}))
+		},
+	}
+
+	suite.Run(t, s)
+}
+
+type TrustdJoinTokenSuite struct {
+	ctest.DefaultSuite
+
+	mu     sync.Mutex
+	tokens []jointoken.Token
+}
+
+func (suite *TrustdJoinTokenSuite) listTokens(context.Context) ([]jointoken.Token, error) {
+	suite.mu.Lock()
+	defer suite.mu.Unlock()
+
+	return append([]jointoken.Token(nil), suite.tokens...), nil
+}
+
+func (suite *TrustdJoinTokenSuite) setTokens(tokens ...jointoken.Token) {
+	suite.mu.Lock()
+	defer suite.mu.Unlock()
+
+	suite.tokens = tokens
+}
+
+func (suite *TrustdJoinTokenSuite) TestReconcile() {
+	expires := time.Now().Add(time.Hour).UTC().Truncate(time.Second)
+
+	suite.setTokens(
+		jointoken.Token{
+			ID:          "abcdef",
+			SecretHash:  jointoken.HashSecret("0123456789abcdef"),
+			Expires:     expires,
+			Description: "rack 1",
+		},
+		jointoken.Token{
+			ID:         "expird",
+			SecretHash: jointoken.HashSecret("0123456789abcdef"),
+			Expires:    time.Now().Add(-time.Minute),
+		},
+	)
+
+	// etcd is not running
+	etcdService := v1alpha1.NewService("etcd")
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), etcdService))
+
+	ctest.AssertNoResource[*secrets.TrustdJoinToken](suite, "abcdef")
+
+	etcdService.TypedSpec().Running = true
+	etcdService.TypedSpec().Healthy = true
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), etcdService))
+
+	ctest.AssertResource(suite, "abcdef", func(r *secrets.TrustdJoinToken, asrt *assert.Assertions) {
+		asrt.Equal(jointoken.HashSecret("0123456789abcdef"), r.TypedSpec().SecretHash)
+		asrt.Equal(expires, r.TypedSpec().Expires)
+		asrt.Equal("rack 1", r.TypedSpec().Description)
+	})
+
+	ctest.AssertNoResource[*secrets.TrustdJoinToken](suite, "expird")
+
+	// revoke the token, and trigger the reconcile
+	suite.setTokens()
+
+	etcdService.TypedSpec().Unknown = true
+	suite.Require().NoError(suite.State().Update(suite.Ctx(), etcdService))
+
+	ctest.AssertNoResource[*secrets.TrustdJoinToken](suite, "abcdef")
+}
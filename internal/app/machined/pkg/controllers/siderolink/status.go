@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
 	"os"
 	"time"
 
@@ -19,7 +20,9 @@ import (
 	"go.uber.org/zap"
 	"golang.zx2c4.com/wireguard/wgctrl"
 
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	"github.com/siderolabs/talos/pkg/machinery/resources/siderolink"
 )
 
@@ -53,6 +56,11 @@ func (ctrl *StatusController) Inputs() []controller.Input {
 			ID:        optional.Some(siderolink.ConfigID),
 			Kind:      controller.InputWeak,
 		},
+		{
+			Namespace: network.NamespaceName,
+			Type:      network.AddressStatusType,
+			Kind:      controller.InputWeak,
+		},
 	}
 }
 
@@ -138,9 +146,25 @@ func (ctrl *StatusController) reconcileStatus(ctx context.Context, r controller.
 		down = true // wireguard device does not exist, we mark it as down
 	}
 
+	addresses, err := safe.ReaderListAll[*network.AddressStatus](ctx, r)
+	if err != nil {
+		return fmt.Errorf("error listing addresses: %w", err)
+	}
+
+	var nodeAddress netip.Prefix
+
+	for iter := addresses.Iterator(); iter.Next(); {
+		if iter.Value().TypedSpec().LinkName == constants.SideroLinkName {
+			nodeAddress = iter.Value().TypedSpec().Address
+
+			break
+		}
+	}
+
 	if err = safe.WriterModify(ctx, r, siderolink.NewStatus(), func(status *siderolink.Status) error {
 		status.TypedSpec().Host = host
 		status.TypedSpec().Connected = !down
+		status.TypedSpec().NodeAddress = nodeAddress
 
 		return nil
 	}); err != nil {
@@ -5,6 +5,7 @@
 package siderolink_test
 
 import (
+	"net/netip"
 	"os"
 	"sync"
 	"testing"
@@ -18,7 +19,9 @@ import (
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/ctest"
 	siderolinkctrl "github.com/siderolabs/talos/internal/app/machined/pkg/controllers/siderolink"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/config"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	"github.com/siderolabs/talos/pkg/machinery/resources/siderolink"
 )
 
@@ -92,6 +95,28 @@ func (suite *StatusSuite) TestStatus() {
 	suite.Require().NoError(suite.State().Update(suite.Ctx(), siderolinkConfig))
 	suite.assertStatus("new.example.org", true)
 
+	// tunnel address is up
+
+	nodeAddress := netip.MustParsePrefix("fdae:41e4:649b:9303::1/64")
+
+	addressStatus := network.NewAddressStatus(network.NamespaceName, network.AddressID(constants.SideroLinkName, nodeAddress))
+	addressStatus.TypedSpec().Address = nodeAddress
+	addressStatus.TypedSpec().LinkName = constants.SideroLinkName
+
+	suite.Require().NoError(suite.State().Create(suite.Ctx(), addressStatus))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{siderolink.StatusID},
+		func(c *siderolink.Status, assert *assert.Assertions) {
+			assert.Equal(nodeAddress, c.TypedSpec().NodeAddress)
+		})
+
+	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), addressStatus.Metadata()))
+
+	rtestutils.AssertResources(suite.Ctx(), suite.T(), suite.State(), []resource.ID{siderolink.StatusID},
+		func(c *siderolink.Status, assert *assert.Assertions) {
+			assert.False(c.TypedSpec().NodeAddress.IsValid())
+		})
+
 	// no config
 
 	suite.Require().NoError(suite.State().Destroy(suite.Ctx(), siderolinkConfig.Metadata()))
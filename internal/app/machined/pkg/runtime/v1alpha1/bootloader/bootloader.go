@@ -25,6 +25,13 @@ type Bootloader interface {
 	//
 	// Revert mounts the partitions as required.
 	Revert(disk string) error
+	// ResetBootAttempts resets the boot attempts counter once the machine has booted successfully.
+	//
+	// If the bootloader fell back to the previous entry after exhausting the boot attempts,
+	// the fallback entry is made the default one, and the fallback details are returned.
+	//
+	// ResetBootAttempts mounts the partitions as required.
+	ResetBootAttempts(disk string) (*options.BootFallback, error)
 	// RequiredPartitions returns the required partitions for the bootloader.
 	RequiredPartitions() []partition.Options
 }
@@ -0,0 +1,97 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package grub
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/siderolabs/gen/xerrors"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/mount"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/options"
+	mountv2 "github.com/siderolabs/talos/internal/pkg/mount/v2"
+	"github.com/siderolabs/talos/internal/pkg/partition"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+func bootAttemptsExhausted() string {
+	return strings.Repeat("x", MaxBootAttempts)
+}
+
+// ResetBootAttempts resets the boot attempts counter in the grub environment block.
+//
+// If grub fell back to the previous entry after exhausting the boot attempts, the fallback entry
+// is made the default one, so that the machine doesn't try to boot the failed entry again.
+func (c *Config) ResetBootAttempts(disk string) (*options.BootFallback, error) {
+	if c == nil {
+		return nil, fmt.Errorf("cannot reset boot attempts: %w", bootloaderNotInstalledError{})
+	}
+
+	var fallback *options.BootFallback
+
+	err := mount.PartitionOp(
+		disk,
+		[]mount.Spec{
+			{
+				PartitionLabel: constants.BootPartitionLabel,
+				FilesystemType: partition.FilesystemTypeXFS,
+				MountTarget:    constants.BootMountPoint,
+			},
+		},
+		func() error {
+			var resetErr error
+
+			fallback, resetErr = c.resetBootAttempts()
+
+			return resetErr
+		},
+		nil,
+		nil,
+		[]mountv2.OperationOption{
+			mountv2.WithSkipIfMounted(),
+		},
+		nil,
+	)
+	if err != nil && !xerrors.TagIs[mount.NotFoundTag](err) {
+		return nil, err
+	}
+
+	return fallback, nil
+}
+
+func (c *Config) resetBootAttempts() (*options.BootFallback, error) {
+	env, err := ReadEnv(EnvPath)
+	if err != nil {
+		return nil, err
+	}
+
+	attempts, ok := env[bootAttemptsVar]
+	if !ok {
+		return nil, nil
+	}
+
+	var fallback *options.BootFallback
+
+	if c.Fallback != "" && attempts == bootAttemptsExhausted() {
+		fallback = &options.BootFallback{
+			FailedLabel:  string(c.Default),
+			BootedLabel:  string(c.Fallback),
+			BootAttempts: MaxBootAttempts,
+		}
+
+		if err = c.revert(); err != nil {
+			return nil, err
+		}
+	}
+
+	delete(env, bootAttemptsVar)
+
+	if err = env.Write(EnvPath); err != nil {
+		return nil, fmt.Errorf("failed to reset boot attempts: %w", err)
+	}
+
+	return fallback, nil
+}
@@ -14,6 +14,10 @@ type BootLabel string
 const (
 	// ConfigPath is the path to the grub config.
 	ConfigPath = constants.BootMountPoint + "/grub/grub.cfg"
+	// EnvPath is the path to the grub environment block.
+	EnvPath = constants.BootMountPoint + "/grub/grubenv"
+	// MaxBootAttempts is the number of boot attempts before grub falls back to the previous entry.
+	MaxBootAttempts = 3
 	// BootA is a bootloader label.
 	BootA BootLabel = "A"
 	// BootB is a bootloader label.
@@ -24,6 +28,11 @@ const (
 
 const (
 	bootloaderNotInstalled = "bootloader not installed"
+
+	// bootAttemptsVar is the grub environment variable which counts the boot attempts (see confTemplate).
+	//
+	// Grub scripting doesn't support arithmetic, so each boot attempt appends a single "x" to the value.
+	bootAttemptsVar = "boot_attempts"
 )
 
 type bootloaderNotInstalledError struct{}
@@ -15,6 +15,14 @@ import (
 const confTemplate = `set default="{{ (index .Entries .Default).Name }}"
 {{ with (index .Entries .Fallback).Name -}}
 set fallback="{{ . }}"
+
+load_env boot_attempts
+if [ "${boot_attempts}" = "{{ bootAttemptsExhausted }}" ]; then
+  set default='{{ . }}'
+else
+  set boot_attempts="${boot_attempts}x"
+  save_env boot_attempts
+fi
 {{- end }}
 set timeout=3
 
@@ -69,7 +77,8 @@ func (c *Config) Encode(wr io.Writer) error {
 	}
 
 	t := template.Must(template.New("grub").Funcs(template.FuncMap{
-		"quote": Quote,
+		"quote":                 Quote,
+		"bootAttemptsExhausted": bootAttemptsExhausted,
 	}).Parse(confTemplate))
 
 	return t.Execute(wr, c)
@@ -0,0 +1,106 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package grub
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+const (
+	envHeader = "# GRUB Environment Block\n"
+	envSize   = 1024
+)
+
+// Env represents the grub environment block (grubenv).
+//
+// The environment block is a fixed-size file, so grub can update it in-place with `save_env`.
+type Env map[string]string
+
+// ReadEnv reads the grub environment block from the disk.
+//
+// If the environment block doesn't exist, an empty environment is returned.
+func ReadEnv(path string) (Env, error) {
+	c, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return Env{}, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	return DecodeEnv(c)
+}
+
+// DecodeEnv parses the grub environment block from the given bytes.
+func DecodeEnv(c []byte) (Env, error) {
+	if !bytes.HasPrefix(c, []byte(envHeader)) {
+		return nil, errors.New("invalid grub environment block header")
+	}
+
+	env := Env{}
+
+	for _, line := range strings.Split(string(c[len(envHeader):]), "\n") {
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid grub environment line: %q", line)
+		}
+
+		env[key] = value
+	}
+
+	return env, nil
+}
+
+// Encode the grub environment block padded to the fixed size.
+func (env Env) Encode() ([]byte, error) {
+	var buf bytes.Buffer
+
+	buf.WriteString(envHeader)
+
+	keys := make([]string, 0, len(env))
+
+	for key := range env {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		fmt.Fprintf(&buf, "%s=%s\n", key, env[key])
+	}
+
+	if buf.Len() > envSize {
+		return nil, fmt.Errorf("grub environment block is too large: %d bytes", buf.Len())
+	}
+
+	buf.Write(bytes.Repeat([]byte("#"), envSize-buf.Len()))
+
+	return buf.Bytes(), nil
+}
+
+// Write the grub environment block to the given file.
+func (env Env) Write(path string) error {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModeDir); err != nil {
+		return err
+	}
+
+	contents, err := env.Encode()
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, contents, 0o600)
+}
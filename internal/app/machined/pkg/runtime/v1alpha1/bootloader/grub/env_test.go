@@ -0,0 +1,66 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package grub_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/grub"
+)
+
+func TestEnvEncodeDecode(t *testing.T) {
+	env := grub.Env{
+		"boot_attempts": "xx",
+		"foo":           "bar",
+	}
+
+	encoded, err := env.Encode()
+	require.NoError(t, err)
+
+	assert.Len(t, encoded, 1024)
+	assert.True(t, strings.HasPrefix(string(encoded), "# GRUB Environment Block\nboot_attempts=xx\nfoo=bar\n###"))
+
+	decoded, err := grub.DecodeEnv(encoded)
+	require.NoError(t, err)
+
+	assert.Equal(t, env, decoded)
+}
+
+func TestEnvTooLarge(t *testing.T) {
+	_, err := grub.Env{"foo": strings.Repeat("a", 1024)}.Encode()
+	assert.Error(t, err)
+}
+
+func TestEnvDecodeInvalid(t *testing.T) {
+	_, err := grub.DecodeEnv([]byte("foo=bar\n"))
+	assert.Error(t, err)
+
+	_, err = grub.DecodeEnv([]byte("# GRUB Environment Block\nfoo\n"))
+	assert.Error(t, err)
+}
+
+func TestEnvReadWrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "grub", "grubenv")
+
+	env, err := grub.ReadEnv(path)
+	require.NoError(t, err)
+	assert.Empty(t, env)
+
+	require.NoError(t, grub.Env{"boot_attempts": "x"}.Write(path))
+
+	contents, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Len(t, contents, 1024)
+
+	env, err = grub.ReadEnv(path)
+	require.NoError(t, err)
+	assert.Equal(t, grub.Env{"boot_attempts": "x"}, env)
+}
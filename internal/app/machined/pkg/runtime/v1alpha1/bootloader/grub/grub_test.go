@@ -172,6 +172,8 @@ func TestFallback(t *testing.T) {
 	result := buf.String()
 
 	assert.Contains(t, result, `set fallback="B - `)
+	assert.Contains(t, result, `if [ "${boot_attempts}" = "xxx" ]; then`)
+	assert.Contains(t, result, `set default='B - `)
 
 	buf.Reset()
 
@@ -180,6 +182,7 @@ func TestFallback(t *testing.T) {
 
 	result = buf.String()
 	assert.NotContains(t, result, "set fallback")
+	assert.NotContains(t, result, "boot_attempts")
 }
 
 type bootEntry struct {
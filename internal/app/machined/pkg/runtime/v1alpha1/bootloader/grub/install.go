@@ -90,6 +90,11 @@ func (c *Config) install(opts options.InstallOptions) (*options.InstallResult, e
 		return nil, err
 	}
 
+	// grub can't create the environment block with `save_env`, so write an empty one (which also resets boot attempts)
+	if err := (Env{}).Write(filepath.Join(opts.MountPrefix, EnvPath)); err != nil {
+		return nil, err
+	}
+
 	var platforms []string
 
 	switch opts.Arch {
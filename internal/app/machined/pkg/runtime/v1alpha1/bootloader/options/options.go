@@ -49,6 +49,16 @@ type InstallResult struct {
 	PreviousLabel string
 }
 
+// BootFallback describes the fallback to the previous boot entry after the boot attempts were exhausted.
+type BootFallback struct {
+	// Label which failed to boot.
+	FailedLabel string
+	// Label which was booted instead.
+	BootedLabel string
+	// Number of failed boot attempts.
+	BootAttempts int
+}
+
 // BootAssets describes the assets to be installed by the bootloader.
 type BootAssets struct {
 	KernelPath    string
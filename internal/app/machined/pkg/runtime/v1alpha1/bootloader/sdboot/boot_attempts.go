@@ -0,0 +1,157 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package sdboot
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/ecks/uefi/efi/efivario"
+	"github.com/siderolabs/gen/xerrors"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/mount"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/options"
+	mountv2 "github.com/siderolabs/talos/internal/pkg/mount/v2"
+	"github.com/siderolabs/talos/internal/pkg/partition"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// MaxBootAttempts is the number of boot attempts before sd-boot falls back to the previous entry.
+const MaxBootAttempts = 3
+
+// ukiCounterRe matches the sd-boot boot counting suffix of the UKI file name: "+LEFT" or "+LEFT-DONE".
+//
+// See https://systemd.io/AUTOMATIC_BOOT_ASSESSMENT/.
+var ukiCounterRe = regexp.MustCompile(`(?i)^(.+)\+(\d+)(?:-(\d+))?\.efi$`)
+
+// ukiEntry describes the UKI file name with the optional boot counter.
+type ukiEntry struct {
+	// ID is the entry ID as reported by sd-boot: the file name without the boot counter.
+	ID string
+
+	Counted   bool
+	TriesLeft int
+	TriesDone int
+}
+
+func parseUKIName(name string) ukiEntry {
+	matches := ukiCounterRe.FindStringSubmatch(name)
+	if matches == nil {
+		return ukiEntry{ID: name}
+	}
+
+	entry := ukiEntry{
+		ID:      matches[1] + ".efi",
+		Counted: true,
+	}
+
+	entry.TriesLeft, _ = strconv.Atoi(matches[2]) //nolint:errcheck
+
+	if matches[3] != "" {
+		entry.TriesDone, _ = strconv.Atoi(matches[3]) //nolint:errcheck
+	}
+
+	return entry
+}
+
+// ukiCountedName returns the UKI file name with the initial boot counter.
+func ukiCountedName(id string) string {
+	return fmt.Sprintf("%s+%d.efi", strings.TrimSuffix(id, ".efi"), MaxBootAttempts)
+}
+
+// ResetBootAttempts marks the booted entry as good by removing the boot counter from the UKI file name.
+//
+// sd-boot decrements the boot counter on each boot attempt, and skips the entries without tries left.
+// If sd-boot fell back to the previous entry after exhausting the boot attempts, the fallback entry
+// is made the default one, so that the machine doesn't try to boot the failed entry again.
+func (c *Config) ResetBootAttempts(disk string) (*options.BootFallback, error) {
+	if c == nil {
+		return nil, nil
+	}
+
+	var fallback *options.BootFallback
+
+	err := mount.PartitionOp(
+		disk,
+		[]mount.Spec{
+			{
+				PartitionLabel: constants.EFIPartitionLabel,
+				FilesystemType: partition.FilesystemTypeVFAT,
+				MountTarget:    constants.EFIMountPoint,
+			},
+		},
+		func() error {
+			var resetErr error
+
+			fallback, resetErr = c.resetBootAttempts()
+
+			return resetErr
+		},
+		nil,
+		nil,
+		[]mountv2.OperationOption{
+			mountv2.WithSkipIfMounted(),
+		},
+		nil,
+	)
+	if err != nil && !xerrors.TagIs[mount.NotFoundTag](err) {
+		return nil, err
+	}
+
+	return fallback, nil
+}
+
+//nolint:gocyclo
+func (c *Config) resetBootAttempts() (*options.BootFallback, error) {
+	efiCtx := efivario.NewDefaultContext()
+
+	defaultEntry, err := ReadVariable(efiCtx, LoaderEntryDefaultName)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := filepath.Glob(filepath.Join(constants.EFIMountPoint, "EFI", "Linux", "Talos-*.efi"))
+	if err != nil {
+		return nil, err
+	}
+
+	var fallback *options.BootFallback
+
+	for _, file := range files {
+		entry := parseUKIName(filepath.Base(file))
+
+		if !entry.Counted {
+			continue
+		}
+
+		switch {
+		case strings.EqualFold(entry.ID, c.Default):
+			log.Printf("marking UKI %s as good", entry.ID)
+
+			if err = os.Rename(file, filepath.Join(filepath.Dir(file), entry.ID)); err != nil {
+				return nil, fmt.Errorf("failed to reset boot attempts: %w", err)
+			}
+		case strings.EqualFold(entry.ID, defaultEntry) && entry.TriesLeft == 0:
+			fallback = &options.BootFallback{
+				FailedLabel:  entry.ID,
+				BootedLabel:  c.Default,
+				BootAttempts: entry.TriesDone,
+			}
+		}
+	}
+
+	if fallback != nil {
+		if err = WriteVariable(efiCtx, LoaderEntryDefaultName, c.Default); err != nil {
+			return nil, err
+		}
+	}
+
+	return fallback, nil
+}
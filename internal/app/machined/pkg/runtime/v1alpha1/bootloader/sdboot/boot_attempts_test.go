@@ -0,0 +1,61 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package sdboot_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/sdboot"
+)
+
+func TestParseUKIName(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+
+		expectedID        string
+		expectedCounted   bool
+		expectedTriesLeft int
+		expectedTriesDone int
+	}{
+		{
+			name:       "Talos-v1.9.0.efi",
+			expectedID: "Talos-v1.9.0.efi",
+		},
+		{
+			name:              "Talos-v1.9.0+3.efi",
+			expectedID:        "Talos-v1.9.0.efi",
+			expectedCounted:   true,
+			expectedTriesLeft: 3,
+		},
+		{
+			name:              "Talos-v1.9.0-alpha.1+1-2.efi",
+			expectedID:        "Talos-v1.9.0-alpha.1.efi",
+			expectedCounted:   true,
+			expectedTriesLeft: 1,
+			expectedTriesDone: 2,
+		},
+		{
+			name:              "Talos-v1.9.0+0-3.EFI",
+			expectedID:        "Talos-v1.9.0.efi",
+			expectedCounted:   true,
+			expectedTriesDone: 3,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			id, counted, triesLeft, triesDone := sdboot.ParseUKIName(test.name)
+
+			assert.Equal(t, test.expectedID, id)
+			assert.Equal(t, test.expectedCounted, counted)
+			assert.Equal(t, test.expectedTriesLeft, triesLeft)
+			assert.Equal(t, test.expectedTriesDone, triesDone)
+		})
+	}
+}
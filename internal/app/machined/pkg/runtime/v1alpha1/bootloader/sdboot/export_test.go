@@ -0,0 +1,12 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package sdboot
+
+// ParseUKIName exports parseUKIName for testing.
+func ParseUKIName(name string) (id string, counted bool, triesLeft, triesDone int) {
+	entry := parseUKIName(name)
+
+	return entry.ID, entry.Counted, entry.TriesLeft, entry.TriesDone
+}
@@ -136,7 +136,7 @@ func Probe(disk string, options options.ProbeOptions) (*Config, error) {
 			}
 
 			for _, file := range files {
-				if strings.EqualFold(filepath.Base(file), bootedEntry) {
+				if strings.EqualFold(parseUKIName(filepath.Base(file)).ID, bootedEntry) {
 					return nil
 				}
 			}
@@ -223,15 +223,15 @@ func (c *Config) install(opts options.InstallOptions) (*options.InstallResult, e
 		return nil, err
 	}
 
-	// writing UKI by version-based filename here
+	// writing UKI by version-based filename here, the entry ID doesn't include the boot counter
 	ukiPath := fmt.Sprintf("%s-%s.efi", "Talos", opts.Version)
 
 	for _, file := range files {
-		if strings.EqualFold(filepath.Base(file), c.Default) {
-			if !strings.EqualFold(c.Default, ukiPath) {
-				// set fallback to the current default unless it matches the new install
-				c.Fallback = c.Default
-			}
+		entryID := parseUKIName(filepath.Base(file)).ID
+
+		if strings.EqualFold(entryID, c.Default) && !strings.EqualFold(entryID, ukiPath) {
+			// set fallback to the current default unless it matches the new install
+			c.Fallback = c.Default
 
 			continue
 		}
@@ -247,7 +247,7 @@ func (c *Config) install(opts options.InstallOptions) (*options.InstallResult, e
 		opts.Printf,
 		utils.SourceDestination(
 			opts.BootAssets.UKIPath,
-			filepath.Join(opts.MountPrefix, constants.EFIMountPoint, "EFI", "Linux", ukiPath),
+			filepath.Join(opts.MountPrefix, constants.EFIMountPoint, "EFI", "Linux", ukiCountedName(ukiPath)),
 		),
 		utils.SourceDestination(
 			opts.BootAssets.SDBootPath,
@@ -319,13 +319,15 @@ func (c *Config) revert() error {
 	}
 
 	for _, file := range files {
-		if strings.EqualFold(filepath.Base(file), c.Default) {
+		entryID := parseUKIName(filepath.Base(file)).ID
+
+		if strings.EqualFold(entryID, c.Default) {
 			continue
 		}
 
 		log.Printf("reverting to previous UKI: %s", file)
 
-		return WriteVariable(efivario.NewDefaultContext(), LoaderEntryDefaultName, filepath.Base(file))
+		return WriteVariable(efivario.NewDefaultContext(), LoaderEntryDefaultName, entryID)
 	}
 
 	return errors.New("previous UKI not found")
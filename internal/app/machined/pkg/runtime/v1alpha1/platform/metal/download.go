@@ -0,0 +1,78 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package metal
+
+import (
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/textproto"
+	"net/url"
+	"strings"
+
+	"github.com/siderolabs/go-procfs/procfs"
+
+	"github.com/siderolabs/talos/pkg/download"
+	"github.com/siderolabs/talos/pkg/httpdefaults"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// ConfigDownloadOptions returns the download options for the machine config based on the kernel cmdline.
+//
+// The options include extra HTTP headers (`talos.config.header`) and the extra trusted CAs (`talos.config.ca`).
+// The header is specified as a percent-encoded `Name: value`.
+func ConfigDownloadOptions(cmdline *procfs.Cmdline) ([]download.Option, error) {
+	var opts []download.Option
+
+	headers := map[string]string{}
+
+	for i := 0; ; i++ {
+		header := cmdline.Get(constants.KernelParamConfigHeader).Get(i)
+		if header == nil {
+			break
+		}
+
+		// kernel cmdline can't contain spaces, so the header is percent-encoded
+		decoded, err := url.PathUnescape(*header)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s value %q: %w", constants.KernelParamConfigHeader, *header, err)
+		}
+
+		name, value, ok := strings.Cut(decoded, ":")
+		if !ok || strings.TrimSpace(name) == "" {
+			return nil, fmt.Errorf("invalid %s value %q, expected `Name: value`", constants.KernelParamConfigHeader, *header)
+		}
+
+		headers[textproto.CanonicalMIMEHeaderKey(strings.TrimSpace(name))] = strings.TrimSpace(value)
+	}
+
+	if len(headers) > 0 {
+		opts = append(opts, download.WithHeaders(headers))
+	}
+
+	if ca := cmdline.Get(constants.KernelParamConfigCA).First(); ca != nil {
+		pem, err := base64.StdEncoding.DecodeString(*ca)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", constants.KernelParamConfigCA, err)
+		}
+
+		// the CA is trusted in addition to the system CAs
+		pool := httpdefaults.RootCAs()
+		if pool == nil {
+			pool = x509.NewCertPool()
+		} else {
+			pool = pool.Clone()
+		}
+
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, errors.New("no valid certificates found in " + constants.KernelParamConfigCA)
+		}
+
+		opts = append(opts, download.WithRootCAs(pool))
+	}
+
+	return opts, nil
+}
@@ -0,0 +1,66 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package metal_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-procfs/procfs"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/metal"
+	"github.com/siderolabs/talos/pkg/download"
+)
+
+func TestConfigDownloadOptions(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" || r.Header.Get("X-Inventory") != "rack-1" {
+			w.WriteHeader(http.StatusUnauthorized)
+
+			return
+		}
+
+		w.Write([]byte("config")) //nolint:errcheck
+	}))
+	t.Cleanup(srv.Close)
+
+	ca := base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	opts, err := metal.ConfigDownloadOptions(procfs.NewCmdline("talos.config.header=Authorization:Bearer%20token talos.config.header=x-inventory:rack-1 talos.config.ca=" + ca))
+	require.NoError(t, err)
+
+	data, err := download.Download(ctx, srv.URL, append(opts, download.WithTimeout(time.Second))...)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("config"), data)
+
+	// no CA, the server certificate is not trusted
+	opts, err = metal.ConfigDownloadOptions(procfs.NewCmdline("talos.config.header=Authorization:Bearer%20token"))
+	require.NoError(t, err)
+
+	_, err = download.Download(ctx, srv.URL, append(opts, download.WithTimeout(time.Second))...)
+	require.Error(t, err)
+
+	for _, cmdline := range []string{
+		"talos.config.header=Authorization",
+		"talos.config.header=X-Header:%zz",
+		"talos.config.ca=invalid!",
+		"talos.config.ca=" + base64.StdEncoding.EncodeToString([]byte("not a PEM")),
+	} {
+		_, err = metal.ConfigDownloadOptions(procfs.NewCmdline(cmdline))
+		assert.Error(t, err, cmdline)
+	}
+}
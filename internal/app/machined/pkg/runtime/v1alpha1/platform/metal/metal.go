@@ -28,6 +28,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/errors"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/internal/netutils"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/metal/oauth2"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/metal/profile"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/metal/url"
 	"github.com/siderolabs/talos/pkg/download"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -100,17 +101,38 @@ func (m *Metal) Configuration(ctx context.Context, r state.State) ([]byte, error
 			extraHeaders = oauth2Cfg.ExtraHeaders()
 		}
 
-		return download.Download(
+		downloadOpts, err := ConfigDownloadOptions(procfs.ProcCmdline())
+		if err != nil {
+			return nil, err
+		}
+
+		cfgBytes, err := download.Download(
 			ctx,
 			*option,
-			download.WithEndpointFunc(getURL),
-			download.WithTimeout(constants.ConfigLoadTimeout),
-			download.WithRetryOptions(
-				// give a timeout per attempt, max 50% of that is dedicated for URL interpolation, the rest is for the actual download
-				retry.WithAttemptTimeout(constants.ConfigLoadAttemptTimeout),
-			),
-			download.WithHeaders(extraHeaders),
+			append([]download.Option{
+				download.WithEndpointFunc(getURL),
+				download.WithTimeout(constants.ConfigLoadTimeout),
+				download.WithRetryOptions(
+					// give a timeout per attempt, max 50% of that is dedicated for URL interpolation, the rest is for the actual download
+					retry.WithAttemptTimeout(constants.ConfigLoadAttemptTimeout),
+				),
+				download.WithHeaders(extraHeaders),
+			}, downloadOpts...)...,
 		)
+		if err != nil {
+			return nil, err
+		}
+
+		cfgBytes, profileName, err := profile.Select(ctx, cfgBytes, r)
+		if err != nil {
+			return nil, err
+		}
+
+		if profileName != "" {
+			log.Printf("selected machine config profile %q", profileName)
+		}
+
+		return cfgBytes, nil
 	}
 }
 
@@ -0,0 +1,190 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package profile selects the machine config profile matching the hardware identity.
+//
+// The config server might respond with a list of profiles instead of the machine config:
+//
+//	profiles:
+//	  - name: storage
+//	    match:
+//	      serials: [0OCZJ19N65]
+//	    config: |
+//	      version: v1alpha1
+//	      ...
+//	  - name: default
+//	    config: |
+//	      ...
+//
+// The config of the first profile matching the machine is used.
+package profile
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"gopkg.in/yaml.v3"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/errors"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// Profiles is a list of machine config profiles.
+type Profiles struct {
+	Profiles []Profile `yaml:"profiles"`
+}
+
+// Profile is a machine config for the machines matching the selector.
+type Profile struct {
+	Name   string `yaml:"name,omitempty"`
+	Match  Match  `yaml:"match,omitempty"`
+	Config string `yaml:"config"`
+}
+
+// Match selects the machines by the hardware identity.
+//
+// Each non-empty list should contain the machine's value, empty match selects any machine.
+type Match struct {
+	UUIDs   []string `yaml:"uuids,omitempty"`
+	Serials []string `yaml:"serials,omitempty"`
+	MACs    []string `yaml:"macs,omitempty"`
+}
+
+// Identity is the hardware identity of the machine.
+type Identity struct {
+	UUID   string
+	Serial string
+	MACs   []string
+}
+
+// Matches checks whether the machine matches the selector.
+func (m Match) Matches(identity Identity) bool {
+	if len(m.UUIDs) > 0 && !containsFold(m.UUIDs, identity.UUID) {
+		return false
+	}
+
+	if len(m.Serials) > 0 && !slices.Contains(m.Serials, identity.Serial) {
+		return false
+	}
+
+	if len(m.MACs) > 0 && !slices.ContainsFunc(identity.MACs, func(mac string) bool { return containsFold(m.MACs, mac) }) {
+		return false
+	}
+
+	return true
+}
+
+// Select returns the first profile matching the machine.
+func (p Profiles) Select(identity Identity) (Profile, bool) {
+	for _, profile := range p.Profiles {
+		if profile.Match.Matches(identity) {
+			return profile, true
+		}
+	}
+
+	return Profile{}, false
+}
+
+// needsSystemInformation checks whether any profile matches on SMBIOS values.
+func (p Profiles) needsSystemInformation() bool {
+	return slices.ContainsFunc(p.Profiles, func(profile Profile) bool {
+		return len(profile.Match.UUIDs) > 0 || len(profile.Match.Serials) > 0
+	})
+}
+
+// Parse parses the list of profiles.
+//
+// If the data is not a list of profiles (e.g. it's a machine config), nil is returned.
+func Parse(data []byte) (*Profiles, error) {
+	var probe map[string]any
+
+	if err := yaml.Unmarshal(data, &probe); err != nil {
+		// not a valid YAML document, leave it to the config loader
+		return nil, nil //nolint:nilerr
+	}
+
+	if _, ok := probe["profiles"]; !ok {
+		return nil, nil
+	}
+
+	var profiles Profiles
+
+	if err := yaml.Unmarshal(data, &profiles); err != nil {
+		return nil, fmt.Errorf("failed to parse config profiles: %w", err)
+	}
+
+	return &profiles, nil
+}
+
+// Select returns the machine config of the first profile matching the machine.
+//
+// If the data is not a list of profiles, it is returned as is.
+// If no profile matches, errors.ErrNoConfigSource is returned.
+func Select(ctx context.Context, data []byte, st state.State) ([]byte, string, error) {
+	profiles, err := Parse(data)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if profiles == nil {
+		return data, "", nil
+	}
+
+	identity, err := getIdentity(ctx, st, profiles.needsSystemInformation())
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to get hardware identity: %w", err)
+	}
+
+	profile, ok := profiles.Select(identity)
+	if !ok {
+		return nil, "", fmt.Errorf("no config profile matches the machine (uuid %q, serial %q, macs %v): %w",
+			identity.UUID, identity.Serial, identity.MACs, errors.ErrNoConfigSource)
+	}
+
+	return []byte(profile.Config), profile.Name, nil
+}
+
+func getIdentity(ctx context.Context, st state.State, needsSystemInformation bool) (Identity, error) {
+	var identity Identity
+
+	if needsSystemInformation {
+		sysInfo, err := st.WatchFor(ctx, hardware.NewSystemInformation(hardware.SystemInformationID).Metadata(), state.WithEventTypes(state.Created, state.Updated))
+		if err != nil {
+			return identity, err
+		}
+
+		if sysInfo, ok := sysInfo.(*hardware.SystemInformation); ok {
+			identity.UUID = sysInfo.TypedSpec().UUID
+			identity.Serial = sysInfo.TypedSpec().SerialNumber
+		}
+	}
+
+	links, err := safe.StateListAll[*network.LinkStatus](ctx, st)
+	if err != nil {
+		return identity, err
+	}
+
+	links.ForEach(func(link *network.LinkStatus) {
+		if !link.TypedSpec().Physical() {
+			return
+		}
+
+		identity.MACs = append(identity.MACs, link.TypedSpec().PermanentAddr.String(), link.TypedSpec().HardwareAddr.String())
+	})
+
+	identity.MACs = slices.DeleteFunc(identity.MACs, func(mac string) bool { return mac == "" })
+	slices.Sort(identity.MACs)
+	identity.MACs = slices.Compact(identity.MACs)
+
+	return identity, nil
+}
+
+func containsFold(values []string, value string) bool {
+	return slices.ContainsFunc(values, func(v string) bool { return strings.EqualFold(v, value) })
+}
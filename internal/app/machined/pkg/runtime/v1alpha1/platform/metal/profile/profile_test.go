@@ -0,0 +1,138 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package profile_test
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/errors"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/platform/metal/profile"
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+const profiles = `profiles:
+  - name: storage
+    match:
+      serials: [SERIAL1, SERIAL2]
+    config: storage-config
+  - name: rack-1
+    match:
+      macs: ["AA:BB:CC:DD:EE:FF"]
+    config: rack-1-config
+  - name: uuid
+    match:
+      uuids: [40dcbd19-3b10-444e-bfff-aaee44a51fda]
+      macs: ["00:11:22:33:44:55"]
+    config: uuid-config
+  - name: default
+    config: default-config
+`
+
+func TestProfilesSelect(t *testing.T) {
+	t.Parallel()
+
+	parsed, err := profile.Parse([]byte(profiles))
+	require.NoError(t, err)
+	require.NotNil(t, parsed)
+
+	for _, test := range []struct {
+		name     string
+		identity profile.Identity
+		expected string
+	}{
+		{
+			name:     "serial",
+			identity: profile.Identity{Serial: "SERIAL2", MACs: []string{"aa:bb:cc:dd:ee:ff"}},
+			expected: "storage",
+		},
+		{
+			name:     "mac",
+			identity: profile.Identity{Serial: "SERIAL3", MACs: []string{"11:11:11:11:11:11", "aa:bb:cc:dd:ee:ff"}},
+			expected: "rack-1",
+		},
+		{
+			name:     "uuid and mac",
+			identity: profile.Identity{UUID: "40DCBD19-3B10-444E-BFFF-AAEE44A51FDA", MACs: []string{"00:11:22:33:44:55"}},
+			expected: "uuid",
+		},
+		{
+			name:     "uuid without mac",
+			identity: profile.Identity{UUID: "40DCBD19-3B10-444E-BFFF-AAEE44A51FDA"},
+			expected: "default",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			selected, ok := parsed.Select(test.identity)
+			require.True(t, ok)
+			assert.Equal(t, test.expected, selected.Name)
+		})
+	}
+}
+
+func TestParseNotProfiles(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range []string{
+		"version: v1alpha1\nmachine:\n  type: worker\n",
+		"not: [valid",
+		"",
+	} {
+		parsed, err := profile.Parse([]byte(data))
+		require.NoError(t, err)
+		assert.Nil(t, parsed)
+	}
+
+	_, err := profile.Parse([]byte("profiles: 1"))
+	require.Error(t, err)
+}
+
+func TestSelect(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	mac, err := net.ParseMAC("aa:bb:cc:dd:ee:ff")
+	require.NoError(t, err)
+
+	link := network.NewLinkStatus(network.NamespaceName, "eth0")
+	link.TypedSpec().Type = nethelpers.LinkEther
+	link.TypedSpec().HardwareAddr = nethelpers.HardwareAddr(mac)
+	require.NoError(t, st.Create(ctx, link))
+
+	sysInfo := hardware.NewSystemInformation(hardware.SystemInformationID)
+	sysInfo.TypedSpec().SerialNumber = "SERIAL3"
+	require.NoError(t, st.Create(ctx, sysInfo))
+
+	cfg, name, err := profile.Select(ctx, []byte(profiles), st)
+	require.NoError(t, err)
+	assert.Equal(t, "rack-1", name)
+	assert.Equal(t, []byte("rack-1-config"), cfg)
+
+	// not a list of profiles
+	cfg, name, err = profile.Select(ctx, []byte("version: v1alpha1\n"), st)
+	require.NoError(t, err)
+	assert.Empty(t, name)
+	assert.Equal(t, []byte("version: v1alpha1\n"), cfg)
+
+	// no profile matches
+	_, _, err = profile.Select(ctx, []byte("profiles:\n  - match:\n      serials: [SERIAL1]\n    config: foo\n"), st)
+	require.ErrorIs(t, err, errors.ErrNoConfigSource)
+}
@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/gen/xerrors"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/mount"
+	"github.com/siderolabs/talos/internal/pkg/bootlog"
+	mountv2 "github.com/siderolabs/talos/internal/pkg/mount/v2"
+	"github.com/siderolabs/talos/internal/pkg/partition"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	blockres "github.com/siderolabs/talos/pkg/machinery/resources/block"
+)
+
+// SaveFallbackBootLog saves the boot log to the EFI partition of the system disk.
+//
+// It is used when the boot fails before the STATE partition is mounted, the log is moved
+// to the STATE partition on the next boot which reaches it.
+func SaveFallbackBootLog(ctx context.Context, st state.State, bootID string, contents []byte) error {
+	return fallbackBootLogOp(ctx, st, func(dir string) error {
+		return bootlog.Save(dir, bootID, contents, constants.BootLogMaxBoots)
+	})
+}
+
+// importFallbackBootLogs moves the boot logs saved to the EFI partition to the STATE partition.
+func importFallbackBootLogs(ctx context.Context, st state.State) error {
+	return fallbackBootLogOp(ctx, st, func(dir string) error {
+		boots, err := bootlog.Read(dir, constants.BootLogMaxBoots)
+		if err != nil {
+			return err
+		}
+
+		if len(boots) == 0 {
+			return nil
+		}
+
+		// boots are returned latest first, save them in the order they happened
+		for _, boot := range slices.Backward(boots) {
+			if err = bootlog.Save(constants.BootLogPath, boot.BootID, boot.Log, constants.BootLogMaxBoots); err != nil {
+				return err
+			}
+		}
+
+		return os.RemoveAll(dir)
+	})
+}
+
+func fallbackBootLogOp(ctx context.Context, st state.State, op func(dir string) error) error {
+	systemDisk, err := blockres.GetSystemDisk(ctx, st)
+	if err != nil {
+		return err
+	}
+
+	if systemDisk == nil {
+		// booted without the system disk (e.g. from ISO), nowhere to keep the log
+		return nil
+	}
+
+	err = mount.PartitionOp(
+		systemDisk.DevPath,
+		[]mount.Spec{
+			{
+				PartitionLabel: constants.EFIPartitionLabel,
+				FilesystemType: partition.FilesystemTypeVFAT,
+				MountTarget:    constants.EFIMountPoint,
+			},
+		},
+		func() error {
+			return op(filepath.Join(constants.EFIMountPoint, constants.BootLogFallbackDir))
+		},
+		nil,
+		nil,
+		[]mountv2.OperationOption{
+			mountv2.WithSkipIfMounted(),
+		},
+		nil,
+	)
+	if err != nil && !xerrors.TagIs[mount.NotFoundTag](err) {
+		return err
+	}
+
+	return nil
+}
@@ -21,11 +21,15 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/acpi"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/options"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha2"
 	krnl "github.com/siderolabs/talos/pkg/kernel"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
 	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/kernel"
+	metaconsts "github.com/siderolabs/talos/pkg/machinery/meta"
+	blockres "github.com/siderolabs/talos/pkg/machinery/resources/block"
 )
 
 // Controller represents the controller responsible for managing the execution
@@ -56,7 +60,7 @@ func NewController() (*Controller, error) {
 		priorityLock: NewPriorityLock[runtime.Sequence](),
 	}
 
-	ctlr.v2, err = v1alpha2.NewController(ctlr.r)
+	ctlr.v2, err = v1alpha2.NewController(ctlr.r, ctlr.rollback, ctlr.resetBootAttempts)
 	if err != nil {
 		return nil, err
 	}
@@ -68,6 +72,70 @@ func NewController() (*Controller, error) {
 	return ctlr, nil
 }
 
+// rollback reverts the bootloader to the previous Talos version and reboots the machine.
+func (c *Controller) rollback(ctx context.Context) error {
+	systemDisk, err := blockres.GetSystemDisk(ctx, c.r.State().V1Alpha2().Resources())
+	if err != nil {
+		return fmt.Errorf("system disk lookup failed: %w", err)
+	}
+
+	if systemDisk == nil {
+		return errors.New("system disk not found")
+	}
+
+	config, err := bootloader.Probe(systemDisk.DevPath, options.ProbeOptions{})
+	if err != nil {
+		return err
+	}
+
+	if err = config.Revert(systemDisk.DevPath); err != nil {
+		return err
+	}
+
+	if _, err = c.r.State().Machine().Meta().DeleteTag(ctx, metaconsts.Upgrade); err != nil {
+		return err
+	}
+
+	if err = c.r.State().Machine().Meta().Flush(); err != nil {
+		return err
+	}
+
+	// the reboot sequence stops the controller runtime, so it can't be waited for by the caller
+	go func() {
+		if err := c.Run(context.Background(), runtime.SequenceReboot, nil, runtime.WithTakeover()); err != nil {
+			if !runtime.IsRebootError(err) {
+				log.Printf("reboot after rollback failed: %s", err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// resetBootAttempts resets the bootloader boot attempts counter on the system disk.
+func (c *Controller) resetBootAttempts(ctx context.Context) (*options.BootFallback, error) {
+	systemDisk, err := blockres.GetSystemDisk(ctx, c.r.State().V1Alpha2().Resources())
+	if err != nil {
+		return nil, fmt.Errorf("system disk lookup failed: %w", err)
+	}
+
+	if systemDisk == nil {
+		// booted without the system disk (e.g. from ISO), nothing to reset
+		return nil, nil
+	}
+
+	config, err := bootloader.Probe(systemDisk.DevPath, options.ProbeOptions{})
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return config.ResetBootAttempts(systemDisk.DevPath)
+}
+
 func (c *Controller) setupLogging() error {
 	machinedLog, err := c.r.Logging().ServiceLog("machined").Writer()
 	if err != nil {
@@ -377,7 +445,10 @@ func (c *Controller) phases(seq runtime.Sequence, data any) ([]runtime.Phase, er
 
 		phases = c.s.Shutdown(c.r, in)
 	case runtime.SequenceReboot:
-		phases = c.s.Reboot(c.r)
+		// reboot might be requested without the RebootRequest (e.g. rollback), so ignore the type mismatch
+		in, _ := data.(*machine.RebootRequest)
+
+		phases = c.s.Reboot(c.r, in)
 	case runtime.SequenceUpgrade:
 		in, ok := data.(*machine.UpgradeRequest)
 		if !ok {
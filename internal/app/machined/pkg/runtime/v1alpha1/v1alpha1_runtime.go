@@ -16,13 +16,16 @@ import (
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/siderolabs/gen/xslices"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system/services"
+	"github.com/siderolabs/talos/internal/pkg/userfiles"
 	"github.com/siderolabs/talos/pkg/machinery/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/configdiff"
 	"github.com/siderolabs/talos/pkg/machinery/config/container"
+	v1alpha1config "github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/resources/hardware"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 )
@@ -118,6 +121,7 @@ func (r *Runtime) CanApplyImmediate(cfg config.Provider) error {
 	// * .machine.ca
 	// * .machine.acceptedCAs
 	// * .machine.time
+	// * .machine.env
 	// * .machine.certCANs
 	// * .machine.install
 	// * .machine.network
@@ -136,6 +140,7 @@ func (r *Runtime) CanApplyImmediate(cfg config.Provider) error {
 	// * .machine.features.kubernetesTalosAPIAccess
 	// * .machine.features.kubePrism
 	// * .machine.features.localDNS
+	// * .machine.files (only the files with the "create" operation)
 	newConfig.ConfigDebug = currentConfig.ConfigDebug
 	newConfig.ClusterConfig = currentConfig.ClusterConfig
 
@@ -143,6 +148,7 @@ func (r *Runtime) CanApplyImmediate(cfg config.Provider) error {
 		newConfig.MachineConfig.MachineCA = currentConfig.MachineConfig.MachineCA
 		newConfig.MachineConfig.MachineAcceptedCAs = currentConfig.MachineConfig.MachineAcceptedCAs
 		newConfig.MachineConfig.MachineTime = currentConfig.MachineConfig.MachineTime
+		newConfig.MachineConfig.MachineEnv = currentConfig.MachineConfig.MachineEnv
 		newConfig.MachineConfig.MachineCertSANs = currentConfig.MachineConfig.MachineCertSANs
 		newConfig.MachineConfig.MachineInstall = currentConfig.MachineConfig.MachineInstall
 		newConfig.MachineConfig.MachineNetwork = currentConfig.MachineConfig.MachineNetwork
@@ -159,6 +165,11 @@ func (r *Runtime) CanApplyImmediate(cfg config.Provider) error {
 		newConfig.MachineConfig.MachineNodeLabels = currentConfig.MachineConfig.MachineNodeLabels
 		newConfig.MachineConfig.MachineNodeTaints = currentConfig.MachineConfig.MachineNodeTaints
 
+		// files with other operations are written only on boot
+		if reflect.DeepEqual(bootOnlyFiles(newConfig.MachineConfig.MachineFiles), bootOnlyFiles(currentConfig.MachineConfig.MachineFiles)) {
+			newConfig.MachineConfig.MachineFiles = currentConfig.MachineConfig.MachineFiles
+		}
+
 		if newConfig.MachineConfig.MachineFeatures != nil && currentConfig.MachineConfig.MachineFeatures != nil {
 			newConfig.MachineConfig.MachineFeatures.KubernetesTalosAPIAccessConfig = currentConfig.MachineConfig.MachineFeatures.KubernetesTalosAPIAccessConfig
 			newConfig.MachineConfig.MachineFeatures.KubePrismSupport = currentConfig.MachineConfig.MachineFeatures.KubePrismSupport
@@ -178,6 +189,13 @@ func (r *Runtime) CanApplyImmediate(cfg config.Provider) error {
 	return nil
 }
 
+// bootOnlyFiles filters out the machine files which are re-applied without a reboot.
+func bootOnlyFiles(files []*v1alpha1config.MachineFile) []*v1alpha1config.MachineFile {
+	return xslices.Filter(files, func(f *v1alpha1config.MachineFile) bool {
+		return f.Op() != "create" || !userfiles.CanCreate(f.Path())
+	})
+}
+
 // State implements the Runtime interface.
 func (r *Runtime) State() runtime.State {
 	return r.s
@@ -12,8 +12,10 @@ import (
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/machine"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/meta"
 )
 
 // Sequencer implements the sequencer interface.
@@ -175,6 +177,10 @@ func (*Sequencer) Install(r runtime.Runtime) []runtime.Phase {
 			phases = phases.Append(
 				"env",
 				SetUserEnvVars,
+			).AppendWhen(
+				!r.State().Machine().Installed(),
+				"preInstallHooks",
+				RunSequenceHooks(config.SequenceHookPreInstall),
 			).Append(
 				"install",
 				Install,
@@ -221,6 +227,9 @@ func (*Sequencer) Install(r runtime.Runtime) []runtime.Phase {
 func (*Sequencer) Boot(r runtime.Runtime) []runtime.Phase {
 	phases := PhaseList{}
 
+	// the upgrade tag is removed once the machine is healthy, so check it before the services are started
+	_, upgraded := r.State().Machine().Meta().ReadTag(meta.Upgrade)
+
 	phases = phases.AppendWhen(
 		r.State().Platform().Mode() != runtime.ModeContainer,
 		"saveStateEncryptionConfig",
@@ -229,6 +238,10 @@ func (*Sequencer) Boot(r runtime.Runtime) []runtime.Phase {
 		r.State().Platform().Mode() != runtime.ModeContainer,
 		"mountState",
 		MountStatePartition(true),
+	).AppendWhen(
+		r.State().Platform().Mode() != runtime.ModeContainer,
+		"saveBootLog",
+		SaveBootLog,
 	).Append(
 		"saveConfig",
 		SaveConfig,
@@ -277,14 +290,22 @@ func (*Sequencer) Boot(r runtime.Runtime) []runtime.Phase {
 	).Append(
 		"startEverything",
 		StartAllServices,
+	).AppendWhen(
+		upgraded,
+		"postUpgradeHooks",
+		RunSequenceHooks(config.SequenceHookPostUpgrade),
+	).Append(
+		"postBootHooks",
+		RunSequenceHooks(config.SequenceHookPostBoot),
 	)
 
 	return phases
 }
 
 // Reboot is the reboot sequence.
-func (*Sequencer) Reboot(r runtime.Runtime) []runtime.Phase {
-	phases := PhaseList{}.Append(
+func (*Sequencer) Reboot(r runtime.Runtime, in *machineapi.RebootRequest) []runtime.Phase {
+	phases := PhaseList{}.AppendWhen(
+		!in.GetForce(),
 		"cleanup",
 		StopAllPods,
 	).Append(
@@ -418,6 +439,9 @@ func (*Sequencer) StageUpgrade(r runtime.Runtime, in *machineapi.UpgradeRequest)
 		return nil
 	default:
 		phases = phases.Append(
+			"preUpgradeHooks",
+			RunSequenceHooks(config.SequenceHookPreUpgrade),
+		).Append(
 			"cleanup",
 			StopAllPods,
 		).Append(
@@ -472,7 +496,10 @@ func (*Sequencer) Upgrade(r runtime.Runtime, in *machineapi.UpgradeRequest) []ru
 	case runtime.ModeContainer:
 		return nil
 	default:
-		phases = phases.AppendWhen(
+		phases = phases.Append(
+			"preUpgradeHooks",
+			RunSequenceHooks(config.SequenceHookPreUpgrade),
+		).AppendWhen(
 			!r.Config().Machine().Kubelet().SkipNodeRegistration(),
 			"drain",
 			CordonAndDrainNode,
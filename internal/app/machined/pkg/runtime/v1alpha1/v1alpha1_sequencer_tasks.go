@@ -13,6 +13,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"net/http"
 	"os"
 	"path/filepath"
 	goruntime "runtime"
@@ -52,6 +53,7 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system/events"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system/services"
+	"github.com/siderolabs/talos/internal/pkg/bootlog"
 	"github.com/siderolabs/talos/internal/pkg/cgroup"
 	"github.com/siderolabs/talos/internal/pkg/cri"
 	"github.com/siderolabs/talos/internal/pkg/environment"
@@ -63,6 +65,8 @@ import (
 	"github.com/siderolabs/talos/internal/pkg/partition"
 	"github.com/siderolabs/talos/internal/pkg/secureboot"
 	"github.com/siderolabs/talos/internal/pkg/secureboot/tpm2"
+	"github.com/siderolabs/talos/internal/pkg/sequencehook"
+	"github.com/siderolabs/talos/internal/pkg/userfiles"
 	"github.com/siderolabs/talos/internal/pkg/zboot"
 	"github.com/siderolabs/talos/pkg/conditions"
 	"github.com/siderolabs/talos/pkg/images"
@@ -75,6 +79,7 @@ import (
 	blockres "github.com/siderolabs/talos/pkg/machinery/resources/block"
 	resourcefiles "github.com/siderolabs/talos/pkg/machinery/resources/files"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
 	resourceruntime "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 	resourcev1alpha1 "github.com/siderolabs/talos/pkg/machinery/resources/v1alpha1"
 	"github.com/siderolabs/talos/pkg/machinery/version"
@@ -525,6 +530,46 @@ func SaveConfig(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	}, "saveConfig"
 }
 
+// SaveBootLog represents the SaveBootLog task.
+//
+// SaveBootLog persists the kernel and machined logs collected so far to the STATE partition.
+// The logs of the failed boots saved to the EFI partition are moved to the STATE partition as well.
+// Failure to save the log is not fatal for the boot.
+func SaveBootLog(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
+	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+		if err := importFallbackBootLogs(ctx, r.State().V1Alpha2().Resources()); err != nil {
+			logger.Printf("failed to import boot logs from the EFI partition: %s", err)
+		}
+
+		if err := saveBootLog(ctx, r); err != nil {
+			logger.Printf("failed to save boot log: %s", err)
+		}
+
+		return nil
+	}, "saveBootLog"
+}
+
+func saveBootLog(ctx context.Context, r runtime.Runtime) error {
+	bootID, err := bootlog.CurrentBootID()
+	if err != nil {
+		return fmt.Errorf("failed to read boot ID: %w", err)
+	}
+
+	machinedLog, err := r.Logging().ServiceLog("machined").Reader()
+	if err != nil {
+		return err
+	}
+
+	defer machinedLog.Close() //nolint:errcheck
+
+	contents, err := bootlog.Collect(ctx, machinedLog)
+	if err != nil {
+		return err
+	}
+
+	return bootlog.Save(constants.BootLogPath, bootID, contents, constants.BootLogMaxBoots)
+}
+
 // MemorySizeCheck represents the MemorySizeCheck task.
 func MemorySizeCheck(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
@@ -804,12 +849,25 @@ func StartAllServices(runtime.Sequence, any) (runtime.TaskExecutionFunc, string)
 
 		logger.Printf("waiting for %d services", len(svcs.List()))
 
+		timeout := constants.BootTimeout
+
 		for _, svc := range svcs.List() {
-			cond := system.WaitForService(system.StateEventUp, svc.AsProto().GetId())
+			id := svc.AsProto().GetId()
+
+			// the startup timeout of the service might be overridden in the machine config
+			startupTimeout := system.StartupTimeout(r, id, constants.BootTimeout)
+
+			cond := conditions.WithTimeout(
+				system.WaitForService(system.StateEventUp, id),
+				startupTimeout,
+			)
 			all = append(all, cond)
+
+			// the services configured with the longer startup timeout extend the overall wait
+			timeout = max(timeout, startupTimeout)
 		}
 
-		ctx, cancel := context.WithTimeout(ctx, constants.BootTimeout)
+		ctx, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
 
 		aggregateCondition := conditions.WaitForAll(all...)
@@ -973,8 +1031,22 @@ func WriteUserFiles(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 			return fmt.Errorf("error generating extra files: %w", err)
 		}
 
+		templateData, err := userFilesTemplateData(ctx, r)
+		if err != nil {
+			return fmt.Errorf("error getting template data: %w", err)
+		}
+
 		for _, f := range files {
-			content := f.Content()
+			var rendered []byte
+
+			rendered, err = userfiles.Content(ctx, f.Path(), f, templateData)
+			if err != nil {
+				result = multierror.Append(result, fmt.Errorf("error getting contents of file %q: %w", f.Path(), err))
+
+				continue
+			}
+
+			content := string(rendered)
 
 			switch f.Op() {
 			case "create":
@@ -1001,7 +1073,7 @@ func WriteUserFiles(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 					continue
 				}
 
-				content = string(existingFileContents) + "\n" + f.Content()
+				content = string(existingFileContents) + "\n" + content
 			default:
 				result = multierror.Append(result, fmt.Errorf("unknown operation for file %q: %q", f.Path(), f.Op()))
 
@@ -1026,7 +1098,7 @@ func WriteUserFiles(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 
 			// CRI configuration customization
 			if f.Path() == filepath.Join("/etc", constants.CRICustomizationConfigPart) {
-				if err = injectCRIConfigPatch(ctx, r.State().V1Alpha2().Resources(), []byte(f.Content())); err != nil {
+				if err = injectCRIConfigPatch(ctx, r.State().V1Alpha2().Resources(), []byte(content)); err != nil {
 					result = multierror.Append(result, err)
 				}
 
@@ -1048,24 +1120,18 @@ func WriteUserFiles(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 			}
 
 			// We do not want to support creating new files anywhere outside of
-			// /var. If a valid use case comes up, we can reconsider then.
+			// /var and the overlay mounts. If a valid use case comes up, we can reconsider then.
 			if !inVar && f.Op() == "create" {
-				return fmt.Errorf("create operation not allowed outside of /var: %q", f.Path())
-			}
-
-			if err = os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
-				result = multierror.Append(result, err)
-
-				continue
-			}
-
-			if err = os.WriteFile(p, []byte(content), f.Permissions()); err != nil {
-				result = multierror.Append(result, err)
+				if !userfiles.CanCreate(f.Path()) {
+					return fmt.Errorf("create operation not allowed outside of /var: %q", f.Path())
+				}
 
-				continue
+				// overlay mounts are writable, so no bind mount is needed
+				p = f.Path()
+				inVar = true
 			}
 
-			if err = os.Chmod(p, f.Permissions()); err != nil {
+			if _, err = userfiles.Write(p, f, []byte(content)); err != nil {
 				result = multierror.Append(result, err)
 
 				continue
@@ -1082,6 +1148,28 @@ func WriteUserFiles(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	}, "writeUserFiles"
 }
 
+// userFilesTemplateData returns the node metadata for the machine files templates.
+func userFilesTemplateData(ctx context.Context, r runtime.Runtime) (userfiles.TemplateData, error) {
+	st := r.State().V1Alpha2().Resources()
+
+	hostnameStatus, err := safe.StateGetByID[*network.HostnameStatus](ctx, st, network.HostnameID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return userfiles.TemplateData{}, err
+	}
+
+	defaultAddress, err := safe.StateGetByID[*network.NodeAddress](ctx, st, network.NodeAddressDefaultID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return userfiles.TemplateData{}, err
+	}
+
+	routedAddresses, err := safe.StateGetByID[*network.NodeAddress](ctx, st, network.NodeAddressRoutedID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return userfiles.TemplateData{}, err
+	}
+
+	return userfiles.NewTemplateData(r.Config().Machine().Type(), hostnameStatus, defaultAddress, routedAddresses), nil
+}
+
 func injectCRIConfigPatch(ctx context.Context, st state.State, content []byte) error {
 	// limit overall waiting time
 	ctx, cancel := context.WithTimeout(ctx, time.Minute)
@@ -1985,7 +2073,7 @@ func Install(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 				r.ConfigContainer(),
 				install.WithForce(true),
 				install.WithZero(r.Config().Machine().Install().Zero()),
-				install.WithExtraKernelArgs(r.Config().Machine().Install().ExtraKernelArgs()),
+				install.WithExtraKernelArgs(install.ExtraKernelArgs(r.Config())),
 			)
 			if err != nil {
 				platform.FireEvent(
@@ -2359,6 +2447,47 @@ func WaitForCARoots(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	}, "waitForCARoots"
 }
 
+// RunSequenceHooks represents the task for running the sequence hooks configured for the sequence point.
+func RunSequenceHooks(point string) runtime.TaskSetupFunc {
+	return func(_ runtime.Sequence, data any) (runtime.TaskExecutionFunc, string) {
+		return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
+			if r.Config() == nil {
+				return nil
+			}
+
+			event := sequencehook.Event{
+				Version: version.Tag,
+			}
+
+			event.Hostname, _ = os.Hostname() //nolint:errcheck
+
+			if in, ok := data.(*machineapi.UpgradeRequest); ok {
+				event.Image = in.GetImage()
+			}
+
+			for _, hook := range r.Config().Runtime().SequenceHooks() {
+				if hook.Point() != point {
+					continue
+				}
+
+				logger.Printf("running %s hook %q", point, hook.Name())
+
+				if err := sequencehook.Run(ctx, http.DefaultClient, hook, event); err != nil {
+					if hook.IgnoreFailure() {
+						logger.Printf("ignoring failed %s hook %q: %s", point, hook.Name(), err)
+
+						continue
+					}
+
+					return fmt.Errorf("%s hook %q failed: %w", point, hook.Name(), err)
+				}
+			}
+
+			return nil
+		}, "runSequenceHooks"
+	}
+}
+
 // InitVolumeLifecycle initializes volume lifecycle resource.
 func InitVolumeLifecycle(runtime.Sequence, any) (runtime.TaskExecutionFunc, string) {
 	return func(ctx context.Context, logger *log.Logger, r runtime.Runtime) error {
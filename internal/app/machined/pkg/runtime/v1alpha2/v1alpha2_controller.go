@@ -9,12 +9,14 @@ import (
 	"fmt"
 	"net/url"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/controller"
 	osruntime "github.com/cosi-project/runtime/pkg/controller/runtime"
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-procfs/procfs"
 	"go.uber.org/zap"
@@ -39,7 +41,11 @@ import (
 	"github.com/siderolabs/talos/internal/app/machined/pkg/controllers/v1alpha1"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
 	runtimelogging "github.com/siderolabs/talos/internal/app/machined/pkg/runtime/logging"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime/v1alpha1/bootloader/options"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
+	"github.com/siderolabs/talos/internal/pkg/certinventory"
+	"github.com/siderolabs/talos/internal/pkg/dns"
+	"github.com/siderolabs/talos/internal/pkg/hwmon"
 	"github.com/siderolabs/talos/pkg/logging"
 	talosconfig "github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -52,17 +58,30 @@ type Controller struct {
 
 	loggingManager  runtime.LoggingManager
 	consoleLogLevel zap.AtomicLevel
+	structuredLogs  atomic.Bool
 	logger          *zap.Logger
 
 	v1alpha1Runtime runtime.Runtime
+	rollback        func(context.Context) error
+
+	resetBootAttempts func(context.Context) (*options.BootFallback, error)
 }
 
 // NewController creates Controller.
-func NewController(v1alpha1Runtime runtime.Runtime) (*Controller, error) {
+//
+// The rollback function is used to revert the machine to the previous version if the post-upgrade health checks fail.
+// The resetBootAttempts function is used to reset the bootloader boot attempts counter once the machine is ready.
+func NewController(
+	v1alpha1Runtime runtime.Runtime,
+	rollback func(context.Context) error,
+	resetBootAttempts func(context.Context) (*options.BootFallback, error),
+) (*Controller, error) {
 	ctrl := &Controller{
-		consoleLogLevel: zap.NewAtomicLevel(),
-		loggingManager:  v1alpha1Runtime.Logging(),
-		v1alpha1Runtime: v1alpha1Runtime,
+		consoleLogLevel:   zap.NewAtomicLevel(),
+		loggingManager:    v1alpha1Runtime.Logging(),
+		v1alpha1Runtime:   v1alpha1Runtime,
+		rollback:          rollback,
+		resetBootAttempts: resetBootAttempts,
 	}
 
 	var err error
@@ -87,6 +106,8 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		return err
 	}
 
+	dnsMetrics := dns.NewMetrics()
+
 	for _, c := range []controller.Controller{
 		&block.DevicesController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
@@ -144,6 +165,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			EtcPath:    "/etc",
 			ShadowPath: constants.SystemEtcPath,
 		},
+		&files.UserFilesController{},
 		&hardware.PCIDevicesController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
@@ -162,6 +184,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&k8s.EndpointController{},
 		&k8s.ExtraManifestController{},
 		k8s.NewKubeletConfigController(),
+		&k8s.KubeletServingCertApproverController{},
 		&k8s.KubeletServiceController{
 			V1Alpha1Services: system.Services(ctrl.v1alpha1Runtime),
 			V1Alpha1Mode:     ctrl.v1alpha1Runtime.State().Platform().Mode(),
@@ -180,6 +203,9 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&k8s.NodeAnnotationSpecController{},
 		&k8s.NodeApplyController{},
 		&k8s.NodeCordonedSpecController{},
+		&k8s.NodeEventsController{
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+		},
 		&k8s.NodeLabelSpecController{},
 		&k8s.NodeStatusController{},
 		&k8s.NodeTaintSpecController{},
@@ -207,16 +233,19 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.AddressMergeController{},
 		&network.AddressSpecController{},
 		&network.AddressStatusController{},
+		&network.BGPController{},
 		&network.DeviceConfigController{},
 		&network.DNSResolveCacheController{
-			State:  ctrl.v1alpha1Runtime.State().V1Alpha2().Resources(),
-			Logger: dnsCacheLogger,
+			State:   ctrl.v1alpha1Runtime.State().V1Alpha2().Resources(),
+			Logger:  dnsCacheLogger,
+			Metrics: dnsMetrics,
 		},
 		&network.DNSUpstreamController{},
 		&network.EtcFileController{
 			PodResolvConfPath: constants.PodResolvConfPath,
 			V1Alpha1Mode:      ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
+		&network.EthernetConfigController{},
 		&network.HardwareAddrController{},
 		&network.HostDNSConfigController{},
 		&network.HostnameConfigController{
@@ -232,6 +261,8 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.LinkMergeController{},
 		&network.LinkSpecController{},
 		&network.LinkStatusController{},
+		&network.LinkSysctlController{},
+		&network.LLDPNeighborController{},
 		&network.NfTablesChainConfigController{},
 		&network.NfTablesChainController{},
 		&network.NodeAddressController{},
@@ -262,6 +293,7 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.RouteMergeController{},
 		&network.RouteSpecController{},
 		&network.RouteStatusController{},
+		&network.SRIOVController{},
 		&network.StatusController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
@@ -271,6 +303,11 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&network.TimeServerMergeController{},
 		&network.TimeServerSpecController{},
 		&perf.StatsController{},
+		&runtimecontrollers.BootAttemptsController{
+			V1Alpha1Events:    ctrl.v1alpha1Runtime.Events(),
+			V1Alpha1Mode:      ctrl.v1alpha1Runtime.State().Platform().Mode(),
+			ResetBootAttempts: ctrl.resetBootAttempts,
+		},
 		&runtimecontrollers.CRIImageGCController{},
 		&runtimecontrollers.DevicesStatusController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
@@ -285,6 +322,9 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 			V1Alpha1Mode:            ctrl.v1alpha1Runtime.State().Platform().Mode(),
 			ExtensionsConfigBaseDir: constants.ExtensionServiceUserConfigPath,
 		},
+		&runtimecontrollers.EnvironmentController{
+			V1Alpha1Services: system.Services(ctrl.v1alpha1Runtime),
+		},
 		&runtimecontrollers.EventsSinkConfigController{
 			Cmdline:      procfs.ProcCmdline(),
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
@@ -313,6 +353,9 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&runtimecontrollers.KmsgLogDeliveryController{
 			Drainer: drainer,
 		},
+		&runtimecontrollers.LSMController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
 		&runtimecontrollers.MaintenanceConfigController{},
 		&runtimecontrollers.MaintenanceServiceController{},
 		&runtimecontrollers.MachineStatusController{
@@ -321,12 +364,63 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		&runtimecontrollers.MachineStatusPublisherController{
 			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
 		},
+		&runtimecontrollers.MetricsServerController{
+			Collectors: []prometheus.Collector{
+				&hwmon.Collector{},
+				&certinventory.Inventory{
+					State: ctrl.v1alpha1Runtime.State().V1Alpha2().Resources(),
+				},
+				dnsMetrics,
+			},
+		},
+		&runtimecontrollers.NodeMaintenanceController{
+			V1Alpha1Services: system.Services(ctrl.v1alpha1Runtime),
+		},
+		&runtimecontrollers.OOMKillController{
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+			V1Alpha1Mode:   ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
+		&runtimecontrollers.KernelErrorController{
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+			V1Alpha1Mode:   ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
+		&runtimecontrollers.PCRStatusController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
+		&runtimecontrollers.SBOMItemController{},
+		&runtimecontrollers.SNMPAgentController{},
+		&runtimecontrollers.SeccompAuditController{
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+			V1Alpha1Mode:   ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
 		&runtimecontrollers.SecurityStateController{
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
 		},
+		&runtimecontrollers.ServiceLimitsController{
+			V1Alpha1Mode:     ctrl.v1alpha1Runtime.State().Platform().Mode(),
+			V1Alpha1Services: system.Services(ctrl.v1alpha1Runtime),
+		},
+		&runtimecontrollers.SystemResourcesController{
+			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
+		},
 		runtimecontrollers.NewUniqueMachineTokenController(),
+		&runtimecontrollers.UpgradeHealthCheckController{
+			MetaProvider: ctrl.v1alpha1Runtime.State().Machine(),
+			Rollback:     ctrl.rollback,
+		},
+		&runtimecontrollers.UserServiceController{
+			V1Alpha1Services: system.Services(ctrl.v1alpha1Runtime),
+		},
 		&runtimecontrollers.WatchdogTimerConfigController{},
-		&runtimecontrollers.WatchdogTimerController{},
+		&runtimecontrollers.WatchdogTimerController{
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+		},
+		&runtimecontrollers.WebhookNotificationController{
+			V1Alpha1Events: ctrl.v1alpha1Runtime.Events(),
+			Certificates: &certinventory.Inventory{
+				State: ctrl.v1alpha1Runtime.State().V1Alpha2().Resources(),
+			},
+		},
 		&secrets.APICertSANsController{},
 		&secrets.APIController{},
 		&secrets.EtcdController{},
@@ -342,6 +436,9 @@ func (ctrl *Controller) Run(ctx context.Context, drainer *runtime.Drainer) error
 		secrets.NewRootOSController(),
 		&secrets.TrustedRootsController{},
 		&secrets.TrustdController{},
+		&secrets.TrustdAttestationPolicyController{},
+		&secrets.TrustdCSRPolicyController{},
+		&secrets.TrustdJoinTokenController{},
 		&siderolink.ConfigController{
 			Cmdline:      procfs.ProcCmdline(),
 			V1Alpha1Mode: ctrl.v1alpha1Runtime.State().Platform().Mode(),
@@ -434,8 +531,10 @@ func (ctrl *Controller) watchMachineConfig(ctx context.Context) {
 		ctrl.updateConsoleLoggingConfig(cfg.Debug())
 
 		if cfg.Machine() == nil {
+			ctrl.updateStructuredLoggingConfig(false)
 			ctrl.updateLoggingConfig(ctx, nil, &loggingDestinations)
 		} else {
+			ctrl.updateStructuredLoggingConfig(cfg.Machine().Logging().Structured())
 			ctrl.updateLoggingConfig(ctx, cfg.Machine().Logging().Destinations(), &loggingDestinations)
 		}
 	}
@@ -453,6 +552,12 @@ func (ctrl *Controller) updateConsoleLoggingConfig(debug bool) {
 	}
 }
 
+func (ctrl *Controller) updateStructuredLoggingConfig(structured bool) {
+	if ctrl.structuredLogs.Swap(structured) != structured {
+		ctrl.logger.Info("setting structured logging", zap.Bool("structured", structured))
+	}
+}
+
 func (ctrl *Controller) updateLoggingConfig(ctx context.Context, dests []talosconfig.LoggingDestination, prevLoggingDestinations *[]loggingDestination) {
 	loggingDestinations := make([]loggingDestination, len(dests))
 
@@ -527,6 +632,7 @@ func (ctrl *Controller) makeLogger(s string) (*zap.Logger, error) {
 	return logging.ZapLogger(
 		logging.NewLogDestination(logWriter, zapcore.DebugLevel,
 			logging.WithColoredLevels(),
+			logging.WithStructuredSwitch(&ctrl.structuredLogs),
 		),
 		logging.NewLogDestination(logging.StdWriter, ctrl.consoleLogLevel,
 			logging.WithoutTimestamp(),
@@ -123,6 +123,7 @@ func NewState() (*State, error) {
 		&hardware.PCIDevice{},
 		&hardware.Processor{},
 		&hardware.SystemInformation{},
+		&hardware.VirtualFunction{},
 		&k8s.AdmissionControlConfig{},
 		&k8s.AuditPolicyConfig{},
 		&k8s.APIServerConfig{},
@@ -160,9 +161,11 @@ func NewState() (*State, error) {
 		&kubespan.PeerStatus{},
 		&network.AddressStatus{},
 		&network.AddressSpec{},
+		&network.BGPPeerStatus{},
 		&network.DeviceConfigSpec{},
 		&network.DNSResolveCache{},
 		&network.DNSUpstream{},
+		&network.EthernetStatus{},
 		&network.HardwareAddr{},
 		&network.HostDNSConfig{},
 		&network.HostnameStatus{},
@@ -170,6 +173,7 @@ func NewState() (*State, error) {
 		&network.LinkRefresh{},
 		&network.LinkStatus{},
 		&network.LinkSpec{},
+		&network.LLDPNeighbor{},
 		&network.NfTablesChain{},
 		&network.NodeAddress{},
 		&network.NodeAddressFilter{},
@@ -196,6 +200,7 @@ func NewState() (*State, error) {
 		&runtime.KernelParamDefaultSpec{},
 		&runtime.KernelParamStatus{},
 		&runtime.KmsgLogConfig{},
+		&runtime.LSMStatus{},
 		&runtime.MaintenanceServiceConfig{},
 		&runtime.MaintenanceServiceRequest{},
 		&runtime.MachineResetSignal{},
@@ -203,7 +208,9 @@ func NewState() (*State, error) {
 		&runtime.MetaKey{},
 		&runtime.MetaLoaded{},
 		&runtime.MountStatus{},
+		&runtime.PCRStatus{},
 		&runtime.PlatformMetadata{},
+		&runtime.SBOMItem{},
 		&runtime.SecurityState{},
 		&runtime.UniqueMachineToken{},
 		&runtime.WatchdogTimerConfig{},
@@ -211,6 +218,7 @@ func NewState() (*State, error) {
 		&secrets.API{},
 		&secrets.CertSAN{},
 		&secrets.Etcd{},
+		&secrets.EtcdCertsRefreshRequest{},
 		&secrets.EtcdRoot{},
 		&secrets.Kubelet{},
 		&secrets.Kubernetes{},
@@ -220,6 +228,9 @@ func NewState() (*State, error) {
 		&secrets.MaintenanceRoot{},
 		&secrets.OSRoot{},
 		&secrets.Trustd{},
+		&secrets.TrustdAttestationPolicy{},
+		&secrets.TrustdCSRPolicy{},
+		&secrets.TrustdJoinToken{},
 		&siderolink.Config{},
 		&siderolink.Status{},
 		&siderolink.Tunnel{},
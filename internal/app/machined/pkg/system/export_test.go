@@ -9,6 +9,8 @@ import (
 	"github.com/siderolabs/talos/pkg/conditions"
 )
 
+var ApplyPolicy = applyPolicy
+
 func NewServices(runtime runtime.Runtime) *singleton { //nolint:revive
 	return newServices(runtime)
 }
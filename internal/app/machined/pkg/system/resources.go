@@ -0,0 +1,67 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package system
+
+import (
+	"math"
+	"os"
+	"path/filepath"
+
+	"github.com/containerd/cgroups/v3"
+	"github.com/containerd/cgroups/v3/cgroup2"
+
+	"github.com/siderolabs/talos/internal/pkg/cgroup"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// cgroupResources returns the resource usage and limits of the service cgroup.
+//
+// If the cgroup can't be read (e.g. it was removed after the service exited), nil is returned.
+func cgroupResources(cgroupPath string) *machineapi.ServiceResources {
+	if cgroups.Mode() != cgroups.Unified {
+		return nil
+	}
+
+	cgroupPath = cgroup.Path(cgroupPath)
+
+	cg, err := cgroup2.Load(cgroupPath)
+	if err != nil {
+		return nil
+	}
+
+	stat, err := cg.Stat()
+	if err != nil {
+		return nil
+	}
+
+	resources := &machineapi.ServiceResources{}
+
+	if stat.GetMemory() != nil {
+		resources.MemoryCurrent = stat.GetMemory().GetUsage()
+
+		if limit := stat.GetMemory().GetUsageLimit(); limit != math.MaxUint64 {
+			resources.MemoryMax = limit
+		}
+	}
+
+	if stat.GetCPU() != nil {
+		resources.CpuUsageUsec = stat.GetCPU().GetUsageUsec()
+	}
+
+	if stat.GetPids() != nil {
+		resources.PidsCurrent = stat.GetPids().GetCurrent()
+
+		if limit := stat.GetPids().GetLimit(); limit != math.MaxUint64 {
+			resources.PidsMax = limit
+		}
+	}
+
+	if cpuMax, err := os.ReadFile(filepath.Join(constants.CgroupMountPath, cgroupPath, "cpu.max")); err == nil {
+		resources.CpuMaxMillicores = uint64(cgroup.CPUMaxToMilliCores(cgroup2.CPUMax(cpuMax)))
+	}
+
+	return resources
+}
@@ -10,6 +10,7 @@ import (
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system/events"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/system/runner"
+	extservices "github.com/siderolabs/talos/pkg/machinery/extensions/services"
 )
 
 type restarter struct {
@@ -94,6 +95,50 @@ func WithRestartInterval(interval time.Duration) Option {
 	}
 }
 
+// TypeFromRestartKind converts the restart kind of the service configuration to the restart policy type.
+func TypeFromRestartKind(kind extservices.RestartKind) Type {
+	switch kind {
+	case extservices.RestartNever:
+		return Once
+	case extservices.RestartUntilSuccess:
+		return UntilSuccess
+	case extservices.RestartAlways:
+		fallthrough
+	default:
+		return Forever
+	}
+}
+
+// RestartKind converts the restart policy type to the restart kind of the service configuration.
+func (t Type) RestartKind() extservices.RestartKind {
+	switch t {
+	case Once:
+		return extservices.RestartNever
+	case UntilSuccess:
+		return extservices.RestartUntilSuccess
+	case Forever:
+		fallthrough
+	default:
+		return extservices.RestartAlways
+	}
+}
+
+// Apply applies the options on top of the options of the runner with the restart policy.
+//
+// Apply returns the effective options, or false if the runner doesn't have the restart policy.
+func Apply(r runner.Runner, opts ...Option) (Options, bool) {
+	restarter, ok := r.(*restarter)
+	if !ok {
+		return Options{}, false
+	}
+
+	for _, opt := range opts {
+		opt(restarter.opts)
+	}
+
+	return *restarter.opts, true
+}
+
 // Open implements the Runner interface.
 func (r *restarter) Open() error {
 	return r.wrappedRunner.Open()
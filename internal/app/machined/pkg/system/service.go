@@ -53,3 +53,9 @@ type APIStoppableService interface {
 type APIRestartableService interface {
 	APIRestartAllowed(runtime.Runtime) bool
 }
+
+// CgroupService is a service which runs in a dedicated cgroup.
+type CgroupService interface {
+	// Cgroup returns the cgroup path of the service (relative to the cgroup root).
+	Cgroup(runtime.Runtime) string
+}
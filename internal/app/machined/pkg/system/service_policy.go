@@ -0,0 +1,93 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package system
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/health"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/runner"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/runner/restart"
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+// configuredPolicy returns the policy of the service in the machine config, if any.
+func configuredPolicy(r runtime.Runtime, id string) config.ServicePolicyConfig {
+	if r == nil || r.Config() == nil {
+		return nil
+	}
+
+	for _, policy := range r.Config().ServicePolicies() {
+		if policy.Service() == id {
+			return policy
+		}
+	}
+
+	return nil
+}
+
+// applyPolicy applies the configured policy to the service runner and the health check settings.
+//
+// applyPolicy returns the effective health check settings (nil if the service is not health checked) and the effective policy.
+func applyPolicy(policy config.ServicePolicyConfig, runnr runner.Runner, healthSettings *health.Settings) (*health.Settings, *machineapi.ServicePolicy) {
+	effective := &machineapi.ServicePolicy{
+		Configured: policy != nil,
+	}
+
+	var restartOpts []restart.Option
+
+	if policy != nil {
+		if kind, ok := policy.Restart().Get(); ok {
+			restartOpts = append(restartOpts, restart.WithType(restart.TypeFromRestartKind(kind)))
+		}
+
+		if interval, ok := policy.RestartInterval().Get(); ok {
+			restartOpts = append(restartOpts, restart.WithRestartInterval(interval))
+		}
+
+		if timeout, ok := policy.StartupTimeout().Get(); ok {
+			effective.StartupTimeout = durationpb.New(timeout)
+		}
+	}
+
+	if opts, ok := restart.Apply(runnr, restartOpts...); ok {
+		effective.Restart = opts.Type.RestartKind().String()
+		effective.RestartInterval = durationpb.New(opts.RestartInterval)
+	}
+
+	if healthSettings == nil {
+		return nil, effective
+	}
+
+	// the settings might be shared between the services, so never modify them in place
+	settings := *healthSettings
+
+	if policy != nil {
+		settings.InitialDelay = policy.HealthCheckInitialDelay().ValueOr(settings.InitialDelay)
+		settings.Period = policy.HealthCheckPeriod().ValueOr(settings.Period)
+		settings.Timeout = policy.HealthCheckTimeout().ValueOr(settings.Timeout)
+	}
+
+	effective.HealthCheckInitialDelay = durationpb.New(settings.InitialDelay)
+	effective.HealthCheckPeriod = durationpb.New(settings.Period)
+	effective.HealthCheckTimeout = durationpb.New(settings.Timeout)
+
+	return &settings, effective
+}
+
+// StartupTimeout returns the time to wait for the service to be up on boot.
+//
+// If the startup timeout is not configured for the service, defaultTimeout is returned.
+func StartupTimeout(r runtime.Runtime, id string, defaultTimeout time.Duration) time.Duration {
+	if policy := configuredPolicy(r, id); policy != nil {
+		return policy.StartupTimeout().ValueOr(defaultTimeout)
+	}
+
+	return defaultTimeout
+}
@@ -0,0 +1,59 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package system_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/health"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/runner/restart"
+	runtimecfg "github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+)
+
+func TestApplyPolicy(t *testing.T) {
+	t.Parallel()
+
+	defaults := health.DefaultSettings
+
+	settings, policy := system.ApplyPolicy(nil, restart.New(&MockRunner{}), &defaults)
+	require.NotNil(t, settings)
+
+	assert.Equal(t, health.DefaultSettings, *settings)
+	assert.False(t, policy.Configured)
+	assert.Equal(t, "always", policy.Restart)
+	assert.Equal(t, 5*time.Second, policy.RestartInterval.AsDuration())
+	assert.Equal(t, health.DefaultSettings.Period, policy.HealthCheckPeriod.AsDuration())
+	assert.Nil(t, policy.StartupTimeout)
+
+	cfg := runtimecfg.NewServicePolicyV1Alpha1()
+	cfg.MetaName = "kubelet"
+	cfg.PolicyRestart = "untilSuccess"
+	cfg.PolicyHealthCheck = &runtimecfg.ServiceHealthCheck{
+		HealthCheckPeriod: time.Minute,
+	}
+	cfg.PolicyStartupTimeout = time.Hour
+
+	settings, policy = system.ApplyPolicy(cfg, restart.New(&MockRunner{}), &defaults)
+	require.NotNil(t, settings)
+
+	assert.Equal(t, time.Minute, settings.Period)
+	assert.Equal(t, health.DefaultSettings.Timeout, settings.Timeout)
+	assert.Equal(t, health.DefaultSettings, defaults, "default settings should not be modified")
+	assert.True(t, policy.Configured)
+	assert.Equal(t, "untilSuccess", policy.Restart)
+	assert.Equal(t, 5*time.Second, policy.RestartInterval.AsDuration())
+	assert.Equal(t, time.Minute, policy.HealthCheckPeriod.AsDuration())
+	assert.Equal(t, time.Hour, policy.StartupTimeout.AsDuration())
+
+	settings, policy = system.ApplyPolicy(cfg, &MockRunner{}, nil)
+	assert.Nil(t, settings)
+	assert.Empty(t, policy.Restart)
+	assert.Nil(t, policy.HealthCheckPeriod)
+}
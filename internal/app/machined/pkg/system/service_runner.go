@@ -43,6 +43,12 @@ type ServiceRunner struct {
 
 	healthState health.State
 
+	// waitCondition is the condition the service is waiting for (if any).
+	waitCondition conditions.Condition
+
+	// policy is the effective policy of the service (set once the runner is created).
+	policy *machineapi.ServicePolicy
+
 	stateSubscribers map[StateEvent][]chan<- struct{}
 
 	stopCh chan struct{}
@@ -155,8 +161,11 @@ func (svcrunner *ServiceRunner) GetEventHistory(count int) []events.ServiceEvent
 
 func (svcrunner *ServiceRunner) waitFor(ctx context.Context, condition conditions.Condition) error {
 	description := condition.String()
+	svcrunner.setWaitCondition(condition)
 	svcrunner.UpdateState(ctx, events.StateWaiting, "Waiting for %s", description)
 
+	defer svcrunner.setWaitCondition(nil)
+
 	errCh := make(chan error)
 
 	go func() {
@@ -181,6 +190,13 @@ func (svcrunner *ServiceRunner) waitFor(ctx context.Context, condition condition
 	}
 }
 
+func (svcrunner *ServiceRunner) setWaitCondition(condition conditions.Condition) {
+	svcrunner.mu.Lock()
+	defer svcrunner.mu.Unlock()
+
+	svcrunner.waitCondition = condition
+}
+
 // ErrSkip is returned by Run when service is skipped.
 var ErrSkip = errors.New("service skipped")
 
@@ -256,7 +272,19 @@ func (svcrunner *ServiceRunner) Run(notifyChannels ...chan<- struct{}) error {
 		return ErrSkip
 	}
 
-	if err := svcrunner.run(ctx, runnr); err != nil {
+	var healthSettings *health.Settings
+
+	if healthSvc, ok := svcrunner.service.(HealthcheckedService); ok {
+		healthSettings = healthSvc.HealthSettings(svcrunner.runtime)
+	}
+
+	healthSettings, policy := applyPolicy(configuredPolicy(svcrunner.runtime, svcrunner.id), runnr, healthSettings)
+
+	svcrunner.mu.Lock()
+	svcrunner.policy = policy
+	svcrunner.mu.Unlock()
+
+	if err := svcrunner.run(ctx, runnr, healthSettings); err != nil {
 		return fmt.Errorf("failed running service: %w", err)
 	}
 
@@ -264,7 +292,7 @@ func (svcrunner *ServiceRunner) Run(notifyChannels ...chan<- struct{}) error {
 }
 
 //nolint:gocyclo
-func (svcrunner *ServiceRunner) run(ctx context.Context, runnr runner.Runner) error {
+func (svcrunner *ServiceRunner) run(ctx context.Context, runnr runner.Runner, healthSettings *health.Settings) error {
 	if runnr == nil {
 		// special case - run nothing (TODO: we should handle it better, e.g. in PreFunc)
 		return nil
@@ -301,7 +329,7 @@ func (svcrunner *ServiceRunner) run(ctx context.Context, runnr runner.Runner) er
 			//nolint:errcheck
 			health.Run(
 				ctx,
-				healthSvc.HealthSettings(svcrunner.runtime),
+				healthSettings,
 				&svcrunner.healthState,
 				healthSvc.HealthFunc(svcrunner.runtime),
 			)
@@ -359,14 +387,55 @@ func (svcrunner *ServiceRunner) Shutdown() {
 // AsProto returns protobuf struct with the state of the service runner.
 func (svcrunner *ServiceRunner) AsProto() *machineapi.ServiceInfo {
 	svcrunner.mu.Lock()
-	defer svcrunner.mu.Unlock()
 
-	return &machineapi.ServiceInfo{
+	info := &machineapi.ServiceInfo{
 		Id:     svcrunner.id,
 		State:  svcrunner.state.String(),
 		Events: svcrunner.events.AsProto(events.MaxEventsToKeep),
 		Health: svcrunner.healthState.AsProto(),
+		Policy: svcrunner.policy,
 	}
+
+	running := svcrunner.state == events.StateRunning
+	waitCondition := svcrunner.waitCondition
+
+	svcrunner.mu.Unlock()
+
+	if waitCondition != nil {
+		info.WaitingFor = conditions.Pending(waitCondition)
+	}
+
+	if cgroupService, ok := svcrunner.service.(CgroupService); ok && running {
+		info.Resources = cgroupResources(cgroupService.Cgroup(svcrunner.runtime))
+	}
+
+	return info
+}
+
+// AsGraphProto returns protobuf struct with the dependencies and the conditions of the service.
+func (svcrunner *ServiceRunner) AsGraphProto() *machineapi.ServiceGraphNode {
+	svcrunner.mu.Lock()
+
+	node := &machineapi.ServiceGraphNode{
+		Id:    svcrunner.id,
+		State: svcrunner.state.String(),
+	}
+
+	waitCondition := svcrunner.waitCondition
+
+	svcrunner.mu.Unlock()
+
+	if waitCondition != nil {
+		node.WaitingFor = waitCondition.String()
+	}
+
+	node.DependsOn = svcrunner.service.DependsOn(svcrunner.runtime)
+
+	if condition := svcrunner.service.Condition(svcrunner.runtime); condition != nil {
+		node.Condition = condition.String()
+	}
+
+	return node
 }
 
 // Subscribe to a specific event for this service.
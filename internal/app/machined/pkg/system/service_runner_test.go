@@ -111,6 +111,12 @@ func (suite *ServiceRunnerSuite) TestFullFlowHealthy() {
 		events.StateRunning,
 		events.StateRunning, // one more notification when service is healthy
 	}, sr)
+
+	policy := sr.AsProto().Policy
+	suite.Assert().False(policy.Configured)
+	suite.Assert().Empty(policy.Restart)
+	suite.Assert().Equal(time.Millisecond, policy.HealthCheckPeriod.AsDuration())
+	suite.Assert().Equal(time.Second, policy.HealthCheckTimeout.AsDuration())
 }
 
 func (suite *ServiceRunnerSuite) TestFullFlowHealthChanges() {
@@ -222,6 +228,9 @@ func (suite *ServiceRunnerSuite) TestWaitingDescriptionChange() {
 		return nil
 	}))
 
+	suite.Assert().Equal("cond2", sr.AsGraphProto().WaitingFor)
+	suite.Assert().Equal([]string{"cond2"}, sr.AsProto().WaitingFor)
+
 	select {
 	case <-errCh:
 		suite.Require().Fail("service running should be still running")
@@ -239,6 +248,9 @@ func (suite *ServiceRunnerSuite) TestWaitingDescriptionChange() {
 		return nil
 	}))
 
+	suite.Assert().Empty(sr.AsGraphProto().WaitingFor)
+	suite.Assert().Empty(sr.AsProto().WaitingFor)
+
 	sr.Shutdown()
 
 	suite.Assert().NoError(<-errCh)
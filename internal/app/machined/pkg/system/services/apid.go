@@ -35,6 +35,7 @@ import (
 	"github.com/siderolabs/talos/pkg/conditions"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
 )
 
@@ -51,6 +52,11 @@ func (o *APID) ID(r runtime.Runtime) string {
 	return "apid"
 }
 
+// Cgroup implements the CgroupService interface.
+func (o *APID) Cgroup(runtime.Runtime) string {
+	return constants.CgroupApid
+}
+
 // apidResourceFilter filters access to COSI state for apid.
 func apidResourceFilter(ctx context.Context, access state.Access) error {
 	if !access.Verb.Readonly() {
@@ -120,7 +126,10 @@ func (o *APID) PostFunc(r runtime.Runtime, state events.ServiceState) (err error
 
 // Condition implements the Service interface.
 func (o *APID) Condition(r runtime.Runtime) conditions.Condition {
-	return secrets.NewAPIReadyCondition(r.State().V1Alpha2().Resources())
+	return conditions.WaitForAll(
+		secrets.NewAPIReadyCondition(r.State().V1Alpha2().Resources()),
+		runtimeres.NewLSMStatusCondition(r.State().V1Alpha2().Resources()),
+	)
 }
 
 // DependsOn implements the Service interface.
@@ -156,6 +165,28 @@ func (o *APID) Runner(r runtime.Runtime) (runner.Runner, error) {
 		args.ProcessArgs = append(args.ProcessArgs, "--enable-ext-key-usage-check")
 	}
 
+	if rateLimit := r.Config().Machine().Features().APIRateLimit(); rateLimit.Enabled() {
+		args.ProcessArgs = append(args.ProcessArgs,
+			"--rate-limit-methods="+strings.Join(rateLimit.Methods(), ","),
+			"--rate-limit-requests-per-minute="+strconv.Itoa(rateLimit.RequestsPerMinute()),
+			"--rate-limit-burst="+strconv.Itoa(rateLimit.Burst()),
+			"--rate-limit-max-concurrent-requests="+strconv.Itoa(rateLimit.MaxConcurrentRequests()),
+		)
+	}
+
+	seccompProfile := systemServiceSeccomp(o.ID(r), seccompAudit(r))
+
+	if apiVsock := r.Config().Machine().Features().APIVsock(); apiVsock.Enabled() {
+		args.ProcessArgs = append(args.ProcessArgs, "--vsock-port="+strconv.Itoa(apiVsock.Port()))
+
+		systemProfile := seccompProfile
+
+		seccompProfile = func(seccomp *specs.LinuxSeccomp) {
+			systemProfile(seccomp)
+			allowVsockSockets(seccomp)
+		}
+	}
+
 	// Set the mounts.
 	mounts := []specs.Mount{
 		{Type: "bind", Destination: "/etc/ssl", Source: "/etc/ssl", Options: []string{"bind", "ro"}},
@@ -163,8 +194,25 @@ func (o *APID) Runner(r runtime.Runtime) (runner.Runner, error) {
 		{Type: "bind", Destination: filepath.Dir(constants.APISocketPath), Source: filepath.Dir(constants.APISocketPath), Options: []string{"rbind", "rw"}},
 	}
 
+	pprofMount, err := profilingMount(constants.ApidProfilingSocketPath, constants.ApidUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	mounts = append(mounts, pprofMount)
+
+	if debug.Enabled {
+		// the socket is created by debugd, which might be started later
+		if err = os.MkdirAll(filepath.Dir(constants.DebugdSocketPath), 0o770); err != nil {
+			return nil, err
+		}
+
+		mounts = append(mounts, specs.Mount{Type: "bind", Destination: filepath.Dir(constants.DebugdSocketPath), Source: filepath.Dir(constants.DebugdSocketPath), Options: []string{"rbind", "ro"}})
+	}
+
 	env := []string{
 		constants.TcellMinimizeEnvironment,
+		constants.GoTracebackCrashEnvironment,
 		"GOMEMLIMIT=" + strconv.Itoa(constants.CgroupApidMaxMemory/5*4),
 	}
 
@@ -194,7 +242,7 @@ func (o *APID) Runner(r runtime.Runtime) (runner.Runner, error) {
 		runner.WithLoggingManager(r.Logging()),
 		runner.WithContainerdAddress(constants.SystemContainerdAddress),
 		runner.WithEnv(env),
-		runner.WithCgroupPath(constants.CgroupApid),
+		runner.WithCgroupPath(o.Cgroup(r)),
 		runner.WithOCISpecOpts(
 			oci.WithDroppedCapabilities(cap.Known()),
 			oci.WithHostNamespace(specs.NetworkNamespace),
@@ -202,8 +250,10 @@ func (o *APID) Runner(r runtime.Runtime) (runner.Runner, error) {
 			oci.WithRootFSPath(filepath.Join(constants.SystemLibexecPath, o.ID(r))),
 			oci.WithRootFSReadonly(),
 			oci.WithUser(fmt.Sprintf("%d:%d", constants.ApidUserID, constants.ApidUserID)),
+			oci.WithApparmorProfile(appArmorProfile(r)),
 		),
 		runner.WithOOMScoreAdj(-998),
+		runner.WithCustomSeccompProfile(seccompProfile),
 	),
 		restart.WithType(restart.Forever),
 	), nil
@@ -0,0 +1,26 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services
+
+import (
+	"context"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+)
+
+// appArmorProfile returns the name of the AppArmor profile for system services.
+//
+// If the profile is not loaded, an empty string is returned (unconfined).
+func appArmorProfile(r runtime.Runtime) string {
+	status, err := safe.StateGetByID[*runtimeres.LSMStatus](context.Background(), r.State().V1Alpha2().Resources(), runtimeres.LSMStatusID)
+	if err != nil {
+		return ""
+	}
+
+	return status.TypedSpec().AppArmorProfile
+}
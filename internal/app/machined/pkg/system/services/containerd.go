@@ -54,6 +54,11 @@ func (c *Containerd) ID(r runtime.Runtime) string {
 	return "containerd"
 }
 
+// Cgroup implements the CgroupService interface.
+func (c *Containerd) Cgroup(runtime.Runtime) string {
+	return constants.CgroupSystemRuntime
+}
+
 // PreFunc implements the Service interface.
 func (c *Containerd) PreFunc(ctx context.Context, r runtime.Runtime) error {
 	return nil
@@ -105,13 +110,13 @@ func (c *Containerd) Runner(r runtime.Runtime) (runner.Runner, error) {
 		args,
 		runner.WithLoggingManager(r.Logging()),
 		runner.WithEnv(append(
-			environment.Get(r.Config()),
+			append([]string{constants.GoTracebackCrashEnvironment}, environment.Get(r.Config())...),
 			// append a default value for XDG_RUNTIME_DIR for the services running on the host
 			// see https://specifications.freedesktop.org/basedir-spec/basedir-spec-latest.html
 			"XDG_RUNTIME_DIR=/run",
 		)),
 		runner.WithOOMScoreAdj(-999),
-		runner.WithCgroupPath(constants.CgroupSystemRuntime),
+		runner.WithCgroupPath(c.Cgroup(r)),
 		runner.WithDroppedCapabilities(constants.DefaultDroppedCapabilities),
 	),
 		restart.WithType(restart.Forever),
@@ -56,6 +56,11 @@ func (c *CRI) ID(r runtime.Runtime) string {
 	return "cri"
 }
 
+// Cgroup implements the CgroupService interface.
+func (c *CRI) Cgroup(runtime.Runtime) string {
+	return constants.CgroupPodRuntime
+}
+
 // PreFunc implements the Service interface.
 func (c *CRI) PreFunc(ctx context.Context, r runtime.Runtime) error {
 	return os.MkdirAll(defaults.DefaultRootDir, os.ModeDir)
@@ -99,13 +104,13 @@ func (c *CRI) Runner(r runtime.Runtime) (runner.Runner, error) {
 		args,
 		runner.WithLoggingManager(r.Logging()),
 		runner.WithEnv(append(
-			environment.Get(r.Config()),
+			append([]string{constants.GoTracebackCrashEnvironment}, environment.Get(r.Config())...),
 			// append a default value for XDG_RUNTIME_DIR for the services running on the host
 			// see https://specifications.freedesktop.org/basedir-spec/basedir-spec-latest.html
 			"XDG_RUNTIME_DIR=/run",
 		)),
 		runner.WithOOMScoreAdj(-500),
-		runner.WithCgroupPath(constants.CgroupPodRuntime),
+		runner.WithCgroupPath(c.Cgroup(r)),
 		runner.WithDroppedCapabilities(constants.DefaultDroppedCapabilities),
 	),
 		restart.WithType(restart.Forever),
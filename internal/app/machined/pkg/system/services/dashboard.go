@@ -30,6 +30,11 @@ func (d *Dashboard) ID(_ runtime.Runtime) string {
 	return "dashboard"
 }
 
+// Cgroup implements the CgroupService interface.
+func (d *Dashboard) Cgroup(runtime.Runtime) string {
+	return constants.CgroupDashboard
+}
+
 // PreFunc implements the Service interface.
 func (d *Dashboard) PreFunc(_ context.Context, _ runtime.Runtime) error {
 	return console.Switch(constants.DashboardTTY)
@@ -62,6 +67,7 @@ func (d *Dashboard) Runner(r runtime.Runtime) (runner.Runner, error) {
 		runner.WithEnv([]string{
 			"TERM=linux",
 			constants.TcellMinimizeEnvironment,
+			constants.GoTracebackCrashEnvironment,
 			"GOMEMLIMIT=" + strconv.Itoa(constants.CgroupDashboardMaxMemory/5*4),
 		}),
 		runner.WithStdinFile(tty),
@@ -69,7 +75,7 @@ func (d *Dashboard) Runner(r runtime.Runtime) (runner.Runner, error) {
 		runner.WithCtty(1),
 		runner.WithOOMScoreAdj(-400),
 		runner.WithDroppedCapabilities(capability.AllCapabilitiesSetLowercase()),
-		runner.WithCgroupPath(constants.CgroupDashboard),
+		runner.WithCgroupPath(d.Cgroup(r)),
 		runner.WithUID(constants.DashboardUserID),
 	),
 		restart.WithType(restart.Forever),
@@ -0,0 +1,62 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services
+
+import (
+	"context"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/events"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/runner"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/runner/process"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/runner/restart"
+	"github.com/siderolabs/talos/pkg/conditions"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// Debugd implements the Service interface. It serves as the concrete type with
+// the required methods.
+//
+// Debugd tunnels the connections to the delve debugger attached to the Talos services,
+// it is only started in the debug builds of Talos.
+type Debugd struct{}
+
+// ID implements the Service interface.
+func (d *Debugd) ID(_ runtime.Runtime) string {
+	return "debugd"
+}
+
+// PreFunc implements the Service interface.
+func (d *Debugd) PreFunc(_ context.Context, _ runtime.Runtime) error {
+	return nil
+}
+
+// PostFunc implements the Service interface.
+func (d *Debugd) PostFunc(_ runtime.Runtime, _ events.ServiceState) error {
+	return nil
+}
+
+// Condition implements the Service interface.
+func (d *Debugd) Condition(_ runtime.Runtime) conditions.Condition {
+	return nil
+}
+
+// DependsOn implements the Service interface.
+func (d *Debugd) DependsOn(_ runtime.Runtime) []string {
+	return nil
+}
+
+// Runner implements the Service interface.
+func (d *Debugd) Runner(r runtime.Runtime) (runner.Runner, error) {
+	return restart.New(process.NewRunner(false, &runner.Args{
+		ID:          d.ID(r),
+		ProcessArgs: []string{"/sbin/debugd"},
+	},
+		runner.WithLoggingManager(r.Logging()),
+		runner.WithCgroupPath(constants.CgroupSystem),
+	),
+		restart.WithType(restart.Forever),
+	), nil
+}
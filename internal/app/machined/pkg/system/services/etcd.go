@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"io"
 	"log"
+	"maps"
 	"net/netip"
 	"os"
 	goruntime "runtime"
@@ -52,6 +53,7 @@ import (
 	etcdresource "github.com/siderolabs/talos/pkg/machinery/resources/etcd"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 	timeresource "github.com/siderolabs/talos/pkg/machinery/resources/time"
 )
 
@@ -80,6 +82,11 @@ func (e *Etcd) ID(r runtime.Runtime) string {
 	return "etcd"
 }
 
+// Cgroup implements the CgroupService interface.
+func (e *Etcd) Cgroup(runtime.Runtime) string {
+	return constants.CgroupEtcd
+}
+
 // PreFunc implements the Service interface.
 //
 //nolint:gocyclo
@@ -169,6 +176,7 @@ func (e *Etcd) Condition(r runtime.Runtime) conditions.Condition {
 		timeresource.NewSyncCondition(r.State().V1Alpha2().Resources()),
 		network.NewReadyCondition(r.State().V1Alpha2().Resources(), network.AddressReady, network.HostnameReady, network.EtcFilesReady),
 		etcdresource.NewSpecReadyCondition(r.State().V1Alpha2().Resources()),
+		runtimeres.NewLSMStatusCondition(r.State().V1Alpha2().Resources()),
 	)
 }
 
@@ -190,7 +198,7 @@ func (e *Etcd) Runner(r runtime.Runtime) (runner.Runner, error) {
 		{Type: "bind", Destination: constants.EtcdDataPath, Source: constants.EtcdDataPath, Options: []string{"rbind", "rw"}},
 	}
 
-	env := environment.Get(r.Config())
+	env := append([]string{constants.GoTracebackCrashEnvironment}, environment.Get(r.Config())...)
 
 	if goruntime.GOARCH == "arm64" {
 		env = append(env, "ETCD_UNSUPPORTED_ARCH=arm64")
@@ -219,16 +227,18 @@ func (e *Etcd) Runner(r runtime.Runtime) (runner.Runner, error) {
 		runner.WithNamespace(constants.SystemContainerdNamespace),
 		runner.WithContainerImage(e.imgRef),
 		runner.WithEnv(env),
-		runner.WithCgroupPath(constants.CgroupEtcd),
+		runner.WithCgroupPath(e.Cgroup(r)),
 		runner.WithOCISpecOpts(
 			oci.WithDroppedCapabilities(cap.Known()),
 			oci.WithHostNamespace(specs.NetworkNamespace),
 			oci.WithMounts(mounts),
 			oci.WithUser(fmt.Sprintf("%d:%d", constants.EtcdUserID, constants.EtcdUserID)),
+			oci.WithApparmorProfile(appArmorProfile(r)),
 			runner.WithMemoryReservation(constants.CgroupEtcdReservedMemory),
 			oci.WithCPUShares(uint64(cgroup.MilliCoresToShares(constants.CgroupEtcdMillicores))),
 		),
 		runner.WithOOMScoreAdj(-998),
+		runner.WithCustomSeccompProfile(systemServiceSeccomp(e.ID(r), seccompAudit(r))),
 	),
 		restart.WithType(restart.Forever),
 	), nil
@@ -384,24 +394,16 @@ func (e *Etcd) argsForInit(ctx context.Context, r runtime.Runtime, spec *etcdres
 
 	denyListArgs := argsbuilder.Args{
 		"name":                               spec.Name,
-		"auto-tls":                           "false",
-		"peer-auto-tls":                      "false",
 		"data-dir":                           constants.EtcdDataPath,
 		"listen-peer-urls":                   formatEtcdURLs(spec.ListenPeerAddresses, constants.EtcdPeerPort),
 		"listen-client-urls":                 formatEtcdURLs(spec.ListenClientAddresses, constants.EtcdClientPort),
-		"client-cert-auth":                   "true",
-		"cert-file":                          constants.EtcdCert,
-		"key-file":                           constants.EtcdKey,
-		"trusted-ca-file":                    constants.EtcdCACert,
-		"peer-client-cert-auth":              "true",
-		"peer-cert-file":                     constants.EtcdPeerCert,
-		"peer-key-file":                      constants.EtcdPeerKey,
-		"peer-trusted-ca-file":               constants.EtcdCACert,
 		"experimental-initial-corrupt-check": "true",
 		"experimental-watch-progress-notify-interval": "5s",
 		"experimental-compact-hash-check-enabled":     "true",
 	}
 
+	maps.Copy(denyListArgs, etcdresource.EnforcedArgs())
+
 	extraArgs := argsbuilder.Args(spec.ExtraArgs)
 
 	denyList := argsbuilder.WithDenyList(denyListArgs)
@@ -461,24 +463,16 @@ func (e *Etcd) argsForInit(ctx context.Context, r runtime.Runtime, spec *etcdres
 func (e *Etcd) argsForControlPlane(ctx context.Context, r runtime.Runtime, spec *etcdresource.SpecSpec) error {
 	denyListArgs := argsbuilder.Args{
 		"name":                               spec.Name,
-		"auto-tls":                           "false",
-		"peer-auto-tls":                      "false",
 		"data-dir":                           constants.EtcdDataPath,
 		"listen-peer-urls":                   formatEtcdURLs(spec.ListenPeerAddresses, constants.EtcdPeerPort),
 		"listen-client-urls":                 formatEtcdURLs(spec.ListenClientAddresses, constants.EtcdClientPort),
-		"client-cert-auth":                   "true",
-		"cert-file":                          constants.EtcdCert,
-		"key-file":                           constants.EtcdKey,
-		"trusted-ca-file":                    constants.EtcdCACert,
-		"peer-client-cert-auth":              "true",
-		"peer-cert-file":                     constants.EtcdPeerCert,
-		"peer-key-file":                      constants.EtcdPeerKey,
-		"peer-trusted-ca-file":               constants.EtcdCACert,
 		"experimental-initial-corrupt-check": "true",
 		"experimental-watch-progress-notify-interval": "5s",
 		"experimental-compact-hash-check-enabled":     "true",
 	}
 
+	maps.Copy(denyListArgs, etcdresource.EnforcedArgs())
+
 	extraArgs := argsbuilder.Args(spec.ExtraArgs)
 
 	denyList := argsbuilder.WithDenyList(denyListArgs)
@@ -4,7 +4,12 @@
 
 package services
 
-import "github.com/containerd/containerd/v2/pkg/oci"
+import (
+	"github.com/containerd/containerd/v2/pkg/oci"
+	eventsapi "github.com/siderolabs/siderolink/api/events"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+)
 
 // GetOCIOptions gets all OCI options from an Extension.
 func (svc *Extension) GetOCIOptions() ([]oci.SpecOpts, error) {
@@ -13,5 +18,19 @@ func (svc *Extension) GetOCIOptions() ([]oci.SpecOpts, error) {
 		return nil, err
 	}
 
-	return svc.getOCIOptions(envVars, svc.Spec.Container.Mounts), nil
+	return svc.getOCIOptions(envVars, svc.Spec.Container.Mounts, ""), nil
+}
+
+// ProcessArgs is exported for testing.
+var ProcessArgs = processArgs
+
+// SystemServiceSeccomp is exported for testing.
+var SystemServiceSeccomp = systemServiceSeccomp
+
+// AllowVsockSockets is exported for testing.
+var AllowVsockSockets = allowVsockSockets
+
+// NewTrustdEventSink is exported for testing.
+func NewTrustdEventSink(publisher runtime.Publisher) eventsapi.EventSinkServiceServer {
+	return &trustdEventSink{publisher: publisher}
 }
@@ -47,6 +47,11 @@ func (svc *Extension) ID(r runtime.Runtime) string {
 	return "ext-" + svc.Spec.Name
 }
 
+// Cgroup implements the CgroupService interface.
+func (svc *Extension) Cgroup(runtime.Runtime) string {
+	return filepath.Join(constants.CgroupExtensions, svc.Spec.Name)
+}
+
 // PreFunc implements the Service interface.
 func (svc *Extension) PreFunc(ctx context.Context, r runtime.Runtime) error {
 	// re-mount service rootfs as overlay rw mount to allow containerd to mount there /dev, /proc, etc.
@@ -69,7 +74,10 @@ func (svc *Extension) PostFunc(r runtime.Runtime, state events.ServiceState) (er
 
 // Condition implements the Service interface.
 func (svc *Extension) Condition(r runtime.Runtime) conditions.Condition {
-	var conds []conditions.Condition
+	conds := []conditions.Condition{
+		// the baseline AppArmor profile should be loaded before the service is started
+		runtimeres.NewLSMStatusCondition(r.State().V1Alpha2().Resources()),
+	}
 
 	if svc.Spec.Container.EnvironmentFile != "" {
 		// add a dependency on the environment file
@@ -89,10 +97,6 @@ func (svc *Extension) Condition(r runtime.Runtime) conditions.Condition {
 		}
 	}
 
-	if len(conds) == 0 {
-		return nil
-	}
-
 	return conditions.WaitForAll(conds...)
 }
 
@@ -109,14 +113,14 @@ func (svc *Extension) DependsOn(r runtime.Runtime) []string {
 	return deps
 }
 
-func (svc *Extension) getOCIOptions(envVars []string, mounts []specs.Mount) []oci.SpecOpts {
+func (svc *Extension) getOCIOptions(envVars []string, mounts []specs.Mount, apparmorProfile string) []oci.SpecOpts {
 	ociOpts := []oci.SpecOpts{
 		oci.WithRootFSPath(filepath.Join(constants.ExtensionServiceRootfsPath, svc.Spec.Name)),
 		containerd.WithRootfsPropagation(svc.Spec.Container.Security.RootfsPropagation),
 		oci.WithMounts(mounts),
 		oci.WithHostNamespace(specs.NetworkNamespace),
 		oci.WithSelinuxLabel(""),
-		oci.WithApparmorProfile(""),
+		oci.WithApparmorProfile(apparmorProfile),
 		oci.WithCapabilities(capability.AllGrantableCapabilities()),
 		oci.WithAllDevicesAllowed,
 		oci.WithEnv(envVars),
@@ -199,7 +203,7 @@ func (svc *Extension) Runner(r runtime.Runtime) (runner.Runner, error) {
 		restartType = restart.UntilSuccess
 	}
 
-	ociSpecOpts := svc.getOCIOptions(envVars, mounts)
+	ociSpecOpts := svc.getOCIOptions(envVars, mounts, appArmorProfile(r))
 
 	debug := false
 
@@ -215,7 +219,7 @@ func (svc *Extension) Runner(r runtime.Runtime) (runner.Runner, error) {
 		runner.WithContainerdAddress(constants.SystemContainerdAddress),
 		runner.WithEnv(environment.Get(r.Config())),
 		runner.WithOCISpecOpts(ociSpecOpts...),
-		runner.WithCgroupPath(filepath.Join(constants.CgroupExtensions, svc.Spec.Name)),
+		runner.WithCgroupPath(svc.Cgroup(r)),
 		runner.WithOOMScoreAdj(-600),
 	),
 		restart.WithType(restartType),
@@ -33,6 +33,7 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 	timeresource "github.com/siderolabs/talos/pkg/machinery/resources/time"
 )
 
@@ -49,6 +50,11 @@ func (k *Kubelet) ID(r runtime.Runtime) string {
 	return "kubelet"
 }
 
+// Cgroup implements the CgroupService interface.
+func (k *Kubelet) Cgroup(runtime.Runtime) string {
+	return constants.CgroupKubelet
+}
+
 // PreFunc implements the Service interface.
 func (k *Kubelet) PreFunc(ctx context.Context, r runtime.Runtime) error {
 	specResource, err := r.State().V1Alpha2().Resources().Get(ctx, resource.NewMetadata(k8s.NamespaceName, k8s.KubeletSpecType, k8s.KubeletID, resource.VersionUndefined))
@@ -94,12 +100,25 @@ func (k *Kubelet) Condition(r runtime.Runtime) conditions.Condition {
 	return conditions.WaitForAll(
 		timeresource.NewSyncCondition(r.State().V1Alpha2().Resources()),
 		network.NewReadyCondition(r.State().V1Alpha2().Resources(), network.AddressReady, network.HostnameReady, network.EtcFilesReady),
+		runtimeres.NewLSMStatusCondition(r.State().V1Alpha2().Resources()),
 	)
 }
 
 // DependsOn implements the Service interface.
 func (k *Kubelet) DependsOn(r runtime.Runtime) []string {
-	return []string{"cri"}
+	deps := []string{"cri"}
+
+	if r == nil || r.Config() == nil {
+		return deps
+	}
+
+	for _, svc := range r.Config().UserServices() {
+		if svc.RequiredByKubelet() {
+			deps = append(deps, UserServiceIDPrefix+svc.Name())
+		}
+	}
+
+	return deps
 }
 
 // Runner implements the Service interface.
@@ -156,8 +175,8 @@ func (k *Kubelet) Runner(r runtime.Runtime) (runner.Runner, error) {
 		runner.WithLoggingManager(r.Logging()),
 		runner.WithNamespace(constants.SystemContainerdNamespace),
 		runner.WithContainerImage(k.imgRef),
-		runner.WithEnv(environment.Get(r.Config())),
-		runner.WithCgroupPath(constants.CgroupKubelet),
+		runner.WithEnv(append([]string{constants.GoTracebackCrashEnvironment}, environment.Get(r.Config())...)),
+		runner.WithCgroupPath(k.Cgroup(r)),
 		runner.WithOCISpecOpts(
 			containerd.WithRootfsPropagation("shared"),
 			oci.WithMounts(mounts),
@@ -169,7 +188,7 @@ func (k *Kubelet) Runner(r runtime.Runtime) (runner.Runner, error) {
 			oci.WithWriteableSysfs,
 			oci.WithWriteableCgroupfs,
 			oci.WithSelinuxLabel(""),
-			oci.WithApparmorProfile(""),
+			oci.WithApparmorProfile(appArmorProfile(r)),
 			oci.WithAllDevicesAllowed,
 			oci.WithCapabilities(capability.AllGrantableCapabilities()), // TODO: kubelet doesn't need all of these, we should consider limiting capabilities
 		),
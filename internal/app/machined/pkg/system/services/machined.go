@@ -6,6 +6,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"io"
 	"log"
 	"net"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/siderolabs/go-debug"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
 
 	v1alpha1server "github.com/siderolabs/talos/internal/app/machined/internal/server/v1alpha1"
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
@@ -26,6 +28,9 @@ import (
 	"github.com/siderolabs/talos/pkg/conditions"
 	"github.com/siderolabs/talos/pkg/grpc/factory"
 	"github.com/siderolabs/talos/pkg/grpc/middleware/authz"
+	"github.com/siderolabs/talos/pkg/grpc/middleware/drain"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/role"
 )
@@ -38,56 +43,84 @@ var rules = map[string]role.Set{
 	"/inspect.InspectService/ControllerRuntimeDependencies": role.MakeSet(role.Admin, role.Operator, role.Reader),
 
 	"/machine.MachineService/ApplyConfiguration":          role.MakeSet(role.Admin),
+	"/machine.MachineService/BMCEventLog":                 role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/BMCSensors":                  role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/BootLogs":                    role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/Bootstrap":                   role.MakeSet(role.Admin),
 	"/machine.MachineService/CPUInfo":                     role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/Certificates":                role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/ChaosEphemeralFill":          role.MakeSet(role.Chaos),
+	"/machine.MachineService/ChaosNetworkDrop":            role.MakeSet(role.Chaos),
+	"/machine.MachineService/ChaosServiceKill":            role.MakeSet(role.Chaos),
 	"/machine.MachineService/Containers":                  role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/Copy":                        role.MakeSet(role.Admin),
+	"/machine.MachineService/DebugAttach":                 role.MakeSet(role.Admin),
+	"/machine.MachineService/DiskBenchmark":               role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/DiskStats":                   role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/DiskUsage":                   role.MakeSet(role.Admin, role.Operator, role.Reader),
-	"/machine.MachineService/Dmesg":                       role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/Dmesg":                       role.MakeSet(role.Admin, role.Operator, role.Reader, role.Support),
 	"/machine.MachineService/EtcdAlarmList":               role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/EtcdAlarmDisarm":             role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/EtcdDefragment":              role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/EtcdForfeitLeadership":       role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdLeaveCluster":            role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdMemberList":              role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/EtcdPrecheck":                role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/EtcdRecover":                 role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdRemoveMemberByID":        role.MakeSet(role.Admin),
+	"/machine.MachineService/EtcdRotateCertificates":      role.MakeSet(role.Admin),
 	"/machine.MachineService/EtcdSnapshot":                role.MakeSet(role.Admin, role.Operator, role.EtcdBackup),
 	"/machine.MachineService/EtcdStatus":                  role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/Events":                      role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/GenerateClientConfiguration": role.MakeSet(role.Admin),
 	"/machine.MachineService/GenerateConfiguration":       role.MakeSet(role.Admin),
+	"/machine.MachineService/HardwareInventory":           role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/Hostname":                    role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/ImageList":                   role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/ImagePull":                   role.MakeSet(role.Admin, role.Operator),
+	"/machine.MachineService/ImageValidate":               role.MakeSet(role.Admin, role.Operator),
+	"/machine.MachineService/JoinTokenCreate":             role.MakeSet(role.Admin),
+	"/machine.MachineService/JoinTokenList":               role.MakeSet(role.Admin, role.Operator),
+	"/machine.MachineService/JoinTokenRevoke":             role.MakeSet(role.Admin),
 	"/machine.MachineService/Kubeconfig":                  role.MakeSet(role.Admin),
 	"/machine.MachineService/List":                        role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/LoadAvg":                     role.MakeSet(role.Admin, role.Operator, role.Reader),
-	"/machine.MachineService/Logs":                        role.MakeSet(role.Admin, role.Operator, role.Reader),
-	"/machine.MachineService/LogsContainers":              role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/Logs":                        role.MakeSet(role.Admin, role.Operator, role.Reader, role.Support),
+	"/machine.MachineService/LogsContainers":              role.MakeSet(role.Admin, role.Operator, role.Reader, role.Support),
 	"/machine.MachineService/Memory":                      role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/MemoryBenchmark":             role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/MetaWrite":                   role.MakeSet(role.Admin),
 	"/machine.MachineService/MetaDelete":                  role.MakeSet(role.Admin),
 	"/machine.MachineService/Mounts":                      role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/NetworkBenchmark":            role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/NetworkDeviceStats":          role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/Neighbors":                   role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/Netstat":                     role.MakeSet(role.Admin, role.Operator, role.Reader),
 	"/machine.MachineService/PacketCapture":               role.MakeSet(role.Admin, role.Operator),
+	"/machine.MachineService/PathMTU":                     role.MakeSet(role.Admin, role.Operator),
+	"/machine.MachineService/PowerActionCancel":           role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/Processes":                   role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/ProcessesStream":             role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/Profile":                     role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/Read":                        role.MakeSet(role.Admin),
 	"/machine.MachineService/Reboot":                      role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/Reset":                       role.MakeSet(role.Admin),
 	"/machine.MachineService/Restart":                     role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/Rollback":                    role.MakeSet(role.Admin),
-	"/machine.MachineService/ServiceList":                 role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/SensorStats":                 role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/ServiceGraph":                role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/ServiceList":                 role.MakeSet(role.Admin, role.Operator, role.Reader, role.Support),
 	"/machine.MachineService/ServiceRestart":              role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/ServiceStart":                role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/ServiceStop":                 role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/Shutdown":                    role.MakeSet(role.Admin, role.Operator),
+	"/machine.MachineService/StackDump":                   role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/Stats":                       role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/Strace":                      role.MakeSet(role.Admin),
 	"/machine.MachineService/SystemStat":                  role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/Trace":                       role.MakeSet(role.Admin, role.Operator),
 	"/machine.MachineService/Upgrade":                     role.MakeSet(role.Admin),
-	"/machine.MachineService/Version":                     role.MakeSet(role.Admin, role.Operator, role.Reader),
+	"/machine.MachineService/Version":                     role.MakeSet(role.Admin, role.Operator, role.Reader, role.Support),
 
 	// per-type authorization is handled by the service itself
 	"/cosi.resource.State/Create":  role.MakeSet(role.Admin),
@@ -103,10 +136,87 @@ var rules = map[string]role.Set{
 	"/time.TimeService/TimeCheck": role.MakeSet(role.Admin, role.Operator, role.Reader),
 }
 
+// Methods which are served while the node is going down, so that the clients can watch the progress
+// (or escalate, e.g. reset the node which is stuck rebooting).
+var drainExemptMethods = []string{
+	"/cosi.resource.State/Get",
+	"/cosi.resource.State/List",
+	"/cosi.resource.State/Watch",
+	"/machine.MachineService/Events",
+	"/machine.MachineService/Reboot",
+	"/machine.MachineService/Reset",
+	"/machine.MachineService/Shutdown",
+	"/machine.MachineService/Version",
+}
+
+const (
+	// drainGracePeriod is the time in-flight API streams have to finish once the node starts going down.
+	drainGracePeriod = 5 * time.Second
+
+	// drainRetryDelay is the delay suggested to the clients to retry the requests while the node is going down.
+	drainRetryDelay = time.Minute
+)
+
 type machinedService struct {
 	c runtime.Controller
 }
 
+// drainStatus returns the status returned to the API clients while the sequence is running,
+// or nil if the API is not drained for the sequence.
+func drainStatus(sequence string) *status.Status {
+	switch sequence {
+	case runtime.SequenceReboot.String():
+		return drain.Status(client.ReasonNodeRebooting, "node is rebooting", drainRetryDelay)
+	case runtime.SequenceShutdown.String():
+		return drain.Status(client.ReasonNodeShuttingDown, "node is shutting down", 0)
+	case runtime.SequenceUpgrade.String(), runtime.SequenceStageUpgrade.String(), runtime.SequenceMaintenanceUpgrade.String():
+		return drain.Status(client.ReasonNodeUpgrading, "node is upgrading", drainRetryDelay)
+	case runtime.SequenceReset.String():
+		return drain.Status(client.ReasonNodeResetting, "node is resetting", drainRetryDelay)
+	default:
+		return nil
+	}
+}
+
+// watchSequences drains the API once the node starts going down, and resumes serving the requests
+// if the sequence fails (so the node stays up).
+func (s *machinedService) watchSequences(ctx context.Context, r runtime.Runtime, drainer *drain.Drainer) error {
+	return r.Events().Watch(func(events <-chan runtime.EventInfo) {
+		var drainingSequence string
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+
+				sequenceEvent, ok := event.Payload.(*machine.SequenceEvent)
+				if !ok {
+					continue
+				}
+
+				switch sequenceEvent.Action { //nolint:exhaustive
+				case machine.SequenceEvent_START:
+					if st := drainStatus(sequenceEvent.Sequence); st != nil {
+						drainingSequence = sequenceEvent.Sequence
+
+						drainer.Drain(st)
+					}
+				case machine.SequenceEvent_STOP:
+					if sequenceEvent.Sequence == drainingSequence && sequenceEvent.Error != nil {
+						drainingSequence = ""
+
+						drainer.Resume()
+					}
+				}
+			}
+		}
+	})
+}
+
 // Main is an entrypoint to the API service.
 func (s *machinedService) Main(ctx context.Context, r runtime.Runtime, logWriter io.Writer) error {
 	injector := &authz.Injector{
@@ -123,15 +233,33 @@ func (s *machinedService) Main(ctx context.Context, r runtime.Runtime, logWriter
 		Logger:        log.New(logWriter, "machined/authz/authorizer ", log.Flags()).Printf,
 	}
 
+	drainer := &drain.Drainer{
+		GracePeriod: drainGracePeriod,
+		Exempt:      drainExemptMethods,
+	}
+
+	if err := s.watchSequences(ctx, r, drainer); err != nil {
+		return err
+	}
+
+	machineServer := &v1alpha1server.Server{
+		Controller: s.c,
+		// breaking the import loop cycle between services/ package and v1alpha1_server.go
+		EtcdBootstrapper: BootstrapEtcd,
+
+		ShutdownCtx: ctx,
+	}
+
+	// restore the reboot or shutdown scheduled before the reboot once META is loaded
+	go func() {
+		if err := machineServer.RestorePowerAction(ctx); err != nil && !errors.Is(err, context.Canceled) {
+			log.Printf("failed to restore the scheduled power action: %s", err)
+		}
+	}()
+
 	// Start the API server.
 	server := factory.NewServer( //nolint:contextcheck
-		&v1alpha1server.Server{
-			Controller: s.c,
-			// breaking the import loop cycle between services/ package and v1alpha1_server.go
-			EtcdBootstrapper: BootstrapEtcd,
-
-			ShutdownCtx: ctx,
-		},
+		machineServer,
 		factory.WithLog("machined ", logWriter),
 
 		factory.ServerOptions(
@@ -143,6 +271,9 @@ func (s *machinedService) Main(ctx context.Context, r runtime.Runtime, logWriter
 
 		factory.WithUnaryInterceptor(authorizer.UnaryInterceptor()),
 		factory.WithStreamInterceptor(authorizer.StreamInterceptor()), //nolint:contextcheck
+
+		factory.WithUnaryInterceptor(drainer.UnaryInterceptor()),
+		factory.WithStreamInterceptor(drainer.StreamInterceptor()), //nolint:contextcheck
 	)
 
 	// ensure socket dir exists
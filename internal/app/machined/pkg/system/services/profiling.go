@@ -0,0 +1,28 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services
+
+import (
+	"os"
+	"path/filepath"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+)
+
+// profilingMount prepares the directory for the pprof socket of the service owned by the service user,
+// and returns the mount for it.
+func profilingMount(socketPath string, uid int) (specs.Mount, error) {
+	dir := filepath.Dir(socketPath)
+
+	if err := os.MkdirAll(dir, 0o750); err != nil {
+		return specs.Mount{}, err
+	}
+
+	if err := os.Chown(dir, uid, uid); err != nil {
+		return specs.Mount{}, err
+	}
+
+	return specs.Mount{Type: "bind", Destination: dir, Source: dir, Options: []string{"rbind", "rw"}}, nil
+}
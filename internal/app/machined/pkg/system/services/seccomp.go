@@ -0,0 +1,143 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services
+
+import (
+	"slices"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+)
+
+// systemServiceDeniedSyscalls are the syscalls allowed by the default profile, but never used by Talos system services.
+//
+// The system services are Go programs, so they never use the SysV IPC and the POSIX message queues either.
+var systemServiceDeniedSyscalls = []string{
+	"add_key",
+	"bpf",
+	"io_uring_enter",
+	"io_uring_register",
+	"io_uring_setup",
+	"kcmp",
+	"keyctl",
+	"mq_getsetattr",
+	"mq_notify",
+	"mq_open",
+	"mq_timedreceive",
+	"mq_timedsend",
+	"mq_unlink",
+	"msgctl",
+	"msgget",
+	"msgrcv",
+	"msgsnd",
+	"perf_event_open",
+	"personality",
+	"process_vm_readv",
+	"process_vm_writev",
+	"ptrace",
+	"request_key",
+	"semctl",
+	"semget",
+	"semop",
+	"semtimedop",
+	"setns",
+	"shmat",
+	"shmctl",
+	"shmdt",
+	"shmget",
+	"unshare",
+	"userfaultfd",
+}
+
+// serviceDeniedSyscalls are the syscalls denied in addition to systemServiceDeniedSyscalls for each system service.
+//
+// apid and trustd run with a read-only root filesystem and don't keep any state on disk,
+// so they never create links or device nodes, and never resize files.
+// etcd preallocates and truncates its WAL files, but it doesn't create links or device nodes either.
+var serviceDeniedSyscalls = map[string][]string{
+	"apid": {
+		"fallocate",
+		"ftruncate",
+		"link",
+		"linkat",
+		"mknod",
+		"mknodat",
+		"symlink",
+		"symlinkat",
+		"truncate",
+	},
+	"trustd": {
+		"fallocate",
+		"ftruncate",
+		"link",
+		"linkat",
+		"mknod",
+		"mknodat",
+		"symlink",
+		"symlinkat",
+		"truncate",
+	},
+	"etcd": {
+		"link",
+		"linkat",
+		"mknod",
+		"mknodat",
+		"symlink",
+		"symlinkat",
+	},
+}
+
+// seccompAudit returns true if the system services seccomp profile should be in the audit mode.
+func seccompAudit(r runtime.Runtime) bool {
+	return r.Config() != nil && r.Config().Seccomp() != nil && r.Config().Seccomp().Audit()
+}
+
+// systemServiceSeccomp generates the seccomp profile for the Talos system service.
+//
+// The profile applies to the system services running in containers (apid, trustd and etcd),
+// the services running as plain processes (machined, containerd, udevd, dashboard) are not covered,
+// as seccomp filters can't be applied to them from the outside (and machined is the init process).
+//
+// In audit mode, syscalls not allowed by the profile are logged by the kernel instead of being denied.
+func systemServiceSeccomp(service string, audit bool) func(*specs.LinuxSeccomp) {
+	denied := slices.Concat(systemServiceDeniedSyscalls, serviceDeniedSyscalls[service])
+
+	return func(seccomp *specs.LinuxSeccomp) {
+		for i := range seccomp.Syscalls {
+			if seccomp.Syscalls[i].Action != specs.ActAllow {
+				continue
+			}
+
+			seccomp.Syscalls[i].Names = slices.DeleteFunc(seccomp.Syscalls[i].Names, func(name string) bool {
+				return slices.Contains(denied, name)
+			})
+		}
+
+		seccomp.Syscalls = slices.DeleteFunc(seccomp.Syscalls, func(syscall specs.LinuxSyscall) bool {
+			return len(syscall.Names) == 0
+		})
+
+		if audit {
+			seccomp.DefaultAction = specs.ActLog
+			seccomp.DefaultErrnoRet = nil
+		}
+	}
+}
+
+// allowVsockSockets allows creating the VM sockets (AF_VSOCK), which are denied by the default profile.
+func allowVsockSockets(seccomp *specs.LinuxSeccomp) {
+	seccomp.Syscalls = slices.DeleteFunc(seccomp.Syscalls, func(syscall specs.LinuxSyscall) bool {
+		return slices.Contains(syscall.Names, "socket") && slices.ContainsFunc(syscall.Args, func(arg specs.LinuxSeccompArg) bool {
+			return arg.Index == 0 && arg.Value == unix.AF_VSOCK
+		})
+	})
+
+	seccomp.Syscalls = append(seccomp.Syscalls, specs.LinuxSyscall{
+		Names:  []string{"socket"},
+		Action: specs.ActAllow,
+	})
+}
@@ -0,0 +1,128 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services_test
+
+import (
+	"testing"
+
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/services"
+)
+
+func testSeccompProfile() *specs.LinuxSeccomp {
+	errnoRet := uint(1)
+
+	return &specs.LinuxSeccomp{
+		DefaultAction:   specs.ActErrno,
+		DefaultErrnoRet: &errnoRet,
+		Syscalls: []specs.LinuxSyscall{
+			{
+				Names:  []string{"read", "write", "ptrace", "bpf", "ftruncate", "msgget"},
+				Action: specs.ActAllow,
+			},
+			{
+				Names:  []string{"keyctl"},
+				Action: specs.ActAllow,
+			},
+			{
+				Names:  []string{"unshare"},
+				Action: specs.ActErrno,
+			},
+		},
+	}
+}
+
+func TestSystemServiceSeccomp(t *testing.T) {
+	t.Parallel()
+
+	t.Run("enforcing", func(t *testing.T) {
+		t.Parallel()
+
+		profile := testSeccompProfile()
+		services.SystemServiceSeccomp("apid", false)(profile)
+
+		assert.Equal(t, specs.ActErrno, profile.DefaultAction)
+		assert.NotNil(t, profile.DefaultErrnoRet)
+		assert.Equal(t, []specs.LinuxSyscall{
+			{
+				Names:  []string{"read", "write"},
+				Action: specs.ActAllow,
+			},
+			{
+				Names:  []string{"unshare"},
+				Action: specs.ActErrno,
+			},
+		}, profile.Syscalls)
+	})
+
+	t.Run("per service", func(t *testing.T) {
+		t.Parallel()
+
+		profile := testSeccompProfile()
+		services.SystemServiceSeccomp("etcd", false)(profile)
+
+		// etcd truncates its WAL files
+		assert.Equal(t, []string{"read", "write", "ftruncate"}, profile.Syscalls[0].Names)
+	})
+
+	t.Run("audit", func(t *testing.T) {
+		t.Parallel()
+
+		profile := testSeccompProfile()
+		services.SystemServiceSeccomp("apid", true)(profile)
+
+		assert.Equal(t, specs.ActLog, profile.DefaultAction)
+		assert.Nil(t, profile.DefaultErrnoRet)
+		assert.Len(t, profile.Syscalls, 2)
+	})
+}
+
+func TestAllowVsockSockets(t *testing.T) {
+	t.Parallel()
+
+	errnoRet := uint(1)
+
+	profile := &specs.LinuxSeccomp{
+		DefaultAction:   specs.ActErrno,
+		DefaultErrnoRet: &errnoRet,
+		Syscalls: []specs.LinuxSyscall{
+			{
+				Names:  []string{"read", "write"},
+				Action: specs.ActAllow,
+			},
+			{
+				Names:    []string{"socket"},
+				Action:   specs.ActErrno,
+				ErrnoRet: &errnoRet,
+				Args: []specs.LinuxSeccompArg{
+					{Index: 0, Value: unix.AF_VSOCK, Op: specs.OpEqualTo},
+				},
+			},
+			{
+				Names:  []string{"socket"},
+				Action: specs.ActAllow,
+				Args: []specs.LinuxSeccompArg{
+					{Index: 0, Value: unix.AF_VSOCK, Op: specs.OpNotEqual},
+				},
+			},
+		},
+	}
+
+	services.AllowVsockSockets(profile)
+
+	assert.Equal(t, []specs.LinuxSyscall{
+		{
+			Names:  []string{"read", "write"},
+			Action: specs.ActAllow,
+		},
+		{
+			Names:  []string{"socket"},
+			Action: specs.ActAllow,
+		},
+	}, profile.Syscalls)
+}
@@ -21,6 +21,7 @@ import (
 	"github.com/cosi-project/runtime/pkg/state/protobuf/server"
 	specs "github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/siderolabs/go-debug"
+	eventsapi "github.com/siderolabs/siderolink/api/events"
 	"google.golang.org/grpc"
 
 	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
@@ -34,6 +35,7 @@ import (
 	"github.com/siderolabs/talos/pkg/conditions"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
 	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
 	timeresource "github.com/siderolabs/talos/pkg/machinery/resources/time"
 )
@@ -51,6 +53,11 @@ func (t *Trustd) ID(r runtime.Runtime) string {
 	return "trustd"
 }
 
+// Cgroup implements the CgroupService interface.
+func (t *Trustd) Cgroup(runtime.Runtime) string {
+	return constants.CgroupTrustd
+}
+
 // PreFunc implements the Service interface.
 //
 //nolint:gocyclo
@@ -66,6 +73,9 @@ func (t *Trustd) PreFunc(ctx context.Context, r runtime.Runtime) error {
 			switch {
 			case access.ResourceNamespace == secrets.NamespaceName && access.ResourceType == secrets.TrustdType && access.ResourceID == secrets.TrustdID:
 			case access.ResourceNamespace == secrets.NamespaceName && access.ResourceType == secrets.OSRootType && access.ResourceID == secrets.OSRootID:
+			case access.ResourceNamespace == secrets.NamespaceName && access.ResourceType == secrets.TrustdAttestationPolicyType && access.ResourceID == secrets.TrustdAttestationPolicyID:
+			case access.ResourceNamespace == secrets.NamespaceName && access.ResourceType == secrets.TrustdJoinTokenType:
+			case access.ResourceNamespace == secrets.NamespaceName && access.ResourceType == secrets.TrustdCSRPolicyType && access.ResourceID == secrets.TrustdCSRPolicyID:
 			default:
 				return errors.New("access denied")
 			}
@@ -103,6 +113,7 @@ func (t *Trustd) PreFunc(ctx context.Context, r runtime.Runtime) error {
 		grpc.SharedWriteBuffer(true),
 	)
 	v1alpha1.RegisterStateServer(t.runtimeServer, server.NewState(resources))
+	eventsapi.RegisterEventSinkServiceServer(t.runtimeServer, &trustdEventSink{publisher: r.Events()})
 
 	go t.runtimeServer.Serve(listener) //nolint:errcheck
 
@@ -121,6 +132,7 @@ func (t *Trustd) Condition(r runtime.Runtime) conditions.Condition {
 	return conditions.WaitForAll(
 		timeresource.NewSyncCondition(r.State().V1Alpha2().Resources()),
 		network.NewReadyCondition(r.State().V1Alpha2().Resources(), network.AddressReady, network.HostnameReady),
+		runtimeres.NewLSMStatusCondition(r.State().V1Alpha2().Resources()),
 	)
 }
 
@@ -142,11 +154,19 @@ func (t *Trustd) Runner(r runtime.Runtime) (runner.Runner, error) {
 		{Type: "bind", Destination: filepath.Dir(constants.TrustdRuntimeSocketPath), Source: filepath.Dir(constants.TrustdRuntimeSocketPath), Options: []string{"rbind", "ro"}},
 	}
 
-	env := environment.Get(r.Config())
-	env = append(env,
+	pprofMount, err := profilingMount(constants.TrustdProfilingSocketPath, constants.TrustdUserID)
+	if err != nil {
+		return nil, err
+	}
+
+	mounts = append(mounts, pprofMount)
+
+	env := []string{
 		constants.TcellMinimizeEnvironment,
-		"GOMEMLIMIT="+strconv.Itoa(constants.CgroupTrustdMaxMemory/5*4),
-	)
+		constants.GoTracebackCrashEnvironment,
+		"GOMEMLIMIT=" + strconv.Itoa(constants.CgroupTrustdMaxMemory/5*4),
+	}
+	env = append(env, environment.Get(r.Config())...)
 
 	if debug.RaceEnabled {
 		env = append(env, "GORACE=halt_on_error=1")
@@ -158,7 +178,7 @@ func (t *Trustd) Runner(r runtime.Runtime) (runner.Runner, error) {
 		runner.WithLoggingManager(r.Logging()),
 		runner.WithContainerdAddress(constants.SystemContainerdAddress),
 		runner.WithEnv(env),
-		runner.WithCgroupPath(constants.CgroupTrustd),
+		runner.WithCgroupPath(t.Cgroup(r)),
 		runner.WithOCISpecOpts(
 			oci.WithDroppedCapabilities(cap.Known()),
 			oci.WithHostNamespace(specs.NetworkNamespace),
@@ -166,8 +186,10 @@ func (t *Trustd) Runner(r runtime.Runtime) (runner.Runner, error) {
 			oci.WithRootFSPath(filepath.Join(constants.SystemLibexecPath, t.ID(r))),
 			oci.WithRootFSReadonly(),
 			oci.WithUser(fmt.Sprintf("%d:%d", constants.TrustdUserID, constants.TrustdUserID)),
+			oci.WithApparmorProfile(appArmorProfile(r)),
 		),
 		runner.WithOOMScoreAdj(-998),
+		runner.WithCustomSeccompProfile(systemServiceSeccomp(t.ID(r), seccompAudit(r))),
 	),
 		restart.WithType(restart.Forever),
 	), nil
@@ -0,0 +1,47 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services
+
+import (
+	"context"
+
+	eventsapi "github.com/siderolabs/siderolink/api/events"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+// trustdEventSink accepts events reported by trustd over the runtime socket and publishes them to the machined event stream.
+//
+// Only the event types trustd is expected to report are accepted.
+type trustdEventSink struct {
+	eventsapi.UnimplementedEventSinkServiceServer
+
+	publisher runtime.Publisher
+}
+
+// Publish implements eventsapi.EventSinkServiceServer interface.
+func (sink *trustdEventSink) Publish(ctx context.Context, req *eventsapi.EventRequest) (*eventsapi.EventResponse, error) {
+	if req.GetData() == nil {
+		return nil, status.Error(codes.InvalidArgument, "event data is missing")
+	}
+
+	msg, err := req.GetData().UnmarshalNew()
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to unmarshal event: %s", err)
+	}
+
+	switch msg.(type) {
+	case *machine.CSRRejectedEvent:
+	default:
+		return nil, status.Errorf(codes.PermissionDenied, "event type %q is not allowed", req.GetData().GetTypeUrl())
+	}
+
+	sink.publisher.Publish(ctx, msg)
+
+	return &eventsapi.EventResponse{}, nil
+}
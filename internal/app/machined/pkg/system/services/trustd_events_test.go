@@ -0,0 +1,64 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	eventsapi "github.com/siderolabs/siderolink/api/events"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/services"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+)
+
+type mockPublisher struct {
+	published []proto.Message
+}
+
+func (p *mockPublisher) Publish(_ context.Context, msg proto.Message) {
+	p.published = append(p.published, msg)
+}
+
+func TestTrustdEventSink(t *testing.T) {
+	t.Parallel()
+
+	publisher := &mockPublisher{}
+	sink := services.NewTrustdEventSink(publisher)
+
+	ctx := context.Background()
+
+	data, err := anypb.New(&machine.CSRRejectedEvent{
+		Peer:   "172.20.0.5:41000",
+		Reason: "DNS name \"foo\" is not allowed by the CSR policy",
+	})
+	require.NoError(t, err)
+
+	_, err = sink.Publish(ctx, &eventsapi.EventRequest{Data: data})
+	require.NoError(t, err)
+
+	require.Len(t, publisher.published, 1)
+	assert.Equal(t, "172.20.0.5:41000", publisher.published[0].(*machine.CSRRejectedEvent).GetPeer())
+
+	// trustd is not allowed to report other event types
+	data, err = anypb.New(&machine.PowerActionEvent{})
+	require.NoError(t, err)
+
+	_, err = sink.Publish(ctx, &eventsapi.EventRequest{Data: data})
+	require.Error(t, err)
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	_, err = sink.Publish(ctx, &eventsapi.EventRequest{})
+	require.Error(t, err)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	assert.Len(t, publisher.published, 1)
+}
@@ -38,6 +38,11 @@ func (c *Udevd) ID(r runtime.Runtime) string {
 	return "udevd"
 }
 
+// Cgroup implements the CgroupService interface.
+func (c *Udevd) Cgroup(runtime.Runtime) string {
+	return constants.CgroupUdevd
+}
+
 // PreFunc implements the Service interface.
 func (c *Udevd) PreFunc(ctx context.Context, r runtime.Runtime) error {
 	_, err := cmd.RunContext(
@@ -87,7 +92,7 @@ func (c *Udevd) Runner(r runtime.Runtime) (runner.Runner, error) {
 		debug,
 		args,
 		runner.WithLoggingManager(r.Logging()),
-		runner.WithCgroupPath(constants.CgroupUdevd),
+		runner.WithCgroupPath(c.Cgroup(r)),
 		runner.WithDroppedCapabilities(constants.UdevdDroppedCapabilities),
 		runner.WithEnv([]string{
 			// append a default value for XDG_RUNTIME_DIR for the services running on the host
@@ -0,0 +1,198 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	containerdapi "github.com/containerd/containerd/v2/client"
+	"github.com/containerd/containerd/v2/pkg/namespaces"
+	"github.com/containerd/containerd/v2/pkg/oci"
+	specs "github.com/opencontainers/runtime-spec/specs-go"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/runtime"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/events"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/runner"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/runner/containerd"
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/runner/restart"
+	"github.com/siderolabs/talos/internal/pkg/capability"
+	"github.com/siderolabs/talos/internal/pkg/containers/image"
+	"github.com/siderolabs/talos/internal/pkg/environment"
+	"github.com/siderolabs/talos/pkg/conditions"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	extservices "github.com/siderolabs/talos/pkg/machinery/extensions/services"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+	runtimeres "github.com/siderolabs/talos/pkg/machinery/resources/runtime"
+	timeresource "github.com/siderolabs/talos/pkg/machinery/resources/time"
+)
+
+var (
+	_ system.APIRestartableService = (*UserService)(nil)
+	_ system.CgroupService         = (*UserService)(nil)
+)
+
+// UserServiceIDPrefix is the prefix of the IDs of the user services.
+const UserServiceIDPrefix = "user-"
+
+// UserService implements the Service interface for the system services defined in the machine config.
+type UserService struct {
+	Config config.UserServiceConfig
+
+	imgRef      string
+	processArgs []string
+}
+
+// ID implements the Service interface.
+func (svc *UserService) ID(r runtime.Runtime) string {
+	return UserServiceIDPrefix + svc.Config.Name()
+}
+
+// Cgroup implements the CgroupService interface.
+func (svc *UserService) Cgroup(runtime.Runtime) string {
+	return filepath.Join(constants.CgroupUserServices, svc.Config.Name())
+}
+
+// PreFunc implements the Service interface.
+func (svc *UserService) PreFunc(ctx context.Context, r runtime.Runtime) error {
+	for _, mount := range svc.Config.Mounts() {
+		if mount.Type != "bind" {
+			continue
+		}
+
+		if _, err := os.Stat(mount.Source); err == nil {
+			// already exists, skip
+			continue
+		} else if !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+
+		if err := os.MkdirAll(mount.Source, 0o700); err != nil {
+			return err
+		}
+	}
+
+	client, err := containerdapi.New(constants.CRIContainerdAddress)
+	if err != nil {
+		return err
+	}
+	//nolint:errcheck
+	defer client.Close()
+
+	// Pull the image and unpack it.
+	containerdctx := namespaces.WithNamespace(ctx, constants.SystemContainerdNamespace)
+
+	img, err := image.Pull(containerdctx, r.Config().Machine().Registries(), client, svc.Config.Image(), image.WithSkipIfAlreadyPulled())
+	if err != nil {
+		return fmt.Errorf("failed to pull image %q: %w", svc.Config.Image(), err)
+	}
+
+	svc.imgRef = img.Target().Digest.String()
+
+	imageSpec, err := img.Spec(containerdctx)
+	if err != nil {
+		return fmt.Errorf("failed to read image %q config: %w", svc.Config.Image(), err)
+	}
+
+	svc.processArgs = processArgs(imageSpec.Config.Entrypoint, imageSpec.Config.Cmd, svc.Config.Command(), svc.Config.Args())
+
+	if len(svc.processArgs) == 0 {
+		return fmt.Errorf("no command specified for image %q", svc.Config.Image())
+	}
+
+	return nil
+}
+
+// processArgs merges the image entrypoint and command with the overrides the same way Kubernetes does.
+func processArgs(entrypoint, cmd, command, args []string) []string {
+	if len(command) > 0 {
+		return append(append([]string(nil), command...), args...)
+	}
+
+	if len(args) > 0 {
+		return append(append([]string(nil), entrypoint...), args...)
+	}
+
+	return append(append([]string(nil), entrypoint...), cmd...)
+}
+
+// PostFunc implements the Service interface.
+func (svc *UserService) PostFunc(r runtime.Runtime, state events.ServiceState) (err error) {
+	return nil
+}
+
+// Condition implements the Service interface.
+func (svc *UserService) Condition(r runtime.Runtime) conditions.Condition {
+	return conditions.WaitForAll(
+		timeresource.NewSyncCondition(r.State().V1Alpha2().Resources()),
+		network.NewReadyCondition(r.State().V1Alpha2().Resources(), network.AddressReady, network.HostnameReady, network.EtcFilesReady),
+		runtimeres.NewLSMStatusCondition(r.State().V1Alpha2().Resources()),
+	)
+}
+
+// DependsOn implements the Service interface.
+func (svc *UserService) DependsOn(r runtime.Runtime) []string {
+	return []string{"cri"}
+}
+
+// Runner implements the Service interface.
+func (svc *UserService) Runner(r runtime.Runtime) (runner.Runner, error) {
+	args := runner.Args{
+		ID:          svc.ID(r),
+		ProcessArgs: svc.processArgs,
+	}
+
+	var restartType restart.Type
+
+	switch svc.Config.Restart() {
+	case extservices.RestartAlways:
+		restartType = restart.Forever
+	case extservices.RestartNever:
+		restartType = restart.Once
+	case extservices.RestartUntilSuccess:
+		restartType = restart.UntilSuccess
+	}
+
+	return restart.New(containerd.NewRunner(
+		r.Config().Debug(),
+		&args,
+		runner.WithLoggingManager(r.Logging()),
+		runner.WithNamespace(constants.SystemContainerdNamespace),
+		runner.WithContainerImage(svc.imgRef),
+		runner.WithEnv(append(environment.Get(r.Config()), svc.Config.Environment()...)),
+		runner.WithCgroupPath(svc.Cgroup(r)),
+		runner.WithOCISpecOpts(
+			oci.WithMounts(svc.Config.Mounts()),
+			oci.WithHostNamespace(specs.NetworkNamespace),
+			oci.WithSelinuxLabel(""),
+			oci.WithApparmorProfile(appArmorProfile(r)),
+			oci.WithCapabilities(capability.AllGrantableCapabilities()),
+			oci.WithAllDevicesAllowed,
+		),
+		runner.WithOOMScoreAdj(-600),
+	),
+		restart.WithType(restartType),
+	), nil
+}
+
+// APIRestartAllowed implements APIRestartableService.
+func (svc *UserService) APIRestartAllowed(runtime.Runtime) bool {
+	return true
+}
+
+// APIStartAllowed implements APIStartableService.
+func (svc *UserService) APIStartAllowed(runtime.Runtime) bool {
+	return true
+}
+
+// APIStopAllowed implements APIStoppableService.
+func (svc *UserService) APIStopAllowed(runtime.Runtime) bool {
+	return true
+}
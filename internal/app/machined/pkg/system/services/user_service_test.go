@@ -0,0 +1,58 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package services_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/talos/internal/app/machined/pkg/system/services"
+)
+
+func TestUserServiceProcessArgs(t *testing.T) {
+	t.Parallel()
+
+	entrypoint := []string{"/bin/agent"}
+	cmd := []string{"--default"}
+
+	for _, test := range []struct {
+		name          string
+		command, args []string
+
+		expected []string
+	}{
+		{
+			name: "image defaults",
+
+			expected: []string{"/bin/agent", "--default"},
+		},
+		{
+			name: "args override",
+			args: []string{"--verbose"},
+
+			expected: []string{"/bin/agent", "--verbose"},
+		},
+		{
+			name:    "command override",
+			command: []string{"/bin/other"},
+
+			expected: []string{"/bin/other"},
+		},
+		{
+			name:    "command and args override",
+			command: []string{"/bin/other"},
+			args:    []string{"--verbose"},
+
+			expected: []string{"/bin/other", "--verbose"},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, test.expected, services.ProcessArgs(entrypoint, cmd, test.command, test.args))
+		})
+	}
+}
@@ -0,0 +1,179 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	stdx509 "crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+
+	v1alpha1server "github.com/siderolabs/talos/internal/app/machined/internal/server/v1alpha1"
+	"github.com/siderolabs/talos/pkg/grpc/factory"
+	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+const standaloneMode = "standalone"
+
+// standaloneMain runs machined in the standalone mode: the read-only inspection APIs are served
+// on a generic Linux host, which allows developing and testing talosctl features without booting Talos.
+//
+// The Talos PKI is generated on each start, and the talosconfig to access the API is written to the given path.
+func standaloneMain(args []string) error {
+	flags := flag.NewFlagSet("machined", flag.ContinueOnError)
+
+	mode := flags.String("mode", "", fmt.Sprintf("machined mode, only %q is supported when not running as PID 1", standaloneMode))
+	listenAddress := flags.String("listen-address", net.JoinHostPort("127.0.0.1", strconv.Itoa(constants.ApidPort)), "address to serve the API on")
+	talosconfigPath := flags.String("talosconfig", "talosconfig", "path to write the talosconfig to access the API")
+
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	if *mode != standaloneMode {
+		return fmt.Errorf("machined should run as PID 1, or with --mode %s", standaloneMode)
+	}
+
+	host, port, err := net.SplitHostPort(*listenAddress)
+	if err != nil {
+		return fmt.Errorf("error parsing listen address: %w", err)
+	}
+
+	endpoint := host
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		endpoint = "127.0.0.1"
+	}
+
+	tlsConfig, err := standalonePKI(net.JoinHostPort(endpoint, port), *talosconfigPath)
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	server := factory.NewServer(
+		&v1alpha1server.StandaloneServer{},
+		factory.WithDefaultLog(),
+		factory.ServerOptions(
+			grpc.Creds(credentials.NewTLS(tlsConfig)),
+			grpc.MaxRecvMsgSize(constants.GRPCMaxMessageSize),
+		),
+	)
+
+	listener, err := net.Listen("tcp", *listenAddress)
+	if err != nil {
+		return fmt.Errorf("error listening: %w", err)
+	}
+
+	log.Printf("serving the standalone API on %s, use talosctl --talosconfig %s", listener.Addr(), *talosconfigPath)
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- server.Serve(listener)
+	}()
+
+	select {
+	case err = <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer shutdownCancel()
+
+	factory.ServerGracefulStop(server, shutdownCtx)
+
+	if err = <-errCh; err != nil && !errors.Is(err, grpc.ErrServerStopped) {
+		return err
+	}
+
+	return nil
+}
+
+// standalonePKI generates the CA, server certificate, and the talosconfig with the admin client certificate.
+func standalonePKI(endpoint, talosconfigPath string) (*tls.Config, error) {
+	now := time.Now()
+
+	ca, err := secrets.NewTalosCA(now)
+	if err != nil {
+		return nil, fmt.Errorf("error generating CA: %w", err)
+	}
+
+	caCertAndKey := &x509.PEMEncodedCertificateAndKey{
+		Crt: ca.CrtPEM,
+		Key: ca.KeyPEM,
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return nil, err
+	}
+
+	ips := []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback}
+
+	if host, _, err := net.SplitHostPort(endpoint); err == nil {
+		if ip := net.ParseIP(host); ip != nil && !ip.IsLoopback() {
+			ips = append(ips, ip)
+		}
+	}
+
+	serverCert, err := x509.NewKeyPair(ca,
+		x509.IPAddresses(ips),
+		x509.DNSNames([]string{"localhost", hostname}),
+		x509.CommonName(hostname),
+		x509.NotAfter(now.Add(x509.DefaultCertificateValidityDuration)),
+		x509.KeyUsage(stdx509.KeyUsageDigitalSignature),
+		x509.ExtKeyUsage([]stdx509.ExtKeyUsage{
+			stdx509.ExtKeyUsageServerAuth,
+		}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error generating server certificate: %w", err)
+	}
+
+	adminCert, err := secrets.NewAdminCertificateAndKey(now, caCertAndKey, role.MakeSet(role.Admin), constants.TalosAPIDefaultCertificateValidityDuration)
+	if err != nil {
+		return nil, fmt.Errorf("error generating admin certificate: %w", err)
+	}
+
+	talosconfig := clientconfig.NewConfig(standaloneMode, []string{endpoint}, ca.CrtPEM, adminCert)
+
+	// there is no apid to proxy the requests, so the node is the endpoint itself
+	if host, _, err := net.SplitHostPort(endpoint); err == nil {
+		talosconfig.Contexts[standaloneMode].Nodes = []string{host}
+	}
+
+	if err = talosconfig.Save(talosconfigPath); err != nil {
+		return nil, fmt.Errorf("error writing talosconfig: %w", err)
+	}
+
+	clientCAs := stdx509.NewCertPool()
+	clientCAs.AddCert(ca.Crt)
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{*serverCert.Certificate},
+		ClientCAs:    clientCAs,
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		MinVersion:   tls.VersionTLS13,
+	}, nil
+}
@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package strace implements the syscall tracer helper process.
+//
+// The tracer runs as a separate process, as ptrace events are delivered via wait4(), and machined
+// reaps all its children.
+package strace
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/siderolabs/talos/internal/pkg/ptrace"
+)
+
+var (
+	pid         int
+	syscalls    string
+	minDuration time.Duration
+)
+
+// Main is the entrypoint into /sbin/strace.
+//
+// The traced syscalls are written to stdout as JSON-encoded ptrace.Event, one per line.
+func Main() {
+	flag.IntVar(&pid, "pid", 0, "process ID to trace")
+	flag.StringVar(&syscalls, "syscalls", "", "comma-separated list of syscalls to trace")
+	flag.DurationVar(&minDuration, "min-duration", 0, "skip syscalls completing faster")
+	flag.Parse()
+
+	log.SetPrefix("strace: ")
+	log.SetFlags(0)
+
+	ctx, cancel := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer cancel()
+
+	opts := ptrace.Options{
+		MinDuration: minDuration,
+	}
+
+	if syscalls != "" {
+		var err error
+
+		if opts.Syscalls, err = ptrace.ParseSyscalls(strings.Split(syscalls, ",")); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+
+	if err := ptrace.Trace(ctx, pid, opts, func(event ptrace.Event) error {
+		return encoder.Encode(event)
+	}); err != nil {
+		log.Fatal(err)
+	}
+}
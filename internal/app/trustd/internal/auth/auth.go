@@ -0,0 +1,148 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package auth implements trustd request authentication.
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	stdx509 "crypto/x509"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/pkg/jointoken"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// NodeCertificateRenewalWindow is the period since the issuance of the node certificate during which
+// it authenticates the certificate renewal requests, even if the certificate is already expired.
+//
+// Nodes renew the certificate while running, so the node which was powered off for a longer period
+// should join the cluster again with a valid join token (or the machine token).
+const NodeCertificateRenewalWindow = 30 * 24 * time.Hour
+
+// Authenticator authenticates the requests with the node certificate issued by trustd, the machine token, or a join token.
+type Authenticator struct {
+	Resources state.State
+}
+
+// Authenticate checks the TLS client certificate or the token in the request metadata.
+//
+// The join token is only used to join the cluster: once the node is issued the certificate,
+// it presents this certificate to renew it, so the join token can expire or be revoked.
+func (a *Authenticator) Authenticate(ctx context.Context) (context.Context, error) {
+	osRoot, err := safe.StateGetByID[*secrets.OSRoot](ctx, a.Resources, secrets.OSRootID)
+	if err != nil {
+		return nil, err
+	}
+
+	if verifyNodeCertificate(ctx, osRoot.TypedSpec(), time.Now()) {
+		return ctx, nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md["token"]) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "missing token")
+	}
+
+	token := md["token"][0]
+
+	if subtle.ConstantTimeCompare([]byte(token), []byte(osRoot.TypedSpec().Token)) == 1 {
+		return ctx, nil
+	}
+
+	id, secret, ok := jointoken.Parse(token)
+	if !ok {
+		return nil, status.Error(codes.Unauthenticated, codes.Unauthenticated.String())
+	}
+
+	joinToken, err := safe.StateGetByID[*secrets.TrustdJoinToken](ctx, a.Resources, id)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil, status.Error(codes.Unauthenticated, codes.Unauthenticated.String())
+		}
+
+		return nil, err
+	}
+
+	if !jointoken.VerifySecret(joinToken.TypedSpec().SecretHash, secret) {
+		return nil, status.Error(codes.Unauthenticated, codes.Unauthenticated.String())
+	}
+
+	if !joinToken.TypedSpec().Expires.After(time.Now()) {
+		return nil, status.Errorf(codes.Unauthenticated, "join token %q expired", id)
+	}
+
+	return ctx, nil
+}
+
+// verifyNodeCertificate verifies the TLS client certificate presented by the node.
+//
+// The node presents the server certificate issued to it by trustd (or generated by a control plane node),
+// so the certificate should be issued by the accepted CAs for the server authentication.
+func verifyNodeCertificate(ctx context.Context, osRoot *secrets.OSRootSpec, now time.Time) bool {
+	remotePeer, ok := peer.FromContext(ctx)
+	if !ok {
+		return false
+	}
+
+	tlsInfo, ok := remotePeer.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return false
+	}
+
+	cert := tlsInfo.State.PeerCertificates[0]
+
+	if now.Sub(cert.NotBefore) > NodeCertificateRenewalWindow {
+		return false
+	}
+
+	roots := stdx509.NewCertPool()
+
+	for _, ca := range osRoot.AcceptedCAs {
+		roots.AppendCertsFromPEM(ca.Crt)
+	}
+
+	intermediates := stdx509.NewCertPool()
+
+	for _, intermediate := range tlsInfo.State.PeerCertificates[1:] {
+		intermediates.AddCert(intermediate)
+	}
+
+	// the expired certificate is still accepted within the renewal window
+	currentTime := now
+	if currentTime.After(cert.NotAfter) {
+		currentTime = cert.NotAfter
+	}
+
+	_, err := cert.Verify(stdx509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   currentTime,
+		KeyUsages:     []stdx509.ExtKeyUsage{stdx509.ExtKeyUsageServerAuth},
+	})
+
+	return err == nil
+}
+
+// UnaryInterceptor returns the grpc.UnaryServerInterceptor which enforces the authentication.
+func (a *Authenticator) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := a.Authenticate(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,171 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package auth_test
+
+import (
+	"context"
+	"crypto/tls"
+	stdx509 "crypto/x509"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/internal/app/trustd/internal/auth"
+	"github.com/siderolabs/talos/internal/pkg/jointoken"
+	gensecrets "github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+func TestAuthenticate(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	osRoot := secrets.NewOSRoot(secrets.OSRootID)
+	osRoot.TypedSpec().Token = "machin.0123456789abcdef"
+	require.NoError(t, st.Create(ctx, osRoot))
+
+	valid := secrets.NewTrustdJoinToken("abcdef")
+	valid.TypedSpec().SecretHash = jointoken.HashSecret("0123456789abcdef")
+	valid.TypedSpec().Expires = time.Now().Add(time.Hour)
+	require.NoError(t, st.Create(ctx, valid))
+
+	expired := secrets.NewTrustdJoinToken("expird")
+	expired.TypedSpec().SecretHash = jointoken.HashSecret("0123456789abcdef")
+	expired.TypedSpec().Expires = time.Now().Add(-time.Hour)
+	require.NoError(t, st.Create(ctx, expired))
+
+	authenticator := &auth.Authenticator{Resources: st}
+
+	for _, test := range []struct {
+		token string
+		valid bool
+	}{
+		{token: "machin.0123456789abcdef", valid: true},
+		{token: "abcdef.0123456789abcdef", valid: true},
+		{token: "abcdef.0123456789abcdeg"},
+		{token: "expird.0123456789abcdef"},
+		{token: "unknwn.0123456789abcdef"},
+		{token: "garbage"},
+	} {
+		_, err := authenticator.Authenticate(metadata.NewIncomingContext(ctx, metadata.Pairs("token", test.token)))
+
+		if test.valid {
+			require.NoError(t, err, test.token)
+		} else {
+			assert.Equal(t, codes.Unauthenticated, status.Code(err), test.token)
+		}
+	}
+
+	_, err := authenticator.Authenticate(ctx)
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+}
+
+func TestAuthenticateNodeCertificate(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	now := time.Now()
+
+	ca, err := gensecrets.NewTalosCA(now.Add(-365 * 24 * time.Hour))
+	require.NoError(t, err)
+
+	otherCA, err := gensecrets.NewTalosCA(now.Add(-365 * 24 * time.Hour))
+	require.NoError(t, err)
+
+	osRoot := secrets.NewOSRoot(secrets.OSRootID)
+	osRoot.TypedSpec().Token = "machin.0123456789abcdef"
+	osRoot.TypedSpec().AcceptedCAs = []*x509.PEMEncodedCertificate{{Crt: ca.CrtPEM}}
+	require.NoError(t, st.Create(ctx, osRoot))
+
+	authenticator := &auth.Authenticator{Resources: st}
+
+	for _, test := range []struct {
+		name      string
+		ca        *x509.CertificateAuthority
+		issued    time.Time
+		extUsages []stdx509.ExtKeyUsage
+		valid     bool
+	}{
+		{
+			name:      "valid",
+			ca:        ca,
+			issued:    now.Add(-time.Hour),
+			extUsages: []stdx509.ExtKeyUsage{stdx509.ExtKeyUsageServerAuth},
+			valid:     true,
+		},
+		{
+			name:      "expired within renewal window",
+			ca:        ca,
+			issued:    now.Add(-7 * 24 * time.Hour),
+			extUsages: []stdx509.ExtKeyUsage{stdx509.ExtKeyUsageServerAuth},
+			valid:     true,
+		},
+		{
+			name:      "expired out of renewal window",
+			ca:        ca,
+			issued:    now.Add(-auth.NodeCertificateRenewalWindow - time.Hour),
+			extUsages: []stdx509.ExtKeyUsage{stdx509.ExtKeyUsageServerAuth},
+		},
+		{
+			name:      "other CA",
+			ca:        otherCA,
+			issued:    now.Add(-time.Hour),
+			extUsages: []stdx509.ExtKeyUsage{stdx509.ExtKeyUsageServerAuth},
+		},
+		{
+			name:      "client certificate",
+			ca:        ca,
+			issued:    now.Add(-time.Hour),
+			extUsages: []stdx509.ExtKeyUsage{stdx509.ExtKeyUsageClientAuth},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			keyPair, err := x509.NewKeyPair(test.ca,
+				x509.CommonName("talos-default-worker-1"),
+				x509.NotBefore(test.issued),
+				x509.NotAfter(test.issued.Add(24*time.Hour)),
+				x509.ExtKeyUsage(test.extUsages),
+			)
+			require.NoError(t, err)
+
+			cert, err := stdx509.ParseCertificate(keyPair.Certificate.Certificate[0])
+			require.NoError(t, err)
+
+			peerCtx := peer.NewContext(ctx, &peer.Peer{
+				AuthInfo: credentials.TLSInfo{
+					State: tls.ConnectionState{
+						PeerCertificates: []*stdx509.Certificate{cert},
+					},
+				},
+			})
+
+			_, err = authenticator.Authenticate(peerCtx)
+			if test.valid {
+				require.NoError(t, err)
+			} else {
+				assert.Equal(t, codes.Unauthenticated, status.Code(err))
+			}
+		})
+	}
+}
@@ -109,11 +109,20 @@ func (tlsConfig *TLSConfig) ServerConfig() (*stdlibtls.Config, error) {
 		return nil, fmt.Errorf("failed to get root CA: %w", err)
 	}
 
-	return tls.New(
+	cfg, err := tls.New(
 		tls.WithClientAuthType(tls.ServerOnly),
 		tls.WithCACertPEM(ca),
 		tls.WithServerCertificateProvider(tlsConfig.certificateProvider),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	// the client certificate is optional, the nodes present the certificate issued by trustd to renew it,
+	// and it is verified by the authenticator
+	cfg.ClientAuth = stdlibtls.RequestClientCert
+
+	return cfg, nil
 }
 
 type certificateProvider struct {
@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package reg
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/talos/internal/pkg/secureboot/tpm2"
+	securityapi "github.com/siderolabs/talos/pkg/machinery/api/security"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// verifyAttestation verifies the TPM attestation of the certificate request against the attestation policy.
+//
+// If the attestation is not required, verifyAttestation only verifies the attestation if it was provided.
+// The quote is trusted only if it is signed by one of the enrolled attestation keys, as the attestation key
+// is sent by the node itself, and it is qualified with the nonce issued by trustd and the CSR, so it can't be replayed.
+func (r *Registrator) verifyAttestation(ctx context.Context, in *securityapi.CertificateRequest) (attested bool, err error) {
+	policy, err := safe.StateGetByID[*secrets.TrustdAttestationPolicy](ctx, r.Resources, secrets.TrustdAttestationPolicyID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return false, err
+	}
+
+	required := policy != nil && policy.TypedSpec().Required
+
+	if in.Attestation == nil {
+		if required {
+			return false, errors.New("TPM attestation is required")
+		}
+
+		return false, nil
+	}
+
+	if policy == nil || len(policy.TypedSpec().AttestationKeys) == 0 {
+		if required {
+			return false, errors.New("TPM attestation is required, but no attestation keys are enrolled")
+		}
+
+		// there is nothing to anchor the attestation key to, so the quote proves nothing
+		return false, nil
+	}
+
+	if fingerprint := tpm2.AttestationKeyFingerprint(in.Attestation.AttestationKey); !slices.Contains(policy.TypedSpec().AttestationKeys, fingerprint) {
+		return false, fmt.Errorf("TPM attestation key %s is not enrolled", fingerprint)
+	}
+
+	if len(in.Attestation.Nonce) == 0 {
+		return false, errors.New("TPM attestation nonce is missing")
+	}
+
+	if err = r.verifyNonce(ctx, in.Attestation.Nonce); err != nil {
+		return false, err
+	}
+
+	quote := &tpm2.QuoteResponse{
+		AttestationKeyPublic: in.Attestation.AttestationKey,
+		Quote:                in.Attestation.Quote,
+		Signature:            in.Attestation.Signature,
+		PCRValues:            make(map[int][]byte, len(in.Attestation.PcrValues)),
+	}
+
+	for pcr, value := range in.Attestation.PcrValues {
+		quote.PCRValues[int(pcr)] = value
+	}
+
+	if err = tpm2.VerifyQuote(quote, tpm2.AttestationQualifyingData(in.Attestation.Nonce, in.Csr)); err != nil {
+		return false, fmt.Errorf("TPM attestation verification failed: %w", err)
+	}
+
+	// VerifyQuote rejects the PCR values which are not quoted, so all values are covered by the quote
+	for _, pcrPolicy := range policy.TypedSpec().PCRs {
+		value, ok := quote.PCRValues[pcrPolicy.Index]
+		if !ok {
+			return false, fmt.Errorf("PCR %d is not quoted by the TPM attestation", pcrPolicy.Index)
+		}
+
+		if !slices.Contains(pcrPolicy.Values, hex.EncodeToString(value)) {
+			return false, fmt.Errorf("PCR %d value %x is not allowed", pcrPolicy.Index, value)
+		}
+	}
+
+	return true, nil
+}
@@ -0,0 +1,87 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package reg
+
+import (
+	"context"
+	stdx509 "crypto/x509"
+	"fmt"
+	"net/netip"
+	"path"
+	"slices"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// verifyCSRPolicy verifies the identities requested in the certificate request against the CSR policy.
+//
+// URI and email SANs are never allowed, as Talos nodes don't request them.
+// The common name is verified as an IP address or a DNS name (if it parses as an IP address).
+// If there is no policy, or some part of the policy is not set, any IP addresses and DNS names are allowed.
+//
+//nolint:gocyclo
+func (r *Registrator) verifyCSRPolicy(ctx context.Context, request *stdx509.CertificateRequest) error {
+	if len(request.URIs) > 0 {
+		return fmt.Errorf("URI SANs are not allowed: %s", request.URIs)
+	}
+
+	if len(request.EmailAddresses) > 0 {
+		return fmt.Errorf("email SANs are not allowed: %s", request.EmailAddresses)
+	}
+
+	policy, err := safe.StateGetByID[*secrets.TrustdCSRPolicy](ctx, r.Resources, secrets.TrustdCSRPolicyID)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	ips := slices.Clone(request.IPAddresses)
+	dnsNames := slices.Clone(request.DNSNames)
+
+	if commonName := request.Subject.CommonName; commonName != "" {
+		if addr, parseErr := netip.ParseAddr(commonName); parseErr == nil {
+			ips = append(ips, addr.AsSlice())
+		} else {
+			dnsNames = append(dnsNames, commonName)
+		}
+	}
+
+	if allowedIPs := policy.TypedSpec().AllowedIPs; len(allowedIPs) > 0 {
+		for _, ip := range ips {
+			addr, ok := netip.AddrFromSlice(ip)
+			if !ok {
+				return fmt.Errorf("IP address %s is not valid", ip)
+			}
+
+			addr = addr.Unmap()
+
+			if !slices.ContainsFunc(allowedIPs, func(prefix netip.Prefix) bool {
+				return prefix.Contains(addr)
+			}) {
+				return fmt.Errorf("IP address %s is not allowed by the CSR policy", addr)
+			}
+		}
+	}
+
+	if allowedDNSNames := policy.TypedSpec().AllowedDNSNames; len(allowedDNSNames) > 0 {
+		for _, name := range dnsNames {
+			if !slices.ContainsFunc(allowedDNSNames, func(pattern string) bool {
+				matched, _ := path.Match(pattern, name) //nolint:errcheck // patterns are validated in the machine config
+
+				return matched
+			}) {
+				return fmt.Errorf("DNS name %q is not allowed by the CSR policy", name)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package reg
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+
+	securityapi "github.com/siderolabs/talos/pkg/machinery/api/security"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// attestationNonceTTL is the validity period of the attestation nonce.
+const attestationNonceTTL = 5 * time.Minute
+
+const (
+	nonceTimestampSize = 8
+	nonceRandomSize    = 16
+	nonceSize          = nonceTimestampSize + nonceRandomSize + sha256.Size
+)
+
+// AttestationNonce implements the securityapi.SecurityServer interface.
+//
+// The nonce is the issue time and a random value authenticated with the key derived from the issuing CA key,
+// so that it can be verified by trustd running on any control plane node without sharing any state.
+func (r *Registrator) AttestationNonce(ctx context.Context, _ *securityapi.AttestationNonceRequest) (*securityapi.AttestationNonceResponse, error) {
+	key, err := r.nonceKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, err := issueNonce(key, time.Now())
+	if err != nil {
+		return nil, err
+	}
+
+	return &securityapi.AttestationNonceResponse{
+		Nonce: nonce,
+	}, nil
+}
+
+// verifyNonce verifies that the nonce was issued by trustd, is not expired, and was not used before.
+//
+// The nonce is tracked as used only by this trustd instance, the validity period limits the replay
+// through the other control plane nodes.
+func (r *Registrator) verifyNonce(ctx context.Context, nonce []byte) error {
+	key, err := r.nonceKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	if err = checkNonce(key, nonce, now); err != nil {
+		return err
+	}
+
+	r.nonceMu.Lock()
+	defer r.nonceMu.Unlock()
+
+	for usedNonce, expires := range r.usedNonces {
+		if now.After(expires) {
+			delete(r.usedNonces, usedNonce)
+		}
+	}
+
+	if _, used := r.usedNonces[string(nonce)]; used {
+		return errors.New("attestation nonce was already used")
+	}
+
+	if r.usedNonces == nil {
+		r.usedNonces = map[string]time.Time{}
+	}
+
+	r.usedNonces[string(nonce)] = now.Add(attestationNonceTTL)
+
+	return nil
+}
+
+// nonceKey derives the nonce authentication key from the issuing CA key.
+func (r *Registrator) nonceKey(ctx context.Context) ([]byte, error) {
+	osRoot, err := safe.StateGetByID[*secrets.OSRoot](ctx, r.Resources, secrets.OSRootID)
+	if err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha256.New, osRoot.TypedSpec().IssuingCA.Key)
+	mac.Write([]byte("talos attestation nonce"))
+
+	return mac.Sum(nil), nil
+}
+
+func issueNonce(key []byte, now time.Time) ([]byte, error) {
+	nonce := make([]byte, nonceTimestampSize+nonceRandomSize, nonceSize)
+
+	binary.BigEndian.PutUint64(nonce, uint64(now.Unix()))
+
+	if _, err := rand.Read(nonce[nonceTimestampSize:]); err != nil {
+		return nil, err
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write(nonce)
+
+	return mac.Sum(nonce), nil
+}
+
+func checkNonce(key, nonce []byte, now time.Time) error {
+	if len(nonce) != nonceSize {
+		return errors.New("attestation nonce is invalid")
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write(nonce[:nonceTimestampSize+nonceRandomSize])
+
+	if !hmac.Equal(mac.Sum(nil), nonce[nonceTimestampSize+nonceRandomSize:]) {
+		return errors.New("attestation nonce is invalid")
+	}
+
+	issued := time.Unix(int64(binary.BigEndian.Uint64(nonce)), 0)
+
+	if now.Sub(issued) > attestationNonceTTL || issued.Sub(now) > time.Minute {
+		return errors.New("attestation nonce is expired")
+	}
+
+	return nil
+}
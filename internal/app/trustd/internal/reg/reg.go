@@ -11,17 +11,23 @@ import (
 	"crypto/x509/pkix"
 	"encoding/pem"
 	"log"
+	"net"
+	"sync"
+	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/gen/xslices"
+	eventsapi "github.com/siderolabs/siderolink/api/events"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/anypb"
 
+	machineapi "github.com/siderolabs/talos/pkg/machinery/api/machine"
 	securityapi "github.com/siderolabs/talos/pkg/machinery/api/security"
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
 )
@@ -32,6 +38,12 @@ type Registrator struct {
 	securityapi.UnimplementedSecurityServiceServer
 
 	Resources state.State
+
+	// Events is used to report rejected certificate requests, optional.
+	Events eventsapi.EventSinkServiceClient
+
+	nonceMu    sync.Mutex
+	usedNonces map[string]time.Time
 }
 
 // Register implements the factory.Registrator interface.
@@ -69,6 +81,19 @@ func (r *Registrator) Certificate(ctx context.Context, in *securityapi.Certifica
 
 	log.Printf("received CSR signing request from %s: subject %s dns names %s addresses %s", remotePeer.Addr, request.Subject, request.DNSNames, request.IPAddresses)
 
+	attested, err := r.verifyAttestation(ctx, in)
+	if err != nil {
+		return nil, r.reject(ctx, remotePeer, request, err)
+	}
+
+	if attested {
+		log.Printf("CSR signing request from %s passed TPM attestation", remotePeer.Addr)
+	}
+
+	if err = r.verifyCSRPolicy(ctx, request); err != nil {
+		return nil, r.reject(ctx, remotePeer, request, err)
+	}
+
 	// allow only server auth certificates
 	x509Opts := []x509.Option{
 		x509.KeyUsage(stdx509.KeyUsageDigitalSignature),
@@ -116,3 +141,28 @@ func (r *Registrator) Certificate(ctx context.Context, in *securityapi.Certifica
 
 	return resp, nil
 }
+
+// reject logs and reports the rejected certificate request, and returns the error to the client.
+func (r *Registrator) reject(ctx context.Context, remotePeer *peer.Peer, request *stdx509.CertificateRequest, reason error) error {
+	log.Printf("rejecting CSR signing request from %s: %s", remotePeer.Addr, reason)
+
+	if r.Events != nil {
+		event, err := anypb.New(&machineapi.CSRRejectedEvent{
+			Peer:        remotePeer.Addr.String(),
+			DnsNames:    request.DNSNames,
+			IpAddresses: xslices.Map(request.IPAddresses, net.IP.String),
+			Reason:      reason.Error(),
+		})
+		if err == nil {
+			_, err = r.Events.Publish(ctx, &eventsapi.EventRequest{
+				Data: event,
+			})
+		}
+
+		if err != nil {
+			log.Printf("failed to report rejected CSR signing request: %s", err)
+		}
+	}
+
+	return status.Error(codes.PermissionDenied, reason.Error())
+}
@@ -6,9 +6,13 @@ package reg_test
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
 	stdx509 "crypto/x509"
+	"encoding/pem"
 	"net"
 	"net/netip"
+	"net/url"
 	"testing"
 	"time"
 
@@ -16,11 +20,17 @@ import (
 	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
 	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
 	"github.com/siderolabs/crypto/x509"
+	eventsapi "github.com/siderolabs/siderolink/api/events"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
 
 	"github.com/siderolabs/talos/internal/app/trustd/internal/reg"
+	"github.com/siderolabs/talos/internal/pkg/secureboot/tpm2"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
 	"github.com/siderolabs/talos/pkg/machinery/api/security"
 	gensecrets "github.com/siderolabs/talos/pkg/machinery/config/generate/secrets"
 	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
@@ -55,8 +65,11 @@ func TestCertificate(t *testing.T) {
 		},
 	})
 
+	events := &mockEventSink{}
+
 	r := &reg.Registrator{
 		Resources: resources,
+		Events:    events,
 	}
 
 	for _, tt := range []struct {
@@ -101,4 +114,195 @@ func TestCertificate(t *testing.T) {
 			assert.Equal(t, []string(nil), cert.Subject.Organization)
 		})
 	}
+
+	t.Run("attestation required", func(t *testing.T) {
+		policy := secrets.NewTrustdAttestationPolicy()
+		policy.TypedSpec().Required = true
+		policy.TypedSpec().AttestationKeys = []string{tpm2.AttestationKeyFingerprint([]byte("enrolled"))}
+		require.NoError(t, resources.Create(ctx, policy))
+
+		serverCSR, _, err := x509.NewEd25519CSRAndIdentity(x509.CommonName("talos-default-worker-1"))
+		require.NoError(t, err)
+
+		_, err = r.Certificate(ctx, &security.CertificateRequest{
+			Csr: serverCSR.X509CertificateRequestPEM,
+		})
+		require.Error(t, err)
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		_, err = r.Certificate(ctx, &security.CertificateRequest{
+			Csr: serverCSR.X509CertificateRequestPEM,
+			Attestation: &security.TPMAttestation{
+				AttestationKey: []byte("enrolled"),
+				Quote:          []byte("invalid"),
+			},
+		})
+		require.Error(t, err)
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), "nonce is missing")
+
+		_, err = r.Certificate(ctx, &security.CertificateRequest{
+			Csr: serverCSR.X509CertificateRequestPEM,
+			Attestation: &security.TPMAttestation{
+				AttestationKey: []byte("enrolled"),
+				Quote:          []byte("invalid"),
+				Nonce:          []byte("forged"),
+			},
+		})
+		require.Error(t, err)
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), "nonce is invalid")
+
+		nonce, err := r.AttestationNonce(ctx, &security.AttestationNonceRequest{})
+		require.NoError(t, err)
+
+		for _, expected := range []string{"verification failed", "nonce was already used"} {
+			_, err = r.Certificate(ctx, &security.CertificateRequest{
+				Csr: serverCSR.X509CertificateRequestPEM,
+				Attestation: &security.TPMAttestation{
+					AttestationKey: []byte("enrolled"),
+					Quote:          []byte("invalid"),
+					Nonce:          nonce.Nonce,
+				},
+			})
+			require.Error(t, err)
+
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+			assert.Contains(t, status.Convert(err).Message(), expected)
+		}
+
+		_, err = r.Certificate(ctx, &security.CertificateRequest{
+			Csr: serverCSR.X509CertificateRequestPEM,
+			Attestation: &security.TPMAttestation{
+				AttestationKey: []byte("unknown"),
+			},
+		})
+		require.Error(t, err)
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Contains(t, status.Convert(err).Message(), "is not enrolled")
+	})
+
+	require.NoError(t, resources.Destroy(ctx, secrets.NewTrustdAttestationPolicy().Metadata()))
+
+	t.Run("csr policy", func(t *testing.T) {
+		policy := secrets.NewTrustdCSRPolicy()
+		policy.TypedSpec().AllowedIPs = []netip.Prefix{netip.MustParsePrefix("10.5.0.0/24")}
+		policy.TypedSpec().AllowedDNSNames = []string{"talos-default-worker-*"}
+		require.NoError(t, resources.Create(ctx, policy))
+
+		for _, tt := range []struct {
+			name       string
+			csrSetters []x509.Option
+
+			expectedReason string
+		}{
+			{
+				name: "allowed",
+				csrSetters: []x509.Option{
+					x509.IPAddresses([]net.IP{netip.MustParseAddr("10.5.0.4").AsSlice()}),
+					x509.DNSNames([]string{"talos-default-worker-1"}),
+				},
+			},
+			{
+				name: "ip not allowed",
+				csrSetters: []x509.Option{
+					x509.IPAddresses([]net.IP{netip.MustParseAddr("10.5.0.4").AsSlice(), netip.MustParseAddr("172.20.0.2").AsSlice()}),
+					x509.DNSNames([]string{"talos-default-worker-1"}),
+				},
+				expectedReason: "IP address 172.20.0.2 is not allowed by the CSR policy",
+			},
+			{
+				name: "dns name not allowed",
+				csrSetters: []x509.Option{
+					x509.IPAddresses([]net.IP{netip.MustParseAddr("10.5.0.4").AsSlice()}),
+					x509.DNSNames([]string{"talos-default-controlplane-1"}),
+				},
+				expectedReason: "DNS name \"talos-default-controlplane-1\" is not allowed by the CSR policy",
+			},
+			{
+				name: "common name not allowed",
+				csrSetters: []x509.Option{
+					x509.CommonName("talos-default-controlplane-1"),
+					x509.IPAddresses([]net.IP{netip.MustParseAddr("10.5.0.4").AsSlice()}),
+					x509.DNSNames([]string{"talos-default-worker-1"}),
+				},
+				expectedReason: "DNS name \"talos-default-controlplane-1\" is not allowed by the CSR policy",
+			},
+			{
+				name: "common name ip not allowed",
+				csrSetters: []x509.Option{
+					x509.CommonName("172.20.0.2"),
+					x509.IPAddresses([]net.IP{netip.MustParseAddr("10.5.0.4").AsSlice()}),
+				},
+				expectedReason: "IP address 172.20.0.2 is not allowed by the CSR policy",
+			},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				events.requests = nil
+
+				serverCSR, _, err := x509.NewEd25519CSRAndIdentity(tt.csrSetters...)
+				require.NoError(t, err)
+
+				_, err = r.Certificate(ctx, &security.CertificateRequest{
+					Csr: serverCSR.X509CertificateRequestPEM,
+				})
+
+				if tt.expectedReason == "" {
+					require.NoError(t, err)
+					assert.Empty(t, events.requests)
+
+					return
+				}
+
+				require.Error(t, err)
+				assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+				require.Len(t, events.requests, 1)
+
+				var event machine.CSRRejectedEvent
+
+				require.NoError(t, events.requests[0].GetData().UnmarshalTo(&event))
+
+				assert.Equal(t, "127.0.0.1:30000", event.GetPeer())
+				assert.Equal(t, tt.expectedReason, event.GetReason())
+			})
+		}
+	})
+
+	t.Run("uri and email sans", func(t *testing.T) {
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		for _, template := range []*stdx509.CertificateRequest{
+			{
+				URIs: []*url.URL{{Scheme: "spiffe", Host: "cluster.local", Path: "/ns/kube-system/sa/default"}},
+			},
+			{
+				EmailAddresses: []string{"admin@example.com"},
+			},
+		} {
+			csr, err := stdx509.CreateCertificateRequest(rand.Reader, template, key)
+			require.NoError(t, err)
+
+			_, err = r.Certificate(ctx, &security.CertificateRequest{
+				Csr: pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr}),
+			})
+			require.Error(t, err)
+			assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		}
+	})
+}
+
+type mockEventSink struct {
+	requests []*eventsapi.EventRequest
+}
+
+func (sink *mockEventSink) Publish(_ context.Context, req *eventsapi.EventRequest, _ ...grpc.CallOption) (*eventsapi.EventResponse, error) {
+	sink.requests = append(sink.requests, req)
+
+	return &eventsapi.EventResponse{}, nil
 }
@@ -15,22 +15,21 @@ import (
 	"time"
 
 	"github.com/cosi-project/runtime/api/v1alpha1"
-	"github.com/cosi-project/runtime/pkg/resource"
-	"github.com/cosi-project/runtime/pkg/safe"
 	"github.com/cosi-project/runtime/pkg/state"
 	"github.com/cosi-project/runtime/pkg/state/protobuf/client"
 	debug "github.com/siderolabs/go-debug"
+	eventsapi "github.com/siderolabs/siderolink/api/events"
 	"golang.org/x/sync/errgroup"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
 
+	"github.com/siderolabs/talos/internal/app/trustd/internal/auth"
 	"github.com/siderolabs/talos/internal/app/trustd/internal/provider"
 	"github.com/siderolabs/talos/internal/app/trustd/internal/reg"
+	"github.com/siderolabs/talos/internal/pkg/profiling"
 	"github.com/siderolabs/talos/pkg/grpc/factory"
-	"github.com/siderolabs/talos/pkg/grpc/middleware/auth/basic"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
-	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
 	"github.com/siderolabs/talos/pkg/startup"
 )
 
@@ -46,6 +45,12 @@ func runDebugServer(ctx context.Context) {
 	}
 }
 
+func runProfilingServer(ctx context.Context) {
+	if err := profiling.Serve(ctx, constants.TrustdProfilingSocketPath); err != nil {
+		log.Printf("failed to serve profiles: %s", err)
+	}
+}
+
 // Main is the entrypoint into trustd.
 func Main() {
 	if err := trustdMain(); err != nil {
@@ -62,6 +67,7 @@ func trustdMain() error {
 	flag.Parse()
 
 	go runDebugServer(ctx)
+	go runProfilingServer(ctx)
 
 	startup.LimitMaxProcs(constants.TrustdMaxProcs)
 
@@ -89,7 +95,7 @@ func trustdMain() error {
 		return fmt.Errorf("failed to create OS-level TLS configuration: %w", err)
 	}
 
-	creds := basic.NewTokenCredentialsDynamic(tokenGetter(resources))
+	authenticator := &auth.Authenticator{Resources: resources}
 
 	networkListener, err := factory.NewListener(
 		factory.Port(constants.TrustdPort),
@@ -99,9 +105,12 @@ func trustdMain() error {
 	}
 
 	networkServer := factory.NewServer(
-		&reg.Registrator{Resources: resources},
+		&reg.Registrator{
+			Resources: resources,
+			Events:    eventsapi.NewEventSinkServiceClient(runtimeConn),
+		},
 		factory.WithDefaultLog(),
-		factory.WithUnaryInterceptor(creds.UnaryInterceptor()),
+		factory.WithUnaryInterceptor(authenticator.UnaryInterceptor()),
 		factory.ServerOptions(
 			grpc.Creds(
 				credentials.NewTLS(serverTLSConfig),
@@ -132,14 +141,3 @@ func trustdMain() error {
 
 	return errGroup.Wait()
 }
-
-func tokenGetter(state state.State) basic.TokenGetterFunc {
-	return func(ctx context.Context) (string, error) {
-		osRoot, err := safe.StateGet[*secrets.OSRoot](ctx, state, resource.NewMetadata(secrets.NamespaceName, secrets.OSRootType, secrets.OSRootID, resource.VersionUndefined))
-		if err != nil {
-			return "", err
-		}
-
-		return osRoot.TypedSpec().Token, nil
-	}
-}
@@ -0,0 +1,77 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package benchmark implements micro-benchmarks of the node disk, memory and network.
+package benchmark
+
+import (
+	"slices"
+	"sync"
+	"time"
+)
+
+// Result of a single benchmark test.
+type Result struct {
+	Name       string
+	Bytes      uint64
+	Operations uint64
+	Elapsed    time.Duration
+
+	// LatencyAvg and LatencyP99 are only set for the tests measuring each operation.
+	LatencyAvg time.Duration
+	LatencyP99 time.Duration
+}
+
+// BytesPerSecond returns the throughput of the test.
+func (r Result) BytesPerSecond() float64 {
+	if r.Elapsed <= 0 {
+		return 0
+	}
+
+	return float64(r.Bytes) / r.Elapsed.Seconds()
+}
+
+// OperationsPerSecond returns the operation rate of the test.
+func (r Result) OperationsPerSecond() float64 {
+	if r.Elapsed <= 0 {
+		return 0
+	}
+
+	return float64(r.Operations) / r.Elapsed.Seconds()
+}
+
+// latencies accumulates the operation latencies.
+type latencies []time.Duration
+
+func (l latencies) apply(r *Result) {
+	if len(l) == 0 {
+		return
+	}
+
+	var total time.Duration
+
+	for _, d := range l {
+		total += d
+	}
+
+	r.LatencyAvg = total / time.Duration(len(l))
+
+	slices.Sort(l)
+
+	r.LatencyP99 = l[(len(l)*99)/100]
+}
+
+// lock allows only one benchmark to run at a time, as they would skew each other's results.
+var lock sync.Mutex
+
+// TryLock acquires the benchmark lock if no other benchmark is running.
+//
+// The returned function releases the lock.
+func TryLock() (func(), bool) {
+	if !lock.TryLock() {
+		return nil, false
+	}
+
+	return lock.Unlock, true
+}
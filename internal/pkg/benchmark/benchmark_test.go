@@ -0,0 +1,190 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package benchmark_test
+
+import (
+	"context"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/benchmark"
+)
+
+func TestDisk(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	results, err := benchmark.Disk(context.Background(), benchmark.DiskOptions{
+		Directory:           dir,
+		Size:                4 * 1024 * 1024,
+		SequentialBlockSize: 1024 * 1024,
+		RandomBlockSize:     4096,
+		Duration:            50 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	for i, name := range []string{"seq-write", "seq-read", "rand-write", "rand-read"} {
+		assert.Equal(t, name, results[i].Name)
+		assert.Positive(t, results[i].Operations, name)
+		assert.Positive(t, results[i].BytesPerSecond(), name)
+		assert.Positive(t, results[i].LatencyAvg, name)
+		assert.GreaterOrEqual(t, results[i].LatencyP99, results[i].LatencyAvg/2, name)
+	}
+
+	assert.Equal(t, uint64(4096)*results[3].Operations, results[3].Bytes)
+
+	// scratch file is removed
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestDiskValidate(t *testing.T) {
+	t.Parallel()
+
+	_, err := benchmark.Disk(context.Background(), benchmark.DiskOptions{
+		Directory:           t.TempDir(),
+		Size:                1024,
+		SequentialBlockSize: 1000,
+		RandomBlockSize:     4096,
+	})
+	require.EqualError(t, err, "sequential block size should be a positive multiple of 512 bytes\nsize should be at least the block size\nduration should be positive")
+
+	_, err = benchmark.Disk(context.Background(), benchmark.DiskOptions{
+		Directory:           t.TempDir(),
+		Size:                1 << 62,
+		SequentialBlockSize: 1024 * 1024,
+		RandomBlockSize:     4096,
+		Duration:            time.Second,
+	})
+	require.ErrorContains(t, err, "not enough free space")
+}
+
+func TestEtcdDisk(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	results, err := benchmark.EtcdDisk(context.Background(), dir, 50*time.Millisecond)
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	for i, name := range []string{benchmark.EtcdWALFsync, benchmark.EtcdBackendCommit} {
+		assert.Equal(t, name, results[i].Name)
+		assert.Positive(t, results[i].Operations, name)
+		assert.Positive(t, results[i].LatencyAvg, name)
+		assert.GreaterOrEqual(t, results[i].LatencyP99, results[i].LatencyAvg/2, name)
+	}
+
+	assert.Equal(t, uint64(2300)*results[0].Operations, results[0].Bytes)
+
+	// scratch files are removed
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestMemory(t *testing.T) {
+	t.Parallel()
+
+	results, err := benchmark.Memory(context.Background(), benchmark.MemoryOptions{
+		Size:     1024 * 1024,
+		Threads:  2,
+		Duration: 20 * time.Millisecond,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	for i, name := range []string{"read", "write", "copy"} {
+		assert.Equal(t, name, results[i].Name)
+		assert.Positive(t, results[i].Operations, name)
+		assert.Positive(t, results[i].BytesPerSecond(), name)
+	}
+
+	assert.Equal(t, 2*1024*1024*results[2].Operations, results[2].Bytes)
+}
+
+func TestNetwork(t *testing.T) {
+	t.Parallel()
+
+	// pick a free port
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	addr := l.Addr().String()
+
+	require.NoError(t, l.Close())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	type serverResult struct {
+		result benchmark.Result
+		err    error
+	}
+
+	serverCh := make(chan serverResult, 1)
+
+	go func() {
+		result, err := benchmark.NetworkServer(ctx, benchmark.NetworkServerOptions{
+			ListenAddress: addr,
+			AcceptTimeout: 5 * time.Second,
+			Timeout:       5 * time.Second,
+		})
+
+		serverCh <- serverResult{result, err}
+	}()
+
+	clientResult, err := benchmark.NetworkClient(ctx, benchmark.NetworkClientOptions{
+		Address:  addr,
+		Streams:  4,
+		Duration: 100 * time.Millisecond,
+	})
+	require.NoError(t, err)
+
+	server := <-serverCh
+	require.NoError(t, server.err)
+
+	assert.Equal(t, "tcp-send", clientResult.Name)
+	assert.Equal(t, uint64(4), clientResult.Operations)
+	assert.Positive(t, clientResult.Bytes)
+
+	assert.Equal(t, "tcp-receive", server.result.Name)
+	assert.Equal(t, uint64(4), server.result.Operations)
+	assert.Equal(t, clientResult.Bytes, server.result.Bytes)
+}
+
+func TestNetworkServerTimeout(t *testing.T) {
+	t.Parallel()
+
+	_, err := benchmark.NetworkServer(context.Background(), benchmark.NetworkServerOptions{
+		ListenAddress: "127.0.0.1:0",
+		AcceptTimeout: 50 * time.Millisecond,
+		Timeout:       time.Second,
+	})
+	require.EqualError(t, err, "timed out waiting for the client to connect")
+}
+
+func TestTryLock(t *testing.T) {
+	unlock, ok := benchmark.TryLock()
+	require.True(t, ok)
+
+	_, ok = benchmark.TryLock()
+	require.False(t, ok)
+
+	unlock()
+
+	unlock, ok = benchmark.TryLock()
+	require.True(t, ok)
+
+	unlock()
+}
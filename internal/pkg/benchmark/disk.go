@@ -0,0 +1,223 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package benchmark
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	mathrand "math/rand/v2"
+	"os"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// DiskOptions configures the disk benchmark.
+type DiskOptions struct {
+	// Directory to create the scratch file in.
+	Directory string
+	// Size of the scratch file.
+	Size uint64
+	// SequentialBlockSize is the block size of the sequential tests.
+	SequentialBlockSize uint32
+	// RandomBlockSize is the block size of the random tests.
+	RandomBlockSize uint32
+	// Duration of each test.
+	Duration time.Duration
+}
+
+// Validate the options.
+func (o DiskOptions) Validate() error {
+	var errs error
+
+	for _, bs := range []struct {
+		name  string
+		value uint32
+	}{
+		{"sequential block size", o.SequentialBlockSize},
+		{"random block size", o.RandomBlockSize},
+	} {
+		if bs.value == 0 || bs.value%512 != 0 {
+			errs = errors.Join(errs, fmt.Errorf("%s should be a positive multiple of 512 bytes", bs.name))
+		}
+	}
+
+	if o.Size < uint64(o.SequentialBlockSize) || o.Size < uint64(o.RandomBlockSize) {
+		errs = errors.Join(errs, errors.New("size should be at least the block size"))
+	}
+
+	if o.Duration <= 0 {
+		errs = errors.Join(errs, errors.New("duration should be positive"))
+	}
+
+	return errs
+}
+
+// Disk runs the sequential and random read and write tests on a scratch file.
+//
+// The scratch file is opened with O_DIRECT (if supported by the filesystem) to bypass the page cache,
+// and it is removed when the benchmark finishes.
+//
+//nolint:gocyclo
+func Disk(ctx context.Context, opts DiskOptions) ([]Result, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	var st unix.Statfs_t
+
+	if err := unix.Statfs(opts.Directory, &st); err != nil {
+		return nil, fmt.Errorf("error checking free space: %w", err)
+	}
+
+	if free := st.Bavail * uint64(st.Bsize); opts.Size > free/10*9 {
+		return nil, fmt.Errorf("not enough free space in %q: %d bytes requested, %d bytes available", opts.Directory, opts.Size, free)
+	}
+
+	f, err := os.CreateTemp(opts.Directory, "talos-benchmark-")
+	if err != nil {
+		return nil, fmt.Errorf("error creating scratch file: %w", err)
+	}
+
+	path := f.Name()
+
+	f.Close() //nolint:errcheck
+
+	defer os.Remove(path) //nolint:errcheck
+
+	fd, err := unix.Open(path, unix.O_RDWR|unix.O_DIRECT|unix.O_CLOEXEC, 0)
+	if errors.Is(err, unix.EINVAL) {
+		// the filesystem doesn't support direct IO
+		fd, err = unix.Open(path, unix.O_RDWR|unix.O_CLOEXEC, 0)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("error opening scratch file: %w", err)
+	}
+
+	defer unix.Close(fd) //nolint:errcheck
+
+	// direct IO requires aligned buffers, and mmap returns page-aligned memory
+	buf, err := unix.Mmap(-1, 0, int(max(opts.SequentialBlockSize, opts.RandomBlockSize)), unix.PROT_READ|unix.PROT_WRITE, unix.MAP_PRIVATE|unix.MAP_ANONYMOUS)
+	if err != nil {
+		return nil, fmt.Errorf("error allocating buffer: %w", err)
+	}
+
+	defer unix.Munmap(buf) //nolint:errcheck
+
+	// random data, so that the compressing and deduplicating storage doesn't skew the results
+	if _, err = rand.Read(buf); err != nil {
+		return nil, err
+	}
+
+	size := opts.Size - opts.Size%uint64(opts.SequentialBlockSize)
+
+	// lay out the file, so that the reads hit the actual blocks and not the holes
+	for offset := uint64(0); offset < size; offset += uint64(opts.SequentialBlockSize) {
+		if err = ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		if _, err = unix.Pwrite(fd, buf[:opts.SequentialBlockSize], int64(offset)); err != nil {
+			return nil, fmt.Errorf("error writing scratch file: %w", err)
+		}
+	}
+
+	if err = unix.Fdatasync(fd); err != nil {
+		return nil, fmt.Errorf("error syncing scratch file: %w", err)
+	}
+
+	tests := []struct {
+		name      string
+		write     bool
+		random    bool
+		blockSize uint32
+	}{
+		{"seq-write", true, false, opts.SequentialBlockSize},
+		{"seq-read", false, false, opts.SequentialBlockSize},
+		{"rand-write", true, true, opts.RandomBlockSize},
+		{"rand-read", false, true, opts.RandomBlockSize},
+	}
+
+	results := make([]Result, 0, len(tests))
+
+	for _, test := range tests {
+		result, err := diskTest(ctx, fd, buf[:test.blockSize], size, test.write, test.random, opts.Duration)
+		if err != nil {
+			return nil, fmt.Errorf("error running %s: %w", test.name, err)
+		}
+
+		result.Name = test.name
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func diskTest(ctx context.Context, fd int, buf []byte, size uint64, write, random bool, duration time.Duration) (Result, error) {
+	var (
+		result Result
+		lat    latencies
+		offset uint64
+	)
+
+	blocks := size / uint64(len(buf))
+	start := time.Now()
+	deadline := start.Add(duration)
+
+	for {
+		if random {
+			offset = mathrand.Uint64N(blocks) * uint64(len(buf))
+		} else if offset >= size {
+			offset = 0
+		}
+
+		opStart := time.Now()
+
+		if opStart.After(deadline) {
+			break
+		}
+
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		var (
+			n   int
+			err error
+		)
+
+		if write {
+			n, err = unix.Pwrite(fd, buf, int64(offset))
+		} else {
+			n, err = unix.Pread(fd, buf, int64(offset))
+		}
+
+		if err != nil {
+			return result, err
+		}
+
+		lat = append(lat, time.Since(opStart))
+
+		result.Bytes += uint64(n)
+		result.Operations++
+		offset += uint64(n)
+	}
+
+	if write {
+		// include flushing the device caches
+		if err := unix.Fdatasync(fd); err != nil {
+			return result, err
+		}
+	}
+
+	result.Elapsed = time.Since(start)
+	lat.apply(&result)
+
+	return result, nil
+}
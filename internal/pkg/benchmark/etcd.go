@@ -0,0 +1,201 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package benchmark
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	mathrand "math/rand/v2"
+	"os"
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+const (
+	// etcdWALEntrySize is the typical size of the etcd WAL entry (as recommended for the fio etcd test).
+	etcdWALEntrySize = 2300
+	// etcdWALSegmentSize is the size of the preallocated etcd WAL segment.
+	etcdWALSegmentSize = 64 * 1024 * 1024
+
+	// etcdBackendPageSize is the bbolt page size.
+	etcdBackendPageSize = 4096
+	// etcdBackendDirtyPages is the number of the pages written by a single backend commit.
+	etcdBackendDirtyPages = 16
+	// etcdBackendSize is the size of the simulated backend database.
+	etcdBackendSize = 64 * 1024 * 1024
+)
+
+// Etcd tests names.
+const (
+	EtcdWALFsync      = "wal-fsync"
+	EtcdBackendCommit = "backend-commit"
+)
+
+// EtcdDisk simulates the disk writes etcd depends on in the directory, and measures their latency.
+//
+// The wal-fsync test appends the WAL entries to the preallocated segment, with fdatasync after each entry.
+// The backend-commit test mimics bbolt commits: it writes the dirty pages at random offsets, fdatasyncs,
+// writes the meta page and fdatasyncs again.
+//
+// The writes go through the page cache (as etcd does), so the latency is dominated by fdatasync.
+func EtcdDisk(ctx context.Context, directory string, duration time.Duration) ([]Result, error) {
+	if duration <= 0 {
+		return nil, errors.New("duration should be positive")
+	}
+
+	results := make([]Result, 0, 2)
+
+	for _, test := range []struct {
+		name string
+		size int64
+		run  func(ctx context.Context, f *os.File, deadline time.Time) (Result, error)
+	}{
+		{EtcdWALFsync, etcdWALSegmentSize, etcdWALTest},
+		{EtcdBackendCommit, etcdBackendSize, etcdBackendTest},
+	} {
+		result, err := withScratchFile(directory, test.size, func(f *os.File) (Result, error) {
+			return test.run(ctx, f, time.Now().Add(duration))
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error running %s: %w", test.name, err)
+		}
+
+		result.Name = test.name
+
+		results = append(results, result)
+	}
+
+	return results, nil
+}
+
+func withScratchFile(directory string, size int64, f func(*os.File) (Result, error)) (Result, error) {
+	file, err := os.CreateTemp(directory, "talos-benchmark-")
+	if err != nil {
+		return Result{}, fmt.Errorf("error creating scratch file: %w", err)
+	}
+
+	defer os.Remove(file.Name()) //nolint:errcheck
+	defer file.Close()           //nolint:errcheck
+
+	// preallocate the file like etcd does, so that fdatasync doesn't have to flush the metadata on each write
+	if err = unix.Fallocate(int(file.Fd()), 0, 0, size); err != nil {
+		if !errors.Is(err, unix.EOPNOTSUPP) {
+			return Result{}, fmt.Errorf("error allocating scratch file: %w", err)
+		}
+
+		if err = file.Truncate(size); err != nil {
+			return Result{}, fmt.Errorf("error allocating scratch file: %w", err)
+		}
+	}
+
+	if err = file.Sync(); err != nil {
+		return Result{}, fmt.Errorf("error syncing scratch file: %w", err)
+	}
+
+	return f(file)
+}
+
+func etcdWALTest(ctx context.Context, f *os.File, deadline time.Time) (Result, error) {
+	entry := make([]byte, etcdWALEntrySize)
+
+	if _, err := rand.Read(entry); err != nil {
+		return Result{}, err
+	}
+
+	var (
+		result Result
+		lat    latencies
+		offset int64
+	)
+
+	start := time.Now()
+
+	for time.Now().Before(deadline) {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		if offset+etcdWALEntrySize > etcdWALSegmentSize {
+			offset = 0
+		}
+
+		opStart := time.Now()
+
+		if _, err := f.WriteAt(entry, offset); err != nil {
+			return result, err
+		}
+
+		if err := unix.Fdatasync(int(f.Fd())); err != nil {
+			return result, err
+		}
+
+		lat = append(lat, time.Since(opStart))
+
+		result.Bytes += etcdWALEntrySize
+		result.Operations++
+		offset += etcdWALEntrySize
+	}
+
+	result.Elapsed = time.Since(start)
+	lat.apply(&result)
+
+	return result, nil
+}
+
+func etcdBackendTest(ctx context.Context, f *os.File, deadline time.Time) (Result, error) {
+	page := make([]byte, etcdBackendPageSize)
+
+	if _, err := rand.Read(page); err != nil {
+		return Result{}, err
+	}
+
+	var (
+		result Result
+		lat    latencies
+	)
+
+	pages := int64(etcdBackendSize / etcdBackendPageSize)
+	start := time.Now()
+
+	for time.Now().Before(deadline) {
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		opStart := time.Now()
+
+		for range etcdBackendDirtyPages {
+			// the first two pages are the meta pages
+			if _, err := f.WriteAt(page, (2+mathrand.Int64N(pages-2))*etcdBackendPageSize); err != nil {
+				return result, err
+			}
+		}
+
+		if err := unix.Fdatasync(int(f.Fd())); err != nil {
+			return result, err
+		}
+
+		if _, err := f.WriteAt(page, (int64(result.Operations)%2)*etcdBackendPageSize); err != nil {
+			return result, err
+		}
+
+		if err := unix.Fdatasync(int(f.Fd())); err != nil {
+			return result, err
+		}
+
+		lat = append(lat, time.Since(opStart))
+
+		result.Bytes += (etcdBackendDirtyPages + 1) * etcdBackendPageSize
+		result.Operations++
+	}
+
+	result.Elapsed = time.Since(start)
+	lat.apply(&result)
+
+	return result, nil
+}
@@ -0,0 +1,146 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package benchmark
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+	"unsafe"
+)
+
+// MemoryOptions configures the memory benchmark.
+type MemoryOptions struct {
+	// Size of the buffer of each thread, it should be much larger than the CPU caches.
+	Size uint64
+	// Threads is the number of the concurrent threads.
+	Threads uint32
+	// Duration of each test.
+	Duration time.Duration
+}
+
+// Validate the options.
+func (o MemoryOptions) Validate() error {
+	var errs error
+
+	if o.Size == 0 || o.Size%8 != 0 {
+		errs = errors.Join(errs, errors.New("size should be a positive multiple of 8 bytes"))
+	}
+
+	if o.Threads == 0 {
+		errs = errors.Join(errs, errors.New("threads should be positive"))
+	}
+
+	if o.Duration <= 0 {
+		errs = errors.Join(errs, errors.New("duration should be positive"))
+	}
+
+	return errs
+}
+
+// sink prevents the compiler from optimizing out the read test.
+var sink atomic.Uint64
+
+// Memory runs the memory bandwidth tests: read, write and copy (STREAM-like).
+//
+// The copy test counts both bytes read and written.
+func Memory(ctx context.Context, opts MemoryOptions) ([]Result, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	type buffers struct {
+		src, dst []byte
+	}
+
+	bufs := make([]buffers, opts.Threads)
+
+	for i := range bufs {
+		bufs[i] = buffers{src: make([]byte, opts.Size), dst: make([]byte, opts.Size)}
+
+		// touch the memory, so that the page faults are not measured
+		for j := range bufs[i].src {
+			bufs[i].src[j] = byte(j)
+		}
+
+		clear(bufs[i].dst)
+	}
+
+	tests := []struct {
+		name  string
+		bytes uint64
+		op    func(b buffers)
+	}{
+		{
+			name:  "read",
+			bytes: opts.Size,
+			op: func(b buffers) {
+				var sum uint64
+
+				for _, v := range unsafe.Slice((*uint64)(unsafe.Pointer(&b.src[0])), len(b.src)/8) {
+					sum += v
+				}
+
+				sink.Add(sum)
+			},
+		},
+		{
+			name:  "write",
+			bytes: opts.Size,
+			op: func(b buffers) {
+				clear(b.dst)
+			},
+		},
+		{
+			name:  "copy",
+			bytes: 2 * opts.Size,
+			op: func(b buffers) {
+				copy(b.dst, b.src)
+			},
+		},
+	}
+
+	results := make([]Result, 0, len(tests))
+
+	for _, test := range tests {
+		var (
+			operations atomic.Uint64
+			wg         sync.WaitGroup
+		)
+
+		start := time.Now()
+		deadline := start.Add(opts.Duration)
+
+		for _, b := range bufs {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				for time.Now().Before(deadline) && ctx.Err() == nil {
+					test.op(b)
+					operations.Add(1)
+				}
+			}()
+		}
+
+		wg.Wait()
+
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		results = append(results, Result{
+			Name:       test.name,
+			Bytes:      operations.Load() * test.bytes,
+			Operations: operations.Load(),
+			Elapsed:    time.Since(start),
+		})
+	}
+
+	return results, nil
+}
@@ -0,0 +1,268 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package benchmark
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// DefaultNetworkPort is the default TCP port of the network benchmark server (same as iperf3).
+const DefaultNetworkPort = 5201
+
+const (
+	networkBufferSize = 128 * 1024
+
+	// networkDialTimeout allows the server node to start listening after the client.
+	networkDialTimeout = 10 * time.Second
+)
+
+// NetworkServerOptions configures the network benchmark server.
+type NetworkServerOptions struct {
+	// Address to listen on.
+	ListenAddress string
+	// AcceptTimeout is the time to wait for the client to connect.
+	AcceptTimeout time.Duration
+	// Timeout of the whole test after the client connects.
+	Timeout time.Duration
+}
+
+// NetworkServer accepts a single test session from the client and measures the received throughput.
+//
+// The session starts with the first connection, and ends once all connections are closed by the client.
+func NetworkServer(ctx context.Context, opts NetworkServerOptions) (Result, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var lc net.ListenConfig
+
+	listener, err := lc.Listen(ctx, "tcp", opts.ListenAddress)
+	if err != nil {
+		return Result{}, fmt.Errorf("error listening: %w", err)
+	}
+
+	defer listener.Close() //nolint:errcheck
+
+	acceptCh := make(chan net.Conn)
+	acceptErrCh := make(chan error, 1)
+
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				acceptErrCh <- err
+
+				return
+			}
+
+			select {
+			case acceptCh <- conn:
+			case <-ctx.Done():
+				conn.Close() //nolint:errcheck
+
+				return
+			}
+		}
+	}()
+
+	var (
+		conns    []net.Conn
+		active   int
+		received uint64
+		start    time.Time
+	)
+
+	// receivedCh gets the number of bytes received over each connection once it's closed
+	receivedCh := make(chan uint64)
+
+	defer func() {
+		for _, conn := range conns {
+			conn.Close() //nolint:errcheck
+		}
+
+		// drain the connection goroutines
+		for range active {
+			<-receivedCh
+		}
+	}()
+
+	timer := time.NewTimer(opts.AcceptTimeout)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return Result{}, ctx.Err()
+		case <-timer.C:
+			if conns == nil {
+				return Result{}, errors.New("timed out waiting for the client to connect")
+			}
+
+			return Result{}, errors.New("timed out waiting for the client to finish")
+		case err := <-acceptErrCh:
+			return Result{}, err
+		case conn := <-acceptCh:
+			if conns == nil {
+				start = time.Now()
+
+				timer.Reset(opts.Timeout)
+			}
+
+			conns = append(conns, conn)
+			active++
+
+			go func() {
+				n, _ := io.Copy(io.Discard, conn) //nolint:errcheck
+
+				receivedCh <- uint64(n)
+			}()
+		case n := <-receivedCh:
+			received += n
+			active--
+
+			if active == 0 {
+				return Result{
+					Name:       "tcp-receive",
+					Bytes:      received,
+					Operations: uint64(len(conns)),
+					Elapsed:    time.Since(start),
+				}, nil
+			}
+		}
+	}
+}
+
+// NetworkClientOptions configures the network benchmark client.
+type NetworkClientOptions struct {
+	// Address of the server.
+	Address string
+	// Streams is the number of the parallel TCP connections.
+	Streams uint32
+	// Duration of the test.
+	Duration time.Duration
+}
+
+// Validate the options.
+func (o NetworkClientOptions) Validate() error {
+	var errs error
+
+	if o.Streams == 0 {
+		errs = errors.Join(errs, errors.New("streams should be positive"))
+	}
+
+	if o.Duration <= 0 {
+		errs = errors.Join(errs, errors.New("duration should be positive"))
+	}
+
+	return errs
+}
+
+// NetworkClient sends the data to the server over the parallel TCP connections and measures the sent throughput.
+func NetworkClient(ctx context.Context, opts NetworkClientOptions) (Result, error) {
+	if err := opts.Validate(); err != nil {
+		return Result{}, err
+	}
+
+	conns := make([]net.Conn, 0, opts.Streams)
+
+	defer func() {
+		for _, conn := range conns {
+			conn.Close() //nolint:errcheck
+		}
+	}()
+
+	for range opts.Streams {
+		conn, err := dialRetry(ctx, opts.Address)
+		if err != nil {
+			return Result{}, fmt.Errorf("error connecting to %q: %w", opts.Address, err)
+		}
+
+		conns = append(conns, conn)
+	}
+
+	var (
+		sent     atomic.Uint64
+		wg       sync.WaitGroup
+		errCount atomic.Uint32
+	)
+
+	buf := make([]byte, networkBufferSize)
+	start := time.Now()
+	deadline := start.Add(opts.Duration)
+
+	for _, conn := range conns {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if err := conn.SetWriteDeadline(deadline); err != nil {
+				errCount.Add(1)
+
+				return
+			}
+
+			for ctx.Err() == nil {
+				n, err := conn.Write(buf)
+				sent.Add(uint64(n))
+
+				if err != nil {
+					// the write deadline marks the end of the test
+					if !os.IsTimeout(err) {
+						errCount.Add(1)
+					}
+
+					return
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	elapsed := time.Since(start)
+
+	if err := ctx.Err(); err != nil {
+		return Result{}, err
+	}
+
+	if errCount.Load() > 0 {
+		return Result{}, fmt.Errorf("%d of %d streams failed", errCount.Load(), opts.Streams)
+	}
+
+	return Result{
+		Name:       "tcp-send",
+		Bytes:      sent.Load(),
+		Operations: uint64(opts.Streams),
+		Elapsed:    elapsed,
+	}, nil
+}
+
+func dialRetry(ctx context.Context, address string) (net.Conn, error) {
+	ctx, cancel := context.WithTimeout(ctx, networkDialTimeout)
+	defer cancel()
+
+	var d net.Dialer
+
+	for {
+		conn, err := d.DialContext(ctx, "tcp", address)
+		if err == nil {
+			return conn, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, err
+		case <-time.After(100 * time.Millisecond):
+		}
+	}
+}
@@ -0,0 +1,221 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package bootlog persists early boot logs, so that boot failures can be diagnosed after recovery.
+package bootlog
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/siderolabs/go-kmsg"
+)
+
+// MaxSize is the maximum size of a single boot log, older messages are dropped.
+const MaxSize = 512 * 1024
+
+const logExt = ".log"
+
+// Boot is the early log of a single boot.
+type Boot struct {
+	// Sequence number of the boot, increasing with each boot.
+	Sequence int
+	// Kernel boot ID.
+	BootID string
+	// Time the log was last written.
+	Timestamp time.Time
+	// Log contents.
+	Log []byte
+}
+
+// CurrentBootID returns the kernel boot ID of the current boot.
+func CurrentBootID() (string, error) {
+	bootID, err := os.ReadFile("/proc/sys/kernel/random/boot_id")
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(bootID)), nil
+}
+
+// Collect captures the kernel log and the given log (e.g. machined log) collected so far.
+func Collect(ctx context.Context, log io.Reader) ([]byte, error) {
+	var buf bytes.Buffer
+
+	reader, err := kmsg.NewReader()
+	if err != nil {
+		return nil, fmt.Errorf("error opening /dev/kmsg reader: %w", err)
+	}
+
+	defer reader.Close() //nolint:errcheck
+
+	for packet := range reader.Scan(ctx) {
+		if packet.Err != nil {
+			return nil, fmt.Errorf("error reading kernel logs: %w", packet.Err)
+		}
+
+		msg := packet.Message
+
+		fmt.Fprintf(&buf, "%s: %7s: [%s]: %s\n", msg.Facility, msg.Priority, msg.Timestamp.Format(time.RFC3339Nano), msg.Message)
+	}
+
+	if log != nil {
+		if _, err = io.Copy(&buf, log); err != nil {
+			return nil, fmt.Errorf("error reading log: %w", err)
+		}
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Save the log of the current boot to the directory.
+//
+// If the log for the boot ID already exists, it is overwritten, otherwise a new log is created,
+// and only maxBoots latest logs are kept.
+func Save(dir, bootID string, log []byte, maxBoots int) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	boots, err := list(dir)
+	if err != nil {
+		return err
+	}
+
+	if idx := slices.IndexFunc(boots, func(b Boot) bool { return b.BootID == bootID }); idx != -1 {
+		return write(dir, boots[idx], log)
+	}
+
+	sequence := 1
+
+	if len(boots) > 0 {
+		sequence = boots[0].Sequence + 1
+	}
+
+	for _, boot := range boots[min(len(boots), max(maxBoots-1, 0)):] {
+		if err = os.Remove(filepath.Join(dir, fileName(boot))); err != nil {
+			return err
+		}
+	}
+
+	return write(dir, Boot{Sequence: sequence, BootID: bootID}, log)
+}
+
+// Update the log of the current boot in the directory.
+//
+// If the log for the boot ID doesn't exist, os.ErrNotExist is returned.
+func Update(dir, bootID string, log []byte) error {
+	boots, err := list(dir)
+	if err != nil {
+		return err
+	}
+
+	idx := slices.IndexFunc(boots, func(b Boot) bool { return b.BootID == bootID })
+	if idx == -1 {
+		return os.ErrNotExist
+	}
+
+	return write(dir, boots[idx], log)
+}
+
+// Read returns up to n latest boot logs, latest first.
+func Read(dir string, n int) ([]Boot, error) {
+	boots, err := list(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	boots = boots[:min(len(boots), n)]
+
+	for i := range boots {
+		boots[i].Log, err = os.ReadFile(filepath.Join(dir, fileName(boots[i])))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return boots, nil
+}
+
+// list the boot logs in the directory (without contents), latest first.
+func list(dir string) ([]Boot, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	boots := make([]Boot, 0, len(entries))
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+
+		boot, ok := parseFileName(entry.Name())
+		if !ok {
+			continue
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return nil, err
+		}
+
+		boot.Timestamp = info.ModTime()
+
+		boots = append(boots, boot)
+	}
+
+	slices.SortFunc(boots, func(a, b Boot) int {
+		return b.Sequence - a.Sequence
+	})
+
+	return boots, nil
+}
+
+func write(dir string, boot Boot, log []byte) error {
+	if len(log) > MaxSize {
+		log = log[len(log)-MaxSize:]
+	}
+
+	return os.WriteFile(filepath.Join(dir, fileName(boot)), log, 0o600)
+}
+
+func fileName(boot Boot) string {
+	return fmt.Sprintf("%06d-%s%s", boot.Sequence, boot.BootID, logExt)
+}
+
+func parseFileName(name string) (Boot, bool) {
+	name, ok := strings.CutSuffix(name, logExt)
+	if !ok {
+		return Boot{}, false
+	}
+
+	seq, bootID, ok := strings.Cut(name, "-")
+	if !ok {
+		return Boot{}, false
+	}
+
+	sequence, err := strconv.Atoi(seq)
+	if err != nil {
+		return Boot{}, false
+	}
+
+	return Boot{
+		Sequence: sequence,
+		BootID:   bootID,
+	}, true
+}
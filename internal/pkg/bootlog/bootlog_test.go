@@ -0,0 +1,79 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package bootlog_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/siderolabs/gen/xslices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/bootlog"
+)
+
+func TestSaveRead(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "bootlog")
+
+	boots, err := bootlog.Read(dir, 10)
+	require.NoError(t, err)
+	assert.Empty(t, boots)
+
+	for i := range 5 {
+		require.NoError(t, bootlog.Save(dir, fmt.Sprintf("boot%d", i), []byte(fmt.Sprintf("log %d", i)), 3))
+	}
+
+	// overwrite the log of the current boot
+	require.NoError(t, bootlog.Save(dir, "boot4", []byte("log 4 updated"), 3))
+
+	boots, err = bootlog.Read(dir, 10)
+	require.NoError(t, err)
+
+	assert.Equal(t, []int{5, 4, 3}, xslices.Map(boots, func(b bootlog.Boot) int { return b.Sequence }))
+	assert.Equal(t, []string{"boot4", "boot3", "boot2"}, xslices.Map(boots, func(b bootlog.Boot) string { return b.BootID }))
+	assert.Equal(t, "log 4 updated", string(boots[0].Log))
+	assert.Equal(t, "log 3", string(boots[1].Log))
+
+	boots, err = bootlog.Read(dir, 1)
+	require.NoError(t, err)
+	require.Len(t, boots, 1)
+	assert.Equal(t, "boot4", boots[0].BootID)
+
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, entries, 3)
+}
+
+func TestUpdate(t *testing.T) {
+	dir := t.TempDir()
+
+	assert.ErrorIs(t, bootlog.Update(dir, "boot0", []byte("log")), os.ErrNotExist)
+
+	require.NoError(t, bootlog.Save(dir, "boot0", []byte("log"), 3))
+	require.NoError(t, bootlog.Update(dir, "boot0", []byte("log updated")))
+
+	boots, err := bootlog.Read(dir, 1)
+	require.NoError(t, err)
+	require.Len(t, boots, 1)
+	assert.Equal(t, "log updated", string(boots[0].Log))
+}
+
+func TestMaxSize(t *testing.T) {
+	dir := t.TempDir()
+
+	log := append(bytes.Repeat([]byte("a"), bootlog.MaxSize), []byte("tail")...)
+
+	require.NoError(t, bootlog.Save(dir, "boot0", log, 3))
+
+	boots, err := bootlog.Read(dir, 1)
+	require.NoError(t, err)
+	require.Len(t, boots, 1)
+	assert.Len(t, boots[0].Log, bootlog.MaxSize)
+	assert.True(t, bytes.HasSuffix(boots[0].Log, []byte("tail")))
+}
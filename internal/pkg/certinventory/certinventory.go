@@ -0,0 +1,244 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package certinventory lists the certificates held by the node.
+package certinventory
+
+import (
+	"context"
+	stdx509 "crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/controller/generic"
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/siderolabs/crypto/x509"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+// Certificate is a certificate held by the node.
+type Certificate struct {
+	// Name identifies the certificate, e.g. `etcd-peer`.
+	Name string
+
+	*stdx509.Certificate
+}
+
+// Inventory lists the certificates held by the node.
+//
+// Certificates are read from the secrets resources and from the kubelet PKI directory.
+type Inventory struct {
+	State state.State
+
+	// KubeletPKIDir defaults to constants.KubeletPKIDir.
+	KubeletPKIDir string
+}
+
+// List returns the certificates held by the node sorted by name.
+//
+// Certificates which are not present on the node (e.g. etcd certificates on worker nodes) are skipped.
+//
+//nolint:gocyclo
+func (inv *Inventory) List(ctx context.Context) ([]Certificate, error) {
+	var certs []Certificate
+
+	addPEM := func(name string, pair *x509.PEMEncodedCertificateAndKey) error {
+		if pair == nil || len(pair.Crt) == 0 {
+			return nil
+		}
+
+		cert, err := parseCertificate(pair.Crt)
+		if err != nil {
+			return fmt.Errorf("error parsing certificate %q: %w", name, err)
+		}
+
+		certs = append(certs, Certificate{Name: name, Certificate: cert})
+
+		return nil
+	}
+
+	addKubeconfig := func(name, kubeconfig string) error {
+		if kubeconfig == "" {
+			return nil
+		}
+
+		config, err := clientcmd.Load([]byte(kubeconfig))
+		if err != nil {
+			return fmt.Errorf("error parsing kubeconfig %q: %w", name, err)
+		}
+
+		for _, authInfo := range config.AuthInfos {
+			if len(authInfo.ClientCertificateData) == 0 {
+				continue
+			}
+
+			cert, err := parseCertificate(authInfo.ClientCertificateData)
+			if err != nil {
+				return fmt.Errorf("error parsing certificate %q: %w", name, err)
+			}
+
+			certs = append(certs, Certificate{Name: name, Certificate: cert})
+		}
+
+		return nil
+	}
+
+	if err := get(ctx, inv.State, secrets.OSRootID, func(res *secrets.OSRoot) error {
+		return addPEM("os-ca", res.TypedSpec().IssuingCA)
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := get(ctx, inv.State, secrets.APIID, func(res *secrets.API) error {
+		return errors.Join(
+			addPEM("apid-server", res.TypedSpec().Server),
+			addPEM("apid-client", res.TypedSpec().Client),
+		)
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := get(ctx, inv.State, secrets.TrustdID, func(res *secrets.Trustd) error {
+		return addPEM("trustd-server", res.TypedSpec().Server)
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := get(ctx, inv.State, secrets.EtcdRootID, func(res *secrets.EtcdRoot) error {
+		return addPEM("etcd-ca", res.TypedSpec().EtcdCA)
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := get(ctx, inv.State, secrets.EtcdID, func(res *secrets.Etcd) error {
+		return errors.Join(
+			addPEM("etcd-server", res.TypedSpec().Etcd),
+			addPEM("etcd-peer", res.TypedSpec().EtcdPeer),
+			addPEM("etcd-admin", res.TypedSpec().EtcdAdmin),
+			addPEM("etcd-apiserver-client", res.TypedSpec().EtcdAPIServer),
+		)
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := get(ctx, inv.State, secrets.KubernetesRootID, func(res *secrets.KubernetesRoot) error {
+		return errors.Join(
+			addPEM("kubernetes-ca", res.TypedSpec().IssuingCA),
+			addPEM("kubernetes-aggregator-ca", res.TypedSpec().AggregatorCA),
+		)
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := get(ctx, inv.State, secrets.KubernetesDynamicCertsID, func(res *secrets.KubernetesDynamicCerts) error {
+		return errors.Join(
+			addPEM("kube-apiserver", res.TypedSpec().APIServer),
+			addPEM("kube-apiserver-kubelet-client", res.TypedSpec().APIServerKubeletClient),
+			addPEM("kube-front-proxy-client", res.TypedSpec().FrontProxy),
+		)
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := get(ctx, inv.State, secrets.KubernetesID, func(res *secrets.Kubernetes) error {
+		return errors.Join(
+			addKubeconfig("kube-scheduler-client", res.TypedSpec().SchedulerKubeconfig),
+			addKubeconfig("kube-controller-manager-client", res.TypedSpec().ControllerManagerKubeconfig),
+			addKubeconfig("kubernetes-admin", res.TypedSpec().AdminKubeconfig),
+		)
+	}); err != nil {
+		return nil, err
+	}
+
+	kubeletPKIDir := inv.KubeletPKIDir
+	if kubeletPKIDir == "" {
+		kubeletPKIDir = constants.KubeletPKIDir
+	}
+
+	for _, file := range []struct {
+		name      string
+		filenames []string
+	}{
+		{
+			name:      "kubelet-client",
+			filenames: []string{"kubelet-client-current.pem"},
+		},
+		{
+			name: "kubelet-server",
+			// kubelet-server-current.pem is present if the serving certificate rotation is enabled,
+			// otherwise kubelet uses the self-signed certificate.
+			filenames: []string{"kubelet-server-current.pem", "kubelet.crt"},
+		},
+	} {
+		for _, filename := range file.filenames {
+			contents, err := os.ReadFile(filepath.Join(kubeletPKIDir, filename))
+			if err != nil {
+				if errors.Is(err, os.ErrNotExist) {
+					continue
+				}
+
+				return nil, err
+			}
+
+			cert, err := parseCertificate(contents)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing certificate %q: %w", file.name, err)
+			}
+
+			certs = append(certs, Certificate{Name: file.name, Certificate: cert})
+
+			break
+		}
+	}
+
+	sort.SliceStable(certs, func(i, j int) bool {
+		return certs[i].Name < certs[j].Name
+	})
+
+	return certs, nil
+}
+
+// DaysToExpiry returns the number of days until the certificate expires.
+func DaysToExpiry(cert *stdx509.Certificate, now time.Time) float64 {
+	return cert.NotAfter.Sub(now).Hours() / 24
+}
+
+func get[T generic.ResourceWithRD](ctx context.Context, st state.State, id resource.ID, f func(T) error) error {
+	res, err := safe.StateGetByID[T](ctx, st, id)
+	if err != nil {
+		if state.IsNotFoundError(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	return f(res)
+}
+
+// parseCertificate parses the first certificate in the PEM-encoded data.
+func parseCertificate(data []byte) (*stdx509.Certificate, error) {
+	for {
+		var block *pem.Block
+
+		block, data = pem.Decode(data)
+		if block == nil {
+			return nil, errors.New("no certificate found")
+		}
+
+		if block.Type == "CERTIFICATE" {
+			return stdx509.ParseCertificate(block.Bytes)
+		}
+	}
+}
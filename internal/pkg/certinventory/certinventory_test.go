@@ -0,0 +1,81 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package certinventory_test
+
+import (
+	"context"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/state"
+	"github.com/cosi-project/runtime/pkg/state/impl/inmem"
+	"github.com/cosi-project/runtime/pkg/state/impl/namespaced"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/siderolabs/crypto/x509"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/certinventory"
+	"github.com/siderolabs/talos/pkg/machinery/resources/secrets"
+)
+
+func TestInventory(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	st := state.WrapCore(namespaced.NewState(inmem.Build))
+
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.Organization("talos"))
+	require.NoError(t, err)
+
+	issue := func(opts ...x509.Option) *x509.PEMEncodedCertificateAndKey {
+		keyPair, err := x509.NewKeyPair(ca, append(opts, x509.NotAfter(time.Now().Add(48*time.Hour)))...)
+		require.NoError(t, err)
+
+		return x509.NewCertificateAndKeyFromKeyPair(keyPair)
+	}
+
+	osRoot := secrets.NewOSRoot(secrets.OSRootID)
+	osRoot.TypedSpec().IssuingCA = &x509.PEMEncodedCertificateAndKey{Crt: ca.CrtPEM}
+	require.NoError(t, st.Create(ctx, osRoot))
+
+	api := secrets.NewAPI()
+	api.TypedSpec().Server = issue(
+		x509.CommonName("talos-worker-1"),
+		x509.DNSNames([]string{"talos-worker-1"}),
+		x509.IPAddresses([]net.IP{net.ParseIP("10.5.0.2")}),
+	)
+	require.NoError(t, st.Create(ctx, api))
+
+	kubeletPKIDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(kubeletPKIDir, "kubelet-client-current.pem"), issue(x509.CommonName("system:node:talos-worker-1")).Crt, 0o600))
+
+	inventory := &certinventory.Inventory{
+		State:         st,
+		KubeletPKIDir: kubeletPKIDir,
+	}
+
+	certs, err := inventory.List(ctx)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"apid-server", "kubelet-client", "os-ca"}, xslices.Map(certs, func(cert certinventory.Certificate) string {
+		return cert.Name
+	}))
+
+	assert.Equal(t, "CN=talos-worker-1", certs[0].Subject.String())
+	assert.Equal(t, []string{"talos-worker-1"}, certs[0].DNSNames)
+	assert.Equal(t, "CN=system:node:talos-worker-1", certs[1].Subject.String())
+	assert.True(t, certs[2].IsCA)
+
+	assert.InDelta(t, 2, certinventory.DaysToExpiry(certs[0].Certificate, time.Now()), 0.01)
+
+	assert.Equal(t, 3, testutil.CollectAndCount(inventory, "talos_certificate_days_to_expiry"))
+}
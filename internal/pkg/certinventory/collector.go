@@ -0,0 +1,42 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package certinventory
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const collectTimeout = 10 * time.Second
+
+var daysToExpiryDesc = prometheus.NewDesc(
+	"talos_certificate_days_to_expiry",
+	"Number of days until the certificate held by the node expires (negative if already expired).",
+	[]string{"name", "subject"}, nil,
+)
+
+// Describe implements prometheus.Collector interface.
+func (inv *Inventory) Describe(ch chan<- *prometheus.Desc) {
+	ch <- daysToExpiryDesc
+}
+
+// Collect implements prometheus.Collector interface.
+func (inv *Inventory) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), collectTimeout)
+	defer cancel()
+
+	certs, err := inv.List(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(daysToExpiryDesc, err)
+
+		return
+	}
+
+	for _, cert := range certs {
+		ch <- prometheus.MustNewConstMetric(daysToExpiryDesc, prometheus.GaugeValue, DaysToExpiry(cert.Certificate, time.Now()), cert.Name, cert.Subject.String())
+	}
+}
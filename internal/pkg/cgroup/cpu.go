@@ -6,8 +6,11 @@ package cgroup
 
 import (
 	"runtime"
+	"strconv"
+	"strings"
 	"sync"
 
+	"github.com/containerd/cgroups/v3/cgroup2"
 	"github.com/google/cadvisor/utils/sysfs"
 	"github.com/google/cadvisor/utils/sysinfo"
 )
@@ -50,3 +53,43 @@ func MillicoresToCPUWeight(requested MilliCores) uint64 {
 
 	return SharesToCPUWeight(MilliCoresToShares(requested))
 }
+
+// CPUMaxPeriod is the period of the CPU bandwidth limit in microseconds.
+const CPUMaxPeriod = 100000
+
+// MilliCoresToCPUMax converts milli-cores to the CPU bandwidth limit (cpu.max).
+//
+// Zero milli-cores means no limit.
+func MilliCoresToCPUMax(milliCores MilliCores) cgroup2.CPUMax {
+	period := uint64(CPUMaxPeriod)
+
+	if milliCores == 0 {
+		return cgroup2.NewCPUMax(nil, &period)
+	}
+
+	quota := int64(milliCores) * CPUMaxPeriod / 1000
+
+	return cgroup2.NewCPUMax(&quota, &period)
+}
+
+// CPUMaxToMilliCores converts the CPU bandwidth limit (cpu.max) to milli-cores.
+//
+// Zero milli-cores are returned if there is no limit.
+func CPUMaxToMilliCores(cpuMax cgroup2.CPUMax) MilliCores {
+	quota, period, ok := strings.Cut(strings.TrimSpace(string(cpuMax)), " ")
+	if !ok || quota == "max" {
+		return 0
+	}
+
+	quotaValue, err := strconv.ParseUint(quota, 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	periodValue, err := strconv.ParseUint(period, 10, 64)
+	if err != nil || periodValue == 0 {
+		return 0
+	}
+
+	return MilliCores(quotaValue * 1000 / periodValue)
+}
@@ -7,6 +7,7 @@ package cgroup_test
 import (
 	"testing"
 
+	"github.com/containerd/cgroups/v3/cgroup2"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/siderolabs/talos/internal/pkg/cgroup"
@@ -36,3 +37,16 @@ func TestMillicoresToCPUWeight(t *testing.T) {
 	assert.Equal(t, uint64(20), cgroup.MillicoresToCPUWeight(500))
 	assert.Equal(t, uint64(39), cgroup.MillicoresToCPUWeight(1000))
 }
+
+func TestMilliCoresToCPUMax(t *testing.T) {
+	assert.Equal(t, cgroup2.CPUMax("max 100000"), cgroup.MilliCoresToCPUMax(0))
+	assert.Equal(t, cgroup2.CPUMax("50000 100000"), cgroup.MilliCoresToCPUMax(500))
+	assert.Equal(t, cgroup2.CPUMax("250000 100000"), cgroup.MilliCoresToCPUMax(2500))
+}
+
+func TestCPUMaxToMilliCores(t *testing.T) {
+	assert.Equal(t, cgroup.MilliCores(0), cgroup.CPUMaxToMilliCores("max 100000\n"))
+	assert.Equal(t, cgroup.MilliCores(500), cgroup.CPUMaxToMilliCores("50000 100000\n"))
+	assert.Equal(t, cgroup.MilliCores(1500), cgroup.CPUMaxToMilliCores("15000 10000"))
+	assert.Equal(t, cgroup.MilliCores(0), cgroup.CPUMaxToMilliCores("garbage"))
+}
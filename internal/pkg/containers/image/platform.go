@@ -0,0 +1,102 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package image
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/containerd/containerd/v2/core/images"
+	"github.com/containerd/platforms"
+	"github.com/distribution/reference"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+// ResolvePlatform resolves the image reference using the registry configuration and checks that the image is available for the platform.
+//
+// ResolvePlatform only fetches the image metadata (index, manifest and config), the image layers are not pulled.
+// ResolvePlatform returns the digest of the resolved image (which might be an image index).
+//
+//nolint:gocyclo
+func ResolvePlatform(ctx context.Context, reg config.Registries, ref string, platform ocispec.Platform) (digest.Digest, error) {
+	namedRef, err := reference.ParseDockerRef(ref)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse image reference %q: %w", ref, err)
+	}
+
+	resolver := NewResolver(reg)
+
+	name, desc, err := resolver.Resolve(ctx, namedRef.String())
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve image %q: %w", ref, err)
+	}
+
+	fetcher, err := resolver.Fetcher(ctx, name)
+	if err != nil {
+		return "", err
+	}
+
+	matcher := platforms.OnlyStrict(platform)
+
+	data, err := fetchBlob(ctx, fetcher, desc)
+	if err != nil {
+		return "", fmt.Errorf("failed to fetch image %q: %w", ref, err)
+	}
+
+	switch desc.MediaType {
+	case ocispec.MediaTypeImageIndex, images.MediaTypeDockerSchema2ManifestList:
+		var index ocispec.Index
+
+		if err = json.Unmarshal(data, &index); err != nil {
+			return "", fmt.Errorf("failed to unmarshal image index: %w", err)
+		}
+
+		available := make([]string, 0, len(index.Manifests))
+
+		for _, manifest := range index.Manifests {
+			if manifest.Platform == nil {
+				continue
+			}
+
+			if matcher.Match(*manifest.Platform) {
+				return desc.Digest, nil
+			}
+
+			available = append(available, platforms.Format(*manifest.Platform))
+		}
+
+		return "", fmt.Errorf("image %q is not available for platform %s, available platforms: %s", ref, platforms.Format(platform), strings.Join(available, ", "))
+	case ocispec.MediaTypeImageManifest, images.MediaTypeDockerSchema2Manifest:
+		var manifest ocispec.Manifest
+
+		if err = json.Unmarshal(data, &manifest); err != nil {
+			return "", fmt.Errorf("failed to unmarshal image manifest: %w", err)
+		}
+
+		configData, err := fetchBlob(ctx, fetcher, manifest.Config)
+		if err != nil {
+			return "", fmt.Errorf("failed to fetch image config: %w", err)
+		}
+
+		var imageConfig ocispec.Image
+
+		if err = json.Unmarshal(configData, &imageConfig); err != nil {
+			return "", fmt.Errorf("failed to unmarshal image config: %w", err)
+		}
+
+		if !matcher.Match(imageConfig.Platform) {
+			return "", fmt.Errorf("image %q is built for platform %s, expected %s", ref, platforms.Format(imageConfig.Platform), platforms.Format(platform))
+		}
+
+		return desc.Digest, nil
+	default:
+		return "", fmt.Errorf("unsupported image media type %q", desc.MediaType)
+	}
+}
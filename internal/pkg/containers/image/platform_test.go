@@ -0,0 +1,136 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package image_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/containers/image"
+)
+
+func platformImage(t *testing.T, arch string) v1.Image {
+	t.Helper()
+
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+
+	cfg, err := img.ConfigFile()
+	require.NoError(t, err)
+
+	cfg.OS = "linux"
+	cfg.Architecture = arch
+
+	img, err = mutate.ConfigFile(img, cfg)
+	require.NoError(t, err)
+
+	return img
+}
+
+func TestResolvePlatform(t *testing.T) {
+	t.Parallel()
+
+	reg, srvHost := startRegistry(t)
+
+	indexRef, err := name.ParseReference(srvHost+"/siderolabs/installer:multiarch", name.Insecure)
+	require.NoError(t, err)
+
+	index := mutate.AppendManifests(empty.Index,
+		mutate.IndexAddendum{
+			Add:        platformImage(t, "amd64"),
+			Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: "amd64"}},
+		},
+		mutate.IndexAddendum{
+			Add:        platformImage(t, "arm64"),
+			Descriptor: v1.Descriptor{Platform: &v1.Platform{OS: "linux", Architecture: "arm64"}},
+		},
+	)
+
+	require.NoError(t, remote.WriteIndex(indexRef, index))
+
+	indexDigest, err := index.Digest()
+	require.NoError(t, err)
+
+	singleRef, err := name.ParseReference(srvHost+"/siderolabs/installer:arm64", name.Insecure)
+	require.NoError(t, err)
+
+	singleImage := platformImage(t, "arm64")
+
+	require.NoError(t, remote.Write(singleRef, singleImage))
+
+	singleDigest, err := singleImage.Digest()
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		name     string
+		ref      string
+		platform ocispec.Platform
+
+		expectedDigest string
+		expectedError  string
+	}{
+		{
+			name:     "index amd64",
+			ref:      "registry.test/siderolabs/installer:multiarch",
+			platform: ocispec.Platform{OS: "linux", Architecture: "amd64"},
+
+			expectedDigest: indexDigest.String(),
+		},
+		{
+			name:     "index riscv64",
+			ref:      "registry.test/siderolabs/installer:multiarch",
+			platform: ocispec.Platform{OS: "linux", Architecture: "riscv64"},
+
+			expectedError: `image "registry.test/siderolabs/installer:multiarch" is not available for platform linux/riscv64, available platforms: linux/amd64, linux/arm64`,
+		},
+		{
+			name:     "single arm64",
+			ref:      "registry.test/siderolabs/installer:arm64",
+			platform: ocispec.Platform{OS: "linux", Architecture: "arm64"},
+
+			expectedDigest: singleDigest.String(),
+		},
+		{
+			name:     "single amd64",
+			ref:      "registry.test/siderolabs/installer:arm64",
+			platform: ocispec.Platform{OS: "linux", Architecture: "amd64"},
+
+			expectedError: `image "registry.test/siderolabs/installer:arm64" is built for platform linux/arm64, expected linux/amd64`,
+		},
+		{
+			name:     "missing",
+			ref:      "registry.test/siderolabs/installer:missing",
+			platform: ocispec.Platform{OS: "linux", Architecture: "amd64"},
+
+			expectedError: `failed to resolve image "registry.test/siderolabs/installer:missing"`,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			digest, err := image.ResolvePlatform(context.Background(), reg, test.ref, test.platform)
+
+			if test.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedDigest, digest.String())
+		})
+	}
+}
@@ -0,0 +1,215 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package image
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/containerd/containerd/v2/core/remotes"
+	"github.com/distribution/reference"
+	"github.com/opencontainers/go-digest"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+// Cosign signature format constants.
+const (
+	cosignSignatureAnnotation = "dev.cosignproject.cosign/signature"
+	cosignPayloadType         = "cosign container image signature"
+)
+
+// maxBlobSize limits the size of the fetched manifests, image configs and signature payloads.
+const maxBlobSize = 1024 * 1024
+
+// cosignPayload is the "simple signing" payload signed by cosign.
+type cosignPayload struct {
+	Critical struct {
+		Identity struct {
+			DockerReference string `json:"docker-reference"`
+		} `json:"identity"`
+		Image struct {
+			DockerManifestDigest string `json:"docker-manifest-digest"`
+		} `json:"image"`
+		Type string `json:"type"`
+	} `json:"critical"`
+}
+
+// VerifySignature verifies the cosign signature of the image against the trusted public keys.
+//
+// The signature is looked up as `<repository>:sha256-<digest>.sig` tag, as pushed by `cosign sign`.
+// VerifySignature returns the image reference pinned to the verified digest, so that the image
+// which is pulled later on is exactly the one which was verified.
+func VerifySignature(ctx context.Context, reg config.Registries, ref string, verification config.ImageVerificationConfig) (string, error) {
+	namedRef, err := reference.ParseDockerRef(ref)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse image reference %q: %w", ref, err)
+	}
+
+	if _, ok := namedRef.(reference.Canonical); !ok && verification.RequireDigest() {
+		return "", fmt.Errorf("image reference %q is not pinned by digest", ref)
+	}
+
+	resolver := NewResolver(reg)
+
+	_, desc, err := resolver.Resolve(ctx, namedRef.String())
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve image %q: %w", ref, err)
+	}
+
+	signatureRef := fmt.Sprintf("%s:%s-%s.sig", namedRef.Name(), desc.Digest.Algorithm(), desc.Digest.Encoded())
+
+	name, signatureDesc, err := resolver.Resolve(ctx, signatureRef)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve image signature %q: %w", signatureRef, err)
+	}
+
+	fetcher, err := resolver.Fetcher(ctx, name)
+	if err != nil {
+		return "", err
+	}
+
+	if err = verifyCosignSignatures(ctx, fetcher, signatureDesc, desc.Digest, verification.PublicKeys()); err != nil {
+		return "", fmt.Errorf("image %q signature verification failed: %w", ref, err)
+	}
+
+	return namedRef.Name() + "@" + desc.Digest.String(), nil
+}
+
+// verifyCosignSignatures succeeds if any of the signatures in the signature manifest is valid.
+func verifyCosignSignatures(ctx context.Context, fetcher remotes.Fetcher, signatureDesc ocispec.Descriptor, imageDigest digest.Digest, keys []crypto.PublicKey) error {
+	if len(keys) == 0 {
+		return errors.New("no trusted public keys")
+	}
+
+	manifestData, err := fetchBlob(ctx, fetcher, signatureDesc)
+	if err != nil {
+		return fmt.Errorf("failed to fetch signature manifest: %w", err)
+	}
+
+	var manifest ocispec.Manifest
+
+	if err = json.Unmarshal(manifestData, &manifest); err != nil {
+		return fmt.Errorf("failed to unmarshal signature manifest: %w", err)
+	}
+
+	var errs error
+
+	for _, layer := range manifest.Layers {
+		signature, ok := layer.Annotations[cosignSignatureAnnotation]
+		if !ok {
+			continue
+		}
+
+		payload, err := fetchBlob(ctx, fetcher, layer)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("failed to fetch signature payload: %w", err))
+
+			continue
+		}
+
+		if err = verifyCosignPayload(payload, signature, imageDigest, keys); err != nil {
+			errs = errors.Join(errs, err)
+
+			continue
+		}
+
+		return nil
+	}
+
+	if errs == nil {
+		errs = errors.New("no cosign signatures found")
+	}
+
+	return errs
+}
+
+func verifyCosignPayload(payload []byte, signature string, imageDigest digest.Digest, keys []crypto.PublicKey) error {
+	sig, err := base64.StdEncoding.DecodeString(signature)
+	if err != nil {
+		return fmt.Errorf("failed to decode signature: %w", err)
+	}
+
+	if !verifyWithAnyKey(payload, sig, keys) {
+		return errors.New("signature doesn't match any of the trusted public keys")
+	}
+
+	var p cosignPayload
+
+	if err = json.Unmarshal(payload, &p); err != nil {
+		return fmt.Errorf("failed to unmarshal signature payload: %w", err)
+	}
+
+	if p.Critical.Type != cosignPayloadType {
+		return fmt.Errorf("unexpected signature payload type %q", p.Critical.Type)
+	}
+
+	if p.Critical.Image.DockerManifestDigest != imageDigest.String() {
+		return fmt.Errorf("signature is for digest %q, expected %q", p.Critical.Image.DockerManifestDigest, imageDigest)
+	}
+
+	return nil
+}
+
+func verifyWithAnyKey(payload, sig []byte, keys []crypto.PublicKey) bool {
+	hash := sha256.Sum256(payload)
+
+	for _, key := range keys {
+		switch key := key.(type) {
+		case *ecdsa.PublicKey:
+			if ecdsa.VerifyASN1(key, hash[:], sig) {
+				return true
+			}
+		case *rsa.PublicKey:
+			if rsa.VerifyPKCS1v15(key, crypto.SHA256, hash[:], sig) == nil {
+				return true
+			}
+		case ed25519.PublicKey:
+			if ed25519.Verify(key, payload, sig) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func fetchBlob(ctx context.Context, fetcher remotes.Fetcher, desc ocispec.Descriptor) ([]byte, error) {
+	if err := desc.Digest.Validate(); err != nil {
+		return nil, err
+	}
+
+	if desc.Size > maxBlobSize {
+		return nil, fmt.Errorf("blob %s is too large: %d bytes", desc.Digest, desc.Size)
+	}
+
+	r, err := fetcher.Fetch(ctx, desc)
+	if err != nil {
+		return nil, err
+	}
+
+	defer r.Close() //nolint:errcheck
+
+	data, err := io.ReadAll(io.LimitReader(r, maxBlobSize))
+	if err != nil {
+		return nil, err
+	}
+
+	if desc.Digest.Algorithm().FromBytes(data) != desc.Digest {
+		return nil, fmt.Errorf("blob %s digest mismatch", desc.Digest)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,198 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package image_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"log"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/registry"
+	v1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/empty"
+	"github.com/google/go-containerregistry/pkg/v1/mutate"
+	"github.com/google/go-containerregistry/pkg/v1/random"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/google/go-containerregistry/pkg/v1/static"
+	"github.com/google/go-containerregistry/pkg/v1/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/containers/image"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+// startRegistry starts an in-memory registry, which is available as registry.test mirror in the returned config.
+func startRegistry(t *testing.T) (*mockConfig, string) {
+	t.Helper()
+
+	srv := httptest.NewServer(registry.New(registry.Logger(log.New(io.Discard, "", 0))))
+	t.Cleanup(srv.Close)
+
+	return &mockConfig{
+		mirrors: map[string]*v1alpha1.RegistryMirrorConfig{
+			"registry.test": {
+				MirrorEndpoints: []string{srv.URL},
+			},
+		},
+	}, strings.TrimPrefix(srv.URL, "http://")
+}
+
+func generateKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+
+	return key, string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der}))
+}
+
+// pushSigned pushes a random image and its cosign signature (made with the key) to the registry.
+func pushSigned(t *testing.T, ref name.Reference, key *ecdsa.PrivateKey) v1.Hash {
+	t.Helper()
+
+	img, err := random.Image(1024, 1)
+	require.NoError(t, err)
+
+	require.NoError(t, remote.Write(ref, img))
+
+	imgDigest, err := img.Digest()
+	require.NoError(t, err)
+
+	payload := fmt.Sprintf(
+		`{"critical":{"identity":{"docker-reference":%q},"image":{"docker-manifest-digest":%q},"type":"cosign container image signature"},"optional":null}`,
+		ref.Context().Name(), imgDigest.String(),
+	)
+
+	hash := sha256.Sum256([]byte(payload))
+
+	sig, err := ecdsa.SignASN1(rand.Reader, key, hash[:])
+	require.NoError(t, err)
+
+	signatureImg, err := mutate.Append(empty.Image, mutate.Addendum{
+		Layer: static.NewLayer([]byte(payload), types.MediaType("application/vnd.dev.cosign.simplesigning.v1+json")),
+		Annotations: map[string]string{
+			"dev.cosignproject.cosign/signature": base64.StdEncoding.EncodeToString(sig),
+		},
+	})
+	require.NoError(t, err)
+
+	signatureRef := ref.Context().Tag(strings.ReplaceAll(imgDigest.String(), ":", "-") + ".sig")
+
+	require.NoError(t, remote.Write(signatureRef, signatureImg))
+
+	return imgDigest
+}
+
+func TestVerifySignature(t *testing.T) {
+	t.Parallel()
+
+	reg, srvHost := startRegistry(t)
+
+	trustedKey, trustedPublicKey := generateKey(t)
+	untrustedKey, _ := generateKey(t)
+
+	signedRef, err := name.ParseReference(srvHost+"/siderolabs/installer:signed", name.Insecure)
+	require.NoError(t, err)
+
+	signedDigest := pushSigned(t, signedRef, trustedKey)
+
+	untrustedRef, err := name.ParseReference(srvHost+"/siderolabs/installer:untrusted", name.Insecure)
+	require.NoError(t, err)
+
+	pushSigned(t, untrustedRef, untrustedKey)
+
+	unsignedRef, err := name.ParseReference(srvHost+"/siderolabs/installer:unsigned", name.Insecure)
+	require.NoError(t, err)
+
+	unsignedImg, err := random.Image(1024, 1)
+	require.NoError(t, err)
+
+	require.NoError(t, remote.Write(unsignedRef, unsignedImg))
+
+	cfg := security.NewImageVerificationConfigV1Alpha1()
+	cfg.VerificationPublicKeys = []string{trustedPublicKey}
+
+	digestCfg := security.NewImageVerificationConfigV1Alpha1()
+	digestCfg.VerificationPublicKeys = []string{trustedPublicKey}
+	digestCfg.VerificationRequireDigest = true
+
+	for _, test := range []struct {
+		name string
+		ref  string
+		cfg  *security.ImageVerificationConfigV1Alpha1
+
+		expectedRef   string
+		expectedError string
+	}{
+		{
+			name: "signed",
+			ref:  "registry.test/siderolabs/installer:signed",
+			cfg:  cfg,
+
+			expectedRef: "registry.test/siderolabs/installer@" + signedDigest.String(),
+		},
+		{
+			name: "signed pinned",
+			ref:  "registry.test/siderolabs/installer@" + signedDigest.String(),
+			cfg:  digestCfg,
+
+			expectedRef: "registry.test/siderolabs/installer@" + signedDigest.String(),
+		},
+		{
+			name: "not pinned",
+			ref:  "registry.test/siderolabs/installer:signed",
+			cfg:  digestCfg,
+
+			expectedError: `image reference "registry.test/siderolabs/installer:signed" is not pinned by digest`,
+		},
+		{
+			name: "untrusted key",
+			ref:  "registry.test/siderolabs/installer:untrusted",
+			cfg:  cfg,
+
+			expectedError: "signature doesn't match any of the trusted public keys",
+		},
+		{
+			name: "unsigned",
+			ref:  "registry.test/siderolabs/installer:unsigned",
+			cfg:  cfg,
+
+			expectedError: "failed to resolve image signature",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			pinnedRef, err := image.VerifySignature(context.Background(), reg, test.ref, test.cfg)
+
+			if test.expectedError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedRef, pinnedRef)
+		})
+	}
+}
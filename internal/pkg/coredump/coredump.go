@@ -0,0 +1,228 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package coredump stores compressed core dumps of the crashed processes.
+package coredump
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"golang.org/x/sys/unix"
+)
+
+// Ext is the extension of the compressed core dump files.
+const Ext = ".core.zst"
+
+// reservedSpace is the free space left on the filesystem after writing the core dump.
+const reservedSpace = 8 * 1024 * 1024
+
+// ErrTruncated is returned when the core dump was truncated to the size limit.
+var ErrTruncated = errors.New("core dump truncated")
+
+// Dump describes a stored core dump.
+type Dump struct {
+	// Time of the crash.
+	Timestamp time.Time
+	// PID of the crashed process.
+	PID int
+	// Name (comm) of the crashed process.
+	Name string
+}
+
+// FileName returns the name of the core dump file.
+func (d Dump) FileName() string {
+	return fmt.Sprintf("%d-%s-%d%s", d.Timestamp.Unix(), sanitize(d.Name), d.PID, Ext)
+}
+
+// Save compresses the core dump and stores it in the directory.
+//
+// Only maxCount latest dumps are kept, the compressed dump is truncated to maxSize,
+// and to the free space available on the filesystem.
+func Save(dir string, dump Dump, r io.Reader, maxCount int, maxSize int64) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+
+	if err := rotate(dir, maxCount-1); err != nil {
+		return err
+	}
+
+	var statfs unix.Statfs_t
+
+	if err := unix.Statfs(dir, &statfs); err != nil {
+		return err
+	}
+
+	maxSize = min(maxSize, int64(statfs.Bavail)*statfs.Bsize-reservedSpace)
+	if maxSize <= 0 {
+		return errors.New("not enough free space")
+	}
+
+	f, err := os.OpenFile(filepath.Join(dir, dump.FileName()), os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	w := bufio.NewWriter(&limitedWriter{w: f, n: maxSize})
+
+	encoder, err := zstd.NewWriter(w, zstd.WithEncoderLevel(zstd.SpeedFastest), zstd.WithEncoderConcurrency(1))
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(encoder, r)
+	if err == nil {
+		err = encoder.Close()
+	}
+
+	if err == nil {
+		err = w.Flush()
+	}
+
+	truncated := errors.Is(err, ErrTruncated)
+
+	if err != nil && !truncated {
+		return err
+	}
+
+	if err = f.Close(); err != nil {
+		return err
+	}
+
+	if truncated {
+		return ErrTruncated
+	}
+
+	return nil
+}
+
+// List the core dumps in the directory, latest first.
+func List(dir string) ([]Dump, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	dumps := make([]Dump, 0, len(entries))
+
+	for _, entry := range entries {
+		if !entry.Type().IsRegular() {
+			continue
+		}
+
+		dump, ok := ParseFileName(entry.Name())
+		if !ok {
+			continue
+		}
+
+		dumps = append(dumps, dump)
+	}
+
+	slices.SortStableFunc(dumps, func(a, b Dump) int {
+		return b.Timestamp.Compare(a.Timestamp)
+	})
+
+	return dumps, nil
+}
+
+// ParseFileName parses the core dump file name.
+func ParseFileName(name string) (Dump, bool) {
+	base, ok := strings.CutSuffix(name, Ext)
+	if !ok {
+		return Dump{}, false
+	}
+
+	timestamp, rest, ok := strings.Cut(base, "-")
+	if !ok {
+		return Dump{}, false
+	}
+
+	idx := strings.LastIndexByte(rest, '-')
+	if idx == -1 {
+		return Dump{}, false
+	}
+
+	sec, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return Dump{}, false
+	}
+
+	pid, err := strconv.Atoi(rest[idx+1:])
+	if err != nil {
+		return Dump{}, false
+	}
+
+	return Dump{
+		Timestamp: time.Unix(sec, 0),
+		PID:       pid,
+		Name:      rest[:idx],
+	}, true
+}
+
+// rotate removes the oldest dumps, so that at most keep dumps remain.
+func rotate(dir string, keep int) error {
+	dumps, err := List(dir)
+	if err != nil {
+		return err
+	}
+
+	for _, dump := range dumps[min(len(dumps), max(keep, 0)):] {
+		if err = os.Remove(filepath.Join(dir, dump.FileName())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// sanitize the process name to be used in the file name.
+func sanitize(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_', r == '.', r == '-':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
+
+type limitedWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (lw *limitedWriter) Write(p []byte) (int, error) {
+	if int64(len(p)) <= lw.n {
+		n, err := lw.w.Write(p)
+		lw.n -= int64(n)
+
+		return n, err
+	}
+
+	n, err := lw.w.Write(p[:lw.n])
+	lw.n -= int64(n)
+
+	if err == nil {
+		err = ErrTruncated
+	}
+
+	return n, err
+}
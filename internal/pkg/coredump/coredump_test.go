@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package coredump_test
+
+import (
+	"bytes"
+	"crypto/rand"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/coredump"
+)
+
+func TestSave(t *testing.T) {
+	dir := t.TempDir()
+
+	core := bytes.Repeat([]byte("core"), 1024)
+
+	for i := range 5 {
+		require.NoError(t, coredump.Save(dir, coredump.Dump{
+			Timestamp: time.Unix(int64(1700000000+i), 0),
+			PID:       100 + i,
+			Name:      "kube let/1",
+		}, bytes.NewReader(core), 3, 1024*1024))
+	}
+
+	dumps, err := coredump.List(dir)
+	require.NoError(t, err)
+
+	// only the latest dumps are kept
+	require.Len(t, dumps, 3)
+
+	for i, dump := range dumps {
+		assert.Equal(t, time.Unix(int64(1700000004-i), 0), dump.Timestamp)
+		assert.Equal(t, 104-i, dump.PID)
+		assert.Equal(t, "kube_let_1", dump.Name)
+	}
+
+	assert.Equal(t, "1700000004-kube_let_1-104.core.zst", dumps[0].FileName())
+
+	f, err := os.Open(filepath.Join(dir, dumps[0].FileName()))
+	require.NoError(t, err)
+
+	defer f.Close() //nolint:errcheck
+
+	decoder, err := zstd.NewReader(f)
+	require.NoError(t, err)
+
+	defer decoder.Close()
+
+	contents, err := io.ReadAll(decoder)
+	require.NoError(t, err)
+
+	assert.Equal(t, core, contents)
+}
+
+func TestSaveTruncated(t *testing.T) {
+	dir := t.TempDir()
+
+	dump := coredump.Dump{
+		Timestamp: time.Unix(1700000000, 0),
+		PID:       1234,
+		Name:      "udevd",
+	}
+
+	// random data doesn't compress
+	err := coredump.Save(dir, dump, io.LimitReader(rand.Reader, 1024*1024), 3, 64*1024)
+	require.ErrorIs(t, err, coredump.ErrTruncated)
+
+	st, err := os.Stat(filepath.Join(dir, dump.FileName()))
+	require.NoError(t, err)
+
+	assert.EqualValues(t, 64*1024, st.Size())
+}
+
+func TestParseFileName(t *testing.T) {
+	dump, ok := coredump.ParseFileName("1700000000-containerd-shim-1234.core.zst")
+	require.True(t, ok)
+
+	assert.Equal(t, coredump.Dump{Timestamp: time.Unix(1700000000, 0), PID: 1234, Name: "containerd-shim"}, dump)
+
+	for _, name := range []string{
+		"config.yaml",
+		"1700000000.core.zst",
+		"1700000000-udevd.core.zst",
+		"now-udevd-1234.core.zst",
+	} {
+		_, ok = coredump.ParseFileName(name)
+		assert.False(t, ok, name)
+	}
+}
@@ -55,7 +55,11 @@ func AnnotationsFromAffiliate(affiliate *cluster.Affiliate) map[string]string {
 		kubeSpanAddress = affiliate.TypedSpec().KubeSpan.Address.String()
 	}
 
-	var apiServerPort string
+	var apiServerPort, nodeMaintenance string
+
+	if affiliate.TypedSpec().NodeMaintenance {
+		nodeMaintenance = "true"
+	}
 
 	if affiliate.TypedSpec().ControlPlane != nil {
 		apiServerPort = strconv.Itoa(affiliate.TypedSpec().ControlPlane.APIServerPort)
@@ -63,6 +67,7 @@ func AnnotationsFromAffiliate(affiliate *cluster.Affiliate) map[string]string {
 
 	return map[string]string{
 		constants.ClusterNodeIDAnnotation:            affiliate.Metadata().ID(),
+		constants.ClusterNodeMaintenanceAnnotation:   nodeMaintenance,
 		constants.NetworkSelfIPsAnnotation:           ipsToString(affiliate.TypedSpec().Addresses),
 		constants.NetworkAPIServerPortAnnotation:     apiServerPort,
 		constants.KubeSpanIPAnnotation:               kubeSpanAddress,
@@ -131,6 +136,10 @@ func AffiliateFromNode(node *v1.Node) *cluster.AffiliateSpec {
 		affiliate.KubeSpan.Endpoints = parseIPPorts(endpoints)
 	}
 
+	if nodeMaintenance, ok := node.Annotations[constants.ClusterNodeMaintenanceAnnotation]; ok {
+		affiliate.NodeMaintenance = nodeMaintenance == "true"
+	}
+
 	if apiServerPort, ok := node.Annotations[constants.NetworkAPIServerPortAnnotation]; ok {
 		if port, err := strconv.Atoi(apiServerPort); err == nil {
 			affiliate.ControlPlane = &cluster.ControlPlane{
@@ -28,6 +28,7 @@ func TestAnnotationsFromAffiliate(t *testing.T) {
 			name: "zero",
 			expected: map[string]string{
 				"cluster.talos.dev/node-id":                "",
+				"cluster.talos.dev/node-maintenance":       "",
 				"networking.talos.dev/api-server-port":     "",
 				"networking.talos.dev/assigned-prefixes":   "",
 				"networking.talos.dev/kubespan-endpoints":  "",
@@ -53,6 +54,7 @@ func TestAnnotationsFromAffiliate(t *testing.T) {
 			},
 			expected: map[string]string{
 				"cluster.talos.dev/node-id":                "29QQTc97U5ZyFTIX33Dp9NqtwxqQI8QI13scCLzffrZ",
+				"cluster.talos.dev/node-maintenance":       "",
 				"networking.talos.dev/api-server-port":     "",
 				"networking.talos.dev/assigned-prefixes":   "10.244.3.1/24",
 				"networking.talos.dev/kubespan-endpoints":  "10.0.0.2:51820,192.168.3.4:51820",
@@ -72,9 +74,11 @@ func TestAnnotationsFromAffiliate(t *testing.T) {
 				ControlPlane: &cluster.ControlPlane{
 					APIServerPort: 443,
 				},
+				NodeMaintenance: true,
 			},
 			expected: map[string]string{
 				"cluster.talos.dev/node-id":                "29QQTc97U5ZyFTIX33Dp9NqtwxqQI8QI13scCLzffrZ",
+				"cluster.talos.dev/node-maintenance":       "true",
 				"networking.talos.dev/api-server-port":     "443",
 				"networking.talos.dev/assigned-prefixes":   "",
 				"networking.talos.dev/kubespan-endpoints":  "",
@@ -162,6 +166,7 @@ func TestAffiliateFromNode(t *testing.T) {
 					Name: "bar",
 					Annotations: map[string]string{
 						"cluster.talos.dev/node-id":            "29QQTc97U5ZyFTIX33Dp9NqtwxqQI8QI13scCLzffrZ",
+						"cluster.talos.dev/node-maintenance":   "true",
 						"networking.talos.dev/api-server-port": "6443",
 						"networking.talos.dev/self-ips":        "10.0.0.2,192.168.3.4",
 					},
@@ -192,6 +197,7 @@ func TestAffiliateFromNode(t *testing.T) {
 				ControlPlane: &cluster.ControlPlane{
 					APIServerPort: 6443,
 				},
+				NodeMaintenance: true,
 			},
 		},
 	} {
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"maps"
 	"net"
 	"net/netip"
 	"slices"
@@ -31,12 +32,13 @@ import (
 
 // Cache is a [dns.Handler] to [plugin.Handler] adapter.
 type Cache struct {
-	cache  *cache.Cache
-	logger *zap.Logger
+	cache   *cache.Cache
+	logger  *zap.Logger
+	metrics *Metrics
 }
 
 // NewCache creates a new Cache.
-func NewCache(next plugin.Handler, l *zap.Logger) *Cache {
+func NewCache(next plugin.Handler, l *zap.Logger, metrics *Metrics) *Cache {
 	c := cache.NewCache(
 		"zones",
 		"view",
@@ -44,11 +46,13 @@ func NewCache(next plugin.Handler, l *zap.Logger) *Cache {
 	)
 	c.Next = next
 
-	return &Cache{cache: c, logger: l}
+	return &Cache{cache: c, logger: l, metrics: metrics}
 }
 
 // ServeDNS implements [dns.Handler].
 func (c *Cache) ServeDNS(wr dns.ResponseWriter, msg *dns.Msg) {
+	c.metrics.observeRequest()
+
 	wr = request.NewScrubWriter(msg, wr)
 
 	ctx, cancel := context.WithTimeout(context.Background(), 4500*time.Millisecond)
@@ -89,15 +93,18 @@ func clientWrite(rcode int) bool {
 
 // Handler is a dns proxy selector.
 type Handler struct {
-	mx     sync.RWMutex
-	dests  []*proxy.Proxy
-	logger *zap.Logger
+	mx      sync.RWMutex
+	dests   []*proxy.Proxy
+	stubs   map[string][]*proxy.Proxy
+	logger  *zap.Logger
+	metrics *Metrics
 }
 
 // NewHandler creates a new Handler.
-func NewHandler(logger *zap.Logger) *Handler {
+func NewHandler(logger *zap.Logger, metrics *Metrics) *Handler {
 	return &Handler{
-		logger: logger,
+		logger:  logger,
+		metrics: metrics,
 	}
 }
 
@@ -117,7 +124,11 @@ func (h *Handler) ServeDNS(ctx context.Context, wrt dns.ResponseWriter, msg *dns
 
 	h.logger.Debug("dns request", zap.Stringer("data", msg))
 
-	if len(h.dests) == 0 {
+	h.metrics.observeForwarded()
+
+	dests := h.destinations(req.Name())
+
+	if len(dests) == 0 {
 		return dns.RcodeServerFailure, errors.New("no destination available")
 	}
 
@@ -126,7 +137,7 @@ func (h *Handler) ServeDNS(ctx context.Context, wrt dns.ResponseWriter, msg *dns
 		err  error
 	)
 
-	for _, ups := range h.dests {
+	for _, ups := range dests {
 		opts := proxy.Options{}
 
 		for {
@@ -145,6 +156,8 @@ func (h *Handler) ServeDNS(ctx context.Context, wrt dns.ResponseWriter, msg *dns
 		}
 
 		if resp != nil && (resp.Rcode == dns.RcodeServerFailure || resp.Rcode == dns.RcodeRefused) {
+			h.metrics.observeUpstreamError(ups.Addr())
+
 			continue
 		}
 
@@ -152,7 +165,7 @@ func (h *Handler) ServeDNS(ctx context.Context, wrt dns.ResponseWriter, msg *dns
 			break
 		}
 
-		continue
+		h.metrics.observeUpstreamError(ups.Addr())
 	}
 
 	if ctx.Err() != nil {
@@ -192,8 +205,48 @@ func (h *Handler) SetProxy(prxs []*proxy.Proxy) bool {
 	return true
 }
 
+// SetStubDomains sets destination dns proxy servers of the stub domains.
+func (h *Handler) SetStubDomains(stubs map[string][]*proxy.Proxy) bool {
+	h.mx.Lock()
+	defer h.mx.Unlock()
+
+	normalized := make(map[string][]*proxy.Proxy, len(stubs))
+
+	for domain, prxs := range stubs {
+		normalized[dns.CanonicalName(domain)] = prxs
+	}
+
+	if maps.EqualFunc(h.stubs, normalized, slices.Equal) {
+		return false
+	}
+
+	h.stubs = normalized
+
+	return true
+}
+
+// destinations returns the proxies for the name: the proxies of the longest matching stub domain,
+// or the default proxies if the name doesn't belong to any stub domain.
+func (h *Handler) destinations(name string) []*proxy.Proxy {
+	var (
+		dests       = h.dests
+		matchLabels = -1
+	)
+
+	for domain, prxs := range h.stubs {
+		if labels := dns.CountLabel(domain); labels > matchLabels && dns.IsSubDomain(domain, name) {
+			dests, matchLabels = prxs, labels
+		}
+	}
+
+	return dests
+}
+
 // Stop stops and clears dns proxy selector.
-func (h *Handler) Stop() { h.SetProxy(nil) }
+func (h *Handler) Stop() {
+	h.SetProxy(nil)
+	h.SetStubDomains(nil)
+}
 
 // NewNodeHandler creates a new NodeHandler.
 func NewNodeHandler(next plugin.Handler, hostMapper HostMapper, logger *zap.Logger) *NodeHandler {
@@ -8,11 +8,13 @@ import (
 	"context"
 	"net"
 	"net/netip"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/coredns/coredns/plugin/pkg/proxy"
 	dnssrv "github.com/miekg/dns"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"github.com/siderolabs/gen/ensure"
 	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/gen/xtesting/check"
@@ -110,10 +112,102 @@ func TestDNSEmptyDestinations(t *testing.T) {
 	stop()
 }
 
+func TestDNSStubDomains(t *testing.T) {
+	l := zaptest.NewLogger(t)
+
+	metrics := dns.NewMetrics()
+
+	handler := dns.NewHandler(l, metrics)
+	t.Cleanup(handler.Stop)
+
+	handler.SetProxy([]*proxy.Proxy{newUpstream(t, "10.0.0.1")})
+	handler.SetStubDomains(map[string][]*proxy.Proxy{
+		"corp.example.com":     {newUpstream(t, "10.0.0.2")},
+		"lab.corp.example.com": {newUpstream(t, "10.0.0.3")},
+	})
+
+	pc, err := dns.NewUDPPacketConn("udp", "127.0.0.1:0", ensure.Value(dns.MakeControl("udp", false)))
+	require.NoError(t, err)
+
+	srv := dns.NewServer(dns.ServerOptions{
+		PacketConn: pc,
+		Handler:    dns.NewCache(handler, l, metrics),
+		Logger:     l,
+	})
+
+	stop, _ := srv.Start(func(err error) {
+		if err != nil {
+			t.Errorf("error running dns server: %v", err)
+		}
+	})
+	t.Cleanup(stop)
+
+	for _, test := range []struct {
+		hostname string
+		expected string
+	}{
+		{hostname: "www.example.com", expected: "10.0.0.1"},
+		{hostname: "corp.example.com", expected: "10.0.0.2"},
+		{hostname: "git.CORP.example.com", expected: "10.0.0.2"},
+		{hostname: "host.lab.corp.example.com", expected: "10.0.0.3"},
+		{hostname: "notcorp.example.com", expected: "10.0.0.1"},
+		// served from the cache
+		{hostname: "www.example.com", expected: "10.0.0.1"},
+	} {
+		r, err := dnssrv.Exchange(createQuery(test.hostname), pc.LocalAddr().String())
+		require.NoError(t, err)
+		require.Equal(t, dnssrv.RcodeSuccess, r.Rcode, r)
+		require.Len(t, r.Answer, 1)
+
+		require.Equal(t, test.expected, r.Answer[0].(*dnssrv.A).A.String(), test.hostname) //nolint:forcetypeassert
+	}
+
+	require.NoError(t, testutil.CollectAndCompare(metrics, strings.NewReader(`
+# HELP talos_hostdns_forwarded_requests_total Number of requests which were not served from the cache and were forwarded to the upstream nameservers.
+# TYPE talos_hostdns_forwarded_requests_total counter
+talos_hostdns_forwarded_requests_total 5
+# HELP talos_hostdns_requests_total Number of requests received by the host DNS resolver.
+# TYPE talos_hostdns_requests_total counter
+talos_hostdns_requests_total 6
+`)))
+}
+
+// newUpstream starts a nameserver which answers all A queries with the address.
+func newUpstream(t *testing.T, address string) *proxy.Proxy {
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := &dnssrv.Server{
+		PacketConn: pc,
+		Handler: dnssrv.HandlerFunc(func(w dnssrv.ResponseWriter, req *dnssrv.Msg) {
+			resp := new(dnssrv.Msg).SetReply(req)
+			resp.Answer = []dnssrv.RR{
+				&dnssrv.A{
+					Hdr: dnssrv.RR_Header{Name: req.Question[0].Name, Rrtype: dnssrv.TypeA, Class: dnssrv.ClassINET, Ttl: 60},
+					A:   net.ParseIP(address),
+				},
+			}
+
+			w.WriteMsg(resp) //nolint:errcheck
+		}),
+	}
+
+	go srv.ActivateAndServe() //nolint:errcheck
+
+	t.Cleanup(func() { srv.Shutdown() }) //nolint:errcheck
+
+	p := proxy.NewProxy(address, pc.LocalAddr().String(), "dns")
+	p.Start(500 * time.Millisecond)
+
+	t.Cleanup(p.Stop)
+
+	return p
+}
+
 func newServer(t *testing.T, nameservers ...string) func() {
 	l := zaptest.NewLogger(t)
 
-	handler := dns.NewHandler(l)
+	handler := dns.NewHandler(l, nil)
 	t.Cleanup(handler.Stop)
 
 	pxs := xslices.Map(nameservers, func(ns string) *proxy.Proxy {
@@ -136,7 +230,7 @@ func newServer(t *testing.T, nameservers ...string) func() {
 
 	srv := dns.NewServer(dns.ServerOptions{
 		PacketConn: pc,
-		Handler:    dns.NewCache(nodeHandler, l),
+		Handler:    dns.NewCache(nodeHandler, l, nil),
 		Logger:     l,
 	})
 
@@ -0,0 +1,74 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package dns
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Metrics exposes host DNS resolver metrics as Prometheus metrics.
+//
+// Nil Metrics is valid and doesn't record anything.
+type Metrics struct {
+	requests       prometheus.Counter
+	forwarded      prometheus.Counter
+	upstreamErrors *prometheus.CounterVec
+}
+
+// NewMetrics creates a new Metrics.
+func NewMetrics() *Metrics {
+	return &Metrics{
+		requests: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "talos_hostdns_requests_total",
+			Help: "Number of requests received by the host DNS resolver.",
+		}),
+		forwarded: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "talos_hostdns_forwarded_requests_total",
+			Help: "Number of requests which were not served from the cache and were forwarded to the upstream nameservers.",
+		}),
+		upstreamErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "talos_hostdns_upstream_errors_total",
+			Help: "Number of failed requests to the upstream nameserver.",
+		}, []string{"upstream"}),
+	}
+}
+
+// Describe implements prometheus.Collector interface.
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.requests.Describe(ch)
+	m.forwarded.Describe(ch)
+	m.upstreamErrors.Describe(ch)
+}
+
+// Collect implements prometheus.Collector interface.
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.requests.Collect(ch)
+	m.forwarded.Collect(ch)
+	m.upstreamErrors.Collect(ch)
+}
+
+func (m *Metrics) observeRequest() {
+	if m == nil {
+		return
+	}
+
+	m.requests.Inc()
+}
+
+func (m *Metrics) observeForwarded() {
+	if m == nil {
+		return
+	}
+
+	m.forwarded.Inc()
+}
+
+func (m *Metrics) observeUpstreamError(upstream string) {
+	if m == nil {
+		return
+	}
+
+	m.upstreamErrors.WithLabelValues(upstream).Inc()
+}
@@ -97,23 +97,31 @@ func (c *Client) ValidateForUpgrade(ctx context.Context, config config.Config) e
 		return nil
 	}
 
+	return c.ValidateForMemberRestart(ctx, "upgrade")
+}
+
+// ValidateForMemberRestart validates the etcd cluster state to ensure that
+// the cluster keeps quorum while a single member is restarted.
+//
+// The operation is used in the error messages, e.g. "upgrade".
+func (c *Client) ValidateForMemberRestart(ctx context.Context, operation string) error {
 	resp, err := c.MemberList(ctx)
 	if err != nil {
 		return err
 	}
 
 	if len(resp.Members) == 2 {
-		return fmt.Errorf("etcd member count(%d) is insufficient to maintain quorum if upgrade commences", len(resp.Members))
+		return fmt.Errorf("etcd member count(%d) is insufficient to maintain quorum if %s commences", len(resp.Members), operation)
 	}
 
 	for _, member := range resp.Members {
 		// If the member is not started, the name will be an empty string.
 		if len(member.Name) == 0 {
-			return fmt.Errorf("etcd member %016x is not started, all members must be running to perform an upgrade", member.ID)
+			return fmt.Errorf("etcd member %016x is not started, all members must be running to perform the %s", member.ID, operation)
 		}
 
 		if err = validateMemberHealth(ctx, member.GetClientURLs()); err != nil {
-			return fmt.Errorf("etcd member %016x is not healthy; all members must be healthy to perform an upgrade: %w", member.ID, err)
+			return fmt.Errorf("etcd member %016x is not healthy; all members must be healthy to perform the %s: %w", member.ID, operation, err)
 		}
 	}
 
@@ -0,0 +1,67 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hwmon
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var sensorLabels = []string{"device", "chip", "sensor"}
+
+var valueDescs = map[Type]*prometheus.Desc{
+	TypeTemperature: prometheus.NewDesc("talos_hwmon_temperature_celsius", "Temperature reported by the hwmon sensor.", sensorLabels, nil),
+	TypeFan:         prometheus.NewDesc("talos_hwmon_fan_rpm", "Fan speed reported by the hwmon sensor.", sensorLabels, nil),
+	TypeVoltage:     prometheus.NewDesc("talos_hwmon_voltage_volts", "Voltage reported by the hwmon sensor.", sensorLabels, nil),
+	TypeCurrent:     prometheus.NewDesc("talos_hwmon_current_amperes", "Current reported by the hwmon sensor.", sensorLabels, nil),
+	TypePower:       prometheus.NewDesc("talos_hwmon_power_watts", "Power reported by the hwmon sensor.", sensorLabels, nil),
+}
+
+var criticalDesc = prometheus.NewDesc(
+	"talos_hwmon_sensor_critical",
+	"Whether the hwmon sensor is in alarm state or beyond the critical threshold (1) or not (0).",
+	append(sensorLabels, "type"), nil,
+)
+
+// Collector exposes hwmon sensors as Prometheus metrics.
+type Collector struct {
+	// Root is the hwmon sysfs root, defaults to SysfsPath.
+	Root string
+}
+
+// Describe implements prometheus.Collector interface.
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	for _, desc := range valueDescs {
+		ch <- desc
+	}
+
+	ch <- criticalDesc
+}
+
+// Collect implements prometheus.Collector interface.
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	root := c.Root
+	if root == "" {
+		root = SysfsPath
+	}
+
+	sensors, err := Read(root)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(criticalDesc, err)
+
+		return
+	}
+
+	for _, sensor := range sensors {
+		ch <- prometheus.MustNewConstMetric(valueDescs[sensor.Type], prometheus.GaugeValue, sensor.Value, sensor.Device, sensor.Chip, sensor.Label)
+
+		var critical float64
+
+		if sensor.IsCritical() {
+			critical = 1
+		}
+
+		ch <- prometheus.MustNewConstMetric(criticalDesc, prometheus.GaugeValue, critical, sensor.Device, sensor.Chip, sensor.Label, string(sensor.Type))
+	}
+}
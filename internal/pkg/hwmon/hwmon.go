@@ -0,0 +1,240 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package hwmon reads hardware monitoring sensors (temperature, fan, voltage, etc.) from the Linux hwmon sysfs interface.
+package hwmon
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// SysfsPath is the default path of the hwmon sysfs class.
+const SysfsPath = "/sys/class/hwmon"
+
+// Type is the sensor type.
+type Type string
+
+// Sensor types.
+const (
+	TypeTemperature Type = "temperature"
+	TypeFan         Type = "fan"
+	TypeVoltage     Type = "voltage"
+	TypeCurrent     Type = "current"
+	TypePower       Type = "power"
+)
+
+// sensorTypes maps sysfs file prefixes to sensor types and the scale of the raw values.
+var sensorTypes = map[string]struct {
+	typ   Type
+	scale float64
+}{
+	"temp":  {TypeTemperature, 1e-3}, // millidegree Celsius
+	"fan":   {TypeFan, 1},            // RPM
+	"in":    {TypeVoltage, 1e-3},     // millivolts
+	"curr":  {TypeCurrent, 1e-3},     // milliamperes
+	"power": {TypePower, 1e-6},       // microwatts
+}
+
+// Unit returns the unit of the sensor value.
+func (t Type) Unit() string {
+	switch t {
+	case TypeTemperature:
+		return "°C"
+	case TypeFan:
+		return "RPM"
+	case TypeVoltage:
+		return "V"
+	case TypeCurrent:
+		return "A"
+	case TypePower:
+		return "W"
+	default:
+		return ""
+	}
+}
+
+// Sensor is a single hwmon sensor reading.
+type Sensor struct {
+	// hwmon device, e.g. "hwmon0".
+	Device string
+	// Chip name, e.g. "coretemp".
+	Chip string
+	// Sensor ID, e.g. "temp1".
+	ID string
+	// Sensor label, e.g. "Package id 0" (defaults to ID).
+	Label string
+	Type  Type
+	// Current value (in °C, RPM, V, A or W).
+	Value float64
+	// Thresholds, nil if not reported by the driver.
+	Max         *float64
+	Critical    *float64
+	LowCritical *float64
+	// Alarm is set if the driver reports an alarm condition.
+	Alarm bool
+}
+
+// IsCritical returns true if the sensor is in an alarm state or the value is beyond the critical thresholds.
+func (s Sensor) IsCritical() bool {
+	if s.Alarm {
+		return true
+	}
+
+	if s.Critical != nil && s.Value >= *s.Critical {
+		return true
+	}
+
+	return s.LowCritical != nil && s.Value <= *s.LowCritical
+}
+
+var inputRe = regexp.MustCompile(`^(temp|fan|in|curr|power)(\d+)_input$`)
+
+// Read all sensors from the hwmon sysfs root (usually SysfsPath).
+//
+// Sensors which can't be read (e.g. the device is powered down) are skipped.
+func Read(root string) ([]Sensor, error) {
+	devices, err := os.ReadDir(root)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var sensors []Sensor
+
+	for _, device := range devices {
+		deviceSensors, err := readDevice(filepath.Join(root, device.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("error reading %s: %w", device.Name(), err)
+		}
+
+		sensors = append(sensors, deviceSensors...)
+	}
+
+	return sensors, nil
+}
+
+func readDevice(path string) ([]Sensor, error) {
+	device := filepath.Base(path)
+
+	// older drivers put the sensor files in the device directory
+	dir := path
+
+	chip, err := readString(filepath.Join(dir, "name"))
+	if errors.Is(err, os.ErrNotExist) {
+		dir = filepath.Join(path, "device")
+
+		chip, err = readString(filepath.Join(dir, "name"))
+	}
+
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	var sensors []Sensor
+
+	for _, entry := range entries {
+		matches := inputRe.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			continue
+		}
+
+		id := matches[1] + matches[2]
+		sensorType := sensorTypes[matches[1]]
+
+		value, ok := readValue(filepath.Join(dir, entry.Name()), sensorType.scale)
+		if !ok {
+			continue
+		}
+
+		label, err := readString(filepath.Join(dir, id+"_label"))
+		if err != nil || label == "" {
+			label = id
+		}
+
+		sensor := Sensor{
+			Device: device,
+			Chip:   chip,
+			ID:     id,
+			Label:  label,
+			Type:   sensorType.typ,
+			Value:  *value,
+		}
+
+		sensor.Max, _ = readValue(filepath.Join(dir, id+"_max"), sensorType.scale)
+		sensor.Critical, _ = readValue(filepath.Join(dir, id+"_crit"), sensorType.scale)
+		sensor.LowCritical, _ = readValue(filepath.Join(dir, id+"_lcrit"), sensorType.scale)
+
+		for _, alarm := range []string{"_alarm", "_crit_alarm", "_lcrit_alarm"} {
+			if v, _ := readValue(filepath.Join(dir, id+alarm), 1); v != nil && *v != 0 {
+				sensor.Alarm = true
+			}
+		}
+
+		sensors = append(sensors, sensor)
+	}
+
+	slices.SortFunc(sensors, func(a, b Sensor) int {
+		if a.Type != b.Type {
+			return strings.Compare(string(a.Type), string(b.Type))
+		}
+
+		return naturalCompare(a.ID, b.ID)
+	})
+
+	return sensors, nil
+}
+
+// naturalCompare compares sensor IDs so that "temp2" goes before "temp10".
+func naturalCompare(a, b string) int {
+	if len(a) != len(b) {
+		return len(a) - len(b)
+	}
+
+	return strings.Compare(a, b)
+}
+
+func readString(path string) (string, error) {
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bytes.TrimSpace(contents)), nil
+}
+
+func readValue(path string, scale float64) (*float64, bool) {
+	contents, err := readString(path)
+	if err != nil {
+		return nil, false
+	}
+
+	v, err := strconv.ParseInt(contents, 10, 64)
+	if err != nil {
+		return nil, false
+	}
+
+	value := float64(v) * scale
+
+	return &value, true
+}
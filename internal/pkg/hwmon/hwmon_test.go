@@ -0,0 +1,105 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hwmon_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/hwmon"
+)
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+
+	for name, contents := range files {
+		path := filepath.Join(dir, name)
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(contents+"\n"), 0o644))
+	}
+}
+
+func setup(t *testing.T) string {
+	t.Helper()
+
+	root := t.TempDir()
+
+	writeFiles(t, root, map[string]string{
+		"hwmon0/name":              "coretemp",
+		"hwmon0/temp1_input":       "45000",
+		"hwmon0/temp1_label":       "Package id 0",
+		"hwmon0/temp1_crit":        "100000",
+		"hwmon0/temp2_input":       "101000",
+		"hwmon0/temp2_crit":        "100000",
+		"hwmon0/temp10_input":      "30000",
+		"hwmon0/temp10_crit_alarm": "0",
+		"hwmon1/device/name":       "nct6775",
+		"hwmon1/device/fan1_input": "1200",
+		"hwmon1/device/fan1_alarm": "1",
+		"hwmon1/device/in0_input":  "1104",
+		"hwmon1/device/in0_lcrit":  "1200",
+		"hwmon2/name":              "nvme",
+		"hwmon2/temp1_input":       "invalid",
+	})
+
+	return root
+}
+
+func TestRead(t *testing.T) {
+	sensors, err := hwmon.Read(setup(t))
+	require.NoError(t, err)
+
+	assert.Equal(t,
+		[]string{"coretemp/Package id 0", "coretemp/temp2", "coretemp/temp10", "nct6775/fan1", "nct6775/in0"},
+		xslices.Map(sensors, func(s hwmon.Sensor) string { return s.Chip + "/" + s.Label }),
+	)
+
+	assert.Equal(t, hwmon.TypeTemperature, sensors[0].Type)
+	assert.InDelta(t, 45.0, sensors[0].Value, 1e-9)
+	require.NotNil(t, sensors[0].Critical)
+	assert.InDelta(t, 100.0, *sensors[0].Critical, 1e-9)
+	assert.Nil(t, sensors[0].Max)
+
+	assert.Equal(t,
+		[]bool{false, true, false, true, true},
+		xslices.Map(sensors, hwmon.Sensor.IsCritical),
+	)
+
+	assert.Equal(t, "hwmon1", sensors[3].Device)
+	assert.Equal(t, hwmon.TypeFan, sensors[3].Type)
+	assert.InDelta(t, 1200.0, sensors[3].Value, 1e-9)
+
+	assert.Equal(t, hwmon.TypeVoltage, sensors[4].Type)
+	assert.InDelta(t, 1.104, sensors[4].Value, 1e-9)
+}
+
+func TestReadMissing(t *testing.T) {
+	sensors, err := hwmon.Read(filepath.Join(t.TempDir(), "missing"))
+	require.NoError(t, err)
+	assert.Empty(t, sensors)
+}
+
+func TestCollector(t *testing.T) {
+	collector := &hwmon.Collector{Root: setup(t)}
+
+	require.NoError(t, testutil.CollectAndCompare(collector, strings.NewReader(`
+# HELP talos_hwmon_fan_rpm Fan speed reported by the hwmon sensor.
+# TYPE talos_hwmon_fan_rpm gauge
+talos_hwmon_fan_rpm{chip="nct6775",device="hwmon1",sensor="fan1"} 1200
+# HELP talos_hwmon_temperature_celsius Temperature reported by the hwmon sensor.
+# TYPE talos_hwmon_temperature_celsius gauge
+talos_hwmon_temperature_celsius{chip="coretemp",device="hwmon0",sensor="Package id 0"} 45
+talos_hwmon_temperature_celsius{chip="coretemp",device="hwmon0",sensor="temp10"} 30
+talos_hwmon_temperature_celsius{chip="coretemp",device="hwmon0",sensor="temp2"} 101
+`), "talos_hwmon_fan_rpm", "talos_hwmon_temperature_celsius"))
+}
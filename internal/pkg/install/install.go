@@ -292,9 +292,26 @@ func OptionsFromUpgradeRequest(r runtime.Runtime, in *machineapi.UpgradeRequest)
 		WithForce(!in.GetPreserve()),
 	}
 
-	if r.Config() != nil && r.Config().Machine() != nil {
-		opts = append(opts, WithExtraKernelArgs(r.Config().Machine().Install().ExtraKernelArgs()))
+	if r.Config() != nil {
+		opts = append(opts, WithExtraKernelArgs(ExtraKernelArgs(r.Config())))
 	}
 
 	return opts
 }
+
+// ExtraKernelArgs returns extra kernel args to install with based on the machine configuration.
+//
+// Kernel args rendered from the performance config come first, so that they can be overridden via `.machine.install.extraKernelArgs`.
+func ExtraKernelArgs(cfg config.Config) []string {
+	var args []string
+
+	if cfg.Performance() != nil {
+		args = append(args, cfg.Performance().KernelArgs()...)
+	}
+
+	if cfg.Machine() != nil {
+		args = append(args, cfg.Machine().Install().ExtraKernelArgs()...)
+	}
+
+	return args
+}
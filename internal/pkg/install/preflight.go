@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package install
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/containerd/platforms"
+	"github.com/distribution/reference"
+	ocispec "github.com/opencontainers/image-spec/specs-go/v1"
+
+	"github.com/siderolabs/talos/internal/pkg/containers/image"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+// ErrSignatureVerification is returned by the pre-flight checks if the installer image signature can't be verified.
+var ErrSignatureVerification = errors.New("installer image signature verification failed")
+
+// PreflightResult is the result of the installer image pre-flight checks.
+type PreflightResult struct {
+	// Image is the installer image reference pinned to the validated digest.
+	Image string
+	// Platform is the platform the image was validated for.
+	Platform ocispec.Platform
+	// SignatureVerified is set if the image signature was verified against the trusted keys.
+	SignatureVerified bool
+}
+
+// PreflightInstallerImage checks that the installer image can be used for the upgrade without pulling it.
+//
+// The image should be resolvable with the registry configuration and available for the node architecture.
+// If the image verification is configured, the image should have a valid signature.
+func PreflightInstallerImage(ctx context.Context, reg config.Registries, verification config.ImageVerificationConfig, ref string) (*PreflightResult, error) {
+	result := &PreflightResult{
+		Platform: platforms.DefaultSpec(),
+	}
+
+	if verification != nil {
+		pinnedRef, err := image.VerifySignature(ctx, reg, ref, verification)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrSignatureVerification, err)
+		}
+
+		ref = pinnedRef
+		result.SignatureVerified = true
+	}
+
+	digest, err := image.ResolvePlatform(ctx, reg, ref, result.Platform)
+	if err != nil {
+		return nil, err
+	}
+
+	namedRef, err := reference.ParseDockerRef(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Image = namedRef.Name() + "@" + digest.String()
+
+	return result, nil
+}
@@ -0,0 +1,37 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ipmi
+
+import (
+	"context"
+	"errors"
+)
+
+const cmdGetChassisStatus = 0x01
+
+// ChassisStatus is the chassis power state reported by the BMC.
+type ChassisStatus struct {
+	PowerOn       bool
+	PowerOverload bool
+	PowerFault    bool
+}
+
+// GetChassisStatus returns the chassis power state.
+func GetChassisStatus(ctx context.Context, t Transport) (ChassisStatus, error) {
+	resp, err := t.Send(ctx, NetFnChassis, cmdGetChassisStatus, nil)
+	if err != nil {
+		return ChassisStatus{}, err
+	}
+
+	if len(resp) < 1 {
+		return ChassisStatus{}, errors.New("short chassis status response")
+	}
+
+	return ChassisStatus{
+		PowerOn:       resp[0]&0x01 != 0,
+		PowerOverload: resp[0]&0x02 != 0,
+		PowerFault:    resp[0]&0x08 != 0,
+	}, nil
+}
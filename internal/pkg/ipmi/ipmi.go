@@ -0,0 +1,252 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package ipmi provides in-band access to the node's BMC via the Linux IPMI device interface.
+package ipmi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// Network functions.
+const (
+	NetFnChassis = 0x00
+	NetFnSensor  = 0x04
+	NetFnApp     = 0x06
+	NetFnStorage = 0x0a
+)
+
+// DefaultTimeout is the timeout for a single command if the context doesn't have a deadline.
+const DefaultTimeout = 5 * time.Second
+
+// devicePaths are the paths of the IPMI device node created by the ipmi_devintf kernel module.
+var devicePaths = []string{"/dev/ipmi0", "/dev/ipmi/0", "/dev/ipmidev/0"}
+
+// ErrNotAvailable is returned when the IPMI device is not available.
+var ErrNotAvailable = errors.New("IPMI device is not available (is ipmi_si and ipmi_devintf kernel modules loaded?)")
+
+// Transport sends IPMI commands to the BMC.
+type Transport interface {
+	// Send the command and return the response data (without the completion code).
+	Send(ctx context.Context, netFn, cmd uint8, data []byte) ([]byte, error)
+}
+
+// CompletionCodeError is returned when the BMC responds with a non-zero completion code.
+type CompletionCodeError struct {
+	NetFn, Cmd uint8
+	Code       uint8
+}
+
+// Error implements error interface.
+func (e *CompletionCodeError) Error() string {
+	return fmt.Sprintf("IPMI command 0x%02x/0x%02x failed with completion code 0x%02x", e.NetFn, e.Cmd, e.Code)
+}
+
+// Linux IPMI device interface, see include/uapi/linux/ipmi.h.
+const (
+	ipmiIOCMagic = 'i'
+
+	ipmiSystemInterfaceAddrType = 0x0c
+	ipmiBMCChannel              = 0x0f
+	ipmiResponseRecvType        = 1
+
+	ipmiMaxMsgLength = 272
+	ipmiMaxAddrSize  = 32
+)
+
+type ipmiSystemInterfaceAddr struct {
+	AddrType int32
+	Channel  int16
+	LUN      uint8
+}
+
+type ipmiMsg struct {
+	NetFn   uint8
+	Cmd     uint8
+	DataLen uint16
+	Data    *byte
+}
+
+type ipmiReq struct {
+	Addr    *byte
+	AddrLen uint32
+	MsgID   int
+	Msg     ipmiMsg
+}
+
+type ipmiRecv struct {
+	RecvType int32
+	Addr     *byte
+	AddrLen  uint32
+	MsgID    int
+	Msg      ipmiMsg
+}
+
+var (
+	ipmictlSendCommand     = ioctlNumber(2, 13, unsafe.Sizeof(ipmiReq{}))
+	ipmictlReceiveMsgTrunc = ioctlNumber(3, 11, unsafe.Sizeof(ipmiRecv{}))
+)
+
+func ioctlNumber(dir, nr, size uintptr) uintptr {
+	return dir<<30 | size<<16 | ipmiIOCMagic<<8 | nr
+}
+
+// Device is the IPMI device transport.
+type Device struct {
+	f     *os.File
+	msgID int
+}
+
+// Open the IPMI device.
+//
+// If the device is not present, ErrNotAvailable is returned.
+func Open() (*Device, error) {
+	for _, path := range devicePaths {
+		f, err := os.OpenFile(path, os.O_RDWR, 0)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+
+			return nil, fmt.Errorf("error opening IPMI device: %w", err)
+		}
+
+		return &Device{f: f}, nil
+	}
+
+	return nil, ErrNotAvailable
+}
+
+// Close the device.
+func (d *Device) Close() error {
+	return d.f.Close()
+}
+
+// Send implements Transport interface.
+func (d *Device) Send(ctx context.Context, netFn, cmd uint8, data []byte) ([]byte, error) {
+	d.msgID++
+
+	if err := d.send(netFn, cmd, data); err != nil {
+		return nil, fmt.Errorf("error sending IPMI command 0x%02x/0x%02x: %w", netFn, cmd, err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		deadline = time.Now().Add(DefaultTimeout)
+	}
+
+	for {
+		timeout := time.Until(deadline)
+		if timeout <= 0 {
+			return nil, fmt.Errorf("timed out waiting for IPMI response 0x%02x/0x%02x", netFn, cmd)
+		}
+
+		fds := []unix.PollFd{{Fd: int32(d.f.Fd()), Events: unix.POLLIN}}
+
+		n, err := unix.Poll(fds, int(timeout.Milliseconds())+1)
+		if err != nil {
+			if errors.Is(err, unix.EINTR) {
+				continue
+			}
+
+			return nil, fmt.Errorf("error waiting for IPMI response: %w", err)
+		}
+
+		if n == 0 {
+			continue
+		}
+
+		resp, msgID, recvType, err := d.receive()
+		if err != nil {
+			return nil, fmt.Errorf("error receiving IPMI response: %w", err)
+		}
+
+		if recvType != ipmiResponseRecvType || msgID != d.msgID {
+			// stale response or an event, skip it
+			continue
+		}
+
+		if len(resp) == 0 {
+			return nil, fmt.Errorf("empty IPMI response 0x%02x/0x%02x", netFn, cmd)
+		}
+
+		if resp[0] != 0 {
+			return nil, &CompletionCodeError{NetFn: netFn, Cmd: cmd, Code: resp[0]}
+		}
+
+		return resp[1:], nil
+	}
+}
+
+func (d *Device) send(netFn, cmd uint8, data []byte) error {
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
+
+	addr := &ipmiSystemInterfaceAddr{
+		AddrType: ipmiSystemInterfaceAddrType,
+		Channel:  ipmiBMCChannel,
+	}
+	pinner.Pin(addr)
+
+	req := &ipmiReq{
+		Addr:    (*byte)(unsafe.Pointer(addr)),
+		AddrLen: uint32(unsafe.Sizeof(*addr)),
+		MsgID:   d.msgID,
+		Msg: ipmiMsg{
+			NetFn:   netFn,
+			Cmd:     cmd,
+			DataLen: uint16(len(data)),
+		},
+	}
+
+	if len(data) > 0 {
+		req.Msg.Data = &data[0]
+		pinner.Pin(req.Msg.Data)
+	}
+
+	return ioctl(d.f.Fd(), ipmictlSendCommand, unsafe.Pointer(req))
+}
+
+func (d *Device) receive() ([]byte, int, int32, error) {
+	var pinner runtime.Pinner
+	defer pinner.Unpin()
+
+	addr := make([]byte, ipmiMaxAddrSize)
+	data := make([]byte, ipmiMaxMsgLength)
+
+	pinner.Pin(&addr[0])
+	pinner.Pin(&data[0])
+
+	recv := &ipmiRecv{
+		Addr:    &addr[0],
+		AddrLen: uint32(len(addr)),
+		Msg: ipmiMsg{
+			DataLen: uint16(len(data)),
+			Data:    &data[0],
+		},
+	}
+
+	if err := ioctl(d.f.Fd(), ipmictlReceiveMsgTrunc, unsafe.Pointer(recv)); err != nil && !errors.Is(err, unix.EMSGSIZE) {
+		return nil, 0, 0, err
+	}
+
+	return data[:recv.Msg.DataLen], recv.MsgID, recv.RecvType, nil
+}
+
+func ioctl(fd, req uintptr, arg unsafe.Pointer) error {
+	_, _, errno := unix.Syscall(unix.SYS_IOCTL, fd, req, uintptr(arg))
+	if errno != 0 {
+		return errno
+	}
+
+	return nil
+}
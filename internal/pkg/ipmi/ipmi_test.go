@@ -0,0 +1,205 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ipmi_test
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/ipmi"
+)
+
+type command struct {
+	netFn, cmd uint8
+}
+
+// fakeBMC implements ipmi.Transport.
+type fakeBMC struct {
+	sdr      [][]byte
+	readings map[uint8][]byte
+	sel      [][]byte
+
+	// number of Get SDR commands to fail with reservation canceled
+	cancelReservation int
+}
+
+func (bmc *fakeBMC) Send(_ context.Context, netFn, cmd uint8, data []byte) ([]byte, error) {
+	switch (command{netFn, cmd}) {
+	case command{ipmi.NetFnChassis, 0x01}:
+		return []byte{0x01, 0x00, 0x00}, nil
+	case command{ipmi.NetFnStorage, 0x22}:
+		return []byte{0x01, 0x00}, nil
+	case command{ipmi.NetFnStorage, 0x23}:
+		if bmc.cancelReservation > 0 {
+			bmc.cancelReservation--
+
+			return nil, &ipmi.CompletionCodeError{NetFn: netFn, Cmd: cmd, Code: 0xc5}
+		}
+
+		id := int(binary.LittleEndian.Uint16(data[2:]))
+		offset, size := int(data[4]), int(data[5])
+		record := bmc.sdr[id]
+
+		return append(nextID(id, len(bmc.sdr)), record[offset:offset+size]...), nil
+	case command{ipmi.NetFnSensor, 0x2d}:
+		reading, ok := bmc.readings[data[0]]
+		if !ok {
+			return nil, &ipmi.CompletionCodeError{NetFn: netFn, Cmd: cmd, Code: 0xcb}
+		}
+
+		return reading, nil
+	case command{ipmi.NetFnStorage, 0x40}:
+		return []byte{0x51, byte(len(bmc.sel)), 0x00}, nil
+	case command{ipmi.NetFnStorage, 0x43}:
+		id := int(binary.LittleEndian.Uint16(data[2:]))
+
+		return append(nextID(id, len(bmc.sel)), bmc.sel[id]...), nil
+	}
+
+	return nil, fmt.Errorf("unexpected command 0x%02x/0x%02x", netFn, cmd)
+}
+
+func nextID(id, total int) []byte {
+	next := uint16(id + 1)
+
+	if int(next) == total {
+		next = 0xffff
+	}
+
+	return binary.LittleEndian.AppendUint16(nil, next)
+}
+
+func fullSensorRecord(id uint16, number, sensorType uint8, name string) []byte {
+	record := make([]byte, 48)
+	binary.LittleEndian.PutUint16(record, id)
+	record[2] = 0x51
+	record[3] = 0x01
+	record[5] = 0x20
+	record[7] = number
+	record[12] = sensorType
+	record[13] = 0x01 // threshold
+	record[21] = 0x01 // degrees C
+	record[24] = 5    // M
+	record[29] = 0xf0 // R exp = -1
+	record[47] = 0xc0 | byte(len(name))
+
+	record = append(record, name...)
+	record[4] = byte(len(record) - 5)
+
+	return record
+}
+
+func compactSensorRecord(id uint16, number, sensorType uint8, name string) []byte {
+	record := make([]byte, 32)
+	binary.LittleEndian.PutUint16(record, id)
+	record[2] = 0x51
+	record[3] = 0x02
+	record[5] = 0x20
+	record[7] = number
+	record[12] = sensorType
+	record[13] = 0x6f // sensor-specific
+	record[31] = 0xc0 | byte(len(name))
+
+	record = append(record, name...)
+	record[4] = byte(len(record) - 5)
+
+	return record
+}
+
+func TestReadSensors(t *testing.T) {
+	bmc := &fakeBMC{
+		sdr: [][]byte{
+			fullSensorRecord(0, 0x01, 0x01, "CPU Temp"),
+			compactSensorRecord(1, 0x02, 0x08, "PSU1 Status"),
+			fullSensorRecord(2, 0x03, 0x01, "Inlet Temp"),
+		},
+		readings: map[uint8][]byte{
+			0x01: {80, 0x40, 0x08},
+			0x02: {0, 0x40, 0x01, 0x80},
+		},
+		cancelReservation: 1,
+	}
+
+	sensors, err := ipmi.ReadSensors(context.Background(), bmc)
+	require.NoError(t, err)
+
+	assert.Equal(t, []ipmi.Sensor{
+		{
+			Number:   0x01,
+			Name:     "CPU Temp",
+			Type:     "Temperature",
+			Unit:     "degrees C",
+			Value:    40,
+			HasValue: true,
+			Status:   ipmi.SensorStatusNonCritical,
+		},
+		{
+			Number: 0x02,
+			Name:   "PSU1 Status",
+			Type:   "Power Supply",
+			State:  0x0001,
+			Status: ipmi.SensorStatusOK,
+		},
+		{
+			Number: 0x03,
+			Name:   "Inlet Temp",
+			Type:   "Temperature",
+			Unit:   "degrees C",
+			Status: ipmi.SensorStatusNotAvailable,
+		},
+	}, sensors)
+}
+
+func TestGetChassisStatus(t *testing.T) {
+	status, err := ipmi.GetChassisStatus(context.Background(), &fakeBMC{})
+	require.NoError(t, err)
+
+	assert.Equal(t, ipmi.ChassisStatus{PowerOn: true}, status)
+}
+
+func TestReadSEL(t *testing.T) {
+	bmc := &fakeBMC{
+		sel: [][]byte{
+			{0x00, 0x00, 0x02, 0x00, 0xe1, 0xf5, 0x65, 0x20, 0x00, 0x04, 0x01, 0x01, 0x01, 0x09, 0xff, 0xff},
+			{0x01, 0x00, 0x02, 0x00, 0xe1, 0xf5, 0x65, 0x20, 0x00, 0x04, 0x01, 0x01, 0x81, 0x09, 0xff, 0xff},
+			{0x02, 0x00, 0x02, 0x10, 0x00, 0x00, 0x00, 0x20, 0x00, 0x04, 0x20, 0x05, 0x6f, 0x01, 0xff, 0xff},
+			{0x03, 0x00, 0xe0, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d},
+		},
+	}
+
+	entries, total, err := ipmi.ReadSEL(context.Background(), bmc, 3)
+	require.NoError(t, err)
+
+	assert.Equal(t, 4, total)
+	assert.Equal(t, []ipmi.SELEntry{
+		{
+			ID:           1,
+			RecordType:   0x02,
+			Timestamp:    time.Unix(0x65f5e100, 0).UTC(),
+			SensorType:   "Temperature",
+			SensorNumber: 0x01,
+			Event:        "Upper Critical going high",
+			Deasserted:   true,
+		},
+		{
+			ID:           2,
+			RecordType:   0x02,
+			SensorType:   "OS Stop / Shutdown",
+			SensorNumber: 0x05,
+			Event:        "event type 0x6f, offset 0x01",
+		},
+		{
+			ID:         3,
+			RecordType: 0xe0,
+			Event:      "OEM record 01 02 03 04 05 06 07 08 09 0a 0b 0c 0d",
+		},
+	}, entries)
+}
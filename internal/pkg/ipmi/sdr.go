@@ -0,0 +1,421 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ipmi
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+)
+
+const (
+	cmdGetSensorReading = 0x2d
+
+	cmdReserveSDRRepository = 0x22
+	cmdGetSDR               = 0x23
+
+	ccReservationCanceled = 0xc5
+
+	sdrHeaderSize   = 5
+	sdrReadChunk    = 16
+	sdrLastRecordID = 0xffff
+	sdrMaxRetries   = 3
+
+	sdrTypeFullSensor    = 0x01
+	sdrTypeCompactSensor = 0x02
+
+	eventReadingTypeThreshold = 0x01
+)
+
+// Sensor status values.
+const (
+	SensorStatusOK             = "ok"
+	SensorStatusNonCritical    = "non-critical"
+	SensorStatusCritical       = "critical"
+	SensorStatusNonRecoverable = "non-recoverable"
+	SensorStatusNotAvailable   = "n/a"
+)
+
+// Sensor is the sensor reading reported by the BMC.
+type Sensor struct {
+	// Sensor number.
+	Number uint8
+	// Sensor name (ID string from the SDR).
+	Name string
+	// Sensor type, e.g. "Temperature".
+	Type string
+	// Unit of the value, e.g. "degrees C".
+	Unit string
+	// Value is the converted reading of a threshold sensor.
+	Value float64
+	// HasValue is set if Value is valid.
+	HasValue bool
+	// State is the bitmask of asserted states for discrete sensors.
+	State uint16
+	// Status is the threshold status of the sensor.
+	Status string
+}
+
+// sdrRecord is a parsed full or compact sensor record.
+type sdrRecord struct {
+	number           uint8
+	name             string
+	sensorType       uint8
+	eventReadingType uint8
+
+	// full sensor record only
+	analog        bool
+	analogFormat  uint8
+	linearization uint8
+	m, b          int
+	rExp, bExp    int
+	percentage    bool
+	unit          uint8
+}
+
+// ReadSensors reads the sensor data repository and returns current readings of all sensors.
+func ReadSensors(ctx context.Context, t Transport) ([]Sensor, error) {
+	records, err := readSDR(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	sensors := make([]Sensor, 0, len(records))
+
+	for _, record := range records {
+		sensor, err := readSensor(ctx, t, record)
+		if err != nil {
+			return nil, err
+		}
+
+		sensors = append(sensors, sensor)
+	}
+
+	return sensors, nil
+}
+
+func readSensor(ctx context.Context, t Transport, record sdrRecord) (Sensor, error) {
+	sensor := Sensor{
+		Number: record.number,
+		Name:   record.name,
+		Type:   SensorTypeName(record.sensorType),
+		Unit:   unitName(record.unit, record.percentage),
+		Status: SensorStatusNotAvailable,
+	}
+
+	// only sensors owned by the BMC on LUN 0 are supported
+	resp, err := t.Send(ctx, NetFnSensor, cmdGetSensorReading, []byte{record.number})
+	if err != nil {
+		var ccErr *CompletionCodeError
+
+		if errors.As(err, &ccErr) {
+			// sensor is not present or the reading is not supported, report it as unavailable
+			return sensor, nil
+		}
+
+		return sensor, err
+	}
+
+	if len(resp) < 2 || resp[1]&0x20 != 0 {
+		// reading is unavailable
+		return sensor, nil
+	}
+
+	var state uint16
+
+	if len(resp) > 2 {
+		state = uint16(resp[2])
+	}
+
+	if len(resp) > 3 {
+		state |= uint16(resp[3]&0x7f) << 8
+	}
+
+	if record.eventReadingType != eventReadingTypeThreshold {
+		sensor.State = state
+		sensor.Status = SensorStatusOK
+
+		return sensor, nil
+	}
+
+	sensor.Status = thresholdStatus(uint8(state))
+
+	if record.analog {
+		if value, ok := record.convert(resp[0]); ok {
+			sensor.Value = value
+			sensor.HasValue = true
+		}
+	}
+
+	return sensor, nil
+}
+
+func thresholdStatus(state uint8) string {
+	switch {
+	case state&0x24 != 0:
+		return SensorStatusNonRecoverable
+	case state&0x12 != 0:
+		return SensorStatusCritical
+	case state&0x09 != 0:
+		return SensorStatusNonCritical
+	default:
+		return SensorStatusOK
+	}
+}
+
+// convert the raw reading using the linear formula from the full sensor record:
+//
+//	y = L[(M*x + B*10^Bexp) * 10^Rexp]
+func (r sdrRecord) convert(raw uint8) (float64, bool) {
+	var x float64
+
+	switch r.analogFormat {
+	case 0: // unsigned
+		x = float64(raw)
+	case 1: // 1's complement
+		if raw&0x80 != 0 {
+			x = -float64(^raw)
+		} else {
+			x = float64(raw)
+		}
+	case 2: // 2's complement
+		x = float64(int8(raw))
+	default:
+		return 0, false
+	}
+
+	y := (float64(r.m)*x + float64(r.b)*math.Pow10(r.bExp)) * math.Pow10(r.rExp)
+
+	switch r.linearization {
+	case 0x00: // linear
+	case 0x01:
+		y = math.Log(y)
+	case 0x02:
+		y = math.Log10(y)
+	case 0x03:
+		y = math.Log2(y)
+	case 0x04:
+		y = math.Exp(y)
+	case 0x05:
+		y = math.Pow(10, y)
+	case 0x06:
+		y = math.Exp2(y)
+	case 0x07:
+		y = 1 / y
+	case 0x08:
+		y *= y
+	case 0x09:
+		y = y * y * y
+	case 0x0a:
+		y = math.Sqrt(y)
+	case 0x0b:
+		y = math.Cbrt(y)
+	default:
+		return 0, false
+	}
+
+	return y, true
+}
+
+func readSDR(ctx context.Context, t Transport) ([]sdrRecord, error) {
+	reservation, err := reserveSDR(ctx, t)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []sdrRecord
+
+	for recordID, retries := uint16(0), 0; recordID != sdrLastRecordID; {
+		data, next, err := getSDRRecord(ctx, t, reservation, recordID)
+		if err != nil {
+			var ccErr *CompletionCodeError
+
+			if errors.As(err, &ccErr) && ccErr.Code == ccReservationCanceled && retries < sdrMaxRetries {
+				retries++
+
+				if reservation, err = reserveSDR(ctx, t); err != nil {
+					return nil, err
+				}
+
+				continue
+			}
+
+			return nil, fmt.Errorf("error reading SDR record 0x%04x: %w", recordID, err)
+		}
+
+		if record, ok := parseSDRRecord(data); ok {
+			records = append(records, record)
+		}
+
+		if next == recordID {
+			break
+		}
+
+		recordID, retries = next, 0
+	}
+
+	return records, nil
+}
+
+func reserveSDR(ctx context.Context, t Transport) (uint16, error) {
+	resp, err := t.Send(ctx, NetFnStorage, cmdReserveSDRRepository, nil)
+	if err != nil {
+		var ccErr *CompletionCodeError
+
+		if errors.As(err, &ccErr) {
+			// reservation is not supported, records are read without it
+			return 0, nil
+		}
+
+		return 0, err
+	}
+
+	if len(resp) < 2 {
+		return 0, errors.New("short SDR reservation response")
+	}
+
+	return binary.LittleEndian.Uint16(resp), nil
+}
+
+// getSDRRecord reads the whole SDR record in chunks, returning the record and the next record ID.
+func getSDRRecord(ctx context.Context, t Transport, reservation, recordID uint16) ([]byte, uint16, error) {
+	header, next, err := getSDRChunk(ctx, t, reservation, recordID, 0, sdrHeaderSize)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	length := int(header[4])
+	record := append(make([]byte, 0, sdrHeaderSize+length), header...)
+
+	for offset := sdrHeaderSize; offset < sdrHeaderSize+length; {
+		chunk, _, err := getSDRChunk(ctx, t, reservation, recordID, uint8(offset), uint8(min(sdrReadChunk, sdrHeaderSize+length-offset)))
+		if err != nil {
+			return nil, 0, err
+		}
+
+		record = append(record, chunk...)
+		offset += len(chunk)
+	}
+
+	return record, next, nil
+}
+
+func getSDRChunk(ctx context.Context, t Transport, reservation, recordID uint16, offset, size uint8) ([]byte, uint16, error) {
+	req := make([]byte, 6)
+	binary.LittleEndian.PutUint16(req[0:], reservation)
+	binary.LittleEndian.PutUint16(req[2:], recordID)
+	req[4] = offset
+	req[5] = size
+
+	resp, err := t.Send(ctx, NetFnStorage, cmdGetSDR, req)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(resp) < 2+int(size) {
+		return nil, 0, fmt.Errorf("short SDR response: %d bytes", len(resp))
+	}
+
+	return resp[2 : 2+int(size)], binary.LittleEndian.Uint16(resp), nil
+}
+
+func parseSDRRecord(data []byte) (sdrRecord, bool) {
+	if len(data) < sdrHeaderSize {
+		return sdrRecord{}, false
+	}
+
+	var nameOffset int
+
+	switch data[3] {
+	case sdrTypeFullSensor:
+		nameOffset = 47
+	case sdrTypeCompactSensor:
+		nameOffset = 31
+	default:
+		return sdrRecord{}, false
+	}
+
+	if len(data) <= nameOffset {
+		return sdrRecord{}, false
+	}
+
+	record := sdrRecord{
+		number:           data[7],
+		sensorType:       data[12],
+		eventReadingType: data[13] & 0x7f,
+		name:             parseIDString(data[nameOffset:]),
+	}
+
+	if data[3] == sdrTypeFullSensor {
+		record.analogFormat = data[20] >> 6
+		record.analog = record.analogFormat != 3
+		record.percentage = data[20]&0x01 != 0
+		record.unit = data[21]
+		record.linearization = data[23] & 0x7f
+		record.m = signExtend(int(data[24])|int(data[25]&0xc0)<<2, 10)
+		record.b = signExtend(int(data[26])|int(data[27]&0xc0)<<2, 10)
+		record.rExp = signExtend(int(data[29]>>4), 4)
+		record.bExp = signExtend(int(data[29]&0x0f), 4)
+	}
+
+	return record, true
+}
+
+func parseIDString(data []byte) string {
+	length := int(data[0] & 0x1f)
+	data = data[1:]
+
+	if len(data) > length {
+		data = data[:length]
+	}
+
+	return strings.TrimRight(string(data), "\x00 ")
+}
+
+func signExtend(v, bits int) int {
+	if v&(1<<(bits-1)) != 0 {
+		return v - 1<<bits
+	}
+
+	return v
+}
+
+func unitName(unit uint8, percentage bool) string {
+	if percentage {
+		return "%"
+	}
+
+	switch unit {
+	case 1:
+		return "degrees C"
+	case 2:
+		return "degrees F"
+	case 3:
+		return "degrees K"
+	case 4:
+		return "Volts"
+	case 5:
+		return "Amps"
+	case 6:
+		return "Watts"
+	case 7:
+		return "Joules"
+	case 9:
+		return "VA"
+	case 17:
+		return "CFM"
+	case 18:
+		return "RPM"
+	case 19:
+		return "Hz"
+	case 22:
+		return "seconds"
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,215 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ipmi
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	cmdGetSELInfo  = 0x40
+	cmdGetSELEntry = 0x43
+
+	selEntrySize    = 16
+	selLastRecordID = 0xffff
+
+	selTypeSystemEvent    = 0x02
+	selTypeOEMTimestamped = 0xc0
+	selTypeOEM            = 0xe0
+
+	// timestamps below this value are relative to the BMC initialization.
+	selTimestampPreInit = 0x20000000
+)
+
+// SELEntry is the System Event Log entry.
+type SELEntry struct {
+	// Record ID.
+	ID uint16
+	// Record type (0x02 for system events, 0xc0-0xff for OEM records).
+	RecordType uint8
+	// Timestamp of the event, zero if not known.
+	Timestamp time.Time
+	// Sensor type, e.g. "Temperature" (system events only).
+	SensorType string
+	// Sensor number (system events only).
+	SensorNumber uint8
+	// Event description.
+	Event string
+	// Deasserted is set if the event is a deassertion event.
+	Deasserted bool
+}
+
+// ReadSEL reads the System Event Log, returning up to tail latest entries (all entries if tail is not positive).
+//
+// The total number of entries in the log is returned as well.
+func ReadSEL(ctx context.Context, t Transport, tail int) ([]SELEntry, int, error) {
+	info, err := t.Send(ctx, NetFnStorage, cmdGetSELInfo, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(info) < 3 {
+		return nil, 0, errors.New("short SEL info response")
+	}
+
+	total := int(binary.LittleEndian.Uint16(info[1:]))
+
+	var entries []SELEntry
+
+	if total == 0 {
+		return entries, 0, nil
+	}
+
+	for recordID := uint16(0); recordID != selLastRecordID; {
+		req := make([]byte, 6)
+		binary.LittleEndian.PutUint16(req[2:], recordID)
+		req[5] = 0xff
+
+		resp, err := t.Send(ctx, NetFnStorage, cmdGetSELEntry, req)
+		if err != nil {
+			return nil, 0, fmt.Errorf("error reading SEL entry 0x%04x: %w", recordID, err)
+		}
+
+		if len(resp) < 2+selEntrySize {
+			return nil, 0, fmt.Errorf("short SEL entry response: %d bytes", len(resp))
+		}
+
+		entries = append(entries, ParseSELEntry(resp[2:2+selEntrySize]))
+
+		if tail > 0 && len(entries) > tail {
+			entries = entries[1:]
+		}
+
+		next := binary.LittleEndian.Uint16(resp)
+		if next == recordID {
+			break
+		}
+
+		recordID = next
+	}
+
+	return entries, total, nil
+}
+
+// ParseSELEntry parses the raw 16-byte SEL record.
+func ParseSELEntry(data []byte) SELEntry {
+	entry := SELEntry{
+		ID:         binary.LittleEndian.Uint16(data),
+		RecordType: data[2],
+	}
+
+	switch {
+	case entry.RecordType == selTypeSystemEvent:
+		entry.Timestamp = parseSELTimestamp(binary.LittleEndian.Uint32(data[3:]))
+		entry.SensorType = SensorTypeName(data[10])
+		entry.SensorNumber = data[11]
+		entry.Deasserted = data[12]&0x80 != 0
+		entry.Event = eventDescription(data[12]&0x7f, data[13])
+	case entry.RecordType >= selTypeOEMTimestamped && entry.RecordType < selTypeOEM:
+		entry.Timestamp = parseSELTimestamp(binary.LittleEndian.Uint32(data[3:]))
+		entry.Event = fmt.Sprintf("OEM record % x", data[7:])
+	default:
+		entry.Event = fmt.Sprintf("OEM record % x", data[3:])
+	}
+
+	return entry
+}
+
+func parseSELTimestamp(ts uint32) time.Time {
+	if ts < selTimestampPreInit || ts == 0xffffffff {
+		return time.Time{}
+	}
+
+	return time.Unix(int64(ts), 0).UTC()
+}
+
+var thresholdEvents = [...]string{
+	"Lower Non-critical going low",
+	"Lower Non-critical going high",
+	"Lower Critical going low",
+	"Lower Critical going high",
+	"Lower Non-recoverable going low",
+	"Lower Non-recoverable going high",
+	"Upper Non-critical going low",
+	"Upper Non-critical going high",
+	"Upper Critical going low",
+	"Upper Critical going high",
+	"Upper Non-recoverable going low",
+	"Upper Non-recoverable going high",
+}
+
+func eventDescription(eventType, eventData1 uint8) string {
+	offset := eventData1 & 0x0f
+
+	if eventType == eventReadingTypeThreshold && int(offset) < len(thresholdEvents) {
+		return thresholdEvents[offset]
+	}
+
+	return fmt.Sprintf("event type 0x%02x, offset 0x%02x", eventType, offset)
+}
+
+var sensorTypes = map[uint8]string{
+	0x01: "Temperature",
+	0x02: "Voltage",
+	0x03: "Current",
+	0x04: "Fan",
+	0x05: "Physical Security",
+	0x06: "Platform Security",
+	0x07: "Processor",
+	0x08: "Power Supply",
+	0x09: "Power Unit",
+	0x0a: "Cooling Device",
+	0x0b: "Other Units-based Sensor",
+	0x0c: "Memory",
+	0x0d: "Drive Slot",
+	0x0e: "POST Memory Resize",
+	0x0f: "System Firmware Progress",
+	0x10: "Event Logging Disabled",
+	0x11: "Watchdog 1",
+	0x12: "System Event",
+	0x13: "Critical Interrupt",
+	0x14: "Button / Switch",
+	0x15: "Module / Board",
+	0x16: "Microcontroller / Coprocessor",
+	0x17: "Add-in Card",
+	0x18: "Chassis",
+	0x19: "Chip Set",
+	0x1a: "Other FRU",
+	0x1b: "Cable / Interconnect",
+	0x1c: "Terminator",
+	0x1d: "System Boot / Restart Initiated",
+	0x1e: "Boot Error",
+	0x1f: "Base OS Boot / Installation Status",
+	0x20: "OS Stop / Shutdown",
+	0x21: "Slot / Connector",
+	0x22: "System ACPI Power State",
+	0x23: "Watchdog 2",
+	0x24: "Platform Alert",
+	0x25: "Entity Presence",
+	0x26: "Monitor ASIC / IC",
+	0x27: "LAN",
+	0x28: "Management Subsystem Health",
+	0x29: "Battery",
+	0x2a: "Session Audit",
+	0x2b: "Version Change",
+	0x2c: "FRU State",
+}
+
+// SensorTypeName returns the name of the sensor type.
+func SensorTypeName(sensorType uint8) string {
+	if name, ok := sensorTypes[sensorType]; ok {
+		return name
+	}
+
+	if sensorType >= 0xc0 {
+		return fmt.Sprintf("OEM 0x%02x", sensorType)
+	}
+
+	return fmt.Sprintf("Unknown 0x%02x", sensorType)
+}
@@ -0,0 +1,178 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package jointoken implements short-lived join tokens accepted by trustd.
+//
+// Join tokens are used in the worker machine configuration instead of the machine token,
+// so that a leaked worker configuration can only be used to join the cluster until the token expires or is revoked.
+//
+// Tokens are stored in etcd, so that they are shared by all control plane nodes; only the hash of the token secret is stored.
+package jointoken
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	clientv3 "go.etcd.io/etcd/client/v3"
+)
+
+// keyPrefix is the etcd key prefix for the join tokens.
+const keyPrefix = "/talos/jointokens/"
+
+const (
+	idLength     = 6
+	secretLength = 16
+	alphabet     = "0123456789abcdefghijklmnopqrstuvwxyz"
+)
+
+var tokenRegexp = regexp.MustCompile(`^([a-z0-9]{6})\.([a-z0-9]{16})$`)
+
+// ErrNotFound is returned when the token is not found.
+var ErrNotFound = errors.New("join token not found")
+
+// Token is a join token stored in etcd.
+type Token struct {
+	ID          string    `json:"id"`
+	SecretHash  string    `json:"secretHash"`
+	Expires     time.Time `json:"expires"`
+	Description string    `json:"description,omitempty"`
+}
+
+// Generate generates a new join token in the `<id>.<secret>` format.
+func Generate() (token string, id string, secret string, err error) {
+	if id, err = randString(idLength); err != nil {
+		return "", "", "", err
+	}
+
+	if secret, err = randString(secretLength); err != nil {
+		return "", "", "", err
+	}
+
+	return id + "." + secret, id, secret, nil
+}
+
+// Parse parses the join token into ID and secret.
+func Parse(token string) (id, secret string, ok bool) {
+	matches := tokenRegexp.FindStringSubmatch(token)
+	if matches == nil {
+		return "", "", false
+	}
+
+	return matches[1], matches[2], true
+}
+
+// HashSecret returns the hex-encoded SHA-256 hash of the token secret.
+func HashSecret(secret string) string {
+	hash := sha256.Sum256([]byte(secret))
+
+	return hex.EncodeToString(hash[:])
+}
+
+// VerifySecret checks the token secret against the hash, the comparison is constant-time.
+func VerifySecret(secretHash, secret string) bool {
+	return subtle.ConstantTimeCompare([]byte(secretHash), []byte(HashSecret(secret))) == 1
+}
+
+// Create generates a new token and stores it in etcd, the token is removed from etcd once it expires.
+func Create(ctx context.Context, client *clientv3.Client, ttl time.Duration, description string) (string, Token, error) {
+	if ttl < time.Second {
+		return "", Token{}, fmt.Errorf("join token TTL should be at least 1s, got %s", ttl)
+	}
+
+	token, id, secret, err := Generate()
+	if err != nil {
+		return "", Token{}, err
+	}
+
+	lease, err := client.Grant(ctx, int64(ttl/time.Second))
+	if err != nil {
+		return "", Token{}, fmt.Errorf("error creating etcd lease: %w", err)
+	}
+
+	stored := Token{
+		ID:          id,
+		SecretHash:  HashSecret(secret),
+		Expires:     time.Now().Add(ttl).UTC().Truncate(time.Second),
+		Description: description,
+	}
+
+	data, err := json.Marshal(stored)
+	if err != nil {
+		return "", Token{}, err
+	}
+
+	if _, err = client.Put(ctx, keyPrefix+id, string(data), clientv3.WithLease(lease.ID)); err != nil {
+		return "", Token{}, fmt.Errorf("error storing join token: %w", err)
+	}
+
+	return token, stored, nil
+}
+
+// List returns the tokens stored in etcd sorted by expiration time.
+func List(ctx context.Context, client *clientv3.Client) ([]Token, error) {
+	resp, err := client.Get(ctx, keyPrefix, clientv3.WithPrefix())
+	if err != nil {
+		return nil, fmt.Errorf("error listing join tokens: %w", err)
+	}
+
+	tokens := make([]Token, 0, len(resp.Kvs))
+
+	for _, kv := range resp.Kvs {
+		var token Token
+
+		if err = json.Unmarshal(kv.Value, &token); err != nil {
+			return nil, fmt.Errorf("error decoding join token %q: %w", strings.TrimPrefix(string(kv.Key), keyPrefix), err)
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	slices.SortFunc(tokens, func(a, b Token) int { return a.Expires.Compare(b.Expires) })
+
+	return tokens, nil
+}
+
+// Revoke removes the token from etcd.
+func Revoke(ctx context.Context, client *clientv3.Client, id string) error {
+	resp, err := client.Delete(ctx, keyPrefix+id)
+	if err != nil {
+		return fmt.Errorf("error revoking join token: %w", err)
+	}
+
+	if resp.Deleted == 0 {
+		return fmt.Errorf("%w: %q", ErrNotFound, id)
+	}
+
+	return nil
+}
+
+func randString(length int) (string, error) {
+	var sb strings.Builder
+
+	sb.Grow(length)
+
+	maxIndex := big.NewInt(int64(len(alphabet)))
+
+	for range length {
+		n, err := rand.Int(rand.Reader, maxIndex)
+		if err != nil {
+			return "", err
+		}
+
+		sb.WriteByte(alphabet[n.Int64()])
+	}
+
+	return sb.String(), nil
+}
@@ -0,0 +1,44 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package jointoken_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/jointoken"
+)
+
+func TestGenerateParse(t *testing.T) {
+	t.Parallel()
+
+	token, id, secret, err := jointoken.Generate()
+	require.NoError(t, err)
+
+	assert.Len(t, id, 6)
+	assert.Len(t, secret, 16)
+
+	parsedID, parsedSecret, ok := jointoken.Parse(token)
+	require.True(t, ok)
+	assert.Equal(t, id, parsedID)
+	assert.Equal(t, secret, parsedSecret)
+
+	assert.True(t, jointoken.VerifySecret(jointoken.HashSecret(secret), secret))
+	assert.False(t, jointoken.VerifySecret(jointoken.HashSecret(secret), secret+"x"))
+
+	for _, invalid := range []string{
+		"",
+		"abcdef",
+		"abcdef.0123456789abcde",
+		"ABCDEF.0123456789abcdef",
+		"abcdef.0123456789abcdef.",
+		"abcdef:0123456789abcdef",
+	} {
+		_, _, ok = jointoken.Parse(invalid)
+		assert.False(t, ok, invalid)
+	}
+}
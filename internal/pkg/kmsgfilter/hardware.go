@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kmsgfilter
+
+import (
+	"strings"
+
+	"github.com/siderolabs/go-kmsg"
+)
+
+// HardwareErrorKind is the kind of the hardware error reported by the kernel.
+type HardwareErrorKind string
+
+// Hardware error kinds.
+const (
+	// HardwareErrorEDAC is a memory error reported by the EDAC subsystem.
+	HardwareErrorEDAC HardwareErrorKind = "edac"
+	// HardwareErrorMCE is a machine check exception.
+	HardwareErrorMCE HardwareErrorKind = "mce"
+	// HardwareErrorIO is a block device I/O error.
+	HardwareErrorIO HardwareErrorKind = "io"
+)
+
+// hardwareErrorPatterns maps the message prefixes and substrings to the hardware error kinds.
+var hardwareErrorPatterns = []struct {
+	kind     HardwareErrorKind
+	prefixes []string
+	contains []string
+}{
+	{
+		kind:     HardwareErrorEDAC,
+		prefixes: []string{"EDAC "},
+	},
+	{
+		kind:     HardwareErrorMCE,
+		prefixes: []string{"mce: ", "[Hardware Error]: "},
+	},
+	{
+		kind:     HardwareErrorIO,
+		prefixes: []string{"I/O error, dev ", "blk_update_request: ", "Buffer I/O error "},
+		contains: []string{" I/O error, dev ", "critical medium error", "Unrecovered read error"},
+	},
+}
+
+// ClassifyHardwareError returns the kind of the hardware error if the message reports one.
+//
+// Only kernel messages of the warning or higher severity are considered.
+func ClassifyHardwareError(msg kmsg.Message) (HardwareErrorKind, bool) {
+	if msg.Facility != kmsg.Kern || msg.Priority > kmsg.Warning {
+		return "", false
+	}
+
+	for _, pattern := range hardwareErrorPatterns {
+		for _, prefix := range pattern.prefixes {
+			if strings.HasPrefix(msg.Message, prefix) {
+				return pattern.kind, true
+			}
+		}
+
+		for _, substring := range pattern.contains {
+			if strings.Contains(msg.Message, substring) {
+				return pattern.kind, true
+			}
+		}
+	}
+
+	return "", false
+}
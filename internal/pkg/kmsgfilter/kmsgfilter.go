@@ -0,0 +1,139 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package kmsgfilter provides filtering, rate limiting and classification of the kernel log messages.
+package kmsgfilter
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/siderolabs/go-kmsg"
+	"golang.org/x/time/rate"
+)
+
+// Filter selects kernel log messages by the severity and facility.
+type Filter struct {
+	// Facilities limits the messages to the listed facilities, empty list matches all facilities.
+	Facilities []kmsg.Facility
+	// Severity is the least severe priority which passes the filter.
+	Severity kmsg.Priority
+}
+
+// NewFilter builds a filter from the severity and facility names.
+//
+// Empty severity matches all messages.
+func NewFilter(severity string, facilities []string) (Filter, error) {
+	filter := Filter{
+		Severity: kmsg.Debug,
+	}
+
+	if severity != "" {
+		var err error
+
+		if filter.Severity, err = ParsePriority(severity); err != nil {
+			return Filter{}, err
+		}
+	}
+
+	for _, name := range facilities {
+		facility, err := ParseFacility(name)
+		if err != nil {
+			return Filter{}, err
+		}
+
+		filter.Facilities = append(filter.Facilities, facility)
+	}
+
+	return filter, nil
+}
+
+// Match returns true if the message passes the filter.
+func (f Filter) Match(msg kmsg.Message) bool {
+	if msg.Priority > f.Severity {
+		return false
+	}
+
+	return len(f.Facilities) == 0 || slices.Contains(f.Facilities, msg.Facility)
+}
+
+// ParsePriority parses the kernel log priority name, e.g. "err" or "warning".
+func ParsePriority(name string) (kmsg.Priority, error) {
+	name = strings.ToLower(name)
+
+	switch name {
+	case "error":
+		return kmsg.Err, nil
+	case "warn":
+		return kmsg.Warning, nil
+	}
+
+	for priority := kmsg.Emerg; priority <= kmsg.Debug; priority++ {
+		if priority.String() == name {
+			return priority, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown kernel log severity %q", name)
+}
+
+// ParseFacility parses the kernel log facility name, e.g. "kern" or "daemon".
+func ParseFacility(name string) (kmsg.Facility, error) {
+	name = strings.ToLower(name)
+
+	for facility := kmsg.Kern; facility <= kmsg.Local7; facility++ {
+		if facility.String() == name {
+			return facility, nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown kernel log facility %q", name)
+}
+
+// RateLimiter limits the rate of the kernel log messages keeping track of the suppressed messages.
+//
+// Nil RateLimiter allows all messages.
+type RateLimiter struct {
+	limiter    *rate.Limiter
+	suppressed int
+}
+
+// NewRateLimiter returns a rate limiter allowing perSecond messages per second (with the same burst).
+//
+// If perSecond is zero, nil (unlimited) rate limiter is returned.
+func NewRateLimiter(perSecond uint32) *RateLimiter {
+	if perSecond == 0 {
+		return nil
+	}
+
+	return &RateLimiter{
+		limiter: rate.NewLimiter(rate.Limit(perSecond), int(perSecond)),
+	}
+}
+
+// Allow reports whether the message should be passed.
+//
+// When the message is allowed, the number of messages suppressed since the last allowed message is returned as well.
+func (l *RateLimiter) Allow() (allowed bool, suppressed int) {
+	return l.AllowAt(time.Now())
+}
+
+// AllowAt is same as Allow, but for the specified time.
+func (l *RateLimiter) AllowAt(now time.Time) (allowed bool, suppressed int) {
+	if l == nil {
+		return true, 0
+	}
+
+	if !l.limiter.AllowN(now, 1) {
+		l.suppressed++
+
+		return false, 0
+	}
+
+	suppressed, l.suppressed = l.suppressed, 0
+
+	return true, suppressed
+}
@@ -0,0 +1,217 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package kmsgfilter_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/siderolabs/go-kmsg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/kmsgfilter"
+)
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+
+		severity   string
+		facilities []string
+
+		matched []kmsg.Message
+		skipped []kmsg.Message
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+
+			matched: []kmsg.Message{
+				{Facility: kmsg.Kern, Priority: kmsg.Debug},
+				{Facility: kmsg.Daemon, Priority: kmsg.Emerg},
+			},
+		},
+		{
+			name:     "severity",
+			severity: "warning",
+
+			matched: []kmsg.Message{
+				{Facility: kmsg.Kern, Priority: kmsg.Warning},
+				{Facility: kmsg.User, Priority: kmsg.Crit},
+			},
+			skipped: []kmsg.Message{
+				{Facility: kmsg.Kern, Priority: kmsg.Notice},
+				{Facility: kmsg.Kern, Priority: kmsg.Info},
+			},
+		},
+		{
+			name:       "severity alias and facilities",
+			severity:   "ERROR",
+			facilities: []string{"kern", "daemon"},
+
+			matched: []kmsg.Message{
+				{Facility: kmsg.Kern, Priority: kmsg.Err},
+				{Facility: kmsg.Daemon, Priority: kmsg.Alert},
+			},
+			skipped: []kmsg.Message{
+				{Facility: kmsg.Kern, Priority: kmsg.Warning},
+				{Facility: kmsg.User, Priority: kmsg.Err},
+			},
+		},
+		{
+			name:     "invalid severity",
+			severity: "fatal",
+
+			expectedError: "unknown kernel log severity \"fatal\"",
+		},
+		{
+			name:       "invalid facility",
+			facilities: []string{"kernel"},
+
+			expectedError: "unknown kernel log facility \"kernel\"",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			filter, err := kmsgfilter.NewFilter(test.severity, test.facilities)
+			if test.expectedError != "" {
+				require.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			for _, msg := range test.matched {
+				assert.True(t, filter.Match(msg), "%s/%s", msg.Facility, msg.Priority)
+			}
+
+			for _, msg := range test.skipped {
+				assert.False(t, filter.Match(msg), "%s/%s", msg.Facility, msg.Priority)
+			}
+		})
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	unlimited := kmsgfilter.NewRateLimiter(0)
+
+	for range 100 {
+		allowed, suppressed := unlimited.Allow()
+		assert.True(t, allowed)
+		assert.Zero(t, suppressed)
+	}
+
+	limiter := kmsgfilter.NewRateLimiter(2)
+	now := time.Now()
+
+	for range 2 {
+		allowed, suppressed := limiter.AllowAt(now)
+		assert.True(t, allowed)
+		assert.Zero(t, suppressed)
+	}
+
+	for range 3 {
+		allowed, _ := limiter.AllowAt(now)
+		assert.False(t, allowed)
+	}
+
+	allowed, suppressed := limiter.AllowAt(now.Add(time.Second))
+	assert.True(t, allowed)
+	assert.Equal(t, 3, suppressed)
+
+	allowed, suppressed = limiter.AllowAt(now.Add(time.Second))
+	assert.True(t, allowed)
+	assert.Zero(t, suppressed)
+}
+
+func TestClassifyHardwareError(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		msg  kmsg.Message
+
+		expected kmsgfilter.HardwareErrorKind
+	}{
+		{
+			name: "EDAC corrected error",
+			msg: kmsg.Message{
+				Facility: kmsg.Kern,
+				Priority: kmsg.Warning,
+				Message:  "EDAC MC0: 1 CE memory read error on CPU_SrcID#0_Ha#0_Chan#1_DIMM#0 (channel:1 slot:0 page:0x12345 offset:0x0 grain:32 syndrome:0x0)",
+			},
+			expected: kmsgfilter.HardwareErrorEDAC,
+		},
+		{
+			name: "machine check",
+			msg: kmsg.Message{
+				Facility: kmsg.Kern,
+				Priority: kmsg.Emerg,
+				Message:  "mce: [Hardware Error]: CPU 0: Machine Check: 0 Bank 5: be00000000800400",
+			},
+			expected: kmsgfilter.HardwareErrorMCE,
+		},
+		{
+			name: "I/O error",
+			msg: kmsg.Message{
+				Facility: kmsg.Kern,
+				Priority: kmsg.Err,
+				Message:  "I/O error, dev sda, sector 2048 op 0x0:(READ) flags 0x80700 phys_seg 1 prio class 2",
+			},
+			expected: kmsgfilter.HardwareErrorIO,
+		},
+		{
+			name: "block I/O error",
+			msg: kmsg.Message{
+				Facility: kmsg.Kern,
+				Priority: kmsg.Err,
+				Message:  "blk_update_request: I/O error, dev sda, sector 2048 op 0x0:(READ) flags 0x80700 phys_seg 1 prio class 0",
+			},
+			expected: kmsgfilter.HardwareErrorIO,
+		},
+		{
+			name: "buffer I/O error",
+			msg: kmsg.Message{
+				Facility: kmsg.Kern,
+				Priority: kmsg.Err,
+				Message:  "Buffer I/O error on dev sda1, logical block 0, async page read",
+			},
+			expected: kmsgfilter.HardwareErrorIO,
+		},
+		{
+			name: "low severity",
+			msg: kmsg.Message{
+				Facility: kmsg.Kern,
+				Priority: kmsg.Info,
+				Message:  "EDAC MC: Ver: 3.0.0",
+			},
+		},
+		{
+			name: "user space",
+			msg: kmsg.Message{
+				Facility: kmsg.User,
+				Priority: kmsg.Err,
+				Message:  "EDAC test message",
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			kind, ok := kmsgfilter.ClassifyHardwareError(test.msg)
+
+			assert.Equal(t, test.expected != "", ok)
+			assert.Equal(t, test.expected, kind)
+		})
+	}
+}
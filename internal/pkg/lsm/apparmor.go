@@ -0,0 +1,130 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package lsm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/template"
+
+	"github.com/siderolabs/go-cmd/pkg/cmd"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// AppArmor profile modes.
+const (
+	AppArmorModeEnforce  = "enforce"
+	AppArmorModeComplain = "complain"
+)
+
+const appArmorProfilesPath = "/sys/kernel/security/apparmor/profiles"
+
+// appArmorProfileTemplate is a baseline profile rather than a least-privilege policy.
+//
+// The extension services and kubelet run with all capabilities, host networking and arbitrary mounts,
+// so the profile allows everything except for a set of operations which are never required by them
+// or by the core services (apid, trustd, etcd), and might be used to compromise the host: access to the kernel memory
+// interfaces, the sysrq trigger and writes to the securityfs (e.g. replacing the AppArmor profiles).
+//
+// machined (and the services it runs as plain processes) is not confined, as it is the init process of the host.
+var appArmorProfileTemplate = template.Must(template.New("apparmor").Parse(`profile {{ .Name }} flags=(attach_disconnected,mediate_deleted{{ if not .Enforcing }},complain{{ end }}) {
+  capability,
+  network,
+  mount,
+  remount,
+  umount,
+  pivot_root,
+  ptrace,
+  signal,
+  unix,
+  file,
+
+  deny /proc/sysrq-trigger rwklx,
+  deny /proc/kcore rwklx,
+  deny /dev/mem rwklx,
+  deny /dev/kmem rwklx,
+  deny /dev/port rwklx,
+  deny /sys/kernel/security/** wklx,
+}
+`))
+
+// AppArmorProfile renders the baseline AppArmor profile for the system services, kubelet and the extension services.
+//
+// In non-enforcing mode, the profile is loaded in complain mode, so the denied operations are only logged.
+func AppArmorProfile(name string, enforcing bool) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if err := appArmorProfileTemplate.Execute(&buf, struct {
+		Name      string
+		Enforcing bool
+	}{
+		Name:      name,
+		Enforcing: enforcing,
+	}); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// LoadAppArmorProfile loads (or replaces) the AppArmor profile in the kernel.
+func LoadAppArmorProfile(ctx context.Context, profile []byte) error {
+	_, err := cmd.RunContext(cmd.WithStdin(ctx, bytes.NewReader(profile)), constants.AppArmorParserPath, "--replace", "--skip-cache")
+
+	return err
+}
+
+// UnloadAppArmorProfile removes the AppArmor profile from the kernel.
+func UnloadAppArmorProfile(ctx context.Context, profile []byte) error {
+	_, err := cmd.RunContext(cmd.WithStdin(ctx, bytes.NewReader(profile)), constants.AppArmorParserPath, "--remove")
+
+	return err
+}
+
+// AppArmorProfiles returns the loaded AppArmor profiles and their modes.
+func AppArmorProfiles() (map[string]string, error) {
+	f, err := os.Open(appArmorProfilesPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	return parseAppArmorProfiles(f)
+}
+
+// parseAppArmorProfiles parses the list of profiles in the format 'name (mode)'.
+func parseAppArmorProfiles(r io.Reader) (map[string]string, error) {
+	profiles := map[string]string{}
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		idx := strings.LastIndex(line, " (")
+		if idx == -1 || !strings.HasSuffix(line, ")") {
+			return nil, fmt.Errorf("unexpected AppArmor profile line %q", line)
+		}
+
+		profiles[line[:idx]] = line[idx+2 : len(line)-1]
+	}
+
+	return profiles, scanner.Err()
+}
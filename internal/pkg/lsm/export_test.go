@@ -0,0 +1,15 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package lsm
+
+import "io"
+
+func ParseModules(r io.Reader) ([]string, error) {
+	return parseModules(r)
+}
+
+func ParseAppArmorProfiles(r io.Reader) (map[string]string, error) {
+	return parseAppArmorProfiles(r)
+}
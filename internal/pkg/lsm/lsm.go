@@ -0,0 +1,53 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package lsm provides helpers to manage Linux Security Modules policies.
+package lsm
+
+import (
+	"errors"
+	"io"
+	"os"
+	"strings"
+)
+
+// ModuleAppArmor is the name of the AppArmor LSM.
+const ModuleAppArmor = "apparmor"
+
+const modulesPath = "/sys/kernel/security/lsm"
+
+// Modules returns the list of active Linux Security Modules.
+//
+// If the securityfs is not mounted, Modules returns an empty list.
+func Modules() ([]string, error) {
+	f, err := os.Open(modulesPath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	return parseModules(f)
+}
+
+func parseModules(r io.Reader) ([]string, error) {
+	contents, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var modules []string
+
+	for _, module := range strings.Split(strings.TrimSpace(string(contents)), ",") {
+		if module != "" {
+			modules = append(modules, module)
+		}
+	}
+
+	return modules, nil
+}
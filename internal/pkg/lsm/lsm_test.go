@@ -0,0 +1,60 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package lsm_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/lsm"
+)
+
+func TestParseModules(t *testing.T) {
+	t.Parallel()
+
+	modules, err := lsm.ParseModules(strings.NewReader("capability,lockdown,yama,apparmor,bpf"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"capability", "lockdown", "yama", "apparmor", "bpf"}, modules)
+
+	modules, err = lsm.ParseModules(strings.NewReader(""))
+	require.NoError(t, err)
+
+	assert.Empty(t, modules)
+}
+
+func TestParseAppArmorProfiles(t *testing.T) {
+	t.Parallel()
+
+	profiles, err := lsm.ParseAppArmorProfiles(strings.NewReader("talos-system (enforce)\ncri-containerd.apparmor.d (enforce)\n/usr/bin/foo (complain)\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]string{
+		"talos-system":              lsm.AppArmorModeEnforce,
+		"cri-containerd.apparmor.d": lsm.AppArmorModeEnforce,
+		"/usr/bin/foo":              lsm.AppArmorModeComplain,
+	}, profiles)
+
+	_, err = lsm.ParseAppArmorProfiles(strings.NewReader("garbage"))
+	require.EqualError(t, err, "unexpected AppArmor profile line \"garbage\"")
+}
+
+func TestAppArmorProfile(t *testing.T) {
+	t.Parallel()
+
+	enforcing, err := lsm.AppArmorProfile("talos-system", true)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(enforcing), "profile talos-system flags=(attach_disconnected,mediate_deleted) {")
+	assert.Contains(t, string(enforcing), "deny /proc/sysrq-trigger rwklx,")
+
+	complain, err := lsm.AppArmorProfile("talos-system", false)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(complain), "profile talos-system flags=(attach_disconnected,mediate_deleted,complain) {")
+}
@@ -8,6 +8,7 @@ import (
 	"bytes"
 	"errors"
 	"io"
+	"io/fs"
 	"os"
 	"strconv"
 
@@ -28,6 +29,7 @@ type Processes struct {
 
 	buf      []byte
 	pagesize int
+	vanished int
 
 	RootPath string
 }
@@ -61,10 +63,16 @@ func (procs *Processes) Close() error {
 	return procs.fd.Close()
 }
 
+// Vanished returns the number of processes which exited while they were being read.
+func (procs *Processes) Vanished() int {
+	return procs.vanished
+}
+
 // Next returns process info until the list of processes is exhausted.
 //
 // Next returns nil, nil when all processes were processed.
-// Next skips processes which can't be analyzed.
+// Next skips processes which can't be analyzed (e.g. kernel threads),
+// and processes which exited while they were being read (see Vanished).
 func (procs *Processes) Next() (*machine.ProcessInfo, error) {
 	for {
 		if procs.idx >= len(procs.dirnames) {
@@ -85,13 +93,27 @@ func (procs *Processes) Next() (*machine.ProcessInfo, error) {
 		info, err := procs.readProc(procs.dirnames[procs.idx])
 		procs.idx++
 
-		// if err != nil, this process was killed before we were able to read /proc data
 		if err == nil {
 			return info, nil
 		}
+
+		if procs.exited(procs.dirnames[procs.idx-1]) {
+			procs.vanished++
+		}
 	}
 }
 
+// exited returns true if the process has exited since the /proc directory was listed.
+func (procs *Processes) exited(pidString string) bool {
+	if _, err := strconv.ParseInt(pidString, 10, 32); err != nil {
+		return false
+	}
+
+	_, err := os.Stat(procs.RootPath + "/" + pidString)
+
+	return errors.Is(err, fs.ErrNotExist)
+}
+
 //nolint:gocyclo
 func (procs *Processes) readProc(pidString string) (*machine.ProcessInfo, error) {
 	pid, err := strconv.ParseInt(pidString, 10, 32)
@@ -127,7 +149,10 @@ func (procs *Processes) readProc(pidString string) (*machine.ProcessInfo, error)
 		return nil, errors.New("unexpected format")
 	}
 
-	fields := bytes.Fields(procs.buf[rbracket+2:])
+	fields := bytes.Fields(procs.buf[rbracket+1:])
+	if len(fields) < 22 {
+		return nil, errors.New("unexpected format")
+	}
 
 	state := string(fields[0])
 
@@ -0,0 +1,19 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mount
+
+import "io"
+
+// VerityTable is exported for testing.
+func VerityTable(r io.Reader, dataDev, hashDev, rootHash string) (uint64, string, error) {
+	sb, err := readVeritySuperblock(r)
+	if err != nil {
+		return 0, "", err
+	}
+
+	length, params := sb.table(dataDev, hashDev, rootHash)
+
+	return length, params, nil
+}
@@ -5,7 +5,10 @@
 package mount
 
 import (
+	"fmt"
+
 	"github.com/freddierice/go-losetup/v2"
+	"github.com/siderolabs/go-procfs/procfs"
 	"golang.org/x/sys/unix"
 
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -13,15 +16,41 @@ import (
 
 // SquashfsMountPoints returns the mountpoints required to boot the system.
 func SquashfsMountPoints(prefix string) (mountpoints *Points, err error) {
-	var dev losetup.Device
-
-	dev, err = losetup.Attach("/"+constants.RootfsAsset, 0, true)
+	rootfsDev, err := AttachRootfs()
 	if err != nil {
 		return nil, err
 	}
 
 	squashfs := NewMountPoints()
-	squashfs.Set("squashfs", NewMountPoint(dev.Path(), "/", "squashfs", unix.MS_RDONLY|unix.MS_I_VERSION, "", WithPrefix(prefix), WithFlags(ReadOnly|Shared)))
+	squashfs.Set("squashfs", NewMountPoint(rootfsDev, "/", "squashfs", unix.MS_RDONLY|unix.MS_I_VERSION, "", WithPrefix(prefix), WithFlags(ReadOnly|Shared)))
 
 	return squashfs, nil
 }
+
+// AttachRootfs attaches the rootfs image to a loop device and returns the block device to mount.
+//
+// If the dm-verity root hash is passed via the kernel command line, the rootfs is verified
+// with dm-verity, and any failure to set up the verification is returned as an error.
+func AttachRootfs() (string, error) {
+	dev, err := losetup.Attach("/"+constants.RootfsAsset, 0, true)
+	if err != nil {
+		return "", err
+	}
+
+	rootHash := procfs.ProcCmdline().Get(constants.KernelParamRootfsVerity).First()
+	if rootHash == nil {
+		return dev.Path(), nil
+	}
+
+	hashDev, err := losetup.Attach("/"+constants.RootfsVerityAsset, 0, true)
+	if err != nil {
+		return "", fmt.Errorf("error attaching rootfs verity hash tree: %w", err)
+	}
+
+	verityDev, err := SetupVerity("rootfs", dev.Path(), hashDev.Path(), *rootHash)
+	if err != nil {
+		return "", fmt.Errorf("rootfs verity setup failed: %w", err)
+	}
+
+	return verityDev, nil
+}
@@ -0,0 +1,212 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mount
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// verityMagic is the signature of the dm-verity superblock (as created by `veritysetup format`).
+var verityMagic = [8]byte{'v', 'e', 'r', 'i', 't', 'y', 0, 0}
+
+// veritySuperblock is the on-disk dm-verity superblock.
+type veritySuperblock struct {
+	Signature     [8]byte
+	Version       uint32
+	HashType      uint32
+	UUID          [16]byte
+	Algorithm     [32]byte
+	DataBlockSize uint32
+	HashBlockSize uint32
+	DataBlocks    uint64
+	SaltSize      uint16
+	_             [6]byte
+	Salt          [256]byte
+	_             [168]byte
+}
+
+// readVeritySuperblock reads and validates dm-verity superblock from the beginning of the hash device.
+func readVeritySuperblock(r io.Reader) (*veritySuperblock, error) {
+	var sb veritySuperblock
+
+	if err := binary.Read(r, binary.LittleEndian, &sb); err != nil {
+		return nil, fmt.Errorf("error reading verity superblock: %w", err)
+	}
+
+	if sb.Signature != verityMagic {
+		return nil, errors.New("verity superblock signature mismatch")
+	}
+
+	if sb.Version != 1 {
+		return nil, fmt.Errorf("unsupported verity superblock version %d", sb.Version)
+	}
+
+	if sb.SaltSize > uint16(len(sb.Salt)) {
+		return nil, fmt.Errorf("invalid verity salt size %d", sb.SaltSize)
+	}
+
+	if sb.DataBlockSize == 0 || sb.HashBlockSize == 0 || sb.DataBlockSize%512 != 0 || sb.HashBlockSize%512 != 0 {
+		return nil, fmt.Errorf("invalid verity block sizes %d/%d", sb.DataBlockSize, sb.HashBlockSize)
+	}
+
+	return &sb, nil
+}
+
+// table returns the dm-verity target length (in sectors) and table parameters.
+//
+// Any corruption detected at runtime causes a kernel panic.
+func (sb *veritySuperblock) table(dataDev, hashDev, rootHash string) (uint64, string) {
+	salt := "-"
+
+	if sb.SaltSize > 0 {
+		salt = hex.EncodeToString(sb.Salt[:sb.SaltSize])
+	}
+
+	algorithm := string(bytes.TrimRight(sb.Algorithm[:], "\x00"))
+
+	// hash tree starts right after the superblock, which occupies a single hash block
+	const hashStartBlock = 1
+
+	return sb.DataBlocks * uint64(sb.DataBlockSize) / 512, fmt.Sprintf("%d %s %s %d %d %d %d %s %s %s 1 panic_on_corruption",
+		sb.HashType,
+		dataDev,
+		hashDev,
+		sb.DataBlockSize,
+		sb.HashBlockSize,
+		sb.DataBlocks,
+		hashStartBlock,
+		algorithm,
+		rootHash,
+		salt,
+	)
+}
+
+// SetupVerity creates a read-only dm-verity device on top of the data and hash devices.
+//
+// SetupVerity returns the path to the created device.
+func SetupVerity(name, dataDev, hashDev, rootHash string) (string, error) {
+	if _, err := hex.DecodeString(rootHash); err != nil || rootHash == "" {
+		return "", fmt.Errorf("invalid verity root hash %q", rootHash)
+	}
+
+	hashFile, err := os.Open(hashDev)
+	if err != nil {
+		return "", err
+	}
+
+	sb, err := readVeritySuperblock(hashFile)
+
+	hashFile.Close() //nolint:errcheck
+
+	if err != nil {
+		return "", err
+	}
+
+	length, params := sb.table(dataDev, hashDev, rootHash)
+
+	control, err := os.OpenFile("/dev/mapper/control", os.O_RDWR, 0)
+	if err != nil {
+		return "", fmt.Errorf("error opening device-mapper control: %w", err)
+	}
+
+	defer control.Close() //nolint:errcheck
+
+	if _, err = dmIoctl(control, unix.DM_DEV_CREATE, name, 0, nil); err != nil {
+		return "", fmt.Errorf("error creating device-mapper device: %w", err)
+	}
+
+	devPath, err := activateVerity(control, name, length, params)
+	if err != nil {
+		// remove the half-created device, so that it doesn't linger and the setup can be retried with the same name
+		if _, removeErr := dmIoctl(control, unix.DM_DEV_REMOVE, name, 0, nil); removeErr != nil {
+			return "", errors.Join(err, fmt.Errorf("error removing device-mapper device: %w", removeErr))
+		}
+
+		return "", err
+	}
+
+	return devPath, nil
+}
+
+// activateVerity loads the verity table into the created device-mapper device and activates it.
+func activateVerity(control *os.File, name string, length uint64, params string) (string, error) {
+	var target bytes.Buffer
+
+	spec := unix.DmTargetSpec{
+		Length: length,
+	}
+	copy(spec.Target_type[:], "verity")
+
+	if err := binary.Write(&target, binary.NativeEndian, spec); err != nil {
+		return "", err
+	}
+
+	target.WriteString(params)
+	target.WriteByte(0)
+
+	// target spec with params should be aligned on 8 bytes
+	for target.Len()%8 != 0 {
+		target.WriteByte(0)
+	}
+
+	if _, err := dmIoctl(control, unix.DM_TABLE_LOAD, name, unix.DM_READONLY_FLAG, target.Bytes()); err != nil {
+		return "", fmt.Errorf("error loading verity table: %w", err)
+	}
+
+	// resume the device to activate the loaded table
+	resp, err := dmIoctl(control, unix.DM_DEV_SUSPEND, name, 0, nil)
+	if err != nil {
+		return "", fmt.Errorf("error activating verity device: %w", err)
+	}
+
+	return fmt.Sprintf("/dev/dm-%d", unix.Minor(resp.Dev)), nil
+}
+
+func dmIoctl(control *os.File, cmd uintptr, name string, flags uint32, payload []byte) (*unix.DmIoctl, error) {
+	req := unix.DmIoctl{
+		Version:      [3]uint32{4, 0, 0},
+		Data_size:    uint32(unix.SizeofDmIoctl + len(payload)),
+		Data_start:   unix.SizeofDmIoctl,
+		Flags:        flags,
+		Target_count: 0,
+	}
+
+	if payload != nil {
+		req.Target_count = 1
+	}
+
+	copy(req.Name[:unix.DM_NAME_LEN-1], name)
+
+	var buf bytes.Buffer
+
+	if err := binary.Write(&buf, binary.NativeEndian, req); err != nil {
+		return nil, err
+	}
+
+	buf.Write(payload)
+
+	data := buf.Bytes()
+
+	if _, _, errno := unix.Syscall(unix.SYS_IOCTL, control.Fd(), cmd, uintptr(unsafe.Pointer(&data[0]))); errno != 0 {
+		return nil, errno
+	}
+
+	var resp unix.DmIoctl
+
+	if err := binary.Read(bytes.NewReader(data), binary.NativeEndian, &resp); err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
@@ -0,0 +1,101 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package mount_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/mount"
+)
+
+func buildVeritySuperblock(t *testing.T, signature string, salt []byte) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+
+	sigBytes := make([]byte, 8)
+	copy(sigBytes, signature)
+
+	algorithm := make([]byte, 32)
+	copy(algorithm, "sha256")
+
+	saltBytes := make([]byte, 256)
+	copy(saltBytes, salt)
+
+	for _, v := range []any{
+		sigBytes,
+		uint32(1),        // version
+		uint32(1),        // hash type
+		make([]byte, 16), // uuid
+		algorithm,
+		uint32(4096), // data block size
+		uint32(4096), // hash block size
+		uint64(1000), // data blocks
+		uint16(len(salt)),
+		make([]byte, 6),
+		saltBytes,
+		make([]byte, 168),
+	} {
+		require.NoError(t, binary.Write(&buf, binary.LittleEndian, v))
+	}
+
+	require.Equal(t, 512, buf.Len())
+
+	return buf.Bytes()
+}
+
+func TestVerityTable(t *testing.T) {
+	t.Parallel()
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		length, params, err := mount.VerityTable(
+			bytes.NewReader(buildVeritySuperblock(t, "verity", []byte{0xca, 0xfe})),
+			"/dev/loop0", "/dev/loop1", "abcd",
+		)
+		require.NoError(t, err)
+
+		assert.EqualValues(t, 8000, length)
+		assert.Equal(t, "1 /dev/loop0 /dev/loop1 4096 4096 1000 1 sha256 abcd cafe 1 panic_on_corruption", params)
+	})
+
+	t.Run("no salt", func(t *testing.T) {
+		t.Parallel()
+
+		_, params, err := mount.VerityTable(
+			bytes.NewReader(buildVeritySuperblock(t, "verity", nil)),
+			"/dev/loop0", "/dev/loop1", "abcd",
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, "1 /dev/loop0 /dev/loop1 4096 4096 1000 1 sha256 abcd - 1 panic_on_corruption", params)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := mount.VerityTable(
+			bytes.NewReader(buildVeritySuperblock(t, "notverity", nil)),
+			"/dev/loop0", "/dev/loop1", "abcd",
+		)
+		require.EqualError(t, err, "verity superblock signature mismatch")
+	})
+
+	t.Run("truncated", func(t *testing.T) {
+		t.Parallel()
+
+		_, _, err := mount.VerityTable(
+			bytes.NewReader(buildVeritySuperblock(t, "verity", nil)[:100]),
+			"/dev/loop0", "/dev/loop1", "abcd",
+		)
+		require.Error(t, err)
+	})
+}
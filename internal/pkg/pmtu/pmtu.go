@@ -0,0 +1,305 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package pmtu discovers the path MTU to a destination with ICMP echo probes.
+package pmtu
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"syscall"
+	"time"
+
+	"golang.org/x/net/icmp"
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+	"golang.org/x/sys/unix"
+)
+
+const (
+	ipv4HeaderLen = 20
+	ipv6HeaderLen = 40
+	icmpHeaderLen = 8
+
+	// minimum MTU every link must support.
+	ipv4MinMTU = 68
+	ipv6MinMTU = 1280
+)
+
+// Result of the path MTU discovery.
+type Result struct {
+	// RouteMTU is the MTU of the route to the destination, it is the upper bound of the search.
+	RouteMTU int
+	// PathMTU is the largest packet size (including the IP header) which reached the destination.
+	PathMTU int
+	// Probes is the number of the probes sent.
+	Probes int
+}
+
+// Discover runs the binary search for the path MTU to the destination.
+//
+// Each probe is an ICMP echo request with the Don't Fragment bit set, the probe succeeds
+// if the echo reply arrives within the timeout.
+//
+//nolint:gocyclo
+func Discover(ctx context.Context, dst netip.Addr, timeout time.Duration) (Result, error) {
+	var result Result
+
+	dst = dst.Unmap()
+
+	routeMTU, err := routeMTU(dst)
+	if err != nil {
+		return result, fmt.Errorf("error getting route MTU: %w", err)
+	}
+
+	result.RouteMTU = routeMTU
+
+	p, err := newProber(dst)
+	if err != nil {
+		return result, err
+	}
+
+	defer p.Close() //nolint:errcheck
+
+	low := ipv4MinMTU
+	if dst.Is6() {
+		low = ipv6MinMTU
+	}
+
+	probe := func(size int) (bool, error) {
+		result.Probes++
+
+		return p.probe(ctx, size, timeout)
+	}
+
+	// the minimum MTU should always pass, otherwise the destination is not reachable at all
+	ok, err := probe(low)
+	if err != nil {
+		return result, err
+	}
+
+	if !ok {
+		return result, fmt.Errorf("destination %s is not reachable", dst)
+	}
+
+	high := routeMTU + 1
+
+	// binary search: low always passes, high always fails
+	for high-low > 1 {
+		mid := low + (high-low)/2
+
+		ok, err = probe(mid)
+		if err != nil {
+			return result, err
+		}
+
+		if ok {
+			low = mid
+		} else {
+			high = mid
+		}
+	}
+
+	result.PathMTU = low
+
+	return result, nil
+}
+
+// routeMTU returns the MTU the kernel would use to send a packet to the destination.
+func routeMTU(dst netip.Addr) (int, error) {
+	conn, err := net.DialUDP("udp", nil, net.UDPAddrFromAddrPort(netip.AddrPortFrom(dst, 9)))
+	if err != nil {
+		return 0, err
+	}
+
+	defer conn.Close() //nolint:errcheck
+
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		return 0, err
+	}
+
+	var (
+		mtu    int
+		sysErr error
+	)
+
+	if err = rawConn.Control(func(fd uintptr) {
+		if dst.Is6() {
+			mtu, sysErr = unix.GetsockoptInt(int(fd), unix.IPPROTO_IPV6, unix.IPV6_MTU)
+		} else {
+			mtu, sysErr = unix.GetsockoptInt(int(fd), unix.IPPROTO_IP, unix.IP_MTU)
+		}
+	}); err != nil {
+		return 0, err
+	}
+
+	return mtu, sysErr
+}
+
+type prober struct {
+	conn *net.IPConn
+	dst  netip.Addr
+
+	id  int
+	seq int
+}
+
+func newProber(dst netip.Addr) (*prober, error) {
+	network, address := "ip4:icmp", "0.0.0.0"
+	if dst.Is6() {
+		network, address = "ip6:ipv6-icmp", "::"
+	}
+
+	conn, err := net.ListenIP(network, &net.IPAddr{IP: net.ParseIP(address)})
+	if err != nil {
+		return nil, fmt.Errorf("error opening ICMP socket: %w", err)
+	}
+
+	rawConn, err := conn.SyscallConn()
+	if err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, err
+	}
+
+	var sysErr error
+
+	// set the DF bit, and allow sending packets larger than the cached path MTU
+	if err = rawConn.Control(func(fd uintptr) {
+		if dst.Is6() {
+			sysErr = unix.SetsockoptInt(int(fd), unix.IPPROTO_IPV6, unix.IPV6_MTU_DISCOVER, unix.IPV6_PMTUDISC_PROBE)
+		} else {
+			sysErr = unix.SetsockoptInt(int(fd), unix.IPPROTO_IP, unix.IP_MTU_DISCOVER, unix.IP_PMTUDISC_PROBE)
+		}
+	}); err == nil {
+		err = sysErr
+	}
+
+	if err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("error setting MTU discovery mode: %w", err)
+	}
+
+	return &prober{
+		conn: conn,
+		dst:  dst,
+		id:   os.Getpid() & 0xffff,
+	}, nil
+}
+
+func (p *prober) Close() error {
+	return p.conn.Close()
+}
+
+//nolint:gocyclo
+func (p *prober) probe(ctx context.Context, size int, timeout time.Duration) (bool, error) {
+	p.seq = (p.seq + 1) & 0xffff
+
+	headerLen := ipv4HeaderLen
+	proto := 1 // ICMP
+
+	var echoType, replyType icmp.Type = ipv4.ICMPTypeEcho, ipv4.ICMPTypeEchoReply
+
+	if p.dst.Is6() {
+		headerLen = ipv6HeaderLen
+		proto = 58 // ICMPv6
+		echoType, replyType = ipv6.ICMPTypeEchoRequest, ipv6.ICMPTypeEchoReply
+	}
+
+	msg := icmp.Message{
+		Type: echoType,
+		Body: &icmp.Echo{
+			ID:   p.id,
+			Seq:  p.seq,
+			Data: make([]byte, size-headerLen-icmpHeaderLen),
+		},
+	}
+
+	// for ICMPv6, the kernel calculates the checksum
+	b, err := msg.Marshal(nil)
+	if err != nil {
+		return false, err
+	}
+
+	if _, err = p.conn.WriteToIP(b, &net.IPAddr{IP: p.dst.AsSlice()}); err != nil {
+		// the packet is larger than the MTU of the local link, or a cached path MTU
+		if errors.Is(err, syscall.EMSGSIZE) {
+			return false, nil
+		}
+
+		return false, fmt.Errorf("error sending probe: %w", err)
+	}
+
+	deadline := time.Now().Add(timeout)
+
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+
+	if err = p.conn.SetReadDeadline(deadline); err != nil {
+		return false, err
+	}
+
+	buf := make([]byte, 65536)
+
+	for {
+		n, from, err := p.conn.ReadFromIP(buf)
+		if err != nil {
+			if errors.Is(err, os.ErrDeadlineExceeded) {
+				if ctx.Err() != nil {
+					return false, ctx.Err()
+				}
+
+				return false, nil
+			}
+
+			return false, fmt.Errorf("error receiving reply: %w", err)
+		}
+
+		reply, err := icmp.ParseMessage(proto, buf[:n])
+		if err != nil {
+			continue
+		}
+
+		switch body := reply.Body.(type) {
+		case *icmp.Echo:
+			fromAddr, _ := netip.AddrFromSlice(from.IP)
+
+			if reply.Type == replyType && body.ID == p.id && body.Seq == p.seq && fromAddr.Unmap() == p.dst {
+				return true, nil
+			}
+		case *icmp.DstUnreach:
+			// fragmentation needed
+			if p.isOwnProbe(body.Data, headerLen) {
+				return false, nil
+			}
+		case *icmp.PacketTooBig:
+			if p.isOwnProbe(body.Data, headerLen) {
+				return false, nil
+			}
+		}
+	}
+}
+
+// isOwnProbe checks if the original datagram quoted in the ICMP error is the current probe.
+func (p *prober) isOwnProbe(data []byte, headerLen int) bool {
+	if !p.dst.Is6() && len(data) > 0 {
+		headerLen = int(data[0]&0x0f) * 4
+	}
+
+	if len(data) < headerLen+icmpHeaderLen {
+		return false
+	}
+
+	echo := data[headerLen:]
+
+	return int(binary.BigEndian.Uint16(echo[4:6])) == p.id && int(binary.BigEndian.Uint16(echo[6:8])) == p.seq
+}
@@ -0,0 +1,36 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package pmtu_test
+
+import (
+	"context"
+	"net/netip"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/pmtu"
+)
+
+func TestDiscoverLoopback(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("requires root")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	t.Cleanup(cancel)
+
+	result, err := pmtu.Discover(ctx, netip.MustParseAddr("127.0.0.1"), time.Second)
+	require.NoError(t, err)
+
+	t.Logf("result: %+v", result)
+
+	// loopback MTU is larger than the maximum IPv4 packet size
+	assert.Equal(t, 65535, result.PathMTU)
+	assert.GreaterOrEqual(t, result.RouteMTU, result.PathMTU)
+}
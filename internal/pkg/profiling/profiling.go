@@ -0,0 +1,98 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package profiling collects pprof profiles of Talos services.
+package profiling
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+	"runtime/pprof"
+	"sync"
+	"time"
+)
+
+// Type is a profile type.
+type Type string
+
+// Profile types.
+const (
+	CPU       Type = "cpu"
+	Heap      Type = "heap"
+	Goroutine Type = "goroutine"
+	Mutex     Type = "mutex"
+	// Stacks is the plain text dump of all goroutine stacks, same as the Go runtime prints on SIGQUIT.
+	Stacks Type = "stacks"
+)
+
+// DefaultDuration is the default duration of the CPU and mutex profiles.
+const DefaultDuration = 30 * time.Second
+
+// mutexProfileFraction is the mutex contention sampling rate enabled while collecting the mutex profile.
+const mutexProfileFraction = 5
+
+// ErrUnsupportedType is returned for unknown profile types.
+var ErrUnsupportedType = errors.New("unsupported profile type")
+
+var mutexProfileMu sync.Mutex
+
+// Collect collects the profile of the current process and writes it to w in the gzipped protobuf format
+// (or plain text for the Stacks type).
+//
+// CPU and mutex profiles are collected over the duration, heap, goroutine and stacks profiles are snapshots.
+func Collect(ctx context.Context, w io.Writer, typ Type, duration time.Duration) error {
+	if duration <= 0 {
+		duration = DefaultDuration
+	}
+
+	switch typ {
+	case CPU:
+		if err := pprof.StartCPUProfile(w); err != nil {
+			return err
+		}
+
+		sleep(ctx, duration)
+
+		pprof.StopCPUProfile()
+
+		return ctx.Err()
+	case Heap, Goroutine:
+		return pprof.Lookup(string(typ)).WriteTo(w, 0)
+	case Stacks:
+		return pprof.Lookup(string(Goroutine)).WriteTo(w, 2)
+	case Mutex:
+		mutexProfileMu.Lock()
+		defer mutexProfileMu.Unlock()
+
+		// mutex contention is not sampled by default, so enable sampling for the duration of the profile
+		if runtime.SetMutexProfileFraction(-1) == 0 {
+			runtime.SetMutexProfileFraction(mutexProfileFraction)
+
+			sleep(ctx, duration)
+
+			runtime.SetMutexProfileFraction(0)
+
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+		}
+
+		return pprof.Lookup(string(typ)).WriteTo(w, 0)
+	default:
+		return fmt.Errorf("%w %q", ErrUnsupportedType, typ)
+	}
+}
+
+func sleep(ctx context.Context, duration time.Duration) {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	}
+}
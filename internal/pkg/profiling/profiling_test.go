@@ -0,0 +1,98 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package profiling_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/profiling"
+)
+
+// assertProfile checks that the profile is a valid gzipped stream.
+func assertProfile(t *testing.T, data []byte) {
+	t.Helper()
+
+	gz, err := gzip.NewReader(bytes.NewReader(data))
+	require.NoError(t, err)
+
+	raw, err := io.ReadAll(gz)
+	require.NoError(t, err)
+	assert.NotEmpty(t, raw)
+}
+
+func TestCollect(t *testing.T) {
+	for _, typ := range []profiling.Type{profiling.CPU, profiling.Heap, profiling.Goroutine, profiling.Mutex} {
+		t.Run(string(typ), func(t *testing.T) {
+			var buf bytes.Buffer
+
+			require.NoError(t, profiling.Collect(context.Background(), &buf, typ, 100*time.Millisecond))
+
+			assertProfile(t, buf.Bytes())
+		})
+	}
+
+	assert.ErrorIs(t, profiling.Collect(context.Background(), io.Discard, "threadcreate", 0), profiling.ErrUnsupportedType)
+}
+
+func TestCollectStacks(t *testing.T) {
+	var buf bytes.Buffer
+
+	require.NoError(t, profiling.Collect(context.Background(), &buf, profiling.Stacks, 0))
+
+	assert.Contains(t, buf.String(), "goroutine ")
+	assert.Contains(t, buf.String(), "profiling_test.TestCollectStacks")
+}
+
+func TestServeFetch(t *testing.T) {
+	dir, err := os.MkdirTemp("", "pprof")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { os.RemoveAll(dir) }) //nolint:errcheck
+
+	socketPath := filepath.Join(dir, "pprof.sock")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- profiling.Serve(ctx, socketPath)
+	}()
+
+	require.Eventually(t, func() bool {
+		_, err := os.Stat(socketPath)
+
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	var buf bytes.Buffer
+
+	require.NoError(t, profiling.Fetch(ctx, socketPath, &buf, profiling.Goroutine, 0))
+
+	assertProfile(t, buf.Bytes())
+
+	buf.Reset()
+
+	require.NoError(t, profiling.Fetch(ctx, socketPath, &buf, profiling.Stacks, 0))
+
+	assert.Contains(t, buf.String(), "profiling.Serve")
+
+	assert.ErrorIs(t, profiling.Fetch(ctx, socketPath, io.Discard, "threadcreate", 0), profiling.ErrUnsupportedType)
+
+	cancel()
+
+	require.NoError(t, <-errCh)
+}
@@ -0,0 +1,140 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package profiling
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// Serve serves the profiles of the current process over HTTP on the unix socket.
+//
+// Profiles are fetched from the socket with Fetch.
+func Serve(ctx context.Context, socketPath string) error {
+	if err := os.RemoveAll(socketPath); err != nil {
+		return err
+	}
+
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		return fmt.Errorf("error listening on %q: %w", socketPath, err)
+	}
+
+	server := &http.Server{
+		Handler: Handler(),
+	}
+
+	go func() {
+		<-ctx.Done()
+
+		server.Close() //nolint:errcheck
+	}()
+
+	if err = server.Serve(listener); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// Handler returns the HTTP handler serving the profiles of the current process.
+//
+// The profile type and duration are passed as `type` and `duration` query parameters.
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		duration, err := parseDuration(req.URL.Query().Get("duration"))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+
+			return
+		}
+
+		var buf bytes.Buffer
+
+		if err = Collect(req.Context(), &buf, Type(req.URL.Query().Get("type")), duration); err != nil {
+			code := http.StatusInternalServerError
+
+			if errors.Is(err, ErrUnsupportedType) {
+				code = http.StatusBadRequest
+			}
+
+			http.Error(w, err.Error(), code)
+
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/octet-stream")
+		w.Write(buf.Bytes()) //nolint:errcheck
+	})
+}
+
+// Fetch the profile of another process served on the unix socket with Serve.
+func Fetch(ctx context.Context, socketPath string, w io.Writer, typ Type, duration time.Duration) error {
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+
+				return d.DialContext(ctx, "unix", socketPath)
+			},
+		},
+	}
+
+	defer client.CloseIdleConnections()
+
+	query := url.Values{}
+	query.Set("type", string(typ))
+	query.Set("duration", duration.String())
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "http://localhost/profile?"+query.Encode(), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096)) //nolint:errcheck
+
+		msg := strings.TrimSpace(string(body))
+
+		if resp.StatusCode == http.StatusBadRequest && strings.HasPrefix(msg, ErrUnsupportedType.Error()) {
+			return fmt.Errorf("%w%s", ErrUnsupportedType, strings.TrimPrefix(msg, ErrUnsupportedType.Error()))
+		}
+
+		return fmt.Errorf("error collecting profile: %s", msg)
+	}
+
+	_, err = io.Copy(w, resp.Body)
+
+	return err
+}
+
+func parseDuration(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid duration: %w", err)
+	}
+
+	return duration, nil
+}
@@ -0,0 +1,110 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ptrace
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+)
+
+// maxStringLen is the maximum length of the string argument to read from the tracee.
+const maxStringLen = 256
+
+// numArgs is the number of arguments printed for the syscalls, as the number of arguments isn't known.
+const numArgs = 6
+
+// String formats the event in strace style.
+func (e Event) String() string {
+	if e.Errno != "" {
+		return fmt.Sprintf("%s(%s) = -1 %s <%.6f>", e.Syscall, strings.Join(e.Args, ", "), e.Errno, e.Duration.Seconds())
+	}
+
+	return fmt.Sprintf("%s(%s) = %d <%.6f>", e.Syscall, strings.Join(e.Args, ", "), e.Return, e.Duration.Seconds())
+}
+
+// formatArgs formats the syscall arguments, reading the string arguments from the tracee memory.
+func formatArgs(tid int, nr uint64, args [numArgs]uint64) []string {
+	formatted := make([]string, numArgs)
+
+	for i, arg := range args {
+		formatted[i] = formatInt(arg)
+	}
+
+	for _, i := range stringArgs[SyscallName(nr)] {
+		if args[i] == 0 {
+			formatted[i] = "NULL"
+
+			continue
+		}
+
+		if s, ok := readString(tid, uintptr(args[i])); ok {
+			formatted[i] = s
+		}
+	}
+
+	return formatted
+}
+
+// formatInt formats small (and small negative) values as decimals, and everything else (pointers, flags) as hex.
+func formatInt(v uint64) string {
+	if n := int64(v); n > -4096 && n < 65536 {
+		return strconv.FormatInt(n, 10)
+	}
+
+	// int arguments are not sign-extended
+	if n := int32(v); v>>32 == 0 && n > -4096 && n < 0 {
+		return strconv.FormatInt(int64(n), 10)
+	}
+
+	return "0x" + strconv.FormatUint(v, 16)
+}
+
+// readString reads the NUL-terminated string from the tracee memory.
+func readString(tid int, addr uintptr) (string, bool) {
+	var (
+		buf   []byte
+		chunk [64]byte
+	)
+
+	for len(buf) < maxStringLen {
+		n, err := unix.PtracePeekData(tid, addr+uintptr(len(buf)), chunk[:])
+		if n == 0 {
+			if err != nil && len(buf) == 0 {
+				return "", false
+			}
+
+			break
+		}
+
+		if idx := bytes.IndexByte(chunk[:n], 0); idx != -1 {
+			return strconv.Quote(string(append(buf, chunk[:idx]...))), true
+		}
+
+		buf = append(buf, chunk[:n]...)
+
+		if err != nil {
+			break
+		}
+	}
+
+	if len(buf) > maxStringLen {
+		buf = buf[:maxStringLen]
+	}
+
+	return strconv.Quote(string(buf)) + "...", true
+}
+
+func errnoName(errno syscall.Errno) string {
+	if name := unix.ErrnoName(errno); name != "" {
+		return name
+	}
+
+	return "errno " + strconv.Itoa(int(errno))
+}
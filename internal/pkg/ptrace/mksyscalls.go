@@ -0,0 +1,59 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//go:build ignore
+
+// mksyscalls generates the syscall name tables from golang.org/x/sys/unix.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+var sysRe = regexp.MustCompile(`(?m)^\s*SYS_(\w+)\s*=\s*(\d+)$`)
+
+func main() {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", "golang.org/x/sys").Output()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	dir := filepath.Join(strings.TrimSpace(string(out)), "unix")
+
+	for _, arch := range []string{"amd64", "arm64"} {
+		contents, err := os.ReadFile(filepath.Join(dir, fmt.Sprintf("zsysnum_linux_%s.go", arch)))
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		var buf bytes.Buffer
+
+		fmt.Fprintf(&buf, "// Code generated by mksyscalls.go; DO NOT EDIT.\n\n")
+		fmt.Fprintf(&buf, "package ptrace\n\n")
+		fmt.Fprintf(&buf, "var syscallNames = map[uint64]string{\n")
+
+		for _, match := range sysRe.FindAllStringSubmatch(string(contents), -1) {
+			fmt.Fprintf(&buf, "\t%s: %q,\n", match[2], strings.ToLower(match[1]))
+		}
+
+		fmt.Fprintf(&buf, "}\n")
+
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err = os.WriteFile(fmt.Sprintf("zsyscalls_linux_%s.go", arch), src, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
@@ -0,0 +1,359 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package ptrace implements strace-like syscall tracing of a running process.
+//
+// The tracer uses wait4() to receive the events, so it should run in a process which doesn't have other children
+// reaped concurrently (e.g. not in machined which runs the zombie reaper).
+package ptrace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"syscall"
+	"time"
+	"unsafe"
+
+	"golang.org/x/sys/unix"
+)
+
+// pollInterval is the interval to poll for the tracee events when there are none pending.
+const pollInterval = 10 * time.Millisecond
+
+// Options configures the tracing.
+type Options struct {
+	// Syscalls to trace, if empty, all syscalls are traced.
+	Syscalls map[uint64]struct{}
+	// MinDuration skips the syscalls which completed faster.
+	MinDuration time.Duration
+}
+
+// Event is a completed syscall.
+type Event struct {
+	Timestamp time.Time
+	TID       int
+	Syscall   string
+	Args      []string
+	Return    int64
+	// Errno is set if the syscall failed, Return is -1 in that case.
+	Errno    string
+	Duration time.Duration
+}
+
+// thread is the state of the traced thread.
+type thread struct {
+	entered time.Time
+	nr      uint64
+	args    []string
+	// inSyscall is set after the syscall entry stop.
+	inSyscall bool
+}
+
+// syscallInfo is struct ptrace_syscall_info.
+type syscallInfo struct {
+	Op   uint8
+	_    [3]uint8
+	Arch uint32
+	IP   uint64
+	SP   uint64
+	Data [8]uint64
+}
+
+// ptrace_syscall_info ops.
+const (
+	syscallInfoEntry = 1
+	syscallInfoExit  = 2
+)
+
+// syscallStopSignal is reported for syscall stops with PTRACE_O_TRACESYSGOOD.
+const syscallStopSignal = unix.SIGTRAP | 0x80
+
+const traceOptions = unix.PTRACE_O_TRACESYSGOOD | unix.PTRACE_O_TRACECLONE | unix.PTRACE_O_TRACEEXEC
+
+type tracer struct {
+	opts    Options
+	threads map[int]*thread
+}
+
+// Trace the syscalls of all threads of the process until the context is canceled or the process exits.
+//
+// The tracer detaches from the process before returning.
+func Trace(ctx context.Context, pid int, opts Options, handler func(Event) error) (err error) {
+	// all ptrace requests should come from the same thread
+	runtime.LockOSThread()
+	defer runtime.UnlockOSThread()
+
+	t := &tracer{
+		opts:    opts,
+		threads: map[int]*thread{},
+	}
+
+	tids, err := listThreads(pid)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		if detachErr := t.detach(); detachErr != nil && err == nil {
+			err = detachErr
+		}
+	}()
+
+	for _, tid := range tids {
+		if err = t.attach(tid); err != nil {
+			if errors.Is(err, unix.ESRCH) {
+				// thread exited
+				continue
+			}
+
+			return fmt.Errorf("error attaching to thread %d: %w", tid, err)
+		}
+	}
+
+	if len(t.threads) == 0 {
+		return fmt.Errorf("process %d not found", pid)
+	}
+
+	for len(t.threads) > 0 {
+		var status unix.WaitStatus
+
+		tid, err := unix.Wait4(-1, &status, unix.WALL|unix.WNOHANG, nil)
+
+		switch {
+		case errors.Is(err, unix.EINTR):
+			continue
+		case errors.Is(err, unix.ECHILD):
+			return nil
+		case err != nil:
+			return fmt.Errorf("error waiting for the tracee: %w", err)
+		case tid == 0:
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(pollInterval):
+			}
+
+			continue
+		}
+
+		if err = t.handle(tid, status, handler); err != nil {
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func listThreads(pid int) ([]int, error) {
+	entries, err := os.ReadDir(filepath.Join("/proc", strconv.Itoa(pid), "task"))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, fmt.Errorf("process %d not found", pid)
+		}
+
+		return nil, fmt.Errorf("error listing threads: %w", err)
+	}
+
+	tids := make([]int, 0, len(entries))
+
+	for _, entry := range entries {
+		tid, err := strconv.Atoi(entry.Name())
+		if err != nil {
+			continue
+		}
+
+		tids = append(tids, tid)
+	}
+
+	return tids, nil
+}
+
+// attach seizes the thread and interrupts it, so that the syscall tracing starts on the following stop.
+func (t *tracer) attach(tid int) error {
+	if err := ptrace(unix.PTRACE_SEIZE, tid, 0, traceOptions); err != nil {
+		return err
+	}
+
+	t.threads[tid] = &thread{}
+
+	return unix.PtraceInterrupt(tid)
+}
+
+//nolint:gocyclo
+func (t *tracer) handle(tid int, status unix.WaitStatus, handler func(Event) error) error {
+	if status.Exited() || status.Signaled() {
+		delete(t.threads, tid)
+
+		return nil
+	}
+
+	if !status.Stopped() {
+		return nil
+	}
+
+	th, ok := t.threads[tid]
+	if !ok {
+		// a new thread, attached automatically via PTRACE_O_TRACECLONE
+		th = &thread{}
+		t.threads[tid] = th
+	}
+
+	sig := status.StopSignal()
+	event := uint32(status) >> 16
+
+	switch {
+	case sig == syscallStopSignal:
+		if err := t.syscallStop(tid, th, handler); err != nil {
+			return err
+		}
+	case event == unix.PTRACE_EVENT_STOP:
+		switch sig { //nolint:exhaustive
+		case unix.SIGSTOP, unix.SIGTSTP, unix.SIGTTIN, unix.SIGTTOU:
+			// group-stop, keep the thread stopped without blocking the events
+			return ignoreESRCH(ptrace(unix.PTRACE_LISTEN, tid, 0, 0))
+		}
+	case event != 0:
+		// clone, exec events
+	default:
+		// signal-delivery-stop, inject the signal
+		return ignoreESRCH(unix.PtraceSyscall(tid, int(sig)))
+	}
+
+	return ignoreESRCH(unix.PtraceSyscall(tid, 0))
+}
+
+func (t *tracer) syscallStop(tid int, th *thread, handler func(Event) error) error {
+	var info syscallInfo
+
+	if err := ptrace(unix.PTRACE_GET_SYSCALL_INFO, tid, unsafe.Sizeof(info), uintptr(unsafe.Pointer(&info))); err != nil {
+		return ignoreESRCH(err)
+	}
+
+	switch info.Op {
+	case syscallInfoEntry:
+		th.inSyscall = true
+		th.entered = time.Now()
+		th.nr = info.Data[0]
+		th.args = nil
+
+		if _, traced := t.opts.Syscalls[th.nr]; traced || len(t.opts.Syscalls) == 0 {
+			th.args = formatArgs(tid, th.nr, [6]uint64(info.Data[1:7]))
+		}
+	case syscallInfoExit:
+		if !th.inSyscall {
+			// attached in the middle of the syscall
+			return nil
+		}
+
+		th.inSyscall = false
+
+		if th.args == nil {
+			return nil
+		}
+
+		now := time.Now()
+
+		ev := Event{
+			Timestamp: th.entered,
+			TID:       tid,
+			Syscall:   SyscallName(th.nr),
+			Args:      th.args,
+			Return:    int64(info.Data[0]),
+			Duration:  now.Sub(th.entered),
+		}
+
+		if ev.Duration < t.opts.MinDuration {
+			return nil
+		}
+
+		if info.Data[1] != 0 {
+			ev.Errno = errnoName(syscall.Errno(-ev.Return))
+			ev.Return = -1
+		}
+
+		return handler(ev)
+	}
+
+	return nil
+}
+
+// detach stops all the threads and detaches from them.
+func (t *tracer) detach() error {
+	for tid := range t.threads {
+		if err := unix.PtraceInterrupt(tid); err != nil && !errors.Is(err, unix.ESRCH) {
+			return fmt.Errorf("error interrupting thread %d: %w", tid, err)
+		}
+	}
+
+	for len(t.threads) > 0 {
+		var status unix.WaitStatus
+
+		tid, err := unix.Wait4(-1, &status, unix.WALL, nil)
+
+		switch {
+		case errors.Is(err, unix.EINTR):
+			continue
+		case errors.Is(err, unix.ECHILD):
+			return nil
+		case err != nil:
+			return fmt.Errorf("error waiting for the tracee: %w", err)
+		}
+
+		if _, ok := t.threads[tid]; !ok {
+			continue
+		}
+
+		if status.Exited() || status.Signaled() {
+			delete(t.threads, tid)
+
+			continue
+		}
+
+		if !status.Stopped() {
+			continue
+		}
+
+		var sig uintptr
+
+		if uint32(status)>>16 == 0 && status.StopSignal() != syscallStopSignal {
+			// pending signal, deliver it on detach
+			sig = uintptr(status.StopSignal())
+		}
+
+		if err = ptrace(unix.PTRACE_DETACH, tid, 0, sig); err != nil && !errors.Is(err, unix.ESRCH) {
+			return fmt.Errorf("error detaching from thread %d: %w", tid, err)
+		}
+
+		delete(t.threads, tid)
+	}
+
+	return nil
+}
+
+func ptrace(request, pid int, addr, data uintptr) error {
+	_, _, errno := unix.Syscall6(unix.SYS_PTRACE, uintptr(request), uintptr(pid), addr, data, 0, 0)
+	if errno != 0 {
+		return errno
+	}
+
+	return nil
+}
+
+func ignoreESRCH(err error) error {
+	if errors.Is(err, unix.ESRCH) {
+		// the thread was killed
+		return nil
+	}
+
+	return err
+}
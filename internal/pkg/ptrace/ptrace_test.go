@@ -0,0 +1,101 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ptrace_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	"github.com/siderolabs/talos/internal/pkg/ptrace"
+)
+
+func TestTrace(t *testing.T) {
+	if os.Geteuid() != 0 {
+		t.Skip("requires root")
+	}
+
+	cmd := exec.Command("/bin/sh", "-c", "while :; do : > /dev/null; done")
+	require.NoError(t, cmd.Start())
+
+	t.Cleanup(func() {
+		cmd.Process.Kill() //nolint:errcheck
+		cmd.Wait()         //nolint:errcheck
+	})
+
+	syscalls, err := ptrace.ParseSyscalls([]string{"openat"})
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	var events []ptrace.Event
+
+	err = ptrace.Trace(ctx, cmd.Process.Pid, ptrace.Options{Syscalls: syscalls}, func(event ptrace.Event) error {
+		events = append(events, event)
+
+		return nil
+	})
+	if errors.Is(err, unix.EPERM) {
+		t.Skip("ptrace is not permitted")
+	}
+
+	require.NoError(t, err)
+	require.NotEmpty(t, events)
+
+	paths := map[string]struct{}{}
+
+	for _, event := range events {
+		assert.Equal(t, "openat", event.Syscall)
+		assert.Equal(t, cmd.Process.Pid, event.TID)
+		assert.Equal(t, "-100", event.Args[0])
+
+		paths[event.Args[1]] = struct{}{}
+	}
+
+	assert.Contains(t, paths, `"/dev/null"`)
+
+	// the process is still running after the tracer detaches
+	require.NoError(t, cmd.Process.Signal(unix.Signal(0)))
+}
+
+func TestParseSyscalls(t *testing.T) {
+	syscalls, err := ptrace.ParseSyscalls([]string{"openat", "read"})
+	require.NoError(t, err)
+	assert.Len(t, syscalls, 2)
+
+	_, err = ptrace.ParseSyscalls([]string{"openat", "nosuchsyscall"})
+	assert.EqualError(t, err, `unknown syscall "nosuchsyscall"`)
+}
+
+func TestEventString(t *testing.T) {
+	assert.Equal(t,
+		`openat(-100, "/etc/hosts", 524288, 0, 0, 0) = 3 <0.000012>`,
+		ptrace.Event{
+			Syscall:  "openat",
+			Args:     []string{"-100", `"/etc/hosts"`, "524288", "0", "0", "0"},
+			Return:   3,
+			Duration: 12 * time.Microsecond,
+		}.String(),
+	)
+
+	assert.Equal(t,
+		`openat(-100, "/nonexistent", 524288, 0, 0, 0) = -1 ENOENT <0.000010>`,
+		ptrace.Event{
+			Syscall:  "openat",
+			Args:     []string{"-100", `"/nonexistent"`, "524288", "0", "0", "0"},
+			Return:   -1,
+			Errno:    "ENOENT",
+			Duration: 10 * time.Microsecond,
+		}.String(),
+	)
+}
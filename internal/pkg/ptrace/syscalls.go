@@ -0,0 +1,83 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ptrace
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+//go:generate go run mksyscalls.go
+
+// SyscallName returns the name of the syscall by its number.
+func SyscallName(nr uint64) string {
+	if name, ok := syscallNames[nr]; ok {
+		return name
+	}
+
+	return "syscall_" + strconv.FormatUint(nr, 10)
+}
+
+// ParseSyscalls converts the list of syscall names to the set of syscall numbers.
+func ParseSyscalls(names []string) (map[uint64]struct{}, error) {
+	numbers := make(map[string]uint64, len(syscallNames))
+
+	for nr, name := range syscallNames {
+		numbers[name] = nr
+	}
+
+	set := make(map[uint64]struct{}, len(names))
+
+	for _, name := range names {
+		nr, ok := numbers[strings.TrimSpace(name)]
+		if !ok {
+			return nil, fmt.Errorf("unknown syscall %q", name)
+		}
+
+		set[nr] = struct{}{}
+	}
+
+	return set, nil
+}
+
+// stringArgs lists the arguments of the syscalls which are NUL-terminated strings.
+var stringArgs = map[string][]int{
+	"access":     {0},
+	"chdir":      {0},
+	"chmod":      {0},
+	"chown":      {0},
+	"creat":      {0},
+	"execve":     {0},
+	"execveat":   {1},
+	"faccessat":  {1},
+	"faccessat2": {1},
+	"fchmodat":   {1},
+	"fchownat":   {1},
+	"link":       {0, 1},
+	"linkat":     {1, 3},
+	"lstat":      {0},
+	"mkdir":      {0},
+	"mkdirat":    {1},
+	"mount":      {0, 1, 2},
+	"newfstatat": {1},
+	"open":       {0},
+	"openat":     {1},
+	"openat2":    {1},
+	"readlink":   {0},
+	"readlinkat": {1},
+	"rename":     {0, 1},
+	"renameat":   {1, 3},
+	"renameat2":  {1, 3},
+	"rmdir":      {0},
+	"stat":       {0},
+	"statx":      {1},
+	"symlink":    {0, 1},
+	"symlinkat":  {0, 2},
+	"truncate":   {0},
+	"umount2":    {0},
+	"unlink":     {0},
+	"unlinkat":   {1},
+}
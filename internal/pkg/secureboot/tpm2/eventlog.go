@@ -0,0 +1,406 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package tpm2
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+
+	"github.com/google/go-tpm/tpm2"
+
+	"github.com/siderolabs/talos/internal/pkg/secureboot"
+)
+
+// EventLogPath is the path to the firmware TPM event log exposed by the kernel.
+const EventLogPath = "/sys/kernel/security/tpm0/binary_bios_measurements"
+
+// BootPhaseEventType is the type of the events describing the boot phases extended by Talos.
+//
+// Boot phases are not recorded into the firmware event log, so they are derived from the current PCR value.
+const BootPhaseEventType = "TALOS_BOOT_PHASE"
+
+// Event is a single measurement extended to a PCR.
+type Event struct {
+	PCR         int
+	Type        string
+	Digest      []byte
+	Description string
+}
+
+// MeasurementLog returns the SHA256 measurements extended to the PCRs.
+//
+// The log consists of the firmware event log and the Talos boot phases extended to the UKI PCR,
+// the current pcrValues are used to find out which boot phases were extended so far.
+// If the firmware event log is not available, MeasurementLog returns nil and no error.
+func MeasurementLog(pcrValues map[int][]byte) (map[int][]Event, error) {
+	data, err := os.ReadFile(EventLogPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	events, err := ParseEventLog(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse TPM event log: %w", err)
+	}
+
+	measurements := make(map[int][]Event, len(pcrValues))
+
+	for _, event := range events {
+		if _, ok := pcrValues[event.PCR]; ok {
+			measurements[event.PCR] = append(measurements[event.PCR], event)
+		}
+	}
+
+	if value, ok := pcrValues[secureboot.UKIPCR]; ok {
+		measurements[secureboot.UKIPCR] = appendBootPhases(measurements[secureboot.UKIPCR], value)
+	}
+
+	return measurements, nil
+}
+
+// ReplayPCR calculates the PCR value from the measurements extended to it.
+func ReplayPCR(events []Event) []byte {
+	value := make([]byte, sha256.Size)
+
+	for _, event := range events {
+		value = extend(value, event.Digest)
+	}
+
+	return value
+}
+
+func extend(value, digest []byte) []byte {
+	hash := sha256.New()
+	hash.Write(value)
+	hash.Write(digest)
+
+	return hash.Sum(nil)
+}
+
+// appendBootPhases appends the boot phases which bring the replayed PCR value to the current one.
+//
+// If the current value can't be reached, the phases are not guessed and the events are returned as is.
+func appendBootPhases(events []Event, current []byte) []Event {
+	value := ReplayPCR(events)
+
+	var phaseEvents []Event
+
+	for _, phase := range []secureboot.Phase{secureboot.EnterInitrd, secureboot.LeaveInitrd, secureboot.EnterMachined, secureboot.StartTheWorld} {
+		if bytes.Equal(value, current) {
+			break
+		}
+
+		digest := sha256.Sum256([]byte(phase))
+
+		phaseEvents = append(phaseEvents, Event{
+			PCR:         secureboot.UKIPCR,
+			Type:        BootPhaseEventType,
+			Digest:      digest[:],
+			Description: string(phase),
+		})
+
+		value = extend(value, digest[:])
+	}
+
+	if !bytes.Equal(value, current) {
+		return events
+	}
+
+	return append(events, phaseEvents...)
+}
+
+const (
+	evNoAction         = 0x00000003
+	evEFIEventBase     = 0x80000000
+	specIDSignature    = "Spec ID Event03\x00"
+	sha1DigestSize     = 20
+	uefiVariableHeader = 16 + 8 + 8
+)
+
+var eventTypeNames = map[uint32]string{
+	0x00000000: "EV_PREBOOT_CERT",
+	0x00000001: "EV_POST_CODE",
+	0x00000003: "EV_NO_ACTION",
+	0x00000004: "EV_SEPARATOR",
+	0x00000005: "EV_ACTION",
+	0x00000006: "EV_EVENT_TAG",
+	0x00000007: "EV_S_CRTM_CONTENTS",
+	0x00000008: "EV_S_CRTM_VERSION",
+	0x00000009: "EV_CPU_MICROCODE",
+	0x0000000a: "EV_PLATFORM_CONFIG_FLAGS",
+	0x0000000b: "EV_TABLE_OF_DEVICES",
+	0x0000000c: "EV_COMPACT_HASH",
+	0x0000000d: "EV_IPL",
+	0x0000000e: "EV_IPL_PARTITION_DATA",
+	0x0000000f: "EV_NONHOST_CODE",
+	0x00000010: "EV_NONHOST_CONFIG",
+	0x00000011: "EV_NONHOST_INFO",
+	0x00000012: "EV_OMIT_BOOT_DEVICE_EVENTS",
+	0x80000001: "EV_EFI_VARIABLE_DRIVER_CONFIG",
+	0x80000002: "EV_EFI_VARIABLE_BOOT",
+	0x80000003: "EV_EFI_BOOT_SERVICES_APPLICATION",
+	0x80000004: "EV_EFI_BOOT_SERVICES_DRIVER",
+	0x80000005: "EV_EFI_RUNTIME_SERVICES_DRIVER",
+	0x80000006: "EV_EFI_GPT_EVENT",
+	0x80000007: "EV_EFI_ACTION",
+	0x80000008: "EV_EFI_PLATFORM_FIRMWARE_BLOB",
+	0x80000009: "EV_EFI_HANDOFF_TABLES",
+	0x8000000a: "EV_EFI_PLATFORM_FIRMWARE_BLOB2",
+	0x8000000b: "EV_EFI_HANDOFF_TABLES2",
+	0x8000000c: "EV_EFI_VARIABLE_BOOT2",
+	0x80000010: "EV_EFI_HCRTM_EVENT",
+	0x800000e0: "EV_EFI_VARIABLE_AUTHORITY",
+	0x800000e1: "EV_EFI_SPDM_FIRMWARE_BLOB",
+	0x800000e2: "EV_EFI_SPDM_FIRMWARE_CONFIG",
+}
+
+func eventTypeName(eventType uint32) string {
+	if name, ok := eventTypeNames[eventType]; ok {
+		return name
+	}
+
+	return fmt.Sprintf("0x%08x", eventType)
+}
+
+// eventLogReader reads the little-endian fields of the event log.
+type eventLogReader struct {
+	data []byte
+}
+
+func (r *eventLogReader) bytes(n int) ([]byte, error) {
+	if n < 0 || n > len(r.data) {
+		return nil, errors.New("unexpected end of the event log")
+	}
+
+	b := r.data[:n]
+	r.data = r.data[n:]
+
+	return b, nil
+}
+
+func (r *eventLogReader) uint32() (uint32, error) {
+	b, err := r.bytes(4)
+	if err != nil {
+		return 0, err
+	}
+
+	return binary.LittleEndian.Uint32(b), nil
+}
+
+func (r *eventLogReader) uint16() (uint16, error) {
+	b, err := r.bytes(2)
+	if err != nil {
+		return 0, err
+	}
+
+	return binary.LittleEndian.Uint16(b), nil
+}
+
+// ParseEventLog parses the TCG crypto-agile firmware event log and returns the SHA256 measurements.
+//
+// EV_NO_ACTION events are skipped, as they are not extended to the PCRs.
+//
+//nolint:gocyclo,cyclop
+func ParseEventLog(data []byte) ([]Event, error) {
+	r := &eventLogReader{data: data}
+
+	// the first event is in the SHA1 log format and describes the digest sizes of the algorithms used in the log
+	header, err := r.bytes(4 + 4 + sha1DigestSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if eventType := binary.LittleEndian.Uint32(header[4:]); eventType != evNoAction {
+		return nil, errors.New("event log is not in the crypto-agile format")
+	}
+
+	specIDSize, err := r.uint32()
+	if err != nil {
+		return nil, err
+	}
+
+	specID, err := r.bytes(int(specIDSize))
+	if err != nil {
+		return nil, err
+	}
+
+	digestSizes, err := parseSpecIDEvent(specID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := digestSizes[tpm2.TPMAlgSHA256]; !ok {
+		return nil, errors.New("event log doesn't contain SHA256 digests")
+	}
+
+	var events []Event
+
+	for len(r.data) > 0 {
+		pcr, err := r.uint32()
+		if err != nil {
+			return nil, err
+		}
+
+		eventType, err := r.uint32()
+		if err != nil {
+			return nil, err
+		}
+
+		digestCount, err := r.uint32()
+		if err != nil {
+			return nil, err
+		}
+
+		var sha256Digest []byte
+
+		for range digestCount {
+			algorithm, err := r.uint16()
+			if err != nil {
+				return nil, err
+			}
+
+			size, ok := digestSizes[tpm2.TPMAlgID(algorithm)]
+			if !ok {
+				return nil, fmt.Errorf("unknown digest algorithm 0x%04x", algorithm)
+			}
+
+			digest, err := r.bytes(int(size))
+			if err != nil {
+				return nil, err
+			}
+
+			if tpm2.TPMAlgID(algorithm) == tpm2.TPMAlgSHA256 {
+				sha256Digest = digest
+			}
+		}
+
+		eventSize, err := r.uint32()
+		if err != nil {
+			return nil, err
+		}
+
+		eventData, err := r.bytes(int(eventSize))
+		if err != nil {
+			return nil, err
+		}
+
+		if eventType == evNoAction || sha256Digest == nil {
+			continue
+		}
+
+		events = append(events, Event{
+			PCR:         int(pcr),
+			Type:        eventTypeName(eventType),
+			Digest:      bytes.Clone(sha256Digest),
+			Description: eventDescription(eventType, eventData),
+		})
+	}
+
+	return events, nil
+}
+
+// parseSpecIDEvent parses the TCG_EfiSpecIDEvent structure and returns the digest sizes of the algorithms.
+func parseSpecIDEvent(data []byte) (map[tpm2.TPMAlgID]uint16, error) {
+	r := &eventLogReader{data: data}
+
+	signature, err := r.bytes(len(specIDSignature))
+	if err != nil {
+		return nil, err
+	}
+
+	if string(signature) != specIDSignature {
+		return nil, errors.New("event log is not in the crypto-agile format")
+	}
+
+	// platform class, spec version minor/major, errata and uintn size
+	if _, err = r.bytes(4 + 4); err != nil {
+		return nil, err
+	}
+
+	algorithmCount, err := r.uint32()
+	if err != nil {
+		return nil, err
+	}
+
+	digestSizes := make(map[tpm2.TPMAlgID]uint16, algorithmCount)
+
+	for range algorithmCount {
+		algorithm, err := r.uint16()
+		if err != nil {
+			return nil, err
+		}
+
+		size, err := r.uint16()
+		if err != nil {
+			return nil, err
+		}
+
+		digestSizes[tpm2.TPMAlgID(algorithm)] = size
+	}
+
+	return digestSizes, nil
+}
+
+// eventDescription returns the human-readable description of the event data.
+func eventDescription(eventType uint32, data []byte) string {
+	switch eventType {
+	case evEFIEventBase + 0x1, evEFIEventBase + 0x2, evEFIEventBase + 0xc, evEFIEventBase + 0xe0:
+		// UEFI_VARIABLE_DATA: the description is the variable name
+		if len(data) < uefiVariableHeader {
+			return ""
+		}
+
+		nameLength := binary.LittleEndian.Uint64(data[16:])
+
+		if nameLength > uint64(len(data)-uefiVariableHeader)/2 {
+			return ""
+		}
+
+		return decodeUTF16(data[uefiVariableHeader : uefiVariableHeader+2*nameLength])
+	}
+
+	if text := strings.TrimRight(string(data), "\x00"); text != "" && isPrintable(text) {
+		return text
+	}
+
+	if len(data)%2 == 0 {
+		if text := decodeUTF16(data); text != "" && isPrintable(text) {
+			return text
+		}
+	}
+
+	return ""
+}
+
+func decodeUTF16(data []byte) string {
+	chars := make([]uint16, 0, len(data)/2)
+
+	for i := 0; i+1 < len(data); i += 2 {
+		chars = append(chars, binary.LittleEndian.Uint16(data[i:]))
+	}
+
+	return strings.TrimRight(string(utf16.Decode(chars)), "\x00")
+}
+
+func isPrintable(text string) bool {
+	for _, r := range text {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+
+	return true
+}
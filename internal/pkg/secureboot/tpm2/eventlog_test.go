@@ -0,0 +1,160 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package tpm2_test
+
+import (
+	"bytes"
+	"crypto/sha1" //nolint:gosec
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+	"unicode/utf16"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/secureboot"
+	tpm2internal "github.com/siderolabs/talos/internal/pkg/secureboot/tpm2"
+)
+
+// eventLog builds a crypto-agile event log with SHA1 and SHA256 digests.
+type eventLog struct {
+	bytes.Buffer
+}
+
+func newEventLog() *eventLog {
+	log := &eventLog{}
+
+	var specID bytes.Buffer
+
+	specID.WriteString("Spec ID Event03\x00")
+	binary.Write(&specID, binary.LittleEndian, uint32(0))            //nolint:errcheck // platform class
+	specID.Write([]byte{0, 2, 0, 2})                                 // spec version, errata, uintn size
+	binary.Write(&specID, binary.LittleEndian, uint32(2))            //nolint:errcheck
+	binary.Write(&specID, binary.LittleEndian, []uint16{0x0004, 20}) //nolint:errcheck // SHA1
+	binary.Write(&specID, binary.LittleEndian, []uint16{0x000b, 32}) //nolint:errcheck // SHA256
+	specID.WriteByte(0)                                              // vendor info size
+	binary.Write(log, binary.LittleEndian, []uint32{0, 0x3})         //nolint:errcheck // PCR 0, EV_NO_ACTION
+	log.Write(make([]byte, sha1.Size))                               //nolint:errcheck
+	binary.Write(log, binary.LittleEndian, uint32(specID.Len()))     //nolint:errcheck
+	log.Write(specID.Bytes())                                        //nolint:errcheck
+
+	return log
+}
+
+func (log *eventLog) add(pcr int, eventType uint32, measured, data []byte) {
+	sha1Digest := sha1.Sum(measured) //nolint:gosec
+	sha256Digest := sha256.Sum256(measured)
+
+	binary.Write(log, binary.LittleEndian, []uint32{uint32(pcr), eventType, 2}) //nolint:errcheck
+	binary.Write(log, binary.LittleEndian, uint16(0x0004))                      //nolint:errcheck
+	log.Write(sha1Digest[:])                                                    //nolint:errcheck
+	binary.Write(log, binary.LittleEndian, uint16(0x000b))                      //nolint:errcheck
+	log.Write(sha256Digest[:])                                                  //nolint:errcheck
+	binary.Write(log, binary.LittleEndian, uint32(len(data)))                   //nolint:errcheck
+	log.Write(data)                                                             //nolint:errcheck
+}
+
+func utf16Bytes(s string) []byte {
+	var buf bytes.Buffer
+
+	binary.Write(&buf, binary.LittleEndian, utf16.Encode([]rune(s+"\x00"))) //nolint:errcheck
+
+	return buf.Bytes()
+}
+
+func efiVariable(name string) []byte {
+	var buf bytes.Buffer
+
+	buf.Write(make([]byte, 16))                                                     // vendor GUID
+	binary.Write(&buf, binary.LittleEndian, []uint64{uint64(len(name)), uint64(1)}) //nolint:errcheck
+	buf.Write(utf16Bytes(name)[:2*len(name)])
+	buf.WriteByte(1)
+
+	return buf.Bytes()
+}
+
+func testEventLog() []byte {
+	log := newEventLog()
+	log.add(7, 0x80000001, []byte("secure-boot-enabled"), efiVariable("SecureBoot"))
+	log.add(7, 0x4, []byte{0, 0, 0, 0}, []byte{0, 0, 0, 0})
+	log.add(11, 0xd, []byte("kernel"), utf16Bytes(".linux"))
+	log.add(11, 0x3, []byte("ignored"), []byte("StartupLocality"))
+	log.add(11, 0xd, []byte("cmdline"), []byte(".cmdline\x00"))
+
+	return log.Bytes()
+}
+
+func digest(s string) []byte {
+	hash := sha256.Sum256([]byte(s))
+
+	return hash[:]
+}
+
+func TestParseEventLog(t *testing.T) {
+	t.Parallel()
+
+	events, err := tpm2internal.ParseEventLog(testEventLog())
+	require.NoError(t, err)
+
+	assert.Equal(t, []tpm2internal.Event{
+		{
+			PCR:         7,
+			Type:        "EV_EFI_VARIABLE_DRIVER_CONFIG",
+			Digest:      digest("secure-boot-enabled"),
+			Description: "SecureBoot",
+		},
+		{
+			PCR:    7,
+			Type:   "EV_SEPARATOR",
+			Digest: digest("\x00\x00\x00\x00"),
+		},
+		{
+			PCR:         11,
+			Type:        "EV_IPL",
+			Digest:      digest("kernel"),
+			Description: ".linux",
+		},
+		{
+			PCR:         11,
+			Type:        "EV_IPL",
+			Digest:      digest("cmdline"),
+			Description: ".cmdline",
+		},
+	}, events)
+
+	_, err = tpm2internal.ParseEventLog(testEventLog()[:100])
+	require.Error(t, err)
+
+	_, err = tpm2internal.ParseEventLog(make([]byte, 100))
+	require.Error(t, err)
+}
+
+func TestAppendBootPhases(t *testing.T) {
+	t.Parallel()
+
+	events, err := tpm2internal.ParseEventLog(testEventLog())
+	require.NoError(t, err)
+
+	events = events[2:]
+
+	withPhases := append(events[:len(events):len(events)], tpm2internal.Event{
+		PCR:         secureboot.UKIPCR,
+		Type:        tpm2internal.BootPhaseEventType,
+		Digest:      digest(string(secureboot.EnterInitrd)),
+		Description: string(secureboot.EnterInitrd),
+	}, tpm2internal.Event{
+		PCR:         secureboot.UKIPCR,
+		Type:        tpm2internal.BootPhaseEventType,
+		Digest:      digest(string(secureboot.LeaveInitrd)),
+		Description: string(secureboot.LeaveInitrd),
+	})
+
+	assert.Equal(t, events, tpm2internal.AppendBootPhases(events, tpm2internal.ReplayPCR(events)))
+	assert.Equal(t, withPhases, tpm2internal.AppendBootPhases(events, tpm2internal.ReplayPCR(withPhases)))
+
+	// the PCR value doesn't match any sequence of the boot phases
+	assert.Equal(t, events, tpm2internal.AppendBootPhases(events, digest("unknown")))
+}
@@ -0,0 +1,391 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package tpm2
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/google/go-tpm/tpm2"
+	"github.com/google/go-tpm/tpm2/transport"
+
+	"github.com/siderolabs/talos/internal/pkg/secureboot"
+)
+
+// AttestationPCRs is the default set of PCRs included into the attestation quote.
+//
+// PCR 7 covers the Secure Boot state, PCR 11 covers the UKI sections and boot phases.
+var AttestationPCRs = []int{secureboot.SecureBootStatePCR, secureboot.UKIPCR}
+
+// QuoteResponse is the response from the TPM2.0 Quote operation.
+type QuoteResponse struct {
+	// AttestationKeyPublic is the marshaled TPMT_PUBLIC of the key which signed the quote.
+	AttestationKeyPublic []byte
+	// Quote is the marshaled TPMS_ATTEST structure.
+	Quote []byte
+	// Signature is the marshaled TPMT_SIGNATURE over the Quote.
+	Signature []byte
+	// PCRValues are the SHA256 PCR values covered by the quote.
+	PCRValues map[int][]byte
+}
+
+// attestationKeyTemplate is a restricted ECC P-256 signing key template.
+//
+// The key is created as a primary key under the endorsement hierarchy, so it is
+// deterministic for a given TPM.
+var attestationKeyTemplate = tpm2.TPMTPublic{
+	Type:    tpm2.TPMAlgECC,
+	NameAlg: tpm2.TPMAlgSHA256,
+	ObjectAttributes: tpm2.TPMAObject{
+		FixedTPM:            true,
+		FixedParent:         true,
+		SensitiveDataOrigin: true,
+		UserWithAuth:        true,
+		NoDA:                true,
+		Restricted:          true,
+		SignEncrypt:         true,
+	},
+	Parameters: tpm2.NewTPMUPublicParms(
+		tpm2.TPMAlgECC,
+		&tpm2.TPMSECCParms{
+			Symmetric: tpm2.TPMTSymDefObject{
+				Algorithm: tpm2.TPMAlgNull,
+			},
+			Scheme: tpm2.TPMTECCScheme{
+				Scheme: tpm2.TPMAlgECDSA,
+				Details: tpm2.NewTPMUAsymScheme(
+					tpm2.TPMAlgECDSA,
+					&tpm2.TPMSSigSchemeECDSA{
+						HashAlg: tpm2.TPMAlgSHA256,
+					},
+				),
+			},
+			CurveID: tpm2.TPMECCNistP256,
+			KDF: tpm2.TPMTKDFScheme{
+				Scheme: tpm2.TPMAlgNull,
+			},
+		},
+	),
+}
+
+// Quote produces a TPM2.0 quote over the specified PCRs qualified with the nonce.
+//
+// If the TPM is not available, Quote returns nil response and no error.
+//
+//nolint:gocyclo
+func Quote(nonce []byte, pcrs []int) (resp *QuoteResponse, err error) {
+	t, err := transport.OpenTPM()
+	if err != nil {
+		// if the TPM is not available or not a TPM 2.0, there is nothing to attest
+		if os.IsNotExist(err) || strings.Contains(err.Error(), "device is not a TPM 2.0") {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+	defer t.Close() //nolint:errcheck
+
+	pcrs = slices.Clone(pcrs)
+	slices.Sort(pcrs)
+
+	pcrSelector, err := CreateSelector(pcrs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create PCR selection: %w", err)
+	}
+
+	primary := tpm2.CreatePrimary{
+		PrimaryHandle: tpm2.TPMRHEndorsement,
+		InPublic:      tpm2.New2B(attestationKeyTemplate),
+	}
+
+	createPrimaryResponse, err := primary.Execute(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create attestation key: %w", err)
+	}
+
+	defer func() {
+		flush := tpm2.FlushContext{
+			FlushHandle: createPrimaryResponse.ObjectHandle,
+		}
+
+		_, flushErr := flush.Execute(t)
+		if flushErr != nil && err == nil {
+			err = flushErr
+		}
+	}()
+
+	outPub, err := createPrimaryResponse.OutPublic.Contents()
+	if err != nil {
+		return nil, err
+	}
+
+	quote := tpm2.Quote{
+		SignHandle: tpm2.AuthHandle{
+			Handle: createPrimaryResponse.ObjectHandle,
+			Name:   createPrimaryResponse.Name,
+			Auth:   tpm2.PasswordAuth(nil),
+		},
+		QualifyingData: tpm2.TPM2BData{
+			Buffer: nonce,
+		},
+		InScheme: tpm2.TPMTSigScheme{
+			Scheme: tpm2.TPMAlgNull,
+		},
+		PCRSelect: tpm2.TPMLPCRSelection{
+			PCRSelections: []tpm2.TPMSPCRSelection{
+				{
+					Hash:      tpm2.TPMAlgSHA256,
+					PCRSelect: pcrSelector,
+				},
+			},
+		},
+	}
+
+	quoteResponse, err := quote.Execute(t)
+	if err != nil {
+		return nil, fmt.Errorf("failed to quote PCRs: %w", err)
+	}
+
+	pcrValues := make(map[int][]byte, len(pcrs))
+
+	for _, pcr := range pcrs {
+		pcrValues[pcr], err = ReadPCR(t, pcr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &QuoteResponse{
+		AttestationKeyPublic: tpm2.Marshal(*outPub),
+		Quote:                quoteResponse.Quoted.Bytes(),
+		Signature:            tpm2.Marshal(quoteResponse.Signature),
+		PCRValues:            pcrValues,
+	}, nil
+}
+
+// AttestationKeyFingerprint returns the hex SHA256 fingerprint of the marshaled attestation key public area.
+func AttestationKeyFingerprint(attestationKeyPublic []byte) string {
+	hash := sha256.Sum256(attestationKeyPublic)
+
+	return hex.EncodeToString(hash[:])
+}
+
+// AttestationQualifyingData returns the data to qualify the quote attesting the certificate request with.
+//
+// The quote is bound both to the nonce issued by trustd (freshness) and to the CSR (the key being certified).
+// Without the nonce (older trustd), the quote is qualified with the hash of the CSR only.
+func AttestationQualifyingData(nonce, csr []byte) []byte {
+	hash := sha256.New()
+	hash.Write(nonce)
+	hash.Write(csr)
+
+	return hash.Sum(nil)
+}
+
+// ReadAttestationKeyFingerprint returns the fingerprint of the attestation key of the TPM.
+//
+// The fingerprint is stable for the TPM, so it can be enrolled to anchor the quotes signed by this TPM.
+// If the TPM is not available, ReadAttestationKeyFingerprint returns an empty string and no error.
+func ReadAttestationKeyFingerprint() (fingerprint string, err error) {
+	t, err := transport.OpenTPM()
+	if err != nil {
+		// if the TPM is not available or not a TPM 2.0, there is no attestation key
+		if os.IsNotExist(err) || strings.Contains(err.Error(), "device is not a TPM 2.0") {
+			return "", nil
+		}
+
+		return "", err
+	}
+	defer t.Close() //nolint:errcheck
+
+	primary := tpm2.CreatePrimary{
+		PrimaryHandle: tpm2.TPMRHEndorsement,
+		InPublic:      tpm2.New2B(attestationKeyTemplate),
+	}
+
+	createPrimaryResponse, err := primary.Execute(t)
+	if err != nil {
+		return "", fmt.Errorf("failed to create attestation key: %w", err)
+	}
+
+	defer func() {
+		flush := tpm2.FlushContext{
+			FlushHandle: createPrimaryResponse.ObjectHandle,
+		}
+
+		_, flushErr := flush.Execute(t)
+		if flushErr != nil && err == nil {
+			err = flushErr
+		}
+	}()
+
+	outPub, err := createPrimaryResponse.OutPublic.Contents()
+	if err != nil {
+		return "", err
+	}
+
+	return AttestationKeyFingerprint(tpm2.Marshal(*outPub)), nil
+}
+
+// ReadPCRs reads the current SHA256 values of the specified PCRs.
+//
+// If the TPM is not available, ReadPCRs returns nil and no error.
+func ReadPCRs(pcrs []int) (map[int][]byte, error) {
+	t, err := transport.OpenTPM()
+	if err != nil {
+		// if the TPM is not available or not a TPM 2.0, there is nothing to read
+		if os.IsNotExist(err) || strings.Contains(err.Error(), "device is not a TPM 2.0") {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	defer t.Close() //nolint:errcheck
+
+	pcrValues := make(map[int][]byte, len(pcrs))
+
+	for _, pcr := range pcrs {
+		pcrValues[pcr], err = ReadPCR(t, pcr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pcrValues, nil
+}
+
+// VerifyQuote verifies the quote signature, nonce and that the reported PCR values match the quoted digest.
+//
+// All reported PCR values should be covered by the quote, so that the caller can trust any of them.
+//
+// VerifyQuote doesn't establish trust in the attestation key itself, it only verifies
+// that the quote is consistent and fresh: the caller should check the AttestationKeyFingerprint
+// against the enrolled attestation keys.
+//
+//nolint:gocyclo
+func VerifyQuote(resp *QuoteResponse, nonce []byte) error {
+	akPublic, err := tpm2.Unmarshal[tpm2.TPMTPublic](resp.AttestationKeyPublic)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal attestation key: %w", err)
+	}
+
+	pubKey, err := eccPublicKey(akPublic)
+	if err != nil {
+		return err
+	}
+
+	signature, err := tpm2.Unmarshal[tpm2.TPMTSignature](resp.Signature)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal quote signature: %w", err)
+	}
+
+	ecdsaSignature, err := signature.Signature.ECDSA()
+	if err != nil {
+		return fmt.Errorf("unexpected quote signature algorithm: %w", err)
+	}
+
+	quoteHash := sha256.Sum256(resp.Quote)
+
+	if !ecdsa.Verify(
+		pubKey,
+		quoteHash[:],
+		new(big.Int).SetBytes(ecdsaSignature.SignatureR.Buffer),
+		new(big.Int).SetBytes(ecdsaSignature.SignatureS.Buffer),
+	) {
+		return errors.New("quote signature verification failed")
+	}
+
+	attest, err := tpm2.Unmarshal[tpm2.TPMSAttest](resp.Quote)
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal quote: %w", err)
+	}
+
+	if attest.Type != tpm2.TPMSTAttestQuote {
+		return fmt.Errorf("unexpected attestation type %v", attest.Type)
+	}
+
+	if !bytes.Equal(attest.ExtraData.Buffer, nonce) {
+		return errors.New("quote nonce mismatch")
+	}
+
+	quoteInfo, err := attest.Attested.Quote()
+	if err != nil {
+		return fmt.Errorf("failed to parse quote info: %w", err)
+	}
+
+	pcrDigest := sha256.New()
+	quoted := make(map[int]struct{}, len(resp.PCRValues))
+
+	for _, selection := range quoteInfo.PCRSelect.PCRSelections {
+		if selection.Hash != tpm2.TPMAlgSHA256 {
+			return fmt.Errorf("unexpected PCR bank hash algorithm %v", selection.Hash)
+		}
+
+		for i, mask := range selection.PCRSelect {
+			for bit := range 8 {
+				if mask&(1<<bit) == 0 {
+					continue
+				}
+
+				value, ok := resp.PCRValues[i*8+bit]
+				if !ok {
+					return fmt.Errorf("PCR %d is quoted, but its value is missing", i*8+bit)
+				}
+
+				pcrDigest.Write(value)
+
+				quoted[i*8+bit] = struct{}{}
+			}
+		}
+	}
+
+	for pcr := range resp.PCRValues {
+		if _, ok := quoted[pcr]; !ok {
+			return fmt.Errorf("PCR %d value is reported, but it is not quoted", pcr)
+		}
+	}
+
+	if !bytes.Equal(pcrDigest.Sum(nil), quoteInfo.PCRDigest.Buffer) {
+		return errors.New("PCR values don't match the quoted digest")
+	}
+
+	return nil
+}
+
+func eccPublicKey(pub *tpm2.TPMTPublic) (*ecdsa.PublicKey, error) {
+	if pub.Type != tpm2.TPMAlgECC {
+		return nil, fmt.Errorf("unsupported attestation key type %v", pub.Type)
+	}
+
+	params, err := pub.Parameters.ECCDetail()
+	if err != nil {
+		return nil, err
+	}
+
+	if params.CurveID != tpm2.TPMECCNistP256 {
+		return nil, fmt.Errorf("unsupported attestation key curve %v", params.CurveID)
+	}
+
+	point, err := pub.Unique.ECC()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(point.X.Buffer),
+		Y:     new(big.Int).SetBytes(point.Y.Buffer),
+	}, nil
+}
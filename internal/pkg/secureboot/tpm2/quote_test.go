@@ -0,0 +1,164 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package tpm2_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/google/go-tpm/tpm2"
+	"github.com/stretchr/testify/require"
+
+	tpm2internal "github.com/siderolabs/talos/internal/pkg/secureboot/tpm2"
+)
+
+// fakeQuote builds a quote signed with a software key, mimicking the TPM2_Quote output.
+func fakeQuote(t *testing.T, nonce []byte, pcrValues map[int][]byte) *tpm2internal.QuoteResponse {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	pcrs := []int{7, 11}
+
+	selector, err := tpm2internal.CreateSelector(pcrs)
+	require.NoError(t, err)
+
+	digest := sha256.New()
+
+	for _, pcr := range pcrs {
+		digest.Write(pcrValues[pcr])
+	}
+
+	attest := tpm2.TPMSAttest{
+		Magic: tpm2.TPMGeneratedValue,
+		Type:  tpm2.TPMSTAttestQuote,
+		ExtraData: tpm2.TPM2BData{
+			Buffer: nonce,
+		},
+		Attested: tpm2.NewTPMUAttest(tpm2.TPMSTAttestQuote, &tpm2.TPMSQuoteInfo{
+			PCRSelect: tpm2.TPMLPCRSelection{
+				PCRSelections: []tpm2.TPMSPCRSelection{
+					{
+						Hash:      tpm2.TPMAlgSHA256,
+						PCRSelect: selector,
+					},
+				},
+			},
+			PCRDigest: tpm2.TPM2BDigest{
+				Buffer: digest.Sum(nil),
+			},
+		}),
+	}
+
+	quote := tpm2.Marshal(attest)
+	quoteHash := sha256.Sum256(quote)
+
+	r, s, err := ecdsa.Sign(rand.Reader, key, quoteHash[:])
+	require.NoError(t, err)
+
+	signature := tpm2.TPMTSignature{
+		SigAlg: tpm2.TPMAlgECDSA,
+		Signature: tpm2.NewTPMUSignature(tpm2.TPMAlgECDSA, &tpm2.TPMSSignatureECC{
+			Hash:       tpm2.TPMAlgSHA256,
+			SignatureR: tpm2.TPM2BECCParameter{Buffer: r.Bytes()},
+			SignatureS: tpm2.TPM2BECCParameter{Buffer: s.Bytes()},
+		}),
+	}
+
+	akPublic := tpm2.TPMTPublic{
+		Type:    tpm2.TPMAlgECC,
+		NameAlg: tpm2.TPMAlgSHA256,
+		Parameters: tpm2.NewTPMUPublicParms(tpm2.TPMAlgECC, &tpm2.TPMSECCParms{
+			Symmetric: tpm2.TPMTSymDefObject{Algorithm: tpm2.TPMAlgNull},
+			Scheme:    tpm2.TPMTECCScheme{Scheme: tpm2.TPMAlgNull},
+			CurveID:   tpm2.TPMECCNistP256,
+			KDF:       tpm2.TPMTKDFScheme{Scheme: tpm2.TPMAlgNull},
+		}),
+		Unique: tpm2.NewTPMUPublicID(tpm2.TPMAlgECC, &tpm2.TPMSECCPoint{
+			X: tpm2.TPM2BECCParameter{Buffer: key.X.Bytes()},
+			Y: tpm2.TPM2BECCParameter{Buffer: key.Y.Bytes()},
+		}),
+	}
+
+	return &tpm2internal.QuoteResponse{
+		AttestationKeyPublic: tpm2.Marshal(akPublic),
+		Quote:                quote,
+		Signature:            tpm2.Marshal(signature),
+		PCRValues:            pcrValues,
+	}
+}
+
+func TestVerifyQuote(t *testing.T) {
+	t.Parallel()
+
+	nonce := []byte("nonce")
+	pcrValues := map[int][]byte{
+		7:  bytes.Repeat([]byte{0x07}, sha256.Size),
+		11: bytes.Repeat([]byte{0x0b}, sha256.Size),
+	}
+
+	t.Run("valid", func(t *testing.T) {
+		t.Parallel()
+
+		require.NoError(t, tpm2internal.VerifyQuote(fakeQuote(t, nonce, pcrValues), nonce))
+	})
+
+	t.Run("nonce mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		require.EqualError(t, tpm2internal.VerifyQuote(fakeQuote(t, nonce, pcrValues), []byte("other")), "quote nonce mismatch")
+	})
+
+	t.Run("tampered PCR value", func(t *testing.T) {
+		t.Parallel()
+
+		quote := fakeQuote(t, nonce, pcrValues)
+		quote.PCRValues = map[int][]byte{
+			7:  pcrValues[7],
+			11: bytes.Repeat([]byte{0xff}, sha256.Size),
+		}
+
+		require.EqualError(t, tpm2internal.VerifyQuote(quote, nonce), "PCR values don't match the quoted digest")
+	})
+
+	t.Run("missing PCR value", func(t *testing.T) {
+		t.Parallel()
+
+		quote := fakeQuote(t, nonce, pcrValues)
+		quote.PCRValues = map[int][]byte{
+			7: pcrValues[7],
+		}
+
+		require.EqualError(t, tpm2internal.VerifyQuote(quote, nonce), "PCR 11 is quoted, but its value is missing")
+	})
+
+	t.Run("extra PCR value", func(t *testing.T) {
+		t.Parallel()
+
+		quote := fakeQuote(t, nonce, pcrValues)
+		quote.PCRValues = map[int][]byte{
+			7:  pcrValues[7],
+			11: pcrValues[11],
+			14: bytes.Repeat([]byte{0x0e}, sha256.Size),
+		}
+
+		require.EqualError(t, tpm2internal.VerifyQuote(quote, nonce), "PCR 14 value is reported, but it is not quoted")
+	})
+
+	t.Run("tampered quote", func(t *testing.T) {
+		t.Parallel()
+
+		quote := fakeQuote(t, nonce, pcrValues)
+		quote.Quote = append([]byte(nil), quote.Quote...)
+		quote.Quote[len(quote.Quote)-1] ^= 0xff
+
+		require.EqualError(t, tpm2internal.VerifyQuote(quote, nonce), "quote signature verification failed")
+	})
+}
@@ -0,0 +1,82 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package sequencehook implements the HTTP callbacks run at the sequence points.
+package sequencehook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+// maxResponseSize limits the size of the response body included into the error message.
+const maxResponseSize = 512
+
+// Event describes the sequence point to the hook receiver.
+//
+// Event is sent as JSON in the body of the POST request.
+type Event struct {
+	// Hook is the name of the hook document.
+	Hook string `json:"hook"`
+	// Point is the sequence point, e.g. pre-upgrade.
+	Point string `json:"point"`
+	// Hostname is the hostname of the machine.
+	Hostname string `json:"hostname,omitempty"`
+	// Version is the Talos version running the hook.
+	Version string `json:"version"`
+	// Image is the installer image for the pre-upgrade hooks.
+	Image string `json:"image,omitempty"`
+}
+
+// Run sends the event to the hook URL and waits for the response.
+//
+// The hook fails if the response status code is not 2xx, or the hook doesn't respond within the timeout.
+func Run(ctx context.Context, client *http.Client, hook config.SequenceHookConfig, event Event) error {
+	ctx, cancel := context.WithTimeout(ctx, hook.Timeout())
+	defer cancel()
+
+	event.Hook = hook.Name()
+	event.Point = hook.Point()
+
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.URL().String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for name, value := range hook.Headers() {
+		req.Header.Set(name, value)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize)) //nolint:errcheck
+
+	if len(bytes.TrimSpace(msg)) > 0 {
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+	}
+
+	return fmt.Errorf("unexpected status %d", resp.StatusCode)
+}
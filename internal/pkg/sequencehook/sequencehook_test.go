@@ -0,0 +1,98 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package sequencehook_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/gen/ensure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/sequencehook"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+)
+
+func newHook(t *testing.T, u string) *runtime.SequenceHookV1Alpha1 {
+	t.Helper()
+
+	hook := runtime.NewSequenceHookV1Alpha1()
+	hook.MetaName = "approval"
+	hook.HookPoint = config.SequenceHookPreUpgrade
+	hook.HookURL.URL = ensure.Value(url.Parse(u))
+	hook.HookHeaders = map[string]string{"Authorization": "Bearer token"}
+
+	return hook
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	var received sequencehook.Event
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+
+		if received.Image == "rejected" {
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte("change is not approved\n")) //nolint:errcheck
+
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	hook := newHook(t, srv.URL)
+
+	require.NoError(t, sequencehook.Run(context.Background(), srv.Client(), hook, sequencehook.Event{
+		Hostname: "talos-1",
+		Version:  "v1.9.0",
+		Image:    "ghcr.io/siderolabs/installer:v1.9.1",
+	}))
+
+	assert.Equal(t, sequencehook.Event{
+		Hook:     "approval",
+		Point:    config.SequenceHookPreUpgrade,
+		Hostname: "talos-1",
+		Version:  "v1.9.0",
+		Image:    "ghcr.io/siderolabs/installer:v1.9.1",
+	}, received)
+
+	assert.EqualError(t, sequencehook.Run(context.Background(), srv.Client(), hook, sequencehook.Event{
+		Image: "rejected",
+	}), "unexpected status 403: change is not approved")
+}
+
+func TestRunTimeout(t *testing.T) {
+	t.Parallel()
+
+	unblock := make(chan struct{})
+
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		<-unblock
+	}))
+	t.Cleanup(srv.Close)
+	t.Cleanup(func() { close(unblock) })
+
+	hook := newHook(t, srv.URL)
+	hook.HookTimeout = 100 * time.Millisecond
+
+	err := sequencehook.Run(context.Background(), srv.Client(), hook, sequencehook.Event{})
+	require.Error(t, err)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -0,0 +1,546 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package snmp implements a read-only SNMP agent.
+package snmp
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gosnmp/gosnmp"
+	"go.uber.org/zap"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+const (
+	// maxMessageSize is the maximum size of the SNMP message over UDP.
+	maxMessageSize = 65507
+
+	// maxBulkVariables limits the number of the variables in the GetBulk response.
+	maxBulkVariables = 512
+
+	// timeWindow is the SNMPv3 time window (RFC 3414).
+	timeWindow = 150
+
+	// engineBoots is the number of the times the SNMP engine was (re-)initialized.
+	//
+	// The engine ID is generated on each start, so the counter is always 1.
+	engineBoots = 1
+
+	// mibCacheTTL is the time the collected MIB is reused for the subsequent requests (e.g. walks).
+	mibCacheTTL = time.Second
+)
+
+// USM statistics reported to the SNMPv3 managers (RFC 3414).
+const (
+	oidUnsupportedSecLevels = ".1.3.6.1.6.3.15.1.1.1.0"
+	oidNotInTimeWindows     = ".1.3.6.1.6.3.15.1.1.2.0"
+	oidUnknownUserNames     = ".1.3.6.1.6.3.15.1.1.3.0"
+	oidUnknownEngineIDs     = ".1.3.6.1.6.3.15.1.1.4.0"
+	oidWrongDigests         = ".1.3.6.1.6.3.15.1.1.5.0"
+)
+
+var authProtocols = map[string]gosnmp.SnmpV3AuthProtocol{
+	config.SNMPAuthProtocolMD5:    gosnmp.MD5,
+	config.SNMPAuthProtocolSHA:    gosnmp.SHA,
+	config.SNMPAuthProtocolSHA224: gosnmp.SHA224,
+	config.SNMPAuthProtocolSHA256: gosnmp.SHA256,
+	config.SNMPAuthProtocolSHA384: gosnmp.SHA384,
+	config.SNMPAuthProtocolSHA512: gosnmp.SHA512,
+}
+
+var privProtocols = map[string]gosnmp.SnmpV3PrivProtocol{
+	"":                            gosnmp.NoPriv,
+	config.SNMPPrivProtocolDES:    gosnmp.DES,
+	config.SNMPPrivProtocolAES:    gosnmp.AES,
+	config.SNMPPrivProtocolAES192: gosnmp.AES192,
+	config.SNMPPrivProtocolAES256: gosnmp.AES256,
+}
+
+// User is a SNMPv3 user.
+type User struct {
+	Name           string
+	AuthProtocol   string
+	AuthPassphrase string
+	PrivProtocol   string
+	PrivPassphrase string
+}
+
+// Agent is a read-only SNMP agent.
+//
+// Agent supports SNMPv2c with the community-based access and SNMPv3 with the User-based Security Model.
+type Agent struct {
+	community string
+	users     map[string]*gosnmp.UsmSecurityParameters
+	collect   func() (MIB, error)
+	logger    *zap.Logger
+
+	engineID    string
+	engineStart time.Time
+	salt        atomic.Uint64
+
+	unsupportedSecLevels atomic.Uint32
+	notInTimeWindows     atomic.Uint32
+	unknownUserNames     atomic.Uint32
+	unknownEngineIDs     atomic.Uint32
+	wrongDigests         atomic.Uint32
+
+	mibMu   sync.Mutex
+	mib     MIB
+	mibTime time.Time
+}
+
+// NewAgent creates a new agent.
+//
+// SNMPv2c is disabled if the community is empty.
+func NewAgent(community string, users []User, collect func() (MIB, error), logger *zap.Logger) (*Agent, error) {
+	var engineID [13]byte
+
+	// RFC 3411 engine ID: net-snmp enterprise number, random octets format
+	copy(engineID[:], []byte{0x80, 0x00, 0x1f, 0x88, 0x05})
+
+	if _, err := rand.Read(engineID[5:]); err != nil {
+		return nil, fmt.Errorf("error generating engine ID: %w", err)
+	}
+
+	var salt [8]byte
+
+	if _, err := rand.Read(salt[:]); err != nil {
+		return nil, fmt.Errorf("error generating salt: %w", err)
+	}
+
+	agent := &Agent{
+		community:   community,
+		users:       make(map[string]*gosnmp.UsmSecurityParameters, len(users)),
+		collect:     collect,
+		logger:      logger,
+		engineID:    string(engineID[:]),
+		engineStart: time.Now(),
+	}
+
+	agent.salt.Store(binary.BigEndian.Uint64(salt[:]))
+
+	for _, user := range users {
+		authProtocol, ok := authProtocols[user.AuthProtocol]
+		if !ok {
+			return nil, fmt.Errorf("user %q: unsupported authentication protocol %q", user.Name, user.AuthProtocol)
+		}
+
+		privProtocol, ok := privProtocols[user.PrivProtocol]
+		if !ok {
+			return nil, fmt.Errorf("user %q: unsupported privacy protocol %q", user.Name, user.PrivProtocol)
+		}
+
+		params := &gosnmp.UsmSecurityParameters{
+			AuthoritativeEngineID:    agent.engineID,
+			UserName:                 user.Name,
+			AuthenticationProtocol:   authProtocol,
+			AuthenticationPassphrase: user.AuthPassphrase,
+			PrivacyProtocol:          privProtocol,
+			PrivacyPassphrase:        user.PrivPassphrase,
+		}
+
+		// localize the keys to the engine ID once
+		if err := params.InitSecurityKeys(); err != nil {
+			return nil, fmt.Errorf("user %q: error initializing keys: %w", user.Name, err)
+		}
+
+		agent.users[user.Name] = params
+	}
+
+	return agent, nil
+}
+
+// Serve handles the SNMP requests received on the connection.
+//
+// Serve returns nil when the connection is closed.
+func (a *Agent) Serve(conn net.PacketConn) error {
+	buf := make([]byte, maxMessageSize)
+
+	for {
+		n, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+
+			return err
+		}
+
+		response, err := a.handle(slices.Clone(buf[:n]))
+		if err != nil {
+			a.logger.Debug("dropped SNMP request", zap.Stringer("remote", addr), zap.Error(err))
+
+			continue
+		}
+
+		if _, err = conn.WriteTo(response, addr); err != nil {
+			a.logger.Debug("error sending SNMP response", zap.Stringer("remote", addr), zap.Error(err))
+		}
+	}
+}
+
+func (a *Agent) handle(packet []byte) ([]byte, error) {
+	msg, _, err := readExpected(packet, tagSequence)
+	if err != nil {
+		return nil, err
+	}
+
+	version, _, err := readInteger(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	switch gosnmp.SnmpVersion(version) { //nolint:exhaustive
+	case gosnmp.Version2c:
+		return a.handleV2c(packet)
+	case gosnmp.Version3:
+		return a.handleV3(packet)
+	default:
+		return nil, fmt.Errorf("unsupported SNMP version %d", version)
+	}
+}
+
+func (a *Agent) handleV2c(packet []byte) ([]byte, error) {
+	if a.community == "" {
+		return nil, errors.New("SNMPv2c is disabled")
+	}
+
+	request, err := (&gosnmp.GoSNMP{Version: gosnmp.Version2c}).SnmpDecodePacket(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	if subtle.ConstantTimeCompare([]byte(request.Community), []byte(a.community)) != 1 {
+		return nil, errors.New("invalid community")
+	}
+
+	response, err := a.process(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Version = gosnmp.Version2c
+	response.Community = request.Community
+
+	return a.marshal(request, response)
+}
+
+//nolint:gocyclo
+func (a *Agent) handleV3(packet []byte) ([]byte, error) {
+	hdr, err := parseV3Header(packet)
+	if err != nil {
+		return nil, err
+	}
+
+	if gosnmp.SnmpV3SecurityModel(hdr.SecurityModel) != gosnmp.UserSecurityModel {
+		return nil, fmt.Errorf("unsupported security model %d", hdr.SecurityModel)
+	}
+
+	// engine discovery is done with the empty engine ID
+	if hdr.EngineID != a.engineID {
+		return a.report(hdr, hdr.requestID(), oidUnknownEngineIDs, &a.unknownEngineIDs, nil)
+	}
+
+	user, ok := a.users[hdr.UserName]
+	if !ok {
+		return a.report(hdr, hdr.requestID(), oidUnknownUserNames, &a.unknownUserNames, nil)
+	}
+
+	if gosnmp.SnmpV3MsgFlags(hdr.Flags)&gosnmp.AuthPriv != securityLevel(user) {
+		return a.report(hdr, hdr.requestID(), oidUnsupportedSecLevels, &a.unsupportedSecLevels, nil)
+	}
+
+	decoder := &gosnmp.GoSNMP{
+		Version:            gosnmp.Version3,
+		SecurityModel:      gosnmp.UserSecurityModel,
+		SecurityParameters: user,
+	}
+
+	// UnmarshalTrap verifies the digest and decrypts any SNMPv3 message, not only traps
+	request, err := decoder.UnmarshalTrap(packet, true)
+	if err != nil {
+		return a.report(hdr, hdr.requestID(), oidWrongDigests, &a.wrongDigests, nil)
+	}
+
+	if engineTime := a.engineTime(); hdr.EngineBoots != engineBoots || hdr.EngineTime < int64(engineTime)-timeWindow || hdr.EngineTime > int64(engineTime)+timeWindow {
+		return a.report(hdr, request.RequestID, oidNotInTimeWindows, &a.notInTimeWindows, user)
+	}
+
+	response, err := a.process(request)
+	if err != nil {
+		return nil, err
+	}
+
+	response.Version = gosnmp.Version3
+	response.MsgID = request.MsgID
+	response.MsgFlags = securityLevel(user)
+	response.SecurityModel = gosnmp.UserSecurityModel
+	response.SecurityParameters = a.securityParameters(user)
+	response.ContextEngineID = request.ContextEngineID
+	response.ContextName = request.ContextName
+
+	return a.marshal(request, response)
+}
+
+// report builds the SNMPv3 Report PDU.
+//
+// The report is authenticated if the user is set.
+func (a *Agent) report(hdr *v3Header, requestID uint32, oid string, counter *atomic.Uint32, user *gosnmp.UsmSecurityParameters) ([]byte, error) {
+	value := counter.Add(1)
+
+	if gosnmp.SnmpV3MsgFlags(hdr.Flags)&gosnmp.Reportable == 0 {
+		return nil, fmt.Errorf("request is not reportable: %s", oid)
+	}
+
+	report := &gosnmp.SnmpPacket{
+		Version:         gosnmp.Version3,
+		MsgID:           uint32(hdr.MsgID),
+		MsgFlags:        gosnmp.NoAuthNoPriv,
+		SecurityModel:   gosnmp.UserSecurityModel,
+		ContextEngineID: a.engineID,
+		PDUType:         gosnmp.Report,
+		RequestID:       requestID,
+		Variables: []gosnmp.SnmpPDU{
+			{
+				Name:  oid,
+				Type:  gosnmp.Counter32,
+				Value: value,
+			},
+		},
+	}
+
+	if user != nil {
+		report.MsgFlags = gosnmp.AuthNoPriv
+		report.SecurityParameters = a.securityParameters(user)
+	} else {
+		report.SecurityParameters = &gosnmp.UsmSecurityParameters{
+			AuthoritativeEngineID:    a.engineID,
+			AuthoritativeEngineBoots: engineBoots,
+			AuthoritativeEngineTime:  a.engineTime(),
+			UserName:                 hdr.UserName,
+		}
+	}
+
+	return report.MarshalMsg()
+}
+
+// securityParameters builds the USM parameters of the outgoing message.
+func (a *Agent) securityParameters(user *gosnmp.UsmSecurityParameters) *gosnmp.UsmSecurityParameters {
+	params := user.Copy().(*gosnmp.UsmSecurityParameters) //nolint:forcetypeassert,errcheck
+	params.AuthoritativeEngineBoots = engineBoots
+	params.AuthoritativeEngineTime = a.engineTime()
+
+	// RFC 3826 (AES) and RFC 3414 (DES) salt, it should never repeat for the same key
+	salt := make([]byte, 8)
+
+	if params.PrivacyProtocol == gosnmp.DES {
+		binary.BigEndian.PutUint32(salt, engineBoots)
+		binary.BigEndian.PutUint32(salt[4:], uint32(a.salt.Add(1)))
+	} else {
+		binary.BigEndian.PutUint64(salt, a.salt.Add(1))
+	}
+
+	params.PrivacyParameters = salt
+
+	return params
+}
+
+func (a *Agent) engineTime() uint32 {
+	return uint32(time.Since(a.engineStart) / time.Second)
+}
+
+// process handles the request PDU and builds the response.
+//
+//nolint:gocyclo,cyclop
+func (a *Agent) process(request *gosnmp.SnmpPacket) (*gosnmp.SnmpPacket, error) {
+	response := &gosnmp.SnmpPacket{
+		PDUType:   gosnmp.GetResponse,
+		RequestID: request.RequestID,
+	}
+
+	oids := make([]OID, 0, len(request.Variables))
+
+	for i, v := range request.Variables {
+		oid, err := ParseOID(v.Name)
+		if err != nil {
+			return a.errorResponse(request, response, gosnmp.GenErr, i), nil
+		}
+
+		oids = append(oids, oid)
+	}
+
+	switch request.PDUType { //nolint:exhaustive
+	case gosnmp.GetRequest, gosnmp.GetNextRequest, gosnmp.GetBulkRequest:
+	case gosnmp.SetRequest:
+		return a.errorResponse(request, response, gosnmp.NotWritable, 0), nil
+	default:
+		return nil, fmt.Errorf("unsupported PDU type %s", request.PDUType)
+	}
+
+	mib, err := a.getMIB()
+	if err != nil {
+		a.logger.Warn("error collecting SNMP variables", zap.Error(err))
+
+		return a.errorResponse(request, response, gosnmp.GenErr, 0), nil
+	}
+
+	switch request.PDUType { //nolint:exhaustive
+	case gosnmp.GetRequest:
+		for _, oid := range oids {
+			response.Variables = append(response.Variables, get(mib, oid))
+		}
+	case gosnmp.GetNextRequest:
+		for _, oid := range oids {
+			response.Variables = append(response.Variables, getNext(mib, oid))
+		}
+	case gosnmp.GetBulkRequest:
+		nonRepeaters := min(int(request.NonRepeaters), len(oids))
+
+		for _, oid := range oids[:nonRepeaters] {
+			response.Variables = append(response.Variables, getNext(mib, oid))
+		}
+
+		repeaters := slices.Clone(oids[nonRepeaters:])
+
+		for range request.MaxRepetitions {
+			if len(repeaters) == 0 || len(response.Variables)+len(repeaters) > maxBulkVariables {
+				break
+			}
+
+			endOfMIB := true
+
+			for i, oid := range repeaters {
+				v, ok := mib.Next(oid)
+				if !ok {
+					response.Variables = append(response.Variables, endOfMIBView(oid))
+
+					continue
+				}
+
+				response.Variables = append(response.Variables, v.pdu())
+				repeaters[i] = v.OID
+				endOfMIB = false
+			}
+
+			if endOfMIB {
+				break
+			}
+		}
+	}
+
+	return response, nil
+}
+
+// errorResponse builds the error response, variable bindings are copied from the request.
+func (a *Agent) errorResponse(request, response *gosnmp.SnmpPacket, code gosnmp.SNMPError, index int) *gosnmp.SnmpPacket {
+	response.Error = code
+	response.ErrorIndex = uint8(min(index+1, len(request.Variables)))
+
+	for _, v := range request.Variables {
+		response.Variables = append(response.Variables, gosnmp.SnmpPDU{Name: v.Name, Type: gosnmp.Null})
+	}
+
+	return response
+}
+
+// marshal encodes the response, making sure it fits the maximum message size.
+func (a *Agent) marshal(request, response *gosnmp.SnmpPacket) ([]byte, error) {
+	maxSize := maxMessageSize
+
+	if request.MsgMaxSize != 0 {
+		maxSize = min(maxSize, int(request.MsgMaxSize))
+	}
+
+	for {
+		encoded, err := response.MarshalMsg()
+		if err != nil {
+			return nil, err
+		}
+
+		if len(encoded) <= maxSize {
+			return encoded, nil
+		}
+
+		switch {
+		case request.PDUType == gosnmp.GetBulkRequest && len(response.Variables) > 1:
+			// GetBulk response might be truncated
+			response.Variables = response.Variables[:len(response.Variables)/2]
+		case response.Error == gosnmp.TooBig:
+			return nil, errors.New("response is too big")
+		default:
+			response.Error = gosnmp.TooBig
+			response.ErrorIndex = 0
+			response.Variables = nil
+		}
+	}
+}
+
+func (a *Agent) getMIB() (MIB, error) {
+	a.mibMu.Lock()
+	defer a.mibMu.Unlock()
+
+	if a.mib != nil && time.Since(a.mibTime) < mibCacheTTL {
+		return a.mib, nil
+	}
+
+	mib, err := a.collect()
+	if err != nil {
+		return nil, err
+	}
+
+	a.mib, a.mibTime = mib, time.Now()
+
+	return mib, nil
+}
+
+func get(mib MIB, oid OID) gosnmp.SnmpPDU {
+	if v, ok := mib.Get(oid); ok {
+		return v.pdu()
+	}
+
+	pdu := gosnmp.SnmpPDU{Name: oid.String(), Type: gosnmp.NoSuchObject}
+
+	// the object exists, but not the instance: some instance is a direct child of the OID prefix
+	for k := len(oid) - 1; k > 0; k-- {
+		next, ok := mib.Next(oid[:k])
+		if ok && len(next.OID) == k+1 && slices.Equal(next.OID[:k], oid[:k]) {
+			pdu.Type = gosnmp.NoSuchInstance
+
+			break
+		}
+	}
+
+	return pdu
+}
+
+func getNext(mib MIB, oid OID) gosnmp.SnmpPDU {
+	if v, ok := mib.Next(oid); ok {
+		return v.pdu()
+	}
+
+	return endOfMIBView(oid)
+}
+
+func endOfMIBView(oid OID) gosnmp.SnmpPDU {
+	return gosnmp.SnmpPDU{Name: oid.String(), Type: gosnmp.EndOfMibView}
+}
+
+func securityLevel(user *gosnmp.UsmSecurityParameters) gosnmp.SnmpV3MsgFlags {
+	if user.PrivacyProtocol > gosnmp.NoPriv {
+		return gosnmp.AuthPriv
+	}
+
+	return gosnmp.AuthNoPriv
+}
@@ -0,0 +1,244 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package snmp_test
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"github.com/siderolabs/talos/internal/pkg/snmp"
+)
+
+func testMIB() (snmp.MIB, error) {
+	vars := []snmp.Variable{
+		{OID: snmp.MustParseOID("1.3.6.1.2.1.1.1.0"), Type: gosnmp.OctetString, Value: "Talos"},
+		{OID: snmp.MustParseOID("1.3.6.1.2.1.1.3.0"), Type: gosnmp.TimeTicks, Value: uint32(100)},
+		{OID: snmp.MustParseOID("1.3.6.1.2.1.1.5.0"), Type: gosnmp.OctetString, Value: "talos-node"},
+	}
+
+	for i := range uint32(50) {
+		vars = append(vars, snmp.Variable{OID: snmp.MustParseOID("1.3.6.1.2.1.2.2.1.10").Append(i + 1), Type: gosnmp.Counter32, Value: i * 1000})
+	}
+
+	return snmp.NewMIB(vars), nil
+}
+
+func startAgent(t *testing.T, community string, users []snmp.User) *net.UDPAddr {
+	t.Helper()
+
+	agent, err := snmp.NewAgent(community, users, testMIB, zaptest.NewLogger(t))
+	require.NoError(t, err)
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- agent.Serve(conn)
+	}()
+
+	t.Cleanup(func() {
+		require.NoError(t, conn.Close())
+		require.NoError(t, <-errCh)
+	})
+
+	return conn.LocalAddr().(*net.UDPAddr) //nolint:forcetypeassert
+}
+
+func connect(t *testing.T, client *gosnmp.GoSNMP, addr *net.UDPAddr) *gosnmp.GoSNMP {
+	t.Helper()
+
+	client.Target = addr.IP.String()
+	client.Port = uint16(addr.Port)
+	client.Timeout = time.Second
+	client.Retries = 0
+
+	require.NoError(t, client.Connect())
+
+	t.Cleanup(func() {
+		client.Conn.Close() //nolint:errcheck
+	})
+
+	return client
+}
+
+func TestAgentV2c(t *testing.T) {
+	t.Parallel()
+
+	addr := startAgent(t, "public", nil)
+	client := connect(t, &gosnmp.GoSNMP{Version: gosnmp.Version2c, Community: "public"}, addr)
+
+	result, err := client.Get([]string{".1.3.6.1.2.1.1.1.0", ".1.3.6.1.2.1.1.2.0", ".1.3.6.1.2.1.1.5.0.1"})
+	require.NoError(t, err)
+	require.Len(t, result.Variables, 3)
+
+	assert.Equal(t, gosnmp.OctetString, result.Variables[0].Type)
+	assert.Equal(t, []byte("Talos"), result.Variables[0].Value)
+	assert.Equal(t, gosnmp.NoSuchObject, result.Variables[1].Type)
+	assert.Equal(t, gosnmp.NoSuchInstance, result.Variables[2].Type)
+
+	result, err = client.GetNext([]string{".1.3.6.1.2.1.1.1.0", ".1.3.6.1.2.1.2.2.1.10.50"})
+	require.NoError(t, err)
+	require.Len(t, result.Variables, 2)
+
+	assert.Equal(t, ".1.3.6.1.2.1.1.3.0", result.Variables[0].Name)
+	assert.Equal(t, uint32(100), result.Variables[0].Value)
+	assert.Equal(t, gosnmp.EndOfMibView, result.Variables[1].Type)
+
+	result, err = client.GetBulk([]string{".1.3.6.1.2.1.2.2.1.10"}, 0, 10)
+	require.NoError(t, err)
+	require.Len(t, result.Variables, 10)
+
+	assert.Equal(t, ".1.3.6.1.2.1.2.2.1.10.1", result.Variables[0].Name)
+	assert.Equal(t, ".1.3.6.1.2.1.2.2.1.10.10", result.Variables[9].Name)
+	assert.EqualValues(t, 9000, gosnmp.ToBigInt(result.Variables[9].Value).Uint64())
+
+	walk, err := client.BulkWalkAll(".1.3.6.1.2.1")
+	require.NoError(t, err)
+	assert.Len(t, walk, 53)
+
+	result, err = client.Set([]gosnmp.SnmpPDU{{Name: ".1.3.6.1.2.1.1.5.0", Type: gosnmp.OctetString, Value: "foo"}})
+	require.NoError(t, err)
+	assert.Equal(t, gosnmp.NotWritable, result.Error)
+}
+
+func TestAgentV2cWrongCommunity(t *testing.T) {
+	t.Parallel()
+
+	addr := startAgent(t, "public", nil)
+	client := connect(t, &gosnmp.GoSNMP{Version: gosnmp.Version2c, Community: "private"}, addr)
+
+	_, err := client.Get([]string{".1.3.6.1.2.1.1.1.0"})
+	require.Error(t, err)
+}
+
+func TestAgentV2cDisabled(t *testing.T) {
+	t.Parallel()
+
+	addr := startAgent(t, "", []snmp.User{{Name: "monitoring", AuthProtocol: "sha", AuthPassphrase: "authpassphrase"}})
+	client := connect(t, &gosnmp.GoSNMP{Version: gosnmp.Version2c, Community: ""}, addr)
+
+	_, err := client.Get([]string{".1.3.6.1.2.1.1.1.0"})
+	require.Error(t, err)
+}
+
+func TestAgentV3(t *testing.T) {
+	t.Parallel()
+
+	users := []snmp.User{
+		{Name: "auth", AuthProtocol: "sha256", AuthPassphrase: "authpassphrase"},
+		{Name: "priv", AuthProtocol: "sha", AuthPassphrase: "authpassphrase", PrivProtocol: "aes", PrivPassphrase: "privpassphrase"},
+		{Name: "des", AuthProtocol: "md5", AuthPassphrase: "authpassphrase", PrivProtocol: "des", PrivPassphrase: "privpassphrase"},
+	}
+
+	addr := startAgent(t, "", users)
+
+	for _, test := range []struct {
+		name string
+
+		flags  gosnmp.SnmpV3MsgFlags
+		params *gosnmp.UsmSecurityParameters
+
+		expectError bool
+	}{
+		{
+			name:  "authNoPriv",
+			flags: gosnmp.AuthNoPriv,
+			params: &gosnmp.UsmSecurityParameters{
+				UserName:                 "auth",
+				AuthenticationProtocol:   gosnmp.SHA256,
+				AuthenticationPassphrase: "authpassphrase",
+			},
+		},
+		{
+			name:  "authPriv",
+			flags: gosnmp.AuthPriv,
+			params: &gosnmp.UsmSecurityParameters{
+				UserName:                 "priv",
+				AuthenticationProtocol:   gosnmp.SHA,
+				AuthenticationPassphrase: "authpassphrase",
+				PrivacyProtocol:          gosnmp.AES,
+				PrivacyPassphrase:        "privpassphrase",
+			},
+		},
+		{
+			name:  "authPriv DES",
+			flags: gosnmp.AuthPriv,
+			params: &gosnmp.UsmSecurityParameters{
+				UserName:                 "des",
+				AuthenticationProtocol:   gosnmp.MD5,
+				AuthenticationPassphrase: "authpassphrase",
+				PrivacyProtocol:          gosnmp.DES,
+				PrivacyPassphrase:        "privpassphrase",
+			},
+		},
+		{
+			name:  "wrong passphrase",
+			flags: gosnmp.AuthNoPriv,
+			params: &gosnmp.UsmSecurityParameters{
+				UserName:                 "auth",
+				AuthenticationProtocol:   gosnmp.SHA256,
+				AuthenticationPassphrase: "wrongpassphrase",
+			},
+			expectError: true,
+		},
+		{
+			name:  "unknown user",
+			flags: gosnmp.AuthNoPriv,
+			params: &gosnmp.UsmSecurityParameters{
+				UserName:                 "unknown",
+				AuthenticationProtocol:   gosnmp.SHA256,
+				AuthenticationPassphrase: "authpassphrase",
+			},
+			expectError: true,
+		},
+		{
+			name:  "missing privacy",
+			flags: gosnmp.AuthNoPriv,
+			params: &gosnmp.UsmSecurityParameters{
+				UserName:                 "priv",
+				AuthenticationProtocol:   gosnmp.SHA,
+				AuthenticationPassphrase: "authpassphrase",
+			},
+			expectError: true,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			client := connect(t, &gosnmp.GoSNMP{
+				Version:            gosnmp.Version3,
+				SecurityModel:      gosnmp.UserSecurityModel,
+				MsgFlags:           test.flags,
+				SecurityParameters: test.params,
+			}, addr)
+
+			result, err := client.Get([]string{".1.3.6.1.2.1.1.5.0"})
+			if test.expectError {
+				if err == nil {
+					// gosnmp returns Report PDUs as a result
+					assert.Equal(t, gosnmp.Report, result.PDUType)
+				}
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, result.Variables, 1)
+			assert.Equal(t, []byte("talos-node"), result.Variables[0].Value)
+
+			walk, err := client.WalkAll(".1.3.6.1.2.1.2")
+			require.NoError(t, err)
+			assert.Len(t, walk, 50)
+		})
+	}
+}
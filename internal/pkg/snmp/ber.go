@@ -0,0 +1,231 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package snmp
+
+import (
+	"errors"
+	"fmt"
+)
+
+// BER tags used in the SNMP message headers.
+const (
+	tagInteger     = 0x02
+	tagOctetString = 0x04
+	tagSequence    = 0x30
+)
+
+var errTruncated = errors.New("truncated message")
+
+// readTLV reads a single BER encoded element.
+//
+// Unlike encoding/asn1 it doesn't enforce DER rules, as SNMP implementations use non-minimal encodings.
+func readTLV(b []byte) (tag byte, value, rest []byte, err error) {
+	if len(b) < 2 {
+		return 0, nil, nil, errTruncated
+	}
+
+	tag = b[0]
+	length := int(b[1])
+	b = b[2:]
+
+	if length&0x80 != 0 {
+		n := length & 0x7f
+		if n == 0 || n > 4 || len(b) < n {
+			return 0, nil, nil, fmt.Errorf("unsupported length encoding")
+		}
+
+		length = 0
+
+		for _, c := range b[:n] {
+			length = length<<8 | int(c)
+		}
+
+		b = b[n:]
+	}
+
+	if length < 0 || length > len(b) {
+		return 0, nil, nil, errTruncated
+	}
+
+	return tag, b[:length], b[length:], nil
+}
+
+// readExpected reads the element with the expected tag.
+func readExpected(b []byte, expectedTag byte) (value, rest []byte, err error) {
+	tag, value, rest, err := readTLV(b)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if tag != expectedTag {
+		return nil, nil, fmt.Errorf("unexpected tag 0x%02x, expected 0x%02x", tag, expectedTag)
+	}
+
+	return value, rest, nil
+}
+
+// readInteger reads the INTEGER element.
+func readInteger(b []byte) (int64, []byte, error) {
+	value, rest, err := readExpected(b, tagInteger)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	if len(value) == 0 || len(value) > 8 {
+		return 0, nil, fmt.Errorf("invalid integer length %d", len(value))
+	}
+
+	var n int64
+
+	if value[0]&0x80 != 0 {
+		n = -1
+	}
+
+	for _, c := range value {
+		n = n<<8 | int64(c)
+	}
+
+	return n, rest, nil
+}
+
+// v3Header is the SNMPv3 message header with the USM security parameters.
+type v3Header struct {
+	MsgID         int64
+	Flags         byte
+	SecurityModel int64
+
+	EngineID    string
+	EngineBoots int64
+	EngineTime  int64
+	UserName    string
+
+	// ScopedPDU is either plaintext scoped PDU or encrypted one.
+	ScopedPDU []byte
+	Encrypted bool
+}
+
+// parseV3Header parses the SNMPv3 message header.
+//
+//nolint:gocyclo,cyclop
+func parseV3Header(packet []byte) (*v3Header, error) {
+	msg, _, err := readExpected(packet, tagSequence)
+	if err != nil {
+		return nil, err
+	}
+
+	var hdr v3Header
+
+	if _, msg, err = readInteger(msg); err != nil { // msgVersion
+		return nil, err
+	}
+
+	globalData, msg, err := readExpected(msg, tagSequence)
+	if err != nil {
+		return nil, err
+	}
+
+	if hdr.MsgID, globalData, err = readInteger(globalData); err != nil {
+		return nil, err
+	}
+
+	if _, globalData, err = readInteger(globalData); err != nil { // msgMaxSize
+		return nil, err
+	}
+
+	flags, globalData, err := readExpected(globalData, tagOctetString)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(flags) != 1 {
+		return nil, fmt.Errorf("invalid msgFlags length %d", len(flags))
+	}
+
+	hdr.Flags = flags[0]
+
+	if hdr.SecurityModel, _, err = readInteger(globalData); err != nil {
+		return nil, err
+	}
+
+	securityParameters, msg, err := readExpected(msg, tagOctetString)
+	if err != nil {
+		return nil, err
+	}
+
+	usm, _, err := readExpected(securityParameters, tagSequence)
+	if err != nil {
+		return nil, err
+	}
+
+	engineID, usm, err := readExpected(usm, tagOctetString)
+	if err != nil {
+		return nil, err
+	}
+
+	hdr.EngineID = string(engineID)
+
+	if hdr.EngineBoots, usm, err = readInteger(usm); err != nil {
+		return nil, err
+	}
+
+	if hdr.EngineTime, usm, err = readInteger(usm); err != nil {
+		return nil, err
+	}
+
+	userName, _, err := readExpected(usm, tagOctetString)
+	if err != nil {
+		return nil, err
+	}
+
+	hdr.UserName = string(userName)
+
+	tag, scopedPDU, _, err := readTLV(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	switch tag {
+	case tagSequence:
+		hdr.ScopedPDU = scopedPDU
+	case tagOctetString:
+		hdr.ScopedPDU = scopedPDU
+		hdr.Encrypted = true
+	default:
+		return nil, fmt.Errorf("unexpected scoped PDU tag 0x%02x", tag)
+	}
+
+	return &hdr, nil
+}
+
+// requestID extracts the request ID from the plaintext scoped PDU.
+//
+// Zero is returned if the request ID can't be extracted, as specified for the Report PDU.
+func (hdr *v3Header) requestID() uint32 {
+	if hdr.Encrypted {
+		return 0
+	}
+
+	_, rest, err := readExpected(hdr.ScopedPDU, tagOctetString) // contextEngineID
+	if err != nil {
+		return 0
+	}
+
+	_, rest, err = readExpected(rest, tagOctetString) // contextName
+	if err != nil {
+		return 0
+	}
+
+	_, pdu, _, err := readTLV(rest)
+	if err != nil {
+		return 0
+	}
+
+	requestID, _, err := readInteger(pdu)
+	if err != nil {
+		return 0
+	}
+
+	return uint32(requestID)
+}
@@ -0,0 +1,469 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package snmp
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/prometheus/procfs"
+	"github.com/siderolabs/go-pointer"
+	"golang.org/x/sys/unix"
+)
+
+// Standard MIB subtrees.
+var (
+	oidSystem        = MustParseOID("1.3.6.1.2.1.1")         // SNMPv2-MIB::system
+	oidIfNumber      = MustParseOID("1.3.6.1.2.1.2.1.0")     // IF-MIB::ifNumber
+	oidIfEntry       = MustParseOID("1.3.6.1.2.1.2.2.1")     // IF-MIB::ifEntry
+	oidIfXEntry      = MustParseOID("1.3.6.1.2.1.31.1.1.1")  // IF-MIB::ifXEntry
+	oidHrSystem      = MustParseOID("1.3.6.1.2.1.25.1")      // HOST-RESOURCES-MIB::hrSystem
+	oidHrMemorySize  = MustParseOID("1.3.6.1.2.1.25.2.2.0")  // HOST-RESOURCES-MIB::hrMemorySize
+	oidHrStorageType = MustParseOID("1.3.6.1.2.1.25.2.1")    // HOST-RESOURCES-MIB::hrStorageTypes
+	oidHrStorage     = MustParseOID("1.3.6.1.2.1.25.2.3.1")  // HOST-RESOURCES-MIB::hrStorageEntry
+	oidUCDMemory     = MustParseOID("1.3.6.1.4.1.2021.4")    // UCD-SNMP-MIB::memory
+	oidUCDLaEntry    = MustParseOID("1.3.6.1.4.1.2021.10.1") // UCD-SNMP-MIB::laEntry
+
+	// oidSysObjectID is the net-snmp Linux object ID, so that the managers recognize the machine as Linux host.
+	oidSysObjectID = ".1.3.6.1.4.1.8072.3.2.10"
+)
+
+// hrStorageTable indices, same as used by net-snmp.
+const (
+	hrStoragePhysicalMemory = 1
+	hrStorageSwap           = 10
+	hrStorageFilesystems    = 31
+)
+
+// Collector collects the standard MIB objects from the host.
+type Collector struct {
+	// ProcPath defaults to /proc.
+	ProcPath string
+	// SysClassNetPath defaults to /sys/class/net.
+	SysClassNetPath string
+
+	// Filesystems are mount points reported in the hrStorageTable.
+	Filesystems []string
+
+	Description string
+	Location    string
+	Contact     string
+
+	// StartTime is the time the agent was started at, reported as sysUpTime.
+	StartTime time.Time
+}
+
+// Collect the MIB objects.
+func (c *Collector) Collect() (MIB, error) {
+	procPath := c.ProcPath
+	if procPath == "" {
+		procPath = procfs.DefaultMountPoint
+	}
+
+	fs, err := procfs.NewFS(procPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var vars []Variable
+
+	vars = append(vars, c.system()...)
+
+	interfaces, err := c.interfaces()
+	if err != nil {
+		return nil, fmt.Errorf("error collecting interfaces: %w", err)
+	}
+
+	vars = append(vars, interfaces...)
+
+	hostResources, err := c.hostResources(fs, procPath)
+	if err != nil {
+		return nil, fmt.Errorf("error collecting host resources: %w", err)
+	}
+
+	vars = append(vars, hostResources...)
+
+	ucd, err := c.ucd(fs)
+	if err != nil {
+		return nil, fmt.Errorf("error collecting load and memory: %w", err)
+	}
+
+	vars = append(vars, ucd...)
+
+	return NewMIB(vars), nil
+}
+
+// system builds SNMPv2-MIB system group.
+func (c *Collector) system() []Variable {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = ""
+	}
+
+	return []Variable{
+		{oidSystem.Append(1, 0), gosnmp.OctetString, c.Description},
+		{oidSystem.Append(2, 0), gosnmp.ObjectIdentifier, oidSysObjectID},
+		{oidSystem.Append(3, 0), gosnmp.TimeTicks, timeTicks(time.Since(c.StartTime))},
+		{oidSystem.Append(4, 0), gosnmp.OctetString, c.Contact},
+		{oidSystem.Append(5, 0), gosnmp.OctetString, hostname},
+		{oidSystem.Append(6, 0), gosnmp.OctetString, c.Location},
+		// internet and end-to-end layers
+		{oidSystem.Append(7, 0), gosnmp.Integer, 72},
+	}
+}
+
+// netInterface is a network interface read from the sysfs.
+type netInterface struct {
+	name     string
+	index    int
+	mtu      int
+	arpType  int
+	flags    uint64
+	address  []byte
+	oper     string
+	speedMbs uint64
+
+	rxBytes, rxPackets, rxErrors, rxDropped uint64
+	txBytes, txPackets, txErrors, txDropped uint64
+}
+
+// interfaces builds IF-MIB ifTable and ifXTable.
+func (c *Collector) interfaces() ([]Variable, error) {
+	sysClassNet := c.SysClassNetPath
+	if sysClassNet == "" {
+		sysClassNet = "/sys/class/net"
+	}
+
+	entries, err := os.ReadDir(sysClassNet)
+	if err != nil {
+		return nil, err
+	}
+
+	interfaces := make([]netInterface, 0, len(entries))
+
+	for _, entry := range entries {
+		iface, err := readNetInterface(filepath.Join(sysClassNet, entry.Name()))
+		if err != nil {
+			// interface might be removed while reading
+			continue
+		}
+
+		interfaces = append(interfaces, iface)
+	}
+
+	slices.SortFunc(interfaces, func(a, b netInterface) int {
+		return a.index - b.index
+	})
+
+	vars := []Variable{
+		{oidIfNumber, gosnmp.Integer, len(interfaces)},
+	}
+
+	for _, iface := range interfaces {
+		idx := uint32(iface.index)
+		speed := min(iface.speedMbs*1_000_000, math.MaxUint32)
+
+		vars = append(vars,
+			Variable{oidIfEntry.Append(1, idx), gosnmp.Integer, iface.index},
+			Variable{oidIfEntry.Append(2, idx), gosnmp.OctetString, iface.name},
+			Variable{oidIfEntry.Append(3, idx), gosnmp.Integer, iface.ifType()},
+			Variable{oidIfEntry.Append(4, idx), gosnmp.Integer, iface.mtu},
+			Variable{oidIfEntry.Append(5, idx), gosnmp.Gauge32, uint32(speed)},
+			Variable{oidIfEntry.Append(6, idx), gosnmp.OctetString, iface.address},
+			Variable{oidIfEntry.Append(7, idx), gosnmp.Integer, iface.adminStatus()},
+			Variable{oidIfEntry.Append(8, idx), gosnmp.Integer, iface.operStatus()},
+			Variable{oidIfEntry.Append(10, idx), gosnmp.Counter32, uint32(iface.rxBytes)},
+			Variable{oidIfEntry.Append(11, idx), gosnmp.Counter32, uint32(iface.rxPackets)},
+			Variable{oidIfEntry.Append(13, idx), gosnmp.Counter32, uint32(iface.rxDropped)},
+			Variable{oidIfEntry.Append(14, idx), gosnmp.Counter32, uint32(iface.rxErrors)},
+			Variable{oidIfEntry.Append(16, idx), gosnmp.Counter32, uint32(iface.txBytes)},
+			Variable{oidIfEntry.Append(17, idx), gosnmp.Counter32, uint32(iface.txPackets)},
+			Variable{oidIfEntry.Append(19, idx), gosnmp.Counter32, uint32(iface.txDropped)},
+			Variable{oidIfEntry.Append(20, idx), gosnmp.Counter32, uint32(iface.txErrors)},
+			Variable{oidIfXEntry.Append(1, idx), gosnmp.OctetString, iface.name},
+			Variable{oidIfXEntry.Append(6, idx), gosnmp.Counter64, iface.rxBytes},
+			Variable{oidIfXEntry.Append(7, idx), gosnmp.Counter64, iface.rxPackets},
+			Variable{oidIfXEntry.Append(10, idx), gosnmp.Counter64, iface.txBytes},
+			Variable{oidIfXEntry.Append(11, idx), gosnmp.Counter64, iface.txPackets},
+			Variable{oidIfXEntry.Append(15, idx), gosnmp.Gauge32, uint32(min(iface.speedMbs, math.MaxUint32))},
+		)
+	}
+
+	return vars, nil
+}
+
+//nolint:gocyclo,cyclop
+func readNetInterface(path string) (netInterface, error) {
+	iface := netInterface{
+		name: filepath.Base(path),
+	}
+
+	var err error
+
+	if iface.index, err = readSysfsInt(path, "ifindex"); err != nil {
+		return iface, err
+	}
+
+	if iface.mtu, err = readSysfsInt(path, "mtu"); err != nil {
+		return iface, err
+	}
+
+	if iface.arpType, err = readSysfsInt(path, "type"); err != nil {
+		return iface, err
+	}
+
+	flags, err := readSysfsString(path, "flags")
+	if err != nil {
+		return iface, err
+	}
+
+	if iface.flags, err = strconv.ParseUint(flags, 0, 64); err != nil {
+		return iface, err
+	}
+
+	// the files below might be missing or unreadable depending on the link type and state
+	if address, err := readSysfsString(path, "address"); err == nil {
+		if hw, err := net.ParseMAC(address); err == nil {
+			iface.address = hw
+		}
+	}
+
+	iface.oper, _ = readSysfsString(path, "operstate") //nolint:errcheck
+
+	if speed, err := readSysfsInt(path, "speed"); err == nil && speed > 0 {
+		iface.speedMbs = uint64(speed)
+	}
+
+	for _, counter := range []struct {
+		name  string
+		value *uint64
+	}{
+		{"rx_bytes", &iface.rxBytes},
+		{"rx_packets", &iface.rxPackets},
+		{"rx_errors", &iface.rxErrors},
+		{"rx_dropped", &iface.rxDropped},
+		{"tx_bytes", &iface.txBytes},
+		{"tx_packets", &iface.txPackets},
+		{"tx_errors", &iface.txErrors},
+		{"tx_dropped", &iface.txDropped},
+	} {
+		value, err := readSysfsString(path, filepath.Join("statistics", counter.name))
+		if err != nil {
+			continue
+		}
+
+		*counter.value, _ = strconv.ParseUint(value, 10, 64) //nolint:errcheck
+	}
+
+	return iface, nil
+}
+
+// ifType maps ARPHRD_* link type to IANAifType.
+func (iface netInterface) ifType() int {
+	switch iface.arpType {
+	case unix.ARPHRD_ETHER:
+		return 6 // ethernetCsmacd
+	case unix.ARPHRD_LOOPBACK:
+		return 24 // softwareLoopback
+	case unix.ARPHRD_TUNNEL, unix.ARPHRD_TUNNEL6, unix.ARPHRD_SIT, unix.ARPHRD_IPGRE, unix.ARPHRD_NONE:
+		return 131 // tunnel
+	default:
+		return 1 // other
+	}
+}
+
+func (iface netInterface) adminStatus() int {
+	if iface.flags&unix.IFF_UP != 0 {
+		return 1 // up
+	}
+
+	return 2 // down
+}
+
+func (iface netInterface) operStatus() int {
+	switch iface.oper {
+	case "up":
+		return 1
+	case "down":
+		return 2
+	case "testing":
+		return 3
+	case "dormant":
+		return 5
+	case "notpresent":
+		return 6
+	case "lowerlayerdown":
+		return 7
+	default:
+		// loopback and some virtual links don't report the operational state
+		if iface.flags&unix.IFF_UP != 0 {
+			return 1
+		}
+
+		return 4 // unknown
+	}
+}
+
+// hostResources builds HOST-RESOURCES-MIB hrSystem group and hrStorageTable.
+func (c *Collector) hostResources(fs procfs.FS, procPath string) ([]Variable, error) {
+	uptime, err := readUptime(procPath)
+	if err != nil {
+		return nil, err
+	}
+
+	meminfo, err := fs.Meminfo()
+	if err != nil {
+		return nil, err
+	}
+
+	memTotal := pointer.SafeDeref(meminfo.MemTotal)
+	memAvailable := pointer.SafeDeref(meminfo.MemAvailable)
+	swapTotal := pointer.SafeDeref(meminfo.SwapTotal)
+	swapFree := pointer.SafeDeref(meminfo.SwapFree)
+
+	vars := []Variable{
+		{oidHrSystem.Append(1, 0), gosnmp.TimeTicks, timeTicks(uptime)},
+		{oidHrMemorySize, gosnmp.Integer, clampInt32(memTotal)},
+	}
+
+	vars = append(vars, hrStorage(hrStoragePhysicalMemory, 2, "Physical memory", 1024, memTotal, memTotal-min(memAvailable, memTotal))...)
+
+	if swapTotal > 0 {
+		vars = append(vars, hrStorage(hrStorageSwap, 3, "Swap space", 1024, swapTotal, swapTotal-min(swapFree, swapTotal))...)
+	}
+
+	for i, mountpoint := range c.Filesystems {
+		var st unix.Statfs_t
+
+		if err := unix.Statfs(mountpoint, &st); err != nil {
+			// the filesystem is not mounted
+			continue
+		}
+
+		vars = append(vars, hrStorage(uint32(hrStorageFilesystems+i), 4, mountpoint, uint64(st.Bsize), st.Blocks, st.Blocks-st.Bfree)...)
+	}
+
+	return vars, nil
+}
+
+// hrStorage builds the hrStorageEntry, scaling the allocation units to fit the size into Integer32.
+func hrStorage(index, storageType uint32, descr string, units, size, used uint64) []Variable {
+	for units > 0 && size > math.MaxInt32 {
+		units, size, used = units*2, size/2, used/2
+	}
+
+	return []Variable{
+		{oidHrStorage.Append(1, index), gosnmp.Integer, int(index)},
+		{oidHrStorage.Append(2, index), gosnmp.ObjectIdentifier, oidHrStorageType.Append(storageType).String()},
+		{oidHrStorage.Append(3, index), gosnmp.OctetString, descr},
+		{oidHrStorage.Append(4, index), gosnmp.Integer, clampInt32(units)},
+		{oidHrStorage.Append(5, index), gosnmp.Integer, clampInt32(size)},
+		{oidHrStorage.Append(6, index), gosnmp.Integer, clampInt32(used)},
+	}
+}
+
+// ucd builds UCD-SNMP-MIB memory group and laTable.
+func (c *Collector) ucd(fs procfs.FS) ([]Variable, error) {
+	loadAvg, err := fs.LoadAvg()
+	if err != nil {
+		return nil, err
+	}
+
+	meminfo, err := fs.Meminfo()
+	if err != nil {
+		return nil, err
+	}
+
+	memFree := pointer.SafeDeref(meminfo.MemFree)
+	swapFree := pointer.SafeDeref(meminfo.SwapFree)
+
+	vars := []Variable{
+		{oidUCDMemory.Append(1, 0), gosnmp.Integer, 0},
+		{oidUCDMemory.Append(2, 0), gosnmp.OctetString, "swap"},
+		{oidUCDMemory.Append(3, 0), gosnmp.Integer, clampInt32(pointer.SafeDeref(meminfo.SwapTotal))},
+		{oidUCDMemory.Append(4, 0), gosnmp.Integer, clampInt32(swapFree)},
+		{oidUCDMemory.Append(5, 0), gosnmp.Integer, clampInt32(pointer.SafeDeref(meminfo.MemTotal))},
+		{oidUCDMemory.Append(6, 0), gosnmp.Integer, clampInt32(memFree)},
+		{oidUCDMemory.Append(11, 0), gosnmp.Integer, clampInt32(memFree + swapFree)},
+		{oidUCDMemory.Append(13, 0), gosnmp.Integer, clampInt32(pointer.SafeDeref(meminfo.Shmem))},
+		{oidUCDMemory.Append(14, 0), gosnmp.Integer, clampInt32(pointer.SafeDeref(meminfo.Buffers))},
+		{oidUCDMemory.Append(15, 0), gosnmp.Integer, clampInt32(pointer.SafeDeref(meminfo.Cached))},
+	}
+
+	for i, load := range []struct {
+		name  string
+		value float64
+	}{
+		{"Load-1", loadAvg.Load1},
+		{"Load-5", loadAvg.Load5},
+		{"Load-15", loadAvg.Load15},
+	} {
+		idx := uint32(i + 1)
+
+		vars = append(vars,
+			Variable{oidUCDLaEntry.Append(1, idx), gosnmp.Integer, int(idx)},
+			Variable{oidUCDLaEntry.Append(2, idx), gosnmp.OctetString, load.name},
+			Variable{oidUCDLaEntry.Append(3, idx), gosnmp.OctetString, strconv.FormatFloat(load.value, 'f', 2, 64)},
+			Variable{oidUCDLaEntry.Append(5, idx), gosnmp.Integer, int(math.Round(load.value * 100))},
+		)
+	}
+
+	return vars, nil
+}
+
+func readUptime(procPath string) (time.Duration, error) {
+	contents, err := os.ReadFile(filepath.Join(procPath, "uptime"))
+	if err != nil {
+		return 0, err
+	}
+
+	fields := strings.Fields(string(contents))
+	if len(fields) == 0 {
+		return 0, errors.New("empty uptime")
+	}
+
+	seconds, err := strconv.ParseFloat(fields[0], 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return time.Duration(seconds * float64(time.Second)), nil
+}
+
+func readSysfsString(path, name string) (string, error) {
+	contents, err := os.ReadFile(filepath.Join(path, name))
+	if err != nil {
+		return "", err
+	}
+
+	return strings.TrimSpace(string(contents)), nil
+}
+
+func readSysfsInt(path, name string) (int, error) {
+	value, err := readSysfsString(path, name)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.Atoi(value)
+}
+
+// timeTicks converts the duration to hundredths of a second, wrapping around as TimeTicks do.
+func timeTicks(d time.Duration) uint32 {
+	return uint32(d / (10 * time.Millisecond))
+}
+
+func clampInt32(v uint64) int {
+	return int(min(v, math.MaxInt32))
+}
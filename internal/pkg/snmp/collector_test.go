@@ -0,0 +1,148 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package snmp_test
+
+import (
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/gosnmp/gosnmp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/snmp"
+)
+
+func writeFiles(t *testing.T, root string, files map[string]string) {
+	t.Helper()
+
+	for name, contents := range files {
+		path := filepath.Join(root, name)
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(contents+"\n"), 0o644))
+	}
+}
+
+func TestCollector(t *testing.T) {
+	t.Parallel()
+
+	procPath := t.TempDir()
+	sysClassNet := t.TempDir()
+
+	writeFiles(t, procPath, map[string]string{
+		"uptime":  "1234.56 4000.00",
+		"loadavg": "0.52 1.25 2.00 1/100 1234",
+		"meminfo": `MemTotal:       16384000 kB
+MemFree:         8192000 kB
+MemAvailable:   12288000 kB
+Buffers:          100000 kB
+Cached:          2000000 kB
+Shmem:             50000 kB
+SwapTotal:             0 kB
+SwapFree:              0 kB`,
+	})
+
+	writeFiles(t, sysClassNet, map[string]string{
+		"lo/ifindex":               "1",
+		"lo/mtu":                   "65536",
+		"lo/type":                  "772",
+		"lo/flags":                 "0x9",
+		"lo/address":               "00:00:00:00:00:00",
+		"lo/operstate":             "unknown",
+		"eth0/ifindex":             "2",
+		"eth0/mtu":                 "1500",
+		"eth0/type":                "1",
+		"eth0/flags":               "0x1003",
+		"eth0/address":             "52:54:00:12:34:56",
+		"eth0/operstate":           "up",
+		"eth0/speed":               "10000",
+		"eth0/statistics/rx_bytes": "5000000000",
+		"eth0/statistics/tx_bytes": "1000",
+		// incomplete interface is skipped
+		"broken/mtu": "1500",
+	})
+
+	collector := &snmp.Collector{
+		ProcPath:        procPath,
+		SysClassNetPath: sysClassNet,
+		Filesystems:     []string{t.TempDir(), "/nonexistent"},
+		Description:     "Talos",
+		Location:        "DC1",
+		Contact:         "ops@example.com",
+		StartTime:       time.Now().Add(-10 * time.Second),
+	}
+
+	mib, err := collector.Collect()
+	require.NoError(t, err)
+
+	hostname, err := os.Hostname()
+	require.NoError(t, err)
+
+	value := func(oid string) any {
+		v, ok := mib.Get(snmp.MustParseOID(oid))
+		require.True(t, ok, "missing %s", oid)
+
+		return v.Value
+	}
+
+	// system
+	assert.Equal(t, "Talos", value("1.3.6.1.2.1.1.1.0"))
+	assert.GreaterOrEqual(t, value("1.3.6.1.2.1.1.3.0"), uint32(1000))
+	assert.Equal(t, "ops@example.com", value("1.3.6.1.2.1.1.4.0"))
+	assert.Equal(t, hostname, value("1.3.6.1.2.1.1.5.0"))
+	assert.Equal(t, "DC1", value("1.3.6.1.2.1.1.6.0"))
+
+	// interfaces
+	assert.Equal(t, 2, value("1.3.6.1.2.1.2.1.0"))
+	assert.Equal(t, "lo", value("1.3.6.1.2.1.2.2.1.2.1"))
+	assert.Equal(t, 24, value("1.3.6.1.2.1.2.2.1.3.1"))
+	assert.Equal(t, 1, value("1.3.6.1.2.1.2.2.1.8.1"))
+	assert.Equal(t, "eth0", value("1.3.6.1.2.1.2.2.1.2.2"))
+	assert.Equal(t, 6, value("1.3.6.1.2.1.2.2.1.3.2"))
+	assert.Equal(t, 1500, value("1.3.6.1.2.1.2.2.1.4.2"))
+	assert.Equal(t, uint32(4294967295), value("1.3.6.1.2.1.2.2.1.5.2"))
+	assert.Equal(t, []byte(net.HardwareAddr{0x52, 0x54, 0x00, 0x12, 0x34, 0x56}), value("1.3.6.1.2.1.2.2.1.6.2"))
+	assert.Equal(t, 1, value("1.3.6.1.2.1.2.2.1.7.2"))
+	assert.Equal(t, uint32(5000000000%(1<<32)), value("1.3.6.1.2.1.2.2.1.10.2"))
+	assert.Equal(t, uint64(5000000000), value("1.3.6.1.2.1.31.1.1.1.6.2"))
+	assert.Equal(t, uint64(1000), value("1.3.6.1.2.1.31.1.1.1.10.2"))
+	assert.Equal(t, uint32(10000), value("1.3.6.1.2.1.31.1.1.1.15.2"))
+
+	// host resources
+	assert.Equal(t, uint32(123456), value("1.3.6.1.2.1.25.1.1.0"))
+	assert.Equal(t, 16384000, value("1.3.6.1.2.1.25.2.2.0"))
+	assert.Equal(t, "Physical memory", value("1.3.6.1.2.1.25.2.3.1.3.1"))
+	assert.Equal(t, 1024, value("1.3.6.1.2.1.25.2.3.1.4.1"))
+	assert.Equal(t, 16384000, value("1.3.6.1.2.1.25.2.3.1.5.1"))
+	assert.Equal(t, 4096000, value("1.3.6.1.2.1.25.2.3.1.6.1"))
+	assert.Equal(t, collector.Filesystems[0], value("1.3.6.1.2.1.25.2.3.1.3.31"))
+
+	_, ok := mib.Get(snmp.MustParseOID("1.3.6.1.2.1.25.2.3.1.3.10"))
+	assert.False(t, ok, "no swap should be reported")
+
+	_, ok = mib.Get(snmp.MustParseOID("1.3.6.1.2.1.25.2.3.1.3.32"))
+	assert.False(t, ok, "missing filesystem should be skipped")
+
+	// UCD
+	assert.Equal(t, 16384000, value("1.3.6.1.4.1.2021.4.5.0"))
+	assert.Equal(t, 8192000, value("1.3.6.1.4.1.2021.4.6.0"))
+	assert.Equal(t, "0.52", value("1.3.6.1.4.1.2021.10.1.3.1"))
+	assert.Equal(t, "1.25", value("1.3.6.1.4.1.2021.10.1.3.2"))
+	assert.Equal(t, 200, value("1.3.6.1.4.1.2021.10.1.5.3"))
+
+	// MIB is sorted
+	for i := 1; i < len(mib); i++ {
+		assert.Negative(t, mib[i-1].OID.Compare(mib[i].OID))
+	}
+
+	// all values can be encoded
+	for _, v := range mib {
+		assert.NotEqual(t, gosnmp.Null, v.Type)
+	}
+}
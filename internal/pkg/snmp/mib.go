@@ -0,0 +1,129 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package snmp
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gosnmp/gosnmp"
+)
+
+// OID is a parsed object identifier.
+type OID []uint32
+
+// ParseOID parses the dotted object identifier, the leading dot is optional.
+func ParseOID(s string) (OID, error) {
+	s = strings.TrimPrefix(s, ".")
+	if s == "" {
+		return nil, fmt.Errorf("empty object identifier")
+	}
+
+	parts := strings.Split(s, ".")
+	oid := make(OID, 0, len(parts))
+
+	for _, part := range parts {
+		n, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid object identifier %q: %w", s, err)
+		}
+
+		oid = append(oid, uint32(n))
+	}
+
+	return oid, nil
+}
+
+// MustParseOID parses the object identifier and panics on error.
+func MustParseOID(s string) OID {
+	oid, err := ParseOID(s)
+	if err != nil {
+		panic(err)
+	}
+
+	return oid
+}
+
+// Append returns a new OID with the sub-identifiers appended.
+func (oid OID) Append(sub ...uint32) OID {
+	return append(slices.Clip(oid), sub...)
+}
+
+// Compare compares the OIDs in the lexicographical order.
+func (oid OID) Compare(other OID) int {
+	return slices.Compare(oid, other)
+}
+
+// String implements fmt.Stringer, the OID is formatted with the leading dot.
+func (oid OID) String() string {
+	var sb strings.Builder
+
+	for _, n := range oid {
+		sb.WriteByte('.')
+		sb.WriteString(strconv.FormatUint(uint64(n), 10))
+	}
+
+	return sb.String()
+}
+
+// Variable is a MIB object instance.
+type Variable struct {
+	OID   OID
+	Type  gosnmp.Asn1BER
+	Value any
+}
+
+// MIB is a list of the variables sorted by OID.
+type MIB []Variable
+
+// NewMIB sorts the variables and builds the MIB.
+func NewMIB(vars []Variable) MIB {
+	mib := slices.Clone(vars)
+
+	slices.SortFunc(mib, func(a, b Variable) int {
+		return a.OID.Compare(b.OID)
+	})
+
+	return mib
+}
+
+// Get returns the variable with the exact OID.
+func (mib MIB) Get(oid OID) (Variable, bool) {
+	idx, found := slices.BinarySearchFunc(mib, oid, func(v Variable, oid OID) int {
+		return v.OID.Compare(oid)
+	})
+	if !found {
+		return Variable{}, false
+	}
+
+	return mib[idx], true
+}
+
+// Next returns the first variable with OID greater than the specified one.
+func (mib MIB) Next(oid OID) (Variable, bool) {
+	idx, found := slices.BinarySearchFunc(mib, oid, func(v Variable, oid OID) int {
+		return v.OID.Compare(oid)
+	})
+	if found {
+		idx++
+	}
+
+	if idx >= len(mib) {
+		return Variable{}, false
+	}
+
+	return mib[idx], true
+}
+
+// pdu converts the variable to gosnmp representation.
+func (v Variable) pdu() gosnmp.SnmpPDU {
+	return gosnmp.SnmpPDU{
+		Name:  v.OID.String(),
+		Type:  v.Type,
+		Value: v.Value,
+	}
+}
@@ -0,0 +1,297 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package tracing
+
+import (
+	"fmt"
+
+	"github.com/cilium/ebpf/asm"
+)
+
+// Event layout shared by all programs:
+//
+//	latency (u64), pid_tgid (u64), comm (char[16]), payload.
+const (
+	offLatency = 0
+	offPIDTGID = 8
+	offComm    = 16
+	offPayload = 32
+
+	commLen = 16
+)
+
+// Start timestamps map layout for the latency tools: 16 byte key, u64 value.
+const (
+	startKeySize = 16
+	startKeyOff  = -24
+	startValOff  = -8
+)
+
+const exitLabel = "exit"
+
+// programMaps are the file descriptors of the maps used by the programs.
+type programMaps struct {
+	// events is the ring buffer the events are submitted to.
+	events int
+	// lost is an array with a single u64 counting events dropped because the ring buffer was full.
+	lost int
+	// start is a hash map of start timestamps (latency tools only).
+	start int
+}
+
+// slot describes a tracepoint record field copied to the event payload.
+type slot struct {
+	field  string
+	offset int16
+	size   int
+	// str copies the string the __data_loc field points to.
+	str bool
+}
+
+// ctxAddr sets the register to the address of the field in the tracepoint record (ctx is in R6).
+func ctxAddr(dst asm.Register, offset int) asm.Instructions {
+	return asm.Instructions{
+		asm.Mov.Reg(dst, asm.R6),
+		asm.Add.Imm(dst, int32(offset)),
+	}
+}
+
+// stackAddr sets the register to the address on the stack.
+func stackAddr(dst asm.Register, offset int16) asm.Instructions {
+	return asm.Instructions{
+		asm.Mov.Reg(dst, asm.RFP),
+		asm.Add.Imm(dst, int32(offset)),
+	}
+}
+
+// copyField copies up to size bytes of the tracepoint record field to the stack.
+func copyField(format *Format, name string, stackOff int16, size int) (asm.Instructions, error) {
+	field, err := format.Field(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var insns asm.Instructions
+
+	insns = append(insns, stackAddr(asm.R1, stackOff)...)
+	insns = append(insns, asm.Mov.Imm(asm.R2, int32(min(field.Size, size))))
+	insns = append(insns, ctxAddr(asm.R3, field.Offset)...)
+	insns = append(insns, asm.FnProbeReadKernel.Call())
+
+	return insns, nil
+}
+
+// copyDataLocString copies the string pointed to by the __data_loc field to the stack.
+func copyDataLocString(format *Format, name string, stackOff int16, size int) (asm.Instructions, error) {
+	field, err := format.Field(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if !field.DataLoc || field.Size != 4 || field.Offset%4 != 0 {
+		return nil, fmt.Errorf("field %q of tracepoint %q is not a __data_loc field", name, format.Name)
+	}
+
+	var insns asm.Instructions
+
+	insns = append(insns, stackAddr(asm.R1, stackOff)...)
+	insns = append(insns,
+		asm.Mov.Imm(asm.R2, int32(size)),
+		// lower 16 bits of the __data_loc field is the offset of the data in the record
+		asm.LoadMem(asm.R3, asm.R6, int16(field.Offset), asm.Word),
+		asm.And.Imm(asm.R3, 0xffff),
+		asm.Add.Reg(asm.R3, asm.R6),
+		asm.FnProbeReadKernelStr.Call(),
+	)
+
+	return insns, nil
+}
+
+// emitEvent fills the event on the stack and submits it to the ring buffer.
+//
+// The tracepoint record (ctx) is expected in R6, the latency in R7.
+// The program exits after the event is submitted.
+func emitEvent(format *Format, maps programMaps, payloadSize int, slots []slot) (asm.Instructions, error) {
+	size := (offPayload + payloadSize + 7) &^ 7
+	base := int16(-size)
+	lostKeyOff := base - 8
+
+	var insns asm.Instructions
+
+	// zero the event, so that the padding is initialized
+	for off := int16(0); off < int16(size); off += 8 {
+		insns = append(insns, asm.StoreImm(asm.RFP, base+off, 0, asm.DWord))
+	}
+
+	insns = append(insns,
+		asm.StoreMem(asm.RFP, base+offLatency, asm.R7, asm.DWord),
+		asm.FnGetCurrentPidTgid.Call(),
+		asm.StoreMem(asm.RFP, base+offPIDTGID, asm.R0, asm.DWord),
+	)
+
+	insns = append(insns, stackAddr(asm.R1, base+offComm)...)
+	insns = append(insns,
+		asm.Mov.Imm(asm.R2, commLen),
+		asm.FnGetCurrentComm.Call(),
+	)
+
+	for _, s := range slots {
+		var (
+			copyInsns asm.Instructions
+			err       error
+		)
+
+		if s.str {
+			copyInsns, err = copyDataLocString(format, s.field, base+offPayload+s.offset, s.size)
+		} else {
+			copyInsns, err = copyField(format, s.field, base+offPayload+s.offset, s.size)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		insns = append(insns, copyInsns...)
+	}
+
+	insns = append(insns, asm.LoadMapPtr(asm.R1, maps.events))
+	insns = append(insns, stackAddr(asm.R2, base)...)
+	insns = append(insns,
+		asm.Mov.Imm(asm.R3, int32(size)),
+		asm.Mov.Imm(asm.R4, 0),
+		asm.FnRingbufOutput.Call(),
+		asm.JEq.Imm(asm.R0, 0, exitLabel),
+
+		// the ring buffer is full, count the event as lost
+		asm.StoreImm(asm.RFP, lostKeyOff, 0, asm.DWord),
+		asm.LoadMapPtr(asm.R1, maps.lost),
+	)
+	insns = append(insns, stackAddr(asm.R2, lostKeyOff)...)
+	insns = append(insns,
+		asm.FnMapLookupElem.Call(),
+		asm.JEq.Imm(asm.R0, 0, exitLabel),
+		asm.Mov.Imm(asm.R1, 1),
+		asm.StoreXAdd(asm.R0, asm.R1, asm.DWord),
+
+		asm.Mov.Imm(asm.R0, 0).WithSymbol(exitLabel),
+		asm.Return(),
+	)
+
+	return insns, nil
+}
+
+// eventProgram builds the program submitting an event for each tracepoint hit.
+func eventProgram(format *Format, maps programMaps, payloadSize int, slots []slot) (asm.Instructions, error) {
+	emit, err := emitEvent(format, maps, payloadSize, slots)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(asm.Instructions{
+		asm.Mov.Reg(asm.R6, asm.R1),
+		asm.Mov.Imm(asm.R7, 0),
+	}, emit...), nil
+}
+
+// keyFunc builds the start timestamps map key on the stack at startKeyOff.
+type keyFunc func(format *Format) (asm.Instructions, error)
+
+// pidTGIDKey keys the start timestamps by the current thread.
+func pidTGIDKey(*Format) (asm.Instructions, error) {
+	return asm.Instructions{
+		asm.FnGetCurrentPidTgid.Call(),
+		asm.StoreMem(asm.RFP, startKeyOff, asm.R0, asm.DWord),
+		asm.StoreImm(asm.RFP, startKeyOff+8, 0, asm.DWord),
+	}, nil
+}
+
+// fieldsKey keys the start timestamps by the two tracepoint record fields (up to 8 bytes each).
+func fieldsKey(first, second string) keyFunc {
+	return func(format *Format) (asm.Instructions, error) {
+		insns := asm.Instructions{
+			asm.StoreImm(asm.RFP, startKeyOff, 0, asm.DWord),
+			asm.StoreImm(asm.RFP, startKeyOff+8, 0, asm.DWord),
+		}
+
+		for i, name := range []string{first, second} {
+			copyInsns, err := copyField(format, name, startKeyOff+int16(i)*8, 8)
+			if err != nil {
+				return nil, err
+			}
+
+			insns = append(insns, copyInsns...)
+		}
+
+		return insns, nil
+	}
+}
+
+// startProgram builds the program recording the start timestamp.
+func startProgram(format *Format, maps programMaps, key keyFunc) (asm.Instructions, error) {
+	keyInsns, err := key(format)
+	if err != nil {
+		return nil, err
+	}
+
+	insns := asm.Instructions{
+		asm.Mov.Reg(asm.R6, asm.R1),
+	}
+
+	insns = append(insns, keyInsns...)
+	insns = append(insns,
+		asm.FnKtimeGetNs.Call(),
+		asm.StoreMem(asm.RFP, startValOff, asm.R0, asm.DWord),
+		asm.LoadMapPtr(asm.R1, maps.start),
+	)
+	insns = append(insns, stackAddr(asm.R2, startKeyOff)...)
+	insns = append(insns, stackAddr(asm.R3, startValOff)...)
+	insns = append(insns,
+		asm.Mov.Imm(asm.R4, 0), // BPF_ANY
+		asm.FnMapUpdateElem.Call(),
+		asm.Mov.Imm(asm.R0, 0),
+		asm.Return(),
+	)
+
+	return insns, nil
+}
+
+// endProgram builds the program calculating the latency from the start timestamp,
+// and submitting an event if the latency is above the threshold.
+func endProgram(format *Format, maps programMaps, key keyFunc, minLatencyNs int64, payloadSize int, slots []slot) (asm.Instructions, error) {
+	keyInsns, err := key(format)
+	if err != nil {
+		return nil, err
+	}
+
+	emit, err := emitEvent(format, maps, payloadSize, slots)
+	if err != nil {
+		return nil, err
+	}
+
+	insns := asm.Instructions{
+		asm.Mov.Reg(asm.R6, asm.R1),
+	}
+
+	insns = append(insns, keyInsns...)
+	insns = append(insns, asm.LoadMapPtr(asm.R1, maps.start))
+	insns = append(insns, stackAddr(asm.R2, startKeyOff)...)
+	insns = append(insns,
+		asm.FnMapLookupElem.Call(),
+		asm.JEq.Imm(asm.R0, 0, exitLabel),
+		asm.LoadMem(asm.R7, asm.R0, 0, asm.DWord),
+		asm.LoadMapPtr(asm.R1, maps.start),
+	)
+	insns = append(insns, stackAddr(asm.R2, startKeyOff)...)
+	insns = append(insns,
+		asm.FnMapDeleteElem.Call(),
+		asm.FnKtimeGetNs.Call(),
+		asm.Sub.Reg(asm.R0, asm.R7),
+		asm.Mov.Reg(asm.R7, asm.R0),
+		asm.LoadImm(asm.R1, minLatencyNs, asm.DWord),
+		asm.JLT.Reg(asm.R7, asm.R1, exitLabel),
+	)
+
+	return append(insns, emit...), nil
+}
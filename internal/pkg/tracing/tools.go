@@ -0,0 +1,253 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package tracing
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"time"
+
+	"github.com/cilium/ebpf/asm"
+	"golang.org/x/sys/unix"
+)
+
+// Tool is a tracing tool.
+type Tool string
+
+// Tracing tools.
+const (
+	// SyscallLatency traces system calls slower than the threshold.
+	SyscallLatency Tool = "syscall-latency"
+	// TCPRetransmit traces TCP retransmits.
+	TCPRetransmit Tool = "tcp-retransmit"
+	// BlockIOLatency traces block I/O requests slower than the threshold.
+	BlockIOLatency Tool = "block-io-latency"
+	// Exec traces new processes being executed.
+	Exec Tool = "exec"
+)
+
+// Tools is the list of supported tools.
+var Tools = []Tool{SyscallLatency, TCPRetransmit, BlockIOLatency, Exec}
+
+// program is a BPF program attached to the tracepoint.
+type program struct {
+	group string
+	name  string
+	build func(format *Format, maps programMaps, opts Options) (asm.Instructions, error)
+}
+
+// toolSpec describes the programs of the tool and the event payload.
+type toolSpec struct {
+	programs          []program
+	latency           bool
+	defaultMinLatency time.Duration
+	payloadSize       int
+	decode            func(payload []byte) string
+}
+
+const execFilenameLen = 256
+
+var execSlots = []slot{
+	{field: "filename", offset: 0, size: execFilenameLen, str: true},
+}
+
+const syscallPayloadSize = 16
+
+var syscallSlots = []slot{
+	{field: "id", offset: 0, size: 8},
+	{field: "ret", offset: 8, size: 8},
+}
+
+const blockPayloadSize = 32
+
+var blockSlots = []slot{
+	{field: "dev", offset: 0, size: 8},
+	{field: "sector", offset: 8, size: 8},
+	{field: "nr_sector", offset: 16, size: 4},
+	{field: "error", offset: 20, size: 4},
+	{field: "rwbs", offset: 24, size: 8},
+}
+
+const tcpPayloadSize = 52
+
+var tcpSlots = []slot{
+	{field: "state", offset: 0, size: 4},
+	{field: "family", offset: 4, size: 2},
+	{field: "sport", offset: 6, size: 2},
+	{field: "dport", offset: 8, size: 2},
+	{field: "saddr", offset: 12, size: 4},
+	{field: "daddr", offset: 16, size: 4},
+	{field: "saddr_v6", offset: 20, size: 16},
+	{field: "daddr_v6", offset: 36, size: 16},
+}
+
+var toolSpecs = map[Tool]toolSpec{
+	Exec: {
+		programs: []program{
+			{
+				group: "sched",
+				name:  "sched_process_exec",
+				build: func(format *Format, maps programMaps, _ Options) (asm.Instructions, error) {
+					return eventProgram(format, maps, execFilenameLen, execSlots)
+				},
+			},
+		},
+		payloadSize: execFilenameLen,
+		decode: func(payload []byte) string {
+			return cString(payload)
+		},
+	},
+	SyscallLatency: {
+		programs: []program{
+			{
+				group: "raw_syscalls",
+				name:  "sys_enter",
+				build: func(format *Format, maps programMaps, _ Options) (asm.Instructions, error) {
+					return startProgram(format, maps, pidTGIDKey)
+				},
+			},
+			{
+				group: "raw_syscalls",
+				name:  "sys_exit",
+				build: func(format *Format, maps programMaps, opts Options) (asm.Instructions, error) {
+					return endProgram(format, maps, pidTGIDKey, opts.MinLatency.Nanoseconds(), syscallPayloadSize, syscallSlots)
+				},
+			},
+		},
+		latency:           true,
+		defaultMinLatency: 10 * time.Millisecond,
+		payloadSize:       syscallPayloadSize,
+		decode: func(payload []byte) string {
+			return fmt.Sprintf("syscall=%d ret=%d",
+				int64(binary.NativeEndian.Uint64(payload[0:])),
+				int64(binary.NativeEndian.Uint64(payload[8:])),
+			)
+		},
+	},
+	BlockIOLatency: {
+		programs: []program{
+			{
+				group: "block",
+				name:  "block_rq_issue",
+				build: func(format *Format, maps programMaps, _ Options) (asm.Instructions, error) {
+					return startProgram(format, maps, fieldsKey("dev", "sector"))
+				},
+			},
+			{
+				group: "block",
+				name:  "block_rq_complete",
+				build: func(format *Format, maps programMaps, opts Options) (asm.Instructions, error) {
+					return endProgram(format, maps, fieldsKey("dev", "sector"), opts.MinLatency.Nanoseconds(), blockPayloadSize, blockSlots)
+				},
+			},
+		},
+		latency:           true,
+		defaultMinLatency: time.Millisecond,
+		payloadSize:       blockPayloadSize,
+		decode: func(payload []byte) string {
+			// kernel-internal dev_t: 12 bits major, 20 bits minor
+			dev := binary.NativeEndian.Uint64(payload[0:])
+
+			details := fmt.Sprintf("dev=%d:%d rwbs=%s sector=%d bytes=%d",
+				dev>>20, dev&(1<<20-1),
+				cString(payload[24:32]),
+				binary.NativeEndian.Uint64(payload[8:]),
+				uint64(binary.NativeEndian.Uint32(payload[16:]))*512,
+			)
+
+			if errno := int32(binary.NativeEndian.Uint32(payload[20:])); errno != 0 {
+				details += " error=" + strconv.Itoa(int(errno))
+			}
+
+			return details
+		},
+	},
+	TCPRetransmit: {
+		programs: []program{
+			{
+				group: "tcp",
+				name:  "tcp_retransmit_skb",
+				build: func(format *Format, maps programMaps, _ Options) (asm.Instructions, error) {
+					return eventProgram(format, maps, tcpPayloadSize, tcpSlots)
+				},
+			},
+		},
+		payloadSize: tcpPayloadSize,
+		decode: func(payload []byte) string {
+			state := binary.NativeEndian.Uint32(payload[0:])
+			family := binary.NativeEndian.Uint16(payload[4:])
+			sport := binary.NativeEndian.Uint16(payload[6:])
+			dport := binary.NativeEndian.Uint16(payload[8:])
+
+			var saddr, daddr netip.Addr
+
+			if family == unix.AF_INET {
+				saddr = netip.AddrFrom4([4]byte(payload[12:16]))
+				daddr = netip.AddrFrom4([4]byte(payload[16:20]))
+			} else {
+				saddr = netip.AddrFrom16([16]byte(payload[20:36])).Unmap()
+				daddr = netip.AddrFrom16([16]byte(payload[36:52])).Unmap()
+			}
+
+			return fmt.Sprintf("%s -> %s state=%s",
+				netip.AddrPortFrom(saddr, sport),
+				netip.AddrPortFrom(daddr, dport),
+				tcpStateName(state),
+			)
+		},
+	},
+}
+
+var tcpStates = []string{
+	"",
+	"ESTABLISHED",
+	"SYN_SENT",
+	"SYN_RECV",
+	"FIN_WAIT1",
+	"FIN_WAIT2",
+	"TIME_WAIT",
+	"CLOSE",
+	"CLOSE_WAIT",
+	"LAST_ACK",
+	"LISTEN",
+	"CLOSING",
+	"NEW_SYN_RECV",
+}
+
+func tcpStateName(state uint32) string {
+	if state > 0 && int(state) < len(tcpStates) {
+		return tcpStates[state]
+	}
+
+	return strconv.Itoa(int(state))
+}
+
+func cString(b []byte) string {
+	if idx := bytes.IndexByte(b, 0); idx != -1 {
+		b = b[:idx]
+	}
+
+	return string(b)
+}
+
+// decodeEvent decodes the event submitted by the program.
+func decodeEvent(spec toolSpec, data []byte) (Event, error) {
+	if len(data) < offPayload+spec.payloadSize {
+		return Event{}, fmt.Errorf("short event: %d bytes", len(data))
+	}
+
+	pidTGID := binary.NativeEndian.Uint64(data[offPIDTGID:])
+
+	return Event{
+		PID:     uint32(pidTGID >> 32),
+		TID:     uint32(pidTGID),
+		Comm:    cString(data[offComm : offComm+commLen]),
+		Latency: time.Duration(binary.NativeEndian.Uint64(data[offLatency:])),
+		Details: spec.decode(data[offPayload : offPayload+spec.payloadSize]),
+	}, nil
+}
@@ -0,0 +1,125 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package tracing
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// EventsPath is the path to the tracepoint definitions.
+const EventsPath = "/sys/kernel/tracing/events"
+
+// Format describes the layout of the tracepoint record.
+type Format struct {
+	Name   string
+	Fields map[string]Field
+}
+
+// Field is a field of the tracepoint record.
+type Field struct {
+	Offset int
+	Size   int
+	// DataLoc is set for the dynamic fields (__data_loc): the field holds
+	// the location of the data in the record (offset in the lower 16 bits, length in the upper 16 bits).
+	DataLoc bool
+}
+
+// ReadFormat reads the tracepoint record format from tracefs.
+func ReadFormat(group, name string) (*Format, error) {
+	f, err := os.Open(filepath.Join(EventsPath, group, name, "format"))
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close() //nolint:errcheck
+
+	return ParseFormat(f)
+}
+
+// ParseFormat parses the tracepoint record format.
+//
+// Example line:
+//
+//	field:__data_loc char[] filename;	offset:8;	size:4;	signed:1;
+func ParseFormat(r io.Reader) (*Format, error) {
+	format := &Format{
+		Fields: map[string]Field{},
+	}
+
+	scanner := bufio.NewScanner(r)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		if name, ok := strings.CutPrefix(line, "name:"); ok {
+			format.Name = strings.TrimSpace(name)
+
+			continue
+		}
+
+		if !strings.HasPrefix(line, "field:") {
+			continue
+		}
+
+		var (
+			decl  string
+			field Field
+		)
+
+		for _, part := range strings.Split(line, ";") {
+			key, value, ok := strings.Cut(strings.TrimSpace(part), ":")
+			if !ok {
+				continue
+			}
+
+			var err error
+
+			switch key {
+			case "field":
+				decl = value
+			case "offset":
+				field.Offset, err = strconv.Atoi(value)
+			case "size":
+				field.Size, err = strconv.Atoi(value)
+			}
+
+			if err != nil {
+				return nil, fmt.Errorf("error parsing field %q: %w", line, err)
+			}
+		}
+
+		tokens := strings.Fields(decl)
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("error parsing field %q", line)
+		}
+
+		name, _, _ := strings.Cut(tokens[len(tokens)-1], "[")
+		field.DataLoc = tokens[0] == "__data_loc"
+
+		format.Fields[name] = field
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return format, nil
+}
+
+// Field returns the field by name.
+func (f *Format) Field(name string) (Field, error) {
+	field, ok := f.Fields[name]
+	if !ok {
+		return Field{}, fmt.Errorf("field %q not found in tracepoint %q", name, f.Name)
+	}
+
+	return field, nil
+}
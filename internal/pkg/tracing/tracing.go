@@ -0,0 +1,192 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package tracing implements a bounded set of eBPF-based tracing tools.
+//
+// The BPF programs are assembled at runtime and attached to the stable kernel tracepoints,
+// the tracepoint record layout is read from tracefs, so the programs don't depend on the kernel build.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/cilium/ebpf"
+	"github.com/cilium/ebpf/link"
+	"github.com/cilium/ebpf/ringbuf"
+	"github.com/cilium/ebpf/rlimit"
+)
+
+// ringBufferSize is the size of the events ring buffer, events are dropped when it's full.
+const ringBufferSize = 1 << 20
+
+// maxInFlight is the maximum number of tracked in-flight operations for the latency tools.
+const maxInFlight = 16384
+
+// ErrUnsupportedTool is returned for unknown tools.
+var ErrUnsupportedTool = errors.New("unsupported tracing tool")
+
+// Options configures the tracing tool.
+type Options struct {
+	// MinLatency is the latency threshold for the latency tools (zero means the tool default).
+	MinLatency time.Duration
+}
+
+// Event is a traced event.
+type Event struct {
+	Timestamp time.Time
+	PID       uint32
+	TID       uint32
+	Comm      string
+	// Latency is set for the latency tools.
+	Latency time.Duration
+	// Details of the event, depending on the tool.
+	Details string
+	// Lost is the number of events dropped so far because the ring buffer was full.
+	Lost uint64
+}
+
+// DefaultMinLatency returns the default latency threshold for the tool (zero for non-latency tools).
+func DefaultMinLatency(tool Tool) time.Duration {
+	return toolSpecs[tool].defaultMinLatency
+}
+
+// Run the tracing tool until the context is canceled, calling the handler for each event.
+//
+//nolint:gocyclo,cyclop
+func Run(ctx context.Context, tool Tool, opts Options, handler func(Event) error) error {
+	spec, ok := toolSpecs[tool]
+	if !ok {
+		return fmt.Errorf("%w %q", ErrUnsupportedTool, tool)
+	}
+
+	if opts.MinLatency == 0 {
+		opts.MinLatency = spec.defaultMinLatency
+	}
+
+	if err := rlimit.RemoveMemlock(); err != nil {
+		return fmt.Errorf("error removing memlock limit: %w", err)
+	}
+
+	events, err := ebpf.NewMap(&ebpf.MapSpec{
+		Name:       "talos_events",
+		Type:       ebpf.RingBuf,
+		MaxEntries: ringBufferSize,
+	})
+	if err != nil {
+		return fmt.Errorf("error creating ring buffer: %w", err)
+	}
+
+	defer events.Close() //nolint:errcheck
+
+	lost, err := ebpf.NewMap(&ebpf.MapSpec{
+		Name:       "talos_lost",
+		Type:       ebpf.Array,
+		KeySize:    4,
+		ValueSize:  8,
+		MaxEntries: 1,
+	})
+	if err != nil {
+		return fmt.Errorf("error creating map: %w", err)
+	}
+
+	defer lost.Close() //nolint:errcheck
+
+	maps := programMaps{
+		events: events.FD(),
+		lost:   lost.FD(),
+	}
+
+	if spec.latency {
+		var start *ebpf.Map
+
+		start, err = ebpf.NewMap(&ebpf.MapSpec{
+			Name:       "talos_start",
+			Type:       ebpf.Hash,
+			KeySize:    startKeySize,
+			ValueSize:  8,
+			MaxEntries: maxInFlight,
+		})
+		if err != nil {
+			return fmt.Errorf("error creating map: %w", err)
+		}
+
+		defer start.Close() //nolint:errcheck
+
+		maps.start = start.FD()
+	}
+
+	for _, p := range spec.programs {
+		format, err := ReadFormat(p.group, p.name)
+		if err != nil {
+			return fmt.Errorf("error reading tracepoint %s:%s format: %w", p.group, p.name, err)
+		}
+
+		insns, err := p.build(format, maps, opts)
+		if err != nil {
+			return fmt.Errorf("error building program for tracepoint %s:%s: %w", p.group, p.name, err)
+		}
+
+		prog, err := ebpf.NewProgram(&ebpf.ProgramSpec{
+			Name:         "talos_" + p.name,
+			Type:         ebpf.TracePoint,
+			Instructions: insns,
+			License:      "GPL",
+		})
+		if err != nil {
+			return fmt.Errorf("error loading program for tracepoint %s:%s: %w", p.group, p.name, err)
+		}
+
+		defer prog.Close() //nolint:errcheck
+
+		tp, err := link.Tracepoint(p.group, p.name, prog, nil)
+		if err != nil {
+			return fmt.Errorf("error attaching to tracepoint %s:%s: %w", p.group, p.name, err)
+		}
+
+		defer tp.Close() //nolint:errcheck
+	}
+
+	reader, err := ringbuf.NewReader(events)
+	if err != nil {
+		return fmt.Errorf("error creating ring buffer reader: %w", err)
+	}
+
+	defer reader.Close() //nolint:errcheck
+
+	for {
+		reader.SetDeadline(time.Now().Add(time.Second))
+
+		record, err := reader.Read()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil //nolint:nilerr
+			}
+
+			if errors.Is(err, os.ErrDeadlineExceeded) {
+				continue
+			}
+
+			return fmt.Errorf("error reading events: %w", err)
+		}
+
+		event, err := decodeEvent(spec, record.RawSample)
+		if err != nil {
+			return err
+		}
+
+		event.Timestamp = time.Now()
+
+		if err = lost.Lookup(uint32(0), &event.Lost); err != nil {
+			return fmt.Errorf("error reading lost events counter: %w", err)
+		}
+
+		if err = handler(event); err != nil {
+			return err
+		}
+	}
+}
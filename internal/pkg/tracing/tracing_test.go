@@ -0,0 +1,158 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package tracing
+
+import (
+	"encoding/binary"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func readTestFormat(t *testing.T, name string) *Format {
+	t.Helper()
+
+	f, err := os.Open(filepath.Join("testdata", name+".format"))
+	require.NoError(t, err)
+
+	t.Cleanup(func() { f.Close() }) //nolint:errcheck
+
+	format, err := ParseFormat(f)
+	require.NoError(t, err)
+
+	return format
+}
+
+func TestParseFormat(t *testing.T) {
+	format := readTestFormat(t, "sched_process_exec")
+
+	assert.Equal(t, "sched_process_exec", format.Name)
+	assert.Equal(t, Field{Offset: 8, Size: 4, DataLoc: true}, format.Fields["filename"])
+	assert.Equal(t, Field{Offset: 12, Size: 4}, format.Fields["pid"])
+
+	format = readTestFormat(t, "tcp_retransmit_skb")
+
+	assert.Equal(t, Field{Offset: 42, Size: 16}, format.Fields["saddr_v6"])
+	assert.Equal(t, Field{Offset: 16, Size: 8}, format.Fields["skaddr"])
+
+	_, err := format.Field("nonexistent")
+	assert.EqualError(t, err, `field "nonexistent" not found in tracepoint "tcp_retransmit_skb"`)
+}
+
+func TestBuildPrograms(t *testing.T) {
+	maps := programMaps{
+		events: 3,
+		lost:   4,
+		start:  5,
+	}
+
+	for _, tool := range Tools {
+		t.Run(string(tool), func(t *testing.T) {
+			for _, p := range toolSpecs[tool].programs {
+				insns, err := p.build(readTestFormat(t, p.name), maps, Options{MinLatency: time.Millisecond})
+				require.NoError(t, err)
+
+				// marshaling resolves the jumps
+				require.NoError(t, insns.Marshal(io.Discard, binary.LittleEndian))
+			}
+		})
+	}
+}
+
+func TestDecodeEvent(t *testing.T) {
+	newEvent := func(tool Tool, latency time.Duration, payload []byte) []byte {
+		data := make([]byte, (offPayload+toolSpecs[tool].payloadSize+7)&^7)
+
+		binary.NativeEndian.PutUint64(data[offLatency:], uint64(latency))
+		binary.NativeEndian.PutUint64(data[offPIDTGID:], 1234<<32|1235)
+		copy(data[offComm:], "kubelet")
+		copy(data[offPayload:], payload)
+
+		return data
+	}
+
+	for _, test := range []struct {
+		tool    Tool
+		latency time.Duration
+		payload func() []byte
+
+		expectedDetails string
+	}{
+		{
+			tool: Exec,
+			payload: func() []byte {
+				return []byte("/usr/local/bin/kubelet\x00")
+			},
+
+			expectedDetails: "/usr/local/bin/kubelet",
+		},
+		{
+			tool:    SyscallLatency,
+			latency: 15 * time.Millisecond,
+			payload: func() []byte {
+				payload := make([]byte, syscallPayloadSize)
+
+				binary.NativeEndian.PutUint64(payload[0:], 7)
+				binary.NativeEndian.PutUint64(payload[8:], uint64(0xfffffffffffffffc)) // -EINTR
+
+				return payload
+			},
+
+			expectedDetails: "syscall=7 ret=-4",
+		},
+		{
+			tool:    BlockIOLatency,
+			latency: 20 * time.Millisecond,
+			payload: func() []byte {
+				payload := make([]byte, blockPayloadSize)
+
+				binary.NativeEndian.PutUint64(payload[0:], 259<<20|1)
+				binary.NativeEndian.PutUint64(payload[8:], 2048)
+				binary.NativeEndian.PutUint32(payload[16:], 8)
+				copy(payload[24:], "WS")
+
+				return payload
+			},
+
+			expectedDetails: "dev=259:1 rwbs=WS sector=2048 bytes=4096",
+		},
+		{
+			tool: TCPRetransmit,
+			payload: func() []byte {
+				payload := make([]byte, tcpPayloadSize)
+
+				binary.NativeEndian.PutUint32(payload[0:], 1)
+				binary.NativeEndian.PutUint16(payload[4:], 2) // AF_INET
+				binary.NativeEndian.PutUint16(payload[6:], 50000)
+				binary.NativeEndian.PutUint16(payload[8:], 6443)
+				copy(payload[12:], []byte{10, 5, 0, 2})
+				copy(payload[16:], []byte{10, 5, 0, 3})
+
+				return payload
+			},
+
+			expectedDetails: "10.5.0.2:50000 -> 10.5.0.3:6443 state=ESTABLISHED",
+		},
+	} {
+		t.Run(string(test.tool), func(t *testing.T) {
+			event, err := decodeEvent(toolSpecs[test.tool], newEvent(test.tool, test.latency, test.payload()))
+			require.NoError(t, err)
+
+			assert.Equal(t, uint32(1234), event.PID)
+			assert.Equal(t, uint32(1235), event.TID)
+			assert.Equal(t, "kubelet", event.Comm)
+			assert.Equal(t, test.latency, event.Latency)
+			assert.Equal(t, test.expectedDetails, event.Details)
+		})
+	}
+
+	_, err := decodeEvent(toolSpecs[Exec], make([]byte, 16))
+	assert.EqualError(t, err, "short event: 16 bytes")
+}
@@ -0,0 +1,193 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package userfiles implements provisioning of the files defined in the machine config.
+package userfiles
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/siderolabs/talos/pkg/download"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/machine"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/resources/network"
+)
+
+// TemplateData is the node metadata available in the file templates.
+type TemplateData struct {
+	Hostname    string
+	Domainname  string
+	IP          string
+	IPs         []string
+	MachineType string
+}
+
+// NewTemplateData builds the template data from the node resources.
+//
+// Any of the resources might be nil if it is not available yet.
+func NewTemplateData(machineType machine.Type, hostname *network.HostnameStatus, defaultAddress, routedAddresses *network.NodeAddress) TemplateData {
+	data := TemplateData{
+		MachineType: machineType.String(),
+	}
+
+	if hostname != nil {
+		data.Hostname = hostname.TypedSpec().Hostname
+		data.Domainname = hostname.TypedSpec().Domainname
+	}
+
+	if defaultAddress != nil && len(defaultAddress.TypedSpec().Addresses) > 0 {
+		data.IP = defaultAddress.TypedSpec().Addresses[0].Addr().String()
+	}
+
+	if routedAddresses != nil {
+		for _, addr := range routedAddresses.TypedSpec().Addresses {
+			data.IPs = append(data.IPs, addr.Addr().String())
+		}
+	}
+
+	return data
+}
+
+// CanCreate returns true if the new files can be created at the path.
+//
+// Files can be created under /var and in the overlay mounts (e.g. /etc/cni),
+// all other paths are read-only.
+func CanCreate(path string) bool {
+	path = filepath.Clean(path)
+
+	for _, dir := range append([]string{"/var"}, constants.Overlays...) {
+		if strings.HasPrefix(path, dir+string(os.PathSeparator)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Content returns the contents of the file.
+//
+// The contents are downloaded from the file source, unless the existing file at the path matches the checksum,
+// and rendered as a template if the file is templated.
+func Content(ctx context.Context, path string, f config.File, data TemplateData) ([]byte, error) {
+	if f.Type() == config.FileTypeDirectory {
+		return nil, nil
+	}
+
+	content := []byte(f.Content())
+
+	if source := f.Source(); source != nil {
+		if !f.Template() {
+			if existing, err := os.ReadFile(path); err == nil && verifyChecksum(existing, source.Checksum()) == nil {
+				return existing, nil
+			}
+		}
+
+		var err error
+
+		content, err = download.Download(ctx, source.URL())
+		if err != nil {
+			return nil, fmt.Errorf("error downloading %q: %w", source.URL(), err)
+		}
+
+		if err = verifyChecksum(content, source.Checksum()); err != nil {
+			return nil, fmt.Errorf("error verifying %q: %w", source.URL(), err)
+		}
+	}
+
+	if !f.Template() {
+		return content, nil
+	}
+
+	tmpl, err := template.New(f.Path()).Option("missingkey=error").Parse(string(content))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %w", err)
+	}
+
+	var buf bytes.Buffer
+
+	if err = tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("error rendering template: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// Write writes the file or creates the directory at the path with the permissions and the ownership of the file.
+//
+// The file is written only if the contents differ, Write returns true if the contents were changed.
+func Write(path string, f config.File, content []byte) (bool, error) {
+	changed := false
+
+	if f.Type() == config.FileTypeDirectory {
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			changed = true
+		}
+
+		if err := os.MkdirAll(path, f.Permissions()); err != nil {
+			return false, err
+		}
+	} else {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			return false, err
+		}
+
+		existing, err := os.ReadFile(path)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return false, err
+		}
+
+		if err != nil || !bytes.Equal(existing, content) {
+			if err = os.WriteFile(path, content, f.Permissions()); err != nil {
+				return false, err
+			}
+
+			changed = true
+		}
+	}
+
+	if err := os.Chmod(path, f.Permissions()); err != nil {
+		return false, err
+	}
+
+	if err := os.Chown(path, f.UID(), f.GID()); err != nil {
+		return false, err
+	}
+
+	return changed, nil
+}
+
+func verifyChecksum(content []byte, checksum string) error {
+	algorithm, expected, _ := strings.Cut(checksum, ":")
+
+	var h hash.Hash
+
+	switch algorithm {
+	case "sha256":
+		h = sha256.New()
+	case "sha512":
+		h = sha512.New()
+	default:
+		return fmt.Errorf("unsupported checksum algorithm %q", algorithm)
+	}
+
+	h.Write(content)
+
+	if actual := hex.EncodeToString(h.Sum(nil)); actual != expected {
+		return fmt.Errorf("checksum mismatch: expected %q, actual %q", expected, actual)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package userfiles_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/userfiles"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/v1alpha1"
+)
+
+func TestCanCreate(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, userfiles.CanCreate("/var/lib/agent/config.yaml"))
+	assert.True(t, userfiles.CanCreate("/etc/cni/net.d/10-custom.conflist"))
+	assert.False(t, userfiles.CanCreate("/etc/hosts"))
+	assert.False(t, userfiles.CanCreate("/var"))
+	assert.False(t, userfiles.CanCreate("/var/../etc/hosts"))
+}
+
+func TestContentTemplate(t *testing.T) {
+	t.Parallel()
+
+	f := &v1alpha1.MachineFile{
+		FileContent:  "{{ .Hostname }}.{{ .Domainname }} {{ .IP }} {{ range .IPs }}[{{ . }}]{{ end }} {{ .MachineType }}",
+		FilePath:     "/var/lib/agent/config",
+		FileTemplate: true,
+	}
+
+	content, err := userfiles.Content(context.Background(), f.FilePath, f, userfiles.TemplateData{
+		Hostname:    "node-1",
+		Domainname:  "example.com",
+		IP:          "10.5.0.2",
+		IPs:         []string{"10.5.0.2", "fd00::2"},
+		MachineType: "worker",
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, "node-1.example.com 10.5.0.2 [10.5.0.2][fd00::2] worker", string(content))
+
+	f.FileContent = "{{ .Unknown }}"
+
+	_, err = userfiles.Content(context.Background(), f.FilePath, f, userfiles.TemplateData{})
+	require.Error(t, err)
+}
+
+func TestContentSource(t *testing.T) {
+	t.Parallel()
+
+	const license = "licensed to node-1\n"
+
+	var requests atomic.Int64
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests.Add(1)
+
+		w.Write([]byte(license)) //nolint:errcheck
+	}))
+	t.Cleanup(srv.Close)
+
+	checksum := sha256.Sum256([]byte(license))
+
+	path := filepath.Join(t.TempDir(), "license.txt")
+
+	f := &v1alpha1.MachineFile{
+		FilePath: path,
+		FileSource: &v1alpha1.MachineFileSource{
+			SourceURL:      srv.URL,
+			SourceChecksum: "sha256:" + hex.EncodeToString(checksum[:]),
+		},
+	}
+
+	content, err := userfiles.Content(context.Background(), path, f, userfiles.TemplateData{})
+	require.NoError(t, err)
+	assert.Equal(t, license, string(content))
+	assert.EqualValues(t, 1, requests.Load())
+
+	// the file is not downloaded again if the existing contents match
+	require.NoError(t, os.WriteFile(path, content, 0o644))
+
+	_, err = userfiles.Content(context.Background(), path, f, userfiles.TemplateData{})
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, requests.Load())
+
+	f.FileSource.SourceChecksum = "sha256:" + hex.EncodeToString(make([]byte, sha256.Size))
+
+	_, err = userfiles.Content(context.Background(), path, f, userfiles.TemplateData{})
+	require.ErrorContains(t, err, "checksum mismatch")
+}
+
+func TestWrite(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "agent")
+
+	changed, err := userfiles.Write(dir, &v1alpha1.MachineFile{
+		FilePath:        dir,
+		FilePermissions: 0o750,
+		FileType:        "directory",
+		FileUID:         os.Getuid(),
+		FileGID:         os.Getgid(),
+	}, nil)
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	st, err := os.Stat(dir)
+	require.NoError(t, err)
+	assert.True(t, st.IsDir())
+	assert.Equal(t, os.FileMode(0o750), st.Mode().Perm())
+
+	path := filepath.Join(dir, "config.yaml")
+	f := &v1alpha1.MachineFile{
+		FilePath:        path,
+		FilePermissions: 0o600,
+		FileUID:         os.Getuid(),
+		FileGID:         os.Getgid(),
+	}
+
+	changed, err = userfiles.Write(path, f, []byte("foo"))
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	changed, err = userfiles.Write(path, f, []byte("foo"))
+	require.NoError(t, err)
+	assert.False(t, changed)
+
+	changed, err = userfiles.Write(path, f, []byte("bar"))
+	require.NoError(t, err)
+	assert.True(t, changed)
+
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, "bar", string(content))
+
+	st, err = os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), st.Mode().Perm())
+}
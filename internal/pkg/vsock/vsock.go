@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package vsock implements the stream listener on the VM sockets (AF_VSOCK).
+//
+// VM sockets connect the virtual machine with the hypervisor without the network,
+// the peers are identified by the context ID (CID) and the port.
+package vsock
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/mdlayher/socket"
+	"golang.org/x/sys/unix"
+)
+
+// Network is the name of the VM sockets network.
+const Network = "vsock"
+
+// Addr is the address of the VM socket.
+type Addr struct {
+	ContextID uint32
+	Port      uint32
+}
+
+// Network implements net.Addr interface.
+func (a *Addr) Network() string {
+	return Network
+}
+
+// String implements net.Addr interface.
+func (a *Addr) String() string {
+	return fmt.Sprintf("vm(%d):%d", a.ContextID, a.Port)
+}
+
+func addrFromSockaddr(sa unix.Sockaddr) *Addr {
+	vm, ok := sa.(*unix.SockaddrVM)
+	if !ok {
+		return &Addr{}
+	}
+
+	return &Addr{ContextID: vm.CID, Port: vm.Port}
+}
+
+// Listen listens for the connections on the port from any context ID.
+func Listen(port uint32) (net.Listener, error) {
+	c, err := socket.Socket(unix.AF_VSOCK, unix.SOCK_STREAM, 0, Network, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.Bind(&unix.SockaddrVM{CID: unix.VMADDR_CID_ANY, Port: port}); err != nil {
+		c.Close() //nolint:errcheck
+
+		return nil, err
+	}
+
+	if err = c.Listen(unix.SOMAXCONN); err != nil {
+		c.Close() //nolint:errcheck
+
+		return nil, err
+	}
+
+	sa, err := c.Getsockname()
+	if err != nil {
+		c.Close() //nolint:errcheck
+
+		return nil, err
+	}
+
+	return &listener{c: c, addr: addrFromSockaddr(sa)}, nil
+}
+
+type listener struct {
+	c    *socket.Conn
+	addr *Addr
+}
+
+// Accept implements net.Listener interface.
+func (l *listener) Accept() (net.Conn, error) {
+	c, sa, err := l.c.Accept(context.Background(), 0)
+	if err != nil {
+		return nil, &net.OpError{Op: "accept", Net: Network, Addr: l.addr, Err: err}
+	}
+
+	return &conn{Conn: c, local: l.addr, remote: addrFromSockaddr(sa)}, nil
+}
+
+// Close implements net.Listener interface.
+func (l *listener) Close() error {
+	return l.c.Close()
+}
+
+// Addr implements net.Listener interface.
+func (l *listener) Addr() net.Addr {
+	return l.addr
+}
+
+// conn is the accepted connection on the VM socket.
+//
+// socket.Conn provides the I/O and the deadlines, conn adds the addresses to implement net.Conn.
+type conn struct {
+	*socket.Conn
+
+	local, remote *Addr
+}
+
+// LocalAddr implements net.Conn interface.
+func (c *conn) LocalAddr() net.Addr {
+	return c.local
+}
+
+// RemoteAddr implements net.Conn interface.
+func (c *conn) RemoteAddr() net.Addr {
+	return c.remote
+}
@@ -0,0 +1,212 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package webhook implements the webhook notifications about the critical machine events.
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/siderolabs/go-retry/retry"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+// Signature headers.
+//
+// The signature is the HMAC-SHA256 of the timestamp and the request body joined with '.',
+// so that the receiver can reject the replayed requests by checking the timestamp.
+const (
+	// SignatureHeader carries the signature as 'sha256=<hex digest>'.
+	SignatureHeader = "X-Talos-Signature"
+	// TimestampHeader carries the time the request was sent at as Unix seconds.
+	TimestampHeader = "X-Talos-Timestamp"
+)
+
+// SignatureTolerance is the recommended maximum difference between the signed timestamp and the receiver clock.
+//
+// Each retry attempt is signed with a new timestamp, so the tolerance doesn't need to cover the retries.
+const SignatureTolerance = 5 * time.Minute
+
+// Default retry settings.
+const (
+	DefaultRetryTimeout  = 5 * time.Minute
+	DefaultRetryInterval = time.Second
+)
+
+// maxResponseSize limits the size of the response body included into the error message.
+const maxResponseSize = 512
+
+// Notification describes the critical machine event.
+//
+// Notification is sent as JSON in the body of the POST request with the 'json' format.
+type Notification struct {
+	// Webhook is the name of the webhook document.
+	Webhook string `json:"webhook"`
+	// Event is the notification event, e.g. upgrade-failed.
+	Event string `json:"event"`
+	// Hostname is the hostname of the machine.
+	Hostname string `json:"hostname,omitempty"`
+	// Version is the Talos version running on the machine.
+	Version string `json:"version"`
+	// Message describes the event.
+	Message string `json:"message"`
+	// Timestamp is the time of the event.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// slackMessage is the Slack incoming webhook message.
+type slackMessage struct {
+	Text string `json:"text"`
+}
+
+// Sender delivers the notifications to the webhooks.
+type Sender struct {
+	Client *http.Client
+
+	// RetryTimeout defaults to DefaultRetryTimeout.
+	RetryTimeout time.Duration
+	// RetryInterval defaults to DefaultRetryInterval.
+	RetryInterval time.Duration
+}
+
+// Send delivers the notification to the webhook retrying on the network errors, 5xx and 429 responses.
+func (s *Sender) Send(ctx context.Context, webhook config.WebhookNotificationConfig, notification Notification) error {
+	notification.Webhook = webhook.Name()
+
+	body, err := Body(webhook.Format(), notification)
+	if err != nil {
+		return err
+	}
+
+	retryTimeout := s.RetryTimeout
+	if retryTimeout == 0 {
+		retryTimeout = DefaultRetryTimeout
+	}
+
+	retryInterval := s.RetryInterval
+	if retryInterval == 0 {
+		retryInterval = DefaultRetryInterval
+	}
+
+	return retry.Exponential(retryTimeout, retry.WithUnits(retryInterval), retry.WithJitter(retryInterval)).RetryWithContext(ctx, func(ctx context.Context) error {
+		return s.send(ctx, webhook, body)
+	})
+}
+
+func (s *Sender) send(ctx context.Context, webhook config.WebhookNotificationConfig, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, webhook.Timeout())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.URL().String(), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	for name, value := range webhook.Headers() {
+		req.Header.Set(name, value)
+	}
+
+	if key := webhook.SigningKey(); key != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+		req.Header.Set(TimestampHeader, timestamp)
+		req.Header.Set(SignatureHeader, Sign(key, timestamp, body))
+	}
+
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			return err
+		}
+
+		return retry.ExpectedError(err)
+	}
+
+	defer resp.Body.Close() //nolint:errcheck
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize)) //nolint:errcheck
+
+	err = fmt.Errorf("unexpected status %d", resp.StatusCode)
+
+	if len(bytes.TrimSpace(msg)) > 0 {
+		err = fmt.Errorf("unexpected status %d: %s", resp.StatusCode, bytes.TrimSpace(msg))
+	}
+
+	if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests {
+		return retry.ExpectedError(err)
+	}
+
+	return err
+}
+
+// Body builds the request body for the notification in the specified format.
+func Body(format string, notification Notification) ([]byte, error) {
+	switch format {
+	case config.WebhookFormatJSON:
+		return json.Marshal(notification)
+	case config.WebhookFormatSlack:
+		text := fmt.Sprintf("*%s*: %s", notification.Event, notification.Message)
+
+		if notification.Hostname != "" {
+			text = fmt.Sprintf("*%s* on `%s`: %s", notification.Event, notification.Hostname, notification.Message)
+		}
+
+		return json.Marshal(slackMessage{Text: text})
+	default:
+		return nil, fmt.Errorf("unsupported webhook format %q", format)
+	}
+}
+
+// Sign returns the signature of the timestamp and the body for the SignatureHeader.
+func Sign(key, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(key))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks the signature of the request as the receiver should do it.
+//
+// The timestamp should be within SignatureTolerance of now.
+func Verify(key, timestamp, signature string, body []byte, now time.Time) error {
+	if !hmac.Equal([]byte(Sign(key, timestamp, body)), []byte(signature)) {
+		return errors.New("signature mismatch")
+	}
+
+	sec, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid timestamp %q", timestamp)
+	}
+
+	if diff := now.Sub(time.Unix(sec, 0)); diff > SignatureTolerance || diff < -SignatureTolerance {
+		return fmt.Errorf("timestamp %q is outside of the tolerance window", timestamp)
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/gen/ensure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/internal/pkg/webhook"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+)
+
+func newWebhook(t *testing.T, u string) *runtime.WebhookNotificationV1Alpha1 {
+	t.Helper()
+
+	cfg := runtime.NewWebhookNotificationV1Alpha1()
+	cfg.MetaName = "ops"
+	cfg.WebhookURL.URL = ensure.Value(url.Parse(u))
+	cfg.WebhookHeaders = map[string]string{"Authorization": "Bearer token"}
+	cfg.WebhookSigningKey = "secret"
+
+	return cfg
+}
+
+func newSender() *webhook.Sender {
+	return &webhook.Sender{
+		RetryTimeout:  5 * time.Second,
+		RetryInterval: 10 * time.Millisecond,
+	}
+}
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+
+	received := make(chan webhook.Notification, 1)
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if attempts.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		body, err := io.ReadAll(r.Body)
+		assert.NoError(t, err)
+
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+		assert.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		assert.NoError(t, webhook.Verify("secret", r.Header.Get(webhook.TimestampHeader), r.Header.Get(webhook.SignatureHeader), body, time.Now()))
+
+		var notification webhook.Notification
+
+		assert.NoError(t, json.Unmarshal(body, &notification))
+
+		received <- notification
+
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	require.NoError(t, newSender().Send(context.Background(), newWebhook(t, srv.URL), webhook.Notification{
+		Event:    config.WebhookEventUpgradeFailed,
+		Hostname: "node-1",
+		Message:  "sequence failed",
+	}))
+
+	assert.EqualValues(t, 3, attempts.Load())
+
+	notification := <-received
+	assert.Equal(t, "ops", notification.Webhook)
+	assert.Equal(t, config.WebhookEventUpgradeFailed, notification.Event)
+	assert.Equal(t, "node-1", notification.Hostname)
+	assert.Equal(t, "sequence failed", notification.Message)
+}
+
+func TestSendPermanentFailure(t *testing.T) {
+	t.Parallel()
+
+	var attempts atomic.Int32
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts.Add(1)
+
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte("invalid payload\n")) //nolint:errcheck
+	}))
+	t.Cleanup(srv.Close)
+
+	err := newSender().Send(context.Background(), newWebhook(t, srv.URL), webhook.Notification{Event: config.WebhookEventBootFallback})
+	require.ErrorContains(t, err, "unexpected status 400: invalid payload")
+
+	assert.EqualValues(t, 1, attempts.Load())
+}
+
+func TestBody(t *testing.T) {
+	t.Parallel()
+
+	notification := webhook.Notification{
+		Webhook:  "ops",
+		Event:    config.WebhookEventDiskFailure,
+		Hostname: "node-1",
+		Version:  "v1.9.0",
+		Message:  "I/O error, dev sda, sector 2048",
+	}
+
+	body, err := webhook.Body(config.WebhookFormatSlack, notification)
+	require.NoError(t, err)
+
+	assert.JSONEq(t, `{"text":"*disk-failure* on `+"`node-1`"+`: I/O error, dev sda, sector 2048"}`, string(body))
+
+	body, err = webhook.Body(config.WebhookFormatJSON, notification)
+	require.NoError(t, err)
+
+	assert.JSONEq(t,
+		`{"webhook":"ops","event":"disk-failure","hostname":"node-1","version":"v1.9.0","message":"I/O error, dev sda, sector 2048","timestamp":"0001-01-01T00:00:00Z"}`,
+		string(body),
+	)
+
+	_, err = webhook.Body("xml", notification)
+	require.EqualError(t, err, "unsupported webhook format \"xml\"")
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "sha256=4d583a269f4f276a3fa80ff31b5a01879a848096983222a17893d198418939aa", webhook.Sign("key", "1700000000", []byte("hello")))
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	now := time.Unix(1700000000, 0)
+	signature := webhook.Sign("key", "1700000000", []byte("hello"))
+
+	require.NoError(t, webhook.Verify("key", "1700000000", signature, []byte("hello"), now))
+	require.NoError(t, webhook.Verify("key", "1700000000", signature, []byte("hello"), now.Add(webhook.SignatureTolerance)))
+
+	require.EqualError(t, webhook.Verify("key", "1700000000", signature, []byte("hello"), now.Add(webhook.SignatureTolerance+time.Second)),
+		"timestamp \"1700000000\" is outside of the tolerance window")
+	require.EqualError(t, webhook.Verify("key", "1700000001", signature, []byte("hello"), now), "signature mismatch")
+	require.EqualError(t, webhook.Verify("other", "1700000000", signature, []byte("hello"), now), "signature mismatch")
+	require.EqualError(t, webhook.Verify("key", "1700000000", signature, []byte("hello!"), now), "signature mismatch")
+}
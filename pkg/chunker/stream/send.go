@@ -0,0 +1,70 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package stream
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// DefaultSendSize is the default chunk size for Send.
+const DefaultSendSize = 64 * 1024
+
+// Send reads the source in chunks and calls send for each chunk.
+//
+// Unlike the Chunker, Send doesn't read ahead: the next chunk is read only after the previous one is sent,
+// so the memory usage is bounded by the chunk size, and the flow control of the send function (e.g. gRPC stream)
+// is propagated to the source.
+// Each chunk is a new slice, so send might retain it.
+//
+// Send returns nil once the source returns io.EOF, any other error of reading the source (including io.ErrUnexpectedEOF)
+// or sending the chunk is returned as is.
+func Send(ctx context.Context, source io.Reader, size int, send func([]byte) error) error {
+	if size <= 0 {
+		size = DefaultSendSize
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		buf := make([]byte, size)
+
+		n, err := readChunk(source, buf)
+		if n > 0 {
+			if sendErr := send(buf[:n]); sendErr != nil {
+				return sendErr
+			}
+		}
+
+		switch {
+		case errors.Is(err, io.EOF):
+			return nil
+		case err != nil:
+			return err
+		}
+	}
+}
+
+// readChunk reads from the source until buf is full.
+//
+// Unlike io.ReadFull, a short read at the end of the source is reported as io.EOF,
+// so that it can't be confused with io.ErrUnexpectedEOF returned by the source itself.
+func readChunk(source io.Reader, buf []byte) (int, error) {
+	var n int
+
+	for n < len(buf) {
+		nn, err := source.Read(buf[n:])
+		n += nn
+
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
@@ -0,0 +1,79 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package stream_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"testing/iotest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/chunker/stream"
+)
+
+func TestSend(t *testing.T) {
+	t.Parallel()
+
+	data := bytes.Repeat([]byte("0123456789"), 1000)
+
+	var (
+		received []byte
+		chunks   int
+	)
+
+	require.NoError(t, stream.Send(context.Background(), iotest.HalfReader(bytes.NewReader(data)), 1024, func(chunk []byte) error {
+		assert.LessOrEqual(t, len(chunk), 1024)
+
+		received = append(received, chunk...)
+		chunks++
+
+		return nil
+	}))
+
+	assert.Equal(t, data, received)
+	assert.Equal(t, 10, chunks)
+}
+
+func TestSendErrors(t *testing.T) {
+	t.Parallel()
+
+	sendErr := errors.New("send failed")
+
+	calls := 0
+
+	err := stream.Send(context.Background(), bytes.NewReader(make([]byte, 4096)), 1024, func([]byte) error {
+		calls++
+
+		return sendErr
+	})
+	assert.ErrorIs(t, err, sendErr)
+	assert.Equal(t, 1, calls)
+
+	readErr := errors.New("read failed")
+
+	err = stream.Send(context.Background(), io.MultiReader(bytes.NewReader([]byte("abc")), iotest.ErrReader(readErr)), 1024, func(chunk []byte) error {
+		assert.Equal(t, []byte("abc"), chunk)
+
+		return nil
+	})
+	assert.ErrorIs(t, err, readErr)
+
+	// truncated source (e.g. compressed stream) is not a clean completion
+	err = stream.Send(context.Background(), io.MultiReader(bytes.NewReader([]byte("abc")), iotest.ErrReader(io.ErrUnexpectedEOF)), 1024, func([]byte) error {
+		return nil
+	})
+	assert.ErrorIs(t, err, io.ErrUnexpectedEOF)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	err = stream.Send(ctx, bytes.NewReader([]byte("abc")), 1024, func([]byte) error { return nil })
+	assert.ErrorIs(t, err, context.Canceled)
+}
@@ -0,0 +1,125 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package cis implements CIS Kubernetes Benchmark self-assessment of Talos nodes.
+//
+// The node configuration is gathered over the Talos API, so no privileged pods are required.
+package cis
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/cosi-project/runtime/pkg/safe"
+	"github.com/cosi-project/runtime/pkg/state"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/resources/etcd"
+	"github.com/siderolabs/talos/pkg/machinery/resources/k8s"
+)
+
+// NodeConfig is the configuration of the Kubernetes components running on the node.
+type NodeConfig struct {
+	// KubeletArgs are the kubelet command line arguments.
+	KubeletArgs map[string]string
+	// KubeletConfig is the kubelet configuration (KubeletConfiguration).
+	KubeletConfig map[string]any
+
+	// APIServerArgs, ControllerManagerArgs, SchedulerArgs are the control plane components arguments.
+	//
+	// The arguments are nil on worker nodes.
+	APIServerArgs         map[string]string
+	ControllerManagerArgs map[string]string
+	SchedulerArgs         map[string]string
+
+	// EtcdArgs are the effective etcd arguments, nil if the node is not an etcd member.
+	EtcdArgs map[string]string
+}
+
+// Gather collects the node configuration over the Talos API.
+//
+// The node is selected via the context (see client.WithNode).
+func Gather(ctx context.Context, c *client.Client) (*NodeConfig, error) {
+	var cfg NodeConfig
+
+	kubelet, err := safe.StateGetByID[*k8s.KubeletSpec](ctx, c.COSI, k8s.KubeletID)
+	if err != nil {
+		return nil, fmt.Errorf("error getting kubelet spec: %w", err)
+	}
+
+	cfg.KubeletArgs = ParseArgs(kubelet.TypedSpec().Args)
+	cfg.KubeletConfig = kubelet.TypedSpec().Config
+
+	for _, component := range []struct {
+		id   string
+		args *map[string]string
+	}{
+		{k8s.APIServerID, &cfg.APIServerArgs},
+		{k8s.ControllerManagerID, &cfg.ControllerManagerArgs},
+		{k8s.SchedulerID, &cfg.SchedulerArgs},
+	} {
+		staticPod, err := safe.StateGetByID[*k8s.StaticPod](ctx, c.COSI, component.id)
+		if err != nil {
+			if state.IsNotFoundError(err) {
+				continue
+			}
+
+			return nil, fmt.Errorf("error getting %s static pod: %w", component.id, err)
+		}
+
+		var pod v1.Pod
+
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(staticPod.TypedSpec().Pod, &pod); err != nil {
+			return nil, fmt.Errorf("error decoding %s static pod: %w", component.id, err)
+		}
+
+		for _, container := range pod.Spec.Containers {
+			if container.Name == component.id {
+				*component.args = ParseArgs(container.Command)
+			}
+		}
+	}
+
+	etcdSpec, err := safe.StateGetByID[*etcd.Spec](ctx, c.COSI, etcd.SpecID)
+	if err != nil && !state.IsNotFoundError(err) {
+		return nil, fmt.Errorf("error getting etcd spec: %w", err)
+	}
+
+	if etcdSpec != nil {
+		enforcedArgs := etcd.EnforcedArgs()
+
+		cfg.EtcdArgs = make(map[string]string, len(etcdSpec.TypedSpec().ExtraArgs)+len(enforcedArgs))
+
+		maps.Copy(cfg.EtcdArgs, etcdSpec.TypedSpec().ExtraArgs)
+		maps.Copy(cfg.EtcdArgs, enforcedArgs)
+	}
+
+	return &cfg, nil
+}
+
+// ParseArgs parses command line flags in the '--key=value' form.
+//
+// Positional arguments are ignored, flags without a value are set to "true".
+func ParseArgs(args []string) map[string]string {
+	result := map[string]string{}
+
+	for _, arg := range args {
+		if !strings.HasPrefix(arg, "--") {
+			continue
+		}
+
+		key, value, ok := strings.Cut(strings.TrimPrefix(arg, "--"), "=")
+		if !ok {
+			value = "true"
+		}
+
+		result[key] = value
+	}
+
+	return result
+}
@@ -0,0 +1,140 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cis_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/cluster/cis"
+)
+
+func TestParseArgs(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, map[string]string{
+		"anonymous-auth":     "false",
+		"authorization-mode": "Node,RBAC",
+		"v":                  "2",
+		"allow-privileged":   "true",
+	}, cis.ParseArgs([]string{
+		"/usr/local/bin/kube-apiserver",
+		"--anonymous-auth=false",
+		"--authorization-mode=Node,RBAC",
+		"--v=1",
+		"--v=2",
+		"--allow-privileged",
+	}))
+}
+
+func failed(results []cis.Result) []string {
+	var ids []string
+
+	for _, result := range results {
+		if !result.Pass {
+			ids = append(ids, result.ID)
+		}
+	}
+
+	return ids
+}
+
+func TestEvaluateWorker(t *testing.T) {
+	t.Parallel()
+
+	results := cis.Evaluate(&cis.NodeConfig{
+		KubeletArgs: cis.ParseArgs([]string{"--config=/etc/kubernetes/kubelet.yaml", "--rotate-server-certificates=true"}),
+		KubeletConfig: map[string]any{
+			"authentication": map[string]any{
+				"anonymous": map[string]any{
+					"enabled": false,
+				},
+				"x509": map[string]any{
+					"clientCAFile": "/etc/kubernetes/pki/ca.crt",
+				},
+			},
+			"authorization": map[string]any{
+				"mode": "Webhook",
+			},
+			"protectKernelDefaults":          true,
+			"streamingConnectionIdleTimeout": "5m",
+			"tlsCipherSuites":                []any{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"},
+			"podPidsLimit":                   int64(4096),
+		},
+	})
+
+	// only kubelet controls are evaluated on the worker
+	for _, result := range results {
+		assert.Regexp(t, `^4\.2\.`, result.ID)
+	}
+
+	assert.Empty(t, failed(results))
+}
+
+func TestEvaluateControlPlane(t *testing.T) {
+	t.Parallel()
+
+	results := cis.Evaluate(&cis.NodeConfig{
+		KubeletArgs: map[string]string{"read-only-port": "10255"},
+		KubeletConfig: map[string]any{
+			"authentication": map[string]any{
+				"anonymous": map[string]any{
+					"enabled": true,
+				},
+			},
+		},
+		APIServerArgs: cis.ParseArgs([]string{
+			"--anonymous-auth=false",
+			"--authorization-mode=Node,RBAC",
+			"--enable-admission-plugins=NodeRestriction",
+			"--profiling=false",
+			"--audit-log-path=/var/log/audit/kube/kube-apiserver.log",
+			"--audit-log-maxage=30",
+			"--audit-log-maxbackup=10",
+			"--audit-log-maxsize=100",
+			"--client-ca-file=ca.crt",
+			"--etcd-cafile=etcd-client-ca.crt",
+			"--etcd-certfile=etcd-client.crt",
+			"--etcd-keyfile=etcd-client.key",
+			"--encryption-provider-config=encryptionconfig.yaml",
+			"--kubelet-client-certificate=apiserver-kubelet-client.crt",
+			"--kubelet-client-key=apiserver-kubelet-client.key",
+			"--service-account-key-file=service-account.pub",
+			"--tls-cert-file=apiserver.crt",
+			"--tls-private-key-file=apiserver.key",
+			"--tls-cipher-suites=TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256",
+		}),
+		ControllerManagerArgs: cis.ParseArgs([]string{
+			"--profiling=false",
+			"--use-service-account-credentials=true",
+			"--service-account-private-key-file=service-account.key",
+			"--root-ca-file=ca.crt",
+			"--bind-address=127.0.0.1",
+		}),
+		SchedulerArgs: cis.ParseArgs([]string{
+			"--profiling=true",
+			"--bind-address=127.0.0.1",
+		}),
+		EtcdArgs: map[string]string{
+			"auto-tls":              "false",
+			"peer-auto-tls":         "false",
+			"client-cert-auth":      "true",
+			"cert-file":             "server.crt",
+			"key-file":              "server.key",
+			"peer-client-cert-auth": "true",
+			"peer-cert-file":        "peer.crt",
+			"peer-key-file":         "peer.key",
+		},
+	})
+
+	require.NotEmpty(t, results)
+
+	assert.Equal(t,
+		[]string{"1.2.5", "1.3.1", "1.4.1", "4.2.1", "4.2.3", "4.2.4", "4.2.11", "4.2.12", "4.2.13"},
+		failed(results),
+	)
+}
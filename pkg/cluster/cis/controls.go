@@ -0,0 +1,523 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package cis
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Result is the result of a single benchmark control evaluation.
+type Result struct {
+	ID          string
+	Title       string
+	Pass        bool
+	Remediation string
+}
+
+type argsSelector func(*NodeConfig) map[string]string
+
+func apiServer(cfg *NodeConfig) map[string]string         { return cfg.APIServerArgs }
+func controllerManager(cfg *NodeConfig) map[string]string { return cfg.ControllerManagerArgs }
+func scheduler(cfg *NodeConfig) map[string]string         { return cfg.SchedulerArgs }
+func etcdServer(cfg *NodeConfig) map[string]string        { return cfg.EtcdArgs }
+func kubelet(cfg *NodeConfig) map[string]string           { return cfg.KubeletArgs }
+
+type control struct {
+	id          string
+	title       string
+	remediation string
+
+	component argsSelector
+	check     func(cfg *NodeConfig) bool
+}
+
+// controls is the subset of CIS Kubernetes Benchmark v1.8 controls which can be evaluated automatically.
+var controls = []control{
+	// 1.2 API Server
+	{
+		id:          "1.2.1",
+		title:       "Ensure that the --anonymous-auth argument is set to false",
+		remediation: "Set `cluster.apiServer.extraArgs.anonymous-auth` to `false`.",
+		component:   apiServer,
+		check:       argIs(apiServer, "anonymous-auth", "false"),
+	},
+	{
+		id:          "1.2.2",
+		title:       "Ensure that the --token-auth-file parameter is not set",
+		remediation: "Remove `token-auth-file` from `cluster.apiServer.extraArgs`.",
+		component:   apiServer,
+		check:       argUnset(apiServer, "token-auth-file"),
+	},
+	{
+		id:          "1.2.4",
+		title:       "Ensure that the --kubelet-client-certificate and --kubelet-client-key arguments are set as appropriate",
+		remediation: "Remove `kubelet-client-certificate` and `kubelet-client-key` overrides from `cluster.apiServer.extraArgs`.",
+		component:   apiServer,
+		check:       argSet(apiServer, "kubelet-client-certificate", "kubelet-client-key"),
+	},
+	{
+		id:          "1.2.5",
+		title:       "Ensure that the --kubelet-certificate-authority argument is set as appropriate",
+		remediation: "Enable `cluster.kubeletServingCertificateRotation`.",
+		component:   apiServer,
+		check:       argSet(apiServer, "kubelet-certificate-authority"),
+	},
+	{
+		id:          "1.2.6",
+		title:       "Ensure that the --authorization-mode argument is not set to AlwaysAllow",
+		remediation: "Remove `AlwaysAllow` from `cluster.apiServer.extraArgs.authorization-mode`.",
+		component:   apiServer,
+		check:       argListExcludes(apiServer, "authorization-mode", "AlwaysAllow"),
+	},
+	{
+		id:          "1.2.7",
+		title:       "Ensure that the --authorization-mode argument includes Node",
+		remediation: "Add `Node` to `cluster.apiServer.extraArgs.authorization-mode`.",
+		component:   apiServer,
+		check:       argListIncludes(apiServer, "authorization-mode", "Node"),
+	},
+	{
+		id:          "1.2.8",
+		title:       "Ensure that the --authorization-mode argument includes RBAC",
+		remediation: "Add `RBAC` to `cluster.apiServer.extraArgs.authorization-mode`.",
+		component:   apiServer,
+		check:       argListIncludes(apiServer, "authorization-mode", "RBAC"),
+	},
+	{
+		id:          "1.2.10",
+		title:       "Ensure that the admission control plugin AlwaysAdmit is not set",
+		remediation: "Remove `AlwaysAdmit` from `cluster.apiServer.extraArgs.enable-admission-plugins`.",
+		component:   apiServer,
+		check:       argListExcludes(apiServer, "enable-admission-plugins", "AlwaysAdmit"),
+	},
+	{
+		id:          "1.2.15",
+		title:       "Ensure that the admission control plugin NodeRestriction is set",
+		remediation: "Add `NodeRestriction` to `cluster.apiServer.extraArgs.enable-admission-plugins`.",
+		component:   apiServer,
+		check:       argListIncludes(apiServer, "enable-admission-plugins", "NodeRestriction"),
+	},
+	{
+		id:          "1.2.16",
+		title:       "Ensure that the --profiling argument is set to false",
+		remediation: "Set `cluster.apiServer.extraArgs.profiling` to `false`.",
+		component:   apiServer,
+		check:       argIs(apiServer, "profiling", "false"),
+	},
+	{
+		id:          "1.2.17",
+		title:       "Ensure that the --audit-log-path argument is set",
+		remediation: "Remove `audit-log-path` override from `cluster.apiServer.extraArgs`.",
+		component:   apiServer,
+		check:       argSet(apiServer, "audit-log-path"),
+	},
+	{
+		id:          "1.2.18",
+		title:       "Ensure that the --audit-log-maxage argument is set to 30 or as appropriate",
+		remediation: "Set `cluster.apiServer.extraArgs.audit-log-maxage` to `30` or more.",
+		component:   apiServer,
+		check:       argAtLeast(apiServer, "audit-log-maxage", 30),
+	},
+	{
+		id:          "1.2.19",
+		title:       "Ensure that the --audit-log-maxbackup argument is set to 10 or as appropriate",
+		remediation: "Set `cluster.apiServer.extraArgs.audit-log-maxbackup` to `10` or more.",
+		component:   apiServer,
+		check:       argAtLeast(apiServer, "audit-log-maxbackup", 10),
+	},
+	{
+		id:          "1.2.20",
+		title:       "Ensure that the --audit-log-maxsize argument is set to 100 or as appropriate",
+		remediation: "Set `cluster.apiServer.extraArgs.audit-log-maxsize` to `100` or more.",
+		component:   apiServer,
+		check:       argAtLeast(apiServer, "audit-log-maxsize", 100),
+	},
+	{
+		id:          "1.2.22",
+		title:       "Ensure that the --service-account-lookup argument is set to true",
+		remediation: "Remove `service-account-lookup` from `cluster.apiServer.extraArgs`.",
+		component:   apiServer,
+		check:       argNot(apiServer, "service-account-lookup", "false"),
+	},
+	{
+		id:          "1.2.23",
+		title:       "Ensure that the --service-account-key-file argument is set as appropriate",
+		remediation: "Remove `service-account-key-file` override from `cluster.apiServer.extraArgs`.",
+		component:   apiServer,
+		check:       argSet(apiServer, "service-account-key-file"),
+	},
+	{
+		id:          "1.2.24",
+		title:       "Ensure that the --etcd-certfile and --etcd-keyfile arguments are set as appropriate",
+		remediation: "Remove `etcd-certfile` and `etcd-keyfile` overrides from `cluster.apiServer.extraArgs`.",
+		component:   apiServer,
+		check:       argSet(apiServer, "etcd-certfile", "etcd-keyfile"),
+	},
+	{
+		id:          "1.2.25",
+		title:       "Ensure that the --tls-cert-file and --tls-private-key-file arguments are set as appropriate",
+		remediation: "Remove `tls-cert-file` and `tls-private-key-file` overrides from `cluster.apiServer.extraArgs`.",
+		component:   apiServer,
+		check:       argSet(apiServer, "tls-cert-file", "tls-private-key-file"),
+	},
+	{
+		id:          "1.2.26",
+		title:       "Ensure that the --client-ca-file argument is set as appropriate",
+		remediation: "Remove `client-ca-file` override from `cluster.apiServer.extraArgs`.",
+		component:   apiServer,
+		check:       argSet(apiServer, "client-ca-file"),
+	},
+	{
+		id:          "1.2.27",
+		title:       "Ensure that the --etcd-cafile argument is set as appropriate",
+		remediation: "Remove `etcd-cafile` override from `cluster.apiServer.extraArgs`.",
+		component:   apiServer,
+		check:       argSet(apiServer, "etcd-cafile"),
+	},
+	{
+		id:          "1.2.28",
+		title:       "Ensure that the --encryption-provider-config argument is set as appropriate",
+		remediation: "Remove `encryption-provider-config` override from `cluster.apiServer.extraArgs`.",
+		component:   apiServer,
+		check:       argSet(apiServer, "encryption-provider-config"),
+	},
+	{
+		id:          "1.2.30",
+		title:       "Ensure that the API Server only makes use of Strong Cryptographic Ciphers",
+		remediation: "Set `cluster.apiServer.extraArgs.tls-cipher-suites` to the list of strong ciphers.",
+		component:   apiServer,
+		check:       argSet(apiServer, "tls-cipher-suites"),
+	},
+	// 1.3 Controller Manager
+	{
+		id:          "1.3.1",
+		title:       "Ensure that the --terminated-pod-gc-threshold argument is set as appropriate",
+		remediation: "Set `cluster.controllerManager.extraArgs.terminated-pod-gc-threshold`, for example to `10`.",
+		component:   controllerManager,
+		check:       argSet(controllerManager, "terminated-pod-gc-threshold"),
+	},
+	{
+		id:          "1.3.2",
+		title:       "Ensure that the --profiling argument is set to false",
+		remediation: "Set `cluster.controllerManager.extraArgs.profiling` to `false`.",
+		component:   controllerManager,
+		check:       argIs(controllerManager, "profiling", "false"),
+	},
+	{
+		id:          "1.3.3",
+		title:       "Ensure that the --use-service-account-credentials argument is set to true",
+		remediation: "Set `cluster.controllerManager.extraArgs.use-service-account-credentials` to `true`.",
+		component:   controllerManager,
+		check:       argIs(controllerManager, "use-service-account-credentials", "true"),
+	},
+	{
+		id:          "1.3.4",
+		title:       "Ensure that the --service-account-private-key-file argument is set as appropriate",
+		remediation: "Remove `service-account-private-key-file` override from `cluster.controllerManager.extraArgs`.",
+		component:   controllerManager,
+		check:       argSet(controllerManager, "service-account-private-key-file"),
+	},
+	{
+		id:          "1.3.5",
+		title:       "Ensure that the --root-ca-file argument is set as appropriate",
+		remediation: "Remove `root-ca-file` override from `cluster.controllerManager.extraArgs`.",
+		component:   controllerManager,
+		check:       argSet(controllerManager, "root-ca-file"),
+	},
+	{
+		id:          "1.3.6",
+		title:       "Ensure that the RotateKubeletServerCertificate argument is set to true",
+		remediation: "Remove `RotateKubeletServerCertificate=false` from `cluster.controllerManager.extraArgs.feature-gates`.",
+		component:   controllerManager,
+		check:       argListExcludes(controllerManager, "feature-gates", "RotateKubeletServerCertificate=false"),
+	},
+	{
+		id:          "1.3.7",
+		title:       "Ensure that the --bind-address argument is set to 127.0.0.1",
+		remediation: "Set `cluster.controllerManager.extraArgs.bind-address` to `127.0.0.1`.",
+		component:   controllerManager,
+		check:       argIs(controllerManager, "bind-address", "127.0.0.1"),
+	},
+	// 1.4 Scheduler
+	{
+		id:          "1.4.1",
+		title:       "Ensure that the --profiling argument is set to false",
+		remediation: "Set `cluster.scheduler.extraArgs.profiling` to `false`.",
+		component:   scheduler,
+		check:       argIs(scheduler, "profiling", "false"),
+	},
+	{
+		id:          "1.4.2",
+		title:       "Ensure that the --bind-address argument is set to 127.0.0.1",
+		remediation: "Set `cluster.scheduler.extraArgs.bind-address` to `127.0.0.1`.",
+		component:   scheduler,
+		check:       argIs(scheduler, "bind-address", "127.0.0.1"),
+	},
+	// 2 etcd
+	{
+		id:          "2.1",
+		title:       "Ensure that the --cert-file and --key-file arguments are set as appropriate",
+		remediation: "The arguments are managed by Talos.",
+		component:   etcdServer,
+		check:       argSet(etcdServer, "cert-file", "key-file"),
+	},
+	{
+		id:          "2.2",
+		title:       "Ensure that the --client-cert-auth argument is set to true",
+		remediation: "The argument is managed by Talos.",
+		component:   etcdServer,
+		check:       argIs(etcdServer, "client-cert-auth", "true"),
+	},
+	{
+		id:          "2.3",
+		title:       "Ensure that the --auto-tls argument is not set to true",
+		remediation: "The argument is managed by Talos.",
+		component:   etcdServer,
+		check:       argNot(etcdServer, "auto-tls", "true"),
+	},
+	{
+		id:          "2.4",
+		title:       "Ensure that the --peer-cert-file and --peer-key-file arguments are set as appropriate",
+		remediation: "The arguments are managed by Talos.",
+		component:   etcdServer,
+		check:       argSet(etcdServer, "peer-cert-file", "peer-key-file"),
+	},
+	{
+		id:          "2.5",
+		title:       "Ensure that the --peer-client-cert-auth argument is set to true",
+		remediation: "The argument is managed by Talos.",
+		component:   etcdServer,
+		check:       argIs(etcdServer, "peer-client-cert-auth", "true"),
+	},
+	{
+		id:          "2.6",
+		title:       "Ensure that the --peer-auto-tls argument is not set to true",
+		remediation: "The argument is managed by Talos.",
+		component:   etcdServer,
+		check:       argNot(etcdServer, "peer-auto-tls", "true"),
+	},
+	// 4.2 Kubelet
+	{
+		id:          "4.2.1",
+		title:       "Ensure that the --anonymous-auth argument is set to false",
+		remediation: "Set `machine.kubelet.extraConfig.authentication.anonymous.enabled` to `false`.",
+		component:   kubelet,
+		check:       kubeletConfigIs("false", "authentication", "anonymous", "enabled"),
+	},
+	{
+		id:          "4.2.2",
+		title:       "Ensure that the --authorization-mode argument is not set to AlwaysAllow",
+		remediation: "Set `machine.kubelet.extraConfig.authorization.mode` to `Webhook`.",
+		component:   kubelet,
+		check:       kubeletConfigNot("AlwaysAllow", "authorization", "mode"),
+	},
+	{
+		id:          "4.2.3",
+		title:       "Ensure that the --client-ca-file argument is set as appropriate",
+		remediation: "Remove `authentication.x509.clientCAFile` override from `machine.kubelet.extraConfig`.",
+		component:   kubelet,
+		check:       kubeletConfigSet("authentication", "x509", "clientCAFile"),
+	},
+	{
+		id:          "4.2.4",
+		title:       "Verify that the --read-only-port argument is set to 0",
+		remediation: "Remove `readOnlyPort` from `machine.kubelet.extraConfig` and `read-only-port` from `machine.kubelet.extraArgs`.",
+		component:   kubelet,
+		check: func(cfg *NodeConfig) bool {
+			return kubeletConfigAbsentOr("0", "readOnlyPort")(cfg) &&
+				(argUnset(kubelet, "read-only-port")(cfg) || argIs(kubelet, "read-only-port", "0")(cfg))
+		},
+	},
+	{
+		id:          "4.2.5",
+		title:       "Ensure that the --streaming-connection-idle-timeout argument is not set to 0",
+		remediation: "Set `machine.kubelet.extraConfig.streamingConnectionIdleTimeout` to a non-zero value, for example `5m`.",
+		component:   kubelet,
+		check: func(cfg *NodeConfig) bool {
+			return kubeletConfigNot("0", "streamingConnectionIdleTimeout")(cfg) && kubeletConfigNot("0s", "streamingConnectionIdleTimeout")(cfg)
+		},
+	},
+	{
+		id:          "4.2.6",
+		title:       "Ensure that the --make-iptables-util-chains argument is set to true",
+		remediation: "Remove `makeIPTablesUtilChains` from `machine.kubelet.extraConfig`.",
+		component:   kubelet,
+		check:       kubeletConfigNot("false", "makeIPTablesUtilChains"),
+	},
+	{
+		id:          "4.2.10",
+		title:       "Ensure that the --rotate-certificates argument is not set to false",
+		remediation: "Remove `rotateCertificates` from `machine.kubelet.extraConfig`.",
+		component:   kubelet,
+		check:       kubeletConfigNot("false", "rotateCertificates"),
+	},
+	{
+		id:          "4.2.11",
+		title:       "Verify that the RotateKubeletServerCertificate argument is set to true",
+		remediation: "Enable `cluster.kubeletServingCertificateRotation`.",
+		component:   kubelet,
+		check: func(cfg *NodeConfig) bool {
+			return argIs(kubelet, "rotate-server-certificates", "true")(cfg) || kubeletConfigIs("true", "serverTLSBootstrap")(cfg)
+		},
+	},
+	{
+		id:          "4.2.12",
+		title:       "Ensure that the Kubelet only makes use of Strong Cryptographic Ciphers",
+		remediation: "Set `machine.kubelet.extraConfig.tlsCipherSuites` to the list of strong ciphers.",
+		component:   kubelet,
+		check:       kubeletConfigSet("tlsCipherSuites"),
+	},
+	{
+		id:          "4.2.13",
+		title:       "Ensure that a limit is set on pod PIDs",
+		remediation: "Set `machine.kubelet.extraConfig.podPidsLimit` to a positive value.",
+		component:   kubelet,
+		check: func(cfg *NodeConfig) bool {
+			limit, err := strconv.Atoi(kubeletConfigValue(cfg, "podPidsLimit"))
+
+			return err == nil && limit > 0
+		},
+	},
+}
+
+// Evaluate evaluates the benchmark controls applicable to the node.
+//
+// Controls for the components not running on the node are skipped.
+func Evaluate(cfg *NodeConfig) []Result {
+	var results []Result
+
+	for _, c := range controls {
+		if c.component(cfg) == nil {
+			continue
+		}
+
+		results = append(results, Result{
+			ID:          c.id,
+			Title:       c.title,
+			Pass:        c.check(cfg),
+			Remediation: c.remediation,
+		})
+	}
+
+	return results
+}
+
+func argIs(args argsSelector, key, value string) func(*NodeConfig) bool {
+	return func(cfg *NodeConfig) bool {
+		v, ok := args(cfg)[key]
+
+		return ok && v == value
+	}
+}
+
+func argNot(args argsSelector, key, value string) func(*NodeConfig) bool {
+	return func(cfg *NodeConfig) bool {
+		return args(cfg)[key] != value
+	}
+}
+
+func argSet(args argsSelector, keys ...string) func(*NodeConfig) bool {
+	return func(cfg *NodeConfig) bool {
+		for _, key := range keys {
+			if args(cfg)[key] == "" {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+func argUnset(args argsSelector, key string) func(*NodeConfig) bool {
+	return func(cfg *NodeConfig) bool {
+		_, ok := args(cfg)[key]
+
+		return !ok
+	}
+}
+
+func argList(args map[string]string, key string) []string {
+	if args[key] == "" {
+		return nil
+	}
+
+	return strings.Split(args[key], ",")
+}
+
+func argListIncludes(args argsSelector, key, item string) func(*NodeConfig) bool {
+	return func(cfg *NodeConfig) bool {
+		return slices.Contains(argList(args(cfg), key), item)
+	}
+}
+
+func argListExcludes(args argsSelector, key, item string) func(*NodeConfig) bool {
+	return func(cfg *NodeConfig) bool {
+		return !slices.Contains(argList(args(cfg), key), item)
+	}
+}
+
+func argAtLeast(args argsSelector, key string, minimum int) func(*NodeConfig) bool {
+	return func(cfg *NodeConfig) bool {
+		v, err := strconv.Atoi(args(cfg)[key])
+
+		return err == nil && v >= minimum
+	}
+}
+
+// kubeletConfigValue returns the string representation of the kubelet configuration value, empty string if not set.
+func kubeletConfigValue(cfg *NodeConfig, path ...string) string {
+	var value any = cfg.KubeletConfig
+
+	for _, key := range path {
+		m, ok := value.(map[string]any)
+		if !ok {
+			return ""
+		}
+
+		if value, ok = m[key]; !ok {
+			return ""
+		}
+	}
+
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case []any:
+		if len(v) == 0 {
+			return ""
+		}
+	}
+
+	return fmt.Sprint(value)
+}
+
+func kubeletConfigIs(expected string, path ...string) func(*NodeConfig) bool {
+	return func(cfg *NodeConfig) bool {
+		return kubeletConfigValue(cfg, path...) == expected
+	}
+}
+
+func kubeletConfigNot(unexpected string, path ...string) func(*NodeConfig) bool {
+	return func(cfg *NodeConfig) bool {
+		return kubeletConfigValue(cfg, path...) != unexpected
+	}
+}
+
+func kubeletConfigAbsentOr(expected string, path ...string) func(*NodeConfig) bool {
+	return func(cfg *NodeConfig) bool {
+		v := kubeletConfigValue(cfg, path...)
+
+		return v == "" || v == expected
+	}
+}
+
+func kubeletConfigSet(path ...string) func(*NodeConfig) bool {
+	return func(cfg *NodeConfig) bool {
+		return kubeletConfigValue(cfg, path...) != ""
+	}
+}
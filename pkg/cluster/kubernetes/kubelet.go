@@ -32,7 +32,7 @@ func upgradeKubelet(ctx context.Context, cluster UpgradeProvider, options Upgrad
 	if !options.UpgradeKubelet {
 		options.Log("skipped updating kubelet")
 
-		return nil
+		return logKubeletUpgradeGuidance(ctx, cluster, options)
 	}
 
 	options.Log("updating kubelet to version %q", options.Path.ToVersion())
@@ -46,6 +46,43 @@ func upgradeKubelet(ctx context.Context, cluster UpgradeProvider, options Upgrad
 	return nil
 }
 
+// logKubeletUpgradeGuidance prints the instructions to update kubelet on the nodes which run an outdated version.
+func logKubeletUpgradeGuidance(ctx context.Context, cluster UpgradeProvider, options UpgradeOptions) error {
+	k8sClient, err := cluster.K8sHelper(ctx)
+	if err != nil {
+		return fmt.Errorf("error building kubernetes client: %w", err)
+	}
+
+	nodes, err := k8sClient.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return fmt.Errorf("error listing nodes: %w", err)
+	}
+
+	version := "v" + options.Path.ToVersion()
+	image := fmt.Sprintf("%s:%s", options.KubeletImage, version)
+
+	for _, node := range nodes.Items {
+		if node.Status.NodeInfo.KubeletVersion == version {
+			continue
+		}
+
+		address := node.Name
+
+		for _, nodeAddress := range node.Status.Addresses {
+			if nodeAddress.Type == v1.NodeInternalIP {
+				address = nodeAddress.Address
+
+				break
+			}
+		}
+
+		options.Log(" > %q: kubelet version %s, to update run:", address, node.Status.NodeInfo.KubeletVersion)
+		options.Log("   talosctl -n %s patch machineconfig -p '{\"machine\": {\"kubelet\": {\"image\": \"%s\"}}}'", address, image)
+	}
+
+	return nil
+}
+
 //nolint:gocyclo,cyclop
 func upgradeKubeletOnNode(ctx context.Context, cluster UpgradeProvider, options UpgradeOptions, node string) error {
 	ctx, cancel := context.WithCancel(ctx)
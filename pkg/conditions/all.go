@@ -76,6 +76,25 @@ func (a *all) String() string {
 	return strings.Join(descriptions, ", ")
 }
 
+func (a *all) pending() []string {
+	var descriptions []string
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	for _, c := range a.conditions {
+		if c == nil {
+			continue
+		}
+
+		if description := c.String(); description != "" && description != OK {
+			descriptions = append(descriptions, description)
+		}
+	}
+
+	return descriptions
+}
+
 // WaitForAll creates a condition which waits for all the conditions to be successful.
 func WaitForAll(conditions ...Condition) Condition {
 	res := &all{}
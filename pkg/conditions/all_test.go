@@ -60,16 +60,28 @@ func (suite *AllSuite) TestString() {
 	}()
 
 	suite.Require().Equal("A, B", waiter.String())
+	suite.Require().Equal([]string{"A", "B"}, conditions.Pending(waiter))
 	conds[0].(*MockCondition).errCh <- nil
 	time.Sleep(50 * time.Millisecond)
 
 	// done waiting for 'A', so description should now be shorter
 	suite.Require().Equal("B", waiter.String())
+	suite.Require().Equal([]string{"B"}, conditions.Pending(waiter))
 
 	conds[1].(*MockCondition).errCh <- nil
 	<-done
 }
 
+func (suite *AllSuite) TestPending() {
+	suite.Require().Equal([]string{"A"}, conditions.Pending(&MockCondition{description: "A"}))
+	suite.Require().Empty(conditions.Pending(&MockCondition{description: conditions.OK}))
+	suite.Require().Equal([]string{"A", "C"}, conditions.Pending(conditions.WaitForAll(
+		&MockCondition{description: "A"},
+		&MockCondition{description: conditions.OK},
+		conditions.WaitForAll(&MockCondition{description: "C"}),
+	)))
+}
+
 func (suite *AllSuite) TestFlatten() {
 	conds1 := []conditions.Condition{
 		&MockCondition{description: "A", errCh: make(chan error)},
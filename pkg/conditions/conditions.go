@@ -19,3 +19,18 @@ type Condition interface {
 	fmt.Stringer
 	Wait(ctx context.Context) error
 }
+
+// Pending returns the descriptions of the conditions which are not met yet.
+//
+// The conditions combined with WaitForAll are described separately.
+func Pending(condition Condition) []string {
+	if multi, ok := condition.(*all); ok {
+		return multi.pending()
+	}
+
+	if description := condition.String(); description != "" && description != OK {
+		return []string{description}
+	}
+
+	return nil
+}
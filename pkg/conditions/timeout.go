@@ -0,0 +1,43 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package conditions
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+type timeoutCondition struct {
+	condition Condition
+	timeout   time.Duration
+}
+
+func (t *timeoutCondition) String() string {
+	return t.condition.String()
+}
+
+func (t *timeoutCondition) Wait(ctx context.Context) error {
+	timeoutCtx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
+	err := t.condition.Wait(timeoutCtx)
+	if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+		return fmt.Errorf("%s: not met in %s", t.condition, t.timeout)
+	}
+
+	return err
+}
+
+// WithTimeout limits the time to wait for the condition.
+//
+// If the condition is not met in time, Wait returns an error describing the condition.
+func WithTimeout(condition Condition, timeout time.Duration) Condition {
+	return &timeoutCondition{
+		condition: condition,
+		timeout:   timeout,
+	}
+}
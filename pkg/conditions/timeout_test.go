@@ -0,0 +1,36 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package conditions_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/siderolabs/talos/pkg/conditions"
+)
+
+func TestWithTimeout(t *testing.T) {
+	t.Parallel()
+
+	cond := &MockCondition{description: "A", errCh: make(chan error)}
+
+	timeoutCond := conditions.WithTimeout(cond, 10*time.Millisecond)
+
+	assert.Equal(t, "A", timeoutCond.String())
+	assert.EqualError(t, timeoutCond.Wait(context.Background()), "A: not met in 10ms")
+
+	cond = &MockCondition{description: "B", errCh: make(chan error, 1)}
+	cond.errCh <- nil
+
+	assert.NoError(t, conditions.WithTimeout(cond, time.Minute).Wait(context.Background()))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	assert.ErrorIs(t, conditions.WithTimeout(&MockCondition{description: "C"}, time.Minute).Wait(ctx), context.Canceled)
+}
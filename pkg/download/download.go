@@ -8,6 +8,7 @@ package download
 import (
 	"bytes"
 	"context"
+	"crypto/x509"
 	"encoding/base64"
 	"fmt"
 	"io"
@@ -31,6 +32,7 @@ type downloadOptions struct {
 	Headers    map[string]string
 	Format     string
 	LowSrcPort bool
+	RootCAs    *x509.CertPool
 
 	EndpointFunc func(context.Context) (string, error)
 
@@ -95,6 +97,14 @@ func WithLowSrcPort() Option {
 	}
 }
 
+// WithRootCAs sets the CA pool to verify the server certificate,
+// it replaces the default list of trusted CAs.
+func WithRootCAs(pool *x509.CertPool) Option {
+	return func(d *downloadOptions) {
+		d.RootCAs = pool
+	}
+}
+
 // WithErrorOnNotFound provides specific error to return when response has HTTP 404 error.
 func WithErrorOnNotFound(e error) Option {
 	return func(d *downloadOptions) {
@@ -225,6 +235,10 @@ func download(req *http.Request, options *downloadOptions) (data []byte, err err
 	transport := httpdefaults.PatchTransport(cleanhttp.DefaultTransport())
 	transport.RegisterProtocol("tftp", NewTFTPTransport())
 
+	if options.RootCAs != nil {
+		transport.TLSClientConfig.RootCAs = options.RootCAs
+	}
+
 	if options.LowSrcPort {
 		port := 100 + rand.IntN(512)
 
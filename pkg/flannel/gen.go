@@ -47,6 +47,9 @@ data:
           "type": "flannel",
           "delegate": {
             "hairpinMode": true,
+            {{- if .FlannelMTU }}
+            "mtu": {{ .FlannelMTU }},
+            {{- end }}
             "isDefaultGateway": true
           }
         },
@@ -76,6 +76,9 @@ data:
           "type": "flannel",
           "delegate": {
             "hairpinMode": true,
+            {{- if .FlannelMTU }}
+            "mtu": {{ .FlannelMTU }},
+            {{- end }}
             "isDefaultGateway": true
           }
         },
@@ -6,6 +6,7 @@ package gen
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
 	"strings"
@@ -14,6 +15,8 @@ import (
 	"github.com/siderolabs/crypto/x509"
 	"github.com/siderolabs/go-retry/retry"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/siderolabs/talos/pkg/grpc/middleware/auth/basic"
 	securityapi "github.com/siderolabs/talos/pkg/machinery/api/security"
@@ -28,7 +31,9 @@ type RemoteGenerator struct {
 }
 
 // NewRemoteGenerator initializes a RemoteGenerator with a preconfigured grpc.ClientConn.
-func NewRemoteGenerator(token string, endpoints []string, acceptedCAs []*x509.PEMEncodedCertificate) (g *RemoteGenerator, err error) {
+//
+// The clientCerts are presented to authenticate with the certificate previously issued to the node.
+func NewRemoteGenerator(token string, endpoints []string, acceptedCAs []*x509.PEMEncodedCertificate, clientCerts ...tls.Certificate) (g *RemoteGenerator, err error) {
 	if len(endpoints) == 0 {
 		return nil, errors.New("at least one root of trust endpoint is required")
 	}
@@ -37,7 +42,7 @@ func NewRemoteGenerator(token string, endpoints []string, acceptedCAs []*x509.PE
 
 	g = &RemoteGenerator{}
 
-	conn, err := basic.NewConnection(fmt.Sprintf("%s:///%s", resolver.RoundRobinResolverScheme, strings.Join(endpoints, ",")), basic.NewTokenCredentials(token), acceptedCAs)
+	conn, err := basic.NewConnection(fmt.Sprintf("%s:///%s", resolver.RoundRobinResolverScheme, strings.Join(endpoints, ",")), basic.NewTokenCredentials(token), acceptedCAs, clientCerts...)
 	if err != nil {
 		return nil, err
 	}
@@ -48,17 +53,50 @@ func NewRemoteGenerator(token string, endpoints []string, acceptedCAs []*x509.PE
 	return g, nil
 }
 
+// IdentityOption configures the certificate request.
+type IdentityOption func(*securityapi.CertificateRequest)
+
+// WithAttestation attaches TPM attestation to the certificate request.
+func WithAttestation(attestation *securityapi.TPMAttestation) IdentityOption {
+	return func(req *securityapi.CertificateRequest) {
+		req.Attestation = attestation
+	}
+}
+
+// AttestationNonce requests the nonce to qualify the TPM attestation of the certificate request with.
+//
+// If the security API doesn't issue the attestation nonces (older Talos version), AttestationNonce returns nil.
+func (g *RemoteGenerator) AttestationNonce(ctx context.Context) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+
+	resp, err := g.client.AttestationNonce(ctx, &securityapi.AttestationNonceRequest{})
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return resp.Nonce, nil
+}
+
 // Identity creates an identity certificate via the security API.
-func (g *RemoteGenerator) Identity(csr *x509.CertificateSigningRequest) (ca, crt []byte, err error) {
-	return g.IdentityContext(context.Background(), csr)
+func (g *RemoteGenerator) Identity(csr *x509.CertificateSigningRequest, opts ...IdentityOption) (ca, crt []byte, err error) {
+	return g.IdentityContext(context.Background(), csr, opts...)
 }
 
 // IdentityContext creates an identity certificate via the security API.
-func (g *RemoteGenerator) IdentityContext(ctx context.Context, csr *x509.CertificateSigningRequest) (ca, crt []byte, err error) {
+func (g *RemoteGenerator) IdentityContext(ctx context.Context, csr *x509.CertificateSigningRequest, opts ...IdentityOption) (ca, crt []byte, err error) {
 	req := &securityapi.CertificateRequest{
 		Csr: csr.X509CertificateRequestPEM,
 	}
 
+	for _, opt := range opts {
+		opt(req)
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, time.Minute)
 	defer cancel()
 
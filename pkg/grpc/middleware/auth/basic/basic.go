@@ -24,8 +24,12 @@ type Credentials interface {
 
 // NewConnection initializes a grpc.ClientConn configured for basic
 // authentication.
-func NewConnection(address string, creds credentials.PerRPCCredentials, acceptedCAs []*x509.PEMEncodedCertificate) (conn *grpc.ClientConn, err error) {
-	tlsConfig := &tls.Config{}
+//
+// The clientCerts are presented if the server requests the client certificate.
+func NewConnection(address string, creds credentials.PerRPCCredentials, acceptedCAs []*x509.PEMEncodedCertificate, clientCerts ...tls.Certificate) (conn *grpc.ClientConn, err error) {
+	tlsConfig := &tls.Config{
+		Certificates: clientCerts,
+	}
 
 	tlsConfig.RootCAs = stdx509.NewCertPool()
 	tlsConfig.RootCAs.AppendCertsFromPEM(bytes.Join(
@@ -0,0 +1,208 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package drain provides gRPC interceptors which drain the API server when the node is going down.
+package drain
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware/v2"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+// Status builds the status returned to the clients while the server is draining.
+//
+// The status has codes.Unavailable code, reason is reported as the ErrorInfo details (see client.ErrorReason),
+// and the retry delay (if set) as the RetryInfo details (see client.RetryDelay).
+func Status(reason, message string, retryDelay time.Duration) *status.Status {
+	if retryDelay > 0 {
+		message = fmt.Sprintf("%s, retry after %s", message, retryDelay)
+	}
+
+	st := status.New(codes.Unavailable, message)
+
+	details := []protoadapt.MessageV1{
+		&errdetails.ErrorInfo{
+			Reason: reason,
+			Domain: client.ErrorDomain,
+		},
+	}
+
+	if retryDelay > 0 {
+		details = append(details, &errdetails.RetryInfo{
+			RetryDelay: durationpb.New(retryDelay),
+		})
+	}
+
+	withDetails, err := st.WithDetails(details...)
+	if err != nil {
+		return st
+	}
+
+	return withDetails
+}
+
+// Drainer rejects new requests once the draining has started, and finishes the in-flight streams
+// after the grace period with the draining status.
+//
+// Unary requests in flight are not interrupted.
+type Drainer struct {
+	// GracePeriod is the time in-flight streams have to finish after the draining has started.
+	GracePeriod time.Duration
+	// Exempt is the list of methods which are served while draining (e.g. to watch the node going down).
+	Exempt []string
+
+	mu      sync.Mutex
+	status  *status.Status
+	timer   *time.Timer
+	streams map[uint64]context.CancelCauseFunc
+	nextID  uint64
+}
+
+// Drain starts draining: the new requests fail with the given status, and in-flight streams
+// are canceled with the given status after the grace period.
+//
+// Calling Drain while already draining updates the status returned to the clients.
+func (d *Drainer) Drain(st *status.Status) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	draining := d.status != nil
+	d.status = st
+
+	if draining {
+		return
+	}
+
+	d.timer = time.AfterFunc(d.GracePeriod, d.cancelStreams)
+}
+
+// Resume stops draining, e.g. if the node is not going down anymore.
+func (d *Drainer) Resume() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.timer != nil {
+		d.timer.Stop()
+		d.timer = nil
+	}
+
+	d.status = nil
+}
+
+func (d *Drainer) cancelStreams() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.status == nil {
+		return
+	}
+
+	for id, cancel := range d.streams {
+		cancel(d.status.Err())
+
+		delete(d.streams, id)
+	}
+}
+
+// check returns the error if the server is draining and the method is not exempt.
+func (d *Drainer) check(method string) error {
+	if slices.Contains(d.Exempt, method) {
+		return nil
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.status != nil {
+		return d.status.Err()
+	}
+
+	return nil
+}
+
+// register the in-flight stream, the returned function should be called once the stream is done.
+func (d *Drainer) register(cancel context.CancelCauseFunc) (func(), error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.status != nil {
+		return nil, d.status.Err()
+	}
+
+	if d.streams == nil {
+		d.streams = map[uint64]context.CancelCauseFunc{}
+	}
+
+	id := d.nextID
+	d.nextID++
+
+	d.streams[id] = cancel
+
+	return func() {
+		d.mu.Lock()
+		defer d.mu.Unlock()
+
+		delete(d.streams, id)
+	}, nil
+}
+
+// UnaryInterceptor returns grpc UnaryServerInterceptor.
+func (d *Drainer) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := d.check(info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor returns grpc StreamServerInterceptor.
+func (d *Drainer) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if slices.Contains(d.Exempt, info.FullMethod) {
+			return handler(srv, stream)
+		}
+
+		ctx, cancel := context.WithCancelCause(stream.Context())
+		defer cancel(nil)
+
+		unregister, err := d.register(cancel)
+		if err != nil {
+			return err
+		}
+
+		defer unregister()
+
+		wrapped := grpc_middleware.WrapServerStream(stream)
+		wrapped.WrappedContext = ctx
+
+		err = handler(srv, wrapped)
+
+		// the stream was canceled because of draining, so return the draining status to the client,
+		// whatever the handler returned on cancellation
+		if stream.Context().Err() == nil {
+			if cause := context.Cause(ctx); cause != nil {
+				if _, ok := status.FromError(cause); ok {
+					return cause
+				}
+			}
+		}
+
+		return err
+	}
+}
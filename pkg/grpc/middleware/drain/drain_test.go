@@ -0,0 +1,129 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package drain_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/grpc/middleware/drain"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+type serverStream struct {
+	grpc.ServerStream
+
+	ctx context.Context //nolint:containedctx
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+func TestStatus(t *testing.T) {
+	t.Parallel()
+
+	err := drain.Status(client.ReasonNodeRebooting, "node is rebooting", time.Minute).Err()
+
+	assert.Equal(t, codes.Unavailable, client.StatusCode(err))
+	assert.Equal(t, "node is rebooting, retry after 1m0s", status.Convert(err).Message())
+	assert.Equal(t, client.ReasonNodeRebooting, client.ErrorReason(err))
+
+	delay, ok := client.RetryDelay(err)
+	assert.True(t, ok)
+	assert.Equal(t, time.Minute, delay)
+
+	err = drain.Status(client.ReasonNodeShuttingDown, "node is shutting down", 0).Err()
+
+	assert.Equal(t, client.ReasonNodeShuttingDown, client.ErrorReason(err))
+
+	_, ok = client.RetryDelay(err)
+	assert.False(t, ok)
+}
+
+func TestUnary(t *testing.T) {
+	t.Parallel()
+
+	drainer := &drain.Drainer{
+		GracePeriod: time.Second,
+		Exempt:      []string{"/machine.MachineService/Version"},
+	}
+
+	interceptor := drainer.UnaryInterceptor()
+
+	call := func(method string) error {
+		_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, any) (any, error) {
+			return nil, nil //nolint:nilnil
+		})
+
+		return err
+	}
+
+	require.NoError(t, call("/machine.MachineService/Hostname"))
+
+	drainer.Drain(drain.Status(client.ReasonNodeRebooting, "node is rebooting", time.Minute))
+
+	err := call("/machine.MachineService/Hostname")
+	require.Error(t, err)
+	assert.Equal(t, client.ReasonNodeRebooting, client.ErrorReason(err))
+
+	require.NoError(t, call("/machine.MachineService/Version"))
+
+	drainer.Resume()
+
+	require.NoError(t, call("/machine.MachineService/Hostname"))
+}
+
+func TestStream(t *testing.T) {
+	t.Parallel()
+
+	drainer := &drain.Drainer{
+		GracePeriod: 100 * time.Millisecond,
+	}
+
+	interceptor := drainer.StreamInterceptor()
+	info := &grpc.StreamServerInfo{FullMethod: "/machine.MachineService/Logs"}
+
+	started := make(chan struct{})
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- interceptor(nil, &serverStream{ctx: context.Background()}, info, func(_ any, stream grpc.ServerStream) error {
+			close(started)
+
+			// the handler returns nil on cancellation, like the following streams do
+			<-stream.Context().Done()
+
+			return nil
+		})
+	}()
+
+	<-started
+
+	drainer.Drain(drain.Status(client.ReasonNodeUpgrading, "node is upgrading", time.Minute))
+
+	// new streams are rejected right away
+	err := interceptor(nil, &serverStream{ctx: context.Background()}, info, func(any, grpc.ServerStream) error { return nil })
+	require.Error(t, err)
+	assert.Equal(t, client.ReasonNodeUpgrading, client.ErrorReason(err))
+
+	// in-flight stream is finished with the draining status after the grace period
+	select {
+	case err = <-errCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream was not finished")
+	}
+
+	require.Error(t, err)
+	assert.Equal(t, codes.Unavailable, client.StatusCode(err))
+	assert.Equal(t, client.ReasonNodeUpgrading, client.ErrorReason(err))
+}
@@ -0,0 +1,60 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ratelimit
+
+import (
+	"context"
+	"slices"
+
+	"github.com/siderolabs/crypto/x509"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+// mdKey is used to store the client identity in gRPC metadata.
+const mdKey = constants.APIClientIdentityMetadataKey
+
+// SetMetadata sets given client identity in gRPC metadata.
+func SetMetadata(md metadata.MD, identity string) {
+	if identity == "" {
+		return
+	}
+
+	md.Set(mdKey, identity)
+}
+
+// Identity returns the identity of the client which made the request.
+//
+// The identity is the SPKI fingerprint of the client certificate.
+// For the requests proxied by other apid instances (or made by clients with the impersonator role)
+// the identity is taken from gRPC metadata, as it's the identity of the original client.
+// Empty string is returned if the connection is not authenticated with a client certificate.
+func Identity(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.PeerCertificates) == 0 {
+		return ""
+	}
+
+	cert := tlsInfo.State.PeerCertificates[0]
+
+	if slices.Contains(cert.Subject.Organization, string(role.Impersonator)) {
+		if md, ok := metadata.FromIncomingContext(ctx); ok {
+			if identity := md.Get(mdKey); len(identity) > 0 && identity[0] != "" {
+				return identity[0]
+			}
+		}
+	}
+
+	return x509.SPKIFingerprint(cert).String()
+}
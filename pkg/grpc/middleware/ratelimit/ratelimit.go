@@ -0,0 +1,180 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package ratelimit provides gRPC interceptors which limit the rate of the requests to the expensive API methods
+// for each client.
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// cleanupInterval is the minimum interval between the cleanups of idle clients.
+const cleanupInterval = time.Minute
+
+// Limiter limits the rate and the number of concurrent requests to the configured methods for each client.
+//
+// Each client gets a separate token bucket for each of the methods, while the number of the concurrent requests
+// is counted for all rate limited methods together.
+type Limiter struct {
+	methods       map[string]struct{}
+	limit         rate.Limit
+	burst         int
+	maxConcurrent int
+
+	mu          sync.Mutex
+	clients     map[string]*clientState
+	lastCleanup time.Time
+}
+
+type clientState struct {
+	limiters map[string]*rate.Limiter
+	inFlight int
+}
+
+// NewLimiter creates a new Limiter for the given methods.
+//
+// Methods are full gRPC method names, e.g. `/machine.MachineService/Processes`.
+func NewLimiter(methods []string, requestsPerMinute, burst, maxConcurrent int) *Limiter {
+	l := &Limiter{
+		methods:       make(map[string]struct{}, len(methods)),
+		limit:         rate.Limit(float64(requestsPerMinute) / 60),
+		burst:         burst,
+		maxConcurrent: maxConcurrent,
+		clients:       map[string]*clientState{},
+	}
+
+	for _, method := range methods {
+		l.methods[method] = struct{}{}
+	}
+
+	return l
+}
+
+// acquire checks the limits for the client and reserves the concurrent request slot.
+//
+// The returned function should be called once the request is done.
+func (l *Limiter) acquire(ctx context.Context, method string) (func(), error) {
+	if _, limited := l.methods[method]; !limited {
+		return func() {}, nil
+	}
+
+	identity := Identity(ctx)
+	if identity == "" {
+		// requests without client certificate are not rate limited
+		return func() {}, nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+
+	l.cleanup(now)
+
+	client, ok := l.clients[identity]
+	if !ok {
+		client = &clientState{
+			limiters: map[string]*rate.Limiter{},
+		}
+
+		l.clients[identity] = client
+	}
+
+	if client.inFlight >= l.maxConcurrent {
+		return nil, status.Errorf(codes.ResourceExhausted, "too many concurrent requests to rate limited methods, limit is %d", l.maxConcurrent)
+	}
+
+	limiter, ok := client.limiters[method]
+	if !ok {
+		limiter = rate.NewLimiter(l.limit, l.burst)
+
+		client.limiters[method] = limiter
+	}
+
+	reservation := limiter.ReserveN(now, 1)
+	if !reservation.OK() {
+		return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s", method)
+	}
+
+	if delay := reservation.DelayFrom(now); delay > 0 {
+		reservation.CancelAt(now)
+
+		return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s, retry in %s", method, delay.Round(time.Millisecond))
+	}
+
+	client.inFlight++
+
+	return func() {
+		l.mu.Lock()
+		defer l.mu.Unlock()
+
+		client.inFlight--
+	}, nil
+}
+
+// cleanup removes the clients which have no requests in flight and whose token buckets are full,
+// as such clients are in the same state as the new ones.
+func (l *Limiter) cleanup(now time.Time) {
+	if now.Sub(l.lastCleanup) < cleanupInterval {
+		return
+	}
+
+	l.lastCleanup = now
+
+	for identity, client := range l.clients {
+		if client.inFlight > 0 {
+			continue
+		}
+
+		idle := true
+
+		for _, limiter := range client.limiters {
+			if limiter.TokensAt(now) < float64(l.burst) {
+				idle = false
+
+				break
+			}
+		}
+
+		if idle {
+			delete(l.clients, identity)
+		}
+	}
+}
+
+// UnaryInterceptor returns grpc UnaryServerInterceptor.
+func (l *Limiter) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		release, err := l.acquire(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		defer release()
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor returns grpc StreamServerInterceptor.
+func (l *Limiter) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		release, err := l.acquire(stream.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		defer release()
+
+		return handler(srv, stream)
+	}
+}
@@ -0,0 +1,168 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package ratelimit_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/grpc/middleware/ratelimit"
+	"github.com/siderolabs/talos/pkg/machinery/role"
+)
+
+const (
+	limitedMethod   = "/machine.MachineService/Processes"
+	unlimitedMethod = "/machine.MachineService/Version"
+)
+
+func generateCert(t *testing.T, orgs ...string) *x509.Certificate {
+	t.Helper()
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{Organization: orgs},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, pub, priv)
+	require.NoError(t, err)
+
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert
+}
+
+func clientContext(cert *x509.Certificate, md metadata.MD) context.Context {
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+
+	return peer.NewContext(ctx, &peer.Peer{
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				PeerCertificates: []*x509.Certificate{cert},
+			},
+		},
+	})
+}
+
+func call(ctx context.Context, interceptor grpc.UnaryServerInterceptor, method string) error {
+	_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: method}, func(context.Context, any) (any, error) {
+		return nil, nil //nolint:nilnil
+	})
+
+	return err
+}
+
+func TestRateLimit(t *testing.T) {
+	t.Parallel()
+
+	interceptor := ratelimit.NewLimiter([]string{limitedMethod}, 1, 2, 10).UnaryInterceptor()
+
+	client1 := clientContext(generateCert(t, string(role.Admin)), nil)
+	client2 := clientContext(generateCert(t, string(role.Admin)), nil)
+
+	// burst
+	require.NoError(t, call(client1, interceptor, limitedMethod))
+	require.NoError(t, call(client1, interceptor, limitedMethod))
+
+	err := call(client1, interceptor, limitedMethod)
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// other methods are not limited
+	require.NoError(t, call(client1, interceptor, unlimitedMethod))
+
+	// other clients have their own limits
+	require.NoError(t, call(client2, interceptor, limitedMethod))
+
+	// requests without client certificates are not limited
+	for range 3 {
+		require.NoError(t, call(context.Background(), interceptor, limitedMethod))
+	}
+}
+
+func TestMaxConcurrent(t *testing.T) {
+	t.Parallel()
+
+	interceptor := ratelimit.NewLimiter([]string{limitedMethod}, 600, 10, 1).StreamInterceptor()
+
+	ctx := clientContext(generateCert(t, string(role.Admin)), nil)
+
+	started := make(chan struct{})
+	finish := make(chan struct{})
+	errCh := make(chan error, 1)
+
+	info := &grpc.StreamServerInfo{FullMethod: limitedMethod}
+
+	go func() {
+		errCh <- interceptor(nil, &serverStream{ctx: ctx}, info, func(any, grpc.ServerStream) error {
+			close(started)
+			<-finish
+
+			return nil
+		})
+	}()
+
+	<-started
+
+	err := interceptor(nil, &serverStream{ctx: ctx}, info, func(any, grpc.ServerStream) error { return nil })
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	close(finish)
+	require.NoError(t, <-errCh)
+
+	require.NoError(t, interceptor(nil, &serverStream{ctx: ctx}, info, func(any, grpc.ServerStream) error { return nil }))
+}
+
+func TestIdentity(t *testing.T) {
+	t.Parallel()
+
+	user := generateCert(t, string(role.Admin))
+	apid := generateCert(t, string(role.Impersonator))
+
+	md := metadata.New(nil)
+	ratelimit.SetMetadata(md, ratelimit.Identity(clientContext(user, nil)))
+
+	assert.NotEmpty(t, ratelimit.Identity(clientContext(user, nil)))
+	assert.Empty(t, ratelimit.Identity(context.Background()))
+
+	// requests proxied by apid keep the identity of the original client
+	assert.Equal(t, ratelimit.Identity(clientContext(user, nil)), ratelimit.Identity(clientContext(apid, md)))
+	assert.NotEqual(t, ratelimit.Identity(clientContext(user, nil)), ratelimit.Identity(clientContext(apid, nil)))
+
+	// metadata is not trusted from the clients without the impersonator role
+	assert.NotEqual(t, ratelimit.Identity(clientContext(user, nil)), ratelimit.Identity(clientContext(generateCert(t, string(role.Admin)), md)))
+}
+
+type serverStream struct {
+	grpc.ServerStream
+
+	ctx context.Context //nolint:containedctx
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
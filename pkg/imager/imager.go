@@ -381,6 +381,16 @@ func (i *Imager) buildCmdline() error {
 		if err = cmdline.AppendAll(kernel.SecureBootArgs); err != nil {
 			return err
 		}
+
+		// rootfs dm-verity root hash is protected by the UKI signature
+		if i.prof.Input.RootfsVerityRootHash.Path != "" {
+			rootHash, readErr := os.ReadFile(i.prof.Input.RootfsVerityRootHash.Path)
+			if readErr != nil {
+				return fmt.Errorf("failed to read rootfs verity root hash: %w", readErr)
+			}
+
+			cmdline.Append(constants.KernelParamRootfsVerity, strings.TrimSpace(string(rootHash)))
+		}
 	}
 
 	// meta values can be written only to the "image" output
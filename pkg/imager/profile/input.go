@@ -46,6 +46,10 @@ type Input struct {
 	SDStub FileAsset `yaml:"sdStub,omitempty"`
 	// SDBoot is a sd-boot file (only for SecureBoot).
 	SDBoot FileAsset `yaml:"sdBoot,omitempty"`
+	// RootfsVerityRootHash is a file with the dm-verity root hash of the rootfs (only for SecureBoot).
+	//
+	// If set, the root hash is embedded into the signed kernel command line.
+	RootfsVerityRootHash FileAsset `yaml:"rootfsVerityRootHash,omitempty"`
 	// DTB is a path to the device tree blobs (arm64 only).
 	DTB FileAsset `yaml:"dtb,omitempty"`
 	// UBoot is a path to the u-boot binary (arm64 only).
@@ -215,6 +219,12 @@ func (i *Input) FillDefaults(arch, version string, secureboot bool) {
 			i.SDBoot.Path = fmt.Sprintf(constants.SDBootAssetPath, arch)
 		}
 
+		if i.RootfsVerityRootHash == zeroFileAsset {
+			if rootHashPath := fmt.Sprintf(constants.RootfsVerityRootHashAssetPath, arch); fileExists(rootHashPath) {
+				i.RootfsVerityRootHash.Path = rootHashPath
+			}
+		}
+
 		if i.SecureBoot == nil {
 			i.SecureBoot = &SecureBootAssets{}
 		}
@@ -0,0 +1,71 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging
+
+import (
+	"sync/atomic"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// NewStructuredSwitch creates a new core which writes to the structured core if the flag is set,
+// and to the console core otherwise.
+//
+// The flag can be changed at any time, so that the output format can be switched without rebuilding the loggers.
+func NewStructuredSwitch(console, structured zapcore.Core, enabled *atomic.Bool) zapcore.Core {
+	return &structuredSwitch{
+		console:    console,
+		structured: structured,
+		enabled:    enabled,
+	}
+}
+
+type structuredSwitch struct {
+	console    zapcore.Core
+	structured zapcore.Core
+	enabled    *atomic.Bool
+}
+
+var _ zapcore.Core = (*structuredSwitch)(nil)
+
+func (s *structuredSwitch) current() zapcore.Core {
+	if s.enabled.Load() {
+		return s.structured
+	}
+
+	return s.console
+}
+
+func (s *structuredSwitch) Enabled(level zapcore.Level) bool {
+	return s.console.Enabled(level)
+}
+
+func (s *structuredSwitch) Level() zapcore.Level {
+	return zapcore.LevelOf(s.console)
+}
+
+func (s *structuredSwitch) With(fields []zapcore.Field) zapcore.Core {
+	return &structuredSwitch{
+		console:    s.console.With(fields),
+		structured: s.structured.With(fields),
+		enabled:    s.enabled,
+	}
+}
+
+func (s *structuredSwitch) Check(ent zapcore.Entry, ce *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if s.Enabled(ent.Level) {
+		return ce.AddCore(ent, s)
+	}
+
+	return ce
+}
+
+func (s *structuredSwitch) Write(ent zapcore.Entry, fields []zapcore.Field) error {
+	return s.current().Write(ent, fields)
+}
+
+func (s *structuredSwitch) Sync() error {
+	return s.current().Sync()
+}
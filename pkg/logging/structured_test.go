@@ -0,0 +1,56 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package logging_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/siderolabs/talos/pkg/logging"
+)
+
+func TestStructuredSwitch(t *testing.T) {
+	t.Parallel()
+
+	var (
+		buf        bytes.Buffer
+		structured atomic.Bool
+	)
+
+	logger := logging.ZapLogger(
+		logging.NewLogDestination(&buf, zapcore.InfoLevel, logging.WithStructuredSwitch(&structured)),
+	).With(logging.Component("controller-runtime"))
+
+	logger.Info("console message", zap.String("controller", "c1"))
+	logger.Debug("debug message") // below level
+
+	structured.Store(true)
+
+	logger.Warn("structured message", zap.String("controller", "c2"))
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	require.Len(t, lines, 2)
+
+	assert.Contains(t, lines[0], "INFO")
+	assert.Contains(t, lines[0], `console message {"component": "controller-runtime", "controller": "c1"}`)
+
+	var m map[string]any
+
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &m))
+
+	assert.Equal(t, "warn", m["level"])
+	assert.Equal(t, "structured message", m["msg"])
+	assert.Equal(t, "controller-runtime", m["component"])
+	assert.Equal(t, "c2", m["controller"])
+	assert.NotEmpty(t, m["ts"])
+}
@@ -8,6 +8,7 @@ import (
 	"io"
 	"log"
 	"strings"
+	"sync/atomic"
 
 	"github.com/siderolabs/gen/xslices"
 	"go.uber.org/zap"
@@ -66,6 +67,7 @@ type LogDestination struct {
 	writer            io.Writer
 	config            zapcore.EncoderConfig
 	suppressThreshold int64
+	structured        *atomic.Bool
 }
 
 // LogDestinationOption defines a log destination encoder config setter.
@@ -99,6 +101,15 @@ func WithControllerErrorSuppressor(threshold int64) LogDestinationOption {
 	}
 }
 
+// WithStructuredSwitch enables JSON output when the flag is set.
+//
+// JSON output contains the timestamp, level, message and all the fields of the log entry.
+func WithStructuredSwitch(structured *atomic.Bool) LogDestinationOption {
+	return func(dest *LogDestination) {
+		dest.structured = structured
+	}
+}
+
 // NewLogDestination creates new log destination.
 func NewLogDestination(writer io.Writer, logLevel zapcore.LevelEnabler, options ...LogDestinationOption) *LogDestination {
 	config := zap.NewDevelopmentEncoderConfig()
@@ -118,6 +129,15 @@ func NewLogDestination(writer io.Writer, logLevel zapcore.LevelEnabler, options
 	return dest
 }
 
+func structuredEncoderConfig() zapcore.EncoderConfig {
+	config := zap.NewProductionEncoderConfig()
+	config.TimeKey = "ts"
+	config.EncodeTime = zapcore.RFC3339NanoTimeEncoder
+	config.StacktraceKey = "error"
+
+	return config
+}
+
 // Wrap is a simple helper to wrap io.Writer with default arguments.
 func Wrap(writer io.Writer) *zap.Logger {
 	return ZapLogger(
@@ -138,6 +158,18 @@ func ZapLogger(dests ...*LogDestination) *zap.Logger {
 			dest.level,
 		)
 
+		if dest.structured != nil {
+			core = NewStructuredSwitch(
+				core,
+				zapcore.NewCore(
+					zapcore.NewJSONEncoder(structuredEncoderConfig()),
+					zapcore.AddSync(dest.writer),
+					dest.level,
+				),
+				dest.structured,
+			)
+		}
+
 		if dest.suppressThreshold > 0 {
 			core = NewControllerErrorSuppressor(core, dest.suppressThreshold)
 		}
@@ -41,6 +41,8 @@ const (
 	MachineService_EtcdAlarmDisarm_FullMethodName             = "/machine.MachineService/EtcdAlarmDisarm"
 	MachineService_EtcdDefragment_FullMethodName              = "/machine.MachineService/EtcdDefragment"
 	MachineService_EtcdStatus_FullMethodName                  = "/machine.MachineService/EtcdStatus"
+	MachineService_EtcdRotateCertificates_FullMethodName      = "/machine.MachineService/EtcdRotateCertificates"
+	MachineService_EtcdPrecheck_FullMethodName                = "/machine.MachineService/EtcdPrecheck"
 	MachineService_GenerateConfiguration_FullMethodName       = "/machine.MachineService/GenerateConfiguration"
 	MachineService_Hostname_FullMethodName                    = "/machine.MachineService/Hostname"
 	MachineService_Kubeconfig_FullMethodName                  = "/machine.MachineService/Kubeconfig"
@@ -53,16 +55,19 @@ const (
 	MachineService_Mounts_FullMethodName                      = "/machine.MachineService/Mounts"
 	MachineService_NetworkDeviceStats_FullMethodName          = "/machine.MachineService/NetworkDeviceStats"
 	MachineService_Processes_FullMethodName                   = "/machine.MachineService/Processes"
+	MachineService_ProcessesStream_FullMethodName             = "/machine.MachineService/ProcessesStream"
 	MachineService_Read_FullMethodName                        = "/machine.MachineService/Read"
 	MachineService_Reboot_FullMethodName                      = "/machine.MachineService/Reboot"
 	MachineService_Restart_FullMethodName                     = "/machine.MachineService/Restart"
 	MachineService_Rollback_FullMethodName                    = "/machine.MachineService/Rollback"
 	MachineService_Reset_FullMethodName                       = "/machine.MachineService/Reset"
+	MachineService_ServiceGraph_FullMethodName                = "/machine.MachineService/ServiceGraph"
 	MachineService_ServiceList_FullMethodName                 = "/machine.MachineService/ServiceList"
 	MachineService_ServiceRestart_FullMethodName              = "/machine.MachineService/ServiceRestart"
 	MachineService_ServiceStart_FullMethodName                = "/machine.MachineService/ServiceStart"
 	MachineService_ServiceStop_FullMethodName                 = "/machine.MachineService/ServiceStop"
 	MachineService_Shutdown_FullMethodName                    = "/machine.MachineService/Shutdown"
+	MachineService_PowerActionCancel_FullMethodName           = "/machine.MachineService/PowerActionCancel"
 	MachineService_Stats_FullMethodName                       = "/machine.MachineService/Stats"
 	MachineService_SystemStat_FullMethodName                  = "/machine.MachineService/SystemStat"
 	MachineService_Upgrade_FullMethodName                     = "/machine.MachineService/Upgrade"
@@ -70,10 +75,33 @@ const (
 	MachineService_GenerateClientConfiguration_FullMethodName = "/machine.MachineService/GenerateClientConfiguration"
 	MachineService_PacketCapture_FullMethodName               = "/machine.MachineService/PacketCapture"
 	MachineService_Netstat_FullMethodName                     = "/machine.MachineService/Netstat"
+	MachineService_Neighbors_FullMethodName                   = "/machine.MachineService/Neighbors"
+	MachineService_PathMTU_FullMethodName                     = "/machine.MachineService/PathMTU"
 	MachineService_MetaWrite_FullMethodName                   = "/machine.MachineService/MetaWrite"
 	MachineService_MetaDelete_FullMethodName                  = "/machine.MachineService/MetaDelete"
 	MachineService_ImageList_FullMethodName                   = "/machine.MachineService/ImageList"
 	MachineService_ImagePull_FullMethodName                   = "/machine.MachineService/ImagePull"
+	MachineService_ImageValidate_FullMethodName               = "/machine.MachineService/ImageValidate"
+	MachineService_BootLogs_FullMethodName                    = "/machine.MachineService/BootLogs"
+	MachineService_BMCSensors_FullMethodName                  = "/machine.MachineService/BMCSensors"
+	MachineService_BMCEventLog_FullMethodName                 = "/machine.MachineService/BMCEventLog"
+	MachineService_HardwareInventory_FullMethodName           = "/machine.MachineService/HardwareInventory"
+	MachineService_SensorStats_FullMethodName                 = "/machine.MachineService/SensorStats"
+	MachineService_Profile_FullMethodName                     = "/machine.MachineService/Profile"
+	MachineService_Trace_FullMethodName                       = "/machine.MachineService/Trace"
+	MachineService_Strace_FullMethodName                      = "/machine.MachineService/Strace"
+	MachineService_StackDump_FullMethodName                   = "/machine.MachineService/StackDump"
+	MachineService_DebugAttach_FullMethodName                 = "/machine.MachineService/DebugAttach"
+	MachineService_JoinTokenCreate_FullMethodName             = "/machine.MachineService/JoinTokenCreate"
+	MachineService_JoinTokenList_FullMethodName               = "/machine.MachineService/JoinTokenList"
+	MachineService_JoinTokenRevoke_FullMethodName             = "/machine.MachineService/JoinTokenRevoke"
+	MachineService_Certificates_FullMethodName                = "/machine.MachineService/Certificates"
+	MachineService_DiskBenchmark_FullMethodName               = "/machine.MachineService/DiskBenchmark"
+	MachineService_MemoryBenchmark_FullMethodName             = "/machine.MachineService/MemoryBenchmark"
+	MachineService_NetworkBenchmark_FullMethodName            = "/machine.MachineService/NetworkBenchmark"
+	MachineService_ChaosServiceKill_FullMethodName            = "/machine.MachineService/ChaosServiceKill"
+	MachineService_ChaosNetworkDrop_FullMethodName            = "/machine.MachineService/ChaosNetworkDrop"
+	MachineService_ChaosEphemeralFill_FullMethodName          = "/machine.MachineService/ChaosEphemeralFill"
 )
 
 // MachineServiceClient is the client API for MachineService service.
@@ -123,6 +151,16 @@ type MachineServiceClient interface {
 	// EtcdStatus returns etcd status for the current member.
 	// This method is available only on control plane nodes (which run etcd).
 	EtcdStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*EtcdStatusResponse, error)
+	// EtcdRotateCertificates issues new etcd certificates for the current member
+	// and restarts the member, streaming the progress back to the client.
+	// The rotation is refused if restarting the member would break etcd quorum.
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdRotateCertificates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (MachineService_EtcdRotateCertificatesClient, error)
+	// EtcdPrecheck measures the latency of the disk writes etcd depends on (WAL fsync and backend commit)
+	// in the EPHEMERAL partition, and compares it against the recommended thresholds.
+	// The check should be run before bootstrapping etcd, as the running etcd competes with it for the disk.
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdPrecheck(ctx context.Context, in *EtcdPrecheckRequest, opts ...grpc.CallOption) (*EtcdPrecheckResponse, error)
 	GenerateConfiguration(ctx context.Context, in *GenerateConfigurationRequest, opts ...grpc.CallOption) (*GenerateConfigurationResponse, error)
 	Hostname(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*HostnameResponse, error)
 	Kubeconfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (MachineService_KubeconfigClient, error)
@@ -134,17 +172,24 @@ type MachineServiceClient interface {
 	Memory(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MemoryResponse, error)
 	Mounts(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*MountsResponse, error)
 	NetworkDeviceStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NetworkDeviceStatsResponse, error)
+	// Processes returns the whole process list in a single response, ProcessesStream should be preferred.
 	Processes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ProcessesResponse, error)
+	// ProcessesStream streams the process list in batches, so that the node doesn't keep the whole list in memory.
+	ProcessesStream(ctx context.Context, in *ProcessesStreamRequest, opts ...grpc.CallOption) (MachineService_ProcessesStreamClient, error)
 	Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (MachineService_ReadClient, error)
 	Reboot(ctx context.Context, in *RebootRequest, opts ...grpc.CallOption) (*RebootResponse, error)
 	Restart(ctx context.Context, in *RestartRequest, opts ...grpc.CallOption) (*RestartResponse, error)
 	Rollback(ctx context.Context, in *RollbackRequest, opts ...grpc.CallOption) (*RollbackResponse, error)
 	Reset(ctx context.Context, in *ResetRequest, opts ...grpc.CallOption) (*ResetResponse, error)
+	// ServiceGraph returns the dependencies and the conditions of the services.
+	ServiceGraph(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServiceGraphResponse, error)
 	ServiceList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServiceListResponse, error)
 	ServiceRestart(ctx context.Context, in *ServiceRestartRequest, opts ...grpc.CallOption) (*ServiceRestartResponse, error)
 	ServiceStart(ctx context.Context, in *ServiceStartRequest, opts ...grpc.CallOption) (*ServiceStartResponse, error)
 	ServiceStop(ctx context.Context, in *ServiceStopRequest, opts ...grpc.CallOption) (*ServiceStopResponse, error)
 	Shutdown(ctx context.Context, in *ShutdownRequest, opts ...grpc.CallOption) (*ShutdownResponse, error)
+	// PowerActionCancel cancels the scheduled reboot or shutdown.
+	PowerActionCancel(ctx context.Context, in *PowerActionCancelRequest, opts ...grpc.CallOption) (*PowerActionCancelResponse, error)
 	Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error)
 	SystemStat(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SystemStatResponse, error)
 	Upgrade(ctx context.Context, in *UpgradeRequest, opts ...grpc.CallOption) (*UpgradeResponse, error)
@@ -155,6 +200,10 @@ type MachineServiceClient interface {
 	PacketCapture(ctx context.Context, in *PacketCaptureRequest, opts ...grpc.CallOption) (MachineService_PacketCaptureClient, error)
 	// Netstat provides information about network connections.
 	Netstat(ctx context.Context, in *NetstatRequest, opts ...grpc.CallOption) (*NetstatResponse, error)
+	// Neighbors returns the kernel neighbor table (ARP and NDP entries).
+	Neighbors(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NeighborsResponse, error)
+	// PathMTU discovers the path MTU from the node to the destination.
+	PathMTU(ctx context.Context, in *PathMTURequest, opts ...grpc.CallOption) (*PathMTUResponse, error)
 	// MetaWrite writes a META key-value pair.
 	MetaWrite(ctx context.Context, in *MetaWriteRequest, opts ...grpc.CallOption) (*MetaWriteResponse, error)
 	// MetaDelete deletes a META key.
@@ -163,6 +212,58 @@ type MachineServiceClient interface {
 	ImageList(ctx context.Context, in *ImageListRequest, opts ...grpc.CallOption) (MachineService_ImageListClient, error)
 	// ImagePull pulls an image into the CRI.
 	ImagePull(ctx context.Context, in *ImagePullRequest, opts ...grpc.CallOption) (*ImagePullResponse, error)
+	// ImageValidate runs the upgrade pre-flight checks against the installer image.
+	ImageValidate(ctx context.Context, in *ImageValidateRequest, opts ...grpc.CallOption) (*ImageValidateResponse, error)
+	// BootLogs returns the early boot logs (kernel and machined logs) of the last boots.
+	BootLogs(ctx context.Context, in *BootLogsRequest, opts ...grpc.CallOption) (*BootLogsResponse, error)
+	// BMCSensors returns the chassis power state and the sensor readings reported by the node's BMC via IPMI.
+	BMCSensors(ctx context.Context, in *BMCSensorsRequest, opts ...grpc.CallOption) (*BMCSensorsResponse, error)
+	// BMCEventLog returns the System Event Log (SEL) of the node's BMC via IPMI.
+	BMCEventLog(ctx context.Context, in *BMCEventLogRequest, opts ...grpc.CallOption) (*BMCEventLogResponse, error)
+	// HardwareInventory returns the hardware inventory of the node: DMI/SMBIOS data, PCI devices, NICs, memory modules and disks.
+	HardwareInventory(ctx context.Context, in *HardwareInventoryRequest, opts ...grpc.CallOption) (*HardwareInventoryResponse, error)
+	// SensorStats returns the hardware monitoring (hwmon) sensor readings: temperature, fan, voltage, current and power.
+	SensorStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SensorStatsResponse, error)
+	// Profile collects a pprof profile of a Talos service (machined, apid or trustd).
+	Profile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (MachineService_ProfileClient, error)
+	// Trace runs an eBPF-based tracing tool and streams the traced events.
+	Trace(ctx context.Context, in *TraceRequest, opts ...grpc.CallOption) (MachineService_TraceClient, error)
+	// Strace attaches to the process with ptrace and streams the syscalls it makes.
+	Strace(ctx context.Context, in *StraceRequest, opts ...grpc.CallOption) (MachineService_StraceClient, error)
+	// StackDump dumps the goroutine stacks of a Talos service (machined, apid or trustd) in plain text.
+	StackDump(ctx context.Context, in *StackDumpRequest, opts ...grpc.CallOption) (MachineService_StackDumpClient, error)
+	// DebugAttach attaches the delve debugger to a Talos service process, and tunnels
+	// the connection to the debugger API server.
+	//
+	// The API is only available in the debug builds of Talos.
+	DebugAttach(ctx context.Context, opts ...grpc.CallOption) (MachineService_DebugAttachClient, error)
+	// JoinTokenCreate creates a short-lived join token accepted by trustd in addition to the machine token.
+	// This method is available only on control plane nodes (which run etcd).
+	JoinTokenCreate(ctx context.Context, in *JoinTokenCreateRequest, opts ...grpc.CallOption) (*JoinTokenCreateResponse, error)
+	// JoinTokenList lists the join tokens which are not expired.
+	// This method is available only on control plane nodes (which run etcd).
+	JoinTokenList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*JoinTokenListResponse, error)
+	// JoinTokenRevoke revokes the join token.
+	// This method is available only on control plane nodes (which run etcd).
+	JoinTokenRevoke(ctx context.Context, in *JoinTokenRevokeRequest, opts ...grpc.CallOption) (*JoinTokenRevokeResponse, error)
+	// Certificates lists the certificates held by the node with their issuer, SANs and expiration.
+	Certificates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CertificatesResponse, error)
+	// DiskBenchmark runs the sequential and random read/write tests on a scratch file in the EPHEMERAL partition.
+	DiskBenchmark(ctx context.Context, in *DiskBenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResponse, error)
+	// MemoryBenchmark measures the memory read, write and copy bandwidth.
+	MemoryBenchmark(ctx context.Context, in *MemoryBenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResponse, error)
+	// NetworkBenchmark measures the TCP throughput between two nodes.
+	//
+	// One node should be called as the server (with empty server address), and the other one as the client.
+	NetworkBenchmark(ctx context.Context, in *NetworkBenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResponse, error)
+	// ChaosServiceKill kills all processes of the service with SIGKILL, the service is restarted as after a crash.
+	//
+	// The chaos methods are only available if enabled in the machine configuration, and to the os:chaos role.
+	ChaosServiceKill(ctx context.Context, in *ChaosServiceKillRequest, opts ...grpc.CallOption) (*ChaosResponse, error)
+	// ChaosNetworkDrop drops all network traffic to and from the given subnets for the given duration.
+	ChaosNetworkDrop(ctx context.Context, in *ChaosNetworkDropRequest, opts ...grpc.CallOption) (*ChaosResponse, error)
+	// ChaosEphemeralFill fills the EPHEMERAL partition up to the given percentage of its size for the given duration.
+	ChaosEphemeralFill(ctx context.Context, in *ChaosEphemeralFillRequest, opts ...grpc.CallOption) (*ChaosResponse, error)
 }
 
 type machineServiceClient struct {
@@ -470,6 +571,49 @@ func (c *machineServiceClient) EtcdStatus(ctx context.Context, in *emptypb.Empty
 	return out, nil
 }
 
+func (c *machineServiceClient) EtcdRotateCertificates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (MachineService_EtcdRotateCertificatesClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[5], MachineService_EtcdRotateCertificates_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceEtcdRotateCertificatesClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MachineService_EtcdRotateCertificatesClient interface {
+	Recv() (*EtcdRotateCertificatesProgress, error)
+	grpc.ClientStream
+}
+
+type machineServiceEtcdRotateCertificatesClient struct {
+	grpc.ClientStream
+}
+
+func (x *machineServiceEtcdRotateCertificatesClient) Recv() (*EtcdRotateCertificatesProgress, error) {
+	m := new(EtcdRotateCertificatesProgress)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *machineServiceClient) EtcdPrecheck(ctx context.Context, in *EtcdPrecheckRequest, opts ...grpc.CallOption) (*EtcdPrecheckResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EtcdPrecheckResponse)
+	err := c.cc.Invoke(ctx, MachineService_EtcdPrecheck_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) GenerateConfiguration(ctx context.Context, in *GenerateConfigurationRequest, opts ...grpc.CallOption) (*GenerateConfigurationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GenerateConfigurationResponse)
@@ -492,7 +636,7 @@ func (c *machineServiceClient) Hostname(ctx context.Context, in *emptypb.Empty,
 
 func (c *machineServiceClient) Kubeconfig(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (MachineService_KubeconfigClient, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[5], MachineService_Kubeconfig_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[6], MachineService_Kubeconfig_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -525,7 +669,7 @@ func (x *machineServiceKubeconfigClient) Recv() (*common.Data, error) {
 
 func (c *machineServiceClient) List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (MachineService_ListClient, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[6], MachineService_List_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[7], MachineService_List_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -558,7 +702,7 @@ func (x *machineServiceListClient) Recv() (*FileInfo, error) {
 
 func (c *machineServiceClient) DiskUsage(ctx context.Context, in *DiskUsageRequest, opts ...grpc.CallOption) (MachineService_DiskUsageClient, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[7], MachineService_DiskUsage_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[8], MachineService_DiskUsage_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -601,7 +745,7 @@ func (c *machineServiceClient) LoadAvg(ctx context.Context, in *emptypb.Empty, o
 
 func (c *machineServiceClient) Logs(ctx context.Context, in *LogsRequest, opts ...grpc.CallOption) (MachineService_LogsClient, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[8], MachineService_Logs_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[9], MachineService_Logs_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -682,9 +826,42 @@ func (c *machineServiceClient) Processes(ctx context.Context, in *emptypb.Empty,
 	return out, nil
 }
 
+func (c *machineServiceClient) ProcessesStream(ctx context.Context, in *ProcessesStreamRequest, opts ...grpc.CallOption) (MachineService_ProcessesStreamClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[10], MachineService_ProcessesStream_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceProcessesStreamClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MachineService_ProcessesStreamClient interface {
+	Recv() (*Process, error)
+	grpc.ClientStream
+}
+
+type machineServiceProcessesStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *machineServiceProcessesStreamClient) Recv() (*Process, error) {
+	m := new(Process)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *machineServiceClient) Read(ctx context.Context, in *ReadRequest, opts ...grpc.CallOption) (MachineService_ReadClient, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[9], MachineService_Read_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[11], MachineService_Read_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -755,6 +932,16 @@ func (c *machineServiceClient) Reset(ctx context.Context, in *ResetRequest, opts
 	return out, nil
 }
 
+func (c *machineServiceClient) ServiceGraph(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServiceGraphResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ServiceGraphResponse)
+	err := c.cc.Invoke(ctx, MachineService_ServiceGraph_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) ServiceList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ServiceListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ServiceListResponse)
@@ -805,6 +992,16 @@ func (c *machineServiceClient) Shutdown(ctx context.Context, in *ShutdownRequest
 	return out, nil
 }
 
+func (c *machineServiceClient) PowerActionCancel(ctx context.Context, in *PowerActionCancelRequest, opts ...grpc.CallOption) (*PowerActionCancelResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PowerActionCancelResponse)
+	err := c.cc.Invoke(ctx, MachineService_PowerActionCancel_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) Stats(ctx context.Context, in *StatsRequest, opts ...grpc.CallOption) (*StatsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(StatsResponse)
@@ -857,7 +1054,7 @@ func (c *machineServiceClient) GenerateClientConfiguration(ctx context.Context,
 
 func (c *machineServiceClient) PacketCapture(ctx context.Context, in *PacketCaptureRequest, opts ...grpc.CallOption) (MachineService_PacketCaptureClient, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[10], MachineService_PacketCapture_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[12], MachineService_PacketCapture_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -898,6 +1095,26 @@ func (c *machineServiceClient) Netstat(ctx context.Context, in *NetstatRequest,
 	return out, nil
 }
 
+func (c *machineServiceClient) Neighbors(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*NeighborsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NeighborsResponse)
+	err := c.cc.Invoke(ctx, MachineService_Neighbors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) PathMTU(ctx context.Context, in *PathMTURequest, opts ...grpc.CallOption) (*PathMTUResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PathMTUResponse)
+	err := c.cc.Invoke(ctx, MachineService_PathMTU_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *machineServiceClient) MetaWrite(ctx context.Context, in *MetaWriteRequest, opts ...grpc.CallOption) (*MetaWriteResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(MetaWriteResponse)
@@ -920,7 +1137,7 @@ func (c *machineServiceClient) MetaDelete(ctx context.Context, in *MetaDeleteReq
 
 func (c *machineServiceClient) ImageList(ctx context.Context, in *ImageListRequest, opts ...grpc.CallOption) (MachineService_ImageListClient, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
-	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[11], MachineService_ImageList_FullMethodName, cOpts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[13], MachineService_ImageList_FullMethodName, cOpts...)
 	if err != nil {
 		return nil, err
 	}
@@ -961,1221 +1178,2232 @@ func (c *machineServiceClient) ImagePull(ctx context.Context, in *ImagePullReque
 	return out, nil
 }
 
-// MachineServiceServer is the server API for MachineService service.
-// All implementations must embed UnimplementedMachineServiceServer
-// for forward compatibility
-//
-// The machine service definition.
-type MachineServiceServer interface {
-	ApplyConfiguration(context.Context, *ApplyConfigurationRequest) (*ApplyConfigurationResponse, error)
-	// Bootstrap method makes control plane node enter etcd bootstrap mode.
-	// Node aborts etcd join sequence and creates single-node etcd cluster.
-	// If recover_etcd argument is specified, etcd is recovered from a snapshot
-	// uploaded with EtcdRecover.
-	Bootstrap(context.Context, *BootstrapRequest) (*BootstrapResponse, error)
-	Containers(context.Context, *ContainersRequest) (*ContainersResponse, error)
-	Copy(*CopyRequest, MachineService_CopyServer) error
-	CPUInfo(context.Context, *emptypb.Empty) (*CPUInfoResponse, error)
-	DiskStats(context.Context, *emptypb.Empty) (*DiskStatsResponse, error)
-	Dmesg(*DmesgRequest, MachineService_DmesgServer) error
-	Events(*EventsRequest, MachineService_EventsServer) error
-	EtcdMemberList(context.Context, *EtcdMemberListRequest) (*EtcdMemberListResponse, error)
-	// EtcdRemoveMemberByID removes a member from the etcd cluster identified by member ID.
-	// This API should be used to remove members which don't have an associated Talos node anymore.
-	// To remove a member with a running Talos node, use EtcdLeaveCluster API on the node to be removed.
-	EtcdRemoveMemberByID(context.Context, *EtcdRemoveMemberByIDRequest) (*EtcdRemoveMemberByIDResponse, error)
-	EtcdLeaveCluster(context.Context, *EtcdLeaveClusterRequest) (*EtcdLeaveClusterResponse, error)
-	EtcdForfeitLeadership(context.Context, *EtcdForfeitLeadershipRequest) (*EtcdForfeitLeadershipResponse, error)
-	// EtcdRecover method uploads etcd data snapshot created with EtcdSnapshot
-	// to the node.
-	// Snapshot can be later used to recover the cluster via Bootstrap method.
-	EtcdRecover(MachineService_EtcdRecoverServer) error
-	// EtcdSnapshot method creates etcd data snapshot (backup) from the local etcd instance
-	// and streams it back to the client.
-	// This method is available only on control plane nodes (which run etcd).
-	EtcdSnapshot(*EtcdSnapshotRequest, MachineService_EtcdSnapshotServer) error
-	// EtcdAlarmList lists etcd alarms for the current node.
-	// This method is available only on control plane nodes (which run etcd).
-	EtcdAlarmList(context.Context, *emptypb.Empty) (*EtcdAlarmListResponse, error)
-	// EtcdAlarmDisarm disarms etcd alarms for the current node.
-	// This method is available only on control plane nodes (which run etcd).
-	EtcdAlarmDisarm(context.Context, *emptypb.Empty) (*EtcdAlarmDisarmResponse, error)
-	// EtcdDefragment defragments etcd data directory for the current node.
-	// Defragmentation is a resource-heavy operation, so it should only run on a specific
-	// node.
-	// This method is available only on control plane nodes (which run etcd).
-	EtcdDefragment(context.Context, *emptypb.Empty) (*EtcdDefragmentResponse, error)
-	// EtcdStatus returns etcd status for the current member.
-	// This method is available only on control plane nodes (which run etcd).
-	EtcdStatus(context.Context, *emptypb.Empty) (*EtcdStatusResponse, error)
-	GenerateConfiguration(context.Context, *GenerateConfigurationRequest) (*GenerateConfigurationResponse, error)
-	Hostname(context.Context, *emptypb.Empty) (*HostnameResponse, error)
-	Kubeconfig(*emptypb.Empty, MachineService_KubeconfigServer) error
-	List(*ListRequest, MachineService_ListServer) error
-	DiskUsage(*DiskUsageRequest, MachineService_DiskUsageServer) error
-	LoadAvg(context.Context, *emptypb.Empty) (*LoadAvgResponse, error)
-	Logs(*LogsRequest, MachineService_LogsServer) error
-	LogsContainers(context.Context, *emptypb.Empty) (*LogsContainersResponse, error)
-	Memory(context.Context, *emptypb.Empty) (*MemoryResponse, error)
-	Mounts(context.Context, *emptypb.Empty) (*MountsResponse, error)
-	NetworkDeviceStats(context.Context, *emptypb.Empty) (*NetworkDeviceStatsResponse, error)
-	Processes(context.Context, *emptypb.Empty) (*ProcessesResponse, error)
-	Read(*ReadRequest, MachineService_ReadServer) error
-	Reboot(context.Context, *RebootRequest) (*RebootResponse, error)
-	Restart(context.Context, *RestartRequest) (*RestartResponse, error)
-	Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error)
-	Reset(context.Context, *ResetRequest) (*ResetResponse, error)
-	ServiceList(context.Context, *emptypb.Empty) (*ServiceListResponse, error)
-	ServiceRestart(context.Context, *ServiceRestartRequest) (*ServiceRestartResponse, error)
-	ServiceStart(context.Context, *ServiceStartRequest) (*ServiceStartResponse, error)
-	ServiceStop(context.Context, *ServiceStopRequest) (*ServiceStopResponse, error)
-	Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error)
-	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
-	SystemStat(context.Context, *emptypb.Empty) (*SystemStatResponse, error)
-	Upgrade(context.Context, *UpgradeRequest) (*UpgradeResponse, error)
-	Version(context.Context, *emptypb.Empty) (*VersionResponse, error)
-	// GenerateClientConfiguration generates talosctl client configuration (talosconfig).
-	GenerateClientConfiguration(context.Context, *GenerateClientConfigurationRequest) (*GenerateClientConfigurationResponse, error)
-	// PacketCapture performs packet capture and streams back pcap file.
-	PacketCapture(*PacketCaptureRequest, MachineService_PacketCaptureServer) error
-	// Netstat provides information about network connections.
-	Netstat(context.Context, *NetstatRequest) (*NetstatResponse, error)
-	// MetaWrite writes a META key-value pair.
-	MetaWrite(context.Context, *MetaWriteRequest) (*MetaWriteResponse, error)
-	// MetaDelete deletes a META key.
-	MetaDelete(context.Context, *MetaDeleteRequest) (*MetaDeleteResponse, error)
-	// ImageList lists images in the CRI.
-	ImageList(*ImageListRequest, MachineService_ImageListServer) error
-	// ImagePull pulls an image into the CRI.
-	ImagePull(context.Context, *ImagePullRequest) (*ImagePullResponse, error)
-	mustEmbedUnimplementedMachineServiceServer()
+func (c *machineServiceClient) ImageValidate(ctx context.Context, in *ImageValidateRequest, opts ...grpc.CallOption) (*ImageValidateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImageValidateResponse)
+	err := c.cc.Invoke(ctx, MachineService_ImageValidate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedMachineServiceServer must be embedded to have forward compatible implementations.
-type UnimplementedMachineServiceServer struct {
+func (c *machineServiceClient) BootLogs(ctx context.Context, in *BootLogsRequest, opts ...grpc.CallOption) (*BootLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BootLogsResponse)
+	err := c.cc.Invoke(ctx, MachineService_BootLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (UnimplementedMachineServiceServer) ApplyConfiguration(context.Context, *ApplyConfigurationRequest) (*ApplyConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ApplyConfiguration not implemented")
-}
-func (UnimplementedMachineServiceServer) Bootstrap(context.Context, *BootstrapRequest) (*BootstrapResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Bootstrap not implemented")
+func (c *machineServiceClient) BMCSensors(ctx context.Context, in *BMCSensorsRequest, opts ...grpc.CallOption) (*BMCSensorsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BMCSensorsResponse)
+	err := c.cc.Invoke(ctx, MachineService_BMCSensors_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) Containers(context.Context, *ContainersRequest) (*ContainersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Containers not implemented")
+
+func (c *machineServiceClient) BMCEventLog(ctx context.Context, in *BMCEventLogRequest, opts ...grpc.CallOption) (*BMCEventLogResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BMCEventLogResponse)
+	err := c.cc.Invoke(ctx, MachineService_BMCEventLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) Copy(*CopyRequest, MachineService_CopyServer) error {
-	return status.Errorf(codes.Unimplemented, "method Copy not implemented")
+
+func (c *machineServiceClient) HardwareInventory(ctx context.Context, in *HardwareInventoryRequest, opts ...grpc.CallOption) (*HardwareInventoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(HardwareInventoryResponse)
+	err := c.cc.Invoke(ctx, MachineService_HardwareInventory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) CPUInfo(context.Context, *emptypb.Empty) (*CPUInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CPUInfo not implemented")
+
+func (c *machineServiceClient) SensorStats(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SensorStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SensorStatsResponse)
+	err := c.cc.Invoke(ctx, MachineService_SensorStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedMachineServiceServer) DiskStats(context.Context, *emptypb.Empty) (*DiskStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DiskStats not implemented")
+
+func (c *machineServiceClient) Profile(ctx context.Context, in *ProfileRequest, opts ...grpc.CallOption) (MachineService_ProfileClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[14], MachineService_Profile_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceProfileClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedMachineServiceServer) Dmesg(*DmesgRequest, MachineService_DmesgServer) error {
-	return status.Errorf(codes.Unimplemented, "method Dmesg not implemented")
+
+type MachineService_ProfileClient interface {
+	Recv() (*common.Data, error)
+	grpc.ClientStream
 }
-func (UnimplementedMachineServiceServer) Events(*EventsRequest, MachineService_EventsServer) error {
-	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+
+type machineServiceProfileClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedMachineServiceServer) EtcdMemberList(context.Context, *EtcdMemberListRequest) (*EtcdMemberListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EtcdMemberList not implemented")
+
+func (x *machineServiceProfileClient) Recv() (*common.Data, error) {
+	m := new(common.Data)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedMachineServiceServer) EtcdRemoveMemberByID(context.Context, *EtcdRemoveMemberByIDRequest) (*EtcdRemoveMemberByIDResponse, error) {
+
+func (c *machineServiceClient) Trace(ctx context.Context, in *TraceRequest, opts ...grpc.CallOption) (MachineService_TraceClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[15], MachineService_Trace_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceTraceClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MachineService_TraceClient interface {
+	Recv() (*TraceEvent, error)
+	grpc.ClientStream
+}
+
+type machineServiceTraceClient struct {
+	grpc.ClientStream
+}
+
+func (x *machineServiceTraceClient) Recv() (*TraceEvent, error) {
+	m := new(TraceEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *machineServiceClient) Strace(ctx context.Context, in *StraceRequest, opts ...grpc.CallOption) (MachineService_StraceClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[16], MachineService_Strace_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceStraceClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MachineService_StraceClient interface {
+	Recv() (*StraceEvent, error)
+	grpc.ClientStream
+}
+
+type machineServiceStraceClient struct {
+	grpc.ClientStream
+}
+
+func (x *machineServiceStraceClient) Recv() (*StraceEvent, error) {
+	m := new(StraceEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *machineServiceClient) StackDump(ctx context.Context, in *StackDumpRequest, opts ...grpc.CallOption) (MachineService_StackDumpClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[17], MachineService_StackDump_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceStackDumpClient{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MachineService_StackDumpClient interface {
+	Recv() (*common.Data, error)
+	grpc.ClientStream
+}
+
+type machineServiceStackDumpClient struct {
+	grpc.ClientStream
+}
+
+func (x *machineServiceStackDumpClient) Recv() (*common.Data, error) {
+	m := new(common.Data)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *machineServiceClient) DebugAttach(ctx context.Context, opts ...grpc.CallOption) (MachineService_DebugAttachClient, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MachineService_ServiceDesc.Streams[18], MachineService_DebugAttach_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &machineServiceDebugAttachClient{ClientStream: stream}
+	return x, nil
+}
+
+type MachineService_DebugAttachClient interface {
+	Send(*DebugAttachRequest) error
+	Recv() (*common.Data, error)
+	grpc.ClientStream
+}
+
+type machineServiceDebugAttachClient struct {
+	grpc.ClientStream
+}
+
+func (x *machineServiceDebugAttachClient) Send(m *DebugAttachRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *machineServiceDebugAttachClient) Recv() (*common.Data, error) {
+	m := new(common.Data)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *machineServiceClient) JoinTokenCreate(ctx context.Context, in *JoinTokenCreateRequest, opts ...grpc.CallOption) (*JoinTokenCreateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinTokenCreateResponse)
+	err := c.cc.Invoke(ctx, MachineService_JoinTokenCreate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) JoinTokenList(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*JoinTokenListResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinTokenListResponse)
+	err := c.cc.Invoke(ctx, MachineService_JoinTokenList_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) JoinTokenRevoke(ctx context.Context, in *JoinTokenRevokeRequest, opts ...grpc.CallOption) (*JoinTokenRevokeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(JoinTokenRevokeResponse)
+	err := c.cc.Invoke(ctx, MachineService_JoinTokenRevoke_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) Certificates(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CertificatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CertificatesResponse)
+	err := c.cc.Invoke(ctx, MachineService_Certificates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) DiskBenchmark(ctx context.Context, in *DiskBenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BenchmarkResponse)
+	err := c.cc.Invoke(ctx, MachineService_DiskBenchmark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) MemoryBenchmark(ctx context.Context, in *MemoryBenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BenchmarkResponse)
+	err := c.cc.Invoke(ctx, MachineService_MemoryBenchmark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) NetworkBenchmark(ctx context.Context, in *NetworkBenchmarkRequest, opts ...grpc.CallOption) (*BenchmarkResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BenchmarkResponse)
+	err := c.cc.Invoke(ctx, MachineService_NetworkBenchmark_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) ChaosServiceKill(ctx context.Context, in *ChaosServiceKillRequest, opts ...grpc.CallOption) (*ChaosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChaosResponse)
+	err := c.cc.Invoke(ctx, MachineService_ChaosServiceKill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) ChaosNetworkDrop(ctx context.Context, in *ChaosNetworkDropRequest, opts ...grpc.CallOption) (*ChaosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChaosResponse)
+	err := c.cc.Invoke(ctx, MachineService_ChaosNetworkDrop_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *machineServiceClient) ChaosEphemeralFill(ctx context.Context, in *ChaosEphemeralFillRequest, opts ...grpc.CallOption) (*ChaosResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ChaosResponse)
+	err := c.cc.Invoke(ctx, MachineService_ChaosEphemeralFill_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MachineServiceServer is the server API for MachineService service.
+// All implementations must embed UnimplementedMachineServiceServer
+// for forward compatibility
+//
+// The machine service definition.
+type MachineServiceServer interface {
+	ApplyConfiguration(context.Context, *ApplyConfigurationRequest) (*ApplyConfigurationResponse, error)
+	// Bootstrap method makes control plane node enter etcd bootstrap mode.
+	// Node aborts etcd join sequence and creates single-node etcd cluster.
+	// If recover_etcd argument is specified, etcd is recovered from a snapshot
+	// uploaded with EtcdRecover.
+	Bootstrap(context.Context, *BootstrapRequest) (*BootstrapResponse, error)
+	Containers(context.Context, *ContainersRequest) (*ContainersResponse, error)
+	Copy(*CopyRequest, MachineService_CopyServer) error
+	CPUInfo(context.Context, *emptypb.Empty) (*CPUInfoResponse, error)
+	DiskStats(context.Context, *emptypb.Empty) (*DiskStatsResponse, error)
+	Dmesg(*DmesgRequest, MachineService_DmesgServer) error
+	Events(*EventsRequest, MachineService_EventsServer) error
+	EtcdMemberList(context.Context, *EtcdMemberListRequest) (*EtcdMemberListResponse, error)
+	// EtcdRemoveMemberByID removes a member from the etcd cluster identified by member ID.
+	// This API should be used to remove members which don't have an associated Talos node anymore.
+	// To remove a member with a running Talos node, use EtcdLeaveCluster API on the node to be removed.
+	EtcdRemoveMemberByID(context.Context, *EtcdRemoveMemberByIDRequest) (*EtcdRemoveMemberByIDResponse, error)
+	EtcdLeaveCluster(context.Context, *EtcdLeaveClusterRequest) (*EtcdLeaveClusterResponse, error)
+	EtcdForfeitLeadership(context.Context, *EtcdForfeitLeadershipRequest) (*EtcdForfeitLeadershipResponse, error)
+	// EtcdRecover method uploads etcd data snapshot created with EtcdSnapshot
+	// to the node.
+	// Snapshot can be later used to recover the cluster via Bootstrap method.
+	EtcdRecover(MachineService_EtcdRecoverServer) error
+	// EtcdSnapshot method creates etcd data snapshot (backup) from the local etcd instance
+	// and streams it back to the client.
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdSnapshot(*EtcdSnapshotRequest, MachineService_EtcdSnapshotServer) error
+	// EtcdAlarmList lists etcd alarms for the current node.
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdAlarmList(context.Context, *emptypb.Empty) (*EtcdAlarmListResponse, error)
+	// EtcdAlarmDisarm disarms etcd alarms for the current node.
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdAlarmDisarm(context.Context, *emptypb.Empty) (*EtcdAlarmDisarmResponse, error)
+	// EtcdDefragment defragments etcd data directory for the current node.
+	// Defragmentation is a resource-heavy operation, so it should only run on a specific
+	// node.
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdDefragment(context.Context, *emptypb.Empty) (*EtcdDefragmentResponse, error)
+	// EtcdStatus returns etcd status for the current member.
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdStatus(context.Context, *emptypb.Empty) (*EtcdStatusResponse, error)
+	// EtcdRotateCertificates issues new etcd certificates for the current member
+	// and restarts the member, streaming the progress back to the client.
+	// The rotation is refused if restarting the member would break etcd quorum.
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdRotateCertificates(*emptypb.Empty, MachineService_EtcdRotateCertificatesServer) error
+	// EtcdPrecheck measures the latency of the disk writes etcd depends on (WAL fsync and backend commit)
+	// in the EPHEMERAL partition, and compares it against the recommended thresholds.
+	// The check should be run before bootstrapping etcd, as the running etcd competes with it for the disk.
+	// This method is available only on control plane nodes (which run etcd).
+	EtcdPrecheck(context.Context, *EtcdPrecheckRequest) (*EtcdPrecheckResponse, error)
+	GenerateConfiguration(context.Context, *GenerateConfigurationRequest) (*GenerateConfigurationResponse, error)
+	Hostname(context.Context, *emptypb.Empty) (*HostnameResponse, error)
+	Kubeconfig(*emptypb.Empty, MachineService_KubeconfigServer) error
+	List(*ListRequest, MachineService_ListServer) error
+	DiskUsage(*DiskUsageRequest, MachineService_DiskUsageServer) error
+	LoadAvg(context.Context, *emptypb.Empty) (*LoadAvgResponse, error)
+	Logs(*LogsRequest, MachineService_LogsServer) error
+	LogsContainers(context.Context, *emptypb.Empty) (*LogsContainersResponse, error)
+	Memory(context.Context, *emptypb.Empty) (*MemoryResponse, error)
+	Mounts(context.Context, *emptypb.Empty) (*MountsResponse, error)
+	NetworkDeviceStats(context.Context, *emptypb.Empty) (*NetworkDeviceStatsResponse, error)
+	// Processes returns the whole process list in a single response, ProcessesStream should be preferred.
+	Processes(context.Context, *emptypb.Empty) (*ProcessesResponse, error)
+	// ProcessesStream streams the process list in batches, so that the node doesn't keep the whole list in memory.
+	ProcessesStream(*ProcessesStreamRequest, MachineService_ProcessesStreamServer) error
+	Read(*ReadRequest, MachineService_ReadServer) error
+	Reboot(context.Context, *RebootRequest) (*RebootResponse, error)
+	Restart(context.Context, *RestartRequest) (*RestartResponse, error)
+	Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error)
+	Reset(context.Context, *ResetRequest) (*ResetResponse, error)
+	// ServiceGraph returns the dependencies and the conditions of the services.
+	ServiceGraph(context.Context, *emptypb.Empty) (*ServiceGraphResponse, error)
+	ServiceList(context.Context, *emptypb.Empty) (*ServiceListResponse, error)
+	ServiceRestart(context.Context, *ServiceRestartRequest) (*ServiceRestartResponse, error)
+	ServiceStart(context.Context, *ServiceStartRequest) (*ServiceStartResponse, error)
+	ServiceStop(context.Context, *ServiceStopRequest) (*ServiceStopResponse, error)
+	Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error)
+	// PowerActionCancel cancels the scheduled reboot or shutdown.
+	PowerActionCancel(context.Context, *PowerActionCancelRequest) (*PowerActionCancelResponse, error)
+	Stats(context.Context, *StatsRequest) (*StatsResponse, error)
+	SystemStat(context.Context, *emptypb.Empty) (*SystemStatResponse, error)
+	Upgrade(context.Context, *UpgradeRequest) (*UpgradeResponse, error)
+	Version(context.Context, *emptypb.Empty) (*VersionResponse, error)
+	// GenerateClientConfiguration generates talosctl client configuration (talosconfig).
+	GenerateClientConfiguration(context.Context, *GenerateClientConfigurationRequest) (*GenerateClientConfigurationResponse, error)
+	// PacketCapture performs packet capture and streams back pcap file.
+	PacketCapture(*PacketCaptureRequest, MachineService_PacketCaptureServer) error
+	// Netstat provides information about network connections.
+	Netstat(context.Context, *NetstatRequest) (*NetstatResponse, error)
+	// Neighbors returns the kernel neighbor table (ARP and NDP entries).
+	Neighbors(context.Context, *emptypb.Empty) (*NeighborsResponse, error)
+	// PathMTU discovers the path MTU from the node to the destination.
+	PathMTU(context.Context, *PathMTURequest) (*PathMTUResponse, error)
+	// MetaWrite writes a META key-value pair.
+	MetaWrite(context.Context, *MetaWriteRequest) (*MetaWriteResponse, error)
+	// MetaDelete deletes a META key.
+	MetaDelete(context.Context, *MetaDeleteRequest) (*MetaDeleteResponse, error)
+	// ImageList lists images in the CRI.
+	ImageList(*ImageListRequest, MachineService_ImageListServer) error
+	// ImagePull pulls an image into the CRI.
+	ImagePull(context.Context, *ImagePullRequest) (*ImagePullResponse, error)
+	// ImageValidate runs the upgrade pre-flight checks against the installer image.
+	ImageValidate(context.Context, *ImageValidateRequest) (*ImageValidateResponse, error)
+	// BootLogs returns the early boot logs (kernel and machined logs) of the last boots.
+	BootLogs(context.Context, *BootLogsRequest) (*BootLogsResponse, error)
+	// BMCSensors returns the chassis power state and the sensor readings reported by the node's BMC via IPMI.
+	BMCSensors(context.Context, *BMCSensorsRequest) (*BMCSensorsResponse, error)
+	// BMCEventLog returns the System Event Log (SEL) of the node's BMC via IPMI.
+	BMCEventLog(context.Context, *BMCEventLogRequest) (*BMCEventLogResponse, error)
+	// HardwareInventory returns the hardware inventory of the node: DMI/SMBIOS data, PCI devices, NICs, memory modules and disks.
+	HardwareInventory(context.Context, *HardwareInventoryRequest) (*HardwareInventoryResponse, error)
+	// SensorStats returns the hardware monitoring (hwmon) sensor readings: temperature, fan, voltage, current and power.
+	SensorStats(context.Context, *emptypb.Empty) (*SensorStatsResponse, error)
+	// Profile collects a pprof profile of a Talos service (machined, apid or trustd).
+	Profile(*ProfileRequest, MachineService_ProfileServer) error
+	// Trace runs an eBPF-based tracing tool and streams the traced events.
+	Trace(*TraceRequest, MachineService_TraceServer) error
+	// Strace attaches to the process with ptrace and streams the syscalls it makes.
+	Strace(*StraceRequest, MachineService_StraceServer) error
+	// StackDump dumps the goroutine stacks of a Talos service (machined, apid or trustd) in plain text.
+	StackDump(*StackDumpRequest, MachineService_StackDumpServer) error
+	// DebugAttach attaches the delve debugger to a Talos service process, and tunnels
+	// the connection to the debugger API server.
+	//
+	// The API is only available in the debug builds of Talos.
+	DebugAttach(MachineService_DebugAttachServer) error
+	// JoinTokenCreate creates a short-lived join token accepted by trustd in addition to the machine token.
+	// This method is available only on control plane nodes (which run etcd).
+	JoinTokenCreate(context.Context, *JoinTokenCreateRequest) (*JoinTokenCreateResponse, error)
+	// JoinTokenList lists the join tokens which are not expired.
+	// This method is available only on control plane nodes (which run etcd).
+	JoinTokenList(context.Context, *emptypb.Empty) (*JoinTokenListResponse, error)
+	// JoinTokenRevoke revokes the join token.
+	// This method is available only on control plane nodes (which run etcd).
+	JoinTokenRevoke(context.Context, *JoinTokenRevokeRequest) (*JoinTokenRevokeResponse, error)
+	// Certificates lists the certificates held by the node with their issuer, SANs and expiration.
+	Certificates(context.Context, *emptypb.Empty) (*CertificatesResponse, error)
+	// DiskBenchmark runs the sequential and random read/write tests on a scratch file in the EPHEMERAL partition.
+	DiskBenchmark(context.Context, *DiskBenchmarkRequest) (*BenchmarkResponse, error)
+	// MemoryBenchmark measures the memory read, write and copy bandwidth.
+	MemoryBenchmark(context.Context, *MemoryBenchmarkRequest) (*BenchmarkResponse, error)
+	// NetworkBenchmark measures the TCP throughput between two nodes.
+	//
+	// One node should be called as the server (with empty server address), and the other one as the client.
+	NetworkBenchmark(context.Context, *NetworkBenchmarkRequest) (*BenchmarkResponse, error)
+	// ChaosServiceKill kills all processes of the service with SIGKILL, the service is restarted as after a crash.
+	//
+	// The chaos methods are only available if enabled in the machine configuration, and to the os:chaos role.
+	ChaosServiceKill(context.Context, *ChaosServiceKillRequest) (*ChaosResponse, error)
+	// ChaosNetworkDrop drops all network traffic to and from the given subnets for the given duration.
+	ChaosNetworkDrop(context.Context, *ChaosNetworkDropRequest) (*ChaosResponse, error)
+	// ChaosEphemeralFill fills the EPHEMERAL partition up to the given percentage of its size for the given duration.
+	ChaosEphemeralFill(context.Context, *ChaosEphemeralFillRequest) (*ChaosResponse, error)
+	mustEmbedUnimplementedMachineServiceServer()
+}
+
+// UnimplementedMachineServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedMachineServiceServer struct {
+}
+
+func (UnimplementedMachineServiceServer) ApplyConfiguration(context.Context, *ApplyConfigurationRequest) (*ApplyConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyConfiguration not implemented")
+}
+func (UnimplementedMachineServiceServer) Bootstrap(context.Context, *BootstrapRequest) (*BootstrapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Bootstrap not implemented")
+}
+func (UnimplementedMachineServiceServer) Containers(context.Context, *ContainersRequest) (*ContainersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Containers not implemented")
+}
+func (UnimplementedMachineServiceServer) Copy(*CopyRequest, MachineService_CopyServer) error {
+	return status.Errorf(codes.Unimplemented, "method Copy not implemented")
+}
+func (UnimplementedMachineServiceServer) CPUInfo(context.Context, *emptypb.Empty) (*CPUInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CPUInfo not implemented")
+}
+func (UnimplementedMachineServiceServer) DiskStats(context.Context, *emptypb.Empty) (*DiskStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskStats not implemented")
+}
+func (UnimplementedMachineServiceServer) Dmesg(*DmesgRequest, MachineService_DmesgServer) error {
+	return status.Errorf(codes.Unimplemented, "method Dmesg not implemented")
+}
+func (UnimplementedMachineServiceServer) Events(*EventsRequest, MachineService_EventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Events not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdMemberList(context.Context, *EtcdMemberListRequest) (*EtcdMemberListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdMemberList not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdRemoveMemberByID(context.Context, *EtcdRemoveMemberByIDRequest) (*EtcdRemoveMemberByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method EtcdRemoveMemberByID not implemented")
 }
-func (UnimplementedMachineServiceServer) EtcdLeaveCluster(context.Context, *EtcdLeaveClusterRequest) (*EtcdLeaveClusterResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EtcdLeaveCluster not implemented")
+func (UnimplementedMachineServiceServer) EtcdLeaveCluster(context.Context, *EtcdLeaveClusterRequest) (*EtcdLeaveClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdLeaveCluster not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdForfeitLeadership(context.Context, *EtcdForfeitLeadershipRequest) (*EtcdForfeitLeadershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdForfeitLeadership not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdRecover(MachineService_EtcdRecoverServer) error {
+	return status.Errorf(codes.Unimplemented, "method EtcdRecover not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdSnapshot(*EtcdSnapshotRequest, MachineService_EtcdSnapshotServer) error {
+	return status.Errorf(codes.Unimplemented, "method EtcdSnapshot not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdAlarmList(context.Context, *emptypb.Empty) (*EtcdAlarmListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdAlarmList not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdAlarmDisarm(context.Context, *emptypb.Empty) (*EtcdAlarmDisarmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdAlarmDisarm not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdDefragment(context.Context, *emptypb.Empty) (*EtcdDefragmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdDefragment not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdStatus(context.Context, *emptypb.Empty) (*EtcdStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdStatus not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdRotateCertificates(*emptypb.Empty, MachineService_EtcdRotateCertificatesServer) error {
+	return status.Errorf(codes.Unimplemented, "method EtcdRotateCertificates not implemented")
+}
+func (UnimplementedMachineServiceServer) EtcdPrecheck(context.Context, *EtcdPrecheckRequest) (*EtcdPrecheckResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EtcdPrecheck not implemented")
+}
+func (UnimplementedMachineServiceServer) GenerateConfiguration(context.Context, *GenerateConfigurationRequest) (*GenerateConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateConfiguration not implemented")
+}
+func (UnimplementedMachineServiceServer) Hostname(context.Context, *emptypb.Empty) (*HostnameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hostname not implemented")
+}
+func (UnimplementedMachineServiceServer) Kubeconfig(*emptypb.Empty, MachineService_KubeconfigServer) error {
+	return status.Errorf(codes.Unimplemented, "method Kubeconfig not implemented")
+}
+func (UnimplementedMachineServiceServer) List(*ListRequest, MachineService_ListServer) error {
+	return status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (UnimplementedMachineServiceServer) DiskUsage(*DiskUsageRequest, MachineService_DiskUsageServer) error {
+	return status.Errorf(codes.Unimplemented, "method DiskUsage not implemented")
+}
+func (UnimplementedMachineServiceServer) LoadAvg(context.Context, *emptypb.Empty) (*LoadAvgResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LoadAvg not implemented")
+}
+func (UnimplementedMachineServiceServer) Logs(*LogsRequest, MachineService_LogsServer) error {
+	return status.Errorf(codes.Unimplemented, "method Logs not implemented")
+}
+func (UnimplementedMachineServiceServer) LogsContainers(context.Context, *emptypb.Empty) (*LogsContainersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LogsContainers not implemented")
+}
+func (UnimplementedMachineServiceServer) Memory(context.Context, *emptypb.Empty) (*MemoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Memory not implemented")
+}
+func (UnimplementedMachineServiceServer) Mounts(context.Context, *emptypb.Empty) (*MountsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mounts not implemented")
+}
+func (UnimplementedMachineServiceServer) NetworkDeviceStats(context.Context, *emptypb.Empty) (*NetworkDeviceStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetworkDeviceStats not implemented")
+}
+func (UnimplementedMachineServiceServer) Processes(context.Context, *emptypb.Empty) (*ProcessesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Processes not implemented")
+}
+func (UnimplementedMachineServiceServer) ProcessesStream(*ProcessesStreamRequest, MachineService_ProcessesStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method ProcessesStream not implemented")
+}
+func (UnimplementedMachineServiceServer) Read(*ReadRequest, MachineService_ReadServer) error {
+	return status.Errorf(codes.Unimplemented, "method Read not implemented")
+}
+func (UnimplementedMachineServiceServer) Reboot(context.Context, *RebootRequest) (*RebootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reboot not implemented")
+}
+func (UnimplementedMachineServiceServer) Restart(context.Context, *RestartRequest) (*RestartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Restart not implemented")
+}
+func (UnimplementedMachineServiceServer) Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
+}
+func (UnimplementedMachineServiceServer) Reset(context.Context, *ResetRequest) (*ResetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reset not implemented")
+}
+func (UnimplementedMachineServiceServer) ServiceGraph(context.Context, *emptypb.Empty) (*ServiceGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceGraph not implemented")
+}
+func (UnimplementedMachineServiceServer) ServiceList(context.Context, *emptypb.Empty) (*ServiceListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceList not implemented")
+}
+func (UnimplementedMachineServiceServer) ServiceRestart(context.Context, *ServiceRestartRequest) (*ServiceRestartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceRestart not implemented")
+}
+func (UnimplementedMachineServiceServer) ServiceStart(context.Context, *ServiceStartRequest) (*ServiceStartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceStart not implemented")
+}
+func (UnimplementedMachineServiceServer) ServiceStop(context.Context, *ServiceStopRequest) (*ServiceStopResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ServiceStop not implemented")
+}
+func (UnimplementedMachineServiceServer) Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Shutdown not implemented")
+}
+func (UnimplementedMachineServiceServer) PowerActionCancel(context.Context, *PowerActionCancelRequest) (*PowerActionCancelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PowerActionCancel not implemented")
+}
+func (UnimplementedMachineServiceServer) Stats(context.Context, *StatsRequest) (*StatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+}
+func (UnimplementedMachineServiceServer) SystemStat(context.Context, *emptypb.Empty) (*SystemStatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemStat not implemented")
+}
+func (UnimplementedMachineServiceServer) Upgrade(context.Context, *UpgradeRequest) (*UpgradeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upgrade not implemented")
+}
+func (UnimplementedMachineServiceServer) Version(context.Context, *emptypb.Empty) (*VersionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+}
+func (UnimplementedMachineServiceServer) GenerateClientConfiguration(context.Context, *GenerateClientConfigurationRequest) (*GenerateClientConfigurationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GenerateClientConfiguration not implemented")
+}
+func (UnimplementedMachineServiceServer) PacketCapture(*PacketCaptureRequest, MachineService_PacketCaptureServer) error {
+	return status.Errorf(codes.Unimplemented, "method PacketCapture not implemented")
+}
+func (UnimplementedMachineServiceServer) Netstat(context.Context, *NetstatRequest) (*NetstatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Netstat not implemented")
+}
+func (UnimplementedMachineServiceServer) Neighbors(context.Context, *emptypb.Empty) (*NeighborsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Neighbors not implemented")
+}
+func (UnimplementedMachineServiceServer) PathMTU(context.Context, *PathMTURequest) (*PathMTUResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PathMTU not implemented")
+}
+func (UnimplementedMachineServiceServer) MetaWrite(context.Context, *MetaWriteRequest) (*MetaWriteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MetaWrite not implemented")
+}
+func (UnimplementedMachineServiceServer) MetaDelete(context.Context, *MetaDeleteRequest) (*MetaDeleteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MetaDelete not implemented")
+}
+func (UnimplementedMachineServiceServer) ImageList(*ImageListRequest, MachineService_ImageListServer) error {
+	return status.Errorf(codes.Unimplemented, "method ImageList not implemented")
+}
+func (UnimplementedMachineServiceServer) ImagePull(context.Context, *ImagePullRequest) (*ImagePullResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImagePull not implemented")
+}
+func (UnimplementedMachineServiceServer) ImageValidate(context.Context, *ImageValidateRequest) (*ImageValidateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImageValidate not implemented")
+}
+func (UnimplementedMachineServiceServer) BootLogs(context.Context, *BootLogsRequest) (*BootLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BootLogs not implemented")
+}
+func (UnimplementedMachineServiceServer) BMCSensors(context.Context, *BMCSensorsRequest) (*BMCSensorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BMCSensors not implemented")
+}
+func (UnimplementedMachineServiceServer) BMCEventLog(context.Context, *BMCEventLogRequest) (*BMCEventLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BMCEventLog not implemented")
+}
+func (UnimplementedMachineServiceServer) HardwareInventory(context.Context, *HardwareInventoryRequest) (*HardwareInventoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HardwareInventory not implemented")
+}
+func (UnimplementedMachineServiceServer) SensorStats(context.Context, *emptypb.Empty) (*SensorStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SensorStats not implemented")
+}
+func (UnimplementedMachineServiceServer) Profile(*ProfileRequest, MachineService_ProfileServer) error {
+	return status.Errorf(codes.Unimplemented, "method Profile not implemented")
+}
+func (UnimplementedMachineServiceServer) Trace(*TraceRequest, MachineService_TraceServer) error {
+	return status.Errorf(codes.Unimplemented, "method Trace not implemented")
+}
+func (UnimplementedMachineServiceServer) Strace(*StraceRequest, MachineService_StraceServer) error {
+	return status.Errorf(codes.Unimplemented, "method Strace not implemented")
+}
+func (UnimplementedMachineServiceServer) StackDump(*StackDumpRequest, MachineService_StackDumpServer) error {
+	return status.Errorf(codes.Unimplemented, "method StackDump not implemented")
+}
+func (UnimplementedMachineServiceServer) DebugAttach(MachineService_DebugAttachServer) error {
+	return status.Errorf(codes.Unimplemented, "method DebugAttach not implemented")
+}
+func (UnimplementedMachineServiceServer) JoinTokenCreate(context.Context, *JoinTokenCreateRequest) (*JoinTokenCreateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinTokenCreate not implemented")
+}
+func (UnimplementedMachineServiceServer) JoinTokenList(context.Context, *emptypb.Empty) (*JoinTokenListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinTokenList not implemented")
+}
+func (UnimplementedMachineServiceServer) JoinTokenRevoke(context.Context, *JoinTokenRevokeRequest) (*JoinTokenRevokeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method JoinTokenRevoke not implemented")
+}
+func (UnimplementedMachineServiceServer) Certificates(context.Context, *emptypb.Empty) (*CertificatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Certificates not implemented")
+}
+func (UnimplementedMachineServiceServer) DiskBenchmark(context.Context, *DiskBenchmarkRequest) (*BenchmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiskBenchmark not implemented")
 }
-func (UnimplementedMachineServiceServer) EtcdForfeitLeadership(context.Context, *EtcdForfeitLeadershipRequest) (*EtcdForfeitLeadershipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EtcdForfeitLeadership not implemented")
+func (UnimplementedMachineServiceServer) MemoryBenchmark(context.Context, *MemoryBenchmarkRequest) (*BenchmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MemoryBenchmark not implemented")
 }
-func (UnimplementedMachineServiceServer) EtcdRecover(MachineService_EtcdRecoverServer) error {
-	return status.Errorf(codes.Unimplemented, "method EtcdRecover not implemented")
+func (UnimplementedMachineServiceServer) NetworkBenchmark(context.Context, *NetworkBenchmarkRequest) (*BenchmarkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NetworkBenchmark not implemented")
 }
-func (UnimplementedMachineServiceServer) EtcdSnapshot(*EtcdSnapshotRequest, MachineService_EtcdSnapshotServer) error {
-	return status.Errorf(codes.Unimplemented, "method EtcdSnapshot not implemented")
+func (UnimplementedMachineServiceServer) ChaosServiceKill(context.Context, *ChaosServiceKillRequest) (*ChaosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChaosServiceKill not implemented")
 }
-func (UnimplementedMachineServiceServer) EtcdAlarmList(context.Context, *emptypb.Empty) (*EtcdAlarmListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EtcdAlarmList not implemented")
+func (UnimplementedMachineServiceServer) ChaosNetworkDrop(context.Context, *ChaosNetworkDropRequest) (*ChaosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChaosNetworkDrop not implemented")
 }
-func (UnimplementedMachineServiceServer) EtcdAlarmDisarm(context.Context, *emptypb.Empty) (*EtcdAlarmDisarmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EtcdAlarmDisarm not implemented")
+func (UnimplementedMachineServiceServer) ChaosEphemeralFill(context.Context, *ChaosEphemeralFillRequest) (*ChaosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChaosEphemeralFill not implemented")
 }
-func (UnimplementedMachineServiceServer) EtcdDefragment(context.Context, *emptypb.Empty) (*EtcdDefragmentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EtcdDefragment not implemented")
+func (UnimplementedMachineServiceServer) mustEmbedUnimplementedMachineServiceServer() {}
+
+// UnsafeMachineServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MachineServiceServer will
+// result in compilation errors.
+type UnsafeMachineServiceServer interface {
+	mustEmbedUnimplementedMachineServiceServer()
 }
-func (UnimplementedMachineServiceServer) EtcdStatus(context.Context, *emptypb.Empty) (*EtcdStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EtcdStatus not implemented")
+
+func RegisterMachineServiceServer(s grpc.ServiceRegistrar, srv MachineServiceServer) {
+	s.RegisterService(&MachineService_ServiceDesc, srv)
 }
-func (UnimplementedMachineServiceServer) GenerateConfiguration(context.Context, *GenerateConfigurationRequest) (*GenerateConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateConfiguration not implemented")
+
+func _MachineService_ApplyConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ApplyConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_ApplyConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ApplyConfiguration(ctx, req.(*ApplyConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMachineServiceServer) Hostname(context.Context, *emptypb.Empty) (*HostnameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Hostname not implemented")
+
+func _MachineService_Bootstrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BootstrapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Bootstrap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_Bootstrap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Bootstrap(ctx, req.(*BootstrapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMachineServiceServer) Kubeconfig(*emptypb.Empty, MachineService_KubeconfigServer) error {
-	return status.Errorf(codes.Unimplemented, "method Kubeconfig not implemented")
+
+func _MachineService_Containers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ContainersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Containers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_Containers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Containers(ctx, req.(*ContainersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMachineServiceServer) List(*ListRequest, MachineService_ListServer) error {
-	return status.Errorf(codes.Unimplemented, "method List not implemented")
+
+func _MachineService_Copy_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CopyRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).Copy(m, &machineServiceCopyServer{ServerStream: stream})
+}
+
+type MachineService_CopyServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
+}
+
+type machineServiceCopyServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceCopyServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_CPUInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).CPUInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_CPUInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).CPUInfo(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_DiskStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).DiskStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_DiskStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).DiskStats(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_Dmesg_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DmesgRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).Dmesg(m, &machineServiceDmesgServer{ServerStream: stream})
+}
+
+type MachineService_DmesgServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
+}
+
+type machineServiceDmesgServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceDmesgServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).Events(m, &machineServiceEventsServer{ServerStream: stream})
+}
+
+type MachineService_EventsServer interface {
+	Send(*Event) error
+	grpc.ServerStream
+}
+
+type machineServiceEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceEventsServer) Send(m *Event) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_EtcdMemberList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdMemberListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdMemberList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_EtcdMemberList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdMemberList(ctx, req.(*EtcdMemberListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_EtcdRemoveMemberByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdRemoveMemberByIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdRemoveMemberByID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_EtcdRemoveMemberByID_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdRemoveMemberByID(ctx, req.(*EtcdRemoveMemberByIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_EtcdLeaveCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdLeaveClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdLeaveCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_EtcdLeaveCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdLeaveCluster(ctx, req.(*EtcdLeaveClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_EtcdForfeitLeadership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdForfeitLeadershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdForfeitLeadership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_EtcdForfeitLeadership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdForfeitLeadership(ctx, req.(*EtcdForfeitLeadershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MachineService_EtcdRecover_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MachineServiceServer).EtcdRecover(&machineServiceEtcdRecoverServer{ServerStream: stream})
 }
-func (UnimplementedMachineServiceServer) DiskUsage(*DiskUsageRequest, MachineService_DiskUsageServer) error {
-	return status.Errorf(codes.Unimplemented, "method DiskUsage not implemented")
+
+type MachineService_EtcdRecoverServer interface {
+	SendAndClose(*EtcdRecoverResponse) error
+	Recv() (*common.Data, error)
+	grpc.ServerStream
 }
-func (UnimplementedMachineServiceServer) LoadAvg(context.Context, *emptypb.Empty) (*LoadAvgResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LoadAvg not implemented")
+
+type machineServiceEtcdRecoverServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedMachineServiceServer) Logs(*LogsRequest, MachineService_LogsServer) error {
-	return status.Errorf(codes.Unimplemented, "method Logs not implemented")
+
+func (x *machineServiceEtcdRecoverServer) SendAndClose(m *EtcdRecoverResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedMachineServiceServer) LogsContainers(context.Context, *emptypb.Empty) (*LogsContainersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method LogsContainers not implemented")
+
+func (x *machineServiceEtcdRecoverServer) Recv() (*common.Data, error) {
+	m := new(common.Data)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedMachineServiceServer) Memory(context.Context, *emptypb.Empty) (*MemoryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Memory not implemented")
+
+func _MachineService_EtcdSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(EtcdSnapshotRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).EtcdSnapshot(m, &machineServiceEtcdSnapshotServer{ServerStream: stream})
 }
-func (UnimplementedMachineServiceServer) Mounts(context.Context, *emptypb.Empty) (*MountsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Mounts not implemented")
+
+type MachineService_EtcdSnapshotServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
 }
-func (UnimplementedMachineServiceServer) NetworkDeviceStats(context.Context, *emptypb.Empty) (*NetworkDeviceStatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method NetworkDeviceStats not implemented")
+
+type machineServiceEtcdSnapshotServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedMachineServiceServer) Processes(context.Context, *emptypb.Empty) (*ProcessesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Processes not implemented")
+
+func (x *machineServiceEtcdSnapshotServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedMachineServiceServer) Read(*ReadRequest, MachineService_ReadServer) error {
-	return status.Errorf(codes.Unimplemented, "method Read not implemented")
+
+func _MachineService_EtcdAlarmList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdAlarmList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_EtcdAlarmList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdAlarmList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMachineServiceServer) Reboot(context.Context, *RebootRequest) (*RebootResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Reboot not implemented")
+
+func _MachineService_EtcdAlarmDisarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdAlarmDisarm(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_EtcdAlarmDisarm_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdAlarmDisarm(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMachineServiceServer) Restart(context.Context, *RestartRequest) (*RestartResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Restart not implemented")
+
+func _MachineService_EtcdDefragment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdDefragment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_EtcdDefragment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdDefragment(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMachineServiceServer) Rollback(context.Context, *RollbackRequest) (*RollbackResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Rollback not implemented")
+
+func _MachineService_EtcdStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_EtcdStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdStatus(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMachineServiceServer) Reset(context.Context, *ResetRequest) (*ResetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Reset not implemented")
+
+func _MachineService_EtcdRotateCertificates_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).EtcdRotateCertificates(m, &machineServiceEtcdRotateCertificatesServer{ServerStream: stream})
 }
-func (UnimplementedMachineServiceServer) ServiceList(context.Context, *emptypb.Empty) (*ServiceListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceList not implemented")
+
+type MachineService_EtcdRotateCertificatesServer interface {
+	Send(*EtcdRotateCertificatesProgress) error
+	grpc.ServerStream
 }
-func (UnimplementedMachineServiceServer) ServiceRestart(context.Context, *ServiceRestartRequest) (*ServiceRestartResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceRestart not implemented")
+
+type machineServiceEtcdRotateCertificatesServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedMachineServiceServer) ServiceStart(context.Context, *ServiceStartRequest) (*ServiceStartResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceStart not implemented")
+
+func (x *machineServiceEtcdRotateCertificatesServer) Send(m *EtcdRotateCertificatesProgress) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedMachineServiceServer) ServiceStop(context.Context, *ServiceStopRequest) (*ServiceStopResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ServiceStop not implemented")
+
+func _MachineService_EtcdPrecheck_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EtcdPrecheckRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).EtcdPrecheck(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_EtcdPrecheck_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).EtcdPrecheck(ctx, req.(*EtcdPrecheckRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMachineServiceServer) Shutdown(context.Context, *ShutdownRequest) (*ShutdownResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Shutdown not implemented")
+
+func _MachineService_GenerateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateConfigurationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).GenerateConfiguration(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_GenerateConfiguration_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).GenerateConfiguration(ctx, req.(*GenerateConfigurationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMachineServiceServer) Stats(context.Context, *StatsRequest) (*StatsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Stats not implemented")
+
+func _MachineService_Hostname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Hostname(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_Hostname_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Hostname(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedMachineServiceServer) SystemStat(context.Context, *emptypb.Empty) (*SystemStatResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SystemStat not implemented")
+
+func _MachineService_Kubeconfig_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(emptypb.Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).Kubeconfig(m, &machineServiceKubeconfigServer{ServerStream: stream})
 }
-func (UnimplementedMachineServiceServer) Upgrade(context.Context, *UpgradeRequest) (*UpgradeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Upgrade not implemented")
+
+type MachineService_KubeconfigServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
 }
-func (UnimplementedMachineServiceServer) Version(context.Context, *emptypb.Empty) (*VersionResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Version not implemented")
+
+type machineServiceKubeconfigServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedMachineServiceServer) GenerateClientConfiguration(context.Context, *GenerateClientConfigurationRequest) (*GenerateClientConfigurationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GenerateClientConfiguration not implemented")
+
+func (x *machineServiceKubeconfigServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedMachineServiceServer) PacketCapture(*PacketCaptureRequest, MachineService_PacketCaptureServer) error {
-	return status.Errorf(codes.Unimplemented, "method PacketCapture not implemented")
+
+func _MachineService_List_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ListRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).List(m, &machineServiceListServer{ServerStream: stream})
 }
-func (UnimplementedMachineServiceServer) Netstat(context.Context, *NetstatRequest) (*NetstatResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Netstat not implemented")
+
+type MachineService_ListServer interface {
+	Send(*FileInfo) error
+	grpc.ServerStream
 }
-func (UnimplementedMachineServiceServer) MetaWrite(context.Context, *MetaWriteRequest) (*MetaWriteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MetaWrite not implemented")
+
+type machineServiceListServer struct {
+	grpc.ServerStream
 }
-func (UnimplementedMachineServiceServer) MetaDelete(context.Context, *MetaDeleteRequest) (*MetaDeleteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MetaDelete not implemented")
+
+func (x *machineServiceListServer) Send(m *FileInfo) error {
+	return x.ServerStream.SendMsg(m)
 }
-func (UnimplementedMachineServiceServer) ImageList(*ImageListRequest, MachineService_ImageListServer) error {
-	return status.Errorf(codes.Unimplemented, "method ImageList not implemented")
+
+func _MachineService_DiskUsage_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(DiskUsageRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).DiskUsage(m, &machineServiceDiskUsageServer{ServerStream: stream})
 }
-func (UnimplementedMachineServiceServer) ImagePull(context.Context, *ImagePullRequest) (*ImagePullResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ImagePull not implemented")
+
+type MachineService_DiskUsageServer interface {
+	Send(*DiskUsageInfo) error
+	grpc.ServerStream
 }
-func (UnimplementedMachineServiceServer) mustEmbedUnimplementedMachineServiceServer() {}
 
-// UnsafeMachineServiceServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to MachineServiceServer will
-// result in compilation errors.
-type UnsafeMachineServiceServer interface {
-	mustEmbedUnimplementedMachineServiceServer()
+type machineServiceDiskUsageServer struct {
+	grpc.ServerStream
 }
 
-func RegisterMachineServiceServer(s grpc.ServiceRegistrar, srv MachineServiceServer) {
-	s.RegisterService(&MachineService_ServiceDesc, srv)
+func (x *machineServiceDiskUsageServer) Send(m *DiskUsageInfo) error {
+	return x.ServerStream.SendMsg(m)
 }
 
-func _MachineService_ApplyConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ApplyConfigurationRequest)
+func _MachineService_LoadAvg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).ApplyConfiguration(ctx, in)
+		return srv.(MachineServiceServer).LoadAvg(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_ApplyConfiguration_FullMethodName,
+		FullMethod: MachineService_LoadAvg_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).ApplyConfiguration(ctx, req.(*ApplyConfigurationRequest))
+		return srv.(MachineServiceServer).LoadAvg(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Bootstrap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(BootstrapRequest)
+func _MachineService_Logs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(LogsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).Logs(m, &machineServiceLogsServer{ServerStream: stream})
+}
+
+type MachineService_LogsServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
+}
+
+type machineServiceLogsServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceLogsServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_LogsContainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Bootstrap(ctx, in)
+		return srv.(MachineServiceServer).LogsContainers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Bootstrap_FullMethodName,
+		FullMethod: MachineService_LogsContainers_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Bootstrap(ctx, req.(*BootstrapRequest))
+		return srv.(MachineServiceServer).LogsContainers(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Containers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ContainersRequest)
+func _MachineService_Memory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Containers(ctx, in)
+		return srv.(MachineServiceServer).Memory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Containers_FullMethodName,
+		FullMethod: MachineService_Memory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Containers(ctx, req.(*ContainersRequest))
+		return srv.(MachineServiceServer).Memory(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Copy_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(CopyRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _MachineService_Mounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(MachineServiceServer).Copy(m, &machineServiceCopyServer{ServerStream: stream})
-}
-
-type MachineService_CopyServer interface {
-	Send(*common.Data) error
-	grpc.ServerStream
-}
-
-type machineServiceCopyServer struct {
-	grpc.ServerStream
-}
-
-func (x *machineServiceCopyServer) Send(m *common.Data) error {
-	return x.ServerStream.SendMsg(m)
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Mounts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_Mounts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Mounts(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_CPUInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _MachineService_NetworkDeviceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).CPUInfo(ctx, in)
+		return srv.(MachineServiceServer).NetworkDeviceStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_CPUInfo_FullMethodName,
+		FullMethod: MachineService_NetworkDeviceStats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).CPUInfo(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).NetworkDeviceStats(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_DiskStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _MachineService_Processes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).DiskStats(ctx, in)
+		return srv.(MachineServiceServer).Processes(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_DiskStats_FullMethodName,
+		FullMethod: MachineService_Processes_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).DiskStats(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).Processes(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Dmesg_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(DmesgRequest)
+func _MachineService_ProcessesStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProcessesStreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(MachineServiceServer).Dmesg(m, &machineServiceDmesgServer{ServerStream: stream})
+	return srv.(MachineServiceServer).ProcessesStream(m, &machineServiceProcessesStreamServer{ServerStream: stream})
 }
 
-type MachineService_DmesgServer interface {
-	Send(*common.Data) error
+type MachineService_ProcessesStreamServer interface {
+	Send(*Process) error
 	grpc.ServerStream
 }
 
-type machineServiceDmesgServer struct {
+type machineServiceProcessesStreamServer struct {
 	grpc.ServerStream
 }
 
-func (x *machineServiceDmesgServer) Send(m *common.Data) error {
+func (x *machineServiceProcessesStreamServer) Send(m *Process) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _MachineService_Events_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(EventsRequest)
+func _MachineService_Read_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ReadRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(MachineServiceServer).Events(m, &machineServiceEventsServer{ServerStream: stream})
+	return srv.(MachineServiceServer).Read(m, &machineServiceReadServer{ServerStream: stream})
 }
 
-type MachineService_EventsServer interface {
-	Send(*Event) error
+type MachineService_ReadServer interface {
+	Send(*common.Data) error
 	grpc.ServerStream
 }
 
-type machineServiceEventsServer struct {
+type machineServiceReadServer struct {
 	grpc.ServerStream
 }
 
-func (x *machineServiceEventsServer) Send(m *Event) error {
+func (x *machineServiceReadServer) Send(m *common.Data) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _MachineService_EtcdMemberList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EtcdMemberListRequest)
+func _MachineService_Reboot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RebootRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).EtcdMemberList(ctx, in)
+		return srv.(MachineServiceServer).Reboot(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_EtcdMemberList_FullMethodName,
+		FullMethod: MachineService_Reboot_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).EtcdMemberList(ctx, req.(*EtcdMemberListRequest))
+		return srv.(MachineServiceServer).Reboot(ctx, req.(*RebootRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_EtcdRemoveMemberByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EtcdRemoveMemberByIDRequest)
+func _MachineService_Restart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestartRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).EtcdRemoveMemberByID(ctx, in)
+		return srv.(MachineServiceServer).Restart(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_EtcdRemoveMemberByID_FullMethodName,
+		FullMethod: MachineService_Restart_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).EtcdRemoveMemberByID(ctx, req.(*EtcdRemoveMemberByIDRequest))
+		return srv.(MachineServiceServer).Restart(ctx, req.(*RestartRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_EtcdLeaveCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EtcdLeaveClusterRequest)
+func _MachineService_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).EtcdLeaveCluster(ctx, in)
+		return srv.(MachineServiceServer).Rollback(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_EtcdLeaveCluster_FullMethodName,
+		FullMethod: MachineService_Rollback_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).EtcdLeaveCluster(ctx, req.(*EtcdLeaveClusterRequest))
+		return srv.(MachineServiceServer).Rollback(ctx, req.(*RollbackRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_EtcdForfeitLeadership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EtcdForfeitLeadershipRequest)
+func _MachineService_Reset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).EtcdForfeitLeadership(ctx, in)
+		return srv.(MachineServiceServer).Reset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_EtcdForfeitLeadership_FullMethodName,
+		FullMethod: MachineService_Reset_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).EtcdForfeitLeadership(ctx, req.(*EtcdForfeitLeadershipRequest))
+		return srv.(MachineServiceServer).Reset(ctx, req.(*ResetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_EtcdRecover_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(MachineServiceServer).EtcdRecover(&machineServiceEtcdRecoverServer{ServerStream: stream})
-}
-
-type MachineService_EtcdRecoverServer interface {
-	SendAndClose(*EtcdRecoverResponse) error
-	Recv() (*common.Data, error)
-	grpc.ServerStream
-}
-
-type machineServiceEtcdRecoverServer struct {
-	grpc.ServerStream
-}
-
-func (x *machineServiceEtcdRecoverServer) SendAndClose(m *EtcdRecoverResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func (x *machineServiceEtcdRecoverServer) Recv() (*common.Data, error) {
-	m := new(common.Data)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func _MachineService_ServiceGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
-}
-
-func _MachineService_EtcdSnapshot_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(EtcdSnapshotRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ServiceGraph(ctx, in)
 	}
-	return srv.(MachineServiceServer).EtcdSnapshot(m, &machineServiceEtcdSnapshotServer{ServerStream: stream})
-}
-
-type MachineService_EtcdSnapshotServer interface {
-	Send(*common.Data) error
-	grpc.ServerStream
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_ServiceGraph_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ServiceGraph(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type machineServiceEtcdSnapshotServer struct {
-	grpc.ServerStream
+func _MachineService_ServiceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ServiceList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_ServiceList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ServiceList(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *machineServiceEtcdSnapshotServer) Send(m *common.Data) error {
-	return x.ServerStream.SendMsg(m)
+func _MachineService_ServiceRestart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceRestartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).ServiceRestart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_ServiceRestart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).ServiceRestart(ctx, req.(*ServiceRestartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_EtcdAlarmList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MachineService_ServiceStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceStartRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).EtcdAlarmList(ctx, in)
+		return srv.(MachineServiceServer).ServiceStart(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_EtcdAlarmList_FullMethodName,
+		FullMethod: MachineService_ServiceStart_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).EtcdAlarmList(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).ServiceStart(ctx, req.(*ServiceStartRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_EtcdAlarmDisarm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MachineService_ServiceStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ServiceStopRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).EtcdAlarmDisarm(ctx, in)
+		return srv.(MachineServiceServer).ServiceStop(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_EtcdAlarmDisarm_FullMethodName,
+		FullMethod: MachineService_ServiceStop_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).EtcdAlarmDisarm(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).ServiceStop(ctx, req.(*ServiceStopRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_EtcdDefragment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MachineService_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShutdownRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).EtcdDefragment(ctx, in)
+		return srv.(MachineServiceServer).Shutdown(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_EtcdDefragment_FullMethodName,
+		FullMethod: MachineService_Shutdown_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).EtcdDefragment(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).Shutdown(ctx, req.(*ShutdownRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_EtcdStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MachineService_PowerActionCancel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PowerActionCancelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).EtcdStatus(ctx, in)
+		return srv.(MachineServiceServer).PowerActionCancel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_EtcdStatus_FullMethodName,
+		FullMethod: MachineService_PowerActionCancel_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).EtcdStatus(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).PowerActionCancel(ctx, req.(*PowerActionCancelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_GenerateConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenerateConfigurationRequest)
+func _MachineService_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).GenerateConfiguration(ctx, in)
+		return srv.(MachineServiceServer).Stats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_GenerateConfiguration_FullMethodName,
+		FullMethod: MachineService_Stats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).GenerateConfiguration(ctx, req.(*GenerateConfigurationRequest))
+		return srv.(MachineServiceServer).Stats(ctx, req.(*StatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Hostname_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _MachineService_SystemStat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Hostname(ctx, in)
+		return srv.(MachineServiceServer).SystemStat(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Hostname_FullMethodName,
+		FullMethod: MachineService_SystemStat_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Hostname(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).SystemStat(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Kubeconfig_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(emptypb.Empty)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _MachineService_Upgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpgradeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(MachineServiceServer).Kubeconfig(m, &machineServiceKubeconfigServer{ServerStream: stream})
-}
-
-type MachineService_KubeconfigServer interface {
-	Send(*common.Data) error
-	grpc.ServerStream
-}
-
-type machineServiceKubeconfigServer struct {
-	grpc.ServerStream
-}
-
-func (x *machineServiceKubeconfigServer) Send(m *common.Data) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _MachineService_List_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ListRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Upgrade(ctx, in)
 	}
-	return srv.(MachineServiceServer).List(m, &machineServiceListServer{ServerStream: stream})
-}
-
-type MachineService_ListServer interface {
-	Send(*FileInfo) error
-	grpc.ServerStream
-}
-
-type machineServiceListServer struct {
-	grpc.ServerStream
-}
-
-func (x *machineServiceListServer) Send(m *FileInfo) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _MachineService_DiskUsage_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(DiskUsageRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_Upgrade_FullMethodName,
 	}
-	return srv.(MachineServiceServer).DiskUsage(m, &machineServiceDiskUsageServer{ServerStream: stream})
-}
-
-type MachineService_DiskUsageServer interface {
-	Send(*DiskUsageInfo) error
-	grpc.ServerStream
-}
-
-type machineServiceDiskUsageServer struct {
-	grpc.ServerStream
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Upgrade(ctx, req.(*UpgradeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *machineServiceDiskUsageServer) Send(m *DiskUsageInfo) error {
-	return x.ServerStream.SendMsg(m)
+func _MachineService_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MachineServiceServer).Version(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MachineService_Version_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MachineServiceServer).Version(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_LoadAvg_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MachineService_GenerateClientConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenerateClientConfigurationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).LoadAvg(ctx, in)
+		return srv.(MachineServiceServer).GenerateClientConfiguration(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_LoadAvg_FullMethodName,
+		FullMethod: MachineService_GenerateClientConfiguration_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).LoadAvg(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).GenerateClientConfiguration(ctx, req.(*GenerateClientConfigurationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Logs_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(LogsRequest)
+func _MachineService_PacketCapture_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(PacketCaptureRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(MachineServiceServer).Logs(m, &machineServiceLogsServer{ServerStream: stream})
+	return srv.(MachineServiceServer).PacketCapture(m, &machineServicePacketCaptureServer{ServerStream: stream})
 }
 
-type MachineService_LogsServer interface {
+type MachineService_PacketCaptureServer interface {
 	Send(*common.Data) error
 	grpc.ServerStream
 }
 
-type machineServiceLogsServer struct {
+type machineServicePacketCaptureServer struct {
 	grpc.ServerStream
 }
 
-func (x *machineServiceLogsServer) Send(m *common.Data) error {
+func (x *machineServicePacketCaptureServer) Send(m *common.Data) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _MachineService_LogsContainers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MachineService_Netstat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetstatRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).LogsContainers(ctx, in)
+		return srv.(MachineServiceServer).Netstat(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_LogsContainers_FullMethodName,
+		FullMethod: MachineService_Netstat_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).LogsContainers(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).Netstat(ctx, req.(*NetstatRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Memory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+func _MachineService_Neighbors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Memory(ctx, in)
+		return srv.(MachineServiceServer).Neighbors(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Memory_FullMethodName,
+		FullMethod: MachineService_Neighbors_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Memory(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).Neighbors(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Mounts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MachineService_PathMTU_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PathMTURequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Mounts(ctx, in)
+		return srv.(MachineServiceServer).PathMTU(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Mounts_FullMethodName,
+		FullMethod: MachineService_PathMTU_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Mounts(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).PathMTU(ctx, req.(*PathMTURequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_NetworkDeviceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MachineService_MetaWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetaWriteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).NetworkDeviceStats(ctx, in)
+		return srv.(MachineServiceServer).MetaWrite(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_NetworkDeviceStats_FullMethodName,
+		FullMethod: MachineService_MetaWrite_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).NetworkDeviceStats(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).MetaWrite(ctx, req.(*MetaWriteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Processes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MachineService_MetaDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MetaDeleteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Processes(ctx, in)
+		return srv.(MachineServiceServer).MetaDelete(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Processes_FullMethodName,
+		FullMethod: MachineService_MetaDelete_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Processes(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).MetaDelete(ctx, req.(*MetaDeleteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Read_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ReadRequest)
+func _MachineService_ImageList_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ImageListRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(MachineServiceServer).Read(m, &machineServiceReadServer{ServerStream: stream})
+	return srv.(MachineServiceServer).ImageList(m, &machineServiceImageListServer{ServerStream: stream})
 }
 
-type MachineService_ReadServer interface {
-	Send(*common.Data) error
+type MachineService_ImageListServer interface {
+	Send(*ImageListResponse) error
 	grpc.ServerStream
 }
 
-type machineServiceReadServer struct {
+type machineServiceImageListServer struct {
 	grpc.ServerStream
 }
 
-func (x *machineServiceReadServer) Send(m *common.Data) error {
+func (x *machineServiceImageListServer) Send(m *ImageListResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _MachineService_Reboot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RebootRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MachineServiceServer).Reboot(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: MachineService_Reboot_FullMethodName,
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Reboot(ctx, req.(*RebootRequest))
-	}
-	return interceptor(ctx, in, info, handler)
-}
-
-func _MachineService_Restart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RestartRequest)
+func _MachineService_ImagePull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImagePullRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Restart(ctx, in)
+		return srv.(MachineServiceServer).ImagePull(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Restart_FullMethodName,
+		FullMethod: MachineService_ImagePull_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Restart(ctx, req.(*RestartRequest))
+		return srv.(MachineServiceServer).ImagePull(ctx, req.(*ImagePullRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Rollback_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RollbackRequest)
+func _MachineService_ImageValidate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImageValidateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Rollback(ctx, in)
+		return srv.(MachineServiceServer).ImageValidate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Rollback_FullMethodName,
+		FullMethod: MachineService_ImageValidate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Rollback(ctx, req.(*RollbackRequest))
+		return srv.(MachineServiceServer).ImageValidate(ctx, req.(*ImageValidateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Reset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ResetRequest)
+func _MachineService_BootLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BootLogsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Reset(ctx, in)
+		return srv.(MachineServiceServer).BootLogs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Reset_FullMethodName,
+		FullMethod: MachineService_BootLogs_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Reset(ctx, req.(*ResetRequest))
+		return srv.(MachineServiceServer).BootLogs(ctx, req.(*BootLogsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_ServiceList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MachineService_BMCSensors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BMCSensorsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).ServiceList(ctx, in)
+		return srv.(MachineServiceServer).BMCSensors(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_ServiceList_FullMethodName,
+		FullMethod: MachineService_BMCSensors_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).ServiceList(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).BMCSensors(ctx, req.(*BMCSensorsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_ServiceRestart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServiceRestartRequest)
+func _MachineService_BMCEventLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BMCEventLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).ServiceRestart(ctx, in)
+		return srv.(MachineServiceServer).BMCEventLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_ServiceRestart_FullMethodName,
+		FullMethod: MachineService_BMCEventLog_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).ServiceRestart(ctx, req.(*ServiceRestartRequest))
+		return srv.(MachineServiceServer).BMCEventLog(ctx, req.(*BMCEventLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_ServiceStart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServiceStartRequest)
+func _MachineService_HardwareInventory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HardwareInventoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).ServiceStart(ctx, in)
+		return srv.(MachineServiceServer).HardwareInventory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_ServiceStart_FullMethodName,
+		FullMethod: MachineService_HardwareInventory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).ServiceStart(ctx, req.(*ServiceStartRequest))
+		return srv.(MachineServiceServer).HardwareInventory(ctx, req.(*HardwareInventoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_ServiceStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ServiceStopRequest)
+func _MachineService_SensorStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).ServiceStop(ctx, in)
+		return srv.(MachineServiceServer).SensorStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_ServiceStop_FullMethodName,
+		FullMethod: MachineService_SensorStats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).ServiceStop(ctx, req.(*ServiceStopRequest))
+		return srv.(MachineServiceServer).SensorStats(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Shutdown_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ShutdownRequest)
+func _MachineService_Profile_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ProfileRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).Profile(m, &machineServiceProfileServer{ServerStream: stream})
+}
+
+type MachineService_ProfileServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
+}
+
+type machineServiceProfileServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceProfileServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_Trace_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(TraceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).Trace(m, &machineServiceTraceServer{ServerStream: stream})
+}
+
+type MachineService_TraceServer interface {
+	Send(*TraceEvent) error
+	grpc.ServerStream
+}
+
+type machineServiceTraceServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceTraceServer) Send(m *TraceEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_Strace_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StraceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).Strace(m, &machineServiceStraceServer{ServerStream: stream})
+}
+
+type MachineService_StraceServer interface {
+	Send(*StraceEvent) error
+	grpc.ServerStream
+}
+
+type machineServiceStraceServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceStraceServer) Send(m *StraceEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_StackDump_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StackDumpRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MachineServiceServer).StackDump(m, &machineServiceStackDumpServer{ServerStream: stream})
+}
+
+type MachineService_StackDumpServer interface {
+	Send(*common.Data) error
+	grpc.ServerStream
+}
+
+type machineServiceStackDumpServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceStackDumpServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MachineService_DebugAttach_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(MachineServiceServer).DebugAttach(&machineServiceDebugAttachServer{ServerStream: stream})
+}
+
+type MachineService_DebugAttachServer interface {
+	Send(*common.Data) error
+	Recv() (*DebugAttachRequest, error)
+	grpc.ServerStream
+}
+
+type machineServiceDebugAttachServer struct {
+	grpc.ServerStream
+}
+
+func (x *machineServiceDebugAttachServer) Send(m *common.Data) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *machineServiceDebugAttachServer) Recv() (*DebugAttachRequest, error) {
+	m := new(DebugAttachRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _MachineService_JoinTokenCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinTokenCreateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Shutdown(ctx, in)
+		return srv.(MachineServiceServer).JoinTokenCreate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Shutdown_FullMethodName,
+		FullMethod: MachineService_JoinTokenCreate_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Shutdown(ctx, req.(*ShutdownRequest))
+		return srv.(MachineServiceServer).JoinTokenCreate(ctx, req.(*JoinTokenCreateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Stats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StatsRequest)
+func _MachineService_JoinTokenList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Stats(ctx, in)
+		return srv.(MachineServiceServer).JoinTokenList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Stats_FullMethodName,
+		FullMethod: MachineService_JoinTokenList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Stats(ctx, req.(*StatsRequest))
+		return srv.(MachineServiceServer).JoinTokenList(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_SystemStat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MachineService_JoinTokenRevoke_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JoinTokenRevokeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).SystemStat(ctx, in)
+		return srv.(MachineServiceServer).JoinTokenRevoke(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_SystemStat_FullMethodName,
+		FullMethod: MachineService_JoinTokenRevoke_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).SystemStat(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).JoinTokenRevoke(ctx, req.(*JoinTokenRevokeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Upgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpgradeRequest)
+func _MachineService_Certificates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Upgrade(ctx, in)
+		return srv.(MachineServiceServer).Certificates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Upgrade_FullMethodName,
+		FullMethod: MachineService_Certificates_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Upgrade(ctx, req.(*UpgradeRequest))
+		return srv.(MachineServiceServer).Certificates(ctx, req.(*emptypb.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_Version_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+func _MachineService_DiskBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiskBenchmarkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Version(ctx, in)
+		return srv.(MachineServiceServer).DiskBenchmark(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Version_FullMethodName,
+		FullMethod: MachineService_DiskBenchmark_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Version(ctx, req.(*emptypb.Empty))
+		return srv.(MachineServiceServer).DiskBenchmark(ctx, req.(*DiskBenchmarkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_GenerateClientConfiguration_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenerateClientConfigurationRequest)
+func _MachineService_MemoryBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MemoryBenchmarkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).GenerateClientConfiguration(ctx, in)
+		return srv.(MachineServiceServer).MemoryBenchmark(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_GenerateClientConfiguration_FullMethodName,
+		FullMethod: MachineService_MemoryBenchmark_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).GenerateClientConfiguration(ctx, req.(*GenerateClientConfigurationRequest))
+		return srv.(MachineServiceServer).MemoryBenchmark(ctx, req.(*MemoryBenchmarkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_PacketCapture_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(PacketCaptureRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(MachineServiceServer).PacketCapture(m, &machineServicePacketCaptureServer{ServerStream: stream})
-}
-
-type MachineService_PacketCaptureServer interface {
-	Send(*common.Data) error
-	grpc.ServerStream
-}
-
-type machineServicePacketCaptureServer struct {
-	grpc.ServerStream
-}
-
-func (x *machineServicePacketCaptureServer) Send(m *common.Data) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _MachineService_Netstat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(NetstatRequest)
+func _MachineService_NetworkBenchmark_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NetworkBenchmarkRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).Netstat(ctx, in)
+		return srv.(MachineServiceServer).NetworkBenchmark(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_Netstat_FullMethodName,
+		FullMethod: MachineService_NetworkBenchmark_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).Netstat(ctx, req.(*NetstatRequest))
+		return srv.(MachineServiceServer).NetworkBenchmark(ctx, req.(*NetworkBenchmarkRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_MetaWrite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MetaWriteRequest)
+func _MachineService_ChaosServiceKill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaosServiceKillRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).MetaWrite(ctx, in)
+		return srv.(MachineServiceServer).ChaosServiceKill(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_MetaWrite_FullMethodName,
+		FullMethod: MachineService_ChaosServiceKill_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).MetaWrite(ctx, req.(*MetaWriteRequest))
+		return srv.(MachineServiceServer).ChaosServiceKill(ctx, req.(*ChaosServiceKillRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_MetaDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MetaDeleteRequest)
+func _MachineService_ChaosNetworkDrop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaosNetworkDropRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).MetaDelete(ctx, in)
+		return srv.(MachineServiceServer).ChaosNetworkDrop(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_MetaDelete_FullMethodName,
+		FullMethod: MachineService_ChaosNetworkDrop_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).MetaDelete(ctx, req.(*MetaDeleteRequest))
+		return srv.(MachineServiceServer).ChaosNetworkDrop(ctx, req.(*ChaosNetworkDropRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MachineService_ImageList_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(ImageListRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(MachineServiceServer).ImageList(m, &machineServiceImageListServer{ServerStream: stream})
-}
-
-type MachineService_ImageListServer interface {
-	Send(*ImageListResponse) error
-	grpc.ServerStream
-}
-
-type machineServiceImageListServer struct {
-	grpc.ServerStream
-}
-
-func (x *machineServiceImageListServer) Send(m *ImageListResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _MachineService_ImagePull_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ImagePullRequest)
+func _MachineService_ChaosEphemeralFill_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChaosEphemeralFillRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MachineServiceServer).ImagePull(ctx, in)
+		return srv.(MachineServiceServer).ChaosEphemeralFill(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MachineService_ImagePull_FullMethodName,
+		FullMethod: MachineService_ChaosEphemeralFill_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MachineServiceServer).ImagePull(ctx, req.(*ImagePullRequest))
+		return srv.(MachineServiceServer).ChaosEphemeralFill(ctx, req.(*ChaosEphemeralFillRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -2239,6 +3467,10 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "EtcdStatus",
 			Handler:    _MachineService_EtcdStatus_Handler,
 		},
+		{
+			MethodName: "EtcdPrecheck",
+			Handler:    _MachineService_EtcdPrecheck_Handler,
+		},
 		{
 			MethodName: "GenerateConfiguration",
 			Handler:    _MachineService_GenerateConfiguration_Handler,
@@ -2287,6 +3519,10 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Reset",
 			Handler:    _MachineService_Reset_Handler,
 		},
+		{
+			MethodName: "ServiceGraph",
+			Handler:    _MachineService_ServiceGraph_Handler,
+		},
 		{
 			MethodName: "ServiceList",
 			Handler:    _MachineService_ServiceList_Handler,
@@ -2307,6 +3543,10 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Shutdown",
 			Handler:    _MachineService_Shutdown_Handler,
 		},
+		{
+			MethodName: "PowerActionCancel",
+			Handler:    _MachineService_PowerActionCancel_Handler,
+		},
 		{
 			MethodName: "Stats",
 			Handler:    _MachineService_Stats_Handler,
@@ -2331,6 +3571,14 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Netstat",
 			Handler:    _MachineService_Netstat_Handler,
 		},
+		{
+			MethodName: "Neighbors",
+			Handler:    _MachineService_Neighbors_Handler,
+		},
+		{
+			MethodName: "PathMTU",
+			Handler:    _MachineService_PathMTU_Handler,
+		},
 		{
 			MethodName: "MetaWrite",
 			Handler:    _MachineService_MetaWrite_Handler,
@@ -2343,6 +3591,70 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ImagePull",
 			Handler:    _MachineService_ImagePull_Handler,
 		},
+		{
+			MethodName: "ImageValidate",
+			Handler:    _MachineService_ImageValidate_Handler,
+		},
+		{
+			MethodName: "BootLogs",
+			Handler:    _MachineService_BootLogs_Handler,
+		},
+		{
+			MethodName: "BMCSensors",
+			Handler:    _MachineService_BMCSensors_Handler,
+		},
+		{
+			MethodName: "BMCEventLog",
+			Handler:    _MachineService_BMCEventLog_Handler,
+		},
+		{
+			MethodName: "HardwareInventory",
+			Handler:    _MachineService_HardwareInventory_Handler,
+		},
+		{
+			MethodName: "SensorStats",
+			Handler:    _MachineService_SensorStats_Handler,
+		},
+		{
+			MethodName: "JoinTokenCreate",
+			Handler:    _MachineService_JoinTokenCreate_Handler,
+		},
+		{
+			MethodName: "JoinTokenList",
+			Handler:    _MachineService_JoinTokenList_Handler,
+		},
+		{
+			MethodName: "JoinTokenRevoke",
+			Handler:    _MachineService_JoinTokenRevoke_Handler,
+		},
+		{
+			MethodName: "Certificates",
+			Handler:    _MachineService_Certificates_Handler,
+		},
+		{
+			MethodName: "DiskBenchmark",
+			Handler:    _MachineService_DiskBenchmark_Handler,
+		},
+		{
+			MethodName: "MemoryBenchmark",
+			Handler:    _MachineService_MemoryBenchmark_Handler,
+		},
+		{
+			MethodName: "NetworkBenchmark",
+			Handler:    _MachineService_NetworkBenchmark_Handler,
+		},
+		{
+			MethodName: "ChaosServiceKill",
+			Handler:    _MachineService_ChaosServiceKill_Handler,
+		},
+		{
+			MethodName: "ChaosNetworkDrop",
+			Handler:    _MachineService_ChaosNetworkDrop_Handler,
+		},
+		{
+			MethodName: "ChaosEphemeralFill",
+			Handler:    _MachineService_ChaosEphemeralFill_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -2370,6 +3682,11 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _MachineService_EtcdSnapshot_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "EtcdRotateCertificates",
+			Handler:       _MachineService_EtcdRotateCertificates_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Kubeconfig",
 			Handler:       _MachineService_Kubeconfig_Handler,
@@ -2390,6 +3707,11 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _MachineService_Logs_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ProcessesStream",
+			Handler:       _MachineService_ProcessesStream_Handler,
+			ServerStreams: true,
+		},
 		{
 			StreamName:    "Read",
 			Handler:       _MachineService_Read_Handler,
@@ -2405,6 +3727,32 @@ var MachineService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _MachineService_ImageList_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "Profile",
+			Handler:       _MachineService_Profile_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Trace",
+			Handler:       _MachineService_Trace_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "Strace",
+			Handler:       _MachineService_Strace_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "StackDump",
+			Handler:       _MachineService_StackDump_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DebugAttach",
+			Handler:       _MachineService_DebugAttach_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
 	},
 	Metadata: "machine/machine.proto",
 }
@@ -20,7 +20,8 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	SecurityService_Certificate_FullMethodName = "/securityapi.SecurityService/Certificate"
+	SecurityService_Certificate_FullMethodName      = "/securityapi.SecurityService/Certificate"
+	SecurityService_AttestationNonce_FullMethodName = "/securityapi.SecurityService/AttestationNonce"
 )
 
 // SecurityServiceClient is the client API for SecurityService service.
@@ -30,6 +31,8 @@ const (
 // The security service definition.
 type SecurityServiceClient interface {
 	Certificate(ctx context.Context, in *CertificateRequest, opts ...grpc.CallOption) (*CertificateResponse, error)
+	// AttestationNonce issues a short-lived nonce to qualify the TPM attestation of the certificate request with.
+	AttestationNonce(ctx context.Context, in *AttestationNonceRequest, opts ...grpc.CallOption) (*AttestationNonceResponse, error)
 }
 
 type securityServiceClient struct {
@@ -50,6 +53,16 @@ func (c *securityServiceClient) Certificate(ctx context.Context, in *Certificate
 	return out, nil
 }
 
+func (c *securityServiceClient) AttestationNonce(ctx context.Context, in *AttestationNonceRequest, opts ...grpc.CallOption) (*AttestationNonceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AttestationNonceResponse)
+	err := c.cc.Invoke(ctx, SecurityService_AttestationNonce_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SecurityServiceServer is the server API for SecurityService service.
 // All implementations must embed UnimplementedSecurityServiceServer
 // for forward compatibility
@@ -57,6 +70,8 @@ func (c *securityServiceClient) Certificate(ctx context.Context, in *Certificate
 // The security service definition.
 type SecurityServiceServer interface {
 	Certificate(context.Context, *CertificateRequest) (*CertificateResponse, error)
+	// AttestationNonce issues a short-lived nonce to qualify the TPM attestation of the certificate request with.
+	AttestationNonce(context.Context, *AttestationNonceRequest) (*AttestationNonceResponse, error)
 	mustEmbedUnimplementedSecurityServiceServer()
 }
 
@@ -67,6 +82,9 @@ type UnimplementedSecurityServiceServer struct {
 func (UnimplementedSecurityServiceServer) Certificate(context.Context, *CertificateRequest) (*CertificateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Certificate not implemented")
 }
+func (UnimplementedSecurityServiceServer) AttestationNonce(context.Context, *AttestationNonceRequest) (*AttestationNonceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationNonce not implemented")
+}
 func (UnimplementedSecurityServiceServer) mustEmbedUnimplementedSecurityServiceServer() {}
 
 // UnsafeSecurityServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -98,6 +116,24 @@ func _SecurityService_Certificate_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SecurityService_AttestationNonce_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AttestationNonceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SecurityServiceServer).AttestationNonce(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SecurityService_AttestationNonce_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SecurityServiceServer).AttestationNonce(ctx, req.(*AttestationNonceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SecurityService_ServiceDesc is the grpc.ServiceDesc for SecurityService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -109,6 +145,10 @@ var SecurityService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Certificate",
 			Handler:    _SecurityService_Certificate_Handler,
 		},
+		{
+			MethodName: "AttestationNonce",
+			Handler:    _SecurityService_AttestationNonce_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "security/security.proto",
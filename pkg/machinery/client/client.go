@@ -23,6 +23,7 @@ import (
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	clusterapi "github.com/siderolabs/talos/pkg/machinery/api/cluster"
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
@@ -340,6 +341,27 @@ func WithPowerCycle(req *machineapi.RebootRequest) {
 	req.Mode = machineapi.RebootRequest_POWERCYCLE
 }
 
+// WithRebootForce reboots the machine without gracefully stopping the pods.
+func WithRebootForce(force bool) RebootMode {
+	return func(req *machineapi.RebootRequest) {
+		req.Force = force
+	}
+}
+
+// WithRebootAt schedules the reboot at the specified time.
+func WithRebootAt(at time.Time) RebootMode {
+	return func(req *machineapi.RebootRequest) {
+		req.At = timestamppb.New(at)
+	}
+}
+
+// WithRebootMessage sets the message broadcast to the event stream with the reboot.
+func WithRebootMessage(message string) RebootMode {
+	return func(req *machineapi.RebootRequest) {
+		req.Message = message
+	}
+}
+
 // Reboot implements the proto.MachineServiceClient interface.
 func (c *Client) Reboot(ctx context.Context, opts ...RebootMode) error {
 	_, err := c.RebootWithResponse(ctx, opts...)
@@ -395,6 +417,20 @@ func WithShutdownForce(force bool) ShutdownOption {
 	}
 }
 
+// WithShutdownAt schedules the shutdown at the specified time.
+func WithShutdownAt(at time.Time) ShutdownOption {
+	return func(req *machineapi.ShutdownRequest) {
+		req.At = timestamppb.New(at)
+	}
+}
+
+// WithShutdownMessage sets the message broadcast to the event stream with the shutdown.
+func WithShutdownMessage(message string) ShutdownOption {
+	return func(req *machineapi.ShutdownRequest) {
+		req.Message = message
+	}
+}
+
 // Shutdown implements the proto.MachineServiceClient interface.
 func (c *Client) Shutdown(ctx context.Context, opts ...ShutdownOption) error {
 	_, err := c.ShutdownWithResponse(ctx, opts...)
@@ -419,12 +455,49 @@ func (c *Client) ShutdownWithResponse(ctx context.Context, opts ...ShutdownOptio
 	return resp, err
 }
 
+// PowerActionCancel cancels the scheduled reboot or shutdown.
+func (c *Client) PowerActionCancel(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.PowerActionCancelResponse, err error) {
+	resp, err = c.MachineClient.PowerActionCancel(ctx, &machineapi.PowerActionCancelRequest{}, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// DmesgOption provides dmesg API options.
+type DmesgOption func(*machineapi.DmesgRequest)
+
+// WithDmesgSeverity returns only the messages with the specified or higher severity, e.g. "err" or "warning".
+func WithDmesgSeverity(severity string) DmesgOption {
+	return func(req *machineapi.DmesgRequest) {
+		req.Severity = severity
+	}
+}
+
+// WithDmesgFacilities returns only the messages of the specified facilities, e.g. "kern".
+func WithDmesgFacilities(facilities ...string) DmesgOption {
+	return func(req *machineapi.DmesgRequest) {
+		req.Facilities = append(req.Facilities, facilities...)
+	}
+}
+
+// WithDmesgRateLimit limits the number of the messages sent per second.
+func WithDmesgRateLimit(perSecond uint32) DmesgOption {
+	return func(req *machineapi.DmesgRequest) {
+		req.RateLimit = perSecond
+	}
+}
+
 // Dmesg implements the proto.MachineServiceClient interface.
-func (c *Client) Dmesg(ctx context.Context, follow, tail bool) (machineapi.MachineService_DmesgClient, error) {
-	return c.MachineClient.Dmesg(ctx, &machineapi.DmesgRequest{
+func (c *Client) Dmesg(ctx context.Context, follow, tail bool, opts ...DmesgOption) (machineapi.MachineService_DmesgClient, error) {
+	req := &machineapi.DmesgRequest{
 		Follow: follow,
 		Tail:   tail,
-	})
+	}
+
+	for _, opt := range opts {
+		opt(req)
+	}
+
+	return c.MachineClient.Dmesg(ctx, req)
 }
 
 // Logs implements the proto.MachineServiceClient interface.
@@ -473,6 +546,16 @@ func (c *Client) Processes(ctx context.Context, callOptions ...grpc.CallOption)
 	return FilterMessages(resp, err)
 }
 
+// ProcessesStream streams the process list in batches of batchSize processes (zero means the default batch size).
+func (c *Client) ProcessesStream(ctx context.Context, batchSize uint32, callOptions ...grpc.CallOption) (machineapi.MachineService_ProcessesStreamClient, error) {
+	return c.MachineClient.ProcessesStream(ctx,
+		&machineapi.ProcessesStreamRequest{
+			BatchSize: batchSize,
+		},
+		callOptions...,
+	)
+}
+
 // Memory implements the proto.MachineServiceClient interface.
 func (c *Client) Memory(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.MemoryResponse, err error) {
 	resp, err = c.MachineClient.Memory(
@@ -597,6 +680,17 @@ func (c *Client) UpgradeWithOptions(ctx context.Context, opts ...UpgradeOption)
 	return FilterMessages(resp, err)
 }
 
+// ServiceGraph returns the dependencies and the conditions of the services.
+func (c *Client) ServiceGraph(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.ServiceGraphResponse, err error) {
+	resp, err = c.MachineClient.ServiceGraph(
+		ctx,
+		&emptypb.Empty{},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
 // ServiceList returns list of services with their state.
 func (c *Client) ServiceList(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.ServiceListResponse, err error) {
 	resp, err = c.MachineClient.ServiceList(
@@ -777,6 +871,13 @@ func (c *Client) EtcdSnapshot(ctx context.Context, req *machineapi.EtcdSnapshotR
 	return ReadStream(stream)
 }
 
+// EtcdRotateCertificates issues new etcd certificates on the node and restarts the etcd member.
+//
+// The returned stream reports the progress of the rotation.
+func (c *Client) EtcdRotateCertificates(ctx context.Context, callOptions ...grpc.CallOption) (machineapi.MachineService_EtcdRotateCertificatesClient, error) {
+	return c.MachineClient.EtcdRotateCertificates(ctx, &emptypb.Empty{}, callOptions...)
+}
+
 // EtcdRecover uploads etcd snapshot created with EtcdSnapshot to the node.
 func (c *Client) EtcdRecover(ctx context.Context, snapshot io.Reader, callOptions ...grpc.CallOption) (*machineapi.EtcdRecoverResponse, error) {
 	cli, err := c.MachineClient.EtcdRecover(ctx, callOptions...)
@@ -859,6 +960,15 @@ func (c *Client) EtcdStatus(ctx context.Context, opts ...grpc.CallOption) (*mach
 	return FilterMessages(resp, err)
 }
 
+// EtcdPrecheck measures the latency of the disk writes etcd depends on, and compares it against the recommended thresholds.
+//
+// This method is available only on control plane nodes (which run etcd).
+func (c *Client) EtcdPrecheck(ctx context.Context, req *machineapi.EtcdPrecheckRequest, callOptions ...grpc.CallOption) (*machineapi.EtcdPrecheckResponse, error) {
+	resp, err := c.MachineClient.EtcdPrecheck(ctx, req, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
 // GenerateClientConfiguration implements proto.MachineServiceClient interface.
 func (c *Client) GenerateClientConfiguration(ctx context.Context, req *machineapi.GenerateClientConfigurationRequest, callOptions ...grpc.CallOption) (resp *machineapi.GenerateClientConfigurationResponse, err error) { //nolint:lll
 	resp, err = c.MachineClient.GenerateClientConfiguration(ctx, req, callOptions...)
@@ -947,6 +1057,28 @@ func (c *Client) Netstat(ctx context.Context, req *machineapi.NetstatRequest, ca
 	return FilterMessages(resp, err)
 }
 
+// Neighbors lists the kernel neighbor table entries on the current node.
+func (c *Client) Neighbors(ctx context.Context, callOptions ...grpc.CallOption) (*machineapi.NeighborsResponse, error) {
+	resp, err := c.MachineClient.Neighbors(
+		ctx,
+		&emptypb.Empty{},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// PathMTU discovers the path MTU from the current node to the destination.
+func (c *Client) PathMTU(ctx context.Context, req *machineapi.PathMTURequest, callOptions ...grpc.CallOption) (*machineapi.PathMTUResponse, error) {
+	resp, err := c.MachineClient.PathMTU(
+		ctx,
+		req,
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
 // MetaWrite writes a key to META storage.
 func (c *Client) MetaWrite(ctx context.Context, key uint8, value []byte, callOptions ...grpc.CallOption) error {
 	resp, err := c.MachineClient.MetaWrite(
@@ -978,6 +1110,48 @@ func (c *Client) MetaDelete(ctx context.Context, key uint8, callOptions ...grpc.
 	return err
 }
 
+// Certificates lists the certificates held by the node.
+func (c *Client) Certificates(ctx context.Context, callOptions ...grpc.CallOption) (*machineapi.CertificatesResponse, error) {
+	resp, err := c.MachineClient.Certificates(ctx, &emptypb.Empty{}, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// JoinTokenCreate creates a short-lived join token.
+//
+// This method is available only on control plane nodes (which run etcd).
+func (c *Client) JoinTokenCreate(ctx context.Context, req *machineapi.JoinTokenCreateRequest, callOptions ...grpc.CallOption) (*machineapi.JoinTokenCreateResponse, error) {
+	resp, err := c.MachineClient.JoinTokenCreate(ctx, req, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// JoinTokenList lists the join tokens.
+//
+// This method is available only on control plane nodes (which run etcd).
+func (c *Client) JoinTokenList(ctx context.Context, callOptions ...grpc.CallOption) (*machineapi.JoinTokenListResponse, error) {
+	resp, err := c.MachineClient.JoinTokenList(ctx, &emptypb.Empty{}, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// JoinTokenRevoke revokes the join token.
+//
+// This method is available only on control plane nodes (which run etcd).
+func (c *Client) JoinTokenRevoke(ctx context.Context, id string, callOptions ...grpc.CallOption) error {
+	resp, err := c.MachineClient.JoinTokenRevoke(
+		ctx,
+		&machineapi.JoinTokenRevokeRequest{
+			Id: id,
+		},
+		callOptions...,
+	)
+
+	_, err = FilterMessages(resp, err)
+
+	return err
+}
+
 // ImageList lists images in the CRI.
 func (c *Client) ImageList(ctx context.Context, namespace common.ContainerdNamespace, callOptions ...grpc.CallOption) (machineapi.MachineService_ImageListClient, error) {
 	return c.MachineClient.ImageList(ctx,
@@ -1002,3 +1176,146 @@ func (c *Client) ImagePull(ctx context.Context, namespace common.ContainerdNames
 
 	return err
 }
+
+// ImageValidate runs the upgrade pre-flight checks against the installer image.
+func (c *Client) ImageValidate(ctx context.Context, imageRef string, callOptions ...grpc.CallOption) (resp *machineapi.ImageValidateResponse, err error) {
+	resp, err = c.MachineClient.ImageValidate(ctx,
+		&machineapi.ImageValidateRequest{
+			Reference: imageRef,
+		},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// BootLogs returns the early boot logs of the last boots.
+func (c *Client) BootLogs(ctx context.Context, boots int, callOptions ...grpc.CallOption) (resp *machineapi.BootLogsResponse, err error) {
+	resp, err = c.MachineClient.BootLogs(ctx,
+		&machineapi.BootLogsRequest{
+			Boots: int32(boots),
+		},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// BMCSensors returns the chassis power state and the sensor readings reported by the BMC.
+func (c *Client) BMCSensors(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.BMCSensorsResponse, err error) {
+	resp, err = c.MachineClient.BMCSensors(ctx, &machineapi.BMCSensorsRequest{}, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// BMCEventLog returns up to tail latest entries of the BMC System Event Log (all entries if tail is not positive).
+func (c *Client) BMCEventLog(ctx context.Context, tail int, callOptions ...grpc.CallOption) (resp *machineapi.BMCEventLogResponse, err error) {
+	resp, err = c.MachineClient.BMCEventLog(ctx,
+		&machineapi.BMCEventLogRequest{
+			Tail: int32(tail),
+		},
+		callOptions...,
+	)
+
+	return FilterMessages(resp, err)
+}
+
+// HardwareInventory returns the hardware inventory of the node.
+func (c *Client) HardwareInventory(ctx context.Context, callOptions ...grpc.CallOption) (resp *machineapi.HardwareInventoryResponse, err error) {
+	resp, err = c.MachineClient.HardwareInventory(ctx, &machineapi.HardwareInventoryRequest{}, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// Profile collects a pprof profile of a Talos service.
+func (c *Client) Profile(ctx context.Context, req *machineapi.ProfileRequest, callOptions ...grpc.CallOption) (io.ReadCloser, error) {
+	stream, err := c.MachineClient.Profile(ctx, req, callOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return ReadStream(stream)
+}
+
+// Trace runs an eBPF-based tracing tool and streams the traced events.
+func (c *Client) Trace(ctx context.Context, req *machineapi.TraceRequest, callOptions ...grpc.CallOption) (machineapi.MachineService_TraceClient, error) {
+	return c.MachineClient.Trace(ctx, req, callOptions...)
+}
+
+// Strace attaches to the process with ptrace and streams the syscalls it makes.
+func (c *Client) Strace(ctx context.Context, req *machineapi.StraceRequest, callOptions ...grpc.CallOption) (machineapi.MachineService_StraceClient, error) {
+	return c.MachineClient.Strace(ctx, req, callOptions...)
+}
+
+// StackDump dumps the goroutine stacks of a Talos service in plain text.
+func (c *Client) StackDump(ctx context.Context, service string, callOptions ...grpc.CallOption) (io.ReadCloser, error) {
+	stream, err := c.MachineClient.StackDump(ctx, &machineapi.StackDumpRequest{
+		Service: service,
+	}, callOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	return ReadStream(stream)
+}
+
+// DebugAttach attaches the debugger to the process, and opens a tunnel to the debugger API server.
+//
+// The data to the debugger API server is sent as DebugAttachRequest.Data, the responses from the server are
+// received as common.Data.
+func (c *Client) DebugAttach(ctx context.Context, pid int32, callOptions ...grpc.CallOption) (machineapi.MachineService_DebugAttachClient, error) {
+	stream, err := c.MachineClient.DebugAttach(ctx, callOptions...)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = stream.Send(&machineapi.DebugAttachRequest{
+		Pid: pid,
+	}); err != nil {
+		return nil, err
+	}
+
+	return stream, nil
+}
+
+// DiskBenchmark runs the disk benchmark on a scratch file in the EPHEMERAL partition.
+func (c *Client) DiskBenchmark(ctx context.Context, req *machineapi.DiskBenchmarkRequest, callOptions ...grpc.CallOption) (*machineapi.BenchmarkResponse, error) {
+	resp, err := c.MachineClient.DiskBenchmark(ctx, req, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// MemoryBenchmark runs the memory bandwidth benchmark.
+func (c *Client) MemoryBenchmark(ctx context.Context, req *machineapi.MemoryBenchmarkRequest, callOptions ...grpc.CallOption) (*machineapi.BenchmarkResponse, error) {
+	resp, err := c.MachineClient.MemoryBenchmark(ctx, req, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// NetworkBenchmark runs either the server or the client side of the TCP throughput benchmark.
+func (c *Client) NetworkBenchmark(ctx context.Context, req *machineapi.NetworkBenchmarkRequest, callOptions ...grpc.CallOption) (*machineapi.BenchmarkResponse, error) {
+	resp, err := c.MachineClient.NetworkBenchmark(ctx, req, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// ChaosServiceKill kills all processes of the service (chaos API).
+func (c *Client) ChaosServiceKill(ctx context.Context, id string, callOptions ...grpc.CallOption) (*machineapi.ChaosResponse, error) {
+	resp, err := c.MachineClient.ChaosServiceKill(ctx, &machineapi.ChaosServiceKillRequest{Id: id}, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// ChaosNetworkDrop drops the network traffic to and from the subnets for the duration (chaos API).
+func (c *Client) ChaosNetworkDrop(ctx context.Context, req *machineapi.ChaosNetworkDropRequest, callOptions ...grpc.CallOption) (*machineapi.ChaosResponse, error) {
+	resp, err := c.MachineClient.ChaosNetworkDrop(ctx, req, callOptions...)
+
+	return FilterMessages(resp, err)
+}
+
+// ChaosEphemeralFill fills the EPHEMERAL partition for the duration (chaos API).
+func (c *Client) ChaosEphemeralFill(ctx context.Context, req *machineapi.ChaosEphemeralFillRequest, callOptions ...grpc.CallOption) (*machineapi.ChaosResponse, error) {
+	resp, err := c.MachineClient.ChaosEphemeralFill(ctx, req, callOptions...)
+
+	return FilterMessages(resp, err)
+}
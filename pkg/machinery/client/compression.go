@@ -0,0 +1,224 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto/zstd"
+)
+
+// DefaultCompressors is the default list of compressors to negotiate with the server, in the order of preference.
+var DefaultCompressors = []string{zstd.Name, gzip.Name}
+
+// WithCompression sets the list of compressors to negotiate with the server, in the order of preference.
+//
+// The requests are compressed with the first compressor, the server replies with the same compression.
+// If the server doesn't support the compressor, the request is transparently retried with the next one,
+// and finally without compression.
+// Calling WithCompression without arguments disables the compression.
+//
+// By default, DefaultCompressors are used.
+func WithCompression(compressors ...string) OptionFunc {
+	return func(o *Options) error {
+		o.compressors = compressors
+		o.compressionSet = true
+
+		return nil
+	}
+}
+
+// WithMaxRecvMsgSize sets the maximum size of the message the client can receive.
+//
+// By default, constants.GRPCMaxMessageSize is used.
+func WithMaxRecvMsgSize(size int) OptionFunc {
+	return func(o *Options) error {
+		o.maxRecvMsgSize = size
+
+		return nil
+	}
+}
+
+// compressionNegotiator picks the compressor supported by the server.
+type compressionNegotiator struct {
+	mu sync.Mutex
+
+	// candidates are the compressors which were not rejected by the server yet
+	candidates []string
+	// confirmed is set once the server replied to the compressed request
+	confirmed bool
+}
+
+func newCompressionNegotiator(compressors []string) *compressionNegotiator {
+	return &compressionNegotiator{
+		candidates: compressors,
+	}
+}
+
+// current returns the compressor to use, empty string means no compression.
+//
+// If confirmedOnly is set, the compressor is only returned if it's known to be supported by the server.
+func (n *compressionNegotiator) current(confirmedOnly bool) string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if len(n.candidates) == 0 || (confirmedOnly && !n.confirmed) {
+		return ""
+	}
+
+	return n.candidates[0]
+}
+
+func (n *compressionNegotiator) confirm(name string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if len(n.candidates) > 0 && n.candidates[0] == name {
+		n.confirmed = true
+	}
+}
+
+func (n *compressionNegotiator) reject(name string) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if len(n.candidates) > 0 && n.candidates[0] == name {
+		n.candidates = n.candidates[1:]
+		n.confirmed = false
+	}
+}
+
+func callOptionsWithCompressor(name string, opts []grpc.CallOption) []grpc.CallOption {
+	if name == "" {
+		return opts
+	}
+
+	// prepend, so that the compressor set explicitly for the call takes precedence
+	return append([]grpc.CallOption{grpc.UseCompressor(name)}, opts...)
+}
+
+// compressionUnsupported returns true if the server failed to decompress the request.
+func compressionUnsupported(err error) bool {
+	st, ok := status.FromError(err)
+
+	return ok && st.Code() == codes.Unimplemented && strings.Contains(st.Message(), "Decompressor is not installed")
+}
+
+func (n *compressionNegotiator) unaryInterceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		for {
+			name := n.current(false)
+
+			err := invoker(ctx, method, req, reply, cc, callOptionsWithCompressor(name, opts)...)
+			if name == "" {
+				return err
+			}
+
+			if !compressionUnsupported(err) {
+				if err == nil {
+					n.confirm(name)
+				}
+
+				return err
+			}
+
+			n.reject(name)
+		}
+	}
+}
+
+func (n *compressionNegotiator) streamInterceptor() grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		// client-streaming requests can't be replayed, so use only the compressor known to be supported
+		if desc.ClientStreams {
+			return streamer(ctx, desc, cc, method, callOptionsWithCompressor(n.current(true), opts)...)
+		}
+
+		name := n.current(false)
+
+		stream, err := streamer(ctx, desc, cc, method, callOptionsWithCompressor(name, opts)...)
+		if err != nil || name == "" {
+			return stream, err
+		}
+
+		return &negotiatingStream{
+			ClientStream: stream,
+			negotiator:   n,
+			name:         name,
+			reopen: func(name string) (grpc.ClientStream, error) {
+				return streamer(ctx, desc, cc, method, callOptionsWithCompressor(name, opts)...)
+			},
+		}, nil
+	}
+}
+
+// negotiatingStream replays the request without the compressor if the server doesn't support it.
+//
+// The server reports the unsupported compressor on the first receive, so the server-streaming request
+// (which consists of a single message) can be transparently sent again.
+type negotiatingStream struct {
+	grpc.ClientStream
+
+	negotiator *compressionNegotiator
+	reopen     func(name string) (grpc.ClientStream, error)
+	name       string
+	request    any
+	received   bool
+}
+
+func (s *negotiatingStream) SendMsg(m any) error {
+	s.request = m
+
+	return s.ClientStream.SendMsg(m)
+}
+
+func (s *negotiatingStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+
+	if s.received || s.name == "" {
+		return err
+	}
+
+	if !compressionUnsupported(err) {
+		s.received = true
+
+		if err == nil || errors.Is(err, io.EOF) {
+			s.negotiator.confirm(s.name)
+		}
+
+		return err
+	}
+
+	s.negotiator.reject(s.name)
+	s.name = s.negotiator.current(false)
+
+	stream, reopenErr := s.reopen(s.name)
+	if reopenErr != nil {
+		return reopenErr
+	}
+
+	s.ClientStream = stream
+
+	if s.request != nil {
+		if err = s.ClientStream.SendMsg(s.request); err != nil {
+			return err
+		}
+	}
+
+	if err = s.ClientStream.CloseSend(); err != nil {
+		return err
+	}
+
+	return s.RecvMsg(m)
+}
@@ -0,0 +1,168 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/encoding/gzip"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/proto/zstd"
+)
+
+// encodingRecorder records the request compression and simulates the server which doesn't support zstd.
+type encodingRecorder struct {
+	mu        sync.Mutex
+	encodings []string
+}
+
+func (r *encodingRecorder) check(ctx context.Context) error {
+	stream, ok := grpc.ServerTransportStreamFromContext(ctx).(interface{ RecvCompress() string })
+	if !ok {
+		return status.Error(codes.Internal, "unexpected stream")
+	}
+
+	encoding := stream.RecvCompress()
+
+	r.mu.Lock()
+	r.encodings = append(r.encodings, encoding)
+	r.mu.Unlock()
+
+	if encoding == zstd.Name {
+		return status.Errorf(codes.Unimplemented, "grpc: Decompressor is not installed for grpc-encoding %q", encoding)
+	}
+
+	return nil
+}
+
+func (r *encodingRecorder) get() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	encodings := r.encodings
+	r.encodings = nil
+
+	return encodings
+}
+
+func TestCompressionNegotiation(t *testing.T) {
+	t.Parallel()
+
+	recorder := &encodingRecorder{}
+
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			if err := recorder.check(ctx); err != nil {
+				return nil, err
+			}
+
+			return handler(ctx, req)
+		}),
+		grpc.StreamInterceptor(func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+			if err := recorder.check(ss.Context()); err != nil {
+				return err
+			}
+
+			return handler(srv, ss)
+		}),
+	)
+
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(server, healthServer)
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go server.Serve(listener) //nolint:errcheck
+
+	t.Cleanup(server.Stop)
+
+	unaryInterceptor, streamInterceptor := client.CompressionInterceptors(zstd.Name, gzip.Name)
+
+	conn, err := grpc.NewClient(listener.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(unaryInterceptor),
+		grpc.WithStreamInterceptor(streamInterceptor),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { conn.Close() }) //nolint:errcheck
+
+	healthClient := healthpb.NewHealthClient(conn)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	// the server-streaming request is transparently replayed with the next compressor
+	stream, err := healthClient.Watch(ctx, &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+
+	resp, err := stream.Recv()
+	require.NoError(t, err)
+	assert.Equal(t, healthpb.HealthCheckResponse_SERVING, resp.Status)
+
+	assert.Equal(t, []string{zstd.Name, gzip.Name}, recorder.get())
+
+	// the negotiated compressor is used for the following requests
+	for i := range 2 {
+		_, err = healthClient.Check(ctx, &healthpb.HealthCheckRequest{})
+		require.NoError(t, err, fmt.Sprintf("attempt %d", i))
+	}
+
+	assert.Equal(t, []string{gzip.Name, gzip.Name}, recorder.get())
+}
+
+func TestCompressionDisabled(t *testing.T) {
+	t.Parallel()
+
+	recorder := &encodingRecorder{}
+
+	server := grpc.NewServer(
+		grpc.UnaryInterceptor(func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+			if err := recorder.check(ctx); err != nil {
+				return nil, err
+			}
+
+			return handler(ctx, req)
+		}),
+	)
+
+	healthpb.RegisterHealthServer(server, health.NewServer())
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	go server.Serve(listener) //nolint:errcheck
+
+	t.Cleanup(server.Stop)
+
+	unaryInterceptor, _ := client.CompressionInterceptors()
+
+	conn, err := grpc.NewClient(listener.Addr().String(),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithUnaryInterceptor(unaryInterceptor),
+	)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { conn.Close() }) //nolint:errcheck
+
+	_, err = healthpb.NewHealthClient(conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{""}, recorder.get())
+}
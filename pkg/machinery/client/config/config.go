@@ -56,6 +56,38 @@ type Context struct {
 	Key              string   `yaml:"key,omitempty"`
 	Auth             Auth     `yaml:"auth,omitempty"`
 	Cluster          string   `yaml:"cluster,omitempty"`
+
+	// EndpointFingerprints pins the SPKI SHA-256 fingerprints of the endpoint server certificates (trust on first use).
+	EndpointFingerprints map[string]string `yaml:"endpointFingerprints,omitempty"`
+	// EnforceEndpointFingerprints enforces the pinned fingerprints on every connection to the endpoints.
+	//
+	// Talos re-issues the API server certificate on each boot, so the enforced pins should be refreshed after a reboot.
+	EnforceEndpointFingerprints bool `yaml:"enforceEndpointFingerprints,omitempty"`
+
+	// Proxy configures the transport used to reach the endpoints, e.g. when the nodes are only reachable via a bastion.
+	Proxy *Proxy `yaml:"proxy,omitempty"`
+}
+
+// Proxy configures the transport to reach the endpoints.
+//
+// Only one of the fields should be set.
+type Proxy struct {
+	// SSH tunnels the connections to the endpoints through the SSH jump host.
+	SSH *SSHProxy `yaml:"ssh,omitempty"`
+	// Socket is the path to the local Unix socket which forwards the connections to the endpoints.
+	Socket string `yaml:"socket,omitempty"`
+}
+
+// SSHProxy is the SSH jump host used to reach the endpoints.
+type SSHProxy struct {
+	// Host is the address of the jump host, the port defaults to 22.
+	Host string `yaml:"host"`
+	// User defaults to the current user.
+	User string `yaml:"user,omitempty"`
+	// IdentityFile is the path to the private key, if not set the SSH agent and the default keys are used.
+	IdentityFile string `yaml:"identityFile,omitempty"`
+	// KnownHostsFile defaults to ~/.ssh/known_hosts.
+	KnownHostsFile string `yaml:"knownHostsFile,omitempty"`
 }
 
 // Auth may hold credentials for an authentication method such as Basic Auth.
@@ -5,6 +5,7 @@
 package client
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
@@ -41,12 +42,15 @@ func (c *Client) getConn(opts ...grpc.DialOption) (*grpcConnectionWrapper, error
 			constants.ApidPort),
 	)
 
+	maxRecvMsgSize := c.options.maxRecvMsgSize
+	if maxRecvMsgSize == 0 {
+		maxRecvMsgSize = constants.GRPCMaxMessageSize
+	}
+
 	dialOpts := slices.Concat(
 		[]grpc.DialOption{
-			grpc.WithDefaultCallOptions( // enable compression by default
-				// TODO: enable compression for Talos 1.7+
-				// grpc.UseCompressor(gzip.Name),
-				grpc.MaxCallRecvMsgSize(constants.GRPCMaxMessageSize),
+			grpc.WithDefaultCallOptions(
+				grpc.MaxCallRecvMsgSize(maxRecvMsgSize),
 			),
 			grpc.WithSharedWriteBuffer(true),
 		},
@@ -54,6 +58,33 @@ func (c *Client) getConn(opts ...grpc.DialOption) (*grpcConnectionWrapper, error
 		opts,
 	)
 
+	// the fan-out goes first, so that the compression negotiation, the timeouts and the retries apply to each node
+	if c.options.fanOut != nil {
+		dialOpts = append(dialOpts, grpc.WithChainUnaryInterceptor(unaryFanOutInterceptor(*c.options.fanOut)))
+	}
+
+	compressors := DefaultCompressors
+	if c.options.compressionSet {
+		compressors = c.options.compressors
+	}
+
+	// the compression is not useful for the local connection over the unix socket
+	if len(compressors) > 0 && c.options.unixSocketPath == "" {
+		negotiator := newCompressionNegotiator(compressors)
+
+		dialOpts = append(dialOpts,
+			grpc.WithChainUnaryInterceptor(negotiator.unaryInterceptor()),
+			grpc.WithChainStreamInterceptor(negotiator.streamInterceptor()),
+		)
+	}
+
+	if c.options.requestTimeout > 0 || c.options.retries > 0 {
+		dialOpts = append(dialOpts,
+			grpc.WithChainUnaryInterceptor(unaryRetryInterceptor(c.options.requestTimeout, c.options.retries)),
+			grpc.WithChainStreamInterceptor(streamRetryInterceptor(c.options.requestTimeout, c.options.retries)),
+		)
+	}
+
 	if c.options.unixSocketPath != "" {
 		conn, err := grpc.NewClient(target, dialOpts...)
 
@@ -63,7 +94,7 @@ func (c *Client) getConn(opts ...grpc.DialOption) (*grpcConnectionWrapper, error
 	tlsConfig := c.options.tlsConfig
 
 	if tlsConfig != nil {
-		return c.makeConnection(target, credentials.NewTLS(tlsConfig), dialOpts)
+		return c.dialEndpoints(c.options.proxy, target, endpoints, credentials.NewTLS(tlsConfig), dialOpts)
 	}
 
 	if err := c.resolveConfigContext(); err != nil {
@@ -105,36 +136,81 @@ func (c *Client) getConn(opts ...grpc.DialOption) (*grpcConnectionWrapper, error
 		return nil, err
 	}
 
-	return c.makeConnection(target, creds, dialOpts)
+	proxy := c.options.proxy
+	if proxy == nil {
+		proxy = c.options.configContext.Proxy
+	}
+
+	return c.dialEndpoints(proxy, target, endpoints, creds, dialOpts)
 }
 
-func buildTLSConfig(configContext *clientconfig.Context) (*tls.Config, error) {
-	tlsConfig := &tls.Config{}
+// dialEndpoints creates the connection to the endpoints, dialing them via the proxy if it is set,
+// or over the VM socket for the vsock endpoint.
+func (c *Client) dialEndpoints(
+	proxy *clientconfig.Proxy, target string, endpoints []string, creds credentials.TransportCredentials, dialOpts []grpc.DialOption,
+) (*grpcConnectionWrapper, error) {
+	vsock, err := parseVsockEndpoints(endpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	if vsock != nil {
+		if proxy != nil {
+			return nil, errors.New("proxy can't be used with the vsock endpoint")
+		}
+
+		return c.makeConnection("passthrough:///"+vsock.String(), creds, append(dialOpts,
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return vsock.dial(ctx)
+			}),
+		))
+	}
+
+	if proxy == nil {
+		return c.makeConnection(target, creds, dialOpts)
+	}
 
-	caBytes, err := getCA(configContext)
+	dialer, err := newProxyDialer(proxy)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get CA: %w", err)
+		return nil, fmt.Errorf("invalid proxy configuration: %w", err)
 	}
 
-	if len(caBytes) > 0 {
-		tlsConfig.RootCAs = x509.NewCertPool()
+	// the endpoints are resolved on the other side of the proxy
+	if len(endpoints) == 1 {
+		target = "passthrough:///" + resolver.EnsureEndpointsHavePorts(reduceURLsToAddresses(endpoints), constants.ApidPort)[0]
+	}
 
-		if ok := tlsConfig.RootCAs.AppendCertsFromPEM(caBytes); !ok {
-			return nil, errors.New("failed to append CA certificate to RootCAs pool")
-		}
+	conn, err := c.makeConnection(target, creds, append(dialOpts, grpc.WithContextDialer(dialer.DialContext)))
+	if err != nil {
+		dialer.Close() //nolint:errcheck
+
+		return nil, err
+	}
+
+	conn.proxy = dialer
+
+	return conn, nil
+}
+
+// verifyEndpoints sets up the verification of the server certificate specific to the endpoints.
+func verifyEndpoints(tlsConfig *tls.Config, configContext *clientconfig.Context, endpoints []string) error {
+	var err error
+
+	tlsConfig.VerifyConnection, err = verifyEndpointFingerprints(configContext, endpoints)
+	if err != nil {
+		return err
 	}
 
-	crt, err := CertificateFromConfigContext(configContext)
+	vsock, err := parseVsockEndpoints(endpoints)
 	if err != nil {
-		return nil, fmt.Errorf("failed to acquire credentials: %w", err)
+		return err
 	}
 
-	if crt != nil {
-		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
-		tlsConfig.Certificates = append(tlsConfig.Certificates, *crt)
+	if vsock != nil {
+		skipServerNameVerification(tlsConfig)
 	}
 
-	return tlsConfig, nil
+	return nil
 }
 
 func (c *Client) makeConnection(target string, creds credentials.TransportCredentials, dialOpts []grpc.DialOption) (*grpcConnectionWrapper, error) {
@@ -37,3 +37,20 @@ func WithNode(ctx context.Context, node string) context.Context {
 
 	return metadata.NewOutgoingContext(ctx, md)
 }
+
+// NodesFromContext returns the list of the nodes set on the context with WithNodes or WithNode.
+//
+// If no nodes are set, it returns a single empty node, which stands for the "current" node (the endpoint).
+func NodesFromContext(ctx context.Context) []string {
+	md, _ := metadata.FromOutgoingContext(ctx)
+
+	if nodes := md.Get("nodes"); len(nodes) > 0 {
+		return nodes
+	}
+
+	if nodes := md.Get("node"); len(nodes) > 0 {
+		return nodes
+	}
+
+	return []string{""}
+}
@@ -239,6 +239,15 @@ func UnmarshalEvent(event *machineapi.Event) (*Event, error) {
 		&machineapi.ConfigValidationErrorEvent{},
 		&machineapi.AddressEvent{},
 		&machineapi.MachineStatusEvent{},
+		&machineapi.SyscallAuditEvent{},
+		&machineapi.OOMKillEvent{},
+		&machineapi.BootFallbackEvent{},
+		&machineapi.WatchdogResetEvent{},
+		&machineapi.PowerActionEvent{},
+		&machineapi.CSRRejectedEvent{},
+		&machineapi.EtcdCertificateRotationEvent{},
+		&machineapi.KernelErrorEvent{},
+		&machineapi.ConfigApplyEvent{},
 	} {
 		if typeURL == "talos/runtime/"+string(eventType.ProtoReflect().Descriptor().FullName()) {
 			msg = eventType
@@ -7,6 +7,8 @@ package client
 import (
 	"crypto/tls"
 
+	"google.golang.org/grpc"
+
 	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
 )
 
@@ -17,3 +19,22 @@ func ReduceURLsToAddresses(endpoints []string) []string {
 func BuildTLSConfig(configContext *clientconfig.Context) (*tls.Config, error) {
 	return buildTLSConfig(configContext)
 }
+
+var (
+	UnaryRetryInterceptor  = unaryRetryInterceptor
+	StreamRetryInterceptor = streamRetryInterceptor
+)
+
+var UnaryFanOutInterceptor = unaryFanOutInterceptor
+
+var NewProxyDialer = newProxyDialer
+
+var VerifyEndpointFingerprints = verifyEndpointFingerprints
+
+var ParseVsockEndpoints = parseVsockEndpoints
+
+func CompressionInterceptors(compressors ...string) (grpc.UnaryClientInterceptor, grpc.StreamClientInterceptor) {
+	n := newCompressionNegotiator(compressors)
+
+	return n.unaryInterceptor(), n.streamInterceptor()
+}
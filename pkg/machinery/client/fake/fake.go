@@ -0,0 +1,327 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package fake provides the Talos API server which serves canned responses from the fixture files.
+//
+// The fake server allows to test the tools built on top of the Talos API (including talosctl itself)
+// hermetically, e.g. rendering the output for large synthetic clusters.
+//
+// The fixtures are protobuf messages in JSON format (see protojson), a file per API method:
+//
+//	<service>/<method>.json              # e.g. machine.MachineService/Processes.json
+//	nodes/<node>/<service>/<method>.json # responses of the specific node
+//
+// For unary methods the fixture is a single response message, for server-streaming methods
+// it is a JSON array of the messages to be sent.
+//
+// If the request targets specific nodes (see client.WithNodes) and there are node fixtures,
+// the responses of all nodes are merged (as apid does), and the metadata hostname of each response is set
+// to the node name, unless the fixture sets it explicitly.
+// Otherwise the response is the shared fixture as is.
+// Methods without fixtures return codes.Unimplemented.
+package fake
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+)
+
+const bufferSize = 1024 * 1024
+
+// nodesDir is the directory with the per-node fixtures.
+const nodesDir = "nodes"
+
+// Server serves canned Talos API responses from the fixtures.
+type Server struct {
+	fixtures fs.FS
+	server   *grpc.Server
+	listener *bufconn.Listener
+}
+
+// NewServer creates a new fake server with the given fixtures and starts serving in-process.
+//
+// Server should be stopped with Stop.
+func NewServer(fixtures fs.FS) *Server {
+	s := &Server{
+		fixtures: fixtures,
+		listener: bufconn.Listen(bufferSize),
+	}
+
+	s.server = grpc.NewServer(grpc.UnknownServiceHandler(s.handle))
+
+	go s.server.Serve(s.listener) //nolint:errcheck
+
+	return s
+}
+
+// Stop the server.
+func (s *Server) Stop() {
+	s.server.Stop()
+}
+
+// Nodes returns the list of nodes which have fixtures.
+func (s *Server) Nodes() ([]string, error) {
+	entries, err := fs.ReadDir(s.fixtures, nodesDir)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	var nodes []string
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			nodes = append(nodes, entry.Name())
+		}
+	}
+
+	return nodes, nil
+}
+
+// DialContext connects to the server in-process.
+func (s *Server) DialContext(ctx context.Context, _ string) (net.Conn, error) {
+	return s.listener.DialContext(ctx)
+}
+
+// Client creates a client connected to the server.
+//
+// The nodes of the client config context are set to the nodes which have fixtures.
+func (s *Server) Client(ctx context.Context, opts ...client.OptionFunc) (*client.Client, error) {
+	nodes, err := s.Nodes()
+	if err != nil {
+		return nil, err
+	}
+
+	return client.New(ctx, append([]client.OptionFunc{
+		client.WithUnixSocket("fake"),
+		client.WithConfigContext(&clientconfig.Context{
+			Nodes: nodes,
+		}),
+		client.WithGRPCDialOptions(
+			grpc.WithContextDialer(s.DialContext),
+			grpc.WithTransportCredentials(insecure.NewCredentials()),
+		),
+	}, opts...)...)
+}
+
+func (s *Server) handle(_ any, stream grpc.ServerStream) error {
+	fullMethodName, ok := grpc.MethodFromServerStream(stream)
+	if !ok {
+		return status.Error(codes.Internal, "failed to get method name")
+	}
+
+	method, err := lookupMethod(fullMethodName)
+	if err != nil {
+		return err
+	}
+
+	if method.IsStreamingClient() {
+		return status.Errorf(codes.Unimplemented, "client-streaming method %s is not supported", fullMethodName)
+	}
+
+	req, err := newMessage(method.Input())
+	if err != nil {
+		return err
+	}
+
+	if err = stream.RecvMsg(req); err != nil {
+		return err
+	}
+
+	responses, err := s.responses(stream.Context(), method, fullMethodName)
+	if err != nil {
+		return err
+	}
+
+	for _, resp := range responses {
+		if err = stream.SendMsg(resp); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// responses loads the responses to send for the method.
+func (s *Server) responses(ctx context.Context, method protoreflect.MethodDescriptor, fullMethodName string) ([]proto.Message, error) {
+	fixtureName := strings.TrimPrefix(fullMethodName, "/") + ".json"
+
+	var responses []proto.Message
+
+	for _, node := range requestNodes(ctx) {
+		nodeResponses, err := s.load(method, path.Join(nodesDir, node, fixtureName))
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+
+			return nil, err
+		}
+
+		for _, resp := range nodeResponses {
+			setHostname(resp.ProtoReflect(), node)
+		}
+
+		if method.IsStreamingServer() || len(responses) == 0 {
+			responses = append(responses, nodeResponses...)
+
+			continue
+		}
+
+		// unary responses of the nodes are merged into a single response, like apid does
+		for _, resp := range nodeResponses {
+			proto.Merge(responses[0], resp)
+		}
+	}
+
+	if responses != nil {
+		return responses, nil
+	}
+
+	responses, err := s.load(method, fixtureName)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, status.Errorf(codes.Unimplemented, "no fixture for %s", fullMethodName)
+		}
+
+		return nil, err
+	}
+
+	return responses, nil
+}
+
+// load the fixture file.
+func (s *Server) load(method protoreflect.MethodDescriptor, fixturePath string) ([]proto.Message, error) {
+	data, err := fs.ReadFile(s.fixtures, fixturePath)
+	if err != nil {
+		return nil, err
+	}
+
+	rawMessages := []json.RawMessage{data}
+
+	if method.IsStreamingServer() {
+		if err = json.Unmarshal(data, &rawMessages); err != nil {
+			return nil, fmt.Errorf("error parsing fixture %q, expected JSON array: %w", fixturePath, err)
+		}
+	}
+
+	messages := make([]proto.Message, 0, len(rawMessages))
+
+	for _, raw := range rawMessages {
+		msg, err := newMessage(method.Output())
+		if err != nil {
+			return nil, err
+		}
+
+		if err = protojson.Unmarshal(raw, msg); err != nil {
+			return nil, fmt.Errorf("error parsing fixture %q: %w", fixturePath, err)
+		}
+
+		messages = append(messages, msg)
+	}
+
+	return messages, nil
+}
+
+func lookupMethod(fullMethodName string) (protoreflect.MethodDescriptor, error) {
+	serviceName, methodName, ok := strings.Cut(strings.TrimPrefix(fullMethodName, "/"), "/")
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "invalid method name %q", fullMethodName)
+	}
+
+	desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(serviceName))
+	if err != nil {
+		return nil, status.Errorf(codes.Unimplemented, "unknown service %s", serviceName)
+	}
+
+	service, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, status.Errorf(codes.Unimplemented, "unknown service %s", serviceName)
+	}
+
+	method := service.Methods().ByName(protoreflect.Name(methodName))
+	if method == nil {
+		return nil, status.Errorf(codes.Unimplemented, "unknown method %s", fullMethodName)
+	}
+
+	return method, nil
+}
+
+func newMessage(desc protoreflect.MessageDescriptor) (proto.Message, error) {
+	typ, err := protoregistry.GlobalTypes.FindMessageByName(desc.FullName())
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unknown message type %s", desc.FullName())
+	}
+
+	return typ.New().Interface(), nil
+}
+
+// requestNodes returns the nodes set by client.WithNode or client.WithNodes.
+func requestNodes(ctx context.Context) []string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	if nodes := md.Get("nodes"); len(nodes) > 0 {
+		return nodes
+	}
+
+	return md.Get("node")
+}
+
+// setHostname sets the metadata hostname of the response (or of each response message for unary methods)
+// if it's not set.
+func setHostname(msg protoreflect.Message, node string) {
+	fields := msg.Descriptor().Fields()
+
+	if messages := fields.ByName("messages"); messages != nil && messages.IsList() && messages.Message() != nil {
+		list := msg.Mutable(messages).List()
+
+		for i := range list.Len() {
+			setHostname(list.Get(i).Message(), node)
+		}
+
+		return
+	}
+
+	md := fields.ByName("metadata")
+	if md == nil || md.Message() == nil {
+		return
+	}
+
+	hostname := md.Message().Fields().ByName("hostname")
+	if hostname == nil || hostname.Kind() != protoreflect.StringKind {
+		return
+	}
+
+	mdMsg := msg.Mutable(md).Message()
+
+	if mdMsg.Get(hostname).String() == "" {
+		mdMsg.Set(hostname, protoreflect.ValueOfString(node))
+	}
+}
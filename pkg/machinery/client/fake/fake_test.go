@@ -0,0 +1,84 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package fake_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"testing/fstest"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	"github.com/siderolabs/talos/pkg/machinery/client/fake"
+)
+
+func TestServer(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	srv := fake.NewServer(fstest.MapFS{
+		"nodes/node-1/machine.MachineService/Version.json": {Data: []byte(`{"messages": [{"version": {"tag": "v1.9.0"}}]}`)},
+		"nodes/node-2/machine.MachineService/Version.json": {Data: []byte(`{"messages": [{"metadata": {"hostname": "custom"}, "version": {"tag": "v1.8.3"}}]}`)},
+		"machine.MachineService/Memory.json":               {Data: []byte(`{"messages": [{"meminfo": {"memtotal": 1024}}]}`)},
+		"machine.MachineService/Dmesg.json":                {Data: []byte(`[{"bytes": "Zmlyc3Q="}, {"bytes": "c2Vjb25k"}]`)},
+	})
+	t.Cleanup(srv.Stop)
+
+	c, err := srv.Client(ctx)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { c.Close() }) //nolint:errcheck
+
+	assert.Equal(t, []string{"node-1", "node-2"}, c.GetConfigContext().Nodes)
+
+	// node fixtures are merged
+	version, err := c.Version(client.WithNodes(ctx, "node-1", "node-2"))
+	require.NoError(t, err)
+
+	require.Len(t, version.Messages, 2)
+	assert.Equal(t, "node-1", version.Messages[0].Metadata.Hostname)
+	assert.Equal(t, "v1.9.0", version.Messages[0].Version.Tag)
+	assert.Equal(t, "custom", version.Messages[1].Metadata.Hostname)
+	assert.Equal(t, "v1.8.3", version.Messages[1].Version.Tag)
+
+	// shared fixture
+	memory, err := c.Memory(client.WithNode(ctx, "node-1"))
+	require.NoError(t, err)
+
+	require.Len(t, memory.Messages, 1)
+	assert.EqualValues(t, 1024, memory.Messages[0].Meminfo.Memtotal)
+
+	// streaming
+	stream, err := c.Dmesg(ctx, false, false)
+	require.NoError(t, err)
+
+	var chunks []string
+
+	for {
+		msg, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		require.NoError(t, err)
+
+		chunks = append(chunks, string(msg.Bytes))
+	}
+
+	assert.Equal(t, []string{"first", "second"}, chunks)
+
+	// no fixture
+	_, err = c.Processes(ctx)
+	require.Error(t, err)
+	assert.Equal(t, codes.Unimplemented, client.StatusCode(err))
+}
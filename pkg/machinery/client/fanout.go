@@ -0,0 +1,181 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+)
+
+// FanOutOptions configures FanOut.
+type FanOutOptions struct {
+	// Concurrency limits the number of the nodes called in parallel, zero means no limit.
+	Concurrency int
+	// Timeout of the call to each node, zero means no timeout.
+	Timeout time.Duration
+}
+
+// NodeResult is the result of the call to a single node.
+type NodeResult[T any] struct {
+	// Node is empty for the "current" node (when the context has no nodes).
+	Node  string
+	Value T
+	Err   error
+}
+
+// FanOut calls f for each node in parallel, and returns the results in the order of the nodes.
+//
+// Unlike WithNodes, which relies on the `apid` service to aggregate the responses, FanOut sends a separate request
+// to each node (see WithNode), so it can be used with the APIs which don't support one-to-many proxying (e.g. the resource API).
+// The context passed to f is cancelled once the call to the node finishes.
+func FanOut[T any](ctx context.Context, nodes []string, opts FanOutOptions, f func(ctx context.Context, node string) (T, error)) []NodeResult[T] {
+	results := make([]NodeResult[T], len(nodes))
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 || concurrency > len(nodes) {
+		concurrency = len(nodes)
+	}
+
+	sem := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+
+	for i, node := range nodes {
+		results[i].Node = node
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			results[i].Err = ctx.Err()
+
+			continue
+		}
+
+		wg.Add(1)
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			nodeCtx := ctx
+
+			if node != "" {
+				nodeCtx = WithNode(ctx, node)
+			}
+
+			var cancel context.CancelFunc
+
+			if opts.Timeout > 0 {
+				nodeCtx, cancel = context.WithTimeout(nodeCtx, opts.Timeout)
+			} else {
+				nodeCtx, cancel = context.WithCancel(nodeCtx)
+			}
+
+			defer cancel()
+
+			results[i].Value, results[i].Err = f(nodeCtx, node)
+		}()
+	}
+
+	wg.Wait()
+
+	return results
+}
+
+// WithFanOut makes the client send the unary requests targeting multiple nodes (see WithNodes)
+// to each node separately, in parallel with the bounded concurrency and the timeout of the call to each node.
+//
+// The responses of the nodes are merged in the order of the nodes, and the errors of the nodes are reported
+// in the response metadata, as `apid` does, so the responses are handled the same way.
+// If all nodes fail, the error of the first node is returned.
+// Without this option `apid` queries all nodes at once with no concurrency limit.
+// Streaming requests are still sent to all nodes at once, `apid` forwards the messages of the nodes as they arrive.
+func WithFanOut(opts FanOutOptions) OptionFunc {
+	return func(o *Options) error {
+		o.fanOut = &opts
+
+		return nil
+	}
+}
+
+func unaryFanOutInterceptor(opts FanOutOptions) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, callOpts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		nodes := md.Get("nodes")
+
+		replyMsg, ok := reply.(proto.Message)
+		if !ok || len(nodes) < 2 {
+			return invoker(ctx, method, req, reply, cc, callOpts...)
+		}
+
+		results := FanOut(ctx, nodes, opts, func(nodeCtx context.Context, node string) (proto.Message, error) {
+			nodeReply := replyMsg.ProtoReflect().New().Interface()
+
+			// keep the one-to-many mode for a single node, so that apid annotates the response with the node
+			err := invoker(WithNodes(nodeCtx, node), method, req, nodeReply, cc, callOpts...)
+
+			return nodeReply, err
+		})
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		// if all nodes failed, the endpoint is most probably not reachable, so fail the request as without the fan-out
+		if !slices.ContainsFunc(results, func(result NodeResult[proto.Message]) bool { return result.Err == nil }) {
+			return results[0].Err
+		}
+
+		proto.Reset(replyMsg)
+
+		for _, result := range results {
+			if result.Err == nil {
+				proto.Merge(replyMsg, result.Value)
+
+				continue
+			}
+
+			if err := appendNodeError(replyMsg, result.Node, result.Err); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+// appendNodeError appends the message with the node error to the multi-node response.
+//
+// All multi-node responses have the repeated messages as the field 1, and the metadata as the field 1 of each message,
+// so the error is marshaled as common.EmptyResponse and merged into the response.
+func appendNodeError(reply proto.Message, node string, nodeErr error) error {
+	data, err := proto.Marshal(&common.EmptyResponse{
+		Messages: []*common.Empty{
+			{
+				Metadata: &common.Metadata{
+					Hostname: node,
+					Error:    nodeErr.Error(),
+					Status:   status.Convert(nodeErr).Proto(),
+				},
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	return proto.UnmarshalOptions{Merge: true}.Unmarshal(data, reply)
+}
@@ -0,0 +1,163 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+func TestFanOut(t *testing.T) {
+	t.Parallel()
+
+	nodes := make([]string, 20)
+
+	for i := range nodes {
+		nodes[i] = fmt.Sprintf("10.0.0.%d", i+1)
+	}
+
+	var running, maxRunning atomic.Int32
+
+	results := client.FanOut(context.Background(), nodes, client.FanOutOptions{Concurrency: 4},
+		func(ctx context.Context, node string) (string, error) {
+			n := running.Add(1)
+			defer running.Add(-1)
+
+			for {
+				m := maxRunning.Load()
+				if n <= m || maxRunning.CompareAndSwap(m, n) {
+					break
+				}
+			}
+
+			md, _ := metadata.FromOutgoingContext(ctx)
+
+			// finish in the reverse order to check the ordering of the results
+			time.Sleep(time.Duration(len(nodes)-slices.Index(nodes, node)) * time.Millisecond)
+
+			if node == "10.0.0.5" {
+				return "", errors.New("failed")
+			}
+
+			return md.Get("node")[0], nil
+		},
+	)
+
+	require.Len(t, results, len(nodes))
+	assert.LessOrEqual(t, maxRunning.Load(), int32(4))
+
+	for i, result := range results {
+		assert.Equal(t, nodes[i], result.Node)
+
+		if result.Node == "10.0.0.5" {
+			assert.EqualError(t, result.Err, "failed")
+
+			continue
+		}
+
+		require.NoError(t, result.Err)
+		assert.Equal(t, nodes[i], result.Value)
+	}
+}
+
+func TestFanOutTimeout(t *testing.T) {
+	t.Parallel()
+
+	results := client.FanOut(context.Background(), []string{"fast", "slow"}, client.FanOutOptions{Timeout: 50 * time.Millisecond},
+		func(ctx context.Context, node string) (struct{}, error) {
+			if node == "slow" {
+				<-ctx.Done()
+
+				return struct{}{}, ctx.Err()
+			}
+
+			return struct{}{}, nil
+		},
+	)
+
+	require.Len(t, results, 2)
+	assert.NoError(t, results[0].Err)
+	assert.ErrorIs(t, results[1].Err, context.DeadlineExceeded)
+}
+
+func TestUnaryFanOutInterceptor(t *testing.T) {
+	t.Parallel()
+
+	var calls atomic.Int32
+
+	invoker := func(ctx context.Context, _ string, _, reply any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		calls.Add(1)
+
+		md, _ := metadata.FromOutgoingContext(ctx)
+		nodes := md.Get("nodes")
+
+		if slices.Contains(nodes, "down") {
+			return status.Error(codes.Unavailable, "down")
+		}
+
+		for _, node := range nodes {
+			reply.(*machine.VersionResponse).Messages = append(reply.(*machine.VersionResponse).Messages, &machine.Version{
+				Metadata: &common.Metadata{Hostname: node},
+				Version:  &machine.VersionInfo{Tag: "v1.9.0"},
+			})
+		}
+
+		return nil
+	}
+
+	interceptor := client.UnaryFanOutInterceptor(client.FanOutOptions{Concurrency: 2})
+
+	var reply machine.VersionResponse
+
+	require.NoError(t, interceptor(client.WithNodes(context.Background(), "a", "down", "b"), "/machine.MachineService/Version", &emptypb.Empty{}, &reply, nil, invoker))
+	assert.EqualValues(t, 3, calls.Load())
+
+	require.Len(t, reply.Messages, 3)
+	assert.Equal(t, "a", reply.Messages[0].GetMetadata().GetHostname())
+	assert.Equal(t, "v1.9.0", reply.Messages[0].GetVersion().GetTag())
+	assert.Equal(t, "down", reply.Messages[1].GetMetadata().GetHostname())
+	assert.Equal(t, "rpc error: code = Unavailable desc = down", reply.Messages[1].GetMetadata().GetError())
+	assert.EqualValues(t, codes.Unavailable, reply.Messages[1].GetMetadata().GetStatus().GetCode())
+	assert.Equal(t, "b", reply.Messages[2].GetMetadata().GetHostname())
+
+	// single node requests are not fanned out
+	calls.Store(0)
+	reply.Reset()
+
+	require.NoError(t, interceptor(client.WithNodes(context.Background(), "a"), "/machine.MachineService/Version", &emptypb.Empty{}, &reply, nil, invoker))
+	assert.EqualValues(t, 1, calls.Load())
+	assert.Len(t, reply.Messages, 1)
+
+	// the error is returned if all nodes fail
+	err := interceptor(client.WithNodes(context.Background(), "down", "down"), "/machine.MachineService/Version", &emptypb.Empty{}, &reply, nil, invoker)
+	assert.Equal(t, codes.Unavailable, client.StatusCode(err))
+}
+
+func TestNodesFromContext(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	assert.Equal(t, []string{""}, client.NodesFromContext(ctx))
+	assert.Equal(t, []string{"a", "b"}, client.NodesFromContext(client.WithNodes(ctx, "a", "b")))
+	assert.Equal(t, []string{"c"}, client.NodesFromContext(client.WithNode(client.WithNodes(ctx, "a", "b"), "c")))
+}
@@ -0,0 +1,57 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"crypto/tls"
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/crypto/x509"
+
+	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+)
+
+// verifyEndpointFingerprints returns the tls.Config.VerifyConnection handler which enforces the fingerprints
+// pinned in the configuration context for the endpoints.
+//
+// The server certificate should match one of the fingerprints pinned for the endpoints, as the connection
+// might be established to any of them.
+// It returns nil if the enforcement is not enabled in the configuration context, or none of the endpoints is pinned.
+func verifyEndpointFingerprints(configContext *clientconfig.Context, endpoints []string) (func(tls.ConnectionState) error, error) {
+	if !configContext.EnforceEndpointFingerprints {
+		return nil, nil
+	}
+
+	var fingerprints []x509.Fingerprint
+
+	for _, endpoint := range endpoints {
+		pinned, ok := configContext.EndpointFingerprints[endpoint]
+		if !ok {
+			continue
+		}
+
+		fingerprint, err := x509.ParseFingerprint(pinned)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing the fingerprint pinned for endpoint %q: %w", endpoint, err)
+		}
+
+		fingerprints = append(fingerprints, fingerprint)
+	}
+
+	if len(fingerprints) == 0 {
+		return nil, nil
+	}
+
+	match := x509.MatchSPKIFingerprints(fingerprints...)
+
+	return func(state tls.ConnectionState) error {
+		if err := match(state); err != nil {
+			return errors.Join(err, errors.New("the server certificate doesn't match the pinned fingerprints, verify and re-pin it with `talosctl config info --verify`"))
+		}
+
+		return nil
+	}, nil
+}
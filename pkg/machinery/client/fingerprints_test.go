@@ -0,0 +1,79 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client_test
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/tls"
+	stdx509 "crypto/x509"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/crypto/x509"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+)
+
+func generateCertificate(t *testing.T) *stdx509.Certificate {
+	t.Helper()
+
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	template := &stdx509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+
+	der, err := stdx509.CreateCertificate(rand.Reader, template, template, pub, priv)
+	require.NoError(t, err)
+
+	cert, err := stdx509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	return cert
+}
+
+func TestVerifyEndpointFingerprints(t *testing.T) {
+	t.Parallel()
+
+	pinnedCert := generateCertificate(t)
+	otherCert := generateCertificate(t)
+
+	configContext := &clientconfig.Context{
+		EndpointFingerprints: map[string]string{
+			"10.5.0.2": x509.SPKIFingerprint(pinnedCert).String(),
+		},
+	}
+
+	// the pins are not enforced by default
+	verify, err := client.VerifyEndpointFingerprints(configContext, []string{"10.5.0.2"})
+	require.NoError(t, err)
+	assert.Nil(t, verify)
+
+	configContext.EnforceEndpointFingerprints = true
+
+	verify, err = client.VerifyEndpointFingerprints(configContext, []string{"10.5.0.3"})
+	require.NoError(t, err)
+	assert.Nil(t, verify)
+
+	verify, err = client.VerifyEndpointFingerprints(configContext, []string{"10.5.0.2", "10.5.0.3"})
+	require.NoError(t, err)
+	require.NotNil(t, verify)
+
+	assert.NoError(t, verify(tls.ConnectionState{PeerCertificates: []*stdx509.Certificate{pinnedCert}}))
+	assert.ErrorContains(t, verify(tls.ConnectionState{PeerCertificates: []*stdx509.Certificate{otherCert}}), "talosctl config info --verify")
+
+	configContext.EndpointFingerprints["10.5.0.2"] = "invalid"
+
+	_, err = client.VerifyEndpointFingerprints(configContext, []string{"10.5.0.2"})
+	assert.Error(t, err)
+}
@@ -6,6 +6,8 @@ package client
 
 import (
 	"context"
+	"errors"
+	"io"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -15,6 +17,9 @@ type grpcConnectionWrapper struct {
 	*grpc.ClientConn
 
 	clusterName string
+
+	// proxy is closed with the connection if set
+	proxy io.Closer
 }
 
 func newGRPCConnectionWrapper(clusterName string, conn *grpc.ClientConn) *grpcConnectionWrapper {
@@ -44,3 +49,14 @@ func (c *grpcConnectionWrapper) appendMetadata(ctx context.Context) context.Cont
 
 	return ctx
 }
+
+// Close the connection and the proxy.
+func (c *grpcConnectionWrapper) Close() error {
+	err := c.ClientConn.Close()
+
+	if c.proxy != nil {
+		err = errors.Join(err, c.proxy.Close())
+	}
+
+	return err
+}
@@ -7,6 +7,7 @@ package client
 import (
 	"crypto/tls"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc"
 
@@ -19,6 +20,7 @@ type Options struct {
 	config            *clientconfig.Config
 	configContext     *clientconfig.Context
 	tlsConfig         *tls.Config
+	proxy             *clientconfig.Proxy
 	grpcDialOptions   []grpc.DialOption
 
 	contextOverride    string
@@ -26,6 +28,15 @@ type Options struct {
 
 	unixSocketPath      string
 	clusterNameOverride string
+
+	requestTimeout time.Duration
+	retries        int
+
+	compressors    []string
+	compressionSet bool
+	maxRecvMsgSize int
+
+	fanOut *FanOutOptions
 }
 
 // OptionFunc sets an option for the creation of the Client.
@@ -79,6 +90,17 @@ func WithTLSConfig(tlsConfig *tls.Config) OptionFunc {
 	}
 }
 
+// WithProxy configures the transport used to reach the endpoints.
+//
+// It overrides the proxy of the configuration context, and it also applies to the connections with the custom TLS config.
+func WithProxy(proxy *clientconfig.Proxy) OptionFunc {
+	return func(o *Options) error {
+		o.proxy = proxy
+
+		return nil
+	}
+}
+
 // WithEndpoints overrides the default endpoints with the provided list.
 func WithEndpoints(endpoints ...string) OptionFunc {
 	return func(o *Options) error {
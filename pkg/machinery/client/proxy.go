@@ -0,0 +1,251 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/agent"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+)
+
+// defaultSSHIdentityFiles are tried (in order) if the identity file is not set and the SSH agent is not available.
+var defaultSSHIdentityFiles = []string{"id_ed25519", "id_ecdsa", "id_rsa"}
+
+// proxyDialer dials the endpoints through the proxy configured in the talosconfig context.
+type proxyDialer struct {
+	proxy *clientconfig.Proxy
+
+	mu        sync.Mutex
+	sshClient *ssh.Client
+}
+
+func newProxyDialer(proxy *clientconfig.Proxy) (*proxyDialer, error) {
+	switch {
+	case proxy.SSH != nil && proxy.Socket != "":
+		return nil, errors.New("only one of proxy.ssh and proxy.socket can be set")
+	case proxy.SSH != nil && proxy.SSH.Host == "":
+		return nil, errors.New("proxy.ssh.host is not set")
+	case proxy.SSH == nil && proxy.Socket == "":
+		return nil, errors.New("either proxy.ssh or proxy.socket should be set")
+	}
+
+	return &proxyDialer{proxy: proxy}, nil
+}
+
+// DialContext implements the gRPC context dialer.
+func (d *proxyDialer) DialContext(ctx context.Context, address string) (net.Conn, error) {
+	if d.proxy.Socket != "" {
+		var dialer net.Dialer
+
+		return dialer.DialContext(ctx, "unix", expandHome(d.proxy.Socket))
+	}
+
+	sshClient, err := d.getSSHClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := sshClient.DialContext(ctx, "tcp", address)
+	if err != nil {
+		// the SSH connection might be broken, so reconnect on the next dial
+		d.resetSSHClient(sshClient)
+
+		return nil, fmt.Errorf("error dialing %q via SSH jump host %q: %w", address, d.proxy.SSH.Host, err)
+	}
+
+	return conn, nil
+}
+
+// Close the SSH connection to the jump host.
+func (d *proxyDialer) Close() error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.sshClient == nil {
+		return nil
+	}
+
+	err := d.sshClient.Close()
+	d.sshClient = nil
+
+	return err
+}
+
+func (d *proxyDialer) resetSSHClient(sshClient *ssh.Client) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.sshClient == sshClient {
+		d.sshClient.Close() //nolint:errcheck
+		d.sshClient = nil
+	}
+}
+
+func (d *proxyDialer) getSSHClient(ctx context.Context) (*ssh.Client, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.sshClient != nil {
+		return d.sshClient, nil
+	}
+
+	config, closeAgent, err := sshClientConfig(d.proxy.SSH)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeAgent()
+
+	host := d.proxy.SSH.Host
+
+	if _, _, err = net.SplitHostPort(host); err != nil {
+		host = net.JoinHostPort(host, "22")
+	}
+
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", host)
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to SSH jump host %q: %w", host, err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline) //nolint:errcheck
+	}
+
+	sshConn, chans, reqs, err := ssh.NewClientConn(conn, host, config)
+	if err != nil {
+		conn.Close() //nolint:errcheck
+
+		return nil, fmt.Errorf("error connecting to SSH jump host %q: %w", host, err)
+	}
+
+	conn.SetDeadline(time.Time{}) //nolint:errcheck
+
+	d.sshClient = ssh.NewClient(sshConn, chans, reqs)
+
+	return d.sshClient, nil
+}
+
+// sshClientConfig builds the SSH client config, the returned function closes the connection to the SSH agent
+// once the SSH handshake is done.
+func sshClientConfig(proxy *clientconfig.SSHProxy) (*ssh.ClientConfig, func(), error) {
+	username := proxy.User
+
+	if username == "" {
+		u, err := user.Current()
+		if err != nil {
+			return nil, nil, fmt.Errorf("error getting the current user: %w", err)
+		}
+
+		username = u.Username
+	}
+
+	knownHostsFile := proxy.KnownHostsFile
+	if knownHostsFile == "" {
+		knownHostsFile = filepath.Join("~", ".ssh", "known_hosts")
+	}
+
+	hostKeyCallback, err := knownhosts.New(expandHome(knownHostsFile))
+	if err != nil {
+		return nil, nil, fmt.Errorf("error loading SSH known hosts: %w", err)
+	}
+
+	auth, closeAgent, err := sshAuthMethods(proxy.IdentityFile)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &ssh.ClientConfig{
+		User:            username,
+		Auth:            auth,
+		HostKeyCallback: hostKeyCallback,
+	}, closeAgent, nil
+}
+
+// sshAuthMethods returns the identity file key if it's set, otherwise the SSH agent and the default keys.
+func sshAuthMethods(identityFile string) ([]ssh.AuthMethod, func(), error) {
+	closeAgent := func() {}
+
+	if identityFile != "" {
+		signer, err := loadSSHKey(expandHome(identityFile))
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return []ssh.AuthMethod{ssh.PublicKeys(signer)}, closeAgent, nil
+	}
+
+	var auth []ssh.AuthMethod
+
+	if socket := os.Getenv("SSH_AUTH_SOCK"); socket != "" {
+		if conn, err := net.Dial("unix", socket); err == nil {
+			auth = append(auth, ssh.PublicKeysCallback(agent.NewClient(conn).Signers))
+			closeAgent = func() { conn.Close() } //nolint:errcheck
+		}
+	}
+
+	var signers []ssh.Signer
+
+	for _, name := range defaultSSHIdentityFiles {
+		signer, err := loadSSHKey(expandHome(filepath.Join("~", ".ssh", name)))
+		if err != nil {
+			continue
+		}
+
+		signers = append(signers, signer)
+	}
+
+	if len(signers) > 0 {
+		auth = append(auth, ssh.PublicKeys(signers...))
+	}
+
+	if len(auth) == 0 {
+		return nil, nil, errors.New("no SSH identity is available: set proxy.ssh.identityFile or start the SSH agent")
+	}
+
+	return auth, closeAgent, nil
+}
+
+func loadSSHKey(path string) (ssh.Signer, error) {
+	key, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading SSH key: %w", err)
+	}
+
+	signer, err := ssh.ParsePrivateKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing SSH key %q: %w", path, err)
+	}
+
+	return signer, nil
+}
+
+// expandHome replaces the leading ~ in the path with the home directory.
+func expandHome(path string) string {
+	if path != "~" && !strings.HasPrefix(path, "~/") && !strings.HasPrefix(path, "~"+string(filepath.Separator)) {
+		return path
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+
+	return filepath.Join(home, path[1:])
+}
@@ -0,0 +1,248 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/pem"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ssh"
+	"golang.org/x/crypto/ssh/knownhosts"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+	clientconfig "github.com/siderolabs/talos/pkg/machinery/client/config"
+)
+
+func TestProxyDialerValidation(t *testing.T) {
+	t.Parallel()
+
+	for _, proxy := range []*clientconfig.Proxy{
+		{},
+		{Socket: "/tmp/talos.sock", SSH: &clientconfig.SSHProxy{Host: "bastion"}},
+		{SSH: &clientconfig.SSHProxy{}},
+	} {
+		_, err := client.NewProxyDialer(proxy)
+		assert.Error(t, err)
+	}
+}
+
+func TestProxyDialerSocket(t *testing.T) {
+	t.Parallel()
+
+	socketPath := filepath.Join(t.TempDir(), "talos.sock")
+
+	listener, err := net.Listen("unix", socketPath)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { listener.Close() }) //nolint:errcheck
+
+	go echoServer(listener)
+
+	dialer, err := client.NewProxyDialer(&clientconfig.Proxy{Socket: socketPath})
+	require.NoError(t, err)
+
+	assertEcho(t, dialer.DialContext, "10.5.0.2:50000")
+}
+
+func TestProxyDialerSSH(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+
+	// target behind the jump host
+	target, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { target.Close() }) //nolint:errcheck
+
+	go echoServer(target)
+
+	// client identity
+	_, clientKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	clientKeyPEM, err := ssh.MarshalPrivateKey(clientKey, "")
+	require.NoError(t, err)
+
+	identityFile := filepath.Join(dir, "id_ed25519")
+	require.NoError(t, os.WriteFile(identityFile, pem.EncodeToMemory(clientKeyPEM), 0o600))
+
+	clientSigner, err := ssh.NewSignerFromKey(clientKey)
+	require.NoError(t, err)
+
+	// jump host
+	_, hostKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	hostSigner, err := ssh.NewSignerFromKey(hostKey)
+	require.NoError(t, err)
+
+	jumpHost, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	t.Cleanup(func() { jumpHost.Close() }) //nolint:errcheck
+
+	serverConfig := &ssh.ServerConfig{
+		PublicKeyCallback: func(_ ssh.ConnMetadata, key ssh.PublicKey) (*ssh.Permissions, error) {
+			if !bytes.Equal(key.Marshal(), clientSigner.PublicKey().Marshal()) {
+				return nil, io.EOF
+			}
+
+			return nil, nil
+		},
+	}
+	serverConfig.AddHostKey(hostSigner)
+
+	go sshJumpHost(jumpHost, serverConfig)
+
+	knownHostsFile := filepath.Join(dir, "known_hosts")
+	require.NoError(t, os.WriteFile(knownHostsFile,
+		[]byte(knownhosts.Line([]string{knownhosts.Normalize(jumpHost.Addr().String())}, hostSigner.PublicKey())+"\n"), 0o600))
+
+	dialer, err := client.NewProxyDialer(&clientconfig.Proxy{
+		SSH: &clientconfig.SSHProxy{
+			Host:           jumpHost.Addr().String(),
+			User:           "talos",
+			IdentityFile:   identityFile,
+			KnownHostsFile: knownHostsFile,
+		},
+	})
+	require.NoError(t, err)
+
+	t.Cleanup(func() { dialer.Close() }) //nolint:errcheck
+
+	assertEcho(t, dialer.DialContext, target.Addr().String())
+	assertEcho(t, dialer.DialContext, target.Addr().String())
+
+	// unknown host key
+	require.NoError(t, os.WriteFile(knownHostsFile, nil, 0o600))
+
+	dialer, err = client.NewProxyDialer(&clientconfig.Proxy{
+		SSH: &clientconfig.SSHProxy{
+			Host:           jumpHost.Addr().String(),
+			User:           "talos",
+			IdentityFile:   identityFile,
+			KnownHostsFile: knownHostsFile,
+		},
+	})
+	require.NoError(t, err)
+
+	_, err = dialer.DialContext(context.Background(), target.Addr().String())
+	assert.ErrorContains(t, err, "key is unknown")
+}
+
+func assertEcho(t *testing.T, dial func(context.Context, string) (net.Conn, error), address string) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	conn, err := dial(ctx, address)
+	require.NoError(t, err)
+
+	defer conn.Close() //nolint:errcheck
+
+	_, err = conn.Write([]byte("hello"))
+	require.NoError(t, err)
+
+	buf := make([]byte, 5)
+
+	_, err = io.ReadFull(conn, buf)
+	require.NoError(t, err)
+
+	assert.Equal(t, "hello", string(buf))
+}
+
+func echoServer(listener net.Listener) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go func() {
+			defer conn.Close() //nolint:errcheck
+
+			io.Copy(conn, conn) //nolint:errcheck
+		}()
+	}
+}
+
+// sshJumpHost is a minimal SSH server which only supports the port forwarding.
+func sshJumpHost(listener net.Listener, config *ssh.ServerConfig) {
+	for {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+
+		go func() {
+			_, chans, reqs, err := ssh.NewServerConn(conn, config)
+			if err != nil {
+				return
+			}
+
+			go ssh.DiscardRequests(reqs)
+
+			for newChannel := range chans {
+				if newChannel.ChannelType() != "direct-tcpip" {
+					newChannel.Reject(ssh.UnknownChannelType, "unsupported") //nolint:errcheck
+
+					continue
+				}
+
+				var msg struct {
+					Addr     string
+					Port     uint32
+					OrigAddr string
+					OrigPort uint32
+				}
+
+				if err = ssh.Unmarshal(newChannel.ExtraData(), &msg); err != nil {
+					newChannel.Reject(ssh.ConnectionFailed, err.Error()) //nolint:errcheck
+
+					continue
+				}
+
+				targetConn, err := net.Dial("tcp", net.JoinHostPort(msg.Addr, strconv.Itoa(int(msg.Port))))
+				if err != nil {
+					newChannel.Reject(ssh.ConnectionFailed, err.Error()) //nolint:errcheck
+
+					continue
+				}
+
+				channel, channelReqs, err := newChannel.Accept()
+				if err != nil {
+					targetConn.Close() //nolint:errcheck
+
+					continue
+				}
+
+				go ssh.DiscardRequests(channelReqs)
+
+				go func() {
+					defer channel.Close()    //nolint:errcheck
+					defer targetConn.Close() //nolint:errcheck
+
+					go io.Copy(targetConn, channel) //nolint:errcheck
+
+					io.Copy(channel, targetConn) //nolint:errcheck
+				}()
+			}
+		}()
+	}
+}
@@ -0,0 +1,271 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	cosiapi "github.com/cosi-project/runtime/api/v1alpha1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/inspect"
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/api/storage"
+	timeapi "github.com/siderolabs/talos/pkg/machinery/api/time"
+)
+
+const (
+	retryInitialBackoff = 500 * time.Millisecond
+	retryMaxBackoff     = 10 * time.Second
+)
+
+// WithRequestTimeout sets the timeout of each request.
+//
+// For the unary calls which don't change the state of the node, the timeout applies to each attempt (see WithRetries).
+// The other unary calls (e.g. Upgrade or ImagePull) might legitimately take long, so they are not affected.
+// For the server-streaming calls, the timeout applies to receiving the first response, as the stream
+// might legitimately stay open for a long time.
+// The streams which wait for the new data (e.g. following the logs, or watching the events) are not affected,
+// as well as the client-streaming and bidirectional calls.
+func WithRequestTimeout(timeout time.Duration) OptionFunc {
+	return func(o *Options) error {
+		o.requestTimeout = timeout
+
+		return nil
+	}
+}
+
+// WithRetries sets the number of retries of the requests which failed with Unavailable or DeadlineExceeded errors.
+//
+// The retries are done with the exponential backoff.
+// Only the unary calls which don't change the state of the node are retried, as a failed request
+// might have still reached the server (e.g. Reboot, Upgrade or ApplyConfiguration).
+// For the streaming calls, only opening the stream is retried, as the request is not sent yet at that point.
+func WithRetries(retries int) OptionFunc {
+	return func(o *Options) error {
+		o.retries = retries
+
+		return nil
+	}
+}
+
+// idempotentMethods is the list of the unary methods which are safe to retry.
+var idempotentMethods = map[string]struct{}{
+	cosiapi.State_Get_FullMethodName: {},
+
+	inspect.InspectService_ControllerRuntimeDependencies_FullMethodName: {},
+
+	machine.MachineService_BMCEventLog_FullMethodName:        {},
+	machine.MachineService_BMCSensors_FullMethodName:         {},
+	machine.MachineService_BootLogs_FullMethodName:           {},
+	machine.MachineService_Certificates_FullMethodName:       {},
+	machine.MachineService_Containers_FullMethodName:         {},
+	machine.MachineService_CPUInfo_FullMethodName:            {},
+	machine.MachineService_DiskStats_FullMethodName:          {},
+	machine.MachineService_EtcdAlarmList_FullMethodName:      {},
+	machine.MachineService_EtcdMemberList_FullMethodName:     {},
+	machine.MachineService_EtcdPrecheck_FullMethodName:       {},
+	machine.MachineService_EtcdStatus_FullMethodName:         {},
+	machine.MachineService_HardwareInventory_FullMethodName:  {},
+	machine.MachineService_Hostname_FullMethodName:           {},
+	machine.MachineService_JoinTokenList_FullMethodName:      {},
+	machine.MachineService_LoadAvg_FullMethodName:            {},
+	machine.MachineService_LogsContainers_FullMethodName:     {},
+	machine.MachineService_Memory_FullMethodName:             {},
+	machine.MachineService_Mounts_FullMethodName:             {},
+	machine.MachineService_Neighbors_FullMethodName:          {},
+	machine.MachineService_Netstat_FullMethodName:            {},
+	machine.MachineService_NetworkDeviceStats_FullMethodName: {},
+	machine.MachineService_Processes_FullMethodName:          {},
+	machine.MachineService_SensorStats_FullMethodName:        {},
+	machine.MachineService_ServiceGraph_FullMethodName:       {},
+	machine.MachineService_ServiceList_FullMethodName:        {},
+	machine.MachineService_Stats_FullMethodName:              {},
+	machine.MachineService_SystemStat_FullMethodName:         {},
+	machine.MachineService_Version_FullMethodName:            {},
+
+	storage.StorageService_Disks_FullMethodName: {},
+
+	timeapi.TimeService_Time_FullMethodName:      {},
+	timeapi.TimeService_TimeCheck_FullMethodName: {},
+}
+
+// longLivedStreamMethods is the list of the server-streaming methods which might not send anything for a long time.
+var longLivedStreamMethods = map[string]struct{}{
+	cosiapi.State_Watch_FullMethodName: {},
+
+	machine.MachineService_Events_FullMethodName:          {},
+	machine.MachineService_PacketCapture_FullMethodName:   {},
+	machine.MachineService_ProcessesStream_FullMethodName: {},
+	machine.MachineService_Profile_FullMethodName:         {},
+	machine.MachineService_Strace_FullMethodName:          {},
+	machine.MachineService_Trace_FullMethodName:           {},
+}
+
+// followRequest returns true if the request asks the server to keep the stream open waiting for the new data.
+func followRequest(req any) bool {
+	switch req := req.(type) {
+	case *machine.LogsRequest:
+		return req.GetFollow()
+	case *machine.DmesgRequest:
+		return req.GetFollow()
+	default:
+		return false
+	}
+}
+
+func retryable(err error) bool {
+	switch status.Code(err) { //nolint:exhaustive
+	case codes.Unavailable, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// withRetries calls f up to retries+1 times with the exponential backoff while it fails with the retryable error.
+func withRetries(ctx context.Context, retries int, f func() error) error {
+	backoff := retryInitialBackoff
+
+	for attempt := 0; ; attempt++ {
+		err := f()
+		if err == nil || attempt >= retries || ctx.Err() != nil || !retryable(err) {
+			return err
+		}
+
+		wait := backoff
+
+		// the server might suggest a longer delay, e.g. when the node is rebooting
+		if delay, ok := RetryDelay(err); ok {
+			wait = max(wait, delay)
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+
+		backoff = min(2*backoff, retryMaxBackoff)
+	}
+}
+
+func unaryRetryInterceptor(timeout time.Duration, retries int) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		methodRetries, methodTimeout := retries, timeout
+
+		if _, idempotent := idempotentMethods[method]; !idempotent {
+			methodRetries, methodTimeout = 0, 0
+		}
+
+		return withRetries(ctx, methodRetries, func() error {
+			if m, ok := reply.(proto.Message); ok {
+				proto.Reset(m)
+			}
+
+			attemptCtx := ctx
+
+			if methodTimeout > 0 {
+				var cancel context.CancelFunc
+
+				attemptCtx, cancel = context.WithTimeout(ctx, methodTimeout)
+				defer cancel()
+			}
+
+			return invoker(attemptCtx, method, req, reply, cc, opts...)
+		})
+	}
+}
+
+func streamRetryInterceptor(timeout time.Duration, retries int) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		_, longLived := longLivedStreamMethods[method]
+
+		if timeout <= 0 || longLived || !desc.ServerStreams || desc.ClientStreams {
+			var stream grpc.ClientStream
+
+			err := withRetries(ctx, retries, func() error {
+				var err error
+
+				stream, err = streamer(ctx, desc, cc, method, opts...)
+
+				return err
+			})
+
+			return stream, err
+		}
+
+		streamCtx, cancel := context.WithCancel(ctx)
+
+		var stream grpc.ClientStream
+
+		err := withRetries(ctx, retries, func() error {
+			var err error
+
+			stream, err = streamer(streamCtx, desc, cc, method, opts...)
+
+			return err
+		})
+		if err != nil {
+			cancel()
+
+			return nil, err
+		}
+
+		s := &firstResponseTimeoutStream{
+			ClientStream: stream,
+			cancel:       cancel,
+		}
+
+		s.timer = time.AfterFunc(timeout, func() {
+			s.timedOut.Store(true)
+
+			cancel()
+		})
+
+		return s, nil
+	}
+}
+
+// firstResponseTimeoutStream cancels the stream if the first response is not received within the timeout.
+type firstResponseTimeoutStream struct {
+	grpc.ClientStream
+
+	cancel   context.CancelFunc
+	timer    *time.Timer
+	timedOut atomic.Bool
+	once     sync.Once
+}
+
+func (s *firstResponseTimeoutStream) SendMsg(m any) error {
+	if followRequest(m) {
+		// the stream waits for the new data, so the first response might legitimately take long
+		s.once.Do(func() { s.timer.Stop() })
+	}
+
+	return s.ClientStream.SendMsg(m)
+}
+
+func (s *firstResponseTimeoutStream) RecvMsg(m any) error {
+	err := s.ClientStream.RecvMsg(m)
+
+	s.once.Do(func() { s.timer.Stop() })
+
+	if err != nil {
+		s.cancel()
+
+		if s.timedOut.Load() {
+			return status.Error(codes.DeadlineExceeded, "timed out waiting for the first response")
+		}
+	}
+
+	return err
+}
@@ -0,0 +1,240 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	"github.com/siderolabs/talos/pkg/machinery/api/machine"
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+func TestUnaryRetryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name     string
+		method   string
+		retries  int
+		errs     []error
+		expected codes.Code
+		calls    int
+	}{
+		{
+			name:     "success",
+			method:   "/machine.MachineService/Version",
+			retries:  2,
+			expected: codes.OK,
+			calls:    1,
+		},
+		{
+			name:     "retried",
+			method:   "/machine.MachineService/Version",
+			retries:  2,
+			errs:     []error{status.Error(codes.Unavailable, "down"), status.Error(codes.Unavailable, "down")},
+			expected: codes.OK,
+			calls:    3,
+		},
+		{
+			name:     "retries exhausted",
+			method:   "/machine.MachineService/Version",
+			retries:  1,
+			errs:     []error{status.Error(codes.Unavailable, "down"), status.Error(codes.Unavailable, "down")},
+			expected: codes.Unavailable,
+			calls:    2,
+		},
+		{
+			name:     "not retryable",
+			method:   "/machine.MachineService/Version",
+			retries:  2,
+			errs:     []error{status.Error(codes.PermissionDenied, "denied")},
+			expected: codes.PermissionDenied,
+			calls:    1,
+		},
+		{
+			name:     "not idempotent",
+			method:   "/machine.MachineService/Reboot",
+			retries:  2,
+			errs:     []error{status.Error(codes.Unavailable, "down")},
+			expected: codes.Unavailable,
+			calls:    1,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			var calls int
+
+			invoker := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+				calls++
+
+				if calls <= len(test.errs) {
+					return test.errs[calls-1]
+				}
+
+				return nil
+			}
+
+			err := client.UnaryRetryInterceptor(0, test.retries)(context.Background(), test.method, nil, &emptypb.Empty{}, nil, invoker)
+			assert.Equal(t, test.expected, status.Code(err))
+			assert.Equal(t, test.calls, calls)
+		})
+	}
+}
+
+func TestUnaryRetryInterceptorTimeout(t *testing.T) {
+	t.Parallel()
+
+	var calls int
+
+	invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		calls++
+
+		if calls == 1 {
+			// hung node
+			<-ctx.Done()
+
+			return status.FromContextError(ctx.Err()).Err()
+		}
+
+		return nil
+	}
+
+	err := client.UnaryRetryInterceptor(50*time.Millisecond, 1)(context.Background(), "/machine.MachineService/Version", nil, &emptypb.Empty{}, nil, invoker)
+	require.NoError(t, err)
+	assert.Equal(t, 2, calls)
+}
+
+type fakeStream struct {
+	grpc.ClientStream
+
+	ctx   context.Context //nolint:containedctx
+	delay time.Duration
+}
+
+func (s *fakeStream) SendMsg(any) error {
+	return nil
+}
+
+func (s *fakeStream) RecvMsg(any) error {
+	select {
+	case <-s.ctx.Done():
+		return status.FromContextError(s.ctx.Err()).Err()
+	case <-time.After(s.delay):
+		return nil
+	}
+}
+
+func TestStreamRetryInterceptorTimeout(t *testing.T) {
+	t.Parallel()
+
+	desc := &grpc.StreamDesc{ServerStreams: true}
+
+	for _, test := range []struct {
+		name     string
+		delay    time.Duration
+		expected codes.Code
+	}{
+		{
+			name:     "first response in time",
+			delay:    10 * time.Millisecond,
+			expected: codes.OK,
+		},
+		{
+			name:     "no response",
+			delay:    time.Hour,
+			expected: codes.DeadlineExceeded,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			streamer := func(ctx context.Context, _ *grpc.StreamDesc, _ *grpc.ClientConn, _ string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+				return &fakeStream{ctx: ctx, delay: test.delay}, nil
+			}
+
+			stream, err := client.StreamRetryInterceptor(50*time.Millisecond, 0)(context.Background(), desc, nil, "/test", streamer)
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expected, status.Code(stream.RecvMsg(nil)))
+
+			if test.expected == codes.OK {
+				// the timeout doesn't apply to the following responses
+				time.Sleep(100 * time.Millisecond)
+
+				assert.NoError(t, stream.RecvMsg(nil))
+			}
+		})
+	}
+}
+
+func TestStreamRetryInterceptorFollow(t *testing.T) {
+	t.Parallel()
+
+	desc := &grpc.StreamDesc{ServerStreams: true}
+
+	for _, test := range []struct {
+		name   string
+		method string
+		req    any
+	}{
+		{
+			name:   "follow logs",
+			method: "/machine.MachineService/Logs",
+			req:    &machine.LogsRequest{Follow: true},
+		},
+		{
+			name:   "follow dmesg",
+			method: "/machine.MachineService/Dmesg",
+			req:    &machine.DmesgRequest{Follow: true},
+		},
+		{
+			name:   "events",
+			method: "/machine.MachineService/Events",
+			req:    &machine.EventsRequest{},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			streamer := func(ctx context.Context, _ *grpc.StreamDesc, _ *grpc.ClientConn, _ string, _ ...grpc.CallOption) (grpc.ClientStream, error) {
+				return &fakeStream{ctx: ctx, delay: 100 * time.Millisecond}, nil
+			}
+
+			stream, err := client.StreamRetryInterceptor(50*time.Millisecond, 0)(context.Background(), desc, nil, test.method, streamer)
+			require.NoError(t, err)
+
+			require.NoError(t, stream.SendMsg(test.req))
+
+			assert.NoError(t, stream.RecvMsg(nil))
+		})
+	}
+}
+
+func TestUnaryRetryInterceptorTimeoutNotIdempotent(t *testing.T) {
+	t.Parallel()
+
+	invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		select {
+		case <-ctx.Done():
+			return status.FromContextError(ctx.Err()).Err()
+		case <-time.After(100 * time.Millisecond):
+			return nil
+		}
+	}
+
+	// upgrade might take long, so the request timeout doesn't apply
+	err := client.UnaryRetryInterceptor(50*time.Millisecond, 1)(context.Background(), "/machine.MachineService/Upgrade", nil, &emptypb.Empty{}, nil, invoker)
+	require.NoError(t, err)
+}
@@ -23,5 +23,9 @@ func buildCredentials(configContext *clientconfig.Context, endpoints []string) (
 		return nil, err
 	}
 
+	if err = verifyEndpoints(tlsConfig, configContext, endpoints); err != nil {
+		return nil, err
+	}
+
 	return credentials.NewTLS(tlsConfig), nil
 }
@@ -6,11 +6,28 @@ package client
 
 import (
 	"errors"
+	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+// ErrorDomain is the domain of the error details (google.rpc.ErrorInfo) returned by the Talos API.
+const ErrorDomain = "talos.dev"
+
+// Reasons of the errors returned by the Talos API when the node doesn't accept requests,
+// as it's going down.
+//
+// The errors have codes.Unavailable code, and the suggested retry delay (see RetryDelay),
+// unless the node is shutting down.
+const (
+	ReasonNodeRebooting    = "NODE_REBOOTING"
+	ReasonNodeShuttingDown = "NODE_SHUTTING_DOWN"
+	ReasonNodeUpgrading    = "NODE_UPGRADING"
+	ReasonNodeResetting    = "NODE_RESETTING"
+)
+
 // Status returns the status if it is a Status error, nil otherwise.
 func Status(err error) *status.Status {
 	type grpcStatus interface {
@@ -43,3 +60,36 @@ func StatusCode(err error) codes.Code {
 
 	return codes.Unknown
 }
+
+// ErrorReason returns the reason of the Talos API error (see ErrorDomain), or empty string
+// if the error has no such details.
+func ErrorReason(err error) string {
+	st := Status(err)
+	if st == nil {
+		return ""
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetDomain() == ErrorDomain {
+			return info.GetReason()
+		}
+	}
+
+	return ""
+}
+
+// RetryDelay returns the delay suggested by the server before retrying the request.
+func RetryDelay(err error) (time.Duration, bool) {
+	st := Status(err)
+	if st == nil {
+		return 0, false
+	}
+
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.RetryInfo); ok && info.GetRetryDelay() != nil {
+			return info.GetRetryDelay().AsDuration(), true
+		}
+	}
+
+	return 0, false
+}
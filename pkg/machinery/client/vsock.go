@@ -0,0 +1,114 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
+)
+
+// vsockScheme is the scheme of the endpoints reached over the VM sockets (AF_VSOCK), e.g. vsock://3:50000.
+//
+// The host part of the endpoint is the context ID (CID) of the virtual machine, the port defaults to the apid port.
+const vsockScheme = "vsock"
+
+// vsockAddr is the address of the VM socket.
+type vsockAddr struct {
+	ContextID uint32
+	Port      uint32
+}
+
+// Network implements net.Addr interface.
+func (a *vsockAddr) Network() string {
+	return vsockScheme
+}
+
+// String implements net.Addr interface.
+func (a *vsockAddr) String() string {
+	return net.JoinHostPort(strconv.FormatUint(uint64(a.ContextID), 10), strconv.FormatUint(uint64(a.Port), 10))
+}
+
+// parseVsockEndpoints returns the address of the vsock endpoint, if the endpoints are vsock ones.
+//
+// The vsock endpoint can't be combined with any other endpoints.
+func parseVsockEndpoints(endpoints []string) (*vsockAddr, error) {
+	var addr *vsockAddr
+
+	for _, endpoint := range endpoints {
+		if !strings.HasPrefix(endpoint, vsockScheme+"://") {
+			continue
+		}
+
+		if len(endpoints) > 1 {
+			return nil, errors.New("vsock endpoint can't be combined with other endpoints")
+		}
+
+		u, err := url.Parse(endpoint)
+		if err != nil {
+			return nil, fmt.Errorf("invalid vsock endpoint %q: %w", endpoint, err)
+		}
+
+		cid, err := strconv.ParseUint(u.Hostname(), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid context ID in vsock endpoint %q: %w", endpoint, err)
+		}
+
+		port := uint64(constants.ApidPort)
+
+		if u.Port() != "" {
+			port, err = strconv.ParseUint(u.Port(), 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("invalid port in vsock endpoint %q: %w", endpoint, err)
+			}
+		}
+
+		addr = &vsockAddr{ContextID: uint32(cid), Port: uint32(port)}
+	}
+
+	return addr, nil
+}
+
+// skipServerNameVerification verifies only the server certificate chain.
+//
+// The vsock peer is identified by the context ID, which is not in the server certificate,
+// so the certificate is checked to be issued by the CA (and to match the pinned fingerprints), but not for the server name.
+func skipServerNameVerification(tlsConfig *tls.Config) {
+	verifyConnection := tlsConfig.VerifyConnection
+
+	tlsConfig.InsecureSkipVerify = true //nolint:gosec // the certificate chain is verified below
+	tlsConfig.VerifyConnection = func(state tls.ConnectionState) error {
+		if len(state.PeerCertificates) == 0 {
+			return errors.New("no server certificate")
+		}
+
+		intermediates := x509.NewCertPool()
+
+		for _, cert := range state.PeerCertificates[1:] {
+			intermediates.AddCert(cert)
+		}
+
+		if _, err := state.PeerCertificates[0].Verify(x509.VerifyOptions{
+			Roots:         tlsConfig.RootCAs,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		}); err != nil {
+			return err
+		}
+
+		if verifyConnection != nil {
+			return verifyConnection(state)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,56 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client
+
+import (
+	"context"
+	"net"
+
+	"github.com/mdlayher/socket"
+	"golang.org/x/sys/unix"
+)
+
+// dial connects to the VM socket.
+func (a *vsockAddr) dial(ctx context.Context) (net.Conn, error) {
+	c, err := socket.Socket(unix.AF_VSOCK, unix.SOCK_STREAM, 0, vsockScheme, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = c.Connect(ctx, &unix.SockaddrVM{CID: a.ContextID, Port: a.Port}); err != nil {
+		c.Close() //nolint:errcheck
+
+		return nil, &net.OpError{Op: "dial", Net: vsockScheme, Addr: a, Err: err}
+	}
+
+	local := &vsockAddr{}
+
+	if sa, err := c.Getsockname(); err == nil {
+		if vm, ok := sa.(*unix.SockaddrVM); ok {
+			local = &vsockAddr{ContextID: vm.CID, Port: vm.Port}
+		}
+	}
+
+	return &vsockConn{Conn: c, local: local, remote: a}, nil
+}
+
+// vsockConn is the connection on the VM socket.
+//
+// socket.Conn provides the I/O and the deadlines, vsockConn adds the addresses to implement net.Conn.
+type vsockConn struct {
+	*socket.Conn
+
+	local, remote *vsockAddr
+}
+
+// LocalAddr implements net.Conn interface.
+func (c *vsockConn) LocalAddr() net.Addr {
+	return c.local
+}
+
+// RemoteAddr implements net.Conn interface.
+func (c *vsockConn) RemoteAddr() net.Addr {
+	return c.remote
+}
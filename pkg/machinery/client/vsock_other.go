@@ -0,0 +1,18 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+//go:build !linux
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+)
+
+// dial connects to the VM socket.
+func (a *vsockAddr) dial(context.Context) (net.Conn, error) {
+	return nil, errors.New("vsock endpoints are only supported on Linux")
+}
@@ -0,0 +1,42 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package client_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/client"
+)
+
+func TestParseVsockEndpoints(t *testing.T) {
+	t.Parallel()
+
+	addr, err := client.ParseVsockEndpoints([]string{"10.5.0.2", "10.5.0.3:50000"})
+	require.NoError(t, err)
+	assert.Nil(t, addr)
+
+	addr, err = client.ParseVsockEndpoints([]string{"vsock://3"})
+	require.NoError(t, err)
+	require.NotNil(t, addr)
+	assert.Equal(t, "3:50000", addr.String())
+
+	addr, err = client.ParseVsockEndpoints([]string{"vsock://42:1024"})
+	require.NoError(t, err)
+	require.NotNil(t, addr)
+	assert.EqualValues(t, 42, addr.ContextID)
+	assert.EqualValues(t, 1024, addr.Port)
+
+	for _, endpoints := range [][]string{
+		{"vsock://3", "10.5.0.2"},
+		{"vsock://host"},
+		{"vsock://3:port"},
+	} {
+		_, err = client.ParseVsockEndpoints(endpoints)
+		assert.Error(t, err, "endpoints %v", endpoints)
+	}
+}
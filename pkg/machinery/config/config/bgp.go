@@ -0,0 +1,36 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/siderolabs/gen/optional"
+)
+
+// BGPConfig defines the interface to access the BGP speaker configuration.
+type BGPConfig interface {
+	// ASN returns the local autonomous system number.
+	ASN() uint32
+	// RouterID returns the BGP identifier, if not set the local IPv4 address of each session is used.
+	RouterID() optional.Optional[netip.Addr]
+	// HoldTime returns the proposed hold time.
+	HoldTime() time.Duration
+	// Peers returns the BGP neighbors.
+	Peers() []BGPPeerConfig
+	// AnnounceVIPs returns true if the shared (virtual) IPs held by the machine should be announced.
+	AnnounceVIPs() bool
+	// AnnouncePrefixes returns the prefixes to announce.
+	AnnouncePrefixes() []netip.Prefix
+}
+
+// BGPPeerConfig describes a BGP neighbor.
+type BGPPeerConfig struct {
+	Address netip.Addr
+	Port    uint16
+	ASN     uint32
+	BFD     bool
+}
@@ -45,6 +45,8 @@ type ClusterConfig interface {
 	AdminKubeconfig() AdminKubeconfig
 	ScheduleOnControlPlanes() bool
 	Discovery() Discovery
+	// KubeletServingCertificateRotation returns true if kubelet serving certificates are issued by the Kubernetes CA.
+	KubeletServingCertificateRotation() bool
 }
 
 // ClusterNetwork defines the requirements for a config that pertains to cluster
@@ -71,6 +73,7 @@ type CNI interface {
 // FlannelCNI defines the requirements for a config that pertains to configure Flannel.
 type FlannelCNI interface {
 	ExtraArgs() []string
+	MTU() uint32
 }
 
 // APIServer defines the requirements for a config that pertains to apiserver related
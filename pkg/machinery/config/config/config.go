@@ -15,6 +15,21 @@ type Config interface {
 	Runtime() RuntimeConfig
 	NetworkRules() NetworkRuleConfig
 	TrustedRoots() TrustedRootsConfig
+	TPMAttestation() TPMAttestationConfig
+	TrustdCSRPolicy() TrustdCSRPolicyConfig
+	LSM() LSMConfig
+	Seccomp() SeccompConfig
+	ImageVerification() ImageVerificationConfig
 	Volumes() VolumesConfig
 	KubespanConfig() KubespanConfig
+	LLDP() LLDPConfig
+	EthernetConfigs() []EthernetConfig
+	SRIOVConfigs() []SRIOVConfig
+	LinkSysctlConfigs() []LinkSysctlConfig
+	BGP() BGPConfig
+	Performance() PerformanceConfig
+	SystemResources() SystemResourcesConfig
+	ServiceLimits() []ServiceLimitsConfig
+	ServicePolicies() []ServicePolicyConfig
+	UserServices() []UserServiceConfig
 }
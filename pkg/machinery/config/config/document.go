@@ -25,3 +25,11 @@ type SecretDocument interface {
 	// Redact does in-place replacement of secrets with the given string.
 	Redact(replacement string)
 }
+
+// MigratableDocument is a configuration document which can be migrated from the older versions or deprecated fields.
+type MigratableDocument interface {
+	// Migrate returns the migrated document and the list of changes, or nil if there is nothing to migrate.
+	//
+	// The migrated document might have a different API version, the document itself is not modified.
+	Migrate() (Document, []string, error)
+}
@@ -0,0 +1,40 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import "github.com/siderolabs/gen/optional"
+
+// EthernetConfig defines the interface to access Ethernet link tuning configuration.
+type EthernetConfig interface {
+	// Link returns the name of the link.
+	Link() string
+	// Rings returns the ring buffer sizes.
+	Rings() EthernetRingsConfig
+	// Channels returns the queue (channel) counts.
+	Channels() EthernetChannelsConfig
+	// Offloads returns the offload toggles.
+	Offloads() EthernetOffloadsConfig
+}
+
+// EthernetRingsConfig describes the ring buffer sizes, unset values are not changed.
+type EthernetRingsConfig struct {
+	RX optional.Optional[uint32]
+	TX optional.Optional[uint32]
+}
+
+// EthernetChannelsConfig describes the queue counts, unset values are not changed.
+type EthernetChannelsConfig struct {
+	RX       optional.Optional[uint32]
+	TX       optional.Optional[uint32]
+	Other    optional.Optional[uint32]
+	Combined optional.Optional[uint32]
+}
+
+// EthernetOffloadsConfig describes the offload toggles, unset values are not changed.
+type EthernetOffloadsConfig struct {
+	GRO optional.Optional[bool]
+	GSO optional.Optional[bool]
+	TSO optional.Optional[bool]
+}
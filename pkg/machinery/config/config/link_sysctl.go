@@ -0,0 +1,25 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import "github.com/siderolabs/gen/optional"
+
+// LinkSysctlConfig defines the interface to access per-link kernel network parameters.
+//
+// Unset values are not changed.
+type LinkSysctlConfig interface {
+	// Link returns the name of the link.
+	Link() string
+	// IPv4Forwarding returns the value of net.ipv4.conf.<link>.forwarding.
+	IPv4Forwarding() optional.Optional[bool]
+	// IPv6Forwarding returns the value of net.ipv6.conf.<link>.forwarding.
+	IPv6Forwarding() optional.Optional[bool]
+	// RPFilter returns the value of net.ipv4.conf.<link>.rp_filter.
+	RPFilter() optional.Optional[int]
+	// ProxyARP returns the value of net.ipv4.conf.<link>.proxy_arp.
+	ProxyARP() optional.Optional[bool]
+	// ARPIgnore returns the value of net.ipv4.conf.<link>.arp_ignore.
+	ARPIgnore() optional.Optional[int]
+}
@@ -84,6 +84,23 @@ type File interface {
 	Permissions() os.FileMode
 	Path() string
 	Op() string
+	Type() string
+	UID() int
+	GID() int
+	Source() FileSource
+	Template() bool
+}
+
+// File types.
+const (
+	FileTypeFile      = "file"
+	FileTypeDirectory = "directory"
+)
+
+// FileSource represents a remote source of the file contents.
+type FileSource interface {
+	URL() string
+	Checksum() string
 }
 
 // Install defines the requirements for a config that pertains to install
@@ -135,6 +152,7 @@ type MachineScheduler interface {
 // related options.
 type MachineNetwork interface {
 	Hostname() string
+	HostnameTemplate() string
 	Resolvers() []string
 	Devices() []Device
 	ExtraHosts() []ExtraHost
@@ -428,6 +446,11 @@ type Features interface {
 	DiskQuotaSupportEnabled() bool
 	HostDNS() HostDNS
 	KubePrism() KubePrism
+	NodeTalosMetadataEnabled() bool
+	NodeEventsEnabled() bool
+	APIRateLimit() APIRateLimit
+	ChaosAPIEnabled() bool
+	APIVsock() APIVsock
 }
 
 // KubernetesTalosAPIAccess describes the Kubernetes Talos API access features.
@@ -437,6 +460,21 @@ type KubernetesTalosAPIAccess interface {
 	AllowedKubernetesNamespaces() []string
 }
 
+// APIRateLimit describes the rate limiting of the Talos API methods.
+type APIRateLimit interface {
+	Enabled() bool
+	RequestsPerMinute() int
+	Burst() int
+	MaxConcurrentRequests() int
+	Methods() []string
+}
+
+// APIVsock describes the Talos API listener on the VM sockets.
+type APIVsock interface {
+	Enabled() bool
+	Port() int
+}
+
 // KubePrism describes the API Server load balancer features.
 type KubePrism interface {
 	Enabled() bool
@@ -448,6 +486,14 @@ type HostDNS interface {
 	Enabled() bool
 	ForwardKubeDNSToHost() bool
 	ResolveMemberNames() bool
+	Upstreams() []string
+	StubDomains() []HostDNSStubDomain
+}
+
+// HostDNSStubDomain describes the upstream nameservers of a DNS domain.
+type HostDNSStubDomain interface {
+	Domain() string
+	Nameservers() []string
 }
 
 // UdevConfig describes configuration for udev.
@@ -458,6 +504,7 @@ type UdevConfig interface {
 // Logging describes logging configuration.
 type Logging interface {
 	Destinations() []LoggingDestination
+	Structured() bool
 }
 
 // LoggingDestination describes logging destination.
@@ -64,3 +64,9 @@ func (w networkRuleConfigWrapper) Rules() []NetworkRule {
 		},
 	)
 }
+
+// LLDPConfig defines the interface to access LLDP neighbor discovery configuration.
+type LLDPConfig interface {
+	// Interfaces returns the names of the links to listen on, empty list means all physical links.
+	Interfaces() []string
+}
@@ -0,0 +1,21 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+// PerformanceConfig defines the interface to access huge pages and CPU isolation configuration.
+type PerformanceConfig interface {
+	HugePages() []HugePagesConfig
+	KernelArgs() []string
+}
+
+// HugePagesConfig defines the interface to access huge pages reservation for a single page size.
+type HugePagesConfig interface {
+	// SizeKB returns the huge page size in KiB.
+	SizeKB() uint64
+	// Count returns the number of pages reserved system-wide (if NodeCounts is empty).
+	Count() int
+	// NodeCounts returns the number of pages reserved per NUMA node.
+	NodeCounts() map[int]int
+}
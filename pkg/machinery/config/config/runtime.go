@@ -14,6 +14,11 @@ type RuntimeConfig interface {
 	EventsEndpoint() *string
 	KmsgLogURLs() []*url.URL
 	WatchdogTimer() WatchdogTimerConfig
+	UpgradeHealthCheck() UpgradeHealthCheckConfig
+	Metrics() MetricsConfig
+	SequenceHooks() []SequenceHookConfig
+	WebhookNotifications() []WebhookNotificationConfig
+	SNMP() SNMPConfig
 }
 
 // WatchdogTimerConfig defines the interface to access Talos watchdog timer configuration.
@@ -22,6 +27,104 @@ type WatchdogTimerConfig interface {
 	Timeout() time.Duration
 }
 
+// UpgradeHealthCheckConfig defines the interface to access post-upgrade health check configuration.
+type UpgradeHealthCheckConfig interface {
+	Timeout() time.Duration
+	KubeletReady() bool
+	Services() []string
+	HTTPProbes() []HTTPProbe
+}
+
+// MetricsConfig defines the interface to access Prometheus metrics endpoint configuration.
+type MetricsConfig interface {
+	ListenAddress() string
+}
+
+// Sequence hook points.
+const (
+	SequenceHookPreInstall  = "pre-install"
+	SequenceHookPostBoot    = "post-boot"
+	SequenceHookPreUpgrade  = "pre-upgrade"
+	SequenceHookPostUpgrade = "post-upgrade"
+)
+
+// SequenceHookConfig defines the interface to access sequence hook configuration.
+type SequenceHookConfig interface {
+	Name() string
+	Point() string
+	URL() *url.URL
+	Headers() map[string]string
+	Timeout() time.Duration
+	IgnoreFailure() bool
+}
+
+// Webhook notification formats.
+const (
+	WebhookFormatJSON  = "json"
+	WebhookFormatSlack = "slack"
+)
+
+// Webhook notification events.
+const (
+	WebhookEventUpgradeFailed       = "upgrade-failed"
+	WebhookEventBootFallback        = "boot-fallback"
+	WebhookEventDiskFailure         = "disk-failure"
+	WebhookEventCertificateExpiring = "certificate-expiring"
+)
+
+// WebhookNotificationConfig defines the interface to access webhook notification configuration.
+type WebhookNotificationConfig interface {
+	Name() string
+	URL() *url.URL
+	Format() string
+	Events() []string
+	Headers() map[string]string
+	SigningKey() string
+	Timeout() time.Duration
+}
+
+// SNMP authentication protocols.
+const (
+	SNMPAuthProtocolMD5    = "md5"
+	SNMPAuthProtocolSHA    = "sha"
+	SNMPAuthProtocolSHA224 = "sha224"
+	SNMPAuthProtocolSHA256 = "sha256"
+	SNMPAuthProtocolSHA384 = "sha384"
+	SNMPAuthProtocolSHA512 = "sha512"
+)
+
+// SNMP privacy protocols.
+const (
+	SNMPPrivProtocolDES    = "des"
+	SNMPPrivProtocolAES    = "aes"
+	SNMPPrivProtocolAES192 = "aes192"
+	SNMPPrivProtocolAES256 = "aes256"
+)
+
+// SNMPConfig defines the interface to access SNMP agent configuration.
+type SNMPConfig interface {
+	ListenAddress() string
+	Community() string
+	Users() []SNMPUser
+	Location() string
+	Contact() string
+}
+
+// SNMPUser defines the interface to access SNMPv3 user configuration.
+type SNMPUser interface {
+	Name() string
+	AuthProtocol() string
+	AuthPassphrase() string
+	PrivProtocol() string
+	PrivPassphrase() string
+}
+
+// HTTPProbe defines the interface to access HTTP health check configuration.
+type HTTPProbe interface {
+	URL() *url.URL
+	ExpectedStatus() int
+}
+
 // WrapRuntimeConfigList wraps a list of RuntimeConfig into a single RuntimeConfig aggregating the results.
 func WrapRuntimeConfigList(configs ...RuntimeConfig) RuntimeConfig {
 	return runtimeConfigWrapper(configs)
@@ -46,3 +149,33 @@ func (w runtimeConfigWrapper) WatchdogTimer() WatchdogTimerConfig {
 		return c.WatchdogTimer()
 	})
 }
+
+func (w runtimeConfigWrapper) UpgradeHealthCheck() UpgradeHealthCheckConfig {
+	return findFirstValue(w, func(c RuntimeConfig) UpgradeHealthCheckConfig {
+		return c.UpgradeHealthCheck()
+	})
+}
+
+func (w runtimeConfigWrapper) Metrics() MetricsConfig {
+	return findFirstValue(w, func(c RuntimeConfig) MetricsConfig {
+		return c.Metrics()
+	})
+}
+
+func (w runtimeConfigWrapper) SequenceHooks() []SequenceHookConfig {
+	return aggregateValues(w, func(c RuntimeConfig) []SequenceHookConfig {
+		return c.SequenceHooks()
+	})
+}
+
+func (w runtimeConfigWrapper) WebhookNotifications() []WebhookNotificationConfig {
+	return aggregateValues(w, func(c RuntimeConfig) []WebhookNotificationConfig {
+		return c.WebhookNotifications()
+	})
+}
+
+func (w runtimeConfigWrapper) SNMP() SNMPConfig {
+	return findFirstValue(w, func(c RuntimeConfig) SNMPConfig {
+		return c.SNMP()
+	})
+}
@@ -4,6 +4,11 @@
 
 package config
 
+import (
+	"crypto"
+	"net/netip"
+)
+
 // TrustedRootsConfig defines the interface to access trusted roots configuration.
 type TrustedRootsConfig interface {
 	ExtraTrustedRootCertificates() []string
@@ -21,3 +26,32 @@ func (w trustedRootConfigWrapper) ExtraTrustedRootCertificates() []string {
 		return c.ExtraTrustedRootCertificates()
 	})
 }
+
+// TPMAttestationConfig defines the interface to access TPM attestation configuration.
+type TPMAttestationConfig interface {
+	Required() bool
+	AttestationKeys() []string
+	PCRs() map[int][]string
+}
+
+// TrustdCSRPolicyConfig defines the interface to access trustd certificate request policy configuration.
+type TrustdCSRPolicyConfig interface {
+	AllowedIPs() []netip.Prefix
+	AllowedDNSNames() []string
+}
+
+// LSMConfig defines the interface to access the baseline AppArmor profile configuration.
+type LSMConfig interface {
+	Enforcing() bool
+}
+
+// SeccompConfig defines the interface to access system services seccomp configuration.
+type SeccompConfig interface {
+	Audit() bool
+}
+
+// ImageVerificationConfig defines the interface to access installer image verification configuration.
+type ImageVerificationConfig interface {
+	PublicKeys() []crypto.PublicKey
+	RequireDigest() bool
+}
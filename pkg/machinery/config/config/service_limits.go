@@ -0,0 +1,19 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import "github.com/siderolabs/gen/optional"
+
+// ServiceLimitsConfig defines the interface to access resource limits of a service.
+type ServiceLimitsConfig interface {
+	// Service returns the ID of the service.
+	Service() string
+	// MilliCPU returns the CPU limit in millicores.
+	MilliCPU() optional.Optional[int64]
+	// Memory returns the memory limit in bytes.
+	Memory() optional.Optional[uint64]
+	// Pids returns the limit of the number of processes.
+	Pids() optional.Optional[int64]
+}
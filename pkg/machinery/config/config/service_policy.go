@@ -0,0 +1,31 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"time"
+
+	"github.com/siderolabs/gen/optional"
+
+	extservices "github.com/siderolabs/talos/pkg/machinery/extensions/services"
+)
+
+// ServicePolicyConfig defines the interface to access the restart policy, health check and startup settings of a service.
+type ServicePolicyConfig interface {
+	// Service returns the ID of the service.
+	Service() string
+	// Restart returns the restart policy of the service.
+	Restart() optional.Optional[extservices.RestartKind]
+	// RestartInterval returns the interval between the restarts of the service.
+	RestartInterval() optional.Optional[time.Duration]
+	// HealthCheckInitialDelay returns the delay before the first health check.
+	HealthCheckInitialDelay() optional.Optional[time.Duration]
+	// HealthCheckPeriod returns the interval between the health checks.
+	HealthCheckPeriod() optional.Optional[time.Duration]
+	// HealthCheckTimeout returns the timeout of a single health check.
+	HealthCheckTimeout() optional.Optional[time.Duration]
+	// StartupTimeout returns the time to wait for the service to be up on boot.
+	StartupTimeout() optional.Optional[time.Duration]
+}
@@ -0,0 +1,30 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"net"
+
+	"github.com/siderolabs/gen/optional"
+)
+
+// SRIOVConfig defines the interface to access SR-IOV configuration of a physical link.
+type SRIOVConfig interface {
+	// Link returns the name of the physical link.
+	Link() string
+	// NumVFs returns the number of virtual functions to enable.
+	NumVFs() int
+	// VirtualFunctions returns the per-VF settings.
+	VirtualFunctions() []SRIOVVirtualFunctionConfig
+}
+
+// SRIOVVirtualFunctionConfig describes the settings of a virtual function, unset values are not changed.
+type SRIOVVirtualFunctionConfig struct {
+	Index        int
+	HardwareAddr optional.Optional[net.HardwareAddr]
+	VLAN         optional.Optional[int]
+	SpoofCheck   optional.Optional[bool]
+	Trust        optional.Optional[bool]
+}
@@ -0,0 +1,33 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import "github.com/siderolabs/gen/optional"
+
+// SystemResourcesConfig defines the interface to access resources reserved for Talos system services.
+type SystemResourcesConfig interface {
+	// ReservedMilliCPU returns reserved CPU in millicores (0 if not set).
+	ReservedMilliCPU() int64
+	// ReservedMemory returns reserved memory in bytes (0 if not set).
+	ReservedMemory() uint64
+	// OOMProtection returns OOM protection overrides for the core services.
+	OOMProtection() []OOMProtectionConfig
+}
+
+// OOMProtectionConfig defines the interface to access OOM protection of a core service.
+type OOMProtectionConfig interface {
+	Service() string
+	OOMScoreAdj() optional.Optional[int]
+	// MemoryMin returns the memory protection in bytes.
+	MemoryMin() optional.Optional[uint64]
+}
+
+// Core services supporting OOM protection overrides.
+const (
+	OOMProtectionServiceMachined   = "machined"
+	OOMProtectionServiceAPID       = "apid"
+	OOMProtectionServiceTrustd     = "trustd"
+	OOMProtectionServiceContainerd = "containerd"
+)
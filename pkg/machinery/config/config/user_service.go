@@ -0,0 +1,26 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package config
+
+import (
+	"github.com/opencontainers/runtime-spec/specs-go"
+
+	extservices "github.com/siderolabs/talos/pkg/machinery/extensions/services"
+)
+
+// UserServiceConfig defines the interface to access a user-defined system service.
+type UserServiceConfig interface {
+	Name() string
+	Image() string
+	// Command overrides the image entrypoint (if not empty).
+	Command() []string
+	// Args overrides the image command (if not empty).
+	Args() []string
+	Environment() []string
+	Mounts() []specs.Mount
+	Restart() extservices.RestartKind
+	// RequiredByKubelet returns true if the kubelet should wait for the service to be up before starting.
+	RequiredByKubelet() bool
+}
@@ -197,6 +197,56 @@ func (container *Container) TrustedRoots() config.TrustedRootsConfig {
 	return config.WrapTrustedRootsConfig(findMatchingDocs[config.TrustedRootsConfig](container.documents)...)
 }
 
+// TPMAttestation implements config.Config interface.
+func (container *Container) TPMAttestation() config.TPMAttestationConfig {
+	matching := findMatchingDocs[config.TPMAttestationConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// TrustdCSRPolicy implements config.Config interface.
+func (container *Container) TrustdCSRPolicy() config.TrustdCSRPolicyConfig {
+	matching := findMatchingDocs[config.TrustdCSRPolicyConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// LSM implements config.Config interface.
+func (container *Container) LSM() config.LSMConfig {
+	matching := findMatchingDocs[config.LSMConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// Seccomp implements config.Config interface.
+func (container *Container) Seccomp() config.SeccompConfig {
+	matching := findMatchingDocs[config.SeccompConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// ImageVerification implements config.Config interface.
+func (container *Container) ImageVerification() config.ImageVerificationConfig {
+	matching := findMatchingDocs[config.ImageVerificationConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
 // Volumes implements config.Config interface.
 func (container *Container) Volumes() config.VolumesConfig {
 	return config.WrapVolumesConfigList(findMatchingDocs[config.VolumeConfig](container.documents)...)
@@ -207,6 +257,76 @@ func (container *Container) KubespanConfig() config.KubespanConfig {
 	return config.WrapKubespanConfig(findMatchingDocs[config.KubespanConfig](container.documents)...)
 }
 
+// LLDP implements config.Config interface.
+func (container *Container) LLDP() config.LLDPConfig {
+	matching := findMatchingDocs[config.LLDPConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// EthernetConfigs implements config.Config interface.
+func (container *Container) EthernetConfigs() []config.EthernetConfig {
+	return findMatchingDocs[config.EthernetConfig](container.documents)
+}
+
+// SRIOVConfigs implements config.Config interface.
+func (container *Container) SRIOVConfigs() []config.SRIOVConfig {
+	return findMatchingDocs[config.SRIOVConfig](container.documents)
+}
+
+// LinkSysctlConfigs implements config.Config interface.
+func (container *Container) LinkSysctlConfigs() []config.LinkSysctlConfig {
+	return findMatchingDocs[config.LinkSysctlConfig](container.documents)
+}
+
+// BGP implements config.Config interface.
+func (container *Container) BGP() config.BGPConfig {
+	matching := findMatchingDocs[config.BGPConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// Performance implements config.Config interface.
+func (container *Container) Performance() config.PerformanceConfig {
+	matching := findMatchingDocs[config.PerformanceConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// SystemResources implements config.Config interface.
+func (container *Container) SystemResources() config.SystemResourcesConfig {
+	matching := findMatchingDocs[config.SystemResourcesConfig](container.documents)
+	if len(matching) == 0 {
+		return nil
+	}
+
+	return matching[0]
+}
+
+// ServiceLimits implements config.Config interface.
+func (container *Container) ServiceLimits() []config.ServiceLimitsConfig {
+	return findMatchingDocs[config.ServiceLimitsConfig](container.documents)
+}
+
+// ServicePolicies implements config.Config interface.
+func (container *Container) ServicePolicies() []config.ServicePolicyConfig {
+	return findMatchingDocs[config.ServicePolicyConfig](container.documents)
+}
+
+// UserServices implements config.Config interface.
+func (container *Container) UserServices() []config.UserServiceConfig {
+	return findMatchingDocs[config.UserServiceConfig](container.documents)
+}
+
 // Bytes returns source YAML representation (if available) or does default encoding.
 func (container *Container) Bytes() ([]byte, error) {
 	if !container.readonly {
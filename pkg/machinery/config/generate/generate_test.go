@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 
 	"github.com/siderolabs/talos/pkg/machinery/client"
@@ -133,6 +135,23 @@ func (suite *GenerateSuite) TestGenerateTalosconfigSuccess() {
 	suite.Equal([]string{string(role.Admin)}, cert.Subject.Organization)
 }
 
+func TestWorkerMachineToken(t *testing.T) {
+	t.Parallel()
+
+	input, err := generate.NewInput("test", "https://10.0.1.5", constants.DefaultKubernetesVersion, generate.WithWorkerMachineToken("abcdef.0123456789abcdef"))
+	require.NoError(t, err)
+
+	worker, err := input.Config(machine.TypeWorker)
+	require.NoError(t, err)
+
+	assert.Equal(t, "abcdef.0123456789abcdef", worker.Machine().Security().Token())
+
+	controlPlane, err := input.Config(machine.TypeControlPlane)
+	require.NoError(t, err)
+
+	assert.Equal(t, input.Options.SecretsBundle.TrustdInfo.Token, controlPlane.Machine().Security().Token())
+}
+
 type runtimeMode struct {
 	requiresInstall bool
 }
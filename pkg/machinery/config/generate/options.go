@@ -270,6 +270,18 @@ func WithHostDNSForwardKubeDNSToHost(forward bool) Option {
 	}
 }
 
+// WithWorkerMachineToken specifies the machine token for the worker machine configuration (e.g. a join token).
+//
+// By default, the worker machine configuration uses the machine token from the secrets bundle.
+// The join token is only used to join the cluster, the worker then renews the certificate with the certificate issued by trustd.
+func WithWorkerMachineToken(token string) Option {
+	return func(o *Options) error {
+		o.WorkerMachineToken = token
+
+		return nil
+	}
+}
+
 // Options describes generate parameters.
 type Options struct {
 	VersionContract *config.VersionContract
@@ -281,6 +293,9 @@ type Options struct {
 	Debug   bool
 	Persist bool
 
+	// Machine token for the worker machine configuration.
+	WorkerMachineToken string
+
 	// Machine settings: install.
 	InstallDisk            string
 	InstallImage           string
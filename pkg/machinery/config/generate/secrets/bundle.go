@@ -355,3 +355,14 @@ func (bundle *Bundle) GenerateTalosAPIClientCertificateWithTTL(roles role.Set, c
 		crtTTL,
 	)
 }
+
+// GenerateScopedTalosAPIClientCertificate generates the admin certificate with specified TTL limited to the specified nodes.
+func (bundle *Bundle) GenerateScopedTalosAPIClientCertificate(roles role.Set, crtTTL time.Duration, nodes []string) (*x509.PEMEncodedCertificateAndKey, error) {
+	return NewScopedAdminCertificateAndKey(
+		bundle.Clock.Now(),
+		bundle.Certs.OS,
+		roles,
+		crtTTL,
+		nodes,
+	)
+}
@@ -6,6 +6,7 @@ package secrets
 
 import (
 	stdx509 "crypto/x509"
+	"crypto/x509/pkix"
 	"time"
 
 	"github.com/siderolabs/crypto/x509"
@@ -64,6 +65,15 @@ func NewTalosCA(currentTime time.Time) (ca *x509.CertificateAuthority, err error
 
 // NewAdminCertificateAndKey generates the admin Talos certificate and key.
 func NewAdminCertificateAndKey(currentTime time.Time, ca *x509.PEMEncodedCertificateAndKey, roles role.Set, ttl time.Duration) (p *x509.PEMEncodedCertificateAndKey, err error) {
+	return NewScopedAdminCertificateAndKey(currentTime, ca, roles, ttl, nil)
+}
+
+// NewScopedAdminCertificateAndKey generates the admin Talos certificate and key limited to the specified nodes.
+//
+// If the list of nodes is empty, the certificate is not limited.
+func NewScopedAdminCertificateAndKey(
+	currentTime time.Time, ca *x509.PEMEncodedCertificateAndKey, roles role.Set, ttl time.Duration, nodes []string,
+) (p *x509.PEMEncodedCertificateAndKey, err error) {
 	opts := []x509.Option{
 		x509.Organization(roles.Strings()...),
 		x509.NotAfter(currentTime.Add(ttl)),
@@ -72,6 +82,12 @@ func NewAdminCertificateAndKey(currentTime time.Time, ca *x509.PEMEncodedCertifi
 		x509.ExtKeyUsage([]stdx509.ExtKeyUsage{stdx509.ExtKeyUsageClientAuth}),
 	}
 
+	if len(nodes) > 0 {
+		opts = append(opts, x509.OverrideSubject(func(subject *pkix.Name) {
+			subject.OrganizationalUnit = role.NodeScopeUnits(nodes)
+		}))
+	}
+
 	talosCA, err := x509.NewCertificateAuthorityFromCertificateAndKey(ca)
 	if err != nil {
 		return nil, err
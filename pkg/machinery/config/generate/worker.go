@@ -33,9 +33,14 @@ func (in *Input) worker() ([]config.Document, error) {
 		}
 	}
 
+	machineToken := in.Options.SecretsBundle.TrustdInfo.Token
+	if in.Options.WorkerMachineToken != "" {
+		machineToken = in.Options.WorkerMachineToken
+	}
+
 	machine := &v1alpha1.MachineConfig{
 		MachineType:     machine.TypeWorker.String(),
-		MachineToken:    in.Options.SecretsBundle.TrustdInfo.Token,
+		MachineToken:    machineToken,
 		MachineCertSANs: in.AdditionalMachineCertSANs,
 		MachineKubelet: &v1alpha1.KubeletConfig{
 			KubeletImage: emptyIf(fmt.Sprintf("%s:v%s", constants.KubeletImage, in.KubernetesVersion), in.KubernetesVersion),
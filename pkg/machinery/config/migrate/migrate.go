@@ -0,0 +1,100 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package migrate upgrades the machine configuration written for the older Talos releases.
+//
+// Each document which implements config.MigratableDocument is migrated step by step (e.g. from the deprecated
+// fields, or to the next API version) until there is nothing left to migrate.
+package migrate
+
+import (
+	"errors"
+	"fmt"
+
+	coreconfig "github.com/siderolabs/talos/pkg/machinery/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/container"
+)
+
+// maxSteps limits the number of migrations of a single document, it protects from the migration cycles.
+const maxSteps = 16
+
+// ErrTooManySteps is returned if the document migration doesn't converge.
+var ErrTooManySteps = errors.New("too many migration steps")
+
+// Documents migrates the documents, and returns the migrated documents with the list of changes.
+//
+// The documents are not modified, the documents which don't need migration are returned as is.
+func Documents(docs []config.Document) ([]config.Document, []string, error) {
+	migrated := make([]config.Document, 0, len(docs))
+
+	var changes []string
+
+	for _, doc := range docs {
+		for step := 0; ; step++ {
+			migratable, ok := doc.(config.MigratableDocument)
+			if !ok {
+				break
+			}
+
+			if step == maxSteps {
+				return nil, nil, fmt.Errorf("%s: %w", documentID(doc), ErrTooManySteps)
+			}
+
+			next, docChanges, err := migratable.Migrate()
+			if err != nil {
+				return nil, nil, fmt.Errorf("%s: %w", documentID(doc), err)
+			}
+
+			if next == nil {
+				break
+			}
+
+			for _, change := range docChanges {
+				changes = append(changes, fmt.Sprintf("%s: %s", documentID(doc), change))
+			}
+
+			doc = next
+		}
+
+		migrated = append(migrated, doc)
+	}
+
+	return migrated, changes, nil
+}
+
+// Config migrates the machine configuration, and returns the migrated configuration with the list of changes.
+//
+// If there is nothing to migrate, the original configuration is returned.
+func Config(cfg coreconfig.Provider) (coreconfig.Provider, []string, error) {
+	docs, changes, err := Documents(cfg.Documents())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if len(changes) == 0 {
+		return cfg, nil, nil
+	}
+
+	migrated, err := container.New(docs...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return migrated, changes, nil
+}
+
+func documentID(doc config.Document) string {
+	id := doc.Kind()
+
+	if doc.APIVersion() != "" {
+		id = doc.APIVersion() + "/" + id
+	}
+
+	if named, ok := doc.(config.NamedDocument); ok && named.Name() != "" {
+		id += "/" + named.Name()
+	}
+
+	return id
+}
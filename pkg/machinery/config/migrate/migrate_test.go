@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package migrate_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/migrate"
+)
+
+const deprecatedConfig = `version: v1alpha1
+machine:
+  type: join
+  install:
+    disk: /dev/sda
+    bootloader: true
+cluster:
+  allowSchedulingOnMasters: true
+  etcd:
+    subnet: 10.0.0.0/8
+---
+apiVersion: v1alpha1
+kind: ExtensionServiceConfig
+name: foo
+environment:
+  - FOO=BAR
+`
+
+func TestConfig(t *testing.T) {
+	t.Parallel()
+
+	cfg, err := configloader.NewFromBytes([]byte(deprecatedConfig))
+	require.NoError(t, err)
+
+	migrated, changes, err := migrate.Config(cfg)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{
+		`v1alpha1: .machine.type: "join" renamed to "worker"`,
+		"v1alpha1: .machine.install.bootloader: removed, it has no effect",
+		"v1alpha1: .cluster.allowSchedulingOnMasters: moved to .cluster.allowSchedulingOnControlPlanes",
+		"v1alpha1: .cluster.etcd.subnet: moved to .cluster.etcd.advertisedSubnets",
+	}, changes)
+
+	raw := migrated.RawV1Alpha1()
+	assert.Equal(t, "worker", raw.MachineConfig.MachineType)
+	assert.Nil(t, raw.MachineConfig.MachineInstall.InstallBootloader) //nolint:staticcheck
+	assert.Nil(t, raw.ClusterConfig.AllowSchedulingOnMasters)         //nolint:staticcheck
+	assert.True(t, migrated.Cluster().ScheduleOnControlPlanes())
+	assert.Equal(t, []string{"10.0.0.0/8"}, migrated.Cluster().Etcd().AdvertisedSubnets())
+	assert.Len(t, migrated.Documents(), 2)
+
+	// the original config is not modified
+	assert.Equal(t, "join", cfg.RawV1Alpha1().MachineConfig.MachineType)
+
+	// the migrated config round-trips, and there is nothing left to migrate
+	migratedBytes, err := migrated.Bytes()
+	require.NoError(t, err)
+
+	reloaded, err := configloader.NewFromBytes(migratedBytes)
+	require.NoError(t, err)
+
+	again, changes, err := migrate.Config(reloaded)
+	require.NoError(t, err)
+	assert.Empty(t, changes)
+	assert.Same(t, reloaded, again)
+}
@@ -0,0 +1,82 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package schemas provides the JSON schema of the machine configuration documents.
+//
+// The schema is generated from the config types with `go generate` in the config package.
+package schemas
+
+import (
+	_ "embed"
+	"encoding/json"
+	"slices"
+	"strings"
+
+	"github.com/siderolabs/talos/pkg/machinery/version"
+)
+
+//go:embed config.schema.json
+var configSchema []byte
+
+// ConfigRootName is the name of the root schema in the OpenAPI document, it matches any config document.
+const ConfigRootName = "Config"
+
+// JSONSchema returns the JSON schema (draft 2020-12) of the machine configuration documents.
+func JSONSchema() []byte {
+	return slices.Clone(configSchema)
+}
+
+// OpenAPI returns the OpenAPI 3.1 document with the schemas of the machine configuration documents.
+//
+// The JSON schema definitions are moved to the components of the document, the root schema is the ConfigRootName component.
+func OpenAPI() ([]byte, error) {
+	var schema map[string]any
+
+	if err := json.Unmarshal(configSchema, &schema); err != nil {
+		return nil, err
+	}
+
+	components, _ := schema["$defs"].(map[string]any) //nolint:errcheck
+	if components == nil {
+		components = map[string]any{}
+	}
+
+	components[ConfigRootName] = map[string]any{
+		"oneOf": schema["oneOf"],
+	}
+
+	rewriteRefs(components)
+
+	return json.MarshalIndent(map[string]any{
+		"openapi": "3.1.0",
+		"info": map[string]any{
+			"title":   "Talos machine configuration",
+			"version": version.Tag,
+		},
+		"paths": map[string]any{},
+		"components": map[string]any{
+			"schemas": components,
+		},
+	}, "", "  ")
+}
+
+// rewriteRefs points the references to the JSON schema definitions to the OpenAPI components.
+func rewriteRefs(v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if ref, ok := value.(string); ok && key == "$ref" {
+				v[key] = "#/components/schemas/" + strings.TrimPrefix(ref, "#/$defs/")
+
+				continue
+			}
+
+			rewriteRefs(value)
+		}
+	case []any:
+		for _, value := range v {
+			rewriteRefs(value)
+		}
+	}
+}
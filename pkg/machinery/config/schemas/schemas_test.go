@@ -0,0 +1,59 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package schemas_test
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/schemas"
+)
+
+func TestJSONSchema(t *testing.T) {
+	t.Parallel()
+
+	var schema struct {
+		Schema string                     `json:"$schema"`
+		Defs   map[string]json.RawMessage `json:"$defs"`
+		OneOf  []map[string]string        `json:"oneOf"`
+	}
+
+	require.NoError(t, json.Unmarshal(schemas.JSONSchema(), &schema))
+
+	assert.Equal(t, "https://json-schema.org/draft/2020-12/schema", schema.Schema)
+	assert.Contains(t, schema.Defs, "v1alpha1.Config")
+	assert.Contains(t, schema.OneOf, map[string]string{"$ref": "#/$defs/v1alpha1.Config"})
+}
+
+func TestOpenAPI(t *testing.T) {
+	t.Parallel()
+
+	data, err := schemas.OpenAPI()
+	require.NoError(t, err)
+
+	var document struct {
+		OpenAPI    string `json:"openapi"`
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+
+	require.NoError(t, json.Unmarshal(data, &document))
+
+	assert.Equal(t, "3.1.0", document.OpenAPI)
+	assert.Contains(t, document.Components.Schemas, schemas.ConfigRootName)
+	assert.Contains(t, document.Components.Schemas, "v1alpha1.Config")
+
+	assert.NotContains(t, string(data), "#/$defs/")
+
+	// all references should resolve to the components
+	for _, match := range regexp.MustCompile(`"\$ref": "#/components/schemas/([^"]+)"`).FindAllStringSubmatch(string(data), -1) {
+		assert.Contains(t, document.Components.Schemas, match[1])
+	}
+}
@@ -0,0 +1,273 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+	"time"
+
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/go-pointer"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// BGPKind is a BGP config document kind.
+const BGPKind = "BGPConfig"
+
+func init() {
+	registry.Register(BGPKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &BGPConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.BGPConfig = &BGPConfigV1Alpha1{}
+	_ config.Validator = &BGPConfigV1Alpha1{}
+)
+
+// BGP defaults.
+const (
+	DefaultBGPHoldTime = 90 * time.Second
+	DefaultBGPPort     = 179
+)
+
+// BGPConfigV1Alpha1 is a config document to announce the routes with the built-in BGP speaker.
+//
+//	examples:
+//	  - value: exampleBGPConfigV1Alpha1()
+//	alias: BGPConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/BGPConfig
+type BGPConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Local autonomous system number.
+	//
+	//     The sessions with the peers in the same autonomous system are internal (iBGP), other sessions are external (eBGP).
+	//   schemaRequired: true
+	BGPASN uint32 `yaml:"asn"`
+	//   description: |
+	//     BGP identifier (router ID) of the machine.
+	//
+	//     If not set, the local IPv4 address of each session is used.
+	BGPRouterID string `yaml:"routerID,omitempty"`
+	//   description: |
+	//     Hold time proposed to the peers, the keepalive messages are sent every third of the negotiated hold time.
+	//
+	//     Default value is 90 seconds, minimum value is 3 seconds.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	BGPHoldTime time.Duration `yaml:"holdTime,omitempty"`
+	//   description: |
+	//     BGP neighbors to announce the routes to.
+	//
+	//     Talos establishes the sessions itself, the peers can be configured as passive.
+	//     The routes received from the peers are ignored.
+	//     The state of the sessions is reported as BGPPeerStatus resources (`talosctl get bgppeers`).
+	//   schemaRequired: true
+	BGPPeers []BGPPeer `yaml:"peers"`
+	//   description: |
+	//     Routes to announce.
+	BGPAnnounce BGPAnnounceConfig `yaml:"announce,omitempty"`
+}
+
+// BGPPeer describes a BGP neighbor.
+type BGPPeer struct {
+	//   description: |
+	//     Address of the peer.
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9a-f.:]+$
+	PeerAddress netip.Addr `yaml:"address"`
+	//   description: |
+	//     TCP port of the peer, defaults to 179.
+	PeerPort uint16 `yaml:"port,omitempty"`
+	//   description: |
+	//     Autonomous system number of the peer.
+	PeerASN uint32 `yaml:"asn"`
+	//   description: |
+	//     Enable BFD (Bidirectional Forwarding Detection) with the peer.
+	//
+	//     The peer should be directly connected, the BGP session is reset when the BFD session goes down.
+	PeerBFD *bool `yaml:"bfd,omitempty"`
+}
+
+// BGPAnnounceConfig describes the routes to announce.
+type BGPAnnounceConfig struct {
+	//   description: |
+	//     Announce the shared (virtual) IPs (`.machine.network.interfaces[].vip`) while the machine holds them.
+	//
+	//     The shared IPs are announced as host routes (/32 or /128).
+	AnnounceVIPs *bool `yaml:"vips,omitempty"`
+	//   description: |
+	//     Prefixes to announce, e.g. the LoadBalancer IP pools.
+	//
+	//     The prefixes are announced only to the peers of the same address family.
+	//   schema:
+	//     type: array
+	//     items:
+	//       type: string
+	//       pattern: ^[0-9a-f.:]+/\d{1,3}$
+	AnnouncePrefixes []netip.Prefix `yaml:"prefixes,omitempty"`
+}
+
+// NewBGPConfigV1Alpha1 creates a new BGP config document.
+func NewBGPConfigV1Alpha1() *BGPConfigV1Alpha1 {
+	return &BGPConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       BGPKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleBGPConfigV1Alpha1() *BGPConfigV1Alpha1 {
+	cfg := NewBGPConfigV1Alpha1()
+	cfg.BGPASN = 64512
+	cfg.BGPPeers = []BGPPeer{
+		{
+			PeerAddress: netip.MustParseAddr("10.0.0.1"),
+			PeerASN:     64500,
+			PeerBFD:     pointer.To(true),
+		},
+	}
+	cfg.BGPAnnounce = BGPAnnounceConfig{
+		AnnounceVIPs:     pointer.To(true),
+		AnnouncePrefixes: []netip.Prefix{netip.MustParsePrefix("192.168.100.0/24")},
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *BGPConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// ASN implements config.BGPConfig interface.
+func (s *BGPConfigV1Alpha1) ASN() uint32 {
+	return s.BGPASN
+}
+
+// RouterID implements config.BGPConfig interface.
+func (s *BGPConfigV1Alpha1) RouterID() optional.Optional[netip.Addr] {
+	// the address is checked in Validate
+	routerID, err := netip.ParseAddr(s.BGPRouterID)
+	if err != nil {
+		return optional.None[netip.Addr]()
+	}
+
+	return optional.Some(routerID)
+}
+
+// HoldTime implements config.BGPConfig interface.
+func (s *BGPConfigV1Alpha1) HoldTime() time.Duration {
+	if s.BGPHoldTime == 0 {
+		return DefaultBGPHoldTime
+	}
+
+	return s.BGPHoldTime
+}
+
+// Peers implements config.BGPConfig interface.
+func (s *BGPConfigV1Alpha1) Peers() []config.BGPPeerConfig {
+	return xslices.Map(s.BGPPeers, func(peer BGPPeer) config.BGPPeerConfig {
+		port := peer.PeerPort
+		if port == 0 {
+			port = DefaultBGPPort
+		}
+
+		return config.BGPPeerConfig{
+			Address: peer.PeerAddress,
+			Port:    port,
+			ASN:     peer.PeerASN,
+			BFD:     pointer.SafeDeref(peer.PeerBFD),
+		}
+	})
+}
+
+// AnnounceVIPs implements config.BGPConfig interface.
+func (s *BGPConfigV1Alpha1) AnnounceVIPs() bool {
+	return pointer.SafeDeref(s.BGPAnnounce.AnnounceVIPs)
+}
+
+// AnnouncePrefixes implements config.BGPConfig interface.
+func (s *BGPConfigV1Alpha1) AnnouncePrefixes() []netip.Prefix {
+	return xslices.Map(s.BGPAnnounce.AnnouncePrefixes, netip.Prefix.Masked)
+}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo
+func (s *BGPConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.BGPASN == 0 {
+		errs = errors.Join(errs, errors.New("asn is required"))
+	}
+
+	if s.BGPRouterID != "" {
+		if routerID, err := netip.ParseAddr(s.BGPRouterID); err != nil || !routerID.Is4() {
+			errs = errors.Join(errs, fmt.Errorf("routerID %q should be an IPv4 address", s.BGPRouterID))
+		}
+	}
+
+	if s.BGPHoldTime != 0 && (s.BGPHoldTime < 3*time.Second || s.BGPHoldTime > 65535*time.Second) {
+		errs = errors.Join(errs, fmt.Errorf("holdTime %s is out of range [3s, 65535s]", s.BGPHoldTime))
+	}
+
+	if len(s.BGPPeers) == 0 {
+		errs = errors.Join(errs, errors.New("at least one peer is required"))
+	}
+
+	var seen []netip.Addr
+
+	for _, peer := range s.BGPPeers {
+		if !peer.PeerAddress.IsValid() {
+			errs = errors.Join(errs, errors.New("peers: address is required"))
+
+			continue
+		}
+
+		if slices.Contains(seen, peer.PeerAddress) {
+			errs = errors.Join(errs, fmt.Errorf("peers: duplicate address %s", peer.PeerAddress))
+		}
+
+		seen = append(seen, peer.PeerAddress)
+
+		if peer.PeerASN == 0 {
+			errs = errors.Join(errs, fmt.Errorf("peers: %s: asn is required", peer.PeerAddress))
+		}
+	}
+
+	for _, prefix := range s.BGPAnnounce.AnnouncePrefixes {
+		if !prefix.IsValid() {
+			errs = errors.Join(errs, errors.New("announce: invalid prefix"))
+		}
+	}
+
+	if !s.AnnounceVIPs() && len(s.BGPAnnounce.AnnouncePrefixes) == 0 {
+		errs = errors.Join(errs, errors.New("announce: nothing to announce, enable vips or add prefixes"))
+	}
+
+	return nil, errs
+}
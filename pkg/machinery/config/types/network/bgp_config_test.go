@@ -0,0 +1,184 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/bgpconfig.yaml
+var expectedBGPConfigDocument []byte
+
+func TestBGPConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewBGPConfigV1Alpha1()
+	cfg.BGPASN = 64512
+	cfg.BGPRouterID = "10.0.0.10"
+	cfg.BGPHoldTime = 30 * time.Second
+	cfg.BGPPeers = []network.BGPPeer{
+		{
+			PeerAddress: netip.MustParseAddr("10.0.0.1"),
+			PeerASN:     64500,
+			PeerBFD:     pointer.To(true),
+		},
+		{
+			PeerAddress: netip.MustParseAddr("2001:db8::1"),
+			PeerPort:    1179,
+			PeerASN:     64512,
+		},
+	}
+	cfg.BGPAnnounce = network.BGPAnnounceConfig{
+		AnnounceVIPs:     pointer.To(true),
+		AnnouncePrefixes: []netip.Prefix{netip.MustParsePrefix("192.168.100.0/24")},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedBGPConfigDocument, marshaled)
+}
+
+func TestBGPConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedBGPConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.BGPConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.BGPKind,
+		},
+		BGPASN:      64512,
+		BGPRouterID: "10.0.0.10",
+		BGPHoldTime: 30 * time.Second,
+		BGPPeers: []network.BGPPeer{
+			{
+				PeerAddress: netip.MustParseAddr("10.0.0.1"),
+				PeerASN:     64500,
+				PeerBFD:     pointer.To(true),
+			},
+			{
+				PeerAddress: netip.MustParseAddr("2001:db8::1"),
+				PeerPort:    1179,
+				PeerASN:     64512,
+			},
+		},
+		BGPAnnounce: network.BGPAnnounceConfig{
+			AnnounceVIPs:     pointer.To(true),
+			AnnouncePrefixes: []netip.Prefix{netip.MustParsePrefix("192.168.100.0/24")},
+		},
+	}, docs[0])
+
+	bgpConfig := provider.BGP()
+	require.NotNil(t, bgpConfig)
+
+	assert.EqualValues(t, 64512, bgpConfig.ASN())
+	assert.Equal(t, optional.Some(netip.MustParseAddr("10.0.0.10")), bgpConfig.RouterID())
+	assert.Equal(t, 30*time.Second, bgpConfig.HoldTime())
+	assert.Equal(t, []config.BGPPeerConfig{
+		{
+			Address: netip.MustParseAddr("10.0.0.1"),
+			Port:    179,
+			ASN:     64500,
+			BFD:     true,
+		},
+		{
+			Address: netip.MustParseAddr("2001:db8::1"),
+			Port:    1179,
+			ASN:     64512,
+		},
+	}, bgpConfig.Peers())
+	assert.True(t, bgpConfig.AnnounceVIPs())
+	assert.Equal(t, []netip.Prefix{netip.MustParsePrefix("192.168.100.0/24")}, bgpConfig.AnnouncePrefixes())
+}
+
+func TestBGPConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.BGPConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name:          "empty",
+			cfg:           network.NewBGPConfigV1Alpha1,
+			expectedError: "asn is required\nat least one peer is required\nannounce: nothing to announce, enable vips or add prefixes",
+		},
+		{
+			name: "invalid",
+			cfg: func() *network.BGPConfigV1Alpha1 {
+				cfg := network.NewBGPConfigV1Alpha1()
+				cfg.BGPASN = 64512
+				cfg.BGPRouterID = "2001:db8::10"
+				cfg.BGPHoldTime = time.Second
+				cfg.BGPPeers = []network.BGPPeer{
+					{
+						PeerAddress: netip.MustParseAddr("10.0.0.1"),
+					},
+					{
+						PeerAddress: netip.MustParseAddr("10.0.0.1"),
+						PeerASN:     64500,
+					},
+					{},
+				}
+				cfg.BGPAnnounce.AnnouncePrefixes = []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+
+				return cfg
+			},
+			expectedError: "routerID \"2001:db8::10\" should be an IPv4 address\nholdTime 1s is out of range [3s, 65535s]\npeers: 10.0.0.1: asn is required\npeers: duplicate address 10.0.0.1\npeers: address is required", //nolint:lll
+		},
+		{
+			name: "valid",
+			cfg: func() *network.BGPConfigV1Alpha1 {
+				cfg := network.NewBGPConfigV1Alpha1()
+				cfg.BGPASN = 4200000000
+				cfg.BGPPeers = []network.BGPPeer{
+					{
+						PeerAddress: netip.MustParseAddr("10.0.0.1"),
+						PeerASN:     64500,
+					},
+				}
+				cfg.BGPAnnounce.AnnounceVIPs = pointer.To(true)
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type DefaultActionConfigV1Alpha1 -type KubespanEndpointsConfigV1Alpha1 -type RuleConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type BGPConfigV1Alpha1 -type DefaultActionConfigV1Alpha1 -type EthernetConfigV1Alpha1 -type KubespanEndpointsConfigV1Alpha1 -type LinkSysctlConfigV1Alpha1 -type LLDPConfigV1Alpha1 -type RuleConfigV1Alpha1 -type SRIOVConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package network
 
@@ -10,12 +10,90 @@ import (
 	"net/netip"
 )
 
+// DeepCopy generates a deep copy of *BGPConfigV1Alpha1.
+func (o *BGPConfigV1Alpha1) DeepCopy() *BGPConfigV1Alpha1 {
+	var cp BGPConfigV1Alpha1 = *o
+	if o.BGPPeers != nil {
+		cp.BGPPeers = make([]BGPPeer, len(o.BGPPeers))
+		copy(cp.BGPPeers, o.BGPPeers)
+		for i2 := range o.BGPPeers {
+			if o.BGPPeers[i2].PeerBFD != nil {
+				cp.BGPPeers[i2].PeerBFD = new(bool)
+				*cp.BGPPeers[i2].PeerBFD = *o.BGPPeers[i2].PeerBFD
+			}
+		}
+	}
+	if o.BGPAnnounce.AnnounceVIPs != nil {
+		cp.BGPAnnounce.AnnounceVIPs = new(bool)
+		*cp.BGPAnnounce.AnnounceVIPs = *o.BGPAnnounce.AnnounceVIPs
+	}
+	if o.BGPAnnounce.AnnouncePrefixes != nil {
+		cp.BGPAnnounce.AnnouncePrefixes = make([]netip.Prefix, len(o.BGPAnnounce.AnnouncePrefixes))
+		copy(cp.BGPAnnounce.AnnouncePrefixes, o.BGPAnnounce.AnnouncePrefixes)
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *DefaultActionConfigV1Alpha1.
 func (o *DefaultActionConfigV1Alpha1) DeepCopy() *DefaultActionConfigV1Alpha1 {
 	var cp DefaultActionConfigV1Alpha1 = *o
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *EthernetConfigV1Alpha1.
+func (o *EthernetConfigV1Alpha1) DeepCopy() *EthernetConfigV1Alpha1 {
+	var cp EthernetConfigV1Alpha1 = *o
+	if o.RingsConfig != nil {
+		cp.RingsConfig = new(EthernetRingsConfig)
+		*cp.RingsConfig = *o.RingsConfig
+		if o.RingsConfig.RingsRX != nil {
+			cp.RingsConfig.RingsRX = new(uint32)
+			*cp.RingsConfig.RingsRX = *o.RingsConfig.RingsRX
+		}
+		if o.RingsConfig.RingsTX != nil {
+			cp.RingsConfig.RingsTX = new(uint32)
+			*cp.RingsConfig.RingsTX = *o.RingsConfig.RingsTX
+		}
+	}
+	if o.ChannelsConfig != nil {
+		cp.ChannelsConfig = new(EthernetChannelsConfig)
+		*cp.ChannelsConfig = *o.ChannelsConfig
+		if o.ChannelsConfig.ChannelsRX != nil {
+			cp.ChannelsConfig.ChannelsRX = new(uint32)
+			*cp.ChannelsConfig.ChannelsRX = *o.ChannelsConfig.ChannelsRX
+		}
+		if o.ChannelsConfig.ChannelsTX != nil {
+			cp.ChannelsConfig.ChannelsTX = new(uint32)
+			*cp.ChannelsConfig.ChannelsTX = *o.ChannelsConfig.ChannelsTX
+		}
+		if o.ChannelsConfig.ChannelsOther != nil {
+			cp.ChannelsConfig.ChannelsOther = new(uint32)
+			*cp.ChannelsConfig.ChannelsOther = *o.ChannelsConfig.ChannelsOther
+		}
+		if o.ChannelsConfig.ChannelsCombined != nil {
+			cp.ChannelsConfig.ChannelsCombined = new(uint32)
+			*cp.ChannelsConfig.ChannelsCombined = *o.ChannelsConfig.ChannelsCombined
+		}
+	}
+	if o.OffloadsConfig != nil {
+		cp.OffloadsConfig = new(EthernetOffloadsConfig)
+		*cp.OffloadsConfig = *o.OffloadsConfig
+		if o.OffloadsConfig.OffloadGRO != nil {
+			cp.OffloadsConfig.OffloadGRO = new(bool)
+			*cp.OffloadsConfig.OffloadGRO = *o.OffloadsConfig.OffloadGRO
+		}
+		if o.OffloadsConfig.OffloadGSO != nil {
+			cp.OffloadsConfig.OffloadGSO = new(bool)
+			*cp.OffloadsConfig.OffloadGSO = *o.OffloadsConfig.OffloadGSO
+		}
+		if o.OffloadsConfig.OffloadTSO != nil {
+			cp.OffloadsConfig.OffloadTSO = new(bool)
+			*cp.OffloadsConfig.OffloadTSO = *o.OffloadsConfig.OffloadTSO
+		}
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *KubespanEndpointsConfigV1Alpha1.
 func (o *KubespanEndpointsConfigV1Alpha1) DeepCopy() *KubespanEndpointsConfigV1Alpha1 {
 	var cp KubespanEndpointsConfigV1Alpha1 = *o
@@ -26,6 +104,38 @@ func (o *KubespanEndpointsConfigV1Alpha1) DeepCopy() *KubespanEndpointsConfigV1A
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *LinkSysctlConfigV1Alpha1.
+func (o *LinkSysctlConfigV1Alpha1) DeepCopy() *LinkSysctlConfigV1Alpha1 {
+	var cp LinkSysctlConfigV1Alpha1 = *o
+	if o.LinkIPv4Forwarding != nil {
+		cp.LinkIPv4Forwarding = new(bool)
+		*cp.LinkIPv4Forwarding = *o.LinkIPv4Forwarding
+	}
+	if o.LinkIPv6Forwarding != nil {
+		cp.LinkIPv6Forwarding = new(bool)
+		*cp.LinkIPv6Forwarding = *o.LinkIPv6Forwarding
+	}
+	if o.LinkProxyARP != nil {
+		cp.LinkProxyARP = new(bool)
+		*cp.LinkProxyARP = *o.LinkProxyARP
+	}
+	if o.LinkARPIgnore != nil {
+		cp.LinkARPIgnore = new(int)
+		*cp.LinkARPIgnore = *o.LinkARPIgnore
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *LLDPConfigV1Alpha1.
+func (o *LLDPConfigV1Alpha1) DeepCopy() *LLDPConfigV1Alpha1 {
+	var cp LLDPConfigV1Alpha1 = *o
+	if o.LLDPInterfaces != nil {
+		cp.LLDPInterfaces = make([]string, len(o.LLDPInterfaces))
+		copy(cp.LLDPInterfaces, o.LLDPInterfaces)
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *RuleConfigV1Alpha1.
 func (o *RuleConfigV1Alpha1) DeepCopy() *RuleConfigV1Alpha1 {
 	var cp RuleConfigV1Alpha1 = *o
@@ -39,3 +149,27 @@ func (o *RuleConfigV1Alpha1) DeepCopy() *RuleConfigV1Alpha1 {
 	}
 	return &cp
 }
+
+// DeepCopy generates a deep copy of *SRIOVConfigV1Alpha1.
+func (o *SRIOVConfigV1Alpha1) DeepCopy() *SRIOVConfigV1Alpha1 {
+	var cp SRIOVConfigV1Alpha1 = *o
+	if o.SRIOVVirtualFunctions != nil {
+		cp.SRIOVVirtualFunctions = make([]SRIOVVirtualFunctionConfig, len(o.SRIOVVirtualFunctions))
+		copy(cp.SRIOVVirtualFunctions, o.SRIOVVirtualFunctions)
+		for i2 := range o.SRIOVVirtualFunctions {
+			if o.SRIOVVirtualFunctions[i2].VFVLAN != nil {
+				cp.SRIOVVirtualFunctions[i2].VFVLAN = new(int)
+				*cp.SRIOVVirtualFunctions[i2].VFVLAN = *o.SRIOVVirtualFunctions[i2].VFVLAN
+			}
+			if o.SRIOVVirtualFunctions[i2].VFSpoofCheck != nil {
+				cp.SRIOVVirtualFunctions[i2].VFSpoofCheck = new(bool)
+				*cp.SRIOVVirtualFunctions[i2].VFSpoofCheck = *o.SRIOVVirtualFunctions[i2].VFSpoofCheck
+			}
+			if o.SRIOVVirtualFunctions[i2].VFTrust != nil {
+				cp.SRIOVVirtualFunctions[i2].VFTrust = new(bool)
+				*cp.SRIOVVirtualFunctions[i2].VFTrust = *o.SRIOVVirtualFunctions[i2].VFTrust
+			}
+		}
+	}
+	return &cp
+}
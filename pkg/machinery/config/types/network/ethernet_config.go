@@ -0,0 +1,221 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/go-pointer"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// EthernetKind is an Ethernet config document kind.
+const EthernetKind = "EthernetConfig"
+
+func init() {
+	registry.Register(EthernetKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &EthernetConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.EthernetConfig = &EthernetConfigV1Alpha1{}
+	_ config.NamedDocument  = &EthernetConfigV1Alpha1{}
+	_ config.Validator      = &EthernetConfigV1Alpha1{}
+)
+
+// EthernetConfigV1Alpha1 is a config document to tune the Ethernet link settings (like `ethtool` does).
+//
+//	examples:
+//	  - value: exampleEthernetConfigV1Alpha1()
+//	alias: EthernetConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/EthernetConfig
+type EthernetConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the link to configure.
+	//
+	//     The settings are applied when the link appears and each time the document changes,
+	//     the current settings are reported as EthernetStatus resources (`talosctl get ethernetstatus`).
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Ring buffer sizes of the link (`ethtool -G`).
+	RingsConfig *EthernetRingsConfig `yaml:"rings,omitempty"`
+	//   description: |
+	//     Queue (channel) counts of the link, used for RSS (`ethtool -L`).
+	ChannelsConfig *EthernetChannelsConfig `yaml:"channels,omitempty"`
+	//   description: |
+	//     Offload toggles of the link (`ethtool -K`).
+	OffloadsConfig *EthernetOffloadsConfig `yaml:"offloads,omitempty"`
+}
+
+// EthernetRingsConfig describes the ring buffer sizes.
+type EthernetRingsConfig struct {
+	//   description: |
+	//     Number of the RX ring entries.
+	RingsRX *uint32 `yaml:"rx,omitempty"`
+	//   description: |
+	//     Number of the TX ring entries.
+	RingsTX *uint32 `yaml:"tx,omitempty"`
+}
+
+// EthernetChannelsConfig describes the queue counts.
+type EthernetChannelsConfig struct {
+	//   description: |
+	//     Number of the RX-only queues.
+	ChannelsRX *uint32 `yaml:"rx,omitempty"`
+	//   description: |
+	//     Number of the TX-only queues.
+	ChannelsTX *uint32 `yaml:"tx,omitempty"`
+	//   description: |
+	//     Number of the queues used for other purposes (e.g. link interrupts).
+	ChannelsOther *uint32 `yaml:"other,omitempty"`
+	//   description: |
+	//     Number of the combined RX/TX queues.
+	ChannelsCombined *uint32 `yaml:"combined,omitempty"`
+}
+
+// EthernetOffloadsConfig describes the offload toggles.
+type EthernetOffloadsConfig struct {
+	//   description: |
+	//     Generic receive offload.
+	OffloadGRO *bool `yaml:"gro,omitempty"`
+	//   description: |
+	//     Generic segmentation offload.
+	OffloadGSO *bool `yaml:"gso,omitempty"`
+	//   description: |
+	//     TCP segmentation offload.
+	OffloadTSO *bool `yaml:"tso,omitempty"`
+}
+
+// NewEthernetConfigV1Alpha1 creates a new Ethernet config document.
+func NewEthernetConfigV1Alpha1(name string) *EthernetConfigV1Alpha1 {
+	return &EthernetConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       EthernetKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+		MetaName: name,
+	}
+}
+
+func exampleEthernetConfigV1Alpha1() *EthernetConfigV1Alpha1 {
+	cfg := NewEthernetConfigV1Alpha1("enp0s2")
+	cfg.RingsConfig = &EthernetRingsConfig{
+		RingsRX: pointer.To[uint32](4096),
+		RingsTX: pointer.To[uint32](4096),
+	}
+	cfg.ChannelsConfig = &EthernetChannelsConfig{
+		ChannelsCombined: pointer.To[uint32](8),
+	}
+	cfg.OffloadsConfig = &EthernetOffloadsConfig{
+		OffloadGRO: pointer.To(true),
+		OffloadTSO: pointer.To(false),
+	}
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *EthernetConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *EthernetConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Link implements config.EthernetConfig interface.
+func (s *EthernetConfigV1Alpha1) Link() string {
+	return s.MetaName
+}
+
+// Rings implements config.EthernetConfig interface.
+func (s *EthernetConfigV1Alpha1) Rings() config.EthernetRingsConfig {
+	if s.RingsConfig == nil {
+		return config.EthernetRingsConfig{}
+	}
+
+	return config.EthernetRingsConfig{
+		RX: optionalValue(s.RingsConfig.RingsRX),
+		TX: optionalValue(s.RingsConfig.RingsTX),
+	}
+}
+
+// Channels implements config.EthernetConfig interface.
+func (s *EthernetConfigV1Alpha1) Channels() config.EthernetChannelsConfig {
+	if s.ChannelsConfig == nil {
+		return config.EthernetChannelsConfig{}
+	}
+
+	return config.EthernetChannelsConfig{
+		RX:       optionalValue(s.ChannelsConfig.ChannelsRX),
+		TX:       optionalValue(s.ChannelsConfig.ChannelsTX),
+		Other:    optionalValue(s.ChannelsConfig.ChannelsOther),
+		Combined: optionalValue(s.ChannelsConfig.ChannelsCombined),
+	}
+}
+
+// Offloads implements config.EthernetConfig interface.
+func (s *EthernetConfigV1Alpha1) Offloads() config.EthernetOffloadsConfig {
+	if s.OffloadsConfig == nil {
+		return config.EthernetOffloadsConfig{}
+	}
+
+	return config.EthernetOffloadsConfig{
+		GRO: optionalValue(s.OffloadsConfig.OffloadGRO),
+		GSO: optionalValue(s.OffloadsConfig.OffloadGSO),
+		TSO: optionalValue(s.OffloadsConfig.OffloadTSO),
+	}
+}
+
+// Validate implements config.Validator interface.
+func (s *EthernetConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.MetaName == "" {
+		errs = errors.Join(errs, errors.New("name is required"))
+	}
+
+	if s.RingsConfig == nil && s.ChannelsConfig == nil && s.OffloadsConfig == nil {
+		errs = errors.Join(errs, errors.New("either rings, channels or offloads should be set"))
+	}
+
+	if s.RingsConfig != nil {
+		for _, size := range []*uint32{s.RingsConfig.RingsRX, s.RingsConfig.RingsTX} {
+			if size != nil && *size == 0 {
+				errs = errors.Join(errs, errors.New("rings: ring size should be positive"))
+
+				break
+			}
+		}
+	}
+
+	return nil, errs
+}
+
+func optionalValue[T any](v *T) optional.Optional[T] {
+	if v == nil {
+		return optional.None[T]()
+	}
+
+	return optional.Some(*v)
+}
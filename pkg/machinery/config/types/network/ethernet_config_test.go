@@ -0,0 +1,149 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/ethernetconfig.yaml
+var expectedEthernetConfigDocument []byte
+
+func TestEthernetConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewEthernetConfigV1Alpha1("enp0s2")
+	cfg.RingsConfig = &network.EthernetRingsConfig{
+		RingsRX: pointer.To[uint32](4096),
+		RingsTX: pointer.To[uint32](4096),
+	}
+	cfg.ChannelsConfig = &network.EthernetChannelsConfig{
+		ChannelsCombined: pointer.To[uint32](8),
+	}
+	cfg.OffloadsConfig = &network.EthernetOffloadsConfig{
+		OffloadGRO: pointer.To(true),
+		OffloadTSO: pointer.To(false),
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedEthernetConfigDocument, marshaled)
+}
+
+func TestEthernetConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedEthernetConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.EthernetConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.EthernetKind,
+		},
+		MetaName: "enp0s2",
+		RingsConfig: &network.EthernetRingsConfig{
+			RingsRX: pointer.To[uint32](4096),
+			RingsTX: pointer.To[uint32](4096),
+		},
+		ChannelsConfig: &network.EthernetChannelsConfig{
+			ChannelsCombined: pointer.To[uint32](8),
+		},
+		OffloadsConfig: &network.EthernetOffloadsConfig{
+			OffloadGRO: pointer.To(true),
+			OffloadTSO: pointer.To(false),
+		},
+	}, docs[0])
+
+	ethernetConfigs := provider.EthernetConfigs()
+	require.Len(t, ethernetConfigs, 1)
+
+	assert.Equal(t, "enp0s2", ethernetConfigs[0].Link())
+	assert.Equal(t, config.EthernetRingsConfig{
+		RX: optional.Some[uint32](4096),
+		TX: optional.Some[uint32](4096),
+	}, ethernetConfigs[0].Rings())
+	assert.Equal(t, config.EthernetChannelsConfig{
+		Combined: optional.Some[uint32](8),
+	}, ethernetConfigs[0].Channels())
+	assert.Equal(t, config.EthernetOffloadsConfig{
+		GRO: optional.Some(true),
+		TSO: optional.Some(false),
+	}, ethernetConfigs[0].Offloads())
+}
+
+func TestEthernetConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.EthernetConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg: func() *network.EthernetConfigV1Alpha1 {
+				return network.NewEthernetConfigV1Alpha1("")
+			},
+
+			expectedError: "name is required\neither rings, channels or offloads should be set",
+		},
+		{
+			name: "zero ring size",
+			cfg: func() *network.EthernetConfigV1Alpha1 {
+				cfg := network.NewEthernetConfigV1Alpha1("eth0")
+				cfg.RingsConfig = &network.EthernetRingsConfig{
+					RingsRX: pointer.To[uint32](0),
+				}
+
+				return cfg
+			},
+
+			expectedError: "rings: ring size should be positive",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.EthernetConfigV1Alpha1 {
+				cfg := network.NewEthernetConfigV1Alpha1("eth0")
+				cfg.OffloadsConfig = &network.EthernetOffloadsConfig{
+					OffloadGSO: pointer.To(false),
+				}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
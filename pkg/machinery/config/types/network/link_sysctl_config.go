@@ -0,0 +1,188 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/go-pointer"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// LinkSysctlKind is a link sysctl config document kind.
+const LinkSysctlKind = "LinkSysctlConfig"
+
+func init() {
+	registry.Register(LinkSysctlKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &LinkSysctlConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.LinkSysctlConfig = &LinkSysctlConfigV1Alpha1{}
+	_ config.NamedDocument    = &LinkSysctlConfigV1Alpha1{}
+	_ config.Validator        = &LinkSysctlConfigV1Alpha1{}
+)
+
+// Reverse path filtering modes.
+const (
+	RPFilterOff    = "off"
+	RPFilterStrict = "strict"
+	RPFilterLoose  = "loose"
+)
+
+var rpFilterModes = []string{RPFilterOff, RPFilterStrict, RPFilterLoose}
+
+// ARP ignore modes allowed by the kernel.
+var arpIgnoreModes = []int{0, 1, 2, 3, 8}
+
+// LinkSysctlConfigV1Alpha1 is a config document to set the kernel network parameters of a link.
+//
+//	examples:
+//	  - value: exampleLinkSysctlConfigV1Alpha1()
+//	alias: LinkSysctlConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/LinkSysctlConfig
+type LinkSysctlConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the link to configure.
+	//
+	//     The parameters are applied once the link appears, unset parameters keep the kernel defaults.
+	//     The same parameters should not be set in `.machine.sysctls`, which take precedence.
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Enable IPv4 forwarding on the link (`net.ipv4.conf.<link>.forwarding`).
+	LinkIPv4Forwarding *bool `yaml:"ipv4Forwarding,omitempty"`
+	//   description: |
+	//     Enable IPv6 forwarding on the link (`net.ipv6.conf.<link>.forwarding`).
+	LinkIPv6Forwarding *bool `yaml:"ipv6Forwarding,omitempty"`
+	//   description: |
+	//     Reverse path filtering mode (`net.ipv4.conf.<link>.rp_filter`).
+	//   values:
+	//     - "off"
+	//     - "strict"
+	//     - "loose"
+	LinkRPFilter string `yaml:"rpFilter,omitempty"`
+	//   description: |
+	//     Answer ARP requests on behalf of the hosts reachable via other links (`net.ipv4.conf.<link>.proxy_arp`).
+	LinkProxyARP *bool `yaml:"proxyARP,omitempty"`
+	//   description: |
+	//     Mode of replying to the ARP requests (`net.ipv4.conf.<link>.arp_ignore`).
+	//
+	//     See the kernel `ip-sysctl` documentation for the meaning of the values.
+	//   values:
+	//     - 0
+	//     - 1
+	//     - 2
+	//     - 3
+	//     - 8
+	LinkARPIgnore *int `yaml:"arpIgnore,omitempty"`
+}
+
+// NewLinkSysctlConfigV1Alpha1 creates a new link sysctl config document.
+func NewLinkSysctlConfigV1Alpha1(name string) *LinkSysctlConfigV1Alpha1 {
+	return &LinkSysctlConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       LinkSysctlKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+		MetaName: name,
+	}
+}
+
+func exampleLinkSysctlConfigV1Alpha1() *LinkSysctlConfigV1Alpha1 {
+	cfg := NewLinkSysctlConfigV1Alpha1("eth1")
+	cfg.LinkIPv4Forwarding = pointer.To(true)
+	cfg.LinkRPFilter = RPFilterLoose
+	cfg.LinkProxyARP = pointer.To(true)
+	cfg.LinkARPIgnore = pointer.To(1)
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *LinkSysctlConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *LinkSysctlConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Link implements config.LinkSysctlConfig interface.
+func (s *LinkSysctlConfigV1Alpha1) Link() string {
+	return s.MetaName
+}
+
+// IPv4Forwarding implements config.LinkSysctlConfig interface.
+func (s *LinkSysctlConfigV1Alpha1) IPv4Forwarding() optional.Optional[bool] {
+	return optionalValue(s.LinkIPv4Forwarding)
+}
+
+// IPv6Forwarding implements config.LinkSysctlConfig interface.
+func (s *LinkSysctlConfigV1Alpha1) IPv6Forwarding() optional.Optional[bool] {
+	return optionalValue(s.LinkIPv6Forwarding)
+}
+
+// RPFilter implements config.LinkSysctlConfig interface.
+func (s *LinkSysctlConfigV1Alpha1) RPFilter() optional.Optional[int] {
+	// the index matches the kernel value
+	if idx := slices.Index(rpFilterModes, s.LinkRPFilter); idx >= 0 {
+		return optional.Some(idx)
+	}
+
+	return optional.None[int]()
+}
+
+// ProxyARP implements config.LinkSysctlConfig interface.
+func (s *LinkSysctlConfigV1Alpha1) ProxyARP() optional.Optional[bool] {
+	return optionalValue(s.LinkProxyARP)
+}
+
+// ARPIgnore implements config.LinkSysctlConfig interface.
+func (s *LinkSysctlConfigV1Alpha1) ARPIgnore() optional.Optional[int] {
+	return optionalValue(s.LinkARPIgnore)
+}
+
+// Validate implements config.Validator interface.
+func (s *LinkSysctlConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.MetaName == "" {
+		errs = errors.Join(errs, errors.New("name is required"))
+	}
+
+	if s.LinkIPv4Forwarding == nil && s.LinkIPv6Forwarding == nil && s.LinkRPFilter == "" && s.LinkProxyARP == nil && s.LinkARPIgnore == nil {
+		errs = errors.Join(errs, errors.New("at least one parameter should be set"))
+	}
+
+	if s.LinkRPFilter != "" && !slices.Contains(rpFilterModes, s.LinkRPFilter) {
+		errs = errors.Join(errs, fmt.Errorf("rpFilter should be one of %q", rpFilterModes))
+	}
+
+	if s.LinkARPIgnore != nil && !slices.Contains(arpIgnoreModes, *s.LinkARPIgnore) {
+		errs = errors.Join(errs, fmt.Errorf("arpIgnore should be one of %v", arpIgnoreModes))
+	}
+
+	return nil, errs
+}
@@ -0,0 +1,126 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/linksysctlconfig.yaml
+var expectedLinkSysctlConfigDocument []byte
+
+func TestLinkSysctlConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewLinkSysctlConfigV1Alpha1("eth1")
+	cfg.LinkIPv4Forwarding = pointer.To(true)
+	cfg.LinkRPFilter = network.RPFilterLoose
+	cfg.LinkProxyARP = pointer.To(true)
+	cfg.LinkARPIgnore = pointer.To(1)
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedLinkSysctlConfigDocument, marshaled)
+}
+
+func TestLinkSysctlConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedLinkSysctlConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.LinkSysctlConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.LinkSysctlKind,
+		},
+		MetaName:           "eth1",
+		LinkIPv4Forwarding: pointer.To(true),
+		LinkRPFilter:       network.RPFilterLoose,
+		LinkProxyARP:       pointer.To(true),
+		LinkARPIgnore:      pointer.To(1),
+	}, docs[0])
+
+	linkSysctlConfigs := provider.LinkSysctlConfigs()
+	require.Len(t, linkSysctlConfigs, 1)
+
+	assert.Equal(t, "eth1", linkSysctlConfigs[0].Link())
+	assert.Equal(t, optional.Some(true), linkSysctlConfigs[0].IPv4Forwarding())
+	assert.Equal(t, optional.None[bool](), linkSysctlConfigs[0].IPv6Forwarding())
+	assert.Equal(t, optional.Some(2), linkSysctlConfigs[0].RPFilter())
+	assert.Equal(t, optional.Some(true), linkSysctlConfigs[0].ProxyARP())
+	assert.Equal(t, optional.Some(1), linkSysctlConfigs[0].ARPIgnore())
+}
+
+func TestLinkSysctlConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.LinkSysctlConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg: func() *network.LinkSysctlConfigV1Alpha1 {
+				return network.NewLinkSysctlConfigV1Alpha1("")
+			},
+
+			expectedError: "name is required\nat least one parameter should be set",
+		},
+		{
+			name: "invalid values",
+			cfg: func() *network.LinkSysctlConfigV1Alpha1 {
+				cfg := network.NewLinkSysctlConfigV1Alpha1("eth0")
+				cfg.LinkRPFilter = "lose"
+				cfg.LinkARPIgnore = pointer.To(4)
+
+				return cfg
+			},
+
+			expectedError: "rpFilter should be one of [\"off\" \"strict\" \"loose\"]\narpIgnore should be one of [0 1 2 3 8]",
+		},
+		{
+			name: "valid",
+			cfg: func() *network.LinkSysctlConfigV1Alpha1 {
+				cfg := network.NewLinkSysctlConfigV1Alpha1("eth0.100")
+				cfg.LinkIPv6Forwarding = pointer.To(false)
+				cfg.LinkRPFilter = network.RPFilterOff
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,95 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// LLDPKind is a LLDP config document kind.
+const LLDPKind = "LLDPConfig"
+
+func init() {
+	registry.Register(LLDPKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &LLDPConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.LLDPConfig = &LLDPConfigV1Alpha1{}
+	_ config.Validator  = &LLDPConfigV1Alpha1{}
+)
+
+// LLDPConfigV1Alpha1 is a config document to enable LLDP neighbor discovery.
+//
+//	examples:
+//	  - value: exampleLLDPConfigV1Alpha1()
+//	alias: LLDPConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/LLDPConfig
+type LLDPConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Names of the links to listen for LLDP advertisements on.
+	//
+	//     If not set, Talos listens on all physical links.
+	//     The neighbors advertised by the connected switches are reported as LLDPNeighbor resources
+	//     (`talosctl get lldpneighbors`).
+	//     Talos doesn't send LLDP advertisements itself.
+	//   examples:
+	//     - value: >
+	//        []string{"eth0", "eth1"}
+	LLDPInterfaces []string `yaml:"interfaces,omitempty"`
+}
+
+// NewLLDPConfigV1Alpha1 creates a new LLDP config document.
+func NewLLDPConfigV1Alpha1() *LLDPConfigV1Alpha1 {
+	return &LLDPConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       LLDPKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleLLDPConfigV1Alpha1() *LLDPConfigV1Alpha1 {
+	cfg := NewLLDPConfigV1Alpha1()
+	cfg.LLDPInterfaces = []string{"eth0", "eth1"}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *LLDPConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Interfaces implements config.LLDPConfig interface.
+func (s *LLDPConfigV1Alpha1) Interfaces() []string {
+	return slices.Clone(s.LLDPInterfaces)
+}
+
+// Validate implements config.Validator interface.
+func (s *LLDPConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	if slices.Contains(s.LLDPInterfaces, "") {
+		return nil, errors.New("interface name can't be empty")
+	}
+
+	return nil, nil
+}
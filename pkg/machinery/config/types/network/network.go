@@ -5,6 +5,6 @@
 // Package network provides network machine configuration documents.
 package network
 
-//go:generate docgen -output network_doc.go network.go default_action_config.go kubespan_endpoints.go port_range.go rule_config.go
+//go:generate docgen -output network_doc.go network.go bgp_config.go default_action_config.go ethernet_config.go kubespan_endpoints.go link_sysctl_config.go lldp_config.go port_range.go rule_config.go sriov_config.go
 
-//go:generate deep-copy -type DefaultActionConfigV1Alpha1 -type KubespanEndpointsConfigV1Alpha1 -type RuleConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate deep-copy -type BGPConfigV1Alpha1 -type DefaultActionConfigV1Alpha1 -type EthernetConfigV1Alpha1 -type KubespanEndpointsConfigV1Alpha1 -type LinkSysctlConfigV1Alpha1 -type LLDPConfigV1Alpha1 -type RuleConfigV1Alpha1 -type SRIOVConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
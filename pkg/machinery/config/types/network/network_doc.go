@@ -12,6 +12,134 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
 )
 
+func (BGPConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "BGPConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "BGPConfig is a config document to announce the routes with the built-in BGP speaker." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "BGPConfig is a config document to announce the routes with the built-in BGP speaker.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "asn",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Local autonomous system number.\n\nThe sessions with the peers in the same autonomous system are internal (iBGP), other sessions are external (eBGP).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Local autonomous system number." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "routerID",
+				Type:        "string",
+				Note:        "",
+				Description: "BGP identifier (router ID) of the machine.\n\nIf not set, the local IPv4 address of each session is used.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "BGP identifier (router ID) of the machine." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "holdTime",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Hold time proposed to the peers, the keepalive messages are sent every third of the negotiated hold time.\n\nDefault value is 90 seconds, minimum value is 3 seconds.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Hold time proposed to the peers, the keepalive messages are sent every third of the negotiated hold time." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "peers",
+				Type:        "[]BGPPeer",
+				Note:        "",
+				Description: "BGP neighbors to announce the routes to.\n\nTalos establishes the sessions itself, the peers can be configured as passive.\nThe routes received from the peers are ignored.\nThe state of the sessions is reported as BGPPeerStatus resources (`talosctl get bgppeers`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "BGP neighbors to announce the routes to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "announce",
+				Type:        "BGPAnnounceConfig",
+				Note:        "",
+				Description: "Routes to announce.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Routes to announce." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleBGPConfigV1Alpha1())
+
+	return doc
+}
+
+func (BGPPeer) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "BGPPeer",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "BGPPeer describes a BGP neighbor." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "BGPPeer describes a BGP neighbor.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "BGPConfigV1Alpha1",
+				FieldName: "peers",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "address",
+				Type:        "Addr",
+				Note:        "",
+				Description: "Address of the peer.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Address of the peer." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "port",
+				Type:        "uint16",
+				Note:        "",
+				Description: "TCP port of the peer, defaults to 179.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "TCP port of the peer, defaults to 179." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "asn",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Autonomous system number of the peer.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Autonomous system number of the peer." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "bfd",
+				Type:        "bool",
+				Note:        "",
+				Description: "Enable BFD (Bidirectional Forwarding Detection) with the peer.\n\nThe peer should be directly connected, the BGP session is reset when the BFD session goes down.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable BFD (Bidirectional Forwarding Detection) with the peer." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (BGPAnnounceConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "BGPAnnounceConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "BGPAnnounceConfig describes the routes to announce." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "BGPAnnounceConfig describes the routes to announce.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "BGPConfigV1Alpha1",
+				FieldName: "announce",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "vips",
+				Type:        "bool",
+				Note:        "",
+				Description: "Announce the shared (virtual) IPs (`.machine.network.interfaces[].vip`) while the machine holds them.\n\nThe shared IPs are announced as host routes (/32 or /128).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Announce the shared (virtual) IPs (`.machine.network.interfaces[].vip`) while the machine holds them." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "prefixes",
+				Type:        "[]Prefix",
+				Note:        "",
+				Description: "Prefixes to announce, e.g. the LoadBalancer IP pools.\n\nThe prefixes are announced only to the peers of the same address family.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Prefixes to announce, e.g. the LoadBalancer IP pools." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
 func (DefaultActionConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "NetworkDefaultActionConfig",
@@ -37,6 +165,166 @@ func (DefaultActionConfigV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (EthernetConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EthernetConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EthernetConfig is a config document to tune the Ethernet link settings (like `ethtool` does)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EthernetConfig is a config document to tune the Ethernet link settings (like `ethtool` does).",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the link to configure.\n\nThe settings are applied when the link appears and each time the document changes,\nthe current settings are reported as EthernetStatus resources (`talosctl get ethernetstatus`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link to configure." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "rings",
+				Type:        "EthernetRingsConfig",
+				Note:        "",
+				Description: "Ring buffer sizes of the link (`ethtool -G`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Ring buffer sizes of the link (`ethtool -G`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "channels",
+				Type:        "EthernetChannelsConfig",
+				Note:        "",
+				Description: "Queue (channel) counts of the link, used for RSS (`ethtool -L`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Queue (channel) counts of the link, used for RSS (`ethtool -L`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "offloads",
+				Type:        "EthernetOffloadsConfig",
+				Note:        "",
+				Description: "Offload toggles of the link (`ethtool -K`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Offload toggles of the link (`ethtool -K`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleEthernetConfigV1Alpha1())
+
+	return doc
+}
+
+func (EthernetRingsConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EthernetRingsConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EthernetRingsConfig describes the ring buffer sizes." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EthernetRingsConfig describes the ring buffer sizes.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EthernetConfigV1Alpha1",
+				FieldName: "rings",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "rx",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Number of the RX ring entries.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of the RX ring entries." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "tx",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Number of the TX ring entries.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of the TX ring entries." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (EthernetChannelsConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EthernetChannelsConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EthernetChannelsConfig describes the queue counts." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EthernetChannelsConfig describes the queue counts.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EthernetConfigV1Alpha1",
+				FieldName: "channels",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "rx",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Number of the RX-only queues.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of the RX-only queues." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "tx",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Number of the TX-only queues.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of the TX-only queues." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "other",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Number of the queues used for other purposes (e.g. link interrupts).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of the queues used for other purposes (e.g. link interrupts)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "combined",
+				Type:        "uint32",
+				Note:        "",
+				Description: "Number of the combined RX/TX queues.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of the combined RX/TX queues." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (EthernetOffloadsConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "EthernetOffloadsConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "EthernetOffloadsConfig describes the offload toggles." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "EthernetOffloadsConfig describes the offload toggles.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "EthernetConfigV1Alpha1",
+				FieldName: "offloads",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "gro",
+				Type:        "bool",
+				Note:        "",
+				Description: "Generic receive offload.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Generic receive offload." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "gso",
+				Type:        "bool",
+				Note:        "",
+				Description: "Generic segmentation offload.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Generic segmentation offload." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "tso",
+				Type:        "bool",
+				Note:        "",
+				Description: "TCP segmentation offload.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "TCP segmentation offload." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
 func (KubespanEndpointsConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "KubeSpanEndpointsConfig",
@@ -58,6 +346,98 @@ func (KubespanEndpointsConfigV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (LinkSysctlConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "LinkSysctlConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "LinkSysctlConfig is a config document to set the kernel network parameters of a link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "LinkSysctlConfig is a config document to set the kernel network parameters of a link.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the link to configure.\n\nThe parameters are applied once the link appears, unset parameters keep the kernel defaults.\nThe same parameters should not be set in `.machine.sysctls`, which take precedence.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the link to configure." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ipv4Forwarding",
+				Type:        "bool",
+				Note:        "",
+				Description: "Enable IPv4 forwarding on the link (`net.ipv4.conf.<link>.forwarding`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable IPv4 forwarding on the link (`net.ipv4.conf.<link>.forwarding`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "ipv6Forwarding",
+				Type:        "bool",
+				Note:        "",
+				Description: "Enable IPv6 forwarding on the link (`net.ipv6.conf.<link>.forwarding`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable IPv6 forwarding on the link (`net.ipv6.conf.<link>.forwarding`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "rpFilter",
+				Type:        "string",
+				Note:        "",
+				Description: "Reverse path filtering mode (`net.ipv4.conf.<link>.rp_filter`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Reverse path filtering mode (`net.ipv4.conf.<link>.rp_filter`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"off",
+					"strict",
+					"loose",
+				},
+			},
+			{
+				Name:        "proxyARP",
+				Type:        "bool",
+				Note:        "",
+				Description: "Answer ARP requests on behalf of the hosts reachable via other links (`net.ipv4.conf.<link>.proxy_arp`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Answer ARP requests on behalf of the hosts reachable via other links (`net.ipv4.conf.<link>.proxy_arp`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "arpIgnore",
+				Type:        "int",
+				Note:        "",
+				Description: "Mode of replying to the ARP requests (`net.ipv4.conf.<link>.arp_ignore`).\n\nSee the kernel `ip-sysctl` documentation for the meaning of the values.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Mode of replying to the ARP requests (`net.ipv4.conf.<link>.arp_ignore`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"0",
+					"1",
+					"2",
+					"3",
+					"8",
+				},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleLinkSysctlConfigV1Alpha1())
+
+	return doc
+}
+
+func (LLDPConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "LLDPConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "LLDPConfig is a config document to enable LLDP neighbor discovery." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "LLDPConfig is a config document to enable LLDP neighbor discovery.",
+		Fields: []encoder.Doc{
+			{}, {
+				Name:        "interfaces",
+				Type:        "[]string",
+				Note:        "",
+				Description: "Names of the links to listen for LLDP advertisements on.\n\nIf not set, Talos listens on all physical links.\nThe neighbors advertised by the connected switches are reported as LLDPNeighbor resources\n(`talosctl get lldpneighbors`).\nTalos doesn't send LLDP advertisements itself.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Names of the links to listen for LLDP advertisements on." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleLLDPConfigV1Alpha1())
+
+	doc.Fields[1].AddExample("", []string{"eth0", "eth1"})
+
+	return doc
+}
+
 func (RuleConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "NetworkRuleConfig",
@@ -171,17 +551,117 @@ func (IngressRule) Doc() *encoder.Doc {
 	return doc
 }
 
+func (SRIOVConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "SRIOVConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "SRIOVConfig is a config document to enable the SR-IOV virtual functions of a physical link." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "SRIOVConfig is a config document to enable the SR-IOV virtual functions of a physical link.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the physical link (physical function) to configure.\n\nThe virtual functions are enabled when the link appears, and the virtual functions\nof all SR-IOV capable links are reported as VirtualFunction resources (`talosctl get virtualfunctions`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the physical link (physical function) to configure." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "numVFs",
+				Type:        "int",
+				Note:        "",
+				Description: "Number of the virtual functions to enable.\n\nChanging the number of the virtual functions re-creates all virtual functions of the link.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of the virtual functions to enable." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "vfs",
+				Type:        "[]SRIOVVirtualFunctionConfig",
+				Note:        "",
+				Description: "Settings of the individual virtual functions.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Settings of the individual virtual functions." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleSRIOVConfigV1Alpha1())
+
+	return doc
+}
+
+func (SRIOVVirtualFunctionConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "SRIOVVirtualFunctionConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "SRIOVVirtualFunctionConfig describes the settings of a virtual function." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "SRIOVVirtualFunctionConfig describes the settings of a virtual function.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "SRIOVConfigV1Alpha1",
+				FieldName: "vfs",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "index",
+				Type:        "int",
+				Note:        "",
+				Description: "Index of the virtual function (starting from zero).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Index of the virtual function (starting from zero)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "hardwareAddr",
+				Type:        "string",
+				Note:        "",
+				Description: "Hardware (MAC) address of the virtual function.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Hardware (MAC) address of the virtual function." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "vlan",
+				Type:        "int",
+				Note:        "",
+				Description: "VLAN ID to tag the traffic of the virtual function with, zero disables VLAN tagging.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "VLAN ID to tag the traffic of the virtual function with, zero disables VLAN tagging." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "spoofCheck",
+				Type:        "bool",
+				Note:        "",
+				Description: "Enable the source address spoof checking.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable the source address spoof checking." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "trust",
+				Type:        "bool",
+				Note:        "",
+				Description: "Enable the trusted mode (allows the virtual function to change its MAC address and to enable promiscuous mode).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable the trusted mode (allows the virtual function to change its MAC address and to enable promiscuous mode)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
 // GetFileDoc returns documentation for the file network_doc.go.
 func GetFileDoc() *encoder.FileDoc {
 	return &encoder.FileDoc{
 		Name:        "network",
 		Description: "Package network provides network machine configuration documents.\n",
 		Structs: []*encoder.Doc{
+			BGPConfigV1Alpha1{}.Doc(),
+			BGPPeer{}.Doc(),
+			BGPAnnounceConfig{}.Doc(),
 			DefaultActionConfigV1Alpha1{}.Doc(),
+			EthernetConfigV1Alpha1{}.Doc(),
+			EthernetRingsConfig{}.Doc(),
+			EthernetChannelsConfig{}.Doc(),
+			EthernetOffloadsConfig{}.Doc(),
 			KubespanEndpointsConfigV1Alpha1{}.Doc(),
+			LinkSysctlConfigV1Alpha1{}.Doc(),
+			LLDPConfigV1Alpha1{}.Doc(),
 			RuleConfigV1Alpha1{}.Doc(),
 			RulePortSelector{}.Doc(),
 			IngressRule{}.Doc(),
+			SRIOVConfigV1Alpha1{}.Doc(),
+			SRIOVVirtualFunctionConfig{}.Doc(),
 		},
 	}
 }
@@ -0,0 +1,199 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/go-pointer"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// SRIOVKind is a SR-IOV config document kind.
+const SRIOVKind = "SRIOVConfig"
+
+func init() {
+	registry.Register(SRIOVKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &SRIOVConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.SRIOVConfig   = &SRIOVConfigV1Alpha1{}
+	_ config.NamedDocument = &SRIOVConfigV1Alpha1{}
+	_ config.Validator     = &SRIOVConfigV1Alpha1{}
+)
+
+// SRIOVConfigV1Alpha1 is a config document to enable the SR-IOV virtual functions of a physical link.
+//
+//	examples:
+//	  - value: exampleSRIOVConfigV1Alpha1()
+//	alias: SRIOVConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/SRIOVConfig
+type SRIOVConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the physical link (physical function) to configure.
+	//
+	//     The virtual functions are enabled when the link appears, and the virtual functions
+	//     of all SR-IOV capable links are reported as VirtualFunction resources (`talosctl get virtualfunctions`).
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Number of the virtual functions to enable.
+	//
+	//     Changing the number of the virtual functions re-creates all virtual functions of the link.
+	//   schemaRequired: true
+	SRIOVNumVFs int `yaml:"numVFs"`
+	//   description: |
+	//     Settings of the individual virtual functions.
+	SRIOVVirtualFunctions []SRIOVVirtualFunctionConfig `yaml:"vfs,omitempty"`
+}
+
+// SRIOVVirtualFunctionConfig describes the settings of a virtual function.
+type SRIOVVirtualFunctionConfig struct {
+	//   description: |
+	//     Index of the virtual function (starting from zero).
+	VFIndex int `yaml:"index"`
+	//   description: |
+	//     Hardware (MAC) address of the virtual function.
+	VFHardwareAddr string `yaml:"hardwareAddr,omitempty"`
+	//   description: |
+	//     VLAN ID to tag the traffic of the virtual function with, zero disables VLAN tagging.
+	VFVLAN *int `yaml:"vlan,omitempty"`
+	//   description: |
+	//     Enable the source address spoof checking.
+	VFSpoofCheck *bool `yaml:"spoofCheck,omitempty"`
+	//   description: |
+	//     Enable the trusted mode (allows the virtual function to change its MAC address and to enable promiscuous mode).
+	VFTrust *bool `yaml:"trust,omitempty"`
+}
+
+// NewSRIOVConfigV1Alpha1 creates a new SR-IOV config document.
+func NewSRIOVConfigV1Alpha1(name string) *SRIOVConfigV1Alpha1 {
+	return &SRIOVConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       SRIOVKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+		MetaName: name,
+	}
+}
+
+func exampleSRIOVConfigV1Alpha1() *SRIOVConfigV1Alpha1 {
+	cfg := NewSRIOVConfigV1Alpha1("enp1s0f0")
+	cfg.SRIOVNumVFs = 4
+	cfg.SRIOVVirtualFunctions = []SRIOVVirtualFunctionConfig{
+		{
+			VFIndex:        0,
+			VFHardwareAddr: "02:00:00:00:00:01",
+			VFVLAN:         pointer.To(100),
+			VFSpoofCheck:   pointer.To(false),
+			VFTrust:        pointer.To(true),
+		},
+	}
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *SRIOVConfigV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *SRIOVConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Link implements config.SRIOVConfig interface.
+func (s *SRIOVConfigV1Alpha1) Link() string {
+	return s.MetaName
+}
+
+// NumVFs implements config.SRIOVConfig interface.
+func (s *SRIOVConfigV1Alpha1) NumVFs() int {
+	return s.SRIOVNumVFs
+}
+
+// VirtualFunctions implements config.SRIOVConfig interface.
+func (s *SRIOVConfigV1Alpha1) VirtualFunctions() []config.SRIOVVirtualFunctionConfig {
+	vfs := make([]config.SRIOVVirtualFunctionConfig, 0, len(s.SRIOVVirtualFunctions))
+
+	for _, vf := range s.SRIOVVirtualFunctions {
+		vfConfig := config.SRIOVVirtualFunctionConfig{
+			Index:      vf.VFIndex,
+			VLAN:       optionalValue(vf.VFVLAN),
+			SpoofCheck: optionalValue(vf.VFSpoofCheck),
+			Trust:      optionalValue(vf.VFTrust),
+		}
+
+		if vf.VFHardwareAddr != "" {
+			// the address is checked in Validate
+			hwAddr, _ := net.ParseMAC(vf.VFHardwareAddr) //nolint:errcheck
+
+			vfConfig.HardwareAddr = optional.Some(hwAddr)
+		}
+
+		vfs = append(vfs, vfConfig)
+	}
+
+	return vfs
+}
+
+// Validate implements config.Validator interface.
+func (s *SRIOVConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.MetaName == "" {
+		errs = errors.Join(errs, errors.New("name is required"))
+	}
+
+	if s.SRIOVNumVFs < 0 {
+		errs = errors.Join(errs, errors.New("numVFs should be non-negative"))
+	}
+
+	seen := map[int]struct{}{}
+
+	for _, vf := range s.SRIOVVirtualFunctions {
+		if vf.VFIndex < 0 || vf.VFIndex >= s.SRIOVNumVFs {
+			errs = errors.Join(errs, fmt.Errorf("vfs: index %d is out of range [0, %d)", vf.VFIndex, s.SRIOVNumVFs))
+		}
+
+		if _, exists := seen[vf.VFIndex]; exists {
+			errs = errors.Join(errs, fmt.Errorf("vfs: duplicate index %d", vf.VFIndex))
+		}
+
+		seen[vf.VFIndex] = struct{}{}
+
+		if vf.VFHardwareAddr != "" {
+			if _, err := net.ParseMAC(vf.VFHardwareAddr); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("vfs: index %d: invalid hardware address: %w", vf.VFIndex, err))
+			}
+		}
+
+		if vf.VFVLAN != nil && (*vf.VFVLAN < 0 || *vf.VFVLAN > 4094) {
+			errs = errors.Join(errs, fmt.Errorf("vfs: index %d: vlan %d is out of range [0, 4094]", vf.VFIndex, *vf.VFVLAN))
+		}
+	}
+
+	return nil, errs
+}
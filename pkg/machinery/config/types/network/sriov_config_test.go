@@ -0,0 +1,153 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network_test
+
+import (
+	_ "embed"
+	"net"
+	"testing"
+
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/network"
+)
+
+//go:embed testdata/sriovconfig.yaml
+var expectedSRIOVConfigDocument []byte
+
+func TestSRIOVConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := network.NewSRIOVConfigV1Alpha1("enp1s0f0")
+	cfg.SRIOVNumVFs = 4
+	cfg.SRIOVVirtualFunctions = []network.SRIOVVirtualFunctionConfig{
+		{
+			VFIndex:        0,
+			VFHardwareAddr: "02:00:00:00:00:01",
+			VFVLAN:         pointer.To(100),
+			VFSpoofCheck:   pointer.To(false),
+			VFTrust:        pointer.To(true),
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedSRIOVConfigDocument, marshaled)
+}
+
+func TestSRIOVConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedSRIOVConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &network.SRIOVConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       network.SRIOVKind,
+		},
+		MetaName:    "enp1s0f0",
+		SRIOVNumVFs: 4,
+		SRIOVVirtualFunctions: []network.SRIOVVirtualFunctionConfig{
+			{
+				VFIndex:        0,
+				VFHardwareAddr: "02:00:00:00:00:01",
+				VFVLAN:         pointer.To(100),
+				VFSpoofCheck:   pointer.To(false),
+				VFTrust:        pointer.To(true),
+			},
+		},
+	}, docs[0])
+
+	sriovConfigs := provider.SRIOVConfigs()
+	require.Len(t, sriovConfigs, 1)
+
+	assert.Equal(t, "enp1s0f0", sriovConfigs[0].Link())
+	assert.Equal(t, 4, sriovConfigs[0].NumVFs())
+	assert.Equal(t, []config.SRIOVVirtualFunctionConfig{
+		{
+			Index:        0,
+			HardwareAddr: optional.Some(net.HardwareAddr{0x02, 0x00, 0x00, 0x00, 0x00, 0x01}),
+			VLAN:         optional.Some(100),
+			SpoofCheck:   optional.Some(false),
+			Trust:        optional.Some(true),
+		},
+	}, sriovConfigs[0].VirtualFunctions())
+}
+
+func TestSRIOVConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *network.SRIOVConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty name",
+			cfg: func() *network.SRIOVConfigV1Alpha1 {
+				return network.NewSRIOVConfigV1Alpha1("")
+			},
+
+			expectedError: "name is required",
+		},
+		{
+			name: "invalid vfs",
+			cfg: func() *network.SRIOVConfigV1Alpha1 {
+				cfg := network.NewSRIOVConfigV1Alpha1("eth0")
+				cfg.SRIOVNumVFs = 2
+				cfg.SRIOVVirtualFunctions = []network.SRIOVVirtualFunctionConfig{
+					{
+						VFIndex:        1,
+						VFHardwareAddr: "foo",
+					},
+					{
+						VFIndex: 1,
+						VFVLAN:  pointer.To(4095),
+					},
+					{
+						VFIndex: 2,
+					},
+				}
+
+				return cfg
+			},
+
+			expectedError: "vfs: index 1: invalid hardware address: address foo: invalid MAC address\nvfs: duplicate index 1\nvfs: index 1: vlan 4095 is out of range [0, 4094]\nvfs: index 2 is out of range [0, 2)", //nolint:lll
+		},
+		{
+			name: "disable",
+			cfg: func() *network.SRIOVConfigV1Alpha1 {
+				return network.NewSRIOVConfigV1Alpha1("eth0")
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
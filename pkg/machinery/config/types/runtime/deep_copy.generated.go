@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type EventSinkV1Alpha1 -type KmsgLogV1Alpha1 -type WatchdogTimerV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type EventSinkV1Alpha1 -type KmsgLogV1Alpha1 -type MetricsV1Alpha1 -type PerformanceV1Alpha1 -type SequenceHookV1Alpha1 -type ServiceLimitsV1Alpha1 -type ServicePolicyV1Alpha1 -type SNMPV1Alpha1 -type SystemResourcesV1Alpha1 -type UpgradeHealthCheckV1Alpha1 -type UserServiceV1Alpha1 -type WatchdogTimerV1Alpha1 -type WebhookNotificationV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package runtime
 
@@ -30,8 +30,172 @@ func (o *KmsgLogV1Alpha1) DeepCopy() *KmsgLogV1Alpha1 {
 	return &cp
 }
 
+// DeepCopy generates a deep copy of *MetricsV1Alpha1.
+func (o *MetricsV1Alpha1) DeepCopy() *MetricsV1Alpha1 {
+	var cp MetricsV1Alpha1 = *o
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *PerformanceV1Alpha1.
+func (o *PerformanceV1Alpha1) DeepCopy() *PerformanceV1Alpha1 {
+	var cp PerformanceV1Alpha1 = *o
+	if o.PerformanceHugePages != nil {
+		cp.PerformanceHugePages = make([]HugePagesConfig, len(o.PerformanceHugePages))
+		copy(cp.PerformanceHugePages, o.PerformanceHugePages)
+		for i2 := range o.PerformanceHugePages {
+			if o.PerformanceHugePages[i2].HugePagesNodes != nil {
+				cp.PerformanceHugePages[i2].HugePagesNodes = make([]HugePagesNodeConfig, len(o.PerformanceHugePages[i2].HugePagesNodes))
+				copy(cp.PerformanceHugePages[i2].HugePagesNodes, o.PerformanceHugePages[i2].HugePagesNodes)
+			}
+		}
+	}
+	if o.PerformanceCPUIsolation != nil {
+		cp.PerformanceCPUIsolation = new(CPUIsolationConfig)
+		*cp.PerformanceCPUIsolation = *o.PerformanceCPUIsolation
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *SequenceHookV1Alpha1.
+func (o *SequenceHookV1Alpha1) DeepCopy() *SequenceHookV1Alpha1 {
+	var cp SequenceHookV1Alpha1 = *o
+	if o.HookURL.URL != nil {
+		cp.HookURL.URL = new(url.URL)
+		*cp.HookURL.URL = *o.HookURL.URL
+		if o.HookURL.URL.User != nil {
+			cp.HookURL.URL.User = new(url.Userinfo)
+			*cp.HookURL.URL.User = *o.HookURL.URL.User
+		}
+	}
+	if o.HookHeaders != nil {
+		cp.HookHeaders = make(map[string]string, len(o.HookHeaders))
+		for k2, v2 := range o.HookHeaders {
+			cp.HookHeaders[k2] = v2
+		}
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *ServiceLimitsV1Alpha1.
+func (o *ServiceLimitsV1Alpha1) DeepCopy() *ServiceLimitsV1Alpha1 {
+	var cp ServiceLimitsV1Alpha1 = *o
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *ServicePolicyV1Alpha1.
+func (o *ServicePolicyV1Alpha1) DeepCopy() *ServicePolicyV1Alpha1 {
+	var cp ServicePolicyV1Alpha1 = *o
+	if o.PolicyHealthCheck != nil {
+		cp.PolicyHealthCheck = new(ServiceHealthCheck)
+		*cp.PolicyHealthCheck = *o.PolicyHealthCheck
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *SNMPV1Alpha1.
+func (o *SNMPV1Alpha1) DeepCopy() *SNMPV1Alpha1 {
+	var cp SNMPV1Alpha1 = *o
+	if o.SNMPUsers != nil {
+		cp.SNMPUsers = make([]SNMPUserV1Alpha1, len(o.SNMPUsers))
+		copy(cp.SNMPUsers, o.SNMPUsers)
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *SystemResourcesV1Alpha1.
+func (o *SystemResourcesV1Alpha1) DeepCopy() *SystemResourcesV1Alpha1 {
+	var cp SystemResourcesV1Alpha1 = *o
+	if o.SystemOOMProtection != nil {
+		cp.SystemOOMProtection = make([]OOMProtectionConfig, len(o.SystemOOMProtection))
+		copy(cp.SystemOOMProtection, o.SystemOOMProtection)
+		for i2 := range o.SystemOOMProtection {
+			if o.SystemOOMProtection[i2].OOMScoreAdjValue != nil {
+				cp.SystemOOMProtection[i2].OOMScoreAdjValue = new(int)
+				*cp.SystemOOMProtection[i2].OOMScoreAdjValue = *o.SystemOOMProtection[i2].OOMScoreAdjValue
+			}
+		}
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *UpgradeHealthCheckV1Alpha1.
+func (o *UpgradeHealthCheckV1Alpha1) DeepCopy() *UpgradeHealthCheckV1Alpha1 {
+	var cp UpgradeHealthCheckV1Alpha1 = *o
+	if o.HealthCheckServices != nil {
+		cp.HealthCheckServices = make([]string, len(o.HealthCheckServices))
+		copy(cp.HealthCheckServices, o.HealthCheckServices)
+	}
+	if o.HealthCheckHTTPProbes != nil {
+		cp.HealthCheckHTTPProbes = make([]HTTPProbe, len(o.HealthCheckHTTPProbes))
+		copy(cp.HealthCheckHTTPProbes, o.HealthCheckHTTPProbes)
+		for i2 := range o.HealthCheckHTTPProbes {
+			if o.HealthCheckHTTPProbes[i2].ProbeURL.URL != nil {
+				cp.HealthCheckHTTPProbes[i2].ProbeURL.URL = new(url.URL)
+				*cp.HealthCheckHTTPProbes[i2].ProbeURL.URL = *o.HealthCheckHTTPProbes[i2].ProbeURL.URL
+				if o.HealthCheckHTTPProbes[i2].ProbeURL.URL.User != nil {
+					cp.HealthCheckHTTPProbes[i2].ProbeURL.URL.User = new(url.Userinfo)
+					*cp.HealthCheckHTTPProbes[i2].ProbeURL.URL.User = *o.HealthCheckHTTPProbes[i2].ProbeURL.URL.User
+				}
+			}
+		}
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *UserServiceV1Alpha1.
+func (o *UserServiceV1Alpha1) DeepCopy() *UserServiceV1Alpha1 {
+	var cp UserServiceV1Alpha1 = *o
+	if o.ServiceCommand != nil {
+		cp.ServiceCommand = make([]string, len(o.ServiceCommand))
+		copy(cp.ServiceCommand, o.ServiceCommand)
+	}
+	if o.ServiceArgs != nil {
+		cp.ServiceArgs = make([]string, len(o.ServiceArgs))
+		copy(cp.ServiceArgs, o.ServiceArgs)
+	}
+	if o.ServiceEnvironment != nil {
+		cp.ServiceEnvironment = make([]string, len(o.ServiceEnvironment))
+		copy(cp.ServiceEnvironment, o.ServiceEnvironment)
+	}
+	if o.ServiceMounts != nil {
+		cp.ServiceMounts = make([]UserServiceMount, len(o.ServiceMounts))
+		copy(cp.ServiceMounts, o.ServiceMounts)
+		for i2 := range o.ServiceMounts {
+			if o.ServiceMounts[i2].MountOptions != nil {
+				cp.ServiceMounts[i2].MountOptions = make([]string, len(o.ServiceMounts[i2].MountOptions))
+				copy(cp.ServiceMounts[i2].MountOptions, o.ServiceMounts[i2].MountOptions)
+			}
+		}
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *WatchdogTimerV1Alpha1.
 func (o *WatchdogTimerV1Alpha1) DeepCopy() *WatchdogTimerV1Alpha1 {
 	var cp WatchdogTimerV1Alpha1 = *o
 	return &cp
 }
+
+// DeepCopy generates a deep copy of *WebhookNotificationV1Alpha1.
+func (o *WebhookNotificationV1Alpha1) DeepCopy() *WebhookNotificationV1Alpha1 {
+	var cp WebhookNotificationV1Alpha1 = *o
+	if o.WebhookURL.URL != nil {
+		cp.WebhookURL.URL = new(url.URL)
+		*cp.WebhookURL.URL = *o.WebhookURL.URL
+		if o.WebhookURL.URL.User != nil {
+			cp.WebhookURL.URL.User = new(url.Userinfo)
+			*cp.WebhookURL.URL.User = *o.WebhookURL.URL.User
+		}
+	}
+	if o.WebhookEvents != nil {
+		cp.WebhookEvents = make([]string, len(o.WebhookEvents))
+		copy(cp.WebhookEvents, o.WebhookEvents)
+	}
+	if o.WebhookHeaders != nil {
+		cp.WebhookHeaders = make(map[string]string, len(o.WebhookHeaders))
+		for k2, v2 := range o.WebhookHeaders {
+			cp.WebhookHeaders[k2] = v2
+		}
+	}
+	return &cp
+}
@@ -113,6 +113,31 @@ func (s *KmsgLogV1Alpha1) WatchdogTimer() config.WatchdogTimerConfig {
 	return nil
 }
 
+// UpgradeHealthCheck implements config.RuntimeConfig interface.
+func (s *KmsgLogV1Alpha1) UpgradeHealthCheck() config.UpgradeHealthCheckConfig {
+	return nil
+}
+
+// Metrics implements config.RuntimeConfig interface.
+func (s *KmsgLogV1Alpha1) Metrics() config.MetricsConfig {
+	return nil
+}
+
+// SequenceHooks implements config.RuntimeConfig interface.
+func (s *KmsgLogV1Alpha1) SequenceHooks() []config.SequenceHookConfig {
+	return nil
+}
+
+// WebhookNotifications implements config.RuntimeConfig interface.
+func (s *KmsgLogV1Alpha1) WebhookNotifications() []config.WebhookNotificationConfig {
+	return nil
+}
+
+// SNMP implements config.RuntimeConfig interface.
+func (s *KmsgLogV1Alpha1) SNMP() config.SNMPConfig {
+	return nil
+}
+
 // Validate implements config.Validator interface.
 func (s *KmsgLogV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
 	if s.MetaName == "" {
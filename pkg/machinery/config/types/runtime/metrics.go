@@ -0,0 +1,151 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// MetricsKind is a metrics config document kind.
+const MetricsKind = "MetricsConfig"
+
+func init() {
+	registry.Register(MetricsKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &MetricsV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.RuntimeConfig = &MetricsV1Alpha1{}
+	_ config.Validator     = &MetricsV1Alpha1{}
+)
+
+// DefaultMetricsListenAddress is the default listen address of the metrics endpoint.
+const DefaultMetricsListenAddress = ":9101"
+
+// MetricsV1Alpha1 is a metrics config document.
+//
+//	examples:
+//	  - value: exampleMetricsV1Alpha1()
+//	alias: MetricsConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/MetricsConfig
+type MetricsV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Address to serve the Prometheus metrics endpoint (/metrics) on.
+	//
+	//     The endpoint is served over plain HTTP, and only if this document is present.
+	//
+	//     Default value is ":9101".
+	//   examples:
+	//     - value: >
+	//        "127.0.0.1:9101"
+	MetricsListenAddress string `yaml:"listenAddress,omitempty"`
+}
+
+// NewMetricsV1Alpha1 creates a new metrics config document.
+func NewMetricsV1Alpha1() *MetricsV1Alpha1 {
+	return &MetricsV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       MetricsKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleMetricsV1Alpha1() *MetricsV1Alpha1 {
+	cfg := NewMetricsV1Alpha1()
+	cfg.MetricsListenAddress = ":9101"
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *MetricsV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Runtime implements config.Config interface.
+func (s *MetricsV1Alpha1) Runtime() config.RuntimeConfig {
+	return s
+}
+
+// EventsEndpoint implements config.RuntimeConfig interface.
+func (s *MetricsV1Alpha1) EventsEndpoint() *string {
+	return nil
+}
+
+// KmsgLogURLs implements config.RuntimeConfig interface.
+func (s *MetricsV1Alpha1) KmsgLogURLs() []*url.URL {
+	return nil
+}
+
+// WatchdogTimer implements config.RuntimeConfig interface.
+func (s *MetricsV1Alpha1) WatchdogTimer() config.WatchdogTimerConfig {
+	return nil
+}
+
+// UpgradeHealthCheck implements config.RuntimeConfig interface.
+func (s *MetricsV1Alpha1) UpgradeHealthCheck() config.UpgradeHealthCheckConfig {
+	return nil
+}
+
+// Metrics implements config.RuntimeConfig interface.
+func (s *MetricsV1Alpha1) Metrics() config.MetricsConfig {
+	return s
+}
+
+// SequenceHooks implements config.RuntimeConfig interface.
+func (s *MetricsV1Alpha1) SequenceHooks() []config.SequenceHookConfig {
+	return nil
+}
+
+// WebhookNotifications implements config.RuntimeConfig interface.
+func (s *MetricsV1Alpha1) WebhookNotifications() []config.WebhookNotificationConfig {
+	return nil
+}
+
+// SNMP implements config.RuntimeConfig interface.
+func (s *MetricsV1Alpha1) SNMP() config.SNMPConfig {
+	return nil
+}
+
+// ListenAddress implements config.MetricsConfig interface.
+func (s *MetricsV1Alpha1) ListenAddress() string {
+	if s.MetricsListenAddress == "" {
+		return DefaultMetricsListenAddress
+	}
+
+	return s.MetricsListenAddress
+}
+
+// Validate implements config.Validator interface.
+func (s *MetricsV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	if s.MetricsListenAddress == "" {
+		return nil, nil
+	}
+
+	if _, _, err := net.SplitHostPort(s.MetricsListenAddress); err != nil {
+		return nil, fmt.Errorf("listen address: %w", err)
+	}
+
+	return nil, nil
+}
@@ -0,0 +1,78 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+)
+
+//go:embed testdata/metrics.yaml
+var expectedMetricsDocument []byte
+
+func TestMetricsMarshalStability(t *testing.T) {
+	cfg := runtime.NewMetricsV1Alpha1()
+	cfg.MetricsListenAddress = "127.0.0.1:9101"
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedMetricsDocument, marshaled)
+}
+
+func TestMetricsValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name          string
+		listenAddress string
+
+		expectedAddress string
+		expectedError   string
+	}{
+		{
+			name: "default",
+
+			expectedAddress: ":9101",
+		},
+		{
+			name:          "custom",
+			listenAddress: "[::1]:8080",
+
+			expectedAddress: "[::1]:8080",
+		},
+		{
+			name:          "invalid",
+			listenAddress: "8080",
+
+			expectedError: "listen address: address 8080: missing port in address",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := runtime.NewMetricsV1Alpha1()
+			cfg.MetricsListenAddress = test.listenAddress
+
+			_, err := cfg.Validate(validationMode{})
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedAddress, cfg.Runtime().Metrics().ListenAddress())
+		})
+	}
+}
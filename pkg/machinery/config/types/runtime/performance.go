@@ -0,0 +1,333 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// PerformanceKind is a performance tuning config document kind.
+const PerformanceKind = "PerformanceConfig"
+
+func init() {
+	registry.Register(PerformanceKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &PerformanceV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.PerformanceConfig = &PerformanceV1Alpha1{}
+	_ config.HugePagesConfig   = HugePagesConfig{}
+	_ config.Validator         = &PerformanceV1Alpha1{}
+)
+
+// PerformanceV1Alpha1 is a performance tuning config document.
+//
+//	examples:
+//	  - value: examplePerformanceV1Alpha1()
+//	alias: PerformanceConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/PerformanceConfig
+type PerformanceV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Huge pages to reserve, one entry per page size.
+	//
+	//     Huge pages are reserved at runtime via sysfs, either system-wide or per NUMA node.
+	PerformanceHugePages []HugePagesConfig `yaml:"hugePages,omitempty"`
+	//   description: |
+	//     CPU isolation settings.
+	//
+	//     CPU isolation is applied via kernel arguments on install and upgrade,
+	//     so the machine should be upgraded (or reinstalled) for the change to take effect.
+	PerformanceCPUIsolation *CPUIsolationConfig `yaml:"cpuIsolation,omitempty"`
+}
+
+// HugePagesConfig describes huge pages reservation for a single page size.
+type HugePagesConfig struct {
+	//   description: |
+	//     Huge page size, e.g. `2M` or `1G`.
+	//
+	//     The size should be supported by the CPU.
+	//   schemaRequired: true
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9]+[KMG]$
+	HugePagesSize string `yaml:"size"`
+	//   description: |
+	//     Number of huge pages to reserve system-wide.
+	//
+	//     The kernel distributes the pages across NUMA nodes.
+	//     Mutually exclusive with `nodes`.
+	HugePagesCount int `yaml:"count,omitempty"`
+	//   description: |
+	//     Number of huge pages to reserve on each NUMA node.
+	//
+	//     Mutually exclusive with `count`.
+	HugePagesNodes []HugePagesNodeConfig `yaml:"nodes,omitempty"`
+}
+
+// HugePagesNodeConfig describes huge pages reservation on a NUMA node.
+type HugePagesNodeConfig struct {
+	//   description: |
+	//     NUMA node ID.
+	NodeID int `yaml:"node"`
+	//   description: |
+	//     Number of huge pages to reserve on the node.
+	//   schemaRequired: true
+	NodeCount int `yaml:"count"`
+}
+
+// CPUIsolationConfig describes CPU isolation.
+type CPUIsolationConfig struct {
+	//   description: |
+	//     List of CPUs to isolate from the general scheduler, RCU callbacks and managed interrupts,
+	//     in the kernel CPU list format.
+	//
+	//     Rendered to `isolcpus` and `rcu_nocbs` kernel arguments.
+	//   examples:
+	//     - value: >
+	//        "2-15,18"
+	//   schemaRequired: true
+	IsolatedCPUs string `yaml:"cpus"`
+	//   description: |
+	//     Stop the scheduling-clock tick on the isolated CPUs when possible (`nohz_full` kernel argument).
+	NoHZFull bool `yaml:"nohzFull,omitempty"`
+}
+
+// NewPerformanceV1Alpha1 creates a new performance tuning config document.
+func NewPerformanceV1Alpha1() *PerformanceV1Alpha1 {
+	return &PerformanceV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       PerformanceKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func examplePerformanceV1Alpha1() *PerformanceV1Alpha1 {
+	cfg := NewPerformanceV1Alpha1()
+	cfg.PerformanceHugePages = []HugePagesConfig{
+		{
+			HugePagesSize:  "2M",
+			HugePagesCount: 1024,
+		},
+		{
+			HugePagesSize: "1G",
+			HugePagesNodes: []HugePagesNodeConfig{
+				{
+					NodeID:    0,
+					NodeCount: 8,
+				},
+				{
+					NodeID:    1,
+					NodeCount: 4,
+				},
+			},
+		},
+	}
+	cfg.PerformanceCPUIsolation = &CPUIsolationConfig{
+		IsolatedCPUs: "2-15",
+		NoHZFull:     true,
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *PerformanceV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Performance implements config.Config interface.
+func (s *PerformanceV1Alpha1) Performance() config.PerformanceConfig {
+	return s
+}
+
+// HugePages implements config.PerformanceConfig interface.
+func (s *PerformanceV1Alpha1) HugePages() []config.HugePagesConfig {
+	result := make([]config.HugePagesConfig, 0, len(s.PerformanceHugePages))
+
+	for _, hp := range s.PerformanceHugePages {
+		result = append(result, hp)
+	}
+
+	return result
+}
+
+// KernelArgs implements config.PerformanceConfig interface.
+func (s *PerformanceV1Alpha1) KernelArgs() []string {
+	if s.PerformanceCPUIsolation == nil || s.PerformanceCPUIsolation.IsolatedCPUs == "" {
+		return nil
+	}
+
+	cpus := s.PerformanceCPUIsolation.IsolatedCPUs
+
+	args := []string{
+		"isolcpus=managed_irq,domain," + cpus,
+		"rcu_nocbs=" + cpus,
+	}
+
+	if s.PerformanceCPUIsolation.NoHZFull {
+		args = append(args, "nohz_full="+cpus)
+	}
+
+	return args
+}
+
+// SizeKB implements config.HugePagesConfig interface.
+func (hp HugePagesConfig) SizeKB() uint64 {
+	size, _ := parseHugePageSize(hp.HugePagesSize) //nolint:errcheck
+
+	return size
+}
+
+// Count implements config.HugePagesConfig interface.
+func (hp HugePagesConfig) Count() int {
+	return hp.HugePagesCount
+}
+
+// NodeCounts implements config.HugePagesConfig interface.
+func (hp HugePagesConfig) NodeCounts() map[int]int {
+	if len(hp.HugePagesNodes) == 0 {
+		return nil
+	}
+
+	result := make(map[int]int, len(hp.HugePagesNodes))
+
+	for _, node := range hp.HugePagesNodes {
+		result[node.NodeID] = node.NodeCount
+	}
+
+	return result
+}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo
+func (s *PerformanceV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	sizes := map[uint64]struct{}{}
+
+	for i, hp := range s.PerformanceHugePages {
+		size, err := parseHugePageSize(hp.HugePagesSize)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("hugePages[%d]: %w", i, err))
+
+			continue
+		}
+
+		if _, ok := sizes[size]; ok {
+			errs = errors.Join(errs, fmt.Errorf("hugePages[%d]: duplicate size %q", i, hp.HugePagesSize))
+		}
+
+		sizes[size] = struct{}{}
+
+		if hp.HugePagesCount < 0 {
+			errs = errors.Join(errs, fmt.Errorf("hugePages[%d]: count should be non-negative", i))
+		}
+
+		if hp.HugePagesCount > 0 && len(hp.HugePagesNodes) > 0 {
+			errs = errors.Join(errs, fmt.Errorf("hugePages[%d]: count and nodes are mutually exclusive", i))
+		}
+
+		nodes := map[int]struct{}{}
+
+		for j, node := range hp.HugePagesNodes {
+			if node.NodeID < 0 {
+				errs = errors.Join(errs, fmt.Errorf("hugePages[%d].nodes[%d]: node should be non-negative", i, j))
+			}
+
+			if node.NodeCount < 0 {
+				errs = errors.Join(errs, fmt.Errorf("hugePages[%d].nodes[%d]: count should be non-negative", i, j))
+			}
+
+			if _, ok := nodes[node.NodeID]; ok {
+				errs = errors.Join(errs, fmt.Errorf("hugePages[%d].nodes[%d]: duplicate node %d", i, j, node.NodeID))
+			}
+
+			nodes[node.NodeID] = struct{}{}
+		}
+	}
+
+	if s.PerformanceCPUIsolation != nil {
+		if err := validateCPUList(s.PerformanceCPUIsolation.IsolatedCPUs); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("cpuIsolation: %w", err))
+		}
+	}
+
+	return nil, errs
+}
+
+// parseHugePageSize parses the huge page size in the kernel format (e.g. 2M) and returns it in KiB.
+func parseHugePageSize(s string) (uint64, error) {
+	if len(s) < 2 {
+		return 0, fmt.Errorf("invalid huge page size %q", s)
+	}
+
+	var multiplier uint64
+
+	switch s[len(s)-1] {
+	case 'K':
+		multiplier = 1
+	case 'M':
+		multiplier = 1024
+	case 'G':
+		multiplier = 1024 * 1024
+	default:
+		return 0, fmt.Errorf("invalid huge page size %q: should end with K, M or G", s)
+	}
+
+	value, err := strconv.ParseUint(s[:len(s)-1], 10, 64)
+	if err != nil || value == 0 {
+		return 0, fmt.Errorf("invalid huge page size %q", s)
+	}
+
+	return value * multiplier, nil
+}
+
+// validateCPUList validates the kernel CPU list format, e.g. 0-3,8,10-11.
+func validateCPUList(s string) error {
+	if s == "" {
+		return errors.New("cpus should be set")
+	}
+
+	for _, item := range strings.Split(s, ",") {
+		first, last, isRange := strings.Cut(item, "-")
+
+		start, err := strconv.ParseUint(first, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid CPU list %q", s)
+		}
+
+		if !isRange {
+			continue
+		}
+
+		end, err := strconv.ParseUint(last, 10, 32)
+		if err != nil || end < start {
+			return fmt.Errorf("invalid CPU list %q", s)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,164 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+)
+
+//go:embed testdata/performance.yaml
+var expectedPerformanceDocument []byte
+
+func TestPerformanceMarshalStability(t *testing.T) {
+	cfg := runtime.NewPerformanceV1Alpha1()
+	cfg.PerformanceHugePages = []runtime.HugePagesConfig{
+		{
+			HugePagesSize:  "2M",
+			HugePagesCount: 1024,
+		},
+		{
+			HugePagesSize: "1G",
+			HugePagesNodes: []runtime.HugePagesNodeConfig{
+				{
+					NodeID:    0,
+					NodeCount: 8,
+				},
+				{
+					NodeID:    1,
+					NodeCount: 4,
+				},
+			},
+		},
+	}
+	cfg.PerformanceCPUIsolation = &runtime.CPUIsolationConfig{
+		IsolatedCPUs: "2-15",
+		NoHZFull:     true,
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedPerformanceDocument, marshaled)
+
+	hugePages := cfg.Performance().HugePages()
+	require.Len(t, hugePages, 2)
+
+	assert.EqualValues(t, 2048, hugePages[0].SizeKB())
+	assert.Equal(t, 1024, hugePages[0].Count())
+	assert.Empty(t, hugePages[0].NodeCounts())
+
+	assert.EqualValues(t, 1024*1024, hugePages[1].SizeKB())
+	assert.Equal(t, map[int]int{0: 8, 1: 4}, hugePages[1].NodeCounts())
+
+	assert.Equal(t, []string{
+		"isolcpus=managed_irq,domain,2-15",
+		"rcu_nocbs=2-15",
+		"nohz_full=2-15",
+	}, cfg.Performance().KernelArgs())
+}
+
+func TestPerformanceValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *runtime.PerformanceV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  runtime.NewPerformanceV1Alpha1,
+		},
+		{
+			name: "valid",
+			cfg: func() *runtime.PerformanceV1Alpha1 {
+				cfg := runtime.NewPerformanceV1Alpha1()
+				cfg.PerformanceHugePages = []runtime.HugePagesConfig{
+					{
+						HugePagesSize:  "64K",
+						HugePagesCount: 16,
+					},
+				}
+				cfg.PerformanceCPUIsolation = &runtime.CPUIsolationConfig{
+					IsolatedCPUs: "1,4-7,12",
+				}
+
+				return cfg
+			},
+		},
+		{
+			name: "invalid huge pages",
+			cfg: func() *runtime.PerformanceV1Alpha1 {
+				cfg := runtime.NewPerformanceV1Alpha1()
+				cfg.PerformanceHugePages = []runtime.HugePagesConfig{
+					{
+						HugePagesSize: "2MB",
+					},
+					{
+						HugePagesSize:  "2M",
+						HugePagesCount: 4,
+						HugePagesNodes: []runtime.HugePagesNodeConfig{
+							{
+								NodeID:    0,
+								NodeCount: 2,
+							},
+							{
+								NodeID:    0,
+								NodeCount: -1,
+							},
+						},
+					},
+					{
+						HugePagesSize: "2048K",
+					},
+				}
+
+				return cfg
+			},
+
+			expectedError: "hugePages[0]: invalid huge page size \"2MB\": should end with K, M or G\n" +
+				"hugePages[1]: count and nodes are mutually exclusive\n" +
+				"hugePages[1].nodes[1]: count should be non-negative\n" +
+				"hugePages[1].nodes[1]: duplicate node 0\n" +
+				"hugePages[2]: duplicate size \"2048K\"",
+		},
+		{
+			name: "invalid CPU list",
+			cfg: func() *runtime.PerformanceV1Alpha1 {
+				cfg := runtime.NewPerformanceV1Alpha1()
+				cfg.PerformanceCPUIsolation = &runtime.CPUIsolationConfig{
+					IsolatedCPUs: "4-2",
+				}
+
+				return cfg
+			},
+
+			expectedError: "cpuIsolation: invalid CPU list \"4-2\"",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
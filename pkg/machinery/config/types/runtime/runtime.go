@@ -5,6 +5,6 @@
 // Package runtime provides runtime machine configuration documents.
 package runtime
 
-//go:generate docgen -output runtime_doc.go runtime.go kmsg_log.go event_sink.go watchdog_timer.go
+//go:generate docgen -output runtime_doc.go runtime.go kmsg_log.go event_sink.go metrics.go performance.go sequence_hook.go service_limits.go service_policy.go system_resources.go snmp.go upgrade_health_check.go user_service.go watchdog_timer.go webhook_notification.go
 
-//go:generate deep-copy -type EventSinkV1Alpha1 -type KmsgLogV1Alpha1 -type WatchdogTimerV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate deep-copy -type EventSinkV1Alpha1 -type KmsgLogV1Alpha1 -type MetricsV1Alpha1 -type PerformanceV1Alpha1 -type SequenceHookV1Alpha1 -type ServiceLimitsV1Alpha1 -type ServicePolicyV1Alpha1 -type SNMPV1Alpha1 -type SystemResourcesV1Alpha1 -type UpgradeHealthCheckV1Alpha1 -type UserServiceV1Alpha1 -type WatchdogTimerV1Alpha1 -type WebhookNotificationV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
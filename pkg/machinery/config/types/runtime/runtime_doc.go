@@ -64,6 +64,775 @@ func (EventSinkV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (MetricsV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "MetricsConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "MetricsConfig is a metrics config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "MetricsConfig is a metrics config document.",
+		Fields: []encoder.Doc{
+			{}, {
+				Name:        "listenAddress",
+				Type:        "string",
+				Note:        "",
+				Description: "Address to serve the Prometheus metrics endpoint (/metrics) on.\n\nThe endpoint is served over plain HTTP, and only if this document is present.\n\nDefault value is \":9101\".",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Address to serve the Prometheus metrics endpoint (/metrics) on." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleMetricsV1Alpha1())
+
+	doc.Fields[1].AddExample("", "127.0.0.1:9101")
+
+	return doc
+}
+
+func (PerformanceV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "PerformanceConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "PerformanceConfig is a performance tuning config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "PerformanceConfig is a performance tuning config document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "hugePages",
+				Type:        "[]HugePagesConfig",
+				Note:        "",
+				Description: "Huge pages to reserve, one entry per page size.\n\nHuge pages are reserved at runtime via sysfs, either system-wide or per NUMA node.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Huge pages to reserve, one entry per page size." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "cpuIsolation",
+				Type:        "CPUIsolationConfig",
+				Note:        "",
+				Description: "CPU isolation settings.\n\nCPU isolation is applied via kernel arguments on install and upgrade,\nso the machine should be upgraded (or reinstalled) for the change to take effect.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "CPU isolation settings." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", examplePerformanceV1Alpha1())
+
+	return doc
+}
+
+func (HugePagesConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "HugePagesConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "HugePagesConfig describes huge pages reservation for a single page size." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "HugePagesConfig describes huge pages reservation for a single page size.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "PerformanceV1Alpha1",
+				FieldName: "hugePages",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "size",
+				Type:        "string",
+				Note:        "",
+				Description: "Huge page size, e.g. `2M` or `1G`.\n\nThe size should be supported by the CPU.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Huge page size, e.g. `2M` or `1G`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "count",
+				Type:        "int",
+				Note:        "",
+				Description: "Number of huge pages to reserve system-wide.\n\nThe kernel distributes the pages across NUMA nodes.\nMutually exclusive with `nodes`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of huge pages to reserve system-wide." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "nodes",
+				Type:        "[]HugePagesNodeConfig",
+				Note:        "",
+				Description: "Number of huge pages to reserve on each NUMA node.\n\nMutually exclusive with `count`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of huge pages to reserve on each NUMA node." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (HugePagesNodeConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "HugePagesNodeConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "HugePagesNodeConfig describes huge pages reservation on a NUMA node." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "HugePagesNodeConfig describes huge pages reservation on a NUMA node.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "HugePagesConfig",
+				FieldName: "nodes",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "node",
+				Type:        "int",
+				Note:        "",
+				Description: "NUMA node ID.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "NUMA node ID." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "count",
+				Type:        "int",
+				Note:        "",
+				Description: "Number of huge pages to reserve on the node.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of huge pages to reserve on the node." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (CPUIsolationConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "CPUIsolationConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "CPUIsolationConfig describes CPU isolation." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "CPUIsolationConfig describes CPU isolation.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "PerformanceV1Alpha1",
+				FieldName: "cpuIsolation",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "cpus",
+				Type:        "string",
+				Note:        "",
+				Description: "List of CPUs to isolate from the general scheduler, RCU callbacks and managed interrupts,\nin the kernel CPU list format.\n\nRendered to `isolcpus` and `rcu_nocbs` kernel arguments.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of CPUs to isolate from the general scheduler, RCU callbacks and managed interrupts," /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "nohzFull",
+				Type:        "bool",
+				Note:        "",
+				Description: "Stop the scheduling-clock tick on the isolated CPUs when possible (`nohz_full` kernel argument).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Stop the scheduling-clock tick on the isolated CPUs when possible (`nohz_full` kernel argument)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[0].AddExample("", "2-15,18")
+
+	return doc
+}
+
+func (SequenceHookV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "SequenceHookConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "SequenceHookConfig is a sequence hook config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "SequenceHookConfig is a sequence hook config document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the config document.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "point",
+				Type:        "string",
+				Note:        "",
+				Description: "The sequence point to run the hook at.\n\nThe pre-install and pre-upgrade hooks run before the installation and upgrade start,\nso they can be used to approve the operation.\nThe post-boot hook runs once all services are started on each boot,\nand the post-upgrade hook runs at the same point on the first boot after an upgrade.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The sequence point to run the hook at." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"pre-install",
+					"post-boot",
+					"pre-upgrade",
+					"post-upgrade",
+				},
+			},
+			{
+				Name:        "url",
+				Type:        "URL",
+				Note:        "",
+				Description: "URL to send the POST request to.\n\nThe hook sends a POST request with the JSON description of the event to the URL\nwhen the machine reaches the sequence point.\nThe hook succeeds if the response status code is 2xx.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "URL to send the POST request to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "headers",
+				Type:        "map[string]string",
+				Note:        "",
+				Description: "Extra HTTP headers to send with the request, e.g. for authentication.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Extra HTTP headers to send with the request, e.g. for authentication." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "timeout",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Timeout for the hook to complete.\n\nDefault value is 30 seconds, maximum value is 1 hour.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Timeout for the hook to complete." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "failurePolicy",
+				Type:        "string",
+				Note:        "",
+				Description: "The action to take when the hook fails or times out.\n\nWith the 'fail' policy the sequence is aborted, e.g. the upgrade is not performed.\nWith the 'ignore' policy the failure is logged and the sequence continues.\n\nDefault value is 'fail'.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The action to take when the hook fails or times out." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"fail",
+					"ignore",
+				},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleSequenceHookV1Alpha1())
+
+	doc.Fields[4].AddExample("", map[string]string{"Authorization": "Bearer token"})
+
+	return doc
+}
+
+func (ServiceLimitsV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ServiceLimitsConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ServiceLimitsConfig is a config document to limit resources of a Talos or extension service." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ServiceLimitsConfig is a config document to limit resources of a Talos or extension service.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "ID of the service to limit, as shown by `talosctl services`, e.g. `udevd` or `ext-nut-client`.\n\nThe limits are applied to the cgroup of the service, so only the services running in a dedicated cgroup\nare supported (apid, trustd, etcd, kubelet, udevd, dashboard, cri, containerd and extension services).\nThe limits are re-applied when the service is restarted,\nand removing the document restores the built-in limits of the service.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "ID of the service to limit, as shown by `talosctl services`, e.g. `udevd` or `ext-nut-client`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "cpu",
+				Type:        "string",
+				Note:        "",
+				Description: "CPU limit of the service, either in cores (`2`, `1.5`) or in millicores (`500m`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "CPU limit of the service, either in cores (`2`, `1.5`) or in millicores (`500m`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "memory",
+				Type:        "string",
+				Note:        "",
+				Description: "Memory limit of the service, e.g. `512MiB`.\n\nThe service is OOM killed if it exceeds the limit.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Memory limit of the service, e.g. `512MiB`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "pids",
+				Type:        "int64",
+				Note:        "",
+				Description: "Maximum number of processes (and threads) in the service cgroup.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Maximum number of processes (and threads) in the service cgroup." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleServiceLimitsV1Alpha1())
+
+	doc.Fields[2].AddExample("", "500m")
+	doc.Fields[3].AddExample("", "512MiB")
+	doc.Fields[4].AddExample("", 1024)
+
+	return doc
+}
+
+func (ServicePolicyV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ServicePolicyConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ServicePolicyConfig is a config document to override the restart policy, health checks and startup timeout of a Talos or extension service." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ServicePolicyConfig is a config document to override the restart policy, health checks and startup timeout of a Talos or extension service.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "ID of the service, as shown by `talosctl services`, e.g. `kubelet` or `ext-nut-client`.\n\nThe policy is applied when the service is (re)started,\nand removing the document restores the built-in policy of the service on the next restart.\nThe effective policy is shown by `talosctl service <id>`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "ID of the service, as shown by `talosctl services`, e.g. `kubelet` or `ext-nut-client`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "restart",
+				Type:        "string",
+				Note:        "",
+				Description: "Restart policy of the service.\n\nOnly the services restarted by Talos are supported (all services except for machined and syslogd).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Restart policy of the service." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"always",
+					"untilSuccess",
+					"never",
+				},
+			},
+			{
+				Name:        "restartInterval",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Interval between the restarts of the failed service.\n\nDefault value is 5 seconds.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Interval between the restarts of the failed service." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "healthCheck",
+				Type:        "ServiceHealthCheck",
+				Note:        "",
+				Description: "Health check settings of the service.\n\nOnly the services with the built-in health checks are supported (apid, containerd, cri, etcd, kubelet, machined, syslogd, trustd, udevd).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Health check settings of the service." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "startupTimeout",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Time to wait for the service to be up on boot, the boot fails if the service is not up in time.\n\nOnly the services started after the machine config is loaded are supported (apid, cri, etcd, kubelet, trustd and extension services).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Time to wait for the service to be up on boot, the boot fails if the service is not up in time." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleServicePolicyV1Alpha1())
+
+	return doc
+}
+
+func (ServiceHealthCheck) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ServiceHealthCheck",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ServiceHealthCheck describes the health check settings of a service." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ServiceHealthCheck describes the health check settings of a service.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "ServicePolicyV1Alpha1",
+				FieldName: "healthCheck",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "initialDelay",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Delay before the first health check.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Delay before the first health check." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "period",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Interval between the health checks.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Interval between the health checks." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "timeout",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Timeout of a single health check.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Timeout of a single health check." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (SystemResourcesV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "SystemResourcesConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "SystemResourcesConfig is a config document to reserve resources for Talos system services." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "SystemResourcesConfig is a config document to reserve resources for Talos system services.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "reservedCPU",
+				Type:        "string",
+				Note:        "",
+				Description: "CPU to reserve for the system services, either in cores (`2`, `1.5`) or in millicores (`1500m`).\n\nThe reservation is split between the system cgroups (init, system and podruntime) proportionally\nto the built-in defaults, raising their CPU weight (the reservation never lowers the defaults).\nUnless `systemReserved` is set in the kubelet `extraConfig`, the value is also used as the kubelet `systemReserved` CPU.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "CPU to reserve for the system services, either in cores (`2`, `1.5`) or in millicores (`1500m`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "reservedMemory",
+				Type:        "string",
+				Note:        "",
+				Description: "Memory to reserve for the system services, e.g. `1GiB`.\n\nThe reservation is split between the system cgroups (init, system and podruntime) proportionally\nto the built-in defaults, raising their memory protection (the reservation never lowers the defaults).\nUnless `systemReserved` is set in the kubelet `extraConfig`, the value is also used as the kubelet `systemReserved` memory,\nso that the pods can't consume the reserved memory.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Memory to reserve for the system services, e.g. `1GiB`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "oomProtection",
+				Type:        "[]OOMProtectionConfig",
+				Note:        "",
+				Description: "OOM protection overrides for the core Talos services.\n\nBy default, the core services have the lowest OOM score adjustment\nand a memory protection (`memory.min`) of their cgroup, so that the kernel OOM killer picks other processes.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "OOM protection overrides for the core Talos services." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleSystemResourcesV1Alpha1())
+
+	doc.Fields[1].AddExample("", "1500m")
+	doc.Fields[2].AddExample("", "1GiB")
+
+	return doc
+}
+
+func (OOMProtectionConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "OOMProtectionConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "OOMProtectionConfig describes OOM protection of a core service." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "OOMProtectionConfig describes OOM protection of a core service.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "SystemResourcesV1Alpha1",
+				FieldName: "oomProtection",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "service",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the service.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the service." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"machined",
+					"apid",
+					"trustd",
+					"containerd",
+				},
+			},
+			{
+				Name:        "oomScoreAdj",
+				Type:        "int",
+				Note:        "",
+				Description: "OOM score adjustment of the service processes, from -1000 (never killed) to 1000.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "OOM score adjustment of the service processes, from -1000 (never killed) to 1000." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "memoryMin",
+				Type:        "string",
+				Note:        "",
+				Description: "Memory protection (`memory.min`) of the service cgroup, e.g. `128MiB`.\n\nIf the service has a memory limit, the limit is raised to the protection value.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Memory protection (`memory.min`) of the service cgroup, e.g. `128MiB`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (SNMPV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "SNMPConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "SNMPConfig is a SNMP agent config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "SNMPConfig is a SNMP agent config document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "listenAddress",
+				Type:        "string",
+				Note:        "",
+				Description: "Address to listen for the SNMP requests on (UDP).\n\nDefault value is \":161\".",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Address to listen for the SNMP requests on (UDP)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "community",
+				Type:        "string",
+				Note:        "",
+				Description: "Read-only community for the SNMPv2c requests.\n\nSNMPv2c is disabled if not set.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Read-only community for the SNMPv2c requests." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "users",
+				Type:        "[]SNMPUserV1Alpha1",
+				Note:        "",
+				Description: "List of the SNMPv3 users.\n\nOnly authenticated (authNoPriv and authPriv) requests are accepted.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of the SNMPv3 users." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "location",
+				Type:        "string",
+				Note:        "",
+				Description: "Value of the sysLocation object.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Value of the sysLocation object." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "contact",
+				Type:        "string",
+				Note:        "",
+				Description: "Value of the sysContact object.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Value of the sysContact object." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleSNMPV1Alpha1())
+
+	doc.Fields[1].AddExample("", "10.0.0.5:161")
+
+	return doc
+}
+
+func (SNMPUserV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "SNMPUserV1Alpha1",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "SNMPUserV1Alpha1 describes a SNMPv3 user." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "SNMPUserV1Alpha1 describes a SNMPv3 user.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "SNMPV1Alpha1",
+				FieldName: "users",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the user.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the user." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "authProtocol",
+				Type:        "string",
+				Note:        "",
+				Description: "Authentication protocol.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Authentication protocol." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"md5",
+					"sha",
+					"sha224",
+					"sha256",
+					"sha384",
+					"sha512",
+				},
+			},
+			{
+				Name:        "authPassphrase",
+				Type:        "string",
+				Note:        "",
+				Description: "Authentication passphrase, at least 8 characters long.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Authentication passphrase, at least 8 characters long." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "privProtocol",
+				Type:        "string",
+				Note:        "",
+				Description: "Privacy (encryption) protocol.\n\nResponses are not encrypted if not set.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Privacy (encryption) protocol." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"des",
+					"aes",
+					"aes192",
+					"aes256",
+				},
+			},
+			{
+				Name:        "privPassphrase",
+				Type:        "string",
+				Note:        "",
+				Description: "Privacy passphrase, at least 8 characters long.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Privacy passphrase, at least 8 characters long." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (UpgradeHealthCheckV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "UpgradeHealthCheckConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "UpgradeHealthCheckConfig is an upgrade health check config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "UpgradeHealthCheckConfig is an upgrade health check config document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "timeout",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Timeout for the health checks to pass after the upgrade.\n\nIf the health checks don't pass within this duration after the boot, Talos rolls back to the previous version.\n\nDefault value is 10 minutes, minimum value is 30 seconds.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Timeout for the health checks to pass after the upgrade." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "kubeletReady",
+				Type:        "bool",
+				Note:        "",
+				Description: "Require the Kubernetes node to be Ready.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Require the Kubernetes node to be Ready." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "services",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of services which should be running and healthy.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of services which should be running and healthy." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "httpProbes",
+				Type:        "[]HTTPProbe",
+				Note:        "",
+				Description: "List of HTTP probes which should succeed.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of HTTP probes which should succeed." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleUpgradeHealthCheckV1Alpha1())
+
+	doc.Fields[3].AddExample("", []string{"etcd", "kubelet"})
+
+	return doc
+}
+
+func (HTTPProbe) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "HTTPProbe",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "HTTPProbe describes an HTTP health check." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "HTTPProbe describes an HTTP health check.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "UpgradeHealthCheckV1Alpha1",
+				FieldName: "httpProbes",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "url",
+				Type:        "URL",
+				Note:        "",
+				Description: "URL to send the GET request to.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "URL to send the GET request to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "expectedStatus",
+				Type:        "int",
+				Note:        "",
+				Description: "Expected HTTP response status code.\n\nDefault value is 200.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Expected HTTP response status code." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (UserServiceV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "UserServiceConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "UserServiceConfig is a config document to run a user-defined system service." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "UserServiceConfig is a config document to run a user-defined system service.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the service.\n\nThe service runs outside of Kubernetes in the system containerd namespace,\nand it is managed by machined as `user-<name>` service (see `talosctl services`).\nThe service is restarted when the document changes, and stopped when the document is removed.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the service." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "image",
+				Type:        "string",
+				Note:        "",
+				Description: "Container image of the service.\n\nThe image is pulled using the machine registry configuration.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Container image of the service." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "command",
+				Type:        "[]string",
+				Note:        "",
+				Description: "Command to run, overrides the image entrypoint.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Command to run, overrides the image entrypoint." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "args",
+				Type:        "[]string",
+				Note:        "",
+				Description: "Arguments of the command, override the image command.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Arguments of the command, override the image command." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "environment",
+				Type:        "[]string",
+				Note:        "",
+				Description: "Environment variables of the service in the `KEY=value` format.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Environment variables of the service in the `KEY=value` format." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "mounts",
+				Type:        "[]UserServiceMount",
+				Note:        "",
+				Description: "Host paths to mount into the service container.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Host paths to mount into the service container." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "restart",
+				Type:        "string",
+				Note:        "",
+				Description: "Restart policy of the service.\n\nDefault value is `always`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Restart policy of the service." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"always",
+					"untilSuccess",
+					"never",
+				},
+			},
+			{
+				Name:        "requiredByKubelet",
+				Type:        "bool",
+				Note:        "",
+				Description: "Start the kubelet only once the service is up.\n\nUseful for the node agents which should be running before any pod is scheduled to the node,\ne.g. security agents or storage drivers.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Start the kubelet only once the service is up." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleUserServiceV1Alpha1())
+
+	return doc
+}
+
+func (UserServiceMount) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "UserServiceMount",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "UserServiceMount describes a mount of the user service." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "UserServiceMount describes a mount of the user service.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "UserServiceV1Alpha1",
+				FieldName: "mounts",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "source",
+				Type:        "string",
+				Note:        "",
+				Description: "Source path on the host.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Source path on the host." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "destination",
+				Type:        "string",
+				Note:        "",
+				Description: "Destination path in the service container.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Destination path in the service container." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "type",
+				Type:        "string",
+				Note:        "",
+				Description: "Mount type, defaults to `bind`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Mount type, defaults to `bind`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "options",
+				Type:        "[]string",
+				Note:        "",
+				Description: "Mount options, defaults to `rbind,rw` for bind mounts.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Mount options, defaults to `rbind,rw` for bind mounts." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
 func (WatchdogTimerV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "WatchdogTimerConfig",
@@ -95,6 +864,82 @@ func (WatchdogTimerV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (WebhookNotificationV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "WebhookNotificationConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "WebhookNotificationConfig is a webhook notification config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "WebhookNotificationConfig is a webhook notification config document.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "name",
+				Type:        "string",
+				Note:        "",
+				Description: "Name of the config document.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Name of the config document." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "url",
+				Type:        "URL",
+				Note:        "",
+				Description: "URL to send the notifications to.\n\nEach notification is sent as a POST request, failed requests are retried with an exponential backoff.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "URL to send the notifications to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "format",
+				Type:        "string",
+				Note:        "",
+				Description: "Format of the request body.\n\nThe 'json' format sends the JSON description of the event,\nthe 'slack' format sends a message compatible with the Slack incoming webhooks.\n\nDefault value is 'json'.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Format of the request body." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"json",
+					"slack",
+				},
+			},
+			{
+				Name:        "events",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of the events to send, all events are sent if not set.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of the events to send, all events are sent if not set." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"upgrade-failed",
+					"boot-fallback",
+					"disk-failure",
+					"certificate-expiring",
+				},
+			},
+			{
+				Name:        "headers",
+				Type:        "map[string]string",
+				Note:        "",
+				Description: "Extra HTTP headers to send with the request, e.g. for authentication.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Extra HTTP headers to send with the request, e.g. for authentication." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "signingKey",
+				Type:        "string",
+				Note:        "",
+				Description: "Key to sign the request body with.\n\nIf set, the HMAC-SHA256 signature of the request timestamp and body joined with '.' is sent in the 'X-Talos-Signature' header\nas 'sha256=<hex digest>', and the timestamp (Unix seconds) is sent in the 'X-Talos-Timestamp' header.\nReceivers should reject the requests with the timestamp more than 5 minutes away from their clock to prevent the replays.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Key to sign the request body with." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "timeout",
+				Type:        "Duration",
+				Note:        "",
+				Description: "Timeout for each request.\n\nDefault value is 10 seconds, maximum value is 5 minutes.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Timeout for each request." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleWebhookNotificationV1Alpha1())
+
+	doc.Fields[5].AddExample("", map[string]string{"Authorization": "Bearer token"})
+
+	return doc
+}
+
 // GetFileDoc returns documentation for the file runtime_doc.go.
 func GetFileDoc() *encoder.FileDoc {
 	return &encoder.FileDoc{
@@ -103,7 +948,25 @@ func GetFileDoc() *encoder.FileDoc {
 		Structs: []*encoder.Doc{
 			KmsgLogV1Alpha1{}.Doc(),
 			EventSinkV1Alpha1{}.Doc(),
+			MetricsV1Alpha1{}.Doc(),
+			PerformanceV1Alpha1{}.Doc(),
+			HugePagesConfig{}.Doc(),
+			HugePagesNodeConfig{}.Doc(),
+			CPUIsolationConfig{}.Doc(),
+			SequenceHookV1Alpha1{}.Doc(),
+			ServiceLimitsV1Alpha1{}.Doc(),
+			ServicePolicyV1Alpha1{}.Doc(),
+			ServiceHealthCheck{}.Doc(),
+			SystemResourcesV1Alpha1{}.Doc(),
+			OOMProtectionConfig{}.Doc(),
+			SNMPV1Alpha1{}.Doc(),
+			SNMPUserV1Alpha1{}.Doc(),
+			UpgradeHealthCheckV1Alpha1{}.Doc(),
+			HTTPProbe{}.Doc(),
+			UserServiceV1Alpha1{}.Doc(),
+			UserServiceMount{}.Doc(),
 			WatchdogTimerV1Alpha1{}.Doc(),
+			WebhookNotificationV1Alpha1{}.Doc(),
 		},
 	}
 }
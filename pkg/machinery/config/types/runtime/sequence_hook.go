@@ -0,0 +1,264 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/siderolabs/gen/ensure"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// SequenceHookKind is a sequence hook config document kind.
+const SequenceHookKind = "SequenceHookConfig"
+
+func init() {
+	registry.Register(SequenceHookKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &SequenceHookV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.RuntimeConfig      = &SequenceHookV1Alpha1{}
+	_ config.SequenceHookConfig = &SequenceHookV1Alpha1{}
+	_ config.NamedDocument      = &SequenceHookV1Alpha1{}
+	_ config.Validator          = &SequenceHookV1Alpha1{}
+)
+
+// Timeout constants.
+const (
+	DefaultSequenceHookTimeout = 30 * time.Second
+	MaxSequenceHookTimeout     = time.Hour
+)
+
+// Failure policies.
+const (
+	SequenceHookFailurePolicyFail   = "fail"
+	SequenceHookFailurePolicyIgnore = "ignore"
+)
+
+var sequenceHookPoints = []string{
+	config.SequenceHookPreInstall,
+	config.SequenceHookPostBoot,
+	config.SequenceHookPreUpgrade,
+	config.SequenceHookPostUpgrade,
+}
+
+// SequenceHookV1Alpha1 is a sequence hook config document.
+//
+//	examples:
+//	  - value: exampleSequenceHookV1Alpha1()
+//	alias: SequenceHookConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/SequenceHookConfig
+type SequenceHookV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the config document.
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     The sequence point to run the hook at.
+	//
+	//     The pre-install and pre-upgrade hooks run before the installation and upgrade start,
+	//     so they can be used to approve the operation.
+	//     The post-boot hook runs once all services are started on each boot,
+	//     and the post-upgrade hook runs at the same point on the first boot after an upgrade.
+	//   values:
+	//     - "pre-install"
+	//     - "post-boot"
+	//     - "pre-upgrade"
+	//     - "post-upgrade"
+	//   schemaRequired: true
+	HookPoint string `yaml:"point"`
+	//   description: |
+	//     URL to send the POST request to.
+	//
+	//     The hook sends a POST request with the JSON description of the event to the URL
+	//     when the machine reaches the sequence point.
+	//     The hook succeeds if the response status code is 2xx.
+	//   schemaRequired: true
+	//   schema:
+	//     type: string
+	//     pattern: "^(http|https)://"
+	HookURL meta.URL `yaml:"url"`
+	//   description: |
+	//     Extra HTTP headers to send with the request, e.g. for authentication.
+	//   examples:
+	//     - value: >
+	//        map[string]string{"Authorization": "Bearer token"}
+	HookHeaders map[string]string `yaml:"headers,omitempty"`
+	//   description: |
+	//     Timeout for the hook to complete.
+	//
+	//     Default value is 30 seconds, maximum value is 1 hour.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	HookTimeout time.Duration `yaml:"timeout,omitempty"`
+	//   description: |
+	//     The action to take when the hook fails or times out.
+	//
+	//     With the 'fail' policy the sequence is aborted, e.g. the upgrade is not performed.
+	//     With the 'ignore' policy the failure is logged and the sequence continues.
+	//
+	//     Default value is 'fail'.
+	//   values:
+	//     - "fail"
+	//     - "ignore"
+	HookFailurePolicy string `yaml:"failurePolicy,omitempty"`
+}
+
+// NewSequenceHookV1Alpha1 creates a new sequence hook config document.
+func NewSequenceHookV1Alpha1() *SequenceHookV1Alpha1 {
+	return &SequenceHookV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       SequenceHookKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleSequenceHookV1Alpha1() *SequenceHookV1Alpha1 {
+	cfg := NewSequenceHookV1Alpha1()
+	cfg.MetaName = "upgrade-approval"
+	cfg.HookPoint = config.SequenceHookPreUpgrade
+	cfg.HookURL.URL = ensure.Value(url.Parse("https://cmdb.example.com/hooks/talos"))
+	cfg.HookTimeout = 5 * time.Minute
+	cfg.HookFailurePolicy = SequenceHookFailurePolicyFail
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *SequenceHookV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *SequenceHookV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Runtime implements config.Config interface.
+func (s *SequenceHookV1Alpha1) Runtime() config.RuntimeConfig {
+	return s
+}
+
+// EventsEndpoint implements config.RuntimeConfig interface.
+func (s *SequenceHookV1Alpha1) EventsEndpoint() *string {
+	return nil
+}
+
+// KmsgLogURLs implements config.RuntimeConfig interface.
+func (s *SequenceHookV1Alpha1) KmsgLogURLs() []*url.URL {
+	return nil
+}
+
+// WatchdogTimer implements config.RuntimeConfig interface.
+func (s *SequenceHookV1Alpha1) WatchdogTimer() config.WatchdogTimerConfig {
+	return nil
+}
+
+// UpgradeHealthCheck implements config.RuntimeConfig interface.
+func (s *SequenceHookV1Alpha1) UpgradeHealthCheck() config.UpgradeHealthCheckConfig {
+	return nil
+}
+
+// Metrics implements config.RuntimeConfig interface.
+func (s *SequenceHookV1Alpha1) Metrics() config.MetricsConfig {
+	return nil
+}
+
+// SequenceHooks implements config.RuntimeConfig interface.
+func (s *SequenceHookV1Alpha1) SequenceHooks() []config.SequenceHookConfig {
+	return []config.SequenceHookConfig{s}
+}
+
+// WebhookNotifications implements config.RuntimeConfig interface.
+func (s *SequenceHookV1Alpha1) WebhookNotifications() []config.WebhookNotificationConfig {
+	return nil
+}
+
+// SNMP implements config.RuntimeConfig interface.
+func (s *SequenceHookV1Alpha1) SNMP() config.SNMPConfig {
+	return nil
+}
+
+// Point implements config.SequenceHookConfig interface.
+func (s *SequenceHookV1Alpha1) Point() string {
+	return s.HookPoint
+}
+
+// URL implements config.SequenceHookConfig interface.
+func (s *SequenceHookV1Alpha1) URL() *url.URL {
+	return s.HookURL.URL
+}
+
+// Headers implements config.SequenceHookConfig interface.
+func (s *SequenceHookV1Alpha1) Headers() map[string]string {
+	return s.HookHeaders
+}
+
+// Timeout implements config.SequenceHookConfig interface.
+func (s *SequenceHookV1Alpha1) Timeout() time.Duration {
+	if s.HookTimeout == 0 {
+		return DefaultSequenceHookTimeout
+	}
+
+	return s.HookTimeout
+}
+
+// IgnoreFailure implements config.SequenceHookConfig interface.
+func (s *SequenceHookV1Alpha1) IgnoreFailure() bool {
+	return s.HookFailurePolicy == SequenceHookFailurePolicyIgnore
+}
+
+// Validate implements config.Validator interface.
+func (s *SequenceHookV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.MetaName == "" {
+		errs = errors.Join(errs, errors.New("name is required"))
+	}
+
+	if !slices.Contains(sequenceHookPoints, s.HookPoint) {
+		errs = errors.Join(errs, fmt.Errorf("point: unsupported value %q, expected one of %q", s.HookPoint, sequenceHookPoints))
+	}
+
+	if s.HookURL.URL == nil {
+		errs = errors.Join(errs, errors.New("url is required"))
+	} else if s.HookURL.Scheme != "http" && s.HookURL.Scheme != "https" {
+		errs = errors.Join(errs, fmt.Errorf("url: unsupported scheme %q", s.HookURL.Scheme))
+	}
+
+	if s.HookTimeout < 0 || s.HookTimeout > MaxSequenceHookTimeout {
+		errs = errors.Join(errs, fmt.Errorf("timeout: should be between 0 and %s", MaxSequenceHookTimeout))
+	}
+
+	switch s.HookFailurePolicy {
+	case "", SequenceHookFailurePolicyFail, SequenceHookFailurePolicyIgnore:
+	default:
+		errs = errors.Join(errs, fmt.Errorf("failurePolicy: unsupported value %q", s.HookFailurePolicy))
+	}
+
+	return nil, errs
+}
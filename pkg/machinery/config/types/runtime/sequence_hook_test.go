@@ -0,0 +1,114 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/gen/ensure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+)
+
+//go:embed testdata/sequencehook.yaml
+var expectedSequenceHookDocument []byte
+
+func TestSequenceHookMarshalStability(t *testing.T) {
+	cfg := runtime.NewSequenceHookV1Alpha1()
+	cfg.MetaName = "upgrade-approval"
+	cfg.HookPoint = config.SequenceHookPreUpgrade
+	cfg.HookURL.URL = ensure.Value(url.Parse("https://cmdb.example.com/hooks/talos"))
+	cfg.HookHeaders = map[string]string{"Authorization": "Bearer token"}
+	cfg.HookTimeout = 5 * time.Minute
+	cfg.HookFailurePolicy = runtime.SequenceHookFailurePolicyIgnore
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedSequenceHookDocument, marshaled)
+}
+
+func TestSequenceHookUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedSequenceHookDocument)
+	require.NoError(t, err)
+
+	hooks := provider.Runtime().SequenceHooks()
+	require.Len(t, hooks, 1)
+
+	assert.Equal(t, "upgrade-approval", hooks[0].Name())
+	assert.Equal(t, config.SequenceHookPreUpgrade, hooks[0].Point())
+	assert.Equal(t, "https://cmdb.example.com/hooks/talos", hooks[0].URL().String())
+	assert.Equal(t, map[string]string{"Authorization": "Bearer token"}, hooks[0].Headers())
+	assert.Equal(t, 5*time.Minute, hooks[0].Timeout())
+	assert.True(t, hooks[0].IgnoreFailure())
+}
+
+func TestSequenceHookValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *runtime.SequenceHookV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  runtime.NewSequenceHookV1Alpha1,
+
+			expectedError: "name is required\npoint: unsupported value \"\", expected one of [\"pre-install\" \"post-boot\" \"pre-upgrade\" \"post-upgrade\"]\nurl is required",
+		},
+		{
+			name: "invalid",
+			cfg: func() *runtime.SequenceHookV1Alpha1 {
+				cfg := runtime.NewSequenceHookV1Alpha1()
+				cfg.MetaName = "hook"
+				cfg.HookPoint = config.SequenceHookPostBoot
+				cfg.HookURL.URL = ensure.Value(url.Parse("tcp://localhost:8080"))
+				cfg.HookTimeout = 2 * time.Hour
+				cfg.HookFailurePolicy = "retry"
+
+				return cfg
+			},
+
+			expectedError: "url: unsupported scheme \"tcp\"\ntimeout: should be between 0 and 1h0m0s\nfailurePolicy: unsupported value \"retry\"",
+		},
+		{
+			name: "valid",
+			cfg: func() *runtime.SequenceHookV1Alpha1 {
+				cfg := runtime.NewSequenceHookV1Alpha1()
+				cfg.MetaName = "hook"
+				cfg.HookPoint = config.SequenceHookPostUpgrade
+				cfg.HookURL.URL = ensure.Value(url.Parse("http://localhost:8080/hook"))
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dustin/go-humanize"
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// ServiceLimitsKind is a service limits config document kind.
+const ServiceLimitsKind = "ServiceLimitsConfig"
+
+func init() {
+	registry.Register(ServiceLimitsKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &ServiceLimitsV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.ServiceLimitsConfig = &ServiceLimitsV1Alpha1{}
+	_ config.NamedDocument       = &ServiceLimitsV1Alpha1{}
+	_ config.Validator           = &ServiceLimitsV1Alpha1{}
+)
+
+// MinServiceLimitMilliCPU is the minimum CPU limit of a service (cgroup CPU quota can't be lower than 1ms per 100ms period).
+const MinServiceLimitMilliCPU = 10
+
+// ServiceLimitsV1Alpha1 is a config document to limit resources of a Talos or extension service.
+//
+//	examples:
+//	  - value: exampleServiceLimitsV1Alpha1()
+//	alias: ServiceLimitsConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/ServiceLimitsConfig
+type ServiceLimitsV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     ID of the service to limit, as shown by `talosctl services`, e.g. `udevd` or `ext-nut-client`.
+	//
+	//     The limits are applied to the cgroup of the service, so only the services running in a dedicated cgroup
+	//     are supported (apid, trustd, etcd, kubelet, udevd, dashboard, cri, containerd and extension services).
+	//     The limits are re-applied when the service is restarted,
+	//     and removing the document restores the built-in limits of the service.
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     CPU limit of the service, either in cores (`2`, `1.5`) or in millicores (`500m`).
+	//   examples:
+	//     - value: >
+	//        "500m"
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9]+(\.[0-9]+|m)?$
+	LimitCPU string `yaml:"cpu,omitempty"`
+	//   description: |
+	//     Memory limit of the service, e.g. `512MiB`.
+	//
+	//     The service is OOM killed if it exceeds the limit.
+	//   examples:
+	//     - value: >
+	//        "512MiB"
+	//   schema:
+	//     type: string
+	LimitMemory string `yaml:"memory,omitempty"`
+	//   description: |
+	//     Maximum number of processes (and threads) in the service cgroup.
+	//   examples:
+	//     - value: 1024
+	LimitPids int64 `yaml:"pids,omitempty"`
+}
+
+// NewServiceLimitsV1Alpha1 creates a new service limits config document.
+func NewServiceLimitsV1Alpha1() *ServiceLimitsV1Alpha1 {
+	return &ServiceLimitsV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       ServiceLimitsKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleServiceLimitsV1Alpha1() *ServiceLimitsV1Alpha1 {
+	cfg := NewServiceLimitsV1Alpha1()
+	cfg.MetaName = "ext-nut-client"
+	cfg.LimitCPU = "500m"
+	cfg.LimitMemory = "512MiB"
+	cfg.LimitPids = 1024
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *ServiceLimitsV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *ServiceLimitsV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Service implements config.ServiceLimitsConfig interface.
+func (s *ServiceLimitsV1Alpha1) Service() string {
+	return s.MetaName
+}
+
+// MilliCPU implements config.ServiceLimitsConfig interface.
+func (s *ServiceLimitsV1Alpha1) MilliCPU() optional.Optional[int64] {
+	if s.LimitCPU == "" {
+		return optional.None[int64]()
+	}
+
+	milliCPU, err := parseMilliCPU(s.LimitCPU)
+	if err != nil {
+		return optional.None[int64]()
+	}
+
+	return optional.Some(milliCPU)
+}
+
+// Memory implements config.ServiceLimitsConfig interface.
+func (s *ServiceLimitsV1Alpha1) Memory() optional.Optional[uint64] {
+	if s.LimitMemory == "" {
+		return optional.None[uint64]()
+	}
+
+	memory, err := humanize.ParseBytes(s.LimitMemory)
+	if err != nil {
+		return optional.None[uint64]()
+	}
+
+	return optional.Some(memory)
+}
+
+// Pids implements config.ServiceLimitsConfig interface.
+func (s *ServiceLimitsV1Alpha1) Pids() optional.Optional[int64] {
+	if s.LimitPids == 0 {
+		return optional.None[int64]()
+	}
+
+	return optional.Some(s.LimitPids)
+}
+
+// Validate implements config.Validator interface.
+func (s *ServiceLimitsV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.MetaName == "" {
+		errs = errors.Join(errs, errors.New("name is required"))
+	}
+
+	if s.LimitCPU == "" && s.LimitMemory == "" && s.LimitPids == 0 {
+		errs = errors.Join(errs, errors.New("either cpu, memory or pids should be set"))
+	}
+
+	if s.LimitCPU != "" {
+		milliCPU, err := parseMilliCPU(s.LimitCPU)
+
+		switch {
+		case err != nil:
+			errs = errors.Join(errs, fmt.Errorf("cpu: %w", err))
+		case milliCPU < MinServiceLimitMilliCPU:
+			errs = errors.Join(errs, fmt.Errorf("cpu: should be at least %dm", MinServiceLimitMilliCPU))
+		}
+	}
+
+	if s.LimitMemory != "" {
+		if _, err := humanize.ParseBytes(s.LimitMemory); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("memory: %w", err))
+		}
+	}
+
+	if s.LimitPids < 0 {
+		errs = errors.Join(errs, errors.New("pids: should be positive"))
+	}
+
+	return nil, errs
+}
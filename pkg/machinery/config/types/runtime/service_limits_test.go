@@ -0,0 +1,136 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/siderolabs/gen/optional"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+)
+
+//go:embed testdata/servicelimits.yaml
+var expectedServiceLimitsDocument []byte
+
+func TestServiceLimitsMarshalStability(t *testing.T) {
+	cfg := runtime.NewServiceLimitsV1Alpha1()
+	cfg.MetaName = "ext-nut-client"
+	cfg.LimitCPU = "500m"
+	cfg.LimitMemory = "512MiB"
+	cfg.LimitPids = 1024
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedServiceLimitsDocument, marshaled)
+}
+
+func TestServiceLimitsUnmarshal(t *testing.T) {
+	provider, err := configloader.NewFromBytes(expectedServiceLimitsDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &runtime.ServiceLimitsV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       runtime.ServiceLimitsKind,
+		},
+		MetaName:    "ext-nut-client",
+		LimitCPU:    "500m",
+		LimitMemory: "512MiB",
+		LimitPids:   1024,
+	}, docs[0])
+
+	limits := provider.ServiceLimits()
+	require.Len(t, limits, 1)
+
+	assert.Equal(t, "ext-nut-client", limits[0].Service())
+	assert.Equal(t, optional.Some[int64](500), limits[0].MilliCPU())
+	assert.Equal(t, optional.Some[uint64](512*1024*1024), limits[0].Memory())
+	assert.Equal(t, optional.Some[int64](1024), limits[0].Pids())
+}
+
+func TestServiceLimitsValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *runtime.ServiceLimitsV1Alpha1
+
+		expectedMilliCPU optional.Optional[int64]
+		expectedError    string
+	}{
+		{
+			name: "empty",
+			cfg:  runtime.NewServiceLimitsV1Alpha1,
+
+			expectedError: "name is required\neither cpu, memory or pids should be set",
+		},
+		{
+			name: "cores",
+			cfg: func() *runtime.ServiceLimitsV1Alpha1 {
+				cfg := runtime.NewServiceLimitsV1Alpha1()
+				cfg.MetaName = "udevd"
+				cfg.LimitCPU = "0.5"
+
+				return cfg
+			},
+
+			expectedMilliCPU: optional.Some[int64](500),
+		},
+		{
+			name: "pids only",
+			cfg: func() *runtime.ServiceLimitsV1Alpha1 {
+				cfg := runtime.NewServiceLimitsV1Alpha1()
+				cfg.MetaName = "udevd"
+				cfg.LimitPids = 100
+
+				return cfg
+			},
+		},
+		{
+			name: "invalid",
+			cfg: func() *runtime.ServiceLimitsV1Alpha1 {
+				cfg := runtime.NewServiceLimitsV1Alpha1()
+				cfg.MetaName = "udevd"
+				cfg.LimitCPU = "5m"
+				cfg.LimitMemory = "lots"
+				cfg.LimitPids = -1
+
+				return cfg
+			},
+
+			expectedError: "cpu: should be at least 10m\nmemory: strconv.ParseFloat: parsing \"\": invalid syntax\npids: should be positive",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := test.cfg()
+
+			_, err := cfg.Validate(validationMode{})
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedMilliCPU, cfg.MilliCPU())
+		})
+	}
+}
@@ -0,0 +1,252 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/siderolabs/gen/optional"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	extservices "github.com/siderolabs/talos/pkg/machinery/extensions/services"
+)
+
+// ServicePolicyKind is a service policy config document kind.
+const ServicePolicyKind = "ServicePolicyConfig"
+
+func init() {
+	registry.Register(ServicePolicyKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &ServicePolicyV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.ServicePolicyConfig = &ServicePolicyV1Alpha1{}
+	_ config.NamedDocument       = &ServicePolicyV1Alpha1{}
+	_ config.Validator           = &ServicePolicyV1Alpha1{}
+)
+
+// ServicePolicyV1Alpha1 is a config document to override the restart policy, health checks and startup timeout of a Talos or extension service.
+//
+//	examples:
+//	  - value: exampleServicePolicyV1Alpha1()
+//	alias: ServicePolicyConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/ServicePolicyConfig
+type ServicePolicyV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     ID of the service, as shown by `talosctl services`, e.g. `kubelet` or `ext-nut-client`.
+	//
+	//     The policy is applied when the service is (re)started,
+	//     and removing the document restores the built-in policy of the service on the next restart.
+	//     The effective policy is shown by `talosctl service <id>`.
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Restart policy of the service.
+	//
+	//     Only the services restarted by Talos are supported (all services except for machined and syslogd).
+	//   values:
+	//     - "always"
+	//     - "untilSuccess"
+	//     - "never"
+	PolicyRestart string `yaml:"restart,omitempty"`
+	//   description: |
+	//     Interval between the restarts of the failed service.
+	//
+	//     Default value is 5 seconds.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	PolicyRestartInterval time.Duration `yaml:"restartInterval,omitempty"`
+	//   description: |
+	//     Health check settings of the service.
+	//
+	//     Only the services with the built-in health checks are supported (apid, containerd, cri, etcd, kubelet, machined, syslogd, trustd, udevd).
+	PolicyHealthCheck *ServiceHealthCheck `yaml:"healthCheck,omitempty"`
+	//   description: |
+	//     Time to wait for the service to be up on boot, the boot fails if the service is not up in time.
+	//
+	//     Only the services started after the machine config is loaded are supported (apid, cri, etcd, kubelet, trustd and extension services).
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	PolicyStartupTimeout time.Duration `yaml:"startupTimeout,omitempty"`
+}
+
+// ServiceHealthCheck describes the health check settings of a service.
+type ServiceHealthCheck struct {
+	//   description: |
+	//     Delay before the first health check.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	HealthCheckInitialDelay time.Duration `yaml:"initialDelay,omitempty"`
+	//   description: |
+	//     Interval between the health checks.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	HealthCheckPeriod time.Duration `yaml:"period,omitempty"`
+	//   description: |
+	//     Timeout of a single health check.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	HealthCheckTimeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// NewServicePolicyV1Alpha1 creates a new service policy config document.
+func NewServicePolicyV1Alpha1() *ServicePolicyV1Alpha1 {
+	return &ServicePolicyV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       ServicePolicyKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleServicePolicyV1Alpha1() *ServicePolicyV1Alpha1 {
+	cfg := NewServicePolicyV1Alpha1()
+	cfg.MetaName = "kubelet"
+	cfg.PolicyRestartInterval = 10 * time.Second
+	cfg.PolicyHealthCheck = &ServiceHealthCheck{
+		HealthCheckPeriod:  10 * time.Second,
+		HealthCheckTimeout: 2 * time.Second,
+	}
+	cfg.PolicyStartupTimeout = 30 * time.Minute
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *ServicePolicyV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *ServicePolicyV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Service implements config.ServicePolicyConfig interface.
+func (s *ServicePolicyV1Alpha1) Service() string {
+	return s.MetaName
+}
+
+// Restart implements config.ServicePolicyConfig interface.
+func (s *ServicePolicyV1Alpha1) Restart() optional.Optional[extservices.RestartKind] {
+	if s.PolicyRestart == "" {
+		return optional.None[extservices.RestartKind]()
+	}
+
+	restart, err := extservices.RestartKindString(s.PolicyRestart)
+	if err != nil {
+		return optional.None[extservices.RestartKind]()
+	}
+
+	return optional.Some(restart)
+}
+
+// RestartInterval implements config.ServicePolicyConfig interface.
+func (s *ServicePolicyV1Alpha1) RestartInterval() optional.Optional[time.Duration] {
+	return optionalDuration(s.PolicyRestartInterval)
+}
+
+// HealthCheckInitialDelay implements config.ServicePolicyConfig interface.
+func (s *ServicePolicyV1Alpha1) HealthCheckInitialDelay() optional.Optional[time.Duration] {
+	if s.PolicyHealthCheck == nil {
+		return optional.None[time.Duration]()
+	}
+
+	return optionalDuration(s.PolicyHealthCheck.HealthCheckInitialDelay)
+}
+
+// HealthCheckPeriod implements config.ServicePolicyConfig interface.
+func (s *ServicePolicyV1Alpha1) HealthCheckPeriod() optional.Optional[time.Duration] {
+	if s.PolicyHealthCheck == nil {
+		return optional.None[time.Duration]()
+	}
+
+	return optionalDuration(s.PolicyHealthCheck.HealthCheckPeriod)
+}
+
+// HealthCheckTimeout implements config.ServicePolicyConfig interface.
+func (s *ServicePolicyV1Alpha1) HealthCheckTimeout() optional.Optional[time.Duration] {
+	if s.PolicyHealthCheck == nil {
+		return optional.None[time.Duration]()
+	}
+
+	return optionalDuration(s.PolicyHealthCheck.HealthCheckTimeout)
+}
+
+// StartupTimeout implements config.ServicePolicyConfig interface.
+func (s *ServicePolicyV1Alpha1) StartupTimeout() optional.Optional[time.Duration] {
+	return optionalDuration(s.PolicyStartupTimeout)
+}
+
+func optionalDuration(d time.Duration) optional.Optional[time.Duration] {
+	if d == 0 {
+		return optional.None[time.Duration]()
+	}
+
+	return optional.Some(d)
+}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo
+func (s *ServicePolicyV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.MetaName == "" {
+		errs = errors.Join(errs, errors.New("name is required"))
+	}
+
+	if s.PolicyRestart != "" {
+		if _, err := extservices.RestartKindString(s.PolicyRestart); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("restart: %w", err))
+		}
+	}
+
+	checkDuration := func(name string, d time.Duration) {
+		if d < 0 {
+			errs = errors.Join(errs, fmt.Errorf("%s: should be positive", name))
+		}
+	}
+
+	checkDuration("restartInterval", s.PolicyRestartInterval)
+	checkDuration("startupTimeout", s.PolicyStartupTimeout)
+
+	if s.PolicyHealthCheck != nil {
+		checkDuration("healthCheck.initialDelay", s.PolicyHealthCheck.HealthCheckInitialDelay)
+		checkDuration("healthCheck.period", s.PolicyHealthCheck.HealthCheckPeriod)
+		checkDuration("healthCheck.timeout", s.PolicyHealthCheck.HealthCheckTimeout)
+	}
+
+	if s.PolicyHealthCheck != nil && s.PolicyHealthCheck.HealthCheckPeriod > 0 && s.PolicyHealthCheck.HealthCheckTimeout > s.PolicyHealthCheck.HealthCheckPeriod {
+		errs = errors.Join(errs, errors.New("healthCheck.timeout: should not exceed the period"))
+	}
+
+	if s.PolicyRestart == "" && s.PolicyRestartInterval == 0 && s.PolicyHealthCheck == nil && s.PolicyStartupTimeout == 0 {
+		errs = errors.Join(errs, errors.New("either restart, restartInterval, healthCheck or startupTimeout should be set"))
+	}
+
+	return nil, errs
+}
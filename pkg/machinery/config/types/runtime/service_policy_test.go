@@ -0,0 +1,135 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/gen/optional"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	extservices "github.com/siderolabs/talos/pkg/machinery/extensions/services"
+)
+
+//go:embed testdata/servicepolicy.yaml
+var expectedServicePolicyDocument []byte
+
+func TestServicePolicyMarshalStability(t *testing.T) {
+	cfg := runtime.NewServicePolicyV1Alpha1()
+	cfg.MetaName = "cri"
+	cfg.PolicyRestart = "always"
+	cfg.PolicyRestartInterval = 10 * time.Second
+	cfg.PolicyHealthCheck = &runtime.ServiceHealthCheck{
+		HealthCheckPeriod:  10 * time.Second,
+		HealthCheckTimeout: 2 * time.Second,
+	}
+	cfg.PolicyStartupTimeout = 20 * time.Minute
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedServicePolicyDocument, marshaled)
+}
+
+func TestServicePolicyUnmarshal(t *testing.T) {
+	provider, err := configloader.NewFromBytes(expectedServicePolicyDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &runtime.ServicePolicyV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       runtime.ServicePolicyKind,
+		},
+		MetaName:              "cri",
+		PolicyRestart:         "always",
+		PolicyRestartInterval: 10 * time.Second,
+		PolicyHealthCheck: &runtime.ServiceHealthCheck{
+			HealthCheckPeriod:  10 * time.Second,
+			HealthCheckTimeout: 2 * time.Second,
+		},
+		PolicyStartupTimeout: 20 * time.Minute,
+	}, docs[0])
+
+	policies := provider.ServicePolicies()
+	require.Len(t, policies, 1)
+
+	assert.Equal(t, "cri", policies[0].Service())
+	assert.Equal(t, optional.Some(extservices.RestartAlways), policies[0].Restart())
+	assert.Equal(t, optional.Some(10*time.Second), policies[0].RestartInterval())
+	assert.Equal(t, optional.None[time.Duration](), policies[0].HealthCheckInitialDelay())
+	assert.Equal(t, optional.Some(10*time.Second), policies[0].HealthCheckPeriod())
+	assert.Equal(t, optional.Some(2*time.Second), policies[0].HealthCheckTimeout())
+	assert.Equal(t, optional.Some(20*time.Minute), policies[0].StartupTimeout())
+}
+
+func TestServicePolicyValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *runtime.ServicePolicyV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  runtime.NewServicePolicyV1Alpha1,
+
+			expectedError: "name is required\neither restart, restartInterval, healthCheck or startupTimeout should be set",
+		},
+		{
+			name: "startup timeout",
+			cfg: func() *runtime.ServicePolicyV1Alpha1 {
+				cfg := runtime.NewServicePolicyV1Alpha1()
+				cfg.MetaName = "etcd"
+				cfg.PolicyStartupTimeout = time.Hour
+
+				return cfg
+			},
+		},
+		{
+			name: "invalid",
+			cfg: func() *runtime.ServicePolicyV1Alpha1 {
+				cfg := runtime.NewServicePolicyV1Alpha1()
+				cfg.MetaName = "kubelet"
+				cfg.PolicyRestart = "sometimes"
+				cfg.PolicyRestartInterval = -time.Second
+				cfg.PolicyHealthCheck = &runtime.ServiceHealthCheck{
+					HealthCheckPeriod:  time.Second,
+					HealthCheckTimeout: 5 * time.Second,
+				}
+
+				return cfg
+			},
+
+			expectedError: "restart: sometimes does not belong to RestartKind values\nrestartInterval: should be positive\nhealthCheck.timeout: should not exceed the period",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,319 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+
+	"github.com/siderolabs/gen/xslices"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// SNMPKind is a SNMP agent config document kind.
+const SNMPKind = "SNMPConfig"
+
+func init() {
+	registry.Register(SNMPKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &SNMPV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.RuntimeConfig = &SNMPV1Alpha1{}
+	_ config.SNMPConfig    = &SNMPV1Alpha1{}
+	_ config.Validator     = &SNMPV1Alpha1{}
+)
+
+// DefaultSNMPListenAddress is the default listen address of the SNMP agent.
+const DefaultSNMPListenAddress = ":161"
+
+// minSNMPPassphraseLength is the minimum length of the SNMPv3 passphrases (RFC 3414).
+const minSNMPPassphraseLength = 8
+
+var (
+	snmpAuthProtocols = []string{
+		config.SNMPAuthProtocolMD5,
+		config.SNMPAuthProtocolSHA,
+		config.SNMPAuthProtocolSHA224,
+		config.SNMPAuthProtocolSHA256,
+		config.SNMPAuthProtocolSHA384,
+		config.SNMPAuthProtocolSHA512,
+	}
+
+	snmpPrivProtocols = []string{
+		config.SNMPPrivProtocolDES,
+		config.SNMPPrivProtocolAES,
+		config.SNMPPrivProtocolAES192,
+		config.SNMPPrivProtocolAES256,
+	}
+)
+
+// SNMPV1Alpha1 is a SNMP agent config document.
+//
+//	examples:
+//	  - value: exampleSNMPV1Alpha1()
+//	alias: SNMPConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/SNMPConfig
+type SNMPV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Address to listen for the SNMP requests on (UDP).
+	//
+	//     Default value is ":161".
+	//   examples:
+	//     - value: >
+	//        "10.0.0.5:161"
+	SNMPListenAddress string `yaml:"listenAddress,omitempty"`
+	//   description: |
+	//     Read-only community for the SNMPv2c requests.
+	//
+	//     SNMPv2c is disabled if not set.
+	SNMPCommunity string `yaml:"community,omitempty"`
+	//   description: |
+	//     List of the SNMPv3 users.
+	//
+	//     Only authenticated (authNoPriv and authPriv) requests are accepted.
+	SNMPUsers []SNMPUserV1Alpha1 `yaml:"users,omitempty"`
+	//   description: |
+	//     Value of the sysLocation object.
+	SNMPLocation string `yaml:"location,omitempty"`
+	//   description: |
+	//     Value of the sysContact object.
+	SNMPContact string `yaml:"contact,omitempty"`
+}
+
+// SNMPUserV1Alpha1 describes a SNMPv3 user.
+type SNMPUserV1Alpha1 struct {
+	//   description: |
+	//     Name of the user.
+	//   schemaRequired: true
+	UserName string `yaml:"name"`
+	//   description: |
+	//     Authentication protocol.
+	//   values:
+	//     - "md5"
+	//     - "sha"
+	//     - "sha224"
+	//     - "sha256"
+	//     - "sha384"
+	//     - "sha512"
+	//   schemaRequired: true
+	UserAuthProtocol string `yaml:"authProtocol"`
+	//   description: |
+	//     Authentication passphrase, at least 8 characters long.
+	//   schemaRequired: true
+	UserAuthPassphrase string `yaml:"authPassphrase"`
+	//   description: |
+	//     Privacy (encryption) protocol.
+	//
+	//     Responses are not encrypted if not set.
+	//   values:
+	//     - "des"
+	//     - "aes"
+	//     - "aes192"
+	//     - "aes256"
+	UserPrivProtocol string `yaml:"privProtocol,omitempty"`
+	//   description: |
+	//     Privacy passphrase, at least 8 characters long.
+	UserPrivPassphrase string `yaml:"privPassphrase,omitempty"`
+}
+
+// NewSNMPV1Alpha1 creates a new SNMP agent config document.
+func NewSNMPV1Alpha1() *SNMPV1Alpha1 {
+	return &SNMPV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       SNMPKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleSNMPV1Alpha1() *SNMPV1Alpha1 {
+	cfg := NewSNMPV1Alpha1()
+	cfg.SNMPUsers = []SNMPUserV1Alpha1{
+		{
+			UserName:           "monitoring",
+			UserAuthProtocol:   config.SNMPAuthProtocolSHA256,
+			UserAuthPassphrase: "authpassphrase",
+			UserPrivProtocol:   config.SNMPPrivProtocolAES,
+			UserPrivPassphrase: "privpassphrase",
+		},
+	}
+	cfg.SNMPLocation = "DC1, rack 12"
+	cfg.SNMPContact = "ops@example.com"
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *SNMPV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Runtime implements config.Config interface.
+func (s *SNMPV1Alpha1) Runtime() config.RuntimeConfig {
+	return s
+}
+
+// EventsEndpoint implements config.RuntimeConfig interface.
+func (s *SNMPV1Alpha1) EventsEndpoint() *string {
+	return nil
+}
+
+// KmsgLogURLs implements config.RuntimeConfig interface.
+func (s *SNMPV1Alpha1) KmsgLogURLs() []*url.URL {
+	return nil
+}
+
+// WatchdogTimer implements config.RuntimeConfig interface.
+func (s *SNMPV1Alpha1) WatchdogTimer() config.WatchdogTimerConfig {
+	return nil
+}
+
+// UpgradeHealthCheck implements config.RuntimeConfig interface.
+func (s *SNMPV1Alpha1) UpgradeHealthCheck() config.UpgradeHealthCheckConfig {
+	return nil
+}
+
+// Metrics implements config.RuntimeConfig interface.
+func (s *SNMPV1Alpha1) Metrics() config.MetricsConfig {
+	return nil
+}
+
+// SequenceHooks implements config.RuntimeConfig interface.
+func (s *SNMPV1Alpha1) SequenceHooks() []config.SequenceHookConfig {
+	return nil
+}
+
+// WebhookNotifications implements config.RuntimeConfig interface.
+func (s *SNMPV1Alpha1) WebhookNotifications() []config.WebhookNotificationConfig {
+	return nil
+}
+
+// SNMP implements config.RuntimeConfig interface.
+func (s *SNMPV1Alpha1) SNMP() config.SNMPConfig {
+	return s
+}
+
+// ListenAddress implements config.SNMPConfig interface.
+func (s *SNMPV1Alpha1) ListenAddress() string {
+	if s.SNMPListenAddress == "" {
+		return DefaultSNMPListenAddress
+	}
+
+	return s.SNMPListenAddress
+}
+
+// Community implements config.SNMPConfig interface.
+func (s *SNMPV1Alpha1) Community() string {
+	return s.SNMPCommunity
+}
+
+// Users implements config.SNMPConfig interface.
+func (s *SNMPV1Alpha1) Users() []config.SNMPUser {
+	return xslices.Map(s.SNMPUsers, func(u SNMPUserV1Alpha1) config.SNMPUser { return u })
+}
+
+// Location implements config.SNMPConfig interface.
+func (s *SNMPV1Alpha1) Location() string {
+	return s.SNMPLocation
+}
+
+// Contact implements config.SNMPConfig interface.
+func (s *SNMPV1Alpha1) Contact() string {
+	return s.SNMPContact
+}
+
+// Name implements config.SNMPUser interface.
+func (u SNMPUserV1Alpha1) Name() string {
+	return u.UserName
+}
+
+// AuthProtocol implements config.SNMPUser interface.
+func (u SNMPUserV1Alpha1) AuthProtocol() string {
+	return u.UserAuthProtocol
+}
+
+// AuthPassphrase implements config.SNMPUser interface.
+func (u SNMPUserV1Alpha1) AuthPassphrase() string {
+	return u.UserAuthPassphrase
+}
+
+// PrivProtocol implements config.SNMPUser interface.
+func (u SNMPUserV1Alpha1) PrivProtocol() string {
+	return u.UserPrivProtocol
+}
+
+// PrivPassphrase implements config.SNMPUser interface.
+func (u SNMPUserV1Alpha1) PrivPassphrase() string {
+	return u.UserPrivPassphrase
+}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo
+func (s *SNMPV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.SNMPListenAddress != "" {
+		if _, _, err := net.SplitHostPort(s.SNMPListenAddress); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("listen address: %w", err))
+		}
+	}
+
+	if s.SNMPCommunity == "" && len(s.SNMPUsers) == 0 {
+		errs = errors.Join(errs, errors.New("either community or users should be set"))
+	}
+
+	names := map[string]struct{}{}
+
+	for i, user := range s.SNMPUsers {
+		if user.UserName == "" {
+			errs = errors.Join(errs, fmt.Errorf("users[%d]: name is required", i))
+		} else if _, exists := names[user.UserName]; exists {
+			errs = errors.Join(errs, fmt.Errorf("users[%d]: duplicate user %q", i, user.UserName))
+		}
+
+		names[user.UserName] = struct{}{}
+
+		if !slices.Contains(snmpAuthProtocols, user.UserAuthProtocol) {
+			errs = errors.Join(errs, fmt.Errorf("users[%d]: authProtocol: unsupported value %q, expected one of %q", i, user.UserAuthProtocol, snmpAuthProtocols))
+		}
+
+		if len(user.UserAuthPassphrase) < minSNMPPassphraseLength {
+			errs = errors.Join(errs, fmt.Errorf("users[%d]: authPassphrase should be at least %d characters long", i, minSNMPPassphraseLength))
+		}
+
+		switch {
+		case user.UserPrivProtocol == "":
+			if user.UserPrivPassphrase != "" {
+				errs = errors.Join(errs, fmt.Errorf("users[%d]: privPassphrase requires privProtocol", i))
+			}
+		case !slices.Contains(snmpPrivProtocols, user.UserPrivProtocol):
+			errs = errors.Join(errs, fmt.Errorf("users[%d]: privProtocol: unsupported value %q, expected one of %q", i, user.UserPrivProtocol, snmpPrivProtocols))
+		case len(user.UserPrivPassphrase) < minSNMPPassphraseLength:
+			errs = errors.Join(errs, fmt.Errorf("users[%d]: privPassphrase should be at least %d characters long", i, minSNMPPassphraseLength))
+		}
+	}
+
+	return nil, errs
+}
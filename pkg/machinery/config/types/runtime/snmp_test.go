@@ -0,0 +1,171 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+)
+
+//go:embed testdata/snmp.yaml
+var expectedSNMPDocument []byte
+
+func TestSNMPMarshalStability(t *testing.T) {
+	cfg := runtime.NewSNMPV1Alpha1()
+	cfg.SNMPListenAddress = "10.0.0.5:161"
+	cfg.SNMPCommunity = "public"
+	cfg.SNMPUsers = []runtime.SNMPUserV1Alpha1{
+		{
+			UserName:           "monitoring",
+			UserAuthProtocol:   config.SNMPAuthProtocolSHA256,
+			UserAuthPassphrase: "authpassphrase",
+			UserPrivProtocol:   config.SNMPPrivProtocolAES,
+			UserPrivPassphrase: "privpassphrase",
+		},
+	}
+	cfg.SNMPLocation = "DC1, rack 12"
+	cfg.SNMPContact = "ops@example.com"
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedSNMPDocument, marshaled)
+}
+
+func TestSNMPUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedSNMPDocument)
+	require.NoError(t, err)
+
+	snmp := provider.Runtime().SNMP()
+	require.NotNil(t, snmp)
+
+	assert.Equal(t, "10.0.0.5:161", snmp.ListenAddress())
+	assert.Equal(t, "public", snmp.Community())
+	assert.Equal(t, "DC1, rack 12", snmp.Location())
+	assert.Equal(t, "ops@example.com", snmp.Contact())
+
+	require.Len(t, snmp.Users(), 1)
+
+	user := snmp.Users()[0]
+	assert.Equal(t, "monitoring", user.Name())
+	assert.Equal(t, config.SNMPAuthProtocolSHA256, user.AuthProtocol())
+	assert.Equal(t, "authpassphrase", user.AuthPassphrase())
+	assert.Equal(t, config.SNMPPrivProtocolAES, user.PrivProtocol())
+	assert.Equal(t, "privpassphrase", user.PrivPassphrase())
+}
+
+func TestSNMPDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfg := runtime.NewSNMPV1Alpha1()
+
+	assert.Equal(t, runtime.DefaultSNMPListenAddress, cfg.ListenAddress())
+	assert.Empty(t, cfg.Users())
+}
+
+func TestSNMPValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *runtime.SNMPV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  runtime.NewSNMPV1Alpha1,
+
+			expectedError: "either community or users should be set",
+		},
+		{
+			name: "invalid",
+			cfg: func() *runtime.SNMPV1Alpha1 {
+				cfg := runtime.NewSNMPV1Alpha1()
+				cfg.SNMPListenAddress = "161"
+				cfg.SNMPUsers = []runtime.SNMPUserV1Alpha1{
+					{
+						UserName:           "monitoring",
+						UserAuthProtocol:   "sha1",
+						UserAuthPassphrase: "short",
+						UserPrivProtocol:   "3des",
+					},
+					{
+						UserName:           "monitoring",
+						UserAuthProtocol:   config.SNMPAuthProtocolSHA,
+						UserAuthPassphrase: "authpassphrase",
+						UserPrivProtocol:   config.SNMPPrivProtocolAES,
+						UserPrivPassphrase: "short",
+					},
+					{
+						UserAuthProtocol:   config.SNMPAuthProtocolSHA,
+						UserAuthPassphrase: "authpassphrase",
+						UserPrivPassphrase: "privpassphrase",
+					},
+				}
+
+				return cfg
+			},
+
+			expectedError: "listen address: address 161: missing port in address\n" +
+				"users[0]: authProtocol: unsupported value \"sha1\", expected one of [\"md5\" \"sha\" \"sha224\" \"sha256\" \"sha384\" \"sha512\"]\n" +
+				"users[0]: authPassphrase should be at least 8 characters long\n" +
+				"users[0]: privProtocol: unsupported value \"3des\", expected one of [\"des\" \"aes\" \"aes192\" \"aes256\"]\n" +
+				"users[1]: duplicate user \"monitoring\"\n" +
+				"users[1]: privPassphrase should be at least 8 characters long\n" +
+				"users[2]: name is required\n" +
+				"users[2]: privPassphrase requires privProtocol",
+		},
+		{
+			name: "community",
+			cfg: func() *runtime.SNMPV1Alpha1 {
+				cfg := runtime.NewSNMPV1Alpha1()
+				cfg.SNMPCommunity = "public"
+
+				return cfg
+			},
+		},
+		{
+			name: "users",
+			cfg: func() *runtime.SNMPV1Alpha1 {
+				cfg := runtime.NewSNMPV1Alpha1()
+				cfg.SNMPListenAddress = ":1161"
+				cfg.SNMPUsers = []runtime.SNMPUserV1Alpha1{
+					{
+						UserName:           "monitoring",
+						UserAuthProtocol:   config.SNMPAuthProtocolSHA512,
+						UserAuthPassphrase: "authpassphrase",
+					},
+				}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
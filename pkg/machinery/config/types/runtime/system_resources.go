@@ -0,0 +1,289 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/go-pointer"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// SystemResourcesKind is a system resources config document kind.
+const SystemResourcesKind = "SystemResourcesConfig"
+
+func init() {
+	registry.Register(SystemResourcesKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &SystemResourcesV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.SystemResourcesConfig = &SystemResourcesV1Alpha1{}
+	_ config.OOMProtectionConfig   = OOMProtectionConfig{}
+	_ config.Validator             = &SystemResourcesV1Alpha1{}
+)
+
+// OOM score adjustment range.
+const (
+	MinOOMScoreAdj = -1000
+	MaxOOMScoreAdj = 1000
+)
+
+var oomProtectionServices = []string{
+	config.OOMProtectionServiceMachined,
+	config.OOMProtectionServiceAPID,
+	config.OOMProtectionServiceTrustd,
+	config.OOMProtectionServiceContainerd,
+}
+
+// SystemResourcesV1Alpha1 is a config document to reserve resources for Talos system services.
+//
+//	examples:
+//	  - value: exampleSystemResourcesV1Alpha1()
+//	alias: SystemResourcesConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/SystemResourcesConfig
+type SystemResourcesV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     CPU to reserve for the system services, either in cores (`2`, `1.5`) or in millicores (`1500m`).
+	//
+	//     The reservation is split between the system cgroups (init, system and podruntime) proportionally
+	//     to the built-in defaults, raising their CPU weight (the reservation never lowers the defaults).
+	//     Unless `systemReserved` is set in the kubelet `extraConfig`, the value is also used as the kubelet `systemReserved` CPU.
+	//   examples:
+	//     - value: >
+	//        "1500m"
+	//   schema:
+	//     type: string
+	//     pattern: ^[0-9]+(\.[0-9]+|m)?$
+	SystemReservedCPU string `yaml:"reservedCPU,omitempty"`
+	//   description: |
+	//     Memory to reserve for the system services, e.g. `1GiB`.
+	//
+	//     The reservation is split between the system cgroups (init, system and podruntime) proportionally
+	//     to the built-in defaults, raising their memory protection (the reservation never lowers the defaults).
+	//     Unless `systemReserved` is set in the kubelet `extraConfig`, the value is also used as the kubelet `systemReserved` memory,
+	//     so that the pods can't consume the reserved memory.
+	//   examples:
+	//     - value: >
+	//        "1GiB"
+	//   schema:
+	//     type: string
+	SystemReservedMemory string `yaml:"reservedMemory,omitempty"`
+	//   description: |
+	//     OOM protection overrides for the core Talos services.
+	//
+	//     By default, the core services have the lowest OOM score adjustment
+	//     and a memory protection (`memory.min`) of their cgroup, so that the kernel OOM killer picks other processes.
+	SystemOOMProtection []OOMProtectionConfig `yaml:"oomProtection,omitempty"`
+}
+
+// OOMProtectionConfig describes OOM protection of a core service.
+type OOMProtectionConfig struct {
+	//   description: |
+	//     Name of the service.
+	//   values:
+	//     - "machined"
+	//     - "apid"
+	//     - "trustd"
+	//     - "containerd"
+	//   schemaRequired: true
+	OOMService string `yaml:"service"`
+	//   description: |
+	//     OOM score adjustment of the service processes, from -1000 (never killed) to 1000.
+	OOMScoreAdjValue *int `yaml:"oomScoreAdj,omitempty"`
+	//   description: |
+	//     Memory protection (`memory.min`) of the service cgroup, e.g. `128MiB`.
+	//
+	//     If the service has a memory limit, the limit is raised to the protection value.
+	//   schema:
+	//     type: string
+	OOMMemoryMin string `yaml:"memoryMin,omitempty"`
+}
+
+// NewSystemResourcesV1Alpha1 creates a new system resources config document.
+func NewSystemResourcesV1Alpha1() *SystemResourcesV1Alpha1 {
+	return &SystemResourcesV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       SystemResourcesKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleSystemResourcesV1Alpha1() *SystemResourcesV1Alpha1 {
+	cfg := NewSystemResourcesV1Alpha1()
+	cfg.SystemReservedCPU = "1500m"
+	cfg.SystemReservedMemory = "1GiB"
+	cfg.SystemOOMProtection = []OOMProtectionConfig{
+		{
+			OOMService:       config.OOMProtectionServiceMachined,
+			OOMScoreAdjValue: pointer.To(-999),
+			OOMMemoryMin:     "256MiB",
+		},
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *SystemResourcesV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// SystemResources implements config.Config interface.
+func (s *SystemResourcesV1Alpha1) SystemResources() config.SystemResourcesConfig {
+	return s
+}
+
+// ReservedMilliCPU implements config.SystemResourcesConfig interface.
+func (s *SystemResourcesV1Alpha1) ReservedMilliCPU() int64 {
+	milliCPU, _ := parseMilliCPU(s.SystemReservedCPU) //nolint:errcheck
+
+	return milliCPU
+}
+
+// ReservedMemory implements config.SystemResourcesConfig interface.
+func (s *SystemResourcesV1Alpha1) ReservedMemory() uint64 {
+	if s.SystemReservedMemory == "" {
+		return 0
+	}
+
+	memory, _ := humanize.ParseBytes(s.SystemReservedMemory) //nolint:errcheck
+
+	return memory
+}
+
+// OOMProtection implements config.SystemResourcesConfig interface.
+func (s *SystemResourcesV1Alpha1) OOMProtection() []config.OOMProtectionConfig {
+	result := make([]config.OOMProtectionConfig, 0, len(s.SystemOOMProtection))
+
+	for _, protection := range s.SystemOOMProtection {
+		result = append(result, protection)
+	}
+
+	return result
+}
+
+// Service implements config.OOMProtectionConfig interface.
+func (p OOMProtectionConfig) Service() string {
+	return p.OOMService
+}
+
+// OOMScoreAdj implements config.OOMProtectionConfig interface.
+func (p OOMProtectionConfig) OOMScoreAdj() optional.Optional[int] {
+	if p.OOMScoreAdjValue == nil {
+		return optional.None[int]()
+	}
+
+	return optional.Some(*p.OOMScoreAdjValue)
+}
+
+// MemoryMin implements config.OOMProtectionConfig interface.
+func (p OOMProtectionConfig) MemoryMin() optional.Optional[uint64] {
+	if p.OOMMemoryMin == "" {
+		return optional.None[uint64]()
+	}
+
+	memory, err := humanize.ParseBytes(p.OOMMemoryMin)
+	if err != nil {
+		return optional.None[uint64]()
+	}
+
+	return optional.Some(memory)
+}
+
+// Validate implements config.Validator interface.
+//
+//nolint:gocyclo
+func (s *SystemResourcesV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.SystemReservedCPU == "" && s.SystemReservedMemory == "" && len(s.SystemOOMProtection) == 0 {
+		errs = errors.Join(errs, errors.New("either reservedCPU, reservedMemory or oomProtection should be set"))
+	}
+
+	if s.SystemReservedCPU != "" {
+		if _, err := parseMilliCPU(s.SystemReservedCPU); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("reservedCPU: %w", err))
+		}
+	}
+
+	if s.SystemReservedMemory != "" {
+		if _, err := humanize.ParseBytes(s.SystemReservedMemory); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("reservedMemory: %w", err))
+		}
+	}
+
+	services := map[string]struct{}{}
+
+	for i, protection := range s.SystemOOMProtection {
+		if !slices.Contains(oomProtectionServices, protection.OOMService) {
+			errs = errors.Join(errs, fmt.Errorf("oomProtection[%d]: unsupported service %q, expected one of %q", i, protection.OOMService, oomProtectionServices))
+		}
+
+		if _, ok := services[protection.OOMService]; ok {
+			errs = errors.Join(errs, fmt.Errorf("oomProtection[%d]: duplicate service %q", i, protection.OOMService))
+		}
+
+		services[protection.OOMService] = struct{}{}
+
+		if protection.OOMScoreAdjValue != nil && (*protection.OOMScoreAdjValue < MinOOMScoreAdj || *protection.OOMScoreAdjValue > MaxOOMScoreAdj) {
+			errs = errors.Join(errs, fmt.Errorf("oomProtection[%d]: oomScoreAdj should be between %d and %d", i, MinOOMScoreAdj, MaxOOMScoreAdj))
+		}
+
+		if protection.OOMMemoryMin != "" {
+			if _, err := humanize.ParseBytes(protection.OOMMemoryMin); err != nil {
+				errs = errors.Join(errs, fmt.Errorf("oomProtection[%d]: memoryMin: %w", i, err))
+			}
+		}
+	}
+
+	return nil, errs
+}
+
+// parseMilliCPU parses the CPU amount in cores (1.5) or millicores (1500m) and returns it in millicores.
+func parseMilliCPU(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+
+	if milli, ok := strings.CutSuffix(s, "m"); ok {
+		value, err := strconv.ParseInt(milli, 10, 64)
+		if err != nil || value < 0 {
+			return 0, fmt.Errorf("invalid CPU value %q", s)
+		}
+
+		return value, nil
+	}
+
+	value, err := strconv.ParseFloat(s, 64)
+	if err != nil || value < 0 || math.IsInf(value, 0) || math.IsNaN(value) {
+		return 0, fmt.Errorf("invalid CPU value %q", s)
+	}
+
+	return int64(math.Round(value * 1000)), nil
+}
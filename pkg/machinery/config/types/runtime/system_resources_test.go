@@ -0,0 +1,202 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/siderolabs/gen/optional"
+	"github.com/siderolabs/go-pointer"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+)
+
+//go:embed testdata/systemresources.yaml
+var expectedSystemResourcesDocument []byte
+
+func TestSystemResourcesMarshalStability(t *testing.T) {
+	cfg := runtime.NewSystemResourcesV1Alpha1()
+	cfg.SystemReservedCPU = "1500m"
+	cfg.SystemReservedMemory = "1GiB"
+	cfg.SystemOOMProtection = []runtime.OOMProtectionConfig{
+		{
+			OOMService:       "machined",
+			OOMScoreAdjValue: pointer.To(-999),
+			OOMMemoryMin:     "256MiB",
+		},
+		{
+			OOMService:   "apid",
+			OOMMemoryMin: "64MiB",
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedSystemResourcesDocument, marshaled)
+}
+
+func TestSystemResourcesUnmarshal(t *testing.T) {
+	provider, err := configloader.NewFromBytes(expectedSystemResourcesDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &runtime.SystemResourcesV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       runtime.SystemResourcesKind,
+		},
+		SystemReservedCPU:    "1500m",
+		SystemReservedMemory: "1GiB",
+		SystemOOMProtection: []runtime.OOMProtectionConfig{
+			{
+				OOMService:       "machined",
+				OOMScoreAdjValue: pointer.To(-999),
+				OOMMemoryMin:     "256MiB",
+			},
+			{
+				OOMService:   "apid",
+				OOMMemoryMin: "64MiB",
+			},
+		},
+	}, docs[0])
+
+	require.NotNil(t, provider.SystemResources())
+	assert.EqualValues(t, 1500, provider.SystemResources().ReservedMilliCPU())
+	assert.EqualValues(t, 1024*1024*1024, provider.SystemResources().ReservedMemory())
+
+	oomProtection := provider.SystemResources().OOMProtection()
+	require.Len(t, oomProtection, 2)
+
+	assert.Equal(t, "machined", oomProtection[0].Service())
+	assert.Equal(t, optional.Some(-999), oomProtection[0].OOMScoreAdj())
+	assert.Equal(t, optional.Some[uint64](256*1024*1024), oomProtection[0].MemoryMin())
+
+	assert.Equal(t, "apid", oomProtection[1].Service())
+	assert.Equal(t, optional.None[int](), oomProtection[1].OOMScoreAdj())
+	assert.Equal(t, optional.Some[uint64](64*1024*1024), oomProtection[1].MemoryMin())
+}
+
+func TestSystemResourcesValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *runtime.SystemResourcesV1Alpha1
+
+		expectedMilliCPU int64
+		expectedMemory   uint64
+		expectedError    string
+	}{
+		{
+			name: "empty",
+			cfg:  runtime.NewSystemResourcesV1Alpha1,
+
+			expectedError: "either reservedCPU, reservedMemory or oomProtection should be set",
+		},
+		{
+			name: "oom protection",
+			cfg: func() *runtime.SystemResourcesV1Alpha1 {
+				cfg := runtime.NewSystemResourcesV1Alpha1()
+				cfg.SystemOOMProtection = []runtime.OOMProtectionConfig{
+					{
+						OOMService:       "containerd",
+						OOMScoreAdjValue: pointer.To(-1000),
+					},
+				}
+
+				return cfg
+			},
+		},
+		{
+			name: "invalid oom protection",
+			cfg: func() *runtime.SystemResourcesV1Alpha1 {
+				cfg := runtime.NewSystemResourcesV1Alpha1()
+				cfg.SystemOOMProtection = []runtime.OOMProtectionConfig{
+					{
+						OOMService: "kubelet",
+					},
+					{
+						OOMService:       "trustd",
+						OOMScoreAdjValue: pointer.To(-1001),
+						OOMMemoryMin:     "lots",
+					},
+					{
+						OOMService: "trustd",
+					},
+				}
+
+				return cfg
+			},
+
+			expectedError: "oomProtection[0]: unsupported service \"kubelet\", expected one of [\"machined\" \"apid\" \"trustd\" \"containerd\"]\n" +
+				"oomProtection[1]: oomScoreAdj should be between -1000 and 1000\n" +
+				"oomProtection[1]: memoryMin: strconv.ParseFloat: parsing \"\": invalid syntax\n" +
+				"oomProtection[2]: duplicate service \"trustd\"",
+		},
+		{
+			name: "cores",
+			cfg: func() *runtime.SystemResourcesV1Alpha1 {
+				cfg := runtime.NewSystemResourcesV1Alpha1()
+				cfg.SystemReservedCPU = "1.5"
+
+				return cfg
+			},
+
+			expectedMilliCPU: 1500,
+		},
+		{
+			name: "memory",
+			cfg: func() *runtime.SystemResourcesV1Alpha1 {
+				cfg := runtime.NewSystemResourcesV1Alpha1()
+				cfg.SystemReservedMemory = "512MiB"
+
+				return cfg
+			},
+
+			expectedMemory: 512 * 1024 * 1024,
+		},
+		{
+			name: "invalid",
+			cfg: func() *runtime.SystemResourcesV1Alpha1 {
+				cfg := runtime.NewSystemResourcesV1Alpha1()
+				cfg.SystemReservedCPU = "-1m"
+				cfg.SystemReservedMemory = "lots"
+
+				return cfg
+			},
+
+			expectedError: "reservedCPU: invalid CPU value \"-1m\"\nreservedMemory: strconv.ParseFloat: parsing \"\": invalid syntax",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := test.cfg()
+
+			_, err := cfg.Validate(validationMode{})
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			assert.Equal(t, test.expectedMilliCPU, cfg.ReservedMilliCPU())
+			assert.Equal(t, test.expectedMemory, cfg.ReservedMemory())
+		})
+	}
+}
@@ -0,0 +1,238 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/siderolabs/gen/xslices"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// UpgradeHealthCheckKind is an upgrade health check config document kind.
+const UpgradeHealthCheckKind = "UpgradeHealthCheckConfig"
+
+func init() {
+	registry.Register(UpgradeHealthCheckKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &UpgradeHealthCheckV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.RuntimeConfig = &UpgradeHealthCheckV1Alpha1{}
+	_ config.Validator     = &UpgradeHealthCheckV1Alpha1{}
+)
+
+// Timeout constants.
+const (
+	MinUpgradeHealthCheckTimeout     = 30 * time.Second
+	DefaultUpgradeHealthCheckTimeout = 10 * time.Minute
+)
+
+// UpgradeHealthCheckV1Alpha1 is an upgrade health check config document.
+//
+//	examples:
+//	  - value: exampleUpgradeHealthCheckV1Alpha1()
+//	alias: UpgradeHealthCheckConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/UpgradeHealthCheckConfig
+type UpgradeHealthCheckV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Timeout for the health checks to pass after the upgrade.
+	//
+	//     If the health checks don't pass within this duration after the boot, Talos rolls back to the previous version.
+	//
+	//     Default value is 10 minutes, minimum value is 30 seconds.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	HealthCheckTimeout time.Duration `yaml:"timeout,omitempty"`
+	//   description: |
+	//     Require the Kubernetes node to be Ready.
+	HealthCheckKubeletReady bool `yaml:"kubeletReady,omitempty"`
+	//   description: |
+	//     List of services which should be running and healthy.
+	//   examples:
+	//     - value: >
+	//        []string{"etcd", "kubelet"}
+	HealthCheckServices []string `yaml:"services,omitempty"`
+	//   description: |
+	//     List of HTTP probes which should succeed.
+	HealthCheckHTTPProbes []HTTPProbe `yaml:"httpProbes,omitempty"`
+}
+
+// HTTPProbe describes an HTTP health check.
+type HTTPProbe struct {
+	//   description: |
+	//     URL to send the GET request to.
+	//   schemaRequired: true
+	//   schema:
+	//     type: string
+	//     pattern: "^(http|https)://"
+	ProbeURL meta.URL `yaml:"url"`
+	//   description: |
+	//     Expected HTTP response status code.
+	//
+	//     Default value is 200.
+	ProbeExpectedStatus int `yaml:"expectedStatus,omitempty"`
+}
+
+// NewUpgradeHealthCheckV1Alpha1 creates a new upgrade health check config document.
+func NewUpgradeHealthCheckV1Alpha1() *UpgradeHealthCheckV1Alpha1 {
+	return &UpgradeHealthCheckV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       UpgradeHealthCheckKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleUpgradeHealthCheckV1Alpha1() *UpgradeHealthCheckV1Alpha1 {
+	u, _ := url.Parse("http://localhost:8080/healthz") //nolint:errcheck
+
+	cfg := NewUpgradeHealthCheckV1Alpha1()
+	cfg.HealthCheckTimeout = 5 * time.Minute
+	cfg.HealthCheckKubeletReady = true
+	cfg.HealthCheckServices = []string{"etcd", "kubelet"}
+	cfg.HealthCheckHTTPProbes = []HTTPProbe{
+		{
+			ProbeURL: meta.URL{URL: u},
+		},
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *UpgradeHealthCheckV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Runtime implements config.Config interface.
+func (s *UpgradeHealthCheckV1Alpha1) Runtime() config.RuntimeConfig {
+	return s
+}
+
+// EventsEndpoint implements config.RuntimeConfig interface.
+func (s *UpgradeHealthCheckV1Alpha1) EventsEndpoint() *string {
+	return nil
+}
+
+// KmsgLogURLs implements config.RuntimeConfig interface.
+func (s *UpgradeHealthCheckV1Alpha1) KmsgLogURLs() []*url.URL {
+	return nil
+}
+
+// WatchdogTimer implements config.RuntimeConfig interface.
+func (s *UpgradeHealthCheckV1Alpha1) WatchdogTimer() config.WatchdogTimerConfig {
+	return nil
+}
+
+// UpgradeHealthCheck implements config.RuntimeConfig interface.
+func (s *UpgradeHealthCheckV1Alpha1) UpgradeHealthCheck() config.UpgradeHealthCheckConfig {
+	return s
+}
+
+// Metrics implements config.RuntimeConfig interface.
+func (s *UpgradeHealthCheckV1Alpha1) Metrics() config.MetricsConfig {
+	return nil
+}
+
+// SequenceHooks implements config.RuntimeConfig interface.
+func (s *UpgradeHealthCheckV1Alpha1) SequenceHooks() []config.SequenceHookConfig {
+	return nil
+}
+
+// WebhookNotifications implements config.RuntimeConfig interface.
+func (s *UpgradeHealthCheckV1Alpha1) WebhookNotifications() []config.WebhookNotificationConfig {
+	return nil
+}
+
+// SNMP implements config.RuntimeConfig interface.
+func (s *UpgradeHealthCheckV1Alpha1) SNMP() config.SNMPConfig {
+	return nil
+}
+
+// Timeout implements config.UpgradeHealthCheckConfig interface.
+func (s *UpgradeHealthCheckV1Alpha1) Timeout() time.Duration {
+	if s.HealthCheckTimeout == 0 {
+		return DefaultUpgradeHealthCheckTimeout
+	}
+
+	return s.HealthCheckTimeout
+}
+
+// KubeletReady implements config.UpgradeHealthCheckConfig interface.
+func (s *UpgradeHealthCheckV1Alpha1) KubeletReady() bool {
+	return s.HealthCheckKubeletReady
+}
+
+// Services implements config.UpgradeHealthCheckConfig interface.
+func (s *UpgradeHealthCheckV1Alpha1) Services() []string {
+	return s.HealthCheckServices
+}
+
+// HTTPProbes implements config.UpgradeHealthCheckConfig interface.
+func (s *UpgradeHealthCheckV1Alpha1) HTTPProbes() []config.HTTPProbe {
+	return xslices.Map(s.HealthCheckHTTPProbes, func(p HTTPProbe) config.HTTPProbe { return p })
+}
+
+// URL implements config.HTTPProbe interface.
+func (p HTTPProbe) URL() *url.URL {
+	return p.ProbeURL.URL
+}
+
+// ExpectedStatus implements config.HTTPProbe interface.
+func (p HTTPProbe) ExpectedStatus() int {
+	if p.ProbeExpectedStatus == 0 {
+		return http.StatusOK
+	}
+
+	return p.ProbeExpectedStatus
+}
+
+// Validate implements config.Validator interface.
+func (s *UpgradeHealthCheckV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if !s.HealthCheckKubeletReady && len(s.HealthCheckServices) == 0 && len(s.HealthCheckHTTPProbes) == 0 {
+		errs = errors.Join(errs, errors.New("at least one health check should be configured"))
+	}
+
+	if s.HealthCheckTimeout != 0 && s.HealthCheckTimeout < MinUpgradeHealthCheckTimeout {
+		errs = errors.Join(errs, fmt.Errorf("timeout: minimum value is %s", MinUpgradeHealthCheckTimeout))
+	}
+
+	for i, probe := range s.HealthCheckHTTPProbes {
+		if probe.ProbeURL.URL == nil {
+			errs = errors.Join(errs, fmt.Errorf("http probe %d: url is required", i))
+		} else if probe.ProbeURL.Scheme != "http" && probe.ProbeURL.Scheme != "https" {
+			errs = errors.Join(errs, fmt.Errorf("http probe %d: unsupported url scheme %q", i, probe.ProbeURL.Scheme))
+		}
+
+		if probe.ProbeExpectedStatus != 0 && (probe.ProbeExpectedStatus < 100 || probe.ProbeExpectedStatus > 599) {
+			errs = errors.Join(errs, fmt.Errorf("http probe %d: invalid expected status %d", i, probe.ProbeExpectedStatus))
+		}
+	}
+
+	return nil, errs
+}
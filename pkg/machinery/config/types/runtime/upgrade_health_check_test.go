@@ -0,0 +1,140 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/gen/ensure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+)
+
+//go:embed testdata/upgradehealthcheck.yaml
+var expectedUpgradeHealthCheckDocument []byte
+
+func TestUpgradeHealthCheckMarshalStability(t *testing.T) {
+	cfg := runtime.NewUpgradeHealthCheckV1Alpha1()
+	cfg.HealthCheckTimeout = 5 * time.Minute
+	cfg.HealthCheckKubeletReady = true
+	cfg.HealthCheckServices = []string{"etcd", "kubelet"}
+	cfg.HealthCheckHTTPProbes = []runtime.HTTPProbe{
+		{
+			ProbeURL: meta.URL{
+				URL: ensure.Value(url.Parse("http://localhost:8080/healthz")),
+			},
+			ProbeExpectedStatus: 204,
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedUpgradeHealthCheckDocument, marshaled)
+}
+
+func TestUpgradeHealthCheckUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedUpgradeHealthCheckDocument)
+	require.NoError(t, err)
+
+	healthCheck := provider.Runtime().UpgradeHealthCheck()
+	require.NotNil(t, healthCheck)
+
+	assert.Equal(t, 5*time.Minute, healthCheck.Timeout())
+	assert.True(t, healthCheck.KubeletReady())
+	assert.Equal(t, []string{"etcd", "kubelet"}, healthCheck.Services())
+
+	require.Len(t, healthCheck.HTTPProbes(), 1)
+	assert.Equal(t, "http://localhost:8080/healthz", healthCheck.HTTPProbes()[0].URL().String())
+	assert.Equal(t, 204, healthCheck.HTTPProbes()[0].ExpectedStatus())
+}
+
+func TestUpgradeHealthCheckValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *runtime.UpgradeHealthCheckV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  runtime.NewUpgradeHealthCheckV1Alpha1,
+
+			expectedError: "at least one health check should be configured",
+		},
+		{
+			name: "small timeout",
+			cfg: func() *runtime.UpgradeHealthCheckV1Alpha1 {
+				cfg := runtime.NewUpgradeHealthCheckV1Alpha1()
+				cfg.HealthCheckKubeletReady = true
+				cfg.HealthCheckTimeout = time.Second
+
+				return cfg
+			},
+
+			expectedError: "timeout: minimum value is 30s",
+		},
+		{
+			name: "invalid probes",
+			cfg: func() *runtime.UpgradeHealthCheckV1Alpha1 {
+				cfg := runtime.NewUpgradeHealthCheckV1Alpha1()
+				cfg.HealthCheckHTTPProbes = []runtime.HTTPProbe{
+					{},
+					{
+						ProbeURL: meta.URL{
+							URL: ensure.Value(url.Parse("tcp://localhost:8080")),
+						},
+					},
+					{
+						ProbeURL: meta.URL{
+							URL: ensure.Value(url.Parse("https://localhost:8080")),
+						},
+						ProbeExpectedStatus: 1000,
+					},
+				}
+
+				return cfg
+			},
+
+			expectedError: "http probe 0: url is required\nhttp probe 1: unsupported url scheme \"tcp\"\nhttp probe 2: invalid expected status 1000",
+		},
+		{
+			name: "valid",
+			cfg: func() *runtime.UpgradeHealthCheckV1Alpha1 {
+				cfg := runtime.NewUpgradeHealthCheckV1Alpha1()
+				cfg.HealthCheckServices = []string{"kubelet"}
+				cfg.HealthCheckTimeout = time.Minute
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/siderolabs/gen/xslices"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+	extservices "github.com/siderolabs/talos/pkg/machinery/extensions/services"
+)
+
+// UserServiceKind is a user service config document kind.
+const UserServiceKind = "UserServiceConfig"
+
+func init() {
+	registry.Register(UserServiceKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &UserServiceV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.UserServiceConfig = &UserServiceV1Alpha1{}
+	_ config.NamedDocument     = &UserServiceV1Alpha1{}
+	_ config.Validator         = &UserServiceV1Alpha1{}
+)
+
+var userServiceNameRegexp = regexp.MustCompile(`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?$`)
+
+// UserServiceV1Alpha1 is a config document to run a user-defined system service.
+//
+//	examples:
+//	  - value: exampleUserServiceV1Alpha1()
+//	alias: UserServiceConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/UserServiceConfig
+type UserServiceV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the service.
+	//
+	//     The service runs outside of Kubernetes in the system containerd namespace,
+	//     and it is managed by machined as `user-<name>` service (see `talosctl services`).
+	//     The service is restarted when the document changes, and stopped when the document is removed.
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     Container image of the service.
+	//
+	//     The image is pulled using the machine registry configuration.
+	//   schemaRequired: true
+	ServiceImage string `yaml:"image"`
+	//   description: |
+	//     Command to run, overrides the image entrypoint.
+	ServiceCommand []string `yaml:"command,omitempty"`
+	//   description: |
+	//     Arguments of the command, override the image command.
+	ServiceArgs []string `yaml:"args,omitempty"`
+	//   description: |
+	//     Environment variables of the service in the `KEY=value` format.
+	ServiceEnvironment []string `yaml:"environment,omitempty"`
+	//   description: |
+	//     Host paths to mount into the service container.
+	ServiceMounts []UserServiceMount `yaml:"mounts,omitempty"`
+	//   description: |
+	//     Restart policy of the service.
+	//
+	//     Default value is `always`.
+	//   values:
+	//     - "always"
+	//     - "untilSuccess"
+	//     - "never"
+	ServiceRestart string `yaml:"restart,omitempty"`
+	//   description: |
+	//     Start the kubelet only once the service is up.
+	//
+	//     Useful for the node agents which should be running before any pod is scheduled to the node,
+	//     e.g. security agents or storage drivers.
+	ServiceRequiredByKubelet bool `yaml:"requiredByKubelet,omitempty"`
+}
+
+// UserServiceMount describes a mount of the user service.
+type UserServiceMount struct {
+	//   description: |
+	//     Source path on the host.
+	MountSource string `yaml:"source"`
+	//   description: |
+	//     Destination path in the service container.
+	MountDestination string `yaml:"destination"`
+	//   description: |
+	//     Mount type, defaults to `bind`.
+	MountType string `yaml:"type,omitempty"`
+	//   description: |
+	//     Mount options, defaults to `rbind,rw` for bind mounts.
+	MountOptions []string `yaml:"options,omitempty"`
+}
+
+// NewUserServiceV1Alpha1 creates a new user service config document.
+func NewUserServiceV1Alpha1() *UserServiceV1Alpha1 {
+	return &UserServiceV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       UserServiceKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleUserServiceV1Alpha1() *UserServiceV1Alpha1 {
+	cfg := NewUserServiceV1Alpha1()
+	cfg.MetaName = "node-agent"
+	cfg.ServiceImage = "registry.example.com/node-agent:v1.0.0"
+	cfg.ServiceArgs = []string{"--log-level=info"}
+	cfg.ServiceEnvironment = []string{"AGENT_MODE=host"}
+	cfg.ServiceMounts = []UserServiceMount{
+		{
+			MountSource:      "/var/lib/node-agent",
+			MountDestination: "/var/lib/node-agent",
+		},
+	}
+	cfg.ServiceRequiredByKubelet = true
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *UserServiceV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *UserServiceV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Image implements config.UserServiceConfig interface.
+func (s *UserServiceV1Alpha1) Image() string {
+	return s.ServiceImage
+}
+
+// Command implements config.UserServiceConfig interface.
+func (s *UserServiceV1Alpha1) Command() []string {
+	return s.ServiceCommand
+}
+
+// Args implements config.UserServiceConfig interface.
+func (s *UserServiceV1Alpha1) Args() []string {
+	return s.ServiceArgs
+}
+
+// Environment implements config.UserServiceConfig interface.
+func (s *UserServiceV1Alpha1) Environment() []string {
+	return s.ServiceEnvironment
+}
+
+// Mounts implements config.UserServiceConfig interface.
+func (s *UserServiceV1Alpha1) Mounts() []specs.Mount {
+	return xslices.Map(s.ServiceMounts, func(m UserServiceMount) specs.Mount {
+		mount := specs.Mount{
+			Source:      m.MountSource,
+			Destination: m.MountDestination,
+			Type:        m.MountType,
+			Options:     m.MountOptions,
+		}
+
+		if mount.Type == "" {
+			mount.Type = "bind"
+		}
+
+		if mount.Type == "bind" && len(mount.Options) == 0 {
+			mount.Options = []string{"rbind", "rw"}
+		}
+
+		return mount
+	})
+}
+
+// Restart implements config.UserServiceConfig interface.
+func (s *UserServiceV1Alpha1) Restart() extservices.RestartKind {
+	if s.ServiceRestart == "" {
+		return extservices.RestartAlways
+	}
+
+	restart, err := extservices.RestartKindString(s.ServiceRestart)
+	if err != nil {
+		return extservices.RestartAlways
+	}
+
+	return restart
+}
+
+// RequiredByKubelet implements config.UserServiceConfig interface.
+func (s *UserServiceV1Alpha1) RequiredByKubelet() bool {
+	return s.ServiceRequiredByKubelet
+}
+
+// Validate implements config.Validator interface.
+func (s *UserServiceV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if !userServiceNameRegexp.MatchString(s.MetaName) {
+		errs = errors.Join(errs, fmt.Errorf("name %q is invalid, it should consist of lowercase letters, digits and dashes", s.MetaName))
+	}
+
+	if s.ServiceImage == "" {
+		errs = errors.Join(errs, errors.New("image is required"))
+	}
+
+	for i, env := range s.ServiceEnvironment {
+		if key, _, ok := strings.Cut(env, "="); !ok || key == "" {
+			errs = errors.Join(errs, fmt.Errorf("environment[%d]: expected KEY=value, got %q", i, env))
+		}
+	}
+
+	for i, mount := range s.ServiceMounts {
+		if !filepath.IsAbs(mount.MountDestination) {
+			errs = errors.Join(errs, fmt.Errorf("mounts[%d]: destination %q should be an absolute path", i, mount.MountDestination))
+		}
+
+		if (mount.MountType == "" || mount.MountType == "bind") && !filepath.IsAbs(mount.MountSource) {
+			errs = errors.Join(errs, fmt.Errorf("mounts[%d]: source %q should be an absolute path", i, mount.MountSource))
+		}
+	}
+
+	if s.ServiceRestart != "" {
+		if _, err := extservices.RestartKindString(s.ServiceRestart); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("restart: %w", err))
+		}
+	}
+
+	return nil, errs
+}
@@ -0,0 +1,152 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/opencontainers/runtime-spec/specs-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+	extservices "github.com/siderolabs/talos/pkg/machinery/extensions/services"
+)
+
+//go:embed testdata/userservice.yaml
+var expectedUserServiceDocument []byte
+
+func exampleUserService() *runtime.UserServiceV1Alpha1 {
+	cfg := runtime.NewUserServiceV1Alpha1()
+	cfg.MetaName = "node-agent"
+	cfg.ServiceImage = "registry.example.com/node-agent:v1.0.0"
+	cfg.ServiceArgs = []string{"--log-level=info"}
+	cfg.ServiceEnvironment = []string{"AGENT_MODE=host"}
+	cfg.ServiceMounts = []runtime.UserServiceMount{
+		{
+			MountSource:      "/var/lib/node-agent",
+			MountDestination: "/var/lib/node-agent",
+		},
+		{
+			MountSource:      "tmpfs",
+			MountDestination: "/tmp",
+			MountType:        "tmpfs",
+			MountOptions:     []string{"nosuid"},
+		},
+	}
+	cfg.ServiceRestart = "untilSuccess"
+	cfg.ServiceRequiredByKubelet = true
+
+	return cfg
+}
+
+func TestUserServiceMarshalStability(t *testing.T) {
+	marshaled, err := encoder.NewEncoder(exampleUserService(), encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedUserServiceDocument, marshaled)
+}
+
+func TestUserServiceUnmarshal(t *testing.T) {
+	provider, err := configloader.NewFromBytes(expectedUserServiceDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	expected := exampleUserService()
+	expected.Meta = meta.Meta{
+		MetaAPIVersion: "v1alpha1",
+		MetaKind:       runtime.UserServiceKind,
+	}
+
+	assert.Equal(t, expected, docs[0])
+
+	userServices := provider.UserServices()
+	require.Len(t, userServices, 1)
+
+	assert.Equal(t, "node-agent", userServices[0].Name())
+	assert.Equal(t, extservices.RestartUntilSuccess, userServices[0].Restart())
+	assert.True(t, userServices[0].RequiredByKubelet())
+	assert.Equal(t, []specs.Mount{
+		{
+			Source:      "/var/lib/node-agent",
+			Destination: "/var/lib/node-agent",
+			Type:        "bind",
+			Options:     []string{"rbind", "rw"},
+		},
+		{
+			Source:      "tmpfs",
+			Destination: "/tmp",
+			Type:        "tmpfs",
+			Options:     []string{"nosuid"},
+		},
+	}, userServices[0].Mounts())
+}
+
+func TestUserServiceValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *runtime.UserServiceV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  runtime.NewUserServiceV1Alpha1,
+
+			expectedError: "name \"\" is invalid, it should consist of lowercase letters, digits and dashes\nimage is required",
+		},
+		{
+			name: "valid",
+			cfg:  exampleUserService,
+		},
+		{
+			name: "invalid",
+			cfg: func() *runtime.UserServiceV1Alpha1 {
+				cfg := exampleUserService()
+				cfg.MetaName = "Node_Agent"
+				cfg.ServiceEnvironment = []string{"=value", "NOVALUE"}
+				cfg.ServiceMounts = []runtime.UserServiceMount{
+					{
+						MountSource:      "var",
+						MountDestination: "data",
+					},
+				}
+				cfg.ServiceRestart = "sometimes"
+
+				return cfg
+			},
+
+			expectedError: "name \"Node_Agent\" is invalid, it should consist of lowercase letters, digits and dashes\n" +
+				"environment[0]: expected KEY=value, got \"=value\"\n" +
+				"environment[1]: expected KEY=value, got \"NOVALUE\"\n" +
+				"mounts[0]: destination \"data\" should be an absolute path\n" +
+				"mounts[0]: source \"var\" should be an absolute path\n" +
+				"restart: sometimes does not belong to RestartKind values",
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+
+				return
+			}
+
+			require.NoError(t, err)
+		})
+	}
+}
@@ -113,6 +113,31 @@ func (s *WatchdogTimerV1Alpha1) WatchdogTimer() config.WatchdogTimerConfig {
 	return s
 }
 
+// UpgradeHealthCheck implements config.RuntimeConfig interface.
+func (s *WatchdogTimerV1Alpha1) UpgradeHealthCheck() config.UpgradeHealthCheckConfig {
+	return nil
+}
+
+// Metrics implements config.RuntimeConfig interface.
+func (s *WatchdogTimerV1Alpha1) Metrics() config.MetricsConfig {
+	return nil
+}
+
+// SequenceHooks implements config.RuntimeConfig interface.
+func (s *WatchdogTimerV1Alpha1) SequenceHooks() []config.SequenceHookConfig {
+	return nil
+}
+
+// WebhookNotifications implements config.RuntimeConfig interface.
+func (s *WatchdogTimerV1Alpha1) WebhookNotifications() []config.WebhookNotificationConfig {
+	return nil
+}
+
+// SNMP implements config.RuntimeConfig interface.
+func (s *WatchdogTimerV1Alpha1) SNMP() config.SNMPConfig {
+	return nil
+}
+
 // Device implements config.WatchdogTimerConfig interface.
 func (s *WatchdogTimerV1Alpha1) Device() string {
 	return s.WatchdogDevice
@@ -0,0 +1,276 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"time"
+
+	"github.com/siderolabs/gen/ensure"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// WebhookNotificationKind is a webhook notification config document kind.
+const WebhookNotificationKind = "WebhookNotificationConfig"
+
+func init() {
+	registry.Register(WebhookNotificationKind, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &WebhookNotificationV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.RuntimeConfig             = &WebhookNotificationV1Alpha1{}
+	_ config.WebhookNotificationConfig = &WebhookNotificationV1Alpha1{}
+	_ config.NamedDocument             = &WebhookNotificationV1Alpha1{}
+	_ config.Validator                 = &WebhookNotificationV1Alpha1{}
+)
+
+// Timeout constants.
+const (
+	DefaultWebhookNotificationTimeout = 10 * time.Second
+	MaxWebhookNotificationTimeout     = 5 * time.Minute
+)
+
+var (
+	webhookNotificationFormats = []string{
+		config.WebhookFormatJSON,
+		config.WebhookFormatSlack,
+	}
+
+	webhookNotificationEvents = []string{
+		config.WebhookEventUpgradeFailed,
+		config.WebhookEventBootFallback,
+		config.WebhookEventDiskFailure,
+		config.WebhookEventCertificateExpiring,
+	}
+)
+
+// WebhookNotificationV1Alpha1 is a webhook notification config document.
+//
+//	examples:
+//	  - value: exampleWebhookNotificationV1Alpha1()
+//	alias: WebhookNotificationConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/WebhookNotificationConfig
+type WebhookNotificationV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Name of the config document.
+	//   schemaRequired: true
+	MetaName string `yaml:"name"`
+	//   description: |
+	//     URL to send the notifications to.
+	//
+	//     Each notification is sent as a POST request, failed requests are retried with an exponential backoff.
+	//   schemaRequired: true
+	//   schema:
+	//     type: string
+	//     pattern: "^(http|https)://"
+	WebhookURL meta.URL `yaml:"url"`
+	//   description: |
+	//     Format of the request body.
+	//
+	//     The 'json' format sends the JSON description of the event,
+	//     the 'slack' format sends a message compatible with the Slack incoming webhooks.
+	//
+	//     Default value is 'json'.
+	//   values:
+	//     - "json"
+	//     - "slack"
+	WebhookFormat string `yaml:"format,omitempty"`
+	//   description: |
+	//     List of the events to send, all events are sent if not set.
+	//   values:
+	//     - "upgrade-failed"
+	//     - "boot-fallback"
+	//     - "disk-failure"
+	//     - "certificate-expiring"
+	WebhookEvents []string `yaml:"events,omitempty"`
+	//   description: |
+	//     Extra HTTP headers to send with the request, e.g. for authentication.
+	//   examples:
+	//     - value: >
+	//        map[string]string{"Authorization": "Bearer token"}
+	WebhookHeaders map[string]string `yaml:"headers,omitempty"`
+	//   description: |
+	//     Key to sign the request body with.
+	//
+	//     If set, the HMAC-SHA256 signature of the request timestamp and body joined with '.' is sent in the 'X-Talos-Signature' header
+	//     as 'sha256=<hex digest>', and the timestamp (Unix seconds) is sent in the 'X-Talos-Timestamp' header.
+	//     Receivers should reject the requests with the timestamp more than 5 minutes away from their clock to prevent the replays.
+	WebhookSigningKey string `yaml:"signingKey,omitempty"`
+	//   description: |
+	//     Timeout for each request.
+	//
+	//     Default value is 10 seconds, maximum value is 5 minutes.
+	//   schema:
+	//     type: string
+	//     pattern: ^[-+]?(((\d+(\.\d*)?|\d*(\.\d+)+)([nuµm]?s|m|h))|0)+$
+	WebhookTimeout time.Duration `yaml:"timeout,omitempty"`
+}
+
+// NewWebhookNotificationV1Alpha1 creates a new webhook notification config document.
+func NewWebhookNotificationV1Alpha1() *WebhookNotificationV1Alpha1 {
+	return &WebhookNotificationV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       WebhookNotificationKind,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleWebhookNotificationV1Alpha1() *WebhookNotificationV1Alpha1 {
+	cfg := NewWebhookNotificationV1Alpha1()
+	cfg.MetaName = "ops-channel"
+	cfg.WebhookURL.URL = ensure.Value(url.Parse("https://hooks.slack.com/services/T000/B000/XXXX"))
+	cfg.WebhookFormat = config.WebhookFormatSlack
+	cfg.WebhookEvents = []string{config.WebhookEventUpgradeFailed, config.WebhookEventBootFallback}
+
+	return cfg
+}
+
+// Name implements config.NamedDocument interface.
+func (s *WebhookNotificationV1Alpha1) Name() string {
+	return s.MetaName
+}
+
+// Clone implements config.Document interface.
+func (s *WebhookNotificationV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Runtime implements config.Config interface.
+func (s *WebhookNotificationV1Alpha1) Runtime() config.RuntimeConfig {
+	return s
+}
+
+// EventsEndpoint implements config.RuntimeConfig interface.
+func (s *WebhookNotificationV1Alpha1) EventsEndpoint() *string {
+	return nil
+}
+
+// KmsgLogURLs implements config.RuntimeConfig interface.
+func (s *WebhookNotificationV1Alpha1) KmsgLogURLs() []*url.URL {
+	return nil
+}
+
+// WatchdogTimer implements config.RuntimeConfig interface.
+func (s *WebhookNotificationV1Alpha1) WatchdogTimer() config.WatchdogTimerConfig {
+	return nil
+}
+
+// UpgradeHealthCheck implements config.RuntimeConfig interface.
+func (s *WebhookNotificationV1Alpha1) UpgradeHealthCheck() config.UpgradeHealthCheckConfig {
+	return nil
+}
+
+// Metrics implements config.RuntimeConfig interface.
+func (s *WebhookNotificationV1Alpha1) Metrics() config.MetricsConfig {
+	return nil
+}
+
+// SequenceHooks implements config.RuntimeConfig interface.
+func (s *WebhookNotificationV1Alpha1) SequenceHooks() []config.SequenceHookConfig {
+	return nil
+}
+
+// WebhookNotifications implements config.RuntimeConfig interface.
+func (s *WebhookNotificationV1Alpha1) WebhookNotifications() []config.WebhookNotificationConfig {
+	return []config.WebhookNotificationConfig{s}
+}
+
+// SNMP implements config.RuntimeConfig interface.
+func (s *WebhookNotificationV1Alpha1) SNMP() config.SNMPConfig {
+	return nil
+}
+
+// URL implements config.WebhookNotificationConfig interface.
+func (s *WebhookNotificationV1Alpha1) URL() *url.URL {
+	return s.WebhookURL.URL
+}
+
+// Format implements config.WebhookNotificationConfig interface.
+func (s *WebhookNotificationV1Alpha1) Format() string {
+	if s.WebhookFormat == "" {
+		return config.WebhookFormatJSON
+	}
+
+	return s.WebhookFormat
+}
+
+// Events implements config.WebhookNotificationConfig interface.
+func (s *WebhookNotificationV1Alpha1) Events() []string {
+	if len(s.WebhookEvents) == 0 {
+		return webhookNotificationEvents
+	}
+
+	return s.WebhookEvents
+}
+
+// Headers implements config.WebhookNotificationConfig interface.
+func (s *WebhookNotificationV1Alpha1) Headers() map[string]string {
+	return s.WebhookHeaders
+}
+
+// SigningKey implements config.WebhookNotificationConfig interface.
+func (s *WebhookNotificationV1Alpha1) SigningKey() string {
+	return s.WebhookSigningKey
+}
+
+// Timeout implements config.WebhookNotificationConfig interface.
+func (s *WebhookNotificationV1Alpha1) Timeout() time.Duration {
+	if s.WebhookTimeout == 0 {
+		return DefaultWebhookNotificationTimeout
+	}
+
+	return s.WebhookTimeout
+}
+
+// Validate implements config.Validator interface.
+func (s *WebhookNotificationV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if s.MetaName == "" {
+		errs = errors.Join(errs, errors.New("name is required"))
+	}
+
+	if s.WebhookURL.URL == nil {
+		errs = errors.Join(errs, errors.New("url is required"))
+	} else if s.WebhookURL.Scheme != "http" && s.WebhookURL.Scheme != "https" {
+		errs = errors.Join(errs, fmt.Errorf("url: unsupported scheme %q", s.WebhookURL.Scheme))
+	}
+
+	if s.WebhookFormat != "" && !slices.Contains(webhookNotificationFormats, s.WebhookFormat) {
+		errs = errors.Join(errs, fmt.Errorf("format: unsupported value %q, expected one of %q", s.WebhookFormat, webhookNotificationFormats))
+	}
+
+	for _, event := range s.WebhookEvents {
+		if !slices.Contains(webhookNotificationEvents, event) {
+			errs = errors.Join(errs, fmt.Errorf("events: unsupported value %q, expected one of %q", event, webhookNotificationEvents))
+		}
+	}
+
+	if s.WebhookTimeout < 0 || s.WebhookTimeout > MaxWebhookNotificationTimeout {
+		errs = errors.Join(errs, fmt.Errorf("timeout: should be between 0 and %s", MaxWebhookNotificationTimeout))
+	}
+
+	return nil, errs
+}
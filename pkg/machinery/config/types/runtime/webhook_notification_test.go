@@ -0,0 +1,132 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime_test
+
+import (
+	_ "embed"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/siderolabs/gen/ensure"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/runtime"
+)
+
+//go:embed testdata/webhooknotification.yaml
+var expectedWebhookNotificationDocument []byte
+
+func TestWebhookNotificationMarshalStability(t *testing.T) {
+	cfg := runtime.NewWebhookNotificationV1Alpha1()
+	cfg.MetaName = "ops-channel"
+	cfg.WebhookURL.URL = ensure.Value(url.Parse("https://hooks.example.com/talos"))
+	cfg.WebhookFormat = config.WebhookFormatSlack
+	cfg.WebhookEvents = []string{config.WebhookEventUpgradeFailed, config.WebhookEventDiskFailure}
+	cfg.WebhookHeaders = map[string]string{"Authorization": "Bearer token"}
+	cfg.WebhookSigningKey = "secret"
+	cfg.WebhookTimeout = 30 * time.Second
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedWebhookNotificationDocument, marshaled)
+}
+
+func TestWebhookNotificationUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedWebhookNotificationDocument)
+	require.NoError(t, err)
+
+	webhooks := provider.Runtime().WebhookNotifications()
+	require.Len(t, webhooks, 1)
+
+	assert.Equal(t, "ops-channel", webhooks[0].Name())
+	assert.Equal(t, "https://hooks.example.com/talos", webhooks[0].URL().String())
+	assert.Equal(t, config.WebhookFormatSlack, webhooks[0].Format())
+	assert.Equal(t, []string{config.WebhookEventUpgradeFailed, config.WebhookEventDiskFailure}, webhooks[0].Events())
+	assert.Equal(t, map[string]string{"Authorization": "Bearer token"}, webhooks[0].Headers())
+	assert.Equal(t, "secret", webhooks[0].SigningKey())
+	assert.Equal(t, 30*time.Second, webhooks[0].Timeout())
+}
+
+func TestWebhookNotificationDefaults(t *testing.T) {
+	t.Parallel()
+
+	cfg := runtime.NewWebhookNotificationV1Alpha1()
+
+	assert.Equal(t, config.WebhookFormatJSON, cfg.Format())
+	assert.Equal(t, []string{
+		config.WebhookEventUpgradeFailed,
+		config.WebhookEventBootFallback,
+		config.WebhookEventDiskFailure,
+		config.WebhookEventCertificateExpiring,
+	}, cfg.Events())
+	assert.Equal(t, runtime.DefaultWebhookNotificationTimeout, cfg.Timeout())
+}
+
+func TestWebhookNotificationValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *runtime.WebhookNotificationV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  runtime.NewWebhookNotificationV1Alpha1,
+
+			expectedError: "name is required\nurl is required",
+		},
+		{
+			name: "invalid",
+			cfg: func() *runtime.WebhookNotificationV1Alpha1 {
+				cfg := runtime.NewWebhookNotificationV1Alpha1()
+				cfg.MetaName = "webhook"
+				cfg.WebhookURL.URL = ensure.Value(url.Parse("tcp://localhost:8080"))
+				cfg.WebhookFormat = "xml"
+				cfg.WebhookEvents = []string{"node-ready"}
+				cfg.WebhookTimeout = time.Hour
+
+				return cfg
+			},
+
+			expectedError: "url: unsupported scheme \"tcp\"\nformat: unsupported value \"xml\", expected one of [\"json\" \"slack\"]\n" +
+				"events: unsupported value \"node-ready\", expected one of [\"upgrade-failed\" \"boot-fallback\" \"disk-failure\" \"certificate-expiring\"]\n" +
+				"timeout: should be between 0 and 5m0s",
+		},
+		{
+			name: "valid",
+			cfg: func() *runtime.WebhookNotificationV1Alpha1 {
+				cfg := runtime.NewWebhookNotificationV1Alpha1()
+				cfg.MetaName = "webhook"
+				cfg.WebhookURL.URL = ensure.Value(url.Parse("http://localhost:8080/hook"))
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
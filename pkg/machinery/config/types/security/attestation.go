@@ -0,0 +1,174 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security
+
+//docgen:jsonschema
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// TPMAttestationConfig is a TPM attestation config document kind.
+const TPMAttestationConfig = "TPMAttestationConfig"
+
+// maxPCRIndex is the highest PCR index available on TPM2.0 devices.
+const maxPCRIndex = 23
+
+func init() {
+	registry.Register(TPMAttestationConfig, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &TPMAttestationConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.TPMAttestationConfig = &TPMAttestationConfigV1Alpha1{}
+	_ config.Validator            = &TPMAttestationConfigV1Alpha1{}
+)
+
+// TPMAttestationConfigV1Alpha1 configures TPM attestation of the nodes requesting certificates from trustd.
+//
+//	examples:
+//	  - value: exampleTPMAttestationConfigV1Alpha1()
+//	alias: TPMAttestationConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/TPMAttestationConfig
+type TPMAttestationConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Require nodes to present a valid TPM quote before issuing a certificate.
+	//
+	//     The quote covers the PCR 7 (Secure Boot state) and PCR 11 (UKI measurements).
+	AttestationRequired bool `yaml:"required"`
+	//   description: |
+	//     List of the enrolled TPM attestation keys (hex-encoded SHA256 fingerprints).
+	//
+	//     A quote is only trusted if it is signed by one of the enrolled attestation keys.
+	//     The attestation key fingerprint of a node is shown by `talosctl attest status`.
+	AttestationKeys []string `yaml:"attestationKeys,omitempty"`
+	//   description: |
+	//     List of PCR values accepted in the TPM quote.
+	//
+	//     If not set, any PCR values are accepted as long as the quote is valid.
+	AttestationPCRs []PCRPolicy `yaml:"pcrs,omitempty"`
+}
+
+// PCRPolicy describes accepted values of a single PCR.
+type PCRPolicy struct {
+	//   description: |
+	//     PCR index.
+	//   schemaRequired: true
+	PCRIndex int `yaml:"index"`
+	//   description: |
+	//     List of accepted PCR values (hex-encoded SHA256 digests).
+	//   schemaRequired: true
+	PCRValues []string `yaml:"values"`
+}
+
+// NewTPMAttestationConfigV1Alpha1 creates a new TPMAttestationConfig config document.
+func NewTPMAttestationConfigV1Alpha1() *TPMAttestationConfigV1Alpha1 {
+	return &TPMAttestationConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       TPMAttestationConfig,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleTPMAttestationConfigV1Alpha1() *TPMAttestationConfigV1Alpha1 {
+	cfg := NewTPMAttestationConfigV1Alpha1()
+	cfg.AttestationRequired = true
+	cfg.AttestationKeys = []string{"9f2c4bd6a7e1c0583d4e6b2a1f7c8d9e0a3b5c7d9e1f2a4b6c8d0e2f4a6b8c0d"}
+	cfg.AttestationPCRs = []PCRPolicy{
+		{
+			PCRIndex:  11,
+			PCRValues: []string{"3ad0d2ab2e0b1c5b5dcbf3cb0f6b6ea18a4e9fa3ad3ef9c4c2b5d7f1c6c0e4a2"},
+		},
+	}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *TPMAttestationConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Required implements config.TPMAttestationConfig interface.
+func (s *TPMAttestationConfigV1Alpha1) Required() bool {
+	return s.AttestationRequired
+}
+
+// AttestationKeys implements config.TPMAttestationConfig interface.
+func (s *TPMAttestationConfigV1Alpha1) AttestationKeys() []string {
+	return s.AttestationKeys
+}
+
+// PCRs implements config.TPMAttestationConfig interface.
+func (s *TPMAttestationConfigV1Alpha1) PCRs() map[int][]string {
+	if len(s.AttestationPCRs) == 0 {
+		return nil
+	}
+
+	pcrs := make(map[int][]string, len(s.AttestationPCRs))
+
+	for _, policy := range s.AttestationPCRs {
+		pcrs[policy.PCRIndex] = append(pcrs[policy.PCRIndex], policy.PCRValues...)
+	}
+
+	return pcrs
+}
+
+// Validate implements config.Validator interface.
+func (s *TPMAttestationConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	if (s.AttestationRequired || len(s.AttestationPCRs) > 0) && len(s.AttestationKeys) == 0 {
+		errs = errors.Join(errs, errors.New("at least one attestation key should be enrolled to verify the TPM quotes"))
+	}
+
+	for _, key := range s.AttestationKeys {
+		if !isSHA256Hex(key) {
+			errs = errors.Join(errs, fmt.Errorf("attestation key %q is not a hex-encoded SHA256 fingerprint", key))
+		}
+	}
+
+	for _, policy := range s.AttestationPCRs {
+		if policy.PCRIndex < 0 || policy.PCRIndex > maxPCRIndex {
+			errs = errors.Join(errs, fmt.Errorf("pcr index %d: should be in range 0-%d", policy.PCRIndex, maxPCRIndex))
+		}
+
+		if len(policy.PCRValues) == 0 {
+			errs = errors.Join(errs, fmt.Errorf("pcr %d: at least one value is required", policy.PCRIndex))
+		}
+
+		for _, value := range policy.PCRValues {
+			if !isSHA256Hex(value) {
+				errs = errors.Join(errs, fmt.Errorf("pcr %d: value %q is not a hex-encoded SHA256 digest", policy.PCRIndex, value))
+			}
+		}
+	}
+
+	return nil, errs
+}
+
+func isSHA256Hex(value string) bool {
+	decoded, err := hex.DecodeString(value)
+
+	return err == nil && len(decoded) == sha256.Size
+}
@@ -0,0 +1,194 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+)
+
+//go:embed testdata/tpmattestationconfig.yaml
+var expectedTPMAttestationConfigDocument []byte
+
+const (
+	testPCRValue       = "3ad0d2ab2e0b1c5b5dcbf3cb0f6b6ea18a4e9fa3ad3ef9c4c2b5d7f1c6c0e4a2"
+	testAttestationKey = "9f2c4bd6a7e1c0583d4e6b2a1f7c8d9e0a3b5c7d9e1f2a4b6c8d0e2f4a6b8c0d"
+)
+
+func TestTPMAttestationMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := security.NewTPMAttestationConfigV1Alpha1()
+	cfg.AttestationRequired = true
+	cfg.AttestationKeys = []string{testAttestationKey}
+	cfg.AttestationPCRs = []security.PCRPolicy{
+		{
+			PCRIndex:  11,
+			PCRValues: []string{testPCRValue},
+		},
+	}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedTPMAttestationConfigDocument, marshaled)
+}
+
+func TestTPMAttestationConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedTPMAttestationConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &security.TPMAttestationConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       security.TPMAttestationConfig,
+		},
+		AttestationRequired: true,
+		AttestationKeys:     []string{testAttestationKey},
+		AttestationPCRs: []security.PCRPolicy{
+			{
+				PCRIndex:  11,
+				PCRValues: []string{testPCRValue},
+			},
+		},
+	}, docs[0])
+
+	require.NotNil(t, provider.TPMAttestation())
+	assert.True(t, provider.TPMAttestation().Required())
+	assert.Equal(t, []string{testAttestationKey}, provider.TPMAttestation().AttestationKeys())
+	assert.Equal(t, map[int][]string{11: {testPCRValue}}, provider.TPMAttestation().PCRs())
+}
+
+func TestTPMAttestationConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *security.TPMAttestationConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  security.NewTPMAttestationConfigV1Alpha1,
+		},
+		{
+			name: "invalid index",
+			cfg: func() *security.TPMAttestationConfigV1Alpha1 {
+				cfg := security.NewTPMAttestationConfigV1Alpha1()
+				cfg.AttestationKeys = []string{testAttestationKey}
+				cfg.AttestationPCRs = []security.PCRPolicy{
+					{
+						PCRIndex:  24,
+						PCRValues: []string{testPCRValue},
+					},
+				}
+
+				return cfg
+			},
+
+			expectedError: "pcr index 24: should be in range 0-23",
+		},
+		{
+			name: "invalid values",
+			cfg: func() *security.TPMAttestationConfigV1Alpha1 {
+				cfg := security.NewTPMAttestationConfigV1Alpha1()
+				cfg.AttestationKeys = []string{testAttestationKey}
+				cfg.AttestationPCRs = []security.PCRPolicy{
+					{
+						PCRIndex: 7,
+					},
+					{
+						PCRIndex:  11,
+						PCRValues: []string{"abcd"},
+					},
+				}
+
+				return cfg
+			},
+
+			expectedError: "pcr 7: at least one value is required\npcr 11: value \"abcd\" is not a hex-encoded SHA256 digest",
+		},
+		{
+			name: "no attestation keys",
+			cfg: func() *security.TPMAttestationConfigV1Alpha1 {
+				cfg := security.NewTPMAttestationConfigV1Alpha1()
+				cfg.AttestationRequired = true
+
+				return cfg
+			},
+
+			expectedError: "at least one attestation key should be enrolled to verify the TPM quotes",
+		},
+		{
+			name: "invalid attestation key",
+			cfg: func() *security.TPMAttestationConfigV1Alpha1 {
+				cfg := security.NewTPMAttestationConfigV1Alpha1()
+				cfg.AttestationKeys = []string{"abcd"}
+
+				return cfg
+			},
+
+			expectedError: "attestation key \"abcd\" is not a hex-encoded SHA256 fingerprint",
+		},
+		{
+			name: "valid",
+			cfg: func() *security.TPMAttestationConfigV1Alpha1 {
+				cfg := security.NewTPMAttestationConfigV1Alpha1()
+				cfg.AttestationRequired = true
+				cfg.AttestationKeys = []string{testAttestationKey}
+				cfg.AttestationPCRs = []security.PCRPolicy{
+					{
+						PCRIndex:  11,
+						PCRValues: []string{testPCRValue},
+					},
+				}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+type validationMode struct{}
+
+func (validationMode) String() string {
+	return ""
+}
+
+func (validationMode) RequiresInstall() bool {
+	return false
+}
+
+func (validationMode) InContainer() bool {
+	return false
+}
@@ -0,0 +1,127 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security
+
+//docgen:jsonschema
+
+import (
+	"errors"
+	"fmt"
+	"net/netip"
+	"path"
+
+	"github.com/siderolabs/gen/xslices"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// TrustdCSRPolicyConfig is a trustd CSR policy config document kind.
+const TrustdCSRPolicyConfig = "TrustdCSRPolicyConfig"
+
+func init() {
+	registry.Register(TrustdCSRPolicyConfig, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &TrustdCSRPolicyConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.TrustdCSRPolicyConfig = &TrustdCSRPolicyConfigV1Alpha1{}
+	_ config.Validator             = &TrustdCSRPolicyConfigV1Alpha1{}
+)
+
+// TrustdCSRPolicyConfigV1Alpha1 restricts the identities which nodes can request in the certificates issued by trustd.
+//
+//	examples:
+//	  - value: exampleTrustdCSRPolicyConfigV1Alpha1()
+//	alias: TrustdCSRPolicyConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/TrustdCSRPolicyConfig
+type TrustdCSRPolicyConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     List of CIDR prefixes the IP addresses in the certificate request (including the common name, if it is an IP address) should belong to.
+	//
+	//     If not set, any IP addresses are allowed.
+	//     Talos nodes request the node addresses and the IP addresses from `.machine.certSANs`.
+	//   schema:
+	//     type: array
+	//     items:
+	//       type: string
+	//       pattern: ^[0-9a-f.:]+/\d{1,3}$
+	CSRAllowedIPs []netip.Prefix `yaml:"allowedIPs,omitempty"`
+	//   description: |
+	//     List of patterns the DNS names in the certificate request (including the common name) should match.
+	//
+	//     Patterns use the shell glob syntax, e.g. `*.example.com`.
+	//     If not set, any DNS names are allowed.
+	//   examples:
+	//     - value: >
+	//         []string{"worker-*", "*.cluster.example.com"}
+	CSRAllowedDNSNames []string `yaml:"allowedDNSNames,omitempty"`
+}
+
+// NewTrustdCSRPolicyConfigV1Alpha1 creates a new TrustdCSRPolicyConfig config document.
+func NewTrustdCSRPolicyConfigV1Alpha1() *TrustdCSRPolicyConfigV1Alpha1 {
+	return &TrustdCSRPolicyConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       TrustdCSRPolicyConfig,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleTrustdCSRPolicyConfigV1Alpha1() *TrustdCSRPolicyConfigV1Alpha1 {
+	cfg := NewTrustdCSRPolicyConfigV1Alpha1()
+	cfg.CSRAllowedIPs = []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("fd00::/8"),
+	}
+	cfg.CSRAllowedDNSNames = []string{"worker-*", "*.cluster.example.com"}
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *TrustdCSRPolicyConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// AllowedIPs implements config.TrustdCSRPolicyConfig interface.
+func (s *TrustdCSRPolicyConfigV1Alpha1) AllowedIPs() []netip.Prefix {
+	return xslices.Map(s.CSRAllowedIPs, netip.Prefix.Masked)
+}
+
+// AllowedDNSNames implements config.TrustdCSRPolicyConfig interface.
+func (s *TrustdCSRPolicyConfigV1Alpha1) AllowedDNSNames() []string {
+	return s.CSRAllowedDNSNames
+}
+
+// Validate implements config.Validator interface.
+func (s *TrustdCSRPolicyConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var errs error
+
+	for _, prefix := range s.CSRAllowedIPs {
+		if !prefix.IsValid() {
+			errs = errors.Join(errs, fmt.Errorf("allowed IP prefix %q is not valid", prefix))
+		}
+	}
+
+	for _, pattern := range s.CSRAllowedDNSNames {
+		if _, err := path.Match(pattern, ""); err != nil || pattern == "" {
+			errs = errors.Join(errs, fmt.Errorf("allowed DNS name pattern %q is not valid", pattern))
+		}
+	}
+
+	return nil, errs
+}
@@ -0,0 +1,118 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security_test
+
+import (
+	_ "embed"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+)
+
+//go:embed testdata/trustdcsrpolicyconfig.yaml
+var expectedTrustdCSRPolicyConfigDocument []byte
+
+func TestTrustdCSRPolicyMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := security.NewTrustdCSRPolicyConfigV1Alpha1()
+	cfg.CSRAllowedIPs = []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("::1/128"),
+	}
+	cfg.CSRAllowedDNSNames = []string{"worker-*"}
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedTrustdCSRPolicyConfigDocument, marshaled)
+}
+
+func TestTrustdCSRPolicyConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedTrustdCSRPolicyConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &security.TrustdCSRPolicyConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       security.TrustdCSRPolicyConfig,
+		},
+		CSRAllowedIPs: []netip.Prefix{
+			netip.MustParsePrefix("10.0.0.0/8"),
+			netip.MustParsePrefix("::1/128"),
+		},
+		CSRAllowedDNSNames: []string{"worker-*"},
+	}, docs[0])
+
+	require.NotNil(t, provider.TrustdCSRPolicy())
+	assert.Equal(t, []netip.Prefix{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("::1/128"),
+	}, provider.TrustdCSRPolicy().AllowedIPs())
+	assert.Equal(t, []string{"worker-*"}, provider.TrustdCSRPolicy().AllowedDNSNames())
+}
+
+func TestTrustdCSRPolicyConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		cfg  func() *security.TrustdCSRPolicyConfigV1Alpha1
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+			cfg:  security.NewTrustdCSRPolicyConfigV1Alpha1,
+		},
+		{
+			name: "invalid patterns",
+			cfg: func() *security.TrustdCSRPolicyConfigV1Alpha1 {
+				cfg := security.NewTrustdCSRPolicyConfigV1Alpha1()
+				cfg.CSRAllowedDNSNames = []string{"", "worker-[a"}
+
+				return cfg
+			},
+
+			expectedError: "allowed DNS name pattern \"\" is not valid\nallowed DNS name pattern \"worker-[a\" is not valid",
+		},
+		{
+			name: "valid",
+			cfg: func() *security.TrustdCSRPolicyConfigV1Alpha1 {
+				cfg := security.NewTrustdCSRPolicyConfigV1Alpha1()
+				cfg.CSRAllowedIPs = []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+				cfg.CSRAllowedDNSNames = []string{"*.example.com"}
+
+				return cfg
+			},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := test.cfg().Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
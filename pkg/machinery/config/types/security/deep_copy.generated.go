@@ -2,10 +2,70 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type TrustedRootsConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type ImageVerificationConfigV1Alpha1 -type LSMConfigV1Alpha1 -type SeccompConfigV1Alpha1 -type TPMAttestationConfigV1Alpha1 -type TrustdCSRPolicyConfigV1Alpha1 -type TrustedRootsConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package security
 
+import (
+	"net/netip"
+)
+
+// DeepCopy generates a deep copy of *ImageVerificationConfigV1Alpha1.
+func (o *ImageVerificationConfigV1Alpha1) DeepCopy() *ImageVerificationConfigV1Alpha1 {
+	var cp ImageVerificationConfigV1Alpha1 = *o
+	if o.VerificationPublicKeys != nil {
+		cp.VerificationPublicKeys = make([]string, len(o.VerificationPublicKeys))
+		copy(cp.VerificationPublicKeys, o.VerificationPublicKeys)
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *LSMConfigV1Alpha1.
+func (o *LSMConfigV1Alpha1) DeepCopy() *LSMConfigV1Alpha1 {
+	var cp LSMConfigV1Alpha1 = *o
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *SeccompConfigV1Alpha1.
+func (o *SeccompConfigV1Alpha1) DeepCopy() *SeccompConfigV1Alpha1 {
+	var cp SeccompConfigV1Alpha1 = *o
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *TPMAttestationConfigV1Alpha1.
+func (o *TPMAttestationConfigV1Alpha1) DeepCopy() *TPMAttestationConfigV1Alpha1 {
+	var cp TPMAttestationConfigV1Alpha1 = *o
+	if o.AttestationKeys != nil {
+		cp.AttestationKeys = make([]string, len(o.AttestationKeys))
+		copy(cp.AttestationKeys, o.AttestationKeys)
+	}
+	if o.AttestationPCRs != nil {
+		cp.AttestationPCRs = make([]PCRPolicy, len(o.AttestationPCRs))
+		copy(cp.AttestationPCRs, o.AttestationPCRs)
+		for i2 := range o.AttestationPCRs {
+			if o.AttestationPCRs[i2].PCRValues != nil {
+				cp.AttestationPCRs[i2].PCRValues = make([]string, len(o.AttestationPCRs[i2].PCRValues))
+				copy(cp.AttestationPCRs[i2].PCRValues, o.AttestationPCRs[i2].PCRValues)
+			}
+		}
+	}
+	return &cp
+}
+
+// DeepCopy generates a deep copy of *TrustdCSRPolicyConfigV1Alpha1.
+func (o *TrustdCSRPolicyConfigV1Alpha1) DeepCopy() *TrustdCSRPolicyConfigV1Alpha1 {
+	var cp TrustdCSRPolicyConfigV1Alpha1 = *o
+	if o.CSRAllowedIPs != nil {
+		cp.CSRAllowedIPs = make([]netip.Prefix, len(o.CSRAllowedIPs))
+		copy(cp.CSRAllowedIPs, o.CSRAllowedIPs)
+	}
+	if o.CSRAllowedDNSNames != nil {
+		cp.CSRAllowedDNSNames = make([]string, len(o.CSRAllowedDNSNames))
+		copy(cp.CSRAllowedDNSNames, o.CSRAllowedDNSNames)
+	}
+	return &cp
+}
+
 // DeepCopy generates a deep copy of *TrustedRootsConfigV1Alpha1.
 func (o *TrustedRootsConfigV1Alpha1) DeepCopy() *TrustedRootsConfigV1Alpha1 {
 	var cp TrustedRootsConfigV1Alpha1 = *o
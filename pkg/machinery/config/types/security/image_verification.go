@@ -0,0 +1,140 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security
+
+//docgen:jsonschema
+
+import (
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// ImageVerificationConfig is an image verification config document kind.
+const ImageVerificationConfig = "ImageVerificationConfig"
+
+func init() {
+	registry.Register(ImageVerificationConfig, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &ImageVerificationConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.ImageVerificationConfig = &ImageVerificationConfigV1Alpha1{}
+	_ config.Validator               = &ImageVerificationConfigV1Alpha1{}
+)
+
+// ImageVerificationConfigV1Alpha1 configures verification of the installer image signatures on upgrade.
+//
+//	examples:
+//	  - value: exampleImageVerificationConfigV1Alpha1()
+//	alias: ImageVerificationConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/ImageVerificationConfig
+type ImageVerificationConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     List of trusted public keys (as PEM-encoded PKIX public keys).
+	//
+	//     The installer image should have a valid cosign signature made by one of the keys.
+	//     ECDSA, RSA and Ed25519 keys are supported.
+	//   schemaRequired: true
+	VerificationPublicKeys []string `yaml:"publicKeys"`
+	//   description: |
+	//     Require the installer image reference to be pinned by digest (e.g. `ghcr.io/siderolabs/installer@sha256:...`).
+	VerificationRequireDigest bool `yaml:"requireDigest,omitempty"`
+}
+
+// NewImageVerificationConfigV1Alpha1 creates a new ImageVerificationConfig config document.
+func NewImageVerificationConfigV1Alpha1() *ImageVerificationConfigV1Alpha1 {
+	return &ImageVerificationConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       ImageVerificationConfig,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleImageVerificationConfigV1Alpha1() *ImageVerificationConfigV1Alpha1 {
+	cfg := NewImageVerificationConfigV1Alpha1()
+	cfg.VerificationPublicKeys = []string{`-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAE...
+-----END PUBLIC KEY-----
+`}
+	cfg.VerificationRequireDigest = true
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *ImageVerificationConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// PublicKeys implements config.ImageVerificationConfig interface.
+//
+// Keys which fail to parse are skipped, as they are rejected by the validation.
+func (s *ImageVerificationConfigV1Alpha1) PublicKeys() []crypto.PublicKey {
+	keys := make([]crypto.PublicKey, 0, len(s.VerificationPublicKeys))
+
+	for _, encoded := range s.VerificationPublicKeys {
+		key, err := parsePublicKey(encoded)
+		if err != nil {
+			continue
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys
+}
+
+// RequireDigest implements config.ImageVerificationConfig interface.
+func (s *ImageVerificationConfigV1Alpha1) RequireDigest() bool {
+	return s.VerificationRequireDigest
+}
+
+// Validate implements config.Validator interface.
+func (s *ImageVerificationConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	if len(s.VerificationPublicKeys) == 0 {
+		return nil, errors.New("at least one public key is required")
+	}
+
+	var errs error
+
+	for i, encoded := range s.VerificationPublicKeys {
+		if _, err := parsePublicKey(encoded); err != nil {
+			errs = errors.Join(errs, fmt.Errorf("public key %d: %w", i, err))
+		}
+	}
+
+	return nil, errs
+}
+
+func parsePublicKey(encoded string) (crypto.PublicKey, error) {
+	block, _ := pem.Decode([]byte(encoded))
+	if block == nil {
+		return nil, errors.New("failed to decode PEM block")
+	}
+
+	if block.Type != "PUBLIC KEY" {
+		return nil, fmt.Errorf("unexpected PEM block type %q", block.Type)
+	}
+
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
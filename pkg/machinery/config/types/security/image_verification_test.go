@@ -0,0 +1,117 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security_test
+
+import (
+	"crypto/ecdsa"
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+)
+
+//go:embed testdata/imageverificationconfig.yaml
+var expectedImageVerificationConfigDocument []byte
+
+const testPublicKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEQKhAI4d45/cRx/n5Bwhzf++/7X3M
+nZDrKRdamYRugkFUZ49z5e9c72IpkR/iDMIqqV1ySp0iZKaOV7RqXqRQlg==
+-----END PUBLIC KEY-----
+`
+
+func TestImageVerificationConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := security.NewImageVerificationConfigV1Alpha1()
+	cfg.VerificationPublicKeys = []string{testPublicKey}
+	cfg.VerificationRequireDigest = true
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedImageVerificationConfigDocument, marshaled)
+}
+
+func TestImageVerificationConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedImageVerificationConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &security.ImageVerificationConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       security.ImageVerificationConfig,
+		},
+		VerificationPublicKeys:    []string{testPublicKey},
+		VerificationRequireDigest: true,
+	}, docs[0])
+
+	require.NotNil(t, provider.ImageVerification())
+	assert.True(t, provider.ImageVerification().RequireDigest())
+
+	keys := provider.ImageVerification().PublicKeys()
+	require.Len(t, keys, 1)
+	assert.IsType(t, &ecdsa.PublicKey{}, keys[0])
+}
+
+func TestImageVerificationConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		keys []string
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+
+			expectedError: "at least one public key is required",
+		},
+		{
+			name: "not PEM",
+			keys: []string{"foo"},
+
+			expectedError: "public key 0: failed to decode PEM block",
+		},
+		{
+			name: "wrong block type",
+			keys: []string{testPublicKey, "-----BEGIN CERTIFICATE-----\nMIIB\n-----END CERTIFICATE-----\n"},
+
+			expectedError: "public key 1: unexpected PEM block type \"CERTIFICATE\"",
+		},
+		{
+			name: "valid",
+			keys: []string{testPublicKey},
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := security.NewImageVerificationConfigV1Alpha1()
+			cfg.VerificationPublicKeys = test.keys
+
+			_, err := cfg.Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,104 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security
+
+//docgen:jsonschema
+
+import (
+	"fmt"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// LSMConfig is a Linux Security Module policy config document kind.
+const LSMConfig = "LSMConfig"
+
+// LSM policy modes.
+const (
+	LSMModeEnforcing  = "enforcing"
+	LSMModePermissive = "permissive"
+)
+
+func init() {
+	registry.Register(LSMConfig, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &LSMConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.LSMConfig = &LSMConfigV1Alpha1{}
+	_ config.Validator = &LSMConfigV1Alpha1{}
+)
+
+// LSMConfigV1Alpha1 configures the baseline AppArmor profile applied to the system services, kubelet and the extension services.
+//
+//	examples:
+//	  - value: exampleLSMConfigV1Alpha1()
+//	alias: LSMConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/LSMConfig
+type LSMConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Policy mode.
+	//
+	//     The `talos-system` AppArmor profile is a baseline, not a least-privilege policy:
+	//     it allows everything except for the access to the kernel memory interfaces (e.g. `/dev/mem`, `/proc/kcore`),
+	//     the sysrq trigger and writes to the securityfs, and it is applied to apid, trustd, etcd, kubelet and the extension services.
+	//
+	//     In the `enforcing` mode the profile denies and logs these accesses,
+	//     in the `permissive` mode the profile is loaded in the complain mode, so they are only logged.
+	//   values:
+	//     - enforcing
+	//     - permissive
+	//   schemaRequired: true
+	LSMMode string `yaml:"mode"`
+}
+
+// NewLSMConfigV1Alpha1 creates a new LSMConfig config document.
+func NewLSMConfigV1Alpha1() *LSMConfigV1Alpha1 {
+	return &LSMConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       LSMConfig,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleLSMConfigV1Alpha1() *LSMConfigV1Alpha1 {
+	cfg := NewLSMConfigV1Alpha1()
+	cfg.LSMMode = LSMModeEnforcing
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *LSMConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Enforcing implements config.LSMConfig interface.
+func (s *LSMConfigV1Alpha1) Enforcing() bool {
+	return s.LSMMode == LSMModeEnforcing
+}
+
+// Validate implements config.Validator interface.
+func (s *LSMConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	switch s.LSMMode {
+	case LSMModeEnforcing, LSMModePermissive:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("invalid LSM mode %q: should be one of %q, %q", s.LSMMode, LSMModeEnforcing, LSMModePermissive)
+	}
+}
@@ -0,0 +1,102 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+)
+
+//go:embed testdata/lsmconfig.yaml
+var expectedLSMConfigDocument []byte
+
+func TestLSMConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := security.NewLSMConfigV1Alpha1()
+	cfg.LSMMode = security.LSMModePermissive
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedLSMConfigDocument, marshaled)
+}
+
+func TestLSMConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedLSMConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &security.LSMConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       security.LSMConfig,
+		},
+		LSMMode: security.LSMModePermissive,
+	}, docs[0])
+
+	require.NotNil(t, provider.LSM())
+	assert.False(t, provider.LSM().Enforcing())
+}
+
+func TestLSMConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		mode string
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+
+			expectedError: "invalid LSM mode \"\": should be one of \"enforcing\", \"permissive\"",
+		},
+		{
+			name: "invalid",
+			mode: "disabled",
+
+			expectedError: "invalid LSM mode \"disabled\": should be one of \"enforcing\", \"permissive\"",
+		},
+		{
+			name: "enforcing",
+			mode: security.LSMModeEnforcing,
+		},
+		{
+			name: "permissive",
+			mode: security.LSMModePermissive,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := security.NewLSMConfigV1Alpha1()
+			cfg.LSMMode = test.mode
+
+			_, err := cfg.Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security
+
+//docgen:jsonschema
+
+import (
+	"fmt"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
+)
+
+// SeccompConfig is a system services seccomp config document kind.
+const SeccompConfig = "SeccompConfig"
+
+// Seccomp profile modes.
+const (
+	SeccompModeEnforcing = "enforcing"
+	SeccompModeAudit     = "audit"
+)
+
+func init() {
+	registry.Register(SeccompConfig, func(version string) config.Document {
+		switch version {
+		case "v1alpha1":
+			return &SeccompConfigV1Alpha1{}
+		default:
+			return nil
+		}
+	})
+}
+
+// Check interfaces.
+var (
+	_ config.SeccompConfig = &SeccompConfigV1Alpha1{}
+	_ config.Validator     = &SeccompConfigV1Alpha1{}
+)
+
+// SeccompConfigV1Alpha1 configures the seccomp profiles generated by Talos for the system services.
+//
+//	examples:
+//	  - value: exampleSeccompConfigV1Alpha1()
+//	alias: SeccompConfig
+//	schemaRoot: true
+//	schemaMeta: v1alpha1/SeccompConfig
+type SeccompConfigV1Alpha1 struct {
+	meta.Meta `yaml:",inline"`
+	//   description: |
+	//     Seccomp profile mode.
+	//
+	//     In the `enforcing` mode syscalls not allowed by the profile fail with an error,
+	//     in the `audit` mode such syscalls are allowed, but reported as `SyscallAuditEvent` events.
+	//     Each syscall is reported at most once a minute for each executable.
+	//   values:
+	//     - enforcing
+	//     - audit
+	//   schemaRequired: true
+	SeccompMode string `yaml:"mode"`
+}
+
+// NewSeccompConfigV1Alpha1 creates a new SeccompConfig config document.
+func NewSeccompConfigV1Alpha1() *SeccompConfigV1Alpha1 {
+	return &SeccompConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaKind:       SeccompConfig,
+			MetaAPIVersion: "v1alpha1",
+		},
+	}
+}
+
+func exampleSeccompConfigV1Alpha1() *SeccompConfigV1Alpha1 {
+	cfg := NewSeccompConfigV1Alpha1()
+	cfg.SeccompMode = SeccompModeAudit
+
+	return cfg
+}
+
+// Clone implements config.Document interface.
+func (s *SeccompConfigV1Alpha1) Clone() config.Document {
+	return s.DeepCopy()
+}
+
+// Audit implements config.SeccompConfig interface.
+func (s *SeccompConfigV1Alpha1) Audit() bool {
+	return s.SeccompMode == SeccompModeAudit
+}
+
+// Validate implements config.Validator interface.
+func (s *SeccompConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	switch s.SeccompMode {
+	case SeccompModeEnforcing, SeccompModeAudit:
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("invalid seccomp mode %q: should be one of %q, %q", s.SeccompMode, SeccompModeEnforcing, SeccompModeAudit)
+	}
+}
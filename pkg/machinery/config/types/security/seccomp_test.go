@@ -0,0 +1,102 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package security_test
+
+import (
+	_ "embed"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/configloader"
+	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/types/security"
+)
+
+//go:embed testdata/seccompconfig.yaml
+var expectedSeccompConfigDocument []byte
+
+func TestSeccompConfigMarshalStability(t *testing.T) {
+	t.Parallel()
+
+	cfg := security.NewSeccompConfigV1Alpha1()
+	cfg.SeccompMode = security.SeccompModeAudit
+
+	marshaled, err := encoder.NewEncoder(cfg, encoder.WithComments(encoder.CommentsDisabled)).Encode()
+	require.NoError(t, err)
+
+	t.Log(string(marshaled))
+
+	assert.Equal(t, expectedSeccompConfigDocument, marshaled)
+}
+
+func TestSeccompConfigUnmarshal(t *testing.T) {
+	t.Parallel()
+
+	provider, err := configloader.NewFromBytes(expectedSeccompConfigDocument)
+	require.NoError(t, err)
+
+	docs := provider.Documents()
+	require.Len(t, docs, 1)
+
+	assert.Equal(t, &security.SeccompConfigV1Alpha1{
+		Meta: meta.Meta{
+			MetaAPIVersion: "v1alpha1",
+			MetaKind:       security.SeccompConfig,
+		},
+		SeccompMode: security.SeccompModeAudit,
+	}, docs[0])
+
+	require.NotNil(t, provider.Seccomp())
+	assert.True(t, provider.Seccomp().Audit())
+}
+
+func TestSeccompConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range []struct {
+		name string
+		mode string
+
+		expectedError string
+	}{
+		{
+			name: "empty",
+
+			expectedError: "invalid seccomp mode \"\": should be one of \"enforcing\", \"audit\"",
+		},
+		{
+			name: "invalid",
+			mode: "disabled",
+
+			expectedError: "invalid seccomp mode \"disabled\": should be one of \"enforcing\", \"audit\"",
+		},
+		{
+			name: "enforcing",
+			mode: security.SeccompModeEnforcing,
+		},
+		{
+			name: "audit",
+			mode: security.SeccompModeAudit,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := security.NewSeccompConfigV1Alpha1()
+			cfg.SeccompMode = test.mode
+
+			_, err := cfg.Validate(validationMode{})
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -5,6 +5,6 @@
 // Package security provides security-related machine configuration documents.
 package security
 
-//go:generate docgen -output security_doc.go security.go trusted_roots.go
+//go:generate docgen -output security_doc.go security.go attestation.go image_verification.go lsm.go seccomp.go trusted_roots.go csr_policy.go
 
-//go:generate deep-copy -type TrustedRootsConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate deep-copy -type ImageVerificationConfigV1Alpha1 -type LSMConfigV1Alpha1 -type SeccompConfigV1Alpha1 -type TPMAttestationConfigV1Alpha1 -type TrustdCSRPolicyConfigV1Alpha1 -type TrustedRootsConfigV1Alpha1 -pointer-receiver -header-file ../../../../../hack/boilerplate.txt -o deep_copy.generated.go .
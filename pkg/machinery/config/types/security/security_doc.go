@@ -10,6 +10,153 @@ import (
 	"github.com/siderolabs/talos/pkg/machinery/config/encoder"
 )
 
+func (TPMAttestationConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "TPMAttestationConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "TPMAttestationConfig configures TPM attestation of the nodes requesting certificates from trustd." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "TPMAttestationConfig configures TPM attestation of the nodes requesting certificates from trustd.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "required",
+				Type:        "bool",
+				Note:        "",
+				Description: "Require nodes to present a valid TPM quote before issuing a certificate.\n\nThe quote covers the PCR 7 (Secure Boot state) and PCR 11 (UKI measurements).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Require nodes to present a valid TPM quote before issuing a certificate." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "attestationKeys",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of the enrolled TPM attestation keys (hex-encoded SHA256 fingerprints).\n\nA quote is only trusted if it is signed by one of the enrolled attestation keys.\nThe attestation key fingerprint of a node is shown by `talosctl attest status`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of the enrolled TPM attestation keys (hex-encoded SHA256 fingerprints)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "pcrs",
+				Type:        "[]PCRPolicy",
+				Note:        "",
+				Description: "List of PCR values accepted in the TPM quote.\n\nIf not set, any PCR values are accepted as long as the quote is valid.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of PCR values accepted in the TPM quote." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleTPMAttestationConfigV1Alpha1())
+
+	return doc
+}
+
+func (PCRPolicy) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "PCRPolicy",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "PCRPolicy describes accepted values of a single PCR." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "PCRPolicy describes accepted values of a single PCR.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "TPMAttestationConfigV1Alpha1",
+				FieldName: "pcrs",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "index",
+				Type:        "int",
+				Note:        "",
+				Description: "PCR index.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "PCR index." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "values",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of accepted PCR values (hex-encoded SHA256 digests).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of accepted PCR values (hex-encoded SHA256 digests)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	return doc
+}
+
+func (ImageVerificationConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "ImageVerificationConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "ImageVerificationConfig configures verification of the installer image signatures on upgrade." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "ImageVerificationConfig configures verification of the installer image signatures on upgrade.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "publicKeys",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of trusted public keys (as PEM-encoded PKIX public keys).\n\nThe installer image should have a valid cosign signature made by one of the keys.\nECDSA, RSA and Ed25519 keys are supported.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of trusted public keys (as PEM-encoded PKIX public keys)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "requireDigest",
+				Type:        "bool",
+				Note:        "",
+				Description: "Require the installer image reference to be pinned by digest (e.g. `ghcr.io/siderolabs/installer@sha256:...`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Require the installer image reference to be pinned by digest (e.g. `ghcr.io/siderolabs/installer@sha256:...`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleImageVerificationConfigV1Alpha1())
+
+	return doc
+}
+
+func (LSMConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "LSMConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "LSMConfig configures the baseline AppArmor profile applied to the system services, kubelet and the extension services." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "LSMConfig configures the baseline AppArmor profile applied to the system services, kubelet and the extension services.",
+		Fields: []encoder.Doc{
+			{}, {
+				Name:        "mode",
+				Type:        "string",
+				Note:        "",
+				Description: "Policy mode.\n\nThe `talos-system` AppArmor profile is a baseline, not a least-privilege policy:\nit allows everything except for the access to the kernel memory interfaces (e.g. `/dev/mem`, `/proc/kcore`),\nthe sysrq trigger and writes to the securityfs, and it is applied to apid, trustd, etcd, kubelet and the extension services.\n\nIn the `enforcing` mode the profile denies and logs these accesses,\nin the `permissive` mode the profile is loaded in the complain mode, so they are only logged.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Policy mode." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"enforcing",
+					"permissive",
+				},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleLSMConfigV1Alpha1())
+
+	return doc
+}
+
+func (SeccompConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "SeccompConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "SeccompConfig configures the seccomp profiles generated by Talos for the system services." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "SeccompConfig configures the seccomp profiles generated by Talos for the system services.",
+		Fields: []encoder.Doc{
+			{}, {
+				Name:        "mode",
+				Type:        "string",
+				Note:        "",
+				Description: "Seccomp profile mode.\n\nIn the `enforcing` mode syscalls not allowed by the profile fail with an error,\nin the `audit` mode such syscalls are allowed, but reported as `SyscallAuditEvent` events.\nEach syscall is reported at most once a minute for each executable.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Seccomp profile mode." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"enforcing",
+					"audit",
+				},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleSeccompConfigV1Alpha1())
+
+	return doc
+}
+
 func (TrustedRootsConfigV1Alpha1) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "TrustedRootsConfig",
@@ -39,13 +186,50 @@ func (TrustedRootsConfigV1Alpha1) Doc() *encoder.Doc {
 	return doc
 }
 
+func (TrustdCSRPolicyConfigV1Alpha1) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "TrustdCSRPolicyConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "TrustdCSRPolicyConfig restricts the identities which nodes can request in the certificates issued by trustd." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "TrustdCSRPolicyConfig restricts the identities which nodes can request in the certificates issued by trustd.",
+		Fields: []encoder.Doc{
+			{},
+			{
+				Name:        "allowedIPs",
+				Type:        "[]Prefix",
+				Note:        "",
+				Description: "List of CIDR prefixes the IP addresses in the certificate request (including the common name, if it is an IP address) should belong to.\n\nIf not set, any IP addresses are allowed.\nTalos nodes request the node addresses and the IP addresses from `.machine.certSANs`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of CIDR prefixes the IP addresses in the certificate request (including the common name, if it is an IP address) should belong to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "allowedDNSNames",
+				Type:        "[]string",
+				Note:        "",
+				Description: "List of patterns the DNS names in the certificate request (including the common name) should match.\n\nPatterns use the shell glob syntax, e.g. `*.example.com`.\nIf not set, any DNS names are allowed.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "List of patterns the DNS names in the certificate request (including the common name) should match." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", exampleTrustdCSRPolicyConfigV1Alpha1())
+
+	doc.Fields[2].AddExample("", []string{"worker-*", "*.cluster.example.com"})
+
+	return doc
+}
+
 // GetFileDoc returns documentation for the file security_doc.go.
 func GetFileDoc() *encoder.FileDoc {
 	return &encoder.FileDoc{
 		Name:        "security",
 		Description: "Package security provides security-related machine configuration documents.\n",
 		Structs: []*encoder.Doc{
+			TPMAttestationConfigV1Alpha1{}.Doc(),
+			PCRPolicy{}.Doc(),
+			ImageVerificationConfigV1Alpha1{}.Doc(),
+			LSMConfigV1Alpha1{}.Doc(),
+			SeccompConfigV1Alpha1{}.Doc(),
 			TrustedRootsConfigV1Alpha1{}.Doc(),
+			TrustdCSRPolicyConfigV1Alpha1{}.Doc(),
 		},
 	}
 }
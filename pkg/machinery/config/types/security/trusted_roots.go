@@ -7,11 +7,16 @@ package security
 //docgen:jsonschema
 
 import (
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/config/internal/registry"
 	"github.com/siderolabs/talos/pkg/machinery/config/types/meta"
+	"github.com/siderolabs/talos/pkg/machinery/config/validation"
 )
 
 // TrustedRootsConfig is a default action config document kind.
@@ -32,6 +37,7 @@ func init() {
 var (
 	_ config.TrustedRootsConfig = &TrustedRootsConfigV1Alpha1{}
 	_ config.NamedDocument      = &TrustedRootsConfigV1Alpha1{}
+	_ config.Validator          = &TrustedRootsConfigV1Alpha1{}
 )
 
 // TrustedRootsConfigV1Alpha1 allows to configure additional trusted CA roots.
@@ -92,3 +98,45 @@ func (s *TrustedRootsConfigV1Alpha1) ExtraTrustedRootCertificates() []string {
 
 	return []string{header + s.Certificates}
 }
+
+// Validate implements config.Validator interface.
+func (s *TrustedRootsConfigV1Alpha1) Validate(validation.RuntimeMode, ...validation.Option) ([]string, error) {
+	var (
+		warnings []string
+		errs     error
+	)
+
+	rest := []byte(s.Certificates)
+
+	for i := 0; ; i++ {
+		var block *pem.Block
+
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			if i == 0 {
+				return nil, errors.New("no PEM-encoded certificates found")
+			}
+
+			break
+		}
+
+		if block.Type != "CERTIFICATE" {
+			errs = errors.Join(errs, fmt.Errorf("certificate %d: unexpected PEM block type %q", i, block.Type))
+
+			continue
+		}
+
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			errs = errors.Join(errs, fmt.Errorf("certificate %d: %w", i, err))
+
+			continue
+		}
+
+		if !cert.IsCA {
+			warnings = append(warnings, fmt.Sprintf("certificate %d (%s) is not a CA certificate", i, cert.Subject))
+		}
+	}
+
+	return warnings, errs
+}
@@ -8,6 +8,7 @@ import (
 	_ "embed"
 	"testing"
 
+	"github.com/siderolabs/crypto/x509"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -63,3 +64,61 @@ func TestTrustedConfigHeader(t *testing.T) {
 
 	assert.Equal(t, []string{"\ncustom-ca:\n==========\n-----BEGIN CERTIFICATE-----\n-----END CERTIFICATE-----"}, cfg.ExtraTrustedRootCertificates())
 }
+
+func TestTrustedRootsConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	ca, err := x509.NewSelfSignedCertificateAuthority(x509.CommonName("custom-ca"))
+	require.NoError(t, err)
+
+	leaf, err := x509.NewKeyPair(ca, x509.CommonName("leaf"))
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		name         string
+		certificates string
+
+		expectedWarnings []string
+		expectedError    string
+	}{
+		{
+			name: "empty",
+
+			expectedError: "no PEM-encoded certificates found",
+		},
+		{
+			name:         "wrong block type",
+			certificates: string(ca.CrtPEM) + string(ca.KeyPEM),
+
+			expectedError: "certificate 1: unexpected PEM block type \"ED25519 PRIVATE KEY\"",
+		},
+		{
+			name:         "not a CA",
+			certificates: string(ca.CrtPEM) + string(leaf.CrtPEM),
+
+			expectedWarnings: []string{"certificate 1 (CN=leaf) is not a CA certificate"},
+		},
+		{
+			name:         "valid",
+			certificates: string(ca.CrtPEM),
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			cfg := security.NewTrustedRootsConfigV1Alpha1()
+			cfg.MetaName = "custom-ca"
+			cfg.Certificates = test.certificates
+
+			warnings, err := cfg.Validate(validationMode{})
+
+			assert.Equal(t, test.expectedWarnings, warnings)
+
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
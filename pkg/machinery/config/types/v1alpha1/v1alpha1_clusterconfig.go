@@ -186,6 +186,11 @@ func (c *ClusterConfig) ScheduleOnControlPlanes() bool {
 	return pointer.SafeDeref(c.AllowSchedulingOnMasters)
 }
 
+// KubeletServingCertificateRotation implements the config.ClusterConfig interface.
+func (c *ClusterConfig) KubeletServingCertificateRotation() bool {
+	return pointer.SafeDeref(c.ClusterKubeletServingCertificateRotation)
+}
+
 // ID returns the unique identifier for the cluster.
 func (c *ClusterConfig) ID() string {
 	return c.ClusterID
@@ -29,3 +29,12 @@ func (c *FlannelCNIConfig) ExtraArgs() []string {
 
 	return c.FlanneldExtraArgs
 }
+
+// MTU implements the config.FlannelCNI interface.
+func (c *FlannelCNIConfig) MTU() uint32 {
+	if c == nil {
+		return 0
+	}
+
+	return c.FlannelMTU
+}
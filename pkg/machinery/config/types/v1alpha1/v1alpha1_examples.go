@@ -225,6 +225,13 @@ func machineFilesExample() []*MachineFile {
 	}
 }
 
+func machineFileSourceExample() *MachineFileSource {
+	return &MachineFileSource{
+		SourceURL:      "https://example.com/license.txt",
+		SourceChecksum: "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+	}
+}
+
 func machineEnvExamples0() Env {
 	return Env{
 		"GRPC_GO_LOG_VERBOSITY_LEVEL": "99",
@@ -762,6 +769,21 @@ func kubernetesTalosAPIAccessConfigExample() *KubernetesTalosAPIAccessConfig {
 	}
 }
 
+func apiRateLimitConfigExample() *APIRateLimitConfig {
+	return &APIRateLimitConfig{
+		RateLimitEnabled:               pointer.To(true),
+		RateLimitRequestsPerMinute:     30,
+		RateLimitBurst:                 5,
+		RateLimitMaxConcurrentRequests: 2,
+	}
+}
+
+func apiVsockConfigExample() *APIVsockConfig {
+	return &APIVsockConfig{
+		VsockEnabled: pointer.To(true),
+	}
+}
+
 func kmsKeyExample() *EncryptionKeyKMS {
 	return &EncryptionKeyKMS{
 		KMSEndpoint: "https://192.168.88.21:4443",
@@ -5,9 +5,18 @@
 package v1alpha1
 
 import (
+	"errors"
+	"fmt"
+	"math"
+	"net/netip"
+	"strings"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/siderolabs/gen/xslices"
 	"github.com/siderolabs/go-pointer"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
 // RBACEnabled implements config.Features interface.
@@ -57,6 +66,39 @@ func (f *FeaturesConfig) KubePrism() config.KubePrism {
 	return f.KubePrismSupport
 }
 
+// NodeTalosMetadataEnabled implements config.Features interface.
+func (f *FeaturesConfig) NodeTalosMetadataEnabled() bool {
+	return pointer.SafeDeref(f.NodeTalosMetadata)
+}
+
+// NodeEventsEnabled implements config.Features interface.
+func (f *FeaturesConfig) NodeEventsEnabled() bool {
+	return pointer.SafeDeref(f.NodeEvents)
+}
+
+// APIRateLimit implements config.Features interface.
+func (f *FeaturesConfig) APIRateLimit() config.APIRateLimit {
+	if f.APIRateLimitConfig == nil {
+		return &APIRateLimitConfig{}
+	}
+
+	return f.APIRateLimitConfig
+}
+
+// ChaosAPIEnabled implements config.Features interface.
+func (f *FeaturesConfig) ChaosAPIEnabled() bool {
+	return pointer.SafeDeref(f.ChaosAPI)
+}
+
+// APIVsock implements config.Features interface.
+func (f *FeaturesConfig) APIVsock() config.APIVsock {
+	if f.APIVsockConfig == nil {
+		return &APIVsockConfig{}
+	}
+
+	return f.APIVsockConfig
+}
+
 const defaultKubePrismPort = 7445
 
 // Enabled implements [config.KubePrism].
@@ -73,6 +115,112 @@ func (a *KubePrism) Port() int {
 	return a.ServerPort
 }
 
+// Enabled implements config.APIVsock.
+func (a *APIVsockConfig) Enabled() bool {
+	return pointer.SafeDeref(a.VsockEnabled)
+}
+
+// Port implements config.APIVsock.
+func (a *APIVsockConfig) Port() int {
+	if a.VsockPort == 0 {
+		return constants.ApidPort
+	}
+
+	return a.VsockPort
+}
+
+// Validate checks API vsock configuration for errors.
+func (a *APIVsockConfig) Validate() error {
+	// the highest port is reserved (VMADDR_PORT_ANY)
+	if a.VsockPort < 0 || int64(a.VsockPort) >= math.MaxUint32 {
+		return fmt.Errorf("invalid API vsock port: %d", a.VsockPort)
+	}
+
+	return nil
+}
+
+const (
+	defaultAPIRateLimitRequestsPerMinute     = 60
+	defaultAPIRateLimitBurst                 = 10
+	defaultAPIRateLimitMaxConcurrentRequests = 5
+)
+
+var defaultAPIRateLimitMethods = []string{
+	"/machine.MachineService/DiskUsage",
+	"/machine.MachineService/Dmesg",
+	"/machine.MachineService/EtcdSnapshot",
+	"/machine.MachineService/Logs",
+	"/machine.MachineService/PacketCapture",
+	"/machine.MachineService/Processes",
+	"/machine.MachineService/ProcessesStream",
+}
+
+// Enabled implements config.APIRateLimit.
+func (a *APIRateLimitConfig) Enabled() bool {
+	return pointer.SafeDeref(a.RateLimitEnabled)
+}
+
+// RequestsPerMinute implements config.APIRateLimit.
+func (a *APIRateLimitConfig) RequestsPerMinute() int {
+	if a.RateLimitRequestsPerMinute == 0 {
+		return defaultAPIRateLimitRequestsPerMinute
+	}
+
+	return a.RateLimitRequestsPerMinute
+}
+
+// Burst implements config.APIRateLimit.
+func (a *APIRateLimitConfig) Burst() int {
+	if a.RateLimitBurst == 0 {
+		return defaultAPIRateLimitBurst
+	}
+
+	return a.RateLimitBurst
+}
+
+// MaxConcurrentRequests implements config.APIRateLimit.
+func (a *APIRateLimitConfig) MaxConcurrentRequests() int {
+	if a.RateLimitMaxConcurrentRequests == 0 {
+		return defaultAPIRateLimitMaxConcurrentRequests
+	}
+
+	return a.RateLimitMaxConcurrentRequests
+}
+
+// Methods implements config.APIRateLimit.
+func (a *APIRateLimitConfig) Methods() []string {
+	if len(a.RateLimitMethods) == 0 {
+		return defaultAPIRateLimitMethods
+	}
+
+	return a.RateLimitMethods
+}
+
+// Validate checks API rate limit configuration for errors.
+func (a *APIRateLimitConfig) Validate() error {
+	var errs *multierror.Error
+
+	if a.RateLimitRequestsPerMinute < 0 {
+		errs = multierror.Append(errs, fmt.Errorf("API rate limit requests per minute should be positive: %d", a.RateLimitRequestsPerMinute))
+	}
+
+	if a.RateLimitBurst < 0 {
+		errs = multierror.Append(errs, fmt.Errorf("API rate limit burst should be positive: %d", a.RateLimitBurst))
+	}
+
+	if a.RateLimitMaxConcurrentRequests < 0 {
+		errs = multierror.Append(errs, fmt.Errorf("API rate limit max concurrent requests should be positive: %d", a.RateLimitMaxConcurrentRequests))
+	}
+
+	for _, method := range a.RateLimitMethods {
+		if !strings.HasPrefix(method, "/") || strings.Count(method, "/") != 2 {
+			errs = multierror.Append(errs, fmt.Errorf("invalid API rate limit method %q: expected /<service>/<method>", method))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
 // Enabled implements config.HostDNS.
 func (h *HostDNSConfig) Enabled() bool {
 	return pointer.SafeDeref(h.HostDNSEnabled)
@@ -87,3 +235,52 @@ func (h *HostDNSConfig) ForwardKubeDNSToHost() bool {
 func (h *HostDNSConfig) ResolveMemberNames() bool {
 	return pointer.SafeDeref(h.HostDNSResolveMemberNames)
 }
+
+// Upstreams implements config.HostDNS.
+func (h *HostDNSConfig) Upstreams() []string {
+	return h.HostDNSUpstreams
+}
+
+// StubDomains implements config.HostDNS.
+func (h *HostDNSConfig) StubDomains() []config.HostDNSStubDomain {
+	return xslices.Map(h.HostDNSStubDomains, func(d HostDNSStubDomain) config.HostDNSStubDomain { return d })
+}
+
+// Validate checks host DNS configuration for errors.
+func (h *HostDNSConfig) Validate() error {
+	var errs *multierror.Error
+
+	for _, upstream := range h.HostDNSUpstreams {
+		if _, err := netip.ParseAddr(upstream); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("invalid host DNS upstream %q: %w", upstream, err))
+		}
+	}
+
+	for _, stub := range h.HostDNSStubDomains {
+		if stub.StubDomain == "" {
+			errs = multierror.Append(errs, errors.New("host DNS stub domain name is required"))
+		}
+
+		if len(stub.StubNameservers) == 0 {
+			errs = multierror.Append(errs, fmt.Errorf("host DNS stub domain %q: nameservers are required", stub.StubDomain))
+		}
+
+		for _, nameserver := range stub.StubNameservers {
+			if _, err := netip.ParseAddr(nameserver); err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("host DNS stub domain %q: invalid nameserver %q: %w", stub.StubDomain, nameserver, err))
+			}
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// Domain implements config.HostDNSStubDomain.
+func (d HostDNSStubDomain) Domain() string {
+	return d.StubDomain
+}
+
+// Nameservers implements config.HostDNSStubDomain.
+func (d HostDNSStubDomain) Nameservers() []string {
+	return d.StubNameservers
+}
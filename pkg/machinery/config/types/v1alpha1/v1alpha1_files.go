@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"regexp"
+
+	"github.com/hashicorp/go-multierror"
+
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+var fileChecksumRegexp = regexp.MustCompile(`^(sha256:[0-9a-f]{64}|sha512:[0-9a-f]{128})$`)
+
+// Validate checks the machine file for errors.
+//
+//nolint:gocyclo
+func (f *MachineFile) Validate() error {
+	var errs *multierror.Error
+
+	appendErr := func(err error) {
+		errs = multierror.Append(errs, fmt.Errorf("file %q: %w", f.FilePath, err))
+	}
+
+	if f.FilePath == "" {
+		appendErr(errors.New("path is required"))
+	}
+
+	switch f.FileOp {
+	case "create", "append", "overwrite":
+	default:
+		appendErr(fmt.Errorf("unknown operation %q", f.FileOp))
+	}
+
+	switch f.Type() {
+	case config.FileTypeFile:
+	case config.FileTypeDirectory:
+		if f.FileOp != "create" {
+			appendErr(errors.New("directories only support the create operation"))
+		}
+
+		if f.FileContent != "" || f.FileSource != nil || f.FileTemplate {
+			appendErr(errors.New("content, source and template are not allowed for directories"))
+		}
+	default:
+		appendErr(fmt.Errorf("unknown type %q", f.FileType))
+	}
+
+	if f.FileUID < 0 || f.FileGID < 0 {
+		appendErr(errors.New("uid and gid should not be negative"))
+	}
+
+	if f.FileSource != nil {
+		if f.FileContent != "" {
+			appendErr(fmt.Errorf("content and source: %w", ErrMutuallyExclusive))
+		}
+
+		if u, err := url.Parse(f.FileSource.SourceURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			appendErr(fmt.Errorf("source URL %q should be a valid http(s) URL", f.FileSource.SourceURL))
+		}
+
+		if !fileChecksumRegexp.MatchString(f.FileSource.SourceChecksum) {
+			appendErr(fmt.Errorf("source checksum %q should be in the sha256:<hex> or sha512:<hex> format", f.FileSource.SourceChecksum))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
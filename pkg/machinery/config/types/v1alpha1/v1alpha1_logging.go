@@ -11,6 +11,7 @@ import (
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/siderolabs/gen/xslices"
+	"github.com/siderolabs/go-pointer"
 
 	"github.com/siderolabs/talos/pkg/machinery/config/config"
 	"github.com/siderolabs/talos/pkg/machinery/constants"
@@ -54,6 +55,11 @@ func (lc *LoggingConfig) Destinations() []config.LoggingDestination {
 	return xslices.Map(lc.LoggingDestinations, func(ld LoggingDestination) config.LoggingDestination { return ld })
 }
 
+// Structured implements config.Logging interface.
+func (lc *LoggingConfig) Structured() bool {
+	return pointer.SafeDeref(lc.LoggingStructured)
+}
+
 // Endpoint implements config.LoggingDestination interface.
 func (ld LoggingDestination) Endpoint() *url.URL {
 	return ld.LoggingEndpoint.URL
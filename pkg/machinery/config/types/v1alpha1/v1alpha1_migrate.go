@@ -0,0 +1,65 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package v1alpha1
+
+import (
+	"github.com/siderolabs/talos/pkg/machinery/config/config"
+)
+
+// Migrate implements the config.MigratableDocument interface.
+//
+// The deprecated fields are moved to their replacements, so that the config has the same meaning,
+// but doesn't rely on the fields which might be removed in the future releases.
+//
+//nolint:staticcheck
+func (c *Config) Migrate() (config.Document, []string, error) {
+	if c == nil {
+		return nil, nil, nil
+	}
+
+	migrated := c.DeepCopy()
+
+	var changes []string
+
+	if migrated.MachineConfig != nil {
+		if migrated.MachineConfig.MachineType == "join" {
+			migrated.MachineConfig.MachineType = "worker"
+
+			changes = append(changes, `.machine.type: "join" renamed to "worker"`)
+		}
+
+		if migrated.MachineConfig.MachineInstall != nil && migrated.MachineConfig.MachineInstall.InstallBootloader != nil {
+			migrated.MachineConfig.MachineInstall.InstallBootloader = nil
+
+			changes = append(changes, ".machine.install.bootloader: removed, it has no effect")
+		}
+	}
+
+	if migrated.ClusterConfig != nil {
+		if migrated.ClusterConfig.AllowSchedulingOnMasters != nil {
+			if migrated.ClusterConfig.AllowSchedulingOnControlPlanes == nil {
+				migrated.ClusterConfig.AllowSchedulingOnControlPlanes = migrated.ClusterConfig.AllowSchedulingOnMasters
+			}
+
+			migrated.ClusterConfig.AllowSchedulingOnMasters = nil
+
+			changes = append(changes, ".cluster.allowSchedulingOnMasters: moved to .cluster.allowSchedulingOnControlPlanes")
+		}
+
+		// both fields set is a validation error, so keep them as is
+		if etcd := migrated.ClusterConfig.EtcdConfig; etcd != nil && etcd.EtcdSubnet != "" && len(etcd.EtcdAdvertisedSubnets) == 0 {
+			etcd.EtcdAdvertisedSubnets = []string{etcd.EtcdSubnet}
+			etcd.EtcdSubnet = ""
+
+			changes = append(changes, ".cluster.etcd.subnet: moved to .cluster.etcd.advertisedSubnets")
+		}
+	}
+
+	if len(changes) == 0 {
+		return nil, nil, nil
+	}
+
+	return migrated, changes, nil
+}
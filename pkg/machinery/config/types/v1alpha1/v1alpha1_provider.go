@@ -28,9 +28,10 @@ import (
 
 // Verify interfaces.
 var (
-	_ config.Document       = (*Config)(nil)
-	_ config.SecretDocument = (*Config)(nil)
-	_ config.Validator      = (*Config)(nil)
+	_ config.Document           = (*Config)(nil)
+	_ config.SecretDocument     = (*Config)(nil)
+	_ config.Validator          = (*Config)(nil)
+	_ config.MigratableDocument = (*Config)(nil)
 )
 
 const (
@@ -568,6 +569,11 @@ func (n *NetworkConfig) Hostname() string {
 	return n.NetworkHostname
 }
 
+// HostnameTemplate implements the config.Provider interface.
+func (n *NetworkConfig) HostnameTemplate() string {
+	return n.NetworkHostnameTemplate
+}
+
 // DisableSearchDomain implements the config.Provider interface.
 func (n *NetworkConfig) DisableSearchDomain() bool {
 	return pointer.SafeDeref(n.NetworkDisableSearchDomain)
@@ -1361,6 +1367,49 @@ func (f *MachineFile) Op() string {
 	return f.FileOp
 }
 
+// Type implements the config.Provider interface.
+func (f *MachineFile) Type() string {
+	if f.FileType == "" {
+		return config.FileTypeFile
+	}
+
+	return f.FileType
+}
+
+// UID implements the config.Provider interface.
+func (f *MachineFile) UID() int {
+	return f.FileUID
+}
+
+// GID implements the config.Provider interface.
+func (f *MachineFile) GID() int {
+	return f.FileGID
+}
+
+// Source implements the config.Provider interface.
+func (f *MachineFile) Source() config.FileSource {
+	if f.FileSource == nil {
+		return nil
+	}
+
+	return f.FileSource
+}
+
+// Template implements the config.Provider interface.
+func (f *MachineFile) Template() bool {
+	return f.FileTemplate
+}
+
+// URL implements the config.FileSource interface.
+func (s *MachineFileSource) URL() string {
+	return s.SourceURL
+}
+
+// Checksum implements the config.FileSource interface.
+func (s *MachineFileSource) Checksum() string {
+	return s.SourceChecksum
+}
+
 // Device implements the config.Provider interface.
 func (d *MachineDisk) Device() string {
 	return d.DeviceName
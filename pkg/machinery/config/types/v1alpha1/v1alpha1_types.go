@@ -208,6 +208,11 @@ type MachineConfig struct {
 	//   description: |
 	//     The `env` field allows for the addition of environment variables.
 	//     All environment variables are set on PID 1 in addition to every service.
+	//
+	//     Proxy settings (`http_proxy`, `https_proxy`, `no_proxy`) are used for the image pulls,
+	//     including the installer image.
+	//     Changes are applied without a reboot: `cri` and `kubelet` services are restarted,
+	//     other services pick up the new environment on the next start.
 	//   values:
 	//     - "`GRPC_GO_LOG_VERBOSITY_LEVEL`"
 	//     - "`GRPC_GO_LOG_SEVERITY_LEVEL`"
@@ -523,6 +528,23 @@ type ClusterConfig struct {
 	//   examples:
 	//     - value: true
 	AllowSchedulingOnControlPlanes *bool `yaml:"allowSchedulingOnControlPlanes,omitempty"`
+	//   description: |
+	//     Enables the kubelet serving certificates issued by the Kubernetes CA.
+	//
+	//     Kubelet requests the serving certificate via the Kubernetes CSR API and rotates it before it expires.
+	//     Control plane nodes approve the requests which contain only the node name and the node addresses
+	//     known from the cluster discovery, so the cluster discovery should be enabled.
+	//     kube-apiserver verifies the kubelet serving certificates against the Kubernetes CA.
+	//
+	//     If disabled, kubelet uses a self-signed serving certificate.
+	//   values:
+	//     - true
+	//     - yes
+	//     - false
+	//     - no
+	//   examples:
+	//     - value: true
+	ClusterKubeletServingCertificateRotation *bool `yaml:"kubeletServingCertificateRotation,omitempty"`
 }
 
 // LinuxIDMapping represents the Linux ID mapping.
@@ -690,8 +712,27 @@ type KubeletNodeIPConfig struct {
 type NetworkConfig struct {
 	//   description: |
 	//     Used to statically set the hostname for the machine.
+	//
+	//     If neither `hostname` nor `hostnameTemplate` is set, the hostname provided by DHCP (option 12)
+	//     or by the platform metadata is used.
 	NetworkHostname string `yaml:"hostname,omitempty"`
 	//   description: |
+	//     Go template to generate the hostname of the machine from the node facts.
+	//
+	//     Available facts:
+	//       - `.MAC`: hardware address of the first physical network interface (without separators, e.g. `0a1b2c3d4e5f`),
+	//       - `.IP`: default node IP address (dots and colons replaced with dashes),
+	//       - `.UUID`: system UUID (from SMBIOS),
+	//       - `.Serial`: system serial number (from SMBIOS),
+	//       - `.MachineType`: machine type (`controlplane` or `worker`).
+	//
+	//     The `lower` function converts the value to lowercase.
+	//     The hostname is set once all the facts used in the template are available.
+	//     Mutually exclusive with `hostname`.
+	//   examples:
+	//     - value: '"worker-{{ .MAC }}"'
+	NetworkHostnameTemplate string `yaml:"hostnameTemplate,omitempty"`
+	//   description: |
 	//     `interfaces` is used to define the network interface configuration.
 	//     By default all network interfaces will attempt a DHCP discovery.
 	//     This can be further tuned through this configuration parameter.
@@ -706,6 +747,9 @@ type NetworkConfig struct {
 	NameServers []string `yaml:"nameservers,omitempty"`
 	//   description: |
 	//     Allows for extra entries to be added to the `/etc/hosts` file
+	//
+	//     Changes to the entries are applied without a reboot.
+	//     Useful for the environments without reliable DNS, e.g. to resolve the cluster endpoint.
 	//   examples:
 	//     - value: networkConfigExtraHostsExample()
 	ExtraHostEntries []*ExtraHost `yaml:"extraHostEntries,omitempty"`
@@ -1451,6 +1495,13 @@ type FlannelCNIConfig struct {
 	//     - value: >
 	//         []string{"--iface-can-reach=192.168.1.1"}
 	FlanneldExtraArgs []string `yaml:"extraArgs,omitempty"`
+	//   description: |
+	//     MTU of the pod network interfaces.
+	//     By default, flannel derives the MTU from the MTU of the node link minus the VXLAN overhead,
+	//     set it explicitly if the path MTU between the nodes is lower than the link MTU (e.g. due to the encapsulation in the underlay network).
+	//   examples:
+	//     - value: 1400
+	FlannelMTU uint32 `yaml:"mtu,omitempty"`
 }
 
 var _ config.ExternalCloudProvider = (*ExternalCloudProviderConfig)(nil)
@@ -1697,6 +1748,45 @@ type MachineFile struct {
 	//     - append
 	//     - overwrite
 	FileOp string `yaml:"op"`
+	//   description: |
+	//     The type of the entry, either a regular file or a directory.
+	//
+	//     Directories are created with all the missing parents, `content` and `source` are not allowed for directories.
+	//     Default value is `file`.
+	//   values:
+	//     - file
+	//     - directory
+	FileType string `yaml:"type,omitempty"`
+	//   description: The owner user ID of the file.
+	FileUID int `yaml:"uid,omitempty"`
+	//   description: The owner group ID of the file.
+	FileGID int `yaml:"gid,omitempty"`
+	//   description: |
+	//     The remote source of the file contents, mutually exclusive with `content`.
+	//
+	//     The downloaded contents are verified against the checksum,
+	//     and the file is not downloaded again if the existing file matches the checksum.
+	//   examples:
+	//     - value: machineFileSourceExample()
+	FileSource *MachineFileSource `yaml:"source,omitempty"`
+	//   description: |
+	//     Render the contents of the file as a Go template with the node metadata.
+	//
+	//     Available fields are `.Hostname`, `.Domainname`, `.IP` (the default node address),
+	//     `.IPs` (the routed node addresses) and `.MachineType`.
+	//     Files with the `create` operation are re-rendered when the node metadata changes.
+	FileTemplate bool `yaml:"template,omitempty"`
+}
+
+// MachineFileSource describes the remote source of the file contents.
+type MachineFileSource struct {
+	//   description: The URL to download the file contents from (`http` or `https`).
+	SourceURL string `yaml:"url"`
+	//   description: |
+	//     The checksum of the file contents in the `<algorithm>:<hex digest>` format.
+	//
+	//     Supported algorithms are `sha256` and `sha512`.
+	SourceChecksum string `yaml:"checksum"`
 }
 
 // ExtraHost represents a host entry in /etc/hosts.
@@ -2201,6 +2291,43 @@ type FeaturesConfig struct {
 	//   description: |
 	//     Configures host DNS caching resolver.
 	HostDNSSupport *HostDNSConfig `yaml:"hostDNS,omitempty"`
+	//   description: |
+	//     Publish Talos metadata on the Kubernetes Node object.
+	//
+	//     When enabled, Talos version and platform are added as node labels,
+	//     while the install image and the machine configuration hash are added as node annotations
+	//     (values which are not valid label values are published as annotations).
+	NodeTalosMetadata *bool `yaml:"nodeTalosMetadata,omitempty"`
+	//   description: |
+	//     Publish machine events as Kubernetes Events attached to the Node object.
+	//
+	//     When enabled, events like upgrades, configuration changes, OOM kills and hardware errors
+	//     are visible in `kubectl describe node`.
+	NodeEvents *bool `yaml:"nodeEvents,omitempty"`
+	//   description: |
+	//     Configure rate limiting of the expensive Talos API methods.
+	//
+	//     The limits are applied by apid to each client certificate separately,
+	//     so that aggressive monitoring clients can't exhaust the resources of the node.
+	//     This feature is disabled if the feature config is not specified.
+	//   examples:
+	//     - value: apiRateLimitConfigExample()
+	APIRateLimitConfig *APIRateLimitConfig `yaml:"apiRateLimit,omitempty"`
+	//   description: |
+	//     Enable the chaos APIs which induce controlled failures on the node (killing a service,
+	//     dropping the network traffic, filling the EPHEMERAL partition) for game days.
+	//
+	//     The APIs are only available to the clients with the `os:chaos` role.
+	ChaosAPI *bool `yaml:"chaosAPI,omitempty"`
+	//   description: |
+	//     Serve the Talos API over the VM sockets (vsock) in addition to the network.
+	//
+	//     The hypervisor can reach the Talos API of the virtual machine over vsock
+	//     even if the network configuration of the machine is broken.
+	//     The API is served with the same certificates and access control as over the network.
+	//   examples:
+	//     - value: apiVsockConfigExample()
+	APIVsockConfig *APIVsockConfig `yaml:"apiVsock,omitempty"`
 }
 
 // KubePrism describes the configuration for the KubePrism load balancer.
@@ -2228,6 +2355,49 @@ type KubernetesTalosAPIAccessConfig struct {
 	AccessAllowedKubernetesNamespaces []string `yaml:"allowedKubernetesNamespaces,omitempty"`
 }
 
+// APIVsockConfig describes the Talos API listener on the VM sockets.
+type APIVsockConfig struct {
+	//   description: |
+	//     Enable the Talos API listener on the VM sockets.
+	VsockEnabled *bool `yaml:"enabled,omitempty"`
+	//   description: |
+	//     The vsock port to listen on.
+	//
+	//     Defaults to 50000.
+	VsockPort int `yaml:"port,omitempty"`
+}
+
+// APIRateLimitConfig describes the rate limiting of the Talos API methods.
+type APIRateLimitConfig struct {
+	//   description: |
+	//     Enable rate limiting of the Talos API methods.
+	RateLimitEnabled *bool `yaml:"enabled,omitempty"`
+	//   description: |
+	//     Number of requests per minute each client can make to each of the rate limited methods.
+	//
+	//     Defaults to 60.
+	RateLimitRequestsPerMinute int `yaml:"requestsPerMinute,omitempty"`
+	//   description: |
+	//     Number of requests each client can make in a burst above the rate limit.
+	//
+	//     Defaults to 10.
+	RateLimitBurst int `yaml:"burst,omitempty"`
+	//   description: |
+	//     Number of requests to the rate limited methods each client can have in flight at the same time
+	//     (e.g. `talosctl logs --follow` holds a request for the whole duration of the stream).
+	//
+	//     Defaults to 5.
+	RateLimitMaxConcurrentRequests int `yaml:"maxConcurrentRequests,omitempty"`
+	//   description: |
+	//     The list of rate limited API methods.
+	//
+	//     Defaults to the methods which are expensive for the node: `Processes`, `ProcessesStream`, `Logs`, `Dmesg`, `PacketCapture`, `DiskUsage`
+	//     and `EtcdSnapshot`.
+	//   examples:
+	//     - value: '[]string{"/machine.MachineService/Processes", "/machine.MachineService/Logs"}'
+	RateLimitMethods []string `yaml:"methods,omitempty"`
+}
+
 // HostDNSConfig describes the configuration for the host DNS resolver.
 type HostDNSConfig struct {
 	//   description: |
@@ -2245,6 +2415,34 @@ type HostDNSConfig struct {
 	//     When enabled, cluster member hostnames and node names are resolved using the host DNS resolver.
 	//     This requires service discovery to be enabled.
 	HostDNSResolveMemberNames *bool `yaml:"resolveMemberNames,omitempty"`
+	//   description: |
+	//     Upstream nameservers of the host DNS resolver.
+	//
+	//     By default, the host DNS resolver forwards the requests to the nameservers
+	//     of the machine (`.machine.network.nameservers` or the ones received via DHCP).
+	//   examples:
+	//     - value: '[]string{"1.1.1.1", "8.8.8.8"}'
+	HostDNSUpstreams []string `yaml:"upstreams,omitempty"`
+	//   description: |
+	//     Stub domains of the host DNS resolver.
+	//
+	//     The requests for the names in the stub domain (and its subdomains) are forwarded
+	//     to the nameservers of the stub domain instead of the upstream nameservers.
+	HostDNSStubDomains []HostDNSStubDomain `yaml:"stubDomains,omitempty"`
+}
+
+// HostDNSStubDomain describes the upstream nameservers of a DNS domain.
+type HostDNSStubDomain struct {
+	//   description: |
+	//     DNS domain name.
+	//   examples:
+	//     - value: '"corp.example.com"'
+	StubDomain string `yaml:"domain"`
+	//   description: |
+	//     Nameservers to forward the requests for the domain to.
+	//   examples:
+	//     - value: '[]string{"10.0.0.53"}'
+	StubNameservers []string `yaml:"nameservers"`
 }
 
 // VolumeMountConfig struct describes extra volume mount for the static pods.
@@ -2421,6 +2619,12 @@ type LoggingConfig struct {
 	// description: |
 	//   Logging destination.
 	LoggingDestinations []LoggingDestination `yaml:"destinations"`
+	// description: |
+	//   Write machined controller logs as structured JSON.
+	//
+	//   JSON logs contain the timestamp, level, message and the component and controller fields,
+	//   which are preserved when the logs are sent to the logging destinations.
+	LoggingStructured *bool `yaml:"structured,omitempty"`
 }
 
 // LoggingDestination struct configures Talos logging destination.
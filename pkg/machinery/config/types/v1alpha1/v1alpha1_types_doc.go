@@ -169,7 +169,7 @@ func (MachineConfig) Doc() *encoder.Doc {
 				Name:        "env",
 				Type:        "Env",
 				Note:        "",
-				Description: "The `env` field allows for the addition of environment variables.\nAll environment variables are set on PID 1 in addition to every service.",
+				Description: "The `env` field allows for the addition of environment variables.\nAll environment variables are set on PID 1 in addition to every service.\n\nProxy settings (`http_proxy`, `https_proxy`, `no_proxy`) are used for the image pulls,\nincluding the installer image.\nChanges are applied without a reboot: `cri` and `kubelet` services are restarted,\nother services pick up the new environment on the next start.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "The `env` field allows for the addition of environment variables." /* encoder.LineComment */, "" /* encoder.FootComment */},
 				Values: []string{
 					"`GRPC_GO_LOG_VERBOSITY_LEVEL`",
@@ -533,6 +533,19 @@ func (ClusterConfig) Doc() *encoder.Doc {
 					"no",
 				},
 			},
+			{
+				Name:        "kubeletServingCertificateRotation",
+				Type:        "bool",
+				Note:        "",
+				Description: "Enables the kubelet serving certificates issued by the Kubernetes CA.\n\nKubelet requests the serving certificate via the Kubernetes CSR API and rotates it before it expires.\nControl plane nodes approve the requests which contain only the node name and the node addresses\nknown from the cluster discovery, so the cluster discovery should be enabled.\nkube-apiserver verifies the kubelet serving certificates against the Kubernetes CA.\n\nIf disabled, kubelet uses a self-signed serving certificate.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enables the kubelet serving certificates issued by the Kubernetes CA." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"true",
+					"yes",
+					"false",
+					"no",
+				},
+			},
 		},
 	}
 
@@ -565,6 +578,7 @@ func (ClusterConfig) Doc() *encoder.Doc {
 	doc.Fields[22].AddExample("", clusterInlineManifestsExample())
 	doc.Fields[23].AddExample("", clusterAdminKubeconfigExample())
 	doc.Fields[25].AddExample("", true)
+	doc.Fields[26].AddExample("", true)
 
 	return doc
 }
@@ -932,9 +946,16 @@ func (NetworkConfig) Doc() *encoder.Doc {
 				Name:        "hostname",
 				Type:        "string",
 				Note:        "",
-				Description: "Used to statically set the hostname for the machine.",
+				Description: "Used to statically set the hostname for the machine.\n\nIf neither `hostname` nor `hostnameTemplate` is set, the hostname provided by DHCP (option 12)\nor by the platform metadata is used.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Used to statically set the hostname for the machine." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "hostnameTemplate",
+				Type:        "string",
+				Note:        "",
+				Description: "Go template to generate the hostname of the machine from the node facts.\n\nAvailable facts:\n  - `.MAC`: hardware address of the first physical network interface (without separators, e.g. `0a1b2c3d4e5f`),\n  - `.IP`: default node IP address (dots and colons replaced with dashes),\n  - `.UUID`: system UUID (from SMBIOS),\n  - `.Serial`: system serial number (from SMBIOS),\n  - `.MachineType`: machine type (`controlplane` or `worker`).\n\nThe `lower` function converts the value to lowercase.\nThe hostname is set once all the facts used in the template are available.\nMutually exclusive with `hostname`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Go template to generate the hostname of the machine from the node facts." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 			{
 				Name:        "interfaces",
 				Type:        "[]Device",
@@ -953,7 +974,7 @@ func (NetworkConfig) Doc() *encoder.Doc {
 				Name:        "extraHostEntries",
 				Type:        "[]ExtraHost",
 				Note:        "",
-				Description: "Allows for extra entries to be added to the `/etc/hosts` file",
+				Description: "Allows for extra entries to be added to the `/etc/hosts` file\n\nChanges to the entries are applied without a reboot.\nUseful for the environments without reliable DNS, e.g. to resolve the cluster endpoint.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Allows for extra entries to be added to the `/etc/hosts` file" /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
 			{
@@ -981,10 +1002,11 @@ func (NetworkConfig) Doc() *encoder.Doc {
 
 	doc.AddExample("Network definition example.", machineNetworkConfigExample())
 
-	doc.Fields[1].AddExample("", machineNetworkConfigExample().NetworkInterfaces)
-	doc.Fields[2].AddExample("", []string{"8.8.8.8", "1.1.1.1"})
-	doc.Fields[3].AddExample("", networkConfigExtraHostsExample())
-	doc.Fields[4].AddExample("", networkKubeSpanExample())
+	doc.Fields[1].AddExample("", "worker-{{ .MAC }}")
+	doc.Fields[2].AddExample("", machineNetworkConfigExample().NetworkInterfaces)
+	doc.Fields[3].AddExample("", []string{"8.8.8.8", "1.1.1.1"})
+	doc.Fields[4].AddExample("", networkConfigExtraHostsExample())
+	doc.Fields[5].AddExample("", networkKubeSpanExample())
 
 	return doc
 }
@@ -1845,10 +1867,18 @@ func (FlannelCNIConfig) Doc() *encoder.Doc {
 				Description: "Extra arguments for 'flanneld'.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Extra arguments for 'flanneld'." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "mtu",
+				Type:        "uint32",
+				Note:        "",
+				Description: "MTU of the pod network interfaces.\nBy default, flannel derives the MTU from the MTU of the node link minus the VXLAN overhead,\nset it explicitly if the path MTU between the nodes is lower than the link MTU (e.g. due to the encapsulation in the underlay network).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "MTU of the pod network interfaces." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
 	doc.Fields[0].AddExample("", []string{"--iface-can-reach=192.168.1.1"})
+	doc.Fields[1].AddExample("", 1400)
 
 	return doc
 }
@@ -2310,11 +2340,86 @@ func (MachineFile) Doc() *encoder.Doc {
 					"overwrite",
 				},
 			},
+			{
+				Name:        "type",
+				Type:        "string",
+				Note:        "",
+				Description: "The type of the entry, either a regular file or a directory.\n\nDirectories are created with all the missing parents, `content` and `source` are not allowed for directories.\nDefault value is `file`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The type of the entry, either a regular file or a directory." /* encoder.LineComment */, "" /* encoder.FootComment */},
+				Values: []string{
+					"file",
+					"directory",
+				},
+			},
+			{
+				Name:        "uid",
+				Type:        "int",
+				Note:        "",
+				Description: "The owner user ID of the file.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The owner user ID of the file." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "gid",
+				Type:        "int",
+				Note:        "",
+				Description: "The owner group ID of the file.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The owner group ID of the file." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "source",
+				Type:        "MachineFileSource",
+				Note:        "",
+				Description: "The remote source of the file contents, mutually exclusive with `content`.\n\nThe downloaded contents are verified against the checksum,\nand the file is not downloaded again if the existing file matches the checksum.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The remote source of the file contents, mutually exclusive with `content`." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "template",
+				Type:        "bool",
+				Note:        "",
+				Description: "Render the contents of the file as a Go template with the node metadata.\n\nAvailable fields are `.Hostname`, `.Domainname`, `.IP` (the default node address),\n`.IPs` (the routed node addresses) and `.MachineType`.\nFiles with the `create` operation are re-rendered when the node metadata changes.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Render the contents of the file as a Go template with the node metadata." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
 	doc.AddExample("MachineFiles usage example.", machineFilesExample())
 
+	doc.Fields[7].AddExample("", machineFileSourceExample())
+
+	return doc
+}
+
+func (MachineFileSource) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "MachineFileSource",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "MachineFileSource describes the remote source of the file contents." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "MachineFileSource describes the remote source of the file contents.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "MachineFile",
+				FieldName: "source",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "url",
+				Type:        "string",
+				Note:        "",
+				Description: "The URL to download the file contents from (`http` or `https`).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The URL to download the file contents from (`http` or `https`)." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "checksum",
+				Type:        "string",
+				Note:        "",
+				Description: "The checksum of the file contents in the `<algorithm>:<hex digest>` format.\n\nSupported algorithms are `sha256` and `sha512`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The checksum of the file contents in the `<algorithm>:<hex digest>` format." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", machineFileSourceExample())
+
 	return doc
 }
 
@@ -3423,12 +3528,49 @@ func (FeaturesConfig) Doc() *encoder.Doc {
 				Description: "Configures host DNS caching resolver.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Configures host DNS caching resolver." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "nodeTalosMetadata",
+				Type:        "bool",
+				Note:        "",
+				Description: "Publish Talos metadata on the Kubernetes Node object.\n\nWhen enabled, Talos version and platform are added as node labels,\nwhile the install image and the machine configuration hash are added as node annotations\n(values which are not valid label values are published as annotations).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Publish Talos metadata on the Kubernetes Node object." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "nodeEvents",
+				Type:        "bool",
+				Note:        "",
+				Description: "Publish machine events as Kubernetes Events attached to the Node object.\n\nWhen enabled, events like upgrades, configuration changes, OOM kills and hardware errors\nare visible in `kubectl describe node`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Publish machine events as Kubernetes Events attached to the Node object." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "apiRateLimit",
+				Type:        "APIRateLimitConfig",
+				Note:        "",
+				Description: "Configure rate limiting of the expensive Talos API methods.\n\nThe limits are applied by apid to each client certificate separately,\nso that aggressive monitoring clients can't exhaust the resources of the node.\nThis feature is disabled if the feature config is not specified.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Configure rate limiting of the expensive Talos API methods." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "chaosAPI",
+				Type:        "bool",
+				Note:        "",
+				Description: "Enable the chaos APIs which induce controlled failures on the node (killing a service,\ndropping the network traffic, filling the EPHEMERAL partition) for game days.\n\nThe APIs are only available to the clients with the `os:chaos` role.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable the chaos APIs which induce controlled failures on the node (killing a service," /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "apiVsock",
+				Type:        "APIVsockConfig",
+				Note:        "",
+				Description: "Serve the Talos API over the VM sockets (vsock) in addition to the network.\n\nThe hypervisor can reach the Talos API of the virtual machine over vsock\neven if the network configuration of the machine is broken.\nThe API is served with the same certificates and access control as over the network.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Serve the Talos API over the VM sockets (vsock) in addition to the network." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
 	doc.AddExample("", machineFeaturesExample())
 
 	doc.Fields[2].AddExample("", kubernetesTalosAPIAccessConfigExample())
+	doc.Fields[9].AddExample("", apiRateLimitConfigExample())
+	doc.Fields[11].AddExample("", apiVsockConfigExample())
 
 	return doc
 }
@@ -3506,6 +3648,97 @@ func (KubernetesTalosAPIAccessConfig) Doc() *encoder.Doc {
 	return doc
 }
 
+func (APIVsockConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "APIVsockConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "APIVsockConfig describes the Talos API listener on the VM sockets." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "APIVsockConfig describes the Talos API listener on the VM sockets.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "FeaturesConfig",
+				FieldName: "apiVsock",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "enabled",
+				Type:        "bool",
+				Note:        "",
+				Description: "Enable the Talos API listener on the VM sockets.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable the Talos API listener on the VM sockets." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "port",
+				Type:        "int",
+				Note:        "",
+				Description: "The vsock port to listen on.\n\nDefaults to 50000.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The vsock port to listen on." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", apiVsockConfigExample())
+
+	return doc
+}
+
+func (APIRateLimitConfig) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "APIRateLimitConfig",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "APIRateLimitConfig describes the rate limiting of the Talos API methods." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "APIRateLimitConfig describes the rate limiting of the Talos API methods.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "FeaturesConfig",
+				FieldName: "apiRateLimit",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "enabled",
+				Type:        "bool",
+				Note:        "",
+				Description: "Enable rate limiting of the Talos API methods.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Enable rate limiting of the Talos API methods." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "requestsPerMinute",
+				Type:        "int",
+				Note:        "",
+				Description: "Number of requests per minute each client can make to each of the rate limited methods.\n\nDefaults to 60.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of requests per minute each client can make to each of the rate limited methods." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "burst",
+				Type:        "int",
+				Note:        "",
+				Description: "Number of requests each client can make in a burst above the rate limit.\n\nDefaults to 10.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of requests each client can make in a burst above the rate limit." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "maxConcurrentRequests",
+				Type:        "int",
+				Note:        "",
+				Description: "Number of requests to the rate limited methods each client can have in flight at the same time\n(e.g. `talosctl logs --follow` holds a request for the whole duration of the stream).\n\nDefaults to 5.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Number of requests to the rate limited methods each client can have in flight at the same time" /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "methods",
+				Type:        "[]string",
+				Note:        "",
+				Description: "The list of rate limited API methods.\n\nDefaults to the methods which are expensive for the node: `Processes`, `ProcessesStream`, `Logs`, `Dmesg`, `PacketCapture`, `DiskUsage`\nand `EtcdSnapshot`.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "The list of rate limited API methods." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.AddExample("", apiRateLimitConfigExample())
+
+	doc.Fields[4].AddExample("", []string{"/machine.MachineService/Processes", "/machine.MachineService/Logs"})
+
+	return doc
+}
+
 func (HostDNSConfig) Doc() *encoder.Doc {
 	doc := &encoder.Doc{
 		Type:        "HostDNSConfig",
@@ -3539,9 +3772,60 @@ func (HostDNSConfig) Doc() *encoder.Doc {
 				Description: "Resolve member hostnames using the host DNS resolver.\n\nWhen enabled, cluster member hostnames and node names are resolved using the host DNS resolver.\nThis requires service discovery to be enabled.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Resolve member hostnames using the host DNS resolver." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "upstreams",
+				Type:        "[]string",
+				Note:        "",
+				Description: "Upstream nameservers of the host DNS resolver.\n\nBy default, the host DNS resolver forwards the requests to the nameservers\nof the machine (`.machine.network.nameservers` or the ones received via DHCP).",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Upstream nameservers of the host DNS resolver." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "stubDomains",
+				Type:        "[]HostDNSStubDomain",
+				Note:        "",
+				Description: "Stub domains of the host DNS resolver.\n\nThe requests for the names in the stub domain (and its subdomains) are forwarded\nto the nameservers of the stub domain instead of the upstream nameservers.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Stub domains of the host DNS resolver." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+		},
+	}
+
+	doc.Fields[3].AddExample("", []string{"1.1.1.1", "8.8.8.8"})
+
+	return doc
+}
+
+func (HostDNSStubDomain) Doc() *encoder.Doc {
+	doc := &encoder.Doc{
+		Type:        "HostDNSStubDomain",
+		Comments:    [3]string{"" /* encoder.HeadComment */, "HostDNSStubDomain describes the upstream nameservers of a DNS domain." /* encoder.LineComment */, "" /* encoder.FootComment */},
+		Description: "HostDNSStubDomain describes the upstream nameservers of a DNS domain.",
+		AppearsIn: []encoder.Appearance{
+			{
+				TypeName:  "HostDNSConfig",
+				FieldName: "stubDomains",
+			},
+		},
+		Fields: []encoder.Doc{
+			{
+				Name:        "domain",
+				Type:        "string",
+				Note:        "",
+				Description: "DNS domain name.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "DNS domain name." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
+			{
+				Name:        "nameservers",
+				Type:        "[]string",
+				Note:        "",
+				Description: "Nameservers to forward the requests for the domain to.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Nameservers to forward the requests for the domain to." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
+	doc.Fields[0].AddExample("", "corp.example.com")
+	doc.Fields[1].AddExample("", []string{"10.0.0.53"})
+
 	return doc
 }
 
@@ -3956,6 +4240,13 @@ func (LoggingConfig) Doc() *encoder.Doc {
 				Description: "Logging destination.",
 				Comments:    [3]string{"" /* encoder.HeadComment */, "Logging destination." /* encoder.LineComment */, "" /* encoder.FootComment */},
 			},
+			{
+				Name:        "structured",
+				Type:        "bool",
+				Note:        "",
+				Description: "Write machined controller logs as structured JSON.\n\nJSON logs contain the timestamp, level, message and the component and controller fields,\nwhich are preserved when the logs are sent to the logging destinations.",
+				Comments:    [3]string{"" /* encoder.HeadComment */, "Write machined controller logs as structured JSON." /* encoder.LineComment */, "" /* encoder.FootComment */},
+			},
 		},
 	}
 
@@ -4115,6 +4406,7 @@ func GetFileDoc() *encoder.FileDoc {
 			EncryptionKeyNodeID{}.Doc(),
 			ResourcesConfig{}.Doc(),
 			MachineFile{}.Doc(),
+			MachineFileSource{}.Doc(),
 			ExtraHost{}.Doc(),
 			Device{}.Doc(),
 			DHCPOptions{}.Doc(),
@@ -4137,7 +4429,10 @@ func GetFileDoc() *encoder.FileDoc {
 			FeaturesConfig{}.Doc(),
 			KubePrism{}.Doc(),
 			KubernetesTalosAPIAccessConfig{}.Doc(),
+			APIVsockConfig{}.Doc(),
+			APIRateLimitConfig{}.Doc(),
 			HostDNSConfig{}.Doc(),
+			HostDNSStubDomain{}.Doc(),
 			VolumeMountConfig{}.Doc(),
 			ClusterInlineManifest{}.Doc(),
 			NetworkKubeSpan{}.Doc(),
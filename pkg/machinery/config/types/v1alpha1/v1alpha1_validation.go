@@ -9,6 +9,7 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"net/netip"
 	"net/url"
 	"os"
 	"reflect"
@@ -127,6 +128,21 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 		}
 	}
 
+	if c.MachineConfig.MachineFeatures != nil && c.MachineConfig.MachineFeatures.HostDNSSupport != nil {
+		err := c.MachineConfig.MachineFeatures.HostDNSSupport.Validate()
+		result = multierror.Append(result, err)
+	}
+
+	if c.MachineConfig.MachineFeatures != nil && c.MachineConfig.MachineFeatures.APIRateLimitConfig != nil {
+		err := c.MachineConfig.MachineFeatures.APIRateLimitConfig.Validate()
+		result = multierror.Append(result, err)
+	}
+
+	if c.MachineConfig.MachineFeatures != nil && c.MachineConfig.MachineFeatures.APIVsockConfig != nil {
+		err := c.MachineConfig.MachineFeatures.APIVsockConfig.Validate()
+		result = multierror.Append(result, err)
+	}
+
 	if t := c.Machine().Type(); t != machine.TypeUnknown && t.String() != c.MachineConfig.MachineType {
 		warnings = append(warnings, fmt.Sprintf("use %q instead of %q for machine type", t.String(), c.MachineConfig.MachineType))
 	}
@@ -232,6 +248,32 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 				result = multierror.Append(result, fmt.Errorf("kubespan link MTU must be at least %d", constants.KubeSpanLinkMinimumMTU))
 			}
 		}
+
+		if c.MachineConfig.MachineNetwork.NetworkHostnameTemplate != "" {
+			if c.MachineConfig.MachineNetwork.NetworkHostname != "" {
+				result = multierror.Append(result, fmt.Errorf("hostname and hostnameTemplate: %w", ErrMutuallyExclusive))
+			}
+
+			if err := nethelpers.ValidateHostnameTemplate(c.MachineConfig.MachineNetwork.NetworkHostnameTemplate); err != nil {
+				result = multierror.Append(result, fmt.Errorf("invalid hostname template: %w", err))
+			}
+		}
+
+		for _, host := range c.MachineConfig.MachineNetwork.ExtraHostEntries {
+			if _, err := netip.ParseAddr(host.HostIP); err != nil {
+				result = multierror.Append(result, fmt.Errorf("extra host entry %q has invalid IP: %w", host.HostIP, err))
+			}
+
+			if len(host.HostAliases) == 0 {
+				result = multierror.Append(result, fmt.Errorf("extra host entry %q should have at least one alias", host.HostIP))
+			}
+
+			for _, alias := range host.HostAliases {
+				if !isValidDNSName(alias) {
+					result = multierror.Append(result, fmt.Errorf("extra host entry %q has invalid alias %q", host.HostIP, alias))
+				}
+			}
+		}
 	}
 
 	if c.MachineConfig.MachineDisks != nil {
@@ -294,6 +336,14 @@ func (c *Config) Validate(mode validation.RuntimeMode, options ...validation.Opt
 		}
 	}
 
+	if c.Cluster().KubeletServingCertificateRotation() && !c.Cluster().Discovery().Enabled() {
+		result = multierror.Append(result, errors.New(".cluster.discovery should be enabled when .cluster.kubeletServingCertificateRotation is enabled"))
+	}
+
+	for _, f := range c.MachineConfig.MachineFiles {
+		result = multierror.Append(result, f.Validate())
+	}
+
 	if c.MachineConfig.MachineLogging != nil {
 		err := c.MachineConfig.MachineLogging.Validate()
 		result = multierror.Append(result, err)
@@ -420,6 +470,9 @@ func (c *ClusterConfig) Validate(isControlPlane bool) error {
 	return result.ErrorOrNil()
 }
 
+// minFlannelMTU is the minimum MTU of the pod network interfaces (the minimum IPv4 datagram size every host must accept).
+const minFlannelMTU = 576
+
 // ValidateCNI validates CNI config.
 //
 //nolint:gocyclo
@@ -436,6 +489,11 @@ func ValidateCNI(cni config.CNI) ([]string, error) {
 			result = multierror.Append(result, err)
 		}
 
+		if mtu := cni.Flannel().MTU(); mtu != 0 && mtu < minFlannelMTU {
+			err := fmt.Errorf(`"mtu" field should be at least %d for %q CNI`, minFlannelMTU, cni.Name())
+			result = multierror.Append(result, err)
+		}
+
 	case constants.NoneCNI:
 		if len(cni.URLs()) != 0 {
 			err := fmt.Errorf(`"urls" field should be empty for %q CNI`, cni.Name())
@@ -447,6 +505,11 @@ func ValidateCNI(cni config.CNI) ([]string, error) {
 			result = multierror.Append(result, err)
 		}
 
+		if cni.Flannel().MTU() != 0 {
+			err := fmt.Errorf(`"mtu" field should be empty for %q CNI`, cni.Name())
+			result = multierror.Append(result, err)
+		}
+
 	case constants.CustomCNI:
 		if len(cni.URLs()) == 0 {
 			warn := fmt.Sprintf(`"urls" field should not be empty for %q CNI`, cni.Name())
@@ -458,6 +521,11 @@ func ValidateCNI(cni config.CNI) ([]string, error) {
 			result = multierror.Append(result, err)
 		}
 
+		if cni.Flannel().MTU() != 0 {
+			err := fmt.Errorf(`"mtu" field should be empty for %q CNI`, cni.Name())
+			result = multierror.Append(result, err)
+		}
+
 		for _, u := range cni.URLs() {
 			if err := sideronet.ValidateEndpointURI(u); err != nil {
 				result = multierror.Append(result, err)
@@ -120,6 +120,249 @@ func TestValidate(t *testing.T) {
 			strict:        true,
 			expectedError: "1 error occurred:\n\t* warning: use \"worker\" instead of \"\" for machine type\n\n",
 		},
+		{
+			name: "MachineFiles",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineFiles: []*v1alpha1.MachineFile{
+						{
+							FilePath:        "/var/lib/agent",
+							FilePermissions: 0o755,
+							FileOp:          "create",
+							FileType:        "directory",
+							FileUID:         1000,
+						},
+						{
+							FilePath:        "/var/lib/agent/license.txt",
+							FilePermissions: 0o600,
+							FileOp:          "create",
+							FileSource: &v1alpha1.MachineFileSource{
+								SourceURL:      "https://example.com/license.txt",
+								SourceChecksum: "sha256:e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+							},
+						},
+						{
+							FileContent:     "name: {{ .Hostname }}",
+							FilePath:        "/var/lib/agent/config.yaml",
+							FilePermissions: 0o644,
+							FileOp:          "create",
+							FileTemplate:    true,
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "MachineFilesInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineFiles: []*v1alpha1.MachineFile{
+						{
+							FileContent: "foo",
+							FilePath:    "/var/lib/agent",
+							FileOp:      "overwrite",
+							FileType:    "directory",
+						},
+						{
+							FileContent: "foo",
+							FilePath:    "/var/lib/agent/license.txt",
+							FileOp:      "replace",
+							FileSource: &v1alpha1.MachineFileSource{
+								SourceURL:      "ftp://example.com/license.txt",
+								SourceChecksum: "md5:d41d8cd98f00b204e9800998ecf8427e",
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "6 errors occurred:\n" +
+				"\t* file \"/var/lib/agent\": directories only support the create operation\n" +
+				"\t* file \"/var/lib/agent\": content, source and template are not allowed for directories\n" +
+				"\t* file \"/var/lib/agent/license.txt\": unknown operation \"replace\"\n" +
+				"\t* file \"/var/lib/agent/license.txt\": content and source: config sections are mutually exclusive\n" +
+				"\t* file \"/var/lib/agent/license.txt\": source URL \"ftp://example.com/license.txt\" should be a valid http(s) URL\n" +
+				"\t* file \"/var/lib/agent/license.txt\": source checksum \"md5:d41d8cd98f00b204e9800998ecf8427e\" should be in the sha256:<hex> or sha512:<hex> format\n\n",
+		},
+		{
+			name: "HostnameTemplateInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						NetworkHostname:         "worker-1",
+						NetworkHostnameTemplate: "worker-{{ .Rack }}",
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n" +
+				"\t* hostname and hostnameTemplate: config sections are mutually exclusive\n" +
+				"\t* invalid hostname template: template: hostname:1:10: executing \"hostname\" at <.Rack>: map has no entry for key \"Rack\"\n\n",
+		},
+		{
+			name: "ExtraHostEntriesInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineNetwork: &v1alpha1.NetworkConfig{
+						ExtraHostEntries: []*v1alpha1.ExtraHost{
+							{
+								HostIP:      "192.168.1.100",
+								HostAliases: []string{"cp.example.com", "cp"},
+							},
+							{
+								HostIP:      "192.168.1.300",
+								HostAliases: []string{"bad alias"},
+							},
+							{
+								HostIP: "2001:db8::1",
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "3 errors occurred:\n" +
+				"\t* extra host entry \"192.168.1.300\" has invalid IP: ParseAddr(\"192.168.1.300\"): IPv4 field has value >255\n" +
+				"\t* extra host entry \"192.168.1.300\" has invalid alias \"bad alias\"\n" +
+				"\t* extra host entry \"2001:db8::1\" should have at least one alias\n\n",
+		},
+		{
+			name: "HostDNSInvalid",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						HostDNSSupport: &v1alpha1.HostDNSConfig{
+							HostDNSEnabled:   pointer.To(true),
+							HostDNSUpstreams: []string{"1.1.1.1", "dns.example.com"},
+							HostDNSStubDomains: []v1alpha1.HostDNSStubDomain{
+								{
+									StubDomain:      "corp.example.com",
+									StubNameservers: []string{"10.0.0.53"},
+								},
+								{
+									StubDomain: "lab.example.com",
+								},
+							},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n" +
+				"\t* invalid host DNS upstream \"dns.example.com\": ParseAddr(\"dns.example.com\"): unexpected character (at \"dns.example.com\")\n" +
+				"\t* host DNS stub domain \"lab.example.com\": nameservers are required\n\n",
+		},
+		{
+			name: "InvalidAPIRateLimit",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						APIRateLimitConfig: &v1alpha1.APIRateLimitConfig{
+							RateLimitEnabled:           pointer.To(true),
+							RateLimitRequestsPerMinute: -1,
+							RateLimitMethods:           []string{"/machine.MachineService/Logs", "Processes"},
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "2 errors occurred:\n" +
+				"\t* API rate limit requests per minute should be positive: -1\n" +
+				"\t* invalid API rate limit method \"Processes\": expected /<service>/<method>\n\n",
+		},
+		{
+			name: "InvalidAPIVsock",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "worker",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+					},
+					MachineFeatures: &v1alpha1.FeaturesConfig{
+						APIVsockConfig: &v1alpha1.APIVsockConfig{
+							VsockEnabled: pointer.To(true),
+							VsockPort:    -1,
+						},
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+				},
+			},
+			expectedError: "1 error occurred:\n\t* invalid API vsock port: -1\n\n",
+		},
 		{
 			name: "WorkerNoAcceptedCAs",
 			config: &v1alpha1.Config{
@@ -1137,6 +1380,28 @@ func TestValidate(t *testing.T) {
 				"\t* .cluster.id should be set when .machine.network.kubespan is enabled\n" +
 				"\t* .cluster.secret should be set when .machine.network.kubespan is enabled\n\n",
 		},
+		{
+			name: "KubeletServingCertificateRotationNoDiscovery",
+			config: &v1alpha1.Config{
+				ConfigVersion: "v1alpha1",
+				MachineConfig: &v1alpha1.MachineConfig{
+					MachineType: "controlplane",
+					MachineCA: &x509.PEMEncodedCertificateAndKey{
+						Crt: []byte("foo"),
+						Key: []byte("bar"),
+					},
+				},
+				ClusterConfig: &v1alpha1.ClusterConfig{
+					ControlPlane: &v1alpha1.ControlPlaneConfig{
+						Endpoint: &v1alpha1.Endpoint{
+							endpointURL,
+						},
+					},
+					ClusterKubeletServingCertificateRotation: pointer.To(true),
+				},
+			},
+			expectedError: "1 error occurred:\n\t* .cluster.discovery should be enabled when .cluster.kubeletServingCertificateRotation is enabled\n\n",
+		},
 		{
 			name: "DiscoveryServiceEndpoint",
 			config: &v1alpha1.Config{
@@ -1810,6 +2075,25 @@ func TestValidateCNI(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "FlannelMTU",
+			config: &v1alpha1.CNIConfig{
+				CNIName: constants.FlannelCNI,
+				CNIFlannel: &v1alpha1.FlannelCNIConfig{
+					FlannelMTU: 1400,
+				},
+			},
+		},
+		{
+			name: "FlannelMTUTooLow",
+			config: &v1alpha1.CNIConfig{
+				CNIName: constants.FlannelCNI,
+				CNIFlannel: &v1alpha1.FlannelCNIConfig{
+					FlannelMTU: 500,
+				},
+			},
+			expectedError: "1 error occurred:\n\t* \"mtu\" field should be at least 576 for \"flannel\" CNI\n\n",
+		},
 		{
 			name: "CustomNoManifests",
 			config: &v1alpha1.CNIConfig{
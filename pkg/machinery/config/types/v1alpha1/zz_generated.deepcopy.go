@@ -13,6 +13,32 @@ import (
 	x509 "github.com/siderolabs/crypto/x509"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIRateLimitConfig) DeepCopyInto(out *APIRateLimitConfig) {
+	*out = *in
+	if in.RateLimitEnabled != nil {
+		in, out := &in.RateLimitEnabled, &out.RateLimitEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RateLimitMethods != nil {
+		in, out := &in.RateLimitMethods, &out.RateLimitMethods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIRateLimitConfig.
+func (in *APIRateLimitConfig) DeepCopy() *APIRateLimitConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(APIRateLimitConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *APIServerConfig) DeepCopyInto(out *APIServerConfig) {
 	*out = *in
@@ -75,6 +101,27 @@ func (in *APIServerConfig) DeepCopy() *APIServerConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIVsockConfig) DeepCopyInto(out *APIVsockConfig) {
+	*out = *in
+	if in.VsockEnabled != nil {
+		in, out := &in.VsockEnabled, &out.VsockEnabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIVsockConfig.
+func (in *APIVsockConfig) DeepCopy() *APIVsockConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(APIVsockConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AdminKubeconfigConfig) DeepCopyInto(out *AdminKubeconfigConfig) {
 	*out = *in
@@ -351,6 +398,11 @@ func (in *ClusterConfig) DeepCopyInto(out *ClusterConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ClusterKubeletServingCertificateRotation != nil {
+		in, out := &in.ClusterKubeletServingCertificateRotation, &out.ClusterKubeletServingCertificateRotation
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1077,6 +1129,31 @@ func (in *FeaturesConfig) DeepCopyInto(out *FeaturesConfig) {
 		*out = new(HostDNSConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NodeTalosMetadata != nil {
+		in, out := &in.NodeTalosMetadata, &out.NodeTalosMetadata
+		*out = new(bool)
+		**out = **in
+	}
+	if in.NodeEvents != nil {
+		in, out := &in.NodeEvents, &out.NodeEvents
+		*out = new(bool)
+		**out = **in
+	}
+	if in.APIRateLimitConfig != nil {
+		in, out := &in.APIRateLimitConfig, &out.APIRateLimitConfig
+		*out = new(APIRateLimitConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ChaosAPI != nil {
+		in, out := &in.ChaosAPI, &out.ChaosAPI
+		*out = new(bool)
+		**out = **in
+	}
+	if in.APIVsockConfig != nil {
+		in, out := &in.APIVsockConfig, &out.APIVsockConfig
+		*out = new(APIVsockConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1129,6 +1206,18 @@ func (in *HostDNSConfig) DeepCopyInto(out *HostDNSConfig) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.HostDNSUpstreams != nil {
+		in, out := &in.HostDNSUpstreams, &out.HostDNSUpstreams
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.HostDNSStubDomains != nil {
+		in, out := &in.HostDNSStubDomains, &out.HostDNSStubDomains
+		*out = make([]HostDNSStubDomain, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -1142,6 +1231,27 @@ func (in *HostDNSConfig) DeepCopy() *HostDNSConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HostDNSStubDomain) DeepCopyInto(out *HostDNSStubDomain) {
+	*out = *in
+	if in.StubNameservers != nil {
+		in, out := &in.StubNameservers, &out.StubNameservers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HostDNSStubDomain.
+func (in *HostDNSStubDomain) DeepCopy() *HostDNSStubDomain {
+	if in == nil {
+		return nil
+	}
+	out := new(HostDNSStubDomain)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *IfaceSelector) DeepCopyInto(out *IfaceSelector) {
 	*out = *in
@@ -1514,6 +1624,11 @@ func (in *LoggingConfig) DeepCopyInto(out *LoggingConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LoggingStructured != nil {
+		in, out := &in.LoggingStructured, &out.LoggingStructured
+		*out = new(bool)
+		**out = **in
+	}
 	return
 }
 
@@ -1621,7 +1736,7 @@ func (in *MachineConfig) DeepCopyInto(out *MachineConfig) {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
 				*out = new(MachineFile)
-				**out = **in
+				(*in).DeepCopyInto(*out)
 			}
 		}
 	}
@@ -1792,6 +1907,11 @@ func (in *MachineDisk) DeepCopy() *MachineDisk {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineFile) DeepCopyInto(out *MachineFile) {
 	*out = *in
+	if in.FileSource != nil {
+		in, out := &in.FileSource, &out.FileSource
+		*out = new(MachineFileSource)
+		**out = **in
+	}
 	return
 }
 
@@ -1805,6 +1925,22 @@ func (in *MachineFile) DeepCopy() *MachineFile {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachineFileSource) DeepCopyInto(out *MachineFileSource) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachineFileSource.
+func (in *MachineFileSource) DeepCopy() *MachineFileSource {
+	if in == nil {
+		return nil
+	}
+	out := new(MachineFileSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineSchedulerConfig) DeepCopyInto(out *MachineSchedulerConfig) {
 	*out = *in
@@ -49,6 +49,14 @@ const (
 	// KernelParamConfigOAuthExtraVariable is the kernel parameter name for specifying the OAuth2 extra variable (might be repeated).
 	KernelParamConfigOAuthExtraVariable = "talos.config.oauth.extra_variable"
 
+	// KernelParamConfigHeader is the kernel parameter name for specifying the extra HTTP header (might be repeated).
+	//
+	// The header is specified as percent-encoded `Name: value`.
+	KernelParamConfigHeader = "talos.config.header"
+
+	// KernelParamConfigCA is the kernel parameter name for specifying the base64-encoded PEM CA bundle to verify the config server.
+	KernelParamConfigCA = "talos.config.ca"
+
 	// ConfigNone indicates no config is required.
 	ConfigNone = "none"
 
@@ -92,6 +100,11 @@ const (
 	// KernelParamHaltIfInstalled is the kernel parameter name to control if Talos should pause if booting from boot media while Talos is already installed.
 	KernelParamHaltIfInstalled = "talos.halt_if_installed"
 
+	// KernelParamRootfsVerity is the kernel parameter name to specify the dm-verity root hash of the rootfs.
+	//
+	// If set, the rootfs is mounted via dm-verity, and the boot is aborted if the verification fails.
+	KernelParamRootfsVerity = "talos.rootfs.verity"
+
 	// BoardNone indicates that the install is not for a specific board.
 	BoardNone = "none"
 
@@ -335,6 +348,21 @@ const (
 	// KubeletOOMScoreAdj oom_score_adj config.
 	KubeletOOMScoreAdj = -450
 
+	// MachinedOOMScoreAdj is the oom_score_adj of machined.
+	MachinedOOMScoreAdj = -999
+
+	// AppArmorSystemProfile is the name of the baseline AppArmor profile applied to the system services, kubelet and the extension services.
+	AppArmorSystemProfile = "talos-system"
+
+	// AppArmorParserPath is the path to the AppArmor policy parser.
+	AppArmorParserPath = "/sbin/apparmor_parser"
+
+	// SPDXPath is the path to the SPDX SBOM documents of the Talos rootfs.
+	SPDXPath = "/usr/share/spdx"
+
+	// ExtensionSPDXPath is the path to the SPDX SBOM documents provided by the system extensions.
+	ExtensionSPDXPath = "/usr/local/share/spdx"
+
 	// KubeletPKIDir is the path to the directory where kubelet stores issued certificates and keys.
 	KubeletPKIDir = "/var/lib/kubelet/pki"
 
@@ -448,6 +476,30 @@ const (
 	// ConfigPath is the path to the downloaded config.
 	ConfigPath = StateMountPoint + "/config.yaml"
 
+	// BootLogPath is the path to the directory with early boot logs of the last boots.
+	BootLogPath = StateMountPoint + "/bootlog"
+
+	// BootLogMaxBoots is the number of boots to keep early boot logs for.
+	BootLogMaxBoots = 5
+
+	// BootLogFallbackDir is the directory on the EFI partition to keep the boot logs of the boots
+	// which failed before the STATE partition was mounted.
+	BootLogFallbackDir = "bootlog"
+
+	// CoreDumpsPath is the path to the directory with compressed core dumps of the crashed system services.
+	CoreDumpsPath = StateMountPoint + "/cores"
+
+	// CoreDumpsMaxCount is the number of latest core dumps to keep.
+	CoreDumpsMaxCount = 4
+
+	// CoreDumpMaxSize is the maximum size of a single compressed core dump, the rest of the dump is dropped.
+	CoreDumpMaxSize = 16 * 1024 * 1024
+
+	// CorePattern is the kernel core_pattern which pipes the core dumps to the handler.
+	//
+	// The process name goes last, as it might contain spaces.
+	CorePattern = "|/sbin/coredump %P %t %e"
+
 	// ConfigTryTimeout is the timeout of the config apply in try mode.
 	ConfigTryTimeout = time.Minute
 
@@ -536,6 +588,15 @@ const (
 	// TrustdRuntimeSocketPath is the path to file socket of runtime server for trustd.
 	TrustdRuntimeSocketPath = SystemRunPath + "/trustd/runtime.sock"
 
+	// ApidProfilingSocketPath is the path to file socket serving pprof profiles of apid.
+	ApidProfilingSocketPath = SystemRunPath + "/pprof/apid/pprof.sock"
+
+	// TrustdProfilingSocketPath is the path to file socket serving pprof profiles of trustd.
+	TrustdProfilingSocketPath = SystemRunPath + "/pprof/trustd/pprof.sock"
+
+	// DebugdSocketPath is the path to file socket of the debugger tunnel API (debug builds only).
+	DebugdSocketPath = SystemRunPath + "/debugd/debugd.sock"
+
 	// MachineSocketPath is the path to file socket of machine API.
 	MachineSocketPath = SystemRunPath + "/machined/machine.sock"
 
@@ -566,6 +627,15 @@ const (
 	// RootfsAsset defines a well known name for our rootfs filename.
 	RootfsAsset = "rootfs.sqsh"
 
+	// RootfsVerityAsset defines a well known name for the dm-verity hash tree of the rootfs.
+	RootfsVerityAsset = "rootfs.sqsh.verity"
+
+	// RootfsVerityRootHashAsset defines a well known name for the dm-verity root hash of the rootfs.
+	RootfsVerityRootHashAsset = "rootfs.roothash"
+
+	// RootfsVerityRootHashAssetPath is the path to the dm-verity root hash of the rootfs in the installer.
+	RootfsVerityRootHashAssetPath = "/usr/install/%s/" + RootfsVerityRootHashAsset
+
 	// UKIAsset defines a well known name for our UKI filename.
 	UKIAsset = "vmlinuz.efi.signed"
 
@@ -732,6 +802,9 @@ const (
 	// CgroupExtensions is the cgroup name for system extension processes.
 	CgroupExtensions = CgroupSystem + "/extensions"
 
+	// CgroupUserServices is the cgroup name for user-defined system services.
+	CgroupUserServices = CgroupSystem + "/user-services"
+
 	// CgroupDashboard is the cgroup name for dashboard process.
 	CgroupDashboard = CgroupSystem + "/dashboard"
 
@@ -846,6 +919,18 @@ const (
 	// K8sExtensionPrefix is the prefix for node labels/annotations listing extensions.
 	K8sExtensionPrefix = "extensions.talos.dev/"
 
+	// K8sTalosVersionKey is the node label/annotation key for the Talos version.
+	K8sTalosVersionKey = "talos.dev/version"
+
+	// K8sTalosPlatformKey is the node label/annotation key for the Talos platform.
+	K8sTalosPlatformKey = "talos.dev/platform"
+
+	// K8sTalosInstallImageKey is the node label/annotation key for the Talos install image.
+	K8sTalosInstallImageKey = "talos.dev/install-image"
+
+	// K8sTalosConfigHashKey is the node label/annotation key for the machine configuration hash.
+	K8sTalosConfigHashKey = "talos.dev/config-hash"
+
 	// DefaultNTPServer is the NTP server to use if not configured explicitly.
 	DefaultNTPServer = "time.cloudflare.com"
 
@@ -867,6 +952,9 @@ const (
 	// NodeIdentityFilename is the filename to cache node identity across reboots.
 	NodeIdentityFilename = "node-identity.yaml"
 
+	// NodeCertificateFilename is the filename to cache the certificate issued by trustd across reboots.
+	NodeCertificateFilename = "node-certificate.yaml"
+
 	// DefaultDiscoveryServiceEndpoint is the default endpoint for Talos discovery service.
 	DefaultDiscoveryServiceEndpoint = "https://discovery.talos.dev/"
 
@@ -908,6 +996,9 @@ const (
 	// ClusterNodeIDAnnotation is the node annotation used to represent node ID.
 	ClusterNodeIDAnnotation = "cluster.talos.dev/node-id"
 
+	// ClusterNodeMaintenanceAnnotation is the node annotation used to mark the node in maintenance.
+	ClusterNodeMaintenanceAnnotation = "cluster.talos.dev/node-maintenance"
+
 	// KubeSpanIPAnnotation is the node annotation to be used for indicating the Wireguard IP of the node.
 	KubeSpanIPAnnotation = "networking.talos.dev/kubespan-ip"
 
@@ -1030,6 +1121,10 @@ const (
 	// APIAuthzRoleMetadataKey is the gRPC metadata key used to submit a role with os:impersonator.
 	APIAuthzRoleMetadataKey = "talos-role"
 
+	// APIClientIdentityMetadataKey is the gRPC metadata key used to forward the identity of the client
+	// which made the request to other apid instances (used for rate limiting).
+	APIClientIdentityMetadataKey = "talos-client-identity"
+
 	// KernelLogsTTY is the number of the TTY device (/dev/ttyN) to redirect Kernel logs to.
 	KernelLogsTTY = 1
 
@@ -1054,6 +1149,10 @@ const (
 	// TcellMinimizeEnvironment is the environment variable to minimize tcell library memory usage (skips rune width calculation).
 	TcellMinimizeEnvironment = "TCELL_MINIMIZE=1"
 
+	// GoTracebackCrashEnvironment is the environment variable to make the Go runtime abort with a core dump on a fatal error,
+	// so that the core dumps of the crashed Go system services are collected (see CorePattern).
+	GoTracebackCrashEnvironment = "GOTRACEBACK=crash"
+
 	// DefaultKubePrismPort is the default port for the KubePrism loadbalancer.
 	DefaultKubePrismPort = 7445
 
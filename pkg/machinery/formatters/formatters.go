@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/dustin/go-humanize"
 	"github.com/emicklei/dot"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
@@ -227,7 +228,36 @@ func RenderServicesInfo(services []client.ServiceInfo, output io.Writer, default
 			fmt.Fprintf(w, "LAST HEALTH MESSAGE\t%s\n", svc.Health.LastMessage)
 		}
 
-		label := "EVENTS"
+		label := "WAITING FOR"
+
+		for _, condition := range svc.WaitingFor {
+			fmt.Fprintf(w, "%s\t%s\n", label, condition)
+			label = ""
+		}
+
+		if svc.Resources != nil {
+			fmt.Fprintf(w, "MEMORY\t%s\n", svc.MemoryUsage())
+			fmt.Fprintf(w, "CPU\t%s\n", svc.CPUUsage())
+			fmt.Fprintf(w, "PIDS\t%s\n", svc.PidsUsage())
+		}
+
+		if svc.Policy != nil {
+			fmt.Fprintf(w, "POLICY\t%s\n", svc.PolicySource())
+
+			if svc.Policy.Restart != "" {
+				fmt.Fprintf(w, "RESTART\t%s\n", svc.RestartPolicy())
+			}
+
+			if svc.Policy.HealthCheckPeriod != nil {
+				fmt.Fprintf(w, "HEALTH CHECK\t%s\n", svc.HealthCheckPolicy())
+			}
+
+			if svc.Policy.StartupTimeout != nil {
+				fmt.Fprintf(w, "STARTUP TIMEOUT\t%s\n", svc.Policy.StartupTimeout.AsDuration())
+			}
+		}
+
+		label = "EVENTS"
 
 		for i := range svc.Events.Events {
 			event := svc.Events.Events[len(svc.Events.Events)-1-i]
@@ -278,3 +308,70 @@ func (svc ServiceInfoWrapper) HealthStatus() string {
 
 	return "Fail"
 }
+
+// MemoryUsage returns the memory usage and limit of the service cgroup.
+func (svc ServiceInfoWrapper) MemoryUsage() string {
+	if svc.Resources == nil {
+		return "-"
+	}
+
+	limit := "max"
+	if svc.Resources.MemoryMax != 0 {
+		limit = humanize.IBytes(svc.Resources.MemoryMax)
+	}
+
+	return humanize.IBytes(svc.Resources.MemoryCurrent) + " / " + limit
+}
+
+// CPUUsage returns the CPU time consumed by the service cgroup and the CPU limit.
+func (svc ServiceInfoWrapper) CPUUsage() string {
+	if svc.Resources == nil {
+		return "-"
+	}
+
+	limit := "max"
+	if svc.Resources.CpuMaxMillicores != 0 {
+		limit = fmt.Sprintf("%dm", svc.Resources.CpuMaxMillicores)
+	}
+
+	usage := (time.Duration(svc.Resources.CpuUsageUsec) * time.Microsecond).Round(time.Millisecond)
+
+	return usage.String() + " / " + limit
+}
+
+// PidsUsage returns the number of processes in the service cgroup and the limit.
+func (svc ServiceInfoWrapper) PidsUsage() string {
+	if svc.Resources == nil {
+		return "-"
+	}
+
+	limit := "max"
+	if svc.Resources.PidsMax != 0 {
+		limit = fmt.Sprint(svc.Resources.PidsMax)
+	}
+
+	return fmt.Sprintf("%d / %s", svc.Resources.PidsCurrent, limit)
+}
+
+// PolicySource returns whether the policy of the service is overridden in the machine config.
+func (svc ServiceInfoWrapper) PolicySource() string {
+	if svc.Policy.GetConfigured() {
+		return "machine config"
+	}
+
+	return "default"
+}
+
+// RestartPolicy returns the restart policy of the service and the interval between the restarts.
+func (svc ServiceInfoWrapper) RestartPolicy() string {
+	return fmt.Sprintf("%s (interval %s)", svc.Policy.GetRestart(), svc.Policy.GetRestartInterval().AsDuration())
+}
+
+// HealthCheckPolicy returns the health check settings of the service.
+func (svc ServiceInfoWrapper) HealthCheckPolicy() string {
+	return fmt.Sprintf("every %s (timeout %s, initial delay %s)",
+		svc.Policy.GetHealthCheckPeriod().AsDuration(),
+		svc.Policy.GetHealthCheckTimeout().AsDuration(),
+		svc.Policy.GetHealthCheckInitialDelay().AsDuration(),
+	)
+}
@@ -27,4 +27,10 @@ const (
 	UUIDOverride
 	// UniqueMachineToken store the unique token for this machine. It's useful because UUID may repeat or be filled with zeros.
 	UniqueMachineToken
+	// NodeMaintenance marks the node as being in maintenance, the value is the reason.
+	NodeMaintenance
+	// PowerActionSchedule stores JSON-serialized scheduled reboot or shutdown.
+	PowerActionSchedule
+	// UpgradeHealthCheckStarted stores the time the post-upgrade health checks were started at (RFC3339).
+	UpgradeHealthCheckStarted
 )
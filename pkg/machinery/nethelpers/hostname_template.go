@@ -0,0 +1,39 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers
+
+import (
+	"strings"
+	"text/template"
+)
+
+// HostnameTemplateFacts is the list of node facts available in the hostname template.
+var HostnameTemplateFacts = []string{"MAC", "IP", "UUID", "Serial", "MachineType"}
+
+// ParseHostnameTemplate parses the hostname template.
+//
+// The template is executed over the map of node facts, and it fails if some fact is not available.
+func ParseHostnameTemplate(text string) (*template.Template, error) {
+	return template.New("hostname").
+		Funcs(template.FuncMap{"lower": strings.ToLower}).
+		Option("missingkey=error").
+		Parse(text)
+}
+
+// ValidateHostnameTemplate checks that the hostname template is valid and uses only the known facts.
+func ValidateHostnameTemplate(text string) error {
+	tmpl, err := ParseHostnameTemplate(text)
+	if err != nil {
+		return err
+	}
+
+	facts := make(map[string]string, len(HostnameTemplateFacts))
+
+	for _, fact := range HostnameTemplateFacts {
+		facts[fact] = fact
+	}
+
+	return tmpl.Execute(&strings.Builder{}, facts)
+}
@@ -0,0 +1,35 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package nethelpers_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/siderolabs/talos/pkg/machinery/nethelpers"
+)
+
+func TestHostnameTemplate(t *testing.T) {
+	t.Parallel()
+
+	tmpl, err := nethelpers.ParseHostnameTemplate("{{ .MachineType }}-{{ .Serial | lower }}")
+	require.NoError(t, err)
+
+	var buf strings.Builder
+
+	require.NoError(t, tmpl.Execute(&buf, map[string]string{"MachineType": "worker", "Serial": "ABC123"}))
+	assert.Equal(t, "worker-abc123", buf.String())
+
+	// missing facts fail the template
+	require.Error(t, tmpl.Execute(&strings.Builder{}, map[string]string{"MachineType": "worker"}))
+
+	assert.NoError(t, nethelpers.ValidateHostnameTemplate("node-{{ .MAC }}-{{ .IP }}-{{ .UUID }}"))
+	assert.EqualError(t, nethelpers.ValidateHostnameTemplate("node-{{ .Rack }}"),
+		`template: hostname:1:8: executing "hostname" at <.Rack>: map has no entry for key "Rack"`)
+	assert.Error(t, nethelpers.ValidateHostnameTemplate("node-{{ .MAC "))
+}
@@ -18,6 +18,7 @@ import (
 	"google.golang.org/protobuf/proto"       //nolint:depguard
 
 	"github.com/siderolabs/talos/pkg/machinery/api/common"
+	_ "github.com/siderolabs/talos/pkg/machinery/proto/zstd" // enable compression server-side
 )
 
 // Message is the main interface for protobuf API v2 messages.
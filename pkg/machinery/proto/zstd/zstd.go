@@ -0,0 +1,104 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+// Package zstd implements and registers the zstd compressor for gRPC.
+//
+// The compressor is registered on import, so that it can be used by both the clients and the servers.
+package zstd
+
+import (
+	"errors"
+	"io"
+	"sync"
+
+	"github.com/klauspost/compress/zstd"
+	"google.golang.org/grpc/encoding"
+)
+
+// Name is the name of the compressor, used as the grpc-encoding value.
+const Name = "zstd"
+
+func init() {
+	encoding.RegisterCompressor(&compressor{})
+}
+
+type compressor struct {
+	encoders sync.Pool
+	decoders sync.Pool
+}
+
+// Name implements encoding.Compressor.
+func (c *compressor) Name() string {
+	return Name
+}
+
+// Compress implements encoding.Compressor.
+func (c *compressor) Compress(w io.Writer) (io.WriteCloser, error) {
+	enc, ok := c.encoders.Get().(*zstd.Encoder)
+	if !ok {
+		var err error
+
+		// messages are small, so avoid spinning up goroutines for each of them
+		enc, err = zstd.NewWriter(w, zstd.WithEncoderConcurrency(1), zstd.WithEncoderLevel(zstd.SpeedDefault))
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		enc.Reset(w)
+	}
+
+	return &writer{Encoder: enc, pool: &c.encoders}, nil
+}
+
+// Decompress implements encoding.Compressor.
+func (c *compressor) Decompress(r io.Reader) (io.Reader, error) {
+	dec, ok := c.decoders.Get().(*zstd.Decoder)
+	if !ok {
+		var err error
+
+		dec, err = zstd.NewReader(r, zstd.WithDecoderConcurrency(1))
+		if err != nil {
+			return nil, err
+		}
+	} else if err := dec.Reset(r); err != nil {
+		c.decoders.Put(dec)
+
+		return nil, err
+	}
+
+	return &reader{Decoder: dec, pool: &c.decoders}, nil
+}
+
+type writer struct {
+	*zstd.Encoder
+
+	pool *sync.Pool
+}
+
+func (w *writer) Close() error {
+	defer w.pool.Put(w.Encoder)
+
+	return w.Encoder.Close()
+}
+
+type reader struct {
+	*zstd.Decoder
+
+	pool *sync.Pool
+}
+
+func (r *reader) Read(p []byte) (int, error) {
+	if r.Decoder == nil {
+		return 0, io.EOF
+	}
+
+	n, err := r.Decoder.Read(p)
+	if errors.Is(err, io.EOF) {
+		// return the decoder to the pool once the message is fully read, it is not closed as it might be reused
+		r.pool.Put(r.Decoder)
+		r.Decoder = nil
+	}
+
+	return n, err
+}
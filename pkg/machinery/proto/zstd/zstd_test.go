@@ -0,0 +1,49 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package zstd_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/encoding"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto/zstd"
+)
+
+func TestCompressor(t *testing.T) {
+	t.Parallel()
+
+	compressor := encoding.GetCompressor(zstd.Name)
+	require.NotNil(t, compressor)
+
+	// check that the pooled encoders and decoders are reset properly
+	for _, message := range [][]byte{
+		bytes.Repeat([]byte("talos "), 100000),
+		[]byte("hello"),
+		{},
+	} {
+		var buf bytes.Buffer
+
+		w, err := compressor.Compress(&buf)
+		require.NoError(t, err)
+
+		_, err = w.Write(message)
+		require.NoError(t, err)
+		require.NoError(t, w.Close())
+
+		r, err := compressor.Decompress(&buf)
+		require.NoError(t, err)
+
+		decompressed, err := io.ReadAll(r)
+		require.NoError(t, err)
+
+		assert.Equal(t, len(message), len(decompressed))
+		assert.True(t, bytes.Equal(message, decompressed))
+	}
+}
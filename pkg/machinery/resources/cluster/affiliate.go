@@ -83,6 +83,7 @@ type AffiliateSpec struct {
 	MachineType     machine.Type          `yaml:"machineType" protobuf:"6"`
 	KubeSpan        KubeSpanAffiliateSpec `yaml:"kubespan,omitempty" protobuf:"7"`
 	ControlPlane    *ControlPlane         `yaml:"controlPlane,omitempty" protobuf:"8"`
+	NodeMaintenance bool                  `yaml:"nodeMaintenance,omitempty" protobuf:"9"`
 }
 
 // ControlPlane describes ControlPlane data if any.
@@ -128,6 +129,10 @@ func (spec *AffiliateSpec) Merge(other *AffiliateSpec) {
 		spec.MachineType = other.MachineType
 	}
 
+	if other.NodeMaintenance {
+		spec.NodeMaintenance = true
+	}
+
 	if other.KubeSpan.PublicKey != "" {
 		spec.KubeSpan.PublicKey = other.KubeSpan.PublicKey
 	}
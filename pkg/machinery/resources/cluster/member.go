@@ -34,6 +34,7 @@ type MemberSpec struct {
 	MachineType     machine.Type  `yaml:"machineType" protobuf:"4"`
 	OperatingSystem string        `yaml:"operatingSystem" protobuf:"5"`
 	ControlPlane    *ControlPlane `yaml:"controlPlane,omitempty" protobuf:"6"`
+	NodeMaintenance bool          `yaml:"nodeMaintenance,omitempty" protobuf:"7"`
 }
 
 // NewMember initializes a Member resource.
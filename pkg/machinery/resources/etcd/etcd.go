@@ -10,6 +10,8 @@ import (
 	"strconv"
 
 	"github.com/cosi-project/runtime/pkg/resource"
+
+	"github.com/siderolabs/talos/pkg/machinery/constants"
 )
 
 //go:generate deep-copy -type ConfigSpec -type PKIStatusSpec -type SpecSpec -type MemberSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
@@ -31,3 +33,19 @@ func ParseMemberID(memberID string) (uint64, error) {
 
 	return id, nil
 }
+
+// EnforcedArgs returns the etcd arguments enforced by Talos, they can't be overridden by the extra args.
+func EnforcedArgs() map[string]string {
+	return map[string]string{
+		"auto-tls":              "false",
+		"peer-auto-tls":         "false",
+		"client-cert-auth":      "true",
+		"cert-file":             constants.EtcdCert,
+		"key-file":              constants.EtcdKey,
+		"trusted-ca-file":       constants.EtcdCACert,
+		"peer-client-cert-auth": "true",
+		"peer-cert-file":        constants.EtcdPeerCert,
+		"peer-key-file":         constants.EtcdPeerKey,
+		"peer-trusted-ca-file":  constants.EtcdCACert,
+	}
+}
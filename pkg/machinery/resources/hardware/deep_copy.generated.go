@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type MemoryModuleSpec -type PCIDeviceSpec -type ProcessorSpec -type SystemInformationSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type MemoryModuleSpec -type PCIDeviceSpec -type ProcessorSpec -type SystemInformationSpec -type VirtualFunctionSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package hardware
 
@@ -29,3 +29,9 @@ func (o SystemInformationSpec) DeepCopy() SystemInformationSpec {
 	var cp SystemInformationSpec = o
 	return cp
 }
+
+// DeepCopy generates a deep copy of VirtualFunctionSpec.
+func (o VirtualFunctionSpec) DeepCopy() VirtualFunctionSpec {
+	var cp VirtualFunctionSpec = o
+	return cp
+}
@@ -29,6 +29,7 @@ func TestRegisterResource(t *testing.T) {
 		&hardware.PCIDevice{},
 		&hardware.Processor{},
 		&hardware.SystemInformation{},
+		&hardware.VirtualFunction{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
 	}
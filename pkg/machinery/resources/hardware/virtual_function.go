@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package hardware
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// VirtualFunctionType is type of VirtualFunction resource.
+const VirtualFunctionType = resource.Type("VirtualFunctions.hardware.talos.dev")
+
+// VirtualFunction resource holds an SR-IOV virtual function of a physical link.
+type VirtualFunction = typed.Resource[VirtualFunctionSpec, VirtualFunctionExtension]
+
+// VirtualFunctionSpec represents a single SR-IOV virtual function.
+//
+//gotagsrewrite:gen
+type VirtualFunctionSpec struct {
+	PhysicalFunction string `yaml:"physicalFunction" protobuf:"1"`
+	Index            uint32 `yaml:"index" protobuf:"2"`
+	PCIAddress       string `yaml:"pciAddress,omitempty" protobuf:"3"`
+	LinkName         string `yaml:"linkName,omitempty" protobuf:"4"`
+	HardwareAddr     string `yaml:"hardwareAddr" protobuf:"5"`
+	VLAN             uint32 `yaml:"vlan" protobuf:"6"`
+	SpoofCheck       bool   `yaml:"spoofCheck" protobuf:"7"`
+	Trust            bool   `yaml:"trust" protobuf:"8"`
+}
+
+// NewVirtualFunction initializes a VirtualFunction resource.
+func NewVirtualFunction(id string) *VirtualFunction {
+	return typed.NewResource[VirtualFunctionSpec, VirtualFunctionExtension](
+		resource.NewMetadata(NamespaceName, VirtualFunctionType, id, resource.VersionUndefined),
+		VirtualFunctionSpec{},
+	)
+}
+
+// VirtualFunctionExtension provides auxiliary methods for VirtualFunction.
+type VirtualFunctionExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (VirtualFunctionExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type: VirtualFunctionType,
+		Aliases: []resource.Type{
+			"vf",
+			"vfs",
+		},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Physical Function",
+				JSONPath: `{.physicalFunction}`,
+			},
+			{
+				Name:     "Index",
+				JSONPath: `{.index}`,
+			},
+			{
+				Name:     "PCI Address",
+				JSONPath: `{.pciAddress}`,
+			},
+			{
+				Name:     "Link",
+				JSONPath: `{.linkName}`,
+			},
+			{
+				Name:     "Hardware Addr",
+				JSONPath: `{.hardwareAddr}`,
+			},
+			{
+				Name:     "VLAN",
+				JSONPath: `{.vlan}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[VirtualFunctionSpec](VirtualFunctionType, &VirtualFunction{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -345,6 +345,12 @@ func (o KubeletConfigSpec) DeepCopy() KubeletConfigSpec {
 			cp.CredentialProviderConfig[k2] = v2
 		}
 	}
+	if o.SystemReserved != nil {
+		cp.SystemReserved = make(map[string]string, len(o.SystemReserved))
+		for k2, v2 := range o.SystemReserved {
+			cp.SystemReserved[k2] = v2
+		}
+	}
 	return cp
 }
 
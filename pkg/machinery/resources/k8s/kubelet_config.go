@@ -40,6 +40,8 @@ type KubeletConfigSpec struct {
 	DisableManifestsDirectory    bool              `yaml:"disableManifestsDirectory" protobuf:"11"`
 	EnableFSQuotaMonitoring      bool              `yaml:"enableFSQuotaMonitoring" protobuf:"12"`
 	CredentialProviderConfig     map[string]any    `yaml:"credentialProviderConfig,omitempty" protobuf:"13"`
+	SystemReserved               map[string]string `yaml:"systemReserved,omitempty" protobuf:"14"`
+	ServerTLSBootstrap           bool              `yaml:"serverTLSBootstrap" protobuf:"15"`
 }
 
 // NewKubeletConfig initializes an empty KubeletConfig resource.
@@ -47,6 +47,7 @@ type BootstrapManifestsConfigSpec struct {
 	FlannelExtraArgs       []string `yaml:"flannelExtraArgs" protobuf:"16"`
 	FlannelKubeServiceHost string   `yaml:"flannelKubeServiceHost" protobuf:"17"`
 	FlannelKubeServicePort string   `yaml:"flannelKubeServicePort" protobuf:"18"`
+	FlannelMTU             uint32   `yaml:"flannelMTU" protobuf:"19"`
 
 	PodSecurityPolicyEnabled bool `yaml:"podSecurityPolicyEnabled" protobuf:"14"`
 
@@ -0,0 +1,91 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// BGPPeerStatusType is type of BGPPeerStatus resource.
+const BGPPeerStatusType = resource.Type("BGPPeerStatuses.net.talos.dev")
+
+// BGPPeerStatus resource holds the state of the BGP session with a peer.
+type BGPPeerStatus = typed.Resource[BGPPeerStatusSpec, BGPPeerStatusExtension]
+
+// BGPPeerStatusSpec describes the state of the BGP session.
+//
+//gotagsrewrite:gen
+type BGPPeerStatusSpec struct {
+	// Address of the peer.
+	Address netip.Addr `yaml:"address" protobuf:"1"`
+	// Autonomous system number of the peer.
+	ASN uint32 `yaml:"asn" protobuf:"2"`
+	// Local autonomous system number.
+	LocalASN uint32 `yaml:"localASN" protobuf:"3"`
+	// State of the session (Idle, Connect, OpenSent, OpenConfirm, Established).
+	State string `yaml:"state" protobuf:"4"`
+	// Local address of the session.
+	LocalAddress netip.Addr `yaml:"localAddress,omitempty" protobuf:"5"`
+	// Prefixes announced to the peer.
+	Announced []netip.Prefix `yaml:"announced,omitempty" protobuf:"6"`
+	// State of the BFD session, if enabled.
+	BFDState string `yaml:"bfdState,omitempty" protobuf:"7"`
+	// Last error which reset the session.
+	LastError string `yaml:"lastError,omitempty" protobuf:"8"`
+}
+
+// NewBGPPeerStatus initializes a BGPPeerStatus resource.
+func NewBGPPeerStatus(namespace resource.Namespace, id resource.ID) *BGPPeerStatus {
+	return typed.NewResource[BGPPeerStatusSpec, BGPPeerStatusExtension](
+		resource.NewMetadata(namespace, BGPPeerStatusType, id, resource.VersionUndefined),
+		BGPPeerStatusSpec{},
+	)
+}
+
+// BGPPeerStatusExtension provides auxiliary methods for BGPPeerStatus.
+type BGPPeerStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (BGPPeerStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             BGPPeerStatusType,
+		Aliases:          []resource.Type{"bgp", "bgppeer", "bgppeers"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "ASN",
+				JSONPath: "{.asn}",
+			},
+			{
+				Name:     "State",
+				JSONPath: "{.state}",
+			},
+			{
+				Name:     "BFD",
+				JSONPath: "{.bfdState}",
+			},
+			{
+				Name:     "Announced",
+				JSONPath: "{.announced}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[BGPPeerStatusSpec](BGPPeerStatusType, &BGPPeerStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type DNSResolveCacheSpec -type HardwareAddrSpec -type HostDNSConfigSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LinkRefreshSpec -type LinkSpecSpec -type LinkStatusSpec -type NfTablesChainSpec -type NodeAddressSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type ProbeSpecSpec -type ProbeStatusSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type AddressSpecSpec -type AddressStatusSpec -type BGPPeerStatusSpec -type DNSResolveCacheSpec -type EthernetStatusSpec -type HardwareAddrSpec -type HostDNSConfigSpec -type HostnameSpecSpec -type HostnameStatusSpec -type LinkRefreshSpec -type LinkSpecSpec -type LLDPNeighborSpec -type LinkStatusSpec -type NfTablesChainSpec -type NodeAddressSpec -type NodeAddressFilterSpec -type OperatorSpecSpec -type ProbeSpecSpec -type ProbeStatusSpec -type ResolverSpecSpec -type ResolverStatusSpec -type RouteSpecSpec -type RouteStatusSpec -type StatusSpec -type TimeServerSpecSpec -type TimeServerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package network
 
@@ -24,12 +24,40 @@ func (o AddressStatusSpec) DeepCopy() AddressStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of BGPPeerStatusSpec.
+func (o BGPPeerStatusSpec) DeepCopy() BGPPeerStatusSpec {
+	var cp BGPPeerStatusSpec = o
+	if o.Announced != nil {
+		cp.Announced = make([]netip.Prefix, len(o.Announced))
+		copy(cp.Announced, o.Announced)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of DNSResolveCacheSpec.
 func (o DNSResolveCacheSpec) DeepCopy() DNSResolveCacheSpec {
 	var cp DNSResolveCacheSpec = o
 	return cp
 }
 
+// DeepCopy generates a deep copy of EthernetStatusSpec.
+func (o EthernetStatusSpec) DeepCopy() EthernetStatusSpec {
+	var cp EthernetStatusSpec = o
+	if o.Rings != nil {
+		cp.Rings = new(EthernetRingsStatus)
+		*cp.Rings = *o.Rings
+	}
+	if o.Channels != nil {
+		cp.Channels = new(EthernetChannelsStatus)
+		*cp.Channels = *o.Channels
+	}
+	if o.Offloads != nil {
+		cp.Offloads = new(EthernetOffloadsStatus)
+		*cp.Offloads = *o.Offloads
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of HardwareAddrSpec.
 func (o HardwareAddrSpec) DeepCopy() HardwareAddrSpec {
 	var cp HardwareAddrSpec = o
@@ -47,6 +75,20 @@ func (o HostDNSConfigSpec) DeepCopy() HostDNSConfigSpec {
 		cp.ListenAddresses = make([]netip.AddrPort, len(o.ListenAddresses))
 		copy(cp.ListenAddresses, o.ListenAddresses)
 	}
+	if o.Upstreams != nil {
+		cp.Upstreams = make([]netip.Addr, len(o.Upstreams))
+		copy(cp.Upstreams, o.Upstreams)
+	}
+	if o.StubDomains != nil {
+		cp.StubDomains = make([]HostDNSStubDomain, len(o.StubDomains))
+		copy(cp.StubDomains, o.StubDomains)
+		for i2 := range o.StubDomains {
+			if o.StubDomains[i2].Nameservers != nil {
+				cp.StubDomains[i2].Nameservers = make([]netip.Addr, len(o.StubDomains[i2].Nameservers))
+				copy(cp.StubDomains[i2].Nameservers, o.StubDomains[i2].Nameservers)
+			}
+		}
+	}
 	return cp
 }
 
@@ -84,6 +126,16 @@ func (o LinkSpecSpec) DeepCopy() LinkSpecSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of LLDPNeighborSpec.
+func (o LLDPNeighborSpec) DeepCopy() LLDPNeighborSpec {
+	var cp LLDPNeighborSpec = o
+	if o.ManagementAddresses != nil {
+		cp.ManagementAddresses = make([]netip.Addr, len(o.ManagementAddresses))
+		copy(cp.ManagementAddresses, o.ManagementAddresses)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of LinkStatusSpec.
 func (o LinkStatusSpec) DeepCopy() LinkStatusSpec {
 	var cp LinkStatusSpec = o
@@ -30,6 +30,8 @@ type DNSUpstreamSpecSpec struct {
 	// Once generic aliases are here, redo the type above as `type DNSUpstream[P Proxy] = typed.Resource[...]`.
 	Prx Proxy
 	Idx int
+	// Domain is the stub domain the upstream is used for, empty for the default upstreams.
+	Domain string
 }
 
 // MarshalYAML implements yaml.Marshaler interface.
@@ -40,6 +42,7 @@ func (d *DNSUpstreamSpecSpec) MarshalYAML() (any, error) {
 		"healthy": strconv.FormatBool(d.Prx.Fails() == 0),
 		"addr":    d.Prx.Addr(),
 		"idx":     strconv.Itoa(d.Idx),
+		"domain":  d.Domain,
 	}, nil
 }
 
@@ -73,6 +76,10 @@ func (DNSUpstreamExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 				Name:     "Idx",
 				JSONPath: "{.idx}",
 			},
+			{
+				Name:     "Domain",
+				JSONPath: "{.domain}",
+			},
 		},
 	}
 }
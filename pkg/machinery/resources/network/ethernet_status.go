@@ -0,0 +1,119 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// EthernetStatusType is type of EthernetStatus resource.
+const EthernetStatusType = resource.Type("EthernetStatuses.net.talos.dev")
+
+// EthernetStatus resource holds the Ethernet settings (ring buffers, channels, offloads) of a link.
+type EthernetStatus = typed.Resource[EthernetStatusSpec, EthernetStatusExtension]
+
+// EthernetStatusSpec describes the Ethernet settings of a link.
+//
+// Each section is nil if the link driver doesn't support it.
+//
+//gotagsrewrite:gen
+type EthernetStatusSpec struct {
+	Rings    *EthernetRingsStatus    `yaml:"rings,omitempty" protobuf:"1"`
+	Channels *EthernetChannelsStatus `yaml:"channels,omitempty" protobuf:"2"`
+	Offloads *EthernetOffloadsStatus `yaml:"offloads,omitempty" protobuf:"3"`
+}
+
+// EthernetRingsStatus describes the ring buffer sizes of a link.
+//
+//gotagsrewrite:gen
+type EthernetRingsStatus struct {
+	RXMax uint32 `yaml:"rxMax" protobuf:"1"`
+	TXMax uint32 `yaml:"txMax" protobuf:"2"`
+	RX    uint32 `yaml:"rx" protobuf:"3"`
+	TX    uint32 `yaml:"tx" protobuf:"4"`
+}
+
+// EthernetChannelsStatus describes the queue (channel) counts of a link.
+//
+//gotagsrewrite:gen
+type EthernetChannelsStatus struct {
+	RXMax       uint32 `yaml:"rxMax" protobuf:"1"`
+	TXMax       uint32 `yaml:"txMax" protobuf:"2"`
+	OtherMax    uint32 `yaml:"otherMax" protobuf:"3"`
+	CombinedMax uint32 `yaml:"combinedMax" protobuf:"4"`
+	RX          uint32 `yaml:"rx" protobuf:"5"`
+	TX          uint32 `yaml:"tx" protobuf:"6"`
+	Other       uint32 `yaml:"other" protobuf:"7"`
+	Combined    uint32 `yaml:"combined" protobuf:"8"`
+}
+
+// EthernetOffloadsStatus describes the offload toggles of a link.
+//
+//gotagsrewrite:gen
+type EthernetOffloadsStatus struct {
+	GRO bool `yaml:"gro" protobuf:"1"`
+	GSO bool `yaml:"gso" protobuf:"2"`
+	TSO bool `yaml:"tso" protobuf:"3"`
+}
+
+// NewEthernetStatus initializes a EthernetStatus resource.
+func NewEthernetStatus(namespace resource.Namespace, id resource.ID) *EthernetStatus {
+	return typed.NewResource[EthernetStatusSpec, EthernetStatusExtension](
+		resource.NewMetadata(namespace, EthernetStatusType, id, resource.VersionUndefined),
+		EthernetStatusSpec{},
+	)
+}
+
+// EthernetStatusExtension provides auxiliary methods for EthernetStatus.
+type EthernetStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (EthernetStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             EthernetStatusType,
+		Aliases:          []resource.Type{"ethernet", "ethtool"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "RX Ring",
+				JSONPath: "{.rings.rx}",
+			},
+			{
+				Name:     "TX Ring",
+				JSONPath: "{.rings.tx}",
+			},
+			{
+				Name:     "Combined Channels",
+				JSONPath: "{.channels.combined}",
+			},
+			{
+				Name:     "GRO",
+				JSONPath: "{.offloads.gro}",
+			},
+			{
+				Name:     "GSO",
+				JSONPath: "{.offloads.gso}",
+			},
+			{
+				Name:     "TSO",
+				JSONPath: "{.offloads.tso}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[EthernetStatusSpec](EthernetStatusType, &EthernetStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
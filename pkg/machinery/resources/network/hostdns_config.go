@@ -28,10 +28,20 @@ const HostDNSConfigID resource.ID = "config"
 //
 //gotagsrewrite:gen
 type HostDNSConfigSpec struct {
-	Enabled               bool             `yaml:"enabled" protobuf:"1"`
-	ListenAddresses       []netip.AddrPort `yaml:"listenAddresses,omitempty" protobuf:"2"`
-	ServiceHostDNSAddress netip.Addr       `yaml:"serviceHostDNSAddress,omitempty" protobuf:"3"`
-	ResolveMemberNames    bool             `yaml:"resolveMemberNames,omitempty" protobuf:"4"`
+	Enabled               bool                `yaml:"enabled" protobuf:"1"`
+	ListenAddresses       []netip.AddrPort    `yaml:"listenAddresses,omitempty" protobuf:"2"`
+	ServiceHostDNSAddress netip.Addr          `yaml:"serviceHostDNSAddress,omitempty" protobuf:"3"`
+	ResolveMemberNames    bool                `yaml:"resolveMemberNames,omitempty" protobuf:"4"`
+	Upstreams             []netip.Addr        `yaml:"upstreams,omitempty" protobuf:"5"`
+	StubDomains           []HostDNSStubDomain `yaml:"stubDomains,omitempty" protobuf:"6"`
+}
+
+// HostDNSStubDomain describes the upstream nameservers of a DNS domain.
+//
+//gotagsrewrite:gen
+type HostDNSStubDomain struct {
+	Domain      string       `yaml:"domain" protobuf:"1"`
+	Nameservers []netip.Addr `yaml:"nameservers" protobuf:"2"`
 }
 
 // NewHostDNSConfig initializes a HostDNSConfig resource.
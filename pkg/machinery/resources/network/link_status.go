@@ -102,6 +102,18 @@ func (LinkStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 				Name:     "Link State",
 				JSONPath: `{.linkState}`,
 			},
+			{
+				Name:     "MTU",
+				JSONPath: `{.mtu}`,
+			},
+			{
+				Name:     "Speed Mbit",
+				JSONPath: `{.speedMbit}`,
+			},
+			{
+				Name:     "Driver",
+				JSONPath: `{.driver}`,
+			},
 		},
 		Sensitivity: meta.NonSensitive,
 	}
@@ -0,0 +1,92 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package network
+
+import (
+	"net/netip"
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// LLDPNeighborType is type of LLDPNeighbor resource.
+const LLDPNeighborType = resource.Type("LLDPNeighbors.net.talos.dev")
+
+// LLDPNeighbor resource holds a neighbor discovered via LLDP on a link.
+type LLDPNeighbor = typed.Resource[LLDPNeighborSpec, LLDPNeighborExtension]
+
+// LLDPNeighborSpec describes the LLDP neighbor.
+//
+//gotagsrewrite:gen
+type LLDPNeighborSpec struct {
+	// Name of the link the neighbor was discovered on.
+	LinkName string `yaml:"linkName" protobuf:"1"`
+	// Chassis ID of the neighbor, usually the MAC address of the switch.
+	ChassisID string `yaml:"chassisID" protobuf:"2"`
+	// Port ID of the neighbor, usually the name of the switch port.
+	PortID string `yaml:"portID" protobuf:"3"`
+	// Port description of the neighbor.
+	PortDescription string `yaml:"portDescription,omitempty" protobuf:"4"`
+	// System name of the neighbor.
+	SystemName string `yaml:"systemName,omitempty" protobuf:"5"`
+	// System description of the neighbor.
+	SystemDescription string `yaml:"systemDescription,omitempty" protobuf:"6"`
+	// Management addresses of the neighbor.
+	ManagementAddresses []netip.Addr `yaml:"managementAddresses,omitempty" protobuf:"7"`
+	// Time to live of the neighbor information.
+	TTL time.Duration `yaml:"ttl" protobuf:"8"`
+}
+
+// NewLLDPNeighbor initializes a LLDPNeighbor resource.
+func NewLLDPNeighbor(namespace resource.Namespace, id resource.ID) *LLDPNeighbor {
+	return typed.NewResource[LLDPNeighborSpec, LLDPNeighborExtension](
+		resource.NewMetadata(namespace, LLDPNeighborType, id, resource.VersionUndefined),
+		LLDPNeighborSpec{},
+	)
+}
+
+// LLDPNeighborExtension provides auxiliary methods for LLDPNeighbor.
+type LLDPNeighborExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (LLDPNeighborExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             LLDPNeighborType,
+		Aliases:          []resource.Type{"lldp", "neighbor", "neighbors"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Link",
+				JSONPath: "{.linkName}",
+			},
+			{
+				Name:     "System Name",
+				JSONPath: "{.systemName}",
+			},
+			{
+				Name:     "Port ID",
+				JSONPath: "{.portID}",
+			},
+			{
+				Name:     "Port Description",
+				JSONPath: "{.portDescription}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[LLDPNeighborSpec](LLDPNeighborType, &LLDPNeighbor{})
+	if err != nil {
+		panic(err)
+	}
+}
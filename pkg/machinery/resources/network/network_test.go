@@ -26,15 +26,18 @@ func TestRegisterResource(t *testing.T) {
 
 	for _, resource := range []meta.ResourceWithRD{
 		&network.AddressStatus{},
+		&network.BGPPeerStatus{},
 		&network.AddressSpec{},
 		&network.HardwareAddr{},
 		&network.DNSUpstream{},
+		&network.EthernetStatus{},
 		&network.HostDNSConfig{},
 		&network.HostnameStatus{},
 		&network.HostnameSpec{},
 		&network.LinkRefresh{},
 		&network.LinkStatus{},
 		&network.LinkSpec{},
+		&network.LLDPNeighbor{},
 		&network.NfTablesChain{},
 		&network.NodeAddress{},
 		&network.NodeAddressFilter{},
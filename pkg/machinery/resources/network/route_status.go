@@ -75,6 +75,22 @@ func (RouteStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 				Name:     "Metric",
 				JSONPath: `{.priority}`,
 			},
+			{
+				Name:     "Table",
+				JSONPath: `{.table}`,
+			},
+			{
+				Name:     "Source",
+				JSONPath: `{.src}`,
+			},
+			{
+				Name:     "Protocol",
+				JSONPath: `{.protocol}`,
+			},
+			{
+				Name:     "Scope",
+				JSONPath: `{.scope}`,
+			},
 		},
 	}
 }
@@ -119,3 +119,33 @@ func (condition *DevicesStatusCondition) Wait(ctx context.Context) error {
 
 	return err
 }
+
+// LSMStatusCondition implements condition which waits for the LSM status to be reported.
+type LSMStatusCondition struct {
+	state state.State
+}
+
+// NewLSMStatusCondition builds a condition which waits for the LSM status to be reported.
+//
+// The LSM status is reported once the baseline AppArmor profile is loaded (if configured),
+// so the services started after this condition run confined by the profile.
+func NewLSMStatusCondition(state state.State) *LSMStatusCondition {
+	return &LSMStatusCondition{
+		state: state,
+	}
+}
+
+func (condition *LSMStatusCondition) String() string {
+	return "LSM status"
+}
+
+// Wait implements condition interface.
+func (condition *LSMStatusCondition) Wait(ctx context.Context) error {
+	_, err := condition.state.WatchFor(
+		ctx,
+		resource.NewMetadata(NamespaceName, LSMStatusType, LSMStatusID, resource.VersionUndefined),
+		state.WithEventTypes(state.Created, state.Updated),
+	)
+
+	return err
+}
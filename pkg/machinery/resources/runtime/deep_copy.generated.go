@@ -2,7 +2,7 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type DevicesStatusSpec -type DiagnosticSpec -type EventSinkConfigSpec -type ExtensionServiceConfigSpec -type ExtensionServiceConfigStatusSpec -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type KmsgLogConfigSpec -type MaintenanceServiceConfigSpec -type MaintenanceServiceRequestSpec -type MachineResetSignalSpec -type MachineStatusSpec -type MetaKeySpec -type MountStatusSpec -type PlatformMetadataSpec -type SecurityStateSpec -type MetaLoadedSpec -type UniqueMachineTokenSpec -type WatchdogTimerConfigSpec -type WatchdogTimerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type DevicesStatusSpec -type DiagnosticSpec -type EventSinkConfigSpec -type ExtensionServiceConfigSpec -type ExtensionServiceConfigStatusSpec -type KernelModuleSpecSpec -type KernelParamSpecSpec -type KernelParamStatusSpec -type KmsgLogConfigSpec -type LSMStatusSpec -type MaintenanceServiceConfigSpec -type MaintenanceServiceRequestSpec -type MachineResetSignalSpec -type MachineStatusSpec -type MetaKeySpec -type MountStatusSpec -type PCRStatusSpec -type PlatformMetadataSpec -type SBOMItemSpec -type SecurityStateSpec -type MetaLoadedSpec -type UniqueMachineTokenSpec -type WatchdogTimerConfigSpec -type WatchdogTimerStatusSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package runtime
 
@@ -95,6 +95,16 @@ func (o KmsgLogConfigSpec) DeepCopy() KmsgLogConfigSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of LSMStatusSpec.
+func (o LSMStatusSpec) DeepCopy() LSMStatusSpec {
+	var cp LSMStatusSpec = o
+	if o.Modules != nil {
+		cp.Modules = make([]string, len(o.Modules))
+		copy(cp.Modules, o.Modules)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of MaintenanceServiceConfigSpec.
 func (o MaintenanceServiceConfigSpec) DeepCopy() MaintenanceServiceConfigSpec {
 	var cp MaintenanceServiceConfigSpec = o
@@ -147,12 +157,32 @@ func (o MountStatusSpec) DeepCopy() MountStatusSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of PCRStatusSpec.
+func (o PCRStatusSpec) DeepCopy() PCRStatusSpec {
+	var cp PCRStatusSpec = o
+	if o.Events != nil {
+		cp.Events = make([]PCREvent, len(o.Events))
+		copy(cp.Events, o.Events)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of PlatformMetadataSpec.
 func (o PlatformMetadataSpec) DeepCopy() PlatformMetadataSpec {
 	var cp PlatformMetadataSpec = o
 	return cp
 }
 
+// DeepCopy generates a deep copy of SBOMItemSpec.
+func (o SBOMItemSpec) DeepCopy() SBOMItemSpec {
+	var cp SBOMItemSpec = o
+	if o.PURLs != nil {
+		cp.PURLs = make([]string, len(o.PURLs))
+		copy(cp.PURLs, o.PURLs)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of SecurityStateSpec.
 func (o SecurityStateSpec) DeepCopy() SecurityStateSpec {
 	var cp SecurityStateSpec = o
@@ -180,5 +210,9 @@ func (o WatchdogTimerConfigSpec) DeepCopy() WatchdogTimerConfigSpec {
 // DeepCopy generates a deep copy of WatchdogTimerStatusSpec.
 func (o WatchdogTimerStatusSpec) DeepCopy() WatchdogTimerStatusSpec {
 	var cp WatchdogTimerStatusSpec = o
+	if o.LastResetReasons != nil {
+		cp.LastResetReasons = make([]string, len(o.LastResetReasons))
+		copy(cp.LastResetReasons, o.LastResetReasons)
+	}
 	return cp
 }
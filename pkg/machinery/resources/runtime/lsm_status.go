@@ -0,0 +1,75 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// LSMStatusType is the type of the LSM status resource.
+const LSMStatusType = resource.Type("LSMStatuses.talos.dev")
+
+// LSMStatusID is the ID of the LSM status resource.
+const LSMStatusID = resource.ID("lsm")
+
+// LSMStatus is the Linux Security Modules status resource.
+type LSMStatus = typed.Resource[LSMStatusSpec, LSMStatusExtension]
+
+// LSMStatusSpec describes the active Linux Security Modules and the status of the baseline AppArmor profile of the system services, kubelet and the extension services.
+//
+//gotagsrewrite:gen
+type LSMStatusSpec struct {
+	Modules         []string `yaml:"modules" protobuf:"1"`
+	AppArmorProfile string   `yaml:"appArmorProfile,omitempty" protobuf:"2"`
+	AppArmorMode    string   `yaml:"appArmorMode,omitempty" protobuf:"3"`
+}
+
+// NewLSMStatus initializes a LSM status resource.
+func NewLSMStatus() *LSMStatus {
+	return typed.NewResource[LSMStatusSpec, LSMStatusExtension](
+		resource.NewMetadata(NamespaceName, LSMStatusType, LSMStatusID, resource.VersionUndefined),
+		LSMStatusSpec{},
+	)
+}
+
+// LSMStatusExtension provides auxiliary methods for LSMStatus.
+type LSMStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (LSMStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             LSMStatusType,
+		Aliases:          []resource.Type{"lsm"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Modules",
+				JSONPath: `{.modules}`,
+			},
+			{
+				Name:     "Profile",
+				JSONPath: `{.appArmorProfile}`,
+			},
+			{
+				Name:     "Mode",
+				JSONPath: `{.appArmorMode}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[LSMStatusSpec](LSMStatusType, &LSMStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -28,6 +28,16 @@ type MachineStatus = typed.Resource[MachineStatusSpec, MachineStatusExtension]
 type MachineStatusSpec struct {
 	Stage  MachineStage        `yaml:"stage" protobuf:"1"`
 	Status MachineStatusStatus `yaml:"status" protobuf:"2"`
+
+	// Sequence and phase currently being run.
+	Sequence string `yaml:"sequence,omitempty" protobuf:"3"`
+	Phase    string `yaml:"phase,omitempty" protobuf:"4"`
+	// LastError is the error of the last failed sequence.
+	LastError string `yaml:"lastError,omitempty" protobuf:"5"`
+
+	// NodeMaintenance is set when the node is put into maintenance by the operator.
+	NodeMaintenance       bool   `yaml:"nodeMaintenance,omitempty" protobuf:"6"`
+	NodeMaintenanceReason string `yaml:"nodeMaintenanceReason,omitempty" protobuf:"7"`
 }
 
 // MachineStatusStatus describes machine current status at the stage.
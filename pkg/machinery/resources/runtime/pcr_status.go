@@ -0,0 +1,74 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// PCRStatusType is the type of the PCR status resource.
+const PCRStatusType = resource.Type("PCRStatuses.talos.dev")
+
+// PCRStatus is the PCR status resource.
+//
+// PCRStatus is created for each TPM PCR used for the attestation, resource ID is the PCR index.
+type PCRStatus = typed.Resource[PCRStatusSpec, PCRStatusExtension]
+
+// PCRStatusSpec describes the current value of the TPM PCR.
+//
+//gotagsrewrite:gen
+type PCRStatusSpec struct {
+	Value  string     `yaml:"value" protobuf:"1"`
+	Events []PCREvent `yaml:"events,omitempty" protobuf:"2"`
+}
+
+// PCREvent describes a single measurement extended to the PCR.
+//
+//gotagsrewrite:gen
+type PCREvent struct {
+	Type        string `yaml:"type" protobuf:"1"`
+	Digest      string `yaml:"digest" protobuf:"2"`
+	Description string `yaml:"description,omitempty" protobuf:"3"`
+}
+
+// NewPCRStatus initializes a PCR status resource.
+func NewPCRStatus(id resource.ID) *PCRStatus {
+	return typed.NewResource[PCRStatusSpec, PCRStatusExtension](
+		resource.NewMetadata(NamespaceName, PCRStatusType, id, resource.VersionUndefined),
+		PCRStatusSpec{},
+	)
+}
+
+// PCRStatusExtension provides auxiliary methods for PCRStatus.
+type PCRStatusExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (PCRStatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             PCRStatusType,
+		Aliases:          []resource.Type{"pcrs"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Value",
+				JSONPath: `{.value}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[PCRStatusSpec](PCRStatusType, &PCRStatus{})
+	if err != nil {
+		panic(err)
+	}
+}
@@ -33,6 +33,7 @@ func TestRegisterResource(t *testing.T) {
 		&runtime.KernelParamSpec{},
 		&runtime.KernelParamStatus{},
 		&runtime.KmsgLogConfig{},
+		&runtime.LSMStatus{},
 		&runtime.MachineStatus{},
 		&runtime.MachineResetSignal{},
 		&runtime.MaintenanceServiceConfig{},
@@ -40,7 +41,9 @@ func TestRegisterResource(t *testing.T) {
 		&runtime.MetaKey{},
 		&runtime.MetaLoaded{},
 		&runtime.MountStatus{},
+		&runtime.PCRStatus{},
 		&runtime.PlatformMetadata{},
+		&runtime.SBOMItem{},
 		&runtime.SecurityState{},
 		&runtime.UniqueMachineToken{},
 		&runtime.WatchdogTimerConfig{},
@@ -0,0 +1,72 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package runtime
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// SBOMItemType is the type of the SBOM item resource.
+const SBOMItemType = resource.Type("SBOMItems.talos.dev")
+
+// SBOMItem is the SBOM item resource.
+//
+// SBOMItem is created for each package listed in the SBOM documents shipped with Talos and extensions.
+type SBOMItem = typed.Resource[SBOMItemSpec, SBOMItemExtension]
+
+// SBOMItemSpec describes a single package from the SBOM.
+//
+//gotagsrewrite:gen
+type SBOMItemSpec struct {
+	Name      string   `yaml:"name" protobuf:"1"`
+	Version   string   `yaml:"version" protobuf:"2"`
+	License   string   `yaml:"license,omitempty" protobuf:"3"`
+	PURLs     []string `yaml:"purls,omitempty" protobuf:"4"`
+	Extension bool     `yaml:"extension,omitempty" protobuf:"5"`
+}
+
+// NewSBOMItem initializes a SBOM item resource.
+func NewSBOMItem(id resource.ID) *SBOMItem {
+	return typed.NewResource[SBOMItemSpec, SBOMItemExtension](
+		resource.NewMetadata(NamespaceName, SBOMItemType, id, resource.VersionUndefined),
+		SBOMItemSpec{},
+	)
+}
+
+// SBOMItemExtension provides auxiliary methods for SBOMItem.
+type SBOMItemExtension struct{}
+
+// ResourceDefinition implements [typed.Extension] interface.
+func (SBOMItemExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             SBOMItemType,
+		Aliases:          []resource.Type{"sboms"},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Version",
+				JSONPath: `{.version}`,
+			},
+			{
+				Name:     "License",
+				JSONPath: `{.license}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[SBOMItemSpec](SBOMItemType, &SBOMItem{})
+	if err != nil {
+		panic(err)
+	}
+}
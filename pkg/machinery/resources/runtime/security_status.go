@@ -26,9 +26,10 @@ type SecurityState = typed.Resource[SecurityStateSpec, SecurityStateExtension]
 //
 //gotagsrewrite:gen
 type SecurityStateSpec struct {
-	SecureBoot               bool   `yaml:"secureBoot" protobuf:"1"`
-	UKISigningKeyFingerprint string `yaml:"ukiSigningKeyFingerprint,omitempty" protobuf:"2"`
-	PCRSigningKeyFingerprint string `yaml:"pcrSigningKeyFingerprint,omitempty" protobuf:"3"`
+	SecureBoot                bool   `yaml:"secureBoot" protobuf:"1"`
+	UKISigningKeyFingerprint  string `yaml:"ukiSigningKeyFingerprint,omitempty" protobuf:"2"`
+	PCRSigningKeyFingerprint  string `yaml:"pcrSigningKeyFingerprint,omitempty" protobuf:"3"`
+	AttestationKeyFingerprint string `yaml:"attestationKeyFingerprint,omitempty" protobuf:"4"`
 }
 
 // NewSecurityStateSpec initializes a security state resource.
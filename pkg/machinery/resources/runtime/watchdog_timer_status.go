@@ -25,9 +25,10 @@ type WatchdogTimerStatus = typed.Resource[WatchdogTimerStatusSpec, WatchdogTimer
 //
 //gotagsrewrite:gen
 type WatchdogTimerStatusSpec struct {
-	Device       string        `yaml:"device" protobuf:"1"`
-	Timeout      time.Duration `yaml:"timeout" protobuf:"2"`
-	FeedInterval time.Duration `yaml:"feedInterval" protobuf:"3"`
+	Device           string        `yaml:"device" protobuf:"1"`
+	Timeout          time.Duration `yaml:"timeout" protobuf:"2"`
+	FeedInterval     time.Duration `yaml:"feedInterval" protobuf:"3"`
+	LastResetReasons []string      `yaml:"lastResetReasons,omitempty" protobuf:"4"`
 }
 
 // NewWatchdogTimerStatus initializes a WatchdogTimerStatus resource.
@@ -2,15 +2,14 @@
 // License, v. 2.0. If a copy of the MPL was not distributed with this
 // file, You can obtain one at http://mozilla.org/MPL/2.0/.
 
-// Code generated by "deep-copy -type APICertsSpec -type CertSANSpec -type EtcdCertsSpec -type EtcdRootSpec -type KubeletSpec -type KubernetesCertsSpec -type KubernetesDynamicCertsSpec -type KubernetesRootSpec -type MaintenanceServiceCertsSpec -type MaintenanceRootSpec -type OSRootSpec -type TrustdCertsSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
+// Code generated by "deep-copy -type APICertsSpec -type CertSANSpec -type EtcdCertsSpec -type EtcdCertsRefreshRequestSpec -type EtcdRootSpec -type KubeletSpec -type KubernetesCertsSpec -type KubernetesDynamicCertsSpec -type KubernetesRootSpec -type MaintenanceServiceCertsSpec -type MaintenanceRootSpec -type OSRootSpec -type TrustdAttestationPolicySpec -type TrustdCSRPolicySpec -type TrustdCertsSpec -type TrustdJoinTokenSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go ."; DO NOT EDIT.
 
 package secrets
 
 import (
+	"github.com/siderolabs/crypto/x509"
 	"net/netip"
 	"net/url"
-
-	"github.com/siderolabs/crypto/x509"
 )
 
 // DeepCopy generates a deep copy of APICertsSpec.
@@ -66,6 +65,12 @@ func (o EtcdCertsSpec) DeepCopy() EtcdCertsSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of EtcdCertsRefreshRequestSpec.
+func (o EtcdCertsRefreshRequestSpec) DeepCopy() EtcdCertsRefreshRequestSpec {
+	var cp EtcdCertsRefreshRequestSpec = o
+	return cp
+}
+
 // DeepCopy generates a deep copy of EtcdRootSpec.
 func (o EtcdRootSpec) DeepCopy() EtcdRootSpec {
 	var cp EtcdRootSpec = o
@@ -214,6 +219,40 @@ func (o OSRootSpec) DeepCopy() OSRootSpec {
 	return cp
 }
 
+// DeepCopy generates a deep copy of TrustdAttestationPolicySpec.
+func (o TrustdAttestationPolicySpec) DeepCopy() TrustdAttestationPolicySpec {
+	var cp TrustdAttestationPolicySpec = o
+	if o.PCRs != nil {
+		cp.PCRs = make([]PCRPolicy, len(o.PCRs))
+		copy(cp.PCRs, o.PCRs)
+		for i2 := range o.PCRs {
+			if o.PCRs[i2].Values != nil {
+				cp.PCRs[i2].Values = make([]string, len(o.PCRs[i2].Values))
+				copy(cp.PCRs[i2].Values, o.PCRs[i2].Values)
+			}
+		}
+	}
+	if o.AttestationKeys != nil {
+		cp.AttestationKeys = make([]string, len(o.AttestationKeys))
+		copy(cp.AttestationKeys, o.AttestationKeys)
+	}
+	return cp
+}
+
+// DeepCopy generates a deep copy of TrustdCSRPolicySpec.
+func (o TrustdCSRPolicySpec) DeepCopy() TrustdCSRPolicySpec {
+	var cp TrustdCSRPolicySpec = o
+	if o.AllowedIPs != nil {
+		cp.AllowedIPs = make([]netip.Prefix, len(o.AllowedIPs))
+		copy(cp.AllowedIPs, o.AllowedIPs)
+	}
+	if o.AllowedDNSNames != nil {
+		cp.AllowedDNSNames = make([]string, len(o.AllowedDNSNames))
+		copy(cp.AllowedDNSNames, o.AllowedDNSNames)
+	}
+	return cp
+}
+
 // DeepCopy generates a deep copy of TrustdCertsSpec.
 func (o TrustdCertsSpec) DeepCopy() TrustdCertsSpec {
 	var cp TrustdCertsSpec = o
@@ -231,3 +270,9 @@ func (o TrustdCertsSpec) DeepCopy() TrustdCertsSpec {
 	}
 	return cp
 }
+
+// DeepCopy generates a deep copy of TrustdJoinTokenSpec.
+func (o TrustdJoinTokenSpec) DeepCopy() TrustdJoinTokenSpec {
+	var cp TrustdJoinTokenSpec = o
+	return cp
+}
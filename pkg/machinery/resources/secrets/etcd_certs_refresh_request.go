@@ -0,0 +1,64 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// EtcdCertsRefreshRequestType is type of EtcdCertsRefreshRequest resource.
+const EtcdCertsRefreshRequestType = resource.Type("EtcdCertsRefreshRequests.secrets.talos.dev")
+
+// EtcdCertsRefreshRequest resource holds a request to issue new etcd certificates.
+//
+// The resource has the same ID as the Etcd resource.
+type EtcdCertsRefreshRequest = typed.Resource[EtcdCertsRefreshRequestSpec, EtcdCertsRefreshRequestExtension]
+
+// EtcdCertsRefreshRequestSpec is the spec for EtcdCertsRefreshRequest.
+//
+//gotagsrewrite:gen
+type EtcdCertsRefreshRequestSpec struct {
+	Request int `yaml:"request" protobuf:"1"`
+}
+
+// NewEtcdCertsRefreshRequest initializes a EtcdCertsRefreshRequest resource.
+func NewEtcdCertsRefreshRequest() *EtcdCertsRefreshRequest {
+	return typed.NewResource[EtcdCertsRefreshRequestSpec, EtcdCertsRefreshRequestExtension](
+		resource.NewMetadata(NamespaceName, EtcdCertsRefreshRequestType, EtcdID, resource.VersionUndefined),
+		EtcdCertsRefreshRequestSpec{},
+	)
+}
+
+// EtcdCertsRefreshRequestExtension is auxiliary resource data for EtcdCertsRefreshRequest.
+type EtcdCertsRefreshRequestExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (EtcdCertsRefreshRequestExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             EtcdCertsRefreshRequestType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Request",
+				JSONPath: `{.request}`,
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	err := protobuf.RegisterDynamic[EtcdCertsRefreshRequestSpec](EtcdCertsRefreshRequestType, &EtcdCertsRefreshRequest{})
+	if err != nil {
+		panic(err)
+	}
+}
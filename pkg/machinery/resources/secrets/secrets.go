@@ -10,4 +10,4 @@ import "github.com/cosi-project/runtime/pkg/resource"
 // NamespaceName contains resources containing secret material.
 const NamespaceName resource.Namespace = "secrets"
 
-//go:generate deep-copy -type APICertsSpec -type CertSANSpec -type EtcdCertsSpec -type EtcdRootSpec -type KubeletSpec -type KubernetesCertsSpec -type KubernetesDynamicCertsSpec -type KubernetesRootSpec -type MaintenanceServiceCertsSpec -type MaintenanceRootSpec -type OSRootSpec -type TrustdCertsSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
+//go:generate deep-copy -type APICertsSpec -type CertSANSpec -type EtcdCertsSpec -type EtcdCertsRefreshRequestSpec -type EtcdRootSpec -type KubeletSpec -type KubernetesCertsSpec -type KubernetesDynamicCertsSpec -type KubernetesRootSpec -type MaintenanceServiceCertsSpec -type MaintenanceRootSpec -type OSRootSpec -type TrustdAttestationPolicySpec -type TrustdCSRPolicySpec -type TrustdCertsSpec -type TrustdJoinTokenSpec -header-file ../../../../hack/boilerplate.txt -o deep_copy.generated.go .
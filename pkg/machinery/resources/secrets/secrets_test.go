@@ -28,6 +28,7 @@ func TestRegisterResource(t *testing.T) {
 		&secrets.API{},
 		&secrets.CertSAN{},
 		&secrets.Etcd{},
+		&secrets.EtcdCertsRefreshRequest{},
 		&secrets.EtcdRoot{},
 		&secrets.Kubelet{},
 		&secrets.Kubernetes{},
@@ -37,6 +38,9 @@ func TestRegisterResource(t *testing.T) {
 		&secrets.MaintenanceRoot{},
 		&secrets.OSRoot{},
 		&secrets.Trustd{},
+		&secrets.TrustdAttestationPolicy{},
+		&secrets.TrustdCSRPolicy{},
+		&secrets.TrustdJoinToken{},
 	} {
 		assert.NoError(t, resourceRegistry.Register(ctx, resource))
 	}
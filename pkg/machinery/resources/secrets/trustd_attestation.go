@@ -0,0 +1,74 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// TrustdAttestationPolicyType is type of TrustdAttestationPolicy resource.
+const TrustdAttestationPolicyType = resource.Type("TrustdAttestationPolicies.secrets.talos.dev")
+
+// TrustdAttestationPolicyID is a resource ID of singleton instance.
+const TrustdAttestationPolicyID = resource.ID("trustd")
+
+// TrustdAttestationPolicy describes TPM attestation policy enforced by trustd.
+type TrustdAttestationPolicy = typed.Resource[TrustdAttestationPolicySpec, TrustdAttestationPolicyExtension]
+
+// TrustdAttestationPolicySpec describes TPM attestation policy enforced by trustd.
+//
+//gotagsrewrite:gen
+type TrustdAttestationPolicySpec struct {
+	Required        bool        `yaml:"required" protobuf:"1"`
+	PCRs            []PCRPolicy `yaml:"pcrs,omitempty" protobuf:"2"`
+	AttestationKeys []string    `yaml:"attestationKeys,omitempty" protobuf:"3"`
+}
+
+// PCRPolicy describes accepted values of a single PCR.
+//
+//gotagsrewrite:gen
+type PCRPolicy struct {
+	Index  int      `yaml:"index" protobuf:"1"`
+	Values []string `yaml:"values" protobuf:"2"`
+}
+
+// NewTrustdAttestationPolicy initializes a TrustdAttestationPolicy resource.
+func NewTrustdAttestationPolicy() *TrustdAttestationPolicy {
+	return typed.NewResource[TrustdAttestationPolicySpec, TrustdAttestationPolicyExtension](
+		resource.NewMetadata(NamespaceName, TrustdAttestationPolicyType, TrustdAttestationPolicyID, resource.VersionUndefined),
+		TrustdAttestationPolicySpec{},
+	)
+}
+
+// TrustdAttestationPolicyExtension provides auxiliary methods for TrustdAttestationPolicy.
+type TrustdAttestationPolicyExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (TrustdAttestationPolicyExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             TrustdAttestationPolicyType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Required",
+				JSONPath: "{.required}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	if err := protobuf.RegisterDynamic[TrustdAttestationPolicySpec](TrustdAttestationPolicyType, &TrustdAttestationPolicy{}); err != nil {
+		panic(err)
+	}
+}
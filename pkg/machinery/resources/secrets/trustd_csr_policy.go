@@ -0,0 +1,71 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"net/netip"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// TrustdCSRPolicyType is type of TrustdCSRPolicy resource.
+const TrustdCSRPolicyType = resource.Type("TrustdCSRPolicies.secrets.talos.dev")
+
+// TrustdCSRPolicyID is a resource ID of singleton instance.
+const TrustdCSRPolicyID = resource.ID("trustd")
+
+// TrustdCSRPolicy describes the policy for the certificate requests signed by trustd.
+type TrustdCSRPolicy = typed.Resource[TrustdCSRPolicySpec, TrustdCSRPolicyExtension]
+
+// TrustdCSRPolicySpec describes the policy for the certificate requests signed by trustd.
+//
+//gotagsrewrite:gen
+type TrustdCSRPolicySpec struct {
+	AllowedIPs      []netip.Prefix `yaml:"allowedIPs,omitempty" protobuf:"1"`
+	AllowedDNSNames []string       `yaml:"allowedDNSNames,omitempty" protobuf:"2"`
+}
+
+// NewTrustdCSRPolicy initializes a TrustdCSRPolicy resource.
+func NewTrustdCSRPolicy() *TrustdCSRPolicy {
+	return typed.NewResource[TrustdCSRPolicySpec, TrustdCSRPolicyExtension](
+		resource.NewMetadata(NamespaceName, TrustdCSRPolicyType, TrustdCSRPolicyID, resource.VersionUndefined),
+		TrustdCSRPolicySpec{},
+	)
+}
+
+// TrustdCSRPolicyExtension provides auxiliary methods for TrustdCSRPolicy.
+type TrustdCSRPolicyExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (TrustdCSRPolicyExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             TrustdCSRPolicyType,
+		Aliases:          []resource.Type{},
+		DefaultNamespace: NamespaceName,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Allowed IPs",
+				JSONPath: "{.allowedIPs}",
+			},
+			{
+				Name:     "Allowed DNS Names",
+				JSONPath: "{.allowedDNSNames}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	if err := protobuf.RegisterDynamic[TrustdCSRPolicySpec](TrustdCSRPolicyType, &TrustdCSRPolicy{}); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,73 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package secrets
+
+import (
+	"time"
+
+	"github.com/cosi-project/runtime/pkg/resource"
+	"github.com/cosi-project/runtime/pkg/resource/meta"
+	"github.com/cosi-project/runtime/pkg/resource/protobuf"
+	"github.com/cosi-project/runtime/pkg/resource/typed"
+
+	"github.com/siderolabs/talos/pkg/machinery/proto"
+)
+
+// TrustdJoinTokenType is type of TrustdJoinToken resource.
+const TrustdJoinTokenType = resource.Type("TrustdJoinTokens.secrets.talos.dev")
+
+// TrustdJoinToken describes a short-lived token accepted by trustd in addition to the machine token.
+//
+// Resource ID is the token ID.
+type TrustdJoinToken = typed.Resource[TrustdJoinTokenSpec, TrustdJoinTokenExtension]
+
+// TrustdJoinTokenSpec describes a short-lived token accepted by trustd in addition to the machine token.
+//
+//gotagsrewrite:gen
+type TrustdJoinTokenSpec struct {
+	// SecretHash is the hex-encoded SHA-256 hash of the token secret.
+	SecretHash  string    `yaml:"secretHash" protobuf:"1"`
+	Expires     time.Time `yaml:"expires" protobuf:"2"`
+	Description string    `yaml:"description,omitempty" protobuf:"3"`
+}
+
+// NewTrustdJoinToken initializes a TrustdJoinToken resource.
+func NewTrustdJoinToken(id resource.ID) *TrustdJoinToken {
+	return typed.NewResource[TrustdJoinTokenSpec, TrustdJoinTokenExtension](
+		resource.NewMetadata(NamespaceName, TrustdJoinTokenType, id, resource.VersionUndefined),
+		TrustdJoinTokenSpec{},
+	)
+}
+
+// TrustdJoinTokenExtension provides auxiliary methods for TrustdJoinToken.
+type TrustdJoinTokenExtension struct{}
+
+// ResourceDefinition implements meta.ResourceDefinitionProvider interface.
+func (TrustdJoinTokenExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
+	return meta.ResourceDefinitionSpec{
+		Type:             TrustdJoinTokenType,
+		Aliases:          []resource.Type{"joinToken", "joinTokens"},
+		DefaultNamespace: NamespaceName,
+		Sensitivity:      meta.Sensitive,
+		PrintColumns: []meta.PrintColumn{
+			{
+				Name:     "Expires",
+				JSONPath: "{.expires}",
+			},
+			{
+				Name:     "Description",
+				JSONPath: "{.description}",
+			},
+		},
+	}
+}
+
+func init() {
+	proto.RegisterDefaultTypes()
+
+	if err := protobuf.RegisterDynamic[TrustdJoinTokenSpec](TrustdJoinTokenType, &TrustdJoinToken{}); err != nil {
+		panic(err)
+	}
+}
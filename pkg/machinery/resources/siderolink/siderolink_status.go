@@ -5,6 +5,8 @@
 package siderolink
 
 import (
+	"net/netip"
+
 	"github.com/cosi-project/runtime/pkg/resource"
 	"github.com/cosi-project/runtime/pkg/resource/meta"
 	"github.com/cosi-project/runtime/pkg/resource/protobuf"
@@ -31,6 +33,8 @@ type StatusSpec struct {
 	Host string `yaml:"host" protobuf:"1"`
 	// Connected is the status of the Siderolink GRPC connection.
 	Connected bool `yaml:"connected" protobuf:"2"`
+	// NodeAddress is the address of the node on the tunnel, the Talos API is reachable on this address.
+	NodeAddress netip.Prefix `yaml:"nodeAddress,omitempty" protobuf:"3"`
 }
 
 // NewStatus initializes a Status resource.
@@ -59,6 +63,10 @@ func (StatusExtension) ResourceDefinition() meta.ResourceDefinitionSpec {
 				Name:     "Connected",
 				JSONPath: `{.connected}`,
 			},
+			{
+				Name:     "Node Address",
+				JSONPath: `{.nodeAddress}`,
+			},
 		},
 	}
 }
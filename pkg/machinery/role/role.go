@@ -20,7 +20,7 @@ const (
 	// Prefix for all built-in roles.
 	Prefix = string("os:")
 
-	// Admin defines Talos role for admins (every API is available).
+	// Admin defines Talos role for admins (every API is available, except for the chaos APIs).
 	Admin = Role(Prefix + "admin")
 
 	// Operator defines Talos role for operators (Reader + management APIs which do not allow secret access, e.g. rebooting a node).
@@ -32,6 +32,14 @@ const (
 	// EtcdBackup defines Talos role that allows making etcd backups.
 	EtcdBackup = Role(Prefix + "etcd:backup")
 
+	// Support defines Talos role for the temporary diagnostic access, e.g. by the vendors:
+	// it only allows reading the logs, the kernel log, the version and the list of services.
+	Support = Role(Prefix + "support")
+
+	// Chaos defines Talos role that allows inducing controlled failures on the node via the chaos APIs.
+	// It is not included into any other role, and the APIs should be enabled in the machine configuration.
+	Chaos = Role(Prefix + "chaos")
+
 	// Impersonator defines Talos role for impersonating another user (and their role).
 	// Used internally, but may also be granted to the user.
 	Impersonator = Role(Prefix + "impersonator")
@@ -44,7 +52,7 @@ type Set struct {
 
 var (
 	// All roles that can be granted to users.
-	All = MakeSet(Admin, Operator, Reader, EtcdBackup, Impersonator)
+	All = MakeSet(Admin, Operator, Reader, EtcdBackup, Support, Chaos, Impersonator)
 
 	// Zero is an empty set of roles.
 	Zero = MakeSet()
@@ -32,3 +32,14 @@ func TestSet(t *testing.T) {
 	assert.False(t, role.MakeSet().IncludesAny(roles))
 	assert.False(t, role.MakeSet().IncludesAny(role.MakeSet()))
 }
+
+func TestNodeScope(t *testing.T) {
+	t.Parallel()
+
+	units := role.NodeScopeUnits([]string{"10.5.0.2", "worker-1"})
+	assert.Equal(t, []string{"talos-node:10.5.0.2", "talos-node:worker-1"}, units)
+
+	assert.Equal(t, []string{"10.5.0.2", "worker-1"}, role.NodeScope(append(units, "other", "talos-node:")))
+	assert.Nil(t, role.NodeScope(nil))
+	assert.Nil(t, role.NodeScope([]string{"other"}))
+}
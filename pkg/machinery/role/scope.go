@@ -0,0 +1,36 @@
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at http://mozilla.org/MPL/2.0/.
+
+package role
+
+import "strings"
+
+// NodeScopePrefix prefixes the Organizational Unit values of Talos client certificate
+// which limit the certificate to the specific nodes.
+const NodeScopePrefix = "talos-node:"
+
+// NodeScope returns the nodes the client certificate is limited to, given its Organizational Unit values.
+// Nil is returned if the certificate is not limited to the specific nodes.
+func NodeScope(organizationalUnits []string) []string {
+	var nodes []string
+
+	for _, ou := range organizationalUnits {
+		if node, ok := strings.CutPrefix(ou, NodeScopePrefix); ok && node != "" {
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes
+}
+
+// NodeScopeUnits returns the Organizational Unit values which limit the client certificate to the nodes.
+func NodeScopeUnits(nodes []string) []string {
+	units := make([]string, 0, len(nodes))
+
+	for _, node := range nodes {
+		units = append(units, NodeScopePrefix+node)
+	}
+
+	return units
+}
@@ -15,6 +15,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/alexflint/go-filemutex"
 	"github.com/containernetworking/cni/libcni"
@@ -55,6 +56,7 @@ type LaunchConfig struct {
 	TPM2Config        tpm2Config
 	NodeUUID          uuid.UUID
 	BadRTC            bool
+	ClockOffset       time.Duration
 	ArchitectureData  Arch
 
 	// Talos config
@@ -293,7 +295,7 @@ func launchVM(config *LaunchConfig) error {
 
 	cpuArg := "max"
 
-	if config.BadRTC {
+	if config.BadRTC || config.ClockOffset != 0 {
 		cpuArg += ",-kvmclock"
 	}
 
@@ -430,11 +432,17 @@ func launchVM(config *LaunchConfig) error {
 		}
 	}
 
-	if config.BadRTC {
+	switch {
+	case config.BadRTC:
 		args = append(args,
 			"-rtc",
 			"base=2011-11-11T11:11:00,clock=rt",
 		)
+	case config.ClockOffset != 0:
+		args = append(args,
+			"-rtc",
+			fmt.Sprintf("base=%s,clock=rt", time.Now().UTC().Add(config.ClockOffset).Format("2006-01-02T15:04:05")),
+		)
 	}
 
 	fmt.Fprintf(os.Stderr, "starting %s with args:\n%s\n", config.ArchitectureData.QemuExecutable(), strings.Join(args, " "))
@@ -141,6 +141,7 @@ func (p *provisioner) createNode(state *vm.State, clusterReq provision.ClusterRe
 		MonitorPath:       state.GetRelativePath(fmt.Sprintf("%s.monitor", nodeReq.Name)),
 		EnableKVM:         opts.TargetArch == runtime.GOARCH,
 		BadRTC:            nodeReq.BadRTC,
+		ClockOffset:       nodeReq.ClockOffset,
 		DefaultBootOrder:  defaultBootOrder,
 		BootloaderEnabled: opts.BootloaderEnabled,
 		NodeUUID:          nodeUUID,
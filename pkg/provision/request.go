@@ -201,6 +201,8 @@ type NodeRequest struct {
 
 	// BadRTC resets RTC to well known time in the past (QEMU provisioner).
 	BadRTC bool
+	// ClockOffset shifts RTC relative to the host clock (QEMU provisioner).
+	ClockOffset time.Duration
 
 	// PXE-booted VMs
 	PXEBooted        bool